@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/do/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/shadowapi/shadowapi/backend/internal/admin"
+	"github.com/shadowapi/shadowapi/backend/internal/queue"
+)
+
+// flags shared by the admin commands, registered as persistent flags on the
+// command groups so that they can be given after any subcommand
+var (
+	adminOutput    string
+	adminRemote    string
+	adminToken     string
+	adminWorkspace string
+)
+
+// adminFlags registers the shared flags on the command group.
+func adminFlags(cmd *cobra.Command) {
+	f := cmd.PersistentFlags()
+	f.StringVarP(&adminOutput, "output", "o", "table", "output format: table, json or yaml")
+	f.StringVar(&adminRemote, "remote", os.Getenv("SA_REMOTE_URL"), "URL of the API to work through instead of the database (env SA_REMOTE_URL)")
+	f.StringVar(&adminToken, "token", os.Getenv("SA_API_TOKEN"), "bearer token or API key for --remote (env SA_API_TOKEN)")
+	f.StringVarP(&adminWorkspace, "workspace", "w", "", "workspace slug or UUID, remotely only the UUID (default workspace when empty)")
+}
+
+// adminBackend returns the backend selected by the flags: the REST API with
+// --remote, the database otherwise.
+func adminBackend(cmd *cobra.Command) (admin.Backend, error) {
+	// the arguments are valid by now, the usage does not help with the errors
+	cmd.SilenceUsage = true
+	if adminRemote != "" {
+		if adminToken == "" {
+			return nil, fmt.Errorf("--remote needs --token or SA_API_TOKEN")
+		}
+		return admin.NewRemote(adminRemote, adminToken, adminWorkspace)
+	}
+	dbp := do.MustInvoke[*pgxpool.Pool](injector)
+	log := do.MustInvoke[*slog.Logger](injector)
+	return admin.NewDB(cmd.Context(), log, dbp, func() (*queue.Queue, error) {
+		return do.Invoke[*queue.Queue](injector)
+	}, adminWorkspace)
+}
+
+// adminFormat returns the output format of the --output flag.
+func adminFormat() (admin.Format, error) {
+	return admin.ParseFormat(adminOutput)
+}
+
+// adminList runs the list function against the backend and writes the items.
+func adminList[T admin.Item](cmd *cobra.Command, list func(admin.Backend) ([]T, error)) error {
+	f, err := adminFormat()
+	if err != nil {
+		return err
+	}
+	b, err := adminBackend(cmd)
+	if err != nil {
+		return err
+	}
+	items, err := list(b)
+	if err != nil {
+		return err
+	}
+	return admin.Write(cmd.OutOrStdout(), f, items)
+}
+
+// adminOne runs the function against the backend and writes the item.
+func adminOne[T admin.Item](cmd *cobra.Command, get func(admin.Backend) (T, error)) error {
+	f, err := adminFormat()
+	if err != nil {
+		return err
+	}
+	b, err := adminBackend(cmd)
+	if err != nil {
+		return err
+	}
+	item, err := get(b)
+	if err != nil {
+		return err
+	}
+	return admin.WriteOne(cmd.OutOrStdout(), f, item)
+}
+
+// adminDo runs the function against the backend for every UUID argument.
+func adminDo(cmd *cobra.Command, args []string, verb string, fn func(admin.Backend, uuid.UUID) error) error {
+	b, err := adminBackend(cmd)
+	if err != nil {
+		return err
+	}
+	for _, arg := range args {
+		id, err := uuid.FromString(arg)
+		if err != nil {
+			return fmt.Errorf("invalid UUID %q: %w", arg, err)
+		}
+		if err := fn(b, id); err != nil {
+			return fmt.Errorf("%s %s: %w", verb, id, err)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "%s %s\n", verb, id)
+	}
+	return nil
+}
+
+// optUUID parses an optional UUID flag.
+func optUUID(name, v string) (*uuid.UUID, error) {
+	if v == "" {
+		return nil, nil
+	}
+	id, err := uuid.FromString(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", name, err)
+	}
+	return &id, nil
+}
+
+// parseSince parses a time flag given as RFC 3339, a date or a duration
+// back from now, e.g. 24h.
+func parseSince(name, v string) (time.Time, error) {
+	if v == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(v); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid --%s %q, use RFC 3339, YYYY-MM-DD or a duration like 24h", name, v)
+}
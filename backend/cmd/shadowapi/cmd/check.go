@@ -1,170 +1,93 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"slices"
 	"strings"
 	"time"
 
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/samber/do/v2"
 	"github.com/spf13/cobra"
 
-	"github.com/shadowapi/shadowapi/backend/pkg/query"
+	"github.com/shadowapi/shadowapi/backend/internal/health"
+)
+
+var (
+	checkJSON    bool
+	checkGroups  []string
+	checkTimeout time.Duration
 )
 
 var checkCmd = &cobra.Command{
 	Use:   "check",
-	Short: "Run health checks (db, storages, tokens, pipelines)",
-	Run: func(cmd *cobra.Command, args []string) {
-		ctx := cmd.Context()
-		dbp := do.MustInvoke[*pgxpool.Pool](injector)
-		q := query.New(dbp)
-
-		fmt.Println("ShadowAPI Health Check")
-		fmt.Println(strings.Repeat("═", 50))
+	Short: "Probe the database, queue, storages, tokens, mailboxes and schedulers",
+	Long: `Probe everything shadowapi depends on: the database, NATS and the worker
+consumer lag, every enabled storage (S3 objects are written, read back and
+deleted, hostfiles directories written to, external Postgres pinged), whether
+the OAuth2 tokens can be refreshed (without refreshing them), the IMAP and
+SMTP logins of the email datasources, and schedulers running late.
 
-		// DB
-		var one int
-		err := dbp.QueryRow(ctx, "SELECT 1").Scan(&one)
-		printCheck("PostgreSQL", err)
-
-		// Storages
-		fmt.Println("\n── Storages ──")
-		storages, err := q.GetStorages(ctx, query.GetStoragesParams{
-			OrderBy: "created_at", OrderDirection: "desc",
-			Limit: 50, IsEnabled: -1,
-		})
-		if err != nil {
-			fmt.Printf("  FAIL: %v\n", err)
-		} else if len(storages) == 0 {
-			fmt.Println("  (none)")
-		} else {
-			for _, s := range storages {
-				status := "disabled"
-				if s.IsEnabled {
-					status = "enabled"
-				}
-				fmt.Printf("  %-30s %-10s %s\n", s.Name, s.Type, status)
+Exits with 0 when everything is fine, 2 on warnings only and 1 on failures.
+The report covers every workspace, the server only answers the shallow
+/healthz and /readyz probes.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, g := range checkGroups {
+			if !slices.Contains(health.Groups, g) {
+				return fmt.Errorf("unknown group %q, use %s", g, strings.Join(health.Groups, ", "))
 			}
 		}
+		checker := do.MustInvoke[*health.Checker](injector)
+		checker.Timeout = checkTimeout
+		report := checker.Deep(cmd.Context(), checkGroups...)
 
-		// Datasources
-		fmt.Println("\n── Datasources ──")
-		datasources, err := q.GetDatasources(ctx, query.GetDatasourcesParams{
-			OrderBy: "created_at", OrderDirection: "desc",
-			Limit: 50, IsEnabled: -1,
-		})
-		if err != nil {
-			fmt.Printf("  FAIL: %v\n", err)
-		} else if len(datasources) == 0 {
-			fmt.Println("  (none)")
-		} else {
-			for _, d := range datasources {
-				status := "disabled"
-				if d.IsEnabled {
-					status = "enabled"
-				}
-				fmt.Printf("  %-38s %-15s %-12s %s\n",
-					d.UUID, d.Name, d.Type, status)
+		out := cmd.OutOrStdout()
+		if checkJSON {
+			enc := json.NewEncoder(out)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(report); err != nil {
+				return err
 			}
-		}
-
-		// Pipelines
-		fmt.Println("\n── Pipelines ──")
-		pipes, err := q.GetPipelines(ctx, query.GetPipelinesParams{
-			OrderBy: "created_at", OrderDirection: "desc",
-			Limit: 50, Type: "", IsEnabled: -1,
-		})
-		if err != nil {
-			fmt.Printf("  FAIL: %v\n", err)
-		} else if len(pipes) == 0 {
-			fmt.Println("  (none)")
 		} else {
-			for _, p := range pipes {
-				status := "disabled"
-				if p.IsEnabled {
-					status = "enabled"
-				}
-				fmt.Printf("  %-38s %-25s %-12s %s\n",
-					p.UUID, p.Name, p.Type, status)
-			}
+			printReport(report)
 		}
 
-		// Schedulers
-		fmt.Println("\n── Schedulers ──")
-		schedulers, err := q.GetSchedulers(ctx, query.GetSchedulersParams{
-			OrderBy: "created_at", OrderDirection: "desc",
-			Limit: 50, IsEnabled: -1, IsPaused: -1,
-		})
-		if err != nil {
-			fmt.Printf("  FAIL: %v\n", err)
-		} else if len(schedulers) == 0 {
-			fmt.Println("  (none)")
-		} else {
-			for _, s := range schedulers {
-				nextRun := "never"
-				if s.NextRun.Valid {
-					nextRun = s.NextRun.Time.Format(time.RFC3339)
-				}
-				lastRun := "never"
-				if s.LastRun.Valid {
-					lastRun = s.LastRun.Time.Format(time.RFC3339)
-				}
-				fmt.Printf("  %-38s cron=%-15s next=%s last=%s enabled=%v\n",
-					s.UUID, s.CronExpression.String, nextRun, lastRun, s.IsEnabled)
-			}
+		switch report.Status {
+		case health.StatusFail:
+			os.Exit(1)
+		case health.StatusWarn:
+			os.Exit(2)
 		}
-
-		// OAuth2 Tokens
-		fmt.Println("\n── OAuth2 Tokens ──")
-		tokens, err := q.GetOauth2Tokens(ctx, query.GetOauth2TokensParams{
-			OrderBy: "created_at", OrderDirection: "desc",
-			Limit: 50, ClientUuid: "",
-		})
-		if err != nil {
-			fmt.Printf("  FAIL: %v\n", err)
-		} else if len(tokens) == 0 {
-			fmt.Println("  (none)")
-		} else {
-			now := time.Now()
-			for _, t := range tokens {
-				status := "unknown"
-				if t.UpdatedAt.Valid {
-					age := now.Sub(t.UpdatedAt.Time)
-					status = fmt.Sprintf("updated %s ago", age.Truncate(time.Minute))
-				}
-				clientStr := ""
-				if t.ClientUuid != nil {
-					clientStr = t.ClientUuid.String()
-				}
-				fmt.Printf("  %-38s client=%-38s %s\n",
-					t.UUID, clientStr, status)
-			}
-		}
-
-		// Messages count
-		fmt.Println("\n── Messages ──")
-		var msgCount int64
-		err = dbp.QueryRow(ctx, "SELECT count(*) FROM message").Scan(&msgCount)
-		if err != nil {
-			fmt.Printf("  FAIL: %v\n", err)
-		} else {
-			fmt.Printf("  Total: %d\n", msgCount)
-		}
-
-		fmt.Println()
+		return nil
 	},
 }
 
-func printCheck(name string, err error) {
-	if err != nil {
-		fmt.Printf("  FAIL  %s: %v\n", name, err)
-	} else {
-		fmt.Printf("  OK    %s\n", name)
+func printReport(report health.Report) {
+	fmt.Println("ShadowAPI Health Check")
+	fmt.Println(strings.Repeat("═", 50))
+
+	group := ""
+	for _, r := range report.Checks {
+		if r.Group != group {
+			group = r.Group
+			fmt.Printf("\n── %s ──\n", group)
+		}
+		line := fmt.Sprintf("  %-5s %s", strings.ToUpper(string(r.Status)), r.Name)
+		if r.Detail != "" {
+			line += ": " + r.Detail
+		}
+		fmt.Printf("%s (%dms)\n", line, r.DurationMS)
 	}
+	fmt.Printf("\nOverall: %s\n", strings.ToUpper(string(report.Status)))
 }
 
 func init() {
+	checkCmd.Flags().BoolVar(&checkJSON, "json", false, "print the report as JSON")
+	checkCmd.Flags().StringSliceVar(&checkGroups, "group", nil, "only check these groups: "+strings.Join(health.Groups, ", "))
+	checkCmd.Flags().DurationVar(&checkTimeout, "timeout", health.DefaultTimeout, "timeout of each probe")
+
 	LoadDefault(checkCmd, nil)
 	rootCmd.AddCommand(checkCmd)
 }
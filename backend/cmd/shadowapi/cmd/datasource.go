@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/shadowapi/shadowapi/backend/internal/admin"
+)
+
+var datasourceCmd = &cobra.Command{
+	Use:     "datasource",
+	Aliases: []string{"datasources", "ds"},
+	Short:   "Datasource operations",
+}
+
+// ── list ─────────────────────────────────────────────────────────────
+
+var datasourceListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the datasources",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return adminList(cmd, func(b admin.Backend) ([]admin.Datasource, error) {
+			return b.Datasources(cmd.Context())
+		})
+	},
+}
+
+func init() {
+	datasourceCmd.AddCommand(datasourceListCmd)
+
+	adminFlags(datasourceCmd)
+	LoadDefault(datasourceCmd, nil)
+	rootCmd.AddCommand(datasourceCmd)
+}
@@ -0,0 +1,215 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"slices"
+	"syscall"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/shadowapi/shadowapi/backend/internal/admin"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/monitor"
+)
+
+var jobCmd = &cobra.Command{
+	Use:     "job",
+	Aliases: []string{"jobs"},
+	Short:   "Worker job operations",
+}
+
+var (
+	jobStatus    string
+	jobSubject   string
+	jobScheduler string
+	jobPipeline  string
+	jobLimit     int32
+	jobTailLimit int32
+	jobInterval  time.Duration
+)
+
+func jobFilter() (admin.JobFilter, error) {
+	schedulerUUID, err := optUUID("scheduler", jobScheduler)
+	if err != nil {
+		return admin.JobFilter{}, err
+	}
+	pipelineUUID, err := optUUID("pipeline", jobPipeline)
+	if err != nil {
+		return admin.JobFilter{}, err
+	}
+	return admin.JobFilter{
+		Status:        jobStatus,
+		Subject:       jobSubject,
+		SchedulerUUID: schedulerUUID,
+		PipelineUUID:  pipelineUUID,
+		Limit:         jobLimit,
+	}, nil
+}
+
+// ── list ─────────────────────────────────────────────────────────────
+
+var jobListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the worker jobs, the most recent first",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filter, err := jobFilter()
+		if err != nil {
+			return err
+		}
+		return adminList(cmd, func(b admin.Backend) ([]admin.Job, error) {
+			return b.Jobs(cmd.Context(), filter)
+		})
+	},
+}
+
+// ── get ──────────────────────────────────────────────────────────────
+
+var jobGetCmd = &cobra.Command{
+	Use:   "get <job-uuid>",
+	Short: "Show a worker job with its arguments and result",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := uuid.FromString(args[0])
+		if err != nil {
+			return err
+		}
+		return adminOne(cmd, func(b admin.Backend) (admin.Job, error) {
+			return b.Job(cmd.Context(), id)
+		})
+	},
+}
+
+// ── tail ─────────────────────────────────────────────────────────────
+
+var jobTailCmd = &cobra.Command{
+	Use:   "tail [job-uuid]",
+	Short: "Follow the worker jobs and print them as they change status",
+	Long: `Follow the worker jobs matching the filters and print every job when it
+shows up or changes status, until interrupted. Given a job UUID, follow only
+that job until it finishes.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f, err := adminFormat()
+		if err != nil {
+			return err
+		}
+		filter, err := jobFilter()
+		if err != nil {
+			return err
+		}
+		filter.Limit = jobTailLimit
+		b, err := adminBackend(cmd)
+		if err != nil {
+			return err
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		out := admin.NewStream(cmd.OutOrStdout(), f, admin.Job{}.Header())
+		defer out.Close()
+
+		poll := func(ctx context.Context) ([]admin.Job, error) {
+			return b.Jobs(ctx, filter)
+		}
+		if len(args) == 1 {
+			id, err := uuid.FromString(args[0])
+			if err != nil {
+				return err
+			}
+			poll = func(ctx context.Context) ([]admin.Job, error) {
+				job, err := b.Job(ctx, id)
+				if err != nil {
+					return nil, err
+				}
+				if jobFinished(job) {
+					stop()
+				}
+				return []admin.Job{job}, nil
+			}
+		}
+
+		seen := map[string]string{}
+		for {
+			jobs, err := poll(ctx)
+			if err != nil && ctx.Err() == nil {
+				return err
+			}
+			// the jobs come most recent first, print them in order
+			slices.Reverse(jobs)
+			for _, job := range jobs {
+				if seen[job.UUID] == job.Status {
+					continue
+				}
+				seen[job.UUID] = job.Status
+				if err := out.Write(job); err != nil {
+					return err
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(jobInterval):
+			}
+		}
+	},
+}
+
+func jobFinished(job admin.Job) bool {
+	return job.Status != monitor.StatusQueued && job.Status != monitor.StatusRunning
+}
+
+// ── cancel ───────────────────────────────────────────────────────────
+
+var jobCancelCmd = &cobra.Command{
+	Use:   "cancel <job-uuid>...",
+	Short: "Cancel queued or running jobs",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return adminDo(cmd, args, "cancelled", func(b admin.Backend, id uuid.UUID) error {
+			return b.CancelJob(cmd.Context(), id)
+		})
+	},
+}
+
+// ── retry ────────────────────────────────────────────────────────────
+
+var jobRetryCmd = &cobra.Command{
+	Use:   "retry <job-uuid>",
+	Short: "Queue a finished job again with the same arguments",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := uuid.FromString(args[0])
+		if err != nil {
+			return err
+		}
+		return adminOne(cmd, func(b admin.Backend) (admin.Job, error) {
+			return b.RetryJob(cmd.Context(), id)
+		})
+	},
+}
+
+func init() {
+	for _, c := range []*cobra.Command{jobListCmd, jobTailCmd} {
+		c.Flags().StringVar(&jobStatus, "status", "", "only the jobs with the status: queued, running, done, failed or cancelled")
+		c.Flags().StringVar(&jobSubject, "subject", "", "only the jobs of the queue subject")
+		c.Flags().StringVar(&jobScheduler, "scheduler", "", "only the jobs of the scheduler UUID")
+		c.Flags().StringVar(&jobPipeline, "pipeline", "", "only the runs of the pipeline UUID, scheduled or triggered by hand")
+	}
+	jobListCmd.Flags().Int32VarP(&jobLimit, "limit", "l", 50, "max jobs to list")
+	jobTailCmd.Flags().Int32VarP(&jobTailLimit, "limit", "l", 20, "max recent jobs to watch")
+	jobTailCmd.Flags().DurationVar(&jobInterval, "interval", 2*time.Second, "polling interval")
+	jobCmd.AddCommand(jobListCmd)
+	jobCmd.AddCommand(jobGetCmd)
+	jobCmd.AddCommand(jobTailCmd)
+	jobCmd.AddCommand(jobCancelCmd)
+	jobCmd.AddCommand(jobRetryCmd)
+
+	adminFlags(jobCmd)
+	LoadDefault(jobCmd, nil)
+	rootCmd.AddCommand(jobCmd)
+}
@@ -1,31 +1,109 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
+	"io"
+	"os"
+
 	"github.com/samber/do/v2"
-	"github.com/shadowapi/shadowapi/backend/internal/loader"
 	"github.com/spf13/cobra"
-	"log/slog"
+	"gopkg.in/yaml.v3"
+
+	"github.com/shadowapi/shadowapi/backend/internal/loader"
 )
 
 var loaderCmd = &cobra.Command{
 	Use:   "loader",
-	Short: "loader a yaml and populate datasource, storage, user, etc. for fast testing",
-	Run: func(cmd *cobra.Command, args []string) {
-		ctx := do.MustInvoke[context.Context](injector)
-		loader := do.MustInvoke[*loader.Loader](injector)
-		if err := loader.Run(ctx); err != nil {
-			slog.Error("failed to start loader", "error", err)
-			return
+	Short: "Apply or export the configuration as a declarative manifest",
+	Long: `Apply or export the configuration as a declarative manifest.
+
+The manifest lists users, OAuth2 clients, storages, datasources, pipelines,
+sync policies and schedulers, referencing each other by name. Applying it
+creates and updates the stored items to match in one transaction, so an
+environment can be kept in version control and reproduced.`,
+}
+
+// ── apply ────────────────────────────────────────────────────────────
+
+var (
+	loaderApplyFile   string
+	loaderApplyDryRun bool
+	loaderApplyPrune  bool
+)
+
+var loaderApplyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Make the stored configuration match a YAML or JSON manifest",
+	Long: `Make the stored configuration match a YAML or JSON manifest.
+
+${NAME} in the manifest is replaced by the environment variable NAME, keep
+secrets out of the file this way. Secrets left redacted, as in an export, keep
+their stored values. With --prune the items of the kinds listed in the
+manifest that it does not contain are deleted.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		manifest, err := loader.ReadManifest(loaderApplyFile)
+		if err != nil {
+			return err
 		}
-		// how to get here
-		fmt.Println("loaderCmd Run started")
+		l := do.MustInvoke[*loader.Loader](injector)
+		plan, err := l.Apply(cmd.Context(), manifest, loader.ApplyOptions{
+			DryRun: loaderApplyDryRun,
+			Prune:  loaderApplyPrune,
+		})
+		if err != nil {
+			return fmt.Errorf("apply manifest: %w", err)
+		}
+		fmt.Print(plan.String())
+		return nil
+	},
+}
+
+// ── export ───────────────────────────────────────────────────────────
+
+var (
+	loaderExportOutput    string
+	loaderExportWorkspace string
+)
 
+var loaderExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Print the stored configuration as a YAML manifest",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		l := do.MustInvoke[*loader.Loader](injector)
+		manifest, err := l.Export(cmd.Context(), loaderExportWorkspace)
+		if err != nil {
+			return fmt.Errorf("export configuration: %w", err)
+		}
+		var out io.Writer = os.Stdout
+		if loaderExportOutput != "" && loaderExportOutput != "-" {
+			f, err := os.Create(loaderExportOutput)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			out = f
+		}
+		enc := yaml.NewEncoder(out)
+		enc.SetIndent(2)
+		if err := enc.Encode(manifest); err != nil {
+			return fmt.Errorf("write manifest: %w", err)
+		}
+		return enc.Close()
 	},
 }
 
 func init() {
+	loaderApplyCmd.Flags().StringVarP(&loaderApplyFile, "file", "f", "", "manifest to apply, - reads stdin")
+	loaderApplyCmd.Flags().BoolVar(&loaderApplyDryRun, "dry-run", false, "print the changes without applying them")
+	loaderApplyCmd.Flags().BoolVar(&loaderApplyPrune, "prune", false, "delete the items missing from the manifest")
+	_ = loaderApplyCmd.MarkFlagRequired("file")
+	loaderExportCmd.Flags().StringVarP(&loaderExportOutput, "output", "o", "", "file to write, stdout when empty")
+	loaderExportCmd.Flags().StringVar(&loaderExportWorkspace, "workspace", "", "slug or UUID of the workspace, the default workspace when empty")
+	loaderCmd.AddCommand(loaderApplyCmd)
+	loaderCmd.AddCommand(loaderExportCmd)
+
 	LoadDefault(loaderCmd, nil)
 	rootCmd.AddCommand(loaderCmd)
 }
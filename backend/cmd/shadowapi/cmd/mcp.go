@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/samber/do/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/shadowapi/shadowapi/backend/internal/config"
+	"github.com/shadowapi/shadowapi/backend/internal/mcp"
+	"github.com/shadowapi/shadowapi/backend/internal/role"
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
+)
+
+var (
+	mcpToken     string
+	mcpWorkspace string
+)
+
+var mcpCmd = &cobra.Command{
+	Use:   "mcp",
+	Short: "Serve the Model Context Protocol over stdio for LLM agents",
+	Long: `Serve the messages, threads, contacts and datasources to an LLM agent over
+the Model Context Protocol, reading requests from stdin and writing replies to
+stdout. Register it with the agent as a local server, e.g.
+
+  shadowapi mcp --token sak_...
+
+The token is the bearer token of the server or an API key, every tool call is
+authorized with it like a REST API call: API keys are confined to their scopes
+and workspace, send_email needs datasource:write. The server also answers MCP
+over HTTP at /mcp.
+
+Tools: search_messages, get_thread, get_contact, list_datasources, send_email.
+Resources: shadowapi://thread/{thread_id}, shadowapi://attachment/{uuid}.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if mcpToken == "" {
+			return fmt.Errorf("mcp needs --token or SA_API_TOKEN")
+		}
+		cmd.SilenceUsage = true
+
+		// the credentials travel with every operation as they would over HTTP
+		raw, err := http.NewRequestWithContext(cmd.Context(), http.MethodPost, "/mcp", nil)
+		if err != nil {
+			return err
+		}
+		raw.Header.Set("Authorization", "Bearer "+mcpToken)
+		raw.Header.Set("User-Agent", "shadowapi-mcp")
+		if mcpWorkspace != "" {
+			raw.Header.Set(workspace.Header, mcpWorkspace)
+		}
+
+		srv := do.MustInvoke[*mcp.Server](injector)
+		return srv.ServeStdio(cmd.Context(), os.Stdin, cmd.OutOrStdout(), raw)
+	},
+}
+
+func init() {
+	LoadDefault(mcpCmd, func(cfg *config.Config) {
+		// answer the agent only, the jobs are left to the servers
+		cfg.Roles = []string{role.API}
+	})
+	f := mcpCmd.Flags()
+	f.StringVar(&mcpToken, "token", os.Getenv("SA_API_TOKEN"), "bearer token or API key to authorize the calls with (env SA_API_TOKEN)")
+	f.StringVarP(&mcpWorkspace, "workspace", "w", "", "workspace UUID, the first of the caller when empty")
+	rootCmd.AddCommand(mcpCmd)
+}
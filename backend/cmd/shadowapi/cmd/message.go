@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/shadowapi/shadowapi/backend/internal/admin"
+)
+
+var messageCmd = &cobra.Command{
+	Use:     "message",
+	Aliases: []string{"messages"},
+	Short:   "Message search and export",
+}
+
+var (
+	messageType     string
+	messagePipeline string
+	messageSince    string
+	messageUntil    string
+	messageLimit    int32
+	// messageExportLimit caps the whole export, not a batch
+	messageExportLimit int32
+	messageFile        string
+)
+
+func messageFilter(args []string) (admin.MessageFilter, error) {
+	pipelineUUID, err := optUUID("pipeline", messagePipeline)
+	if err != nil {
+		return admin.MessageFilter{}, err
+	}
+	since, err := parseSince("since", messageSince)
+	if err != nil {
+		return admin.MessageFilter{}, err
+	}
+	until, err := parseSince("until", messageUntil)
+	if err != nil {
+		return admin.MessageFilter{}, err
+	}
+	return admin.MessageFilter{
+		Text:         strings.Join(args, " "),
+		Type:         messageType,
+		PipelineUUID: pipelineUUID,
+		Since:        since,
+		Until:        until,
+		Limit:        messageLimit,
+	}, nil
+}
+
+// ── search ───────────────────────────────────────────────────────────
+
+var messageSearchCmd = &cobra.Command{
+	Use:   "search [text]",
+	Short: "Search the messages, the most recent first",
+	Long: `Search the messages by text matched against the subject, the body and the
+sender, narrowed by type, pipeline and creation time.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filter, err := messageFilter(args)
+		if err != nil {
+			return err
+		}
+		return adminList(cmd, func(b admin.Backend) ([]admin.Message, error) {
+			return b.Messages(cmd.Context(), filter)
+		})
+	},
+}
+
+// ── export ───────────────────────────────────────────────────────────
+
+// messageExportBatch is how many messages are read at a time while exporting
+const messageExportBatch = 500
+
+var messageExportCmd = &cobra.Command{
+	Use:   "export [text]",
+	Short: "Export the matching messages as JSON lines or YAML documents",
+	Long: `Export every message matching the filters of search, read in batches and
+written as they come: one JSON object per line, or one YAML document per
+message with --output yaml.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if adminOutput == string(admin.FormatTable) {
+			if cmd.Flags().Changed("output") {
+				return fmt.Errorf("messages are exported as json or yaml")
+			}
+			adminOutput = string(admin.FormatJSON)
+		}
+		f, err := adminFormat()
+		if err != nil {
+			return err
+		}
+		filter, err := messageFilter(args)
+		if err != nil {
+			return err
+		}
+		total := messageExportLimit
+		b, err := adminBackend(cmd)
+		if err != nil {
+			return err
+		}
+
+		var w io.Writer = cmd.OutOrStdout()
+		if messageFile != "" && messageFile != "-" {
+			file, err := os.Create(messageFile)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			w = file
+		}
+
+		out := admin.NewStream(w, f, nil)
+		var n int32
+		for total == 0 || n < total {
+			filter.Offset = n
+			filter.Limit = messageExportBatch
+			if total > 0 {
+				filter.Limit = min(filter.Limit, total-n)
+			}
+			msgs, err := b.Messages(cmd.Context(), filter)
+			if err != nil {
+				return err
+			}
+			for _, m := range msgs {
+				if err := out.Write(m); err != nil {
+					return err
+				}
+			}
+			n += int32(len(msgs))
+			if len(msgs) < int(filter.Limit) {
+				break
+			}
+		}
+		if err := out.Close(); err != nil {
+			return err
+		}
+		if w != cmd.OutOrStdout() {
+			fmt.Fprintf(cmd.ErrOrStderr(), "exported %d messages to %s\n", n, messageFile)
+		}
+		return nil
+	},
+}
+
+func init() {
+	for _, c := range []*cobra.Command{messageSearchCmd, messageExportCmd} {
+		c.Flags().StringVar(&messageType, "type", "", "only the messages of the type, e.g. email or telegram")
+		c.Flags().StringVar(&messagePipeline, "pipeline", "", "only the messages of the pipeline UUID")
+		c.Flags().StringVar(&messageSince, "since", "", "only the messages created since, RFC 3339, YYYY-MM-DD or a duration like 24h")
+		c.Flags().StringVar(&messageUntil, "until", "", "only the messages created before, same formats as --since")
+	}
+	messageSearchCmd.Flags().Int32VarP(&messageLimit, "limit", "l", 50, "max messages to list")
+	messageExportCmd.Flags().Int32VarP(&messageExportLimit, "limit", "l", 0, "max messages to export, 0 exports all")
+	messageExportCmd.Flags().StringVarP(&messageFile, "file", "f", "", "file to export to instead of stdout")
+	messageCmd.AddCommand(messageSearchCmd)
+	messageCmd.AddCommand(messageExportCmd)
+
+	adminFlags(messageCmd)
+	LoadDefault(messageCmd, nil)
+	rootCmd.AddCommand(messageCmd)
+}
@@ -17,6 +17,7 @@ import (
 	"google.golang.org/api/gmail/v1"
 	"google.golang.org/api/option"
 
+	"github.com/shadowapi/shadowapi/backend/internal/admin"
 	"github.com/shadowapi/shadowapi/backend/internal/converter"
 	oauth2tools "github.com/shadowapi/shadowapi/backend/internal/oauth2"
 	"github.com/shadowapi/shadowapi/backend/pkg/api"
@@ -33,28 +34,11 @@ var pipelineCmd = &cobra.Command{
 var pipelineListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all pipelines",
-	Run: func(cmd *cobra.Command, args []string) {
-		dbp := do.MustInvoke[*pgxpool.Pool](injector)
-		q := query.New(dbp)
-
-		rows, err := q.GetPipelines(cmd.Context(), query.GetPipelinesParams{
-			OrderBy: "created_at", OrderDirection: "desc",
-			Limit: 50, Type: "", IsEnabled: -1,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return adminList(cmd, func(b admin.Backend) ([]admin.Pipeline, error) {
+			return b.Pipelines(cmd.Context())
 		})
-		if err != nil {
-			slog.Error("failed to list pipelines", "error", err)
-			return
-		}
-		if len(rows) == 0 {
-			fmt.Println("No pipelines found.")
-			return
-		}
-		fmt.Printf("%-38s %-25s %-12s %-8s\n", "UUID", "Name", "Type", "Enabled")
-		fmt.Println(strings.Repeat("─", 90))
-		for _, r := range rows {
-			fmt.Printf("%-38s %-25s %-12s %-8v\n",
-				r.UUID.String(), r.Name, r.Type, r.IsEnabled)
-		}
 	},
 }
 
@@ -193,13 +177,15 @@ func runPipelineFetch(ctx context.Context, log *slog.Logger, dbp *pgxpool.Pool,
 
 		// Store in message table
 		_, err = q.CreateMessage(ctx, query.CreateMessageParams{
-			UUID:       pgtype.UUID{Bytes: msgUUID, Valid: true},
-			Sender:     from,
-			Recipients: []string{to},
-			Subject:    converter.PgText(subject),
-			Body:       fullMsg.Snippet,
-			Format:     "email",
-			Type:       "email",
+			UUID:           pgtype.UUID{Bytes: msgUUID, Valid: true},
+			Sender:         from,
+			Recipients:     []string{to},
+			Subject:        converter.PgText(subject),
+			Body:           fullMsg.Snippet,
+			Format:         "email",
+			Type:           "email",
+			PipelineUuid:   pgtype.UUID{Bytes: pipeUUID, Valid: true},
+			DatasourceUUID: pgtype.UUID{Bytes: dsRow.Datasource.UUID, Valid: true},
 		})
 		if err != nil {
 			// likely duplicate — skip
@@ -207,13 +193,15 @@ func runPipelineFetch(ctx context.Context, log *slog.Logger, dbp *pgxpool.Pool,
 		}
 
 		msg := api.Message{
-			UUID:       api.NewOptString(msgUUID.String()),
-			Type:       "email",
-			Format:     "email",
-			Sender:     from,
-			Recipients: []string{to},
-			Subject:    api.NewOptString(subject),
-			Body:       fullMsg.Snippet,
+			UUID:           api.NewOptString(msgUUID.String()),
+			Type:           "email",
+			Format:         "email",
+			PipelineUUID:   api.NewOptString(pipeUUID.String()),
+			DatasourceUUID: api.NewOptString(dsRow.Datasource.UUID.String()),
+			Sender:         from,
+			Recipients:     []string{to},
+			Subject:        api.NewOptString(subject),
+			Body:           fullMsg.Snippet,
 		}
 		messages = append(messages, msg)
 
@@ -225,6 +213,7 @@ func runPipelineFetch(ctx context.Context, log *slog.Logger, dbp *pgxpool.Pool,
 
 func init() {
 	pipelineRunCmd.Flags().Int64VarP(&pipelineRunLimit, "limit", "l", 10, "max messages to fetch")
+	adminFlags(pipelineListCmd)
 	pipelineCmd.AddCommand(pipelineListCmd)
 	pipelineCmd.AddCommand(pipelineRunCmd)
 
@@ -10,15 +10,26 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/shadowapi/shadowapi/backend/internal/audit"
 	"github.com/shadowapi/shadowapi/backend/internal/auth"
 	"github.com/shadowapi/shadowapi/backend/internal/config"
 	"github.com/shadowapi/shadowapi/backend/internal/db"
+	"github.com/shadowapi/shadowapi/backend/internal/embeddings"
 	"github.com/shadowapi/shadowapi/backend/internal/handler"
+	"github.com/shadowapi/shadowapi/backend/internal/health"
 	"github.com/shadowapi/shadowapi/backend/internal/loader"
 	"github.com/shadowapi/shadowapi/backend/internal/log"
+	"github.com/shadowapi/shadowapi/backend/internal/mcp"
+	"github.com/shadowapi/shadowapi/backend/internal/metrics"
+	"github.com/shadowapi/shadowapi/backend/internal/oauth2"
+	"github.com/shadowapi/shadowapi/backend/internal/policies"
 	"github.com/shadowapi/shadowapi/backend/internal/queue"
+	"github.com/shadowapi/shadowapi/backend/internal/role"
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
 	"github.com/shadowapi/shadowapi/backend/internal/server"
 	"github.com/shadowapi/shadowapi/backend/internal/session"
+	"github.com/shadowapi/shadowapi/backend/internal/telemetry"
+	"github.com/shadowapi/shadowapi/backend/internal/tg/workers"
 	"github.com/shadowapi/shadowapi/backend/internal/worker"
 )
 
@@ -60,24 +71,43 @@ func LoadDefault(cmd *cobra.Command, modify func(cfg *config.Config)) {
 		// all objects, kind of registry, of all initilizators
 		do.ProvideValue(injector, cmd.Context())
 		do.Provide(injector, config.Provide)
+		do.Provide(injector, role.Provide)
 		do.Provide(injector, log.Provide)
 		do.Provide(injector, db.Provide)
 		do.Provide(injector, loader.Provide)
+		do.Provide(injector, secrets.Provide)
+		do.Provide(injector, embeddings.Provide)
+		do.Provide(injector, oauth2.Provide)
 
 		// Skip server when subcommand is loader
 		do.Provide(injector, queue.Provide)
 		do.Provide(injector, auth.Provide)
+		do.Provide(injector, policies.Provide)
+		do.Provide(injector, session.ProvideStore)
 		do.Provide(injector, session.Provide)
+		do.Provide(injector, audit.Provide)
 		do.Provide(injector, handler.Provide)
+		do.Provide(injector, health.Provide)
+		do.Provide(injector, mcp.Provide)
+		do.Provide(injector, metrics.Provide)
+		do.Provide(injector, telemetry.Provide)
 		do.Provide(injector, server.Provide)
 
-		// 		do.Provide(injector, worker.ProvideLazy)
 		do.Provide(injector, worker.Provide)
+		do.Provide(injector, workers.Provide)
 
 		if modify != nil {
 			modify(do.MustInvoke[*config.Config](injector))
 		}
 
+		// the keyring is installed as the package default, load it before
+		// anything reads or writes stored credentials
+		do.MustInvoke[*secrets.Keyring](injector)
+		// the tracer provider is installed globally as well
+		do.MustInvoke[*telemetry.Tracing](injector)
+		// the OAuth2 clients resolve with the default resolver
+		do.MustInvoke[*oauth2.Resolver](injector)
+
 		////---------------------------------------
 		//// Provide dynamic connections
 		////---------------------------------------
@@ -105,12 +135,24 @@ func LoadDefault(cmd *cobra.Command, modify func(cfg *config.Config)) {
 
 	}
 	cmd.PersistentPostRun = func(cmd *cobra.Command, args []string) {
-		// Close the database connection pool
-		// only when the pool has actually been created, as some commands
-		// create fake database connections just to satisfy the dependency
-		dbPool := do.MustInvoke[*pgxpool.Pool](injector)
-		if dbPool != nil {
-			dbPool.Close()
+		// serve shuts the services down itself, what it shut down is no
+		// longer listed
+		for _, svc := range injector.ListInvokedServices() {
+			switch svc.Service {
+			case do.NameOf[*telemetry.Tracing]():
+				// export the spans of the command
+				if err := do.MustInvoke[*telemetry.Tracing](injector).Shutdown(cmd.Context()); err != nil {
+					slog.Error("failed to export spans", "error", err)
+				}
+			case do.NameOf[*pgxpool.Pool]():
+				// Close the database connection pool
+				// only when the pool has actually been created, as some commands
+				// create fake database connections just to satisfy the dependency
+				// and the remote admin commands never connect at all
+				if dbPool := do.MustInvoke[*pgxpool.Pool](injector); dbPool != nil {
+					dbPool.Close()
+				}
+			}
 		}
 	}
 }
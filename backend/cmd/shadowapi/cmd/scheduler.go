@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/shadowapi/shadowapi/backend/internal/admin"
+)
+
+var schedulerCmd = &cobra.Command{
+	Use:     "scheduler",
+	Aliases: []string{"schedulers"},
+	Short:   "Scheduler operations",
+}
+
+// ── list ─────────────────────────────────────────────────────────────
+
+var schedulerListPipeline string
+
+var schedulerListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the schedulers",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pipelineUUID, err := optUUID("pipeline", schedulerListPipeline)
+		if err != nil {
+			return err
+		}
+		return adminList(cmd, func(b admin.Backend) ([]admin.Scheduler, error) {
+			return b.Schedulers(cmd.Context(), pipelineUUID)
+		})
+	},
+}
+
+// ── pause / resume ───────────────────────────────────────────────────
+
+var schedulerPauseCmd = &cobra.Command{
+	Use:   "pause <scheduler-uuid>",
+	Short: "Pause a scheduler, it keeps its schedule but runs nothing",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return schedulerSetPaused(cmd, args[0], true)
+	},
+}
+
+var schedulerResumeCmd = &cobra.Command{
+	Use:   "resume <scheduler-uuid>",
+	Short: "Resume a paused scheduler",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return schedulerSetPaused(cmd, args[0], false)
+	},
+}
+
+func schedulerSetPaused(cmd *cobra.Command, arg string, paused bool) error {
+	id, err := uuid.FromString(arg)
+	if err != nil {
+		return err
+	}
+	return adminOne(cmd, func(b admin.Backend) (admin.Scheduler, error) {
+		return b.PauseScheduler(cmd.Context(), id, paused)
+	})
+}
+
+// ── delete ───────────────────────────────────────────────────────────
+
+var schedulerDeleteCmd = &cobra.Command{
+	Use:   "delete <scheduler-uuid>...",
+	Short: "Delete schedulers",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return adminDo(cmd, args, "deleted", func(b admin.Backend, id uuid.UUID) error {
+			return b.DeleteScheduler(cmd.Context(), id)
+		})
+	},
+}
+
+func init() {
+	schedulerListCmd.Flags().StringVar(&schedulerListPipeline, "pipeline", "", "only the schedulers of the pipeline UUID")
+	schedulerCmd.AddCommand(schedulerListCmd)
+	schedulerCmd.AddCommand(schedulerPauseCmd)
+	schedulerCmd.AddCommand(schedulerResumeCmd)
+	schedulerCmd.AddCommand(schedulerDeleteCmd)
+
+	adminFlags(schedulerCmd)
+	LoadDefault(schedulerCmd, nil)
+	rootCmd.AddCommand(schedulerCmd)
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/do/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
+)
+
+var secretsCmd = &cobra.Command{
+	Use:   "secrets",
+	Short: "Manage encryption of stored credentials",
+}
+
+// ── generate-key ─────────────────────────────────────────────────────
+
+var secretsGenerateKeyCmd = &cobra.Command{
+	Use:   "generate-key",
+	Short: "Print a new random master key for secrets.master_key",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		key, err := secrets.GenerateKey()
+		if err != nil {
+			slog.Error("failed to generate key", "error", err)
+			return
+		}
+		fmt.Println(key)
+	},
+}
+
+// ── rotate ───────────────────────────────────────────────────────────
+
+var secretsRotateDryRun bool
+
+var secretsRotateCmd = &cobra.Command{
+	Use:   "rotate",
+	Short: "Re-wrap all stored secrets with the current master key",
+	Long: `Re-wrap all stored secrets with the current master key.
+
+Set the new key as secrets.master_key (or the primary key of the key file),
+keep the old one in secrets.previous_keys, run this command, then remove the
+old key. Values still stored in plaintext are encrypted as well.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		dbp := do.MustInvoke[*pgxpool.Pool](injector)
+		keyring := do.MustInvoke[*secrets.Keyring](injector)
+
+		report, err := keyring.Rotate(cmd.Context(), dbp, secretsRotateDryRun)
+		if err != nil {
+			slog.Error("failed to rotate secrets", "error", err)
+			return
+		}
+		verb := "Re-wrapped"
+		if report.DryRun {
+			verb = "Would re-wrap"
+		}
+		fmt.Printf("%s with key %s: %d oauth2 clients, %d oauth2 tokens, %d storages, %d datasources, %d webhooks\n",
+			verb, report.PrimaryKey, report.OAuth2Clients, report.OAuth2Tokens, report.Storages, report.Datasources, report.Webhooks)
+	},
+}
+
+func init() {
+	secretsRotateCmd.Flags().BoolVar(&secretsRotateDryRun, "dry-run", false, "count the records to rotate without changing them")
+	secretsCmd.AddCommand(secretsGenerateKeyCmd)
+	secretsCmd.AddCommand(secretsRotateCmd)
+
+	LoadDefault(secretsCmd, nil)
+	rootCmd.AddCommand(secretsCmd)
+}
@@ -4,37 +4,114 @@ import (
 	"context"
 	"log/slog"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/samber/do/v2"
 	"github.com/spf13/cobra"
 
+	"github.com/shadowapi/shadowapi/backend/internal/config"
+	"github.com/shadowapi/shadowapi/backend/internal/metrics"
+	"github.com/shadowapi/shadowapi/backend/internal/role"
 	"github.com/shadowapi/shadowapi/backend/internal/server"
+	"github.com/shadowapi/shadowapi/backend/internal/tg/workers"
+	"github.com/shadowapi/shadowapi/backend/internal/worker"
 )
 
+var serveRoles []string
+
+// defaultShutdownTimeout is how long the requests and jobs get to finish on
+// shutdown when none is configured
+const defaultShutdownTimeout = 30 * time.Second
+
 // serveCmd represents the serve command
 var serveCmd = &cobra.Command{
 	Use:   "serve",
 	Short: "starts UI and RESTful API servers",
+	Long: `Start the server. The roles split it into deployable parts:
+
+  api        the REST API, the login and the frontend
+  worker     consumes the jobs and delivers the webhooks, scales horizontally
+  scheduler  publishes the due jobs, one replica leads at a time
+  telegram   keeps the stored Telegram sessions connected, one replica at a time
+  whatsapp   reserved, there is no long-lived WhatsApp client yet
+
+Every role runs when none is given. /healthz and /readyz are served whatever
+the roles, /readyz checks what the roles need.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		// DI must know all modules
 		// injector - DI god-like object, instance of all modules
 		// injector is ...
 		ctx := do.MustInvoke[context.Context](injector)
-		srv := do.MustInvoke[*server.Server](injector)
-		if err := srv.Run(ctx); err != nil {
-			slog.Error("failed to start server", "error", err)
-			return
+		cfg := do.MustInvoke[*config.Config](injector)
+		timeout := defaultShutdownTimeout
+		if cfg.Shutdown.Timeout != "" {
+			d, err := time.ParseDuration(cfg.Shutdown.Timeout)
+			if err != nil || d <= 0 {
+				slog.Error("invalid shutdown timeout", "timeout", cfg.Shutdown.Timeout)
+				return
+			}
+			timeout = d
 		}
-		// wait for shutdown signal
-		s, err := injector.RootScope().ShutdownOnSignals(os.Interrupt)
+
+		roles, err := do.Invoke[role.Roles](injector)
 		if err != nil {
-			slog.Error("failed to shutdown on signals", "error", err.Error(), "signal", s)
+			slog.Error("invalid roles", "error", err)
+			return
+		}
+		slog.Info("starting", "roles", roles.String())
+		if roles.Has(role.WhatsApp) && len(cfg.Roles) > 0 {
+			slog.Warn("the whatsapp role has nothing to run yet")
+		}
+
+		srv := do.MustInvoke[*server.Server](injector)
+		// the broker starts the consumer and the schedulers of the roles
+		do.MustInvoke[*worker.Broker](injector)
+		do.MustInvoke[*workers.Sessions](injector)
+		if err := do.MustInvoke[*metrics.Server](injector).Run(); err != nil {
+			slog.Error("failed to start metrics server", "error", err)
 			return
 		}
+
+		signals, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		errs := make(chan error, 1)
+		go func() { errs <- srv.Run(ctx) }()
+
+		select {
+		case err := <-errs:
+			if err != nil {
+				slog.Error("failed to start server", "error", err)
+				return
+			}
+		case <-signals.Done():
+			slog.Info("shutting down", "timeout", timeout)
+		}
+		stop()
+
+		// the services shut down dependents first: the server stops accepting
+		// requests, the broker stops the schedulers and drains the running
+		// jobs, then the queue connection closes
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+		defer cancel()
+		// the pool has no Shutdown of its own and is dropped with the rest
+		dbPool := do.MustInvoke[*pgxpool.Pool](injector)
+		if err := injector.RootScope().ShutdownWithContext(shutdownCtx); err != nil {
+			slog.Error("failed to shut down cleanly", "error", err)
+		}
+		dbPool.Close()
 	},
 }
 
 func init() {
-	LoadDefault(serveCmd, nil)
+	LoadDefault(serveCmd, func(cfg *config.Config) {
+		if len(serveRoles) > 0 {
+			cfg.Roles = serveRoles
+		}
+	})
+	serveCmd.Flags().StringSliceVar(&serveRoles, "role", nil, "roles to run, comma separated: "+strings.Join(role.All, ","))
 	rootCmd.AddCommand(serveCmd)
 }
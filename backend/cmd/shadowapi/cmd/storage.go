@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/do/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/shadowapi/shadowapi/backend/internal/admin"
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/storages"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+var storageCmd = &cobra.Command{
+	Use:   "storage",
+	Short: "Storage operations",
+}
+
+// ── list ─────────────────────────────────────────────────────────────
+
+var storageListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the storages",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return adminList(cmd, func(b admin.Backend) ([]admin.Storage, error) {
+			return b.Storages(cmd.Context())
+		})
+	},
+}
+
+// ── migrate ──────────────────────────────────────────────────────────
+
+var (
+	storageMigrateOlderThan    int32
+	storageMigrateDeleteSource bool
+	storageMigrateBatchSize    int32
+	storageMigrateResume       string
+)
+
+var storageMigrateCmd = &cobra.Command{
+	Use:   "migrate [<source-uuid> <target-uuid>]",
+	Short: "Move message bodies and files between storages (direct, no NATS), Ctrl+C pauses the migration",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if storageMigrateResume != "" {
+			return cobra.NoArgs(cmd, args)
+		}
+		return cobra.ExactArgs(2)(cmd, args)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		dbp := do.MustInvoke[*pgxpool.Pool](injector)
+		log := do.MustInvoke[*slog.Logger](injector)
+		migrator := storages.NewMigrator(log, dbp)
+
+		var mig query.StorageMigration
+		if storageMigrateResume != "" {
+			migUUID, err := uuid.FromString(storageMigrateResume)
+			if err != nil {
+				slog.Error("invalid migration UUID", "error", err)
+				return
+			}
+			row, err := query.New(dbp).GetStorageMigration(ctx, converter.UuidToPgUUID(migUUID))
+			if err != nil {
+				slog.Error("storage migration not found", "error", err)
+				return
+			}
+			mig = row.StorageMigration
+		} else {
+			sourceUUID, err := uuid.FromString(args[0])
+			if err != nil {
+				slog.Error("invalid source storage UUID", "error", err)
+				return
+			}
+			targetUUID, err := uuid.FromString(args[1])
+			if err != nil {
+				slog.Error("invalid target storage UUID", "error", err)
+				return
+			}
+			mig, err = migrator.Prepare(ctx, sourceUUID, targetUUID, storages.MigrationOptions{
+				OlderThanDays: storageMigrateOlderThan,
+				DeleteSource:  storageMigrateDeleteSource,
+				BatchSize:     storageMigrateBatchSize,
+			})
+			if err != nil {
+				slog.Error("failed to prepare storage migration", "error", err)
+				return
+			}
+		}
+
+		fmt.Printf("Migration: %s (status=%s, already migrated %d messages and %d files)\n", mig.UUID, mig.Status, mig.MessagesMigrated, mig.FilesMigrated)
+		if err := migrator.Run(ctx, mig.UUID); err != nil {
+			if errors.Is(err, storages.ErrMigrationRunning) {
+				fmt.Printf("Migration %s is running elsewhere, a crashed run is resumed once its lease of %s expired.\n", mig.UUID, storages.MigrationLease)
+				return
+			}
+			if errors.Is(err, ctx.Err()) {
+				fmt.Printf("Migration paused, resume with: storage migrate --resume %s\n", mig.UUID)
+				return
+			}
+			slog.Error("storage migration failed", "error", err)
+			return
+		}
+
+		row, err := query.New(dbp).GetStorageMigration(cmd.Context(), converter.UuidToPgUUID(mig.UUID))
+		if err != nil {
+			slog.Error("failed to load storage migration", "error", err)
+			return
+		}
+		fmt.Printf("Migrated %d messages and %d files (%d bytes).\n",
+			row.StorageMigration.MessagesMigrated, row.StorageMigration.FilesMigrated, row.StorageMigration.BytesMigrated)
+	},
+}
+
+func init() {
+	storageMigrateCmd.Flags().Int32Var(&storageMigrateOlderThan, "older-than", 0, "only move messages and files older than N days (0 moves all of them)")
+	storageMigrateCmd.Flags().BoolVar(&storageMigrateDeleteSource, "delete-source", false, "delete the source copy after verification")
+	storageMigrateCmd.Flags().Int32Var(&storageMigrateBatchSize, "batch-size", 100, "files per batch")
+	storageMigrateCmd.Flags().StringVar(&storageMigrateResume, "resume", "", "resume the migration with the given UUID")
+	adminFlags(storageListCmd)
+	storageCmd.AddCommand(storageListCmd)
+	storageCmd.AddCommand(storageMigrateCmd)
+
+	LoadDefault(storageCmd, nil)
+	rootCmd.AddCommand(storageCmd)
+}
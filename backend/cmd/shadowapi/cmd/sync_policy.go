@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/shadowapi/shadowapi/backend/internal/admin"
+)
+
+var syncPolicyCmd = &cobra.Command{
+	Use:     "sync-policy",
+	Aliases: []string{"sync-policies", "syncpolicy"},
+	Short:   "Sync policy operations",
+}
+
+// ── list ─────────────────────────────────────────────────────────────
+
+var syncPolicyListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the sync policies",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return adminList(cmd, func(b admin.Backend) ([]admin.SyncPolicy, error) {
+			return b.SyncPolicies(cmd.Context())
+		})
+	},
+}
+
+func init() {
+	syncPolicyCmd.AddCommand(syncPolicyListCmd)
+
+	adminFlags(syncPolicyCmd)
+	LoadDefault(syncPolicyCmd, nil)
+	rootCmd.AddCommand(syncPolicyCmd)
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/shadowapi/shadowapi/backend/internal/admin"
+)
+
+var tokenCmd = &cobra.Command{
+	Use:     "token",
+	Aliases: []string{"tokens"},
+	Short:   "OAuth2 token operations",
+}
+
+// ── list ─────────────────────────────────────────────────────────────
+
+var tokenListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the OAuth2 tokens with their health",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return adminList(cmd, func(b admin.Backend) ([]admin.Token, error) {
+			return b.Tokens(cmd.Context())
+		})
+	},
+}
+
+// ── refresh ──────────────────────────────────────────────────────────
+
+var tokenRefreshCmd = &cobra.Command{
+	Use:   "refresh <token-uuid>...",
+	Short: "Refresh tokens now, regardless of their expiry",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return adminDo(cmd, args, "refreshed", func(b admin.Backend, id uuid.UUID) error {
+			return b.RefreshToken(cmd.Context(), id)
+		})
+	},
+}
+
+// ── revoke ───────────────────────────────────────────────────────────
+
+var tokenRevokeCmd = &cobra.Command{
+	Use:   "revoke <token-uuid>...",
+	Short: "Revoke tokens at the provider and delete them",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return adminDo(cmd, args, "revoked", func(b admin.Backend, id uuid.UUID) error {
+			return b.RevokeToken(cmd.Context(), id)
+		})
+	},
+}
+
+func init() {
+	tokenCmd.AddCommand(tokenListCmd)
+	tokenCmd.AddCommand(tokenRefreshCmd)
+	tokenCmd.AddCommand(tokenRevokeCmd)
+
+	adminFlags(tokenCmd)
+	LoadDefault(tokenCmd, nil)
+	rootCmd.AddCommand(tokenCmd)
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/shadowapi/shadowapi/backend/internal/admin"
+)
+
+var userCmd = &cobra.Command{
+	Use:     "user",
+	Aliases: []string{"users"},
+	Short:   "User operations",
+}
+
+// ── list ─────────────────────────────────────────────────────────────
+
+var userListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the users",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return adminList(cmd, func(b admin.Backend) ([]admin.User, error) {
+			return b.Users(cmd.Context())
+		})
+	},
+}
+
+// ── enable / disable ─────────────────────────────────────────────────
+
+var userEnableCmd = &cobra.Command{
+	Use:   "enable <user-uuid>",
+	Short: "Enable a user",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return userSetEnabled(cmd, args[0], true)
+	},
+}
+
+var userDisableCmd = &cobra.Command{
+	Use:   "disable <user-uuid>",
+	Short: "Disable a user, they can no longer log in",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return userSetEnabled(cmd, args[0], false)
+	},
+}
+
+func userSetEnabled(cmd *cobra.Command, arg string, enabled bool) error {
+	id, err := uuid.FromString(arg)
+	if err != nil {
+		return err
+	}
+	return adminOne(cmd, func(b admin.Backend) (admin.User, error) {
+		return b.EnableUser(cmd.Context(), id, enabled)
+	})
+}
+
+// ── delete ───────────────────────────────────────────────────────────
+
+var userDeleteCmd = &cobra.Command{
+	Use:   "delete <user-uuid>...",
+	Short: "Delete users",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return adminDo(cmd, args, "deleted", func(b admin.Backend, id uuid.UUID) error {
+			return b.DeleteUser(cmd.Context(), id)
+		})
+	},
+}
+
+func init() {
+	userCmd.AddCommand(userListCmd)
+	userCmd.AddCommand(userEnableCmd)
+	userCmd.AddCommand(userDisableCmd)
+	userCmd.AddCommand(userDeleteCmd)
+
+	adminFlags(userCmd)
+	LoadDefault(userCmd, nil)
+	rootCmd.AddCommand(userCmd)
+}
@@ -0,0 +1,301 @@
+// Package admin backs the operational CLI commands. A Backend lists and acts
+// on datasources, storages, sync policies, schedulers, worker jobs, OAuth2
+// tokens, users and messages, either directly against the database or
+// remotely through the REST API, and the items render as a table, JSON or
+// YAML.
+package admin
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// ErrUnsupported is returned by backends that cannot perform an operation.
+var ErrUnsupported = errors.New("not supported by this backend")
+
+// Backend is where the admin commands read and change the state.
+type Backend interface {
+	Datasources(ctx context.Context) ([]Datasource, error)
+	Storages(ctx context.Context) ([]Storage, error)
+	SyncPolicies(ctx context.Context) ([]SyncPolicy, error)
+	Pipelines(ctx context.Context) ([]Pipeline, error)
+
+	// Schedulers lists the schedulers, of the pipeline when not nil
+	Schedulers(ctx context.Context, pipelineUUID *uuid.UUID) ([]Scheduler, error)
+	PauseScheduler(ctx context.Context, id uuid.UUID, paused bool) (Scheduler, error)
+	DeleteScheduler(ctx context.Context, id uuid.UUID) error
+
+	Jobs(ctx context.Context, filter JobFilter) ([]Job, error)
+	Job(ctx context.Context, id uuid.UUID) (Job, error)
+	CancelJob(ctx context.Context, id uuid.UUID) error
+	// RetryJob queues the finished job again and returns the new job
+	RetryJob(ctx context.Context, id uuid.UUID) (Job, error)
+
+	Tokens(ctx context.Context) ([]Token, error)
+	RefreshToken(ctx context.Context, id uuid.UUID) error
+	// RevokeToken revokes the token at the provider and deletes it
+	RevokeToken(ctx context.Context, id uuid.UUID) error
+
+	Users(ctx context.Context) ([]User, error)
+	EnableUser(ctx context.Context, id uuid.UUID, enabled bool) (User, error)
+	DeleteUser(ctx context.Context, id uuid.UUID) error
+
+	Messages(ctx context.Context, filter MessageFilter) ([]Message, error)
+}
+
+// JobFilter selects worker jobs, zero fields match every job.
+type JobFilter struct {
+	Status        string
+	Subject       string
+	SchedulerUUID *uuid.UUID
+	PipelineUUID  *uuid.UUID
+	Offset        int32
+	Limit         int32
+}
+
+// MessageFilter selects messages, zero fields match every message.
+type MessageFilter struct {
+	// Text is matched against the subject, the body and the sender
+	Text         string
+	Type         string
+	PipelineUUID *uuid.UUID
+	Since        time.Time
+	Until        time.Time
+	Offset       int32
+	Limit        int32
+}
+
+type Datasource struct {
+	UUID         string     `json:"uuid" yaml:"uuid"`
+	Name         string     `json:"name" yaml:"name"`
+	Type         string     `json:"type" yaml:"type"`
+	Provider     string     `json:"provider" yaml:"provider"`
+	UserUUID     string     `json:"user_uuid,omitempty" yaml:"user_uuid,omitempty"`
+	Enabled      bool       `json:"enabled" yaml:"enabled"`
+	NeedsReauth  bool       `json:"needs_reauth" yaml:"needs_reauth"`
+	ReauthReason string     `json:"reauth_reason,omitempty" yaml:"reauth_reason,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+}
+
+func (Datasource) Header() []string {
+	return []string{"UUID", "NAME", "TYPE", "PROVIDER", "ENABLED", "REAUTH"}
+}
+
+func (d Datasource) Row() []string {
+	reauth := ""
+	if d.NeedsReauth {
+		reauth = orDash(d.ReauthReason)
+	}
+	return []string{d.UUID, d.Name, d.Type, d.Provider, yesNo(d.Enabled), reauth}
+}
+
+type Storage struct {
+	UUID      string     `json:"uuid" yaml:"uuid"`
+	Name      string     `json:"name" yaml:"name"`
+	Type      string     `json:"type" yaml:"type"`
+	Enabled   bool       `json:"enabled" yaml:"enabled"`
+	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+}
+
+func (Storage) Header() []string {
+	return []string{"UUID", "NAME", "TYPE", "ENABLED", "CREATED"}
+}
+
+func (s Storage) Row() []string {
+	return []string{s.UUID, s.Name, s.Type, yesNo(s.Enabled), formatTime(s.CreatedAt)}
+}
+
+type SyncPolicy struct {
+	UUID         string   `json:"uuid" yaml:"uuid"`
+	Name         string   `json:"name" yaml:"name"`
+	Type         string   `json:"type" yaml:"type"`
+	PipelineUUID string   `json:"pipeline_uuid,omitempty" yaml:"pipeline_uuid,omitempty"`
+	Enabled      bool     `json:"enabled" yaml:"enabled"`
+	SyncAll      bool     `json:"sync_all" yaml:"sync_all"`
+	Blocklist    []string `json:"blocklist,omitempty" yaml:"blocklist,omitempty"`
+	ExcludeList  []string `json:"exclude_list,omitempty" yaml:"exclude_list,omitempty"`
+}
+
+func (SyncPolicy) Header() []string {
+	return []string{"UUID", "NAME", "TYPE", "PIPELINE", "ENABLED", "SYNC ALL"}
+}
+
+func (p SyncPolicy) Row() []string {
+	return []string{p.UUID, p.Name, p.Type, orDash(p.PipelineUUID), yesNo(p.Enabled), yesNo(p.SyncAll)}
+}
+
+type Pipeline struct {
+	UUID           string `json:"uuid" yaml:"uuid"`
+	Name           string `json:"name" yaml:"name"`
+	Type           string `json:"type" yaml:"type"`
+	DatasourceUUID string `json:"datasource_uuid,omitempty" yaml:"datasource_uuid,omitempty"`
+	StorageUUID    string `json:"storage_uuid,omitempty" yaml:"storage_uuid,omitempty"`
+	Enabled        bool   `json:"enabled" yaml:"enabled"`
+}
+
+func (Pipeline) Header() []string {
+	return []string{"UUID", "NAME", "TYPE", "DATASOURCE", "STORAGE", "ENABLED"}
+}
+
+func (p Pipeline) Row() []string {
+	return []string{p.UUID, p.Name, p.Type, orDash(p.DatasourceUUID), orDash(p.StorageUUID), yesNo(p.Enabled)}
+}
+
+type Scheduler struct {
+	UUID         string `json:"uuid" yaml:"uuid"`
+	PipelineUUID string `json:"pipeline_uuid,omitempty" yaml:"pipeline_uuid,omitempty"`
+	Type         string `json:"schedule_type" yaml:"schedule_type"`
+	Cron         string `json:"cron_expression,omitempty" yaml:"cron_expression,omitempty"`
+	// Interval in seconds
+	Interval     int32      `json:"interval_seconds,omitempty" yaml:"interval_seconds,omitempty"`
+	RunAt        *time.Time `json:"run_at,omitempty" yaml:"run_at,omitempty"`
+	Timezone     string     `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+	Enabled      bool       `json:"enabled" yaml:"enabled"`
+	Paused       bool       `json:"paused" yaml:"paused"`
+	PausedReason string     `json:"paused_reason,omitempty" yaml:"paused_reason,omitempty"`
+	NextRun      *time.Time `json:"next_run,omitempty" yaml:"next_run,omitempty"`
+	LastRun      *time.Time `json:"last_run,omitempty" yaml:"last_run,omitempty"`
+}
+
+func (Scheduler) Header() []string {
+	return []string{"UUID", "PIPELINE", "SCHEDULE", "ENABLED", "PAUSED", "NEXT RUN", "LAST RUN"}
+}
+
+func (s Scheduler) Row() []string {
+	paused := yesNo(s.Paused)
+	if s.Paused && s.PausedReason != "" {
+		paused += " (" + s.PausedReason + ")"
+	}
+	return []string{s.UUID, orDash(s.PipelineUUID), s.Schedule(), yesNo(s.Enabled), paused, formatTime(s.NextRun), formatTime(s.LastRun)}
+}
+
+// Schedule describes when the scheduler runs, e.g. "cron 0 * * * * UTC".
+func (s Scheduler) Schedule() string {
+	switch s.Type {
+	case "cron":
+		return strings.TrimSpace("cron " + s.Cron + " " + s.Timezone)
+	case "interval":
+		return "every " + (time.Duration(s.Interval) * time.Second).String()
+	case "one_time":
+		return "once at " + formatTime(s.RunAt)
+	}
+	return s.Type
+}
+
+type Job struct {
+	UUID          string         `json:"uuid" yaml:"uuid"`
+	SchedulerUUID string         `json:"scheduler_uuid,omitempty" yaml:"scheduler_uuid,omitempty"`
+	PipelineUUID  string         `json:"pipeline_uuid,omitempty" yaml:"pipeline_uuid,omitempty"`
+	Subject       string         `json:"subject" yaml:"subject"`
+	Status        string         `json:"status" yaml:"status"`
+	StartedAt     *time.Time     `json:"started_at,omitempty" yaml:"started_at,omitempty"`
+	FinishedAt    *time.Time     `json:"finished_at,omitempty" yaml:"finished_at,omitempty"`
+	Data          map[string]any `json:"data,omitempty" yaml:"data,omitempty"`
+}
+
+func (Job) Header() []string {
+	return []string{"UUID", "SUBJECT", "STATUS", "STARTED", "FINISHED", "ERROR"}
+}
+
+func (j Job) Row() []string {
+	return []string{j.UUID, j.Subject, j.Status, formatTime(j.StartedAt), formatTime(j.FinishedAt), j.Error()}
+}
+
+// Error is the error the job failed with, if any.
+func (j Job) Error() string {
+	msg, _ := j.Data["error"].(string)
+	return msg
+}
+
+type Token struct {
+	UUID            string     `json:"uuid" yaml:"uuid"`
+	ClientUUID      string     `json:"client_uuid,omitempty" yaml:"client_uuid,omitempty"`
+	UserUUID        string     `json:"user_uuid,omitempty" yaml:"user_uuid,omitempty"`
+	Status          string     `json:"status" yaml:"status"`
+	ExpiresAt       *time.Time `json:"expires_at,omitempty" yaml:"expires_at,omitempty"`
+	LastRefreshedAt *time.Time `json:"last_refreshed_at,omitempty" yaml:"last_refreshed_at,omitempty"`
+	FailureCount    int32      `json:"failure_count" yaml:"failure_count"`
+	LastError       string     `json:"last_error,omitempty" yaml:"last_error,omitempty"`
+	MissingScopes   []string   `json:"missing_scopes,omitempty" yaml:"missing_scopes,omitempty"`
+}
+
+func (Token) Header() []string {
+	return []string{"UUID", "CLIENT", "STATUS", "EXPIRES", "REFRESHED", "FAILURES", "LAST ERROR"}
+}
+
+func (t Token) Row() []string {
+	return []string{t.UUID, orDash(t.ClientUUID), t.Status, formatTime(t.ExpiresAt), formatTime(t.LastRefreshedAt),
+		strconv.Itoa(int(t.FailureCount)), t.LastError}
+}
+
+type User struct {
+	UUID      string     `json:"uuid" yaml:"uuid"`
+	Email     string     `json:"email" yaml:"email"`
+	FirstName string     `json:"first_name" yaml:"first_name"`
+	LastName  string     `json:"last_name" yaml:"last_name"`
+	Enabled   bool       `json:"enabled" yaml:"enabled"`
+	Admin     bool       `json:"admin" yaml:"admin"`
+	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+}
+
+func (User) Header() []string {
+	return []string{"UUID", "EMAIL", "NAME", "ENABLED", "ADMIN", "CREATED"}
+}
+
+func (u User) Row() []string {
+	return []string{u.UUID, u.Email, strings.TrimSpace(u.FirstName + " " + u.LastName), yesNo(u.Enabled), yesNo(u.Admin), formatTime(u.CreatedAt)}
+}
+
+type Message struct {
+	UUID           string     `json:"uuid" yaml:"uuid"`
+	Type           string     `json:"type,omitempty" yaml:"type,omitempty"`
+	Format         string     `json:"format,omitempty" yaml:"format,omitempty"`
+	Sender         string     `json:"sender" yaml:"sender"`
+	Recipients     []string   `json:"recipients,omitempty" yaml:"recipients,omitempty"`
+	Subject        string     `json:"subject,omitempty" yaml:"subject,omitempty"`
+	Body           string     `json:"body" yaml:"body"`
+	PipelineUUID   string     `json:"pipeline_uuid,omitempty" yaml:"pipeline_uuid,omitempty"`
+	DatasourceUUID string     `json:"datasource_uuid,omitempty" yaml:"datasource_uuid,omitempty"`
+	CreatedAt      *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+}
+
+func (Message) Header() []string {
+	return []string{"UUID", "CREATED", "SENDER", "SUBJECT"}
+}
+
+func (m Message) Row() []string {
+	return []string{m.UUID, formatTime(m.CreatedAt), m.Sender, truncate(m.Subject, 60)}
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return "-"
+	}
+	return t.Local().Format("2006-01-02 15:04:05")
+}
+
+func truncate(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n-1]) + "…"
+	}
+	return s
+}
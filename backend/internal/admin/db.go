@@ -0,0 +1,450 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	oauthTools "github.com/shadowapi/shadowapi/backend/internal/oauth2"
+	"github.com/shadowapi/shadowapi/backend/internal/queue"
+	"github.com/shadowapi/shadowapi/backend/internal/storages"
+	"github.com/shadowapi/shadowapi/backend/internal/worker"
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// DB is the Backend working directly against the database, in one workspace.
+type DB struct {
+	log *slog.Logger
+	dbp *pgxpool.Pool
+	ws  uuid.UUID
+	// queue connects to the queue on first use, only cancelling and
+	// retrying jobs need it
+	queue func() (*queue.Queue, error)
+}
+
+// NewDB returns a DB backend scoped to the workspace with the slug or UUID,
+// the default workspace when empty.
+func NewDB(ctx context.Context, log *slog.Logger, dbp *pgxpool.Pool, q func() (*queue.Queue, error), ws string) (*DB, error) {
+	id, err := workspace.Resolve(ctx, query.New(dbp), ws)
+	if err != nil {
+		return nil, err
+	}
+	return &DB{log: log, dbp: dbp, ws: id, queue: q}, nil
+}
+
+func (d *DB) scope(ctx context.Context) (context.Context, *query.Queries) {
+	return workspace.WithUUID(ctx, d.ws), query.New(d.dbp)
+}
+
+func (d *DB) Datasources(ctx context.Context) ([]Datasource, error) {
+	ctx, q := d.scope(ctx)
+	rows, err := q.ListDatasources(ctx, query.ListDatasourcesParams{})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Datasource, 0, len(rows))
+	for _, r := range rows {
+		ds := r.Datasource
+		out = append(out, Datasource{
+			UUID:         ds.UUID.String(),
+			Name:         ds.Name,
+			Type:         ds.Type,
+			Provider:     ds.Provider,
+			UserUUID:     uuidString(ds.UserUUID),
+			Enabled:      ds.IsEnabled,
+			NeedsReauth:  ds.NeedsReauth,
+			ReauthReason: ds.ReauthReason,
+			CreatedAt:    pgTime(ds.CreatedAt),
+		})
+	}
+	return out, nil
+}
+
+func (d *DB) Storages(ctx context.Context) ([]Storage, error) {
+	ctx, q := d.scope(ctx)
+	rows, err := q.ListStorages(ctx, query.ListStoragesParams{})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Storage, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, Storage{
+			UUID:      r.Storage.UUID.String(),
+			Name:      r.Storage.Name,
+			Type:      r.Storage.Type,
+			Enabled:   r.Storage.IsEnabled,
+			CreatedAt: pgTime(r.Storage.CreatedAt),
+		})
+	}
+	return out, nil
+}
+
+func (d *DB) SyncPolicies(ctx context.Context) ([]SyncPolicy, error) {
+	ctx, q := d.scope(ctx)
+	rows, err := q.ListSyncPolicies(ctx, query.ListSyncPoliciesParams{})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]SyncPolicy, 0, len(rows))
+	for _, r := range rows {
+		p := r.SyncPolicy
+		out = append(out, SyncPolicy{
+			UUID:         p.UUID.String(),
+			Name:         p.Name,
+			Type:         p.Type,
+			PipelineUUID: uuidString(p.PipelineUuid),
+			Enabled:      p.IsEnabled,
+			SyncAll:      p.SyncAll,
+			Blocklist:    p.Blocklist,
+			ExcludeList:  p.ExcludeList,
+		})
+	}
+	return out, nil
+}
+
+func (d *DB) Pipelines(ctx context.Context) ([]Pipeline, error) {
+	ctx, q := d.scope(ctx)
+	rows, err := q.ListPipelines(ctx, query.ListPipelinesParams{})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Pipeline, 0, len(rows))
+	for _, r := range rows {
+		p := r.Pipeline
+		out = append(out, Pipeline{
+			UUID:           p.UUID.String(),
+			Name:           p.Name,
+			Type:           p.Type,
+			DatasourceUUID: uuidString(p.DatasourceUUID),
+			StorageUUID:    uuidString(p.StorageUuid),
+			Enabled:        p.IsEnabled,
+		})
+	}
+	return out, nil
+}
+
+func (d *DB) Schedulers(ctx context.Context, pipelineUUID *uuid.UUID) ([]Scheduler, error) {
+	ctx, q := d.scope(ctx)
+	rows, err := q.ListSchedulers(ctx, query.ListSchedulersParams{})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Scheduler, 0, len(rows))
+	for _, r := range rows {
+		if pipelineUUID != nil && (r.Scheduler.PipelineUuid == nil || *r.Scheduler.PipelineUuid != *pipelineUUID) {
+			continue
+		}
+		out = append(out, dbScheduler(r.Scheduler))
+	}
+	return out, nil
+}
+
+func (d *DB) PauseScheduler(ctx context.Context, id uuid.UUID, paused bool) (Scheduler, error) {
+	ctx, q := d.scope(ctx)
+	row, err := q.GetScheduler(ctx, converter.UuidToPgUUID(id))
+	if err != nil {
+		return Scheduler{}, err
+	}
+	s := row.Scheduler
+	err = q.UpdateScheduler(ctx, query.UpdateSchedulerParams{
+		ScheduleType:    s.ScheduleType,
+		CronExpression:  s.CronExpression,
+		RunAt:           s.RunAt,
+		Timezone:        s.Timezone,
+		NextRun:         s.NextRun,
+		LastRun:         s.LastRun,
+		IsEnabled:       s.IsEnabled,
+		IsPaused:        paused,
+		IntervalSeconds: s.IntervalSeconds,
+		JitterSeconds:   s.JitterSeconds,
+		OverlapPolicy:   s.OverlapPolicy,
+		UUID:            converter.UuidToPgUUID(id),
+	})
+	if err != nil {
+		return Scheduler{}, err
+	}
+	row, err = q.GetScheduler(ctx, converter.UuidToPgUUID(id))
+	if err != nil {
+		return Scheduler{}, err
+	}
+	return dbScheduler(row.Scheduler), nil
+}
+
+func (d *DB) DeleteScheduler(ctx context.Context, id uuid.UUID) error {
+	ctx, q := d.scope(ctx)
+	if _, err := q.GetScheduler(ctx, converter.UuidToPgUUID(id)); err != nil {
+		return err
+	}
+	return q.DeleteScheduler(ctx, converter.UuidToPgUUID(id))
+}
+
+func (d *DB) Jobs(ctx context.Context, filter JobFilter) ([]Job, error) {
+	ctx, q := d.scope(ctx)
+	var schedulerUUID pgtype.UUID
+	if filter.SchedulerUUID != nil {
+		schedulerUUID = converter.UuidToPgUUID(*filter.SchedulerUUID)
+	}
+	rows, err := q.GetWorkerJobs(ctx, query.GetWorkerJobsParams{
+		PipelineUuid:   converter.UuidPtrToPgUUID(filter.PipelineUUID),
+		OrderBy:        "started_at",
+		OrderDirection: "desc",
+		Offset:         filter.Offset,
+		Limit:          filter.Limit,
+		SchedulerUuid:  schedulerUUID,
+		Subject:        filter.Subject,
+		Status:         filter.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Job, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, dbJob(query.WorkerJob{
+			UUID:          r.UUID,
+			SchedulerUuid: r.SchedulerUuid,
+			PipelineUuid:  r.PipelineUuid,
+			Subject:       r.Subject,
+			Status:        r.Status,
+			Data:          r.Data,
+			StartedAt:     r.StartedAt,
+			FinishedAt:    r.FinishedAt,
+		}))
+	}
+	return out, nil
+}
+
+func (d *DB) Job(ctx context.Context, id uuid.UUID) (Job, error) {
+	ctx, q := d.scope(ctx)
+	row, err := q.GetWorkerJob(ctx, converter.UuidToPgUUID(id))
+	if err != nil {
+		return Job{}, err
+	}
+	return dbJob(row.WorkerJob), nil
+}
+
+func (d *DB) CancelJob(ctx context.Context, id uuid.UUID) error {
+	ctx, _ = d.scope(ctx)
+	q, err := d.queue()
+	if err != nil {
+		return fmt.Errorf("connect to the queue: %w", err)
+	}
+	return worker.Cancel(ctx, d.dbp, q, id)
+}
+
+func (d *DB) RetryJob(ctx context.Context, id uuid.UUID) (Job, error) {
+	ctx, _ = d.scope(ctx)
+	q, err := d.queue()
+	if err != nil {
+		return Job{}, fmt.Errorf("connect to the queue: %w", err)
+	}
+	job, err := worker.Retry(ctx, d.log, d.dbp, q, id)
+	if err != nil {
+		return Job{}, err
+	}
+	return dbJob(job), nil
+}
+
+func (d *DB) Tokens(ctx context.Context) ([]Token, error) {
+	ctx, q := d.scope(ctx)
+	rows, err := q.GetOauth2Tokens(ctx, query.GetOauth2TokensParams{
+		OrderBy:        "created_at",
+		OrderDirection: "desc",
+		ClientUuid:     "",
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Token, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, Token{
+			UUID:            r.UUID.String(),
+			ClientUUID:      uuidString(r.ClientUuid),
+			UserUUID:        uuidString(r.UserUUID),
+			Status:          r.Status,
+			ExpiresAt:       pgTime(r.ExpiresAt),
+			LastRefreshedAt: pgTime(r.LastRefreshedAt),
+			FailureCount:    r.FailureCount,
+			LastError:       r.LastError,
+			MissingScopes:   r.MissingScopes,
+		})
+	}
+	return out, nil
+}
+
+func (d *DB) RefreshToken(ctx context.Context, id uuid.UUID) error {
+	ctx, _ = d.scope(ctx)
+	_, err := oauthTools.RefreshNow(ctx, d.dbp, id)
+	return err
+}
+
+func (d *DB) RevokeToken(ctx context.Context, id uuid.UUID) error {
+	ctx, q := d.scope(ctx)
+	row, err := q.GetOauth2TokenByUUID(ctx, converter.UuidToPgUUID(id))
+	if err != nil {
+		return err
+	}
+	// the token is deleted even when the provider cannot revoke it
+	if err := oauthTools.RevokeToken(ctx, d.dbp, row.Oauth2Token); err != nil {
+		d.log.Warn("failed to revoke token at the provider", "token_uuid", id, "error", err)
+	}
+	return q.DeleteOauth2Token(ctx, converter.UuidToPgUUID(id))
+}
+
+func (d *DB) Users(ctx context.Context) ([]User, error) {
+	ctx, q := d.scope(ctx)
+	rows, err := q.ListUsers(ctx, query.ListUsersParams{})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]User, 0, len(rows))
+	for _, u := range rows {
+		out = append(out, dbUser(u))
+	}
+	return out, nil
+}
+
+func (d *DB) EnableUser(ctx context.Context, id uuid.UUID, enabled bool) (User, error) {
+	ctx, q := d.scope(ctx)
+	u, err := q.GetUser(ctx, converter.UuidToPgUUID(id))
+	if err != nil {
+		return User{}, err
+	}
+	err = q.UpdateUser(ctx, query.UpdateUserParams{
+		Email:          u.Email,
+		Password:       u.Password,
+		FirstName:      u.FirstName,
+		LastName:       u.LastName,
+		IsEnabled:      enabled,
+		IsAdmin:        u.IsAdmin,
+		ZitadelSubject: u.ZitadelSubject,
+		Meta:           u.Meta,
+		UUID:           converter.UuidToPgUUID(id),
+	})
+	if err != nil {
+		return User{}, err
+	}
+	u.IsEnabled = enabled
+	return dbUser(u), nil
+}
+
+func (d *DB) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	ctx, q := d.scope(ctx)
+	if _, err := q.GetUser(ctx, converter.UuidToPgUUID(id)); err != nil {
+		return err
+	}
+	return q.DeleteUser(ctx, converter.UuidToPgUUID(id))
+}
+
+func (d *DB) Messages(ctx context.Context, filter MessageFilter) ([]Message, error) {
+	ctx, q := d.scope(ctx)
+	params := query.GetMessagesParams{
+		OrderBy:        "created_at",
+		OrderDirection: "desc",
+		Offset:         filter.Offset,
+		Limit:          filter.Limit,
+		Type:           filter.Type,
+		Format:         "",
+		Sender:         "",
+		Search:         filter.Text,
+	}
+	if filter.PipelineUUID != nil {
+		params.PipelineUuid = converter.UuidToPgUUID(*filter.PipelineUUID)
+	}
+	if !filter.Since.IsZero() {
+		params.CreatedAfter = pgtype.Timestamptz{Time: filter.Since, Valid: true}
+	}
+	if !filter.Until.IsZero() {
+		params.CreatedBefore = pgtype.Timestamptz{Time: filter.Until, Valid: true}
+	}
+	rows, err := q.GetMessages(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Message, 0, len(rows))
+	blobs := storages.NewBlobResolver(q)
+	for _, r := range rows {
+		body, err := blobs.MessageBody(ctx, r.Body, r.BodyFileUuid)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, Message{
+			UUID:           r.UUID.String(),
+			Type:           r.Type,
+			Format:         r.Format,
+			Sender:         r.Sender,
+			Recipients:     r.Recipients,
+			Subject:        r.Subject.String,
+			Body:           body,
+			PipelineUUID:   uuidString(r.PipelineUuid),
+			DatasourceUUID: uuidString(r.DatasourceUUID),
+			CreatedAt:      pgTime(r.CreatedAt),
+		})
+	}
+	return out, nil
+}
+
+func dbScheduler(s query.Scheduler) Scheduler {
+	return Scheduler{
+		UUID:         s.UUID.String(),
+		PipelineUUID: uuidString(s.PipelineUuid),
+		Type:         s.ScheduleType,
+		Cron:         s.CronExpression.String,
+		Interval:     s.IntervalSeconds.Int32,
+		RunAt:        pgTime(s.RunAt),
+		Timezone:     s.Timezone,
+		Enabled:      s.IsEnabled,
+		Paused:       s.IsPaused,
+		PausedReason: s.PausedReason,
+		NextRun:      pgTime(s.NextRun),
+		LastRun:      pgTime(s.LastRun),
+	}
+}
+
+func dbJob(j query.WorkerJob) Job {
+	job := Job{
+		UUID:          j.UUID.String(),
+		SchedulerUUID: uuidString(j.SchedulerUuid),
+		PipelineUUID:  uuidString(j.PipelineUuid),
+		Subject:       j.Subject,
+		Status:        j.Status,
+		StartedAt:     pgTime(j.StartedAt),
+		FinishedAt:    pgTime(j.FinishedAt),
+	}
+	// broken data is left out rather than failing the listing
+	_ = json.Unmarshal(j.Data, &job.Data)
+	return job
+}
+
+func dbUser(u query.User) User {
+	return User{
+		UUID:      u.UUID.String(),
+		Email:     u.Email,
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+		Enabled:   u.IsEnabled,
+		Admin:     u.IsAdmin,
+		CreatedAt: pgTime(u.CreatedAt),
+	}
+}
+
+func uuidString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func pgTime(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
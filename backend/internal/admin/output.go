@@ -0,0 +1,126 @@
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Format is how items are written.
+type Format string
+
+const (
+	FormatTable Format = "table"
+	FormatJSON  Format = "json"
+	FormatYAML  Format = "yaml"
+)
+
+// ParseFormat parses the value of an --output flag.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case FormatTable, FormatJSON, FormatYAML:
+		return f, nil
+	}
+	return "", fmt.Errorf("unknown output format %q, use table, json or yaml", s)
+}
+
+// Item is a listed object, rendered as a table row.
+type Item interface {
+	Header() []string
+	Row() []string
+}
+
+// Write writes the items as a table, a JSON array or a YAML sequence.
+func Write[T Item](w io.Writer, f Format, items []T) error {
+	switch f {
+	case FormatJSON:
+		if items == nil {
+			items = []T{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(items)
+	case FormatYAML:
+		if items == nil {
+			items = []T{}
+		}
+		return encodeYAML(w, items)
+	}
+	var zero T
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(zero.Header(), "\t"))
+	for _, item := range items {
+		fmt.Fprintln(tw, strings.Join(item.Row(), "\t"))
+	}
+	return tw.Flush()
+}
+
+// WriteOne writes a single item, as a one row table in table format.
+func WriteOne[T Item](w io.Writer, f Format, item T) error {
+	switch f {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(item)
+	case FormatYAML:
+		return encodeYAML(w, item)
+	}
+	return Write(w, f, []T{item})
+}
+
+// Stream writes items one at a time as they come, e.g. while tailing: table
+// rows, JSON lines or YAML documents.
+type Stream struct {
+	f   Format
+	tw  *tabwriter.Writer
+	enc *yaml.Encoder
+	w   io.Writer
+}
+
+// NewStream returns a stream, in table format the header is written first.
+func NewStream(w io.Writer, f Format, header []string) *Stream {
+	s := &Stream{f: f, w: w}
+	switch f {
+	case FormatYAML:
+		s.enc = yaml.NewEncoder(w)
+		s.enc.SetIndent(2)
+	case FormatTable:
+		// rows are flushed one by one, the minimum width keeps short columns aligned
+		s.tw = tabwriter.NewWriter(w, 12, 0, 2, ' ', 0)
+		fmt.Fprintln(s.tw, strings.Join(header, "\t"))
+	}
+	return s
+}
+
+// Write writes the item.
+func (s *Stream) Write(item Item) error {
+	switch s.f {
+	case FormatJSON:
+		return json.NewEncoder(s.w).Encode(item)
+	case FormatYAML:
+		return s.enc.Encode(item)
+	}
+	fmt.Fprintln(s.tw, strings.Join(item.Row(), "\t"))
+	return s.tw.Flush()
+}
+
+// Close ends the stream.
+func (s *Stream) Close() error {
+	if s.enc != nil {
+		return s.enc.Close()
+	}
+	return nil
+}
+
+func encodeYAML(w io.Writer, v any) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	return enc.Close()
+}
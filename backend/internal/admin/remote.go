@@ -0,0 +1,452 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofrs/uuid"
+	gouuid "github.com/google/uuid"
+
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+)
+
+// Remote is the Backend working through the REST API of a running server.
+type Remote struct {
+	c *api.Client
+}
+
+// NewRemote returns a backend calling the API at url, authenticated with the
+// bearer token or API key. ws selects the workspace by UUID, the one of the
+// key or the default one when empty.
+func NewRemote(url, token, ws string) (*Remote, error) {
+	if ws != "" {
+		if _, err := uuid.FromString(ws); err != nil {
+			return nil, fmt.Errorf("remote workspaces are selected by UUID: %w", err)
+		}
+	}
+	c, err := api.NewClient(url, bearer(token), api.WithClient(&http.Client{
+		Timeout:   time.Minute,
+		Transport: workspaceTransport{ws: ws, next: http.DefaultTransport},
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return &Remote{c: c}, nil
+}
+
+// bearer authenticates every request with the token.
+type bearer string
+
+func (b bearer) BearerAuth(context.Context, api.OperationName) (api.BearerAuth, error) {
+	return api.BearerAuth{Token: string(b)}, nil
+}
+
+func (b bearer) PlainCookieAuth(context.Context, api.OperationName) (api.PlainCookieAuth, error) {
+	return api.PlainCookieAuth{}, nil
+}
+
+func (b bearer) ZitadelCookieAuth(context.Context, api.OperationName) (api.ZitadelCookieAuth, error) {
+	return api.ZitadelCookieAuth{}, nil
+}
+
+// workspaceTransport selects the workspace of every request.
+type workspaceTransport struct {
+	ws   string
+	next http.RoundTripper
+}
+
+func (t workspaceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.ws != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set(workspace.Header, t.ws)
+	}
+	return t.next.RoundTrip(req)
+}
+
+// StatusError is an error response of the API.
+type StatusError struct {
+	StatusCode int
+	Detail     string
+}
+
+func (e *StatusError) Error() string {
+	if e.Detail == "" {
+		return http.StatusText(e.StatusCode)
+	}
+	return fmt.Sprintf("%s (%d)", e.Detail, e.StatusCode)
+}
+
+// remoteErr turns the error responses of the client into StatusErrors.
+func remoteErr(err error) error {
+	var se *api.ErrorStatusCode
+	if errors.As(err, &se) {
+		return &StatusError{StatusCode: se.StatusCode, Detail: se.Response.Detail.Or("")}
+	}
+	return err
+}
+
+func (r *Remote) Datasources(ctx context.Context) ([]Datasource, error) {
+	res, err := r.c.DatasourceList(ctx, api.DatasourceListParams{})
+	if err != nil {
+		return nil, remoteErr(err)
+	}
+	out := make([]Datasource, 0, len(res))
+	for _, ds := range res {
+		out = append(out, Datasource{
+			UUID:         ds.UUID.Or(""),
+			Name:         ds.Name,
+			Type:         ds.Type,
+			Provider:     ds.Provider,
+			UserUUID:     ds.UserUUID,
+			Enabled:      ds.IsEnabled.Or(false),
+			NeedsReauth:  ds.NeedsReauth.Or(false),
+			ReauthReason: ds.ReauthReason.Or(""),
+			CreatedAt:    optTime(ds.CreatedAt),
+		})
+	}
+	return out, nil
+}
+
+func (r *Remote) Storages(ctx context.Context) ([]Storage, error) {
+	res, err := r.c.StorageList(ctx, api.StorageListParams{})
+	if err != nil {
+		return nil, remoteErr(err)
+	}
+	out := make([]Storage, 0, len(res))
+	for _, s := range res {
+		out = append(out, Storage{
+			UUID:      s.UUID,
+			Name:      s.Name.Or(""),
+			Type:      s.Type,
+			Enabled:   s.IsEnabled,
+			CreatedAt: optTime(s.CreatedAt),
+		})
+	}
+	return out, nil
+}
+
+func (r *Remote) SyncPolicies(ctx context.Context) ([]SyncPolicy, error) {
+	res, err := r.c.SyncpolicyList(ctx, api.SyncpolicyListParams{})
+	if err != nil {
+		return nil, remoteErr(err)
+	}
+	out := make([]SyncPolicy, 0, len(res.Policies))
+	for _, p := range res.Policies {
+		out = append(out, SyncPolicy{
+			UUID:         p.UUID.Or(""),
+			Name:         p.Name,
+			Type:         p.Type.Or(""),
+			PipelineUUID: p.PipelineUUID,
+			Enabled:      p.IsEnabled.Or(false),
+			SyncAll:      p.SyncAll.Or(false),
+			Blocklist:    p.Blocklist,
+			ExcludeList:  p.ExcludeList,
+		})
+	}
+	return out, nil
+}
+
+func (r *Remote) Pipelines(ctx context.Context) ([]Pipeline, error) {
+	res, err := r.c.PipelineList(ctx, api.PipelineListParams{})
+	if err != nil {
+		return nil, remoteErr(err)
+	}
+	out := make([]Pipeline, 0, len(res.Pipelines))
+	for _, p := range res.Pipelines {
+		out = append(out, Pipeline{
+			UUID:           p.UUID.Or(""),
+			Name:           p.Name,
+			Type:           p.Type.Or(""),
+			DatasourceUUID: p.DatasourceUUID,
+			StorageUUID:    p.StorageUUID,
+			Enabled:        p.IsEnabled.Or(false),
+		})
+	}
+	return out, nil
+}
+
+func (r *Remote) Schedulers(ctx context.Context, pipelineUUID *uuid.UUID) ([]Scheduler, error) {
+	var params api.SchedulerListParams
+	if pipelineUUID != nil {
+		params.PipelineUUID = api.NewOptUUID(gouuid.UUID(*pipelineUUID))
+	}
+	res, err := r.c.SchedulerList(ctx, params)
+	if err != nil {
+		return nil, remoteErr(err)
+	}
+	out := make([]Scheduler, 0, len(res))
+	for _, s := range res {
+		out = append(out, apiScheduler(s))
+	}
+	return out, nil
+}
+
+func (r *Remote) PauseScheduler(ctx context.Context, id uuid.UUID, paused bool) (Scheduler, error) {
+	s, err := r.c.SchedulerGet(ctx, api.SchedulerGetParams{UUID: gouuid.UUID(id)})
+	if err != nil {
+		return Scheduler{}, remoteErr(err)
+	}
+	s.IsPaused = api.NewOptBool(paused)
+	s, err = r.c.SchedulerUpdate(ctx, s, api.SchedulerUpdateParams{UUID: gouuid.UUID(id)})
+	if err != nil {
+		return Scheduler{}, remoteErr(err)
+	}
+	return apiScheduler(*s), nil
+}
+
+func (r *Remote) DeleteScheduler(ctx context.Context, id uuid.UUID) error {
+	return remoteErr(r.c.SchedulerDelete(ctx, api.SchedulerDeleteParams{UUID: gouuid.UUID(id)}))
+}
+
+func (r *Remote) Jobs(ctx context.Context, filter JobFilter) ([]Job, error) {
+	params := api.WorkerJobsListParams{Offset: api.NewOptInt32(filter.Offset)}
+	if filter.Limit > 0 {
+		params.Limit = api.NewOptInt32(filter.Limit)
+	}
+	if filter.Status != "" {
+		params.Status = api.NewOptString(filter.Status)
+	}
+	if filter.Subject != "" {
+		params.Subject = api.NewOptString(filter.Subject)
+	}
+	if filter.SchedulerUUID != nil {
+		params.SchedulerUUID = api.NewOptUUID(gouuid.UUID(*filter.SchedulerUUID))
+	}
+	if filter.PipelineUUID != nil {
+		params.PipelineUUID = api.NewOptUUID(gouuid.UUID(*filter.PipelineUUID))
+	}
+	res, err := r.c.WorkerJobsList(ctx, params)
+	if err != nil {
+		return nil, remoteErr(err)
+	}
+	out := make([]Job, 0, len(res.Jobs))
+	for _, j := range res.Jobs {
+		out = append(out, apiJob(j))
+	}
+	return out, nil
+}
+
+func (r *Remote) Job(ctx context.Context, id uuid.UUID) (Job, error) {
+	j, err := r.c.WorkerJobsGet(ctx, api.WorkerJobsGetParams{UUID: id.String()})
+	if err != nil {
+		return Job{}, remoteErr(err)
+	}
+	return apiJob(*j), nil
+}
+
+func (r *Remote) CancelJob(ctx context.Context, id uuid.UUID) error {
+	return remoteErr(r.c.WorkerJobsCancel(ctx, api.WorkerJobsCancelParams{UUID: id.String()}))
+}
+
+func (r *Remote) RetryJob(ctx context.Context, id uuid.UUID) (Job, error) {
+	j, err := r.c.WorkerJobsRetry(ctx, api.WorkerJobsRetryParams{UUID: id.String()})
+	if err != nil {
+		return Job{}, remoteErr(err)
+	}
+	return apiJob(*j), nil
+}
+
+// Tokens lists the tokens of every OAuth2 client, the API lists them per
+// client.
+func (r *Remote) Tokens(ctx context.Context) ([]Token, error) {
+	clients, err := r.c.OAuth2ClientList(ctx, api.OAuth2ClientListParams{})
+	if err != nil {
+		return nil, remoteErr(err)
+	}
+	var out []Token
+	for _, c := range clients.Clients {
+		res, err := r.c.OAuth2ClientTokenList(ctx, api.OAuth2ClientTokenListParams{DatasourceUUID: c.UUID.Or("")})
+		if err != nil {
+			return nil, remoteErr(err)
+		}
+		for _, t := range res {
+			out = append(out, Token{
+				UUID:            t.UUID.Or(""),
+				ClientUUID:      t.ClientUUID,
+				UserUUID:        t.UserUUID.Or(""),
+				Status:          string(t.Status.Or("")),
+				ExpiresAt:       optTime(t.ExpiresAt),
+				LastRefreshedAt: optTime(t.LastRefreshedAt),
+				FailureCount:    t.FailureCount.Or(0),
+				LastError:       t.LastError.Or(""),
+				MissingScopes:   t.MissingScopes,
+			})
+		}
+	}
+	return out, nil
+}
+
+// token returns the stored token, the API addresses tokens under their client.
+func (r *Remote) token(ctx context.Context, id uuid.UUID) (Token, error) {
+	tokens, err := r.Tokens(ctx)
+	if err != nil {
+		return Token{}, err
+	}
+	for _, t := range tokens {
+		if t.UUID == id.String() {
+			return t, nil
+		}
+	}
+	return Token{}, &StatusError{StatusCode: http.StatusNotFound, Detail: "token not found"}
+}
+
+func (r *Remote) RefreshToken(ctx context.Context, id uuid.UUID) error {
+	t, err := r.token(ctx, id)
+	if err != nil {
+		return err
+	}
+	return remoteErr(r.c.OAuth2ClientTokenRefresh(ctx, api.OAuth2ClientTokenRefreshParams{UUID: t.UUID, DatasourceUUID: t.ClientUUID}))
+}
+
+func (r *Remote) RevokeToken(ctx context.Context, id uuid.UUID) error {
+	t, err := r.token(ctx, id)
+	if err != nil {
+		return err
+	}
+	return remoteErr(r.c.OAuth2ClientTokenDelete(ctx, api.OAuth2ClientTokenDeleteParams{UUID: t.UUID, DatasourceUUID: t.ClientUUID}))
+}
+
+func (r *Remote) Users(ctx context.Context) ([]User, error) {
+	res, err := r.c.ListUsers(ctx)
+	if err != nil {
+		return nil, remoteErr(err)
+	}
+	out := make([]User, 0, len(res))
+	for _, u := range res {
+		out = append(out, apiUser(u))
+	}
+	return out, nil
+}
+
+func (r *Remote) EnableUser(ctx context.Context, id uuid.UUID, enabled bool) (User, error) {
+	u, err := r.c.GetUser(ctx, api.GetUserParams{UUID: id.String()})
+	if err != nil {
+		return User{}, remoteErr(err)
+	}
+	u.IsEnabled = api.NewOptBool(enabled)
+	u, err = r.c.UpdateUser(ctx, u, api.UpdateUserParams{UUID: id.String()})
+	if err != nil {
+		return User{}, remoteErr(err)
+	}
+	return apiUser(*u), nil
+}
+
+func (r *Remote) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	return remoteErr(r.c.DeleteUser(ctx, api.DeleteUserParams{UUID: id.String()}))
+}
+
+func (r *Remote) Messages(ctx context.Context, filter MessageFilter) ([]Message, error) {
+	req := &api.MessageQuery{
+		Source: api.MessageQuerySourceUnified,
+		Order:  api.NewOptMessageQueryOrder(api.MessageQueryOrderDesc),
+		Offset: api.NewOptInt(int(filter.Offset)),
+	}
+	if filter.Type != "" {
+		req.Source = api.MessageQuerySource(filter.Type)
+	}
+	if filter.Text != "" {
+		req.Query = api.NewOptString(filter.Text)
+	}
+	if filter.PipelineUUID != nil {
+		req.PipelineUUID = api.NewOptUUID(gouuid.UUID(*filter.PipelineUUID))
+	}
+	if !filter.Since.IsZero() {
+		req.StartDate = api.NewOptDateTime(filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		req.EndDate = api.NewOptDateTime(filter.Until)
+	}
+	if filter.Limit > 0 {
+		req.Limit = api.NewOptInt(int(filter.Limit))
+	}
+	res, err := r.c.MessageQuery(ctx, req)
+	if err != nil {
+		return nil, remoteErr(err)
+	}
+	out := make([]Message, 0, len(res.Messages))
+	for _, m := range res.Messages {
+		out = append(out, Message{
+			UUID:           m.UUID.Or(""),
+			Type:           m.Type,
+			Format:         m.Format,
+			Sender:         m.Sender,
+			Recipients:     m.Recipients,
+			Subject:        m.Subject.Or(""),
+			Body:           m.Body,
+			PipelineUUID:   m.PipelineUUID.Or(""),
+			DatasourceUUID: m.DatasourceUUID.Or(""),
+			CreatedAt:      optTime(m.CreatedAt),
+		})
+	}
+	return out, nil
+}
+
+func apiScheduler(s api.Scheduler) Scheduler {
+	out := Scheduler{
+		UUID:         s.UUID.Or(""),
+		PipelineUUID: s.PipelineUUID,
+		Type:         s.ScheduleType,
+		Timezone:     s.Timezone.Or(""),
+		Enabled:      s.IsEnabled.Or(false),
+		Paused:       s.IsPaused.Or(false),
+		PausedReason: s.PausedReason.Or(""),
+		NextRun:      optTime(s.NextRun),
+		LastRun:      optTime(s.LastRun),
+	}
+	if v, ok := s.CronExpression.Get(); ok {
+		out.Cron = v
+	}
+	if v, ok := s.IntervalSeconds.Get(); ok {
+		out.Interval = v
+	}
+	if v, ok := s.RunAt.Get(); ok {
+		out.RunAt = &v
+	}
+	return out
+}
+
+func apiJob(j api.WorkerJobs) Job {
+	job := Job{
+		UUID:          j.UUID.Or(""),
+		SchedulerUUID: j.SchedulerUUID.Or(""),
+		PipelineUUID:  j.PipelineUUID.Or(""),
+		Subject:       j.Subject,
+		Status:        j.Status,
+		StartedAt:     optTime(j.StartedAt),
+		FinishedAt:    optTime(j.FinishedAt),
+	}
+	if data, ok := j.Data.Get(); ok {
+		job.Data = make(map[string]any, len(data))
+		for k, raw := range data {
+			var v any
+			if json.Unmarshal(raw, &v) == nil {
+				job.Data[k] = v
+			}
+		}
+	}
+	return job
+}
+
+func apiUser(u api.User) User {
+	return User{
+		UUID:      u.UUID.Or(""),
+		Email:     u.Email,
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+		Enabled:   u.IsEnabled.Or(false),
+		Admin:     u.IsAdmin.Or(false),
+		CreatedAt: optTime(u.CreatedAt),
+	}
+}
+
+func optTime(t api.OptDateTime) *time.Time {
+	if v, ok := t.Get(); ok && !v.IsZero() {
+		return &v
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+// Package apikey generates API keys and checks their scopes and IP allowlists.
+//
+// A key looks like sak_<prefix>_<secret>. Only its SHA-256 is stored, the
+// prefix is kept in clear so a key can be recognised in listings.
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// Marker starts every API key, it tells keys apart from the static bearer
+// token.
+const Marker = "sak_"
+
+// Scope levels. Read covers the read action, write every other action.
+const (
+	LevelRead  = "read"
+	LevelWrite = "write"
+)
+
+// Generate returns a new key, its display prefix and its hash.
+func Generate() (key, prefix, hash string, err error) {
+	id := make([]byte, 6)
+	secret := make([]byte, 32)
+	if _, err = rand.Read(id); err != nil {
+		return "", "", "", err
+	}
+	if _, err = rand.Read(secret); err != nil {
+		return "", "", "", err
+	}
+	prefix = Marker + hex.EncodeToString(id)
+	key = prefix + "_" + base64.RawURLEncoding.EncodeToString(secret)
+	return key, prefix, Hash(key), nil
+}
+
+// Hash returns the stored form of a key.
+func Hash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsKey reports whether the bearer token looks like an API key.
+func IsKey(token string) bool {
+	return strings.HasPrefix(token, Marker)
+}
+
+// ValidateScope checks a scope of the form <resource>:<level>, where resource
+// is a resource kind, singular or plural, or *, and level is read, write or *.
+func ValidateScope(scope string) error {
+	resource, level, ok := strings.Cut(scope, ":")
+	if !ok || resource == "" {
+		return fmt.Errorf("scope %q must look like resource:read or resource:write", scope)
+	}
+	switch level {
+	case LevelRead, LevelWrite, "*":
+		return nil
+	}
+	return fmt.Errorf("scope %q: level must be read, write or *", scope)
+}
+
+// Allows reports whether the scopes permit the action on the resource kind.
+// Write scopes include read.
+func Allows(scopes []string, kind, action string) bool {
+	need := LevelWrite
+	if action == "read" {
+		need = LevelRead
+	}
+	for _, scope := range scopes {
+		resource, level, ok := strings.Cut(scope, ":")
+		if !ok {
+			continue
+		}
+		if resource != "*" && resource != kind && resource != plural(kind) {
+			continue
+		}
+		if level == "*" || level == LevelWrite || level == need {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsIP reports whether ip is in the allowlist of IPs and CIDRs. An empty
+// allowlist allows every address.
+func AllowsIP(allowed []string, ip string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+	for _, entry := range allowed {
+		if _, network, err := net.ParseCIDR(entry); err == nil {
+			if network.Contains(addr) {
+				return true
+			}
+		} else if allowedIP := net.ParseIP(entry); allowedIP != nil && allowedIP.Equal(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateIP checks an allowlist entry.
+func ValidateIP(entry string) error {
+	if _, _, err := net.ParseCIDR(entry); err == nil {
+		return nil
+	}
+	if net.ParseIP(entry) != nil {
+		return nil
+	}
+	return fmt.Errorf("%q is neither an IP address nor a CIDR", entry)
+}
+
+// plural returns the English plural of a resource kind, e.g. policies,
+// apikeys or accesses.
+func plural(kind string) string {
+	switch {
+	case strings.HasSuffix(kind, "y") && len(kind) > 1 && !strings.ContainsRune("aeiou", rune(kind[len(kind)-2])):
+		return kind[:len(kind)-1] + "ies"
+	case strings.HasSuffix(kind, "s"), strings.HasSuffix(kind, "sh"), strings.HasSuffix(kind, "ch"),
+		strings.HasSuffix(kind, "x"), strings.HasSuffix(kind, "z"):
+		return kind + "es"
+	}
+	return kind + "s"
+}
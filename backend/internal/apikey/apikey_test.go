@@ -0,0 +1,144 @@
+package apikey
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	key, prefix, hash, err := Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsKey(key) || !strings.HasPrefix(key, prefix+"_") || len(prefix) != len(Marker)+12 {
+		t.Errorf("key %q with prefix %q", key, prefix)
+	}
+	if hash != Hash(key) || hash == Hash(key+"x") || strings.Contains(hash, key) {
+		t.Errorf("hash %q of key %q", hash, key)
+	}
+	other, _, _, _ := Generate()
+	if other == key {
+		t.Error("two keys are equal")
+	}
+	if IsKey("static-bearer-token") {
+		t.Error("bearer token taken for a key")
+	}
+}
+
+func TestValidateScope(t *testing.T) {
+	tests := []struct {
+		scope   string
+		wantErr bool
+	}{
+		{"messages:read", false},
+		{"pipeline:write", false},
+		{"policies:*", false},
+		{"*:read", false},
+		{"*:*", false},
+		{"messages", true},
+		{":read", true},
+		{"messages:", true},
+		{"messages:admin", true},
+		{"messages:READ", true},
+	}
+	for _, tt := range tests {
+		if err := ValidateScope(tt.scope); (err != nil) != tt.wantErr {
+			t.Errorf("ValidateScope(%q) = %v, want error %v", tt.scope, err, tt.wantErr)
+		}
+	}
+}
+
+func TestAllows(t *testing.T) {
+	tests := []struct {
+		scopes []string
+		kind   string
+		action string
+		want   bool
+	}{
+		{[]string{"message:read"}, "message", "read", true},
+		{[]string{"messages:read"}, "message", "read", true},
+		{[]string{"messages:read"}, "message", "create", false},
+		// write implies read
+		{[]string{"pipelines:write"}, "pipeline", "read", true},
+		{[]string{"pipelines:write"}, "pipeline", "update", true},
+		{[]string{"pipelines:write"}, "pipeline", "run", true},
+		{[]string{"pipelines:*"}, "pipeline", "delete", true},
+		{[]string{"*:read"}, "datasource", "read", true},
+		{[]string{"*:read"}, "datasource", "send", false},
+		{[]string{"*:*"}, "storage", "migrate", true},
+		{[]string{"policies:read"}, "policy", "read", true},
+		{[]string{"syncpolicies:write"}, "syncpolicy", "update", true},
+		{[]string{"apikeys:read"}, "apikey", "read", true},
+		{[]string{"apikey:write"}, "apikey", "delete", true},
+		{[]string{"messages:read", "files:write"}, "file", "create", true},
+		{[]string{"messages:write"}, "file", "read", false},
+		{[]string{"message"}, "message", "read", false},
+		{nil, "message", "read", false},
+	}
+	for _, tt := range tests {
+		if got := Allows(tt.scopes, tt.kind, tt.action); got != tt.want {
+			t.Errorf("Allows(%v, %s, %s) = %v, want %v", tt.scopes, tt.kind, tt.action, got, tt.want)
+		}
+	}
+}
+
+func TestAllowsIP(t *testing.T) {
+	tests := []struct {
+		allowed []string
+		ip      string
+		want    bool
+	}{
+		{nil, "203.0.113.7", true},
+		{[]string{"203.0.113.7"}, "203.0.113.7", true},
+		{[]string{"203.0.113.7"}, "203.0.113.8", false},
+		{[]string{"10.0.0.0/8"}, "10.20.30.40", true},
+		{[]string{"10.0.0.0/8"}, "11.0.0.1", false},
+		{[]string{"192.168.1.0/24", "10.0.0.0/8"}, "192.168.1.255", true},
+		// IPv4-mapped addresses match IPv4 entries
+		{[]string{"10.0.0.0/8"}, "::ffff:10.1.2.3", true},
+		{[]string{"2001:db8::/32"}, "2001:db8:1::5", true},
+		{[]string{"2001:db8::/32"}, "2001:db9::5", false},
+		{[]string{"2001:db8::1"}, "2001:0db8:0000::0001", true},
+		{[]string{"2001:db8::/32"}, "10.0.0.1", false},
+		{[]string{"10.0.0.0/8"}, "not-an-ip", false},
+		{[]string{"10.0.0.0/8"}, "", false},
+	}
+	for _, tt := range tests {
+		if got := AllowsIP(tt.allowed, tt.ip); got != tt.want {
+			t.Errorf("AllowsIP(%v, %q) = %v, want %v", tt.allowed, tt.ip, got, tt.want)
+		}
+	}
+}
+
+func TestValidateIP(t *testing.T) {
+	for _, entry := range []string{"203.0.113.7", "10.0.0.0/8", "2001:db8::/32", "::1"} {
+		if err := ValidateIP(entry); err != nil {
+			t.Errorf("ValidateIP(%q) = %v", entry, err)
+		}
+	}
+	for _, entry := range []string{"", "10.0.0.0/33", "host.example.com", "10.0.0"} {
+		if err := ValidateIP(entry); err == nil {
+			t.Errorf("ValidateIP(%q) accepted", entry)
+		}
+	}
+}
+
+func TestPlural(t *testing.T) {
+	tests := map[string]string{
+		"message":    "messages",
+		"policy":     "policies",
+		"syncpolicy": "syncpolicies",
+		"apikey":     "apikeys",
+		"access":     "accesses",
+		"status":     "statuses",
+		"push":       "pushes",
+		"batch":      "batches",
+		"box":        "boxes",
+		"oauth2":     "oauth2s",
+	}
+	for kind, want := range tests {
+		if got := plural(kind); got != want {
+			t.Errorf("plural(%q) = %q, want %q", kind, got, want)
+		}
+	}
+}
@@ -0,0 +1,232 @@
+// Package audit records who did what through the API.
+//
+// The recorder is an ogen middleware placed in front of the session
+// middleware. It writes an append-only audit_event row for every mutating
+// operation and every read of sensitive data, including the requests that
+// were rejected.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/samber/do/v2"
+
+	"github.com/shadowapi/shadowapi/backend/internal/config"
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/policies"
+	"github.com/shadowapi/shadowapi/backend/internal/session"
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// Outcomes of an audited operation
+const (
+	OutcomeSuccess         = "success"
+	OutcomeDenied          = "denied"
+	OutcomeUnauthenticated = "unauthenticated"
+	OutcomeError           = "error"
+)
+
+// sensitiveKinds are the resource kinds whose reads are audited as well.
+var sensitiveKinds = map[string]bool{
+	"message": true,
+	"file":    true,
+	"contact": true,
+	"user":    true,
+	"apikey":  true,
+	"erasure": true,
+	"audit":   true,
+}
+
+// sensitiveOperations are reads audited although their kind is not sensitive.
+var sensitiveOperations = map[string]bool{
+	"oauth2-client-token-list": true,
+}
+
+// Recorder writes the audit events.
+type Recorder struct {
+	log        *slog.Logger
+	dbp        *pgxpool.Pool
+	trustProxy bool
+}
+
+// Provide the audit recorder for the dependency injector
+func Provide(i do.Injector) (*Recorder, error) {
+	cfg := do.MustInvoke[*config.Config](i)
+	return &Recorder{
+		log:        do.MustInvoke[*slog.Logger](i).With("service", "audit"),
+		dbp:        do.MustInvoke[*pgxpool.Pool](i),
+		trustProxy: cfg.Auth.TrustProxyHeaders,
+	}, nil
+}
+
+// Audited reports whether calls of the operation are recorded.
+func Audited(operationID string) bool {
+	kind, action := policies.Operation(operationID)
+	if action != policies.ActionRead {
+		return true
+	}
+	return sensitiveKinds[kind] || sensitiveOperations[operationID]
+}
+
+// OgenMiddleware satisfies Ogen's middleware.Middleware signature.
+func (r *Recorder) OgenMiddleware(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+	if !Audited(req.OperationID) {
+		return next(req)
+	}
+	ctx, identity := session.TrackIdentity(req.Context)
+	req.SetContext(ctx)
+	start := time.Now()
+
+	resp, err := next(req)
+
+	id, authenticated := identity()
+	kind, action := policies.Operation(req.OperationID)
+	params := query.CreateAuditEventParams{
+		UUID:         converter.UuidToPgUUID(uuid.Must(uuid.NewV7())),
+		OccurredAt:   pgtype.Timestamptz{Time: start, Valid: true},
+		Actor:        id.ID,
+		Source:       id.Source,
+		OperationID:  req.OperationID,
+		ResourceKind: kind,
+		Action:       action,
+		ResourceID:   resourceID(req.Params),
+		Params:       encodeParams(req.Params),
+		Diff:         encodeBody(req.Body),
+		Outcome:      OutcomeSuccess,
+		Status:       http.StatusOK,
+		IP:           session.ClientIP(req.Raw, r.trustProxy),
+		UserAgent:    req.Raw.UserAgent(),
+		DurationMs:   int32(time.Since(start).Milliseconds()),
+	}
+	if keyUUID, err := uuid.FromString(id.APIKeyUUID); err == nil {
+		params.APIKeyUUID = converter.UuidToPgUUID(keyUUID)
+	}
+	if wsUUID, err := uuid.FromString(id.WorkspaceUUID); err == nil && !wsUUID.IsNil() {
+		params.WorkspaceUUID = converter.UuidToPgUUID(wsUUID)
+	}
+	if err != nil {
+		params.Status = int32(statusCode(err))
+		params.Error = err.Error()
+		switch {
+		case params.Status == http.StatusUnauthorized || !authenticated:
+			params.Outcome = OutcomeUnauthenticated
+		case params.Status == http.StatusForbidden:
+			params.Outcome = OutcomeDenied
+		default:
+			params.Outcome = OutcomeError
+		}
+	}
+	r.write(ctx, params)
+	return resp, err
+}
+
+// write stores the event in the workspace of the caller. The session
+// middleware scopes only its own copy of the request, ctx is not scoped: the
+// row is written scoped to the workspace of the tracked identity, so the
+// policy checks it, and unauthenticated requests are stored without one. A
+// failed write is logged, it does not fail the request.
+func (r *Recorder) write(ctx context.Context, params query.CreateAuditEventParams) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if params.WorkspaceUUID.Valid {
+		ctx = workspace.WithUUID(ctx, uuid.UUID(params.WorkspaceUUID.Bytes))
+	} else {
+		ctx = workspace.Unscoped(ctx)
+	}
+	if err := query.New(r.dbp).CreateAuditEvent(ctx, params); err != nil {
+		r.log.Error("failed to write audit event",
+			"operation", params.OperationID, "actor", params.Actor, "outcome", params.Outcome, "error", err)
+	}
+}
+
+// resourceID returns the object the operation works on, if any.
+func resourceID(p middleware.Parameters) string {
+	for _, name := range []string{"uuid", "id"} {
+		if v, ok := p.Path(name); ok {
+			if s, ok := v.(string); ok {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+// encodeParams returns the set path and query parameters as a JSON object.
+// Headers and cookies are left out, they carry credentials.
+func encodeParams(p middleware.Parameters) []byte {
+	out := map[string]any{}
+	for key, value := range p {
+		if key.In != "path" && key.In != "query" {
+			continue
+		}
+		if v, ok := optValue(value); ok {
+			out[key.Name] = v
+		}
+	}
+	raw, err := json.Marshal(Redact(out))
+	if err != nil {
+		return []byte(`{}`)
+	}
+	return raw
+}
+
+// encodeBody returns the redacted request body, nil when there is none or it
+// is not JSON, e.g. a file upload.
+func encodeBody(body any) []byte {
+	if body == nil {
+		return nil
+	}
+	m, ok := body.(json.Marshaler)
+	if !ok {
+		return nil
+	}
+	raw, err := m.MarshalJSON()
+	if err != nil {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil
+	}
+	raw, err = json.Marshal(Redact(v))
+	if err != nil {
+		return nil
+	}
+	return raw
+}
+
+// optValue unwraps the optional parameter types of ogen, they are structs
+// with a Value and a Set field.
+func optValue(value any) (any, bool) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Struct {
+		return value, true
+	}
+	set, val := v.FieldByName("Set"), v.FieldByName("Value")
+	if !set.IsValid() || !val.IsValid() || set.Kind() != reflect.Bool {
+		return value, true
+	}
+	if !set.Bool() {
+		return nil, false
+	}
+	return val.Interface(), true
+}
+
+func statusCode(err error) int {
+	var sc interface{ StatusCode() int }
+	if errors.As(err, &sc) {
+		return sc.StatusCode()
+	}
+	return http.StatusInternalServerError
+}
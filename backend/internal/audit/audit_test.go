@@ -0,0 +1,95 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/ogen-go/ogen/middleware"
+
+	"github.com/shadowapi/shadowapi/backend/internal/db"
+	"github.com/shadowapi/shadowapi/backend/internal/session"
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// testPool connects to the database at SA_TEST_DB_URI, which has
+// db/schema.sql applied. The test is skipped without one.
+func testPool(t *testing.T) *pgxpool.Pool {
+	uri := os.Getenv("SA_TEST_DB_URI")
+	if uri == "" {
+		t.Skip("SA_TEST_DB_URI is not set")
+	}
+	pool, err := db.Connect(context.Background(), uri, slog.New(slog.DiscardHandler))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+// testWorkspace creates a workspace. Workspaces with audit events cannot be
+// deleted, the events are append-only.
+func testWorkspace(t *testing.T, pool *pgxpool.Pool) uuid.UUID {
+	id := uuid.Must(uuid.NewV7())
+	if _, err := pool.Exec(workspace.Unscoped(context.Background()),
+		"INSERT INTO workspace (uuid, name, slug) VALUES ($1, $2, $3)", id, "audit test", "audit-test-"+id.String(),
+	); err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+func TestRecordedEventIsListedInItsWorkspace(t *testing.T) {
+	pool := testPool(t)
+	own, other := testWorkspace(t, pool), testWorkspace(t, pool)
+	r := &Recorder{log: slog.New(slog.DiscardHandler), dbp: pool}
+
+	pipelineUUID := uuid.Must(uuid.NewV7()).String()
+	req := middleware.Request{
+		Context:     context.Background(),
+		OperationID: "pipeline-update",
+		Params:      middleware.Parameters{{Name: "uuid", In: "path"}: pipelineUUID},
+		Raw:         httptest.NewRequest("PUT", "/api/v1/pipeline/"+pipelineUUID, nil),
+	}
+	// the session middleware scopes its own copy of the request only
+	next := func(req middleware.Request) (middleware.Response, error) {
+		ctx := session.WithIdentity(req.Context, session.Identity{
+			ID:            uuid.Must(uuid.NewV7()).String(),
+			Source:        session.SourceSession,
+			WorkspaceUUID: own.String(),
+			WorkspaceRole: workspace.RoleOperator,
+		})
+		req.SetContext(workspace.WithUUID(ctx, own))
+		return middleware.Response{}, nil
+	}
+	if _, err := r.OgenMiddleware(req, next); err != nil {
+		t.Fatal(err)
+	}
+
+	list := func(ws uuid.UUID) []query.AuditEvent {
+		events, err := query.New(pool).GetAuditEvents(workspace.WithUUID(context.Background(), ws), query.GetAuditEventsParams{
+			ResourceID: pgtype.Text{String: pipelineUUID, Valid: true},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return events
+	}
+	events := list(own)
+	if len(events) != 1 {
+		t.Fatalf("listed %d events in the workspace of the caller, want 1", len(events))
+	}
+	if got := events[0]; got.OperationID != "pipeline-update" || got.Outcome != OutcomeSuccess ||
+		got.WorkspaceUUID == nil || *got.WorkspaceUUID != own {
+		t.Errorf("event = %+v", got)
+	}
+	if events := list(other); len(events) != 0 {
+		t.Errorf("another workspace lists %d events", len(events))
+	}
+}
@@ -0,0 +1,66 @@
+package audit
+
+import "strings"
+
+// Redacted replaces the values of sensitive fields.
+const Redacted = "[REDACTED]"
+
+// sensitive are substrings of field names whose values are never stored.
+var sensitive = []string{
+	"password",
+	"passphrase",
+	"secret",
+	"token",
+	"credential",
+	"private",
+	"api_key",
+	"apikey",
+	"access_key",
+	"authorization",
+	"cookie",
+	"phone_code",
+}
+
+// sensitiveExact are field names too short to match as substrings.
+var sensitiveExact = map[string]bool{
+	"key":  true,
+	"code": true,
+}
+
+// Redact returns a copy of the decoded JSON value with the values of
+// sensitive fields replaced, at any depth.
+func Redact(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, value := range v {
+			if IsSensitive(key) && value != nil {
+				out[key] = Redacted
+				continue
+			}
+			out[key] = Redact(value)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, value := range v {
+			out[i] = Redact(value)
+		}
+		return out
+	}
+	return v
+}
+
+// IsSensitive reports whether a field holds credentials.
+func IsSensitive(field string) bool {
+	field = strings.ToLower(field)
+	if sensitiveExact[field] {
+		return true
+	}
+	for _, s := range sensitive {
+		if strings.Contains(field, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -25,12 +25,43 @@ type Config struct {
 		Level string `json:"level" yaml:"level" env:"SA_LOG_LEVEL"`
 	} `json:"log" yaml:"log"`
 
+	// Roles run by serve: api, worker, scheduler, telegram and whatsapp, every
+	// role when empty. The --role flag of serve overrides them.
+	Roles []string `yaml:"roles" json:"roles" env:"SA_ROLES" envSeparator:","`
+
 	// Server configuration for local UI and API requests
 	Server struct {
 		Host string `yaml:"host" json:"host" env:"SA_HOST"`
 		Port int    `yaml:"port" json:"port" env:"SA_PORT"`
 	} `yaml:"server" json:"server"`
 
+	// Metrics serves the Prometheus metrics on a listener of its own
+	Metrics struct {
+		Host string `yaml:"host" json:"host" env:"SA_METRICS_HOST"`
+		// Port of the /metrics endpoint, 0 disables it
+		Port int `yaml:"port" json:"port" env:"SA_METRICS_PORT"`
+	} `yaml:"metrics" json:"metrics"`
+
+	// Shutdown of serve on SIGTERM or SIGINT
+	Shutdown struct {
+		// Timeout is how long the requests and the running jobs get to finish, as
+		// a Go duration (default 30s). Jobs still running then are queued again.
+		Timeout string `yaml:"timeout" json:"timeout" env:"SA_SHUTDOWN_TIMEOUT"`
+	} `yaml:"shutdown" json:"shutdown"`
+
+	// Tracing exports OpenTelemetry traces
+	Tracing struct {
+		// Exporter is "otlp", "stdout" for local use, or empty to disable tracing
+		Exporter string `yaml:"exporter" json:"exporter" env:"SA_TRACING_EXPORTER"`
+		// Endpoint is the URL of the OTLP/HTTP collector, e.g. "http://localhost:4318",
+		// the OTEL_EXPORTER_OTLP_* variables apply when empty
+		Endpoint string `yaml:"endpoint" json:"endpoint" env:"SA_TRACING_ENDPOINT"`
+		// ServiceName is the service.name of the spans (default shadowapi)
+		ServiceName string `yaml:"service_name" json:"service_name" env:"SA_TRACING_SERVICE_NAME"`
+		// SampleRatio is the share of the traces sampled, between 0 and 1 (default 1)
+		SampleRatio float64 `yaml:"sample_ratio" json:"sample_ratio" env:"SA_TRACING_SAMPLE_RATIO"`
+	} `yaml:"tracing" json:"tracing"`
+
 	// DB is a database configuration
 	DB struct {
 		URI string `yaml:"uri,omitempty" json:"uri,omitempty" env:"SA_DB_URI"`
@@ -64,6 +95,18 @@ type Config struct {
 		// BearerToken is used to validate incoming requests that carry an Authorization header.
 		BearerToken string `yaml:"bearer_token" json:"bearer_token" env:"SA_AUTH_BEARER_TOKEN"`
 
+		// TrustProxyHeaders takes the client IP checked against API key allowlists from
+		// X-Forwarded-For or X-Real-IP. Enable only behind a proxy that sets them.
+		TrustProxyHeaders bool `yaml:"trust_proxy_headers" json:"trust_proxy_headers" env:"SA_AUTH_TRUST_PROXY_HEADERS"`
+
+		// Session lifetime of browser logins, as Go durations such as "30m" or "720h"
+		Session struct {
+			// IdleTimeout ends a session not used for this long, each use extends it (default 24h)
+			IdleTimeout string `yaml:"idle_timeout" json:"idle_timeout" env:"SA_AUTH_SESSION_IDLE_TIMEOUT"`
+			// AbsoluteTimeout ends a session this long after login regardless of use (default 720h)
+			AbsoluteTimeout string `yaml:"absolute_timeout" json:"absolute_timeout" env:"SA_AUTH_SESSION_ABSOLUTE_TIMEOUT"`
+		} `yaml:"session" json:"session"`
+
 		// Zitadel configuration for OAuth2 authentication
 		Zitadel struct {
 			InstanceURL string `json:"instance_url" yaml:"instance_url" env:"SA_ZITADEL_INSTANCE_URL"`
@@ -90,6 +133,16 @@ type Config struct {
 		} `json:"zitadel" yaml:"zitadel"`
 	} `yaml:"auth" json:"auth"`
 
+	// Secrets configures envelope encryption of credentials stored in the database
+	Secrets struct {
+		// MasterKey is the base64 encoded 32 byte key wrapping new data keys
+		MasterKey string `yaml:"master_key,omitempty" json:"master_key,omitempty" env:"SA_SECRETS_MASTER_KEY"`
+		// PreviousKeys are retired master keys still accepted for reading, drop them after `secrets rotate`
+		PreviousKeys []string `yaml:"previous_keys,omitempty" json:"previous_keys,omitempty" env:"SA_SECRETS_PREVIOUS_KEYS" envSeparator:","`
+		// KeyFile is a keyring file with a primary key id and keys by id, it takes precedence over MasterKey
+		KeyFile string `yaml:"key_file,omitempty" json:"key_file,omitempty" env:"SA_SECRETS_KEY_FILE"`
+	} `yaml:"secrets" json:"secrets"`
+
 	// Worker settings
 	Worker struct {
 		// MaxCount is the maximum number of workers that can be started
@@ -104,6 +157,35 @@ type Config struct {
 		Password string `yaml:"password" json:"password" env:"SA_QUEUE_PASSWORD"`
 	} `yaml:"queue" json:"queue"`
 
+	// Webhooks settings of the event deliveries
+	Webhooks struct {
+		// AllowPrivate lets webhooks target loopback, private and link-local
+		// addresses, for local setups only
+		AllowPrivate bool `yaml:"allow_private" json:"allow_private" env:"SA_WEBHOOKS_ALLOW_PRIVATE"`
+	} `yaml:"webhooks" json:"webhooks"`
+
+	// Embeddings index the messages of the pipelines with an embedder node for
+	// semantic search
+	Embeddings struct {
+		// Provider is "openai" for an OpenAI compatible endpoint, "hash" for the
+		// local hashing embedder meant for tests, or empty to disable semantic search
+		Provider string `yaml:"provider" json:"provider" env:"SA_EMBEDDINGS_PROVIDER"`
+		// URL is the base URL of the endpoint (default https://api.openai.com/v1)
+		URL string `yaml:"url" json:"url" env:"SA_EMBEDDINGS_URL"`
+		// Model requested from the endpoint (default text-embedding-3-small)
+		Model  string `yaml:"model" json:"model" env:"SA_EMBEDDINGS_MODEL"`
+		APIKey string `yaml:"api_key,omitempty" json:"api_key,omitempty" env:"SA_EMBEDDINGS_API_KEY"`
+		// Dimensions is requested from the endpoint when set, and is the size of the
+		// hashing vectors (default 256)
+		Dimensions int `yaml:"dimensions" json:"dimensions" env:"SA_EMBEDDINGS_DIMENSIONS"`
+		// BatchSize is the number of chunks embedded per request (default 64)
+		BatchSize int `yaml:"batch_size" json:"batch_size" env:"SA_EMBEDDINGS_BATCH_SIZE"`
+		// ChunkSize and ChunkOverlap, in words, cut the texts into chunks (default
+		// 200 and 40), the embedder node of a pipeline can override them
+		ChunkSize    int `yaml:"chunk_size" json:"chunk_size" env:"SA_EMBEDDINGS_CHUNK_SIZE"`
+		ChunkOverlap int `yaml:"chunk_overlap" json:"chunk_overlap" env:"SA_EMBEDDINGS_CHUNK_OVERLAP"`
+	} `yaml:"embeddings" json:"embeddings"`
+
 	// Add cfg.Telegram.AppID, cfg.Telegram.AppHash
 	Telegram struct {
 		AppHash string `yaml:"app_hash" json:"app_hash" env:"TG_APP_HASH"`
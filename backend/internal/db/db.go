@@ -23,18 +23,27 @@ func Provide(i do.Injector) (*pgxpool.Pool, error) {
 		return nil, fmt.Errorf("failed to connect to database: database URI is empty")
 	}
 
-	cfg, err := pgxpool.ParseConfig(conf.DB.URI)
+	slog.Debug("connecting to database", "uri", conf.DB.URI)
+	return Connect(ctx, conf.DB.URI, log)
+}
+
+// Connect opens the connection pool. Connections are scoped to the workspace
+// of the context they are acquired with, see scopeConn, so the pool fails
+// when the database lacks the tenant role or its policies.
+func Connect(ctx context.Context, uri string, log *slog.Logger) (*pgxpool.Pool, error) {
+	cfg, err := pgxpool.ParseConfig(uri)
 	if err != nil {
 		log.Error("parse config", "error", err)
 		return nil, err
 	}
 
-	cfg.ConnConfig.Tracer = &tracelog.TraceLog{
+	cfg.ConnConfig.Tracer = &Tracer{TraceLog: &tracelog.TraceLog{
 		Logger:   newLogger(log),
 		LogLevel: tracelog.LogLevelDebug,
-	}
+	}}
+
+	cfg.BeforeAcquire = scopeConn(log)
 
-	slog.Debug("connecting to database", "uri", conf.DB.URI)
 	dbpool, err := pgxpool.NewWithConfig(ctx, cfg)
 	if err != nil {
 		log.Error("unable to create connection pool", "error", err)
@@ -43,8 +52,15 @@ func Provide(i do.Injector) (*pgxpool.Pool, error) {
 
 	if err := dbpool.Ping(ctx); err != nil {
 		log.Error("failed to ping database", "error", err)
+		dbpool.Close()
 		return nil, fmt.Errorf("failed to ping database: %v", err)
 	}
 
+	if err := checkTenantRole(ctx, dbpool); err != nil {
+		log.Error("workspace isolation is not set up", "error", err)
+		dbpool.Close()
+		return nil, err
+	}
+
 	return dbpool, nil
 }
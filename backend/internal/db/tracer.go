@@ -0,0 +1,58 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/tracelog"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/shadowapi/shadowapi/backend/internal/telemetry"
+)
+
+// Tracer logs the queries through the tracelog.TraceLog and records them as
+// spans of the trace they run in. Queries outside of a trace, such as the
+// polling of the schedulers, are only logged.
+type Tracer struct {
+	*tracelog.TraceLog
+}
+
+type querySpanKey struct{}
+
+func (t *Tracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	if trace.SpanContextFromContext(ctx).IsValid() {
+		var span trace.Span
+		ctx, span = telemetry.Start(ctx, queryName(data.SQL),
+			trace.WithSpanKind(trace.SpanKindClient),
+			telemetry.Attrs(semconv.DBSystemPostgreSQL, semconv.DBQueryText(data.SQL)),
+		)
+		ctx = context.WithValue(ctx, querySpanKey{}, span)
+	}
+	return t.TraceLog.TraceQueryStart(ctx, conn, data)
+}
+
+func (t *Tracer) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
+	t.TraceLog.TraceQueryEnd(ctx, conn, data)
+	if span, ok := ctx.Value(querySpanKey{}).(trace.Span); ok {
+		err := data.Err
+		if errors.Is(err, pgx.ErrNoRows) {
+			// not finding a row is an answer, not a failure
+			err = nil
+		}
+		telemetry.End(span, err)
+	}
+}
+
+// queryName names the span after the sqlc query, "-- name: GetUser :one".
+func queryName(sql string) string {
+	const prefix = "-- name: "
+	if rest, ok := strings.CutPrefix(sql, prefix); ok {
+		if name, _, ok := strings.Cut(rest, " "); ok {
+			return "db " + name
+		}
+	}
+	return "db query"
+}
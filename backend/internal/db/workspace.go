@@ -0,0 +1,76 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
+)
+
+// TenantRole is the database role workspace scoped connections switch to.
+// Row level security policies on tenant tables apply to this role only.
+const TenantRole = "shadowapi_tenant"
+
+// scopeKey remembers the scope applied to a connection in its custom data,
+// so the common case of reusing a connection with the same scope costs no
+// extra round trip.
+const scopeKey = "workspace_scope"
+
+// checkTenantRole fails unless the login role may switch to TenantRole and
+// the tables have their workspace_isolation policies. Without the role
+// scopeConn could not scope any connection: every one would be destroyed and
+// the pool would keep acquiring new ones until the context ends.
+func checkTenantRole(ctx context.Context, pool *pgxpool.Pool) error {
+	var exists, member, policies bool
+	err := pool.QueryRow(ctx, `SELECT
+		EXISTS (SELECT 1 FROM pg_roles WHERE rolname = $1),
+		COALESCE((SELECT pg_has_role(current_user, oid, 'MEMBER') FROM pg_roles WHERE rolname = $1), false),
+		EXISTS (SELECT 1 FROM pg_policies WHERE policyname = 'workspace_isolation')`,
+		TenantRole,
+	).Scan(&exists, &member, &policies)
+	switch {
+	case err != nil:
+		return fmt.Errorf("check role %s: %w", TenantRole, err)
+	case !exists:
+		return fmt.Errorf("role %s does not exist, apply db/schema.sql", TenantRole)
+	case !member:
+		return fmt.Errorf("the login role may not switch to %s, apply db/schema.sql", TenantRole)
+	case !policies:
+		return fmt.Errorf("no workspace_isolation policies, apply db/schema.sql")
+	}
+	return nil
+}
+
+// scopeConn is the pool BeforeAcquire hook. A connection acquired with a
+// workspace scoped context runs as TenantRole with app.workspace_uuid set,
+// any other connection is reset to the login role.
+func scopeConn(log *slog.Logger) func(context.Context, *pgx.Conn) bool {
+	return func(ctx context.Context, conn *pgx.Conn) bool {
+		want := ""
+		if id, ok := workspace.FromContext(ctx); ok {
+			want = id.String()
+		}
+		data := conn.PgConn().CustomData()
+		if cur, _ := data[scopeKey].(string); cur == want {
+			return true
+		}
+		role := "none"
+		if want != "" {
+			role = TenantRole
+		}
+		if _, err := conn.Exec(ctx,
+			"SELECT set_config('role', $1, false), set_config('app.workspace_uuid', $2, false)",
+			role, want,
+		); err != nil {
+			// the connection is destroyed, never handed out with a stale scope
+			log.Error("failed to apply workspace scope", "workspace_uuid", want, "error", err)
+			return false
+		}
+		data[scopeKey] = want
+		return true
+	}
+}
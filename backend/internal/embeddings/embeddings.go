@@ -0,0 +1,84 @@
+// Package embeddings indexes the messages for semantic search.
+//
+// The embedder node of a pipeline cuts the body and the text attachments of
+// every stored message into chunks, embeds them with the configured provider
+// and stores the vectors with pgvector. Searches rank the chunks nearest to
+// the query and those sharing its terms with a hybrid of their cosine
+// similarity and BM25, see Index.Search.
+package embeddings
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shadowapi/shadowapi/backend/internal/config"
+)
+
+// Providers of embeddings
+const (
+	ProviderOpenAI = "openai"
+	ProviderHash   = "hash"
+)
+
+// Defaults of the configuration
+const (
+	DefaultURL          = "https://api.openai.com/v1"
+	DefaultModel        = "text-embedding-3-small"
+	DefaultDimensions   = 256
+	DefaultBatchSize    = 64
+	DefaultChunkSize    = 200
+	DefaultChunkOverlap = 40
+)
+
+// Embedder turns texts into vectors.
+type Embedder interface {
+	// Embed returns the vectors of the texts, in order
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+	// Model names the vectors, vectors of different models are never compared
+	Model() string
+}
+
+// New returns the embedder of the configuration, nil when semantic search
+// is disabled.
+func New(cfg *config.Config) (Embedder, error) {
+	c := cfg.Embeddings
+	switch c.Provider {
+	case "":
+		return nil, nil
+	case ProviderHash:
+		dims := c.Dimensions
+		if dims <= 0 {
+			dims = DefaultDimensions
+		}
+		return NewHash(dims), nil
+	case ProviderOpenAI:
+		if c.Dimensions < 0 {
+			return nil, fmt.Errorf("embeddings dimensions must not be negative")
+		}
+		url, model := c.URL, c.Model
+		if url == "" {
+			url = DefaultURL
+		}
+		if model == "" {
+			model = DefaultModel
+		}
+		return NewOpenAI(url, model, c.APIKey, c.Dimensions), nil
+	}
+	return nil, fmt.Errorf("unknown embeddings provider %q", c.Provider)
+}
+
+// formatVector returns the text form of a pgvector value.
+func formatVector(v []float32) string {
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, x := range v {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.FormatFloat(float64(x), 'g', -1, 32))
+	}
+	b.WriteByte(']')
+	return b.String()
+}
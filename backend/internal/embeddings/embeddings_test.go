@@ -0,0 +1,139 @@
+package embeddings
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+)
+
+func TestChunk(t *testing.T) {
+	words := strings.Fields("a b c d e f g h i j")
+	var got []string
+	for _, c := range Chunk(words, 4, 1) {
+		got = append(got, strings.Join(c, ""))
+	}
+	if want := []string{"abcd", "defg", "ghij"}; !slices.Equal(got, want) {
+		t.Errorf("chunks = %v, want %v", got, want)
+	}
+	if n := len(Chunk(words, 20, 5)); n != 1 {
+		t.Errorf("short text cut in %d chunks", n)
+	}
+	// an overlap as large as the chunk would never advance
+	if n := len(Chunk(words, 2, 2)); n != 5 {
+		t.Errorf("chunks with a full overlap = %d", n)
+	}
+}
+
+func TestSources(t *testing.T) {
+	note := base64.StdEncoding.EncodeToString([]byte("quarterly <b>numbers</b>"))
+	msg := &api.Message{
+		Subject: api.NewOptString("Invoice"),
+		Body:    "<html><body><p>Please pay&nbsp;the invoice</p><script>x()</script></body></html>",
+		Attachments: []api.FileObject{
+			{UUID: api.NewOptString("0198c1b0-0000-7000-8000-000000000001"), Name: "notes.html", MimeType: api.NewOptString("text/html; charset=utf-8"), Data: api.NewOptString(note)},
+			{Name: "scan.pdf", MimeType: api.NewOptString("application/pdf"), Data: api.NewOptString(note)},
+		},
+	}
+	sources := messageSources(msg)
+	if len(sources) != 2 {
+		t.Fatalf("sources = %+v", sources)
+	}
+	if got := strings.Join(Terms(sources[0].text), " "); got != "invoice please pay the invoice" {
+		t.Errorf("body terms = %q", got)
+	}
+	if sources[1].fileUUID == nil || strings.Join(Terms(sources[1].text), " ") != "notes html quarterly numbers" {
+		t.Errorf("attachment source = %+v", sources[1])
+	}
+}
+
+func cosine(a, b []float32) float64 {
+	var dot float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+	}
+	return dot
+}
+
+func TestHash(t *testing.T) {
+	e := NewHash(64)
+	v, _ := e.Embed(context.Background(), []string{"the invoice is due", "invoice due today", "team offsite in June"})
+	again, _ := e.Embed(context.Background(), []string{"the invoice is due"})
+	if !slices.Equal(v[0], again[0]) {
+		t.Error("hashing is not deterministic")
+	}
+	if n := cosine(v[0], v[0]); math.Abs(n-1) > 1e-5 {
+		t.Errorf("vector norm = %f", n)
+	}
+	if cosine(v[0], v[1]) <= cosine(v[0], v[2]) {
+		t.Error("texts sharing terms are not closer")
+	}
+	if e.Model() != "hash-64" {
+		t.Errorf("model = %q", e.Model())
+	}
+}
+
+func TestOpenAI(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req embeddingRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if r.URL.Path != "/v1/embeddings" || r.Header.Get("Authorization") != "Bearer key" || req.Dimensions != 3 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"message":"bad request"}}`))
+			return
+		}
+		// answered out of order, the index puts them back
+		w.Write([]byte(`{"data":[{"index":1,"embedding":[0,1,0]},{"index":0,"embedding":[1,0,0]}]}`))
+	}))
+	defer srv.Close()
+
+	e := NewOpenAI(srv.URL+"/v1/", "small", "key", 3)
+	v, err := e.Embed(context.Background(), []string{"a", "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v[0][0] != 1 || v[1][1] != 1 {
+		t.Errorf("vectors = %v", v)
+	}
+	if _, err := NewOpenAI(srv.URL+"/v1", "small", "nope", 3).Embed(context.Background(), []string{"a"}); err == nil || !strings.Contains(err.Error(), "bad request") {
+		t.Errorf("error = %v", err)
+	}
+	if got := formatVector(v[1]); got != "[0,1,0]" {
+		t.Errorf("vector text = %s", got)
+	}
+}
+
+func TestRank(t *testing.T) {
+	a, b, c := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+	corpus := corpus{chunks: 10, avgLength: 4, df: map[string]int64{"invoice": 2, "overdue": 1}}
+	hits := rank([]candidate{
+		// close in meaning, no shared words
+		{messageUUID: a, content: "payment reminder bill", length: 3, similarity: 0.8},
+		// both the words and the meaning
+		{messageUUID: b, content: "overdue invoice reminder", length: 3, similarity: 0.7},
+		{messageUUID: b, content: "kind regards", length: 2, similarity: 0.1},
+		// the words only
+		{messageUUID: c, content: "invoice attached for the lunch", length: 5, similarity: 0.2},
+	}, []string{"invoice", "overdue"}, corpus)
+
+	var order []uuid.UUID
+	for _, h := range hits {
+		order = append(order, h.MessageUUID)
+	}
+	// a close meaning outranks a single common word
+	if !slices.Equal(order, []uuid.UUID{b, a, c}) {
+		t.Fatalf("order = %v, want %v", order, []uuid.UUID{b, a, c})
+	}
+	if hits[0].Chunk != "overdue invoice reminder" || hits[0].Score > 1 {
+		t.Errorf("best hit = %+v", hits[0])
+	}
+}
@@ -0,0 +1,62 @@
+package embeddings
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+)
+
+// Hash is a deterministic embedder hashing the terms of a text into a fixed
+// number of dimensions. Texts sharing terms end up close, which is enough to
+// exercise the index and the ranking without a model server.
+type Hash struct {
+	dims int
+}
+
+// NewHash returns a hashing embedder of dims dimensions.
+func NewHash(dims int) *Hash {
+	return &Hash{dims: dims}
+}
+
+// Model implements Embedder
+func (e *Hash) Model() string {
+	return fmt.Sprintf("hash-%d", e.dims)
+}
+
+// Embed implements Embedder
+func (e *Hash) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		v := make([]float32, e.dims)
+		for _, term := range Terms(text) {
+			h := fnv.New64a()
+			h.Write([]byte(term))
+			sum := h.Sum64()
+			// the top bit picks the sign, so collisions cancel out on average
+			if sum>>63 == 1 {
+				v[sum%uint64(e.dims)]--
+			} else {
+				v[sum%uint64(e.dims)]++
+			}
+		}
+		normalize(v)
+		vectors[i] = v
+	}
+	return vectors, nil
+}
+
+// normalize scales v to unit length, the zero vector is left alone.
+func normalize(v []float32) {
+	var sum float64
+	for _, x := range v {
+		sum += float64(x) * float64(x)
+	}
+	if sum == 0 {
+		return
+	}
+	norm := float32(math.Sqrt(sum))
+	for i := range v {
+		v[i] /= norm
+	}
+}
@@ -0,0 +1,258 @@
+package embeddings
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/do/v2"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/shadowapi/shadowapi/backend/internal/config"
+	"github.com/shadowapi/shadowapi/backend/internal/db"
+	"github.com/shadowapi/shadowapi/backend/internal/telemetry"
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// ChunkOptions cut the texts of a message into chunks, in words.
+type ChunkOptions struct {
+	Size    int
+	Overlap int
+}
+
+// Index stores and searches the chunks of the messages.
+type Index struct {
+	log       *slog.Logger
+	dbp       *pgxpool.Pool
+	embedder  Embedder
+	batchSize int
+	chunking  ChunkOptions
+}
+
+// NewIndex returns an index embedding with embedder, a nil embedder disables
+// it.
+func NewIndex(log *slog.Logger, dbp *pgxpool.Pool, embedder Embedder, batchSize int, chunking ChunkOptions) *Index {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	if chunking.Size <= 0 {
+		chunking.Size = DefaultChunkSize
+	}
+	if chunking.Overlap <= 0 {
+		chunking.Overlap = DefaultChunkOverlap
+	}
+	return &Index{log: log, dbp: dbp, embedder: embedder, batchSize: batchSize, chunking: chunking}
+}
+
+// Enabled reports whether an embedding provider is configured.
+func (x *Index) Enabled() bool {
+	return x != nil && x.embedder != nil
+}
+
+// Chunking returns the default chunk options.
+func (x *Index) Chunking() ChunkOptions {
+	return x.chunking
+}
+
+// IndexMessage replaces the chunks of a stored message. Zero options fall
+// back to the configured ones.
+func (x *Index) IndexMessage(ctx context.Context, msg *api.Message, opts ChunkOptions) (err error) {
+	if !x.Enabled() {
+		return nil
+	}
+	messageUUID, err := uuid.FromString(msg.UUID.Or(""))
+	if err != nil {
+		return fmt.Errorf("invalid message UUID %q", msg.UUID.Or(""))
+	}
+	if opts.Size <= 0 {
+		opts.Size = x.chunking.Size
+	}
+	if opts.Overlap <= 0 {
+		opts.Overlap = x.chunking.Overlap
+	}
+
+	ctx, span := telemetry.Start(ctx, "embeddings.index_message", telemetry.Attrs(
+		attribute.String("message.uuid", messageUUID.String()),
+		attribute.String("embeddings.model", x.embedder.Model()),
+	))
+	defer func() { telemetry.End(span, err) }()
+
+	var chunks []query.CreateMessageChunkParams
+	for _, src := range messageSources(msg) {
+		for seq, words := range Chunk(strings.Fields(src.text), opts.Size, opts.Overlap) {
+			content := strings.Join(words, " ")
+			terms := Terms(content)
+			if len(terms) == 0 {
+				continue
+			}
+			chunks = append(chunks, query.CreateMessageChunkParams{
+				UUID:        uuid.Must(uuid.NewV7()),
+				MessageUuid: messageUUID,
+				FileUuid:    src.fileUUID,
+				Seq:         int32(seq),
+				Content:     content,
+				Terms:       unique(terms),
+				Length:      int32(len(terms)),
+				Model:       x.embedder.Model(),
+			})
+		}
+	}
+	span.SetAttributes(attribute.Int("embeddings.chunks", len(chunks)))
+
+	for batch := range slices.Chunk(chunks, x.batchSize) {
+		texts := make([]string, len(batch))
+		for i, c := range batch {
+			texts[i] = c.Content
+		}
+		vectors, err := x.embedder.Embed(ctx, texts)
+		if err != nil {
+			return fmt.Errorf("embed message chunks: %w", err)
+		}
+		for i := range batch {
+			batch[i].Embedding = formatVector(vectors[i])
+		}
+	}
+
+	_, err = db.InTx(ctx, x.dbp, func(tx pgx.Tx) (any, error) {
+		q := query.New(x.dbp).WithTx(tx)
+		if err := q.DeleteMessageChunks(ctx, &messageUUID); err != nil {
+			return nil, err
+		}
+		for _, c := range chunks {
+			n, err := q.CreateMessageChunk(ctx, c)
+			if err != nil {
+				return nil, err
+			}
+			if n == 0 {
+				return nil, fmt.Errorf("message %s is not stored", messageUUID)
+			}
+		}
+		return nil, nil
+	})
+	return err
+}
+
+// Hit is a message found by a search, with its best chunk.
+type Hit struct {
+	MessageUUID uuid.UUID
+	Score       float64
+	Chunk       string
+}
+
+// Search returns up to limit messages matching text, best first. The
+// filter restricts the messages searched, its search fields are set by the
+// index.
+func (x *Index) Search(ctx context.Context, text string, limit int, filter query.SearchMessageChunksParams) (hits []Hit, err error) {
+	if !x.Enabled() {
+		return nil, ErrDisabled
+	}
+	terms := unique(Terms(text))
+	if len(terms) == 0 {
+		return nil, ErrEmptyQuery
+	}
+	ctx, span := telemetry.Start(ctx, "embeddings.search", telemetry.Attrs(
+		attribute.String("embeddings.model", x.embedder.Model()),
+	))
+	defer func() { telemetry.End(span, err) }()
+
+	vectors, err := x.embedder.Embed(ctx, []string{text})
+	if err != nil {
+		return nil, fmt.Errorf("embed query: %w", err)
+	}
+
+	q := query.New(x.dbp)
+	filter.Embedding = formatVector(vectors[0])
+	filter.Model = x.embedder.Model()
+	filter.Dims = int32(len(vectors[0]))
+	filter.Candidates = int32(min(max(limit*4, 50), 500))
+	filter.Terms = terms
+	rows, err := q.SearchMessageChunks(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	stats, err := q.GetMessageChunkStats(ctx, filter.Model)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := q.GetMessageChunkTermCounts(ctx, query.GetMessageChunkTermCountsParams{Model: filter.Model, Terms: terms})
+	if err != nil {
+		return nil, err
+	}
+	df := make(map[string]int64, len(counts))
+	for _, c := range counts {
+		df[c.Term] = c.Chunks
+	}
+
+	candidates := make([]candidate, 0, len(rows))
+	for _, r := range rows {
+		if r.MessageUuid == nil {
+			continue
+		}
+		candidates = append(candidates, candidate{
+			messageUUID: *r.MessageUuid,
+			content:     r.Content,
+			length:      int(r.Length),
+			similarity:  r.Similarity,
+		})
+	}
+	hits = rank(candidates, terms, corpus{chunks: stats.Chunks, avgLength: stats.AvgLength, df: df})
+	span.SetAttributes(attribute.Int("embeddings.candidates", len(rows)), attribute.Int("embeddings.hits", len(hits)))
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits, nil
+}
+
+func unique(terms []string) []string {
+	out := slices.Clone(terms)
+	slices.Sort(out)
+	return slices.Compact(out)
+}
+
+var (
+	defaultMu    sync.RWMutex
+	defaultIndex = &Index{}
+)
+
+// Default returns the process wide index used by the pipelines.
+func Default() *Index {
+	defaultMu.RLock()
+	defer defaultMu.RUnlock()
+	return defaultIndex
+}
+
+// SetDefault replaces the process wide index.
+func SetDefault(x *Index) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultIndex = x
+}
+
+// Provide the index for the dependency injector and install it as default
+func Provide(i do.Injector) (*Index, error) {
+	cfg := do.MustInvoke[*config.Config](i)
+	log := do.MustInvoke[*slog.Logger](i)
+	embedder, err := New(cfg)
+	if err != nil {
+		return nil, err
+	}
+	x := NewIndex(log, do.MustInvoke[*pgxpool.Pool](i), embedder, cfg.Embeddings.BatchSize, ChunkOptions{
+		Size:    cfg.Embeddings.ChunkSize,
+		Overlap: cfg.Embeddings.ChunkOverlap,
+	})
+	if x.Enabled() {
+		log.Info("semantic search enabled", "provider", cfg.Embeddings.Provider, "model", embedder.Model())
+	}
+	SetDefault(x)
+	return x, nil
+}
@@ -0,0 +1,131 @@
+package embeddings
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"github.com/shadowapi/shadowapi/backend/internal/metrics"
+)
+
+// OpenAI embeds with an OpenAI compatible /embeddings endpoint, which most
+// hosted and local model servers implement.
+type OpenAI struct {
+	url        string
+	model      string
+	apiKey     string
+	dimensions int
+	client     *http.Client
+}
+
+// NewOpenAI returns an embedder posting to url/embeddings. dimensions is
+// requested from the endpoint when positive, the model default otherwise.
+func NewOpenAI(url, model, apiKey string, dimensions int) *OpenAI {
+	transport := otelhttp.NewTransport(metrics.ProviderTransport("embeddings", nil),
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return "embeddings " + r.Method + " " + r.URL.Path
+		}),
+	)
+	return &OpenAI{
+		url:        strings.TrimSuffix(url, "/"),
+		model:      model,
+		apiKey:     apiKey,
+		dimensions: dimensions,
+		client:     &http.Client{Transport: transport, Timeout: time.Minute},
+	}
+}
+
+// Model returns the model of the endpoint, with the dimensions when they are
+// requested as they change the vectors.
+func (e *OpenAI) Model() string {
+	if e.dimensions > 0 {
+		return fmt.Sprintf("%s-%d", e.model, e.dimensions)
+	}
+	return e.model
+}
+
+type embeddingRequest struct {
+	Model          string   `json:"model"`
+	Input          []string `json:"input"`
+	Dimensions     int      `json:"dimensions,omitempty"`
+	EncodingFormat string   `json:"encoding_format"`
+}
+
+type embeddingResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Embed implements Embedder
+func (e *OpenAI) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+	body, err := json.Marshal(embeddingRequest{
+		Model:          e.model,
+		Input:          texts,
+		Dimensions:     e.dimensions,
+		EncodingFormat: "float",
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url+"/embeddings", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if e.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+e.apiKey)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var out embeddingResponse
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 64<<20))
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		if resp.StatusCode >= http.StatusBadRequest {
+			return nil, fmt.Errorf("embeddings endpoint returned %s", resp.Status)
+		}
+		return nil, fmt.Errorf("decode embeddings: %w", err)
+	}
+	if resp.StatusCode >= http.StatusBadRequest || out.Error != nil {
+		msg := resp.Status
+		if out.Error != nil {
+			msg = out.Error.Message
+		}
+		return nil, fmt.Errorf("embeddings endpoint: %s", msg)
+	}
+
+	vectors := make([][]float32, len(texts))
+	for _, d := range out.Data {
+		if d.Index < 0 || d.Index >= len(texts) {
+			return nil, fmt.Errorf("embeddings endpoint returned index %d for %d inputs", d.Index, len(texts))
+		}
+		vectors[d.Index] = d.Embedding
+	}
+	for i, v := range vectors {
+		if len(v) == 0 {
+			return nil, fmt.Errorf("embeddings endpoint returned no vector for input %d", i)
+		}
+	}
+	return vectors, nil
+}
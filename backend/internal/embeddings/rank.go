@@ -0,0 +1,104 @@
+package embeddings
+
+import (
+	"cmp"
+	"errors"
+	"math"
+	"slices"
+
+	"github.com/gofrs/uuid"
+)
+
+var (
+	// ErrDisabled is returned by searches when no provider is configured
+	ErrDisabled = errors.New("semantic search is disabled, no embeddings provider is configured")
+	// ErrEmptyQuery is returned by searches for a text without terms
+	ErrEmptyQuery = errors.New("semantic search needs a query with words")
+)
+
+// BM25 parameters, the usual ones
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// semanticWeight is the share of the cosine similarity in the hybrid score,
+// BM25 takes the rest
+const semanticWeight = 0.5
+
+// candidate is a chunk found by a search.
+type candidate struct {
+	messageUUID uuid.UUID
+	content     string
+	length      int
+	similarity  float64
+}
+
+// corpus holds the statistics of the chunks BM25 needs.
+type corpus struct {
+	chunks    int64
+	avgLength float64
+	// df is the number of chunks holding each term
+	df map[string]int64
+}
+
+// rank scores the candidates with a hybrid of their cosine similarity and
+// BM25 and returns the messages, best first, with their best chunk. BM25 is
+// scaled by the best BM25 of the candidates so both parts range from 0 to 1.
+func rank(candidates []candidate, terms []string, c corpus) []Hit {
+	keyword := make([]float64, len(candidates))
+	var best float64
+	for i, cand := range candidates {
+		keyword[i] = bm25(cand, terms, c)
+		best = max(best, keyword[i])
+	}
+
+	byMessage := make(map[uuid.UUID]int)
+	var hits []Hit
+	for i, cand := range candidates {
+		score := semanticWeight * min(max(cand.similarity, 0), 1)
+		if best > 0 {
+			score += (1 - semanticWeight) * keyword[i] / best
+		}
+		if j, ok := byMessage[cand.messageUUID]; ok {
+			if score > hits[j].Score {
+				hits[j].Score, hits[j].Chunk = score, cand.content
+			}
+			continue
+		}
+		byMessage[cand.messageUUID] = len(hits)
+		hits = append(hits, Hit{MessageUUID: cand.messageUUID, Score: score, Chunk: cand.content})
+	}
+	slices.SortStableFunc(hits, func(a, b Hit) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+	return hits
+}
+
+// bm25 scores a chunk for the terms of a query.
+func bm25(cand candidate, terms []string, c corpus) float64 {
+	if c.chunks == 0 || c.avgLength == 0 {
+		return 0
+	}
+	tf := make(map[string]int, len(terms))
+	for _, t := range terms {
+		tf[t] = 0
+	}
+	for _, t := range Terms(cand.content) {
+		if _, ok := tf[t]; ok {
+			tf[t]++
+		}
+	}
+	length := float64(cand.length)
+	var score float64
+	for _, t := range terms {
+		f := float64(tf[t])
+		if f == 0 {
+			continue
+		}
+		n := float64(c.df[t])
+		idf := math.Log(1 + (float64(c.chunks)-n+0.5)/(n+0.5))
+		score += idf * f * (bm25K1 + 1) / (f + bm25K1*(1-bm25B+bm25B*length/c.avgLength))
+	}
+	return score
+}
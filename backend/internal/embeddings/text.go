@@ -0,0 +1,134 @@
+package embeddings
+
+import (
+	"encoding/base64"
+	"html"
+	"mime"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+)
+
+// maxAttachmentSize bounds the attachments whose text is indexed
+const maxAttachmentSize = 1 << 20
+
+// Terms returns the lowercased words of a text, single characters left out.
+func Terms(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	terms := words[:0]
+	for _, w := range words {
+		if len([]rune(w)) > 1 {
+			terms = append(terms, w)
+		}
+	}
+	return terms
+}
+
+// Chunk cuts words into chunks of size words, consecutive chunks sharing
+// overlap words so a passage split in two is still found whole in one.
+func Chunk(words []string, size, overlap int) [][]string {
+	if size <= 0 {
+		size = DefaultChunkSize
+	}
+	if overlap < 0 || overlap >= size {
+		overlap = 0
+	}
+	var chunks [][]string
+	for start := 0; start < len(words); start += size - overlap {
+		end := min(start+size, len(words))
+		chunks = append(chunks, words[start:end])
+		if end == len(words) {
+			break
+		}
+	}
+	return chunks
+}
+
+// source is a text of a message, the body or an attachment.
+type source struct {
+	fileUUID *uuid.UUID
+	text     string
+}
+
+var (
+	tagRe   = regexp.MustCompile(`(?is)<(script|style)\b.*?</(script|style)>|<[^>]*>`)
+	blockRe = regexp.MustCompile(`(?i)<(br|p|div|li|tr|h[1-6])\b`)
+)
+
+// stripHTML returns the text of an HTML document.
+func stripHTML(s string) string {
+	s = blockRe.ReplaceAllString(s, "\n$0")
+	return html.UnescapeString(tagRe.ReplaceAllString(s, " "))
+}
+
+// looksLikeHTML reports whether the body is an HTML document or fragment.
+func looksLikeHTML(s string) bool {
+	head := strings.ToLower(strings.TrimSpace(s))
+	if len(head) > 512 {
+		head = head[:512]
+	}
+	return strings.HasPrefix(head, "<!doctype html") || strings.Contains(head, "<html") ||
+		strings.Contains(head, "<body") || strings.Contains(head, "<div") || strings.Contains(head, "<p>")
+}
+
+// messageSources returns the texts of the message to index: the subject with
+// the body, then the text attachments carried inline. Other attachments have
+// no text to extract and are skipped.
+func messageSources(msg *api.Message) []source {
+	body := msg.Body
+	if parsed, ok := msg.BodyParsed.Get(); ok && strings.TrimSpace(parsed.BodyText) != "" {
+		body = parsed.BodyText
+	} else if looksLikeHTML(body) {
+		body = stripHTML(body)
+	}
+	if subject := msg.Subject.Or(""); subject != "" {
+		body = subject + "\n\n" + body
+	}
+	sources := []source{{text: body}}
+
+	for _, att := range msg.Attachments {
+		text, ok := attachmentText(att)
+		if !ok {
+			continue
+		}
+		src := source{text: att.Name + "\n\n" + text}
+		if id, err := uuid.FromString(att.UUID.Or("")); err == nil {
+			src.fileUUID = &id
+		}
+		sources = append(sources, src)
+	}
+	return sources
+}
+
+// attachmentText returns the text of an attachment carried inline, false
+// when it has none or is too large.
+func attachmentText(att api.FileObject) (string, bool) {
+	data, ok := att.Data.Get()
+	if !ok || att.IsRaw.Or(false) || base64.StdEncoding.DecodedLen(len(data)) > maxAttachmentSize {
+		return "", false
+	}
+	mt, _, err := mime.ParseMediaType(att.MimeType.Or(""))
+	if err != nil {
+		return "", false
+	}
+	isText := strings.HasPrefix(mt, "text/") || mt == "application/json" || mt == "application/xml" ||
+		strings.HasSuffix(mt, "+json") || strings.HasSuffix(mt, "+xml")
+	if !isText {
+		return "", false
+	}
+	raw, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return "", false
+	}
+	text := string(raw)
+	if mt == "text/html" {
+		text = stripHTML(text)
+	}
+	return text, strings.TrimSpace(text) != ""
+}
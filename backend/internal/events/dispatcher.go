@@ -0,0 +1,212 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// Headers of a webhook delivery
+const (
+	HeaderEvent     = "X-Shadowapi-Event"
+	HeaderDelivery  = "X-Shadowapi-Delivery"
+	HeaderSignature = "X-Shadowapi-Signature"
+)
+
+var (
+	dispatchInterval = 15 * time.Second
+	// eventRetention is how long events are kept, older ones are not delivered
+	eventRetention = 7 * 24 * time.Hour
+	// deliveryBatch is the number of events a webhook gets per run
+	deliveryBatch int32 = 50
+	// webhookLease is how long a dispatcher holds a webhook without recording
+	// progress, another one takes it over afterwards
+	webhookLease = 2 * time.Minute
+)
+
+// Payload is the JSON body POSTed to webhooks.
+type Payload struct {
+	UUID          string          `json:"uuid"`
+	Type          string          `json:"type"`
+	WorkspaceUUID string          `json:"workspace_uuid"`
+	SubjectKind   string          `json:"subject_kind,omitempty"`
+	SubjectUUID   string          `json:"subject_uuid,omitempty"`
+	Data          json.RawMessage `json:"data"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// Dispatcher delivers the events to the webhooks.
+type Dispatcher struct {
+	log  *slog.Logger
+	db   query.DBTX
+	http *http.Client
+}
+
+// NewDispatcher creates a dispatcher posting with client, see NewClient.
+// Start runs it.
+func NewDispatcher(log *slog.Logger, db query.DBTX, client *http.Client) *Dispatcher {
+	return &Dispatcher{
+		log:  log.With("service", "events"),
+		db:   db,
+		http: client,
+	}
+}
+
+// Start delivers pending events periodically until ctx is done.
+func (d *Dispatcher) Start(ctx context.Context) {
+	ticker := time.NewTicker(dispatchInterval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				d.run(ctx)
+			case <-ctx.Done():
+				d.log.Info("event dispatcher shutting down")
+				return
+			}
+		}
+	}()
+}
+
+func (d *Dispatcher) run(ctx context.Context) {
+	q := query.New(d.db)
+	before := pgtype.Timestamptz{Time: time.Now().Add(-eventRetention), Valid: true}
+	if err := q.DeleteEventsBefore(ctx, before); err != nil {
+		d.log.Error("failed to delete old events", "error", err)
+	}
+	// the webhooks are leased rather than locked, no transaction stays open
+	// while posting
+	hooks, err := q.ClaimDueWebhooks(ctx, int32(webhookLease.Seconds()))
+	if err != nil {
+		d.log.Error("failed to dispatch events", "error", err)
+		return
+	}
+	for _, w := range hooks {
+		if err := d.deliver(ctx, q, w, before); err != nil {
+			d.log.Warn("webhook delivery failed", "webhook_uuid", w.UUID, "url", w.Url, "error", err)
+		}
+		if err := q.ReleaseWebhook(ctx, converter.UuidToPgUUID(w.UUID)); err != nil {
+			d.log.Error("failed to release webhook", "webhook_uuid", w.UUID, "error", err)
+		}
+	}
+}
+
+// deliver posts the next events to the webhook, stopping at the first failure
+// so that it is retried before any later event. The cursor is stored after
+// each delivery, an event is posted again only when that fails.
+func (d *Dispatcher) deliver(ctx context.Context, q *query.Queries, w query.Webhook, createdAfter pgtype.Timestamptz) error {
+	evs, err := q.GetWebhookEvents(ctx, query.GetWebhookEventsParams{
+		WorkspaceUUID: converter.UuidPtrToPgUUID(w.WorkspaceUUID),
+		AfterTxid:     w.LastEventTxid,
+		AfterUuid:     converter.UuidPtrToPgUUID(w.LastEventUuid),
+		CreatedAfter:  createdAfter,
+		Limit:         deliveryBatch,
+	})
+	if err != nil {
+		return err
+	}
+	if len(evs) == 0 {
+		return nil
+	}
+	secret, err := secrets.Decrypt(w.Secret)
+	if err != nil {
+		return err
+	}
+
+	status := w.LastStatus
+	for _, e := range evs {
+		if Subscribed(w.EventTypes, e.Type) {
+			var deliverErr error
+			status, deliverErr = d.post(ctx, w.Url, secret, e)
+			if deliverErr != nil {
+				if err := q.SetWebhookFailed(ctx, query.SetWebhookFailedParams{
+					UUID:       converter.UuidToPgUUID(w.UUID),
+					LastStatus: status,
+					LastError:  deliverErr.Error(),
+				}); err != nil {
+					return errors.Join(deliverErr, err)
+				}
+				return deliverErr
+			}
+		}
+		if err := q.SetWebhookDelivered(ctx, query.SetWebhookDeliveredParams{
+			UUID:          converter.UuidToPgUUID(w.UUID),
+			LastEventTxid: e.Txid,
+			LastEventUuid: converter.UuidToPgUUID(e.UUID),
+			LastStatus:    status,
+			LeaseSeconds:  int32(webhookLease.Seconds()),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *Dispatcher) post(ctx context.Context, url, secret string, e query.Event) (int32, error) {
+	payload := Payload{
+		UUID:        e.UUID.String(),
+		Type:        e.Type,
+		SubjectKind: e.SubjectKind,
+		Data:        e.Data,
+		CreatedAt:   e.CreatedAt.Time,
+	}
+	if e.WorkspaceUUID != nil {
+		payload.WorkspaceUUID = e.WorkspaceUUID.String()
+	}
+	if e.SubjectUuid != nil {
+		payload.SubjectUUID = e.SubjectUuid.String()
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, e.Type)
+	req.Header.Set(HeaderDelivery, e.UUID.String())
+	if secret != "" {
+		req.Header.Set(HeaderSignature, Sign(secret, time.Now(), body))
+	}
+	resp, err := d.http.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode >= 300 {
+		return int32(resp.StatusCode), fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return int32(resp.StatusCode), nil
+}
+
+// Sign returns the signature header of a delivery, "t=<unix time>,v1=<hex>"
+// where v1 is the HMAC-SHA256 of "<unix time>.<body>" keyed with the secret
+// of the webhook. Receivers should reject old timestamps to prevent replays.
+func Sign(secret string, t time.Time, body []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,81 @@
+// Package events records what happened to the objects of a workspace for
+// integrations, e.g. that a datasource needs a new OAuth2 login.
+//
+// Events are rows of the event table written in the transaction of the change
+// they describe. The dispatcher delivers them to the webhooks of the
+// workspace afterwards, in order and at least once.
+package events
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// Event types
+const (
+	// DatasourceNeedsReauth is emitted when the OAuth2 grant of a datasource is
+	// revoked, expired or lacks scopes. Its schedulers are paused until a new login.
+	DatasourceNeedsReauth = "datasource.needs_reauth"
+	// DatasourceReauthorized is emitted when a new login resumed a datasource.
+	DatasourceReauthorized = "datasource.reauthorized"
+)
+
+// Types lists the known event types, webhooks may subscribe to others too.
+var Types = []string{
+	DatasourceNeedsReauth,
+	DatasourceReauthorized,
+}
+
+// Event is a new event.
+type Event struct {
+	Type        string
+	SubjectKind string
+	SubjectUUID uuid.UUID
+	// WorkspaceUUID defaults to the workspace of the context
+	WorkspaceUUID *uuid.UUID
+	Data          any
+}
+
+// Emit records the event. Pass the queries of the transaction making the
+// change, the event is then only kept when the change is.
+func Emit(ctx context.Context, q *query.Queries, e Event) error {
+	data, err := json.Marshal(e.Data)
+	if err != nil {
+		return err
+	}
+	if e.Data == nil {
+		data = []byte("{}")
+	}
+	params := query.CreateEventParams{
+		UUID:        converter.UuidToPgUUID(uuid.Must(uuid.NewV7())),
+		Type:        e.Type,
+		SubjectKind: e.SubjectKind,
+		Data:        data,
+	}
+	if e.SubjectUUID != uuid.Nil {
+		params.SubjectUuid = converter.UuidToPgUUID(e.SubjectUUID)
+	}
+	if e.WorkspaceUUID != nil {
+		params.WorkspaceUUID = converter.UuidToPgUUID(*e.WorkspaceUUID)
+	}
+	return q.CreateEvent(ctx, params)
+}
+
+// Subscribed reports whether a webhook listening to types receives events of
+// type typ, no types means every event.
+func Subscribed(types []string, typ string) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, t := range types {
+		if t == typ || t == "*" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrForbiddenAddress is returned for webhooks targeting the host itself, a
+// private network or the link-local range holding the cloud metadata services.
+var ErrForbiddenAddress = errors.New("webhooks may not target loopback, private or link-local addresses")
+
+// cgnat is the shared address space of carrier-grade NAT, internal too
+var cgnat = netip.MustParsePrefix("100.64.0.0/10")
+
+// forbidden reports whether a webhook may not be delivered to addr.
+func forbidden(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() || addr.IsMulticast() ||
+		addr.IsUnspecified() || cgnat.Contains(addr)
+}
+
+// ValidateURL checks the URL of a webhook: an http or https URL whose host,
+// when it is an address or localhost, is not internal unless allowPrivate.
+// Names resolving to internal addresses are refused when delivering.
+func ValidateURL(raw string, allowPrivate bool) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("url must be an http or https URL")
+	}
+	if u.User != nil {
+		return errors.New("url must not hold credentials, use the secret to authenticate deliveries")
+	}
+	if allowPrivate {
+		return nil
+	}
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrForbiddenAddress
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && forbidden(addr) {
+		return ErrForbiddenAddress
+	}
+	return nil
+}
+
+// NewClient returns the HTTP client delivering to webhooks. Unless
+// allowPrivate, it refuses to connect to internal addresses whatever the name
+// resolved to, redirects included, and ignores the proxy settings of the
+// environment which would connect on its behalf.
+func NewClient(allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: 5 * time.Second}
+	if !allowPrivate {
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if forbidden(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", ErrForbiddenAddress, addrPort.Addr())
+			}
+			return nil
+		}
+	}
+	transport := &http.Transport{
+		DialContext:           dialer.DialContext,
+		TLSHandshakeTimeout:   5 * time.Second,
+		ResponseHeaderTimeout: 10 * time.Second,
+		MaxIdleConnsPerHost:   2,
+		IdleConnTimeout:       90 * time.Second,
+	}
+	return &http.Client{Timeout: 10 * time.Second, Transport: transport}
+}
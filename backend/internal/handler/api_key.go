@@ -0,0 +1,206 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/shadowapi/shadowapi/backend/internal/apikey"
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/session"
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// ApikeyCreate creates an API key in the current workspace.
+// POST /apikey
+func (h *Handler) ApikeyCreate(ctx context.Context, req *api.APIKey) (*api.APIKey, error) {
+	log := h.log.With("handler", "ApikeyCreate")
+	ident, ok := session.GetIdentity(ctx)
+	if !ok {
+		return nil, ErrWithCode(http.StatusUnauthorized, E("unauthorized"))
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, ErrWithCode(http.StatusBadRequest, E("name is required"))
+	}
+	if len(req.Scopes) == 0 {
+		return nil, ErrWithCode(http.StatusBadRequest, E("at least one scope is required, *:* allows everything the role allows"))
+	}
+	for _, scope := range req.Scopes {
+		if err := apikey.ValidateScope(scope); err != nil {
+			return nil, ErrWithCode(http.StatusBadRequest, err)
+		}
+	}
+	for _, entry := range req.AllowedIps {
+		if err := apikey.ValidateIP(entry); err != nil {
+			return nil, ErrWithCode(http.StatusBadRequest, err)
+		}
+	}
+	if req.ExpiresAt.IsSet() && !req.ExpiresAt.Value.After(time.Now()) {
+		return nil, ErrWithCode(http.StatusBadRequest, E("expires_at must be in the future"))
+	}
+
+	params := query.CreateAPIKeyParams{
+		UUID:       converter.UuidToPgUUID(uuid.Must(uuid.NewV7())),
+		Name:       name,
+		Role:       workspace.RoleAPIClient,
+		Scopes:     req.Scopes,
+		AllowedIPs: req.AllowedIps,
+	}
+	if params.AllowedIPs == nil {
+		params.AllowedIPs = []string{}
+	}
+	if req.ExpiresAt.IsSet() {
+		params.ExpiresAt = pgtype.Timestamptz{Time: req.ExpiresAt.Value, Valid: true}
+	}
+	if req.ServiceAccount.Or(false) {
+		if !canManageAPIKeys(ident) {
+			return nil, ErrWithCode(http.StatusForbidden, E("service account keys require the owner or admin role"))
+		}
+		if req.Role.IsSet() {
+			if err := req.Role.Value.Validate(); err != nil {
+				return nil, ErrWithCode(http.StatusBadRequest, E("invalid role: %w", err))
+			}
+			params.Role = string(req.Role.Value)
+		}
+		// a key acts with its own role, it may not be handed more than the caller has
+		if !workspace.RoleCovers(ident.WorkspaceRole, params.Role) {
+			return nil, ErrWithCode(http.StatusForbidden, E("role %s may not create a key with role %s", ident.WorkspaceRole, params.Role))
+		}
+	} else {
+		// a personal key acts as the caller, a key or the bearer token has no
+		// user to act as
+		userUUID, err := uuid.FromString(ident.ID)
+		if err != nil || ident.Source == session.SourceAPIKey {
+			return nil, ErrWithCode(http.StatusBadRequest, E("only users have personal keys, create a service account key instead"))
+		}
+		params.UserUUID = converter.UuidToPgUUID(userUUID)
+	}
+
+	key, prefix, hash, err := apikey.Generate()
+	if err != nil {
+		log.Error("failed to generate api key", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to create api key"))
+	}
+	params.Prefix, params.Hash = prefix, hash
+	created, err := query.New(h.dbp).CreateAPIKey(ctx, params)
+	if err != nil {
+		log.Error("failed to create api key", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to create api key"))
+	}
+	log.Info("api key created", "key_uuid", created.UUID, "prefix", prefix, "created_by", ident.ID)
+	out := qToApiAPIKey(created)
+	out.Key = api.NewOptString(key)
+	return &out, nil
+}
+
+// ApikeyList lists the API keys of the current workspace.
+// GET /apikey
+func (h *Handler) ApikeyList(ctx context.Context, params api.ApikeyListParams) ([]api.APIKey, error) {
+	log := h.log.With("handler", "ApikeyList")
+	ident, ok := session.GetIdentity(ctx)
+	if !ok {
+		return nil, ErrWithCode(http.StatusUnauthorized, E("unauthorized"))
+	}
+	arg := query.GetAPIKeysParams{
+		IncludeRevoked: params.IncludeRevoked.Or(false),
+		Offset:         params.Offset.Or(0),
+		Limit:          params.Limit.Or(50),
+	}
+	if !canManageAPIKeys(ident) {
+		userUUID, err := uuid.FromString(ident.ID)
+		if err != nil {
+			return []api.APIKey{}, nil
+		}
+		arg.UserUUID = converter.UuidToPgUUID(userUUID)
+	}
+	keys, err := query.New(h.dbp).GetAPIKeys(ctx, arg)
+	if err != nil {
+		log.Error("failed to list api keys", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to list api keys"))
+	}
+	out := make([]api.APIKey, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, qToApiAPIKey(k))
+	}
+	return out, nil
+}
+
+// ApikeyRevoke revokes an API key.
+// DELETE /apikey/{uuid}
+func (h *Handler) ApikeyRevoke(ctx context.Context, params api.ApikeyRevokeParams) error {
+	log := h.log.With("handler", "ApikeyRevoke")
+	ident, ok := session.GetIdentity(ctx)
+	if !ok {
+		return ErrWithCode(http.StatusUnauthorized, E("unauthorized"))
+	}
+	keyUUID, err := uuid.FromString(params.UUID)
+	if err != nil {
+		return ErrWithCode(http.StatusBadRequest, E("invalid api key uuid"))
+	}
+	q := query.New(h.dbp)
+	key, err := q.GetAPIKey(ctx, converter.UuidToPgUUID(keyUUID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrWithCode(http.StatusNotFound, E("api key not found"))
+	} else if err != nil {
+		log.Error("failed to get api key", "error", err)
+		return ErrWithCode(http.StatusInternalServerError, E("failed to get api key"))
+	}
+	own := key.UserUUID != nil && key.UserUUID.String() == ident.ID
+	if !own && !canManageAPIKeys(ident) {
+		return ErrWithCode(http.StatusForbidden, E("only owners and admins revoke the keys of others"))
+	}
+	if _, err := q.RevokeAPIKey(ctx, converter.UuidToPgUUID(keyUUID)); err != nil {
+		log.Error("failed to revoke api key", "error", err)
+		return ErrWithCode(http.StatusInternalServerError, E("failed to revoke api key"))
+	}
+	log.Info("api key revoked", "key_uuid", keyUUID, "revoked_by", ident.ID)
+	return nil
+}
+
+// canManageAPIKeys reports whether the caller manages every key of the
+// workspace.
+func canManageAPIKeys(ident session.Identity) bool {
+	if ident.IsMachine() {
+		return true
+	}
+	return ident.WorkspaceRole == workspace.RoleOwner || ident.WorkspaceRole == workspace.RoleAdmin
+}
+
+func qToApiAPIKey(k query.APIKey) api.APIKey {
+	out := api.APIKey{
+		UUID:           api.NewOptString(k.UUID.String()),
+		Name:           k.Name,
+		Prefix:         api.NewOptString(k.Prefix),
+		ServiceAccount: api.NewOptBool(k.UserUUID == nil),
+		Scopes:         k.Scopes,
+		AllowedIps:     k.AllowedIPs,
+		CreatedAt:      api.NewOptDateTime(k.CreatedAt.Time),
+	}
+	if k.UserUUID != nil {
+		out.UserUUID = api.NewOptString(k.UserUUID.String())
+	} else {
+		out.Role = api.NewOptAPIKeyRole(api.APIKeyRole(k.Role))
+	}
+	if k.ExpiresAt.Valid {
+		out.ExpiresAt = api.NewOptDateTime(k.ExpiresAt.Time)
+	}
+	if k.LastUsedAt.Valid {
+		out.LastUsedAt = api.NewOptDateTime(k.LastUsedAt.Time)
+	}
+	if k.LastUsedIP.Valid {
+		out.LastUsedIP = api.NewOptString(k.LastUsedIP.String)
+	}
+	if k.RevokedAt.Valid {
+		out.RevokedAt = api.NewOptDateTime(k.RevokedAt.Time)
+	}
+	return out
+}
@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/go-faster/jx"
+	gouuid "github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// auditExportBatch is the number of events read per query during an export.
+const auditExportBatch = 500
+
+// AuditList lists the audit events of the current workspace.
+// GET /audit
+func (h *Handler) AuditList(ctx context.Context, params api.AuditListParams) ([]api.AuditEvent, error) {
+	log := h.log.With("handler", "AuditList")
+	outcome := api.OptString{Value: string(params.Outcome.Value), Set: params.Outcome.Set}
+	arg := auditFilter(params.Actor, params.Source, params.OperationID, params.ResourceKind,
+		params.ResourceID, outcome, params.Since, params.Until)
+	arg.Offset = params.Offset.Or(0)
+	arg.Limit = params.Limit.Or(100)
+	events, err := query.New(h.dbp).GetAuditEvents(ctx, arg)
+	if err != nil {
+		log.Error("failed to list audit events", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to list audit events"))
+	}
+	out := make([]api.AuditEvent, 0, len(events))
+	for _, e := range events {
+		out = append(out, qToApiAuditEvent(e))
+	}
+	return out, nil
+}
+
+// AuditExport streams the matching audit events of the current workspace as
+// NDJSON.
+// GET /audit/export
+func (h *Handler) AuditExport(ctx context.Context, params api.AuditExportParams) (api.AuditExportOK, error) {
+	log := h.log.With("handler", "AuditExport")
+	outcome := api.OptString{Value: string(params.Outcome.Value), Set: params.Outcome.Set}
+	arg := auditFilter(params.Actor, params.Source, params.OperationID, params.ResourceKind,
+		params.ResourceID, outcome, params.Since, params.Until)
+	arg.Limit = auditExportBatch
+
+	// fail with a proper status when the first page can't be read, later
+	// errors can only cut the stream short
+	q := query.New(h.dbp)
+	events, err := q.GetAuditEvents(ctx, arg)
+	if err != nil {
+		log.Error("failed to export audit events", "error", err)
+		return api.AuditExportOK{}, ErrWithCode(http.StatusInternalServerError, E("failed to export audit events"))
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		w := bufio.NewWriter(pw)
+		for len(events) > 0 {
+			for _, e := range events {
+				out := qToApiAuditEvent(e)
+				raw, err := out.MarshalJSON()
+				if err != nil {
+					pw.CloseWithError(err)
+					return
+				}
+				w.Write(raw)
+				w.WriteByte('\n')
+			}
+			if err := w.Flush(); err != nil {
+				// the client went away
+				return
+			}
+			if len(events) < auditExportBatch {
+				break
+			}
+			arg.BeforeUUID = converter.UuidToPgUUID(events[len(events)-1].UUID)
+			if events, err = q.GetAuditEvents(ctx, arg); err != nil {
+				log.Error("failed to export audit events", "error", err)
+				pw.CloseWithError(err)
+				return
+			}
+		}
+		pw.Close()
+	}()
+	return api.AuditExportOK{Data: pr}, nil
+}
+
+// auditFilter builds the query of the filters shared by list and export.
+func auditFilter(actor, source, operationID, resourceKind, resourceID, outcome api.OptString, since, until api.OptDateTime) query.GetAuditEventsParams {
+	text := func(v api.OptString) pgtype.Text {
+		return pgtype.Text{String: v.Value, Valid: v.Set && v.Value != ""}
+	}
+	arg := query.GetAuditEventsParams{
+		Actor:        text(actor),
+		Source:       text(source),
+		OperationID:  text(operationID),
+		ResourceKind: text(resourceKind),
+		ResourceID:   text(resourceID),
+		Outcome:      text(outcome),
+	}
+	if since.IsSet() {
+		arg.Since = pgtype.Timestamptz{Time: since.Value, Valid: true}
+	}
+	if until.IsSet() {
+		arg.Until = pgtype.Timestamptz{Time: until.Value, Valid: true}
+	}
+	return arg
+}
+
+func qToApiAuditEvent(e query.AuditEvent) api.AuditEvent {
+	out := api.AuditEvent{
+		UUID:         gouuid.UUID(e.UUID),
+		OccurredAt:   e.OccurredAt.Time,
+		Actor:        e.Actor,
+		Source:       e.Source,
+		OperationID:  e.OperationID,
+		ResourceKind: api.NewOptString(e.ResourceKind),
+		Action:       api.NewOptString(e.Action),
+		ResourceID:   api.NewOptString(e.ResourceID),
+		Outcome:      api.AuditEventOutcome(e.Outcome),
+		Status:       e.Status,
+		Error:        api.NewOptString(e.Error),
+		IP:           api.NewOptString(e.IP),
+		UserAgent:    api.NewOptString(e.UserAgent),
+		DurationMs:   api.NewOptInt32(e.DurationMs),
+	}
+	if e.WorkspaceUUID != nil {
+		out.WorkspaceUUID = api.NewOptUUID(gouuid.UUID(*e.WorkspaceUUID))
+	}
+	if e.APIKeyUUID != nil {
+		out.APIKeyUUID = api.NewOptUUID(gouuid.UUID(*e.APIKeyUUID))
+	}
+	if params := rawObject(e.Params); params != nil {
+		out.Params = api.NewOptAuditEventParams(params)
+	}
+	if diff := rawObject(e.Diff); diff != nil {
+		out.Diff = api.NewOptAuditEventDiff(diff)
+	}
+	return out
+}
+
+// rawObject splits a stored JSON object into its raw fields.
+func rawObject(data []byte) map[string]jx.Raw {
+	var fields map[string]json.RawMessage
+	if len(data) == 0 || json.Unmarshal(data, &fields) != nil || len(fields) == 0 {
+		return nil
+	}
+	out := make(map[string]jx.Raw, len(fields))
+	for k, v := range fields {
+		out[k] = jx.Raw(v)
+	}
+	return out
+}
@@ -56,6 +56,10 @@ func QToDatasource(ds query.Datasource) api.Datasource {
 		Type:      ds.Type,
 		IsEnabled: api.NewOptBool(ds.IsEnabled),
 	}
+	if ds.NeedsReauth {
+		c.NeedsReauth = api.NewOptBool(true)
+		c.ReauthReason = api.NewOptString(ds.ReauthReason)
+	}
 	if ds.UserUUID != nil {
 		c.UserUUID = ds.UserUUID.String()
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/shadowapi/shadowapi/backend/internal/db"
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
 	"github.com/shadowapi/shadowapi/backend/pkg/api"
 	"github.com/shadowapi/shadowapi/backend/pkg/query"
 )
@@ -23,6 +24,10 @@ func (h *Handler) DatasourceEmailCreate(ctx context.Context, req *api.Datasource
 		log.Error("failed to marshal settings", "error", err)
 		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to marshal settings"))
 	}
+	if settings, err = secrets.SealFields(settings, nil, secrets.DatasourceFields["email"]...); err != nil {
+		log.Error("failed to encrypt settings", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to encrypt settings"))
+	}
 	isEnabled := req.IsEnabled.Or(false)
 	pgUserUUID, err := converter.ConvertStringToPgUUID(req.UserUUID)
 	if err != nil {
@@ -43,6 +48,7 @@ func (h *Handler) DatasourceEmailCreate(ctx context.Context, req *api.Datasource
 		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to create datasource"))
 	}
 	resp := *req
+	resp.Password = secrets.Redact(resp.Password)
 	resp.UUID = api.NewOptString(ds.UUID.String())
 	return &resp, nil
 }
@@ -95,6 +101,10 @@ func (h *Handler) DatasourceEmailUpdate(ctx context.Context, req *api.Datasource
 			log.Error("failed to marshal settings", "error", err)
 			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to marshal settings"))
 		}
+		if newSettings, err = secrets.SealFields(newSettings, dse.Datasource.Settings, secrets.DatasourceFields["email"]...); err != nil {
+			log.Error("failed to encrypt settings", "error", err)
+			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to encrypt settings"))
+		}
 		pgUserUUID, err := converter.ConvertStringToPgUUID(req.UserUUID)
 		if err != nil {
 			log.Error("failed to convert user uuid", "error", err)
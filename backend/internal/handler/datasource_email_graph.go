@@ -0,0 +1,293 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/db"
+	"github.com/shadowapi/shadowapi/backend/internal/msgraph"
+	oauthTools "github.com/shadowapi/shadowapi/backend/internal/oauth2"
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// emailGraphProvider is the OAuth2 provider of email_graph datasources.
+const emailGraphProvider = "microsoft"
+
+// DatasourceEmailGraphCreate creates a new Microsoft Graph mail datasource.
+// POST /datasource/email_graph
+func (h *Handler) DatasourceEmailGraphCreate(ctx context.Context, req *api.DatasourceEmailGraph) (*api.DatasourceEmailGraph, error) {
+	log := h.log.With("handler", "DatasourceEmailGraphCreate")
+	if err := h.checkEmailGraphClient(ctx, req.OAuth2ClientUUID); err != nil {
+		return nil, err
+	}
+	req.Provider = api.NewOptString(emailGraphProvider)
+	settings, err := json.Marshal(req)
+	if err != nil {
+		log.Error("failed to marshal settings", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to marshal settings"))
+	}
+	pgUserUUID, err := converter.ConvertStringToPgUUID(req.UserUUID)
+	if err != nil {
+		log.Error("failed to convert user uuid", "error", err)
+		return nil, ErrWithCode(http.StatusBadRequest, E("invalid user UUID"))
+	}
+	dsUUID := uuid.Must(uuid.NewV7())
+	ds, err := query.New(h.dbp).CreateDatasource(ctx, query.CreateDatasourceParams{
+		UUID:      converter.UuidToPgUUID(dsUUID),
+		UserUUID:  pgUserUUID,
+		Name:      req.Name,
+		IsEnabled: req.IsEnabled.Or(false),
+		Provider:  emailGraphProvider,
+		Settings:  settings,
+		Type:      "email_graph",
+	})
+	if err != nil {
+		log.Error("failed to create datasource", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to create datasource"))
+	}
+	resp := *req
+	resp.UUID = api.NewOptString(ds.UUID.String())
+	return &resp, nil
+}
+
+// DatasourceEmailGraphDelete deletes a Microsoft Graph mail datasource.
+// DELETE /datasource/email_graph/{uuid}
+func (h *Handler) DatasourceEmailGraphDelete(ctx context.Context, params api.DatasourceEmailGraphDeleteParams) error {
+	log := h.log.With("handler", "DatasourceEmailGraphDelete")
+	dsUUID, err := uuid.FromString(params.UUID)
+	if err != nil {
+		log.Error("failed to parse datasource uuid", "error", err)
+		return ErrWithCode(http.StatusBadRequest, E("invalid datasource UUID"))
+	}
+	if err := h.deleteOAuth2Datasource(ctx, log, dsUUID); err != nil {
+		log.Error("failed to delete datasource", "error", err)
+		return ErrWithCode(http.StatusInternalServerError, E("failed to delete datasource"))
+	}
+	return nil
+}
+
+// DatasourceEmailGraphGet retrieves a single Microsoft Graph mail datasource.
+// GET /datasource/email_graph/{uuid}
+func (h *Handler) DatasourceEmailGraphGet(ctx context.Context, params api.DatasourceEmailGraphGetParams) (*api.DatasourceEmailGraph, error) {
+	log := h.log.With("handler", "DatasourceEmailGraphGet")
+	dsUUID, err := uuid.FromString(params.UUID)
+	if err != nil {
+		log.Error("failed to parse datasource uuid", "error", err)
+		return nil, ErrWithCode(http.StatusBadRequest, E("invalid datasource UUID"))
+	}
+	dse, err := query.New(h.dbp).GetDatasource(ctx, converter.UuidToPgUUID(dsUUID))
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && dse.Datasource.Type != "email_graph") {
+		return nil, ErrWithCode(http.StatusNotFound, E("datasource not found"))
+	}
+	if err != nil {
+		log.Error("failed to get datasource", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to get datasource"))
+	}
+	return qToDatasourceEmailGraph(dse.Datasource)
+}
+
+// DatasourceEmailGraphList lists all Microsoft Graph mail datasources.
+// GET /datasource/email_graph
+func (h *Handler) DatasourceEmailGraphList(ctx context.Context, params api.DatasourceEmailGraphListParams) ([]api.DatasourceEmailGraph, error) {
+	log := h.log.With("handler", "DatasourceEmailGraphList")
+	rows, err := query.New(h.dbp).GetDatasources(ctx, query.GetDatasourcesParams{
+		OrderBy:        "created_at",
+		OrderDirection: "desc",
+		Offset:         params.Offset.Or(0),
+		Limit:          params.Limit.Or(0),
+		Type:           "email_graph",
+		IsEnabled:      -1,
+		SyncAll:        -1,
+	})
+	if err != nil {
+		log.Error("failed to get datasources", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to list email_graph datasources"))
+	}
+	result := []api.DatasourceEmailGraph{}
+	for _, row := range rows {
+		out, err := qToDatasourceEmailGraph(query.Datasource{
+			UUID:      row.UUID,
+			UserUUID:  row.UserUUID,
+			Name:      row.Name,
+			Type:      row.Type,
+			IsEnabled: row.IsEnabled,
+			Provider:  row.Provider,
+			Settings:  row.Settings,
+			CreatedAt: row.CreatedAt,
+			UpdatedAt: row.UpdatedAt,
+		})
+		if err != nil {
+			log.Error("failed to parse datasource row", "error", err)
+			continue
+		}
+		result = append(result, *out)
+	}
+	return result, nil
+}
+
+// DatasourceEmailGraphUpdate updates an existing Microsoft Graph mail datasource.
+// PUT /datasource/email_graph/{uuid}
+func (h *Handler) DatasourceEmailGraphUpdate(ctx context.Context, req *api.DatasourceEmailGraph, params api.DatasourceEmailGraphUpdateParams) (*api.DatasourceEmailGraph, error) {
+	log := h.log.With("handler", "DatasourceEmailGraphUpdate")
+	dsUUID, err := uuid.FromString(params.UUID)
+	if err != nil {
+		log.Error("failed to parse datasource uuid", "error", err)
+		return nil, ErrWithCode(http.StatusBadRequest, E("invalid datasource UUID"))
+	}
+	if err := h.checkEmailGraphClient(ctx, req.OAuth2ClientUUID); err != nil {
+		return nil, err
+	}
+	req.Provider = api.NewOptString(emailGraphProvider)
+	return db.InTx(ctx, h.dbp, func(tx pgx.Tx) (*api.DatasourceEmailGraph, error) {
+		dse, err := query.New(tx).GetDatasource(ctx, converter.UuidToPgUUID(dsUUID))
+		if errors.Is(err, pgx.ErrNoRows) || (err == nil && dse.Datasource.Type != "email_graph") {
+			return nil, ErrWithCode(http.StatusNotFound, E("datasource not found"))
+		}
+		if err != nil {
+			log.Error("failed to get datasource", "error", err)
+			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to get datasource"))
+		}
+		settings, err := json.Marshal(req)
+		if err != nil {
+			log.Error("failed to marshal settings", "error", err)
+			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to marshal settings"))
+		}
+		pgUserUUID, err := converter.ConvertStringToPgUUID(req.UserUUID)
+		if err != nil {
+			log.Error("failed to convert user uuid", "error", err)
+			return nil, ErrWithCode(http.StatusBadRequest, E("invalid user UUID"))
+		}
+		err = query.New(tx).UpdateDatasource(ctx, query.UpdateDatasourceParams{
+			UUID:      converter.UuidToPgUUID(dsUUID),
+			UserUUID:  pgUserUUID,
+			Name:      req.Name,
+			IsEnabled: req.IsEnabled.Or(dse.Datasource.IsEnabled),
+			Provider:  emailGraphProvider,
+			Settings:  settings,
+			Type:      "email_graph",
+		})
+		if err != nil {
+			log.Error("failed to update datasource", "error", err)
+			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to update datasource"))
+		}
+		dse, err = query.New(tx).GetDatasource(ctx, converter.UuidToPgUUID(dsUUID))
+		if err != nil {
+			log.Error("failed to get datasource", "error", err)
+			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to get datasource"))
+		}
+		return qToDatasourceEmailGraph(dse.Datasource)
+	})
+}
+
+// DatasourceEmailGraphSend sends a mail from the mailbox of the datasource.
+// POST /datasource/email_graph/{uuid}/send
+func (h *Handler) DatasourceEmailGraphSend(ctx context.Context, req *api.EmailSend, params api.DatasourceEmailGraphSendParams) error {
+	log := h.log.With("handler", "DatasourceEmailGraphSend")
+	ds, err := h.DatasourceEmailGraphGet(ctx, api.DatasourceEmailGraphGetParams{UUID: params.UUID})
+	if err != nil {
+		return err
+	}
+	if !ds.IsEnabled.Or(false) {
+		return ErrWithCode(http.StatusConflict, E("datasource is disabled"))
+	}
+	if ds.NeedsReauth.Or(false) {
+		return ErrWithCode(http.StatusConflict, E("datasource needs a new Microsoft login: %s", ds.ReauthReason.Or("")))
+	}
+	if len(req.To) == 0 {
+		return ErrWithCode(http.StatusBadRequest, E("at least one recipient is required"))
+	}
+
+	clientToken, err := oauthTools.GetClientToken(ctx, h.dbp, ds.OAuth2ClientUUID)
+	if errors.Is(err, oauthTools.ErrNoToken) {
+		return ErrWithCode(http.StatusConflict, E("datasource is not authorized with Microsoft yet"))
+	}
+	if err != nil {
+		log.Error("failed to get oauth2 token", "error", err)
+		return ErrWithCode(http.StatusInternalServerError, E("failed to get oauth2 token"))
+	}
+
+	err = msgraph.New(clientToken.HTTP).SendMail(ctx, &msgraph.OutgoingMail{
+		To:              req.To,
+		Cc:              req.Cc,
+		Bcc:             req.Bcc,
+		Subject:         req.Subject,
+		Body:            req.Body,
+		HTML:            req.ContentType.Or(api.EmailSendContentTypeText) == api.EmailSendContentTypeHTML,
+		SaveToSentItems: req.SaveToSentItems.Or(true),
+	})
+	if errors.Is(err, oauthTools.ErrNeedsReauth) {
+		return ErrWithCode(http.StatusConflict, E("datasource needs a new Microsoft login"))
+	}
+	var graphErr *msgraph.Error
+	if errors.As(err, &graphErr) && graphErr.Status == http.StatusBadRequest {
+		return ErrWithCode(http.StatusBadRequest, E("mail rejected: %s", graphErr.Message))
+	}
+	if err != nil {
+		log.Error("failed to send mail", "error", err)
+		return ErrWithCode(http.StatusBadGateway, E("failed to send mail"))
+	}
+	return nil
+}
+
+// checkEmailGraphClient returns an error unless the OAuth2 client exists and
+// uses the Microsoft identity platform.
+func (h *Handler) checkEmailGraphClient(ctx context.Context, clientUUID string) error {
+	pgClientUUID, err := converter.ConvertStringToPgUUID(clientUUID)
+	if err != nil {
+		return ErrWithCode(http.StatusBadRequest, E("invalid oauth2_client_uuid"))
+	}
+	client, err := query.New(h.dbp).GetOauth2Client(ctx, pgClientUUID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrWithCode(http.StatusBadRequest, E("oauth2 client not found"))
+	}
+	if err != nil {
+		h.log.Error("failed to get oauth2 client", "error", err)
+		return ErrWithCode(http.StatusInternalServerError, E("failed to get oauth2 client"))
+	}
+	if p, ok := oauthTools.Lookup(client.Oauth2Client.Provider); !ok || p.Name != emailGraphProvider {
+		return ErrWithCode(http.StatusBadRequest, E("oauth2 client must use the %s provider", emailGraphProvider))
+	}
+	return nil
+}
+
+// datasourceEmailGraphSettings holds the fields stored in the settings JSON column.
+type datasourceEmailGraphSettings struct {
+	Email            string   `json:"email"`
+	OAuth2ClientUUID string   `json:"oauth2_client_uuid"`
+	Folders          []string `json:"folders"`
+}
+
+func qToDatasourceEmailGraph(ds query.Datasource) (*api.DatasourceEmailGraph, error) {
+	var s datasourceEmailGraphSettings
+	if err := json.Unmarshal(ds.Settings, &s); err != nil {
+		return nil, err
+	}
+	out := &api.DatasourceEmailGraph{
+		UUID:             api.NewOptString(ds.UUID.String()),
+		Name:             ds.Name,
+		Provider:         api.NewOptString(ds.Provider),
+		IsEnabled:        api.NewOptBool(ds.IsEnabled),
+		NeedsReauth:      api.NewOptBool(ds.NeedsReauth),
+		ReauthReason:     api.NewOptString(ds.ReauthReason),
+		Email:            s.Email,
+		OAuth2ClientUUID: s.OAuth2ClientUUID,
+		Folders:          s.Folders,
+	}
+	if ds.UserUUID != nil {
+		out.UserUUID = ds.UserUUID.String()
+	}
+	if ds.CreatedAt.Valid {
+		out.CreatedAt = api.NewOptDateTime(ds.CreatedAt.Time)
+	}
+	if ds.UpdatedAt.Valid {
+		out.UpdatedAt = api.NewOptDateTime(ds.UpdatedAt.Time)
+	}
+	return out, nil
+}
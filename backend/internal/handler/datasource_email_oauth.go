@@ -58,7 +58,7 @@ func (h *Handler) DatasourceEmailOAuthDelete(ctx context.Context, params api.Dat
 		log.Error("failed to parse datasource uuid", "error", err)
 		return ErrWithCode(http.StatusBadRequest, E("invalid datasource UUID"))
 	}
-	if err := query.New(h.dbp).DeleteDatasource(ctx, pgtype.UUID{Bytes: converter.UToBytes(dsUUID), Valid: true}); err != nil {
+	if err := h.deleteOAuth2Datasource(ctx, log, dsUUID); err != nil {
 		log.Error("failed to delete datasource", "error", err)
 		return ErrWithCode(http.StatusInternalServerError, E("failed to delete datasource"))
 	}
@@ -180,6 +180,8 @@ func QToDatasourceEmailOAuthRow(dse query.GetDatasourceRow) (*api.DatasourceEmai
 		Name:             dse.Datasource.Name,
 		Provider:         dse.Datasource.Provider,
 		IsEnabled:        api.NewOptBool(dse.Datasource.IsEnabled),
+		NeedsReauth:      api.NewOptBool(dse.Datasource.NeedsReauth),
+		ReauthReason:     api.NewOptString(dse.Datasource.ReauthReason),
 		Email:            s.Email,
 		OAuth2ClientUUID: s.OAuth2ClientUUID,
 	}
@@ -200,6 +202,8 @@ func QToDatasourceEmailOAuthRowMany(r query.GetDatasourcesRow) (*api.DatasourceE
 		Name:             r.Name,
 		Provider:         r.Provider,
 		IsEnabled:        api.NewOptBool(r.IsEnabled),
+		NeedsReauth:      api.NewOptBool(r.NeedsReauth),
+		ReauthReason:     api.NewOptString(r.ReauthReason),
 		Email:            s.Email,
 		OAuth2ClientUUID: s.OAuth2ClientUUID,
 	}
@@ -21,3 +21,8 @@ type errWraper struct {
 func (e *errWraper) Error() string {
 	return e.err.Error()
 }
+
+// StatusCode returns the associated HTTP status code.
+func (e *errWraper) StatusCode() int {
+	return e.status
+}
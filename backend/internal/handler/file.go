@@ -152,6 +152,9 @@ func (h *Handler) FileGet(ctx context.Context, params api.FileGetParams) (*api.F
 		StorageType: "", // Not populated here
 		StorageUUID: "", // Not populated
 	}
+	if fileRow.File.MessageUuid != nil {
+		out.MessageUUID = api.NewOptString(fileRow.File.MessageUuid.String())
+	}
 	if fileRow.File.CreatedAt.Valid {
 		out.CreatedAt = api.NewOptDateTime(fileRow.File.CreatedAt.Time)
 	}
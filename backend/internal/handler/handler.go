@@ -14,7 +14,12 @@ import (
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/shadowapi/shadowapi/backend/internal/config"
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/embeddings"
+	"github.com/shadowapi/shadowapi/backend/internal/policies"
+	"github.com/shadowapi/shadowapi/backend/internal/session"
 	"github.com/shadowapi/shadowapi/backend/internal/worker"
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
 	"github.com/shadowapi/shadowapi/backend/pkg/api"
 	"github.com/shadowapi/shadowapi/backend/pkg/query"
 )
@@ -25,6 +30,9 @@ type Handler struct {
 	log *slog.Logger
 	dbp *pgxpool.Pool
 	wbr *worker.Broker
+	pol *policies.Enforcer
+	ses *session.Store
+	emb *embeddings.Index
 }
 
 func (h *Handler) DB() *pgxpool.Pool {
@@ -60,6 +68,14 @@ func (h *Handler) ensureInitAdmin(ctx context.Context) error {
 		ZitadelSubject: pgtype.Text{},
 		Meta:           []byte(`{}`),
 	})
+	if err != nil {
+		return err
+	}
+	_, err = q.UpsertWorkspaceMember(ctx, query.UpsertWorkspaceMemberParams{
+		WorkspaceUUID: converter.UuidToPgUUID(workspace.DefaultUUID),
+		UserUUID:      pgtype.UUID{Bytes: uid, Valid: true},
+		Role:          workspace.RoleOwner,
+	})
 	return err
 }
 
@@ -70,6 +86,9 @@ func Provide(i do.Injector) (*Handler, error) {
 		log: do.MustInvoke[*slog.Logger](i),
 		dbp: do.MustInvoke[*pgxpool.Pool](i),
 		wbr: do.MustInvoke[*worker.Broker](i),
+		pol: do.MustInvoke[*policies.Enforcer](i),
+		ses: do.MustInvoke[*session.Store](i),
+		emb: do.MustInvoke[*embeddings.Index](i),
 	}
 	if err := h.ensureInitAdmin(context.Background()); err != nil {
 		h.log.Error("init admin", "error", err)
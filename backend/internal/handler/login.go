@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"errors"
+	"net/http"
 
 	"github.com/gofrs/uuid"
 	gouuid "github.com/google/uuid"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/shadowapi/shadowapi/backend/internal/session"
 	"github.com/shadowapi/shadowapi/backend/pkg/api"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
 )
 
 // PlainLogin verifies email/password and returns user UUID on success.
@@ -41,6 +43,18 @@ func (h *Handler) SessionStatus(ctx context.Context) (*api.SessionStatus, error)
 		if uid, err := gouuid.Parse(id.ID); err == nil {
 			out.SetUUID(api.NewOptUUID(uid))
 		}
+		if sid, err := gouuid.Parse(id.SessionUUID); err == nil {
+			out.SetSessionUUID(api.NewOptUUID(sid))
+		}
+		sessions, err := h.ses.List(ctx, id.ID)
+		if err != nil {
+			log.Error("failed to list sessions", "error", err)
+			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to list sessions"))
+		}
+		out.Sessions = make([]api.Session, 0, len(sessions))
+		for _, s := range sessions {
+			out.Sessions = append(out.Sessions, qToApiSession(s, id.SessionUUID))
+		}
 		return &out, nil
 	}
 
@@ -53,3 +67,67 @@ func (h *Handler) SessionStatus(ctx context.Context) (*api.SessionStatus, error)
 	out.SetReason(api.NewOptString(reason))
 	return &out, nil
 }
+
+// SessionRevoke revokes one session of the current user, e.g. a forgotten
+// browser.
+// DELETE /session/{uuid}
+func (h *Handler) SessionRevoke(ctx context.Context, params api.SessionRevokeParams) error {
+	log := h.log.With("handler", "SessionRevoke")
+	id, ok := session.GetIdentity(ctx)
+	if !ok {
+		return ErrWithCode(http.StatusUnauthorized, E("unauthorized"))
+	}
+	sessionUUID, err := uuid.FromString(params.UUID)
+	if err != nil {
+		return ErrWithCode(http.StatusBadRequest, E("invalid session uuid"))
+	}
+	revoked, err := h.ses.RevokeID(ctx, id.ID, sessionUUID)
+	if err != nil {
+		log.Error("failed to revoke session", "error", err)
+		return ErrWithCode(http.StatusInternalServerError, E("failed to revoke session"))
+	}
+	if !revoked {
+		return ErrWithCode(http.StatusNotFound, E("session not found"))
+	}
+	log.Info("session revoked", "uid", id.ID, "session_uuid", sessionUUID)
+	return nil
+}
+
+// SessionRevokeAll logs the current user out everywhere.
+// DELETE /session
+func (h *Handler) SessionRevokeAll(ctx context.Context, params api.SessionRevokeAllParams) error {
+	log := h.log.With("handler", "SessionRevokeAll")
+	id, ok := session.GetIdentity(ctx)
+	if !ok {
+		return ErrWithCode(http.StatusUnauthorized, E("unauthorized"))
+	}
+	var keep uuid.UUID
+	if params.KeepCurrent.Or(false) {
+		keep = uuid.FromStringOrNil(id.SessionUUID)
+	}
+	n, err := h.ses.RevokeAll(ctx, id.ID, keep)
+	if err != nil {
+		log.Error("failed to revoke sessions", "error", err)
+		return ErrWithCode(http.StatusInternalServerError, E("failed to revoke sessions"))
+	}
+	log.Info("sessions revoked", "uid", id.ID, "count", n)
+	return nil
+}
+
+func qToApiSession(s query.UserSession, current string) api.Session {
+	out := api.Session{
+		UUID:       gouuid.UUID(s.UUID),
+		Source:     api.SessionSource(s.Source),
+		CreatedAt:  s.CreatedAt.Time,
+		LastSeenAt: s.LastSeenAt.Time,
+		ExpiresAt:  s.ExpiresAt.Time,
+		Current:    api.NewOptBool(s.UUID.String() == current),
+	}
+	if s.UserAgent != "" {
+		out.UserAgent = api.NewOptString(s.UserAgent)
+	}
+	if s.IP != "" {
+		out.IP = api.NewOptString(s.IP)
+	}
+	return out
+}
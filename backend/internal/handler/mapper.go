@@ -2,6 +2,7 @@ package handler
 
 import (
 	"encoding/json"
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
 	"github.com/shadowapi/shadowapi/backend/pkg/api"
 	"github.com/shadowapi/shadowapi/backend/pkg/query"
 )
@@ -12,6 +13,7 @@ func QToDatasourceEmail(row query.GetDatasourcesRow) (*api.DatasourceEmail, erro
 	if err := json.Unmarshal(row.Settings, &ds); err != nil {
 		return nil, err
 	}
+	ds.Password = secrets.Redact(ds.Password)
 	ds.UUID = api.NewOptString(row.UUID.String())
 
 	if row.UserUUID != nil {
@@ -39,6 +41,7 @@ func QToDatasourceEmailRow(row query.GetDatasourceRow) (*api.DatasourceEmail, er
 	if err := json.Unmarshal(row.Datasource.Settings, &ds); err != nil {
 		return nil, err
 	}
+	ds.Password = secrets.Redact(ds.Password)
 	ds.UUID = api.NewOptString(row.Datasource.UUID.String())
 	if row.Datasource.UserUUID != nil {
 		ds.UserUUID = row.Datasource.UserUUID.String()
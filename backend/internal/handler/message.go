@@ -1,10 +1,20 @@
 package handler
 
 import (
+	"cmp"
 	"context"
+	"errors"
+	"log/slog"
 	"net/http"
+	"slices"
 	"time"
 
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/embeddings"
+	"github.com/shadowapi/shadowapi/backend/internal/storages"
 	"github.com/shadowapi/shadowapi/backend/pkg/api"
 	"github.com/shadowapi/shadowapi/backend/pkg/query"
 )
@@ -16,20 +26,9 @@ import (
 // POST /message/query
 func (h *Handler) MessageQuery(ctx context.Context, req *api.MessageQuery) (*api.MessageQueryOK, error) {
 	log := h.log.With("handler", "MessageQuery")
-	params := convertMessageQueryToParams(req, "unified")
-	rows, err := query.New(h.dbp).GetMessages(ctx, params)
+	messages, err := h.queryMessages(ctx, log, req, "unified")
 	if err != nil {
-		log.Error("failed to query unified messages", "error", err)
-		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to query unified messages"))
-	}
-	var messages []api.Message
-	for _, row := range rows {
-		m, err := qToApiMessage(row)
-		if err != nil {
-			log.Error("failed to map unified message", "error", err)
-			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to map unified message"))
-		}
-		messages = append(messages, m)
+		return nil, err
 	}
 	return &api.MessageQueryOK{Messages: messages}, nil
 }
@@ -39,20 +38,9 @@ func (h *Handler) MessageQuery(ctx context.Context, req *api.MessageQuery) (*api
 // POST /message/email/query
 func (h *Handler) MessageEmailQuery(ctx context.Context, req *api.MessageQuery) (*api.MessageEmailQueryOK, error) {
 	log := h.log.With("handler", "MessageEmailQuery")
-	params := convertMessageQueryToParams(req, "email")
-	rows, err := query.New(h.dbp).GetMessages(ctx, params)
+	messages, err := h.queryMessages(ctx, log, req, "email")
 	if err != nil {
-		log.Error("failed to query email messages", "error", err)
-		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to query email messages"))
-	}
-	var messages []api.Message
-	for _, row := range rows {
-		m, err := qToApiMessage(row)
-		if err != nil {
-			log.Error("failed to map email message", "error", err)
-			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to map email message"))
-		}
-		messages = append(messages, m)
+		return nil, err
 	}
 	return &api.MessageEmailQueryOK{Messages: messages}, nil
 }
@@ -62,20 +50,9 @@ func (h *Handler) MessageEmailQuery(ctx context.Context, req *api.MessageQuery)
 // POST /message/linkedin/query
 func (h *Handler) MessageLinkedinQuery(ctx context.Context, req *api.MessageQuery) (*api.MessageLinkedinQueryOK, error) {
 	log := h.log.With("handler", "MessageLinkedinQuery")
-	params := convertMessageQueryToParams(req, "linkedin")
-	rows, err := query.New(h.dbp).GetMessages(ctx, params)
+	messages, err := h.queryMessages(ctx, log, req, "linkedin")
 	if err != nil {
-		log.Error("failed to query linkedin messages", "error", err)
-		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to query linkedin messages"))
-	}
-	var messages []api.Message
-	for _, row := range rows {
-		m, err := qToApiMessage(row)
-		if err != nil {
-			log.Error("failed to map linkedin message", "error", err)
-			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to map linkedin message"))
-		}
-		messages = append(messages, m)
+		return nil, err
 	}
 	return &api.MessageLinkedinQueryOK{Messages: messages}, nil
 }
@@ -85,20 +62,9 @@ func (h *Handler) MessageLinkedinQuery(ctx context.Context, req *api.MessageQuer
 // POST /message/telegram/query
 func (h *Handler) MessageTelegramQuery(ctx context.Context, req *api.MessageQuery) (*api.MessageTelegramQueryOK, error) {
 	log := h.log.With("handler", "MessageTelegramQuery")
-	params := convertMessageQueryToParams(req, "telegram")
-	rows, err := query.New(h.dbp).GetMessages(ctx, params)
+	messages, err := h.queryMessages(ctx, log, req, "telegram")
 	if err != nil {
-		log.Error("failed to query telegram messages", "error", err)
-		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to query telegram messages"))
-	}
-	var messages []api.Message
-	for _, row := range rows {
-		m, err := qToApiMessage(row)
-		if err != nil {
-			log.Error("failed to map telegram message", "error", err)
-			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to map telegram message"))
-		}
-		messages = append(messages, m)
+		return nil, err
 	}
 	return &api.MessageTelegramQueryOK{Messages: messages}, nil
 }
@@ -108,22 +74,96 @@ func (h *Handler) MessageTelegramQuery(ctx context.Context, req *api.MessageQuer
 // POST /message/whatsapp/query
 func (h *Handler) MessageWhatsappQuery(ctx context.Context, req *api.MessageQuery) (*api.MessageWhatsappQueryOK, error) {
 	log := h.log.With("handler", "MessageWhatsappQuery")
-	params := convertMessageQueryToParams(req, "whatsapp")
+	messages, err := h.queryMessages(ctx, log, req, "whatsapp")
+	if err != nil {
+		return nil, err
+	}
+	return &api.MessageWhatsappQueryOK{Messages: messages}, nil
+}
+
+// queryMessages runs a message query of the msgType messages, "unified" for
+// all of them.
+func (h *Handler) queryMessages(ctx context.Context, log *slog.Logger, req *api.MessageQuery, msgType string) ([]api.Message, error) {
+	params := convertMessageQueryToParams(req, msgType)
+	var scores map[uuid.UUID]float64
+	if req.Mode.Or(api.MessageQueryModeKeyword) == api.MessageQueryModeSemantic {
+		var err error
+		if params, scores, err = h.semanticParams(ctx, log, req, params); err != nil {
+			return nil, err
+		}
+		if len(params.Uuids) == 0 {
+			return nil, nil
+		}
+	}
 	rows, err := query.New(h.dbp).GetMessages(ctx, params)
 	if err != nil {
-		log.Error("failed to query whatsapp messages", "error", err)
-		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to query whatsapp messages"))
+		log.Error("failed to query "+msgType+" messages", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to query %s messages", msgType))
 	}
 	var messages []api.Message
+	blobs := storages.NewBlobResolver(query.New(h.dbp))
 	for _, row := range rows {
 		m, err := qToApiMessage(row)
 		if err != nil {
-			log.Error("failed to map whatsapp message", "error", err)
-			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to map whatsapp message"))
+			log.Error("failed to map "+msgType+" message", "error", err)
+			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to map %s message", msgType))
+		}
+		if m.Body, err = blobs.MessageBody(ctx, row.Body, row.BodyFileUuid); err != nil {
+			log.Error("failed to read "+msgType+" message body", "error", err)
+			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to read %s message body", msgType))
+		}
+		if scores != nil {
+			m.Score = api.NewOptFloat64(scores[row.UUID])
 		}
 		messages = append(messages, m)
 	}
-	return &api.MessageWhatsappQueryOK{Messages: messages}, nil
+	if scores != nil {
+		// best first, the order of the query is meaningless here
+		slices.SortStableFunc(messages, func(a, b api.Message) int {
+			return cmp.Compare(b.Score.Or(0), a.Score.Or(0))
+		})
+	}
+	return messages, nil
+}
+
+// semanticParams runs the semantic search of the query and narrows params to
+// the page of messages found, returned with their scores. The search itself
+// takes the filters, so the page is cut from the ranked hits.
+func (h *Handler) semanticParams(ctx context.Context, log *slog.Logger, req *api.MessageQuery, params query.GetMessagesParams) (query.GetMessagesParams, map[uuid.UUID]float64, error) {
+	if !h.emb.Enabled() {
+		return params, nil, ErrWithCode(http.StatusBadRequest, embeddings.ErrDisabled)
+	}
+	text := params.Search
+	params.Search = ""
+	limit, offset := int(params.Limit), int(params.Offset)
+	if limit <= 0 {
+		limit = 50
+	}
+	hits, err := h.emb.Search(ctx, text, offset+limit, query.SearchMessageChunksParams{
+		Type:          params.Type,
+		ChatUuid:      params.ChatUuid,
+		ThreadUuid:    params.ThreadUuid,
+		PipelineUuid:  params.PipelineUuid,
+		CreatedAfter:  params.CreatedAfter,
+		CreatedBefore: params.CreatedBefore,
+	})
+	if errors.Is(err, embeddings.ErrEmptyQuery) {
+		return params, nil, ErrWithCode(http.StatusBadRequest, err)
+	}
+	if err != nil {
+		log.Error("failed to run semantic search", "error", err)
+		return params, nil, ErrWithCode(http.StatusInternalServerError, E("failed to run semantic search"))
+	}
+	hits = hits[min(offset, len(hits)):]
+
+	scores := make(map[uuid.UUID]float64, len(hits))
+	params.Uuids = make([]pgtype.UUID, 0, len(hits))
+	for _, hit := range hits {
+		scores[hit.MessageUUID] = hit.Score
+		params.Uuids = append(params.Uuids, pgtype.UUID{Bytes: hit.MessageUUID, Valid: true})
+	}
+	params.Offset, params.Limit = 0, 0
+	return params, scores, nil
 }
 
 // convertMessageQueryToParams converts an API MessageQuery into query.GetMessagesParams.
@@ -148,40 +188,38 @@ func convertMessageQueryToParams(req *api.MessageQuery, msgType string) query.Ge
 		limit = int32(req.Limit.Value)
 	}
 
-	// TODO @reactima bring back
-	//var chatUUID pgtype.UUID
-	//if req.ChatID.IsSet() && req.ChatID.Value != "" {
-	//	if u, err := uuid.FromString(req.ChatID.Value); err == nil {
-	//		chatUUID = pgtype.UUID{Bytes: converter.UToBytes(u), Valid: true}
-	//	} else {
-	//		chatUUID = pgtype.UUID{Valid: false}
-	//	}
-	//} else {
-	//	chatUUID = pgtype.UUID{Valid: false}
-	//}
-	//
-	//var threadUUID pgtype.UUID
-	//if req.ThreadID.IsSet() && req.ThreadID.Value != "" {
-	//	if u, err := uuid.FromString(req.ThreadID.Value); err == nil {
-	//		threadUUID = pgtype.UUID{Bytes: converter.UToBytes(u), Valid: true}
-	//	} else {
-	//		threadUUID = pgtype.UUID{Valid: false}
-	//	}
-	//} else {
-	//	threadUUID = pgtype.UUID{Valid: false}
-	//}
-
-	return query.GetMessagesParams{
+	params := query.GetMessagesParams{
 		OrderBy:        orderBy,
 		OrderDirection: orderDirection,
 		Offset:         offset,
 		Limit:          limit,
 		Type:           msgType,
 		Format:         "",
-		//ChatUuid:       chatUUID,
-		//ThreadUuid:     threadUUID,
-		Sender: "",
+		ChatUuid:       optPgUUID(req.ChatID),
+		ThreadUuid:     optPgUUID(req.ThreadID),
+		Sender:         "",
+		Search:         req.Query.Or(""),
+	}
+	if id, ok := req.PipelineUUID.Get(); ok {
+		params.PipelineUuid = pgtype.UUID{Bytes: id, Valid: true}
+	}
+	if t, ok := req.StartDate.Get(); ok {
+		params.CreatedAfter = pgtype.Timestamptz{Time: t, Valid: true}
+	}
+	if t, ok := req.EndDate.Get(); ok {
+		params.CreatedBefore = pgtype.Timestamptz{Time: t, Valid: true}
+	}
+	return params
+}
+
+// optPgUUID parses an optional UUID filter, an unset or invalid value
+// disables the filter.
+func optPgUUID(v api.OptString) pgtype.UUID {
+	u, err := uuid.FromString(v.Or(""))
+	if err != nil {
+		return pgtype.UUID{}
 	}
+	return converter.UuidToPgUUID(u)
 }
 
 // qToApiMessage converts a query.GetMessagesRow into an API Message.
@@ -189,17 +227,23 @@ func qToApiMessage(r query.GetMessagesRow) (api.Message, error) {
 	var msg api.Message
 	msg.UUID = api.NewOptString(r.UUID.String())
 	msg.Format = r.Format
-	//msg.Type = r.Type
-	//if r.ChatUuid != nil {
-	//	msg.ChatUUID = api.NewOptString(r.ChatUuid.String())
-	//}
-	//if r.ThreadUuid != nil {
-	//	msg.ThreadUUID = api.NewOptString(r.ThreadUuid.String())
-	//}
+	msg.Type = r.Type
+	if r.ChatUuid != nil {
+		msg.ChatUUID = api.NewOptString(r.ChatUuid.String())
+	}
+	if r.ThreadUuid != nil {
+		msg.ThreadUUID = api.NewOptString(r.ThreadUuid.String())
+	}
 	msg.Sender = r.Sender
 	msg.Recipients = r.Recipients
 	msg.Subject = api.NewOptString(r.Subject.String)
 	msg.Body = r.Body
+	if r.PipelineUuid != nil {
+		msg.PipelineUUID = api.NewOptString(r.PipelineUuid.String())
+	}
+	if r.DatasourceUUID != nil {
+		msg.DatasourceUUID = api.NewOptString(r.DatasourceUUID.String())
+	}
 	//if len(r.BodyParsed) > 0 {
 	//	var bp api.MessageBodyParsed
 	//	if err := json.Unmarshal(r.BodyParsed, &bp); err != nil {
@@ -5,6 +5,8 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	oauthTools "github.com/shadowapi/shadowapi/backend/internal/oauth2"
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
 	"github.com/shadowapi/shadowapi/backend/pkg/api"
 	"github.com/shadowapi/shadowapi/backend/pkg/query"
 	"net/http"
@@ -15,33 +17,32 @@ import (
 // OAuth2ClientCreate creates a new OAuth2 client.
 func (h *Handler) OAuth2ClientCreate(ctx context.Context, req *api.OAuth2ClientCreateReq) (*api.OAuth2Client, error) {
 	log := h.log.With("handler", "OAuth2ClientCreate")
+	if err := validateOAuth2Provider(req.Provider, req.IssuerURL.Or("")); err != nil {
+		return nil, err
+	}
 
+	secret, err := secrets.Encrypt(req.Secret)
+	if err != nil {
+		log.Error("failed to encrypt client secret", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("internal server error"))
+	}
 	clientUUID := uuid.Must(uuid.NewV7())
 	create := query.CreateOauth2ClientParams{
 		UUID:     converter.UuidToPgUUID(clientUUID),
 		Name:     req.Name,
 		Provider: req.Provider,
 		// New required field.
-		ClientID: req.ClientID,
-		Secret:   req.Secret,
+		ClientID:  req.ClientID,
+		Secret:    secret,
+		IssuerURL: req.IssuerURL.Or(""),
+		Scopes:    scopesOrEmpty(req.Scopes),
 	}
 	obj, err := query.New(h.dbp).CreateOauth2Client(ctx, create)
 	if err != nil {
 		log.Error("failed to create oauth2 client", "error", err)
 		return nil, ErrWithCode(http.StatusInternalServerError, E("internal server error"))
 	}
-	out := api.OAuth2Client{
-		UUID:     api.NewOptString(obj.UUID.String()),
-		Name:     obj.Name,
-		Provider: obj.Provider,
-		ClientID: obj.ClientID,
-	}
-	if obj.CreatedAt.Valid {
-		out.CreatedAt = api.NewOptDateTime(obj.CreatedAt.Time)
-	}
-	if obj.UpdatedAt.Valid {
-		out.UpdatedAt = api.NewOptDateTime(obj.UpdatedAt.Time)
-	}
+	out := qToApiOAuth2Client(obj)
 	return &out, nil
 }
 
@@ -77,20 +78,8 @@ func (h *Handler) OAuth2ClientGet(ctx context.Context, params api.OAuth2ClientGe
 		log.Error("failed to get client details", "error", err)
 		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to get client details"))
 	}
-	result := &api.OAuth2Client{
-		UUID:     api.NewOptString(details.Oauth2Client.UUID.String()),
-		Name:     details.Oauth2Client.Name,
-		Provider: details.Oauth2Client.Provider,
-		ClientID: details.Oauth2Client.ClientID,
-		Secret:   details.Oauth2Client.Secret,
-	}
-	if details.Oauth2Client.CreatedAt.Valid {
-		result.CreatedAt = api.NewOptDateTime(details.Oauth2Client.CreatedAt.Time)
-	}
-	if details.Oauth2Client.UpdatedAt.Valid {
-		result.UpdatedAt = api.NewOptDateTime(details.Oauth2Client.UpdatedAt.Time)
-	}
-	return result, nil
+	result := qToApiOAuth2Client(details.Oauth2Client)
+	return &result, nil
 }
 
 // OAuth2ClientList lists all OAuth2 clients.
@@ -110,20 +99,7 @@ func (h *Handler) OAuth2ClientList(ctx context.Context, params api.OAuth2ClientL
 	}
 	out := &api.OAuth2ClientListOK{}
 	for _, c := range clients {
-		a := api.OAuth2Client{
-			UUID:     api.NewOptString(c.Oauth2Client.UUID.String()),
-			Name:     c.Oauth2Client.Name,
-			Provider: c.Oauth2Client.Provider,
-			ClientID: c.Oauth2Client.ClientID,
-			Secret:   c.Oauth2Client.Secret,
-		}
-		if c.Oauth2Client.CreatedAt.Valid {
-			a.CreatedAt = api.NewOptDateTime(c.Oauth2Client.CreatedAt.Time)
-		}
-		if c.Oauth2Client.UpdatedAt.Valid {
-			a.UpdatedAt = api.NewOptDateTime(c.Oauth2Client.UpdatedAt.Time)
-		}
-		out.Clients = append(out.Clients, a)
+		out.Clients = append(out.Clients, qToApiOAuth2Client(c.Oauth2Client))
 	}
 	return out, nil
 }
@@ -163,18 +139,39 @@ func (h *Handler) OAuth2ClientTokenList(ctx context.Context, params api.OAuth2Cl
 
 	var out []api.OAuth2ClientToken
 	for _, t := range tokens {
-		// Convert the raw JSONB token field into a string.
-		tokenStr := string(t.Token)
+		tokenData, err := secrets.DecryptJSON(t.Token)
+		if err != nil {
+			log.Error("failed to decrypt oauth token", "error", err)
+			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to decode oauth token"))
+		}
 		// Unmarshal the token JSON into tokenObj.
 		var tokenObj api.OAuth2ClientTokenObj
-		if err := tokenObj.UnmarshalJSON([]byte(tokenStr)); err != nil {
+		if err := tokenObj.UnmarshalJSON(tokenData); err != nil {
 			log.Error("failed to unmarshal oauth token", "error", err)
 			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to decode oauth token"))
 		}
+		// never hand out the credentials themselves
+		tokenObj.AccessToken = secrets.Redact(tokenObj.AccessToken)
+		tokenObj.RefreshToken = secrets.Redact(tokenObj.RefreshToken)
+		tokenObj.Token.Reset()
 		token := api.OAuth2ClientToken{
-			UUID:       api.NewOptString(t.UUID.String()),
-			ClientUUID: t.ClientUuid.String(), // new expires_at field
-			Token:      tokenObj,              // wrap the token JSON
+			UUID:          api.NewOptString(t.UUID.String()),
+			ClientUUID:    t.ClientUuid.String(),
+			Token:         tokenObj, // wrap the token JSON
+			Status:        api.NewOptOAuth2ClientTokenStatus(api.OAuth2ClientTokenStatus(t.Status)),
+			FailureCount:  api.NewOptInt32(t.FailureCount),
+			LastError:     api.NewOptString(t.LastError),
+			Scopes:        t.Scopes,
+			MissingScopes: t.MissingScopes,
+		}
+		if t.ExpiresAt.Valid {
+			token.ExpiresAt = api.NewOptDateTime(t.ExpiresAt.Time)
+		}
+		if t.LastRefreshedAt.Valid {
+			token.LastRefreshedAt = api.NewOptDateTime(t.LastRefreshedAt.Time)
+		}
+		if t.LastFailedAt.Valid {
+			token.LastFailedAt = api.NewOptDateTime(t.LastFailedAt.Time)
 		}
 		if t.CreatedAt.Valid {
 			token.CreatedAt = api.NewOptDateTime(t.CreatedAt.Time)
@@ -190,18 +187,38 @@ func (h *Handler) OAuth2ClientTokenList(ctx context.Context, params api.OAuth2Cl
 // OAuth2ClientUpdate updates an OAuth2 client.
 func (h *Handler) OAuth2ClientUpdate(ctx context.Context, req *api.OAuth2ClientUpdateReq, params api.OAuth2ClientUpdateParams) (*api.OAuth2Client, error) {
 	log := h.log.With("handler", "OAuth2ClientUpdate", "clientUUID", params.UUID)
+	if err := validateOAuth2Provider(req.Provider, req.IssuerURL.Or("")); err != nil {
+		return nil, err
+	}
 	q := query.New(h.dbp)
 	clientUUID, err := converter.ConvertStringToPgUUID(params.UUID)
 	if err != nil {
 		log.Error("invalid UUID", "error", err)
 		return nil, ErrWithCode(http.StatusBadRequest, E("invalid client id"))
 	}
+	secret := req.Secret
+	if secret == secrets.Redacted {
+		existing, err := q.GetOauth2Client(ctx, clientUUID)
+		if err == pgx.ErrNoRows {
+			log.Error("no such oauth2 client")
+			return nil, ErrWithCode(http.StatusNotFound, E("no such oauth2 client"))
+		} else if err != nil {
+			log.Error("failed to get oauth2 client", "error", err)
+			return nil, ErrWithCode(http.StatusInternalServerError, E("internal server error"))
+		}
+		secret = existing.Oauth2Client.Secret
+	} else if secret, err = secrets.Encrypt(secret); err != nil {
+		log.Error("failed to encrypt client secret", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("internal server error"))
+	}
 	update := query.UpdateOauth2ClientParams{
-		Name:     req.Name,
-		Provider: req.Provider,
-		Secret:   req.Secret,
-		ClientID: req.ClientID,
-		UUID:     clientUUID,
+		Name:      req.Name,
+		Provider:  req.Provider,
+		Secret:    secret,
+		ClientID:  req.ClientID,
+		IssuerURL: req.IssuerURL.Or(""),
+		Scopes:    scopesOrEmpty(req.Scopes),
+		UUID:      clientUUID,
 	}
 	if err := q.UpdateOauth2Client(ctx, update); err == pgx.ErrNoRows {
 		log.Error("no such oauth2 client")
@@ -216,20 +233,66 @@ func (h *Handler) OAuth2ClientUpdate(ctx context.Context, req *api.OAuth2ClientU
 		return nil, ErrWithCode(http.StatusInternalServerError, E("internal server error"))
 	}
 
+	out := qToApiOAuth2Client(raw.Oauth2Client)
+	return &out, nil
+}
+
+// OAuth2ProviderList lists the registered OAuth2 providers.
+// GET /oauth2/provider
+func (h *Handler) OAuth2ProviderList(ctx context.Context) ([]api.OAuth2Provider, error) {
+	providers := oauthTools.Providers()
+	out := make([]api.OAuth2Provider, 0, len(providers))
+	for _, p := range providers {
+		out = append(out, api.OAuth2Provider{
+			Name:       p.Name,
+			Title:      p.Title,
+			Scopes:     p.Scopes,
+			Discovery:  p.Discovery,
+			Pkce:       p.PKCE,
+			Revocation: p.CanRevoke(),
+			Userinfo:   p.UserInfoURL != "" || p.Discovery,
+		})
+	}
+	return out, nil
+}
+
+// validateOAuth2Provider checks the provider is registered and has the
+// issuer it needs.
+func validateOAuth2Provider(name, issuerURL string) error {
+	p, ok := oauthTools.Lookup(name)
+	if !ok {
+		return ErrWithCode(http.StatusBadRequest, E("unknown provider %q, see GET /oauth2/provider", name))
+	}
+	if p.Discovery && issuerURL == "" {
+		return ErrWithCode(http.StatusBadRequest, E("provider %s requires issuer_url", p.Name))
+	}
+	return nil
+}
+
+func scopesOrEmpty(scopes []string) []string {
+	if scopes == nil {
+		return []string{}
+	}
+	return scopes
+}
+
+func qToApiOAuth2Client(c query.Oauth2Client) api.OAuth2Client {
 	out := api.OAuth2Client{
-		UUID:      api.NewOptString(raw.Oauth2Client.UUID.String()),
-		Name:      raw.Oauth2Client.Name,
-		Provider:  raw.Oauth2Client.Provider,
-		ClientID:  raw.Oauth2Client.ClientID,
-		Secret:    raw.Oauth2Client.Secret,
-		CreatedAt: api.NewOptDateTime(raw.Oauth2Client.CreatedAt.Time),
-		UpdatedAt: api.NewOptDateTime(raw.Oauth2Client.UpdatedAt.Time),
+		UUID:     api.NewOptString(c.UUID.String()),
+		Name:     c.Name,
+		Provider: c.Provider,
+		ClientID: c.ClientID,
+		Secret:   secrets.Redact(c.Secret),
+		Scopes:   c.Scopes,
 	}
-	if raw.Oauth2Client.CreatedAt.Valid {
-		out.CreatedAt = api.NewOptDateTime(raw.Oauth2Client.CreatedAt.Time)
+	if c.IssuerURL != "" {
+		out.IssuerURL = api.NewOptString(c.IssuerURL)
 	}
-	if raw.Oauth2Client.UpdatedAt.Valid {
-		out.UpdatedAt = api.NewOptDateTime(raw.Oauth2Client.UpdatedAt.Time)
+	if c.CreatedAt.Valid {
+		out.CreatedAt = api.NewOptDateTime(c.CreatedAt.Time)
 	}
-	return &out, nil
+	if c.UpdatedAt.Valid {
+		out.UpdatedAt = api.NewOptDateTime(c.UpdatedAt.Time)
+	}
+	return out
 }
@@ -3,6 +3,8 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"log/slog"
 	"net/http"
 	"net/url"
 
@@ -10,17 +12,18 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"golang.org/x/oauth2"
-	"log/slog"
 
 	"github.com/shadowapi/shadowapi/backend/internal/converter"
 	"github.com/shadowapi/shadowapi/backend/internal/db"
+	oauthTools "github.com/shadowapi/shadowapi/backend/internal/oauth2"
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
 	"github.com/shadowapi/shadowapi/backend/pkg/api"
 	"github.com/shadowapi/shadowapi/backend/pkg/query"
 )
 
-// handleGmailToken persists a newly issued Gmail OAuth2 token, links it with the datasource
+// handleDatasourceToken persists a newly issued OAuth2 token, links it with the datasource
 // that initiated the flow and stores an oauth2_subject row so that we know which user
-// granted which client access.
+// granted which client access, and with which account at the provider.
 //
 // Tables layout (relevant columns):
 //
@@ -31,15 +34,21 @@ import (
 // The oauth2_subject table does **not** have a client_uuid column – it references token_uuid.
 // sqlc generated query CreateOauth2Subject is therefore unusable (it inserts client_uuid).
 // We do a raw `INSERT` instead.
-func (h *Handler) handleGmailToken(
+//
+// The token is checked against the requested scopes: a login granting all of
+// them clears the needs_reauth flag of the datasources of the client and
+// resumes their schedulers, a partial grant flags them again.
+func (h *Handler) handleDatasourceToken(
 	ctx context.Context,
 	log *slog.Logger,
 	token *oauth2.Token,
+	requested []string,
+	account *oauthTools.UserInfo,
 	stateQuery url.Values,
 	clientID string,
 ) (*api.OAuth2ClientCallbackFound, error) {
 
-	log.Info("0. handleGmailToken", "client_id", clientID, "state_query", stateQuery)
+	log.Info("0. handleDatasourceToken", "client_id", clientID, "state_query", stateQuery)
 
 	// 1) Marshal token
 	tokenData, err := json.Marshal(token)
@@ -47,6 +56,10 @@ func (h *Handler) handleGmailToken(
 		log.Error("1. failed to marshal token", "error", err)
 		return nil, ErrWithCode(http.StatusInternalServerError, E("failed marshal token"))
 	}
+	if tokenData, err = secrets.EncryptJSON(tokenData); err != nil {
+		log.Error("1.1 failed to encrypt token", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed encrypt token"))
+	}
 
 	// 2) Extract datasource_uuid from state
 	dsID := stateQuery.Get("datasource_uuid")
@@ -54,7 +67,7 @@ func (h *Handler) handleGmailToken(
 		log.Error("2.1 missing datasource_uuid in state query")
 		return nil, ErrWithCode(http.StatusBadRequest, E("missing datasource_uuid parameter"))
 	}
-	log.Info("2.1 handleGmailToken", "dsID", dsID)
+	log.Info("2.1 handleDatasourceToken", "dsID", dsID)
 
 	goDSUUID, err := uuid.FromString(dsID)
 	if err != nil {
@@ -93,6 +106,9 @@ func (h *Handler) handleGmailToken(
 		return nil, ErrWithCode(http.StatusInternalServerError, E("user_uuid not resolved"))
 	}
 
+	health := oauthTools.CheckToken(requested, token)
+	goClientUUID := uuid.UUID(pgClientUUID.Bytes)
+
 	// 4) Start transaction
 	return db.InTx(ctx, h.dbp, func(tx pgx.Tx) (*api.OAuth2ClientCallbackFound, error) {
 		q := query.New(tx)
@@ -104,18 +120,17 @@ func (h *Handler) handleGmailToken(
 		goTokenUUID := uuid.Must(uuid.NewV7())
 		pgTokenUUID := converter.UuidToPgUUID(goTokenUUID)
 
-		log.Info("4.1 handleGmailToken", "query.CreateOauth2TokenParams", query.CreateOauth2TokenParams{
-			UUID:       pgTokenUUID,
-			ClientUuid: pgClientUUID,
-			UserUUID:   pgUserUUID,
-			Token:      tokenData,
-		})
+		log.Info("4.1 handleDatasourceToken", "token_uuid", goTokenUUID, "client_uuid", clientID)
 
 		if _, err := q.CreateOauth2Token(ctx, query.CreateOauth2TokenParams{
-			UUID:       pgTokenUUID,
-			ClientUuid: pgClientUUID,
-			UserUUID:   pgUserUUID,
-			Token:      tokenData,
+			UUID:          pgTokenUUID,
+			ClientUuid:    pgClientUUID,
+			UserUUID:      pgUserUUID,
+			Token:         tokenData,
+			ExpiresAt:     oauthTools.TokenExpiry(token),
+			Scopes:        health.Scopes,
+			MissingScopes: health.MissingScopes,
+			Status:        health.Status,
 		}); err != nil {
 			log.Error("4.2 create oauth2 token", "error", err)
 			return nil, ErrWithCode(http.StatusInternalServerError, E("can't add oauth2 token"))
@@ -133,21 +148,35 @@ func (h *Handler) handleGmailToken(
 		// 4.4) Insert oauth2_subject referencing **token_uuid** (raw SQL because sqlc file is wrong)
 		goSubjectUUID := uuid.Must(uuid.NewV7())
 		pgSubjectUUID := converter.UuidToPgUUID(goSubjectUUID)
-		if _, err := q.CreateOauth2Subject(ctx, query.CreateOauth2SubjectParams{
+		subject := query.CreateOauth2SubjectParams{
 			UUID:      pgSubjectUUID,
 			UserUUID:  pgUserUUID,
 			TokenUuid: pgTokenUUID,
-		}); err != nil {
+		}
+		if account != nil {
+			subject.ExternalSubject, subject.ExternalEmail = account.Subject, account.Email
+		}
+		if _, err := q.CreateOauth2Subject(ctx, subject); err != nil {
 			log.Error("4.4 failed to add oauth2 subject", "error", err)
 			return nil, ErrWithCode(http.StatusInternalServerError, E("can't add oauth2 subject"))
 		}
 
-		// TODO: schedule token refresh via worker/pipeline – skipped per current scope
+		// 4.5) Resume the datasources of the client, or flag them when scopes are missing
+		if health.Status == oauthTools.TokenStatusNeedsReauth {
+			log.Warn("4.5 scopes not granted", "missing_scopes", health.MissingScopes)
+			err = oauthTools.FlagReauth(ctx, q, goClientUUID, goTokenUUID, health.Reason())
+		} else {
+			err = oauthTools.Reauthorized(ctx, q, goClientUUID, goTokenUUID)
+		}
+		if err != nil {
+			log.Error("4.5 failed to update datasource health", "error", err)
+			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to update datasource health"))
+		}
 
-		// 4.5) Build redirect location back to datasource page
+		// 4.6) Build redirect location back to datasource page
 		location, err := url.Parse("/datasources/" + dsID)
 		if err != nil {
-			log.Error("4.5 can't parse location", "error", err)
+			log.Error("4.6 can't parse location", "error", err)
 			return nil, ErrWithCode(http.StatusInternalServerError, E("can't parse location"))
 		}
 
@@ -156,3 +185,55 @@ func (h *Handler) handleGmailToken(
 		}, nil
 	})
 }
+
+// deleteOAuth2Datasource deletes a datasource authorized with an OAuth2 client.
+// When it was the last datasource of the client, the tokens of the client are
+// deleted too and revoked at the provider so that the grant does not outlive
+// the datasources using it.
+func (h *Handler) deleteOAuth2Datasource(ctx context.Context, log *slog.Logger, dsUUID uuid.UUID) error {
+	revoke, err := db.InTx(ctx, h.dbp, func(tx pgx.Tx) ([]query.Oauth2Token, error) {
+		q := query.New(tx)
+		dse, err := q.GetDatasource(ctx, converter.UuidToPgUUID(dsUUID))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if err := q.DeleteDatasource(ctx, converter.UuidToPgUUID(dsUUID)); err != nil {
+			return nil, err
+		}
+		var s struct {
+			OAuth2ClientUUID string `json:"oauth2_client_uuid"`
+		}
+		if err := json.Unmarshal(dse.Datasource.Settings, &s); err != nil || s.OAuth2ClientUUID == "" {
+			return nil, nil
+		}
+		clientUUID, err := converter.ConvertStringToPgUUID(s.OAuth2ClientUUID)
+		if err != nil {
+			return nil, nil
+		}
+		if n, err := q.CountDatasourcesWithClient(ctx, s.OAuth2ClientUUID); err != nil || n > 0 {
+			return nil, err
+		}
+		rows, err := q.GetOauth2TokensByClientUUID(ctx, clientUUID)
+		if err != nil {
+			return nil, err
+		}
+		if err := q.DeleteOauth2TokenByClientUUID(ctx, clientUUID); err != nil {
+			return nil, err
+		}
+		tokens := make([]query.Oauth2Token, 0, len(rows))
+		for _, r := range rows {
+			tokens = append(tokens, r.Oauth2Token)
+		}
+		return tokens, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, tok := range revoke {
+		log.Info("revoking token of deleted datasource", "token_uuid", tok.UUID)
+		h.revokeOAuth2Token(ctx, log, tok)
+	}
+	return nil
+}
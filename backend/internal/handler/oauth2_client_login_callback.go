@@ -3,10 +3,10 @@ package handler
 import (
 	"context"
 	"encoding/json"
-	"io"
+	"errors"
+	"log/slog"
 	"net/http"
 	"net/url"
-	"strings"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -16,6 +16,7 @@ import (
 
 	"github.com/shadowapi/shadowapi/backend/internal/converter"
 	oauthTools "github.com/shadowapi/shadowapi/backend/internal/oauth2"
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
 	"github.com/shadowapi/shadowapi/backend/pkg/api"
 	"github.com/shadowapi/shadowapi/backend/pkg/query"
 )
@@ -78,10 +79,14 @@ func (h *Handler) OAuth2ClientLogin(ctx context.Context, req *api.OAuth2ClientLo
 	}
 
 	// ------------------------------------------------------------------
-	// 3. Persist state to DB (10‑minute expiration)
+	// 3. Persist state and PKCE verifier to DB (10‑minute expiration)
 	// ------------------------------------------------------------------
 	queryData, _ := json.Marshal(req.Query) // never fails, query is simple map
 	stateID := uuid.Must(uuid.NewV7())
+	var verifier string
+	if provider.Spec.PKCE {
+		verifier = oauth2.GenerateVerifier()
+	}
 
 	clientPgUUID, err := converter.ConvertStringToPgUUID(clientUUIDStr)
 	if err != nil {
@@ -90,10 +95,11 @@ func (h *Handler) OAuth2ClientLogin(ctx context.Context, req *api.OAuth2ClientLo
 	}
 
 	if _, err := q.CreateOauth2State(ctx, query.CreateOauth2StateParams{
-		UUID:       converter.UuidToPgUUID(stateID),
-		ClientUuid: clientPgUUID,
-		State:      queryData,
-		ExpiredAt:  pgtype.Timestamptz{Time: time.Now().Add(10 * time.Minute), Valid: true},
+		UUID:         converter.UuidToPgUUID(stateID),
+		ClientUuid:   clientPgUUID,
+		State:        queryData,
+		CodeVerifier: verifier,
+		ExpiredAt:    pgtype.Timestamptz{Time: time.Now().Add(10 * time.Minute), Valid: true},
 	}); err != nil {
 		log.Error("failed to create oauth2 state", "error", err)
 		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to create oauth2 state"))
@@ -103,7 +109,7 @@ func (h *Handler) OAuth2ClientLogin(ctx context.Context, req *api.OAuth2ClientLo
 	// 4. Produce AuthCodeURL and hand it back to UI
 	// ------------------------------------------------------------------
 	return &api.OAuth2ClientLoginOK{
-		AuthCodeURL: provider.AuthCodeURL(stateID.String(), oauth2.AccessTypeOffline, oauth2.ApprovalForce),
+		AuthCodeURL: provider.LoginURL(stateID.String(), verifier),
 	}, nil
 }
 
@@ -118,11 +124,17 @@ func (h *Handler) OAuth2ClientCallback(ctx context.Context, params api.OAuth2Cli
 		log.Error("broken state parameter", "error", err)
 		return nil, ErrWithCode(http.StatusBadRequest, E("broken state parameter"))
 	}
-	stateRow, err := q.GetOauth2State(ctx, stateUUID)
-	if err != nil {
+	// the provider redirects the browser here without a workspace, the state
+	// is looked up in every workspace and the rest runs in the one it was
+	// created in
+	stateRow, err := q.GetOauth2State(workspace.Unscoped(ctx), stateUUID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrWithCode(http.StatusBadRequest, E("unknown state"))
+	} else if err != nil {
 		log.Error("failed to query state object", "error", err)
 		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to query state object"))
 	}
+	ctx = workspace.WithUUID(ctx, *stateRow.Oauth2State.WorkspaceUUID)
 
 	// Expired?
 	if stateRow.Oauth2State.ExpiredAt.Valid && time.Now().After(stateRow.Oauth2State.ExpiredAt.Time) {
@@ -141,20 +153,21 @@ func (h *Handler) OAuth2ClientCallback(ctx context.Context, params api.OAuth2Cli
 	}
 
 	// 4. Exchange code → token
-	tok, err := config.Exchange(ctx, params.Code.Value)
+	tok, err := config.ExchangeCode(ctx, params.Code.Value, stateRow.Oauth2State.CodeVerifier)
 	if err != nil {
 		log.Error("token exchange failed", "error", err)
 		return nil, ErrWithCode(http.StatusBadRequest, E("failed token exchange"))
 	}
 	// Force fill expiry / refresh etc.
-	tok, _ = config.TokenSource(ctx, tok).Token()
+	tok, _ = config.TokenSource(config.Context(ctx), tok).Token()
 
-	switch strings.ToLower(config.Provider) {
-	case "gmail":
-		return h.handleGmailToken(ctx, log, tok, stateQuery, stateRow.Oauth2State.ClientUuid.String())
-	default:
-		return nil, ErrWithCode(http.StatusInternalServerError, E("unknown provider"))
+	// 5. Who granted access, informational only
+	account, err := config.FetchUserInfo(ctx, tok)
+	if err != nil {
+		log.Warn("failed to fetch user info", "provider", config.Provider, "error", err)
 	}
+
+	return h.handleDatasourceToken(ctx, log, tok, config.Scopes, account, stateQuery, stateRow.Oauth2State.ClientUuid.String())
 }
 
 // OAuth2ClientTokenDelete revokes the token at the provider and removes it from DB.
@@ -176,23 +189,7 @@ func (h *Handler) OAuth2ClientTokenDelete(ctx context.Context, params api.OAuth2
 		return ErrWithCode(http.StatusInternalServerError, E("internal error"))
 	}
 
-	clientCfg, err := oauthTools.GetClientConfig(ctx, h.dbp, tokRow.Oauth2Token.ClientUuid.String())
-	if err != nil {
-		log.Error("failed to resolve client", "error", err)
-		return ErrWithCode(http.StatusInternalServerError, E("internal error"))
-	}
-
-	// Unmarshal stored token JSON so we can pick refresh/access tokens
-	var stored oauth2.Token
-	if err := json.Unmarshal(tokRow.Oauth2Token.Token, &stored); err != nil {
-		log.Warn("broken stored token json", "error", err)
-	}
-
-	// Provider‑specific revoke
-	switch strings.ToLower(clientCfg.Provider) {
-	case "gmail":
-		_ = revokeGoogleToken(ctx, stored.AccessToken)
-	}
+	h.revokeOAuth2Token(ctx, log, tokRow.Oauth2Token)
 
 	// Finally delete from DB
 	if err := q.DeleteOauth2Token(ctx, tokenPgUUID); err != nil {
@@ -202,21 +199,32 @@ func (h *Handler) OAuth2ClientTokenDelete(ctx context.Context, params api.OAuth2
 	return nil
 }
 
-// revokeGoogleToken calls https://oauth2.googleapis.com/revoke
-func revokeGoogleToken(ctx context.Context, accessToken string) error {
-	if accessToken == "" {
-		return nil // nothing to revoke
+// revokeOAuth2Token revokes the token at the provider, failures are only
+// logged since the token is deleted anyway.
+func (h *Handler) revokeOAuth2Token(ctx context.Context, log *slog.Logger, tok query.Oauth2Token) {
+	if err := oauthTools.RevokeToken(ctx, h.dbp, tok); err != nil {
+		log.Warn("failed to revoke token at the provider", "error", err)
 	}
-	form := url.Values{}
-	form.Set("token", accessToken)
-	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, "https://oauth2.googleapis.com/revoke", strings.NewReader(form.Encode()))
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	resp, err := http.DefaultClient.Do(req)
+}
+
+// OAuth2ClientTokenRefresh refreshes the token now, e.g. to check that the
+// grant still works.
+func (h *Handler) OAuth2ClientTokenRefresh(ctx context.Context, params api.OAuth2ClientTokenRefreshParams) error {
+	log := h.log.With("handler", "OAuth2ClientTokenRefresh", "tokenUUID", params.UUID)
+	tokenUUID, err := uuid.FromString(params.UUID)
 	if err != nil {
-		return err
+		return ErrWithCode(http.StatusBadRequest, E("invalid UUID"))
+	}
+
+	_, err = oauthTools.RefreshNow(ctx, h.dbp, tokenUUID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrWithCode(http.StatusNotFound, E("token not found"))
+	} else if errors.Is(err, oauthTools.ErrNeedsReauth) || oauthTools.IsReauthError(err) {
+		return ErrWithCode(http.StatusConflict, E("token needs a new login"))
+	} else if err != nil {
+		log.Error("failed to refresh oauth2 token", "error", err)
+		return ErrWithCode(http.StatusBadGateway, E("failed to refresh token"))
 	}
-	io.Copy(io.Discard, resp.Body)
-	resp.Body.Close()
 	return nil
 }
 
@@ -249,6 +257,7 @@ func (h *Handler) OAuth2ClientTokenDelete(ctx context.Context, params api.OAuth2
 import (
 	"context"
 	"encoding/json"
+	"log/slog"
 	"github.com/gofrs/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shadowapi/shadowapi/backend/internal/converter"
@@ -347,14 +356,14 @@ func (h *Handler) OAuth2ClientCallback(ctx context.Context, params api.OAuth2Cli
 	}
 
 	// 3) rebuild provider config
-	token, err := config.Exchange(ctx, params.Code.Value)
+	token, err := config.Exchange(config.Context(ctx), params.Code.Value)
 	if err != nil {
 		log.Error("token exchange failed", "error", err)
 		return nil, ErrWithCode(http.StatusBadRequest, E("failed token exchange"))
 	}
 
 	// 4) exchange code → token
-	token, err = config.TokenSource(ctx, token).Token()
+	token, err = config.TokenSource(config.Context(ctx), token).Token()
 	if err != nil {
 		log.Error("failed to get token from token source", "error", err)
 		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to get token from token source"))
@@ -3,14 +3,19 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"github.com/shadowapi/shadowapi/backend/internal/converter"
 	"net/http"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/shadowapi/shadowapi/backend/internal/db"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/registry"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/scheduler"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/types"
 	"github.com/shadowapi/shadowapi/backend/pkg/api"
 	"github.com/shadowapi/shadowapi/backend/pkg/query"
 )
@@ -234,3 +239,83 @@ func qToApiPipeline(dbp query.Pipeline) (api.Pipeline, error) {
 
 	return out, nil
 }
+
+// PipelineRun enqueues a fetch job of the pipeline outside its schedule.
+//
+// POST /pipeline/{uuid}/run
+func (h *Handler) PipelineRun(ctx context.Context, req api.OptPipelineRun, params api.PipelineRunParams) (*api.PipelineRun, error) {
+	log := h.log.With("handler", "PipelineRun")
+	run := req.Or(api.PipelineRun{})
+	if limit, ok := run.Limit.Get(); ok && limit < 1 {
+		return nil, ErrWithCode(http.StatusBadRequest, E("limit must be at least 1"))
+	}
+	opts := types.RunOptions{
+		Limit:    int(run.Limit.Or(0)),
+		DryRun:   run.DryRun.Or(false),
+		OnDemand: true,
+	}
+	if since, ok := run.Since.Get(); ok {
+		opts.Since = &since
+	}
+	if until, ok := run.Until.Get(); ok {
+		opts.Until = &until
+	}
+	if opts.Since != nil && opts.Until != nil && !opts.Until.After(*opts.Since) {
+		return nil, ErrWithCode(http.StatusBadRequest, E("until must be after since"))
+	}
+
+	q := query.New(h.dbp)
+	pipelineUUID, err := uuid.FromString(params.UUID.String())
+	if err != nil {
+		return nil, ErrWithCode(http.StatusBadRequest, E("invalid pipeline uuid"))
+	}
+	row, err := q.GetPipeline(ctx, converter.UuidToPgUUID(pipelineUUID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrWithCode(http.StatusNotFound, E("pipeline not found"))
+	} else if err != nil {
+		log.Error("failed to get pipeline", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to get pipeline"))
+	}
+	if !row.Pipeline.IsEnabled {
+		return nil, ErrWithCode(http.StatusConflict, E("pipeline is disabled"))
+	}
+	ds, err := q.GetDatasource(ctx, converter.UuidPtrToPgUUID(row.Pipeline.DatasourceUUID))
+	if err != nil {
+		log.Error("failed to get datasource", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to get datasource"))
+	}
+	subject, ok := registry.FetchSubjects[ds.Datasource.Type]
+	if !ok {
+		return nil, ErrWithCode(http.StatusBadRequest, E("pipelines of %s datasources cannot be run", ds.Datasource.Type))
+	}
+	if storageUUID, ok := run.StorageUUID.Get(); ok {
+		pgStorageUUID, err := converter.ConvertStringToPgUUID(storageUUID)
+		if err != nil {
+			return nil, ErrWithCode(http.StatusBadRequest, E("invalid storage uuid"))
+		}
+		if _, err := q.GetStorage(ctx, pgStorageUUID); errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrWithCode(http.StatusNotFound, E("storage not found"))
+		} else if err != nil {
+			log.Error("failed to get storage", "error", err)
+			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to get storage"))
+		}
+		opts.StorageUUID = storageUUID
+	}
+
+	// manual runs have no scheduler, they are recorded under the pipeline only
+	jobUUID := uuid.Must(uuid.NewV7())
+	err = h.wbr.EnqueueTracked(ctx, subject, nil, &pipelineUUID, jobUUID, scheduler.PipelineJobArgs{
+		JobUUID:      jobUUID.String(),
+		PipelineUUID: pipelineUUID.String(),
+		LastFetched:  time.Now().UTC(),
+		RunOptions:   opts,
+	})
+	if err != nil {
+		log.Error("failed to enqueue pipeline run", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to enqueue pipeline run"))
+	}
+	log.Info("pipeline run enqueued", "pipeline_uuid", pipelineUUID, "job_uuid", jobUUID, "dry_run", opts.DryRun)
+
+	run.JobUUID = api.NewOptString(jobUUID.String())
+	return &run, nil
+}
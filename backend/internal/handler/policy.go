@@ -0,0 +1,215 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-faster/jx"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/ory/ladon"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/policies"
+	"github.com/shadowapi/shadowapi/backend/internal/session"
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// PolicyCreate creates an access policy.
+// POST /policy
+func (h *Handler) PolicyCreate(ctx context.Context, req *api.Policy) (*api.Policy, error) {
+	log := h.log.With("handler", "PolicyCreate")
+	if err := h.requireSystemAdmin(ctx); err != nil {
+		return nil, err
+	}
+	policy, err := apiToLadonPolicy(req)
+	if err != nil {
+		return nil, err
+	}
+	manager := h.pol.Manager()
+	if _, err := manager.GetRow(ctx, policy.ID); err == nil {
+		return nil, ErrWithCode(http.StatusConflict, E("access policy %s already exists", policy.ID))
+	}
+	if err := manager.Create(ctx, policy); err != nil {
+		log.Error("failed to create access policy", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to create access policy"))
+	}
+	return h.PolicyGet(ctx, api.PolicyGetParams{ID: policy.ID})
+}
+
+// PolicyGet returns an access policy.
+// GET /policy/{id}
+func (h *Handler) PolicyGet(ctx context.Context, params api.PolicyGetParams) (*api.Policy, error) {
+	log := h.log.With("handler", "PolicyGet")
+	row, err := h.pol.Manager().GetRow(ctx, params.ID)
+	if errors.Is(err, policies.ErrNotFound) {
+		return nil, ErrWithCode(http.StatusNotFound, E("access policy not found"))
+	} else if err != nil {
+		log.Error("failed to get access policy", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to get access policy"))
+	}
+	out, err := qToApiPolicy(row)
+	if err != nil {
+		log.Error("failed to decode access policy", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to decode access policy"))
+	}
+	return &out, nil
+}
+
+// PolicyList lists access policies, built-in ones first.
+// GET /policy
+func (h *Handler) PolicyList(ctx context.Context, params api.PolicyListParams) ([]api.Policy, error) {
+	log := h.log.With("handler", "PolicyList")
+	rows, err := query.New(h.dbp).GetAccessPolicies(ctx, query.GetAccessPoliciesParams{
+		Offset: params.Offset.Or(0),
+		Limit:  params.Limit.Or(100),
+	})
+	if err != nil {
+		log.Error("failed to list access policies", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to list access policies"))
+	}
+	out := make([]api.Policy, 0, len(rows))
+	for _, row := range rows {
+		p, err := qToApiPolicy(row)
+		if err != nil {
+			log.Error("failed to decode access policy", "id", row.ID, "error", err)
+			continue
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+// PolicyUpdate replaces an access policy.
+// PUT /policy/{id}
+func (h *Handler) PolicyUpdate(ctx context.Context, req *api.Policy, params api.PolicyUpdateParams) (*api.Policy, error) {
+	log := h.log.With("handler", "PolicyUpdate")
+	if err := h.requireSystemAdmin(ctx); err != nil {
+		return nil, err
+	}
+	req.ID = params.ID
+	policy, err := apiToLadonPolicy(req)
+	if err != nil {
+		return nil, err
+	}
+	err = h.pol.Manager().Update(ctx, policy)
+	if errors.Is(err, policies.ErrNotFound) {
+		return nil, ErrWithCode(http.StatusNotFound, E("access policy not found"))
+	} else if err != nil {
+		log.Error("failed to update access policy", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to update access policy"))
+	}
+	return h.PolicyGet(ctx, api.PolicyGetParams{ID: params.ID})
+}
+
+// PolicyDelete deletes an access policy.
+// DELETE /policy/{id}
+func (h *Handler) PolicyDelete(ctx context.Context, params api.PolicyDeleteParams) error {
+	log := h.log.With("handler", "PolicyDelete")
+	if err := h.requireSystemAdmin(ctx); err != nil {
+		return err
+	}
+	err := h.pol.Manager().Delete(ctx, params.ID)
+	switch {
+	case errors.Is(err, policies.ErrNotFound):
+		return ErrWithCode(http.StatusNotFound, E("access policy not found"))
+	case errors.Is(err, policies.ErrBuiltin):
+		return ErrWithCode(http.StatusBadRequest, E("built-in access policies cannot be deleted, update them instead"))
+	case err != nil:
+		log.Error("failed to delete access policy", "error", err)
+		return ErrWithCode(http.StatusInternalServerError, E("failed to delete access policy"))
+	}
+	return nil
+}
+
+// requireSystemAdmin fails with 403 unless the caller is the bearer token or
+// an administrator user. Access policies apply to every workspace, so a
+// workspace role is not enough to change them.
+func (h *Handler) requireSystemAdmin(ctx context.Context) error {
+	ident, ok := session.GetIdentity(ctx)
+	if !ok {
+		return ErrWithCode(http.StatusUnauthorized, E("unauthorized"))
+	}
+	if ident.IsMachine() {
+		return nil
+	}
+	userUUID, err := uuid.FromString(ident.ID)
+	if err != nil {
+		return ErrWithCode(http.StatusBadRequest, E("invalid user id"))
+	}
+	user, err := query.New(h.dbp).GetUser(ctx, converter.UuidToPgUUID(userUUID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrWithCode(http.StatusUnauthorized, E("unauthorized"))
+	} else if err != nil {
+		h.log.Error("failed to get user", "error", err)
+		return ErrWithCode(http.StatusInternalServerError, E("failed to get user"))
+	}
+	if !user.IsAdmin {
+		return ErrWithCode(http.StatusForbidden, E("requires an administrator"))
+	}
+	return nil
+}
+
+func apiToLadonPolicy(req *api.Policy) (*ladon.DefaultPolicy, error) {
+	if req.ID == "" {
+		return nil, ErrWithCode(http.StatusBadRequest, E("id is required"))
+	}
+	if err := req.Effect.Validate(); err != nil {
+		return nil, ErrWithCode(http.StatusBadRequest, E("invalid effect: %w", err))
+	}
+	policy := &ladon.DefaultPolicy{
+		ID:          req.ID,
+		Description: req.Description.Or(""),
+		Subjects:    req.Subjects,
+		Resources:   req.Resources,
+		Actions:     req.Actions,
+		Effect:      string(req.Effect),
+		Conditions:  ladon.Conditions{},
+	}
+	if req.Conditions.IsSet() && len(req.Conditions.Value) > 0 {
+		raw, err := json.Marshal(req.Conditions.Value)
+		if err != nil {
+			return nil, ErrWithCode(http.StatusBadRequest, E("invalid conditions: %w", err))
+		}
+		if err := policy.Conditions.UnmarshalJSON(raw); err != nil {
+			return nil, ErrWithCode(http.StatusBadRequest, E("invalid conditions: %w", err))
+		}
+	}
+	return policy, nil
+}
+
+func qToApiPolicy(row query.AccessPolicy) (api.Policy, error) {
+	out := api.Policy{
+		ID:          row.ID,
+		Description: api.NewOptString(row.Description),
+		Effect:      api.PolicyEffect(row.Effect),
+		IsBuiltin:   api.NewOptBool(row.IsBuiltin),
+		CreatedAt:   api.NewOptDateTime(row.CreatedAt.Time),
+	}
+	if row.UpdatedAt.Valid {
+		out.UpdatedAt = api.NewOptDateTime(row.UpdatedAt.Time)
+	}
+	if err := json.Unmarshal(row.Subjects, &out.Subjects); err != nil {
+		return out, err
+	}
+	if err := json.Unmarshal(row.Resources, &out.Resources); err != nil {
+		return out, err
+	}
+	if err := json.Unmarshal(row.Actions, &out.Actions); err != nil {
+		return out, err
+	}
+	var conditions map[string]json.RawMessage
+	if err := json.Unmarshal(row.Conditions, &conditions); err != nil {
+		return out, err
+	}
+	if len(conditions) > 0 {
+		out.Conditions = api.NewOptPolicyConditions(make(api.PolicyConditions, len(conditions)))
+		for k, v := range conditions {
+			out.Conditions.Value[k] = jx.Raw(v)
+		}
+	}
+	return out, nil
+}
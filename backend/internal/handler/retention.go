@@ -0,0 +1,286 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/retention"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/jobs"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/registry"
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// RetentionPolicyCreate creates a retention policy.
+// POST /retention
+func (h *Handler) RetentionPolicyCreate(ctx context.Context, req *api.RetentionPolicy) (*api.RetentionPolicy, error) {
+	log := h.log.With("handler", "RetentionPolicyCreate")
+	params, err := apiToRetentionPolicyParams(req)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := query.New(h.dbp).CreateRetentionPolicy(ctx, query.CreateRetentionPolicyParams{
+		UUID:            converter.UuidToPgUUID(uuid.Must(uuid.NewV7())),
+		Name:            params.Name,
+		DatasourceUUID:  params.DatasourceUUID,
+		PipelineUuid:    params.PipelineUuid,
+		KeepDays:        params.KeepDays,
+		KeepLast:        params.KeepLast,
+		AttachmentsDays: params.AttachmentsDays,
+		IsEnabled:       params.IsEnabled,
+	})
+	if err != nil {
+		log.Error("failed to create retention policy", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to create retention policy"))
+	}
+	out := qToApiRetentionPolicy(policy)
+	return &out, nil
+}
+
+// RetentionPolicyGet returns a retention policy.
+// GET /retention/{uuid}
+func (h *Handler) RetentionPolicyGet(ctx context.Context, params api.RetentionPolicyGetParams) (*api.RetentionPolicy, error) {
+	log := h.log.With("handler", "RetentionPolicyGet")
+	policyUUID, err := uuid.FromString(params.UUID)
+	if err != nil {
+		return nil, ErrWithCode(http.StatusBadRequest, E("invalid retention policy uuid"))
+	}
+	row, err := query.New(h.dbp).GetRetentionPolicy(ctx, converter.UuidToPgUUID(policyUUID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrWithCode(http.StatusNotFound, E("retention policy not found"))
+	} else if err != nil {
+		log.Error("failed to get retention policy", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to get retention policy"))
+	}
+	out := qToApiRetentionPolicy(row.RetentionPolicy)
+	return &out, nil
+}
+
+// RetentionPolicyList lists retention policies.
+// GET /retention
+func (h *Handler) RetentionPolicyList(ctx context.Context, params api.RetentionPolicyListParams) ([]api.RetentionPolicy, error) {
+	log := h.log.With("handler", "RetentionPolicyList")
+	rows, err := query.New(h.dbp).GetRetentionPolicies(ctx, query.GetRetentionPoliciesParams{
+		IsEnabled: -1,
+		Offset:    params.Offset.Or(0),
+		Limit:     params.Limit.Or(50),
+	})
+	if err != nil {
+		log.Error("failed to list retention policies", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to list retention policies"))
+	}
+	out := make([]api.RetentionPolicy, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, qToApiRetentionPolicy(row.RetentionPolicy))
+	}
+	return out, nil
+}
+
+// RetentionPolicyUpdate updates a retention policy.
+// PUT /retention/{uuid}
+func (h *Handler) RetentionPolicyUpdate(ctx context.Context, req *api.RetentionPolicy, params api.RetentionPolicyUpdateParams) (*api.RetentionPolicy, error) {
+	log := h.log.With("handler", "RetentionPolicyUpdate")
+	policyUUID, err := uuid.FromString(params.UUID)
+	if err != nil {
+		return nil, ErrWithCode(http.StatusBadRequest, E("invalid retention policy uuid"))
+	}
+	update, err := apiToRetentionPolicyParams(req)
+	if err != nil {
+		return nil, err
+	}
+	update.UUID = converter.UuidToPgUUID(policyUUID)
+
+	q := query.New(h.dbp)
+	if _, err := q.GetRetentionPolicy(ctx, update.UUID); errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrWithCode(http.StatusNotFound, E("retention policy not found"))
+	} else if err != nil {
+		log.Error("failed to get retention policy", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to get retention policy"))
+	}
+	if err := q.UpdateRetentionPolicy(ctx, update); err != nil {
+		log.Error("failed to update retention policy", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to update retention policy"))
+	}
+	return h.RetentionPolicyGet(ctx, api.RetentionPolicyGetParams{UUID: params.UUID})
+}
+
+// RetentionPolicyDelete deletes a retention policy.
+// DELETE /retention/{uuid}
+func (h *Handler) RetentionPolicyDelete(ctx context.Context, params api.RetentionPolicyDeleteParams) error {
+	log := h.log.With("handler", "RetentionPolicyDelete")
+	policyUUID, err := uuid.FromString(params.UUID)
+	if err != nil {
+		return ErrWithCode(http.StatusBadRequest, E("invalid retention policy uuid"))
+	}
+	if err := query.New(h.dbp).DeleteRetentionPolicy(ctx, converter.UuidToPgUUID(policyUUID)); err != nil {
+		log.Error("failed to delete retention policy", "error", err)
+		return ErrWithCode(http.StatusInternalServerError, E("failed to delete retention policy"))
+	}
+	return nil
+}
+
+// RetentionPolicyRun enqueues a retention job for a single policy.
+// POST /retention/{uuid}/run
+func (h *Handler) RetentionPolicyRun(ctx context.Context, params api.RetentionPolicyRunParams) error {
+	log := h.log.With("handler", "RetentionPolicyRun")
+	policyUUID, err := uuid.FromString(params.UUID)
+	if err != nil {
+		return ErrWithCode(http.StatusBadRequest, E("invalid retention policy uuid"))
+	}
+	if _, err := query.New(h.dbp).GetRetentionPolicy(ctx, converter.UuidToPgUUID(policyUUID)); errors.Is(err, pgx.ErrNoRows) {
+		return ErrWithCode(http.StatusNotFound, E("retention policy not found"))
+	} else if err != nil {
+		log.Error("failed to get retention policy", "error", err)
+		return ErrWithCode(http.StatusInternalServerError, E("failed to get retention policy"))
+	}
+
+	jobUUID := uuid.Must(uuid.NewV7()).String()
+	err = h.wbr.Enqueue(ctx, registry.WorkerSubjectRetention, jobUUID, jobs.RetentionJobArgs{
+		SchedulerUUID: policyUUID.String(),
+		JobUUID:       jobUUID,
+		PolicyUUID:    &policyUUID,
+	})
+	if err != nil {
+		log.Error("failed to enqueue retention job", "error", err)
+		return ErrWithCode(http.StatusInternalServerError, E("failed to enqueue retention job"))
+	}
+	return nil
+}
+
+// ErasureCreate erases a subject (email address or phone number) and returns the report.
+// POST /erasure
+func (h *Handler) ErasureCreate(ctx context.Context, req *api.ErasureRequest) (*api.ErasureRequest, error) {
+	log := h.log.With("handler", "ErasureCreate")
+	report, err := retention.NewEnforcer(h.log, h.dbp).Erase(ctx, req.Subject, string(req.Mode), req.DryRun.Value)
+	if errors.Is(err, retention.ErrInvalidSubject) {
+		return nil, ErrWithCode(http.StatusBadRequest, E("%s", err.Error()))
+	} else if err != nil {
+		// a failed run is still recorded with its partial report and can be retried
+		log.Error("subject erasure failed", "erasure_uuid", report.UUID, "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("subject erasure failed"))
+	}
+
+	out := api.ErasureRequest{
+		Subject: report.Subject,
+		Mode:    req.Mode,
+		DryRun:  api.NewOptBool(report.DryRun),
+		Status:  api.NewOptString("done"),
+		Report:  api.NewOptErasureReport(reportToApi(report)),
+	}
+	if report.UUID != "" {
+		out.UUID = api.NewOptString(report.UUID)
+	}
+	return &out, nil
+}
+
+// ErasureList lists executed erasure requests.
+// GET /erasure
+func (h *Handler) ErasureList(ctx context.Context, params api.ErasureListParams) ([]api.ErasureRequest, error) {
+	log := h.log.With("handler", "ErasureList")
+	rows, err := query.New(h.dbp).GetErasureRequests(ctx, query.GetErasureRequestsParams{
+		Offset: params.Offset.Or(0),
+		Limit:  params.Limit.Or(50),
+	})
+	if err != nil {
+		log.Error("failed to list erasure requests", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to list erasure requests"))
+	}
+	out := make([]api.ErasureRequest, 0, len(rows))
+	for _, row := range rows {
+		r := row.ErasureRequest
+		item := api.ErasureRequest{
+			UUID:    api.NewOptString(r.UUID.String()),
+			Subject: r.Subject,
+			Mode:    api.ErasureRequestMode(r.Mode),
+			Status:  api.NewOptString(r.Status),
+		}
+		var report retention.ErasureReport
+		if err := json.Unmarshal(r.Report, &report); err == nil {
+			item.Report = api.NewOptErasureReport(reportToApi(report))
+		}
+		if r.Error.Valid {
+			item.Error = api.NewOptString(r.Error.String)
+		}
+		if r.CreatedAt.Valid {
+			item.CreatedAt = api.NewOptDateTime(r.CreatedAt.Time)
+		}
+		if r.FinishedAt.Valid {
+			item.FinishedAt = api.NewOptDateTime(r.FinishedAt.Time)
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+func reportToApi(r retention.ErasureReport) api.ErasureReport {
+	return api.ErasureReport{
+		MessageUuids:       r.MessageUUIDs,
+		FileUuids:          r.FileUUIDs,
+		ContactUuids:       r.ContactUUIDs,
+		MessagesDeleted:    api.NewOptInt64(r.MessagesDeleted),
+		MessagesAnonymized: api.NewOptInt64(r.MessagesAnonymized),
+		FilesDeleted:       api.NewOptInt64(r.FilesDeleted),
+		BytesDeleted:       api.NewOptInt64(r.BytesDeleted),
+		ContactsDeleted:    api.NewOptInt64(r.ContactsDeleted),
+		ContactsAnonymized: api.NewOptInt64(r.ContactsAnonymized),
+	}
+}
+
+func apiToRetentionPolicyParams(req *api.RetentionPolicy) (query.UpdateRetentionPolicyParams, error) {
+	var out query.UpdateRetentionPolicyParams
+	if req.Name == "" {
+		return out, ErrWithCode(http.StatusBadRequest, E("name is required"))
+	}
+	if req.KeepDays.Value < 0 || req.KeepLast.Value < 0 || req.AttachmentsDays.Value < 0 {
+		return out, ErrWithCode(http.StatusBadRequest, E("retention rules must not be negative"))
+	}
+	datasourceUUID, err := converter.ConvertOptStringToPgUUID(req.DatasourceUUID)
+	if err != nil {
+		return out, ErrWithCode(http.StatusBadRequest, E("invalid datasource uuid"))
+	}
+	pipelineUUID, err := converter.ConvertOptStringToPgUUID(req.PipelineUUID)
+	if err != nil {
+		return out, ErrWithCode(http.StatusBadRequest, E("invalid pipeline uuid"))
+	}
+	out.Name = req.Name
+	out.DatasourceUUID = datasourceUUID
+	out.PipelineUuid = pipelineUUID
+	out.KeepDays = req.KeepDays.Value
+	out.KeepLast = req.KeepLast.Value
+	out.AttachmentsDays = req.AttachmentsDays.Value
+	out.IsEnabled = req.IsEnabled.Or(true)
+	return out, nil
+}
+
+func qToApiRetentionPolicy(p query.RetentionPolicy) api.RetentionPolicy {
+	out := api.RetentionPolicy{
+		UUID:            api.NewOptString(p.UUID.String()),
+		Name:            p.Name,
+		KeepDays:        api.NewOptInt32(p.KeepDays),
+		KeepLast:        api.NewOptInt32(p.KeepLast),
+		AttachmentsDays: api.NewOptInt32(p.AttachmentsDays),
+		IsEnabled:       api.NewOptBool(p.IsEnabled),
+	}
+	if p.DatasourceUUID != nil {
+		out.DatasourceUUID = api.NewOptString(p.DatasourceUUID.String())
+	}
+	if p.PipelineUuid != nil {
+		out.PipelineUUID = api.NewOptString(p.PipelineUuid.String())
+	}
+	if p.LastRunAt.Valid {
+		out.LastRunAt = api.NewOptDateTime(p.LastRunAt.Time)
+	}
+	if p.CreatedAt.Valid {
+		out.CreatedAt = api.NewOptDateTime(p.CreatedAt.Time)
+	}
+	if p.UpdatedAt.Valid {
+		out.UpdatedAt = api.NewOptDateTime(p.UpdatedAt.Time)
+	}
+	return out
+}
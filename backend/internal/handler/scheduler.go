@@ -7,8 +7,11 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shadowapi/shadowapi/backend/internal/converter"
 	"net/http"
+	"slices"
+	"time"
 
 	"github.com/shadowapi/shadowapi/backend/internal/db"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/scheduler"
 	"github.com/shadowapi/shadowapi/backend/pkg/api"
 	"github.com/shadowapi/shadowapi/backend/pkg/query"
 )
@@ -18,6 +21,14 @@ import (
 // POST /scheduler
 func (h *Handler) SchedulerCreate(ctx context.Context, req *api.Scheduler) (*api.Scheduler, error) {
 	log := h.log.With("handler", "SchedulerCreate")
+	schedule, nextRun, err := requestSchedule(req)
+	if err != nil {
+		return nil, err
+	}
+	overlap, err := requestOverlapPolicy(req, scheduler.OverlapSkip)
+	if err != nil {
+		return nil, err
+	}
 	return db.InTx(ctx, h.dbp, func(tx pgx.Tx) (*api.Scheduler, error) {
 		// Generate a new UUID for the scheduler
 		schedulerUUID := uuid.Must(uuid.NewV7())
@@ -36,15 +47,19 @@ func (h *Handler) SchedulerCreate(ctx context.Context, req *api.Scheduler) (*api
 
 		// Build query parameters for the new scheduler.
 		qParams := query.CreateSchedulerParams{
-			UUID:           pgtype.UUID{Bytes: converter.UToBytes(schedulerUUID), Valid: true},
-			PipelineUuid:   pgPipelineUUID,
-			ScheduleType:   req.ScheduleType, // 'cron' or 'one_time'
-			CronExpression: converter.ConvertOptNilStringToPgText(req.CronExpression),
-			RunAt:          converter.NullTimestamptz(),
-			Timezone:       req.Timezone.Or("UTC"),
-			NextRun:        converter.NullTimestamptz(),
-			LastRun:        converter.NullTimestamptz(),
-			IsEnabled:      req.IsEnabled.Or(false),
+			UUID:            pgtype.UUID{Bytes: converter.UToBytes(schedulerUUID), Valid: true},
+			PipelineUuid:    pgPipelineUUID,
+			ScheduleType:    schedule.Type,
+			CronExpression:  converter.ConvertOptNilStringToPgText(req.CronExpression),
+			RunAt:           scheduleTime(schedule.RunAt),
+			Timezone:        req.Timezone.Or("UTC"),
+			NextRun:         nextRun,
+			LastRun:         converter.NullTimestamptz(),
+			IsEnabled:       req.IsEnabled.Or(false),
+			IsPaused:        req.IsPaused.Or(false),
+			IntervalSeconds: scheduleInterval(schedule),
+			JitterSeconds:   req.JitterSeconds.Or(0),
+			OverlapPolicy:   overlap,
 		}
 
 		sch, err := query.New(tx).CreateScheduler(ctx, qParams)
@@ -166,27 +181,34 @@ func (h *Handler) SchedulerUpdate(ctx context.Context, req *api.Scheduler, param
 		log.Error("invalid scheduler uuid", "error", err)
 		return nil, ErrWithCode(http.StatusBadRequest, E("invalid scheduler uuid"))
 	}
+	schedule, nextRun, err := requestSchedule(req)
+	if err != nil {
+		return nil, err
+	}
 	return db.InTx(ctx, h.dbp, func(tx pgx.Tx) (*api.Scheduler, error) {
-		scheduler, err := query.New(tx).GetScheduler(ctx, schUUID)
+		prev, err := query.New(tx).GetScheduler(ctx, schUUID)
 		if err != nil {
 			log.Error("failed to get scheduler", "error", err)
 			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to get scheduler"))
 		}
-		var isEnabled bool
-		if req.IsEnabled.IsSet() {
-			isEnabled = req.IsEnabled.Value
-		} else {
-			isEnabled = scheduler.Scheduler.IsEnabled
+		overlap, err := requestOverlapPolicy(req, prev.Scheduler.OverlapPolicy)
+		if err != nil {
+			return nil, err
 		}
 
 		uParams := query.UpdateSchedulerParams{
-			CronExpression: converter.ConvertOptNilStringToPgText(req.CronExpression),
-			RunAt:          converter.NullTimestamptz(),
-			Timezone:       req.Timezone.Or("UTC"),
-			NextRun:        converter.NullTimestamptz(),
-			LastRun:        converter.NullTimestamptz(),
-			IsEnabled:      isEnabled,
-			UUID:           schUUID,
+			ScheduleType:    schedule.Type,
+			CronExpression:  converter.ConvertOptNilStringToPgText(req.CronExpression),
+			RunAt:           scheduleTime(schedule.RunAt),
+			Timezone:        req.Timezone.Or("UTC"),
+			NextRun:         nextRun,
+			LastRun:         prev.Scheduler.LastRun,
+			IsEnabled:       req.IsEnabled.Or(prev.Scheduler.IsEnabled),
+			IsPaused:        req.IsPaused.Or(prev.Scheduler.IsPaused),
+			IntervalSeconds: scheduleInterval(schedule),
+			JitterSeconds:   req.JitterSeconds.Or(prev.Scheduler.JitterSeconds),
+			OverlapPolicy:   overlap,
+			UUID:            schUUID,
 		}
 		err = query.New(tx).UpdateScheduler(ctx, uParams)
 		if err != nil {
@@ -207,6 +229,52 @@ func (h *Handler) SchedulerUpdate(ctx context.Context, req *api.Scheduler, param
 	})
 }
 
+// requestSchedule validates the schedule of a scheduler request and returns it
+// with its first run.
+func requestSchedule(req *api.Scheduler) (scheduler.Schedule, pgtype.Timestamptz, error) {
+	schedule := scheduler.Schedule{
+		Type:     req.ScheduleType,
+		Cron:     req.CronExpression.Or(""),
+		Timezone: req.Timezone.Or("UTC"),
+		Jitter:   time.Duration(req.JitterSeconds.Or(0)) * time.Second,
+	}
+	if runAt, ok := req.RunAt.Get(); ok && schedule.Type == scheduler.TypeOneTime {
+		schedule.RunAt = runAt
+	}
+	if interval, ok := req.IntervalSeconds.Get(); ok {
+		schedule.Interval = time.Duration(interval) * time.Second
+	}
+	if err := schedule.Validate(); err != nil {
+		return schedule, pgtype.Timestamptz{}, ErrWithCode(http.StatusBadRequest, E("%s", err.Error()))
+	}
+	first, err := schedule.First(time.Now())
+	if err != nil {
+		return schedule, pgtype.Timestamptz{}, ErrWithCode(http.StatusBadRequest, E("%s", err.Error()))
+	}
+	return schedule, scheduleTime(first), nil
+}
+
+// requestOverlapPolicy returns the overlap policy of a scheduler request, def
+// when it has none.
+func requestOverlapPolicy(req *api.Scheduler, def string) (string, error) {
+	policy := req.OverlapPolicy.Or(def)
+	if !slices.Contains(scheduler.OverlapPolicies, policy) {
+		return "", ErrWithCode(http.StatusBadRequest, E("unknown overlap policy %q, use skip, queue or cancel", policy))
+	}
+	return policy, nil
+}
+
+func scheduleTime(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: t, Valid: !t.IsZero()}
+}
+
+func scheduleInterval(s scheduler.Schedule) pgtype.Int4 {
+	if s.Type != scheduler.TypeInterval {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: int32(s.Interval / time.Second), Valid: true}
+}
+
 // qToApiScheduler converts a query.Scheduler to an api.Scheduler.
 func qToApiScheduler(s query.Scheduler) (api.Scheduler, error) {
 	// Map fields from the query type to your API type.
@@ -220,9 +288,16 @@ func qToApiScheduler(s query.Scheduler) (api.Scheduler, error) {
 		NextRun:        api.NewOptDateTime(s.NextRun.Time),
 		LastRun:        api.NewOptDateTime(s.LastRun.Time),
 		IsEnabled:      api.NewOptBool(s.IsEnabled),
+		IsPaused:       api.NewOptBool(s.IsPaused),
+		PausedReason:   api.NewOptString(s.PausedReason),
+		JitterSeconds:  api.NewOptInt32(s.JitterSeconds),
+		OverlapPolicy:  api.NewOptString(s.OverlapPolicy),
 		CreatedAt:      api.NewOptDateTime(s.CreatedAt.Time),
 		UpdatedAt:      api.NewOptDateTime(s.UpdatedAt.Time),
 	}
+	if s.IntervalSeconds.Valid {
+		out.IntervalSeconds = api.NewOptNilInt32(s.IntervalSeconds.Int32)
+	}
 	return out, nil
 }
 
@@ -239,9 +314,16 @@ func qToApiSchedulerRow(s query.GetSchedulerRow) (api.Scheduler, error) {
 		NextRun:        api.NewOptDateTime(s.Scheduler.NextRun.Time),
 		LastRun:        api.NewOptDateTime(s.Scheduler.LastRun.Time),
 		IsEnabled:      api.NewOptBool(s.Scheduler.IsEnabled),
+		IsPaused:       api.NewOptBool(s.Scheduler.IsPaused),
+		PausedReason:   api.NewOptString(s.Scheduler.PausedReason),
+		JitterSeconds:  api.NewOptInt32(s.Scheduler.JitterSeconds),
+		OverlapPolicy:  api.NewOptString(s.Scheduler.OverlapPolicy),
 		CreatedAt:      api.NewOptDateTime(s.Scheduler.CreatedAt.Time),
 		UpdatedAt:      api.NewOptDateTime(s.Scheduler.UpdatedAt.Time),
 	}
+	if s.Scheduler.IntervalSeconds.Valid {
+		out.IntervalSeconds = api.NewOptNilInt32(s.Scheduler.IntervalSeconds.Int32)
+	}
 	return out, nil
 }
 
@@ -257,9 +339,16 @@ func qToApiSchedulersRow(s query.GetSchedulersRow) (api.Scheduler, error) {
 		NextRun:        api.NewOptDateTime(s.NextRun.Time),
 		LastRun:        api.NewOptDateTime(s.LastRun.Time),
 		IsEnabled:      api.NewOptBool(s.IsEnabled),
+		IsPaused:       api.NewOptBool(s.IsPaused),
+		PausedReason:   api.NewOptString(s.PausedReason),
+		JitterSeconds:  api.NewOptInt32(s.JitterSeconds),
+		OverlapPolicy:  api.NewOptString(s.OverlapPolicy),
 		CreatedAt:      api.NewOptDateTime(s.CreatedAt.Time),
 		UpdatedAt:      api.NewOptDateTime(s.UpdatedAt.Time),
 	}
+	if s.IntervalSeconds.Valid {
+		out.IntervalSeconds = api.NewOptNilInt32(s.IntervalSeconds.Int32)
+	}
 	return out, nil
 }
 
@@ -275,8 +364,15 @@ func qToApiListSchedulersRow(s query.ListSchedulersRow) (api.Scheduler, error) {
 		NextRun:        api.NewOptDateTime(s.Scheduler.NextRun.Time),
 		LastRun:        api.NewOptDateTime(s.Scheduler.LastRun.Time),
 		IsEnabled:      api.NewOptBool(s.Scheduler.IsEnabled),
+		IsPaused:       api.NewOptBool(s.Scheduler.IsPaused),
+		PausedReason:   api.NewOptString(s.Scheduler.PausedReason),
+		JitterSeconds:  api.NewOptInt32(s.Scheduler.JitterSeconds),
+		OverlapPolicy:  api.NewOptString(s.Scheduler.OverlapPolicy),
 		CreatedAt:      api.NewOptDateTime(s.Scheduler.CreatedAt.Time),
 		UpdatedAt:      api.NewOptDateTime(s.Scheduler.UpdatedAt.Time),
 	}
+	if s.Scheduler.IntervalSeconds.Valid {
+		out.IntervalSeconds = api.NewOptNilInt32(s.Scheduler.IntervalSeconds.Int32)
+	}
 	return out, nil
 }
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/storages"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/jobs"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/registry"
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// StorageMigrate starts or resumes moving files from one storage to another.
+// POST /storage/{uuid}/migrate
+func (h *Handler) StorageMigrate(ctx context.Context, req *api.StorageMigration, params api.StorageMigrateParams) (*api.StorageMigration, error) {
+	log := h.log.With("handler", "StorageMigrate")
+	sourceUUID, err := uuid.FromString(params.UUID)
+	if err != nil {
+		return nil, ErrWithCode(http.StatusBadRequest, E("invalid source storage uuid"))
+	}
+	targetUUID, err := uuid.FromString(req.TargetStorageUUID)
+	if err != nil {
+		return nil, ErrWithCode(http.StatusBadRequest, E("invalid target storage uuid"))
+	}
+	if req.OlderThanDays.Value < 0 || req.BatchSize.Value < 0 {
+		return nil, ErrWithCode(http.StatusBadRequest, E("older_than_days and batch_size must not be negative"))
+	}
+
+	mig, err := storages.NewMigrator(h.log, h.dbp).Prepare(ctx, sourceUUID, targetUUID, storages.MigrationOptions{
+		OlderThanDays: req.OlderThanDays.Value,
+		DeleteSource:  req.DeleteSource.Value,
+		BatchSize:     req.BatchSize.Value,
+	})
+	if errors.Is(err, storages.ErrSameStorage) {
+		return nil, ErrWithCode(http.StatusBadRequest, E("source and target storage are the same"))
+	} else if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrWithCode(http.StatusNotFound, E("storage not found"))
+	} else if err != nil {
+		log.Error("failed to prepare storage migration", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to prepare storage migration"))
+	}
+	if storages.MigrationActive(mig) {
+		return nil, ErrWithCode(http.StatusConflict, E("storage migration %s is already running", mig.UUID))
+	}
+
+	jobUUID := uuid.Must(uuid.NewV7()).String()
+	err = h.wbr.Enqueue(ctx, registry.WorkerSubjectStorageMigrate, jobUUID, jobs.StorageMigrateJobArgs{
+		JobUUID:       jobUUID,
+		MigrationUUID: mig.UUID,
+	})
+	if err != nil {
+		log.Error("failed to enqueue storage migration", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to enqueue storage migration"))
+	}
+
+	out := qToApiStorageMigration(mig)
+	return &out, nil
+}
+
+// StorageMigrationList lists migrations started from a storage.
+// GET /storage/{uuid}/migrate
+func (h *Handler) StorageMigrationList(ctx context.Context, params api.StorageMigrationListParams) ([]api.StorageMigration, error) {
+	log := h.log.With("handler", "StorageMigrationList")
+	sourceUUID, err := uuid.FromString(params.UUID)
+	if err != nil {
+		return nil, ErrWithCode(http.StatusBadRequest, E("invalid storage uuid"))
+	}
+
+	rows, err := query.New(h.dbp).GetStorageMigrations(ctx, query.GetStorageMigrationsParams{
+		SourceStorageUuid: converter.UuidToPgUUID(sourceUUID),
+		Status:            "",
+		Offset:            params.Offset.Or(0),
+		Limit:             params.Limit.Or(50),
+	})
+	if err != nil {
+		log.Error("failed to list storage migrations", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to list storage migrations"))
+	}
+
+	out := make([]api.StorageMigration, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, qToApiStorageMigration(row.StorageMigration))
+	}
+	return out, nil
+}
+
+func qToApiStorageMigration(mig query.StorageMigration) api.StorageMigration {
+	out := api.StorageMigration{
+		UUID:             api.NewOptString(mig.UUID.String()),
+		Status:           api.NewOptString(mig.Status),
+		OlderThanDays:    api.NewOptInt32(mig.OlderThanDays),
+		DeleteSource:     api.NewOptBool(mig.DeleteSource),
+		BatchSize:        api.NewOptInt32(mig.BatchSize),
+		FilesMigrated:    api.NewOptInt64(mig.FilesMigrated),
+		MessagesMigrated: api.NewOptInt64(mig.MessagesMigrated),
+		BytesMigrated:    api.NewOptInt64(mig.BytesMigrated),
+	}
+	if mig.SourceStorageUuid != nil {
+		out.SourceStorageUUID = api.NewOptString(mig.SourceStorageUuid.String())
+	}
+	if mig.TargetStorageUuid != nil {
+		out.TargetStorageUUID = mig.TargetStorageUuid.String()
+	}
+	if mig.CheckpointUuid != nil {
+		out.CheckpointUUID = api.NewOptString(mig.CheckpointUuid.String())
+	}
+	if mig.Error.Valid {
+		out.Error = api.NewOptString(mig.Error.String)
+	}
+	if mig.CreatedAt.Valid {
+		out.CreatedAt = api.NewOptDateTime(mig.CreatedAt.Time)
+	}
+	if mig.UpdatedAt.Valid {
+		out.UpdatedAt = api.NewOptDateTime(mig.UpdatedAt.Time)
+	}
+	if mig.FinishedAt.Valid {
+		out.FinishedAt = api.NewOptDateTime(mig.FinishedAt.Time)
+	}
+	return out
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/shadowapi/shadowapi/backend/internal/db"
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
 	"github.com/shadowapi/shadowapi/backend/pkg/api"
 	"github.com/shadowapi/shadowapi/backend/pkg/query"
 )
@@ -25,6 +26,10 @@ func (h *Handler) StoragePostgresCreate(ctx context.Context, req *api.StoragePos
 		log.Error("failed to marshal settings", "error", err)
 		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to marshal settings"))
 	}
+	if settings, err = secrets.SealFields(settings, nil, secrets.StorageFields["postgres"]...); err != nil {
+		log.Error("failed to encrypt settings", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to encrypt settings"))
+	}
 
 	// Extract underlying values from optional fields.
 	var isEnabled bool
@@ -47,6 +52,7 @@ func (h *Handler) StoragePostgresCreate(ctx context.Context, req *api.StoragePos
 	}
 
 	resp := *req
+	resp.Password = redactOpt(resp.Password)
 	resp.UUID = api.OptString{Value: storage.UUID.String(), Set: true}
 
 	return &resp, nil
@@ -117,6 +123,10 @@ func (h *Handler) StoragePostgresUpdate(ctx context.Context, req *api.StoragePos
 			log.Error("failed to marshal settings", "error", err)
 			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to marshal settings"))
 		}
+		if newSettings, err = secrets.SealFields(newSettings, storage.Storage.Settings, secrets.StorageFields["postgres"]...); err != nil {
+			log.Error("failed to encrypt settings", "error", err)
+			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to encrypt settings"))
+		}
 
 		if err := query.New(h.dbp).UpdateStorage(ctx, query.UpdateStorageParams{
 			UUID:      pgtype.UUID{Bytes: converter.UToBytes(storageUUID), Valid: true},
@@ -133,11 +143,20 @@ func (h *Handler) StoragePostgresUpdate(ctx context.Context, req *api.StoragePos
 	})
 }
 
+// redactOpt hides an optional secret in API responses.
+func redactOpt(v api.OptString) api.OptString {
+	if v.IsSet() {
+		v.Value = secrets.Redact(v.Value)
+	}
+	return v
+}
+
 func QToStoragePostgres(row query.GetStorageRow) (*api.StoragePostgres, error) {
 	var s api.StoragePostgres
 	if err := json.Unmarshal(row.Storage.Settings, &s); err != nil {
 		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to unmarshal postgres settings: %w", err))
 	}
+	s.Password = redactOpt(s.Password)
 	s.UUID = api.NewOptString(row.Storage.UUID.String())
 	s.Name = row.Storage.Name
 	s.IsEnabled = api.NewOptBool(row.Storage.IsEnabled)
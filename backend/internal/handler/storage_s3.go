@@ -11,6 +11,7 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/shadowapi/shadowapi/backend/internal/db"
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
 	"github.com/shadowapi/shadowapi/backend/pkg/api"
 	"github.com/shadowapi/shadowapi/backend/pkg/query"
 )
@@ -24,6 +25,10 @@ func (h *Handler) StorageS3Create(ctx context.Context, req *api.StorageS3) (*api
 		log.Error("failed to marshal s3 settings", "error", err)
 		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to marshal s3 settings"))
 	}
+	if settings, err = secrets.SealFields(settings, nil, secrets.StorageFields["s3"]...); err != nil {
+		log.Error("failed to encrypt settings", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to encrypt settings"))
+	}
 
 	var isEnabled bool
 	if req.IsEnabled.IsSet() {
@@ -45,6 +50,7 @@ func (h *Handler) StorageS3Create(ctx context.Context, req *api.StorageS3) (*api
 	}
 
 	resp := *req
+	resp.SecretAccessKey = secrets.Redact(resp.SecretAccessKey)
 	resp.UUID = api.NewOptString(storage.UUID.String())
 	return &resp, nil
 }
@@ -110,6 +116,10 @@ func (h *Handler) StorageS3Update(ctx context.Context, req *api.StorageS3, param
 			log.Error("failed to marshal s3 updated settings", "error", err)
 			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to marshal s3 updated settings"))
 		}
+		if newSettings, err = secrets.SealFields(newSettings, storage.Storage.Settings, secrets.StorageFields["s3"]...); err != nil {
+			log.Error("failed to encrypt settings", "error", err)
+			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to encrypt settings"))
+		}
 
 		if err := query.New(h.dbp).UpdateStorage(ctx, query.UpdateStorageParams{
 			UUID:      pgtype.UUID{Bytes: converter.UToBytes(s3UUID), Valid: true},
@@ -131,6 +141,7 @@ func QToStorageS3(row query.GetStorageRow) (*api.StorageS3, error) {
 	if err := json.Unmarshal(row.Storage.Settings, &stored); err != nil {
 		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to unmarshal s3 settings: %w", err))
 	}
+	stored.SecretAccessKey = secrets.Redact(stored.SecretAccessKey)
 	stored.UUID = api.NewOptString(row.Storage.UUID.String())
 	stored.Name = row.Storage.Name
 	stored.IsEnabled = api.NewOptBool(row.Storage.IsEnabled)
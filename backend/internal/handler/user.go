@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shadowapi/shadowapi/backend/internal/converter"
 	"net/http"
@@ -10,6 +11,7 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/shadowapi/shadowapi/backend/internal/db"
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
 	"github.com/shadowapi/shadowapi/backend/pkg/api"
 	"github.com/shadowapi/shadowapi/backend/pkg/query"
 )
@@ -48,6 +50,17 @@ func (h *Handler) CreateUser(ctx context.Context, req *api.User) (*api.User, err
 			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to create user: %w", err))
 		}
 
+		// the new user joins the workspace the request is scoped to
+		if wsUUID, ok := workspace.FromContext(ctx); ok {
+			if _, err := query.New(tx).UpsertWorkspaceMember(ctx, query.UpsertWorkspaceMemberParams{
+				WorkspaceUUID: converter.UuidToPgUUID(wsUUID),
+				UserUUID:      converter.UuidToPgUUID(created.UUID),
+				Role:          workspace.RoleOperator,
+			}); err != nil {
+				return nil, ErrWithCode(http.StatusInternalServerError, E("failed to add user to workspace: %w", err))
+			}
+		}
+
 		// Convert the stored meta bytes into an api.UserMeta.
 		var meta api.UserMeta
 		if len(created.Meta) > 0 {
@@ -188,6 +201,13 @@ func (h *Handler) UpdateUser(ctx context.Context, req *api.User, params api.Upda
 		return nil, ErrWithCode(http.StatusBadRequest, E("invalid user UUID"))
 	}
 	return db.InTx(ctx, h.dbp, func(tx pgx.Tx) (*api.User, error) {
+		prev, err := query.New(tx).GetUser(ctx, pgtype.UUID{Bytes: converter.UToBytes(userUUID), Valid: true})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrWithCode(http.StatusNotFound, E("user not found"))
+		} else if err != nil {
+			h.log.Error("failed to get user", "error", err)
+			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to get user"))
+		}
 		updateParams := query.UpdateUserParams{
 			UUID:      pgtype.UUID{Bytes: converter.UToBytes(userUUID), Valid: true},
 			Email:     req.Email,
@@ -196,6 +216,8 @@ func (h *Handler) UpdateUser(ctx context.Context, req *api.User, params api.Upda
 			LastName:  req.LastName,
 			IsEnabled: req.IsEnabled.Or(false),
 			IsAdmin:   req.IsAdmin.Or(false),
+			// the link to the identity provider is not part of the API model
+			ZitadelSubject: prev.ZitadelSubject,
 		}
 		// Handle Meta field (if provided)
 		if req.Meta.IsSet() && req.Meta.Value != nil {
@@ -0,0 +1,226 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-faster/jx"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/events"
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// WebhookCreate creates a webhook of the workspace.
+// POST /webhook
+func (h *Handler) WebhookCreate(ctx context.Context, req *api.Webhook) (*api.Webhook, error) {
+	log := h.log.With("handler", "WebhookCreate")
+	if err := h.validateWebhook(req); err != nil {
+		return nil, err
+	}
+	secret, err := secrets.Encrypt(req.Secret.Or(""))
+	if err != nil {
+		log.Error("failed to encrypt webhook secret", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to create webhook"))
+	}
+	w, err := query.New(h.dbp).CreateWebhook(ctx, query.CreateWebhookParams{
+		UUID:       converter.UuidToPgUUID(uuid.Must(uuid.NewV7())),
+		Name:       req.Name,
+		Url:        req.URL,
+		Secret:     secret,
+		EventTypes: webhookEventTypes(req.EventTypes),
+		IsEnabled:  req.IsEnabled.Or(true),
+	})
+	if err != nil {
+		log.Error("failed to create webhook", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to create webhook"))
+	}
+	out := qToApiWebhook(w)
+	return &out, nil
+}
+
+// WebhookGet returns a webhook.
+// GET /webhook/{uuid}
+func (h *Handler) WebhookGet(ctx context.Context, params api.WebhookGetParams) (*api.Webhook, error) {
+	log := h.log.With("handler", "WebhookGet")
+	w, err := h.getWebhook(ctx, log, params.UUID)
+	if err != nil {
+		return nil, err
+	}
+	out := qToApiWebhook(w)
+	return &out, nil
+}
+
+// WebhookList lists the webhooks of the workspace.
+// GET /webhook
+func (h *Handler) WebhookList(ctx context.Context) ([]api.Webhook, error) {
+	log := h.log.With("handler", "WebhookList")
+	rows, err := query.New(h.dbp).ListWebhooks(ctx)
+	if err != nil {
+		log.Error("failed to list webhooks", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to list webhooks"))
+	}
+	out := make([]api.Webhook, 0, len(rows))
+	for _, w := range rows {
+		out = append(out, qToApiWebhook(w))
+	}
+	return out, nil
+}
+
+// WebhookUpdate updates a webhook, a redacted secret keeps the stored one.
+// PUT /webhook/{uuid}
+func (h *Handler) WebhookUpdate(ctx context.Context, req *api.Webhook, params api.WebhookUpdateParams) (*api.Webhook, error) {
+	log := h.log.With("handler", "WebhookUpdate")
+	if err := h.validateWebhook(req); err != nil {
+		return nil, err
+	}
+	prev, err := h.getWebhook(ctx, log, params.UUID)
+	if err != nil {
+		return nil, err
+	}
+	secret := prev.Secret
+	if s := req.Secret.Or(secrets.Redacted); s != secrets.Redacted {
+		if secret, err = secrets.Encrypt(s); err != nil {
+			log.Error("failed to encrypt webhook secret", "error", err)
+			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to update webhook"))
+		}
+	}
+	w, err := query.New(h.dbp).UpdateWebhook(ctx, query.UpdateWebhookParams{
+		UUID:       converter.UuidToPgUUID(prev.UUID),
+		Name:       req.Name,
+		Url:        req.URL,
+		Secret:     secret,
+		EventTypes: webhookEventTypes(req.EventTypes),
+		IsEnabled:  req.IsEnabled.Or(prev.IsEnabled),
+	})
+	if err != nil {
+		log.Error("failed to update webhook", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to update webhook"))
+	}
+	out := qToApiWebhook(w)
+	return &out, nil
+}
+
+// WebhookDelete deletes a webhook.
+// DELETE /webhook/{uuid}
+func (h *Handler) WebhookDelete(ctx context.Context, params api.WebhookDeleteParams) error {
+	log := h.log.With("handler", "WebhookDelete")
+	webhookUUID, err := uuid.FromString(params.UUID)
+	if err != nil {
+		return ErrWithCode(http.StatusBadRequest, E("invalid webhook uuid"))
+	}
+	if err := query.New(h.dbp).DeleteWebhook(ctx, converter.UuidToPgUUID(webhookUUID)); err != nil {
+		log.Error("failed to delete webhook", "error", err)
+		return ErrWithCode(http.StatusInternalServerError, E("failed to delete webhook"))
+	}
+	return nil
+}
+
+// EventList lists the events of the workspace, newest first.
+// GET /event
+func (h *Handler) EventList(ctx context.Context, params api.EventListParams) ([]api.Event, error) {
+	log := h.log.With("handler", "EventList")
+	args := query.ListEventsParams{
+		Type:        params.Type.Or(""),
+		SubjectUuid: params.SubjectUUID.Or(""),
+		Offset:      params.Offset.Or(0),
+		Limit:       params.Limit.Or(100),
+	}
+	if after, ok := params.After.Get(); ok {
+		afterUUID, err := uuid.FromString(after)
+		if err != nil {
+			return nil, ErrWithCode(http.StatusBadRequest, E("invalid event uuid"))
+		}
+		args.AfterUuid = converter.UuidToPgUUID(afterUUID)
+	}
+	rows, err := query.New(h.dbp).ListEvents(ctx, args)
+	if err != nil {
+		log.Error("failed to list events", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to list events"))
+	}
+	out := make([]api.Event, 0, len(rows))
+	for _, e := range rows {
+		out = append(out, qToApiEvent(e))
+	}
+	return out, nil
+}
+
+func (h *Handler) getWebhook(ctx context.Context, log *slog.Logger, id string) (query.Webhook, error) {
+	webhookUUID, err := uuid.FromString(id)
+	if err != nil {
+		return query.Webhook{}, ErrWithCode(http.StatusBadRequest, E("invalid webhook uuid"))
+	}
+	w, err := query.New(h.dbp).GetWebhook(ctx, converter.UuidToPgUUID(webhookUUID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return query.Webhook{}, ErrWithCode(http.StatusNotFound, E("webhook not found"))
+	} else if err != nil {
+		log.Error("failed to get webhook", "error", err)
+		return query.Webhook{}, ErrWithCode(http.StatusInternalServerError, E("failed to get webhook"))
+	}
+	return w, nil
+}
+
+func (h *Handler) validateWebhook(req *api.Webhook) error {
+	if req.Name == "" {
+		return ErrWithCode(http.StatusBadRequest, E("name is required"))
+	}
+	if err := events.ValidateURL(req.URL, h.cfg.Webhooks.AllowPrivate); err != nil {
+		return ErrWithCode(http.StatusBadRequest, E("%s", err.Error()))
+	}
+	return nil
+}
+
+func webhookEventTypes(types []string) []string {
+	if types == nil {
+		return []string{}
+	}
+	return types
+}
+
+func qToApiWebhook(w query.Webhook) api.Webhook {
+	out := api.Webhook{
+		UUID:         api.NewOptString(w.UUID.String()),
+		Name:         w.Name,
+		URL:          w.Url,
+		Secret:       api.NewOptString(secrets.Redact(w.Secret)),
+		EventTypes:   w.EventTypes,
+		IsEnabled:    api.NewOptBool(w.IsEnabled),
+		LastStatus:   api.NewOptInt32(w.LastStatus),
+		LastError:    api.NewOptString(w.LastError),
+		FailureCount: api.NewOptInt32(w.FailureCount),
+	}
+	if w.CreatedAt.Valid {
+		out.CreatedAt = api.NewOptDateTime(w.CreatedAt.Time)
+	}
+	if w.UpdatedAt.Valid {
+		out.UpdatedAt = api.NewOptDateTime(w.UpdatedAt.Time)
+	}
+	return out
+}
+
+func qToApiEvent(e query.Event) api.Event {
+	out := api.Event{
+		UUID:        e.UUID.String(),
+		Type:        e.Type,
+		SubjectKind: api.NewOptString(e.SubjectKind),
+		Data:        api.EventData{},
+		CreatedAt:   e.CreatedAt.Time,
+	}
+	if e.SubjectUuid != nil {
+		out.SubjectUUID = api.NewOptString(e.SubjectUuid.String())
+	}
+	var data map[string]json.RawMessage
+	if err := json.Unmarshal(e.Data, &data); err == nil {
+		for k, v := range data {
+			out.Data[k] = jx.Raw(v)
+		}
+	}
+	return out
+}
@@ -55,10 +55,23 @@ func (h *Handler) WorkerJobsList(ctx context.Context, params api.WorkerJobsListP
 		offset = params.Offset.Value
 	}
 
-	q := query.New(h.dbp)
-	rows, err := q.ListWorkerJobs(ctx, query.ListWorkerJobsParams{
-		Offset: offset,
-		Limit:  limit,
+	var schedulerUUID pgtype.UUID
+	if id, ok := params.SchedulerUUID.Get(); ok {
+		schedulerUUID = pgtype.UUID{Bytes: id, Valid: true}
+	}
+	var pipelineUUID pgtype.UUID
+	if id, ok := params.PipelineUUID.Get(); ok {
+		pipelineUUID = pgtype.UUID{Bytes: id, Valid: true}
+	}
+	rows, err := query.New(h.dbp).GetWorkerJobs(ctx, query.GetWorkerJobsParams{
+		OrderBy:        "started_at",
+		OrderDirection: "desc",
+		Offset:         offset,
+		Limit:          limit,
+		SchedulerUuid:  schedulerUUID,
+		PipelineUuid:   pipelineUUID,
+		Subject:        params.Subject.Or(""),
+		Status:         params.Status.Or(""),
 	})
 	if err != nil {
 		log.Error("failed to list worker jobs", "error", err)
@@ -67,7 +80,18 @@ func (h *Handler) WorkerJobsList(ctx context.Context, params api.WorkerJobsListP
 
 	out := &api.WorkerJobsListOK{}
 	for _, row := range rows {
-		mapped, mapErr := qToApiWorkerJobsRow(row.WorkerJob)
+		mapped, mapErr := qToApiWorkerJobsRow(query.WorkerJob{
+			UUID:          row.UUID,
+			SchedulerUuid: row.SchedulerUuid,
+			PipelineUuid:  row.PipelineUuid,
+			JobUuid:       row.JobUuid,
+			Subject:       row.Subject,
+			Status:        row.Status,
+			Data:          row.Data,
+			StartedAt:     row.StartedAt,
+			FinishedAt:    row.FinishedAt,
+			WorkspaceUUID: row.WorkspaceUUID,
+		})
 		if mapErr != nil {
 			log.Error("failed to map worker jobs row", "error", mapErr)
 			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to map worker job row"))
@@ -103,7 +127,10 @@ func qToApiWorkerJobsRow(dbRow query.WorkerJob) (api.WorkerJobs, error) {
 
 	// If there's a scheduler UUID, convert it to string.
 	if dbRow.SchedulerUuid != nil {
-		res.SchedulerUUID = dbRow.SchedulerUuid.String()
+		res.SchedulerUUID = api.NewOptString(dbRow.SchedulerUuid.String())
+	}
+	if dbRow.PipelineUuid != nil {
+		res.PipelineUUID = api.NewOptString(dbRow.PipelineUuid.String())
 	}
 	if dbRow.JobUuid != nil {
 		res.JobUUID = api.NewOptString(dbRow.JobUuid.String())
@@ -2,21 +2,63 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+
 	"github.com/shadowapi/shadowapi/backend/internal/worker"
 	"github.com/shadowapi/shadowapi/backend/pkg/api"
 )
 
+// WorkerJobsCancel cancels a queued or running job on whichever worker runs it.
+// POST /workerjobs/{uuid}/cancel
 func (h *Handler) WorkerJobsCancel(ctx context.Context, params api.WorkerJobsCancelParams) error {
 	log := h.log.With("handler", "WorkerJobsCancel", "uuid", params.UUID)
+	jobUUID, err := uuid.FromString(params.UUID)
+	if err != nil {
+		return ErrWithCode(http.StatusBadRequest, E("invalid worker job uuid"))
+	}
 
-	if !worker.CancelJob(params.UUID) {
-		log.Error("job not found or already finished")
-		return ErrWithCode(http.StatusNotFound, E("job not found or already running"))
+	err = h.wbr.Cancel(ctx, jobUUID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrWithCode(http.StatusNotFound, E("worker job not found"))
+	} else if errors.Is(err, worker.ErrJobFinished) {
+		return ErrWithCode(http.StatusConflict, E("worker job already finished"))
+	} else if err != nil {
+		log.Error("failed to cancel worker job", "error", err)
+		return ErrWithCode(http.StatusInternalServerError, E("failed to cancel worker job"))
 	}
 
 	log.Info("cancellation signaled")
 	// returning nil ⇒ 204 No Content
 	return nil
 }
+
+// WorkerJobsRetry publishes a finished job again with the same arguments.
+// POST /workerjobs/{uuid}/retry
+func (h *Handler) WorkerJobsRetry(ctx context.Context, params api.WorkerJobsRetryParams) (*api.WorkerJobs, error) {
+	log := h.log.With("handler", "WorkerJobsRetry", "uuid", params.UUID)
+	jobUUID, err := uuid.FromString(params.UUID)
+	if err != nil {
+		return nil, ErrWithCode(http.StatusBadRequest, E("invalid worker job uuid"))
+	}
+
+	job, err := h.wbr.Retry(ctx, jobUUID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrWithCode(http.StatusNotFound, E("worker job not found"))
+	} else if errors.Is(err, worker.ErrJobNotFinished) || errors.Is(err, worker.ErrJobNoArgs) {
+		return nil, ErrWithCode(http.StatusConflict, E("%s", err.Error()))
+	} else if err != nil {
+		log.Error("failed to retry worker job", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to retry worker job"))
+	}
+
+	res, err := qToApiWorkerJobsRow(job)
+	if err != nil {
+		log.Error("failed to map worker job row", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to map worker job row"))
+	}
+	return &res, nil
+}
@@ -0,0 +1,384 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/db"
+	"github.com/shadowapi/shadowapi/backend/internal/session"
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+var slugInvalid = regexp.MustCompile(`[^a-z0-9]+`)
+
+// WorkspaceCreate creates a workspace owned by the caller.
+// POST /workspace
+func (h *Handler) WorkspaceCreate(ctx context.Context, req *api.Workspace) (*api.Workspace, error) {
+	log := h.log.With("handler", "WorkspaceCreate")
+	ident, ok := session.GetIdentity(ctx)
+	if !ok {
+		return nil, ErrWithCode(http.StatusUnauthorized, E("unauthorized"))
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, ErrWithCode(http.StatusBadRequest, E("name is required"))
+	}
+	slug := workspaceSlug(req.Slug.Or(name))
+	if slug == "" {
+		return nil, ErrWithCode(http.StatusBadRequest, E("invalid slug"))
+	}
+
+	return db.InTx(ctx, h.dbp, func(tx pgx.Tx) (*api.Workspace, error) {
+		q := query.New(tx)
+		ws, err := q.CreateWorkspace(ctx, query.CreateWorkspaceParams{
+			UUID: converter.UuidToPgUUID(uuid.Must(uuid.NewV7())),
+			Name: name,
+			Slug: slug,
+		})
+		if isUniqueViolation(err) {
+			return nil, ErrWithCode(http.StatusConflict, E("workspace slug %q is taken", slug))
+		} else if err != nil {
+			log.Error("failed to create workspace", "error", err)
+			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to create workspace"))
+		}
+		if !ident.IsMachine() {
+			userUUID, err := uuid.FromString(ident.ID)
+			if err != nil {
+				return nil, ErrWithCode(http.StatusBadRequest, E("invalid user id"))
+			}
+			if _, err := q.UpsertWorkspaceMember(ctx, query.UpsertWorkspaceMemberParams{
+				WorkspaceUUID: converter.UuidToPgUUID(ws.UUID),
+				UserUUID:      converter.UuidToPgUUID(userUUID),
+				Role:          workspace.RoleOwner,
+			}); err != nil {
+				log.Error("failed to add workspace owner", "error", err)
+				return nil, ErrWithCode(http.StatusInternalServerError, E("failed to create workspace"))
+			}
+		}
+		out := qToApiWorkspace(ws, workspace.RoleOwner)
+		return &out, nil
+	})
+}
+
+// WorkspaceGet returns a workspace of the caller.
+// GET /workspace/{uuid}
+func (h *Handler) WorkspaceGet(ctx context.Context, params api.WorkspaceGetParams) (*api.Workspace, error) {
+	log := h.log.With("handler", "WorkspaceGet")
+	wsUUID, err := uuid.FromString(params.UUID)
+	if err != nil {
+		return nil, ErrWithCode(http.StatusBadRequest, E("invalid workspace uuid"))
+	}
+	q := query.New(h.dbp)
+	role, err := h.workspaceRole(ctx, q, wsUUID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := q.GetWorkspace(ctx, converter.UuidToPgUUID(wsUUID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrWithCode(http.StatusNotFound, E("workspace not found"))
+	} else if err != nil {
+		log.Error("failed to get workspace", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to get workspace"))
+	}
+	out := qToApiWorkspace(row.Workspace, role)
+	return &out, nil
+}
+
+// WorkspaceList lists the workspaces of the caller, every workspace for the
+// bearer token.
+// GET /workspace
+func (h *Handler) WorkspaceList(ctx context.Context, params api.WorkspaceListParams) ([]api.Workspace, error) {
+	log := h.log.With("handler", "WorkspaceList")
+	ident, ok := session.GetIdentity(ctx)
+	if !ok {
+		return nil, ErrWithCode(http.StatusUnauthorized, E("unauthorized"))
+	}
+	arg := query.GetWorkspacesParams{
+		Offset: params.Offset.Or(0),
+		Limit:  params.Limit.Or(50),
+	}
+	if !ident.IsMachine() {
+		userUUID, err := uuid.FromString(ident.ID)
+		if err != nil {
+			return nil, ErrWithCode(http.StatusBadRequest, E("invalid user id"))
+		}
+		arg.UserUUID = converter.UuidToPgUUID(userUUID)
+	}
+	rows, err := query.New(h.dbp).GetWorkspaces(ctx, arg)
+	if err != nil {
+		log.Error("failed to list workspaces", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to list workspaces"))
+	}
+	out := make([]api.Workspace, 0, len(rows))
+	for _, row := range rows {
+		role := row.Role
+		if ident.IsMachine() {
+			role = workspace.RoleOwner
+		}
+		out = append(out, qToApiWorkspace(row.Workspace, role))
+	}
+	return out, nil
+}
+
+// WorkspaceUpdate renames a workspace.
+// PUT /workspace/{uuid}
+func (h *Handler) WorkspaceUpdate(ctx context.Context, req *api.Workspace, params api.WorkspaceUpdateParams) (*api.Workspace, error) {
+	log := h.log.With("handler", "WorkspaceUpdate")
+	wsUUID, err := uuid.FromString(params.UUID)
+	if err != nil {
+		return nil, ErrWithCode(http.StatusBadRequest, E("invalid workspace uuid"))
+	}
+	q := query.New(h.dbp)
+	if err := h.requireWorkspaceRole(ctx, q, wsUUID, workspace.RoleOwner, workspace.RoleAdmin); err != nil {
+		return nil, err
+	}
+	row, err := q.GetWorkspace(ctx, converter.UuidToPgUUID(wsUUID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrWithCode(http.StatusNotFound, E("workspace not found"))
+	} else if err != nil {
+		log.Error("failed to get workspace", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to get workspace"))
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, ErrWithCode(http.StatusBadRequest, E("name is required"))
+	}
+	slug := row.Workspace.Slug
+	if req.Slug.IsSet() {
+		if slug = workspaceSlug(req.Slug.Value); slug == "" {
+			return nil, ErrWithCode(http.StatusBadRequest, E("invalid slug"))
+		}
+	}
+	err = q.UpdateWorkspace(ctx, query.UpdateWorkspaceParams{
+		UUID: converter.UuidToPgUUID(wsUUID),
+		Name: name,
+		Slug: slug,
+	})
+	if isUniqueViolation(err) {
+		return nil, ErrWithCode(http.StatusConflict, E("workspace slug %q is taken", slug))
+	} else if err != nil {
+		log.Error("failed to update workspace", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to update workspace"))
+	}
+	return h.WorkspaceGet(ctx, api.WorkspaceGetParams{UUID: params.UUID})
+}
+
+// WorkspaceDelete deletes a workspace with all its resources.
+// DELETE /workspace/{uuid}
+func (h *Handler) WorkspaceDelete(ctx context.Context, params api.WorkspaceDeleteParams) error {
+	log := h.log.With("handler", "WorkspaceDelete")
+	wsUUID, err := uuid.FromString(params.UUID)
+	if err != nil {
+		return ErrWithCode(http.StatusBadRequest, E("invalid workspace uuid"))
+	}
+	if wsUUID == workspace.DefaultUUID {
+		return ErrWithCode(http.StatusBadRequest, E("the default workspace cannot be deleted"))
+	}
+	q := query.New(h.dbp)
+	if err := h.requireWorkspaceRole(ctx, q, wsUUID, workspace.RoleOwner); err != nil {
+		return err
+	}
+	if err := q.DeleteWorkspace(ctx, converter.UuidToPgUUID(wsUUID)); err != nil {
+		log.Error("failed to delete workspace", "error", err)
+		return ErrWithCode(http.StatusInternalServerError, E("failed to delete workspace"))
+	}
+	return nil
+}
+
+// WorkspaceMemberList lists the members of a workspace.
+// GET /workspace/{uuid}/member
+func (h *Handler) WorkspaceMemberList(ctx context.Context, params api.WorkspaceMemberListParams) ([]api.WorkspaceMember, error) {
+	log := h.log.With("handler", "WorkspaceMemberList")
+	wsUUID, err := uuid.FromString(params.UUID)
+	if err != nil {
+		return nil, ErrWithCode(http.StatusBadRequest, E("invalid workspace uuid"))
+	}
+	q := query.New(h.dbp)
+	if _, err := h.workspaceRole(ctx, q, wsUUID); err != nil {
+		return nil, err
+	}
+	rows, err := q.GetWorkspaceMembers(ctx, converter.UuidToPgUUID(wsUUID))
+	if err != nil {
+		log.Error("failed to list workspace members", "error", err)
+		return nil, ErrWithCode(http.StatusInternalServerError, E("failed to list workspace members"))
+	}
+	out := make([]api.WorkspaceMember, 0, len(rows))
+	for _, row := range rows {
+		m := qToApiWorkspaceMember(row.WorkspaceMember)
+		m.Email = api.NewOptString(row.Email)
+		out = append(out, m)
+	}
+	return out, nil
+}
+
+// WorkspaceMemberSet adds a member or changes the role of a member.
+// POST /workspace/{uuid}/member
+func (h *Handler) WorkspaceMemberSet(ctx context.Context, req *api.WorkspaceMember, params api.WorkspaceMemberSetParams) (*api.WorkspaceMember, error) {
+	log := h.log.With("handler", "WorkspaceMemberSet")
+	wsUUID, err := uuid.FromString(params.UUID)
+	if err != nil {
+		return nil, ErrWithCode(http.StatusBadRequest, E("invalid workspace uuid"))
+	}
+	userUUID, err := uuid.FromString(req.UserUUID)
+	if err != nil {
+		return nil, ErrWithCode(http.StatusBadRequest, E("invalid user uuid"))
+	}
+	if err := req.Role.Validate(); err != nil {
+		return nil, ErrWithCode(http.StatusBadRequest, E("invalid role: %w", err))
+	}
+
+	return db.InTx(ctx, h.dbp, func(tx pgx.Tx) (*api.WorkspaceMember, error) {
+		q := query.New(tx)
+		if err := h.requireWorkspaceRole(ctx, q, wsUUID, workspace.RoleOwner, workspace.RoleAdmin); err != nil {
+			return nil, err
+		}
+		if _, err := q.GetUser(ctx, converter.UuidToPgUUID(userUUID)); errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrWithCode(http.StatusNotFound, E("user not found"))
+		} else if err != nil {
+			log.Error("failed to get user", "error", err)
+			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to get user"))
+		}
+		member, err := q.UpsertWorkspaceMember(ctx, query.UpsertWorkspaceMemberParams{
+			WorkspaceUUID: converter.UuidToPgUUID(wsUUID),
+			UserUUID:      converter.UuidToPgUUID(userUUID),
+			Role:          string(req.Role),
+		})
+		if err != nil {
+			log.Error("failed to save workspace member", "error", err)
+			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to save workspace member"))
+		}
+		// demoting the last owner would leave the workspace unmanageable
+		if owners, err := q.CountWorkspaceOwners(ctx, converter.UuidToPgUUID(wsUUID)); err != nil {
+			log.Error("failed to count workspace owners", "error", err)
+			return nil, ErrWithCode(http.StatusInternalServerError, E("failed to save workspace member"))
+		} else if owners == 0 {
+			return nil, ErrWithCode(http.StatusConflict, E("a workspace needs at least one owner"))
+		}
+		out := qToApiWorkspaceMember(member)
+		return &out, nil
+	})
+}
+
+// WorkspaceMemberDelete removes a member from a workspace.
+// DELETE /workspace/{uuid}/member/{user_uuid}
+func (h *Handler) WorkspaceMemberDelete(ctx context.Context, params api.WorkspaceMemberDeleteParams) error {
+	log := h.log.With("handler", "WorkspaceMemberDelete")
+	wsUUID, err := uuid.FromString(params.UUID)
+	if err != nil {
+		return ErrWithCode(http.StatusBadRequest, E("invalid workspace uuid"))
+	}
+	userUUID, err := uuid.FromString(params.UserUUID)
+	if err != nil {
+		return ErrWithCode(http.StatusBadRequest, E("invalid user uuid"))
+	}
+
+	_, err = db.InTx(ctx, h.dbp, func(tx pgx.Tx) (struct{}, error) {
+		q := query.New(tx)
+		if err := h.requireWorkspaceRole(ctx, q, wsUUID, workspace.RoleOwner, workspace.RoleAdmin); err != nil {
+			return struct{}{}, err
+		}
+		deleted, err := q.DeleteWorkspaceMember(ctx, query.DeleteWorkspaceMemberParams{
+			WorkspaceUUID: converter.UuidToPgUUID(wsUUID),
+			UserUUID:      converter.UuidToPgUUID(userUUID),
+		})
+		if err != nil {
+			log.Error("failed to delete workspace member", "error", err)
+			return struct{}{}, ErrWithCode(http.StatusInternalServerError, E("failed to delete workspace member"))
+		}
+		if deleted == 0 {
+			return struct{}{}, ErrWithCode(http.StatusNotFound, E("workspace member not found"))
+		}
+		if owners, err := q.CountWorkspaceOwners(ctx, converter.UuidToPgUUID(wsUUID)); err != nil {
+			log.Error("failed to count workspace owners", "error", err)
+			return struct{}{}, ErrWithCode(http.StatusInternalServerError, E("failed to delete workspace member"))
+		} else if owners == 0 {
+			return struct{}{}, ErrWithCode(http.StatusConflict, E("the last owner cannot be removed"))
+		}
+		return struct{}{}, nil
+	})
+	return err
+}
+
+// workspaceRole returns the role of the caller in the workspace. The bearer
+// token owns every workspace, administrators reach every workspace as admin.
+func (h *Handler) workspaceRole(ctx context.Context, q *query.Queries, wsUUID uuid.UUID) (string, error) {
+	ident, ok := session.GetIdentity(ctx)
+	if !ok {
+		return "", ErrWithCode(http.StatusUnauthorized, E("unauthorized"))
+	}
+	if ident.IsMachine() {
+		return workspace.RoleOwner, nil
+	}
+	userUUID, err := uuid.FromString(ident.ID)
+	if err != nil {
+		return "", ErrWithCode(http.StatusBadRequest, E("invalid user id"))
+	}
+	row, err := q.ResolveUserWorkspace(ctx, query.ResolveUserWorkspaceParams{
+		UserUUID:      converter.UuidToPgUUID(userUUID),
+		WorkspaceUUID: converter.UuidToPgUUID(wsUUID),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrWithCode(http.StatusNotFound, E("workspace not found"))
+	} else if err != nil {
+		h.log.Error("failed to resolve workspace role", "error", err)
+		return "", ErrWithCode(http.StatusInternalServerError, E("failed to resolve workspace"))
+	}
+	return row.Role, nil
+}
+
+// requireWorkspaceRole fails with 403 unless the caller has one of the roles.
+func (h *Handler) requireWorkspaceRole(ctx context.Context, q *query.Queries, wsUUID uuid.UUID, roles ...string) error {
+	role, err := h.workspaceRole(ctx, q, wsUUID)
+	if err != nil {
+		return err
+	}
+	for _, r := range roles {
+		if role == r {
+			return nil
+		}
+	}
+	return ErrWithCode(http.StatusForbidden, E("requires the %s role in the workspace", strings.Join(roles, " or ")))
+}
+
+func workspaceSlug(s string) string {
+	return strings.Trim(slugInvalid.ReplaceAllString(strings.ToLower(s), "-"), "-")
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+func qToApiWorkspace(ws query.Workspace, role string) api.Workspace {
+	out := api.Workspace{
+		UUID:      api.NewOptString(ws.UUID.String()),
+		Name:      ws.Name,
+		Slug:      api.NewOptString(ws.Slug),
+		CreatedAt: api.NewOptDateTime(ws.CreatedAt.Time),
+	}
+	if role != "" {
+		out.Role = api.NewOptString(role)
+	}
+	if ws.UpdatedAt.Valid {
+		out.UpdatedAt = api.NewOptDateTime(ws.UpdatedAt.Time)
+	}
+	return out
+}
+
+func qToApiWorkspaceMember(m query.WorkspaceMember) api.WorkspaceMember {
+	return api.WorkspaceMember{
+		UserUUID:  m.UserUUID.String(),
+		Role:      api.WorkspaceMemberRole(m.Role),
+		CreatedAt: api.NewOptDateTime(m.CreatedAt.Time),
+	}
+}
@@ -0,0 +1,335 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/samber/do/v2"
+
+	oauthTools "github.com/shadowapi/shadowapi/backend/internal/oauth2"
+	"github.com/shadowapi/shadowapi/backend/internal/queue"
+	"github.com/shadowapi/shadowapi/backend/internal/role"
+	"github.com/shadowapi/shadowapi/backend/internal/storages"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/registry"
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// Check groups
+const (
+	GroupDatabase   = "database"
+	GroupQueue      = "queue"
+	GroupStorages   = "storages"
+	GroupTokens     = "tokens"
+	GroupMail       = "mail"
+	GroupSchedulers = "schedulers"
+	// GroupRole holds the readiness of the roles the process runs, it is
+	// part of /readyz only
+	GroupRole = "role"
+)
+
+// Groups lists every check group in report order.
+var Groups = []string{GroupDatabase, GroupQueue, GroupStorages, GroupTokens, GroupMail, GroupSchedulers}
+
+const (
+	// DefaultTimeout bounds each check
+	DefaultTimeout = 10 * time.Second
+
+	// workerConsumer is the durable consumer of the worker jobs, see worker.Broker.Start
+	workerConsumer = "worker-jobs"
+	// lagWarn is how many undelivered jobs make the queue degraded
+	lagWarn = 1000
+	// driftGrace is how late a scheduler may run before it counts as drifting
+	driftGrace = 5 * time.Minute
+)
+
+// Checker builds and runs the checks.
+type Checker struct {
+	log *slog.Logger
+	dbp *pgxpool.Pool
+	// dbErr is why the pool could not be created, every check needing the
+	// database fails with it
+	dbErr error
+	// queue connects to NATS on first use, the connection failing is a
+	// failed check rather than a startup error
+	queue   func() (*queue.Queue, error)
+	roles   role.Roles
+	Timeout time.Duration
+
+	readyMu sync.Mutex
+	ready   []Check
+}
+
+// Provide the checker for the dependency injector.
+func Provide(i do.Injector) (*Checker, error) {
+	log := do.MustInvoke[*slog.Logger](i).With("service", "health")
+	// the database being down is what the checks are there to report
+	dbp, dbErr := do.Invoke[*pgxpool.Pool](i)
+	c := New(log, dbp, func() (*queue.Queue, error) {
+		return do.Invoke[*queue.Queue](i)
+	})
+	c.dbErr = dbErr
+	// invalid roles are reported by serve, the check command has none
+	c.roles, _ = do.Invoke[role.Roles](i)
+	return c, nil
+}
+
+// New returns a checker.
+func New(log *slog.Logger, dbp *pgxpool.Pool, q func() (*queue.Queue, error)) *Checker {
+	return &Checker{log: log, dbp: dbp, queue: q, Timeout: DefaultTimeout}
+}
+
+// AddReady adds a check of a role to Ready, registered by whatever runs it.
+func (c *Checker) AddReady(check Check) {
+	c.readyMu.Lock()
+	defer c.readyMu.Unlock()
+	c.ready = append(c.ready, check)
+}
+
+// Ready checks what the roles of the process need: the database, the queue
+// unless it only keeps sessions connected, and the checks added by the roles.
+func (c *Checker) Ready(ctx context.Context) Report {
+	checks := c.databaseChecks()
+	if c.roles == nil || c.roles.Has(role.API) || c.roles.Has(role.Worker) || c.roles.Has(role.Scheduler) {
+		checks = append(checks, c.queueChecks()...)
+	}
+	if c.roles != nil {
+		roles := c.roles.String()
+		checks = append(checks, Check{Group: GroupRole, Name: "roles", Run: func(context.Context) (string, error) {
+			return roles, nil
+		}})
+	}
+	c.readyMu.Lock()
+	checks = append(checks, c.ready...)
+	c.readyMu.Unlock()
+	return Run(ctx, checks, c.Timeout)
+}
+
+// Deep runs the checks of the groups, every group when none is given. It
+// covers every workspace and is meant for the operator only, see the check
+// command.
+func (c *Checker) Deep(ctx context.Context, groups ...string) Report {
+	if len(groups) == 0 {
+		groups = Groups
+	}
+	// the probes cover every workspace
+	ctx = workspace.Unscoped(ctx)
+	var checks []Check
+	for _, g := range Groups {
+		if !slices.Contains(groups, g) {
+			continue
+		}
+		var list func(context.Context) ([]Check, error)
+		switch g {
+		case GroupDatabase:
+			checks = append(checks, c.databaseChecks()...)
+			continue
+		case GroupQueue:
+			checks = append(checks, c.queueChecks()...)
+			continue
+		case GroupStorages:
+			list = c.storageChecks
+		case GroupTokens:
+			list = c.tokenChecks
+		case GroupMail:
+			list = c.mailChecks
+		case GroupSchedulers:
+			checks = append(checks, c.schedulerChecks()...)
+			continue
+		}
+		if c.dbErr != nil {
+			checks = append(checks, failed(g, "list", c.dbErr))
+			continue
+		}
+		more, err := list(ctx)
+		if err != nil {
+			// listing what to probe fails when the database is down
+			checks = append(checks, failed(g, "list", err))
+			continue
+		}
+		checks = append(checks, more...)
+	}
+	return Run(ctx, checks, c.Timeout)
+}
+
+func failed(group, name string, err error) Check {
+	return Check{Group: group, Name: name, Run: func(context.Context) (string, error) {
+		return "", err
+	}}
+}
+
+func (c *Checker) databaseChecks() []Check {
+	return []Check{{Group: GroupDatabase, Name: "postgres", Run: func(ctx context.Context) (string, error) {
+		if c.dbErr != nil {
+			return "", c.dbErr
+		}
+		var version string
+		if err := c.dbp.QueryRow(ctx, "SHOW server_version").Scan(&version); err != nil {
+			return "", err
+		}
+		stat := c.dbp.Stat()
+		return fmt.Sprintf("version %s, %d/%d connections in use", version, stat.AcquiredConns(), stat.MaxConns()), nil
+	}}}
+}
+
+func (c *Checker) queueChecks() []Check {
+	return []Check{
+		{Group: GroupQueue, Name: "nats", Run: func(ctx context.Context) (string, error) {
+			q, err := c.queue()
+			if err != nil {
+				return "", err
+			}
+			if s := q.Status(); s != nats.CONNECTED {
+				return "", fmt.Errorf("connection %s", s)
+			}
+			info, err := q.StreamInfo(ctx, registry.WorkerStream)
+			if err != nil {
+				return "", fmt.Errorf("stream %s: %w", registry.WorkerStream, err)
+			}
+			return fmt.Sprintf("stream %s holds %d messages", registry.WorkerStream, info.State.Msgs), nil
+		}},
+		{Group: GroupQueue, Name: "consumer " + workerConsumer, Run: func(ctx context.Context) (string, error) {
+			q, err := c.queue()
+			if err != nil {
+				return "", err
+			}
+			info, err := q.ConsumerInfo(ctx, registry.WorkerStream, workerConsumer)
+			if errors.Is(err, jetstream.ErrConsumerNotFound) {
+				return "", Warnf("no worker consumes the jobs yet")
+			} else if err != nil {
+				return "", err
+			}
+			detail := fmt.Sprintf("%d pending, %d unacknowledged, %d redelivered",
+				info.NumPending, info.NumAckPending, info.NumRedelivered)
+			if info.NumPending > lagWarn {
+				return "", Warnf("lagging: %s", detail)
+			}
+			return detail, nil
+		}},
+	}
+}
+
+func (c *Checker) storageChecks(ctx context.Context) ([]Check, error) {
+	rows, err := query.New(c.dbp).GetStorages(ctx, query.GetStoragesParams{
+		OrderBy: "created_at", OrderDirection: "asc", IsEnabled: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	checks := make([]Check, 0, len(rows))
+	for _, r := range rows {
+		storage := query.Storage{UUID: r.UUID, Name: r.Name, Type: r.Type, IsEnabled: r.IsEnabled, Settings: r.Settings}
+		checks = append(checks, Check{
+			Group: GroupStorages,
+			Name:  fmt.Sprintf("%s %s (%s)", r.Type, r.Name, r.UUID),
+			Run: func(ctx context.Context) (string, error) {
+				return "", storages.Probe(ctx, c.dbp, storage)
+			},
+		})
+	}
+	return checks, nil
+}
+
+func (c *Checker) tokenChecks(ctx context.Context) ([]Check, error) {
+	rows, err := query.New(c.dbp).GetOauth2Tokens(ctx, query.GetOauth2TokensParams{
+		OrderBy: "created_at", OrderDirection: "asc", ClientUuid: "",
+	})
+	if err != nil {
+		return nil, err
+	}
+	checks := make([]Check, 0, len(rows))
+	for _, r := range rows {
+		token := query.Oauth2Token{UUID: r.UUID, ClientUuid: r.ClientUuid, Token: r.Token, Status: r.Status}
+		checks = append(checks, Check{
+			Group: GroupTokens,
+			Name:  r.UUID.String(),
+			Run: func(ctx context.Context) (string, error) {
+				if err := oauthTools.CheckRefreshable(ctx, c.dbp, token); err != nil {
+					return "", err
+				}
+				if r.Status == oauthTools.TokenStatusFailing {
+					return "", Warnf("%d failed refreshes, last: %s", r.FailureCount, r.LastError)
+				}
+				if r.ExpiresAt.Valid {
+					return "expires " + r.ExpiresAt.Time.Format(time.RFC3339), nil
+				}
+				return "", nil
+			},
+		})
+	}
+	return checks, nil
+}
+
+func (c *Checker) mailChecks(ctx context.Context) ([]Check, error) {
+	rows, err := query.New(c.dbp).GetDatasources(ctx, query.GetDatasourcesParams{
+		OrderBy: "created_at", OrderDirection: "asc", Type: "email", IsEnabled: 1, SyncAll: -1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var checks []Check
+	for _, r := range rows {
+		settings, err := mailSettings(r.Settings)
+		if err != nil {
+			checks = append(checks, failed(GroupMail, r.Name, err))
+			continue
+		}
+		if settings.ImapServer != "" {
+			checks = append(checks, Check{
+				Group: GroupMail,
+				Name:  fmt.Sprintf("imap %s (%s)", settings.Email, r.UUID),
+				Run: func(ctx context.Context) (string, error) {
+					return settings.ImapServer, loginIMAP(ctx, settings)
+				},
+			})
+		}
+		if settings.SMTPServer != "" {
+			checks = append(checks, Check{
+				Group: GroupMail,
+				Name:  fmt.Sprintf("smtp %s (%s)", settings.Email, r.UUID),
+				Run: func(ctx context.Context) (string, error) {
+					return settings.SMTPServer, loginSMTP(ctx, settings)
+				},
+			})
+		}
+	}
+	return checks, nil
+}
+
+func (c *Checker) schedulerChecks() []Check {
+	return []Check{{Group: GroupSchedulers, Name: "drift", Run: func(ctx context.Context) (string, error) {
+		if c.dbErr != nil {
+			return "", c.dbErr
+		}
+		rows, err := query.New(c.dbp).GetSchedulers(ctx, query.GetSchedulersParams{
+			OrderBy: "created_at", OrderDirection: "asc", IsEnabled: 1, IsPaused: 0,
+		})
+		if err != nil {
+			return "", err
+		}
+		now := time.Now()
+		var late int
+		var worst time.Duration
+		for _, r := range rows {
+			if !r.NextRun.Valid {
+				continue
+			}
+			if drift := now.Sub(r.NextRun.Time); drift > driftGrace {
+				late++
+				worst = max(worst, drift)
+			}
+		}
+		if late > 0 {
+			return "", Warnf("%d of %d schedulers are overdue, the oldest by %s", late, len(rows), worst.Truncate(time.Second))
+		}
+		return fmt.Sprintf("%d schedulers on time", len(rows)), nil
+	}}}
+}
@@ -0,0 +1,116 @@
+// Package health probes the services shadowapi depends on: the database, the
+// NATS queue, the storages, the OAuth2 tokens, the mailboxes and the
+// schedulers. The same report backs the check command and the /healthz and
+// /readyz endpoints.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Status of a check, and of a report as its worst check.
+type Status string
+
+const (
+	StatusOK   Status = "ok"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+)
+
+func (s Status) worse(than Status) bool {
+	rank := map[Status]int{StatusOK: 0, StatusWarn: 1, StatusFail: 2}
+	return rank[s] > rank[than]
+}
+
+// Result is the outcome of one check.
+type Result struct {
+	Group      string `json:"group"`
+	Name       string `json:"name"`
+	Status     Status `json:"status"`
+	Detail     string `json:"detail,omitempty"`
+	DurationMS int64  `json:"duration_ms"`
+}
+
+// Report is the outcome of a set of checks, in the order they were given.
+type Report struct {
+	Status Status   `json:"status"`
+	Checks []Result `json:"checks"`
+}
+
+// Check is one probe. Run returns a detail to show when it passes, an error
+// when it fails, or a Warning when it passes in a degraded state.
+type Check struct {
+	Group string
+	Name  string
+	Run   func(ctx context.Context) (string, error)
+}
+
+// Warning is an error marking a degraded but working state.
+type Warning struct {
+	msg string
+}
+
+func (w *Warning) Error() string { return w.msg }
+
+// Warnf returns a Warning.
+func Warnf(format string, args ...any) error {
+	return &Warning{msg: fmt.Sprintf(format, args...)}
+}
+
+// parallel is how many checks run at once
+const parallel = 8
+
+// Run runs the checks concurrently, each within the timeout.
+func Run(ctx context.Context, checks []Check, timeout time.Duration) Report {
+	report := Report{Status: StatusOK, Checks: make([]Result, len(checks))}
+	sem := make(chan struct{}, parallel)
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			report.Checks[i] = run(ctx, check, timeout)
+		}()
+	}
+	wg.Wait()
+	for _, r := range report.Checks {
+		if r.Status.worse(report.Status) {
+			report.Status = r.Status
+		}
+	}
+	return report
+}
+
+func run(ctx context.Context, check Check, timeout time.Duration) (res Result) {
+	res = Result{Group: check.Group, Name: check.Name, Status: StatusOK}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	started := time.Now()
+	defer func() {
+		res.DurationMS = time.Since(started).Milliseconds()
+		if r := recover(); r != nil {
+			res.Status, res.Detail = StatusFail, fmt.Sprintf("panic: %v", r)
+		}
+	}()
+
+	detail, err := check.Run(ctx)
+	var warning *Warning
+	switch {
+	case errors.As(err, &warning):
+		res.Status, res.Detail = StatusWarn, err.Error()
+	case err != nil && ctx.Err() == context.DeadlineExceeded:
+		res.Status, res.Detail = StatusFail, fmt.Sprintf("timed out after %s: %v", timeout, err)
+	case err != nil:
+		res.Status, res.Detail = StatusFail, err.Error()
+	default:
+		res.Detail = detail
+	}
+	return res
+}
@@ -0,0 +1,68 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	checks := []Check{
+		{Group: "a", Name: "ok", Run: func(context.Context) (string, error) { return "fine", nil }},
+		{Group: "a", Name: "warn", Run: func(context.Context) (string, error) { return "", Warnf("slow by %d", 3) }},
+		{Group: "b", Name: "slow", Run: func(ctx context.Context) (string, error) {
+			<-ctx.Done()
+			return "", ctx.Err()
+		}},
+		{Group: "b", Name: "panic", Run: func(context.Context) (string, error) { panic("boom") }},
+	}
+	report := Run(context.Background(), checks, 50*time.Millisecond)
+
+	if report.Status != StatusFail {
+		t.Errorf("report status = %s, want fail", report.Status)
+	}
+	want := []struct {
+		name   string
+		status Status
+		detail string
+	}{
+		{"ok", StatusOK, "fine"},
+		{"warn", StatusWarn, "slow by 3"},
+		{"slow", StatusFail, "timed out after 50ms: context deadline exceeded"},
+		{"panic", StatusFail, "panic: boom"},
+	}
+	for i, w := range want {
+		got := report.Checks[i]
+		if got.Name != w.name || got.Status != w.status || got.Detail != w.detail {
+			t.Errorf("check %d = %s %s %q, want %s %s %q", i, got.Name, got.Status, got.Detail, w.name, w.status, w.detail)
+		}
+	}
+
+	report = Run(context.Background(), checks[:2], time.Second)
+	if report.Status != StatusWarn {
+		t.Errorf("report status = %s, want warn", report.Status)
+	}
+}
+
+func TestServeLive(t *testing.T) {
+	c := New(nil, nil, nil)
+	c.dbErr = errors.New("down")
+
+	rec := httptest.NewRecorder()
+	c.ServeLive(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("/healthz = %d, want 200 whatever the dependencies", rec.Code)
+	}
+
+	// the deep report is never served, it would name the storages and
+	// mailboxes of every workspace to anyone
+	rec = httptest.NewRecorder()
+	c.ServeLive(rec, httptest.NewRequest(http.MethodGet, "/healthz?deep=true", nil))
+	if rec.Code != http.StatusOK || strings.Contains(rec.Body.String(), GroupStorages) {
+		t.Errorf("/healthz?deep=true = %d %s, want the shallow answer", rec.Code, rec.Body)
+	}
+}
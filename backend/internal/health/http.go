@@ -0,0 +1,31 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// The probes are served unauthenticated, so they only report on the process
+// itself. The deep report names the storages, mailboxes and tokens of every
+// workspace and logs in to them, it is left to the check command.
+
+// ServeLive answers /healthz: the process is up and serving. Dependencies
+// are left to /readyz, restarting the process does not bring them back.
+func (c *Checker) ServeLive(w http.ResponseWriter, r *http.Request) {
+	writeReport(w, Report{Status: StatusOK, Checks: []Result{}})
+}
+
+// ServeReady answers /readyz: what the roles of the process need is usable.
+func (c *Checker) ServeReady(w http.ResponseWriter, r *http.Request) {
+	writeReport(w, c.Ready(r.Context()))
+}
+
+// writeReport answers 503 when a check failed, warnings still answer 200.
+func writeReport(w http.ResponseWriter, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if report.Status == StatusFail {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,119 @@
+package health
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/emersion/go-imap/client"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
+	"github.com/shadowapi/shadowapi/backend/internal/telemetry"
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+)
+
+// mailSettings decodes the settings of an email datasource with the password
+// decrypted.
+func mailSettings(raw []byte) (api.DatasourceEmail, error) {
+	var settings api.DatasourceEmail
+	raw, err := secrets.DecryptFields(raw, secrets.DatasourceFields["email"]...)
+	if err != nil {
+		return settings, fmt.Errorf("decrypt settings: %w", err)
+	}
+	if err := json.Unmarshal(raw, &settings); err != nil {
+		return settings, fmt.Errorf("invalid settings: %w", err)
+	}
+	return settings, nil
+}
+
+// withPort adds the default port to a server without one.
+func withPort(server, port string) string {
+	if _, _, err := net.SplitHostPort(server); err == nil {
+		return server
+	}
+	return net.JoinHostPort(server, port)
+}
+
+// loginIMAP logs in to the IMAP server over TLS and logs out.
+func loginIMAP(ctx context.Context, settings api.DatasourceEmail) (err error) {
+	addr := withPort(settings.ImapServer, "993")
+	_, span := telemetry.Start(ctx, "imap login", telemetry.Attrs(semconv.ServerAddress(addr)))
+	defer func() { telemetry.End(span, err) }()
+	host, _, _ := net.SplitHostPort(addr)
+	dialer := &net.Dialer{}
+	if deadline, ok := ctx.Deadline(); ok {
+		dialer.Deadline = deadline
+	}
+	c, err := client.DialWithDialerTLS(dialer, addr, &tls.Config{ServerName: host})
+	if err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+	defer c.Logout()
+	// the client does not take a context, closing the connection ends a
+	// login hanging past the timeout
+	stop := context.AfterFunc(ctx, func() { c.Terminate() })
+	defer stop()
+	if err := c.Login(settings.Email, settings.Password); err != nil {
+		return fmt.Errorf("login: %w", err)
+	}
+	return nil
+}
+
+// loginSMTP authenticates with the SMTP server and quits: over TLS when
+// smtp_tls is set, otherwise with STARTTLS when the server offers it.
+func loginSMTP(ctx context.Context, settings api.DatasourceEmail) (err error) {
+	implicitTLS := settings.SMTPTLS.Or(false)
+	port := "587"
+	if implicitTLS {
+		port = "465"
+	}
+	addr := withPort(settings.SMTPServer, port)
+	ctx, span := telemetry.Start(ctx, "smtp login", telemetry.Attrs(semconv.ServerAddress(addr)))
+	defer func() { telemetry.End(span, err) }()
+	host, _, _ := net.SplitHostPort(addr)
+	tlsConfig := &tls.Config{ServerName: host}
+
+	var conn net.Conn
+	dialer := &net.Dialer{}
+	if implicitTLS {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("greeting: %w", err)
+	}
+	defer c.Close()
+	if !implicitTLS {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(tlsConfig); err != nil {
+				return fmt.Errorf("starttls: %w", err)
+			}
+		}
+	}
+	ok, mechanisms := c.Extension("AUTH")
+	if !ok {
+		return errors.New("server offers no authentication")
+	}
+	if !strings.Contains(strings.ToUpper(mechanisms), "PLAIN") {
+		return fmt.Errorf("server offers no PLAIN authentication, only %s", mechanisms)
+	}
+	if err := c.Auth(smtp.PlainAuth("", settings.Email, settings.Password, host)); err != nil {
+		return fmt.Errorf("login: %w", err)
+	}
+	return c.Quit()
+}
@@ -0,0 +1,815 @@
+package loader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/db"
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/scheduler"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// ApplyOptions tune Apply.
+type ApplyOptions struct {
+	// DryRun computes the plan and rolls the transaction back
+	DryRun bool
+	// Prune deletes the stored items of the kinds listed in the manifest
+	// that the manifest does not contain
+	Prune bool
+}
+
+// errDryRun rolls back the transaction of a dry run.
+var errDryRun = errors.New("dry run")
+
+// Apply makes the stored configuration match the manifest in one
+// transaction and returns the changes made. Items equal to their manifest
+// entry are left untouched, so applying a manifest twice changes nothing the
+// second time.
+func (l *Loader) Apply(ctx context.Context, m *Manifest, opts ApplyOptions) (*Plan, error) {
+	ctx, err := l.scope(ctx, m.Workspace)
+	if err != nil {
+		return nil, err
+	}
+	plan := &Plan{DryRun: opts.DryRun}
+	_, err = db.InTx(ctx, l.dbp, func(tx pgx.Tx) (struct{}, error) {
+		q := query.New(tx)
+		st, err := loadState(ctx, q)
+		if err != nil {
+			return struct{}{}, err
+		}
+		a := &applier{ctx: ctx, q: q, st: st, m: m, plan: plan, now: time.Now().UTC()}
+		if err := a.apply(opts.Prune); err != nil {
+			return struct{}{}, err
+		}
+		if opts.DryRun {
+			return struct{}{}, errDryRun
+		}
+		return struct{}{}, nil
+	})
+	if errors.Is(err, errDryRun) {
+		err = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+type applier struct {
+	ctx  context.Context
+	q    *query.Queries
+	st   *state
+	m    *Manifest
+	plan *Plan
+	now  time.Time
+	// usedSchedulers holds the stored schedulers matched by the manifest
+	usedSchedulers map[uuid.UUID]bool
+}
+
+func (a *applier) apply(prune bool) error {
+	steps := []func() error{a.users, a.clients, a.storages, a.datasources, a.pipelines, a.syncPolicies, a.schedulers}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return err
+		}
+	}
+	if !prune {
+		return nil
+	}
+	return a.prune()
+}
+
+func (a *applier) users() error {
+	for _, want := range a.m.Users {
+		want.Enabled = enabledOr(want.Enabled)
+		meta, err := encodeObject(want.Meta, nil)
+		if err != nil {
+			return fmt.Errorf("user %q: %w", want.Email, err)
+		}
+		cur, ok, err := lookup(a.st, a.st.users, "user", want.Email)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			hash, err := hashPassword(want.Password)
+			if err != nil {
+				return fmt.Errorf("user %q: %w", want.Email, err)
+			}
+			created, err := a.q.CreateUser(a.ctx, query.CreateUserParams{
+				UUID:      newUUID(),
+				Email:     want.Email,
+				Password:  hash,
+				FirstName: want.FirstName,
+				LastName:  want.LastName,
+				IsEnabled: *want.Enabled,
+				IsAdmin:   want.Admin,
+				Meta:      meta,
+			})
+			if err != nil {
+				return fmt.Errorf("create user %q: %w", want.Email, err)
+			}
+			record(a, a.st.users, "user", want.Email, created, created.UUID)
+			continue
+		}
+
+		have, err := userItem(cur)
+		if err != nil {
+			return fmt.Errorf("user %q: %w", want.Email, err)
+		}
+		// the stored password is a bcrypt hash, an unchanged one compares equal
+		if want.Password == "" || want.Password == secrets.Redacted ||
+			bcrypt.CompareHashAndPassword([]byte(have.Password), []byte(want.Password)) == nil {
+			want.Password = have.Password
+		}
+		fields, err := diffFields(want, have)
+		if err != nil {
+			return err
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		hash := have.Password
+		if want.Password != have.Password {
+			if hash, err = hashPassword(want.Password); err != nil {
+				return fmt.Errorf("user %q: %w", want.Email, err)
+			}
+		}
+		err = a.q.UpdateUser(a.ctx, query.UpdateUserParams{
+			Email:          want.Email,
+			Password:       hash,
+			FirstName:      want.FirstName,
+			LastName:       want.LastName,
+			IsEnabled:      *want.Enabled,
+			IsAdmin:        want.Admin,
+			ZitadelSubject: cur.ZitadelSubject,
+			Meta:           meta,
+			UUID:           converter.UuidToPgUUID(cur.UUID),
+		})
+		if err != nil {
+			return fmt.Errorf("update user %q: %w", want.Email, err)
+		}
+		a.plan.add(ActionUpdate, "user", want.Email, fields...)
+	}
+	return nil
+}
+
+func (a *applier) clients() error {
+	for _, want := range a.m.OAuth2Clients {
+		if want.Scopes == nil {
+			want.Scopes = []string{}
+		}
+		cur, ok, err := lookup(a.st, a.st.clients, "oauth2 client", want.Name)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			secret, err := secrets.Encrypt(want.Secret)
+			if err != nil {
+				return fmt.Errorf("oauth2 client %q: %w", want.Name, err)
+			}
+			created, err := a.q.CreateOauth2Client(a.ctx, query.CreateOauth2ClientParams{
+				UUID:      newUUID(),
+				Name:      want.Name,
+				Provider:  want.Provider,
+				ClientID:  want.ClientID,
+				Secret:    secret,
+				IssuerURL: want.IssuerURL,
+				Scopes:    want.Scopes,
+			})
+			if err != nil {
+				return fmt.Errorf("create oauth2 client %q: %w", want.Name, err)
+			}
+			record(a, a.st.clients, "oauth2 client", want.Name, created, created.UUID)
+			continue
+		}
+
+		have, err := clientItem(cur)
+		if err != nil {
+			return fmt.Errorf("oauth2 client %q: %w", want.Name, err)
+		}
+		if want.Secret == "" || want.Secret == secrets.Redacted {
+			want.Secret = have.Secret
+		}
+		fields, err := diffFields(want, have)
+		if err != nil {
+			return err
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		secret, err := secrets.Encrypt(want.Secret)
+		if err != nil {
+			return fmt.Errorf("oauth2 client %q: %w", want.Name, err)
+		}
+		err = a.q.UpdateOauth2Client(a.ctx, query.UpdateOauth2ClientParams{
+			Name:      want.Name,
+			Provider:  want.Provider,
+			ClientID:  want.ClientID,
+			Secret:    secret,
+			IssuerURL: want.IssuerURL,
+			Scopes:    want.Scopes,
+			UUID:      converter.UuidToPgUUID(cur.UUID),
+		})
+		if err != nil {
+			return fmt.Errorf("update oauth2 client %q: %w", want.Name, err)
+		}
+		a.plan.add(ActionUpdate, "oauth2 client", want.Name, fields...)
+	}
+	return nil
+}
+
+func (a *applier) storages() error {
+	for _, want := range a.m.Storages {
+		want.Enabled = enabledOr(want.Enabled)
+		fields := secrets.StorageFields[want.Type]
+		cur, ok, err := lookup(a.st, a.st.storages, "storage", want.Name)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			settings, err := encodeObject(want.Settings, fields)
+			if err != nil {
+				return fmt.Errorf("storage %q: %w", want.Name, err)
+			}
+			created, err := a.q.CreateStorage(a.ctx, query.CreateStorageParams{
+				UUID:      newUUID(),
+				Name:      want.Name,
+				Type:      want.Type,
+				IsEnabled: *want.Enabled,
+				Settings:  settings,
+			})
+			if err != nil {
+				return fmt.Errorf("create storage %q: %w", want.Name, err)
+			}
+			record(a, a.st.storages, "storage", want.Name, created, created.UUID)
+			continue
+		}
+
+		have, err := storageItem(cur)
+		if err != nil {
+			return fmt.Errorf("storage %q: %w", want.Name, err)
+		}
+		keepRedacted(want.Settings, have.Settings, fields)
+		changed, err := diffFields(want, have)
+		if err != nil {
+			return err
+		}
+		if len(changed) == 0 {
+			continue
+		}
+		settings, err := encodeObject(want.Settings, fields)
+		if err != nil {
+			return fmt.Errorf("storage %q: %w", want.Name, err)
+		}
+		err = a.q.UpdateStorage(a.ctx, query.UpdateStorageParams{
+			Name:      want.Name,
+			Type:      want.Type,
+			IsEnabled: *want.Enabled,
+			Settings:  settings,
+			UUID:      converter.UuidToPgUUID(cur.UUID),
+		})
+		if err != nil {
+			return fmt.Errorf("update storage %q: %w", want.Name, err)
+		}
+		a.plan.add(ActionUpdate, "storage", want.Name, changed...)
+	}
+	return nil
+}
+
+func (a *applier) datasources() error {
+	for _, want := range a.m.Datasources {
+		want.Enabled = enabledOr(want.Enabled)
+		fields := secrets.DatasourceFields[want.Type]
+		userUUID := pgtype.UUID{}
+		if want.User != "" {
+			user, err := ref(a, a.st.users, "user", want.User, "datasource", want.Name)
+			if err != nil {
+				return err
+			}
+			userUUID = converter.UuidToPgUUID(user.UUID)
+		}
+		// the stored settings carry the client UUID and the runtime settings
+		settings := maps.Clone(want.Settings)
+		if settings == nil {
+			settings = map[string]any{}
+		}
+		if want.OAuth2Client != "" {
+			client, err := ref(a, a.st.clients, "oauth2 client", want.OAuth2Client, "datasource", want.Name)
+			if err != nil {
+				return err
+			}
+			settings["oauth2_client_uuid"] = client.UUID.String()
+		}
+
+		cur, ok, err := lookup(a.st, a.st.datasources, "datasource", want.Name)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			data, err := encodeObject(settings, fields)
+			if err != nil {
+				return fmt.Errorf("datasource %q: %w", want.Name, err)
+			}
+			created, err := a.q.CreateDatasource(a.ctx, query.CreateDatasourceParams{
+				UUID:      newUUID(),
+				UserUUID:  userUUID,
+				Name:      want.Name,
+				Type:      want.Type,
+				IsEnabled: *want.Enabled,
+				Provider:  want.Provider,
+				Settings:  data,
+			})
+			if err != nil {
+				return fmt.Errorf("create datasource %q: %w", want.Name, err)
+			}
+			record(a, a.st.datasources, "datasource", want.Name, created, created.UUID)
+			continue
+		}
+
+		have, err := a.st.datasourceItem(cur)
+		if err != nil {
+			return fmt.Errorf("datasource %q: %w", want.Name, err)
+		}
+		stored, err := decryptObject(cur.Settings, secrets.DatasourceFields[cur.Type])
+		if err != nil {
+			return fmt.Errorf("datasource %q: %w", want.Name, err)
+		}
+		keepRedacted(want.Settings, stored, fields)
+		keepRedacted(settings, stored, fields)
+		changed, err := diffFields(want, have)
+		if err != nil {
+			return err
+		}
+		if len(changed) == 0 {
+			continue
+		}
+		for _, key := range runtimeSettings {
+			if v, ok := stored[key]; ok {
+				settings[key] = v
+			}
+		}
+		data, err := encodeObject(settings, fields)
+		if err != nil {
+			return fmt.Errorf("datasource %q: %w", want.Name, err)
+		}
+		err = a.q.UpdateDatasource(a.ctx, query.UpdateDatasourceParams{
+			UserUUID:  userUUID,
+			Type:      want.Type,
+			Name:      want.Name,
+			IsEnabled: *want.Enabled,
+			Provider:  want.Provider,
+			Settings:  data,
+			UUID:      converter.UuidToPgUUID(cur.UUID),
+		})
+		if err != nil {
+			return fmt.Errorf("update datasource %q: %w", want.Name, err)
+		}
+		a.plan.add(ActionUpdate, "datasource", want.Name, changed...)
+	}
+	return nil
+}
+
+func (a *applier) pipelines() error {
+	for _, want := range a.m.Pipelines {
+		want.Enabled = enabledOr(want.Enabled)
+		ds, err := ref(a, a.st.datasources, "datasource", want.Datasource, "pipeline", want.Name)
+		if err != nil {
+			return err
+		}
+		storageUUID := pgtype.UUID{}
+		if want.Storage != "" {
+			storage, err := ref(a, a.st.storages, "storage", want.Storage, "pipeline", want.Name)
+			if err != nil {
+				return err
+			}
+			storageUUID = converter.UuidToPgUUID(storage.UUID)
+		}
+		flow, err := encodeObject(want.Flow, nil)
+		if err != nil {
+			return fmt.Errorf("pipeline %q: %w", want.Name, err)
+		}
+
+		cur, ok, err := lookup(a.st, a.st.pipelines, "pipeline", want.Name)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			created, err := a.q.CreatePipeline(a.ctx, query.CreatePipelineParams{
+				UUID:           newUUID(),
+				DatasourceUUID: converter.UuidToPgUUID(ds.UUID),
+				StorageUuid:    storageUUID,
+				Name:           want.Name,
+				Type:           ds.Type,
+				IsEnabled:      *want.Enabled,
+				Flow:           flow,
+			})
+			if err != nil {
+				return fmt.Errorf("create pipeline %q: %w", want.Name, err)
+			}
+			record(a, a.st.pipelines, "pipeline", want.Name, created, created.UUID)
+			continue
+		}
+
+		have, err := a.st.pipelineItem(cur)
+		if err != nil {
+			return fmt.Errorf("pipeline %q: %w", want.Name, err)
+		}
+		changed, err := diffFields(want, have)
+		if err != nil {
+			return err
+		}
+		if len(changed) == 0 {
+			continue
+		}
+		err = a.q.UpdatePipeline(a.ctx, query.UpdatePipelineParams{
+			Name:           want.Name,
+			Type:           ds.Type,
+			DatasourceUUID: converter.UuidToPgUUID(ds.UUID),
+			StorageUuid:    storageUUID,
+			IsEnabled:      *want.Enabled,
+			Flow:           flow,
+			UUID:           converter.UuidToPgUUID(cur.UUID),
+		})
+		if err != nil {
+			return fmt.Errorf("update pipeline %q: %w", want.Name, err)
+		}
+		a.plan.add(ActionUpdate, "pipeline", want.Name, changed...)
+	}
+	return nil
+}
+
+func (a *applier) syncPolicies() error {
+	for _, want := range a.m.SyncPolicies {
+		pipe, err := ref(a, a.st.pipelines, "pipeline", want.Pipeline, "sync policy", want.Name)
+		if err != nil {
+			return err
+		}
+		settings, err := encodeObject(want.Settings, nil)
+		if err != nil {
+			return fmt.Errorf("sync policy %q: %w", want.Name, err)
+		}
+
+		cur, ok, err := lookup(a.st, a.st.policies, "sync policy", want.Name)
+		if err != nil {
+			return err
+		}
+		if ok && (cur.Type != want.Type || cur.PipelineUuid == nil || *cur.PipelineUuid != pipe.UUID) {
+			// the pipeline and type of a sync policy are fixed, replace it
+			if err := a.q.DeleteSyncPolicy(a.ctx, converter.UuidToPgUUID(cur.UUID)); err != nil {
+				return fmt.Errorf("delete sync policy %q: %w", want.Name, err)
+			}
+			a.plan.add(ActionDelete, "sync policy", want.Name)
+			ok = false
+		}
+		if !ok {
+			created, err := a.q.CreateSyncPolicy(a.ctx, query.CreateSyncPolicyParams{
+				UUID:         newUUID(),
+				PipelineUuid: converter.UuidToPgUUID(pipe.UUID),
+				Name:         want.Name,
+				Type:         want.Type,
+				Blocklist:    orEmpty(want.Blocklist),
+				ExcludeList:  orEmpty(want.ExcludeList),
+				SyncAll:      want.SyncAll,
+				Settings:     settings,
+			})
+			if err != nil {
+				return fmt.Errorf("create sync policy %q: %w", want.Name, err)
+			}
+			record(a, a.st.policies, "sync policy", want.Name, created, created.UUID)
+			continue
+		}
+
+		have, err := a.st.syncPolicyItem(cur)
+		if err != nil {
+			return fmt.Errorf("sync policy %q: %w", want.Name, err)
+		}
+		changed, err := diffFields(want, have)
+		if err != nil {
+			return err
+		}
+		if len(changed) == 0 {
+			continue
+		}
+		err = a.q.UpdateSyncPolicy(a.ctx, query.UpdateSyncPolicyParams{
+			Name:        want.Name,
+			Blocklist:   orEmpty(want.Blocklist),
+			ExcludeList: orEmpty(want.ExcludeList),
+			SyncAll:     want.SyncAll,
+			Settings:    settings,
+			UUID:        converter.UuidToPgUUID(cur.UUID),
+		})
+		if err != nil {
+			return fmt.Errorf("update sync policy %q: %w", want.Name, err)
+		}
+		a.plan.add(ActionUpdate, "sync policy", want.Name, changed...)
+	}
+	return nil
+}
+
+func (a *applier) schedulers() error {
+	wants := make([]Scheduler, len(a.m.Schedulers))
+	pipes := make([]query.Pipeline, len(a.m.Schedulers))
+	for i, want := range a.m.Schedulers {
+		pipe, err := ref(a, a.st.pipelines, "pipeline", want.Pipeline, "scheduler", schedulerName(want))
+		if err != nil {
+			return err
+		}
+		if wants[i], err = normalizeScheduler(want); err != nil {
+			return err
+		}
+		pipes[i] = pipe
+	}
+
+	// exact matches first, then the schedulers of the pipeline in order, so a
+	// changed schedule updates the scheduler it replaces
+	matched := make([]*query.Scheduler, len(wants))
+	used := map[uuid.UUID]bool{}
+	match := func(same func(want Scheduler, have Scheduler) bool) {
+		for i, want := range wants {
+			if matched[i] != nil {
+				continue
+			}
+			for j := range a.st.schedulers {
+				cur := &a.st.schedulers[j]
+				if used[cur.UUID] || cur.PipelineUuid == nil || *cur.PipelineUuid != pipes[i].UUID {
+					continue
+				}
+				if same(want, a.st.schedulerItem(*cur)) {
+					matched[i] = cur
+					used[cur.UUID] = true
+					break
+				}
+			}
+		}
+	}
+	match(func(want, have Scheduler) bool { return scheduleKey(want) == scheduleKey(have) })
+	match(func(Scheduler, Scheduler) bool { return true })
+
+	for i, want := range wants {
+		if err := a.scheduler(want, pipes[i], matched[i]); err != nil {
+			return err
+		}
+	}
+	a.usedSchedulers = used
+	return nil
+}
+
+func (a *applier) scheduler(want Scheduler, pipe query.Pipeline, cur *query.Scheduler) error {
+	schedule := scheduler.Schedule{
+		Type:     want.Type,
+		Cron:     want.Cron,
+		Timezone: want.Timezone,
+		Interval: want.Interval,
+		Jitter:   want.Jitter,
+	}
+	if want.RunAt != nil {
+		schedule.RunAt = *want.RunAt
+	}
+	name := schedulerName(want)
+	params := query.UpdateSchedulerParams{
+		ScheduleType:    want.Type,
+		CronExpression:  pgtype.Text{String: want.Cron, Valid: want.Cron != ""},
+		RunAt:           scheduleTime(schedule.RunAt),
+		Timezone:        want.Timezone,
+		IsEnabled:       *want.Enabled,
+		IsPaused:        want.Paused,
+		IntervalSeconds: pgtype.Int4{Int32: int32(want.Interval / time.Second), Valid: want.Type == scheduler.TypeInterval},
+		JitterSeconds:   int32(want.Jitter / time.Second),
+		OverlapPolicy:   want.OverlapPolicy,
+	}
+
+	var have Scheduler
+	if cur != nil {
+		have = a.st.schedulerItem(*cur)
+		params.UUID = converter.UuidToPgUUID(cur.UUID)
+		params.NextRun, params.LastRun = cur.NextRun, cur.LastRun
+	}
+	// a new or changed schedule starts over
+	if cur == nil || scheduleKey(want) != scheduleKey(have) || want.Jitter != have.Jitter {
+		first, err := schedule.First(a.now)
+		if err != nil {
+			return fmt.Errorf("scheduler %s: %w", name, err)
+		}
+		params.NextRun = scheduleTime(first)
+	}
+
+	if cur == nil {
+		created, err := a.q.CreateScheduler(a.ctx, query.CreateSchedulerParams{
+			UUID:            newUUID(),
+			PipelineUuid:    converter.UuidToPgUUID(pipe.UUID),
+			ScheduleType:    params.ScheduleType,
+			CronExpression:  params.CronExpression,
+			RunAt:           params.RunAt,
+			Timezone:        params.Timezone,
+			NextRun:         params.NextRun,
+			LastRun:         converter.NullTimestamptz(),
+			IsEnabled:       params.IsEnabled,
+			IsPaused:        params.IsPaused,
+			IntervalSeconds: params.IntervalSeconds,
+			JitterSeconds:   params.JitterSeconds,
+			OverlapPolicy:   params.OverlapPolicy,
+		})
+		if err != nil {
+			return fmt.Errorf("create scheduler %s: %w", name, err)
+		}
+		a.st.schedulers = append(a.st.schedulers, created)
+		a.plan.add(ActionCreate, "scheduler", name)
+		return nil
+	}
+
+	changed, err := diffFields(want, have)
+	if err != nil || len(changed) == 0 {
+		return err
+	}
+	if err := a.q.UpdateScheduler(a.ctx, params); err != nil {
+		return fmt.Errorf("update scheduler %s: %w", name, err)
+	}
+	a.plan.add(ActionUpdate, "scheduler", schedulerName(have), changed...)
+	return nil
+}
+
+// prune deletes the stored items missing from the manifest, for the kinds
+// the manifest lists.
+func (a *applier) prune() error {
+	if a.m.Schedulers != nil {
+		for _, s := range a.st.schedulers {
+			if a.usedSchedulers[s.UUID] {
+				continue
+			}
+			if err := a.q.DeleteScheduler(a.ctx, converter.UuidToPgUUID(s.UUID)); err != nil {
+				return fmt.Errorf("delete scheduler: %w", err)
+			}
+			a.plan.add(ActionDelete, "scheduler", schedulerName(a.st.schedulerItem(s)))
+		}
+	}
+	if a.m.SyncPolicies != nil {
+		want := keys(a.m.SyncPolicies, func(p SyncPolicy) string { return p.Name })
+		if err := pruneKind(a, "sync policy", a.st.policies, want, func(p query.SyncPolicy) uuid.UUID { return p.UUID }, a.q.DeleteSyncPolicy); err != nil {
+			return err
+		}
+	}
+	if a.m.Pipelines != nil {
+		want := keys(a.m.Pipelines, func(p Pipeline) string { return p.Name })
+		if err := pruneKind(a, "pipeline", a.st.pipelines, want, func(p query.Pipeline) uuid.UUID { return p.UUID }, a.q.DeletePipeline); err != nil {
+			return err
+		}
+	}
+	if a.m.Datasources != nil {
+		want := keys(a.m.Datasources, func(d Datasource) string { return d.Name })
+		if err := pruneKind(a, "datasource", a.st.datasources, want, func(d query.Datasource) uuid.UUID { return d.UUID }, a.q.DeleteDatasource); err != nil {
+			return err
+		}
+	}
+	if a.m.Storages != nil {
+		want := keys(a.m.Storages, func(s Storage) string { return s.Name })
+		if err := pruneKind(a, "storage", a.st.storages, want, func(s query.Storage) uuid.UUID { return s.UUID }, a.q.DeleteStorage); err != nil {
+			return err
+		}
+	}
+	if a.m.OAuth2Clients != nil {
+		want := keys(a.m.OAuth2Clients, func(c OAuth2Client) string { return c.Name })
+		if err := pruneKind(a, "oauth2 client", a.st.clients, want, func(c query.Oauth2Client) uuid.UUID { return c.UUID }, a.q.DeleteOauth2Client); err != nil {
+			return err
+		}
+	}
+	if a.m.Users != nil {
+		want := keys(a.m.Users, func(u User) string { return u.Email })
+		if err := pruneKind(a, "user", a.st.users, want, func(u query.User) uuid.UUID { return u.UUID }, a.q.DeleteUser); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func pruneKind[T any](a *applier, kind string, items map[string]T, want []string, id func(T) uuid.UUID, del func(context.Context, pgtype.UUID) error) error {
+	for _, name := range sortedKeys(items) {
+		if slices.Contains(want, name) {
+			continue
+		}
+		if _, _, err := lookup(a.st, items, kind, name); err != nil {
+			return err
+		}
+		if err := del(a.ctx, converter.UuidToPgUUID(id(items[name]))); err != nil {
+			return fmt.Errorf("delete %s %q: %w", kind, name, err)
+		}
+		a.plan.add(ActionDelete, kind, name)
+	}
+	return nil
+}
+
+// ref resolves the reference of an item to a stored or created item.
+func ref[T any](a *applier, items map[string]T, kind, name, fromKind, fromName string) (T, error) {
+	item, ok, err := lookup(a.st, items, kind, name)
+	if err != nil {
+		return item, err
+	}
+	if !ok {
+		return item, fmt.Errorf("%s %q references unknown %s %q", fromKind, fromName, kind, name)
+	}
+	return item, nil
+}
+
+// record adds an item created by the apply to the state.
+func record[T any](a *applier, items map[string]T, kind, name string, item T, id uuid.UUID) {
+	items[name] = item
+	a.st.names[id] = name
+	a.plan.add(ActionCreate, kind, name)
+}
+
+// normalizeScheduler fills the defaults of a manifest scheduler and checks
+// its schedule.
+func normalizeScheduler(s Scheduler) (Scheduler, error) {
+	s.Enabled = enabledOr(s.Enabled)
+	if s.Timezone == "" {
+		s.Timezone = "UTC"
+	}
+	if s.OverlapPolicy == "" {
+		s.OverlapPolicy = scheduler.OverlapSkip
+	}
+	if !slices.Contains(scheduler.OverlapPolicies, s.OverlapPolicy) {
+		return s, fmt.Errorf("scheduler %s: unknown overlap policy %q, use skip, queue or cancel", schedulerName(s), s.OverlapPolicy)
+	}
+	if s.RunAt != nil {
+		runAt := s.RunAt.UTC()
+		s.RunAt = &runAt
+	}
+	// only the fields of the schedule type are stored
+	switch s.Type {
+	case scheduler.TypeCron:
+		s.Interval, s.RunAt = 0, nil
+	case scheduler.TypeInterval:
+		s.Cron, s.RunAt = "", nil
+	case scheduler.TypeOneTime:
+		s.Cron, s.Interval = "", 0
+	}
+	schedule := scheduler.Schedule{Type: s.Type, Cron: s.Cron, Timezone: s.Timezone, Interval: s.Interval, Jitter: s.Jitter}
+	if s.RunAt != nil {
+		schedule.RunAt = *s.RunAt
+	}
+	if err := schedule.Validate(); err != nil {
+		return s, fmt.Errorf("scheduler %s: %w", schedulerName(s), err)
+	}
+	return s, nil
+}
+
+// scheduleKey identifies the schedule of a scheduler.
+func scheduleKey(s Scheduler) string {
+	switch s.Type {
+	case scheduler.TypeCron:
+		return fmt.Sprintf("cron %q %s", s.Cron, s.Timezone)
+	case scheduler.TypeInterval:
+		return fmt.Sprintf("every %s", s.Interval)
+	case scheduler.TypeOneTime:
+		if s.RunAt != nil {
+			return fmt.Sprintf("at %s", s.RunAt.UTC().Format(time.RFC3339))
+		}
+	}
+	return s.Type
+}
+
+func schedulerName(s Scheduler) string {
+	return s.Pipeline + " " + scheduleKey(s)
+}
+
+func enabledOr(enabled *bool) *bool {
+	if enabled == nil {
+		enabled = new(bool)
+		*enabled = true
+	}
+	return enabled
+}
+
+// hashPassword hashes a password for the login, empty passwords stay empty.
+func hashPassword(password string) (string, error) {
+	if password == "" {
+		return "", nil
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	return string(hash), err
+}
+
+func orEmpty(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+func newUUID() pgtype.UUID {
+	return converter.UuidToPgUUID(uuid.Must(uuid.NewV7()))
+}
+
+func scheduleTime(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: t, Valid: !t.IsZero()}
+}
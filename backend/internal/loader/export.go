@@ -0,0 +1,100 @@
+package loader
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/shadowapi/shadowapi/backend/internal/db"
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// Export returns the stored configuration of the workspace with the slug or
+// UUID as a manifest. Secrets are redacted, applying the manifest keeps the
+// stored ones, replace them with ${NAME} references to set them.
+func (l *Loader) Export(ctx context.Context, ws string) (*Manifest, error) {
+	ctx, err := l.scope(ctx, ws)
+	if err != nil {
+		return nil, err
+	}
+	return db.InTx(ctx, l.dbp, func(tx pgx.Tx) (*Manifest, error) {
+		st, err := loadState(ctx, query.New(tx))
+		if err != nil {
+			return nil, err
+		}
+		if len(st.ambiguous) > 0 {
+			return nil, fmt.Errorf("names shared by several items, rename them before exporting: %s", strings.Join(sortedKeys(st.ambiguous), ", "))
+		}
+		return st.export(ws)
+	})
+}
+
+func (st *state) export(ws string) (*Manifest, error) {
+	m := &Manifest{Workspace: ws}
+	for _, name := range sortedKeys(st.users) {
+		item, err := userItem(st.users[name])
+		if err != nil {
+			return nil, fmt.Errorf("user %q: %w", name, err)
+		}
+		item.Password = secrets.Redact(item.Password)
+		m.Users = append(m.Users, item)
+	}
+	for _, name := range sortedKeys(st.clients) {
+		item, err := clientItem(st.clients[name])
+		if err != nil {
+			return nil, fmt.Errorf("oauth2 client %q: %w", name, err)
+		}
+		item.Secret = secrets.Redact(item.Secret)
+		m.OAuth2Clients = append(m.OAuth2Clients, item)
+	}
+	for _, name := range sortedKeys(st.storages) {
+		item, err := storageItem(st.storages[name])
+		if err != nil {
+			return nil, fmt.Errorf("storage %q: %w", name, err)
+		}
+		redact(item.Settings, secrets.StorageFields[item.Type])
+		m.Storages = append(m.Storages, item)
+	}
+	for _, name := range sortedKeys(st.datasources) {
+		item, err := st.datasourceItem(st.datasources[name])
+		if err != nil {
+			return nil, fmt.Errorf("datasource %q: %w", name, err)
+		}
+		redact(item.Settings, secrets.DatasourceFields[item.Type])
+		m.Datasources = append(m.Datasources, item)
+	}
+	for _, name := range sortedKeys(st.pipelines) {
+		item, err := st.pipelineItem(st.pipelines[name])
+		if err != nil {
+			return nil, fmt.Errorf("pipeline %q: %w", name, err)
+		}
+		m.Pipelines = append(m.Pipelines, item)
+	}
+	for _, name := range sortedKeys(st.policies) {
+		item, err := st.syncPolicyItem(st.policies[name])
+		if err != nil {
+			return nil, fmt.Errorf("sync policy %q: %w", name, err)
+		}
+		m.SyncPolicies = append(m.SyncPolicies, item)
+	}
+	for _, s := range st.schedulers {
+		m.Schedulers = append(m.Schedulers, st.schedulerItem(s))
+	}
+	slices.SortStableFunc(m.Schedulers, func(a, b Scheduler) int {
+		return strings.Compare(a.Pipeline, b.Pipeline)
+	})
+	return m, nil
+}
+
+func sortedKeys[T any](items map[string]T) []string {
+	out := make([]string, 0, len(items))
+	for k := range items {
+		out = append(out, k)
+	}
+	slices.Sort(out)
+	return out
+}
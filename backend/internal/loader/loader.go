@@ -1,11 +1,18 @@
+// Package loader applies declarative configuration manifests to the database
+// and exports the stored configuration as a manifest, so environments can be
+// kept in version control and reproduced.
 package loader
 
 import (
 	"context"
+	"log/slog"
+
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/samber/do/v2"
+
 	"github.com/shadowapi/shadowapi/backend/internal/config"
-	"log/slog"
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
 )
 
 type Loader struct {
@@ -25,8 +32,12 @@ func Provide(i do.Injector) (*Loader, error) {
 	}, nil
 }
 
-// Run starts the loader
-func (s *Loader) Run(ctx context.Context) error {
-	print("Run loader")
-	return nil
+// scope returns ctx scoped to the workspace with the slug or UUID, the
+// default workspace when empty.
+func (l *Loader) scope(ctx context.Context, ref string) (context.Context, error) {
+	id, err := workspace.Resolve(ctx, query.New(l.dbp), ref)
+	if err != nil {
+		return nil, err
+	}
+	return workspace.WithUUID(ctx, id), nil
 }
@@ -0,0 +1,223 @@
+package loader
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Manifest is the configuration applied by the loader. Items are identified
+// and referenced by name, users by email.
+//
+// A kind that is absent from the manifest is left alone, a kind listed empty
+// (e.g. `storages: []`) is pruned entirely when pruning.
+type Manifest struct {
+	// Workspace is the slug or UUID of the workspace owning the storages,
+	// datasources, pipelines, sync policies and schedulers, the default
+	// workspace when empty. Users and OAuth2 clients are global.
+	Workspace     string         `yaml:"workspace,omitempty"`
+	Users         []User         `yaml:"users,omitempty"`
+	OAuth2Clients []OAuth2Client `yaml:"oauth2_clients,omitempty"`
+	Storages      []Storage      `yaml:"storages,omitempty"`
+	Datasources   []Datasource   `yaml:"datasources,omitempty"`
+	Pipelines     []Pipeline     `yaml:"pipelines,omitempty"`
+	SyncPolicies  []SyncPolicy   `yaml:"sync_policies,omitempty"`
+	Schedulers    []Scheduler    `yaml:"schedulers,omitempty"`
+}
+
+// User is identified by its email. The password is stored hashed, an empty
+// password keeps the stored one.
+type User struct {
+	Email     string         `yaml:"email"`
+	Password  string         `yaml:"password,omitempty"`
+	FirstName string         `yaml:"first_name,omitempty"`
+	LastName  string         `yaml:"last_name,omitempty"`
+	Enabled   *bool          `yaml:"enabled,omitempty"`
+	Admin     bool           `yaml:"admin,omitempty"`
+	Meta      map[string]any `yaml:"meta,omitempty"`
+}
+
+type OAuth2Client struct {
+	Name      string   `yaml:"name"`
+	Provider  string   `yaml:"provider"`
+	ClientID  string   `yaml:"client_id"`
+	Secret    string   `yaml:"secret,omitempty"`
+	IssuerURL string   `yaml:"issuer_url,omitempty"`
+	Scopes    []string `yaml:"scopes,omitempty"`
+}
+
+type Storage struct {
+	Name     string         `yaml:"name"`
+	Type     string         `yaml:"type"`
+	Enabled  *bool          `yaml:"enabled,omitempty"`
+	Settings map[string]any `yaml:"settings,omitempty"`
+}
+
+// Datasource references its user by email and its OAuth2 client by name, the
+// client is stored as settings.oauth2_client_uuid.
+type Datasource struct {
+	Name         string         `yaml:"name"`
+	Type         string         `yaml:"type"`
+	Provider     string         `yaml:"provider,omitempty"`
+	User         string         `yaml:"user,omitempty"`
+	OAuth2Client string         `yaml:"oauth2_client,omitempty"`
+	Enabled      *bool          `yaml:"enabled,omitempty"`
+	Settings     map[string]any `yaml:"settings,omitempty"`
+}
+
+type Pipeline struct {
+	Name       string         `yaml:"name"`
+	Datasource string         `yaml:"datasource"`
+	Storage    string         `yaml:"storage,omitempty"`
+	Enabled    *bool          `yaml:"enabled,omitempty"`
+	Flow       map[string]any `yaml:"flow,omitempty"`
+}
+
+// SyncPolicy belongs to a pipeline, changing its pipeline or type replaces
+// it.
+type SyncPolicy struct {
+	Name        string         `yaml:"name"`
+	Pipeline    string         `yaml:"pipeline"`
+	Type        string         `yaml:"type"`
+	Blocklist   []string       `yaml:"blocklist,omitempty"`
+	ExcludeList []string       `yaml:"exclude_list,omitempty"`
+	SyncAll     bool           `yaml:"sync_all,omitempty"`
+	Settings    map[string]any `yaml:"settings,omitempty"`
+}
+
+// Scheduler has no name, it matches the stored scheduler of its pipeline
+// with the same schedule, else the next unmatched one of the pipeline.
+type Scheduler struct {
+	Pipeline      string        `yaml:"pipeline"`
+	Type          string        `yaml:"type"`
+	Cron          string        `yaml:"cron,omitempty"`
+	Timezone      string        `yaml:"timezone,omitempty"`
+	Interval      time.Duration `yaml:"interval,omitempty"`
+	RunAt         *time.Time    `yaml:"run_at,omitempty"`
+	Jitter        time.Duration `yaml:"jitter,omitempty"`
+	OverlapPolicy string        `yaml:"overlap_policy,omitempty"`
+	Enabled       *bool         `yaml:"enabled,omitempty"`
+	Paused        bool          `yaml:"paused,omitempty"`
+}
+
+// ReadManifest reads a YAML or JSON manifest file, "-" reads stdin.
+func ReadManifest(path string) (*Manifest, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ParseManifest(data)
+}
+
+// ParseManifest parses a YAML or JSON manifest. ${NAME} is replaced by the
+// environment variable NAME beforehand, so secrets stay out of the file.
+func ParseManifest(data []byte) (*Manifest, error) {
+	expanded, err := expandEnv(string(data))
+	if err != nil {
+		return nil, err
+	}
+	var m Manifest
+	dec := yaml.NewDecoder(strings.NewReader(expanded))
+	dec.KnownFields(true)
+	if err := dec.Decode(&m); err != nil {
+		return nil, fmt.Errorf("parse manifest: %w", err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+var envRef = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+func expandEnv(s string) (string, error) {
+	var missing []string
+	out := envRef.ReplaceAllStringFunc(s, func(ref string) string {
+		name := envRef.FindStringSubmatch(ref)[1]
+		v, ok := os.LookupEnv(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+		return v
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("manifest references unset environment variables: %s", strings.Join(missing, ", "))
+	}
+	return out, nil
+}
+
+// Validate checks the items have their keys and that no key is used twice.
+// References are resolved when applying, they may point to items that are
+// only in the database.
+func (m *Manifest) Validate() error {
+	var errs []string
+	check := func(kind string, keys []string) {
+		seen := map[string]bool{}
+		for i, k := range keys {
+			switch {
+			case k == "":
+				errs = append(errs, fmt.Sprintf("%s #%d has no name", kind, i+1))
+			case seen[k]:
+				errs = append(errs, fmt.Sprintf("%s %q is listed twice", kind, k))
+			}
+			seen[k] = true
+		}
+	}
+	check("user", keys(m.Users, func(u User) string { return u.Email }))
+	check("oauth2 client", keys(m.OAuth2Clients, func(c OAuth2Client) string { return c.Name }))
+	check("storage", keys(m.Storages, func(s Storage) string { return s.Name }))
+	check("datasource", keys(m.Datasources, func(d Datasource) string { return d.Name }))
+	check("pipeline", keys(m.Pipelines, func(p Pipeline) string { return p.Name }))
+	check("sync policy", keys(m.SyncPolicies, func(p SyncPolicy) string { return p.Name }))
+
+	for _, d := range m.Datasources {
+		if d.Type == "" {
+			errs = append(errs, fmt.Sprintf("datasource %q has no type", d.Name))
+		}
+	}
+	for _, s := range m.Storages {
+		if s.Type == "" {
+			errs = append(errs, fmt.Sprintf("storage %q has no type", s.Name))
+		}
+	}
+	for _, p := range m.Pipelines {
+		if p.Datasource == "" {
+			errs = append(errs, fmt.Sprintf("pipeline %q has no datasource", p.Name))
+		}
+	}
+	for _, p := range m.SyncPolicies {
+		if p.Pipeline == "" {
+			errs = append(errs, fmt.Sprintf("sync policy %q has no pipeline", p.Name))
+		}
+	}
+	for i, s := range m.Schedulers {
+		if s.Pipeline == "" {
+			errs = append(errs, fmt.Sprintf("scheduler #%d has no pipeline", i+1))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid manifest:\n  %s", strings.Join(errs, "\n  "))
+	}
+	return nil
+}
+
+func keys[T any](items []T, key func(T) string) []string {
+	out := make([]string, len(items))
+	for i, item := range items {
+		out[i] = key(item)
+	}
+	return out
+}
@@ -0,0 +1,106 @@
+package loader
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const testManifest = `
+storages:
+  - name: archive
+    type: s3
+    settings:
+      bucket: mail
+      secret_access_key: ${LOADER_TEST_SECRET}
+      part_size: 5
+pipelines:
+  - name: inbox
+    datasource: gmail
+    storage: archive
+schedulers:
+  - pipeline: inbox
+    type: interval
+    interval: 15m
+    jitter: 30s
+`
+
+func TestParseManifest(t *testing.T) {
+	t.Setenv("LOADER_TEST_SECRET", "s3cr3t")
+	m, err := ParseManifest([]byte(testManifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := m.Storages[0].Settings["secret_access_key"]; got != "s3cr3t" {
+		t.Errorf("secret_access_key %v, want the environment value", got)
+	}
+	if s := m.Schedulers[0]; s.Interval != 15*time.Minute || s.Jitter != 30*time.Second {
+		t.Errorf("scheduler interval %s jitter %s, want 15m and 30s", s.Interval, s.Jitter)
+	}
+	if m.Users != nil {
+		t.Error("absent kinds must stay nil, they are not pruned")
+	}
+
+	// exported manifests parse back to the same items
+	data, err := yaml.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := ParseManifest(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields, err := diffFields(again.Schedulers[0], m.Schedulers[0])
+	if err != nil || len(fields) > 0 {
+		t.Errorf("round trip changed %v (%v)", fields, err)
+	}
+}
+
+func TestParseManifestErrors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		manifest string
+		want     string
+	}{
+		"unset variable": {"storages:\n  - {name: a, type: s3, settings: {key: '${LOADER_TEST_UNSET}'}}", "LOADER_TEST_UNSET"},
+		"unknown field":  {"storages:\n  - {name: a, type: s3, bucket: x}", "bucket"},
+		"duplicate":      {"storages:\n  - {name: a, type: s3}\n  - {name: a, type: postgres}", `storage "a" is listed twice`},
+		"no datasource":  {"pipelines:\n  - {name: p}", `pipeline "p" has no datasource`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseManifest([]byte(tc.manifest))
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("got %v, want an error mentioning %s", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestDiffFields(t *testing.T) {
+	enabled := true
+	stored := Storage{Name: "archive", Type: "s3", Enabled: &enabled, Settings: map[string]any{
+		// numbers decoded from the JSON column
+		"part_size": float64(5),
+		"bucket":    "mail",
+	}}
+	want := Storage{Name: "archive", Type: "s3", Enabled: &enabled, Settings: map[string]any{
+		"part_size": 5,
+		"bucket":    "mail",
+	}}
+	if fields, err := diffFields(want, stored); err != nil || len(fields) > 0 {
+		t.Errorf("equal storages differ in %v (%v)", fields, err)
+	}
+
+	want.Settings["bucket"] = "other"
+	disabled := false
+	want.Enabled = &disabled
+	fields, err := diffFields(want, stored)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(fields, []string{"enabled", "settings"}) {
+		t.Errorf("changed fields %v, want enabled and settings", fields)
+	}
+}
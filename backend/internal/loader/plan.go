@@ -0,0 +1,113 @@
+package loader
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Change actions
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+// Change is one item created, updated or deleted by an apply.
+type Change struct {
+	Action string
+	Kind   string
+	Name   string
+	// Fields lists the changed fields of an update
+	Fields []string
+}
+
+func (c Change) String() string {
+	sign := map[string]string{ActionCreate: "+", ActionUpdate: "~", ActionDelete: "-"}[c.Action]
+	if len(c.Fields) == 0 {
+		return fmt.Sprintf("%s %s %s", sign, c.Kind, c.Name)
+	}
+	return fmt.Sprintf("%s %s %s (%s)", sign, c.Kind, c.Name, strings.Join(c.Fields, ", "))
+}
+
+// Plan lists the changes of an apply, in the order they were made.
+type Plan struct {
+	DryRun  bool
+	Changes []Change
+}
+
+func (p *Plan) add(action, kind, name string, fields ...string) {
+	p.Changes = append(p.Changes, Change{Action: action, Kind: kind, Name: name, Fields: fields})
+}
+
+// Count returns the number of changes with the action.
+func (p *Plan) Count(action string) int {
+	n := 0
+	for _, c := range p.Changes {
+		if c.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// String renders the plan as a diff followed by a summary line.
+func (p *Plan) String() string {
+	var b strings.Builder
+	for _, c := range p.Changes {
+		b.WriteString(c.String())
+		b.WriteByte('\n')
+	}
+	if len(p.Changes) == 0 {
+		return "No changes, the database matches the manifest\n"
+	}
+	verb := "Applied"
+	if p.DryRun {
+		verb = "Dry run, nothing applied"
+	}
+	fmt.Fprintf(&b, "%s: %d to create, %d to update, %d to delete\n",
+		verb, p.Count(ActionCreate), p.Count(ActionUpdate), p.Count(ActionDelete))
+	return b.String()
+}
+
+// diffFields returns the YAML keys of the fields that differ between two
+// manifest items. Both go through YAML so numbers decoded from JSON settings
+// compare equal to the ones written in the manifest.
+func diffFields(want, have any) ([]string, error) {
+	w, err := yamlFields(want)
+	if err != nil {
+		return nil, err
+	}
+	h, err := yamlFields(have)
+	if err != nil {
+		return nil, err
+	}
+	var fields []string
+	for k, v := range w {
+		if !reflect.DeepEqual(v, h[k]) {
+			fields = append(fields, k)
+		}
+	}
+	for k := range h {
+		if _, ok := w[k]; !ok {
+			fields = append(fields, k)
+		}
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
+
+func yamlFields(item any) (map[string]any, error) {
+	data, err := yaml.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]any{}
+	if err := yaml.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,295 @@
+package loader
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// runtimeSettings are datasource settings set by the application rather than
+// by configuration, they are kept on update and left out of exports.
+var runtimeSettings = []string{"oauth2_token_uuid"}
+
+// state is the configuration stored in the database, indexed by the keys of
+// the manifest items. names maps the UUID of every item to its key.
+type state struct {
+	users       map[string]query.User
+	clients     map[string]query.Oauth2Client
+	storages    map[string]query.Storage
+	datasources map[string]query.Datasource
+	pipelines   map[string]query.Pipeline
+	policies    map[string]query.SyncPolicy
+	schedulers  []query.Scheduler
+	names       map[uuid.UUID]string
+	// ambiguous holds the "kind/name" keys shared by several items
+	ambiguous map[string]bool
+}
+
+func loadState(ctx context.Context, q *query.Queries) (*state, error) {
+	st := &state{names: map[uuid.UUID]string{}, ambiguous: map[string]bool{}}
+
+	users, err := q.ListUsers(ctx, query.ListUsersParams{})
+	if err != nil {
+		return nil, fmt.Errorf("list users: %w", err)
+	}
+	st.users = index(st, "user", users, func(u query.User) (string, uuid.UUID) { return u.Email, u.UUID })
+
+	clients, err := q.ListOauth2Clients(ctx, query.ListOauth2ClientsParams{})
+	if err != nil {
+		return nil, fmt.Errorf("list oauth2 clients: %w", err)
+	}
+	st.clients = index(st, "oauth2 client", rows(clients, func(r query.ListOauth2ClientsRow) query.Oauth2Client { return r.Oauth2Client }),
+		func(c query.Oauth2Client) (string, uuid.UUID) { return c.Name, c.UUID })
+
+	storages, err := q.ListStorages(ctx, query.ListStoragesParams{})
+	if err != nil {
+		return nil, fmt.Errorf("list storages: %w", err)
+	}
+	st.storages = index(st, "storage", rows(storages, func(r query.ListStoragesRow) query.Storage { return r.Storage }),
+		func(s query.Storage) (string, uuid.UUID) { return s.Name, s.UUID })
+
+	datasources, err := q.ListDatasources(ctx, query.ListDatasourcesParams{})
+	if err != nil {
+		return nil, fmt.Errorf("list datasources: %w", err)
+	}
+	st.datasources = index(st, "datasource", rows(datasources, func(r query.ListDatasourcesRow) query.Datasource { return r.Datasource }),
+		func(d query.Datasource) (string, uuid.UUID) { return d.Name, d.UUID })
+
+	pipelines, err := q.ListPipelines(ctx, query.ListPipelinesParams{})
+	if err != nil {
+		return nil, fmt.Errorf("list pipelines: %w", err)
+	}
+	st.pipelines = index(st, "pipeline", rows(pipelines, func(r query.ListPipelinesRow) query.Pipeline { return r.Pipeline }),
+		func(p query.Pipeline) (string, uuid.UUID) { return p.Name, p.UUID })
+
+	policies, err := q.ListSyncPolicies(ctx, query.ListSyncPoliciesParams{})
+	if err != nil {
+		return nil, fmt.Errorf("list sync policies: %w", err)
+	}
+	st.policies = index(st, "sync policy", rows(policies, func(r query.ListSyncPoliciesRow) query.SyncPolicy { return r.SyncPolicy }),
+		func(p query.SyncPolicy) (string, uuid.UUID) { return p.Name, p.UUID })
+
+	schedulers, err := q.ListSchedulers(ctx, query.ListSchedulersParams{})
+	if err != nil {
+		return nil, fmt.Errorf("list schedulers: %w", err)
+	}
+	st.schedulers = rows(schedulers, func(r query.ListSchedulersRow) query.Scheduler { return r.Scheduler })
+	return st, nil
+}
+
+func rows[R, T any](in []R, item func(R) T) []T {
+	out := make([]T, len(in))
+	for i, r := range in {
+		out[i] = item(r)
+	}
+	return out
+}
+
+// index keys the items by name. Names are not unique in the database, an
+// ambiguous name can be neither referenced nor managed, see lookup.
+func index[T any](st *state, kind string, items []T, key func(T) (string, uuid.UUID)) map[string]T {
+	out := make(map[string]T, len(items))
+	for _, item := range items {
+		name, id := key(item)
+		if _, ok := out[name]; ok {
+			st.ambiguous[kind+"/"+name] = true
+		}
+		out[name] = item
+		st.names[id] = name
+	}
+	return out
+}
+
+// lookup returns the stored item of kind named name.
+func lookup[T any](st *state, items map[string]T, kind, name string) (T, bool, error) {
+	item, ok := items[name]
+	if ok && st.ambiguous[kind+"/"+name] {
+		return item, false, fmt.Errorf("several %ss are named %q, rename them before using the loader", kind, name)
+	}
+	return item, ok, nil
+}
+
+// name returns the key of the item with the UUID, empty for nil.
+func (st *state) name(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return st.names[*id]
+}
+
+// The functions below convert stored items to manifest items with their
+// secrets in plaintext, for diffing and exporting.
+
+func userItem(u query.User) (User, error) {
+	meta, err := decodeObject(u.Meta)
+	return User{
+		Email:     u.Email,
+		Password:  u.Password,
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+		Enabled:   &u.IsEnabled,
+		Admin:     u.IsAdmin,
+		Meta:      meta,
+	}, err
+}
+
+func clientItem(c query.Oauth2Client) (OAuth2Client, error) {
+	secret, err := secrets.Decrypt(c.Secret)
+	return OAuth2Client{
+		Name:      c.Name,
+		Provider:  c.Provider,
+		ClientID:  c.ClientID,
+		Secret:    secret,
+		IssuerURL: c.IssuerURL,
+		Scopes:    c.Scopes,
+	}, err
+}
+
+func storageItem(s query.Storage) (Storage, error) {
+	settings, err := decryptObject(s.Settings, secrets.StorageFields[s.Type])
+	return Storage{
+		Name:     s.Name,
+		Type:     s.Type,
+		Enabled:  &s.IsEnabled,
+		Settings: settings,
+	}, err
+}
+
+func (st *state) datasourceItem(d query.Datasource) (Datasource, error) {
+	settings, err := decryptObject(d.Settings, secrets.DatasourceFields[d.Type])
+	if err != nil {
+		return Datasource{}, err
+	}
+	item := Datasource{
+		Name:     d.Name,
+		Type:     d.Type,
+		Provider: d.Provider,
+		User:     st.name(d.UserUUID),
+		Enabled:  &d.IsEnabled,
+		Settings: settings,
+	}
+	if id, ok := settings["oauth2_client_uuid"].(string); ok {
+		if clientUUID, err := uuid.FromString(id); err == nil && st.names[clientUUID] != "" {
+			item.OAuth2Client = st.names[clientUUID]
+			delete(settings, "oauth2_client_uuid")
+		}
+	}
+	for _, key := range runtimeSettings {
+		delete(settings, key)
+	}
+	if len(settings) == 0 {
+		item.Settings = nil
+	}
+	return item, nil
+}
+
+func (st *state) pipelineItem(p query.Pipeline) (Pipeline, error) {
+	flow, err := decodeObject(p.Flow)
+	return Pipeline{
+		Name:       p.Name,
+		Datasource: st.name(p.DatasourceUUID),
+		Storage:    st.name(p.StorageUuid),
+		Enabled:    &p.IsEnabled,
+		Flow:       flow,
+	}, err
+}
+
+func (st *state) syncPolicyItem(p query.SyncPolicy) (SyncPolicy, error) {
+	settings, err := decodeObject(p.Settings)
+	return SyncPolicy{
+		Name:        p.Name,
+		Pipeline:    st.name(p.PipelineUuid),
+		Type:        p.Type,
+		Blocklist:   p.Blocklist,
+		ExcludeList: p.ExcludeList,
+		SyncAll:     p.SyncAll,
+		Settings:    settings,
+	}, err
+}
+
+func (st *state) schedulerItem(s query.Scheduler) Scheduler {
+	item := Scheduler{
+		Pipeline:      st.name(s.PipelineUuid),
+		Type:          s.ScheduleType,
+		Cron:          s.CronExpression.String,
+		Timezone:      s.Timezone,
+		Jitter:        time.Duration(s.JitterSeconds) * time.Second,
+		OverlapPolicy: s.OverlapPolicy,
+		Enabled:       &s.IsEnabled,
+		Paused:        s.IsPaused,
+	}
+	if s.IntervalSeconds.Valid {
+		item.Interval = time.Duration(s.IntervalSeconds.Int32) * time.Second
+	}
+	if s.RunAt.Valid {
+		runAt := s.RunAt.Time.UTC()
+		item.RunAt = &runAt
+	}
+	return item
+}
+
+// decodeObject decodes a JSON object column, nil when empty.
+func decodeObject(data []byte) (map[string]any, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var out map[string]any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	if len(out) == 0 {
+		return nil, nil
+	}
+	return out, nil
+}
+
+func decryptObject(data []byte, fields []string) (map[string]any, error) {
+	plain, err := secrets.DecryptFields(data, fields...)
+	if err != nil {
+		return nil, err
+	}
+	return decodeObject(plain)
+}
+
+// encodeObject encodes a manifest object for a JSON column and encrypts its
+// secret fields.
+func encodeObject(obj map[string]any, fields []string) ([]byte, error) {
+	if obj == nil {
+		obj = map[string]any{}
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	return secrets.EncryptFields(data, fields...)
+}
+
+// keepRedacted replaces the secret fields of want set to the redacted
+// placeholder of an export by their stored values.
+func keepRedacted(want, have map[string]any, fields []string) {
+	for _, f := range fields {
+		if v, ok := want[f].(string); !ok || v != secrets.Redacted {
+			continue
+		}
+		if old, ok := have[f]; ok {
+			want[f] = old
+		} else {
+			delete(want, f)
+		}
+	}
+}
+
+// redact hides the secret fields of an exported object.
+func redact(obj map[string]any, fields []string) {
+	for _, f := range fields {
+		if v, ok := obj[f].(string); ok {
+			obj[f] = secrets.Redact(v)
+		}
+	}
+}
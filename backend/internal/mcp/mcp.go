@@ -0,0 +1,304 @@
+// Package mcp serves the messages, threads, contacts and datasources to LLM
+// agents over the Model Context Protocol.
+//
+// The server speaks JSON-RPC 2.0, over stdio for the shadowapi mcp command and
+// over HTTP at /mcp. Every tool call and resource read runs as the API
+// operation it stands for, through the same audit and session middlewares:
+// the caller authenticates with the bearer token, an API key or the session
+// cookie, and the API key scopes, the access policies and the workspace apply
+// as they do to the REST API.
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"runtime/debug"
+	"slices"
+
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/samber/do/v2"
+
+	"github.com/shadowapi/shadowapi/backend/internal/audit"
+	"github.com/shadowapi/shadowapi/backend/internal/handler"
+	"github.com/shadowapi/shadowapi/backend/internal/session"
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+)
+
+// ProtocolVersion is the latest protocol revision the server implements.
+const ProtocolVersion = "2025-06-18"
+
+// protocolVersions lists the revisions the server accepts, newest first.
+var protocolVersions = []string{ProtocolVersion, "2025-03-26", "2024-11-05"}
+
+// maxMessageSize bounds a JSON-RPC message
+const maxMessageSize = 4 << 20
+
+// JSON-RPC error codes
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeInternalError  = -32603
+	// codeResourceNotFound is the MCP code of an unknown resource
+	codeResourceNotFound = -32002
+)
+
+// Server is an MCP server answering with the API handlers.
+type Server struct {
+	log         *slog.Logger
+	api         api.Handler
+	middlewares []middleware.Middleware
+}
+
+// Provide MCP server instance for the dependency injector
+func Provide(i do.Injector) (*Server, error) {
+	return New(
+		do.MustInvoke[*slog.Logger](i),
+		do.MustInvoke[*handler.Handler](i),
+		do.MustInvoke[*audit.Recorder](i).OgenMiddleware,
+		do.MustInvoke[*session.Middleware](i).OgenMiddleware,
+	), nil
+}
+
+// New returns a server running the operations on h through the middlewares,
+// in the order given.
+func New(log *slog.Logger, h api.Handler, middlewares ...middleware.Middleware) *Server {
+	return &Server{log: log, api: h, middlewares: middlewares}
+}
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string { return e.Message }
+
+func errorf(code int, format string, args ...any) *rpcError {
+	return &rpcError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// Handle answers a JSON-RPC message. raw is the request the caller
+// authenticates with, its headers are checked on every operation. The reply
+// is nil for notifications.
+func (s *Server) Handle(ctx context.Context, raw *http.Request, msg []byte) []byte {
+	var req request
+	if err := json.Unmarshal(msg, &req); err != nil {
+		// batches were dropped from the protocol, they land here as well
+		return s.reply(nil, nil, errorf(codeParseError, "invalid JSON-RPC message: %v", err))
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return s.reply(req.ID, nil, errorf(codeInvalidRequest, "not a JSON-RPC 2.0 request"))
+	}
+	notification := len(req.ID) == 0
+
+	result, err := s.dispatch(ctx, raw, req.Method, req.Params)
+	if notification {
+		if err != nil {
+			s.log.Debug("mcp notification failed", "method", req.Method, "error", err)
+		}
+		return nil
+	}
+	return s.reply(req.ID, result, err)
+}
+
+func (s *Server) reply(id json.RawMessage, result any, err error) []byte {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	resp := response{JSONRPC: "2.0", ID: id, Result: result}
+	if err != nil {
+		var rerr *rpcError
+		if !errors.As(err, &rerr) {
+			rerr = errorf(codeInternalError, "%v", err)
+		}
+		resp.Error, resp.Result = rerr, nil
+	} else if result == nil {
+		resp.Result = struct{}{}
+	}
+	out, merr := json.Marshal(resp)
+	if merr != nil {
+		s.log.Error("failed to encode mcp response", "error", merr)
+		out, _ = json.Marshal(response{JSONRPC: "2.0", ID: id, Error: errorf(codeInternalError, "failed to encode the response")})
+	}
+	return out
+}
+
+func (s *Server) dispatch(ctx context.Context, raw *http.Request, method string, params json.RawMessage) (any, error) {
+	switch method {
+	case "initialize":
+		return s.initialize(params)
+	case "notifications/initialized", "notifications/cancelled", "ping":
+		return nil, nil
+	case "tools/list":
+		return map[string]any{"tools": tools}, nil
+	case "tools/call":
+		return s.callTool(ctx, raw, params)
+	case "resources/list":
+		return map[string]any{"resources": []any{}}, nil
+	case "resources/templates/list":
+		return map[string]any{"resourceTemplates": resourceTemplates}, nil
+	case "resources/read":
+		return s.readResource(ctx, raw, params)
+	}
+	return nil, errorf(codeMethodNotFound, "unknown method %q", method)
+}
+
+func (s *Server) initialize(params json.RawMessage) (any, error) {
+	var p struct {
+		ProtocolVersion string `json:"protocolVersion"`
+	}
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+	// answer with the requested revision when supported, the latest otherwise
+	version := ProtocolVersion
+	if slices.Contains(protocolVersions, p.ProtocolVersion) {
+		version = p.ProtocolVersion
+	}
+	return map[string]any{
+		"protocolVersion": version,
+		"capabilities": map[string]any{
+			"tools":     map[string]any{"listChanged": false},
+			"resources": map[string]any{"subscribe": false, "listChanged": false},
+		},
+		"serverInfo": map[string]any{"name": "shadowapi", "version": buildVersion()},
+		"instructions": "Search and read the messages synchronized by ShadowAPI, " +
+			"look up contacts and datasources, and send email through Microsoft Graph datasources.",
+	}, nil
+}
+
+// run runs the API operation through the middlewares, fn gets the context
+// they prepared.
+func (s *Server) run(ctx context.Context, raw *http.Request, op api.OperationName, id string, params middleware.Parameters, body any, fn func(ctx context.Context) (any, error)) (any, error) {
+	next := func(req middleware.Request) (middleware.Response, error) {
+		v, err := fn(req.Context)
+		return middleware.Response{Type: v}, err
+	}
+	for _, mw := range slices.Backward(s.middlewares) {
+		inner := next
+		next = func(req middleware.Request) (middleware.Response, error) {
+			return mw(req, inner)
+		}
+	}
+	resp, err := next(middleware.Request{
+		Context:       ctx,
+		OperationName: op,
+		OperationID:   id,
+		Body:          body,
+		Params:        params,
+		Raw:           raw,
+	})
+	return resp.Type, err
+}
+
+// ServeHTTP serves the streamable HTTP transport without sessions: every
+// POST carries one message and gets its reply as JSON.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		// there is no server to client stream to open
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	// a JSON content type can't be sent cross-origin without a preflight,
+	// which keeps pages from riding on the session cookie
+	if !isJSON(r.Header.Get("Content-Type")) {
+		http.Error(w, "content type must be application/json", http.StatusUnsupportedMediaType)
+		return
+	}
+	msg, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxMessageSize))
+	if err != nil {
+		http.Error(w, "message too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	out := s.Handle(r.Context(), r, msg)
+	if out == nil {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if v := r.Header.Get("Mcp-Protocol-Version"); v != "" {
+		w.Header().Set("Mcp-Protocol-Version", v)
+	}
+	_, _ = w.Write(out)
+}
+
+// ServeStdio serves the stdio transport, one message per line, until in is
+// closed or ctx is done. raw carries the credentials of the caller.
+func (s *Server) ServeStdio(ctx context.Context, in io.Reader, out io.Writer, raw *http.Request) error {
+	lines := bufio.NewScanner(in)
+	lines.Buffer(make([]byte, 64<<10), maxMessageSize)
+	for lines.Scan() {
+		if ctx.Err() != nil {
+			return nil
+		}
+		msg := bytes.TrimSpace(lines.Bytes())
+		if len(msg) == 0 {
+			continue
+		}
+		reply := s.Handle(ctx, raw.WithContext(ctx), msg)
+		if reply == nil {
+			continue
+		}
+		if _, err := out.Write(append(reply, '\n')); err != nil {
+			return err
+		}
+	}
+	return lines.Err()
+}
+
+func decodeParams(params json.RawMessage, v any) error {
+	if len(params) == 0 || string(params) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(params, v); err != nil {
+		return errorf(codeInvalidParams, "invalid params: %v", err)
+	}
+	return nil
+}
+
+func isJSON(contentType string) bool {
+	mt, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mt == "application/json"
+}
+
+// buildVersion returns the module version of the binary.
+func buildVersion() string {
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "" {
+		return info.Main.Version
+	}
+	return "(devel)"
+}
+
+// statusCode returns the HTTP status of an operation error, 500 when it has
+// none.
+func statusCode(err error) int {
+	var coded interface{ StatusCode() int }
+	if errors.As(err, &coded) {
+		return coded.StatusCode()
+	}
+	return http.StatusInternalServerError
+}
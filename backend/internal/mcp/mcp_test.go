@@ -0,0 +1,239 @@
+package mcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ogen-go/ogen/middleware"
+
+	"github.com/shadowapi/shadowapi/backend/internal/session"
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+)
+
+const threadID = "0198c1b0-0000-7000-8000-000000000001"
+
+type fakeHandler struct {
+	api.UnimplementedHandler
+	sent []*api.EmailSend
+}
+
+func (h *fakeHandler) MessageQuery(ctx context.Context, req *api.MessageQuery) (*api.MessageQueryOK, error) {
+	if req.ThreadID.Or("") != threadID {
+		return &api.MessageQueryOK{}, nil
+	}
+	return &api.MessageQueryOK{Messages: []api.Message{
+		{Type: "email", Sender: "a@example.com", Body: strings.Repeat("x", 2*searchBodyLength), ThreadUUID: api.NewOptString(threadID)},
+	}}, nil
+}
+
+func (h *fakeHandler) DatasourceEmailGraphSend(ctx context.Context, req *api.EmailSend, params api.DatasourceEmailGraphSendParams) error {
+	h.sent = append(h.sent, req)
+	return nil
+}
+
+// authorize lets read-only keys read and nothing else, like a key with only
+// read scopes
+func authorize(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+	switch req.Raw.Header.Get("Authorization") {
+	case "Bearer write":
+		return next(req)
+	case "Bearer read":
+		if req.OperationID != "datasource-email-graph-send" {
+			return next(req)
+		}
+		return middleware.Response{}, session.ErrWithCode(http.StatusForbidden, errors.New("api key lacks the datasource:write scope"))
+	}
+	return middleware.Response{}, session.ErrWithCode(http.StatusUnauthorized, errors.New("unauthorized"))
+}
+
+type client struct {
+	t     *testing.T
+	in    io.Writer
+	out   *bufio.Scanner
+	calls int
+}
+
+// newClient serves the stdio transport with the token and returns a client
+// talking to it.
+func newClient(t *testing.T, h api.Handler, token string) *client {
+	t.Helper()
+	srv := New(slog.New(slog.DiscardHandler), h, authorize)
+	raw := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+	raw.Header.Set("Authorization", "Bearer "+token)
+
+	inR, inW := io.Pipe()
+	outR, outW := io.Pipe()
+	go func() {
+		_ = srv.ServeStdio(context.Background(), inR, outW, raw)
+		outW.Close()
+	}()
+	t.Cleanup(func() { inW.Close() })
+	return &client{t: t, in: inW, out: bufio.NewScanner(outR)}
+}
+
+func (c *client) notify(method string) {
+	c.t.Helper()
+	if _, err := io.WriteString(c.in, `{"jsonrpc":"2.0","method":"`+method+`"}`+"\n"); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+func (c *client) call(method string, params any) (json.RawMessage, *rpcError) {
+	c.t.Helper()
+	c.calls++
+	msg, _ := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": c.calls, "method": method, "params": params})
+	if _, err := c.in.Write(append(msg, '\n')); err != nil {
+		c.t.Fatal(err)
+	}
+	if !c.out.Scan() {
+		c.t.Fatalf("%s: no reply", method)
+	}
+	var resp struct {
+		ID     int             `json:"id"`
+		Result json.RawMessage `json:"result"`
+		Error  *rpcError       `json:"error"`
+	}
+	if err := json.Unmarshal(c.out.Bytes(), &resp); err != nil {
+		c.t.Fatalf("%s: %v", method, err)
+	}
+	if resp.ID != c.calls {
+		c.t.Fatalf("%s: reply to %d, want %d", method, resp.ID, c.calls)
+	}
+	return resp.Result, resp.Error
+}
+
+type toolResponse struct {
+	Content []struct {
+		Text string `json:"text"`
+	} `json:"content"`
+	IsError bool `json:"isError"`
+}
+
+func (c *client) tool(name string, args map[string]any) toolResponse {
+	c.t.Helper()
+	res, rerr := c.call("tools/call", map[string]any{"name": name, "arguments": args})
+	if rerr != nil {
+		c.t.Fatalf("%s: %s", name, rerr.Message)
+	}
+	var out toolResponse
+	if err := json.Unmarshal(res, &out); err != nil || len(out.Content) != 1 {
+		c.t.Fatalf("%s: unexpected result %s", name, res)
+	}
+	return out
+}
+
+func TestSession(t *testing.T) {
+	h := &fakeHandler{}
+	c := newClient(t, h, "read")
+
+	res, rerr := c.call("initialize", map[string]any{"protocolVersion": "2025-03-26", "capabilities": map[string]any{}})
+	if rerr != nil {
+		t.Fatal(rerr.Message)
+	}
+	var init struct {
+		ProtocolVersion string `json:"protocolVersion"`
+	}
+	json.Unmarshal(res, &init)
+	if init.ProtocolVersion != "2025-03-26" {
+		t.Errorf("protocol version = %q", init.ProtocolVersion)
+	}
+	// notifications get no reply, the next reply answers the next call
+	c.notify("notifications/initialized")
+
+	res, _ = c.call("tools/list", nil)
+	for _, name := range []string{"search_messages", "get_thread", "get_contact", "list_datasources", "send_email"} {
+		if !strings.Contains(string(res), `"name":"`+name+`"`) {
+			t.Errorf("tools/list misses %s", name)
+		}
+	}
+
+	thread := c.tool("get_thread", map[string]any{"thread_id": threadID})
+	var messages []api.Message
+	if err := json.Unmarshal([]byte(thread.Content[0].Text), &messages); err != nil || len(messages) != 1 {
+		t.Fatalf("get_thread = %s", thread.Content[0].Text)
+	}
+	if len(messages[0].Body) != 2*searchBodyLength {
+		t.Errorf("thread body cut to %d", len(messages[0].Body))
+	}
+
+	res, rerr = c.call("resources/read", map[string]any{"uri": "shadowapi://thread/" + threadID})
+	if rerr != nil || !strings.Contains(string(res), `"mimeType":"application/json"`) {
+		t.Errorf("resources/read = %s, %v", res, rerr)
+	}
+	if _, rerr = c.call("resources/read", map[string]any{"uri": "shadowapi://thread/0198c1b0-0000-7000-8000-000000000002"}); rerr == nil || rerr.Code != codeResourceNotFound {
+		t.Errorf("empty thread error = %v", rerr)
+	}
+
+	if _, rerr = c.call("get_thread", nil); rerr == nil || rerr.Code != codeMethodNotFound {
+		t.Errorf("unknown method error = %v", rerr)
+	}
+	if _, rerr = c.call("tools/call", map[string]any{"name": "get_thread", "arguments": map[string]any{"thread_id": "x"}}); rerr == nil || rerr.Code != codeInvalidParams {
+		t.Errorf("invalid argument error = %v", rerr)
+	}
+
+	// the read key can't send
+	sent := c.tool("send_email", map[string]any{
+		"datasource_uuid": threadID, "to": []string{"b@example.com"}, "subject": "hi", "body": "hello",
+	})
+	if !sent.IsError || !strings.Contains(sent.Content[0].Text, "datasource:write") || len(h.sent) != 0 {
+		t.Errorf("send_email with a read key = %+v, sent %d", sent, len(h.sent))
+	}
+}
+
+func TestSend(t *testing.T) {
+	h := &fakeHandler{}
+	c := newClient(t, h, "write")
+	sent := c.tool("send_email", map[string]any{
+		"datasource_uuid": threadID, "to": []string{"b@example.com"}, "subject": "hi", "body": "<p>hello</p>", "html": true,
+	})
+	if sent.IsError || len(h.sent) != 1 {
+		t.Fatalf("send_email = %+v, sent %d", sent, len(h.sent))
+	}
+	if h.sent[0].ContentType.Or("") != api.EmailSendContentTypeHTML {
+		t.Errorf("content type = %v", h.sent[0].ContentType)
+	}
+}
+
+func TestHTTP(t *testing.T) {
+	srv := httptest.NewServer(New(slog.New(slog.DiscardHandler), &fakeHandler{}, authorize))
+	t.Cleanup(srv.Close)
+
+	post := func(body, token string) *http.Response {
+		req, _ := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	if resp := post(`{"jsonrpc":"2.0","method":"notifications/initialized"}`, "read"); resp.StatusCode != http.StatusAccepted {
+		t.Errorf("notification status = %d", resp.StatusCode)
+	}
+
+	resp := post(`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"search_messages","arguments":{}}}`, "nope")
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || !strings.Contains(string(body), `"isError":true`) || !strings.Contains(string(body), "unauthorized") {
+		t.Errorf("unauthorized call = %d %s", resp.StatusCode, body)
+	}
+
+	get, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	get.Body.Close()
+	if get.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("GET status = %d", get.StatusCode)
+	}
+}
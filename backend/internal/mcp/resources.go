@@ -0,0 +1,80 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+)
+
+// resourceScheme prefixes the resource URIs
+const resourceScheme = "shadowapi"
+
+var resourceTemplates = []map[string]any{
+	{
+		"uriTemplate": resourceScheme + "://thread/{thread_id}",
+		"name":        "thread",
+		"title":       "Thread",
+		"description": "The messages of a thread or a chat, oldest first.",
+		"mimeType":    "application/json",
+	},
+	{
+		"uriTemplate": resourceScheme + "://attachment/{uuid}",
+		"name":        "attachment",
+		"title":       "Attachment",
+		"description": "The name, type, size and message of an attached file.",
+		"mimeType":    "application/json",
+	},
+}
+
+func (s *Server) readResource(ctx context.Context, raw *http.Request, params json.RawMessage) (any, error) {
+	var p struct {
+		URI string `json:"uri"`
+	}
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(p.URI)
+	if err != nil || u.Scheme != resourceScheme {
+		return nil, errorf(codeResourceNotFound, "unknown resource %q", p.URI)
+	}
+	id := strings.TrimPrefix(u.Path, "/")
+	if checkUUID("id", id) != nil {
+		return nil, errorf(codeResourceNotFound, "unknown resource %q", p.URI)
+	}
+
+	var out any
+	switch u.Host {
+	case "thread":
+		var messages []api.Message
+		messages, err = s.thread(ctx, raw, id, maxThreadLimit)
+		if err == nil && len(messages) == 0 {
+			return nil, errorf(codeResourceNotFound, "no messages in thread %s", id)
+		}
+		out = messages
+	case "attachment":
+		params := api.FileGetParams{UUID: id}
+		out, err = s.run(ctx, raw, api.FileGetOperation, "file-get", pathUUID(id), nil, func(ctx context.Context) (any, error) {
+			return s.api.FileGet(ctx, params)
+		})
+	default:
+		return nil, errorf(codeResourceNotFound, "unknown resource %q", p.URI)
+	}
+	if err != nil {
+		if statusCode(err) == http.StatusNotFound {
+			return nil, errorf(codeResourceNotFound, "%v", err)
+		}
+		return nil, errorf(codeInternalError, "%v", err)
+	}
+
+	text, err := json.Marshal(out)
+	if err != nil {
+		return nil, errorf(codeInternalError, "failed to encode the resource: %v", err)
+	}
+	return map[string]any{
+		"contents": []map[string]any{{"uri": p.URI, "mimeType": "application/json", "text": string(text)}},
+	}, nil
+}
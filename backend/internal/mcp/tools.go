@@ -0,0 +1,399 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofrs/uuid"
+	gouuid "github.com/google/uuid"
+	"github.com/ogen-go/ogen/middleware"
+
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+)
+
+// limits of the listing tools
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+	defaultThreadLimit = 100
+	maxThreadLimit     = 500
+	// searchBodyLength is how much of a body search results carry, the
+	// whole message comes with its thread
+	searchBodyLength = 500
+)
+
+type tool struct {
+	Name        string         `json:"name"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	InputSchema map[string]any `json:"inputSchema"`
+	Annotations map[string]any `json:"annotations"`
+
+	call func(s *Server, ctx context.Context, raw *http.Request, args json.RawMessage) (any, error)
+}
+
+// modeDescription describes the search modes of search_messages
+const modeDescription = "keyword matches the text as is, semantic finds the messages closest in meaning and needs a query"
+
+// readOnly annotates the tools that change nothing
+var readOnly = map[string]any{"readOnlyHint": true, "openWorldHint": false}
+
+var tools = []tool{
+	{
+		Name:  "search_messages",
+		Title: "Search messages",
+		Description: "Search the synchronized emails and chat messages of the workspace, newest first. " +
+			"The text is matched against the subject, the body and the sender, or by meaning with the " +
+			"semantic mode, best first, when the server indexes messages. Bodies are cut to " +
+			fmt.Sprint(searchBodyLength) + " characters, read the whole thread with get_thread.",
+		InputSchema: object(map[string]any{
+			"query":         prop("string", "text to look for, every message matches when empty"),
+			"mode":          enumProp(modeDescription, string(api.MessageQueryModeKeyword), string(api.MessageQueryModeSemantic)),
+			"chat_id":       prop("string", "UUID of a chat to search in"),
+			"pipeline_uuid": prop("string", "UUID of the pipeline the messages came through"),
+			"after":         prop("string", "RFC 3339 time, only messages created at or after it"),
+			"before":        prop("string", "RFC 3339 time, only messages created before it"),
+			"limit":         limitProp(defaultSearchLimit, maxSearchLimit),
+			"offset":        prop("integer", "number of messages to skip"),
+		}),
+		Annotations: readOnly,
+		call:        (*Server).searchMessages,
+	},
+	{
+		Name:        "get_thread",
+		Title:       "Get thread",
+		Description: "Read the messages of a thread or a chat, oldest first, with their whole bodies.",
+		InputSchema: object(map[string]any{
+			"thread_id": prop("string", "UUID of the thread, the thread_uuid of a message"),
+			"limit":     limitProp(defaultThreadLimit, maxThreadLimit),
+		}, "thread_id"),
+		Annotations: readOnly,
+		call:        (*Server).getThread,
+	},
+	{
+		Name:        "get_contact",
+		Title:       "Get contact",
+		Description: "Get a contact with its names, emails, phones and positions.",
+		InputSchema: object(map[string]any{
+			"uuid": prop("string", "UUID of the contact"),
+		}, "uuid"),
+		Annotations: readOnly,
+		call:        (*Server).getContact,
+	},
+	{
+		Name:        "list_datasources",
+		Title:       "List datasources",
+		Description: "List the mailboxes and messaging accounts messages are synchronized from.",
+		InputSchema: object(map[string]any{
+			"limit":  limitProp(50, 500),
+			"offset": prop("integer", "number of datasources to skip"),
+		}),
+		Annotations: readOnly,
+		call:        (*Server).listDatasources,
+	},
+	{
+		Name:  "send_email",
+		Title: "Send email",
+		Description: "Send an email from a Microsoft Graph datasource. API keys need the datasource:write " +
+			"scope and the role of the caller must be allowed to send.",
+		InputSchema: object(map[string]any{
+			"datasource_uuid":    prop("string", "UUID of the Microsoft Graph datasource to send from"),
+			"to":                 addresses("recipients"),
+			"cc":                 addresses("carbon copy recipients"),
+			"bcc":                addresses("blind carbon copy recipients"),
+			"subject":            prop("string", "subject of the email"),
+			"body":               prop("string", "body of the email"),
+			"html":               prop("boolean", "whether the body is HTML, plain text by default"),
+			"save_to_sent_items": prop("boolean", "whether to keep a copy in the sent items, true by default"),
+		}, "datasource_uuid", "to", "subject", "body"),
+		Annotations: map[string]any{"readOnlyHint": false, "destructiveHint": false, "idempotentHint": false, "openWorldHint": true},
+		call:        (*Server).sendEmail,
+	},
+}
+
+func (s *Server) callTool(ctx context.Context, raw *http.Request, params json.RawMessage) (any, error) {
+	var p struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	}
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+	for _, t := range tools {
+		if t.Name != p.Name {
+			continue
+		}
+		out, err := t.call(s, ctx, raw, p.Arguments)
+		var rerr *rpcError
+		if errors.As(err, &rerr) {
+			return nil, err
+		}
+		if err != nil {
+			// failed operations are results, the agent gets to read why
+			s.log.Debug("mcp tool failed", "tool", t.Name, "status", statusCode(err), "error", err)
+			return toolResult(err.Error(), true), nil
+		}
+		if text, ok := out.(string); ok {
+			return toolResult(text, false), nil
+		}
+		b, err := json.Marshal(out)
+		if err != nil {
+			return nil, errorf(codeInternalError, "failed to encode the result: %v", err)
+		}
+		return toolResult(string(b), false), nil
+	}
+	return nil, errorf(codeInvalidParams, "unknown tool %q", p.Name)
+}
+
+func toolResult(text string, isError bool) map[string]any {
+	return map[string]any{
+		"content": []map[string]any{{"type": "text", "text": text}},
+		"isError": isError,
+	}
+}
+
+func (s *Server) searchMessages(ctx context.Context, raw *http.Request, args json.RawMessage) (any, error) {
+	var a struct {
+		Query        string `json:"query"`
+		Mode         string `json:"mode"`
+		ChatID       string `json:"chat_id"`
+		PipelineUUID string `json:"pipeline_uuid"`
+		After        string `json:"after"`
+		Before       string `json:"before"`
+		Limit        int    `json:"limit"`
+		Offset       int    `json:"offset"`
+	}
+	if err := decodeParams(args, &a); err != nil {
+		return nil, err
+	}
+	q := &api.MessageQuery{
+		Source: api.MessageQuerySourceUnified,
+		Limit:  api.NewOptInt(clamp(a.Limit, defaultSearchLimit, maxSearchLimit)),
+		Offset: api.NewOptInt(max(a.Offset, 0)),
+	}
+	if a.Query != "" {
+		q.Query = api.NewOptString(a.Query)
+	}
+	if a.Mode != "" {
+		mode := api.MessageQueryMode(a.Mode)
+		if err := mode.Validate(); err != nil {
+			return nil, errorf(codeInvalidParams, "mode must be keyword or semantic")
+		}
+		q.Mode = api.NewOptMessageQueryMode(mode)
+	}
+	if a.ChatID != "" {
+		if err := checkUUID("chat_id", a.ChatID); err != nil {
+			return nil, err
+		}
+		q.ChatID = api.NewOptString(a.ChatID)
+	}
+	if a.PipelineUUID != "" {
+		id, err := uuid.FromString(a.PipelineUUID)
+		if err != nil {
+			return nil, errorf(codeInvalidParams, "pipeline_uuid is not a UUID")
+		}
+		q.PipelineUUID = api.NewOptUUID(gouuid.UUID(id))
+	}
+	if a.After != "" {
+		t, err := parseTime("after", a.After)
+		if err != nil {
+			return nil, err
+		}
+		q.StartDate = api.NewOptDateTime(t)
+	}
+	if a.Before != "" {
+		t, err := parseTime("before", a.Before)
+		if err != nil {
+			return nil, err
+		}
+		q.EndDate = api.NewOptDateTime(t)
+	}
+
+	messages, err := s.queryMessages(ctx, raw, q)
+	if err != nil {
+		return nil, err
+	}
+	for i := range messages {
+		if body := []rune(messages[i].Body); len(body) > searchBodyLength {
+			messages[i].Body = string(body[:searchBodyLength]) + "…"
+		}
+	}
+	return messages, nil
+}
+
+func (s *Server) getThread(ctx context.Context, raw *http.Request, args json.RawMessage) (any, error) {
+	var a struct {
+		ThreadID string `json:"thread_id"`
+		Limit    int    `json:"limit"`
+	}
+	if err := decodeParams(args, &a); err != nil {
+		return nil, err
+	}
+	if err := checkUUID("thread_id", a.ThreadID); err != nil {
+		return nil, err
+	}
+	return s.thread(ctx, raw, a.ThreadID, clamp(a.Limit, defaultThreadLimit, maxThreadLimit))
+}
+
+// thread returns the messages of the thread, oldest first.
+func (s *Server) thread(ctx context.Context, raw *http.Request, threadID string, limit int) ([]api.Message, error) {
+	return s.queryMessages(ctx, raw, &api.MessageQuery{
+		Source:   api.MessageQuerySourceUnified,
+		ThreadID: api.NewOptString(threadID),
+		Order:    api.NewOptMessageQueryOrder(api.MessageQueryOrderAsc),
+		Limit:    api.NewOptInt(limit),
+	})
+}
+
+func (s *Server) queryMessages(ctx context.Context, raw *http.Request, q *api.MessageQuery) ([]api.Message, error) {
+	out, err := s.run(ctx, raw, api.MessageQueryOperation, "messageQuery", nil, q, func(ctx context.Context) (any, error) {
+		return s.api.MessageQuery(ctx, q)
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := out.(*api.MessageQueryOK)
+	if res.Messages == nil {
+		return []api.Message{}, nil
+	}
+	return res.Messages, nil
+}
+
+func (s *Server) getContact(ctx context.Context, raw *http.Request, args json.RawMessage) (any, error) {
+	var a struct {
+		UUID string `json:"uuid"`
+	}
+	if err := decodeParams(args, &a); err != nil {
+		return nil, err
+	}
+	if err := checkUUID("uuid", a.UUID); err != nil {
+		return nil, err
+	}
+	params := api.GetContactParams{UUID: a.UUID}
+	return s.run(ctx, raw, api.GetContactOperation, "getContact", pathUUID(a.UUID), nil, func(ctx context.Context) (any, error) {
+		return s.api.GetContact(ctx, params)
+	})
+}
+
+func (s *Server) listDatasources(ctx context.Context, raw *http.Request, args json.RawMessage) (any, error) {
+	var a struct {
+		Limit  int `json:"limit"`
+		Offset int `json:"offset"`
+	}
+	if err := decodeParams(args, &a); err != nil {
+		return nil, err
+	}
+	params := api.DatasourceListParams{
+		Limit:  api.NewOptInt32(int32(clamp(a.Limit, 50, 500))),
+		Offset: api.NewOptInt32(int32(max(a.Offset, 0))),
+	}
+	return s.run(ctx, raw, api.DatasourceListOperation, "datasource-list", nil, nil, func(ctx context.Context) (any, error) {
+		return s.api.DatasourceList(ctx, params)
+	})
+}
+
+func (s *Server) sendEmail(ctx context.Context, raw *http.Request, args json.RawMessage) (any, error) {
+	var a struct {
+		DatasourceUUID  string   `json:"datasource_uuid"`
+		To              []string `json:"to"`
+		Cc              []string `json:"cc"`
+		Bcc             []string `json:"bcc"`
+		Subject         string   `json:"subject"`
+		Body            string   `json:"body"`
+		HTML            bool     `json:"html"`
+		SaveToSentItems *bool    `json:"save_to_sent_items"`
+	}
+	if err := decodeParams(args, &a); err != nil {
+		return nil, err
+	}
+	if err := checkUUID("datasource_uuid", a.DatasourceUUID); err != nil {
+		return nil, err
+	}
+	if len(a.To) == 0 {
+		return nil, errorf(codeInvalidParams, "to needs at least one recipient")
+	}
+	req := &api.EmailSend{
+		To:          a.To,
+		Cc:          a.Cc,
+		Bcc:         a.Bcc,
+		Subject:     a.Subject,
+		Body:        a.Body,
+		ContentType: api.NewOptEmailSendContentType(api.EmailSendContentTypeText),
+	}
+	if a.HTML {
+		req.ContentType = api.NewOptEmailSendContentType(api.EmailSendContentTypeHTML)
+	}
+	if a.SaveToSentItems != nil {
+		req.SaveToSentItems = api.NewOptBool(*a.SaveToSentItems)
+	}
+	params := api.DatasourceEmailGraphSendParams{UUID: a.DatasourceUUID}
+	_, err := s.run(ctx, raw, api.DatasourceEmailGraphSendOperation, "datasource-email-graph-send", pathUUID(a.DatasourceUUID), req, func(ctx context.Context) (any, error) {
+		return nil, s.api.DatasourceEmailGraphSend(ctx, req, params)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fmt.Sprintf("sent %q to %d recipients", a.Subject, len(a.To)+len(a.Cc)+len(a.Bcc)), nil
+}
+
+// pathUUID returns the parameters of an operation on one object, the
+// policies narrow the resource to it.
+func pathUUID(id string) middleware.Parameters {
+	return middleware.Parameters{{Name: "uuid", In: "path"}: id}
+}
+
+func checkUUID(name, v string) error {
+	if _, err := uuid.FromString(v); err != nil {
+		return errorf(codeInvalidParams, "%s must be a UUID", name)
+	}
+	return nil
+}
+
+func parseTime(name, v string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, errorf(codeInvalidParams, "%s is not an RFC 3339 time", name)
+	}
+	return t, nil
+}
+
+// clamp returns n within (0, most], def when unset.
+func clamp(n, def, most int) int {
+	if n <= 0 {
+		return def
+	}
+	return min(n, most)
+}
+
+func object(props map[string]any, required ...string) map[string]any {
+	schema := map[string]any{"type": "object", "properties": props}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+func prop(typ, description string) map[string]any {
+	return map[string]any{"type": typ, "description": description}
+}
+
+func enumProp(description string, values ...string) map[string]any {
+	return map[string]any{"type": "string", "enum": values, "description": description}
+}
+
+func limitProp(def, most int) map[string]any {
+	return map[string]any{
+		"type":        "integer",
+		"description": fmt.Sprintf("how many to return, %d by default and at most %d", def, most),
+		"minimum":     1,
+		"maximum":     most,
+	}
+}
+
+func addresses(description string) map[string]any {
+	return map[string]any{"type": "array", "items": map[string]any{"type": "string"}, "description": "email addresses of the " + description}
+}
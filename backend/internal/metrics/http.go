@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+)
+
+// APIHandler records the requests served by the API server, labelled by the
+// operation they are routed to so that the path parameters do not blow up the
+// label values.
+func APIHandler(srv *api.Server) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		operation := "unknown"
+		if route, ok := srv.FindPath(r.Method, r.URL); ok {
+			operation = route.OperationID()
+		}
+		HTTPRequestsInFlight.Inc()
+		defer HTTPRequestsInFlight.Dec()
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+		srv.ServeHTTP(rec, r)
+		HTTPRequestDuration.WithLabelValues(operation, r.Method, strconv.Itoa(rec.status)).Observe(time.Since(start).Seconds())
+	})
+}
+
+// statusRecorder keeps the status code written to the response.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -1,3 +1,5 @@
+// Package metrics declares the Prometheus metrics of shadowapi and serves
+// them on a listener of their own.
 package metrics
 
 import "github.com/prometheus/client_golang/prometheus"
@@ -14,12 +16,125 @@ var (
 	JobExecutedDuration = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name:    "worker_job_executed_duration_seconds",
+			Help:    "Duration of the jobs executed by the worker",
 			Buckets: prometheus.DefBuckets,
 		},
-		[]string{"pipeline_uuid", "datasource_uuid"},
+		[]string{"subject", "status"},
+	)
+
+	JobStartedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "worker_job_started_total",
+			Help: "Number of jobs started by the worker",
+		},
+		[]string{"subject"},
+	)
+
+	JobFinishedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "worker_job_finished_total",
+			Help: "Number of jobs finished by the worker, by final status",
+		},
+		[]string{"subject", "status"},
+	)
+
+	JobsRunning = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "worker_jobs_running",
+			Help: "Number of jobs running in the worker",
+		},
+		[]string{"subject"},
+	)
+
+	MessagesFetchedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "pipeline_messages_fetched_total",
+			Help: "Number of messages fetched from the datasources",
+		},
+		[]string{"datasource_uuid", "pipeline_uuid"},
+	)
+
+	MessagesFilteredTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "pipeline_messages_filtered_total",
+			Help: "Number of messages dropped by the sync policies",
+		},
+		[]string{"datasource_uuid", "pipeline_uuid"},
+	)
+
+	MessagesStoredTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "pipeline_messages_stored_total",
+			Help: "Number of messages stored",
+		},
+		[]string{"datasource_uuid", "pipeline_uuid"},
+	)
+
+	AttachmentBytesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "pipeline_attachment_bytes_total",
+			Help: "Size of the attachments stored with the messages",
+		},
+		[]string{"datasource_uuid", "pipeline_uuid"},
+	)
+
+	ProviderRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "provider_request_duration_seconds",
+			Help:    "Duration of the requests to the provider APIs",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"provider", "method"},
+	)
+
+	ProviderRequestErrorsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "provider_request_errors_total",
+			Help: "Number of failed requests to the provider APIs, by status code or \"network\"",
+		},
+		[]string{"provider", "code"},
+	)
+
+	TokenRefreshTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "oauth2_token_refresh_total",
+			Help: "Number of OAuth2 token refreshes, by outcome",
+		},
+		[]string{"outcome"},
+	)
+
+	HTTPRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "Duration of the API requests",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"operation", "method", "code"},
+	)
+
+	HTTPRequestsInFlight = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "http_requests_in_flight",
+			Help: "Number of API requests being served",
+		},
 	)
 )
 
+// Outcomes of TokenRefreshTotal
+const (
+	RefreshRefreshed   = "refreshed"
+	RefreshNotDue      = "not_due"
+	RefreshFailed      = "failed"
+	RefreshNeedsReauth = "needs_reauth"
+)
+
 func init() {
-	prometheus.MustRegister(JobScheduledTotal, JobExecutedDuration)
+	prometheus.MustRegister(
+		JobScheduledTotal, JobExecutedDuration,
+		JobStartedTotal, JobFinishedTotal, JobsRunning,
+		MessagesFetchedTotal, MessagesFilteredTotal, MessagesStoredTotal, AttachmentBytesTotal,
+		ProviderRequestDuration, ProviderRequestErrorsTotal,
+		TokenRefreshTotal,
+		HTTPRequestDuration, HTTPRequestsInFlight,
+	)
 }
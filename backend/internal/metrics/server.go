@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/samber/do/v2"
+
+	"github.com/shadowapi/shadowapi/backend/internal/config"
+	"github.com/shadowapi/shadowapi/backend/internal/queue"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/registry"
+)
+
+// Server serves /metrics on a listener of its own, kept off the API port so
+// that it can stay private to the scraper.
+type Server struct {
+	cfg *config.Config
+	log *slog.Logger
+	srv *http.Server
+}
+
+// Provide the metrics server for the dependency injector.
+func Provide(i do.Injector) (*Server, error) {
+	cfg := do.MustInvoke[*config.Config](i)
+	log := do.MustInvoke[*slog.Logger](i).With("service", "metrics")
+
+	// the queue is connected to on the first scrape
+	lag := queue.NewLagCollector(log, func() (*queue.Queue, error) {
+		return do.Invoke[*queue.Queue](i)
+	}, registry.WorkerStream)
+	if err := prometheus.Register(lag); err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	return &Server{
+		cfg: cfg,
+		log: log,
+		srv: &http.Server{Handler: mux},
+	}, nil
+}
+
+// Run starts serving in the background, nothing is served when no port is
+// configured.
+func (s *Server) Run() error {
+	if s.cfg.Metrics.Port == 0 {
+		s.log.Info("metrics endpoint disabled")
+		return nil
+	}
+	address := fmt.Sprintf("%s:%d", s.cfg.Metrics.Host, s.cfg.Metrics.Port)
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("metrics listener: %w", err)
+	}
+	s.log.Info("serving metrics", "address", address, "path", "/metrics")
+	go func() {
+		if err := s.srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.log.Error("metrics server failed", "error", err)
+		}
+	}()
+	return nil
+}
+
+// Shutdown stops the server, the scrapes in progress get until ctx is done.
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.srv.Shutdown(ctx)
+}
@@ -0,0 +1,34 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ProviderTransport records the latency and the errors of the requests made to
+// the API of a provider.
+func ProviderTransport(provider string, next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &providerTransport{provider: provider, next: next}
+}
+
+type providerTransport struct {
+	provider string
+	next     http.RoundTripper
+}
+
+func (t *providerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	ProviderRequestDuration.WithLabelValues(t.provider, req.Method).Observe(time.Since(start).Seconds())
+	switch {
+	case err != nil:
+		ProviderRequestErrorsTotal.WithLabelValues(t.provider, "network").Inc()
+	case resp.StatusCode >= http.StatusBadRequest:
+		ProviderRequestErrorsTotal.WithLabelValues(t.provider, strconv.Itoa(resp.StatusCode)).Inc()
+	}
+	return resp, err
+}
@@ -0,0 +1,232 @@
+// Package msgraph reads and sends mail of a Microsoft 365 / Outlook mailbox
+// through the Microsoft Graph API.
+package msgraph
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL is the Graph v1.0 endpoint.
+const DefaultBaseURL = "https://graph.microsoft.com/v1.0"
+
+// pageSize is the number of messages asked for per delta page.
+const pageSize = 50
+
+// messageFields are the message properties returned by delta queries.
+var messageFields = []string{
+	"id", "internetMessageId", "conversationId", "subject", "bodyPreview", "body",
+	"from", "sender", "toRecipients", "ccRecipients", "bccRecipients", "replyTo",
+	"receivedDateTime", "sentDateTime", "hasAttachments", "isRead", "isDraft",
+	"parentFolderId", "categories", "webLink",
+}
+
+// Client calls the Graph API with an authorized HTTP client, usually the
+// OAuth2 client of the datasource.
+type Client struct {
+	http    *http.Client
+	baseURL string
+}
+
+// New returns a client of the Graph v1.0 endpoint.
+func New(httpClient *http.Client) *Client {
+	return NewWithBaseURL(httpClient, DefaultBaseURL)
+}
+
+// NewWithBaseURL returns a client of another Graph endpoint, national clouds
+// or a local stand-in.
+func NewWithBaseURL(httpClient *http.Client, baseURL string) *Client {
+	return &Client{http: httpClient, baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+// Error is an error response of the Graph API.
+type Error struct {
+	Status  int
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("graph: %d %s", e.Status, http.StatusText(e.Status))
+	}
+	return fmt.Sprintf("graph: %d %s: %s", e.Status, e.Code, e.Message)
+}
+
+// IsSyncStateInvalid reports whether a delta link expired and the folder
+// must be synced again from the start.
+func IsSyncStateInvalid(err error) bool {
+	e, ok := err.(*Error)
+	if !ok {
+		return false
+	}
+	return e.Status == http.StatusGone ||
+		e.Code == "SyncStateNotFound" || e.Code == "SyncStateInvalid" || e.Code == "ErrorInvalidSyncStateData"
+}
+
+// DeltaPage is the result of a delta query of a folder: the changed messages
+// and the ids of the removed ones. DeltaLink starts the next sync.
+type DeltaPage struct {
+	Messages  []Message
+	Removed   []string
+	DeltaLink string
+}
+
+// Delta returns the changes of a mail folder since deltaLink, or all the
+// messages of the folder when deltaLink is empty. folder is a well-known
+// name such as inbox or a folder id. Pages are followed until the service
+// returns the next delta link.
+func (c *Client) Delta(ctx context.Context, folder, deltaLink string) (*DeltaPage, error) {
+	next := deltaLink
+	if next == "" {
+		q := url.Values{}
+		q.Set("$select", strings.Join(messageFields, ","))
+		next = fmt.Sprintf("%s/me/mailFolders/%s/messages/delta?%s", c.baseURL, url.PathEscape(folder), q.Encode())
+	}
+
+	out := &DeltaPage{}
+	for next != "" {
+		var page struct {
+			Value     []Message `json:"value"`
+			NextLink  string    `json:"@odata.nextLink"`
+			DeltaLink string    `json:"@odata.deltaLink"`
+		}
+		if err := c.do(ctx, http.MethodGet, next, nil, &page); err != nil {
+			return nil, err
+		}
+		for _, m := range page.Value {
+			if m.Removed != nil {
+				out.Removed = append(out.Removed, m.ID)
+				continue
+			}
+			out.Messages = append(out.Messages, m)
+		}
+		next = page.NextLink
+		if page.DeltaLink != "" {
+			out.DeltaLink = page.DeltaLink
+		}
+	}
+	return out, nil
+}
+
+// Messages returns the messages of a mail folder received in [since, until),
+// newest first and at most limit of them. Zero times and limit leave the
+// range or count open. Unlike Delta it does not start a sync.
+func (c *Client) Messages(ctx context.Context, folder string, since, until time.Time, limit int) ([]Message, error) {
+	q := url.Values{}
+	q.Set("$select", strings.Join(messageFields, ","))
+	q.Set("$orderby", "receivedDateTime desc")
+	q.Set("$top", strconv.Itoa(pageSize))
+	var filter []string
+	if !since.IsZero() {
+		filter = append(filter, "receivedDateTime ge "+since.UTC().Format(time.RFC3339))
+	}
+	if !until.IsZero() {
+		filter = append(filter, "receivedDateTime lt "+until.UTC().Format(time.RFC3339))
+	}
+	if len(filter) > 0 {
+		q.Set("$filter", strings.Join(filter, " and "))
+	}
+	next := fmt.Sprintf("%s/me/mailFolders/%s/messages?%s", c.baseURL, url.PathEscape(folder), q.Encode())
+
+	var out []Message
+	for next != "" {
+		var page struct {
+			Value    []Message `json:"value"`
+			NextLink string    `json:"@odata.nextLink"`
+		}
+		if err := c.do(ctx, http.MethodGet, next, nil, &page); err != nil {
+			return nil, err
+		}
+		for _, m := range page.Value {
+			if limit > 0 && len(out) >= limit {
+				return out, nil
+			}
+			out = append(out, m)
+		}
+		next = page.NextLink
+	}
+	return out, nil
+}
+
+// MIME returns the message in RFC 822 format, attachments included.
+func (c *Client) MIME(ctx context.Context, messageID string) ([]byte, error) {
+	u := fmt.Sprintf("%s/me/messages/%s/$value", c.baseURL, url.PathEscape(messageID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, decodeError(resp)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// SendMail sends a mail from the mailbox.
+func (c *Client) SendMail(ctx context.Context, m *OutgoingMail) error {
+	return c.do(ctx, http.MethodPost, c.baseURL+"/me/sendMail", m.request(), nil)
+}
+
+// do sends a JSON request and decodes the JSON response into out when set.
+func (c *Client) do(ctx context.Context, method, u string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if method == http.MethodGet {
+		// text bodies are enough for the message, the MIME keeps the original
+		req.Header.Set("Prefer", fmt.Sprintf(`odata.maxpagesize=%d, outlook.body-content-type="text"`, pageSize))
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return decodeError(resp)
+	}
+	if out == nil {
+		io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("graph: decode %s: %w", req.URL.Path, err)
+	}
+	return nil
+}
+
+func decodeError(resp *http.Response) error {
+	var body struct {
+		Error Error `json:"error"`
+	}
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	_ = json.Unmarshal(raw, &body)
+	e := body.Error
+	e.Status = resp.StatusCode
+	return &e
+}
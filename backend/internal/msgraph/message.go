@@ -0,0 +1,169 @@
+package msgraph
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+)
+
+// Message is a Graph message resource, limited to messageFields.
+type Message struct {
+	ID                string       `json:"id"`
+	InternetMessageID string       `json:"internetMessageId"`
+	ConversationID    string       `json:"conversationId"`
+	Subject           string       `json:"subject"`
+	BodyPreview       string       `json:"bodyPreview"`
+	Body              ItemBody     `json:"body"`
+	From              *Recipient   `json:"from"`
+	Sender            *Recipient   `json:"sender"`
+	ToRecipients      []Recipient  `json:"toRecipients"`
+	CcRecipients      []Recipient  `json:"ccRecipients"`
+	BccRecipients     []Recipient  `json:"bccRecipients"`
+	ReplyTo           []Recipient  `json:"replyTo"`
+	ReceivedDateTime  time.Time    `json:"receivedDateTime"`
+	SentDateTime      time.Time    `json:"sentDateTime"`
+	HasAttachments    bool         `json:"hasAttachments"`
+	IsRead            bool         `json:"isRead"`
+	IsDraft           bool         `json:"isDraft"`
+	ParentFolderID    string       `json:"parentFolderId"`
+	Categories        []string     `json:"categories"`
+	WebLink           string       `json:"webLink"`
+	Removed           *RemovedInfo `json:"@removed,omitempty"`
+}
+
+// RemovedInfo marks a message deleted or moved out of the folder in a delta
+// response.
+type RemovedInfo struct {
+	Reason string `json:"reason"`
+}
+
+// ItemBody is the body of a message.
+type ItemBody struct {
+	ContentType string `json:"contentType"`
+	Content     string `json:"content"`
+}
+
+// Recipient is a mail address with its display name.
+type Recipient struct {
+	EmailAddress EmailAddress `json:"emailAddress"`
+}
+
+// EmailAddress of a recipient.
+type EmailAddress struct {
+	Name    string `json:"name,omitempty"`
+	Address string `json:"address"`
+}
+
+// String formats the recipient like a mail header, "Name <address>".
+func (r Recipient) String() string {
+	if r.EmailAddress.Name == "" || r.EmailAddress.Name == r.EmailAddress.Address {
+		return r.EmailAddress.Address
+	}
+	return r.EmailAddress.Name + " <" + r.EmailAddress.Address + ">"
+}
+
+// MessageUUID is the stable UUID of a Graph message of a datasource, so a
+// message fetched twice is stored once.
+func MessageUUID(datasourceUUID uuid.UUID, messageID string) uuid.UUID {
+	return uuid.NewV5(datasourceUUID, "graph:message:"+messageID)
+}
+
+// ToAPI maps the message into an api.Message of the datasource. mailbox is
+// the address of the datasource, it tells incoming from sent mail.
+func (m *Message) ToAPI(datasourceUUID uuid.UUID, mailbox string) api.Message {
+	from := m.From
+	if from == nil {
+		from = m.Sender
+	}
+	var sender string
+	if from != nil {
+		sender = from.String()
+	}
+
+	recipients := make([]string, 0, len(m.ToRecipients)+len(m.CcRecipients)+len(m.BccRecipients))
+	for _, list := range [][]Recipient{m.ToRecipients, m.CcRecipients, m.BccRecipients} {
+		for _, r := range list {
+			recipients = append(recipients, r.String())
+		}
+	}
+
+	body := m.Body.Content
+	if body == "" {
+		body = m.BodyPreview
+	}
+
+	out := api.Message{
+		UUID:              api.NewOptString(MessageUUID(datasourceUUID, m.ID).String()),
+		Type:              "email",
+		Format:            "email",
+		ExternalMessageID: api.NewOptString(m.ID),
+		DatasourceUUID:    api.NewOptString(datasourceUUID.String()),
+		Sender:            sender,
+		Recipients:        recipients,
+		Body:              body,
+		Meta: api.NewOptMessageMeta(api.MessageMeta{
+			IsIncoming: api.NewOptBool(from == nil || mailbox == "" || !strings.EqualFold(from.EmailAddress.Address, mailbox)),
+		}),
+	}
+	if m.InternetMessageID != "" {
+		out.ExternalMessageID = api.NewOptString(m.InternetMessageID)
+	}
+	if m.Subject != "" {
+		out.Subject = api.NewOptString(m.Subject)
+	}
+	if m.ConversationID != "" {
+		out.ThreadUUID = api.NewOptString(uuid.NewV5(datasourceUUID, "graph:conversation:"+m.ConversationID).String())
+	}
+	if !m.ReceivedDateTime.IsZero() {
+		out.CreatedAt = api.NewOptDateTime(m.ReceivedDateTime)
+	}
+	return out
+}
+
+// OutgoingMail is a mail sent with SendMail.
+type OutgoingMail struct {
+	To, Cc, Bcc []string
+	Subject     string
+	Body        string
+	// HTML sends the body as HTML instead of plain text
+	HTML bool
+	// SaveToSentItems keeps a copy in the Sent Items folder
+	SaveToSentItems bool
+}
+
+func (m *OutgoingMail) request() any {
+	recipients := func(addrs []string) []Recipient {
+		out := make([]Recipient, 0, len(addrs))
+		for _, a := range addrs {
+			out = append(out, Recipient{EmailAddress: EmailAddress{Address: a}})
+		}
+		return out
+	}
+	contentType := "Text"
+	if m.HTML {
+		contentType = "HTML"
+	}
+	type message struct {
+		Subject       string      `json:"subject"`
+		Body          ItemBody    `json:"body"`
+		ToRecipients  []Recipient `json:"toRecipients"`
+		CcRecipients  []Recipient `json:"ccRecipients,omitempty"`
+		BccRecipients []Recipient `json:"bccRecipients,omitempty"`
+	}
+	return struct {
+		Message         message `json:"message"`
+		SaveToSentItems bool    `json:"saveToSentItems"`
+	}{
+		Message: message{
+			Subject:       m.Subject,
+			Body:          ItemBody{ContentType: contentType, Content: m.Body},
+			ToRecipients:  recipients(m.To),
+			CcRecipients:  recipients(m.Cc),
+			BccRecipients: recipients(m.Bcc),
+		},
+		SaveToSentItems: m.SaveToSentItems,
+	}
+}
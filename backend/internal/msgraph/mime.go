@@ -0,0 +1,154 @@
+package msgraph
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+)
+
+// maxMIMEDepth bounds the nesting of multipart bodies.
+const maxMIMEDepth = 10
+
+// Attachment is a file part of a MIME message.
+type Attachment struct {
+	Name        string
+	ContentType string
+	ContentID   string
+	Inline      bool
+	Data        []byte
+}
+
+var wordDecoder = mime.WordDecoder{}
+
+// ParseAttachments returns the attachments of an RFC 822 message. Parts with
+// a file name, parts marked as attachment and forwarded messages count as
+// attachments, the text and HTML bodies do not.
+func ParseAttachments(raw []byte) ([]Attachment, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("mime: %w", err)
+	}
+	var out []Attachment
+	err = walkPart(textproto.MIMEHeader(msg.Header), msg.Body, 0, &out)
+	return out, err
+}
+
+func walkPart(header textproto.MIMEHeader, body io.Reader, depth int, out *[]Attachment) error {
+	if depth > maxMIMEDepth {
+		return fmt.Errorf("mime: parts nested deeper than %d", maxMIMEDepth)
+	}
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", map[string]string{}
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		r := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := r.NextRawPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("mime: %w", err)
+			}
+			if err := walkPart(part.Header, part, depth+1, out); err != nil {
+				return err
+			}
+		}
+	}
+
+	disposition, dparams, _ := mime.ParseMediaType(header.Get("Content-Disposition"))
+	name := dparams["filename"]
+	if name == "" {
+		name = params["name"]
+	}
+	if name, err = wordDecoder.DecodeHeader(name); err != nil {
+		name = params["name"]
+	}
+	isAttachment := disposition == "attachment" || name != "" || mediaType == "message/rfc822"
+	if !isAttachment {
+		return nil
+	}
+	if name == "" {
+		name = "attachment"
+		if mediaType == "message/rfc822" {
+			name = "message.eml"
+		}
+	}
+
+	data, err := io.ReadAll(decodeTransfer(header.Get("Content-Transfer-Encoding"), body))
+	if err != nil {
+		return fmt.Errorf("mime: attachment %s: %w", name, err)
+	}
+	*out = append(*out, Attachment{
+		Name:        name,
+		ContentType: mediaType,
+		ContentID:   strings.Trim(header.Get("Content-Id"), "<>"),
+		Inline:      disposition == "inline",
+		Data:        data,
+	})
+	return nil
+}
+
+func decodeTransfer(encoding string, r io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, r)
+	case "quoted-printable":
+		return quotedprintable.NewReader(r)
+	default:
+		return r
+	}
+}
+
+// AttachMIME adds the raw message and its attachments to the files of msg,
+// file UUIDs derive from the message UUID so fetching again keeps them.
+func AttachMIME(msg *api.Message, raw []byte) error {
+	msgUUID, err := uuid.FromString(msg.UUID.Or(""))
+	if err != nil {
+		return fmt.Errorf("mime: message uuid: %w", err)
+	}
+	attachments, err := ParseAttachments(raw)
+	if err != nil {
+		return err
+	}
+
+	msg.Attachments = append(msg.Attachments, api.FileObject{
+		UUID:        api.NewOptString(uuid.NewV5(msgUUID, "raw").String()),
+		MessageUUID: msg.UUID,
+		Name:        "message.eml",
+		MimeType:    api.NewOptString("message/rfc822"),
+		Size:        api.NewOptInt(len(raw)),
+		Data:        api.NewOptString(string(raw)),
+		IsRaw:       api.NewOptBool(true),
+		HasRawEmail: api.NewOptBool(true),
+	})
+	for i, a := range attachments {
+		msg.Attachments = append(msg.Attachments, api.FileObject{
+			UUID:        api.NewOptString(uuid.NewV5(msgUUID, fmt.Sprintf("attachment:%d", i)).String()),
+			MessageUUID: msg.UUID,
+			Name:        a.Name,
+			MimeType:    api.NewOptString(a.ContentType),
+			Size:        api.NewOptInt(len(a.Data)),
+			Data:        api.NewOptString(string(a.Data)),
+			IsInline:    api.NewOptBool(a.Inline),
+		})
+	}
+
+	meta := msg.Meta.Or(api.MessageMeta{})
+	meta.HasRawEmail = api.NewOptBool(true)
+	msg.Meta = api.NewOptMessageMeta(meta)
+	return nil
+}
@@ -0,0 +1,365 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// fakeGraph is a local stand-in for the mail endpoints of Microsoft Graph.
+type fakeGraph struct {
+	t   *testing.T
+	srv *httptest.Server
+
+	mu   sync.Mutex
+	sent []map[string]any
+}
+
+const testMIME = "From: Alice <alice@example.com>\r\n" +
+	"To: bob@example.com\r\n" +
+	"Subject: Report\r\n" +
+	"MIME-Version: 1.0\r\n" +
+	"Content-Type: multipart/mixed; boundary=outer\r\n" +
+	"\r\n" +
+	"--outer\r\n" +
+	"Content-Type: multipart/alternative; boundary=inner\r\n" +
+	"\r\n" +
+	"--inner\r\n" +
+	"Content-Type: text/plain; charset=utf-8\r\n" +
+	"\r\n" +
+	"See attached.\r\n" +
+	"--inner\r\n" +
+	"Content-Type: text/html; charset=utf-8\r\n" +
+	"\r\n" +
+	"<p>See attached.</p>\r\n" +
+	"--inner--\r\n" +
+	"--outer\r\n" +
+	"Content-Type: application/pdf; name=\"report.pdf\"\r\n" +
+	"Content-Disposition: attachment; filename=\"report.pdf\"\r\n" +
+	"Content-Transfer-Encoding: base64\r\n" +
+	"\r\n" +
+	"JVBERi0xLjQK\r\n" +
+	"--outer\r\n" +
+	"Content-Type: text/plain; charset=utf-8\r\n" +
+	"Content-Disposition: attachment; filename=\"=?utf-8?q?notes_=C3=A9t=C3=A9.txt?=\"\r\n" +
+	"Content-Transfer-Encoding: quoted-printable\r\n" +
+	"\r\n" +
+	"caf=C3=A9\r\n" +
+	"--outer\r\n" +
+	"Content-Type: image/png\r\n" +
+	"Content-Disposition: inline; filename=\"logo.png\"\r\n" +
+	"Content-ID: <logo@example.com>\r\n" +
+	"Content-Transfer-Encoding: base64\r\n" +
+	"\r\n" +
+	"iVBORw==\r\n" +
+	"--outer--\r\n"
+
+func newFakeGraph(t *testing.T) *fakeGraph {
+	f := &fakeGraph{t: t}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1.0/me/mailFolders/inbox/messages/delta", f.delta)
+	mux.HandleFunc("GET /v1.0/me/mailFolders/expired/messages/delta", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusGone, map[string]any{
+			"error": map[string]string{"code": "SyncStateNotFound", "message": "The sync state is no longer valid."},
+		})
+	})
+	mux.HandleFunc("GET /v1.0/me/mailFolders/archive/messages", f.messages)
+	mux.HandleFunc("GET /v1.0/me/messages/{id}/$value", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("id") != "m2" {
+			writeJSON(w, http.StatusNotFound, map[string]any{"error": map[string]string{"code": "ErrorItemNotFound"}})
+			return
+		}
+		w.Header().Set("Content-Type", "message/rfc822")
+		io.WriteString(w, testMIME)
+	})
+	mux.HandleFunc("POST /v1.0/me/sendMail", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("sendMail body: %v", err)
+		}
+		f.mu.Lock()
+		f.sent = append(f.sent, body)
+		f.mu.Unlock()
+		w.WriteHeader(http.StatusAccepted)
+	})
+	f.srv = httptest.NewServer(mux)
+	t.Cleanup(f.srv.Close)
+	return f
+}
+
+// delta serves the inbox in two pages on the first sync, and a removal on
+// the sync that follows.
+func (f *fakeGraph) delta(w http.ResponseWriter, r *http.Request) {
+	base := f.srv.URL + "/v1.0/me/mailFolders/inbox/messages/delta"
+	switch {
+	case r.URL.Query().Get("$deltatoken") == "t1":
+		writeJSON(w, http.StatusOK, map[string]any{
+			"value":            []any{map[string]any{"id": "m1", "@removed": map[string]string{"reason": "deleted"}}},
+			"@odata.deltaLink": base + "?$deltatoken=t2",
+		})
+	case r.URL.Query().Get("$skiptoken") == "p2":
+		writeJSON(w, http.StatusOK, map[string]any{
+			"value": []any{map[string]any{
+				"id":                "m2",
+				"internetMessageId": "<m2@example.com>",
+				"subject":           "Report",
+				"body":              map[string]string{"contentType": "text", "content": "See attached."},
+				"from":              map[string]any{"emailAddress": map[string]string{"name": "Alice", "address": "alice@example.com"}},
+				"toRecipients":      []any{map[string]any{"emailAddress": map[string]string{"address": "bob@example.com"}}},
+				"hasAttachments":    true,
+			}},
+			"@odata.deltaLink": base + "?$deltatoken=t1",
+		})
+	default:
+		if !strings.Contains(r.URL.Query().Get("$select"), "internetMessageId") {
+			f.t.Errorf("delta query without $select: %s", r.URL.RawQuery)
+		}
+		if !strings.Contains(r.Header.Get("Prefer"), "odata.maxpagesize") {
+			f.t.Errorf("delta query without page size preference")
+		}
+		writeJSON(w, http.StatusOK, map[string]any{
+			"value": []any{map[string]any{
+				"id":                "m1",
+				"internetMessageId": "<m1@example.com>",
+				"conversationId":    "c1",
+				"subject":           "Hello",
+				"bodyPreview":       "Hi Bob",
+				"body":              map[string]string{"contentType": "text", "content": "Hi Bob, how are you?"},
+				"from":              map[string]any{"emailAddress": map[string]string{"name": "Alice", "address": "alice@example.com"}},
+				"toRecipients":      []any{map[string]any{"emailAddress": map[string]string{"name": "Bob", "address": "bob@example.com"}}},
+				"ccRecipients":      []any{map[string]any{"emailAddress": map[string]string{"address": "carol@example.com"}}},
+				"receivedDateTime":  "2025-03-01T10:00:00Z",
+			}},
+			"@odata.nextLink": base + "?$skiptoken=p2",
+		})
+	}
+}
+
+// messages serves the archive folder in pages of two messages, the range of
+// the backfill test is checked.
+func (f *fakeGraph) messages(w http.ResponseWriter, r *http.Request) {
+	want := "receivedDateTime ge 2025-01-01T00:00:00Z and receivedDateTime lt 2025-02-01T00:00:00Z"
+	if got := r.URL.Query().Get("$filter"); got != want {
+		f.t.Errorf("$filter = %q, want %q", got, want)
+	}
+	page := []any{map[string]any{"id": "a1"}, map[string]any{"id": "a2"}}
+	out := map[string]any{"value": page}
+	if r.URL.Query().Get("$skiptoken") == "" {
+		out["@odata.nextLink"] = f.srv.URL + "/v1.0/me/mailFolders/archive/messages?" + r.URL.RawQuery + "&$skiptoken=p2"
+	} else {
+		out["value"] = []any{map[string]any{"id": "a3"}, map[string]any{"id": "a4"}}
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func (f *fakeGraph) client() *Client {
+	return NewWithBaseURL(f.srv.Client(), f.srv.URL+"/v1.0")
+}
+
+func TestDeltaFollowsPagesAndDeltaLinks(t *testing.T) {
+	f := newFakeGraph(t)
+	c := f.client()
+	ctx := context.Background()
+
+	page, err := c.Delta(ctx, "inbox", "")
+	if err != nil {
+		t.Fatalf("initial sync: %v", err)
+	}
+	if len(page.Messages) != 2 || page.Messages[0].ID != "m1" || page.Messages[1].ID != "m2" {
+		t.Fatalf("initial sync messages = %+v", page.Messages)
+	}
+	if !strings.HasSuffix(page.DeltaLink, "$deltatoken=t1") {
+		t.Fatalf("initial sync delta link = %q", page.DeltaLink)
+	}
+
+	page, err = c.Delta(ctx, "inbox", page.DeltaLink)
+	if err != nil {
+		t.Fatalf("incremental sync: %v", err)
+	}
+	if len(page.Messages) != 0 || len(page.Removed) != 1 || page.Removed[0] != "m1" {
+		t.Fatalf("incremental sync = %+v", page)
+	}
+	if !strings.HasSuffix(page.DeltaLink, "$deltatoken=t2") {
+		t.Fatalf("incremental sync delta link = %q", page.DeltaLink)
+	}
+}
+
+func TestDeltaExpiredSyncState(t *testing.T) {
+	f := newFakeGraph(t)
+	_, err := f.client().Delta(context.Background(), "expired", "")
+	if !IsSyncStateInvalid(err) {
+		t.Fatalf("err = %v, want an invalid sync state", err)
+	}
+	if e, ok := err.(*Error); !ok || e.Code != "SyncStateNotFound" || e.Status != http.StatusGone {
+		t.Fatalf("err = %#v", err)
+	}
+}
+
+func TestMessagesRangeAndLimit(t *testing.T) {
+	f := newFakeGraph(t)
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	msgs, err := f.client().Messages(context.Background(), "archive", since, until, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 3 || msgs[0].ID != "a1" || msgs[2].ID != "a3" {
+		t.Fatalf("messages = %+v, want a1 to a3", msgs)
+	}
+
+	msgs, err = f.client().Messages(context.Background(), "archive", since, until, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 4 {
+		t.Fatalf("got %d messages without a limit, want 4", len(msgs))
+	}
+}
+
+func TestToAPI(t *testing.T) {
+	f := newFakeGraph(t)
+	page, err := f.client().Delta(context.Background(), "inbox", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds := uuid.Must(uuid.NewV4())
+
+	msg := page.Messages[0].ToAPI(ds, "bob@example.com")
+	if msg.UUID.Value != MessageUUID(ds, "m1").String() {
+		t.Errorf("uuid = %s", msg.UUID.Value)
+	}
+	if msg.Type != "email" || msg.Format != "email" {
+		t.Errorf("type, format = %s, %s", msg.Type, msg.Format)
+	}
+	if msg.Sender != "Alice <alice@example.com>" {
+		t.Errorf("sender = %q", msg.Sender)
+	}
+	if got := strings.Join(msg.Recipients, ", "); got != "Bob <bob@example.com>, carol@example.com" {
+		t.Errorf("recipients = %q", got)
+	}
+	if msg.Subject.Value != "Hello" || msg.Body != "Hi Bob, how are you?" {
+		t.Errorf("subject, body = %q, %q", msg.Subject.Value, msg.Body)
+	}
+	if msg.ExternalMessageID.Value != "<m1@example.com>" {
+		t.Errorf("external id = %q", msg.ExternalMessageID.Value)
+	}
+	if msg.DatasourceUUID.Value != ds.String() || !msg.ThreadUUID.Set {
+		t.Errorf("datasource, thread = %v, %v", msg.DatasourceUUID, msg.ThreadUUID)
+	}
+	if want := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC); !msg.CreatedAt.Value.Equal(want) {
+		t.Errorf("created at = %v", msg.CreatedAt.Value)
+	}
+	if !msg.Meta.Value.IsIncoming.Value {
+		t.Errorf("message from alice is not incoming for bob")
+	}
+	if sent := page.Messages[0].ToAPI(ds, "alice@example.com"); sent.Meta.Value.IsIncoming.Value {
+		t.Errorf("message from alice is incoming for alice")
+	}
+}
+
+func TestAttachMIME(t *testing.T) {
+	f := newFakeGraph(t)
+	c := f.client()
+	ctx := context.Background()
+
+	raw, err := c.MIME(ctx, "m2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := (&Message{ID: "m2"}).ToAPI(uuid.Must(uuid.NewV4()), "")
+	if err := AttachMIME(&msg, raw); err != nil {
+		t.Fatal(err)
+	}
+
+	type file struct {
+		name, mime, data string
+		raw, inline      bool
+	}
+	want := []file{
+		{"message.eml", "message/rfc822", testMIME, true, false},
+		{"report.pdf", "application/pdf", "%PDF-1.4\n", false, false},
+		{"notes été.txt", "text/plain", "café", false, false},
+		{"logo.png", "image/png", "\x89PNG", false, true},
+	}
+	if len(msg.Attachments) != len(want) {
+		t.Fatalf("attachments = %d, want %d", len(msg.Attachments), len(want))
+	}
+	for i, w := range want {
+		a := msg.Attachments[i]
+		got := file{a.Name, a.MimeType.Value, a.Data.Value, a.IsRaw.Value, a.IsInline.Value}
+		if got != w {
+			t.Errorf("attachment %d = %+v, want %+v", i, got, w)
+		}
+		if a.Size.Value != len(w.data) || a.MessageUUID != msg.UUID {
+			t.Errorf("attachment %d size, message = %d, %v", i, a.Size.Value, a.MessageUUID)
+		}
+	}
+	if !msg.Meta.Value.HasRawEmail.Value {
+		t.Errorf("has_raw_email not set")
+	}
+
+	again := (&Message{ID: "m2"}).ToAPI(uuid.FromStringOrNil(msg.DatasourceUUID.Value), "")
+	if err := AttachMIME(&again, raw); err != nil {
+		t.Fatal(err)
+	}
+	for i := range again.Attachments {
+		if again.Attachments[i].UUID != msg.Attachments[i].UUID {
+			t.Errorf("attachment %d uuid changed between fetches", i)
+		}
+	}
+
+	if _, err := c.MIME(ctx, "missing"); err == nil {
+		t.Errorf("MIME of a missing message succeeded")
+	}
+}
+
+func TestSendMail(t *testing.T) {
+	f := newFakeGraph(t)
+	err := f.client().SendMail(context.Background(), &OutgoingMail{
+		To:              []string{"bob@example.com"},
+		Cc:              []string{"carol@example.com"},
+		Subject:         "Hi",
+		Body:            "Hello",
+		HTML:            true,
+		SaveToSentItems: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.sent) != 1 {
+		t.Fatalf("sent %d mails", len(f.sent))
+	}
+	got, _ := json.Marshal(f.sent[0])
+	want := `{"message":{"body":{"content":"Hello","contentType":"HTML"},` +
+		`"ccRecipients":[{"emailAddress":{"address":"carol@example.com"}}],` +
+		`"subject":"Hi","toRecipients":[{"emailAddress":{"address":"bob@example.com"}}]},"saveToSentItems":true}`
+	if string(got) != want {
+		t.Errorf("sendMail body =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestErrorMessage(t *testing.T) {
+	err := &Error{Status: 400, Code: "ErrorInvalidRecipients", Message: "At least one recipient is not valid."}
+	if got := err.Error(); got != "graph: 400 ErrorInvalidRecipients: At least one recipient is not valid." {
+		t.Errorf("Error() = %q", got)
+	}
+	if got := fmt.Sprint(&Error{Status: 503}); got != "graph: 503 Service Unavailable" {
+		t.Errorf("Error() = %q", got)
+	}
+}
@@ -0,0 +1,141 @@
+package oauth2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"golang.org/x/oauth2"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// ErrNoToken is returned when nobody granted the client access yet.
+var ErrNoToken = errors.New("no oauth2 token found for client")
+
+// ClientToken is the latest token of an OAuth2 client with an HTTP client
+// authorized by it.
+type ClientToken struct {
+	Config    *Config
+	TokenUUID uuid.UUID
+	Token     *oauth2.Token
+	HTTP      *http.Client
+}
+
+// GetClientToken loads the latest token of the client, refreshing it when it
+// is about to expire, and returns an HTTP client using it.
+func GetClientToken(ctx context.Context, dbp *pgxpool.Pool, clientUUID string) (*ClientToken, error) {
+	clientConfig, err := GetClientConfig(ctx, dbp, clientUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	pgClientUUID, err := converter.ConvertStringToPgUUID(clientUUID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := query.New(dbp).GetOauth2TokensByClientUUID(ctx, pgClientUUID)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, ErrNoToken
+	}
+	latest := rows[0]
+	for _, r := range rows {
+		lu := r.Oauth2Token.UpdatedAt
+		if !latest.Oauth2Token.UpdatedAt.Valid || (lu.Valid && lu.Time.After(latest.Oauth2Token.UpdatedAt.Time)) {
+			latest = r
+		}
+	}
+	tokenUUID := latest.Oauth2Token.UUID
+	if tokenUUID == uuid.Nil {
+		return nil, ErrNoToken
+	}
+
+	// the store persists refreshed tokens back to oauth2_token
+	tokenStore, err := NewTokenStore(ctx, &clientConfig.Config, dbp, tokenUUID, 5*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	token, err := tokenStore.Token()
+	if err != nil {
+		return nil, err
+	}
+	return &ClientToken{
+		Config:    clientConfig,
+		TokenUUID: tokenUUID,
+		Token:     token,
+		HTTP:      clientConfig.Client(ctx, token),
+	}, nil
+}
+
+// RefreshNow refreshes the stored token whatever its expiry and returns the
+// new one, a refresh that ran concurrently counts.
+func RefreshNow(ctx context.Context, dbp *pgxpool.Pool, tokenUUID uuid.UUID) (*oauth2.Token, error) {
+	row, err := query.New(dbp).GetOauth2TokenByUUID(ctx, converter.UuidToPgUUID(tokenUUID))
+	if err != nil {
+		return nil, err
+	}
+	if row.Oauth2Token.ClientUuid == nil {
+		return nil, errors.New("token has no oauth2 client")
+	}
+	clientConfig, err := GetClientConfig(ctx, dbp, row.Oauth2Token.ClientUuid.String())
+	if err != nil {
+		return nil, err
+	}
+	startedAt := time.Now()
+	return RefreshToken(clientConfig.Context(ctx), dbp, &clientConfig.Config, tokenUUID, func(row query.Oauth2Token, _ *oauth2.Token) bool {
+		return !row.LastRefreshedAt.Valid || row.LastRefreshedAt.Time.Before(startedAt)
+	})
+}
+
+// RevokeToken revokes the stored token at the provider of its client. The
+// token row is left to the caller.
+func RevokeToken(ctx context.Context, dbp *pgxpool.Pool, row query.Oauth2Token) error {
+	if row.ClientUuid == nil {
+		return nil
+	}
+	clientConfig, err := GetClientConfig(ctx, dbp, row.ClientUuid.String())
+	if err != nil {
+		return err
+	}
+	token, err := decodeToken(row.Token)
+	if err != nil {
+		return err
+	}
+	return clientConfig.Revoke(ctx, token)
+}
+
+// CheckRefreshable checks that the stored token can be refreshed without
+// refreshing it, refreshes count against the quota of the provider: the
+// token is healthy, its client resolves and it holds a refresh token or has
+// not expired yet.
+func CheckRefreshable(ctx context.Context, dbp *pgxpool.Pool, row query.Oauth2Token) error {
+	if row.Status == TokenStatusNeedsReauth {
+		return ErrNeedsReauth
+	}
+	if row.ClientUuid == nil {
+		return errors.New("token has no oauth2 client")
+	}
+	clientConfig, err := GetClientConfig(ctx, dbp, row.ClientUuid.String())
+	if err != nil {
+		return err
+	}
+	if clientConfig.Endpoint.TokenURL == "" {
+		return errors.New("oauth2 client has no token endpoint")
+	}
+	token, err := decodeToken(row.Token)
+	if err != nil {
+		return fmt.Errorf("decode token: %w", err)
+	}
+	if token.RefreshToken == "" && !token.Valid() {
+		return errors.New("token expired and has no refresh token")
+	}
+	return nil
+}
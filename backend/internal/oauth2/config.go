@@ -2,9 +2,17 @@ package oauth2
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"strings"
 
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"golang.org/x/oauth2"
+
+	"github.com/shadowapi/shadowapi/backend/internal/metrics"
 )
 
 type Config struct {
@@ -13,13 +21,161 @@ type Config struct {
 	Provider string
 	Secret   string
 	Token    string
+	// Spec is the registered provider, completed by discovery
+	Spec *Provider
+	// HTTP makes the requests to the provider, see Resolver
+	HTTP *http.Client
+}
+
+// Context returns ctx carrying the HTTP client of the config for the
+// requests golang.org/x/oauth2 makes, token exchanges and refreshes.
+func (c *Config) Context(ctx context.Context) context.Context {
+	if c.HTTP == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, oauth2.HTTPClient, c.HTTP)
+}
+
+// httpClient returns the HTTP client of the config.
+func (c *Config) httpClient() *http.Client {
+	if c.HTTP == nil {
+		return Default().http
+	}
+	return c.HTTP
 }
 
-// Client returns OAuth2 client with cached Token store
+// UserInfo is the account at the provider that granted access.
+type UserInfo struct {
+	Subject string
+	Email   string
+	Name    string
+}
+
+// Client returns OAuth2 client with cached Token store, its requests are
+// recorded in the provider metrics and traced
 func (c *Config) Client(ctx context.Context, t *oauth2.Token) *http.Client {
+	hc := c.httpClient()
+	if v, ok := ctx.Value(oauth2.HTTPClient).(*http.Client); ok {
+		hc = v
+	}
+	base := hc.Transport
+	transport := otelhttp.NewTransport(metrics.ProviderTransport(c.Provider, base),
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return c.Provider + " " + r.Method + " " + r.URL.Path
+		}),
+	)
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Transport: transport, Timeout: hc.Timeout})
 	return oauth2.NewClient(ctx, c.TokenSource(ctx, t))
 }
 
 func (c *Config) Close() error {
 	return nil
 }
+
+// LoginURL returns the authorization URL of the provider. verifier is the
+// PKCE code verifier, it is ignored by providers without PKCE.
+func (c *Config) LoginURL(state, verifier string) string {
+	var opts []oauth2.AuthCodeOption
+	for k, v := range c.Spec.AuthParams {
+		opts = append(opts, oauth2.SetAuthURLParam(k, v))
+	}
+	if c.Spec.PKCE && verifier != "" {
+		opts = append(opts, oauth2.S256ChallengeOption(verifier))
+	}
+	return c.AuthCodeURL(state, opts...)
+}
+
+// ExchangeCode exchanges the authorization code, sending the PKCE verifier of
+// the login when there is one.
+func (c *Config) ExchangeCode(ctx context.Context, code, verifier string) (*oauth2.Token, error) {
+	var opts []oauth2.AuthCodeOption
+	if c.Spec.PKCE && verifier != "" {
+		opts = append(opts, oauth2.VerifierOption(verifier))
+	}
+	return c.Exchange(c.Context(ctx), code, opts...)
+}
+
+// Revoke revokes the grant at the provider. Providers without revocation
+// return nil, the token is then only forgotten.
+func (c *Config) Revoke(ctx context.Context, token *oauth2.Token) error {
+	if c.Spec.Revoke != nil {
+		return c.Spec.Revoke(ctx, c, token)
+	}
+	if c.Spec.RevocationURL == "" {
+		return nil
+	}
+	// revoking the refresh token ends the whole grant
+	form := url.Values{}
+	form.Set("client_id", c.ClientID)
+	form.Set("client_secret", c.ClientSecret)
+	switch {
+	case token.RefreshToken != "":
+		form.Set("token", token.RefreshToken)
+		form.Set("token_type_hint", "refresh_token")
+	case token.AccessToken != "":
+		form.Set("token", token.AccessToken)
+		form.Set("token_type_hint", "access_token")
+	default:
+		return nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.Spec.RevocationURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("%s revocation returned %s", c.Provider, resp.Status)
+	}
+	return nil
+}
+
+// FetchUserInfo returns the account that granted the token, nil when the
+// provider has no user info endpoint.
+func (c *Config) FetchUserInfo(ctx context.Context, token *oauth2.Token) (*UserInfo, error) {
+	if c.Spec.UserInfoURL == "" {
+		return nil, nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.Spec.UserInfoURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.Client(ctx, token).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s user info returned %s", c.Provider, resp.Status)
+	}
+	var fields map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&fields); err != nil {
+		return nil, fmt.Errorf("%s user info: %w", c.Provider, err)
+	}
+	m := c.Spec.UserInfo
+	return &UserInfo{
+		Subject: field(fields, m.Subject),
+		Email:   field(fields, m.Email),
+		Name:    field(fields, m.Name),
+	}, nil
+}
+
+// field returns a user info field as a string, GitHub ids are numbers.
+func field(fields map[string]any, name string) string {
+	switch v := fields[name].(type) {
+	case string:
+		return v
+	case float64:
+		return fmt.Sprintf("%.0f", v)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
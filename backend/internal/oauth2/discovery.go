@@ -0,0 +1,68 @@
+package oauth2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// discoveryTTL is how long an OpenID configuration is cached.
+const discoveryTTL = time.Hour
+
+// discovery is the part of an OpenID configuration the providers use.
+type discovery struct {
+	Issuer                string   `json:"issuer"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint"`
+	TokenEndpoint         string   `json:"token_endpoint"`
+	UserInfoEndpoint      string   `json:"userinfo_endpoint"`
+	RevocationEndpoint    string   `json:"revocation_endpoint"`
+	CodeChallengeMethods  []string `json:"code_challenge_methods_supported"`
+
+	fetched time.Time
+}
+
+// discoveryCache keeps the OpenID configurations of the issuers.
+type discoveryCache struct {
+	mu      sync.Mutex
+	entries map[string]*discovery // issuer URL → configuration
+}
+
+// discover returns the OpenID configuration of the issuer.
+func (r *Resolver) discover(ctx context.Context, issuerURL string) (*discovery, error) {
+	issuerURL = strings.TrimSuffix(issuerURL, "/")
+	r.discoveries.mu.Lock()
+	d, ok := r.discoveries.entries[issuerURL]
+	r.discoveries.mu.Unlock()
+	if ok && time.Since(d.fetched) < discoveryTTL {
+		return d, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuerURL+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, fmt.Errorf("openid discovery: %w", err)
+	}
+	resp, err := r.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("openid discovery: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("openid discovery: %s returned %s", issuerURL, resp.Status)
+	}
+	d = &discovery{}
+	if err := json.NewDecoder(resp.Body).Decode(d); err != nil {
+		return nil, fmt.Errorf("openid discovery: %w", err)
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" {
+		return nil, fmt.Errorf("openid discovery: %s has no authorization or token endpoint", issuerURL)
+	}
+	d.fetched = time.Now()
+	r.discoveries.mu.Lock()
+	r.discoveries.entries[issuerURL] = d
+	r.discoveries.mu.Unlock()
+	return d, nil
+}
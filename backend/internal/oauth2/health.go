@@ -0,0 +1,244 @@
+package oauth2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"golang.org/x/oauth2"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/events"
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// Token health stored in oauth2_token.status
+const (
+	TokenStatusOK      = "ok"
+	TokenStatusFailing = "failing"
+	// TokenStatusNeedsReauth tokens are no longer refreshed, a user has to log in again
+	TokenStatusNeedsReauth = "needs_reauth"
+)
+
+// ErrNeedsReauth is returned for tokens that are no longer refreshed.
+var ErrNeedsReauth = errors.New("oauth2 token needs a new login")
+
+// reauthPauseReason is the paused_reason of the schedulers paused until a new login.
+const reauthPauseReason = "needs_reauth"
+
+// reauthErrorCodes are the token endpoint errors telling that the grant is gone.
+var reauthErrorCodes = map[string]bool{
+	"invalid_grant":       true,
+	"unauthorized_client": true,
+	"access_denied":       true,
+}
+
+// identityScopes are granted implicitly and often missing from the scope of
+// token responses, they are ignored when looking for scope drift.
+var identityScopes = map[string]bool{
+	"openid":         true,
+	"profile":        true,
+	"email":          true,
+	"offline_access": true,
+}
+
+// IsReauthError reports whether a failed refresh can only be fixed by a new
+// login, e.g. the user revoked the grant or the refresh token expired.
+func IsReauthError(err error) bool {
+	var re *oauth2.RetrieveError
+	if errors.As(err, &re) {
+		return reauthErrorCodes[re.ErrorCode]
+	}
+	return err != nil && strings.Contains(err.Error(), "refresh token is not set")
+}
+
+// GrantedScopes returns the scopes of a token response, nil when the provider
+// does not report them.
+func GrantedScopes(token *oauth2.Token) []string {
+	scope, ok := token.Extra("scope").(string)
+	if !ok {
+		return nil
+	}
+	return strings.Fields(scope)
+}
+
+// MissingScopes returns the requested scopes the user did not grant. Scopes
+// are compared by their last path segment, case-insensitively, since some
+// providers report https://graph.microsoft.com/Mail.Read as Mail.Read.
+func MissingScopes(requested, granted []string) []string {
+	if granted == nil {
+		return nil
+	}
+	have := make(map[string]bool, len(granted))
+	for _, s := range granted {
+		have[scopeKey(s)] = true
+	}
+	var missing []string
+	for _, s := range requested {
+		if identityScopes[s] || have[scopeKey(s)] {
+			continue
+		}
+		missing = append(missing, s)
+	}
+	return missing
+}
+
+func scopeKey(scope string) string {
+	if i := strings.LastIndex(scope, "/"); i >= 0 {
+		scope = scope[i+1:]
+	}
+	return strings.ToLower(scope)
+}
+
+// TokenHealth is the health of a newly issued or refreshed token.
+type TokenHealth struct {
+	Status        string
+	Scopes        []string
+	MissingScopes []string
+}
+
+// CheckToken compares the scopes granted with the token to the requested ones.
+func CheckToken(requested []string, token *oauth2.Token) TokenHealth {
+	h := TokenHealth{Status: TokenStatusOK, Scopes: GrantedScopes(token)}
+	h.MissingScopes = MissingScopes(requested, h.Scopes)
+	if len(h.MissingScopes) > 0 {
+		h.Status = TokenStatusNeedsReauth
+	}
+	if h.MissingScopes == nil {
+		h.MissingScopes = []string{}
+	}
+	return h
+}
+
+// Reason explains a needs_reauth status.
+func (h TokenHealth) Reason() string {
+	if len(h.MissingScopes) == 0 {
+		return ""
+	}
+	return "scopes not granted: " + strings.Join(h.MissingScopes, " ")
+}
+
+// RecordRefresh stores a refreshed token with its health. Datasources using
+// it are flagged for re-consent when the scopes drifted.
+func RecordRefresh(ctx context.Context, q *query.Queries, requested []string, clientUUID, tokenUUID uuid.UUID, token *oauth2.Token) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	if data, err = secrets.EncryptJSON(data); err != nil {
+		return err
+	}
+	health := CheckToken(requested, token)
+	if err := q.SetOauth2TokenRefreshed(ctx, query.SetOauth2TokenRefreshedParams{
+		UUID:          converter.UuidToPgUUID(tokenUUID),
+		Token:         data,
+		ExpiresAt:     TokenExpiry(token),
+		Scopes:        health.Scopes,
+		MissingScopes: health.MissingScopes,
+		Status:        health.Status,
+	}); err != nil {
+		return err
+	}
+	if health.Status == TokenStatusNeedsReauth {
+		return FlagReauth(ctx, q, clientUUID, tokenUUID, health.Reason())
+	}
+	return nil
+}
+
+// RecordFailure counts a failed refresh of the token. When the grant is gone
+// the token is no longer refreshed and its datasources are flagged for
+// re-consent, other errors are retried with backoff.
+func RecordFailure(ctx context.Context, q *query.Queries, tokenUUID uuid.UUID, refreshErr error) error {
+	status := TokenStatusFailing
+	if IsReauthError(refreshErr) {
+		status = TokenStatusNeedsReauth
+	}
+	row, err := q.SetOauth2TokenFailed(ctx, query.SetOauth2TokenFailedParams{
+		UUID:      converter.UuidToPgUUID(tokenUUID),
+		Status:    status,
+		LastError: refreshErr.Error(),
+	})
+	if err != nil {
+		return err
+	}
+	if status != TokenStatusNeedsReauth || row.ClientUuid == nil {
+		return nil
+	}
+	return FlagReauth(ctx, q, *row.ClientUuid, tokenUUID, "token refresh failed: "+refreshErr.Error())
+}
+
+// FlagReauth marks the datasources of the client as needing a new login,
+// pauses their schedulers and emits datasource.needs_reauth for each
+// datasource not flagged before.
+func FlagReauth(ctx context.Context, q *query.Queries, clientUUID, tokenUUID uuid.UUID, reason string) error {
+	flagged, err := q.FlagDatasourcesNeedsReauth(ctx, query.FlagDatasourcesNeedsReauthParams{
+		ClientUuid: clientUUID.String(),
+		Reason:     reason,
+	})
+	if err != nil {
+		return err
+	}
+	for _, ds := range flagged {
+		if err := q.PauseDatasourceSchedulers(ctx, query.PauseDatasourceSchedulersParams{
+			DatasourceUUID: converter.UuidToPgUUID(ds.UUID),
+			Reason:         reauthPauseReason,
+		}); err != nil {
+			return err
+		}
+		if err := events.Emit(ctx, q, datasourceEvent(events.DatasourceNeedsReauth, ds, clientUUID, tokenUUID, reason)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Reauthorized clears the re-consent flag of the datasources of the client
+// after a new login, resumes the schedulers paused for it and emits
+// datasource.reauthorized.
+func Reauthorized(ctx context.Context, q *query.Queries, clientUUID, tokenUUID uuid.UUID) error {
+	cleared, err := q.ClearDatasourcesNeedsReauth(ctx, clientUUID.String())
+	if err != nil {
+		return err
+	}
+	for _, ds := range cleared {
+		if err := q.ResumeDatasourceSchedulers(ctx, query.ResumeDatasourceSchedulersParams{
+			DatasourceUUID: converter.UuidToPgUUID(ds.UUID),
+			Reason:         reauthPauseReason,
+		}); err != nil {
+			return err
+		}
+		if err := events.Emit(ctx, q, datasourceEvent(events.DatasourceReauthorized, ds, clientUUID, tokenUUID, "")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func datasourceEvent(typ string, ds query.Datasource, clientUUID, tokenUUID uuid.UUID, reason string) events.Event {
+	data := map[string]string{
+		"datasource_uuid":    ds.UUID.String(),
+		"name":               ds.Name,
+		"type":               ds.Type,
+		"oauth2_client_uuid": clientUUID.String(),
+		"oauth2_token_uuid":  tokenUUID.String(),
+	}
+	if reason != "" {
+		data["reason"] = reason
+	}
+	return events.Event{
+		Type:          typ,
+		SubjectKind:   "datasource",
+		SubjectUUID:   ds.UUID,
+		WorkspaceUUID: ds.WorkspaceUUID,
+		Data:          data,
+	}
+}
+
+// TokenExpiry is the expires_at column value of a token.
+func TokenExpiry(token *oauth2.Token) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: token.Expiry, Valid: !token.Expiry.IsZero()}
+}
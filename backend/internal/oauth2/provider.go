@@ -0,0 +1,120 @@
+package oauth2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// Provider describes an OAuth2 provider: where users grant access, what is
+// asked for by default and how the grant is identified and revoked.
+type Provider struct {
+	// Name is the value stored in oauth2_client.provider
+	Name string
+	// Title is the display name
+	Title string
+	// Endpoint are the authorization and token URLs, left empty for
+	// providers using discovery
+	Endpoint oauth2.Endpoint
+	// Discovery resolves the endpoints from the OpenID configuration of the
+	// issuer_url of the client
+	Discovery bool
+	// Scopes are requested unless the client lists its own
+	Scopes []string
+	// AuthParams are added to the authorization URL
+	AuthParams map[string]string
+	// PKCE enables the S256 code challenge
+	PKCE bool
+	// UserInfoURL returns the account that granted access
+	UserInfoURL string
+	// UserInfo maps the fields of the user info response
+	UserInfo UserInfoMapping
+	// RevocationURL takes RFC 7009 revocation requests
+	RevocationURL string
+	// Revoke replaces the RFC 7009 revocation for providers doing it their
+	// own way
+	Revoke func(ctx context.Context, cfg *Config, token *oauth2.Token) error
+}
+
+// UserInfoMapping names the fields of a user info response.
+type UserInfoMapping struct {
+	Subject string
+	Email   string
+	Name    string
+}
+
+// oidcUserInfo is the mapping of the standard OpenID Connect claims.
+var oidcUserInfo = UserInfoMapping{Subject: "sub", Email: "email", Name: "name"}
+
+var registry = struct {
+	sync.RWMutex
+	providers map[string]*Provider
+}{providers: map[string]*Provider{}}
+
+// Register adds a provider to the registry. Registering a name twice panics.
+func Register(p *Provider) {
+	registry.Lock()
+	defer registry.Unlock()
+	name := strings.ToLower(p.Name)
+	if _, ok := registry.providers[name]; ok {
+		panic(fmt.Sprintf("oauth2 provider %s registered twice", p.Name))
+	}
+	registry.providers[name] = p
+}
+
+// Lookup returns the provider of the name, the lookup ignores case.
+func Lookup(name string) (*Provider, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	p, ok := registry.providers[strings.ToLower(name)]
+	return p, ok
+}
+
+// Providers returns the registered providers sorted by name.
+func Providers() []*Provider {
+	registry.RLock()
+	defer registry.RUnlock()
+	out := make([]*Provider, 0, len(registry.providers))
+	for _, p := range registry.providers {
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// CanRevoke reports whether grants of the provider can be revoked.
+func (p *Provider) CanRevoke() bool {
+	return p.Revoke != nil || p.RevocationURL != "" || p.Discovery
+}
+
+// withDiscovery returns a copy of the provider completed with the discovered
+// endpoints of the issuer.
+func (r *Resolver) withDiscovery(ctx context.Context, p *Provider, issuerURL string) (*Provider, error) {
+	if !p.Discovery {
+		return p, nil
+	}
+	if issuerURL == "" {
+		return nil, fmt.Errorf("provider %s requires an issuer_url", p.Name)
+	}
+	d, err := r.discover(ctx, issuerURL)
+	if err != nil {
+		return nil, err
+	}
+	out := *p
+	out.Endpoint = oauth2.Endpoint{AuthURL: d.AuthorizationEndpoint, TokenURL: d.TokenEndpoint}
+	out.UserInfoURL = d.UserInfoEndpoint
+	out.RevocationURL = d.RevocationEndpoint
+	if len(d.CodeChallengeMethods) > 0 {
+		out.PKCE = false
+		for _, m := range d.CodeChallengeMethods {
+			if m == "S256" {
+				out.PKCE = true
+			}
+		}
+	}
+	return &out, nil
+}
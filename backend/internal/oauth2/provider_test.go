@@ -0,0 +1,288 @@
+package oauth2
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/shadowapi/shadowapi/backend/internal/mockoidc"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+const callbackSecret = "mock-secret"
+
+// mockIssuer serves the mock OIDC provider, discoveries counts the requests
+// of its OpenID configuration.
+type mockIssuer struct {
+	*mockoidc.Server
+	url         string
+	discoveries atomic.Int32
+}
+
+func newMockIssuer(t *testing.T) *mockIssuer {
+	m := &mockIssuer{}
+	var handler http.Handler
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/.well-known/openid-configuration" {
+			m.discoveries.Add(1)
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	server, err := mockoidc.New(srv.URL, "http://app.test/login", callbackSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.Server, m.url, handler = server, srv.URL, server.Handler()
+	return m
+}
+
+func (m *mockIssuer) client(provider string) query.Oauth2Client {
+	return query.Oauth2Client{Name: "test", Provider: provider, ClientID: "shadowapi", Secret: "s3cret", IssuerURL: m.url}
+}
+
+// login runs the authorization flow of the mock up to the code sent to the
+// redirect URL.
+func (m *mockIssuer) login(t *testing.T, cfg *Config, state, verifier string) string {
+	noRedirect := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	location := func(u string) *url.URL {
+		resp, err := noRedirect.Get(u)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		loc, err := resp.Location()
+		if err != nil {
+			t.Fatalf("GET %s: %s without location", u, resp.Status)
+		}
+		return loc
+	}
+
+	loginPage := location(cfg.LoginURL(state, verifier))
+	requestID := loginPage.Query().Get("auth_request_id")
+	claims := base64.URLEncoding.EncodeToString([]byte(`{"email":"ada@example.com","name":"Ada"}`))
+	callback := url.Values{
+		"auth_request_id": {requestID},
+		"subject":         {"user-1"},
+		"claims":          {claims},
+		"signature":       {mockoidc.SignCallback(callbackSecret, requestID, "user-1", claims)},
+	}
+	redirect := location(m.url + "/login/callback?" + callback.Encode())
+	if got := redirect.Query().Get("state"); got != state {
+		t.Fatalf("state = %q, want %q", got, state)
+	}
+	return redirect.Query().Get("code")
+}
+
+func TestRegistry(t *testing.T) {
+	p, ok := Lookup("Google")
+	if !ok || p.Name != "google" || !p.PKCE || !p.CanRevoke() {
+		t.Fatalf("google = %+v", p)
+	}
+	if _, ok := Lookup("myspace"); ok {
+		t.Error("unknown provider found")
+	}
+	var names []string
+	for _, p := range Providers() {
+		names = append(names, p.Name)
+	}
+	if !slices.IsSorted(names) || !slices.Contains(names, "oidc") || !slices.Contains(names, "gmail") {
+		t.Errorf("providers = %v", names)
+	}
+	if ms, _ := Lookup("microsoft"); ms.CanRevoke() {
+		t.Error("microsoft has no revocation")
+	}
+	if gh, _ := Lookup("github"); !gh.CanRevoke() {
+		t.Error("github revokes its own way")
+	}
+
+	Register(&Provider{Name: "test-registry"})
+	defer func() {
+		if recover() == nil {
+			t.Error("registering a name twice did not panic")
+		}
+	}()
+	Register(&Provider{Name: "Test-Registry"})
+}
+
+func TestDiscovery(t *testing.T) {
+	m := newMockIssuer(t)
+	r := NewResolver(&http.Client{Timeout: 5 * time.Second})
+	cfg, err := r.ClientConfig(context.Background(), m.client("oidc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Endpoint.AuthURL != m.url+"/authorize" || cfg.Endpoint.TokenURL != m.url+"/oauth/token" {
+		t.Errorf("endpoint = %+v", cfg.Endpoint)
+	}
+	if cfg.Spec.UserInfoURL != m.url+"/userinfo" || cfg.Spec.RevocationURL != m.url+"/oauth/revoke" || !cfg.Spec.PKCE {
+		t.Errorf("provider = %+v", cfg.Spec)
+	}
+	if cfg.Secret != "s3cret" || !slices.Contains(cfg.Scopes, "offline_access") {
+		t.Errorf("config = %+v", cfg)
+	}
+	// the registered provider is left as it is
+	if p, _ := Lookup("oidc"); p.Endpoint.AuthURL != "" {
+		t.Errorf("registered provider changed: %+v", p.Endpoint)
+	}
+
+	// the configuration is cached by the resolver, with or without a slash
+	client := m.client("zitadel")
+	client.IssuerURL += "/"
+	if _, err := r.ClientConfig(context.Background(), client); err != nil {
+		t.Fatal(err)
+	}
+	if n := m.discoveries.Load(); n != 1 {
+		t.Errorf("discovered %d times, want once", n)
+	}
+	if _, err := NewResolver(http.DefaultClient).ClientConfig(context.Background(), client); err != nil {
+		t.Fatal(err)
+	}
+	if n := m.discoveries.Load(); n != 2 {
+		t.Errorf("another resolver shared the cache, %d discoveries", n)
+	}
+}
+
+func TestDiscoveryErrors(t *testing.T) {
+	m := newMockIssuer(t)
+	r := NewResolver(&http.Client{Timeout: 5 * time.Second})
+	client := m.client("oidc")
+	client.IssuerURL = ""
+	if _, err := r.ClientConfig(context.Background(), client); err == nil || !strings.Contains(err.Error(), "issuer_url") {
+		t.Errorf("err = %v", err)
+	}
+	client.IssuerURL = m.url + "/missing"
+	if _, err := r.ClientConfig(context.Background(), client); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("err = %v", err)
+	}
+	client.Provider = "unknown"
+	if _, err := r.ClientConfig(context.Background(), client); err == nil {
+		t.Error("unknown provider resolved")
+	}
+
+	// the injected client bounds the requests
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(500 * time.Millisecond)
+	}))
+	defer slow.Close()
+	client = m.client("oidc")
+	client.IssuerURL = slow.URL
+	started := time.Now()
+	if _, err := NewResolver(&http.Client{Timeout: 50 * time.Millisecond}).ClientConfig(context.Background(), client); err == nil {
+		t.Error("slow discovery did not time out")
+	}
+	if time.Since(started) > 400*time.Millisecond {
+		t.Errorf("discovery took %s", time.Since(started))
+	}
+}
+
+func TestPKCELogin(t *testing.T) {
+	m := newMockIssuer(t)
+	var mu sync.Mutex
+	paths := map[string]bool{}
+	recording := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		paths[req.URL.Path] = true
+		mu.Unlock()
+		return http.DefaultTransport.RoundTrip(req)
+	})}
+	cfg, err := NewResolver(recording).ClientConfig(context.Background(), m.client("oidc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verifier := oauth2.GenerateVerifier()
+	login, _ := url.Parse(cfg.LoginURL("state-1", verifier))
+	sum := sha256.Sum256([]byte(verifier))
+	if q := login.Query(); q.Get("code_challenge") != base64.RawURLEncoding.EncodeToString(sum[:]) || q.Get("code_challenge_method") != "S256" {
+		t.Errorf("login URL %s lacks the S256 challenge", login)
+	}
+
+	code := m.login(t, cfg, "state-1", verifier)
+	token, err := cfg.ExchangeCode(context.Background(), code, verifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken == "" || token.RefreshToken == "" {
+		t.Fatalf("token = %+v", token)
+	}
+	info, err := cfg.FetchUserInfo(context.Background(), token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Subject != "user-1" || info.Email != "ada@example.com" || info.Name != "Ada" {
+		t.Errorf("user info = %+v", info)
+	}
+	// discovery, exchange and user info all went through the injected client
+	for _, path := range []string{"/.well-known/openid-configuration", "/oauth/token", "/userinfo"} {
+		if !paths[path] {
+			t.Errorf("%s not requested through the injected client", path)
+		}
+	}
+
+	// a code is bound to the verifier of its login
+	code = m.login(t, cfg, "state-2", verifier)
+	if _, err := cfg.ExchangeCode(context.Background(), code, oauth2.GenerateVerifier()); err == nil {
+		t.Error("code exchanged with another verifier")
+	}
+}
+
+func TestRevoke(t *testing.T) {
+	m := newMockIssuer(t)
+	cfg, err := NewResolver(http.DefaultClient).ClientConfig(context.Background(), m.client("oidc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the refresh token ends the whole grant, it is preferred
+	if err := cfg.Revoke(context.Background(), &oauth2.Token{AccessToken: "access", RefreshToken: "refresh"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.Revoke(context.Background(), &oauth2.Token{AccessToken: "access-only"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.Revoke(context.Background(), &oauth2.Token{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := m.Revoked(); !slices.Equal(got, []string{"refresh", "access-only"}) {
+		t.Errorf("revoked = %v", got)
+	}
+
+	// providers without revocation only forget the token
+	ms, _ := Lookup("microsoft")
+	if err := (&Config{Provider: "microsoft", Spec: ms}).Revoke(context.Background(), &oauth2.Token{RefreshToken: "x"}); err != nil {
+		t.Error(err)
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer failing.Close()
+	cfg.Spec = &Provider{Name: "oidc", RevocationURL: failing.URL}
+	if err := cfg.Revoke(context.Background(), &oauth2.Token{RefreshToken: "x"}); err == nil {
+		t.Error("failed revocation returned no error")
+	}
+
+	var custom bool
+	cfg.Spec = &Provider{Name: "custom", Revoke: func(context.Context, *Config, *oauth2.Token) error {
+		custom = true
+		return nil
+	}}
+	if err := cfg.Revoke(context.Background(), &oauth2.Token{AccessToken: "x"}); err != nil || !custom {
+		t.Errorf("custom revocation not used: %v", err)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
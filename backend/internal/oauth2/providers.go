@@ -0,0 +1,126 @@
+package oauth2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/github"
+	googleOAuth2 "golang.org/x/oauth2/google"
+	"golang.org/x/oauth2/linkedin"
+	"golang.org/x/oauth2/microsoft"
+)
+
+// Built-in providers
+func init() {
+	google := Provider{
+		Name:          "google",
+		Title:         "Google",
+		Endpoint:      googleOAuth2.Endpoint,
+		Scopes:        []string{"openid", "https://www.googleapis.com/auth/userinfo.email"},
+		AuthParams:    map[string]string{"access_type": "offline", "prompt": "consent"},
+		PKCE:          true,
+		UserInfoURL:   "https://openidconnect.googleapis.com/v1/userinfo",
+		UserInfo:      oidcUserInfo,
+		RevocationURL: "https://oauth2.googleapis.com/revoke",
+	}
+	Register(&google)
+
+	// gmail is google asking for mailbox access, existing clients use it
+	gmail := google
+	gmail.Name, gmail.Title = "gmail", "Gmail"
+	gmail.Scopes = []string{
+		"openid",
+		"https://mail.google.com/",
+		"https://www.googleapis.com/auth/userinfo.email",
+		"https://www.googleapis.com/auth/gmail.readonly",
+	}
+	Register(&gmail)
+
+	Register(&Provider{
+		Name:     "microsoft",
+		Title:    "Microsoft 365 / Outlook",
+		Endpoint: microsoft.AzureADEndpoint("common"),
+		Scopes: []string{
+			"openid",
+			"email",
+			"offline_access",
+			"https://graph.microsoft.com/User.Read",
+			"https://graph.microsoft.com/Mail.ReadWrite",
+			"https://graph.microsoft.com/Mail.Send",
+		},
+		PKCE:        true,
+		UserInfoURL: "https://graph.microsoft.com/oidc/userinfo",
+		UserInfo:    oidcUserInfo,
+		// the Microsoft identity platform has no revocation endpoint, grants
+		// are removed by the user or an admin
+	})
+
+	Register(&Provider{
+		Name:        "github",
+		Title:       "GitHub",
+		Endpoint:    github.Endpoint,
+		Scopes:      []string{"read:user", "user:email"},
+		UserInfoURL: "https://api.github.com/user",
+		UserInfo:    UserInfoMapping{Subject: "id", Email: "email", Name: "name"},
+		Revoke:      revokeGitHubGrant,
+	})
+
+	Register(&Provider{
+		Name:          "linkedin",
+		Title:         "LinkedIn",
+		Endpoint:      linkedin.Endpoint,
+		Scopes:        []string{"openid", "profile", "email"},
+		UserInfoURL:   "https://api.linkedin.com/v2/userinfo",
+		UserInfo:      oidcUserInfo,
+		RevocationURL: "https://www.linkedin.com/oauth/v2/revoke",
+	})
+
+	Register(&Provider{
+		Name:      "zitadel",
+		Title:     "Zitadel",
+		Discovery: true,
+		Scopes:    []string{"openid", "profile", "email", "offline_access"},
+		PKCE:      true,
+		UserInfo:  oidcUserInfo,
+	})
+
+	Register(&Provider{
+		Name:      "oidc",
+		Title:     "OpenID Connect",
+		Discovery: true,
+		Scopes:    []string{"openid", "profile", "email", "offline_access"},
+		PKCE:      true,
+		UserInfo:  oidcUserInfo,
+	})
+}
+
+// revokeGitHubGrant deletes the authorization of the OAuth app, which
+// revokes every token it issued for the user.
+func revokeGitHubGrant(ctx context.Context, cfg *Config, token *oauth2.Token) error {
+	body, err := json.Marshal(map[string]string{"access_token": token.AccessToken})
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("https://api.github.com/applications/%s/grant", cfg.ClientID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(cfg.ClientID, cfg.ClientSecret)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	resp, err := cfg.httpClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("github revocation returned %s", resp.Status)
+	}
+	return nil
+}
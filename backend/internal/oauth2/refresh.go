@@ -0,0 +1,146 @@
+package oauth2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"golang.org/x/oauth2"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/db"
+	"github.com/shadowapi/shadowapi/backend/internal/metrics"
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// refreshGroup joins the concurrent refreshes of a token within the process.
+var refreshGroup singleflight.Group
+
+// RefreshDue tells whether the stored token has to be refreshed. It is called
+// with the token row locked, after any refresh that ran concurrently.
+type RefreshDue func(row query.Oauth2Token, token *oauth2.Token) bool
+
+// RefreshToken refreshes the stored token when due says so and returns the
+// current token.
+//
+// Refreshes of a token are single-flight: callers within the process share
+// one refresh and other processes wait on the lock of the token row, then see
+// the token refreshed by the first one. Providers rotating refresh tokens
+// therefore never see the same refresh token twice. The refreshed token and
+// its health are stored in the transaction holding the lock.
+func RefreshToken(ctx context.Context, dbp *pgxpool.Pool, cfg *oauth2.Config, tokenUUID uuid.UUID, due RefreshDue) (*oauth2.Token, error) {
+	return refreshToken(ctx, &refreshGroup, pgTokenRepo{dbp: dbp}, cfg, tokenUUID, due)
+}
+
+func refreshToken(ctx context.Context, group *singleflight.Group, repo tokenRepo, cfg *oauth2.Config, tokenUUID uuid.UUID, due RefreshDue) (*oauth2.Token, error) {
+	v, err, _ := group.Do(tokenUUID.String(), func() (any, error) {
+		var (
+			current    *oauth2.Token
+			refreshErr error
+		)
+		err := repo.locked(ctx, tokenUUID, func(tx tokenTx) error {
+			row, token, err := tx.load()
+			if err != nil {
+				return err
+			}
+			if row.Status == TokenStatusNeedsReauth {
+				metrics.TokenRefreshTotal.WithLabelValues(metrics.RefreshNeedsReauth).Inc()
+				refreshErr = ErrNeedsReauth
+				return nil
+			}
+			if !due(row, token) {
+				metrics.TokenRefreshTotal.WithLabelValues(metrics.RefreshNotDue).Inc()
+				current = token
+				return nil
+			}
+			// an expired copy makes the token source use the refresh token
+			expired := *token
+			expired.Expiry = time.Now().Add(-time.Minute)
+			if current, refreshErr = cfg.TokenSource(ctx, &expired).Token(); refreshErr != nil {
+				metrics.TokenRefreshTotal.WithLabelValues(metrics.RefreshFailed).Inc()
+				return tx.failed(refreshErr)
+			}
+			metrics.TokenRefreshTotal.WithLabelValues(metrics.RefreshRefreshed).Inc()
+			return tx.refreshed(cfg.Scopes, row, current)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return current, refreshErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*oauth2.Token), nil
+}
+
+// tokenRepo stores the tokens being refreshed.
+type tokenRepo interface {
+	// locked runs fn with the token locked against other refreshes, changes
+	// made by fn are kept when it returns nil.
+	locked(ctx context.Context, tokenUUID uuid.UUID, fn func(tokenTx) error) error
+}
+
+// tokenTx is the locked token of a tokenRepo.
+type tokenTx interface {
+	load() (query.Oauth2Token, *oauth2.Token, error)
+	refreshed(requested []string, row query.Oauth2Token, token *oauth2.Token) error
+	failed(err error) error
+}
+
+// pgTokenRepo locks the oauth2_token row with SELECT ... FOR UPDATE.
+type pgTokenRepo struct {
+	dbp *pgxpool.Pool
+}
+
+func (r pgTokenRepo) locked(ctx context.Context, tokenUUID uuid.UUID, fn func(tokenTx) error) error {
+	_, err := db.InTx(ctx, r.dbp, func(tx pgx.Tx) (struct{}, error) {
+		return struct{}{}, fn(&pgTokenTx{ctx: ctx, q: query.New(tx), tokenUUID: tokenUUID})
+	})
+	return err
+}
+
+type pgTokenTx struct {
+	ctx       context.Context
+	q         *query.Queries
+	tokenUUID uuid.UUID
+}
+
+func (t *pgTokenTx) load() (query.Oauth2Token, *oauth2.Token, error) {
+	row, err := t.q.GetOauth2TokenForUpdate(t.ctx, converter.UuidToPgUUID(t.tokenUUID))
+	if err != nil {
+		return row, nil, err
+	}
+	token, err := decodeToken(row.Token)
+	return row, token, err
+}
+
+func (t *pgTokenTx) refreshed(requested []string, row query.Oauth2Token, token *oauth2.Token) error {
+	if row.ClientUuid == nil {
+		return errors.New("oauth2 token has no client")
+	}
+	return RecordRefresh(t.ctx, t.q, requested, *row.ClientUuid, t.tokenUUID, token)
+}
+
+func (t *pgTokenTx) failed(err error) error {
+	return RecordFailure(t.ctx, t.q, t.tokenUUID, err)
+}
+
+// decodeToken decrypts and unmarshals the token column.
+func decodeToken(data []byte) (*oauth2.Token, error) {
+	raw, err := secrets.DecryptJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	var token oauth2.Token
+	if err := json.Unmarshal(raw, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
@@ -0,0 +1,209 @@
+package oauth2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"golang.org/x/oauth2"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// rotatingProvider is a token endpoint rotating the refresh token on every
+// refresh, a reused refresh token fails with invalid_grant.
+type rotatingProvider struct {
+	srv *httptest.Server
+
+	mu        sync.Mutex
+	current   string
+	refreshes int
+}
+
+func newRotatingProvider(t *testing.T) *rotatingProvider {
+	p := &rotatingProvider{current: "refresh-0"}
+	p.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "refresh_token" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		// a slow provider widens the window for racing refreshes
+		time.Sleep(50 * time.Millisecond)
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if r.PostForm.Get("refresh_token") != p.current {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		p.refreshes++
+		p.current = fmt.Sprintf("refresh-%d", p.refreshes)
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  fmt.Sprintf("access-%d", p.refreshes),
+			"refresh_token": p.current,
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+		})
+	}))
+	t.Cleanup(p.srv.Close)
+	return p
+}
+
+func (p *rotatingProvider) config() *oauth2.Config {
+	return &oauth2.Config{
+		ClientID: "client",
+		Endpoint: oauth2.Endpoint{TokenURL: p.srv.URL, AuthStyle: oauth2.AuthStyleInParams},
+	}
+}
+
+// memTokenRepo keeps one token in memory, a mutex stands in for the row lock
+// shared by all processes.
+type memTokenRepo struct {
+	mu    sync.Mutex
+	row   query.Oauth2Token
+	token *oauth2.Token
+}
+
+func (r *memTokenRepo) locked(_ context.Context, _ uuid.UUID, fn func(tokenTx) error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tx := &memTokenTx{row: r.row, token: r.token}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	r.row, r.token = tx.row, tx.token
+	return nil
+}
+
+type memTokenTx struct {
+	row   query.Oauth2Token
+	token *oauth2.Token
+}
+
+func (t *memTokenTx) load() (query.Oauth2Token, *oauth2.Token, error) {
+	token := *t.token
+	return t.row, &token, nil
+}
+
+func (t *memTokenTx) refreshed(_ []string, _ query.Oauth2Token, token *oauth2.Token) error {
+	t.token = token
+	t.row.Status = TokenStatusOK
+	t.row.FailureCount = 0
+	return nil
+}
+
+func (t *memTokenTx) failed(err error) error {
+	t.row.FailureCount++
+	t.row.LastError = err.Error()
+	if IsReauthError(err) {
+		t.row.Status = TokenStatusNeedsReauth
+	}
+	return nil
+}
+
+func expiresSoon(_ query.Oauth2Token, token *oauth2.Token) bool {
+	return time.Until(token.Expiry) < 5*time.Minute
+}
+
+func TestRefreshTokenSingleFlight(t *testing.T) {
+	provider := newRotatingProvider(t)
+	cfg := provider.config()
+	repo := &memTokenRepo{
+		row: query.Oauth2Token{Status: TokenStatusOK},
+		token: &oauth2.Token{
+			AccessToken:  "access-0",
+			RefreshToken: "refresh-0",
+			Expiry:       time.Now().Add(-time.Minute),
+		},
+	}
+	tokenUUID := uuid.Must(uuid.NewV7())
+
+	// two processes with their own singleflight groups sharing the token row
+	groups := []*singleflight.Group{{}, {}}
+	const callers = 20
+	var (
+		wg     sync.WaitGroup
+		start  = make(chan struct{})
+		tokens = make([]*oauth2.Token, callers)
+		errs   = make([]error, callers)
+	)
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			tokens[i], errs[i] = refreshToken(context.Background(), groups[i%len(groups)], repo, cfg, tokenUUID, expiresSoon)
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("caller %d: %v", i, err)
+		}
+		if tokens[i].AccessToken != "access-1" {
+			t.Errorf("caller %d got access token %q, want access-1", i, tokens[i].AccessToken)
+		}
+	}
+	if provider.refreshes != 1 {
+		t.Errorf("provider saw %d refreshes, want 1", provider.refreshes)
+	}
+	if repo.token.RefreshToken != "refresh-1" {
+		t.Errorf("stored refresh token %q, want the rotated refresh-1", repo.token.RefreshToken)
+	}
+	if repo.row.FailureCount != 0 {
+		t.Errorf("recorded %d failures: %s", repo.row.FailureCount, repo.row.LastError)
+	}
+}
+
+func TestRefreshTokenNotDue(t *testing.T) {
+	provider := newRotatingProvider(t)
+	repo := &memTokenRepo{
+		row: query.Oauth2Token{Status: TokenStatusOK},
+		token: &oauth2.Token{
+			AccessToken:  "access-0",
+			RefreshToken: "refresh-0",
+			Expiry:       time.Now().Add(time.Hour),
+		},
+	}
+	token, err := refreshToken(context.Background(), &singleflight.Group{}, repo, provider.config(), uuid.Must(uuid.NewV7()), expiresSoon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != "access-0" || provider.refreshes != 0 {
+		t.Errorf("got %q after %d refreshes, want the stored token", token.AccessToken, provider.refreshes)
+	}
+}
+
+func TestRefreshTokenRevoked(t *testing.T) {
+	provider := newRotatingProvider(t)
+	repo := &memTokenRepo{
+		row: query.Oauth2Token{Status: TokenStatusOK},
+		token: &oauth2.Token{
+			AccessToken:  "access-0",
+			RefreshToken: "revoked",
+			Expiry:       time.Now().Add(-time.Minute),
+		},
+	}
+	group := &singleflight.Group{}
+	tokenUUID := uuid.Must(uuid.NewV7())
+	if _, err := refreshToken(context.Background(), group, repo, provider.config(), tokenUUID, expiresSoon); !IsReauthError(err) {
+		t.Fatalf("got %v, want invalid_grant", err)
+	}
+	if repo.row.Status != TokenStatusNeedsReauth || repo.row.FailureCount != 1 {
+		t.Errorf("token status %q with %d failures, want needs_reauth with 1", repo.row.Status, repo.row.FailureCount)
+	}
+	if _, err := refreshToken(context.Background(), group, repo, provider.config(), tokenUUID, expiresSoon); err != ErrNeedsReauth {
+		t.Errorf("got %v, want ErrNeedsReauth", err)
+	}
+}
@@ -4,15 +4,39 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
-	"strings"
+	"net/http"
+	"sync"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/do/v2"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"golang.org/x/oauth2"
+
 	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
 	"github.com/shadowapi/shadowapi/backend/pkg/query"
-	"golang.org/x/oauth2"
-	googleOAuth2 "golang.org/x/oauth2/google"
 )
 
+// DefaultRedirectURL is the callback registered with the providers.
+const DefaultRedirectURL = "http://localhost/api/v1/oauth2/callback"
+
+// httpTimeout bounds each request to a provider.
+const httpTimeout = 15 * time.Second
+
+// Resolver builds the configs of the OAuth2 clients. Its HTTP client makes
+// every request to the providers: discovery, token exchanges and refreshes,
+// user info and revocation.
+type Resolver struct {
+	http        *http.Client
+	discoveries discoveryCache
+}
+
+// NewResolver creates a resolver making its requests with client.
+func NewResolver(client *http.Client) *Resolver {
+	return &Resolver{http: client, discoveries: discoveryCache{entries: map[string]*discovery{}}}
+}
+
 // GetClientConfig returns resolved OAuth2 Config for given oauth2_client uuid.
 func GetClientConfig(ctx context.Context, dbp *pgxpool.Pool, clientID string) (*Config, error) {
 	tx := query.New(dbp)
@@ -27,43 +51,79 @@ func GetClientConfig(ctx context.Context, dbp *pgxpool.Pool, clientID string) (*
 		slog.Error("query oauth2 client", "error", err)
 		return nil, fmt.Errorf("failed to query oauth2 client")
 	}
-	return ResolveClientConfig(row.Oauth2Client)
+	return ResolveClientConfig(ctx, row.Oauth2Client)
 }
 
-// ResolveClientConfig builds *oauth2.Config from DB row.
-func ResolveClientConfig(provider query.Oauth2Client) (*Config, error) {
-	var base oauth2.Config
-
-	switch strings.ToLower(provider.Provider) {
-	case "gmail":
-		base = oauth2.Config{
-			ClientID:     provider.ClientID,
-			ClientSecret: provider.Secret,
-			Endpoint:     googleOAuth2.Endpoint,
-			Scopes: []string{
-				"https://mail.google.com/",
-				"https://www.googleapis.com/auth/userinfo.email",
-				"https://www.googleapis.com/auth/gmail.readonly",
-			},
-		}
-	case "google":
-		base = oauth2.Config{
-			ClientID:     provider.ClientID,
-			ClientSecret: provider.Secret,
-			Endpoint:     googleOAuth2.Endpoint,
-			Scopes:       []string{"https://www.googleapis.com/auth/userinfo.email"},
-		}
-	default:
-		return nil, fmt.Errorf("unknown provider %s", provider.Provider)
+// ResolveClientConfig resolves the config of the client with the default
+// resolver.
+func ResolveClientConfig(ctx context.Context, client query.Oauth2Client) (*Config, error) {
+	return Default().ClientConfig(ctx, client)
+}
+
+// ClientConfig builds *oauth2.Config from DB row and the registered
+// provider it names.
+func (r *Resolver) ClientConfig(ctx context.Context, client query.Oauth2Client) (*Config, error) {
+	provider, ok := Lookup(client.Provider)
+	if !ok {
+		return nil, fmt.Errorf("unknown provider %s", client.Provider)
+	}
+	provider, err := r.withDiscovery(ctx, provider, client.IssuerURL)
+	if err != nil {
+		return nil, err
 	}
 
-	// redirect uri must match google console; default to localhost variant.
-	base.RedirectURL = "http://localhost/api/v1/oauth2/callback"
+	secret, err := secrets.Decrypt(client.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt oauth2 client secret: %w", err)
+	}
 
+	scopes := provider.Scopes
+	if len(client.Scopes) > 0 {
+		scopes = client.Scopes
+	}
 	return &Config{
-		Config:   base,
-		Name:     provider.Name,
-		Provider: provider.Provider,
-		Secret:   provider.Secret,
+		Config: oauth2.Config{
+			ClientID:     client.ClientID,
+			ClientSecret: secret,
+			Endpoint:     provider.Endpoint,
+			Scopes:       scopes,
+			// redirect uri must match the provider console
+			RedirectURL: DefaultRedirectURL,
+		},
+		Name:     client.Name,
+		Provider: provider.Name,
+		Secret:   secret,
+		Spec:     provider,
+		HTTP:     r.http,
 	}, nil
 }
+
+var (
+	defaultMu       sync.RWMutex
+	defaultResolver = NewResolver(&http.Client{Timeout: httpTimeout})
+)
+
+// Default returns the process wide resolver.
+func Default() *Resolver {
+	defaultMu.RLock()
+	defer defaultMu.RUnlock()
+	return defaultResolver
+}
+
+// SetDefault replaces the process wide resolver.
+func SetDefault(r *Resolver) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultResolver = r
+}
+
+// Provide the resolver for the dependency injector and install it as
+// default, its requests are traced
+func Provide(i do.Injector) (*Resolver, error) {
+	r := NewResolver(&http.Client{
+		Timeout:   httpTimeout,
+		Transport: otelhttp.NewTransport(http.DefaultTransport),
+	})
+	SetDefault(r)
+	return r, nil
+}
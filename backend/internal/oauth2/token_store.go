@@ -2,8 +2,6 @@ package oauth2
 
 import (
 	"context"
-	"encoding/json"
-	"github.com/shadowapi/shadowapi/backend/internal/converter"
 	"log/slog"
 	"time"
 
@@ -11,6 +9,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"golang.org/x/oauth2"
 
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
 	"github.com/shadowapi/shadowapi/backend/pkg/query"
 )
 
@@ -40,61 +39,50 @@ func NewTokenStore(
 	}, nil
 }
 
+// Token returns the stored token, refreshing it when it is about to expire.
+// Refreshes are single-flight across goroutines and processes, see
+// RefreshToken. Tokens needing a new login return ErrNeedsReauth.
 func (t *TokenStore) Token() (*oauth2.Token, error) {
-	token, err := t.loadToken()
+	row, token, err := t.loadToken()
 	if err != nil {
 		return nil, err
 	}
-
-	duration := token.Expiry.Sub(time.Now())
-	duration = time.Duration(float64(duration) * 0.3)
-
-	if duration <= t.refreshThreshold {
-		token, err := t.cfg.TokenSource(t.ctx, token).Token()
-		if err != nil {
-			return nil, err
-		}
-
-		if err := t.saveToken(token); err != nil {
-			slog.Error("failed saving token", "error", err)
-			return nil, err
-		}
+	if row.Status == TokenStatusNeedsReauth {
+		return nil, ErrNeedsReauth
+	}
+	if !t.refreshDue(row, token) {
 		return token, nil
 	}
-	return token, nil
-}
-
-func (t *TokenStore) saveToken(token *oauth2.Token) error {
-	tx := query.New(t.dbp)
-	tokenData, err := json.Marshal(token)
+	token, err = RefreshToken(t.ctx, t.dbp, t.cfg, t.tokenUUID, t.refreshDue)
 	if err != nil {
-		slog.Error("failed to marshal token", "error", err)
-		return err
+		slog.Error("failed to refresh token", "uuid", t.tokenUUID, "error", err)
+		return nil, err
 	}
+	return token, nil
+}
 
-	update := query.UpdateOauth2TokenParams{
-		UUID:  converter.UuidToPgUUID(t.tokenUUID),
-		Token: tokenData,
-	}
-	if err := tx.UpdateOauth2Token(t.ctx, update); err != nil {
-		slog.Error("failed to update token", "error", err)
-		return err
+// refreshDue is true once less than the threshold is left of 30% of the
+// remaining lifetime of the token.
+func (t *TokenStore) refreshDue(_ query.Oauth2Token, token *oauth2.Token) bool {
+	if token.Expiry.IsZero() {
+		return false
 	}
-	return nil
+	duration := token.Expiry.Sub(time.Now())
+	duration = time.Duration(float64(duration) * 0.3)
+	return duration <= t.refreshThreshold
 }
 
-func (t *TokenStore) loadToken() (*oauth2.Token, error) {
+func (t *TokenStore) loadToken() (query.Oauth2Token, *oauth2.Token, error) {
 	tx := query.New(t.dbp)
 	tokenData, err := tx.GetOauth2TokenByUUID(t.ctx, converter.UuidToPgUUID(t.tokenUUID))
 	if err != nil {
 		slog.Error("failed to get token by uuid", "uuid", t.tokenUUID)
-		return nil, err
+		return query.Oauth2Token{}, nil, err
 	}
-
-	var token oauth2.Token
-	if err := json.Unmarshal([]byte(tokenData.Oauth2Token.Token), &token); err != nil {
-		slog.Error("failed to unmarshal token", "error", err)
-		return nil, err
+	token, err := decodeToken(tokenData.Oauth2Token.Token)
+	if err != nil {
+		slog.Error("failed to decode token", "uuid", t.tokenUUID, "error", err)
+		return query.Oauth2Token{}, nil, err
 	}
-	return &token, nil
+	return tokenData.Oauth2Token, token, nil
 }
@@ -0,0 +1,138 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ory/ladon"
+
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// RoleSubject is the policy subject of a workspace role.
+func RoleSubject(role string) string {
+	return "role:" + role
+}
+
+// UserSubject is the policy subject of a single user.
+func UserSubject(id string) string {
+	return "user:" + id
+}
+
+// Defaults are the built-in policies implementing the workspace roles:
+//
+//   - owner and admin may do everything, only owners delete a workspace
+//   - operator manages datasources, pipelines, storages and the data, but not
+//     the workspace, its users or the access policies
+//   - readonly may read everything but the audit log
+//   - api_client may use the data APIs, but not manage the workspace
+var Defaults = []*ladon.DefaultPolicy{
+	{
+		ID:          "builtin-owner",
+		Description: "Owners may do everything.",
+		Subjects:    []string{RoleSubject(workspace.RoleOwner)},
+		Resources:   []string{"<.*>"},
+		Actions:     []string{"<.*>"},
+		Effect:      ladon.AllowAccess,
+	},
+	{
+		ID:          "builtin-admin",
+		Description: "Admins may do everything but delete the workspace.",
+		Subjects:    []string{RoleSubject(workspace.RoleAdmin)},
+		Resources:   []string{"<.*>"},
+		Actions:     []string{"<.*>"},
+		Effect:      ladon.AllowAccess,
+	},
+	{
+		ID:          "builtin-deny-workspace-delete",
+		Description: "Only owners delete a workspace.",
+		Subjects:    []string{"role:<(admin|operator|readonly|api_client)>"},
+		Resources:   []string{"shadowapi:workspace<(:.*)?>"},
+		Actions:     []string{"delete"},
+		Effect:      ladon.DenyAccess,
+	},
+	{
+		ID:          "builtin-operator",
+		Description: "Operators manage datasources, pipelines, storages and data.",
+		Subjects:    []string{RoleSubject(workspace.RoleOperator)},
+		Resources:   []string{"<.*>"},
+		Actions:     []string{"<.*>"},
+		Effect:      ladon.AllowAccess,
+	},
+	{
+		ID:          "builtin-deny-management",
+		Description: "Only owners and admins manage workspaces, users, access policies and webhooks.",
+		Subjects:    []string{"role:<(operator|readonly|api_client)>"},
+		Resources:   []string{"shadowapi:<(workspace|user|policy|webhook)(:.*)?>"},
+		Actions:     []string{"<(create|update|delete)>"},
+		Effect:      ladon.DenyAccess,
+	},
+	{
+		ID:          "builtin-deny-audit",
+		Description: "Only owners and admins read the audit log.",
+		Subjects:    []string{"role:<(operator|readonly|api_client)>"},
+		Resources:   []string{"shadowapi:audit<(:.*)?>"},
+		Actions:     []string{"<.*>"},
+		Effect:      ladon.DenyAccess,
+	},
+	{
+		ID:          "builtin-readonly",
+		Description: "Readonly members may read everything.",
+		Subjects:    []string{RoleSubject(workspace.RoleReadOnly)},
+		Resources:   []string{"<.*>"},
+		Actions:     []string{"read"},
+		Effect:      ladon.AllowAccess,
+	},
+	{
+		ID:          "builtin-api-client",
+		Description: "API clients use the data APIs.",
+		Subjects:    []string{RoleSubject(workspace.RoleAPIClient)},
+		Resources: []string{
+			"shadowapi:<(datasource|pipeline|storage|message|file|contact|scheduler|syncpolicy|worker|oauth2|tg)(:.*)?>",
+		},
+		Actions: []string{"<.*>"},
+		Effect:  ladon.AllowAccess,
+	},
+	{
+		ID:          "builtin-self-service",
+		Description: "Every member manages their own profile and sees their workspaces.",
+		Subjects:    []string{"role:<.*>"},
+		Resources:   []string{"shadowapi:<(profile|session)>"},
+		Actions:     []string{"<.*>"},
+		Effect:      ladon.AllowAccess,
+	},
+	{
+		ID:          "builtin-workspace-read",
+		Description: "Every member reads the workspace and its member list.",
+		Subjects:    []string{"role:<.*>"},
+		Resources:   []string{"shadowapi:workspace<(:.*)?>"},
+		Actions:     []string{"read"},
+		Effect:      ladon.AllowAccess,
+	},
+}
+
+// Seed stores the built-in policies missing from the database. Existing ones
+// are left as they are, so they can be customized through the API.
+func (m *Manager) Seed(ctx context.Context) error {
+	q := query.New(m.dbp)
+	for _, p := range Defaults {
+		params, err := toParams(p)
+		if err != nil {
+			return fmt.Errorf("built-in policy %s: %w", p.ID, err)
+		}
+		if err := q.SeedAccessPolicy(ctx, query.SeedAccessPolicyParams{
+			ID:          params.ID,
+			Description: params.Description,
+			Subjects:    params.Subjects,
+			Resources:   params.Resources,
+			Actions:     params.Actions,
+			Effect:      params.Effect,
+			Conditions:  params.Conditions,
+		}); err != nil {
+			return fmt.Errorf("built-in policy %s: %w", p.ID, err)
+		}
+	}
+	m.invalidate()
+	return nil
+}
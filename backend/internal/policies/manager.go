@@ -0,0 +1,241 @@
+package policies
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/ory/ladon"
+
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// cacheTTL bounds how long a change made by another instance takes to apply.
+const cacheTTL = 30 * time.Second
+
+var (
+	// ErrNotFound is returned for an unknown policy id.
+	ErrNotFound = errors.New("access policy not found")
+
+	// ErrBuiltin is returned when deleting a built-in policy, it would be
+	// seeded again on the next start. Update it instead.
+	ErrBuiltin = errors.New("built-in access policies cannot be deleted")
+)
+
+// Manager is a ladon.Manager backed by the access_policy table. Requests are
+// evaluated against an in-memory copy of all policies, refreshed on writes
+// and after cacheTTL.
+type Manager struct {
+	dbp *pgxpool.Pool
+
+	mu       sync.RWMutex
+	cache    ladon.Policies
+	loadedAt time.Time
+}
+
+var _ ladon.Manager = (*Manager)(nil)
+
+// NewManager creates a policy manager.
+func NewManager(dbp *pgxpool.Pool) *Manager {
+	return &Manager{dbp: dbp}
+}
+
+// Create persists the policy.
+func (m *Manager) Create(ctx context.Context, policy ladon.Policy) error {
+	params, err := toParams(policy)
+	if err != nil {
+		return err
+	}
+	_, err = query.New(m.dbp).CreateAccessPolicy(ctx, query.CreateAccessPolicyParams(params))
+	m.invalidate()
+	return err
+}
+
+// Update replaces an existing policy.
+func (m *Manager) Update(ctx context.Context, policy ladon.Policy) error {
+	params, err := toParams(policy)
+	if err != nil {
+		return err
+	}
+	n, err := query.New(m.dbp).UpdateAccessPolicy(ctx, query.UpdateAccessPolicyParams{
+		Description: params.Description,
+		Subjects:    params.Subjects,
+		Resources:   params.Resources,
+		Actions:     params.Actions,
+		Effect:      params.Effect,
+		Conditions:  params.Conditions,
+		Meta:        params.Meta,
+		ID:          params.ID,
+	})
+	m.invalidate()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Get retrieves a policy.
+func (m *Manager) Get(ctx context.Context, id string) (ladon.Policy, error) {
+	row, err := m.GetRow(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return fromRow(row)
+}
+
+// GetRow retrieves the stored policy with its bookkeeping columns.
+func (m *Manager) GetRow(ctx context.Context, id string) (query.AccessPolicy, error) {
+	row, err := query.New(m.dbp).GetAccessPolicy(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return row, ErrNotFound
+	}
+	return row, err
+}
+
+// Delete removes a policy.
+func (m *Manager) Delete(ctx context.Context, id string) error {
+	row, err := m.GetRow(ctx, id)
+	if err != nil {
+		return err
+	}
+	if row.IsBuiltin {
+		return ErrBuiltin
+	}
+	if _, err := query.New(m.dbp).DeleteAccessPolicy(ctx, id); err != nil {
+		return err
+	}
+	m.invalidate()
+	return nil
+}
+
+// GetAll retrieves a page of policies.
+func (m *Manager) GetAll(ctx context.Context, limit, offset int64) (ladon.Policies, error) {
+	rows, err := query.New(m.dbp).GetAccessPolicies(ctx, query.GetAccessPoliciesParams{
+		Offset: int32(offset),
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make(ladon.Policies, 0, len(rows))
+	for _, row := range rows {
+		p, err := fromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+// FindRequestCandidates returns all policies, ladon does the matching.
+func (m *Manager) FindRequestCandidates(ctx context.Context, _ *ladon.Request) (ladon.Policies, error) {
+	return m.all(ctx)
+}
+
+// FindPoliciesForSubject returns all policies, a superset of the matches.
+func (m *Manager) FindPoliciesForSubject(ctx context.Context, _ string) (ladon.Policies, error) {
+	return m.all(ctx)
+}
+
+// FindPoliciesForResource returns all policies, a superset of the matches.
+func (m *Manager) FindPoliciesForResource(ctx context.Context, _ string) (ladon.Policies, error) {
+	return m.all(ctx)
+}
+
+func (m *Manager) all(ctx context.Context) (ladon.Policies, error) {
+	m.mu.RLock()
+	cache, loadedAt := m.cache, m.loadedAt
+	m.mu.RUnlock()
+	if cache != nil && time.Since(loadedAt) < cacheTTL {
+		return cache, nil
+	}
+
+	cache, err := m.GetAll(ctx, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("load access policies: %w", err)
+	}
+	m.mu.Lock()
+	m.cache, m.loadedAt = cache, time.Now()
+	m.mu.Unlock()
+	return cache, nil
+}
+
+func (m *Manager) invalidate() {
+	m.mu.Lock()
+	m.cache = nil
+	m.mu.Unlock()
+}
+
+func toParams(p ladon.Policy) (query.CreateAccessPolicyParams, error) {
+	params := query.CreateAccessPolicyParams{
+		ID:          p.GetID(),
+		Description: p.GetDescription(),
+		Effect:      p.GetEffect(),
+		Meta:        p.GetMeta(),
+	}
+	if params.ID == "" {
+		return params, errors.New("access policy id is required")
+	}
+	if params.Effect != ladon.AllowAccess && params.Effect != ladon.DenyAccess {
+		return params, fmt.Errorf("access policy effect must be %q or %q", ladon.AllowAccess, ladon.DenyAccess)
+	}
+	var err error
+	if params.Subjects, err = json.Marshal(nonNil(p.GetSubjects())); err != nil {
+		return params, err
+	}
+	if params.Resources, err = json.Marshal(nonNil(p.GetResources())); err != nil {
+		return params, err
+	}
+	if params.Actions, err = json.Marshal(nonNil(p.GetActions())); err != nil {
+		return params, err
+	}
+	conditions := p.GetConditions()
+	if conditions == nil {
+		conditions = ladon.Conditions{}
+	}
+	if params.Conditions, err = conditions.MarshalJSON(); err != nil {
+		return params, err
+	}
+	return params, nil
+}
+
+func fromRow(row query.AccessPolicy) (*ladon.DefaultPolicy, error) {
+	p := &ladon.DefaultPolicy{
+		ID:          row.ID,
+		Description: row.Description,
+		Effect:      row.Effect,
+		Conditions:  ladon.Conditions{},
+		Meta:        row.Meta,
+	}
+	if err := json.Unmarshal(row.Subjects, &p.Subjects); err != nil {
+		return nil, fmt.Errorf("access policy %s subjects: %w", row.ID, err)
+	}
+	if err := json.Unmarshal(row.Resources, &p.Resources); err != nil {
+		return nil, fmt.Errorf("access policy %s resources: %w", row.ID, err)
+	}
+	if err := json.Unmarshal(row.Actions, &p.Actions); err != nil {
+		return nil, fmt.Errorf("access policy %s actions: %w", row.ID, err)
+	}
+	if len(row.Conditions) > 0 {
+		if err := p.Conditions.UnmarshalJSON(row.Conditions); err != nil {
+			return nil, fmt.Errorf("access policy %s conditions: %w", row.ID, err)
+		}
+	}
+	return p, nil
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -0,0 +1,104 @@
+package policies
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Actions checked by the built-in policies. Verbs without a mapping, such as
+// run, migrate or cancel, are used as the action as they are.
+const (
+	ActionRead   = "read"
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+// verbs maps the verbs found in operation ids to actions.
+var verbs = map[string]string{
+	"get":      ActionRead,
+	"list":     ActionRead,
+	"query":    ActionRead,
+	"status":   ActionRead,
+	"export":   ActionRead,
+	"create":   ActionCreate,
+	"upload":   ActionCreate,
+	"update":   ActionUpdate,
+	"set":      ActionUpdate,
+	"delete":   ActionDelete,
+	"revoke":   ActionDelete,
+	"refresh":  ActionUpdate,
+	"run":      "run",
+	"retry":    "run",
+	"send":     "send",
+	"migrate":  "migrate",
+	"cancel":   "cancel",
+	"verify":   "verify",
+	"login":    "login",
+	"callback": "callback",
+}
+
+// overrides lists the operations whose id does not follow the
+// <kind>-...-<verb> or <verb><Kind> patterns.
+var overrides = map[string][2]string{
+	"generateDownloadLink":       {"file", ActionRead},
+	"generatePresignedUploadUrl": {"file", ActionCreate},
+	// members are part of the workspace, removing one is an update
+	"workspace-member-list":   {"workspace", ActionRead},
+	"workspace-member-set":    {"workspace", ActionUpdate},
+	"workspace-member-delete": {"workspace", ActionUpdate},
+}
+
+// kinds folds plural and alternative names into one resource kind.
+var kinds = map[string]string{
+	"contacts": "contact",
+	"users":    "user",
+}
+
+// Operation returns the resource kind and the action of an API operation,
+// e.g. pipeline-update is (pipeline, update) and listContacts is
+// (contact, read).
+func Operation(operationID string) (kind, action string) {
+	if o, ok := overrides[operationID]; ok {
+		return o[0], o[1]
+	}
+	for _, part := range strings.Split(kebab(operationID), "-") {
+		if a, ok := verbs[part]; ok {
+			if action == "" {
+				action = a
+			}
+			continue
+		}
+		if kind == "" {
+			kind = part
+		}
+	}
+	if k, ok := kinds[kind]; ok {
+		kind = k
+	}
+	return kind, action
+}
+
+// Resource returns the policy resource of a kind, narrowed to a single
+// object when id is set: shadowapi:<kind>[:<id>].
+func Resource(kind, id string) string {
+	if id == "" {
+		return "shadowapi:" + kind
+	}
+	return "shadowapi:" + kind + ":" + id
+}
+
+// kebab converts camelCase operation ids to kebab case.
+func kebab(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('-')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,79 @@
+// Package policies authorizes API operations with ory/ladon policies stored in
+// Postgres.
+//
+// Every operation maps to an action on a resource, see Operation. A request is
+// evaluated once per subject of the caller, "role:<workspace role>" and
+// "user:<uuid>": an explicit deny for any subject wins, otherwise one allow is
+// enough.
+package policies
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/ory/ladon"
+	"github.com/samber/do/v2"
+)
+
+// ErrDenied is returned when no policy allows the request or one denies it.
+var ErrDenied = errors.New("access denied")
+
+// Enforcer checks requests against the stored policies.
+type Enforcer struct {
+	log     *slog.Logger
+	manager *Manager
+	ladon   *ladon.Ladon
+}
+
+// NewEnforcer creates an enforcer evaluating the policies of the manager.
+func NewEnforcer(log *slog.Logger, manager *Manager) *Enforcer {
+	return &Enforcer{
+		log:     log,
+		manager: manager,
+		ladon:   &ladon.Ladon{Manager: manager},
+	}
+}
+
+// Manager returns the policy store of the enforcer.
+func (e *Enforcer) Manager() *Manager {
+	return e.manager
+}
+
+// Authorize returns nil when one of the subjects may perform the action on
+// the resource and none is explicitly denied.
+func (e *Enforcer) Authorize(ctx context.Context, subjects []string, resource, action string, rctx ladon.Context) error {
+	allowed := false
+	for _, subject := range subjects {
+		err := e.ladon.IsAllowed(ctx, &ladon.Request{
+			Subject:  subject,
+			Resource: resource,
+			Action:   action,
+			Context:  rctx,
+		})
+		switch {
+		case err == nil:
+			allowed = true
+		case errors.Is(err, ladon.ErrRequestForcefullyDenied):
+			return ErrDenied
+		case !errors.Is(err, ladon.ErrRequestDenied):
+			return err
+		}
+	}
+	if !allowed {
+		return ErrDenied
+	}
+	return nil
+}
+
+// Provide the policy enforcer for the dependency injector and seed the
+// built-in policies
+func Provide(i do.Injector) (*Enforcer, error) {
+	log := do.MustInvoke[*slog.Logger](i).With("service", "policies")
+	manager := NewManager(do.MustInvoke[*pgxpool.Pool](i))
+	if err := manager.Seed(context.Background()); err != nil {
+		log.Error("failed to seed built-in access policies", "error", err)
+	}
+	return NewEnforcer(log, manager), nil
+}
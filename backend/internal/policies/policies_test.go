@@ -0,0 +1,158 @@
+package policies
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ory/ladon"
+	"github.com/ory/ladon/manager/memory"
+
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
+)
+
+func TestOperation(t *testing.T) {
+	tests := []struct {
+		operationID  string
+		kind, action string
+	}{
+		{"apikey-create", "apikey", ActionCreate},
+		{"apikey-list", "apikey", ActionRead},
+		{"apikey-revoke", "apikey", ActionDelete},
+		{"pipeline-update", "pipeline", ActionUpdate},
+		{"pipeline-run", "pipeline", "run"},
+		{"datasource-email-oauth-get", "datasource", ActionRead},
+		{"datasource-set-oauth2-client", "datasource", ActionUpdate},
+		{"datasource-email-graph-send", "datasource", "send"},
+		{"storage-migrate", "storage", "migrate"},
+		{"storage-migration-list", "storage", ActionRead},
+		{"retention-policy-run", "retention", "run"},
+		{"worker-jobs-retry", "worker", "run"},
+		{"worker-jobs-cancel", "worker", "cancel"},
+		{"oauth2-client-token-refresh", "oauth2", ActionUpdate},
+		{"oauth2-client-callback", "oauth2", "callback"},
+		{"tg-session-verify", "tg", "verify"},
+		{"audit-export", "audit", ActionRead},
+		{"policy-delete", "policy", ActionDelete},
+		{"webhook-create", "webhook", ActionCreate},
+		{"messageEmailQuery", "message", ActionRead},
+		{"listContacts", "contact", ActionRead},
+		{"createUser", "user", ActionCreate},
+		{"getProfile", "profile", ActionRead},
+		{"uploadFile", "file", ActionCreate},
+		{"generateDownloadLink", "file", ActionRead},
+		{"generatePresignedUploadUrl", "file", ActionCreate},
+		{"workspace-member-list", "workspace", ActionRead},
+		{"workspace-member-set", "workspace", ActionUpdate},
+		{"workspace-member-delete", "workspace", ActionUpdate},
+		{"workspace-delete", "workspace", ActionDelete},
+		{"session-revoke-all", "session", ActionDelete},
+	}
+	for _, tt := range tests {
+		kind, action := Operation(tt.operationID)
+		if kind != tt.kind || action != tt.action {
+			t.Errorf("Operation(%q) = (%s, %s), want (%s, %s)", tt.operationID, kind, action, tt.kind, tt.action)
+		}
+	}
+}
+
+func TestResource(t *testing.T) {
+	if got := Resource("pipeline", ""); got != "shadowapi:pipeline" {
+		t.Errorf("resource = %s", got)
+	}
+	if got := Resource("pipeline", "0198c1b0"); got != "shadowapi:pipeline:0198c1b0" {
+		t.Errorf("resource = %s", got)
+	}
+}
+
+// defaultEnforcer evaluates the built-in policies from memory.
+func defaultEnforcer(t *testing.T) *Enforcer {
+	manager := memory.NewMemoryManager()
+	for _, p := range Defaults {
+		if err := manager.Create(context.Background(), p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return &Enforcer{ladon: &ladon.Ladon{Manager: manager}}
+}
+
+func TestDefaults(t *testing.T) {
+	e := defaultEnforcer(t)
+	tests := []struct {
+		role     string
+		kind     string
+		action   string
+		id       string
+		allowed  bool
+		describe string
+	}{
+		{workspace.RoleOwner, "workspace", ActionDelete, "", true, "owners delete the workspace"},
+		{workspace.RoleOwner, "audit", ActionRead, "", true, "owners read the audit log"},
+		{workspace.RoleAdmin, "workspace", ActionDelete, "", false, "only owners delete the workspace"},
+		{workspace.RoleAdmin, "workspace", ActionUpdate, "", true, "admins manage the members"},
+		{workspace.RoleAdmin, "policy", ActionCreate, "", true, "admins manage the policies"},
+		{workspace.RoleAdmin, "audit", ActionRead, "", true, "admins read the audit log"},
+
+		{workspace.RoleOperator, "pipeline", ActionUpdate, "0198c1b0", true, "operators manage pipelines"},
+		{workspace.RoleOperator, "storage", "migrate", "", true, "operators migrate storages"},
+		{workspace.RoleOperator, "apikey", ActionCreate, "", true, "operators create their own keys"},
+		{workspace.RoleOperator, "apikey", ActionRead, "", true, "operators list their own keys"},
+		{workspace.RoleOperator, "apikey", ActionDelete, "0198c1b0", true, "operators revoke their own keys"},
+		{workspace.RoleOperator, "workspace", ActionUpdate, "", false, "operators do not manage the members"},
+		{workspace.RoleOperator, "user", ActionCreate, "", false, "operators do not create users"},
+		{workspace.RoleOperator, "policy", ActionUpdate, "", false, "operators do not change the policies"},
+		{workspace.RoleOperator, "webhook", ActionCreate, "", false, "operators do not create webhooks"},
+		{workspace.RoleOperator, "webhook", ActionRead, "", true, "operators read the webhooks"},
+		{workspace.RoleOperator, "audit", ActionRead, "", false, "operators do not read the audit log"},
+
+		{workspace.RoleReadOnly, "message", ActionRead, "", true, "readonly members read the data"},
+		{workspace.RoleReadOnly, "pipeline", ActionUpdate, "", false, "readonly members change nothing"},
+		{workspace.RoleReadOnly, "apikey", ActionCreate, "", false, "readonly members create no keys"},
+		{workspace.RoleReadOnly, "audit", ActionRead, "", false, "readonly members do not read the audit log"},
+
+		{workspace.RoleAPIClient, "message", ActionRead, "", true, "api clients read the data"},
+		{workspace.RoleAPIClient, "datasource", "send", "", true, "api clients send messages"},
+		{workspace.RoleAPIClient, "pipeline", "run", "0198c1b0", true, "api clients run pipelines"},
+		{workspace.RoleAPIClient, "apikey", ActionCreate, "", false, "api clients create no keys"},
+		{workspace.RoleAPIClient, "policy", ActionRead, "", false, "api clients do not read the policies"},
+		{workspace.RoleAPIClient, "workspace", ActionUpdate, "", false, "api clients do not manage the workspace"},
+
+		{workspace.RoleAPIClient, "profile", ActionUpdate, "", true, "every member manages their profile"},
+		{workspace.RoleReadOnly, "session", ActionDelete, "", true, "every member revokes their sessions"},
+		{workspace.RoleAPIClient, "workspace", ActionRead, "", true, "every member reads the workspace"},
+		{"unknown", "message", ActionRead, "", false, "unknown roles get nothing"},
+	}
+	for _, tt := range tests {
+		err := e.Authorize(context.Background(), []string{RoleSubject(tt.role), UserSubject("0198c1b0-0000-7000-8000-000000000001")},
+			Resource(tt.kind, tt.id), tt.action, ladon.Context{})
+		if err != nil && !errors.Is(err, ErrDenied) {
+			t.Fatalf("%s: %v", tt.describe, err)
+		}
+		if allowed := err == nil; allowed != tt.allowed {
+			t.Errorf("%s: %s %s on %s allowed = %v", tt.describe, tt.role, tt.action, tt.kind, allowed)
+		}
+	}
+}
+
+func TestRoleCovers(t *testing.T) {
+	tests := []struct {
+		role, other string
+		want        bool
+	}{
+		{workspace.RoleOwner, workspace.RoleAdmin, true},
+		{workspace.RoleAdmin, workspace.RoleAdmin, true},
+		{workspace.RoleAdmin, workspace.RoleOwner, false},
+		{workspace.RoleAdmin, workspace.RoleAPIClient, true},
+		{workspace.RoleOperator, workspace.RoleAdmin, false},
+		{workspace.RoleOperator, workspace.RoleReadOnly, true},
+		{workspace.RoleReadOnly, workspace.RoleAPIClient, false},
+		{workspace.RoleAPIClient, workspace.RoleReadOnly, false},
+		{workspace.RoleOwner, "root", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		if got := workspace.RoleCovers(tt.role, tt.other); got != tt.want {
+			t.Errorf("RoleCovers(%q, %q) = %v, want %v", tt.role, tt.other, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Status is the state of the connection to the NATS server.
+func (q *Queue) Status() nats.Status {
+	return q.nc.Status()
+}
+
+// StreamInfo returns the state of the stream.
+func (q *Queue) StreamInfo(ctx context.Context, stream string) (*jetstream.StreamInfo, error) {
+	s, err := q.js.Stream(ctx, stream)
+	if err != nil {
+		return nil, err
+	}
+	return s.Info(ctx)
+}
+
+// ConsumerInfo returns the state of a durable consumer of the stream, named
+// as in Consume.
+func (q *Queue) ConsumerInfo(ctx context.Context, stream, durable string) (*jetstream.ConsumerInfo, error) {
+	c, err := q.js.Consumer(ctx, stream, fmt.Sprintf("%s-%s", q.cfg.Queue.Prefix, durable))
+	if err != nil {
+		return nil, err
+	}
+	return c.Info(ctx)
+}
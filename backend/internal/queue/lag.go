@@ -0,0 +1,76 @@
+package queue
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// lagTimeout bounds the listing of the consumers on a scrape
+const lagTimeout = 5 * time.Second
+
+var (
+	lagPendingDesc = prometheus.NewDesc(
+		"queue_consumer_pending_messages",
+		"Number of messages of the stream not yet delivered to the consumer",
+		[]string{"stream", "consumer"}, nil,
+	)
+	lagAckPendingDesc = prometheus.NewDesc(
+		"queue_consumer_ack_pending_messages",
+		"Number of messages delivered to the consumer and not acknowledged yet",
+		[]string{"stream", "consumer"}, nil,
+	)
+	lagRedeliveredDesc = prometheus.NewDesc(
+		"queue_consumer_redelivered_messages",
+		"Number of messages redelivered to the consumer",
+		[]string{"stream", "consumer"}, nil,
+	)
+)
+
+// LagCollector exports the lag of every consumer of the streams, read from
+// NATS on each scrape.
+type LagCollector struct {
+	log     *slog.Logger
+	queue   func() (*Queue, error)
+	streams []string
+}
+
+// NewLagCollector returns a collector of the streams. The queue is resolved on
+// the first scrape, NATS being down only leaves the lag out.
+func NewLagCollector(log *slog.Logger, queue func() (*Queue, error), streams ...string) *LagCollector {
+	return &LagCollector{log: log, queue: queue, streams: streams}
+}
+
+func (c *LagCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- lagPendingDesc
+	ch <- lagAckPendingDesc
+	ch <- lagRedeliveredDesc
+}
+
+func (c *LagCollector) Collect(ch chan<- prometheus.Metric) {
+	q, err := c.queue()
+	if err != nil {
+		c.log.Debug("queue lag not collected", "error", err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), lagTimeout)
+	defer cancel()
+	for _, name := range c.streams {
+		stream, err := q.js.Stream(ctx, name)
+		if err != nil {
+			c.log.Debug("queue lag not collected", "stream", name, "error", err)
+			continue
+		}
+		consumers := stream.ListConsumers(ctx)
+		for info := range consumers.Info() {
+			ch <- prometheus.MustNewConstMetric(lagPendingDesc, prometheus.GaugeValue, float64(info.NumPending), name, info.Name)
+			ch <- prometheus.MustNewConstMetric(lagAckPendingDesc, prometheus.GaugeValue, float64(info.NumAckPending), name, info.Name)
+			ch <- prometheus.MustNewConstMetric(lagRedeliveredDesc, prometheus.GaugeValue, float64(info.NumRedelivered), name, info.Name)
+		}
+		if err := consumers.Err(); err != nil {
+			c.log.Debug("listing consumers failed", "stream", name, "error", err)
+		}
+	}
+}
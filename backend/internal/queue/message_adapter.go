@@ -43,3 +43,12 @@ func (a *NatsMsgAdapter) InProgress() error {
 func (a *NatsMsgAdapter) GetHeader(key string) string {
 	return a.natsMsg.Header.Get(key)
 }
+
+// MsgHeader returns the headers of a jetstream message, nil for messages
+// without headers.
+func MsgHeader(m Msg) nats.Header {
+	if h, ok := m.(interface{ Headers() nats.Header }); ok {
+		return h.Headers()
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"log/slog"
 
 	"github.com/shadowapi/shadowapi/backend/internal/config"
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
 )
 
 // Queue service
@@ -60,10 +61,16 @@ func Provide(injector do.Injector) (*Queue, error) {
 func (q *Queue) Publish(ctx context.Context, subject string, data []byte) error {
 	log := q.log.With("method", "publish", "subject", subject)
 	log.Debug("publish message")
-	_, err := q.js.PublishMsg(ctx, &nats.Msg{
+	msg := &nats.Msg{
 		Subject: subject,
+		Header:  make(nats.Header),
 		Data:    data,
-	})
+	}
+	if id, ok := workspace.HeaderValue(ctx); ok {
+		msg.Header.Set(workspace.Header, id)
+	}
+	injectTrace(ctx, msg.Header)
+	_, err := q.js.PublishMsg(ctx, msg)
 	if err != nil {
 		log.Error("failed to publish message", "error", err)
 		return err
@@ -79,12 +86,36 @@ func (q *Queue) PublishWithHeaders(ctx context.Context, subject string, headers
 	for k, v := range headers {
 		msg.Header.Set(k, v)
 	}
+	// jobs run in the workspace of whoever enqueued them
+	if id, ok := workspace.HeaderValue(ctx); ok && msg.Header.Get(workspace.Header) == "" {
+		msg.Header.Set(workspace.Header, id)
+	}
+	injectTrace(ctx, msg.Header)
 
 	// Correct argument order: msg first, then options
 	_, err := q.js.PublishMsg(ctx, msg)
 	return err
 }
 
+// Broadcast sends data to every current subscriber of subject, without
+// persisting it in a stream.
+func (q *Queue) Broadcast(subject string, data []byte) error {
+	return q.nc.Publish(subject, data)
+}
+
+// Subscribe calls handler with the data broadcast to subject until the
+// returned func is called.
+func (q *Queue) Subscribe(subject string, handler func(data []byte)) (unsubscribe func(), err error) {
+	sub, err := q.nc.Subscribe(subject, func(msg *nats.Msg) {
+		handler(msg.Data)
+	})
+	if err != nil {
+		q.log.Error("failed to subscribe", "subject", subject, "error", err)
+		return nil, err
+	}
+	return func() { _ = sub.Unsubscribe() }, nil
+}
+
 // Ensure that stream created
 func (q *Queue) Ensure(ctx context.Context, stream string, subjects []string) error {
 	log := q.log.With("method", "ensure", "stream", stream, slog.Any("subjects", subjects))
@@ -131,3 +162,10 @@ func (q *Queue) Consume(
 	}
 	return cc.Stop, nil
 }
+
+// Shutdown flushes the pending publishes, such as the acknowledgements of the
+// last jobs, and closes the connection.
+func (q *Queue) Shutdown(ctx context.Context) error {
+	defer q.nc.Close()
+	return q.nc.FlushWithContext(ctx)
+}
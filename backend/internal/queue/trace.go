@@ -0,0 +1,26 @@
+package queue
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// injectTrace adds the trace context of ctx to the headers, next to X-Job-ID,
+// so that the spans of the job continue the trace of whoever published it.
+func injectTrace(ctx context.Context, header nats.Header) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(http.Header(header)))
+}
+
+// TraceContext returns ctx carrying the trace context found in the headers of
+// the message.
+func TraceContext(ctx context.Context, m Msg) context.Context {
+	header := MsgHeader(m)
+	if header == nil {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(http.Header(header)))
+}
@@ -0,0 +1,375 @@
+package retention
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
+	"github.com/shadowapi/shadowapi/backend/internal/storages"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// Erasure modes
+const (
+	ErasureModeDelete    = "delete"
+	ErasureModeAnonymize = "anonymize"
+)
+
+// erasedPlaceholder replaces the subject in anonymised messages.
+const erasedPlaceholder = "[erased]"
+
+// ErrInvalidSubject is returned when the subject is neither an email address
+// nor a phone number.
+var ErrInvalidSubject = errors.New("subject must be an email address or a phone number")
+
+// ErasureReport lists everything an erasure touched. The subject is kept out
+// of the stored report, recording it would keep what was erased.
+type ErasureReport struct {
+	UUID               string   `json:"uuid,omitempty"`
+	Subject            string   `json:"-"`
+	Mode               string   `json:"mode"`
+	DryRun             bool     `json:"dry_run"`
+	MessageUUIDs       []string `json:"message_uuids"`
+	FileUUIDs          []string `json:"file_uuids"`
+	ContactUUIDs       []string `json:"contact_uuids"`
+	MessagesDeleted    int64    `json:"messages_deleted"`
+	MessagesAnonymized int64    `json:"messages_anonymized"`
+	FilesDeleted       int64    `json:"files_deleted"`
+	BytesDeleted       int64    `json:"bytes_deleted"`
+	ContactsDeleted    int64    `json:"contacts_deleted"`
+	ContactsAnonymized int64    `json:"contacts_anonymized"`
+}
+
+// SubjectPattern builds the case-insensitive Postgres regex matching an email
+// address or a phone number. Phone digits may be separated by spaces, dots,
+// dashes or brackets, so "+1 (555) 010-9999" matches "15550109999".
+func SubjectPattern(subject string) (string, error) {
+	subject = strings.TrimSpace(subject)
+	if strings.Contains(subject, "@") {
+		if strings.ContainsAny(subject, " \t,;<>") {
+			return "", ErrInvalidSubject
+		}
+		// do not match inside a longer address, "bob@x.com" must not hit "jimbob@x.com"
+		return `(?<![a-z0-9._%+-])` + regexp.QuoteMeta(strings.ToLower(subject)) + `(?![a-z0-9-]|\.[a-z0-9])`, nil
+	}
+	var digits []string
+	for _, r := range subject {
+		switch {
+		case r >= '0' && r <= '9':
+			digits = append(digits, string(r))
+		case strings.ContainsRune("+ ().-", r):
+		default:
+			return "", ErrInvalidSubject
+		}
+	}
+	if len(digits) < 5 {
+		return "", ErrInvalidSubject
+	}
+	return `(?<![0-9])` + strings.Join(digits, `[ ().-]{0,2}`) + `(?![0-9])`, nil
+}
+
+// matchSubject returns the spans of text mentioning the subject, the
+// counterpart of SubjectPattern for content read from storages. Go regexps
+// have no lookarounds, the boundaries are checked by hand. The subject must
+// have passed SubjectPattern.
+func matchSubject(subject, text string) [][]int {
+	subject = strings.TrimSpace(subject)
+	var core string
+	var bounded func(start, end int) bool
+	if strings.Contains(subject, "@") {
+		core = `(?i)` + regexp.QuoteMeta(subject)
+		bounded = func(start, end int) bool {
+			if start > 0 && (isAlnum(text[start-1]) || strings.IndexByte("._%+-", text[start-1]) >= 0) {
+				return false
+			}
+			if end < len(text) && (isAlnum(text[end]) || text[end] == '-' ||
+				text[end] == '.' && end+1 < len(text) && isAlnum(text[end+1])) {
+				return false
+			}
+			return true
+		}
+	} else {
+		var digits []string
+		for _, r := range subject {
+			if r >= '0' && r <= '9' {
+				digits = append(digits, string(r))
+			}
+		}
+		core = strings.Join(digits, `[ ().-]{0,2}`)
+		bounded = func(start, end int) bool {
+			return (start == 0 || !isDigit(text[start-1])) && (end == len(text) || !isDigit(text[end]))
+		}
+	}
+	var spans [][]int
+	for _, m := range regexp.MustCompile(core).FindAllStringIndex(text, -1) {
+		if bounded(m[0], m[1]) {
+			spans = append(spans, m)
+		}
+	}
+	return spans
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func isAlnum(c byte) bool { return isDigit(c) || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' }
+
+// SubjectDigest returns what an erasure request records of its subject: the
+// keyed digest of the normalised address or number, so a later request for
+// the same subject can be matched without storing it. Without a secrets
+// master key nothing of the subject is recorded.
+func SubjectDigest(subject string) string {
+	subject = strings.ToLower(strings.TrimSpace(subject))
+	if !strings.Contains(subject, "@") {
+		subject = strings.Map(func(r rune) rune {
+			if r >= '0' && r <= '9' {
+				return r
+			}
+			return -1
+		}, subject)
+	}
+	digest, err := secrets.Digest(subject)
+	if err != nil {
+		return secrets.Redacted
+	}
+	return digest
+}
+
+// Erase finds every message, file and contact referencing the subject and
+// deletes or anonymises it. Files are always deleted, binary content can not
+// be anonymised. With dryRun nothing is changed and no request is recorded.
+func (e *Enforcer) Erase(ctx context.Context, subject, mode string, dryRun bool) (ErasureReport, error) {
+	report := ErasureReport{
+		Subject:      strings.TrimSpace(subject),
+		Mode:         mode,
+		DryRun:       dryRun,
+		MessageUUIDs: []string{},
+		FileUUIDs:    []string{},
+		ContactUUIDs: []string{},
+	}
+	if mode != ErasureModeDelete && mode != ErasureModeAnonymize {
+		return report, fmt.Errorf("unknown erasure mode %q", mode)
+	}
+	pattern, err := SubjectPattern(subject)
+	if err != nil {
+		return report, err
+	}
+	log := e.log.With("erasure_mode", mode, "dry_run", dryRun)
+	startedAt := time.Now().UTC()
+
+	eraseErr := e.erase(ctx, pattern, &report)
+	if dryRun {
+		return report, eraseErr
+	}
+
+	status := "done"
+	var errText pgtype.Text
+	if eraseErr != nil {
+		status = "failed"
+		errText = pgtype.Text{String: eraseErr.Error(), Valid: true}
+	}
+	requestUUID := uuid.Must(uuid.NewV7())
+	report.UUID = requestUUID.String()
+	data, err := json.Marshal(report)
+	if err != nil {
+		return report, err
+	}
+	// record the request even when the run context was cancelled half way,
+	// in the workspace the erasure ran in
+	_, err = query.New(e.db).CreateErasureRequest(context.WithoutCancel(ctx), query.CreateErasureRequestParams{
+		UUID:      converter.UuidToPgUUID(requestUUID),
+		Subject:   SubjectDigest(report.Subject),
+		Mode:      mode,
+		Status:    status,
+		Report:    data,
+		Error:     errText,
+		CreatedAt: pgtype.Timestamptz{Time: startedAt, Valid: true},
+	})
+	if err != nil {
+		log.Error("failed to record erasure request", "error", err)
+	}
+	log.Info("subject erasure finished", "erasure_uuid", requestUUID, "status", status,
+		"messages", len(report.MessageUUIDs), "files", len(report.FileUUIDs), "contacts", len(report.ContactUUIDs))
+	return report, eraseErr
+}
+
+func (e *Enforcer) erase(ctx context.Context, pattern string, report *ErasureReport) error {
+	q := query.New(e.db)
+	blobs := storages.NewBlobResolver(q)
+
+	messageIDs, err := q.FindMessageUUIDsBySubject(ctx, pattern)
+	if err != nil {
+		return fmt.Errorf("find messages: %w", err)
+	}
+	bodies, bodyFileIDs, err := findBodies(ctx, q, blobs, report.Subject)
+	if err != nil {
+		return fmt.Errorf("find message bodies: %w", err)
+	}
+	for _, b := range bodies {
+		if !slices.Contains(messageIDs, b.messageUUID) {
+			messageIDs = append(messageIDs, b.messageUUID)
+		}
+	}
+	pgMessageIDs := toPgUUIDs(messageIDs)
+	for _, id := range messageIDs {
+		report.MessageUUIDs = append(report.MessageUUIDs, id.String())
+	}
+
+	var files []query.File
+	if len(messageIDs) > 0 {
+		rows, err := q.GetFilesByMessageUUIDs(ctx, pgMessageIDs)
+		if err != nil {
+			return fmt.Errorf("find files: %w", err)
+		}
+		for _, row := range rows {
+			// anonymised messages keep their body, it is rewritten below
+			if report.Mode == ErasureModeAnonymize && bodyFileIDs[row.File.UUID] {
+				continue
+			}
+			files = append(files, row.File)
+			report.FileUUIDs = append(report.FileUUIDs, row.File.UUID.String())
+		}
+	}
+	contactIDs, err := q.FindContactUUIDsBySubject(ctx, pattern)
+	if err != nil {
+		return fmt.Errorf("find contacts: %w", err)
+	}
+	contactStrIDs := make([]string, 0, len(contactIDs))
+	for _, id := range contactIDs {
+		contactStrIDs = append(contactStrIDs, id.String())
+	}
+	report.ContactUUIDs = append(report.ContactUUIDs, contactStrIDs...)
+
+	if report.DryRun {
+		return nil
+	}
+
+	res, err := DeleteFiles(ctx, e.db, blobs, files)
+	report.FilesDeleted, report.BytesDeleted = res.FilesDeleted, res.BytesDeleted
+	if err != nil {
+		return err
+	}
+
+	if len(messageIDs) > 0 {
+		if report.Mode == ErasureModeDelete {
+			report.MessagesDeleted, err = q.DeleteMessagesByUUIDs(ctx, pgMessageIDs)
+		} else {
+			report.MessagesAnonymized, err = q.AnonymizeMessages(ctx, query.AnonymizeMessagesParams{
+				Pattern:     pattern,
+				Replacement: erasedPlaceholder,
+				Uuids:       pgMessageIDs,
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("erase messages: %w", err)
+		}
+	}
+	if report.Mode == ErasureModeAnonymize {
+		for _, b := range bodies {
+			if err := anonymizeBody(ctx, q, blobs, b, report.Subject); err != nil {
+				return fmt.Errorf("erase message %s body: %w", b.messageUUID, err)
+			}
+		}
+	}
+
+	if len(contactStrIDs) > 0 {
+		if report.Mode == ErasureModeDelete {
+			report.ContactsDeleted, err = q.DeleteContactsByUUIDs(ctx, contactStrIDs)
+		} else {
+			report.ContactsAnonymized, err = q.AnonymizeContacts(ctx, query.AnonymizeContactsParams{
+				Pattern: pattern,
+				Uuids:   contactStrIDs,
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("erase contacts: %w", err)
+		}
+	}
+	return nil
+}
+
+// body is the content of a message body moved to a storage.
+type body struct {
+	messageUUID uuid.UUID
+	file        query.File
+	text        string
+}
+
+// findBodies returns the moved message bodies mentioning the subject, with
+// the UUIDs of every body file. SQL can not search content kept outside the
+// database, so every body file is read.
+func findBodies(ctx context.Context, q *query.Queries, blobs *storages.BlobResolver, subject string) ([]body, map[uuid.UUID]bool, error) {
+	var found []body
+	fileIDs := make(map[uuid.UUID]bool)
+	var after pgtype.UUID
+	for {
+		rows, err := q.GetMessageBodyFiles(ctx, query.GetMessageBodyFilesParams{AfterUuid: after, Limit: batchSize})
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(rows) == 0 {
+			return found, fileIDs, nil
+		}
+		for _, row := range rows {
+			after = converter.UuidToPgUUID(row.MessageUuid)
+			fileIDs[row.File.UUID] = true
+			blob, err := blobs.ForFile(ctx, row.File)
+			if err != nil {
+				return nil, nil, err
+			}
+			data, err := blob.Get(ctx, row.File)
+			if err != nil {
+				return nil, nil, fmt.Errorf("read file %s: %w", row.File.UUID, err)
+			}
+			if text := string(data); len(matchSubject(subject, text)) > 0 {
+				found = append(found, body{messageUUID: row.MessageUuid, file: row.File, text: text})
+			}
+		}
+	}
+}
+
+// anonymizeBody rewrites a moved body with the subject replaced.
+func anonymizeBody(ctx context.Context, q *query.Queries, blobs *storages.BlobResolver, b body, subject string) error {
+	var out strings.Builder
+	last := 0
+	for _, span := range matchSubject(subject, b.text) {
+		out.WriteString(b.text[last:span[0]])
+		out.WriteString(erasedPlaceholder)
+		last = span[1]
+	}
+	out.WriteString(b.text[last:])
+
+	blob, err := blobs.ForFile(ctx, b.file)
+	if err != nil {
+		return err
+	}
+	path, data, err := blob.Put(ctx, b.file, []byte(out.String()))
+	if err != nil {
+		return err
+	}
+	err = q.UpdateFileLocation(ctx, query.UpdateFileLocationParams{
+		StorageType: b.file.StorageType,
+		StorageUuid: converter.UuidPtrToPgUUID(b.file.StorageUuid),
+		Data:        data,
+		Path:        pgtype.Text{String: path, Valid: path != ""},
+		Size:        pgtype.Int8{Int64: int64(out.Len()), Valid: true},
+		UUID:        converter.UuidToPgUUID(b.file.UUID),
+	})
+	if err != nil {
+		return err
+	}
+	// the original is left behind when it was written under another name
+	if b.file.Path.Valid && b.file.Path.String != path {
+		return blob.Delete(ctx, b.file)
+	}
+	return nil
+}
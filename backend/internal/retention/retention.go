@@ -0,0 +1,231 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/storages"
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// batchSize is the number of messages or files removed per round trip.
+const batchSize = 500
+
+// Result counts what a policy run removed.
+type Result struct {
+	MessagesDeleted int64 `json:"messages_deleted"`
+	FilesDeleted    int64 `json:"files_deleted"`
+	BytesDeleted    int64 `json:"bytes_deleted"`
+}
+
+func (r *Result) add(o Result) {
+	r.MessagesDeleted += o.MessagesDeleted
+	r.FilesDeleted += o.FilesDeleted
+	r.BytesDeleted += o.BytesDeleted
+}
+
+// Enforcer applies retention policies to stored messages and files.
+type Enforcer struct {
+	log *slog.Logger
+	db  query.DBTX
+}
+
+// NewEnforcer creates a new retention Enforcer, db is usually the pool.
+func NewEnforcer(log *slog.Logger, db query.DBTX) *Enforcer {
+	return &Enforcer{log: log.With("service", "retention"), db: db}
+}
+
+// RunAll applies every enabled retention policy. A failing policy does not
+// keep the others from running, the errors are returned together.
+func (e *Enforcer) RunAll(ctx context.Context) (Result, error) {
+	var total Result
+	rows, err := query.New(e.db).GetRetentionPolicies(ctx, query.GetRetentionPoliciesParams{IsEnabled: 1})
+	if err != nil {
+		return total, err
+	}
+	var errs []error
+	for _, row := range rows {
+		// a policy only applies to the workspace that owns it, never unscoped:
+		// without a pipeline or datasource it would delete in every workspace
+		if row.RetentionPolicy.WorkspaceUUID == nil {
+			e.log.Error("retention policy without a workspace, skipping", "policy_uuid", row.RetentionPolicy.UUID)
+			continue
+		}
+		res, err := e.Run(workspace.WithUUID(ctx, *row.RetentionPolicy.WorkspaceUUID), row.RetentionPolicy)
+		total.add(res)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("retention policy %s: %w", row.RetentionPolicy.UUID, err))
+		}
+	}
+	return total, errors.Join(errs...)
+}
+
+// Run applies a single retention policy: attachments first, then messages by
+// age and finally messages beyond the keep-last limit.
+func (e *Enforcer) Run(ctx context.Context, policy query.RetentionPolicy) (Result, error) {
+	var total Result
+	log := e.log.With("policy_uuid", policy.UUID)
+	q := query.New(e.db)
+	blobs := storages.NewBlobResolver(q)
+	pipelineUUID := converter.UuidPtrToPgUUID(policy.PipelineUuid)
+	datasourceUUID := converter.UuidPtrToPgUUID(policy.DatasourceUUID)
+
+	if policy.AttachmentsDays > 0 {
+		cutoff := daysAgo(policy.AttachmentsDays)
+		for {
+			rows, err := q.GetExpiredAttachments(ctx, query.GetExpiredAttachmentsParams{
+				PipelineUuid:   pipelineUUID,
+				DatasourceUUID: datasourceUUID,
+				CreatedBefore:  cutoff,
+				Limit:          batchSize,
+			})
+			if err != nil {
+				return total, err
+			}
+			if len(rows) == 0 {
+				break
+			}
+			files := make([]query.File, 0, len(rows))
+			for _, row := range rows {
+				files = append(files, row.File)
+			}
+			res, err := DeleteFiles(ctx, e.db, blobs, files)
+			total.add(res)
+			if err != nil {
+				return total, err
+			}
+		}
+	}
+
+	if policy.KeepDays > 0 {
+		cutoff := daysAgo(policy.KeepDays)
+		for {
+			ids, err := q.GetExpiredMessageUUIDs(ctx, query.GetExpiredMessageUUIDsParams{
+				PipelineUuid:   pipelineUUID,
+				DatasourceUUID: datasourceUUID,
+				CreatedBefore:  cutoff,
+				Limit:          batchSize,
+			})
+			if err != nil {
+				return total, err
+			}
+			if len(ids) == 0 {
+				break
+			}
+			res, err := DeleteMessages(ctx, e.db, blobs, ids)
+			total.add(res)
+			if err != nil {
+				return total, err
+			}
+		}
+	}
+
+	if policy.KeepLast > 0 {
+		for {
+			ids, err := q.GetMessageUUIDsBeyondKeepLast(ctx, query.GetMessageUUIDsBeyondKeepLastParams{
+				PipelineUuid:   pipelineUUID,
+				DatasourceUUID: datasourceUUID,
+				KeepLast:       policy.KeepLast,
+				Limit:          batchSize,
+			})
+			if err != nil {
+				return total, err
+			}
+			if len(ids) == 0 {
+				break
+			}
+			res, err := DeleteMessages(ctx, e.db, blobs, ids)
+			total.add(res)
+			if err != nil {
+				return total, err
+			}
+		}
+	}
+
+	if err := q.UpdateRetentionPolicyLastRun(ctx, converter.UuidToPgUUID(policy.UUID)); err != nil {
+		log.Error("failed to update retention policy last run", "error", err)
+	}
+	log.Info("retention policy applied",
+		"messages_deleted", total.MessagesDeleted, "files_deleted", total.FilesDeleted, "bytes_deleted", total.BytesDeleted)
+	return total, nil
+}
+
+// DeleteMessages deletes messages together with their attached files.
+func DeleteMessages(ctx context.Context, db query.DBTX, blobs *storages.BlobResolver, ids []uuid.UUID) (Result, error) {
+	var res Result
+	pgIDs := toPgUUIDs(ids)
+	rows, err := query.New(db).GetFilesByMessageUUIDs(ctx, pgIDs)
+	if err != nil {
+		return res, err
+	}
+	files := make([]query.File, 0, len(rows))
+	for _, row := range rows {
+		files = append(files, row.File)
+	}
+	fileRes, err := DeleteFiles(ctx, db, blobs, files)
+	res.add(fileRes)
+	if err != nil {
+		return res, err
+	}
+	res.MessagesDeleted, err = query.New(db).DeleteMessagesByUUIDs(ctx, pgIDs)
+	return res, err
+}
+
+// DeleteFiles removes file content from its storage, then the file rows.
+// Rows are only removed for files whose content was deleted, so a failing
+// storage is retried on the next run instead of leaving orphaned blobs. Rows
+// of files without a storage to reach their content through are removed, no
+// run would ever delete that content.
+func DeleteFiles(ctx context.Context, db query.DBTX, blobs *storages.BlobResolver, files []query.File) (Result, error) {
+	var res Result
+	if len(files) == 0 {
+		return res, nil
+	}
+	deleted := make([]pgtype.UUID, 0, len(files))
+	var blobErr error
+	for _, f := range files {
+		blob, err := blobs.ForFile(ctx, f)
+		if err == nil {
+			err = blob.Delete(ctx, f)
+		}
+		if errors.Is(err, storages.ErrNoStorage) {
+			err = nil
+		}
+		if err != nil {
+			blobErr = fmt.Errorf("delete file %s: %w", f.UUID, err)
+			break
+		}
+		deleted = append(deleted, converter.UuidToPgUUID(f.UUID))
+		if f.Size.Valid {
+			res.BytesDeleted += f.Size.Int64
+		}
+	}
+	if len(deleted) > 0 {
+		n, err := query.New(db).DeleteFilesByUUIDs(ctx, deleted)
+		res.FilesDeleted = n
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, blobErr
+}
+
+func daysAgo(days int32) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: time.Now().UTC().AddDate(0, 0, -int(days)), Valid: true}
+}
+
+func toPgUUIDs(ids []uuid.UUID) []pgtype.UUID {
+	out := make([]pgtype.UUID, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, converter.UuidToPgUUID(id))
+	}
+	return out
+}
@@ -0,0 +1,492 @@
+package retention
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
+	"github.com/shadowapi/shadowapi/backend/internal/storages"
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// fakeDB answers the queries of the enforcer from memory, dispatching on the
+// sqlc query name. Every message of the fake is expired and mentions the
+// erased subject.
+type fakeDB struct {
+	storages map[uuid.UUID]query.Storage
+	files    []query.File
+	messages []uuid.UUID
+	policies []query.RetentionPolicy
+	erasures []query.CreateErasureRequestParams
+	// erasedIn is the workspace each erasure was recorded in
+	erasedIn []uuid.UUID
+	// bodies maps messages to the files their body was moved to
+	bodies map[uuid.UUID]uuid.UUID
+	// fail makes the named query return an error
+	fail string
+}
+
+func queryName(sql string) string {
+	name, _, _ := strings.Cut(strings.TrimPrefix(sql, "-- name: "), " ")
+	return name
+}
+
+func (db *fakeDB) Exec(_ context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	name := queryName(sql)
+	if name == db.fail {
+		return pgconn.CommandTag{}, errors.New(name + " failed")
+	}
+	var n int
+	switch name {
+	case "DeleteFilesByUUIDs":
+		ids := args[0].([]pgtype.UUID)
+		before := len(db.files)
+		db.files = slices.DeleteFunc(db.files, func(f query.File) bool {
+			return slices.Contains(ids, pgtype.UUID{Bytes: f.UUID, Valid: true})
+		})
+		n = before - len(db.files)
+	case "DeleteMessagesByUUIDs":
+		ids := args[0].([]pgtype.UUID)
+		before := len(db.messages)
+		db.messages = slices.DeleteFunc(db.messages, func(id uuid.UUID) bool {
+			return slices.Contains(ids, pgtype.UUID{Bytes: id, Valid: true})
+		})
+		n = before - len(db.messages)
+	case "AnonymizeMessages":
+		n = len(args[2].([]pgtype.UUID))
+	case "UpdateFileLocation":
+		for i, f := range db.files {
+			if args[5].(pgtype.UUID).Bytes == f.UUID {
+				db.files[i].Path, db.files[i].Size = args[3].(pgtype.Text), args[4].(pgtype.Int8)
+				n++
+			}
+		}
+	case "UpdateRetentionPolicyLastRun":
+	default:
+		return pgconn.CommandTag{}, fmt.Errorf("unexpected exec %s", name)
+	}
+	return pgconn.NewCommandTag(fmt.Sprintf("DELETE %d", n)), nil
+}
+
+func (db *fakeDB) Query(_ context.Context, sql string, args ...any) (pgx.Rows, error) {
+	name := queryName(sql)
+	if name == db.fail {
+		return nil, errors.New(name + " failed")
+	}
+	rows := &fakeRows{}
+	switch name {
+	case "GetRetentionPolicies":
+		for _, p := range db.policies {
+			rows.add(p)
+		}
+	case "GetExpiredMessageUUIDs", "FindMessageUUIDsBySubject":
+		for _, id := range db.messages {
+			rows.add(id)
+		}
+	case "GetMessageUUIDsBeyondKeepLast", "GetExpiredAttachments", "FindContactUUIDsBySubject":
+	case "GetMessageBodyFiles":
+		if args[0].(pgtype.UUID).Valid {
+			break
+		}
+		for _, f := range db.files {
+			if f.MessageUuid != nil && db.bodies[*f.MessageUuid] == f.UUID {
+				rows.add(query.GetMessageBodyFilesRow{MessageUuid: *f.MessageUuid, File: f})
+			}
+		}
+	case "GetFilesByMessageUUIDs":
+		ids := args[0].([]pgtype.UUID)
+		for _, f := range db.files {
+			if f.MessageUuid != nil && slices.Contains(ids, pgtype.UUID{Bytes: *f.MessageUuid, Valid: true}) {
+				rows.add(f)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unexpected query %s", name)
+	}
+	return rows, nil
+}
+
+func (db *fakeDB) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	name := queryName(sql)
+	rows := &fakeRows{}
+	switch name {
+	case "GetStorage":
+		s, ok := db.storages[args[0].(pgtype.UUID).Bytes]
+		if !ok {
+			rows.err = pgx.ErrNoRows
+			break
+		}
+		rows.add(s)
+	case "CreateErasureRequest":
+		db.erasures = append(db.erasures, query.CreateErasureRequestParams{
+			Subject: args[1].(string),
+			Status:  args[3].(string),
+			Report:  args[4].([]byte),
+		})
+		ws, _ := workspace.FromContext(ctx)
+		db.erasedIn = append(db.erasedIn, ws)
+		rows.add(query.ErasureRequest{})
+	default:
+		rows.err = fmt.Errorf("unexpected query row %s", name)
+	}
+	return rows
+}
+
+// fakeRows scans the fields of structs, embedded ones flattened, in the
+// order sqlc lists the columns.
+type fakeRows struct {
+	values [][]any
+	i      int
+	err    error
+}
+
+func (r *fakeRows) add(v any) {
+	var out []any
+	var flatten func(v reflect.Value)
+	flatten = func(v reflect.Value) {
+		if v.Kind() != reflect.Struct || v.Type().PkgPath() != reflect.TypeOf(query.File{}).PkgPath() {
+			out = append(out, v.Interface())
+			return
+		}
+		for i := range v.NumField() {
+			flatten(v.Field(i))
+		}
+	}
+	flatten(reflect.ValueOf(v))
+	r.values = append(r.values, out)
+}
+
+func (r *fakeRows) Next() bool {
+	r.i++
+	return r.err == nil && r.i <= len(r.values)
+}
+
+func (r *fakeRows) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	if r.i == 0 && !r.Next() {
+		return pgx.ErrNoRows
+	}
+	for j, d := range dest {
+		reflect.ValueOf(d).Elem().Set(reflect.ValueOf(r.values[r.i-1][j]))
+	}
+	return nil
+}
+
+func (r *fakeRows) Close()                                       {}
+func (r *fakeRows) Err() error                                   { return r.err }
+func (r *fakeRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *fakeRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *fakeRows) Values() ([]any, error)                       { return r.values[r.i-1], nil }
+func (r *fakeRows) RawValues() [][]byte                          { return nil }
+func (r *fakeRows) Conn() *pgx.Conn                              { return nil }
+
+// fakeS3 records the objects deleted through the S3 API.
+type fakeS3 struct {
+	mu      sync.Mutex
+	deleted []string
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	io.Copy(io.Discard, r.Body)
+	if r.Method != http.MethodDelete {
+		w.WriteHeader(http.StatusNotImplemented)
+		return
+	}
+	s.mu.Lock()
+	s.deleted = append(s.deleted, r.URL.Path)
+	s.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func settings(t *testing.T, v map[string]string) []byte {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// storageCase is a message with one attachment kept in a storage.
+type storageCase struct {
+	name string
+	db   *fakeDB
+	file query.File
+	s3   *fakeS3
+}
+
+func storageCases(t *testing.T) []storageCase {
+	s3srv := &fakeS3{}
+	srv := httptest.NewServer(s3srv)
+	t.Cleanup(srv.Close)
+
+	dir := t.TempDir()
+	newCase := func(name, storageType string, storage *query.Storage, path string) storageCase {
+		msg := uuid.Must(uuid.NewV7())
+		f := query.File{
+			UUID:        uuid.Must(uuid.NewV7()),
+			StorageType: storageType,
+			Name:        "invoice.pdf",
+			Size:        pgtype.Int8{Int64: 42, Valid: true},
+			MessageUuid: &msg,
+		}
+		if path != "" {
+			f.Path = pgtype.Text{String: path, Valid: true}
+		}
+		db := &fakeDB{storages: map[uuid.UUID]query.Storage{}, messages: []uuid.UUID{msg}}
+		if storage != nil {
+			storage.UUID = uuid.Must(uuid.NewV7())
+			storage.Type = storageType
+			db.storages[storage.UUID] = *storage
+			f.StorageUuid = &storage.UUID
+		}
+		db.files = []query.File{f}
+		return storageCase{name: name, db: db, file: f, s3: s3srv}
+	}
+	hostfile := func(name string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte("pdf"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	s3Settings := settings(t, map[string]string{
+		"name": "mail", "bucket": "mail", "region": "us-east-1", "provider": srv.URL,
+		"access_key_id": "key", "secret_access_key": "secret",
+	})
+
+	return []storageCase{
+		newCase("postgres", "postgres", &query.Storage{}, ""),
+		newCase("hostfiles", "hostfiles", &query.Storage{Settings: settings(t, map[string]string{"name": "local", "path": dir})}, hostfile("a.pdf")),
+		newCase("s3", "s3", &query.Storage{Settings: s3Settings}, "files/ab/obj.pdf"),
+		// rows written before the worker storages recorded their storage
+		newCase("legacy postgres", "postgres", nil, ""),
+		newCase("legacy hostfiles", "hostfiles", nil, hostfile("b.pdf")),
+		newCase("legacy s3", "s3", nil, "files/cd/obj.pdf"),
+	}
+}
+
+// checkRemoved asserts the message, the file row and its content are gone.
+func (c storageCase) checkRemoved(t *testing.T) {
+	t.Helper()
+	if len(c.db.files) != 0 || len(c.db.messages) != 0 {
+		t.Errorf("left files %d, messages %d", len(c.db.files), len(c.db.messages))
+	}
+	switch {
+	case c.file.StorageType == "hostfiles":
+		if _, err := os.Stat(c.file.Path.String); !os.IsNotExist(err) {
+			t.Errorf("hostfile still there: %v", err)
+		}
+	case c.file.StorageType == "s3" && c.file.StorageUuid != nil:
+		c.s3.mu.Lock()
+		defer c.s3.mu.Unlock()
+		if !slices.Contains(c.s3.deleted, "/mail/"+c.file.Path.String) {
+			t.Errorf("object not deleted, deleted %v", c.s3.deleted)
+		}
+	}
+}
+
+func newEnforcer(db query.DBTX) *Enforcer {
+	return NewEnforcer(slog.New(slog.NewTextHandler(io.Discard, nil)), db)
+}
+
+func TestRunDeletesFilesOfEveryStorage(t *testing.T) {
+	for _, c := range storageCases(t) {
+		t.Run(c.name, func(t *testing.T) {
+			res, err := newEnforcer(c.db).Run(context.Background(), query.RetentionPolicy{UUID: uuid.Must(uuid.NewV7()), KeepDays: 30})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res != (Result{MessagesDeleted: 1, FilesDeleted: 1, BytesDeleted: 42}) {
+				t.Errorf("result = %+v", res)
+			}
+			c.checkRemoved(t)
+		})
+	}
+}
+
+func TestEraseDeletesFilesOfEveryStorage(t *testing.T) {
+	for _, c := range storageCases(t) {
+		t.Run(c.name, func(t *testing.T) {
+			report, err := newEnforcer(c.db).Erase(context.Background(), "bob@example.com", ErasureModeDelete, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if report.FilesDeleted != 1 || report.MessagesDeleted != 1 || len(c.db.erasures) != 1 || c.db.erasures[0].Status != "done" {
+				t.Errorf("report = %+v, erasures = %+v", report, c.db.erasures)
+			}
+			c.checkRemoved(t)
+		})
+	}
+}
+
+func TestDeleteFilesKeepsRowsOfFailedStorage(t *testing.T) {
+	c := storageCases(t)[0]
+	missing := uuid.Must(uuid.NewV7())
+	broken := c.file
+	broken.UUID = uuid.Must(uuid.NewV7())
+	broken.StorageUuid = &missing
+	c.db.files = append(c.db.files, broken)
+
+	res, err := DeleteFiles(context.Background(), c.db, nil, nil)
+	if err != nil || res != (Result{}) {
+		t.Fatalf("no files: %+v, %v", res, err)
+	}
+	q := query.New(c.db)
+	res, err = DeleteFiles(context.Background(), c.db, storages.NewBlobResolver(q), c.db.files)
+	if err == nil {
+		t.Fatal("missing storage not reported")
+	}
+	// the file before the failing one is gone, the failing one is retried
+	if res.FilesDeleted != 1 || len(c.db.files) != 1 || c.db.files[0].UUID != broken.UUID {
+		t.Errorf("result = %+v, left %+v", res, c.db.files)
+	}
+}
+
+func TestRunAllContinuesAfterFailedPolicy(t *testing.T) {
+	db := &fakeDB{messages: []uuid.UUID{uuid.Must(uuid.NewV7())}}
+	ws := uuid.Must(uuid.NewV7())
+	db.policies = []query.RetentionPolicy{
+		{UUID: uuid.Must(uuid.NewV7()), WorkspaceUUID: &ws, KeepLast: 1},
+		{UUID: uuid.Must(uuid.NewV7()), WorkspaceUUID: &ws, KeepDays: 1},
+	}
+	// the first policy fails, the second still removes the message
+	db.fail = "GetMessageUUIDsBeyondKeepLast"
+	res, err := newEnforcer(db).RunAll(context.Background())
+	if err == nil || !strings.Contains(err.Error(), db.policies[0].UUID.String()) {
+		t.Errorf("error = %v", err)
+	}
+	if res.MessagesDeleted != 1 || len(db.messages) != 0 {
+		t.Errorf("result = %+v, left %d", res, len(db.messages))
+	}
+}
+
+func TestRunAllSkipsPoliciesWithoutWorkspace(t *testing.T) {
+	// unscoped, the policy would delete the messages of every workspace
+	db := &fakeDB{messages: []uuid.UUID{uuid.Must(uuid.NewV7())}}
+	db.policies = []query.RetentionPolicy{{UUID: uuid.Must(uuid.NewV7()), KeepDays: 1}}
+	res, err := newEnforcer(db).RunAll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.MessagesDeleted != 0 || len(db.messages) != 1 {
+		t.Errorf("result = %+v, left %d", res, len(db.messages))
+	}
+}
+
+func TestEraseRecordsInItsWorkspace(t *testing.T) {
+	c := storageCases(t)[0]
+	ws := uuid.Must(uuid.NewV7())
+	if _, err := newEnforcer(c.db).Erase(workspace.WithUUID(context.Background(), ws), "bob@example.com", ErasureModeDelete, false); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.db.erasedIn) != 1 || c.db.erasedIn[0] != ws {
+		t.Errorf("erasure recorded in %v, want %s", c.db.erasedIn, ws)
+	}
+}
+
+func TestEraseRecordsSubjectDigest(t *testing.T) {
+	defer secrets.SetDefault(secrets.Default())
+	key := make([]byte, 32)
+	k, err := secrets.NewKeyring("k1", map[string][]byte{"k1": key})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, keyring := range []*secrets.Keyring{k, {}} {
+		secrets.SetDefault(keyring)
+		c := storageCases(t)[0]
+		if _, err := newEnforcer(c.db).Erase(context.Background(), " Bob@Example.com ", ErasureModeAnonymize, false); err != nil {
+			t.Fatal(err)
+		}
+		recorded := c.db.erasures[0]
+		if strings.Contains(strings.ToLower(recorded.Subject+string(recorded.Report)), "bob@example.com") {
+			t.Errorf("subject recorded in plaintext: %s %s", recorded.Subject, recorded.Report)
+		}
+		want := secrets.Redacted
+		if keyring.Enabled() {
+			// the same subject written differently gives the same digest
+			want = SubjectDigest("bob@example.com")
+			if !strings.HasPrefix(want, secrets.DigestPrefix+"k1:") || SubjectDigest("+1 (555) 010-9999") != SubjectDigest("15550109999") {
+				t.Errorf("digest = %s", want)
+			}
+		}
+		if recorded.Subject != want {
+			t.Errorf("recorded subject = %s, want %s", recorded.Subject, want)
+		}
+	}
+}
+
+func TestMatchSubject(t *testing.T) {
+	tests := []struct {
+		subject, text string
+		want          []string
+	}{
+		{"bob@example.com", "Bob@Example.com wrote", []string{"Bob@Example.com"}},
+		{"bob@example.com", "jimbob@example.com, bob@example.com.", []string{"bob@example.com"}},
+		{"bob@example.com", "bob@example.com.au bob@example.community", nil},
+		{"+1 (555) 010-9999", "call 1 555 010 9999 or 15550109999", []string{"1 555 010 9999", "15550109999"}},
+		{"15550109999", "215550109999 and 155501099990", nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, span := range matchSubject(tt.subject, tt.text) {
+			got = append(got, tt.text[span[0]:span[1]])
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("matchSubject(%q, %q) = %q, want %q", tt.subject, tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestEraseAnonymizesMovedBodies(t *testing.T) {
+	// the message only mentions the subject in its body, moved to hostfiles
+	c := storageCases(t)[1]
+	if err := os.WriteFile(c.file.Path.String, []byte("ping bob@example.com today"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	c.db.bodies = map[uuid.UUID]uuid.UUID{*c.file.MessageUuid: c.file.UUID}
+	c.db.messages = nil
+
+	report, err := newEnforcer(c.db).Erase(context.Background(), "bob@example.com", ErasureModeAnonymize, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.MessageUUIDs) != 1 || report.MessagesAnonymized != 1 || report.FilesDeleted != 0 {
+		t.Errorf("report = %+v", report)
+	}
+	if len(c.db.files) != 1 {
+		t.Fatal("body file deleted")
+	}
+	data, err := os.ReadFile(c.db.files[0].Path.String)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "ping [erased] today" || c.db.files[0].Size.Int64 != int64(len(data)) {
+		t.Errorf("body = %q, size %d", data, c.db.files[0].Size.Int64)
+	}
+	if _, err := os.Stat(c.file.Path.String); !os.IsNotExist(err) {
+		t.Errorf("original body left behind: %v", err)
+	}
+}
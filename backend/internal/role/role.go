@@ -0,0 +1,74 @@
+// Package role names the parts of shadowapi a serve process runs, so that the
+// API tier, the fetch workers and the scheduler can be deployed and scaled
+// apart. A process runs every role unless told otherwise.
+package role
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/samber/do/v2"
+
+	"github.com/shadowapi/shadowapi/backend/internal/config"
+)
+
+// Roles of a process
+const (
+	// API serves the REST API, the login and the frontend
+	API = "api"
+	// Worker consumes the jobs from the queue and delivers the webhooks
+	Worker = "worker"
+	// Scheduler publishes the due jobs, on the elected leader only
+	Scheduler = "scheduler"
+	// Telegram keeps the stored Telegram sessions connected for their updates,
+	// on the elected leader only
+	Telegram = "telegram"
+	// WhatsApp is reserved for a long-lived WhatsApp client
+	WhatsApp = "whatsapp"
+)
+
+// All lists every role, a process runs them all when none is configured.
+var All = []string{API, Worker, Scheduler, Telegram, WhatsApp}
+
+// Roles is the set of roles a process runs.
+type Roles []string
+
+// Has tells whether the process runs the role.
+func (r Roles) Has(role string) bool {
+	return slices.Contains(r, role)
+}
+
+func (r Roles) String() string {
+	return strings.Join(r, ",")
+}
+
+// Parse validates the roles, given as a list or comma separated. No roles is
+// every role.
+func Parse(values []string) (Roles, error) {
+	var roles Roles
+	for _, v := range values {
+		for _, name := range strings.Split(v, ",") {
+			name = strings.ToLower(strings.TrimSpace(name))
+			if name == "" {
+				continue
+			}
+			if !slices.Contains(All, name) {
+				return nil, fmt.Errorf("unknown role %q, use %s", name, strings.Join(All, ", "))
+			}
+			if !roles.Has(name) {
+				roles = append(roles, name)
+			}
+		}
+	}
+	if len(roles) == 0 {
+		return slices.Clone(All), nil
+	}
+	return roles, nil
+}
+
+// Provide the roles of the process for the dependency injector.
+func Provide(i do.Injector) (Roles, error) {
+	cfg := do.MustInvoke[*config.Config](i)
+	return Parse(cfg.Roles)
+}
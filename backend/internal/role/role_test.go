@@ -0,0 +1,31 @@
+package role
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      []string
+		want    Roles
+		wantErr bool
+	}{
+		{in: nil, want: All},
+		{in: []string{""}, want: All},
+		{in: []string{"api"}, want: Roles{API}},
+		{in: []string{"worker, Scheduler"}, want: Roles{Worker, Scheduler}},
+		{in: []string{"api,worker", "api"}, want: Roles{API, Worker}},
+		{in: []string{"api,mail"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Parse(%q) error = %v, want error %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("Parse(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
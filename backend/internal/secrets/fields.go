@@ -0,0 +1,162 @@
+package secrets
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// StorageFields lists the secret settings of each storage type.
+var StorageFields = map[string][]string{
+	"s3":       {"secret_access_key"},
+	"postgres": {"password"},
+}
+
+// DatasourceFields lists the secret settings of each datasource type.
+var DatasourceFields = map[string][]string{
+	"email": {"password"},
+}
+
+// EncryptFields encrypts the named top level string fields of a JSON object.
+func EncryptFields(settings []byte, fields ...string) ([]byte, error) {
+	return mapFields(settings, fields, Encrypt)
+}
+
+// DecryptFields decrypts the named top level string fields of a JSON object.
+func DecryptFields(settings []byte, fields ...string) ([]byte, error) {
+	return mapFields(settings, fields, Decrypt)
+}
+
+// RewrapFields re-wraps the named fields with the primary key of k and
+// reports whether any of them changed.
+func (k *Keyring) RewrapFields(settings []byte, fields ...string) ([]byte, bool, error) {
+	changed := false
+	out, err := mapFields(settings, fields, func(v string) (string, error) {
+		nv, ok, err := k.Rewrap(v)
+		changed = changed || ok
+		return nv, err
+	})
+	return out, changed, err
+}
+
+// KeepRedacted copies the named fields from previous into settings where the
+// client sent back the Redacted placeholder, so an update round-tripping a
+// GET response does not overwrite the stored secret.
+func KeepRedacted(settings, previous []byte, fields ...string) ([]byte, error) {
+	var prev map[string]json.RawMessage
+	if len(previous) > 0 {
+		if err := json.Unmarshal(previous, &prev); err != nil {
+			return nil, err
+		}
+	}
+	var cur map[string]json.RawMessage
+	if err := json.Unmarshal(settings, &cur); err != nil {
+		return nil, err
+	}
+	changed := false
+	for _, f := range fields {
+		var v string
+		if raw, ok := cur[f]; !ok || json.Unmarshal(raw, &v) != nil || v != Redacted {
+			continue
+		}
+		if old, ok := prev[f]; ok {
+			cur[f] = old
+		} else {
+			delete(cur, f)
+		}
+		changed = true
+	}
+	if !changed {
+		return settings, nil
+	}
+	return json.Marshal(cur)
+}
+
+// SealFields prepares settings from an API request for storage: redacted
+// placeholders are replaced by the previous values, the rest is encrypted.
+func SealFields(settings, previous []byte, fields ...string) ([]byte, error) {
+	settings, err := KeepRedacted(settings, previous, fields...)
+	if err != nil {
+		return nil, err
+	}
+	return EncryptFields(settings, fields...)
+}
+
+// EncryptJSON seals a whole JSON document and stores it as a JSON string, so
+// the result still fits a JSONB column.
+func EncryptJSON(data []byte) ([]byte, error) {
+	if !Default().Enabled() || len(data) == 0 {
+		return data, nil
+	}
+	sealed, err := Encrypt(string(data))
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(sealed)
+}
+
+// DecryptJSON reverses EncryptJSON. Plain JSON documents are returned as is.
+func DecryptJSON(data []byte) ([]byte, error) {
+	var sealed string
+	if json.Unmarshal(data, &sealed) != nil || !IsEncrypted(sealed) {
+		return data, nil
+	}
+	plain, err := Decrypt(sealed)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(plain), nil
+}
+
+// RewrapJSON re-wraps a document sealed by EncryptJSON, sealing plain
+// documents, and reports whether it changed.
+func (k *Keyring) RewrapJSON(data []byte) ([]byte, bool, error) {
+	if len(data) == 0 || !k.Enabled() {
+		return data, false, nil
+	}
+	var sealed string
+	if json.Unmarshal(data, &sealed) != nil || !IsEncrypted(sealed) {
+		sealed = string(data)
+	}
+	out, changed, err := k.Rewrap(sealed)
+	if err != nil || !changed {
+		return data, false, err
+	}
+	data, err = json.Marshal(out)
+	return data, err == nil, err
+}
+
+func mapFields(settings []byte, fields []string, fn func(string) (string, error)) ([]byte, error) {
+	if len(settings) == 0 || len(fields) == 0 {
+		return settings, nil
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(settings, &obj); err != nil {
+		return nil, err
+	}
+	changed := false
+	for _, f := range fields {
+		raw, ok := obj[f]
+		if !ok {
+			continue
+		}
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil || v == "" {
+			continue
+		}
+		nv, err := fn(v)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", f, err)
+		}
+		if nv == v {
+			continue
+		}
+		if obj[f], err = json.Marshal(nv); err != nil {
+			return nil, err
+		}
+		changed = true
+	}
+	if !changed {
+		return settings, nil
+	}
+	return json.Marshal(obj)
+}
@@ -0,0 +1,162 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/db"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// RotateReport counts the records re-wrapped by Rotate.
+type RotateReport struct {
+	PrimaryKey    string `json:"primary_key"`
+	DryRun        bool   `json:"dry_run"`
+	OAuth2Clients int    `json:"oauth2_clients"`
+	OAuth2Tokens  int    `json:"oauth2_tokens"`
+	Storages      int    `json:"storages"`
+	Datasources   int    `json:"datasources"`
+	Webhooks      int    `json:"webhooks"`
+}
+
+// Rotate re-wraps every stored secret with the primary key of k and encrypts
+// values still stored in plaintext. It runs in a single transaction, so a
+// failure leaves all records untouched. With dryRun the changes are counted
+// and rolled back.
+func (k *Keyring) Rotate(ctx context.Context, dbp *pgxpool.Pool, dryRun bool) (RotateReport, error) {
+	report := RotateReport{PrimaryKey: k.Primary(), DryRun: dryRun}
+	if !k.Enabled() {
+		return report, ErrNoMasterKey
+	}
+	errDryRun := errors.New("dry run")
+	_, err := db.InTx(ctx, dbp, func(tx pgx.Tx) (struct{}, error) {
+		q := query.New(tx)
+
+		clients, err := q.GetOauth2ClientSecrets(ctx)
+		if err != nil {
+			return struct{}{}, err
+		}
+		for _, c := range clients {
+			secret, changed, err := k.Rewrap(c.Secret)
+			if err != nil {
+				return struct{}{}, fmt.Errorf("oauth2 client %s: %w", c.UUID, err)
+			}
+			if !changed {
+				continue
+			}
+			if err := q.SetOauth2ClientSecret(ctx, query.SetOauth2ClientSecretParams{
+				UUID:   converter.UuidToPgUUID(c.UUID),
+				Secret: secret,
+			}); err != nil {
+				return struct{}{}, err
+			}
+			report.OAuth2Clients++
+		}
+
+		tokens, err := q.GetOauth2TokenSecrets(ctx)
+		if err != nil {
+			return struct{}{}, err
+		}
+		for _, t := range tokens {
+			token, changed, err := k.RewrapJSON(t.Token)
+			if err != nil {
+				return struct{}{}, fmt.Errorf("oauth2 token %s: %w", t.UUID, err)
+			}
+			if !changed {
+				continue
+			}
+			if err := q.SetOauth2TokenSecret(ctx, query.SetOauth2TokenSecretParams{
+				UUID:  converter.UuidToPgUUID(t.UUID),
+				Token: token,
+			}); err != nil {
+				return struct{}{}, err
+			}
+			report.OAuth2Tokens++
+		}
+
+		storages, err := q.GetStorageSecrets(ctx, fieldTypes(StorageFields))
+		if err != nil {
+			return struct{}{}, err
+		}
+		for _, s := range storages {
+			settings, changed, err := k.RewrapFields(s.Settings, StorageFields[s.Type]...)
+			if err != nil {
+				return struct{}{}, fmt.Errorf("storage %s: %w", s.UUID, err)
+			}
+			if !changed {
+				continue
+			}
+			if err := q.SetStorageSettings(ctx, query.SetStorageSettingsParams{
+				UUID:     converter.UuidToPgUUID(s.UUID),
+				Settings: settings,
+			}); err != nil {
+				return struct{}{}, err
+			}
+			report.Storages++
+		}
+
+		datasources, err := q.GetDatasourceSecrets(ctx, fieldTypes(DatasourceFields))
+		if err != nil {
+			return struct{}{}, err
+		}
+		for _, d := range datasources {
+			settings, changed, err := k.RewrapFields(d.Settings, DatasourceFields[d.Type]...)
+			if err != nil {
+				return struct{}{}, fmt.Errorf("datasource %s: %w", d.UUID, err)
+			}
+			if !changed {
+				continue
+			}
+			if err := q.SetDatasourceSettings(ctx, query.SetDatasourceSettingsParams{
+				UUID:     converter.UuidToPgUUID(d.UUID),
+				Settings: settings,
+			}); err != nil {
+				return struct{}{}, err
+			}
+			report.Datasources++
+		}
+
+		webhooks, err := q.GetWebhookSecrets(ctx)
+		if err != nil {
+			return struct{}{}, err
+		}
+		for _, w := range webhooks {
+			secret, changed, err := k.Rewrap(w.Secret)
+			if err != nil {
+				return struct{}{}, fmt.Errorf("webhook %s: %w", w.UUID, err)
+			}
+			if !changed {
+				continue
+			}
+			if err := q.SetWebhookSecret(ctx, query.SetWebhookSecretParams{
+				UUID:   converter.UuidToPgUUID(w.UUID),
+				Secret: secret,
+			}); err != nil {
+				return struct{}{}, err
+			}
+			report.Webhooks++
+		}
+
+		if dryRun {
+			return struct{}{}, errDryRun
+		}
+		return struct{}{}, nil
+	})
+	if errors.Is(err, errDryRun) {
+		err = nil
+	}
+	return report, err
+}
+
+func fieldTypes(fields map[string][]string) []string {
+	out := make([]string, 0, len(fields))
+	for t := range fields {
+		out = append(out, t)
+	}
+	return out
+}
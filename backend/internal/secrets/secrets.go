@@ -0,0 +1,415 @@
+// Package secrets implements envelope encryption of credentials stored in the
+// database.
+//
+// Every value is encrypted with its own random data key. The data key is
+// wrapped by a master key and stored next to the ciphertext, so rotating the
+// master key only re-wraps data keys and never touches the payload:
+//
+//	enc:v1:<key id>:<wrapped data key>:<ciphertext>
+//
+// Values without the prefix are treated as legacy plaintext and returned as is.
+//
+// Values that must only be compared, never read back, are stored as a keyed
+// digest instead:
+//
+//	hmac:v1:<key id>:<hex HMAC-SHA256>
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/samber/do/v2"
+	"gopkg.in/yaml.v3"
+
+	"github.com/shadowapi/shadowapi/backend/internal/config"
+)
+
+// Prefix marks an encrypted value.
+const Prefix = "enc:v1:"
+
+// DigestPrefix marks a keyed digest.
+const DigestPrefix = "hmac:v1:"
+
+// Redacted replaces secrets in API responses. Sending it back on update keeps
+// the stored value.
+const Redacted = "********"
+
+// keySize is the size of master and data keys, AES-256.
+const keySize = 32
+
+var (
+	// ErrNoMasterKey is returned when an encrypted value is read but no
+	// master key is configured.
+	ErrNoMasterKey = errors.New("no secrets master key configured")
+
+	// ErrUnknownKey is returned when a value was wrapped by a master key
+	// missing from the keyring.
+	ErrUnknownKey = errors.New("unknown secrets master key")
+
+	// ErrMalformed is returned for values with the prefix but a broken body.
+	ErrMalformed = errors.New("malformed encrypted value")
+)
+
+// Keyring holds the master keys. New values are wrapped with the primary key,
+// the other keys are only used to read values written before a rotation.
+type Keyring struct {
+	primary string
+	keys    map[string]cipher.AEAD
+	// digestKey is derived from the primary key, so digests never use the
+	// master key itself
+	digestKey []byte
+}
+
+// keyFile is the layout of the keyring file, a minimal local stand-in for a
+// KMS:
+//
+//	primary: 2026-10
+//	keys:
+//	  2026-10: <base64 key>
+//	  2026-01: <base64 key>
+type keyFile struct {
+	Primary string            `yaml:"primary" json:"primary"`
+	Keys    map[string]string `yaml:"keys" json:"keys"`
+}
+
+// NewKeyring creates a keyring from raw master keys indexed by key id.
+func NewKeyring(primary string, keys map[string][]byte) (*Keyring, error) {
+	k := &Keyring{primary: primary, keys: make(map[string]cipher.AEAD, len(keys))}
+	for id, key := range keys {
+		if id == "" || strings.Contains(id, ":") {
+			return nil, fmt.Errorf("invalid master key id %q", id)
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, fmt.Errorf("master key %s: %w", id, err)
+		}
+		k.keys[id] = aead
+		if id == primary {
+			mac := hmac.New(sha256.New, key)
+			mac.Write([]byte("shadowapi digest v1"))
+			k.digestKey = mac.Sum(nil)
+		}
+	}
+	if primary != "" {
+		if _, ok := k.keys[primary]; !ok {
+			return nil, fmt.Errorf("primary master key %q is not in the keyring", primary)
+		}
+	}
+	return k, nil
+}
+
+// FromConfig loads the keyring from the key file, or from the master key and
+// previous keys set in the config. An empty keyring is returned when nothing
+// is configured.
+func FromConfig(cfg *config.Config) (*Keyring, error) {
+	if cfg.Secrets.KeyFile != "" {
+		return LoadKeyFile(cfg.Secrets.KeyFile)
+	}
+	keys := make(map[string][]byte)
+	primary := ""
+	for n, encoded := range append([]string{cfg.Secrets.MasterKey}, cfg.Secrets.PreviousKeys...) {
+		if encoded == "" {
+			continue
+		}
+		key, err := DecodeKey(encoded)
+		if err != nil {
+			return nil, err
+		}
+		id := KeyID(key)
+		keys[id] = key
+		if n == 0 {
+			primary = id
+		}
+	}
+	if primary == "" && len(keys) > 0 {
+		return nil, errors.New("secrets previous keys are set without a master key")
+	}
+	return NewKeyring(primary, keys)
+}
+
+// LoadKeyFile reads a keyring file in YAML or JSON format.
+func LoadKeyFile(path string) (*Keyring, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read secrets key file: %w", err)
+	}
+	var f keyFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("parse secrets key file: %w", err)
+	}
+	if f.Primary == "" {
+		return nil, errors.New("secrets key file has no primary key")
+	}
+	keys := make(map[string][]byte, len(f.Keys))
+	for id, encoded := range f.Keys {
+		key, err := DecodeKey(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", id, err)
+		}
+		keys[id] = key
+	}
+	return NewKeyring(f.Primary, keys)
+}
+
+// GenerateKey returns a new random master key, base64 encoded.
+func GenerateKey() (string, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// DecodeKey decodes a base64 master key.
+func DecodeKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("master key is not valid base64: %w", err)
+	}
+	if len(key) != keySize {
+		return nil, fmt.Errorf("master key must be %d bytes, got %d", keySize, len(key))
+	}
+	return key, nil
+}
+
+// KeyID derives a stable id for a master key set in the config.
+func KeyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:4])
+}
+
+// Enabled reports whether new values are encrypted.
+func (k *Keyring) Enabled() bool {
+	return k != nil && k.primary != ""
+}
+
+// Primary returns the id of the key wrapping new values.
+func (k *Keyring) Primary() string {
+	if k == nil {
+		return ""
+	}
+	return k.primary
+}
+
+// IsEncrypted reports whether the value carries the encryption prefix.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, Prefix)
+}
+
+// Encrypt seals the value with a new data key. Without a master key the value
+// is returned unchanged, as are values already sealed by the keyring. A value
+// that only looks sealed, e.g. a password starting with Prefix, is sealed.
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	if plaintext == "" || !k.Enabled() {
+		return plaintext, nil
+	}
+	if IsEncrypted(plaintext) {
+		if _, err := k.Decrypt(plaintext); err == nil {
+			return plaintext, nil
+		}
+	}
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := seal(dataAEAD, []byte(plaintext), nil)
+	if err != nil {
+		return "", err
+	}
+	wrapped, err := seal(k.keys[k.primary], dataKey, []byte(k.primary))
+	if err != nil {
+		return "", err
+	}
+	return Prefix + k.primary + ":" + encode(wrapped) + ":" + encode(ciphertext), nil
+}
+
+// Decrypt opens an encrypted value. Plaintext values are returned unchanged.
+func (k *Keyring) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	id, dataKey, ciphertext, err := k.unwrap(value)
+	if err != nil {
+		return "", err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := open(dataAEAD, ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("decrypt value wrapped by key %s: %w", id, err)
+	}
+	return string(plaintext), nil
+}
+
+// Rewrap re-wraps the data key of the value with the primary master key and
+// encrypts legacy plaintext. It reports whether the value changed.
+func (k *Keyring) Rewrap(value string) (string, bool, error) {
+	if value == "" || !k.Enabled() {
+		return value, false, nil
+	}
+	if !IsEncrypted(value) {
+		out, err := k.Encrypt(value)
+		return out, err == nil, err
+	}
+	id, dataKey, ciphertext, err := k.unwrap(value)
+	if err != nil {
+		return "", false, err
+	}
+	if id == k.primary {
+		return value, false, nil
+	}
+	wrapped, err := seal(k.keys[k.primary], dataKey, []byte(k.primary))
+	if err != nil {
+		return "", false, err
+	}
+	return Prefix + k.primary + ":" + encode(wrapped) + ":" + encode(ciphertext), true, nil
+}
+
+// Digest returns the keyed digest of the value. Equal values give equal
+// digests as long as the primary key does not change, the value can not be
+// recovered from it. ErrNoMasterKey is returned without a primary key, an
+// unkeyed hash of an email address or phone number is easily reversed.
+func (k *Keyring) Digest(value string) (string, error) {
+	if !k.Enabled() {
+		return "", ErrNoMasterKey
+	}
+	mac := hmac.New(sha256.New, k.digestKey)
+	mac.Write([]byte(value))
+	return DigestPrefix + k.primary + ":" + hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+func (k *Keyring) unwrap(value string) (id string, dataKey, ciphertext []byte, err error) {
+	parts := strings.Split(strings.TrimPrefix(value, Prefix), ":")
+	if len(parts) != 3 {
+		return "", nil, nil, ErrMalformed
+	}
+	id = parts[0]
+	if k == nil || len(k.keys) == 0 {
+		return id, nil, nil, ErrNoMasterKey
+	}
+	master, ok := k.keys[id]
+	if !ok {
+		return id, nil, nil, fmt.Errorf("%w %s", ErrUnknownKey, id)
+	}
+	wrapped, err := decode(parts[1])
+	if err != nil {
+		return id, nil, nil, ErrMalformed
+	}
+	if ciphertext, err = decode(parts[2]); err != nil {
+		return id, nil, nil, ErrMalformed
+	}
+	if dataKey, err = open(master, wrapped, []byte(id)); err != nil {
+		return id, nil, nil, fmt.Errorf("unwrap data key with key %s: %w", id, err)
+	}
+	return id, dataKey, ciphertext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != keySize {
+		return nil, fmt.Errorf("key must be %d bytes, got %d", keySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal returns nonce || ciphertext.
+func seal(aead cipher.AEAD, plaintext, additional []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additional), nil
+}
+
+func open(aead cipher.AEAD, data, additional []byte) ([]byte, error) {
+	if len(data) < aead.NonceSize() {
+		return nil, ErrMalformed
+	}
+	return aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], additional)
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decode(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(s)
+}
+
+var (
+	defaultMu      sync.RWMutex
+	defaultKeyring = &Keyring{}
+)
+
+// Default returns the process wide keyring used by the package functions.
+func Default() *Keyring {
+	defaultMu.RLock()
+	defer defaultMu.RUnlock()
+	return defaultKeyring
+}
+
+// SetDefault replaces the process wide keyring.
+func SetDefault(k *Keyring) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultKeyring = k
+}
+
+// Encrypt seals the value with the default keyring.
+func Encrypt(plaintext string) (string, error) {
+	return Default().Encrypt(plaintext)
+}
+
+// Decrypt opens the value with the default keyring.
+func Decrypt(value string) (string, error) {
+	return Default().Decrypt(value)
+}
+
+// Digest returns the keyed digest of the value with the default keyring.
+func Digest(value string) (string, error) {
+	return Default().Digest(value)
+}
+
+// Redact hides a non-empty secret in API responses.
+func Redact(value string) string {
+	if value == "" {
+		return ""
+	}
+	return Redacted
+}
+
+// Provide the keyring for the dependency injector and install it as default
+func Provide(i do.Injector) (*Keyring, error) {
+	cfg := do.MustInvoke[*config.Config](i)
+	log := do.MustInvoke[*slog.Logger](i)
+	k, err := FromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if !k.Enabled() {
+		log.Warn("no secrets master key configured, credentials are stored in plaintext")
+	}
+	SetDefault(k)
+	return k, nil
+}
@@ -0,0 +1,331 @@
+package secrets
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func testKey(b byte) []byte {
+	return bytes.Repeat([]byte{b}, keySize)
+}
+
+func testKeyring(t *testing.T, primary string, keys map[string][]byte) *Keyring {
+	t.Helper()
+	k, err := NewKeyring(primary, keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k
+}
+
+// useKeyring installs k as the default keyring for the test.
+func useKeyring(t *testing.T, k *Keyring) {
+	prev := Default()
+	SetDefault(k)
+	t.Cleanup(func() { SetDefault(prev) })
+}
+
+// field returns the string value of a top level field of a JSON object.
+func field(t *testing.T, data []byte, name string) string {
+	t.Helper()
+	var obj map[string]any
+	if err := json.Unmarshal(data, &obj); err != nil {
+		t.Fatalf("%s: %v", data, err)
+	}
+	v, _ := obj[name].(string)
+	return v
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	k := testKeyring(t, "2026-10", map[string][]byte{"2026-10": testKey(1)})
+	sealed, err := k.Encrypt("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(sealed, Prefix+"2026-10:") || strings.Contains(sealed, "hunter2") {
+		t.Fatalf("sealed = %s", sealed)
+	}
+	if again, _ := k.Encrypt("hunter2"); again == sealed {
+		t.Error("equal values share a data key")
+	}
+	if again, _ := k.Encrypt(sealed); again != sealed {
+		t.Error("an encrypted value was encrypted twice")
+	}
+	// a password that only looks encrypted is sealed like any other
+	lookalike := Prefix + "2026-10:not:sealed"
+	disguised, err := k.Encrypt(lookalike)
+	if err != nil || disguised == lookalike {
+		t.Fatalf("Encrypt(%q) = %q, %v", lookalike, disguised, err)
+	}
+	if plain, err := k.Decrypt(disguised); err != nil || plain != lookalike {
+		t.Errorf("Decrypt = %q, %v", plain, err)
+	}
+	if plain, err := k.Decrypt(sealed); err != nil || plain != "hunter2" {
+		t.Errorf("Decrypt = %q, %v", plain, err)
+	}
+	if empty, _ := k.Encrypt(""); empty != "" {
+		t.Errorf("empty value sealed as %q", empty)
+	}
+
+	// legacy plaintext is read as is
+	if plain, err := k.Decrypt("legacy"); err != nil || plain != "legacy" {
+		t.Errorf("Decrypt(legacy) = %q, %v", plain, err)
+	}
+	// without a master key nothing is encrypted and nothing can be read
+	if plain, _ := (&Keyring{}).Encrypt("hunter2"); plain != "hunter2" {
+		t.Errorf("sealed without a master key: %s", plain)
+	}
+	if _, err := (&Keyring{}).Decrypt(sealed); !errors.Is(err, ErrNoMasterKey) {
+		t.Errorf("err = %v, want ErrNoMasterKey", err)
+	}
+	other := testKeyring(t, "other", map[string][]byte{"other": testKey(2)})
+	if _, err := other.Decrypt(sealed); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("err = %v, want ErrUnknownKey", err)
+	}
+	if _, err := k.Decrypt(Prefix + "2026-10:broken"); !errors.Is(err, ErrMalformed) {
+		t.Errorf("err = %v, want ErrMalformed", err)
+	}
+	tampered := sealed[:len(sealed)-2] + "AA"
+	if tampered == sealed {
+		tampered = sealed[:len(sealed)-2] + "BB"
+	}
+	if _, err := k.Decrypt(tampered); err == nil {
+		t.Error("tampered value decrypted")
+	}
+}
+
+func TestNewKeyring(t *testing.T) {
+	if _, err := NewKeyring("missing", map[string][]byte{"a": testKey(1)}); err == nil {
+		t.Error("primary outside the keyring accepted")
+	}
+	if _, err := NewKeyring("a:b", map[string][]byte{"a:b": testKey(1)}); err == nil {
+		t.Error("key id with a colon accepted")
+	}
+	if _, err := NewKeyring("a", map[string][]byte{"a": []byte("short")}); err == nil {
+		t.Error("short key accepted")
+	}
+}
+
+func TestStorageFields(t *testing.T) {
+	useKeyring(t, testKeyring(t, "k1", map[string][]byte{"k1": testKey(1)}))
+
+	tests := []struct {
+		storageType string
+		settings    string
+		secret      string
+		public      string
+	}{
+		{"s3", `{"provider":"aws","region":"eu-west-1","bucket":"mail","access_key_id":"AKIA","secret_access_key":"s3-secret"}`, "secret_access_key", "access_key_id"},
+		{"postgres", `{"host":"db","port":5432,"user":"shadow","password":"pg-secret","database":"mail"}`, "password", "user"},
+	}
+	for _, tt := range tests {
+		fields := StorageFields[tt.storageType]
+		sealed, err := EncryptFields([]byte(tt.settings), fields...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !IsEncrypted(field(t, sealed, tt.secret)) || bytes.Contains(sealed, []byte(field(t, []byte(tt.settings), tt.secret))) {
+			t.Errorf("%s: secret left in plaintext: %s", tt.storageType, sealed)
+		}
+		if field(t, sealed, tt.public) != field(t, []byte(tt.settings), tt.public) {
+			t.Errorf("%s: %s changed", tt.storageType, tt.public)
+		}
+		// encrypting again leaves the sealed settings alone
+		if again, _ := EncryptFields(sealed, fields...); !bytes.Equal(again, sealed) {
+			t.Errorf("%s: settings sealed twice", tt.storageType)
+		}
+		plain, err := DecryptFields(sealed, fields...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var want, got map[string]any
+		json.Unmarshal([]byte(tt.settings), &want)
+		json.Unmarshal(plain, &got)
+		if wantJSON, _ := json.Marshal(want); !bytes.Equal(wantJSON, mustMarshal(t, got)) {
+			t.Errorf("%s: round trip = %s, want %s", tt.storageType, plain, tt.settings)
+		}
+
+		// legacy plaintext settings are read as they are
+		if legacy, err := DecryptFields([]byte(tt.settings), fields...); err != nil || !bytes.Equal(legacy, []byte(tt.settings)) {
+			t.Errorf("%s: legacy settings = %s, %v", tt.storageType, legacy, err)
+		}
+	}
+
+	// missing, empty and non-string fields are skipped
+	for _, settings := range []string{`{"bucket":"mail"}`, `{"password":""}`, `{"password":42}`, ``} {
+		out, err := EncryptFields([]byte(settings), "password")
+		if err != nil || string(out) != settings {
+			t.Errorf("EncryptFields(%s) = %s, %v", settings, out, err)
+		}
+	}
+	if _, err := EncryptFields([]byte(`[1]`), "password"); err == nil {
+		t.Error("settings that are no object accepted")
+	}
+}
+
+func TestSealFields(t *testing.T) {
+	useKeyring(t, testKeyring(t, "k1", map[string][]byte{"k1": testKey(1)}))
+	fields := StorageFields["postgres"]
+
+	stored, err := SealFields([]byte(`{"host":"db","password":"first"}`), nil, fields...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// an update sending back the redacted placeholder keeps the stored secret
+	updated, err := SealFields([]byte(`{"host":"db2","password":"`+Redacted+`"}`), stored, fields...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if field(t, updated, "password") != field(t, stored, "password") || field(t, updated, "host") != "db2" {
+		t.Errorf("updated = %s", updated)
+	}
+	// a new secret replaces it
+	updated, err = SealFields([]byte(`{"host":"db","password":"second"}`), stored, fields...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plain, _ := DecryptFields(updated, fields...); field(t, plain, "password") != "second" {
+		t.Errorf("password = %s", plain)
+	}
+	// the placeholder without a previous value drops the field
+	created, err := SealFields([]byte(`{"host":"db","password":"`+Redacted+`"}`), nil, fields...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(created, []byte("password")) {
+		t.Errorf("created = %s", created)
+	}
+}
+
+func TestTokenJSON(t *testing.T) {
+	token := []byte(`{"access_token":"ya29.access","token_type":"Bearer","refresh_token":"1//refresh","expiry":"2026-10-19T12:00:00Z"}`)
+
+	// without a master key tokens are stored as they are
+	useKeyring(t, &Keyring{})
+	if out, err := EncryptJSON(token); err != nil || !bytes.Equal(out, token) {
+		t.Errorf("EncryptJSON without key = %s, %v", out, err)
+	}
+
+	useKeyring(t, testKeyring(t, "k1", map[string][]byte{"k1": testKey(1)}))
+	sealed, err := EncryptJSON(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the sealed token is still a JSON document for the JSONB column
+	var s string
+	if err := json.Unmarshal(sealed, &s); err != nil || !IsEncrypted(s) {
+		t.Fatalf("sealed token = %s, %v", sealed, err)
+	}
+	if bytes.Contains(sealed, []byte("refresh")) {
+		t.Errorf("refresh token left in plaintext: %s", sealed)
+	}
+	plain, err := DecryptJSON(sealed)
+	if err != nil || !bytes.Equal(plain, token) {
+		t.Errorf("DecryptJSON = %s, %v", plain, err)
+	}
+
+	// tokens stored before encryption was enabled are read as they are
+	if legacy, err := DecryptJSON(token); err != nil || !bytes.Equal(legacy, token) {
+		t.Errorf("legacy token = %s, %v", legacy, err)
+	}
+	if str, err := DecryptJSON([]byte(`"just a string"`)); err != nil || string(str) != `"just a string"` {
+		t.Errorf("plain JSON string = %s, %v", str, err)
+	}
+
+	useKeyring(t, &Keyring{})
+	if _, err := DecryptJSON(sealed); !errors.Is(err, ErrNoMasterKey) {
+		t.Errorf("err = %v, want ErrNoMasterKey", err)
+	}
+}
+
+// TestMigration follows the records through the rotation: legacy plaintext is
+// encrypted, values wrapped by a previous key are re-wrapped by the primary.
+func TestMigration(t *testing.T) {
+	old := testKeyring(t, "2026-01", map[string][]byte{"2026-01": testKey(1)})
+	k := testKeyring(t, "2026-10", map[string][]byte{"2026-01": testKey(1), "2026-10": testKey(2)})
+	fields := StorageFields["s3"]
+
+	legacy := []byte(`{"bucket":"mail","secret_access_key":"s3-secret"}`)
+	migrated, changed, err := k.RewrapFields(legacy, fields...)
+	if err != nil || !changed {
+		t.Fatalf("RewrapFields(legacy) changed = %v, %v", changed, err)
+	}
+	if v := field(t, migrated, "secret_access_key"); !strings.HasPrefix(v, Prefix+"2026-10:") {
+		t.Errorf("legacy secret = %s", v)
+	}
+	if _, changed, _ := k.RewrapFields(migrated, fields...); changed {
+		t.Error("settings wrapped by the primary key changed")
+	}
+
+	useKeyring(t, old)
+	oldSealed, err := EncryptFields(legacy, fields...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rewrapped, changed, err := k.RewrapFields(oldSealed, fields...)
+	if err != nil || !changed {
+		t.Fatalf("RewrapFields(old) changed = %v, %v", changed, err)
+	}
+	secret := field(t, rewrapped, "secret_access_key")
+	if !strings.HasPrefix(secret, Prefix+"2026-10:") {
+		t.Errorf("rewrapped secret = %s", secret)
+	}
+	// only the data key is re-wrapped, the ciphertext is kept
+	if tail := func(v string) string { return v[strings.LastIndex(v, ":"):] }; tail(secret) != tail(field(t, oldSealed, "secret_access_key")) {
+		t.Error("ciphertext changed on rewrap")
+	}
+	if plain, err := k.Decrypt(secret); err != nil || plain != "s3-secret" {
+		t.Errorf("Decrypt = %q, %v", plain, err)
+	}
+	if _, err := old.Decrypt(secret); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("the retired keyring read the rewrapped secret: %v", err)
+	}
+
+	token := []byte(`{"access_token":"a","refresh_token":"r"}`)
+	sealedToken, changed, err := k.RewrapJSON(token)
+	if err != nil || !changed {
+		t.Fatalf("RewrapJSON(legacy) changed = %v, %v", changed, err)
+	}
+	var s string
+	if err := json.Unmarshal(sealedToken, &s); err != nil {
+		t.Fatal(err)
+	}
+	if plain, err := k.Decrypt(s); err != nil || plain != string(token) {
+		t.Errorf("migrated token = %s, %v", plain, err)
+	}
+	if _, changed, _ := k.RewrapJSON(sealedToken); changed {
+		t.Error("token wrapped by the primary key changed")
+	}
+	if _, changed, _ := (&Keyring{}).RewrapJSON(token); changed {
+		t.Error("token changed without a master key")
+	}
+}
+
+func TestDigest(t *testing.T) {
+	k := testKeyring(t, "k1", map[string][]byte{"k1": testKey(1)})
+	a, err := k.Digest("ada@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := k.Digest("ada@example.com"); a != b || !strings.HasPrefix(a, DigestPrefix+"k1:") {
+		t.Errorf("digests %s and %s", a, b)
+	}
+	if b, _ := k.Digest("bob@example.com"); a == b {
+		t.Error("different values share a digest")
+	}
+	if _, err := (&Keyring{}).Digest("ada@example.com"); !errors.Is(err, ErrNoMasterKey) {
+		t.Errorf("err = %v, want ErrNoMasterKey", err)
+	}
+}
+
+func mustMarshal(t *testing.T, v any) []byte {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
@@ -23,12 +23,19 @@ import (
 	"github.com/lestrrat-go/jwx/v2/jwt"
 
 	"github.com/samber/do/v2"
+	"go.opentelemetry.io/otel"
 
+	"github.com/shadowapi/shadowapi/backend/internal/audit"
 	"github.com/shadowapi/shadowapi/backend/internal/auth"
 	zitadellog "github.com/shadowapi/shadowapi/backend/internal/auth/zitadel"
 	"github.com/shadowapi/shadowapi/backend/internal/config"
 	"github.com/shadowapi/shadowapi/backend/internal/handler"
+	"github.com/shadowapi/shadowapi/backend/internal/health"
+	"github.com/shadowapi/shadowapi/backend/internal/mcp"
+	"github.com/shadowapi/shadowapi/backend/internal/metrics"
+	"github.com/shadowapi/shadowapi/backend/internal/role"
 	"github.com/shadowapi/shadowapi/backend/internal/session"
+	"github.com/shadowapi/shadowapi/backend/internal/telemetry"
 	"github.com/shadowapi/shadowapi/backend/internal/zitadel"
 	"github.com/shadowapi/shadowapi/backend/pkg/api"
 	"github.com/shadowapi/shadowapi/backend/pkg/query"
@@ -43,10 +50,14 @@ type Server struct {
 	log *slog.Logger
 
 	api          *api.Server
-	listener     net.Listener
+	apiMetrics   http.Handler
+	httpServer   *http.Server
 	specsHandler http.Handler
 	zitadel      *zitadel.Client
 	handler      *handler.Handler
+	health       *health.Checker
+	mcp          *mcp.Server
+	roles        role.Roles
 	sessions     *session.Middleware
 	auth         *auth.Auth
 }
@@ -73,13 +84,17 @@ func Provide(i do.Injector) (*Server, error) {
 	authService := do.MustInvoke[*auth.Auth](i)
 	handlerService := do.MustInvoke[*handler.Handler](i)
 	authMiddleware := do.MustInvoke[*session.Middleware](i)
+	auditRecorder := do.MustInvoke[*audit.Recorder](i)
 	zitadelClient := zitadel.Provide(cfg)
 
 	srv, err := api.NewServer(
 		handlerService,
 		authService,
 		api.WithPathPrefix("/api/v1"),
-		api.WithMiddleware(authMiddleware.OgenMiddleware),
+		api.WithTracerProvider(otel.GetTracerProvider()),
+		// the recorder goes first to also audit the requests the session
+		// middleware rejects
+		api.WithMiddleware(auditRecorder.OgenMiddleware, authMiddleware.OgenMiddleware),
 		api.WithNotFound(func(w http.ResponseWriter, r *http.Request) {
 			log.Info("no ogen route matched, returning 404")
 			http.NotFound(w, r)
@@ -100,15 +115,19 @@ func Provide(i do.Injector) (*Server, error) {
 		cfg:          cfg,
 		log:          do.MustInvoke[*slog.Logger](i),
 		api:          srv,
+		apiMetrics:   telemetry.Handler(metrics.APIHandler(srv)),
 		specsHandler: specsHandler,
 		zitadel:      zitadelClient,
 		handler:      handlerService,
+		health:       do.MustInvoke[*health.Checker](i),
+		mcp:          do.MustInvoke[*mcp.Server](i),
+		roles:        do.MustInvoke[role.Roles](i),
 		sessions:     authMiddleware,
 		auth:         authService,
 	}, nil
 }
 
-// Run starts the server
+// Run serves until the server is shut down
 func (s *Server) Run(ctx context.Context) error {
 	address := fmt.Sprintf("%s:%d", s.cfg.Server.Host, s.cfg.Server.Port)
 	listener, err := net.Listen("tcp", address)
@@ -116,14 +135,32 @@ func (s *Server) Run(ctx context.Context) error {
 		s.log.Error("failed to listen", "error", err.Error())
 		return err
 	}
-	s.listener = listener
+	s.httpServer = &http.Server{Handler: s}
 
-	return http.Serve(listener, s)
+	if err := s.httpServer.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
 }
 
 // ServeHTTP wraps the API server and also serves the frontend dist (SPA) with index.html fallback
 func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	// auth endpoints first
+	// probes first, they are served whatever the roles
+	switch r.URL.Path {
+	case "/healthz":
+		s.health.ServeLive(w, r)
+		return
+	case "/readyz":
+		s.health.ServeReady(w, r)
+		return
+	}
+	// workers and schedulers only answer the probes
+	if !s.roles.Has(role.API) {
+		http.NotFound(w, r)
+		return
+	}
+
+	// auth endpoints
 	switch {
 	case r.URL.Path == "/login/zitadel":
 		s.handleZitadelLogin(w, r)
@@ -142,6 +179,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// the MCP server for LLM agents, authorized per operation like the API
+	if r.URL.Path == "/mcp" {
+		s.mcp.ServeHTTP(w, r)
+		return
+	}
+
 	// catch the API static specs requests, handle them separately
 	if s.specsHandler != nil && strings.HasPrefix(r.URL.Path, "/assets/docs/api") {
 		s.specsHandler.ServeHTTP(w, r)
@@ -151,7 +194,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// ogen api
 	if strings.HasPrefix(r.URL.Path, "/api/") || strings.HasPrefix(r.URL.Path, "/api") {
 		s.log.Debug("api request", "method", r.Method, "url", r.URL.Path)
-		s.api.ServeHTTP(w, r)
+		s.apiMetrics.ServeHTTP(w, r)
 		return
 	}
 
@@ -165,9 +208,13 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	s.api.ServeHTTP(w, r)
 }
 
-// Shutdown stops the server
-func (s *Server) Shutdown() error {
-	return s.listener.Close()
+// Shutdown stops accepting connections and waits until ctx is done for the
+// requests in progress to finish.
+func (s *Server) Shutdown(ctx context.Context) error {
+	if s.httpServer == nil {
+		return nil
+	}
+	return s.httpServer.Shutdown(ctx)
 }
 
 // ------------------------ frontend (SPA) helpers -----------------------------
@@ -282,7 +329,6 @@ func (s *Server) handleAuthCallback(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "id_token missing", http.StatusUnauthorized)
 		return
 	}
-	s.log.Info("rawID", rawID)
 
 	idToken, err := jwt.ParseString(rawID, jwt.WithVerify(false))
 	if err != nil {
@@ -290,7 +336,6 @@ func (s *Server) handleAuthCallback(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "token parse failed", http.StatusUnauthorized)
 		return
 	}
-	s.log.Info("idToken", idToken)
 
 	// pull standard OIDC claims
 	subject := idToken.Subject()
@@ -387,17 +432,13 @@ func (s *Server) handleAuthCallback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token := uuid.Must(uuid.NewV7()).String()
-	s.sessions.AddSession(token, user.UUID.String())
-	s.log.Debug("session created", "uid", user.UUID, "token", token)
-
-	http.SetCookie(w, &http.Cookie{
-		Name:     "sa_session",
-		Value:    token,
-		Path:     "/",
-		HttpOnly: true,
-		SameSite: http.SameSiteLaxMode,
-	})
+	cookie, err := s.sessions.StartSession(r, user.UUID.String(), session.LoginZitadel)
+	if err != nil {
+		s.log.Error("session create", "err", err)
+		http.Error(w, "session store failed", http.StatusInternalServerError)
+		return
+	}
+	http.SetCookie(w, cookie)
 
 	http.Redirect(w, r, "/", http.StatusFound)
 }
@@ -417,15 +458,13 @@ func (s *Server) handlePlainLogin(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "invalid credentials", http.StatusUnauthorized)
 		return
 	}
-	token := uuid.Must(uuid.NewV7()).String()
-	s.sessions.AddSession(token, userID)
-	http.SetCookie(w, &http.Cookie{
-		Name:     "sa_session",
-		Value:    token,
-		Path:     "/",
-		HttpOnly: true,
-		SameSite: http.SameSiteLaxMode,
-	})
+	cookie, err := s.sessions.StartSession(r, userID, session.LoginPassword)
+	if err != nil {
+		s.log.Error("session create", "err", err)
+		http.Error(w, "session store failed", http.StatusInternalServerError)
+		return
+	}
+	http.SetCookie(w, cookie)
 	_ = json.NewEncoder(w).Encode(map[string]bool{"active": true})
 }
 
@@ -433,7 +472,7 @@ func (s *Server) handlePlainLogin(w http.ResponseWriter, r *http.Request) {
 func (s *Server) handleLogout(w http.ResponseWriter, r *http.Request) {
 	sessCookie, errSess := r.Cookie("sa_session")
 	if errSess == nil {
-		s.sessions.DeleteSession(sessCookie.Value)
+		s.sessions.EndSession(r.Context(), sessCookie.Value)
 	}
 	http.SetCookie(w, &http.Cookie{
 		Name:   "sa_session",
@@ -469,7 +508,7 @@ func (s *Server) handleLogout(w http.ResponseWriter, r *http.Request) {
 func (s *Server) handleLogoutCallback(w http.ResponseWriter, r *http.Request) {
 	cookie, err := r.Cookie("sa_session")
 	if err == nil {
-		s.sessions.DeleteSession(cookie.Value)
+		s.sessions.EndSession(r.Context(), cookie.Value)
 	}
 	http.SetCookie(w, &http.Cookie{
 		Name:   "sa_session",
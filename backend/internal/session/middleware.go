@@ -2,25 +2,40 @@ package session
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
+	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"strings"
-	"sync"
+	"time"
 
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/ogen-go/ogen/middleware"
+	"github.com/ory/ladon"
 	"github.com/samber/do/v2"
 
+	"github.com/shadowapi/shadowapi/backend/internal/apikey"
 	"github.com/shadowapi/shadowapi/backend/internal/config"
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/policies"
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
 )
 
 // Middleware implements a pure Ogen middleware that checks for a bearer token
 // or a previously established local session.
 type Middleware struct {
 	log          *slog.Logger
+	dbp          *pgxpool.Pool
+	enforcer     *policies.Enforcer
 	bearerSecret string
-	sessions     map[string]string
-	mu           sync.RWMutex
+	trustProxy   bool
+	store        *Store
 }
 
 // Provide session middleware instance for the dependency injector.
@@ -28,8 +43,11 @@ func Provide(i do.Injector) (*Middleware, error) {
 	cfg := do.MustInvoke[*config.Config](i)
 	return &Middleware{
 		log:          do.MustInvoke[*slog.Logger](i),
+		dbp:          do.MustInvoke[*pgxpool.Pool](i),
+		enforcer:     do.MustInvoke[*policies.Enforcer](i),
 		bearerSecret: cfg.Auth.BearerToken,
-		sessions:     make(map[string]string),
+		trustProxy:   cfg.Auth.TrustProxyHeaders,
+		store:        do.MustInvoke[*Store](i),
 	}, nil
 }
 
@@ -37,30 +55,38 @@ func Provide(i do.Injector) (*Middleware, error) {
 func (m *Middleware) OgenMiddleware(req middleware.Request, next middleware.Next) (middleware.Response, error) {
 	r := req.Raw
 
-	// 1) machine-to-machine bearer
-	if m.validBearer(r) {
-		m.log.Debug("auth bearer ok")
-		req.SetContext(WithIdentity(req.Context, Identity{ID: "0"}))
-		return next(req)
+	// 1) API keys and the machine-to-machine bearer
+	if token := bearerToken(r); token != "" {
+		if apikey.IsKey(token) {
+			return m.withAPIKey(req, next, token)
+		}
+		if m.bearerSecret != "" && subtle.ConstantTimeCompare([]byte(token), []byte(m.bearerSecret)) == 1 {
+			m.log.Debug("auth bearer ok")
+			return m.withWorkspace(req, next, Identity{ID: MachineID, Source: SourceBearer})
+		}
 	}
 
 	// 2) first-class local session
 	if c, err := r.Cookie("sa_session"); err == nil {
-		m.mu.RLock()
-		uid, ok := m.sessions[c.Value]
-		m.mu.RUnlock()
-		if ok {
-			m.log.Debug("auth session ok", "uid", uid)
-			req.SetContext(WithIdentity(req.Context, Identity{ID: uid}))
-			return next(req)
+		sess, err := m.store.Lookup(req.Context, c.Value, m.clientIP(r))
+		switch {
+		case err == nil && sess.UserUUID != nil:
+			m.log.Debug("auth session ok", "uid", sess.UserUUID, "session_uuid", sess.UUID)
+			return m.withWorkspace(req, next, Identity{
+				ID:          sess.UserUUID.String(),
+				Source:      SourceSession,
+				SessionUUID: sess.UUID.String(),
+			})
+		case err != nil && !errors.Is(err, ErrNoSession):
+			m.log.Error("failed to look up session", "error", err)
+			return middleware.Response{}, ErrWithCode(http.StatusInternalServerError, errors.New("failed to check session"))
 		}
-		m.log.Debug("session cookie miss", "token", c.Value)
+		m.log.Debug("session cookie miss")
 		req.SetContext(context.WithValue(req.Context, "auth_reason", "session cookie miss"))
 	}
 
 	// 3) public endpoints that don't require auth
-	switch req.OperationID {
-	case "session-status", "oauth2-client-callback":
+	if isPublic(req.OperationID) {
 		return next(req)
 	}
 
@@ -68,25 +94,237 @@ func (m *Middleware) OgenMiddleware(req middleware.Request, next middleware.Next
 	return middleware.Response{}, ErrWithCode(http.StatusUnauthorized, errors.New("unauthorized"))
 }
 
-func (m *Middleware) validBearer(r *http.Request) bool {
+// withAPIKey authenticates an API key. The key is confined to its workspace:
+// a personal key acts with the current role of its user there, a service
+// account key with the role stored on the key. Scopes narrow both.
+func (m *Middleware) withAPIKey(req middleware.Request, next middleware.Next, token string) (middleware.Response, error) {
+	unauthorized := ErrWithCode(http.StatusUnauthorized, errors.New("invalid api key"))
+	q := query.New(m.dbp)
+	key, err := q.GetAPIKeyByHash(req.Context, apikey.Hash(token))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return middleware.Response{}, unauthorized
+	} else if err != nil {
+		m.log.Error("failed to get api key", "error", err)
+		return middleware.Response{}, ErrWithCode(http.StatusInternalServerError, errors.New("failed to check api key"))
+	}
+	if key.RevokedAt.Valid || (key.ExpiresAt.Valid && key.ExpiresAt.Time.Before(time.Now())) {
+		m.log.Debug("api key revoked or expired", "key_uuid", key.UUID)
+		return middleware.Response{}, unauthorized
+	}
+	ip := m.clientIP(req.Raw)
+	if !apikey.AllowsIP(key.AllowedIPs, ip) {
+		m.log.Warn("api key used from an address outside its allowlist", "key_uuid", key.UUID, "ip", ip)
+		return middleware.Response{}, ErrWithCode(http.StatusForbidden, errors.New("api key not allowed from this address"))
+	}
+	if key.WorkspaceUUID == nil {
+		return middleware.Response{}, unauthorized
+	}
+	if h := req.Raw.Header.Get(workspace.Header); h != "" && h != key.WorkspaceUUID.String() {
+		return middleware.Response{}, ErrWithCode(http.StatusForbidden, errors.New("api key is not valid for this workspace"))
+	}
+
+	id := Identity{
+		Source:        SourceAPIKey,
+		APIKeyUUID:    key.UUID.String(),
+		Scopes:        key.Scopes,
+		WorkspaceUUID: key.WorkspaceUUID.String(),
+	}
+	if key.UserUUID != nil {
+		row, err := q.ResolveUserWorkspace(req.Context, query.ResolveUserWorkspaceParams{
+			UserUUID:      converter.UuidToPgUUID(*key.UserUUID),
+			WorkspaceUUID: converter.UuidToPgUUID(*key.WorkspaceUUID),
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return middleware.Response{}, ErrWithCode(http.StatusForbidden, errors.New("api key owner has no access to the workspace"))
+		} else if err != nil {
+			m.log.Error("failed to resolve workspace", "key_uuid", key.UUID, "error", err)
+			return middleware.Response{}, ErrWithCode(http.StatusInternalServerError, errors.New("failed to resolve workspace"))
+		}
+		id.ID, id.WorkspaceRole = key.UserUUID.String(), row.Role
+	} else {
+		id.ID, id.WorkspaceRole = ServiceAccountID(key.UUID.String()), key.Role
+	}
+
+	if err := q.TouchAPIKey(req.Context, query.TouchAPIKeyParams{
+		LastUsedIP: pgtype.Text{String: ip, Valid: ip != ""},
+		UUID:       converter.UuidToPgUUID(key.UUID),
+	}); err != nil {
+		m.log.Warn("failed to record api key use", "key_uuid", key.UUID, "error", err)
+	}
+	m.log.Debug("auth api key ok", "key_uuid", key.UUID, "uid", id.ID, "operation", req.OperationID)
+	return m.serve(req, next, id)
+}
+
+// withWorkspace resolves the workspace selected by the X-Workspace-ID header,
+// or the caller's first workspace, and scopes the request to it. Every query
+// made with the request context is then confined to that workspace.
+func (m *Middleware) withWorkspace(req middleware.Request, next middleware.Next, id Identity) (middleware.Response, error) {
+	var requested pgtype.UUID
+	if h := req.Raw.Header.Get(workspace.Header); h != "" {
+		wsUUID, err := uuid.FromString(h)
+		if err != nil {
+			return middleware.Response{}, ErrWithCode(http.StatusBadRequest, errors.New("invalid workspace id"))
+		}
+		requested = converter.UuidToPgUUID(wsUUID)
+	}
+
+	if id.IsMachine() {
+		// the bearer token is trusted with every workspace
+		id.WorkspaceUUID, id.WorkspaceRole = workspace.DefaultUUID.String(), workspace.RoleOwner
+		if requested.Valid {
+			if _, err := query.New(m.dbp).GetWorkspace(req.Context, requested); errors.Is(err, pgx.ErrNoRows) {
+				return middleware.Response{}, ErrWithCode(http.StatusNotFound, errors.New("no such workspace"))
+			} else if err != nil {
+				m.log.Error("failed to get workspace", "error", err)
+				return middleware.Response{}, ErrWithCode(http.StatusInternalServerError, errors.New("failed to resolve workspace"))
+			}
+			id.WorkspaceUUID = uuid.UUID(requested.Bytes).String()
+		}
+	} else {
+		userUUID, err := uuid.FromString(id.ID)
+		if err != nil {
+			return middleware.Response{}, ErrWithCode(http.StatusUnauthorized, errors.New("unauthorized"))
+		}
+		row, err := query.New(m.dbp).ResolveUserWorkspace(req.Context, query.ResolveUserWorkspaceParams{
+			UserUUID:      converter.UuidToPgUUID(userUUID),
+			WorkspaceUUID: requested,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return middleware.Response{}, ErrWithCode(http.StatusForbidden, errors.New("no access to workspace"))
+		} else if err != nil {
+			m.log.Error("failed to resolve workspace", "uid", id.ID, "error", err)
+			return middleware.Response{}, ErrWithCode(http.StatusInternalServerError, errors.New("failed to resolve workspace"))
+		}
+		id.WorkspaceUUID, id.WorkspaceRole = row.UUID.String(), row.Role
+	}
+
+	return m.serve(req, next, id)
+}
+
+// serve scopes the request to the identity and its workspace, authorizes the
+// operation and passes the request on.
+func (m *Middleware) serve(req middleware.Request, next middleware.Next, id Identity) (middleware.Response, error) {
+	// an authenticated request without a workspace would run unscoped, across
+	// every workspace
+	wsUUID, err := uuid.FromString(id.WorkspaceUUID)
+	if err != nil || wsUUID.IsNil() {
+		m.log.Error("authenticated request without a workspace", "uid", id.ID, "source", id.Source)
+		return middleware.Response{}, ErrWithCode(http.StatusForbidden, errors.New("no access to workspace"))
+	}
+	ctx := WithIdentity(req.Context, id)
+	req.SetContext(workspace.WithUUID(ctx, wsUUID))
+	if err := m.authorize(req, id); err != nil {
+		return middleware.Response{}, err
+	}
+	return next(req)
+}
+
+// authorize checks the operation against the access policies of the caller's
+// role in the workspace and of the caller itself.
+func (m *Middleware) authorize(req middleware.Request, id Identity) error {
+	if isPublic(req.OperationID) {
+		return nil
+	}
+	kind, action := policies.Operation(req.OperationID)
+	var objectID string
+	if v, ok := req.Params.Path("uuid"); ok {
+		objectID, _ = v.(string)
+	}
+	resource := policies.Resource(kind, objectID)
+	if id.Source == SourceAPIKey && !apikey.Allows(id.Scopes, kind, action) {
+		level := apikey.LevelWrite
+		if action == policies.ActionRead {
+			level = apikey.LevelRead
+		}
+		return ErrWithCode(http.StatusForbidden, fmt.Errorf("api key lacks the %s:%s scope", kind, level))
+	}
+	subjects := []string{policies.RoleSubject(id.WorkspaceRole), policies.UserSubject(id.ID)}
+	err := m.enforcer.Authorize(req.Context, subjects, resource, action, ladon.Context{
+		"workspace_uuid": id.WorkspaceUUID,
+		"user_uuid":      id.ID,
+		"operation":      req.OperationID,
+	})
+	if errors.Is(err, policies.ErrDenied) {
+		m.log.Debug("access denied", "uid", id.ID, "role", id.WorkspaceRole, "resource", resource, "action", action)
+		return ErrWithCode(http.StatusForbidden, fmt.Errorf("%s on %s is not allowed for role %s", action, kind, id.WorkspaceRole))
+	} else if err != nil {
+		m.log.Error("failed to evaluate access policies", "error", err)
+		return ErrWithCode(http.StatusInternalServerError, errors.New("failed to evaluate access policies"))
+	}
+	return nil
+}
+
+// isPublic reports whether the operation is reachable without authentication.
+func isPublic(operationID string) bool {
+	switch operationID {
+	case "session-status", "oauth2-client-callback":
+		return true
+	}
+	return false
+}
+
+// bearerToken returns the token of a bearer Authorization header.
+func bearerToken(r *http.Request) string {
 	h := r.Header.Get("Authorization")
 	if h == "" {
-		return false
+		return ""
 	}
 	p := strings.SplitN(h, " ", 2)
-	return len(p) == 2 && strings.EqualFold(p[0], "Bearer") && p[1] == m.bearerSecret
+	if len(p) != 2 || !strings.EqualFold(p[0], "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(p[1])
 }
 
-func (m *Middleware) AddSession(token, uid string) {
-	m.mu.Lock()
-	m.sessions[token] = uid
-	m.mu.Unlock()
-	m.log.Debug("session added", "token", token, "uid", uid)
+// clientIP returns the address of the caller.
+func (m *Middleware) clientIP(r *http.Request) string {
+	return ClientIP(r, m.trustProxy)
 }
 
-func (m *Middleware) DeleteSession(token string) {
-	m.mu.Lock()
-	delete(m.sessions, token)
-	m.mu.Unlock()
-	m.log.Debug("session deleted", "token", token)
+// ClientIP returns the address of the caller, from the proxy headers when
+// they are trusted.
+func ClientIP(r *http.Request, trustProxy bool) string {
+	if trustProxy {
+		if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
+			first, _, _ := strings.Cut(xff, ",")
+			return strings.TrimSpace(first)
+		}
+		if ip := r.Header.Get("X-Real-IP"); ip != "" {
+			return strings.TrimSpace(ip)
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// StartSession persists a session for the user and returns its cookie. The
+// cookie lives as long as the absolute timeout, the idle timeout is enforced
+// on the server.
+func (m *Middleware) StartSession(r *http.Request, uid, source string) (*http.Cookie, error) {
+	token, sess, err := m.store.Create(r.Context(), uid, source, r.UserAgent(), m.clientIP(r))
+	if err != nil {
+		return nil, err
+	}
+	m.log.Debug("session started", "uid", uid, "session_uuid", sess.UUID, "source", source)
+	return &http.Cookie{
+		Name:     "sa_session",
+		Value:    token,
+		Path:     "/",
+		Expires:  sess.ExpiresAt.Time,
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	}, nil
+}
+
+// EndSession revokes the session of the cookie token.
+func (m *Middleware) EndSession(ctx context.Context, token string) {
+	if err := m.store.Revoke(ctx, token); err != nil {
+		m.log.Error("failed to revoke session", "error", err)
+		return
+	}
+	m.log.Debug("session ended")
 }
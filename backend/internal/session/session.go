@@ -6,15 +6,67 @@ import (
 
 type identityKey string
 
+// MachineID is the identity of requests authenticated with the bearer token.
+const MachineID = "0"
+
+// How the caller authenticated
+const (
+	SourceSession = "session"
+	SourceBearer  = "bearer"
+	SourceAPIKey  = "api_key"
+)
+
 type Identity struct {
+	// ID is the user UUID, MachineID for the bearer token or apikey:<uuid>
+	// for service account keys
 	ID string `json:"id"`
+	// Source tells how the caller authenticated
+	Source string `json:"source"`
+	// SessionUUID is the browser session of session callers
+	SessionUUID string `json:"session_uuid,omitempty"`
+	// APIKeyUUID is the key used by API key callers
+	APIKeyUUID string `json:"api_key_uuid,omitempty"`
+	// Scopes narrow what an API key may do, see apikey.Allows
+	Scopes []string `json:"scopes,omitempty"`
+	// WorkspaceUUID is the workspace the request is scoped to
+	WorkspaceUUID string `json:"workspace_uuid"`
+	// WorkspaceRole is the caller's role in that workspace
+	WorkspaceRole string `json:"workspace_role"`
+}
+
+// ServiceAccountID is the identity of a service account API key.
+func ServiceAccountID(keyUUID string) string {
+	return "apikey:" + keyUUID
+}
+
+// IsMachine reports whether the identity is the bearer token.
+func (i Identity) IsMachine() bool {
+	return i.ID == MachineID
 }
 
 // WithIdentity stores the identity in the context
 func WithIdentity(ctx context.Context, id Identity) context.Context {
+	if t, ok := ctx.Value(identityKey("trackerKey")).(*tracker); ok {
+		t.id, t.ok = id, true
+	}
 	return context.WithValue(ctx, identityKey("identityKey"), id)
 }
 
+type tracker struct {
+	id Identity
+	ok bool
+}
+
+// TrackIdentity returns a context that records the identity authenticated
+// further down the middleware chain. The returned function reports it once
+// the chain returned, also when the request was denied.
+func TrackIdentity(ctx context.Context) (context.Context, func() (Identity, bool)) {
+	t := &tracker{}
+	return context.WithValue(ctx, identityKey("trackerKey"), t), func() (Identity, bool) {
+		return t.id, t.ok
+	}
+}
+
 // GetIdentity retrieves the identity from the context
 func GetIdentity(ctx context.Context) (Identity, bool) {
 	id, ok := ctx.Value(identityKey("identityKey")).(Identity)
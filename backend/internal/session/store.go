@@ -0,0 +1,191 @@
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/do/v2"
+
+	"github.com/shadowapi/shadowapi/backend/internal/config"
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// How the user logged in
+const (
+	LoginPassword = "password"
+	LoginZitadel  = "zitadel"
+)
+
+const (
+	defaultIdleTimeout     = 24 * time.Hour
+	defaultAbsoluteTimeout = 30 * 24 * time.Hour
+
+	// renewAfter throttles the sliding renewal to one write per minute
+	renewAfter = time.Minute
+)
+
+// ErrNoSession is returned for unknown, revoked and expired sessions.
+var ErrNoSession = errors.New("no active session")
+
+// Store keeps browser sessions in Postgres, so they survive deploys and are
+// shared by all API replicas. Only a hash of the cookie token is stored.
+type Store struct {
+	log      *slog.Logger
+	dbp      *pgxpool.Pool
+	idle     time.Duration
+	absolute time.Duration
+}
+
+// ProvideStore provides the session store for the dependency injector
+func ProvideStore(i do.Injector) (*Store, error) {
+	cfg := do.MustInvoke[*config.Config](i)
+	idle, err := parseTimeout(cfg.Auth.Session.IdleTimeout, defaultIdleTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("auth.session.idle_timeout: %w", err)
+	}
+	absolute, err := parseTimeout(cfg.Auth.Session.AbsoluteTimeout, defaultAbsoluteTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("auth.session.absolute_timeout: %w", err)
+	}
+	return &Store{
+		log:      do.MustInvoke[*slog.Logger](i).With("service", "sessions"),
+		dbp:      do.MustInvoke[*pgxpool.Pool](i),
+		idle:     idle,
+		absolute: absolute,
+	}, nil
+}
+
+// Create starts a session for the user and returns the cookie token.
+func (s *Store) Create(ctx context.Context, userID, source, userAgent, ip string) (string, query.UserSession, error) {
+	userUUID, err := uuid.FromString(userID)
+	if err != nil {
+		return "", query.UserSession{}, fmt.Errorf("invalid user id: %w", err)
+	}
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", query.UserSession{}, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	q := query.New(s.dbp)
+	sess, err := q.CreateUserSession(ctx, query.CreateUserSessionParams{
+		UUID:      converter.UuidToPgUUID(uuid.Must(uuid.NewV7())),
+		UserUUID:  converter.UuidToPgUUID(userUUID),
+		TokenHash: hashToken(token),
+		Source:    source,
+		UserAgent: userAgent,
+		IP:        ip,
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(s.absolute), Valid: true},
+	})
+	if err != nil {
+		return "", sess, err
+	}
+	// logins are rare enough to double as the cleanup trigger
+	if n, err := q.DeleteEndedUserSessions(ctx, s.idleSeconds()); err != nil {
+		s.log.Warn("failed to delete ended sessions", "error", err)
+	} else if n > 0 {
+		s.log.Debug("deleted ended sessions", "count", n)
+	}
+	return token, sess, nil
+}
+
+// Lookup returns the active session of the cookie token and extends it.
+func (s *Store) Lookup(ctx context.Context, token, ip string) (query.UserSession, error) {
+	q := query.New(s.dbp)
+	sess, err := q.GetActiveUserSession(ctx, query.GetActiveUserSessionParams{
+		TokenHash:   hashToken(token),
+		IdleSeconds: s.idleSeconds(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return sess, ErrNoSession
+	} else if err != nil {
+		return sess, err
+	}
+	if time.Since(sess.LastSeenAt.Time) > renewAfter || sess.IP != ip {
+		if err := q.TouchUserSession(ctx, query.TouchUserSessionParams{
+			IP:   ip,
+			UUID: converter.UuidToPgUUID(sess.UUID),
+		}); err != nil {
+			s.log.Warn("failed to renew session", "session_uuid", sess.UUID, "error", err)
+		}
+	}
+	return sess, nil
+}
+
+// List returns the active sessions of the user, most recently used first.
+func (s *Store) List(ctx context.Context, userID string) ([]query.UserSession, error) {
+	userUUID, err := uuid.FromString(userID)
+	if err != nil {
+		return nil, nil
+	}
+	return query.New(s.dbp).GetActiveUserSessions(ctx, query.GetActiveUserSessionsParams{
+		UserUUID:    converter.UuidToPgUUID(userUUID),
+		IdleSeconds: s.idleSeconds(),
+	})
+}
+
+// Revoke ends the session of the cookie token.
+func (s *Store) Revoke(ctx context.Context, token string) error {
+	return query.New(s.dbp).RevokeUserSessionByToken(ctx, hashToken(token))
+}
+
+// RevokeID ends a session of the user and reports whether it was active.
+func (s *Store) RevokeID(ctx context.Context, userID string, sessionUUID uuid.UUID) (bool, error) {
+	userUUID, err := uuid.FromString(userID)
+	if err != nil {
+		return false, nil
+	}
+	n, err := query.New(s.dbp).RevokeUserSession(ctx, query.RevokeUserSessionParams{
+		UUID:     converter.UuidToPgUUID(sessionUUID),
+		UserUUID: converter.UuidToPgUUID(userUUID),
+	})
+	return n > 0, err
+}
+
+// RevokeAll ends every session of the user but keep, when set.
+func (s *Store) RevokeAll(ctx context.Context, userID string, keep uuid.UUID) (int64, error) {
+	userUUID, err := uuid.FromString(userID)
+	if err != nil {
+		return 0, nil
+	}
+	params := query.RevokeUserSessionsParams{UserUUID: converter.UuidToPgUUID(userUUID)}
+	if !keep.IsNil() {
+		params.KeepUUID = converter.UuidToPgUUID(keep)
+	}
+	return query.New(s.dbp).RevokeUserSessions(ctx, params)
+}
+
+func (s *Store) idleSeconds() int32 {
+	return int32(s.idle / time.Second)
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func parseTimeout(value string, fallback time.Duration) (time.Duration, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, errors.New("must be positive")
+	}
+	return d, nil
+}
@@ -0,0 +1,292 @@
+package storages
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// Blob reads and writes the content of a file row for one storage backend.
+//
+// Put returns the location to record in file.path and the bytes to record in
+// file.data; backends keeping content outside Postgres return nil data.
+type Blob interface {
+	Type() string
+	Get(ctx context.Context, f query.File) ([]byte, error)
+	Put(ctx context.Context, f query.File, data []byte) (path string, dbData []byte, err error)
+	Delete(ctx context.Context, f query.File) error
+}
+
+// ErrNoStorage is returned for a file whose storage is unknown and whose
+// content can not be reached from its type alone.
+var ErrNoStorage = errors.New("file has no storage")
+
+// NewBlob builds the Blob for a storage row based on its type and settings.
+func NewBlob(storage query.Storage) (Blob, error) {
+	switch storage.Type {
+	case "postgres":
+		return &postgresBlob{}, nil
+	case "hostfiles":
+		root, err := HostfilesRoot(storage)
+		if err != nil {
+			return nil, err
+		}
+		return &hostfilesBlob{root: root}, nil
+	case "s3":
+		settings, err := S3Settings(storage)
+		if err != nil {
+			return nil, err
+		}
+		client, err := NewS3Client(settings)
+		if err != nil {
+			return nil, err
+		}
+		return &s3Blob{client: client, bucket: settings.Bucket}, nil
+	default:
+		return nil, fmt.Errorf("unknown storage type %s", storage.Type)
+	}
+}
+
+// HostfilesRoot returns the directory of a hostfiles storage.
+func HostfilesRoot(storage query.Storage) (string, error) {
+	var settings api.StorageHostfiles
+	if err := json.Unmarshal(storage.Settings, &settings); err != nil {
+		return "", fmt.Errorf("invalid hostfiles settings: %w", err)
+	}
+	if settings.Path == "" {
+		return "", errors.New("hostfiles storage has empty path")
+	}
+	return settings.Path, nil
+}
+
+// S3Settings returns the decrypted settings of an S3 storage.
+func S3Settings(storage query.Storage) (api.StorageS3, error) {
+	var settings api.StorageS3
+	raw, err := secrets.DecryptFields(storage.Settings, secrets.StorageFields["s3"]...)
+	if err != nil {
+		return settings, fmt.Errorf("decrypt s3 settings: %w", err)
+	}
+	if err := json.Unmarshal(raw, &settings); err != nil {
+		return settings, fmt.Errorf("invalid s3 settings: %w", err)
+	}
+	return settings, nil
+}
+
+// NewS3Client creates an S3 client from storage settings. A provider value
+// that looks like a URL is used as a custom S3-compatible endpoint.
+func NewS3Client(settings api.StorageS3) (*s3.S3, error) {
+	if settings.Region == "" {
+		return nil, errors.New("empty s3 region in settings")
+	}
+	awsCfg := &aws.Config{
+		Region:      aws.String(settings.Region),
+		Credentials: credentials.NewStaticCredentials(settings.AccessKeyID, settings.SecretAccessKey, ""),
+	}
+	if strings.HasPrefix(settings.Provider, "http://") || strings.HasPrefix(settings.Provider, "https://") {
+		awsCfg.Endpoint = aws.String(settings.Provider)
+		awsCfg.S3ForcePathStyle = aws.Bool(true)
+	}
+	sess, err := session.NewSession(awsCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS session: %w", err)
+	}
+	return s3.New(sess), nil
+}
+
+// blobKey keeps the same layout the worker storages use when writing files.
+func blobKey(f query.File) string {
+	id := f.UUID.String()
+	return filepath.Join(id[:2], id+converter.FileExt(f.Name))
+}
+
+// postgresBlob keeps content in the file.data column of the main database.
+type postgresBlob struct{}
+
+func (b *postgresBlob) Type() string { return "postgres" }
+
+func (b *postgresBlob) Get(_ context.Context, f query.File) ([]byte, error) {
+	return f.Data, nil
+}
+
+func (b *postgresBlob) Put(_ context.Context, _ query.File, data []byte) (string, []byte, error) {
+	return "", data, nil
+}
+
+// Delete is a no-op, the data column is overwritten when the row moves.
+func (b *postgresBlob) Delete(_ context.Context, _ query.File) error {
+	return nil
+}
+
+// hostfilesBlob keeps content on the local file system under root. Files
+// record their full path, a blob without root reads and deletes them but has
+// nowhere to write.
+type hostfilesBlob struct {
+	root string
+}
+
+func (b *hostfilesBlob) Type() string { return "hostfiles" }
+
+func (b *hostfilesBlob) Get(_ context.Context, f query.File) ([]byte, error) {
+	if !f.Path.Valid || f.Path.String == "" {
+		return nil, fmt.Errorf("file %s has no path", f.UUID)
+	}
+	return os.ReadFile(f.Path.String)
+}
+
+func (b *hostfilesBlob) Put(_ context.Context, f query.File, data []byte) (string, []byte, error) {
+	if b.root == "" {
+		return "", nil, fmt.Errorf("%w to write file %s to", ErrNoStorage, f.UUID)
+	}
+	full := filepath.Join(b.root, blobKey(f))
+	if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+		return "", nil, fmt.Errorf("failed to create subdir: %w", err)
+	}
+	if err := os.WriteFile(full, data, 0o644); err != nil {
+		return "", nil, err
+	}
+	return full, nil, nil
+}
+
+func (b *hostfilesBlob) Delete(_ context.Context, f query.File) error {
+	if !f.Path.Valid || f.Path.String == "" {
+		return nil
+	}
+	if err := os.Remove(f.Path.String); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// s3Blob keeps content as objects in an S3 bucket.
+type s3Blob struct {
+	client *s3.S3
+	bucket string
+}
+
+func (b *s3Blob) Type() string { return "s3" }
+
+func (b *s3Blob) Get(ctx context.Context, f query.File) ([]byte, error) {
+	if !f.Path.Valid || f.Path.String == "" {
+		return nil, fmt.Errorf("file %s has no object key", f.UUID)
+	}
+	out, err := b.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(f.Path.String),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer out.Body.Close()
+	return io.ReadAll(out.Body)
+}
+
+func (b *s3Blob) Put(ctx context.Context, f query.File, data []byte) (string, []byte, error) {
+	key := "files/" + filepath.ToSlash(blobKey(f))
+	mime := "application/octet-stream"
+	if f.MimeType.Valid && f.MimeType.String != "" {
+		mime = f.MimeType.String
+	}
+	_, err := b.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(b.bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String(mime),
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return key, nil, nil
+}
+
+func (b *s3Blob) Delete(ctx context.Context, f query.File) error {
+	if !f.Path.Valid || f.Path.String == "" {
+		return nil
+	}
+	_, err := b.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(f.Path.String),
+	})
+	return err
+}
+
+// BlobResolver resolves the Blob holding the content of a file row, caching
+// one Blob per storage.
+type BlobResolver struct {
+	q     *query.Queries
+	blobs map[string]Blob
+}
+
+// NewBlobResolver creates a new BlobResolver.
+func NewBlobResolver(q *query.Queries) *BlobResolver {
+	return &BlobResolver{q: q, blobs: make(map[string]Blob)}
+}
+
+// ForFile returns the Blob for a file. Files written without a storage row
+// fall back to their storage type: postgres and hostfiles files can still be
+// read and deleted, an S3 object can't be found without its bucket and
+// ErrNoStorage is returned.
+func (r *BlobResolver) ForFile(ctx context.Context, f query.File) (Blob, error) {
+	if f.StorageUuid == nil {
+		switch f.StorageType {
+		case "postgres":
+			return &postgresBlob{}, nil
+		case "hostfiles":
+			return &hostfilesBlob{}, nil
+		default:
+			return nil, fmt.Errorf("%w: file %s of type %s", ErrNoStorage, f.UUID, f.StorageType)
+		}
+	}
+	key := f.StorageUuid.String()
+	if b, ok := r.blobs[key]; ok {
+		return b, nil
+	}
+	row, err := r.q.GetStorage(ctx, pgtype.UUID{Bytes: *f.StorageUuid, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("storage %s: %w", key, err)
+	}
+	b, err := NewBlob(row.Storage)
+	if err != nil {
+		return nil, err
+	}
+	r.blobs[key] = b
+	return b, nil
+}
+
+// MessageBody returns the body of a message, read from its file when a
+// storage migration moved it out of the message table.
+func (r *BlobResolver) MessageBody(ctx context.Context, body string, bodyFileUUID *uuid.UUID) (string, error) {
+	if body != "" || bodyFileUUID == nil {
+		return body, nil
+	}
+	row, err := r.q.GetFile(ctx, converter.UuidToPgUUID(*bodyFileUUID))
+	if err != nil {
+		return "", fmt.Errorf("body file %s: %w", bodyFileUUID, err)
+	}
+	b, err := r.ForFile(ctx, row.File)
+	if err != nil {
+		return "", err
+	}
+	data, err := b.Get(ctx, row.File)
+	if err != nil {
+		return "", fmt.Errorf("read body file %s: %w", bodyFileUUID, err)
+	}
+	return string(data), nil
+}
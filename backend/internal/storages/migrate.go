@@ -0,0 +1,443 @@
+package storages
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/db"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// Storage migration statuses
+const (
+	MigrationStatusPending = "pending"
+	MigrationStatusRunning = "running"
+	MigrationStatusPaused  = "paused"
+	MigrationStatusDone    = "done"
+	MigrationStatusFailed  = "failed"
+)
+
+// ErrChecksumMismatch is returned when the content read back from the target
+// storage differs from the content read from the source storage.
+var ErrChecksumMismatch = errors.New("checksum mismatch after copy")
+
+// ErrSameStorage is returned when the source and target storage are the same.
+var ErrSameStorage = errors.New("source and target storage are the same")
+
+// ErrMigrationRunning is returned by Run when another run holds the migration.
+var ErrMigrationRunning = errors.New("storage migration is already running")
+
+// MigrationLease is how long a running migration stays held without a
+// heartbeat. A run that crashed is taken over once its lease expired.
+const MigrationLease = 2 * time.Minute
+
+// MigrationActive reports whether a run holds the migration.
+func MigrationActive(mig query.StorageMigration) bool {
+	return mig.Status == MigrationStatusRunning && mig.UpdatedAt.Valid && time.Since(mig.UpdatedAt.Time) < MigrationLease
+}
+
+// MigrationOptions are the tiering rules of a storage migration.
+type MigrationOptions struct {
+	OlderThanDays int32
+	DeleteSource  bool
+	BatchSize     int32
+}
+
+// Migrator moves message bodies and file blobs between storages for a
+// storage_migration row.
+//
+// Message rows always live in the main database. The bodies of the messages
+// written to the source storage move to a file of the target storage, back to
+// the message table when the target is postgres, and file content
+// (attachments, raw emails and moved bodies) is moved as is. Every copy is read
+// back and verified with sha256 before the rows are repointed, and the
+// checkpoint is advanced in the same transaction, so an interrupted run resumes
+// after the last verified message or file.
+type Migrator struct {
+	log *slog.Logger
+	dbp *pgxpool.Pool
+}
+
+// NewMigrator creates a new storage migrator.
+func NewMigrator(log *slog.Logger, dbp *pgxpool.Pool) *Migrator {
+	return &Migrator{log: log.With("service", "storage-migrator"), dbp: dbp}
+}
+
+// Prepare returns the migration to run between source and target: the one
+// held by a run, else the unfinished one with the same options so it is
+// resumed from its checkpoint, else a new one.
+func (m *Migrator) Prepare(ctx context.Context, sourceUUID, targetUUID uuid.UUID, opts MigrationOptions) (query.StorageMigration, error) {
+	if sourceUUID == targetUUID {
+		return query.StorageMigration{}, ErrSameStorage
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 100
+	}
+	q := query.New(m.dbp)
+	if _, err := q.GetStorage(ctx, converter.UuidToPgUUID(sourceUUID)); err != nil {
+		return query.StorageMigration{}, fmt.Errorf("source storage: %w", err)
+	}
+	if _, err := q.GetStorage(ctx, converter.UuidToPgUUID(targetUUID)); err != nil {
+		return query.StorageMigration{}, fmt.Errorf("target storage: %w", err)
+	}
+
+	rows, err := q.GetStorageMigrations(ctx, query.GetStorageMigrationsParams{
+		SourceStorageUuid: converter.UuidToPgUUID(sourceUUID),
+		Status:            "",
+	})
+	if err != nil {
+		return query.StorageMigration{}, err
+	}
+	var resume *query.StorageMigration
+	for _, row := range rows {
+		mig := row.StorageMigration
+		if mig.Status == MigrationStatusDone || mig.TargetStorageUuid == nil || *mig.TargetStorageUuid != targetUUID {
+			continue
+		}
+		if MigrationActive(mig) {
+			return mig, nil
+		}
+		sameOptions := mig.OlderThanDays == opts.OlderThanDays && mig.DeleteSource == opts.DeleteSource &&
+			mig.BatchSize == opts.BatchSize
+		if resume == nil && sameOptions {
+			resume = &mig
+		}
+	}
+	if resume != nil {
+		return *resume, nil
+	}
+
+	return q.CreateStorageMigration(ctx, query.CreateStorageMigrationParams{
+		UUID:              converter.UuidToPgUUID(uuid.Must(uuid.NewV7())),
+		SourceStorageUuid: converter.UuidToPgUUID(sourceUUID),
+		TargetStorageUuid: converter.UuidToPgUUID(targetUUID),
+		Status:            MigrationStatusPending,
+		OlderThanDays:     opts.OlderThanDays,
+		DeleteSource:      opts.DeleteSource,
+		BatchSize:         opts.BatchSize,
+	})
+}
+
+// Run executes or resumes the migration with the given UUID. Cancelling ctx
+// pauses the migration, it can be resumed by calling Run again. The run holds
+// the migration with a heartbeat, ErrMigrationRunning is returned while
+// another run holds it.
+func (m *Migrator) Run(ctx context.Context, migrationUUID uuid.UUID) error {
+	q := query.New(m.dbp)
+	mig, err := q.ClaimStorageMigration(ctx, query.ClaimStorageMigrationParams{
+		UUID:         converter.UuidToPgUUID(migrationUUID),
+		LeaseSeconds: int32(MigrationLease / time.Second),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		row, err := q.GetStorageMigration(ctx, converter.UuidToPgUUID(migrationUUID))
+		if err != nil {
+			return fmt.Errorf("storage migration not found: %w", err)
+		}
+		if row.StorageMigration.Status == MigrationStatusDone {
+			m.log.Info("storage migration already done", "migration_uuid", migrationUUID)
+			return nil
+		}
+		return ErrMigrationRunning
+	} else if err != nil {
+		return fmt.Errorf("claim storage migration: %w", err)
+	}
+	log := m.log.With("migration_uuid", migrationUUID)
+
+	stop := m.heartbeat(log, mig.UUID)
+	runErr := m.run(ctx, log, mig)
+	stop()
+	switch {
+	case runErr == nil:
+		m.setStatus(log, mig.UUID, MigrationStatusDone, "")
+	case errors.Is(runErr, context.Canceled) || errors.Is(runErr, context.DeadlineExceeded):
+		log.Warn("storage migration interrupted, pausing")
+		m.setStatus(log, mig.UUID, MigrationStatusPaused, runErr.Error())
+	default:
+		log.Error("storage migration failed", "error", runErr)
+		m.setStatus(log, mig.UUID, MigrationStatusFailed, runErr.Error())
+	}
+	return runErr
+}
+
+func (m *Migrator) run(ctx context.Context, log *slog.Logger, mig query.StorageMigration) error {
+	q := query.New(m.dbp)
+	source, err := q.GetStorage(ctx, converter.UuidPtrToPgUUID(mig.SourceStorageUuid))
+	if err != nil {
+		return fmt.Errorf("source storage: %w", err)
+	}
+	target, err := q.GetStorage(ctx, converter.UuidPtrToPgUUID(mig.TargetStorageUuid))
+	if err != nil {
+		return fmt.Errorf("target storage: %w", err)
+	}
+	srcBlob, err := NewBlob(source.Storage)
+	if err != nil {
+		return fmt.Errorf("source storage: %w", err)
+	}
+	dstBlob, err := NewBlob(target.Storage)
+	if err != nil {
+		return fmt.Errorf("target storage: %w", err)
+	}
+
+	var createdBefore pgtype.Timestamptz
+	if mig.OlderThanDays > 0 {
+		createdBefore = pgtype.Timestamptz{
+			Time:  time.Now().UTC().AddDate(0, 0, -int(mig.OlderThanDays)),
+			Valid: true,
+		}
+	}
+	batchSize := mig.BatchSize
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	checkpoint := converter.UuidPtrToPgUUID(mig.CheckpointUuid)
+
+	log.Info("storage migration started",
+		"source", source.Storage.Name, "target", target.Storage.Name,
+		"older_than_days", mig.OlderThanDays, "resume_after", mig.CheckpointUuid,
+		"resume_messages_after", mig.MessageCheckpointUuid)
+
+	// bodies in the message table already are in postgres
+	if target.Storage.Type != "postgres" {
+		bodyCheckpoint := converter.UuidPtrToPgUUID(mig.MessageCheckpointUuid)
+		for {
+			bodies, err := q.GetMessageBodiesToMigrate(ctx, query.GetMessageBodiesToMigrateParams{
+				StorageUuid:   converter.UuidToPgUUID(source.Storage.UUID),
+				AfterUuid:     bodyCheckpoint,
+				CreatedBefore: createdBefore,
+				Limit:         batchSize,
+			})
+			if err != nil {
+				return fmt.Errorf("list messages: %w", err)
+			}
+			if len(bodies) == 0 {
+				break
+			}
+			for _, body := range bodies {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				if err := m.moveBody(ctx, mig, body, target.Storage, dstBlob); err != nil {
+					return fmt.Errorf("message %s: %w", body.UUID, err)
+				}
+				bodyCheckpoint = converter.UuidToPgUUID(body.UUID)
+			}
+		}
+	}
+
+	for {
+		files, err := q.GetFilesToMigrate(ctx, query.GetFilesToMigrateParams{
+			StorageUuid:   converter.UuidToPgUUID(source.Storage.UUID),
+			AfterUuid:     checkpoint,
+			CreatedBefore: createdBefore,
+			Limit:         batchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("list files: %w", err)
+		}
+		if len(files) == 0 {
+			log.Info("storage migration finished")
+			return nil
+		}
+		for _, row := range files {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := m.moveFile(ctx, mig, row.File, target.Storage, srcBlob, dstBlob); err != nil {
+				return fmt.Errorf("file %s: %w", row.File.UUID, err)
+			}
+			checkpoint = converter.UuidToPgUUID(row.File.UUID)
+		}
+	}
+}
+
+// moveBody copies the body of a message to a new file of the target storage,
+// verifies it and points the message to it. The copy in the message table is
+// dropped with DeleteSource.
+func (m *Migrator) moveBody(ctx context.Context, mig query.StorageMigration, body query.GetMessageBodiesToMigrateRow, target query.Storage, dst Blob) error {
+	data := []byte(body.Body)
+	f := query.File{
+		UUID:        uuid.Must(uuid.NewV7()),
+		StorageType: target.Type,
+		StorageUuid: &target.UUID,
+		Name:        "body.txt",
+		MimeType:    pgtype.Text{String: "text/plain; charset=utf-8", Valid: true},
+		MessageUuid: &body.UUID,
+	}
+	moved, err := copyVerified(ctx, f, data, dst)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.InTx(ctx, m.dbp, func(tx pgx.Tx) (struct{}, error) {
+		qtx := query.New(tx)
+		if _, err := qtx.CreateFile(ctx, query.CreateFileParams{
+			UUID:        converter.UuidToPgUUID(f.UUID),
+			StorageType: target.Type,
+			StorageUuid: converter.UuidToPgUUID(target.UUID),
+			Name:        f.Name,
+			MimeType:    f.MimeType,
+			Size:        pgtype.Int8{Int64: int64(len(data)), Valid: true},
+			Data:        moved.Data,
+			Path:        moved.Path,
+			MessageUuid: converter.UuidToPgUUID(body.UUID),
+		}); err != nil {
+			return struct{}{}, err
+		}
+		if err := qtx.SetMessageBodyFile(ctx, query.SetMessageBodyFileParams{
+			BodyFileUuid: converter.UuidToPgUUID(f.UUID),
+			ClearBody:    mig.DeleteSource,
+			UUID:         converter.UuidToPgUUID(body.UUID),
+		}); err != nil {
+			return struct{}{}, err
+		}
+		return struct{}{}, qtx.UpdateStorageMigrationMessageCheckpoint(ctx, query.UpdateStorageMigrationMessageCheckpointParams{
+			CheckpointUuid: converter.UuidToPgUUID(body.UUID),
+			Bytes:          int64(len(data)),
+			UUID:           converter.UuidToPgUUID(mig.UUID),
+		})
+	})
+	if err != nil {
+		_ = dst.Delete(ctx, moved)
+		return fmt.Errorf("update message row: %w", err)
+	}
+	return nil
+}
+
+// copyVerified writes data for f to dst and reads it back, it returns f with
+// the location recorded by dst.
+func copyVerified(ctx context.Context, f query.File, data []byte, dst Blob) (query.File, error) {
+	sum := sha256.Sum256(data)
+	path, dbData, err := dst.Put(ctx, f, data)
+	if err != nil {
+		return f, fmt.Errorf("write target: %w", err)
+	}
+
+	moved := f
+	moved.Path = pgtype.Text{String: path, Valid: path != ""}
+	moved.Data = dbData
+	copied, err := dst.Get(ctx, moved)
+	if err != nil {
+		return f, fmt.Errorf("read back target: %w", err)
+	}
+	if copiedSum := sha256.Sum256(copied); !bytes.Equal(sum[:], copiedSum[:]) {
+		_ = dst.Delete(ctx, moved)
+		return f, ErrChecksumMismatch
+	}
+	return moved, nil
+}
+
+// moveFile copies one file, verifies it and repoints the file row. A moved
+// body going to a postgres storage returns to its message row.
+func (m *Migrator) moveFile(ctx context.Context, mig query.StorageMigration, f query.File, target query.Storage, src, dst Blob) error {
+	data, err := src.Get(ctx, f)
+	if err != nil {
+		return fmt.Errorf("read source: %w", err)
+	}
+	moved, err := copyVerified(ctx, f, data, dst)
+	if err != nil {
+		return err
+	}
+
+	inlined, err := db.InTx(ctx, m.dbp, func(tx pgx.Tx) (bool, error) {
+		qtx := query.New(tx)
+		var n int64
+		if target.Type == "postgres" {
+			var err error
+			n, err = qtx.InlineMessageBody(ctx, query.InlineMessageBodyParams{
+				Body:         string(data),
+				BodyFileUuid: converter.UuidToPgUUID(f.UUID),
+			})
+			if err != nil {
+				return false, err
+			}
+		}
+		var err error
+		if n > 0 {
+			err = qtx.DeleteFile(ctx, converter.UuidToPgUUID(f.UUID))
+		} else {
+			err = qtx.UpdateFileLocation(ctx, query.UpdateFileLocationParams{
+				StorageType: target.Type,
+				StorageUuid: converter.UuidToPgUUID(target.UUID),
+				Data:        moved.Data,
+				Path:        moved.Path,
+				UUID:        converter.UuidToPgUUID(f.UUID),
+			})
+		}
+		if err != nil {
+			return false, err
+		}
+		return n > 0, qtx.UpdateStorageMigrationCheckpoint(ctx, query.UpdateStorageMigrationCheckpointParams{
+			CheckpointUuid: converter.UuidToPgUUID(f.UUID),
+			Bytes:          int64(len(data)),
+			UUID:           converter.UuidToPgUUID(mig.UUID),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("update file row: %w", err)
+	}
+
+	// never delete when both storages resolve to the very same location, a
+	// body back in its message has no row left to find the copy by
+	sameLocation := src.Type() == dst.Type() && f.Path == moved.Path
+	if (mig.DeleteSource || inlined) && !sameLocation {
+		if err := src.Delete(ctx, f); err != nil {
+			// the row already points to the target, a leftover copy is not fatal
+			m.log.Warn("failed to delete source copy", "file_uuid", f.UUID, "error", err)
+		}
+	}
+	return nil
+}
+
+// heartbeat keeps the lease of the migration until the returned stop is
+// called.
+func (m *Migrator) heartbeat(log *slog.Logger, id uuid.UUID) (stop func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(MigrationLease / 4)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := query.New(m.dbp).TouchStorageMigration(ctx, converter.UuidToPgUUID(id)); err != nil && ctx.Err() == nil {
+					log.Warn("failed to renew storage migration lease", "error", err)
+				}
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+func (m *Migrator) setStatus(log *slog.Logger, id uuid.UUID, status, errMsg string) {
+	var finishedAt pgtype.Timestamptz
+	if status == MigrationStatusDone || status == MigrationStatusFailed {
+		finishedAt = pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true}
+	}
+	// use a fresh context, the run context may already be cancelled
+	err := query.New(m.dbp).UpdateStorageMigrationStatus(context.Background(), query.UpdateStorageMigrationStatusParams{
+		Status:     status,
+		Error:      pgtype.Text{String: errMsg, Valid: errMsg != ""},
+		FinishedAt: finishedAt,
+		UUID:       converter.UuidToPgUUID(id),
+	})
+	if err != nil {
+		log.Error("failed to update storage migration status", "status", status, "error", err)
+	}
+}
@@ -0,0 +1,129 @@
+package storages
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// probeKeyPrefix is where the S3 probe objects are written, they are deleted
+// right after
+const probeKeyPrefix = "healthcheck/"
+
+// Probe checks that the storage can be used: its database answers, its
+// directory is writable, or an object can be written to its bucket, read back
+// and deleted. Nothing is left behind.
+func Probe(ctx context.Context, dbp *pgxpool.Pool, storage query.Storage) error {
+	switch storage.Type {
+	case "postgres":
+		return probePostgres(ctx, dbp, storage)
+	case "hostfiles":
+		return probeHostfiles(storage)
+	case "s3":
+		return probeS3(ctx, storage)
+	default:
+		return fmt.Errorf("unknown storage type %s", storage.Type)
+	}
+}
+
+func probePostgres(ctx context.Context, dbp *pgxpool.Pool, storage query.Storage) error {
+	var settings api.StoragePostgres
+	if err := unmarshalPostgresSettings(storage.Settings, &settings); err != nil {
+		return fmt.Errorf("invalid postgres settings: %w", err)
+	}
+	if settings.IsSameDatabase.Or(false) {
+		return dbp.Ping(ctx)
+	}
+	user, host, port := settings.User.Or(""), settings.Host.Or(""), settings.Port.Or("")
+	if user == "" || host == "" || port == "" {
+		return errors.New("missing user, host or port")
+	}
+	// a single connection, the pools of the workers are left alone
+	conn, err := pgx.Connect(ctx, buildPostgresURI(user, settings.Password.Or(""), host, port, settings.Options.Or("")))
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+	return conn.Ping(ctx)
+}
+
+func probeHostfiles(storage query.Storage) error {
+	var settings api.StorageHostfiles
+	if err := json.Unmarshal(storage.Settings, &settings); err != nil {
+		return fmt.Errorf("invalid hostfiles settings: %w", err)
+	}
+	if settings.Path == "" {
+		return errors.New("hostfiles storage has empty path")
+	}
+	f, err := os.CreateTemp(settings.Path, ".healthcheck-*")
+	if err != nil {
+		return fmt.Errorf("directory not writable: %w", err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString("ok"); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func probeS3(ctx context.Context, storage query.Storage) error {
+	raw, err := secrets.DecryptFields(storage.Settings, secrets.StorageFields["s3"]...)
+	if err != nil {
+		return fmt.Errorf("decrypt s3 settings: %w", err)
+	}
+	var settings api.StorageS3
+	if err := json.Unmarshal(raw, &settings); err != nil {
+		return fmt.Errorf("invalid s3 settings: %w", err)
+	}
+	client, err := NewS3Client(settings)
+	if err != nil {
+		return err
+	}
+
+	key := probeKeyPrefix + uuid.Must(uuid.NewV4()).String()
+	body := []byte("ok")
+	if _, err := client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(settings.Bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(body),
+	}); err != nil {
+		return fmt.Errorf("write: %w", err)
+	}
+	out, err := client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(settings.Bucket),
+		Key:    aws.String(key),
+	})
+	var got []byte
+	if err == nil {
+		got, err = io.ReadAll(out.Body)
+		out.Body.Close()
+	}
+	_, delErr := client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(settings.Bucket),
+		Key:    aws.String(key),
+	})
+	switch {
+	case err != nil:
+		return fmt.Errorf("read: %w", err)
+	case !bytes.Equal(got, body):
+		return errors.New("read back different content")
+	case delErr != nil:
+		return fmt.Errorf("delete: %w", delErr)
+	}
+	return nil
+}
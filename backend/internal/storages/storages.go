@@ -16,6 +16,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/samber/do/v2"
 
+	"github.com/shadowapi/shadowapi/backend/internal/secrets"
 	"github.com/shadowapi/shadowapi/backend/pkg/api"
 	"github.com/shadowapi/shadowapi/backend/pkg/query"
 )
@@ -41,7 +42,7 @@ func ProvideDynamicPGConnections(i do.Injector) (map[string]*pgxpool.Pool, error
 		uuidStr := record.UUID.String()
 
 		var pgSettings api.StoragePostgres
-		if err := json.Unmarshal(record.Settings, &pgSettings); err != nil {
+		if err := unmarshalPostgresSettings(record.Settings, &pgSettings); err != nil {
 			log.Error("failed to unmarshal postgres settings",
 				"error", err,
 				"storageUUID", uuidStr,
@@ -209,7 +210,7 @@ func ReconnectDynamicPGConnections(ctx context.Context, pgMap map[string]*pgxpoo
 						}
 
 						var pgSettings api.StoragePostgres
-						if err := json.Unmarshal(record.Settings, &pgSettings); err != nil {
+						if err := unmarshalPostgresSettings(record.Settings, &pgSettings); err != nil {
 							log.Error("failed to unmarshal Postgres settings for reconnect",
 								"storageUUID", uuidStr, "error", err,
 							)
@@ -361,3 +362,13 @@ func ConvertStringToPgUUID(u string) (pgtype.UUID, error) {
 	pgUUID.Valid = true
 	return pgUUID, nil
 }
+
+// unmarshalPostgresSettings decodes postgres storage settings with the
+// password decrypted.
+func unmarshalPostgresSettings(settings []byte, out *api.StoragePostgres) error {
+	raw, err := secrets.DecryptFields(settings, secrets.StorageFields["postgres"]...)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, out)
+}
@@ -0,0 +1,130 @@
+// Package telemetry sets up OpenTelemetry tracing. Spans follow a scheduled
+// sync from the scheduler tick through the fetch job, the provider calls, the
+// per message jobs and the storage writes; the trace context travels in the
+// NATS headers of the jobs.
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/samber/do/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/shadowapi/shadowapi/backend/internal/config"
+)
+
+// Exporters of the spans
+const (
+	ExporterNone   = ""
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+// defaultServiceName is the service.name of the spans when none is configured
+const defaultServiceName = "shadowapi"
+
+// tracer starts the spans of shadowapi itself, libraries get their own
+var tracer = otel.Tracer("github.com/shadowapi/shadowapi/backend")
+
+// Tracing is the installed tracer provider.
+type Tracing struct {
+	provider *sdktrace.TracerProvider
+}
+
+// Provide tracing for the dependency injector. The tracer provider and the
+// W3C trace context propagator are installed globally, nothing is exported
+// when no exporter is configured.
+func Provide(i do.Injector) (*Tracing, error) {
+	cfg := do.MustInvoke[*config.Config](i)
+	log := do.MustInvoke[*slog.Logger](i).With("service", "telemetry")
+
+	// the trace context is propagated even when nothing is exported here,
+	// so that the traces of the callers stay connected
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{},
+	))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch cfg.Tracing.Exporter {
+	case ExporterNone:
+		return &Tracing{}, nil
+	case ExporterOTLP:
+		var opts []otlptracehttp.Option
+		// the OTEL_EXPORTER_OTLP_* variables apply without an endpoint
+		if cfg.Tracing.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpointURL(cfg.Tracing.Endpoint))
+		}
+		exporter, err = otlptracehttp.New(context.Background(), opts...)
+	case ExporterStdout:
+		// stderr keeps the output of the commands clean
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stderr), stdouttrace.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q, use %q or %q", cfg.Tracing.Exporter, ExporterOTLP, ExporterStdout)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("tracing exporter: %w", err)
+	}
+
+	name := cfg.Tracing.ServiceName
+	if name == "" {
+		name = defaultServiceName
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(name),
+	))
+	if err != nil {
+		return nil, err
+	}
+	ratio := cfg.Tracing.SampleRatio
+	if ratio <= 0 {
+		ratio = 1
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+	)
+	otel.SetTracerProvider(provider)
+	log.Info("tracing enabled", "exporter", cfg.Tracing.Exporter, "service", name, "sample_ratio", ratio)
+	return &Tracing{provider: provider}, nil
+}
+
+// Shutdown exports the buffered spans and stops the provider.
+func (t *Tracing) Shutdown(ctx context.Context) error {
+	if t.provider == nil {
+		return nil
+	}
+	return t.provider.Shutdown(ctx)
+}
+
+// Start starts a span of shadowapi.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, opts...)
+}
+
+// End records the error on the span, if any, and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Attrs is a shorthand for the attributes of a span.
+func Attrs(kv ...attribute.KeyValue) trace.SpanStartOption {
+	return trace.WithAttributes(kv...)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/gotd/td/telegram"
 	"github.com/gotd/td/telegram/auth"
 	"github.com/gotd/td/tg"
+	"go.opentelemetry.io/otel"
 )
 
 // Telegram wraps gotd Telegram API implementation for convenient use in REST
@@ -27,6 +28,9 @@ func New(id int, hash string, opts ...Option) *Telegram {
 	if options.SessionStorage == nil {
 		options.SessionStorage = &session.StorageMemory{}
 	}
+	if options.TracerProvider == nil {
+		options.TracerProvider = otel.GetTracerProvider()
+	}
 
 	t := &Telegram{
 		client: telegram.NewClient(id, hash, options),
@@ -0,0 +1,93 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/do/v2"
+
+	"github.com/shadowapi/shadowapi/backend/internal/config"
+	"github.com/shadowapi/shadowapi/backend/internal/role"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/leader"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// Sessions keeps the stored Telegram sessions connected for their updates. It
+// runs with the telegram role, on the leader among the replicas only, so that
+// a session is not connected twice.
+type Sessions struct {
+	log  *slog.Logger
+	cfg  *config.Config
+	dbp  *pgxpool.Pool
+	stop context.CancelFunc
+	done chan struct{}
+}
+
+// Provide the sessions for the dependency injector, they are connected right
+// away when the process runs the telegram role.
+func Provide(i do.Injector) (*Sessions, error) {
+	s := &Sessions{
+		log: do.MustInvoke[*slog.Logger](i).With("service", "telegram"),
+		cfg: do.MustInvoke[*config.Config](i),
+	}
+	if !do.MustInvoke[role.Roles](i).Has(role.Telegram) {
+		return s, nil
+	}
+	if s.cfg.Telegram.AppID == 0 || s.cfg.Telegram.AppHash == "" {
+		s.log.Warn("telegram role without telegram.app_id and app_hash, no session is connected")
+		return s, nil
+	}
+	s.dbp = do.MustInvoke[*pgxpool.Pool](i)
+
+	var ctx context.Context
+	ctx, s.stop = context.WithCancel(do.MustInvoke[context.Context](i))
+	s.done = make(chan struct{})
+	go func() {
+		defer close(s.done)
+		leader.New(s.log, s.dbp, "telegram").Run(ctx, s.run)
+	}()
+	return s, nil
+}
+
+// run connects every stored session until ctx is done.
+func (s *Sessions) run(ctx context.Context) {
+	sessions, err := query.New(s.dbp).TgListSessions(ctx)
+	if err != nil {
+		s.log.Error("failed to list telegram sessions", "error", err)
+		return
+	}
+	var wg sync.WaitGroup
+	for _, session := range sessions {
+		if len(session.Session) == 0 {
+			// not signed in yet
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			log := s.log.With("session_id", session.ID)
+			log.Info("connecting telegram session")
+			if err := NewWorker(int32(session.ID), s.dbp, *s.cfg).Run(ctx); err != nil && ctx.Err() == nil {
+				log.Error("telegram session stopped", "error", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// Shutdown disconnects the sessions, waiting until ctx is done for them to
+// close.
+func (s *Sessions) Shutdown(ctx context.Context) error {
+	if s.stop == nil {
+		return nil
+	}
+	s.stop()
+	select {
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
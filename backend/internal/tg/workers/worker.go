@@ -13,6 +13,7 @@ import (
 	"github.com/gotd/td/telegram/peers"
 	"github.com/gotd/td/telegram/updates"
 	"github.com/gotd/td/tg"
+	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 )
 
@@ -43,6 +44,8 @@ func NewWorker(sessionID int32, pg *pgxpool.Pool, cfg config.Config) *Worker {
 	w.tc = telegram.NewClient(cfg.Telegram.AppID, cfg.Telegram.AppHash, telegram.Options{
 		Logger:         logger,
 		SessionStorage: storages.NewSessionStorage(pg, int64(sessionID)),
+		// the MTProto calls are traced through the global provider
+		TracerProvider: otel.GetTracerProvider(),
 		UpdateHandler: telegram.UpdateHandlerFunc(func(ctx context.Context, u tg.UpdatesClass) error {
 			return h.Handle(ctx, u)
 		}),
@@ -2,23 +2,40 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"log/slog"
-	"os"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/samber/do/v2"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/shadowapi/shadowapi/backend/internal/config"
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/embeddings"
+	"github.com/shadowapi/shadowapi/backend/internal/events"
+	"github.com/shadowapi/shadowapi/backend/internal/health"
 	"github.com/shadowapi/shadowapi/backend/internal/metrics"
 	"github.com/shadowapi/shadowapi/backend/internal/queue"
+	"github.com/shadowapi/shadowapi/backend/internal/role"
+	"github.com/shadowapi/shadowapi/backend/internal/telemetry"
 	"github.com/shadowapi/shadowapi/backend/internal/worker/jobs"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/leader"
 	"github.com/shadowapi/shadowapi/backend/internal/worker/monitor"
 	"github.com/shadowapi/shadowapi/backend/internal/worker/pipelines"
 	"github.com/shadowapi/shadowapi/backend/internal/worker/registry"
 	"github.com/shadowapi/shadowapi/backend/internal/worker/scheduler"
 	"github.com/shadowapi/shadowapi/backend/internal/worker/types"
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
 )
 
 var (
@@ -54,6 +71,10 @@ func CancelJob(jobUUID string) bool {
 	return true
 }
 
+// interruptGrace is how long the jobs still running at the shutdown deadline
+// get to return once cancelled
+const interruptGrace = 5 * time.Second
+
 // Broker routes messages to worker jobs.
 type Broker struct {
 	ctx     context.Context
@@ -63,11 +84,34 @@ type Broker struct {
 	queue   *queue.Queue
 	monitor *monitor.WorkerMonitor
 	cancel  func()
+
+	// stopBackground ends the leadership, and with it the schedulers, and
+	// the event dispatcher. background is closed once the schedulers returned,
+	// it is nil without the scheduler role.
+	stopBackground context.CancelFunc
+	background     chan struct{}
+
+	// mu guards draining and inflight. draining is set once the shutdown
+	// started, messages still delivered are handed back to the queue.
+	mu       sync.Mutex
+	draining bool
+	inflight map[string]*inflightJob
+	running  sync.WaitGroup
+	shutdown sync.Once
 }
 
-// ProvideLazy creates a new Broker without starting it.
-// Use this when you want to inject the broker but not have it automatically
-// start consuming messages (like in the loader).
+// inflightJob is a job running in this process.
+type inflightJob struct {
+	msg     queue.Msg
+	subject string
+	cancel  context.CancelFunc
+	// interrupted jobs were stopped by the shutdown, their message is
+	// redelivered instead of acknowledged
+	interrupted atomic.Bool
+}
+
+// ProvideLazy creates a new Broker without starting it, it only publishes
+// jobs.
 func ProvideLazy(i do.Injector) (*Broker, error) {
 	ctx := do.MustInvoke[context.Context](i)
 	cfg := do.MustInvoke[*config.Config](i)
@@ -75,20 +119,21 @@ func ProvideLazy(i do.Injector) (*Broker, error) {
 	log := do.MustInvoke[*slog.Logger](i).With("service", "broker")
 	q := do.MustInvoke[*queue.Queue](i)
 
-	monitoring := monitor.NewWorkerMonitor(log, dbp, false) // phase2 = false for now
-
-	log.Info("Creating broker in lazy mode (worker disabled)")
+	monitoring := monitor.NewWorkerMonitor(log, dbp)
+	// the embedder nodes of the pipelines index with the default index
+	do.MustInvoke[*embeddings.Index](i)
 
 	b := &Broker{
-		ctx:     ctx,
-		cfg:     cfg,
-		dbp:     dbp,
-		log:     log,
-		queue:   q,
-		monitor: monitoring,
+		ctx:      ctx,
+		cfg:      cfg,
+		dbp:      dbp,
+		log:      log,
+		queue:    q,
+		monitor:  monitoring,
+		inflight: make(map[string]*inflightJob),
 	}
 
-	// pipelinesMap is map of Datasource UUID to Pipeline
+	// pipelinesMap is map of Pipeline UUID to Pipeline
 	// - can be constructed with different Contact extractor, different Storages (archived in S3, or in DB)
 	//.- can have different filters (sync policies)
 	// Pipeline is attached to Datasource
@@ -97,63 +142,90 @@ func ProvideLazy(i do.Injector) (*Broker, error) {
 
 	// Register jobs without starting the broker
 	registry.RegisterJob(registry.WorkerSubjectEmailOAuthFetch, jobs.EmailOAuthFetchJobFactory(dbp, log, q, monitoring, pipelinesMap))
+	registry.RegisterJob(registry.WorkerSubjectEmailGraphFetch, jobs.EmailGraphFetchJobFactory(dbp, log, q, monitoring))
 	registry.RegisterJob(registry.WorkerSubjectEmailApplyPipeline, jobs.EmailPipelineMessageJobFactory(dbp, log, q, monitoring, pipelinesMap))
 	registry.RegisterJob(registry.WorkerSubjectTokenRefresh, jobs.TokenRefresherJobFactory(dbp, log, q, monitoring))
 	registry.RegisterJob(registry.WorkerSubjectDummy, jobs.DummyJobFactory(dbp, log, q, monitoring))
+	registry.RegisterJob(registry.WorkerSubjectStorageMigrate, jobs.StorageMigrateJobFactory(dbp, log, q, monitoring))
+	registry.RegisterJob(registry.WorkerSubjectRetention, jobs.RetentionJobFactory(dbp, log, q, monitoring))
 
 	return b, nil
 }
 
-// Provide creates and starts a new Broker.
-// This maintains backward compatibility with existing code.
+// Provide creates the broker and starts what the roles of the process run:
+// the consumer of the jobs and the webhook deliveries for the worker role, the
+// schedulers for the scheduler role. Either way the broker publishes jobs.
 func Provide(i do.Injector) (*Broker, error) {
-	// Check command name - never start worker for loader command
-	cmdName := os.Args[len(os.Args)-1]
-	if cmdName == "loader" {
-		b, err := ProvideLazy(i)
-		if err != nil {
-			return nil, err
-		}
-		b.log.Info("Detected loader command, worker disabled automatically")
-		return b, nil
-	}
-
-	// Check environment variable
-	if skipWorker := os.Getenv("SA_SKIP_WORKER"); skipWorker == "true" {
-		b, err := ProvideLazy(i)
-		if err != nil {
-			return nil, err
-		}
-		b.log.Info("Worker disabled via SA_SKIP_WORKER=true")
-		return b, nil
-	}
-
-	// Standard path - create and start worker
+	roles := do.MustInvoke[role.Roles](i)
+	checker := do.MustInvoke[*health.Checker](i)
 	b, err := ProvideLazy(i)
 	if err != nil {
 		return nil, err
 	}
-	b.log.Info("Starting worker broker in normal mode")
 
-	// Start the broker
-	if err := b.Start(b.ctx); err != nil {
-		b.log.Error("failed to start broker", "error", err)
-		return nil, err
-	}
+	var bgCtx context.Context
+	bgCtx, b.stopBackground = context.WithCancel(b.ctx)
 
-	// Start Schedulers one by one
-	// MultiEmailScheduler will activate either email or email_oauth type of pipelines
-	s := scheduler.NewMultiEmailScheduler(b.log, b.dbp, b.queue, b.monitor)
-	s.Start(b.ctx)
+	if roles.Has(role.Worker) {
+		b.log.Info("Starting the job consumer")
+		if err := b.Start(b.ctx); err != nil {
+			b.log.Error("failed to start broker", "error", err)
+			return nil, err
+		}
+		// delivers events, e.g. datasource.needs_reauth, to the webhooks
+		events.NewDispatcher(b.log, b.dbp, events.NewClient(b.cfg.Webhooks.AllowPrivate)).Start(bgCtx)
+
+		checker.AddReady(health.Check{Group: health.GroupRole, Name: role.Worker, Run: func(context.Context) (string, error) {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			if b.draining {
+				return "", errors.New("draining, no jobs are taken")
+			}
+			return fmt.Sprintf("consuming, %d jobs running", len(b.inflight)), nil
+		}})
+	}
 
-	// TODO @reactima rethink
-	// this will schedule to token refresh jobs even if no active pipelines
-	tokenScheduler := scheduler.NewTokenRefresherScheduler(b.log, b.dbp, b.queue, b.monitor)
-	tokenScheduler.Start(b.ctx)
+	if roles.Has(role.Scheduler) {
+		// Only the leader among the replicas runs the schedulers, the others
+		// take over when it stops.
+		elector := leader.New(b.log, b.dbp, "scheduler")
+		b.background = make(chan struct{})
+		go func() {
+			defer close(b.background)
+			elector.Run(bgCtx, b.runSchedulers)
+		}()
+
+		checker.AddReady(health.Check{Group: health.GroupRole, Name: role.Scheduler, Run: func(context.Context) (string, error) {
+			if elector.Leading() {
+				return "leading, the schedulers run here", nil
+			}
+			return "standby, another replica leads", nil
+		}})
+	}
 
 	return b, nil
 }
 
+// runSchedulers runs the schedulers until ctx is done.
+func (b *Broker) runSchedulers(ctx context.Context) {
+	schedulers := []interface{ Run(context.Context) }{
+		// publishes the fetch jobs of the pipelines whose schedulers are due
+		scheduler.NewPipelineScheduler(b.log, b.dbp, b.queue, b.monitor),
+		// refreshes the tokens of active pipelines ahead of their expiry
+		scheduler.NewTokenRefresherScheduler(b.log, b.dbp, b.queue, b.monitor),
+		scheduler.NewRetentionScheduler(b.log, b.queue),
+	}
+	var wg sync.WaitGroup
+	for _, s := range schedulers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.Run(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
 // Start ensures the stream exists and begins consuming messages.
 func (b *Broker) Start(ctx context.Context) error {
 	b.log.Debug("Broker starting", "stream", registry.WorkerStream)
@@ -173,16 +245,107 @@ func (b *Broker) Start(ctx context.Context) error {
 		b.log.Error("Failed to start consumer", "error", err)
 		return err
 	}
-	b.cancel = cancel
+	// jobs are cancelled on whichever worker runs them
+	unsubscribe, err := b.queue.Subscribe(registry.WorkerSubjectCancel, func(data []byte) {
+		if CancelJob(string(data)) {
+			b.log.Info("Cancelled job", "job_uuid", string(data))
+		}
+	})
+	if err != nil {
+		cancel()
+		return err
+	}
+	b.cancel = func() {
+		unsubscribe()
+		cancel()
+	}
 	return nil
 }
 
-// Shutdown stops the broker.
+// Shutdown drains the broker: the schedulers stop, no new jobs are pulled
+// and the running jobs get until ctx is done to finish. Jobs still running
+// then are cancelled, once they return their messages are handed back to the
+// queue for another worker and their rows queued again.
 func (b *Broker) Shutdown(ctx context.Context) error {
+	b.shutdown.Do(func() { b.drain(ctx) })
+	return nil
+}
+
+func (b *Broker) drain(ctx context.Context) {
+	b.mu.Lock()
+	b.draining = true
+	b.mu.Unlock()
 	if b.cancel != nil {
 		b.cancel()
 	}
-	return nil
+	if b.stopBackground != nil {
+		b.stopBackground()
+	}
+	if b.background != nil {
+		select {
+		case <-b.background:
+		case <-ctx.Done():
+			b.log.Warn("Schedulers did not stop before the shutdown deadline")
+		}
+	}
+
+	if waitTimeout(ctx, &b.running) {
+		b.log.Info("Broker drained")
+		return
+	}
+
+	// each job hands its message back once it returned, see handBack
+	b.mu.Lock()
+	for _, job := range b.inflight {
+		job.interrupted.Store(true)
+		job.cancel()
+	}
+	interrupted := len(b.inflight)
+	b.mu.Unlock()
+	b.log.Warn("Interrupting jobs still running at the shutdown deadline", "count", interrupted)
+	graceCtx, cancelGrace := context.WithTimeout(context.WithoutCancel(ctx), interruptGrace)
+	defer cancelGrace()
+	if waitTimeout(graceCtx, &b.running) {
+		return
+	}
+
+	// the messages of jobs ignoring the cancellation are left unacknowledged:
+	// handed back now, they would run twice
+	b.mu.Lock()
+	for id, job := range b.inflight {
+		b.log.Error("Interrupted job did not return, its message is redelivered once the ack wait expired",
+			"job_uuid", id, "subject", job.subject)
+	}
+	b.mu.Unlock()
+}
+
+// handBack queues the row of a job interrupted by the shutdown again and
+// hands its message back to the queue. It is called once the job returned.
+func (b *Broker) handBack(jobID string, job *inflightJob) {
+	// the deadline passed, the row is updated on a context of its own
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(b.ctx), interruptGrace)
+	defer cancel()
+	// the row goes first, a job already marked cancelled would be skipped by
+	// the worker it is redelivered to
+	b.monitor.RecordJobRequeued(ctx, jobID, "interrupted by worker shutdown")
+	if err := job.msg.Nak(); err != nil {
+		b.log.Error("Failed to hand back interrupted job", "job_uuid", jobID, "subject", job.subject, "error", err)
+	}
+}
+
+// waitTimeout waits for wg, it returns false when ctx is done first.
+func waitTimeout(ctx context.Context, wg *sync.WaitGroup) bool {
+	finished := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(finished)
+	}()
+	select {
+	case <-finished:
+		return true
+	case <-ctx.Done():
+		return false
+	}
 }
 
 // handleMessages routes incoming messages to the appropriate job.
@@ -198,20 +361,56 @@ func (b *Broker) handleMessages(ctx context.Context) func(msg queue.Msg) {
 		}
 
 		jobCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		job, ok := b.track(jobID, msg, cancel)
+		if !ok {
+			// a message delivered while draining goes back to another worker
+			_ = msg.Nak()
+			return
+		}
+		defer b.untrack(jobID)
 		registerCancel(jobID, cancel, jobCtx)
+		// a job without its workspace would run across every workspace
+		jobCtx, err := workspace.FromHeader(jobCtx, msgHeaderToString(msg, workspace.Header))
+		if err != nil {
+			b.log.Error("Broker handleMessages refusing job", "subject", msg.Subject(), "job_uuid", jobID, "error", err)
+			_ = msg.Term()
+			return
+		}
+		// the job continues the trace of whoever published it
+		jobCtx, span := telemetry.Start(queue.TraceContext(jobCtx, msg), msg.Subject(),
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			telemetry.Attrs(attribute.String("job.uuid", jobID), semconv.MessagingDestinationName(msg.Subject())),
+		)
+		var spanErr error
+		defer func() { telemetry.End(span, spanErr) }()
+
+		if b.cancelledBeforeStart(jobCtx, jobID) {
+			b.log.Info("Broker handleMessages skipping cancelled job", "subject", msg.Subject(), "job_uuid", jobID)
+			_ = msg.Ack()
+			return
+		}
 
-		job, err := registry.CreateJob(msg.Subject(), jobID, msg.Data())
+		worker, err := registry.CreateJob(msg.Subject(), jobID, msg.Data())
 		if err != nil {
 			if notReady, ok := err.(types.JobNotReadyError); ok {
 				_ = msg.NakWithDelay(notReady.Delay)
 				return
 			}
 			b.log.Error("Broker handleMessages failed to create job", "error", err)
+			spanErr = err
 			_ = msg.Term()
 			return
 		}
 
-		if err := job.Execute(jobCtx); err != nil {
+		err = worker.Execute(jobCtx)
+		if job.interrupted.Load() {
+			spanErr = context.Canceled
+			b.handBack(jobID, job)
+			return
+		}
+		if err != nil {
+			spanErr = err
 			duration := time.Since(start).Seconds()
 			metrics.JobExecutedDuration.WithLabelValues(msg.Subject(), "failure").Observe(duration)
 			if notReady, ok := err.(types.JobNotReadyError); ok {
@@ -229,12 +428,68 @@ func (b *Broker) handleMessages(ctx context.Context) func(msg queue.Msg) {
 	}
 }
 
-// msgHeaderToString attempts to extract the header value using the HeaderGetter interface.
+// track records a job running in this process for the drain, it returns
+// false once the broker is draining.
+func (b *Broker) track(jobID string, msg queue.Msg, cancel context.CancelFunc) (*inflightJob, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.draining {
+		return nil, false
+	}
+	job := &inflightJob{msg: msg, subject: msg.Subject(), cancel: cancel}
+	b.inflight[jobID] = job
+	b.running.Add(1)
+	return job, true
+}
+
+func (b *Broker) untrack(jobID string) {
+	b.mu.Lock()
+	delete(b.inflight, jobID)
+	b.mu.Unlock()
+	b.running.Done()
+}
+
+// cancelledBeforeStart tells whether the job was cancelled while queued.
+func (b *Broker) cancelledBeforeStart(ctx context.Context, jobID string) bool {
+	id, err := converter.ConvertStringToPgUUID(jobID)
+	if err != nil {
+		return false
+	}
+	row, err := query.New(b.dbp).GetWorkerJob(ctx, id)
+	return err == nil && row.WorkerJob.Status == monitor.StatusCancelled
+}
+
+// Enqueue publishes a job for the given subject, jobUUID is passed as X-Job-ID.
+func (b *Broker) Enqueue(ctx context.Context, subject, jobUUID string, args any) error {
+	payload, err := json.Marshal(args)
+	if err != nil {
+		return err
+	}
+	headers := queue.Headers{"X-Job-ID": jobUUID}
+	return b.queue.PublishWithHeaders(ctx, subject, headers, payload)
+}
+
+// EnqueueTracked publishes a job like Enqueue after recording it as queued in
+// worker_jobs with its args, so that it can be watched before it starts.
+// schedulerUUID is nil for jobs started on demand, pipelineUUID is set for the
+// runs of a pipeline.
+func (b *Broker) EnqueueTracked(ctx context.Context, subject string, schedulerUUID, pipelineUUID *uuid.UUID, jobUUID uuid.UUID, args any) error {
+	if err := b.monitor.RecordJobQueued(ctx, schedulerUUID, pipelineUUID, jobUUID, subject, args); err != nil {
+		return err
+	}
+	if err := b.Enqueue(ctx, subject, jobUUID.String(), args); err != nil {
+		b.monitor.RecordJobPublishFailed(ctx, jobUUID, subject, err)
+		return err
+	}
+	return nil
+}
+
+// msgHeaderToString returns a header of the message, empty when missing.
 func msgHeaderToString(m queue.Msg, key string) string {
 	if h, ok := m.(queue.HeaderGetter); ok {
 		return h.GetHeader(key)
 	}
-	return ""
+	return queue.MsgHeader(m).Get(key)
 }
 
 // randomShortID returns a short identifier based on the current time.
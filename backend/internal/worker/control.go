@@ -0,0 +1,106 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/queue"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/monitor"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/registry"
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+var (
+	// ErrJobFinished is returned when cancelling a job that already finished
+	ErrJobFinished = errors.New("job already finished")
+	// ErrJobNotFinished is returned when retrying a job that is still queued or running
+	ErrJobNotFinished = errors.New("job is still queued or running")
+	// ErrJobNoArgs is returned when retrying a job queued before its arguments were recorded
+	ErrJobNoArgs = errors.New("job has no recorded arguments to retry with")
+)
+
+// Cancel marks the job cancelled and signals whichever worker runs it, a
+// queued job is skipped when it is delivered.
+func Cancel(ctx context.Context, dbp *pgxpool.Pool, q *queue.Queue, jobUUID uuid.UUID) error {
+	queries := query.New(dbp)
+	row, err := queries.GetWorkerJob(ctx, converter.UuidToPgUUID(jobUUID))
+	if err != nil {
+		return err
+	}
+	if row.WorkerJob.FinishedAt.Valid {
+		return ErrJobFinished
+	}
+	if err := queries.CancelWorkerJob(ctx, converter.UuidToPgUUID(jobUUID)); err != nil {
+		return err
+	}
+	return q.Broadcast(registry.WorkerSubjectCancel, []byte(jobUUID.String()))
+}
+
+// Retry publishes a finished job again under a new UUID with the arguments it
+// was queued with and returns the new job.
+func Retry(ctx context.Context, log *slog.Logger, dbp *pgxpool.Pool, q *queue.Queue, jobUUID uuid.UUID) (query.WorkerJob, error) {
+	queries := query.New(dbp)
+	row, err := queries.GetWorkerJob(ctx, converter.UuidToPgUUID(jobUUID))
+	if err != nil {
+		return query.WorkerJob{}, err
+	}
+	job := row.WorkerJob
+	if !job.FinishedAt.Valid {
+		return query.WorkerJob{}, ErrJobNotFinished
+	}
+	// the retry runs in the workspace of the job
+	ctx = workspace.WithUUID(ctx, *job.WorkspaceUUID)
+
+	args := map[string]json.RawMessage{}
+	if len(job.Data) > 0 {
+		if err := json.Unmarshal(job.Data, &args); err != nil {
+			return query.WorkerJob{}, err
+		}
+	}
+	// recorded by the run, not part of the arguments
+	delete(args, "result")
+	delete(args, "error")
+	if len(args) == 0 {
+		return query.WorkerJob{}, ErrJobNoArgs
+	}
+
+	retryUUID := uuid.Must(uuid.NewV7())
+	if _, ok := args["job_uuid"]; ok {
+		args["job_uuid"], _ = json.Marshal(retryUUID.String())
+	}
+	if err := monitor.NewWorkerMonitor(log, dbp).RecordJobQueued(ctx, job.SchedulerUuid, job.PipelineUuid, retryUUID, job.Subject, args); err != nil {
+		return query.WorkerJob{}, err
+	}
+	payload, err := json.Marshal(args)
+	if err != nil {
+		return query.WorkerJob{}, err
+	}
+	headers := queue.Headers{"X-Job-ID": retryUUID.String()}
+	if err := q.PublishWithHeaders(ctx, job.Subject, headers, payload); err != nil {
+		return query.WorkerJob{}, err
+	}
+	log.Info("Retried job", "job_uuid", jobUUID, "retry_uuid", retryUUID, "subject", job.Subject)
+
+	retried, err := queries.GetWorkerJob(ctx, converter.UuidToPgUUID(retryUUID))
+	if err != nil {
+		return query.WorkerJob{}, err
+	}
+	return retried.WorkerJob, nil
+}
+
+// Cancel cancels the job, see Cancel.
+func (b *Broker) Cancel(ctx context.Context, jobUUID uuid.UUID) error {
+	return Cancel(ctx, b.dbp, b.queue, jobUUID)
+}
+
+// Retry retries the job, see Retry.
+func (b *Broker) Retry(ctx context.Context, jobUUID uuid.UUID) (query.WorkerJob, error) {
+	return Retry(ctx, b.log, b.dbp, b.queue, jobUUID)
+}
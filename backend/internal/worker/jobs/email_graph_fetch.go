@@ -0,0 +1,270 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/msgraph"
+	"github.com/shadowapi/shadowapi/backend/internal/oauth2"
+	"github.com/shadowapi/shadowapi/backend/internal/queue"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/monitor"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/registry"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/types"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// graphDefaultFolders are fetched when the datasource lists no folders.
+var graphDefaultFolders = []string{"inbox"}
+
+type EmailGraphFetchJobArgs struct {
+	SchedulerUUID string    `json:"scheduler_uuid"`
+	JobUUID       string    `json:"job_uuid"`
+	PipelineUUID  string    `json:"pipeline_uuid"`
+	LastFetched   time.Time `json:"last_fetched"`
+	types.RunOptions
+}
+
+// EmailGraphFetchJob fetches the changes of the mail folders of an
+// email_graph datasource with Microsoft Graph delta queries. The delta link
+// of each folder is kept in datasource_cursor, the first fetch reads the
+// whole folder. Backfills list the messages received in their range instead.
+type EmailGraphFetchJob struct {
+	log     *slog.Logger
+	dbp     *pgxpool.Pool
+	queue   *queue.Queue
+	monitor *monitor.WorkerMonitor
+
+	schedulerUUID string
+	jobUUID       string
+	pipelineUUID  string
+	opts          types.RunOptions
+}
+
+func EmailGraphFetchJobFactory(
+	dbp *pgxpool.Pool,
+	log *slog.Logger,
+	q *queue.Queue,
+	mon *monitor.WorkerMonitor,
+) types.JobFactory {
+	return func(data []byte) (types.Job, error) {
+		var args EmailGraphFetchJobArgs
+		if err := json.Unmarshal(data, &args); err != nil {
+			return nil, err
+		}
+		return &EmailGraphFetchJob{
+			log:           log,
+			dbp:           dbp,
+			queue:         q,
+			monitor:       mon,
+			schedulerUUID: args.SchedulerUUID,
+			jobUUID:       args.JobUUID,
+			pipelineUUID:  args.PipelineUUID,
+			opts:          args.RunOptions,
+		}, nil
+	}
+}
+
+// emailGraphSettings are the fields of the email_graph settings the job uses.
+type emailGraphSettings struct {
+	Email            string   `json:"email"`
+	OAuth2ClientUUID string   `json:"oauth2_client_uuid"`
+	Folders          []string `json:"folders"`
+}
+
+func (e *EmailGraphFetchJob) Execute(ctx context.Context) (err error) {
+	e.monitor.RecordJobStart(ctx, e.schedulerUUID, e.jobUUID, registry.WorkerSubjectEmailGraphFetch)
+	defer func() {
+		status := monitor.StatusDone
+		errMsg := ""
+		if err != nil {
+			status = monitor.StatusFailed
+			errMsg = err.Error()
+		}
+		e.monitor.RecordJobEnd(ctx, e.schedulerUUID, e.jobUUID, registry.WorkerSubjectEmailGraphFetch, status, errMsg)
+	}()
+
+	queries := query.New(e.dbp)
+	pipeUUID, err := uuid.FromString(e.pipelineUUID)
+	if err != nil {
+		e.log.Error("invalid pipeline UUID", "error", err)
+		return err
+	}
+	pipeRow, err := queries.GetPipeline(ctx, pgtype.UUID{Bytes: pipeUUID, Valid: true})
+	if err != nil {
+		e.log.Error("failed to get pipeline", "error", err)
+		return err
+	}
+	if !pipeRow.Pipeline.IsEnabled {
+		e.log.Info("pipeline is disabled", "pipeline_uuid", e.pipelineUUID)
+		return nil
+	}
+	dsRow, err := queries.GetDatasource(ctx, converter.UuidPtrToPgUUID(pipeRow.Pipeline.DatasourceUUID))
+	if err != nil {
+		e.log.Error("failed to get datasource", "error", err)
+		return err
+	}
+	ds := dsRow.Datasource
+	if !ds.IsEnabled {
+		e.log.Info("datasource is disabled", "datasource_uuid", ds.UUID.String())
+		return nil
+	}
+	if ds.Type != "email_graph" {
+		return fmt.Errorf("datasource %s is %s, not email_graph", ds.UUID, ds.Type)
+	}
+
+	var settings emailGraphSettings
+	if err := json.Unmarshal(ds.Settings, &settings); err != nil {
+		e.log.Error("failed to unmarshal email_graph settings", "error", err)
+		return err
+	}
+	if settings.OAuth2ClientUUID == "" {
+		return errors.New("email_graph datasource has no oauth2_client_uuid")
+	}
+	folders := settings.Folders
+	if len(folders) == 0 {
+		folders = graphDefaultFolders
+	}
+
+	clientToken, err := oauth2.GetClientToken(ctx, e.dbp, settings.OAuth2ClientUUID)
+	if err != nil {
+		e.log.Error("failed to get oauth2 token of client", "client_uuid", settings.OAuth2ClientUUID, "error", err)
+		return err
+	}
+	graph := msgraph.New(clientToken.HTTP)
+	run, err := newPipelineRun(ctx, e.log, e.dbp, e.queue, pipeRow.Pipeline, e.opts)
+	if err != nil {
+		e.log.Error("failed to prepare pipeline run", "error", err)
+		return err
+	}
+	if e.opts.Manual() {
+		defer func() { e.monitor.RecordJobResult(ctx, e.jobUUID, run.Result()) }()
+	}
+
+	cursors := map[string]string{}
+	rows, err := queries.GetDatasourceCursors(ctx, converter.UuidToPgUUID(ds.UUID))
+	if err != nil {
+		e.log.Error("failed to get datasource cursors", "error", err)
+		return err
+	}
+	for _, r := range rows {
+		cursors[r.Name] = r.State
+	}
+
+	e.log.Info("fetching emails", "datasource_uuid", ds.UUID.String(), "folders", folders)
+	var errs []error
+	for _, folder := range folders {
+		if run.Full() {
+			break
+		}
+		sync := e.syncFolder
+		if e.opts.Backfill() {
+			sync = e.backfillFolder
+		}
+		if err := sync(ctx, graph, run, ds.UUID, settings.Email, folder, cursors[graphCursorName(folder)]); err != nil {
+			e.log.Error("failed to sync mail folder", "folder", folder, "error", err)
+			errs = append(errs, fmt.Errorf("folder %s: %w", folder, err))
+		}
+	}
+
+	// If token expires soon (<2h) schedule a refresh job
+	if time.Until(clientToken.Token.Expiry) < 2*time.Hour {
+		_ = ScheduleTokenRefresh(ctx, e.queue, clientToken.TokenUUID, clientToken.Token.Expiry, e.log)
+	}
+	return errors.Join(errs...)
+}
+
+// graphCursorName is the datasource_cursor name of a mail folder.
+func graphCursorName(folder string) string {
+	return "graph:folder:" + folder
+}
+
+// syncFolder queues the pipeline jobs of the changed messages of the folder
+// and then stores the new delta link, a failure before that repeats the same
+// changes on the next run. Manual runs leave the delta link alone.
+func (e *EmailGraphFetchJob) syncFolder(ctx context.Context, graph *msgraph.Client, run *pipelineRun, dsUUID uuid.UUID, mailbox, folder, deltaLink string) error {
+	page, err := graph.Delta(ctx, folder, deltaLink)
+	if deltaLink != "" && msgraph.IsSyncStateInvalid(err) {
+		e.log.Warn("delta link expired, syncing folder again", "folder", folder)
+		page, err = graph.Delta(ctx, folder, "")
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, m := range page.Messages {
+		if run.Full() {
+			break
+		}
+		if err := e.handleMessage(ctx, graph, run, dsUUID, mailbox, m); err != nil {
+			return err
+		}
+	}
+	// removed messages stay stored, retention policies delete them
+	e.log.Info("mail folder synced", "folder", folder, "messages", len(page.Messages), "removed", len(page.Removed))
+
+	if page.DeltaLink == "" || e.opts.Manual() {
+		return nil
+	}
+	return query.New(e.dbp).SetDatasourceCursor(ctx, query.SetDatasourceCursorParams{
+		DatasourceUUID: converter.UuidToPgUUID(dsUUID),
+		Name:           graphCursorName(folder),
+		State:          page.DeltaLink,
+	})
+}
+
+// backfillFolder queues the pipeline jobs of the messages of the folder
+// received in the range of the run.
+func (e *EmailGraphFetchJob) backfillFolder(ctx context.Context, graph *msgraph.Client, run *pipelineRun, dsUUID uuid.UUID, mailbox, folder, _ string) error {
+	var since, until time.Time
+	if e.opts.Since != nil {
+		since = *e.opts.Since
+	}
+	if e.opts.Until != nil {
+		until = *e.opts.Until
+	}
+	limit := 0
+	if e.opts.Limit > 0 {
+		limit = e.opts.Limit - run.fetched
+	}
+	msgs, err := graph.Messages(ctx, folder, since, until, limit)
+	if err != nil {
+		return err
+	}
+	for _, m := range msgs {
+		if err := e.handleMessage(ctx, graph, run, dsUUID, mailbox, m); err != nil {
+			return err
+		}
+	}
+	e.log.Info("mail folder backfilled", "folder", folder, "messages", len(msgs))
+	return nil
+}
+
+// handleMessage hands a fetched message with its attachments to the run.
+func (e *EmailGraphFetchJob) handleMessage(ctx context.Context, graph *msgraph.Client, run *pipelineRun, dsUUID uuid.UUID, mailbox string, m msgraph.Message) error {
+	if m.IsDraft {
+		return nil
+	}
+	msg := m.ToAPI(dsUUID, mailbox)
+	if m.HasAttachments {
+		raw, err := graph.MIME(ctx, m.ID)
+		if err == nil {
+			err = msgraph.AttachMIME(&msg, raw)
+		}
+		if err != nil {
+			e.log.Warn("failed to fetch attachments", "message_id", m.ID, "error", err)
+		}
+	}
+	if err := run.Handle(ctx, &msg); err != nil {
+		return fmt.Errorf("publish pipeline job: %w", err)
+	}
+	return nil
+}
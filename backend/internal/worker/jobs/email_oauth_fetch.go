@@ -28,6 +28,7 @@ type EmailOAuthFetchJobArgs struct {
 	JobUUID       string    `json:"job_uuid"`
 	PipelineUUID  string    `json:"pipeline_uuid"`
 	LastFetched   time.Time `json:"last_fetched"`
+	types.RunOptions
 }
 
 type EmailOAuthFetchJob struct {
@@ -41,6 +42,7 @@ type EmailOAuthFetchJob struct {
 	jobUUID       string
 	pipelineUUID  string
 	lastFetched   time.Time
+	opts          types.RunOptions
 }
 
 func EmailOAuthFetchJobFactory(
@@ -65,6 +67,7 @@ func EmailOAuthFetchJobFactory(
 			jobUUID:       args.JobUUID,
 			pipelineUUID:  args.PipelineUUID,
 			lastFetched:   args.LastFetched,
+			opts:          args.RunOptions,
 		}, nil
 	}
 }
@@ -119,11 +122,19 @@ func (e *EmailOAuthFetchJob) Execute(ctx context.Context) (err error) {
 	}
 
 	e.log.Info("fetching emails", "datasource_uuid", dsRow.Datasource.UUID.String())
+	run, err := newPipelineRun(ctx, e.log, e.dbp, e.queue, pipeRow.Pipeline, e.opts)
+	if err != nil {
+		e.log.Error("failed to prepare pipeline run", "error", err)
+		return err
+	}
+	if e.opts.Manual() {
+		defer func() { e.monitor.RecordJobResult(ctx, e.jobUUID, run.Result()) }()
+	}
 
 	// ------------------------------------------------------------------
 	// Fetch Gmail messages
 	// ------------------------------------------------------------------
-	msgs, tokUUID, tokExpiry, err := fetchGmailEmails(ctx, dsRow.Datasource, e.lastFetched, e.dbp, e.log)
+	msgs, tokUUID, tokExpiry, err := fetchGmailEmails(ctx, dsRow.Datasource, e.lastFetched, e.opts, e.dbp, e.log)
 	if err != nil {
 		e.log.Error("failed to fetch Gmail messages", "error", err)
 		return err
@@ -135,19 +146,10 @@ func (e *EmailOAuthFetchJob) Execute(ctx context.Context) (err error) {
 
 	// Queue per‑message pipeline jobs
 	for _, m := range msgs {
-		raw, err := mustMarshal(m)
-		if err != nil {
-			e.log.Error("failed to marshal message", "error", err)
-			continue
+		if run.Full() {
+			break
 		}
-
-		data, err := json.Marshal(EmailPipelineMessageJobArgs{PipelineUUID: e.pipelineUUID, MessageData: raw})
-		if err != nil {
-			e.log.Error("failed to marshal pipeline job args", "error", err)
-			continue
-		}
-
-		if err := e.queue.Publish(ctx, registry.WorkerSubjectEmailApplyPipeline, data); err != nil {
+		if err := run.Handle(ctx, &m); err != nil {
 			e.log.Error("failed to publish pipeline job", "error", err)
 		}
 	}
@@ -159,18 +161,13 @@ func (e *EmailOAuthFetchJob) Execute(ctx context.Context) (err error) {
 	return nil
 }
 
-// mustMarshal marshals a value or panics (safe because data is internal).
-func mustMarshal(v any) (json.RawMessage, error) {
-	b, err := json.Marshal(v)
-	return b, err
-}
-
-// fetchGmailEmails fetches new Gmail messages created after `since`.
-// It returns the messages plus the token UUID and expiry so the caller can queue a refresh job.
+// fetchGmailEmails fetches new Gmail messages created after `since`, or
+// those in the range of a backfill. It returns the messages plus the token UUID and expiry so the caller can queue a refresh job.
 func fetchGmailEmails(
 	ctx context.Context,
 	ds query.Datasource,
 	since time.Time,
+	opts types.RunOptions,
 	dbp *pgxpool.Pool,
 	log *slog.Logger,
 ) ([]api.Message, uuid.UUID, time.Time, error) {
@@ -192,51 +189,14 @@ func fetchGmailEmails(
 		return nil, uuid.Nil, time.Time{}, errors.New("invalid OAuth2ClientUUID in settings")
 	}
 
-	// from oauth2_client table
-	clientConfig, err := oauth2.GetClientConfig(ctx, dbp, cfg.OAuth2ClientUUID)
+	clientToken, err := oauth2.GetClientToken(ctx, dbp, cfg.OAuth2ClientUUID)
 	if err != nil {
-		log.Error("failed to build client config", "error", err)
+		log.Error("failed to get oauth2 token of client", "client_uuid", cfg.OAuth2ClientUUID, "error", err)
 		return nil, uuid.Nil, time.Time{}, err
 	}
+	tokenUUID, token := clientToken.TokenUUID, clientToken.Token
 
-	// Get tokenUUID from oauth2_token table
-	queries := query.New(dbp)
-	pgClientUUID, _ := converter.ConvertStringToPgUUID(cfg.OAuth2ClientUUID)
-	rows, err := queries.GetOauth2TokensByClientUUID(ctx, pgClientUUID)
-	if err != nil {
-		log.Error("failed to query tokens by client", "error", err)
-		return nil, uuid.Nil, time.Time{}, err
-	}
-	if len(rows) == 0 {
-		log.Error("no tokens found for client", "client_uuid", cfg.OAuth2ClientUUID)
-		return nil, uuid.Nil, time.Time{}, errors.New("no oauth2 token found for client")
-	}
-	latest := rows[0]
-	for _, r := range rows {
-		lu := r.Oauth2Token.UpdatedAt
-		if !latest.Oauth2Token.UpdatedAt.Valid || (lu.Valid && lu.Time.After(latest.Oauth2Token.UpdatedAt.Time)) {
-			latest = r
-		}
-	}
-	tokenUUID := latest.Oauth2Token.UUID
-	if tokenUUID == uuid.Nil {
-		log.Error("invalid token UUID in settings")
-		return nil, uuid.Nil, time.Time{}, errors.New("invalid token UUID in settings")
-	}
-
-	// Build persistent token store from oauth2_token table – will auto‑persist refreshes back to DB.
-	tokenStore, err := oauth2.NewTokenStore(ctx, &clientConfig.Config, dbp, tokenUUID, 5*time.Minute)
-	if err != nil {
-		log.Error("failed to create token store", "error", err)
-		return nil, uuid.Nil, time.Time{}, err
-	}
-
-	token, err := tokenStore.Token()
-	if err != nil {
-		return nil, uuid.Nil, time.Time{}, err
-	}
-
-	httpClient := clientConfig.Config.Client(ctx, token)
+	httpClient := clientToken.HTTP
 	gmailSvc, err := gmail.NewService(ctx, option.WithHTTPClient(httpClient))
 	if err != nil {
 		return nil, uuid.Nil, time.Time{}, err
@@ -245,7 +205,22 @@ func fetchGmailEmails(
 	// TODO @reactima use query policy??
 	// Use Gmail search query `after:YYYY/MM/DD`.
 	queryStr := fmt.Sprintf("after:%s", since.Format("2024/01/01"))
-	listRes, err := gmailSvc.Users.Messages.List("me").Q(queryStr).Do()
+	if opts.Backfill() {
+		// epoch seconds are exact, dates are in the timezone of the mailbox
+		var bounds []string
+		if opts.Since != nil {
+			bounds = append(bounds, fmt.Sprintf("after:%d", opts.Since.Unix()))
+		}
+		if opts.Until != nil {
+			bounds = append(bounds, fmt.Sprintf("before:%d", opts.Until.Unix()))
+		}
+		queryStr = strings.Join(bounds, " ")
+	}
+	list := gmailSvc.Users.Messages.List("me").Q(queryStr)
+	if opts.Limit > 0 {
+		list = list.MaxResults(int64(opts.Limit))
+	}
+	listRes, err := list.Do()
 	if err != nil {
 		return nil, uuid.Nil, time.Time{}, err
 	}
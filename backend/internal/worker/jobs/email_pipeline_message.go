@@ -3,14 +3,18 @@ package jobs
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/gofrs/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
 	"github.com/shadowapi/shadowapi/backend/internal/queue"
 	"github.com/shadowapi/shadowapi/backend/internal/worker/monitor"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/pipelines"
 	"github.com/shadowapi/shadowapi/backend/internal/worker/registry"
 	"github.com/shadowapi/shadowapi/backend/internal/worker/types"
 	"github.com/shadowapi/shadowapi/backend/pkg/api"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
 	"log/slog"
 )
 
@@ -19,6 +23,8 @@ type EmailPipelineMessageJobArgs struct {
 	SchedulerUUID string          `json:"scheduler_uuid"`
 	JobUUID       string          `json:"job_uuid"`
 	MessageData   json.RawMessage `json:"message_data"`
+	// StorageUUID overrides the storage of the pipeline, see types.RunOptions
+	StorageUUID string `json:"storage_uuid,omitempty"`
 }
 
 type EmailPipelineMessageJob struct {
@@ -31,6 +37,7 @@ type EmailPipelineMessageJob struct {
 	schedulerUUID string
 	jobUUID       string
 	pipelineUUID  string
+	storageUUID   string
 	messageData   json.RawMessage
 }
 
@@ -57,6 +64,7 @@ func EmailPipelineMessageJobFactory(
 			pipelineUUID:  args.PipelineUUID,
 			schedulerUUID: args.SchedulerUUID,
 			jobUUID:       recordID.String(),
+			storageUUID:   args.StorageUUID,
 			messageData:   args.MessageData,
 		}, nil
 	}
@@ -84,10 +92,41 @@ func (e *EmailPipelineMessageJob) Execute(ctx context.Context) (err error) {
 	}
 
 	pl, ok := (*e.pipelinesMap)[e.pipelineUUID]
-	if !ok {
-		e.log.Error("pipeline not found", "uuid", e.pipelineUUID)
-		return nil
+	if !ok || e.storageUUID != "" {
+		// pipelines created after the worker started and storage overrides
+		// are built for the message
+		if pl, err = e.buildPipeline(ctx); err != nil {
+			e.log.Error("failed to build pipeline", "uuid", e.pipelineUUID, "error", err)
+			return err
+		}
 	}
 	err = pl.Run(ctx, &msg)
 	return err
 }
+
+func (e *EmailPipelineMessageJob) buildPipeline(ctx context.Context) (types.Pipeline, error) {
+	pipeUUID, err := uuid.FromString(e.pipelineUUID)
+	if err != nil {
+		return nil, err
+	}
+	pipeRow, err := query.New(e.dbp).GetPipeline(ctx, converter.UuidToPgUUID(pipeUUID))
+	if err != nil {
+		return nil, err
+	}
+	storageUUID := pipeRow.Pipeline.StorageUuid
+	if e.storageUUID != "" {
+		override, err := uuid.FromString(e.storageUUID)
+		if err != nil {
+			return nil, err
+		}
+		storageUUID = &override
+	}
+	if storageUUID == nil {
+		return nil, errors.New("pipeline has no storage")
+	}
+	storage, err := pipelines.OpenStorage(ctx, e.log, e.dbp, *storageUUID)
+	if err != nil {
+		return nil, err
+	}
+	return pipelines.Build(ctx, e.log, e.dbp, pipeRow.Pipeline, storage)
+}
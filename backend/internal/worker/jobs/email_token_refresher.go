@@ -3,6 +3,7 @@ package jobs
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"github.com/shadowapi/shadowapi/backend/internal/converter"
 	"github.com/shadowapi/shadowapi/backend/internal/worker/monitor"
 	"github.com/shadowapi/shadowapi/backend/internal/worker/registry"
@@ -15,7 +16,6 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"golang.org/x/oauth2"
 
-	"github.com/shadowapi/shadowapi/backend/internal/db"
 	oauthTools "github.com/shadowapi/shadowapi/backend/internal/oauth2"
 	"github.com/shadowapi/shadowapi/backend/internal/queue"
 	"github.com/shadowapi/shadowapi/backend/pkg/query"
@@ -72,12 +72,16 @@ func TokenRefresherJobFactory(
 	}
 }
 
-// Execute performs the token refresh work.
-// It uses a transaction to:
-//  1. Retrieve the current token data.
-//  2. Unmarshal the token.
-//  3. Get the OAuth2 client config and refresh the token.
-//  4. Reschedule the next refresh by publishing a new token refresh message.
+// Execute refreshes the token ahead of its expiry and records the outcome in
+// the token health:
+//  1. Tokens needing a new login are skipped.
+//  2. The token is refreshed even if it is still valid.
+//  3. A refreshed token is stored with its granted scopes, a failure is
+//     counted. When the grant is gone the datasources using the token are
+//     flagged for re-consent and their schedulers paused.
+//
+// The TokenRefresherScheduler enqueues the next refresh once the token is
+// about to expire again.
 func (t *TokenRefresherJob) Execute(ctx context.Context) (err error) {
 	t.monitor.RecordJobStart(ctx, t.schedulerUUID, t.jobUUID, registry.WorkerSubjectTokenRefresh)
 	defer func() {
@@ -95,58 +99,66 @@ func (t *TokenRefresherJob) Execute(ctx context.Context) (err error) {
 
 	log := t.log.With("token_uuid", t.args.TokenUUID, "worker", "TokenRefresherJob")
 	log.Debug("Starting token refresh job")
-	refreshedToken, err := db.InTx(ctx, t.dbp, func(tx pgx.Tx) (*oauth2.Token, error) {
-		qh := query.New(t.dbp).WithTx(tx)
-		tokenRow, err := qh.GetOauth2TokenByUUID(ctx, converter.UuidToPgUUID(t.args.TokenUUID))
-		if err != nil {
-			return nil, err
-		}
-		var token oauth2.Token
-		if err = json.Unmarshal(tokenRow.Oauth2Token.Token, &token); err != nil {
-			log.Error("Failed to unmarshal token data", "error", err)
-			return nil, err
-		}
-		// Use the associated client UUID as the OAuth2 client identifier.
-		clientID := tokenRow.Oauth2Token.ClientUuid.String()
-		config, err := oauthTools.GetClientConfig(ctx, t.dbp, clientID)
-		if err != nil {
-			log.Error("Failed to get OAuth2 client config", "error", err)
-			return nil, err
-		}
-		refreshedToken, err := config.TokenSource(ctx, &token).Token()
-		if err != nil {
-			log.Error("Failed to refresh token, deleting it", "error", err)
-			if delErr := qh.DeleteOauth2Token(ctx, converter.UuidToPgUUID(t.args.TokenUUID)); delErr != nil {
-				log.Error("Failed to delete broken token", "error", delErr)
-			}
-			return nil, err
-		}
-		return refreshedToken, nil
-	})
+	startedAt := time.Now()
+	tokenRow, err := query.New(t.dbp).GetOauth2TokenByUUID(ctx, converter.UuidToPgUUID(t.args.TokenUUID))
 	if err == pgx.ErrNoRows {
 		log.Warn("Token not found, cancelling token refresh job")
 		return nil
 	} else if err != nil {
 		return err
 	}
-	log.Debug("Token refreshed successfully", "token_uuid", t.args.TokenUUID)
-	return ScheduleTokenRefresh(ctx, t.queue, t.args.TokenUUID, refreshedToken.Expiry, t.log)
+	row := tokenRow.Oauth2Token
+	if row.Status == oauthTools.TokenStatusNeedsReauth {
+		log.Info("Token needs a new login, skipping refresh")
+		return nil
+	}
+	if row.ClientUuid == nil {
+		return errors.New("token has no oauth2 client")
+	}
+	config, err := oauthTools.GetClientConfig(ctx, t.dbp, row.ClientUuid.String())
+	if err != nil {
+		log.Error("Failed to get OAuth2 client config", "error", err)
+		return err
+	}
+
+	// the refresh is skipped when another job or a TokenStore refreshed the
+	// token while this one waited for the lock
+	refreshed, err := oauthTools.RefreshToken(config.Context(ctx), t.dbp, &config.Config, t.args.TokenUUID,
+		func(row query.Oauth2Token, _ *oauth2.Token) bool {
+			return !row.LastRefreshedAt.Valid || row.LastRefreshedAt.Time.Before(startedAt)
+		})
+	if errors.Is(err, oauthTools.ErrNeedsReauth) {
+		log.Info("Token needs a new login, skipping refresh")
+		return nil
+	}
+	if err != nil {
+		log.Error("Failed to refresh token", "needs_reauth", oauthTools.IsReauthError(err), "error", err)
+		return err
+	}
+	log.Debug("Token refreshed successfully", "expiry", refreshed.Expiry)
+	return nil
 }
 
-// ScheduleTokenRefresh schedules a new token refresh job by publishing a message.
+// tokenRefreshLead is how long before the expiry a scheduled refresh runs.
+const tokenRefreshLead = 5 * time.Minute
+
+// ScheduleTokenRefresh publishes a refresh job of the token that runs shortly
+// before the token expires.
 func ScheduleTokenRefresh(ctx context.Context, q *queue.Queue, tokenUUID uuid.UUID, expiry time.Time, log *slog.Logger) error {
-	delay := time.Until(expiry)
-	log.Debug("Scheduling next token refresh", "delay", delay)
+	runAt := expiry.Add(-tokenRefreshLead)
+	log.Debug("Scheduling next token refresh", "delay", time.Until(runAt))
+	jobUUID := uuid.Must(uuid.NewV7()).String()
 	newArgs := TokenRefresherJobArgs{
+		JobUUID:   jobUUID,
 		TokenUUID: tokenUUID,
-		Expiry:    expiry,
+		Expiry:    runAt,
 	}
 	msg, err := json.Marshal(newArgs)
 	if err != nil {
 		log.Error("Failed to marshal token refresh args", "error", err)
 		return err
 	}
-	return q.Publish(ctx, registry.WorkerSubjectTokenRefresh, msg)
+	return q.PublishWithHeaders(ctx, registry.WorkerSubjectTokenRefresh, queue.Headers{"X-Job-ID": jobUUID}, msg)
 }
 
 /*
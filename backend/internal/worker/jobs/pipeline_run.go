@@ -0,0 +1,101 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/shadowapi/shadowapi/backend/internal/metrics"
+	"github.com/shadowapi/shadowapi/backend/internal/queue"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/pipelines"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/registry"
+	stor "github.com/shadowapi/shadowapi/backend/internal/worker/storage"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/types"
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// pipelineRun hands the messages fetched by a fetch job to the pipeline, as
+// message jobs or, for dry runs, evaluated in place.
+type pipelineRun struct {
+	log            *slog.Logger
+	queue          *queue.Queue
+	pipelineUUID   string
+	datasourceUUID string
+	opts           types.RunOptions
+
+	// dryRun is the pipeline of dry runs, it stores into dryRunStorage
+	dryRun        types.Pipeline
+	dryRunStorage *stor.DryRunStorage
+
+	fetched int
+	failed  int
+}
+
+func newPipelineRun(ctx context.Context, log *slog.Logger, dbp *pgxpool.Pool, q *queue.Queue, pipe query.Pipeline, opts types.RunOptions) (*pipelineRun, error) {
+	r := &pipelineRun{
+		log:          log,
+		queue:        q,
+		pipelineUUID: pipe.UUID.String(),
+		opts:         opts,
+	}
+	if pipe.DatasourceUUID != nil {
+		r.datasourceUUID = pipe.DatasourceUUID.String()
+	}
+	if opts.DryRun {
+		r.dryRunStorage = stor.NewDryRunStorage()
+		pl, err := pipelines.Build(ctx, log, dbp, pipe, r.dryRunStorage)
+		if err != nil {
+			return nil, err
+		}
+		r.dryRun = pl
+	}
+	return r, nil
+}
+
+// Full reports whether the run fetched as many messages as its limit.
+func (r *pipelineRun) Full() bool {
+	return r.opts.Limit > 0 && r.fetched >= r.opts.Limit
+}
+
+// Handle queues the pipeline job of a fetched message, or runs the dry run
+// pipeline on it.
+func (r *pipelineRun) Handle(ctx context.Context, msg *api.Message) error {
+	r.fetched++
+	if r.dryRun != nil {
+		if err := r.dryRun.Run(ctx, msg); err != nil {
+			r.log.Warn("dry run of message failed", "message_uuid", msg.UUID.Value, "error", err)
+			r.failed++
+		}
+		return nil
+	}
+	metrics.MessagesFetchedTotal.WithLabelValues(r.datasourceUUID, r.pipelineUUID).Inc()
+	raw, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(EmailPipelineMessageJobArgs{
+		PipelineUUID: r.pipelineUUID,
+		MessageData:  raw,
+		StorageUUID:  r.opts.StorageUUID,
+	})
+	if err != nil {
+		return err
+	}
+	return r.queue.Publish(ctx, registry.WorkerSubjectEmailApplyPipeline, data)
+}
+
+// Result is the summary of the run recorded in its worker job.
+func (r *pipelineRun) Result() map[string]any {
+	out := map[string]any{"fetched": r.fetched}
+	if r.dryRun != nil {
+		stored, attachments := r.dryRunStorage.Counts()
+		out["dry_run"] = true
+		out["would_store"] = stored
+		out["would_store_attachments"] = attachments
+		out["failed"] = r.failed
+	}
+	return out
+}
@@ -0,0 +1,95 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/queue"
+	"github.com/shadowapi/shadowapi/backend/internal/retention"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/monitor"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/registry"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/types"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// RetentionJobArgs holds the arguments for a retention job. Without
+// PolicyUUID every enabled retention policy is applied.
+type RetentionJobArgs struct {
+	SchedulerUUID string     `json:"scheduler_uuid"`
+	JobUUID       string     `json:"job_uuid"`
+	PolicyUUID    *uuid.UUID `json:"policy_uuid,omitempty"`
+}
+
+// RetentionJob deletes messages and files that are past their retention.
+type RetentionJob struct {
+	log     *slog.Logger
+	dbp     *pgxpool.Pool
+	queue   *queue.Queue
+	monitor *monitor.WorkerMonitor
+
+	schedulerUUID string
+	jobUUID       string
+	args          RetentionJobArgs
+}
+
+// RetentionJobFactory creates RetentionJob instances.
+func RetentionJobFactory(
+	dbp *pgxpool.Pool,
+	log *slog.Logger,
+	q *queue.Queue,
+	mon *monitor.WorkerMonitor,
+) types.JobFactory {
+	return func(data []byte) (types.Job, error) {
+		var args RetentionJobArgs
+		if err := json.Unmarshal(data, &args); err != nil {
+			return nil, err
+		}
+		jobUUID := args.JobUUID
+		if jobUUID == "" {
+			jobUUID = uuid.Must(uuid.NewV7()).String()
+		}
+		return &RetentionJob{
+			log:           log,
+			dbp:           dbp,
+			queue:         q,
+			monitor:       mon,
+			schedulerUUID: args.SchedulerUUID,
+			jobUUID:       jobUUID,
+			args:          args,
+		}, nil
+	}
+}
+
+// Execute applies the retention policies.
+func (j *RetentionJob) Execute(ctx context.Context) (err error) {
+	j.monitor.RecordJobStart(ctx, j.schedulerUUID, j.jobUUID, registry.WorkerSubjectRetention)
+	defer func() {
+		status := monitor.StatusDone
+		if err != nil {
+			status = monitor.StatusFailed
+		}
+		j.monitor.RecordJobEnd(ctx, j.schedulerUUID, j.jobUUID, registry.WorkerSubjectRetention, status, func() string {
+			if err != nil {
+				return err.Error()
+			}
+			return ""
+		}())
+	}()
+
+	enforcer := retention.NewEnforcer(j.log, j.dbp)
+	if j.args.PolicyUUID == nil {
+		_, err = enforcer.RunAll(ctx)
+		return err
+	}
+	row, err := query.New(j.dbp).GetRetentionPolicy(ctx, converter.UuidToPgUUID(*j.args.PolicyUUID))
+	if err != nil {
+		return err
+	}
+	_, err = enforcer.Run(ctx, row.RetentionPolicy)
+	return err
+}
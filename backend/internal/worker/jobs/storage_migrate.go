@@ -0,0 +1,79 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/shadowapi/shadowapi/backend/internal/queue"
+	"github.com/shadowapi/shadowapi/backend/internal/storages"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/monitor"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/registry"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/types"
+)
+
+// StorageMigrateJobArgs holds the arguments for a storage migration job.
+type StorageMigrateJobArgs struct {
+	JobUUID       string    `json:"job_uuid"`
+	MigrationUUID uuid.UUID `json:"migration_uuid"`
+}
+
+// StorageMigrateJob moves files of one storage_migration row to its target storage.
+type StorageMigrateJob struct {
+	log     *slog.Logger
+	dbp     *pgxpool.Pool
+	queue   *queue.Queue
+	monitor *monitor.WorkerMonitor
+
+	jobUUID string
+	args    StorageMigrateJobArgs
+}
+
+// StorageMigrateJobFactory creates StorageMigrateJob instances.
+func StorageMigrateJobFactory(
+	dbp *pgxpool.Pool,
+	log *slog.Logger,
+	q *queue.Queue,
+	mon *monitor.WorkerMonitor,
+) types.JobFactory {
+	return func(data []byte) (types.Job, error) {
+		var args StorageMigrateJobArgs
+		if err := json.Unmarshal(data, &args); err != nil {
+			return nil, err
+		}
+		jobUUID := args.JobUUID
+		if jobUUID == "" {
+			jobUUID = uuid.Must(uuid.NewV7()).String()
+		}
+		return &StorageMigrateJob{
+			log:     log,
+			dbp:     dbp,
+			queue:   q,
+			monitor: mon,
+			jobUUID: jobUUID,
+			args:    args,
+		}, nil
+	}
+}
+
+// Execute runs or resumes the storage migration.
+func (j *StorageMigrateJob) Execute(ctx context.Context) (err error) {
+	j.monitor.RecordJobStart(ctx, "", j.jobUUID, registry.WorkerSubjectStorageMigrate)
+	defer func() {
+		status := monitor.StatusDone
+		if err != nil {
+			status = monitor.StatusFailed
+		}
+		j.monitor.RecordJobEnd(ctx, "", j.jobUUID, registry.WorkerSubjectStorageMigrate, status, func() string {
+			if err != nil {
+				return err.Error()
+			}
+			return ""
+		}())
+	}()
+
+	return storages.NewMigrator(j.log, j.dbp).Run(ctx, j.args.MigrationUUID)
+}
@@ -0,0 +1,133 @@
+// Package leader elects one process among the replicas sharing a database to
+// run the work that must not run twice, e.g. the schedulers.
+//
+// The leader holds a session level Postgres advisory lock on a connection
+// taken out of the pool. The lock is released when the leader stops or its
+// connection dies, another replica then takes over within the retry interval.
+package leader
+
+import (
+	"context"
+	"hash/fnv"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	// retryInterval is how often followers try to become the leader
+	retryInterval = 10 * time.Second
+	// checkInterval is how often the leader checks that it still holds the lock
+	checkInterval = 5 * time.Second
+)
+
+// Elector runs work while its process is the leader of name.
+type Elector struct {
+	log *slog.Logger
+	dbp *pgxpool.Pool
+	key int64
+
+	leading atomic.Bool
+}
+
+// New returns an elector of the leader of name. All replicas must use the
+// same name.
+func New(log *slog.Logger, dbp *pgxpool.Pool, name string) *Elector {
+	h := fnv.New64a()
+	h.Write([]byte("shadowapi.leader." + name))
+	return &Elector{
+		log: log.With("service", "leader", "name", name),
+		dbp: dbp,
+		key: int64(h.Sum64()),
+	}
+}
+
+// Run calls start each time the process becomes the leader, until ctx is
+// done. The context passed to start is cancelled when the leadership is lost
+// or ctx is done, start must then return: the lock is only released once it
+// has, so that no other replica starts the work while it is still running.
+// Run blocks, call it in a goroutine.
+func (e *Elector) Run(ctx context.Context, start func(ctx context.Context)) {
+	for {
+		conn, err := e.acquire(ctx)
+		if err != nil {
+			e.log.Error("failed to try leadership", "error", err)
+		}
+		if conn != nil {
+			e.log.Info("became leader")
+			e.lead(ctx, conn, start)
+			e.log.Info("leadership ended")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// acquire returns a connection holding the lock, nil when another process
+// holds it.
+func (e *Elector) acquire(ctx context.Context) (*pgx.Conn, error) {
+	pooled, err := e.dbp.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var locked bool
+	if err := pooled.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", e.key).Scan(&locked); err != nil {
+		pooled.Release()
+		return nil, err
+	}
+	if !locked {
+		pooled.Release()
+		return nil, nil
+	}
+	// the lock lives as long as the session, keep it away from other users
+	return pooled.Hijack(), nil
+}
+
+// Leading tells whether the process is the leader.
+func (e *Elector) Leading() bool {
+	return e.leading.Load()
+}
+
+func (e *Elector) lead(ctx context.Context, conn *pgx.Conn, start func(ctx context.Context)) {
+	leadCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	e.leading.Store(true)
+	defer func() {
+		// the work stops before the lock is released
+		cancel()
+		<-done
+		e.leading.Store(false)
+		// closing the session releases the lock
+		closeCtx, closeCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer closeCancel()
+		conn.Close(closeCtx)
+	}()
+
+	go func() {
+		defer close(done)
+		start(leadCtx)
+	}()
+
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			pingCtx, pingCancel := context.WithTimeout(ctx, checkInterval)
+			err := conn.Ping(pingCtx)
+			pingCancel()
+			if err != nil {
+				e.log.Warn("lost the leader connection", "error", err)
+				return
+			}
+		}
+	}
+}
@@ -9,36 +9,39 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/metrics"
 	"github.com/shadowapi/shadowapi/backend/pkg/query"
 	"log/slog"
 )
 
 // WorkerMonitor writes worker job lifecycle events to Postgres
-// using both scheduler and job UUIDs for traceability
+// using both scheduler and job UUIDs for traceability, and counts
+// them in the worker metrics
 type WorkerMonitor struct {
-	log    *slog.Logger
-	dbp    *pgxpool.Pool
-	phase2 bool
+	log *slog.Logger
+	dbp *pgxpool.Pool
 }
 
 const (
-	StatusRunning = "running"
-	StatusDone    = "done"
-	StatusFailed  = "failed"
+	// StatusQueued jobs are published but did not start yet
+	StatusQueued    = "queued"
+	StatusRunning   = "running"
+	StatusDone      = "done"
+	StatusFailed    = "failed"
+	StatusCancelled = "cancelled"
 )
 
 // NewWorkerMonitor creates a new monitor instance
-func NewWorkerMonitor(log *slog.Logger, dbp *pgxpool.Pool, phase2 bool) *WorkerMonitor {
-	return &WorkerMonitor{log: log, dbp: dbp, phase2: phase2}
+func NewWorkerMonitor(log *slog.Logger, dbp *pgxpool.Pool) *WorkerMonitor {
+	return &WorkerMonitor{log: log, dbp: dbp}
 }
 
 // RecordJobStart inserts a row with scheduler and job UUIDs and status running
 func (wm *WorkerMonitor) RecordJobStart(ctx context.Context, schedulerUUID, jobUUID, subject string) {
-	schedID, err := converter.ConvertStringToPgUUID(schedulerUUID)
-	if err != nil {
-		wm.log.Error("invalid scheduler uuid", "error", err)
-		schedID = pgtype.UUID{Valid: false}
-	}
+	metrics.JobStartedTotal.WithLabelValues(subject).Inc()
+	metrics.JobsRunning.WithLabelValues(subject).Inc()
+
+	schedID := wm.schedulerID(schedulerUUID)
 
 	jobID, err := converter.ConvertStringToPgUUID(jobUUID)
 	if err != nil {
@@ -60,13 +63,54 @@ func (wm *WorkerMonitor) RecordJobStart(ctx context.Context, schedulerUUID, jobU
 	}
 }
 
-// RecordJobEnd updates the row with final status and optional error
-func (wm *WorkerMonitor) RecordJobEnd(ctx context.Context, schedulerUUID, jobUUID, subject, finalStatus, errMsg string) {
-	schedID, err := converter.ConvertStringToPgUUID(schedulerUUID)
+// RecordJobQueued inserts a row with status queued for a published job,
+// RecordJobStart turns it into a running one. schedulerUUID is nil for jobs
+// started on demand, pipelineUUID is set for the runs of a pipeline. data,
+// e.g. the options of the job, is kept in the row when not nil.
+func (wm *WorkerMonitor) RecordJobQueued(ctx context.Context, schedulerUUID, pipelineUUID *uuid.UUID, jobUUID uuid.UUID, subject string, data any) error {
+	raw := []byte("{}")
+	if data != nil {
+		var err error
+		if raw, err = json.Marshal(data); err != nil {
+			return err
+		}
+	}
+	_, err := query.New(wm.dbp).CreateWorkerJob(ctx, query.CreateWorkerJobParams{
+		UUID:          converter.UuidToPgUUID(jobUUID),
+		SchedulerUuid: converter.UuidPtrToPgUUID(schedulerUUID),
+		PipelineUuid:  converter.UuidPtrToPgUUID(pipelineUUID),
+		JobUuid:       converter.UuidToPgUUID(jobUUID),
+		Subject:       subject,
+		Status:        StatusQueued,
+		Data:          raw,
+		FinishedAt:    nullTime(),
+	})
+	return err
+}
+
+// RecordJobResult stores the result of a job in its row, under "result".
+func (wm *WorkerMonitor) RecordJobResult(ctx context.Context, jobUUID string, result any) {
+	jobID, err := converter.ConvertStringToPgUUID(jobUUID)
 	if err != nil {
-		wm.log.Error("invalid scheduler uuid", "error", err)
-		schedID = pgtype.UUID{Valid: false}
+		wm.log.Error("invalid job uuid", "error", err)
+		return
+	}
+	b, err := json.Marshal(map[string]any{"result": result})
+	if err != nil {
+		wm.log.Error("failed to marshal job result", "error", err)
+		return
+	}
+	if err := query.New(wm.dbp).MergeWorkerJobData(ctx, query.MergeWorkerJobDataParams{UUID: jobID, Data: b}); err != nil {
+		wm.log.Error("record job result failed", "error", err)
 	}
+}
+
+// RecordJobEnd updates the row with final status and optional error
+func (wm *WorkerMonitor) RecordJobEnd(ctx context.Context, schedulerUUID, jobUUID, subject, finalStatus, errMsg string) {
+	metrics.JobFinishedTotal.WithLabelValues(subject, finalStatus).Inc()
+	metrics.JobsRunning.WithLabelValues(subject).Dec()
+
+	schedID := wm.schedulerID(schedulerUUID)
 
 	jobID, err := converter.ConvertStringToPgUUID(jobUUID)
 	if err != nil {
@@ -97,6 +141,47 @@ func (wm *WorkerMonitor) RecordJobEnd(ctx context.Context, schedulerUUID, jobUUI
 	}
 }
 
+// RecordJobRequeued puts the row of a job interrupted by a shutdown back to
+// queued, its message is redelivered to another worker.
+func (wm *WorkerMonitor) RecordJobRequeued(ctx context.Context, jobUUID, reason string) {
+	jobID, err := converter.ConvertStringToPgUUID(jobUUID)
+	if err != nil {
+		wm.log.Error("invalid job uuid", "error", err)
+		return
+	}
+	b, err := json.Marshal(map[string]string{"requeued": reason})
+	if err != nil {
+		wm.log.Error("failed to marshal requeue data", "error", err)
+		return
+	}
+	if err := query.New(wm.dbp).RequeueWorkerJob(ctx, query.RequeueWorkerJobParams{UUID: jobID, Data: b}); err != nil {
+		wm.log.Error("requeue worker job failed", "error", err)
+	}
+}
+
+// RecordJobPublishFailed marks the row of a job whose message could not be
+// published as failed. No worker will run it, left queued it would count as
+// an active job of its scheduler until it is stale. The arguments are kept,
+// the job can be retried.
+func (wm *WorkerMonitor) RecordJobPublishFailed(ctx context.Context, jobUUID uuid.UUID, subject string, cause error) {
+	metrics.JobFinishedTotal.WithLabelValues(subject, StatusFailed).Inc()
+	b, err := json.Marshal(map[string]string{"error": "publish failed: " + cause.Error()})
+	if err != nil {
+		wm.log.Error("failed to marshal error data", "error", err)
+		return
+	}
+	if err := query.New(wm.dbp).UpdateWorkerJob(ctx, query.UpdateWorkerJobParams{
+		UUID:       converter.UuidToPgUUID(jobUUID),
+		JobUuid:    converter.UuidToPgUUID(jobUUID),
+		Subject:    subject,
+		Status:     StatusFailed,
+		Data:       b,
+		FinishedAt: pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true},
+	}); err != nil {
+		wm.log.Error("update worker job failed", "error", err)
+	}
+}
+
 // RecordInstant inserts a completed row immediately and returns its UUID
 func (wm *WorkerMonitor) RecordInstant(ctx context.Context, schedulerUUID *uuid.UUID, subject string) string {
 	id := uuid.Must(uuid.NewV7())
@@ -119,4 +204,18 @@ func (wm *WorkerMonitor) RecordInstant(ctx context.Context, schedulerUUID *uuid.
 	return id.String()
 }
 
+// schedulerID converts the scheduler of a job, NULL for jobs started on demand
+// which have none.
+func (wm *WorkerMonitor) schedulerID(schedulerUUID string) pgtype.UUID {
+	if schedulerUUID == "" {
+		return pgtype.UUID{Valid: false}
+	}
+	id, err := converter.ConvertStringToPgUUID(schedulerUUID)
+	if err != nil {
+		wm.log.Error("invalid scheduler uuid", "error", err)
+		return pgtype.UUID{Valid: false}
+	}
+	return id
+}
+
 func nullTime() pgtype.Timestamptz { return pgtype.Timestamptz{Valid: false} }
@@ -2,19 +2,28 @@ package pipelines
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"log/slog"
 
+	"github.com/gofrs/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+
 	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/embeddings"
+	"github.com/shadowapi/shadowapi/backend/internal/metrics"
+	"github.com/shadowapi/shadowapi/backend/internal/storages"
+	"github.com/shadowapi/shadowapi/backend/internal/telemetry"
 	"github.com/shadowapi/shadowapi/backend/internal/worker/extractors"
 	"github.com/shadowapi/shadowapi/backend/internal/worker/filters"
 	stor "github.com/shadowapi/shadowapi/backend/internal/worker/storage"
 	"github.com/shadowapi/shadowapi/backend/internal/worker/types"
 	"github.com/shadowapi/shadowapi/backend/pkg/api"
 	"github.com/shadowapi/shadowapi/backend/pkg/query"
-	"log/slog"
 )
 
 type EmailPipeline struct {
@@ -22,21 +31,58 @@ type EmailPipeline struct {
 	extractor types.Extractor
 	filter    types.Filter
 	storage   types.Storage
+
+	// pipelineUUID and datasourceUUID are stamped on stored messages,
+	// retention policies are applied per pipeline or datasource
+	pipelineUUID   string
+	datasourceUUID string
+	// dryRun pipelines are left out of the metrics
+	dryRun bool
+
+	// index embeds the stored messages when the flow has an embedder node
+	index    *embeddings.Index
+	chunking embeddings.ChunkOptions
 }
 
-func NewEmailPipeline(log *slog.Logger, extractor types.Extractor, filter types.Filter, storage types.Storage) types.Pipeline {
+func NewEmailPipeline(log *slog.Logger, extractor types.Extractor, filter types.Filter, storage types.Storage, pipelineUUID, datasourceUUID string) *EmailPipeline {
 	return &EmailPipeline{
-		log:       log,
-		extractor: extractor,
-		filter:    filter,
-		storage:   storage,
+		log:            log,
+		extractor:      extractor,
+		filter:         filter,
+		storage:        storage,
+		pipelineUUID:   pipelineUUID,
+		datasourceUUID: datasourceUUID,
+		dryRun:         isDryRun(storage),
 	}
 }
 
-func (p *EmailPipeline) Run(ctx context.Context, message *api.Message) error {
+// WithIndex indexes the stored messages for semantic search, cut into chunks
+// with the options.
+func (p *EmailPipeline) WithIndex(index *embeddings.Index, chunking embeddings.ChunkOptions) *EmailPipeline {
+	p.index = index
+	p.chunking = chunking
+	return p
+}
+
+func isDryRun(storage types.Storage) bool {
+	_, ok := storage.(*stor.DryRunStorage)
+	return ok
+}
+
+func (p *EmailPipeline) Run(ctx context.Context, message *api.Message) (err error) {
+	ctx, span := telemetry.Start(ctx, "pipeline.run", telemetry.Attrs(
+		attribute.String("pipeline.uuid", p.pipelineUUID),
+		attribute.String("datasource.uuid", p.datasourceUUID),
+		attribute.String("message.uuid", message.UUID.Or("")),
+	))
+	defer func() { telemetry.End(span, err) }()
+
 	p.log.Info("Running pipeline", "message_uuid", message.UUID)
 	if !p.filter.Apply(ctx, message) {
 		p.log.Info("Message blocked by sync policy", "sender", message.Sender)
+		if !p.dryRun {
+			metrics.MessagesFilteredTotal.WithLabelValues(p.datasourceUUID, p.pipelineUUID).Inc()
+		}
 		return nil
 	}
 	contact, err := p.extractor.ExtractContact(message)
@@ -45,13 +91,54 @@ func (p *EmailPipeline) Run(ctx context.Context, message *api.Message) error {
 		return err
 	}
 	p.log.Info("Extracted contact", "contact_uuid", contact.UUID)
-	if err := p.storage.SaveMessage(ctx, message); err != nil {
+	if !message.PipelineUUID.IsSet() && p.pipelineUUID != "" {
+		message.PipelineUUID = api.NewOptString(p.pipelineUUID)
+	}
+	if !message.DatasourceUUID.IsSet() && p.datasourceUUID != "" {
+		message.DatasourceUUID = api.NewOptString(p.datasourceUUID)
+	}
+	if err := p.saveMessage(ctx, message); err != nil {
 		p.log.Error("Failed to save message", "error", err)
 		return err
 	}
+	if !p.dryRun {
+		metrics.MessagesStoredTotal.WithLabelValues(p.datasourceUUID, p.pipelineUUID).Inc()
+		metrics.AttachmentBytesTotal.WithLabelValues(p.datasourceUUID, p.pipelineUUID).Add(float64(attachmentBytes(message)))
+	}
+	if p.index != nil {
+		// the message is stored, a retry would fail on it: a message missing
+		// from the search is only logged
+		if err := p.index.IndexMessage(ctx, message, p.chunking); err != nil {
+			p.log.Error("Failed to index message", "message_uuid", message.UUID, "error", err)
+		}
+	}
 	return nil
 }
 
+// saveMessage stores the message in a span of its own.
+func (p *EmailPipeline) saveMessage(ctx context.Context, message *api.Message) (err error) {
+	ctx, span := telemetry.Start(ctx, "storage.save_message", telemetry.Attrs(
+		attribute.String("storage.type", fmt.Sprintf("%T", p.storage)),
+		attribute.Int("message.attachments", len(message.Attachments)),
+	))
+	defer func() { telemetry.End(span, err) }()
+	return p.storage.SaveMessage(ctx, message)
+}
+
+// attachmentBytes is the size of the attachments of the message, taken from
+// their content when the size is not set.
+func attachmentBytes(message *api.Message) int {
+	var n int
+	for _, att := range message.GetAttachments() {
+		if size, ok := att.Size.Get(); ok {
+			n += size
+		} else if data, ok := att.Data.Get(); ok {
+			n += base64.StdEncoding.DecodedLen(len(data))
+		}
+	}
+	return n
+}
+
 func CreateEmailPipelines(ctx context.Context, log *slog.Logger, dbp *pgxpool.Pool) *map[string]types.Pipeline {
 	pipelinesMap := make(map[string]types.Pipeline)
 	q := query.New(dbp)
@@ -60,7 +147,7 @@ func CreateEmailPipelines(ctx context.Context, log *slog.Logger, dbp *pgxpool.Po
 		OrderDirection: "asc",
 		Offset:         0,
 		Limit:          100,
-		Type:           "",  // load all types: email, email_oauth, outlook, etc.
+		Type:           "", // load all types: email, email_oauth, outlook, etc.
 		UUID:           pgtype.UUID{Valid: false},
 		IsEnabled:      1,
 		Name:           "",
@@ -71,92 +158,132 @@ func CreateEmailPipelines(ctx context.Context, log *slog.Logger, dbp *pgxpool.Po
 		return &pipelinesMap
 	}
 	for _, pipe := range pipes {
-		syncParams := query.GetSyncPoliciesParams{
-			OrderBy:        "created_at",
-			OrderDirection: "desc",
-			Offset:         0,
-			Limit:          1,
-			Type:           "email",
-			UUID:           "",
-			SyncAll:        -1,
-		}
-		policies, err := q.GetSyncPolicies(ctx, syncParams)
-		var apiPolicy api.SyncPolicy
-		if err != nil || len(policies) == 0 {
-			apiPolicy = api.SyncPolicy{
-				Type:    api.NewOptString("email"),
-				SyncAll: api.NewOptBool(true),
-			}
-		} else {
-			converted, err := convertSyncPolicy(policies[0])
-			if err != nil {
-				log.Error("Failed to convert sync policy", "error", err)
-				apiPolicy = api.SyncPolicy{
-					Type:    api.NewOptString("email"),
-					SyncAll: api.NewOptBool(true),
-				}
-			} else {
-				apiPolicy = converted
-			}
-		}
-		filter := filters.NewSyncPolicyFilter(apiPolicy, log)
-		extractor := extractors.NewContactExtractor()
-		if pipe.DatasourceUUID == nil {
-			log.Error("Pipeline has nil DatasourceUUID")
+		if pipe.StorageUuid == nil {
+			log.Error("Pipeline has nil StorageUUID")
 			continue
 		}
-		dsRow, err := q.GetDatasource(ctx, converter.UuidToPgUUID(*pipe.DatasourceUUID))
+		storageBackend, err := OpenStorage(ctx, log, dbp, *pipe.StorageUuid)
 		if err != nil {
-			log.Error("Failed to get datasource", "error", err)
+			log.Error("Failed to open storage", "error", err)
 			continue
 		}
-		storageUUID, err := extractStorageUUID(dsRow.Datasource.Settings)
+		pipeline, err := Build(ctx, log, dbp, query.Pipeline{
+			UUID:           pipe.UUID,
+			DatasourceUUID: pipe.DatasourceUUID,
+			StorageUuid:    pipe.StorageUuid,
+			Flow:           pipe.Flow,
+		}, storageBackend)
 		if err != nil {
-			log.Error("Failed to extract storage UUID from datasource settings", "error", err)
+			log.Error("Failed to build pipeline", "error", err)
 			continue
 		}
-		uuidArr, err := converter.BytesToUUID(storageUUID)
-		if err != nil {
-			log.Error("Invalid storage UUID", "error", err)
-			continue
+		// message jobs look their pipeline up by pipeline UUID
+		pipelinesMap[pipe.UUID.String()] = pipeline
+	}
+	return &pipelinesMap
+}
+
+// Build returns the email pipeline of pipe storing messages in storageBackend.
+func Build(ctx context.Context, log *slog.Logger, dbp *pgxpool.Pool, pipe query.Pipeline, storageBackend types.Storage) (types.Pipeline, error) {
+	if pipe.DatasourceUUID == nil {
+		return nil, errors.New("pipeline has no datasource")
+	}
+	q := query.New(dbp)
+	syncParams := query.GetSyncPoliciesParams{
+		OrderBy:        "created_at",
+		OrderDirection: "desc",
+		Offset:         0,
+		Limit:          1,
+		Type:           "email",
+		UUID:           "",
+		SyncAll:        -1,
+	}
+	policies, err := q.GetSyncPolicies(ctx, syncParams)
+	var apiPolicy api.SyncPolicy
+	if err != nil || len(policies) == 0 {
+		apiPolicy = api.SyncPolicy{
+			Type:    api.NewOptString("email"),
+			SyncAll: api.NewOptBool(true),
 		}
-		storageRow, err := q.GetStorage(ctx, pgtype.UUID{Bytes: uuidArr, Valid: true})
+	} else {
+		converted, err := convertSyncPolicy(policies[0])
 		if err != nil {
-			log.Error("Failed to get storage", "error", err)
-			continue
+			log.Error("Failed to convert sync policy", "error", err)
+			apiPolicy = api.SyncPolicy{
+				Type:    api.NewOptString("email"),
+				SyncAll: api.NewOptBool(true),
+			}
+		} else {
+			apiPolicy = converted
 		}
-		var storageBackend types.Storage
-		switch storageRow.Storage.Type {
-		case "s3":
-			// Provide all required args to match the function signature:
-			// func NewS3Storage(log *slog.Logger, s3Client *s3.S3, bucketName string, pgdb *query.Queries) *S3Storage
-			storageBackend = stor.NewS3Storage(
-				log,
-				nil,                   // placeholder s3Client
-				"example-bucket-name", // or read from config
-				query.New(dbp),        // pass your Queries
-			)
-
-		case "hostfiles":
-			storageBackend = stor.NewHostfilesStorage(log, "./data", dbp)
-		case "postgres":
-			storageBackend = stor.NewPostgresStorage(log, dbp)
-		default:
-			log.Error("Unknown storage type", "type", storageRow.Storage.Type)
-			continue
+	}
+	filter := filters.NewSyncPolicyFilter(apiPolicy, log)
+	extractor := extractors.NewContactExtractor()
+	pipeline := NewEmailPipeline(log, extractor, filter, storageBackend, pipe.UUID.String(), pipe.DatasourceUUID.String())
+	if chunking, ok := embedderNode(pipe.Flow); ok && !pipeline.dryRun {
+		if index := embeddings.Default(); index.Enabled() {
+			pipeline.WithIndex(index, chunking)
+		} else {
+			log.Warn("pipeline has an embedder node but no embeddings provider is configured", "pipeline_uuid", pipe.UUID)
 		}
-		pipeline := NewEmailPipeline(log, extractor, filter, storageBackend)
-		pipelinesMap[dsRow.Datasource.UUID.String()] = pipeline
 	}
-	return &pipelinesMap
+	return pipeline, nil
+}
+
+// embedderNode returns the chunk options of the embedder node of a flow,
+// false when it has none.
+func embedderNode(flow []byte) (embeddings.ChunkOptions, bool) {
+	var f struct {
+		Nodes []struct {
+			Type string `json:"type"`
+			Data struct {
+				Config struct {
+					ChunkSize    int `json:"chunk_size"`
+					ChunkOverlap int `json:"chunk_overlap"`
+				} `json:"config"`
+			} `json:"data"`
+		} `json:"nodes"`
+	}
+	if len(flow) == 0 || json.Unmarshal(flow, &f) != nil {
+		return embeddings.ChunkOptions{}, false
+	}
+	for _, n := range f.Nodes {
+		if n.Type == "embedder" {
+			return embeddings.ChunkOptions{Size: n.Data.Config.ChunkSize, Overlap: n.Data.Config.ChunkOverlap}, true
+		}
+	}
+	return embeddings.ChunkOptions{}, false
 }
 
-func extractStorageUUID(settings []byte) ([]byte, error) {
-	if len(settings) == 0 {
-		return nil, errors.New("empty storage settings")
+// OpenStorage returns the backend of a storage, writing where its settings
+// say and recording the storage on the files it writes.
+func OpenStorage(ctx context.Context, log *slog.Logger, dbp *pgxpool.Pool, storageUUID uuid.UUID) (types.Storage, error) {
+	storageRow, err := query.New(dbp).GetStorage(ctx, converter.UuidToPgUUID(storageUUID))
+	if err != nil {
+		return nil, err
+	}
+	switch storageRow.Storage.Type {
+	case "s3":
+		settings, err := storages.S3Settings(storageRow.Storage)
+		if err != nil {
+			return nil, err
+		}
+		client, err := storages.NewS3Client(settings)
+		if err != nil {
+			return nil, err
+		}
+		return stor.NewS3Storage(log, client, settings.Bucket, query.New(dbp), storageUUID), nil
+	case "hostfiles":
+		root, err := storages.HostfilesRoot(storageRow.Storage)
+		if err != nil {
+			return nil, err
+		}
+		return stor.NewHostfilesStorage(log, root, dbp, storageUUID), nil
+	case "postgres":
+		return stor.NewPostgresStorage(log, dbp, storageUUID), nil
+	default:
+		return nil, fmt.Errorf("unknown storage type %q", storageRow.Storage.Type)
 	}
-	// In a real implementation, parse JSON to extract a 16-byte UUID.
-	return settings, nil
 }
 
 func convertSyncPolicy(row query.GetSyncPoliciesRow) (api.SyncPolicy, error) {
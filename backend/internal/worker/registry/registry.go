@@ -13,8 +13,15 @@ const (
 	WorkerSubject                   = "worker.jobs"
 	WorkerSubjectTokenRefresh       = WorkerSubject + ".scheduleTokenRefresh"
 	WorkerSubjectEmailOAuthFetch    = WorkerSubject + ".emailOAuthFetch"
+	WorkerSubjectEmailGraphFetch    = WorkerSubject + ".emailGraphFetch"
 	WorkerSubjectEmailApplyPipeline = WorkerSubject + ".emailApplyPipeline"
 	WorkerSubjectDummy              = WorkerSubject + ".dummy"
+	WorkerSubjectStorageMigrate     = WorkerSubject + ".storageMigrate"
+	WorkerSubjectRetention          = WorkerSubject + ".retention"
+
+	// WorkerSubjectCancel is broadcast to every worker with the UUID of a job
+	// to cancel, it is not part of the stream.
+	WorkerSubjectCancel = WorkerStream + ".cancel"
 )
 
 var (
@@ -25,7 +32,17 @@ var (
 		WorkerSubjectTokenRefresh,
 		WorkerSubjectDummy,
 		WorkerSubjectEmailOAuthFetch, // enable scheduled Gmail OAuth2 fetch jobs
+		WorkerSubjectEmailGraphFetch,
 		WorkerSubjectEmailApplyPipeline,
+		WorkerSubjectStorageMigrate,
+		WorkerSubjectRetention,
+	}
+
+	// FetchSubjects maps datasource types to the subject of the job fetching
+	// them, pipelines of other types are not scheduled.
+	FetchSubjects = map[string]string{
+		"email_oauth": WorkerSubjectEmailOAuthFetch,
+		"email_graph": WorkerSubjectEmailGraphFetch,
 	}
 )
 
@@ -0,0 +1,162 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	// timezones resolve on hosts without a zoneinfo database
+	_ "time/tzdata"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// Schedule types of the scheduler table
+const (
+	TypeCron     = "cron"
+	TypeInterval = "interval"
+	TypeOneTime  = "one_time"
+)
+
+// MinInterval is the shortest interval of interval schedules.
+const MinInterval = time.Minute
+
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// Schedule tells when a scheduler runs.
+type Schedule struct {
+	Type string
+	// Cron is evaluated in Timezone, an IANA name, UTC when empty
+	Cron     string
+	Timezone string
+	// RunAt is the run of a one_time schedule
+	RunAt time.Time
+	// Interval is the period of an interval schedule
+	Interval time.Duration
+	// Jitter delays each run by a random duration up to it
+	Jitter time.Duration
+}
+
+// FromRow returns the schedule of a scheduler row.
+func FromRow(s query.Scheduler) Schedule {
+	out := Schedule{
+		Type:     s.ScheduleType,
+		Cron:     s.CronExpression.String,
+		Timezone: s.Timezone,
+		Jitter:   time.Duration(s.JitterSeconds) * time.Second,
+	}
+	if s.RunAt.Valid {
+		out.RunAt = s.RunAt.Time
+	}
+	if s.IntervalSeconds.Valid {
+		out.Interval = time.Duration(s.IntervalSeconds.Int32) * time.Second
+	}
+	return out
+}
+
+// Validate returns an error describing what is wrong with the schedule.
+func (s Schedule) Validate() error {
+	if s.Jitter < 0 {
+		return errors.New("jitter must not be negative")
+	}
+	switch s.Type {
+	case TypeCron:
+		if s.Cron == "" {
+			return errors.New("cron schedules need a cron expression")
+		}
+		if _, err := cronParser.Parse(s.Cron); err != nil {
+			return fmt.Errorf("invalid cron expression: %w", err)
+		}
+		if _, err := s.location(); err != nil {
+			return fmt.Errorf("invalid timezone: %w", err)
+		}
+	case TypeInterval:
+		if s.Interval < MinInterval {
+			return fmt.Errorf("interval schedules run at most every %s", MinInterval)
+		}
+	case TypeOneTime:
+		if s.RunAt.IsZero() {
+			return errors.New("one_time schedules need run_at")
+		}
+	default:
+		return fmt.Errorf("unknown schedule type %q, use cron, interval or one_time", s.Type)
+	}
+	return nil
+}
+
+// First returns the first run of a new or changed schedule, one_time
+// schedules in the past run right away.
+func (s Schedule) First(now time.Time) (time.Time, error) {
+	if s.Type == TypeOneTime {
+		return s.RunAt, nil
+	}
+	return s.Next(now)
+}
+
+// Next returns the run following a run at t, the zero time when there is
+// none.
+func (s Schedule) Next(t time.Time) (time.Time, error) {
+	var next time.Time
+	switch s.Type {
+	case TypeCron:
+		sched, err := cronParser.Parse(s.Cron)
+		if err != nil {
+			return time.Time{}, err
+		}
+		loc, err := s.location()
+		if err != nil {
+			return time.Time{}, err
+		}
+		next = wallNext(sched, t, loc)
+	case TypeInterval:
+		if s.Interval < MinInterval {
+			return time.Time{}, fmt.Errorf("interval %s is below %s", s.Interval, MinInterval)
+		}
+		next = t.Add(s.Interval)
+	case TypeOneTime:
+		return time.Time{}, nil
+	default:
+		return time.Time{}, fmt.Errorf("unknown schedule type %q", s.Type)
+	}
+	if s.Jitter > 0 {
+		next = next.Add(rand.N(s.Jitter))
+	}
+	return next.UTC(), nil
+}
+
+// wallNext returns the run of sched following t, evaluated on the wall clock
+// of loc. A daily run is thus kept once on the day the clocks fall back and
+// moved past the gap on the day they spring forward instead of skipped.
+func wallNext(sched cron.Schedule, t time.Time, loc *time.Location) time.Time {
+	wall := wallClock(t.In(loc))
+	for {
+		wall = sched.Next(wall)
+		if wall.IsZero() {
+			return wall
+		}
+		next := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), 0, loc)
+		// a wall time in the gap resolves before it, it is shifted by the
+		// length of the gap
+		next = next.Add(wall.Sub(wallClock(next)))
+		// a wall time repeated by the fall back resolves to its first
+		// occurrence, which may already be past
+		if next.After(t) {
+			return next
+		}
+	}
+}
+
+// wallClock returns the time showing the clock of t in UTC.
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+func (s Schedule) location() (*time.Location, error) {
+	if s.Timezone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(s.Timezone)
+}
@@ -0,0 +1,118 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+func utc(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return t.UTC()
+}
+
+func TestScheduleNext(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule Schedule
+		from     string
+		want     string
+		wantErr  bool
+	}{
+		{"cron utc", Schedule{Type: TypeCron, Cron: "0 9 * * *"}, "2026-03-07T10:00:00Z", "2026-03-08T09:00:00Z", false},
+		{"cron descriptor", Schedule{Type: TypeCron, Cron: "@hourly"}, "2026-03-07T10:15:00Z", "2026-03-07T11:00:00Z", false},
+		// 09:00 local is 14:00 UTC before the switch and 13:00 UTC after
+		{"cron before spring forward", Schedule{Type: TypeCron, Cron: "0 9 * * *", Timezone: "America/New_York"}, "2026-03-06T15:00:00Z", "2026-03-07T14:00:00Z", false},
+		{"cron across spring forward", Schedule{Type: TypeCron, Cron: "0 9 * * *", Timezone: "America/New_York"}, "2026-03-07T15:00:00Z", "2026-03-08T13:00:00Z", false},
+		// 02:30 does not exist on the day of the switch, it runs at 03:30
+		{"cron in the spring forward gap", Schedule{Type: TypeCron, Cron: "30 2 * * *", Timezone: "America/New_York"}, "2026-03-07T08:00:00Z", "2026-03-08T07:30:00Z", false},
+		{"cron after the spring forward gap", Schedule{Type: TypeCron, Cron: "30 2 * * *", Timezone: "America/New_York"}, "2026-03-08T07:30:00Z", "2026-03-09T06:30:00Z", false},
+		{"hourly cron across spring forward", Schedule{Type: TypeCron, Cron: "0 * * * *", Timezone: "America/New_York"}, "2026-03-08T06:10:00Z", "2026-03-08T07:00:00Z", false},
+		{"cron across fall back", Schedule{Type: TypeCron, Cron: "0 9 * * *", Timezone: "America/New_York"}, "2026-10-31T14:00:00Z", "2026-11-01T14:00:00Z", false},
+		// 01:30 happens twice on the day of the switch, it runs once
+		{"cron in the fall back overlap", Schedule{Type: TypeCron, Cron: "30 1 * * *", Timezone: "America/New_York"}, "2026-10-31T12:00:00Z", "2026-11-01T05:30:00Z", false},
+		{"cron after the fall back overlap", Schedule{Type: TypeCron, Cron: "30 1 * * *", Timezone: "America/New_York"}, "2026-11-01T05:30:00Z", "2026-11-02T06:30:00Z", false},
+		{"cron during the repeated hour", Schedule{Type: TypeCron, Cron: "30 1 * * *", Timezone: "America/New_York"}, "2026-11-01T06:10:00Z", "2026-11-02T06:30:00Z", false},
+		{"cron europe across spring forward", Schedule{Type: TypeCron, Cron: "0 6 * * 1-5", Timezone: "Europe/Berlin"}, "2026-03-27T06:00:00Z", "2026-03-30T04:00:00Z", false},
+		{"invalid cron", Schedule{Type: TypeCron, Cron: "61 * * * *"}, "2026-03-07T10:00:00Z", "", true},
+		{"invalid timezone", Schedule{Type: TypeCron, Cron: "0 9 * * *", Timezone: "Mars/Olympus"}, "2026-03-07T10:00:00Z", "", true},
+		{"interval", Schedule{Type: TypeInterval, Interval: 90 * time.Minute}, "2026-03-07T10:00:00Z", "2026-03-07T11:30:00Z", false},
+		// intervals are elapsed time, the switch does not shift them
+		{"interval across spring forward", Schedule{Type: TypeInterval, Interval: 24 * time.Hour, Timezone: "America/New_York"}, "2026-03-07T15:00:00Z", "2026-03-08T15:00:00Z", false},
+		{"interval below the minimum", Schedule{Type: TypeInterval, Interval: 30 * time.Second}, "2026-03-07T10:00:00Z", "", true},
+		{"one time", Schedule{Type: TypeOneTime, RunAt: utc("2026-04-01T00:00:00Z")}, "2026-03-07T10:00:00Z", "", false},
+		{"unknown type", Schedule{Type: "weekly"}, "2026-03-07T10:00:00Z", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.schedule.Next(utc(tt.from))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %v", err, tt.wantErr)
+			}
+			var want time.Time
+			if tt.want != "" {
+				want = utc(tt.want)
+			}
+			if !got.Equal(want) {
+				t.Errorf("next = %s, want %s", got, want)
+			}
+			if !got.IsZero() && got.Location() != time.UTC {
+				t.Errorf("next is in %s, want UTC", got.Location())
+			}
+		})
+	}
+}
+
+func TestScheduleFirst(t *testing.T) {
+	now := utc("2026-03-07T10:00:00Z")
+	tests := []struct {
+		name     string
+		schedule Schedule
+		want     string
+	}{
+		{"one time in the future", Schedule{Type: TypeOneTime, RunAt: utc("2026-04-01T00:00:00Z")}, "2026-04-01T00:00:00Z"},
+		// a run_at already past runs on the next tick
+		{"one time in the past", Schedule{Type: TypeOneTime, RunAt: utc("2026-03-01T00:00:00Z")}, "2026-03-01T00:00:00Z"},
+		{"cron", Schedule{Type: TypeCron, Cron: "0 9 * * *", Timezone: "Europe/Berlin"}, "2026-03-08T08:00:00Z"},
+		{"interval", Schedule{Type: TypeInterval, Interval: time.Hour}, "2026-03-07T11:00:00Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.schedule.First(now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(utc(tt.want)) {
+				t.Errorf("first = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleJitter(t *testing.T) {
+	from := utc("2026-03-07T10:00:00Z")
+	tests := []struct {
+		name     string
+		schedule Schedule
+		base     string
+	}{
+		{"cron", Schedule{Type: TypeCron, Cron: "0 9 * * *", Timezone: "America/New_York", Jitter: 10 * time.Minute}, "2026-03-07T14:00:00Z"},
+		{"interval", Schedule{Type: TypeInterval, Interval: time.Hour, Jitter: time.Second}, "2026-03-07T11:00:00Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base := utc(tt.base)
+			for range 200 {
+				got, err := tt.schedule.Next(from)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got.Before(base) || !got.Before(base.Add(tt.schedule.Jitter)) {
+					t.Fatalf("next = %s, want in [%s, %s)", got, base, base.Add(tt.schedule.Jitter))
+				}
+			}
+		})
+	}
+}
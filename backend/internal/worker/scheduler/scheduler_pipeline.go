@@ -0,0 +1,261 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/shadowapi/shadowapi/backend/internal/converter"
+	"github.com/shadowapi/shadowapi/backend/internal/db"
+	"github.com/shadowapi/shadowapi/backend/internal/metrics"
+	"github.com/shadowapi/shadowapi/backend/internal/queue"
+	"github.com/shadowapi/shadowapi/backend/internal/telemetry"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/monitor"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/registry"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/types"
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// PipelineJobArgs is the payload of the fetch jobs published for pipelines,
+// RunOptions is empty for scheduled runs.
+type PipelineJobArgs struct {
+	SchedulerUUID string    `json:"scheduler_uuid"`
+	JobUUID       string    `json:"job_uuid"`
+	PipelineUUID  string    `json:"pipeline_uuid"`
+	LastFetched   time.Time `json:"last_fetched"`
+	types.RunOptions
+}
+
+var (
+	pipelineTick = 15 * time.Second
+	// pipelineRetryDelay is when a scheduler whose job could not be published runs again
+	pipelineRetryDelay = 5 * time.Minute
+	// pipelineBatch is the number of due schedulers handled per tick
+	pipelineBatch int32 = 100
+	// staleJobAge is when a queued or running job no longer counts as
+	// overlapping, its worker is assumed dead
+	staleJobAge = 6 * time.Hour
+)
+
+// Overlap policies, what a due scheduler does while its previous job is
+// queued or running
+const (
+	OverlapSkip   = "skip"
+	OverlapQueue  = "queue"
+	OverlapCancel = "cancel"
+)
+
+// OverlapPolicies lists the valid overlap policies.
+var OverlapPolicies = []string{OverlapSkip, OverlapQueue, OverlapCancel}
+
+// PipelineScheduler publishes the fetch jobs of the pipelines when their
+// schedulers are due. The job subject follows the datasource type of the
+// pipeline, see registry.FetchSubjects.
+type PipelineScheduler struct {
+	log     *slog.Logger
+	dbp     *pgxpool.Pool
+	queue   *queue.Queue
+	monitor *monitor.WorkerMonitor
+}
+
+func NewPipelineScheduler(log *slog.Logger, dbp *pgxpool.Pool, queue *queue.Queue, monitor *monitor.WorkerMonitor) *PipelineScheduler {
+	return &PipelineScheduler{
+		log:     log.With("service", "pipeline_scheduler"),
+		dbp:     dbp,
+		queue:   queue,
+		monitor: monitor,
+	}
+}
+
+// Run ticks until ctx is done, a tick in progress is completed first.
+func (s *PipelineScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(pipelineTick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.run(context.WithoutCancel(ctx))
+		case <-ctx.Done():
+			s.log.Info("PipelineScheduler shutting down")
+			return
+		}
+	}
+}
+
+func (s *PipelineScheduler) run(ctx context.Context) {
+	now := time.Now().UTC()
+	// the due schedulers stay claimed until their next run is stored, each
+	// one is handled in its own savepoint so a failing one keeps the others
+	_, err := db.InTx(ctx, s.dbp, func(tx pgx.Tx) (struct{}, error) {
+		due, err := query.New(tx).GetDueSchedulers(ctx, query.GetDueSchedulersParams{
+			Now:   pgtype.Timestamptz{Time: now, Valid: true},
+			Limit: pipelineBatch,
+		})
+		if err != nil {
+			return struct{}{}, err
+		}
+		for _, row := range due {
+			sp, err := tx.Begin(ctx)
+			if err != nil {
+				return struct{}{}, err
+			}
+			if err := s.handle(ctx, query.New(sp), row, now); err != nil {
+				s.log.Error("Failed scheduling pipeline", "schedulerUUID", row.Scheduler.UUID.String(), "err", err)
+				if err := sp.Rollback(ctx); err != nil {
+					return struct{}{}, err
+				}
+				continue
+			}
+			if err := sp.Commit(ctx); err != nil {
+				return struct{}{}, err
+			}
+		}
+		return struct{}{}, nil
+	})
+	if err != nil {
+		s.log.Error("Failed scheduling pipelines", "err", err)
+	}
+}
+
+// handle publishes the job of a due scheduler and stores its next run.
+// Schedulers without a next run get their first one computed, they are only
+// run when it is already due. On error the changes of queries are to be
+// rolled back, the scheduler stays due and is handled again on the next tick.
+func (s *PipelineScheduler) handle(ctx context.Context, queries *query.Queries, row query.GetDueSchedulersRow, now time.Time) (err error) {
+	sched := row.Scheduler
+	log := s.log.With("schedulerUUID", sched.UUID.String(), "pipelineUUID", sched.PipelineUuid.String())
+	// the trace of a scheduled sync starts here, the published job carries it
+	ctx, span := telemetry.Start(ctx, "scheduler.run", telemetry.Attrs(
+		attribute.String("scheduler.uuid", sched.UUID.String()),
+		attribute.String("pipeline.uuid", sched.PipelineUuid.String()),
+	))
+	defer func() { telemetry.End(span, err) }()
+	schedule := FromRow(sched)
+
+	if !sched.NextRun.Valid {
+		first, err := schedule.First(now)
+		if err != nil {
+			log.Error("Invalid schedule, disabling scheduler", "err", err)
+			return setRun(ctx, queries, sched, time.Time{}, time.Time{}, false)
+		}
+		if first.After(now) {
+			return setRun(ctx, queries, sched, time.Time{}, first, true)
+		}
+	}
+
+	subject, ok := registry.FetchSubjects[row.DatasourceType]
+	if !ok {
+		// nothing fetches this datasource type, check again on the next run
+		log.Warn("No fetch job for datasource type", "datasourceType", row.DatasourceType)
+		return s.advance(ctx, queries, sched, schedule, now, time.Time{})
+	}
+
+	// the job runs in the workspace owning the scheduler, never unscoped
+	if sched.WorkspaceUUID == nil {
+		log.Error("Scheduler without a workspace, skipping")
+		return s.advance(ctx, queries, sched, schedule, now, time.Time{})
+	}
+	jobCtx := workspace.WithUUID(ctx, *sched.WorkspaceUUID)
+	run, err := s.resolveOverlap(jobCtx, queries, sched, now)
+	if err != nil {
+		return err
+	}
+	if !run {
+		log.Info("Previous job still running, skipping run", "overlapPolicy", sched.OverlapPolicy)
+		return s.advance(ctx, queries, sched, schedule, now, time.Time{})
+	}
+
+	jobUUID := uuid.Must(uuid.NewV7())
+	payload, err := json.Marshal(PipelineJobArgs{
+		SchedulerUUID: sched.UUID.String(),
+		JobUUID:       jobUUID.String(),
+		PipelineUUID:  sched.PipelineUuid.String(),
+		LastFetched:   now,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal job payload: %w", err)
+	}
+	// the arguments are kept with the job so that it can be retried
+	if err := s.monitor.RecordJobQueued(jobCtx, &sched.UUID, sched.PipelineUuid, jobUUID, subject, json.RawMessage(payload)); err != nil {
+		return fmt.Errorf("record queued job: %w", err)
+	}
+	headers := queue.Headers{"X-Job-ID": jobUUID.String()}
+	if err := s.queue.PublishWithHeaders(jobCtx, subject, headers, payload); err != nil {
+		log.Error("Failed to publish job", "subject", subject, "err", err)
+		// the row is stored outside the savepoint, it must not stay queued
+		// and block the next runs through the overlap policy
+		s.monitor.RecordJobPublishFailed(jobCtx, jobUUID, subject, err)
+		return setRun(ctx, queries, sched, time.Time{}, now.Add(pipelineRetryDelay), true)
+	}
+	log.Debug("Published pipeline job", "subject", subject, "jobUUID", jobUUID)
+	metrics.JobScheduledTotal.WithLabelValues(sched.PipelineUuid.String(), row.DatasourceUUID.String()).Inc()
+	return s.advance(ctx, queries, sched, schedule, now, now)
+}
+
+// resolveOverlap applies the overlap policy of the scheduler to its queued
+// and running jobs, it returns false when the run is to be skipped.
+func (s *PipelineScheduler) resolveOverlap(ctx context.Context, queries *query.Queries, sched query.Scheduler, now time.Time) (bool, error) {
+	if sched.OverlapPolicy == OverlapQueue {
+		return true, nil
+	}
+	active, err := queries.GetActiveSchedulerJobs(ctx, query.GetActiveSchedulerJobsParams{
+		SchedulerUuid: converter.UuidToPgUUID(sched.UUID),
+		StartedAfter:  pgtype.Timestamptz{Time: now.Add(-staleJobAge), Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("fetch active jobs: %w", err)
+	}
+	if len(active) == 0 {
+		return true, nil
+	}
+	if sched.OverlapPolicy != OverlapCancel {
+		return false, nil
+	}
+	// queued jobs are skipped by the broker, running ones are cancelled by
+	// the worker running them once every cancellation is stored
+	for _, job := range active {
+		if err := queries.CancelWorkerJob(ctx, converter.UuidToPgUUID(job.UUID)); err != nil {
+			return false, fmt.Errorf("cancel previous job %s: %w", job.UUID, err)
+		}
+	}
+	for _, job := range active {
+		if job.Status == monitor.StatusRunning {
+			if err := s.queue.Broadcast(registry.WorkerSubjectCancel, []byte(job.UUID.String())); err != nil {
+				s.log.Error("Failed to broadcast job cancellation", "jobUUID", job.UUID.String(), "err", err)
+			}
+		}
+		s.log.Info("Cancelled previous job", "schedulerUUID", sched.UUID.String(), "jobUUID", job.UUID.String())
+	}
+	return true, nil
+}
+
+// advance stores the run after now, one_time schedulers are disabled.
+func (s *PipelineScheduler) advance(ctx context.Context, queries *query.Queries, sched query.Scheduler, schedule Schedule, now, lastRun time.Time) error {
+	next, err := schedule.Next(now)
+	if err != nil {
+		s.log.Error("Invalid schedule, disabling scheduler", "schedulerUUID", sched.UUID.String(), "err", err)
+	}
+	return setRun(ctx, queries, sched, lastRun, next, err == nil && !next.IsZero())
+}
+
+func setRun(ctx context.Context, queries *query.Queries, sched query.Scheduler, lastRun, nextRun time.Time, enabled bool) error {
+	err := queries.SetSchedulerRun(ctx, query.SetSchedulerRunParams{
+		UUID:      converter.UuidToPgUUID(sched.UUID),
+		LastRun:   pgtype.Timestamptz{Time: lastRun, Valid: !lastRun.IsZero()},
+		NextRun:   pgtype.Timestamptz{Time: nextRun, Valid: !nextRun.IsZero()},
+		IsEnabled: enabled,
+	})
+	if err != nil {
+		return fmt.Errorf("update scheduler run times: %w", err)
+	}
+	return nil
+}
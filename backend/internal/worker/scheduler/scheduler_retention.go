@@ -0,0 +1,68 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/shadowapi/shadowapi/backend/internal/queue"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/jobs"
+	"github.com/shadowapi/shadowapi/backend/internal/worker/registry"
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
+)
+
+var defaultRetentionInterval = time.Hour
+
+// RetentionScheduler periodically publishes a job applying all retention policies.
+type RetentionScheduler struct {
+	log           *slog.Logger
+	queue         *queue.Queue
+	interval      time.Duration
+	schedulerUUID string
+}
+
+func NewRetentionScheduler(log *slog.Logger, q *queue.Queue) *RetentionScheduler {
+	return &RetentionScheduler{
+		log:           log,
+		queue:         q,
+		interval:      defaultRetentionInterval,
+		schedulerUUID: uuid.Must(uuid.NewV7()).String(),
+	}
+}
+
+// Run ticks until ctx is done, a tick in progress is completed first.
+func (s *RetentionScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.run(context.WithoutCancel(ctx))
+		case <-ctx.Done():
+			s.log.Info("RetentionScheduler shutting down")
+			return
+		}
+	}
+}
+
+func (s *RetentionScheduler) run(ctx context.Context) {
+	jobUUID := uuid.Must(uuid.NewV7()).String()
+	payload, err := json.Marshal(jobs.RetentionJobArgs{
+		SchedulerUUID: s.schedulerUUID,
+		JobUUID:       jobUUID,
+	})
+	if err != nil {
+		s.log.Error("Failed to marshal retention job payload", "err", err)
+		return
+	}
+	headers := queue.Headers{"X-Job-ID": jobUUID}
+	// the job applies the policies of every workspace, each in its own scope
+	if err := s.queue.PublishWithHeaders(workspace.Unscoped(ctx), registry.WorkerSubjectRetention, headers, payload); err != nil {
+		s.log.Error("Failed to publish retention job", "err", err)
+		return
+	}
+	s.log.Debug("Published retention job", "job_uuid", jobUUID)
+}
@@ -7,11 +7,13 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/robfig/cron/v3"
 	"github.com/shadowapi/shadowapi/backend/internal/queue"
 	"github.com/shadowapi/shadowapi/backend/internal/worker/jobs"
 	"github.com/shadowapi/shadowapi/backend/internal/worker/registry"
+	"github.com/shadowapi/shadowapi/backend/internal/workspace"
 	"github.com/shadowapi/shadowapi/backend/pkg/query"
 	"log/slog"
 )
@@ -39,55 +41,52 @@ func NewTokenRefresherScheduler(log *slog.Logger, dbp *pgxpool.Pool, q *queue.Qu
 	}
 }
 
-func (s *TokenRefresherScheduler) Start(ctx context.Context) {
+// Run ticks until ctx is done, a tick in progress is completed first.
+func (s *TokenRefresherScheduler) Run(ctx context.Context) {
 	ticker := time.NewTicker(s.interval)
-	go func() {
-		defer ticker.Stop()
-		for {
-			select {
-			case <-ticker.C:
-				s.run(ctx)
-			case <-ctx.Done():
-				s.log.Info("TokenRefresherScheduler shutting down")
-				return
-			}
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.run(context.WithoutCancel(ctx))
+		case <-ctx.Done():
+			s.log.Info("TokenRefresherScheduler shutting down")
+			return
 		}
-	}()
+	}
 }
 
+// refreshWindow is how long before their expiry tokens are refreshed, it
+// spans a few runs so a failed refresh is retried before the token expires.
+var refreshWindow = 15 * time.Minute
+
+// run enqueues a refresh of the tokens expiring soon that an active pipeline
+// needs. Tokens needing a new login are left alone, failing ones back off.
 func (s *TokenRefresherScheduler) run(ctx context.Context) {
 	queries := query.New(s.dbp)
-	// TODO @reactive
-	// - review
-	// - cut off disabled
-	// Query tokens expiring within the next 5 minutes
-	tokens, err := queries.GetTokensToRefresh(ctx, nil)
+	expiresBefore := pgtype.Timestamptz{Time: time.Now().Add(refreshWindow), Valid: true}
+	tokens, err := queries.GetTokensToRefresh(ctx, expiresBefore)
 	if err != nil {
 		s.log.Error("Failed fetching tokens to refresh", "err", err)
 		return
 	}
+	schedulerUUID := uuid.Must(uuid.NewV7()).String()
 	for _, tokenRow := range tokens {
-
 		jobUUID := uuid.Must(uuid.NewV7()).String()
-
-		schedulerUUID := uuid.Must(uuid.NewV7()).String()
 		headers := queue.Headers{"X-Job-ID": jobUUID}
 
-		// TODO @reactima
-		// - fix schedulerUUID
-		// - review expire
 		jobArgs := jobs.TokenRefresherJobArgs{
 			JobUUID:       jobUUID,
 			SchedulerUUID: schedulerUUID,
 			TokenUUID:     tokenRow.Oauth2Token.UUID,
-			Expiry:        time.Now().Add(defaultRefreshInterval),
+			Expiry:        time.Now(),
 		}
 		payload, err := json.Marshal(jobArgs)
 		if err != nil {
 			s.log.Error("Failed to marshal token refresher job payload", "token_uuid", tokenRow.Oauth2Token.UUID.String(), "err", err)
 			continue
 		}
-		err = s.queue.PublishWithHeaders(ctx, registry.WorkerSubjectTokenRefresh, headers, payload)
+		err = s.queue.PublishWithHeaders(workspace.WithUUID(ctx, *tokenRow.Oauth2Token.WorkspaceUUID), registry.WorkerSubjectTokenRefresh, headers, payload)
 		if err != nil {
 			s.log.Error("Failed to publish token refresher job", "token_uuid", tokenRow.Oauth2Token.UUID.String(), "err", err)
 			continue
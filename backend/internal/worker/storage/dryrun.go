@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"context"
+	"sync"
+
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+)
+
+// DryRunStorage counts the messages and attachments a pipeline would store
+// instead of storing them.
+type DryRunStorage struct {
+	mu          sync.Mutex
+	messages    int
+	attachments int
+}
+
+func NewDryRunStorage() *DryRunStorage {
+	return &DryRunStorage{}
+}
+
+func (s *DryRunStorage) SaveMessage(_ context.Context, _ *api.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages++
+	return nil
+}
+
+func (s *DryRunStorage) SaveAttachment(_ context.Context, _ *api.FileObject) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attachments++
+	return nil
+}
+
+// Counts returns the number of messages and attachments that would have been
+// stored.
+func (s *DryRunStorage) Counts() (messages, attachments int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.messages, s.attachments
+}
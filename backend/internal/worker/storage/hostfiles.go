@@ -22,10 +22,13 @@ type HostfilesStorage struct {
 	rootFolder string
 	dbp        *pgxpool.Pool
 	pgdb       *query.Queries
+	// storageUUID is recorded on the files, migrations and retention find
+	// them by it
+	storageUUID uuid.UUID
 }
 
-func NewHostfilesStorage(log *slog.Logger, folder string, dbp *pgxpool.Pool) *HostfilesStorage {
-	return &HostfilesStorage{log: log, rootFolder: folder, dbp: dbp}
+func NewHostfilesStorage(log *slog.Logger, folder string, dbp *pgxpool.Pool, storageUUID uuid.UUID) *HostfilesStorage {
+	return &HostfilesStorage{log: log, rootFolder: folder, dbp: dbp, pgdb: query.New(dbp), storageUUID: storageUUID}
 }
 
 func (s *HostfilesStorage) SaveMessage(ctx context.Context, message *api.Message) error {
@@ -51,19 +54,31 @@ func (s *HostfilesStorage) SaveMessage(ctx context.Context, message *api.Message
 		s.log.Error("invalid thread UUID", "error", err)
 		return err
 	}
+	pipelineUUID, err := converter.ConvertOptStringToPgUUID(message.PipelineUUID)
+	if err != nil {
+		s.log.Error("invalid pipeline UUID", "error", err)
+		return err
+	}
+	datasourceUUID, err := converter.ConvertOptStringToPgUUID(message.DatasourceUUID)
+	if err != nil {
+		s.log.Error("invalid datasource UUID", "error", err)
+		return err
+	}
 
 	_, err = s.pgdb.CreateMessage(ctx, query.CreateMessageParams{
-		UUID:       uid,
-		Sender:     message.GetSender(),
-		Recipients: message.GetRecipients(),
-		Subject:    converter.OptionalText(message.GetSubject()),
-		Body:       message.GetBody(),
-		Format:     message.Format,
-		Type:       message.Type,
-		ChatUuid:   chatUUID,
-		ThreadUuid: threadUuid,
-		BodyParsed: nil,
-		Reactions:  nil,
+		UUID:           uid,
+		Sender:         message.GetSender(),
+		Recipients:     message.GetRecipients(),
+		Subject:        converter.OptionalText(message.GetSubject()),
+		Body:           message.GetBody(),
+		Format:         message.Format,
+		Type:           message.Type,
+		ChatUuid:       chatUUID,
+		ThreadUuid:     threadUuid,
+		PipelineUuid:   pipelineUUID,
+		DatasourceUUID: datasourceUUID,
+		BodyParsed:     nil,
+		Reactions:      nil,
 	})
 
 	if err != nil {
@@ -72,6 +87,7 @@ func (s *HostfilesStorage) SaveMessage(ctx context.Context, message *api.Message
 	}
 
 	for _, att := range message.GetAttachments() {
+		att.MessageUUID = message.UUID
 		if err := s.SaveAttachment(ctx, &att); err != nil {
 			s.log.Error("failed to save attachment (hostfiles)", "error", err)
 			return err
@@ -89,6 +105,12 @@ func (s *HostfilesStorage) SaveAttachment(ctx context.Context, file *api.FileObj
 		return err
 	}
 
+	messageUUID, err := converter.ConvertOptStringToPgUUID(file.MessageUUID)
+	if err != nil {
+		s.log.Error("invalid message UUID", "uuid", file.MessageUUID.Or(""), "error", err)
+		return err
+	}
+
 	name := file.GetName() // .Or("attachment")
 	mime := file.GetMimeType().Or("application/octet-stream")
 	size := file.GetSize().Or(0)
@@ -131,13 +153,14 @@ func (s *HostfilesStorage) SaveAttachment(ctx context.Context, file *api.FileObj
 	_, err = q.CreateFile(ctx, query.CreateFileParams{
 		UUID:        uid,
 		StorageType: "hostfiles",
-		StorageUuid: pgtype.UUID{Valid: false}, // or set if you have a 'storage' record
+		StorageUuid: converter.UuidToPgUUID(s.storageUUID),
 		Name:        name,
 		MimeType:    converter.PgText(mime),
 		Size:        converter.PgInt8(size),
 		Data:        nil, // no data in Postgres
 		Path:        converter.PgText(filePath),
 		IsRaw:       converter.PgBool(false), // or detect if raw
+		MessageUuid: messageUUID,
 	})
 	if err != nil {
 		return err
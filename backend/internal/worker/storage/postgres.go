@@ -17,10 +17,13 @@ type PostgresStorage struct {
 	log  *slog.Logger
 	dbp  *pgxpool.Pool
 	pgdb *query.Queries
+	// storageUUID is recorded on the files, migrations and retention find
+	// them by it
+	storageUUID uuid.UUID
 }
 
-func NewPostgresStorage(log *slog.Logger, dbp *pgxpool.Pool) *PostgresStorage {
-	return &PostgresStorage{log: log, dbp: dbp}
+func NewPostgresStorage(log *slog.Logger, dbp *pgxpool.Pool, storageUUID uuid.UUID) *PostgresStorage {
+	return &PostgresStorage{log: log, dbp: dbp, pgdb: query.New(dbp), storageUUID: storageUUID}
 }
 
 func (s *PostgresStorage) SaveMessage(ctx context.Context, message *api.Message) error {
@@ -47,19 +50,31 @@ func (s *PostgresStorage) SaveMessage(ctx context.Context, message *api.Message)
 		s.log.Error("invalid thread UUID", "error", err)
 		return err
 	}
+	pipelineUUID, err := converter.ConvertOptStringToPgUUID(message.PipelineUUID)
+	if err != nil {
+		s.log.Error("invalid pipeline UUID", "error", err)
+		return err
+	}
+	datasourceUUID, err := converter.ConvertOptStringToPgUUID(message.DatasourceUUID)
+	if err != nil {
+		s.log.Error("invalid datasource UUID", "error", err)
+		return err
+	}
 
 	_, err = s.pgdb.CreateMessage(ctx, query.CreateMessageParams{
-		UUID:       uid,
-		Sender:     message.GetSender(),
-		Recipients: message.GetRecipients(),
-		Subject:    converter.OptionalText(message.GetSubject()),
-		Body:       message.GetBody(),
-		Format:     message.Format,
-		Type:       message.Type,
-		ChatUuid:   chatUUID,
-		ThreadUuid: threadUuid,
-		BodyParsed: nil,
-		Reactions:  nil,
+		UUID:           uid,
+		Sender:         message.GetSender(),
+		Recipients:     message.GetRecipients(),
+		Subject:        converter.OptionalText(message.GetSubject()),
+		Body:           message.GetBody(),
+		Format:         message.Format,
+		Type:           message.Type,
+		ChatUuid:       chatUUID,
+		ThreadUuid:     threadUuid,
+		PipelineUuid:   pipelineUUID,
+		DatasourceUUID: datasourceUUID,
+		BodyParsed:     nil,
+		Reactions:      nil,
 	})
 
 	if err != nil {
@@ -69,6 +84,7 @@ func (s *PostgresStorage) SaveMessage(ctx context.Context, message *api.Message)
 
 	// If you wish to separately store attachments:
 	for _, att := range message.GetAttachments() {
+		att.MessageUUID = message.UUID
 		if err := s.SaveAttachment(ctx, &att); err != nil {
 			s.log.Error("failed to save attachment in Postgres", "error", err)
 			return err
@@ -92,6 +108,12 @@ func (s *PostgresStorage) SaveAttachment(ctx context.Context, file *api.FileObje
 	copy(arr[:], u.Bytes())
 	uid := pgtype.UUID{Bytes: arr, Valid: true}
 
+	messageUUID, err := converter.ConvertOptStringToPgUUID(file.MessageUUID)
+	if err != nil {
+		s.log.Error("invalid message UUID", "uuid", file.MessageUUID.Or(""), "error", err)
+		return err
+	}
+
 	name := file.GetName() // .Or("attachment")
 	mime := file.GetMimeType().Or("application/octet-stream")
 	size := file.GetSize().Or(0)
@@ -111,13 +133,14 @@ func (s *PostgresStorage) SaveAttachment(ctx context.Context, file *api.FileObje
 		UUID: uid,
 
 		StorageType: "postgres",
-		StorageUuid: pgtype.UUID{Valid: false}, // or set if you have a storage record
+		StorageUuid: converter.UuidToPgUUID(s.storageUUID),
 		Name:        name,
 		MimeType:    converter.PgText(mime),
 		Size:        converter.PgInt8(size),
 		Data:        fileBytes,
 		Path:        pgtype.Text{Valid: false},
 		IsRaw:       converter.PgBool(false), // or detect if raw
+		MessageUuid: messageUUID,
 	})
 	if err != nil {
 		s.log.Error("failed to insert file record in DB (postgres)", "error", err)
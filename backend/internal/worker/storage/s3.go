@@ -20,14 +20,17 @@ type S3Storage struct {
 	s3Client *s3.S3
 	bucket   string
 	pgdb     *query.Queries
+	// storageUUID is recorded on the files, their bucket is found by it
+	storageUUID uuid.UUID
 }
 
-func NewS3Storage(log *slog.Logger, s3Client *s3.S3, bucketName string, pgdb *query.Queries) *S3Storage {
+func NewS3Storage(log *slog.Logger, s3Client *s3.S3, bucketName string, pgdb *query.Queries, storageUUID uuid.UUID) *S3Storage {
 	return &S3Storage{
-		log:      log,
-		s3Client: s3Client,
-		bucket:   bucketName,
-		pgdb:     pgdb,
+		log:         log,
+		s3Client:    s3Client,
+		bucket:      bucketName,
+		pgdb:        pgdb,
+		storageUUID: storageUUID,
 	}
 }
 
@@ -58,19 +61,31 @@ func (s *S3Storage) SaveMessage(ctx context.Context, message *api.Message) error
 		s.log.Error("invalid thread UUID", "error", err)
 		return err
 	}
+	pipelineUUID, err := converter.ConvertOptStringToPgUUID(message.PipelineUUID)
+	if err != nil {
+		s.log.Error("invalid pipeline UUID", "error", err)
+		return err
+	}
+	datasourceUUID, err := converter.ConvertOptStringToPgUUID(message.DatasourceUUID)
+	if err != nil {
+		s.log.Error("invalid datasource UUID", "error", err)
+		return err
+	}
 
 	_, err = s.pgdb.CreateMessage(ctx, query.CreateMessageParams{
-		UUID:       uid,
-		Sender:     message.GetSender(),
-		Recipients: message.GetRecipients(),
-		Subject:    converter.OptionalText(message.GetSubject()),
-		Body:       message.GetBody(),
-		Format:     message.Format,
-		Type:       message.Type,
-		ChatUuid:   chatUUID,
-		ThreadUuid: threadUuid,
-		BodyParsed: nil,
-		Reactions:  nil,
+		UUID:           uid,
+		Sender:         message.GetSender(),
+		Recipients:     message.GetRecipients(),
+		Subject:        converter.OptionalText(message.GetSubject()),
+		Body:           message.GetBody(),
+		Format:         message.Format,
+		Type:           message.Type,
+		ChatUuid:       chatUUID,
+		ThreadUuid:     threadUuid,
+		PipelineUuid:   pipelineUUID,
+		DatasourceUUID: datasourceUUID,
+		BodyParsed:     nil,
+		Reactions:      nil,
 	})
 	if err != nil {
 		s.log.Error("failed to insert message record (S3 mode)", "error", err)
@@ -78,6 +93,7 @@ func (s *S3Storage) SaveMessage(ctx context.Context, message *api.Message) error
 	}
 
 	for _, att := range message.GetAttachments() {
+		att.MessageUUID = message.UUID
 		if err := s.SaveAttachment(ctx, &att); err != nil {
 			return err
 		}
@@ -99,6 +115,12 @@ func (s *S3Storage) SaveAttachment(ctx context.Context, file *api.FileObject) er
 	copy(arr[:], u.Bytes())
 	uid := pgtype.UUID{Bytes: arr, Valid: true}
 
+	messageUUID, err := converter.ConvertOptStringToPgUUID(file.MessageUUID)
+	if err != nil {
+		s.log.Error("invalid message UUID", "uuid", file.MessageUUID.Or(""), "error", err)
+		return err
+	}
+
 	name := file.GetName() // .Or("attachment")
 	mime := file.GetMimeType().Or("application/octet-stream")
 	size := file.GetSize().Or(0)
@@ -125,13 +147,14 @@ func (s *S3Storage) SaveAttachment(ctx context.Context, file *api.FileObject) er
 		_, err = s.pgdb.CreateFile(ctx, query.CreateFileParams{
 			UUID:        uid,
 			StorageType: "s3",
-			StorageUuid: pgtype.UUID{Valid: false},
+			StorageUuid: converter.UuidToPgUUID(s.storageUUID),
 			Name:        name,
 			MimeType:    converter.PgText(mime),
 			Size:        converter.PgInt8(size),
 			Data:        nil,
 			Path:        converter.PgText(key),
 			IsRaw:       converter.PgBool(false),
+			MessageUuid: messageUUID,
 		})
 		if err != nil {
 			s.log.Error("failed to insert file record in DB (S3)", "error", err)
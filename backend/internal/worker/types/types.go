@@ -56,5 +56,33 @@ type Pipeline interface {
 	Run(ctx context.Context, message *api.Message) error
 }
 
+// RunOptions are the options of a pipeline run triggered by hand, the zero
+// value is a scheduled run.
+type RunOptions struct {
+	// Since and Until backfill the messages received in the range instead of
+	// fetching the changes since the last run
+	Since *time.Time `json:"since,omitempty"`
+	Until *time.Time `json:"until,omitempty"`
+	// Limit caps the number of fetched messages, no cap when 0
+	Limit int `json:"limit,omitempty"`
+	// DryRun evaluates the filters and extractors without storing messages
+	DryRun bool `json:"dry_run,omitempty"`
+	// StorageUUID stores the messages in another storage than the pipeline's
+	StorageUUID string `json:"storage_uuid,omitempty"`
+	// OnDemand marks a run triggered by hand, also without any other option
+	OnDemand bool `json:"on_demand,omitempty"`
+}
+
+// Manual reports whether the options are those of a run triggered by hand.
+// Manual runs leave the sync cursors of the datasource alone.
+func (o RunOptions) Manual() bool {
+	return o != RunOptions{}
+}
+
+// Backfill reports whether the run fetches a date range.
+func (o RunOptions) Backfill() bool {
+	return o.Since != nil || o.Until != nil
+}
+
 // JobFactory is a function that builds a Job from a message’s raw data.
 type JobFactory func(data []byte) (Job, error)
@@ -0,0 +1,127 @@
+// Package workspace carries the workspace a request or job is scoped to.
+//
+// Every tenant resource belongs to a workspace. The workspace travels in the
+// context; the database pool switches connections acquired with a scoped
+// context to the tenant role, where row level security hides the rows of
+// other workspaces.
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/shadowapi/shadowapi/backend/pkg/query"
+)
+
+// DefaultUUID is the workspace existing data was assigned to when workspaces
+// were introduced, and the fallback for machine (bearer token) access.
+var DefaultUUID = uuid.Must(uuid.FromString("00000000-0000-0000-0000-000000000001"))
+
+// Header selects the workspace of an API request and carries the workspace
+// of a queued job.
+const Header = "X-Workspace-ID"
+
+// Member roles, the access policies of each role are seeded by the policies
+// package.
+const (
+	RoleOwner     = "owner"
+	RoleAdmin     = "admin"
+	RoleOperator  = "operator"
+	RoleReadOnly  = "readonly"
+	RoleAPIClient = "api_client"
+)
+
+// roleRanks orders the roles by the access they grant. readonly and
+// api_client share a rank, neither grants all the access of the other.
+var roleRanks = map[string]int{
+	RoleOwner:     4,
+	RoleAdmin:     3,
+	RoleOperator:  2,
+	RoleReadOnly:  1,
+	RoleAPIClient: 1,
+}
+
+// RoleCovers reports whether role grants at least the access of other, e.g.
+// whether a member with role may hand out other.
+func RoleCovers(role, other string) bool {
+	if role == other {
+		return roleRanks[role] > 0
+	}
+	r, o := roleRanks[role], roleRanks[other]
+	return o > 0 && r > o
+}
+
+type ctxKey struct{}
+
+// WithUUID scopes the context to the workspace.
+func WithUUID(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// FromContext returns the workspace the context is scoped to.
+func FromContext(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(ctxKey{}).(uuid.UUID)
+	return id, ok && !id.IsNil()
+}
+
+// Unscoped drops the workspace scope, for system work that must see every
+// workspace such as schedulers scanning all pipelines.
+func Unscoped(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxKey{}, uuid.Nil)
+}
+
+// ErrNoScope is returned for jobs carrying no workspace, they are refused
+// rather than run across every workspace.
+var ErrNoScope = errors.New("job carries no workspace")
+
+// HeaderValue returns the value of Header for a job published with ctx: the
+// workspace it is scoped to, or the nil UUID for system work published with
+// an Unscoped context. It returns false for a context without any scope.
+func HeaderValue(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(ctxKey{}).(uuid.UUID)
+	if !ok {
+		return "", false
+	}
+	return id.String(), true
+}
+
+// FromHeader scopes the context of a job to the workspace its Header carries,
+// see HeaderValue. A missing or invalid value is ErrNoScope.
+func FromHeader(ctx context.Context, value string) (context.Context, error) {
+	id, err := uuid.FromString(value)
+	if err != nil {
+		return ctx, ErrNoScope
+	}
+	if id.IsNil() {
+		return Unscoped(ctx), nil
+	}
+	return WithUUID(ctx, id), nil
+}
+
+// Resolve returns the workspace with the slug or UUID, DefaultUUID when ref
+// is empty.
+func Resolve(ctx context.Context, q *query.Queries, ref string) (uuid.UUID, error) {
+	if ref == "" {
+		return DefaultUUID, nil
+	}
+	if id, err := uuid.FromString(ref); err == nil {
+		if _, err := q.GetWorkspace(ctx, pgtype.UUID{Bytes: id, Valid: true}); err != nil {
+			return uuid.Nil, fmt.Errorf("workspace %s: %w", ref, err)
+		}
+		return id, nil
+	}
+	rows, err := q.GetWorkspaces(ctx, query.GetWorkspacesParams{})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("list workspaces: %w", err)
+	}
+	for _, row := range rows {
+		if row.Workspace.Slug == ref {
+			return row.Workspace.UUID, nil
+		}
+	}
+	return uuid.Nil, fmt.Errorf("workspace %q not found", ref)
+}
@@ -0,0 +1,50 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid"
+)
+
+func TestHeader(t *testing.T) {
+	id := uuid.Must(uuid.NewV7())
+
+	// a context without any scope publishes no header
+	if _, ok := HeaderValue(context.Background()); ok {
+		t.Error("unscoped background context got a header")
+	}
+
+	value, ok := HeaderValue(WithUUID(context.Background(), id))
+	if !ok || value != id.String() {
+		t.Fatalf("header = %q, %v", value, ok)
+	}
+	ctx, err := FromHeader(context.Background(), value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := FromContext(ctx); !ok || got != id {
+		t.Errorf("workspace = %s, %v", got, ok)
+	}
+
+	// system work is published with the nil UUID and runs unscoped
+	value, ok = HeaderValue(Unscoped(context.Background()))
+	if !ok || value != uuid.Nil.String() {
+		t.Fatalf("unscoped header = %q, %v", value, ok)
+	}
+	ctx, err = FromHeader(context.Background(), value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := FromContext(ctx); ok {
+		t.Error("nil header scoped the job")
+	}
+
+	// jobs without a header are refused
+	for _, value := range []string{"", "not-a-uuid"} {
+		if _, err := FromHeader(context.Background(), value); !errors.Is(err, ErrNoScope) {
+			t.Errorf("FromHeader(%q) = %v, want ErrNoScope", value, err)
+		}
+	}
+}
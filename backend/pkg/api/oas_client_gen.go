@@ -29,6 +29,38 @@ func trimTrailingSlashes(u *url.URL) {
 
 // Invoker invokes operations described by OpenAPI v3 specification.
 type Invoker interface {
+	// ApikeyCreate invokes apikey-create operation.
+	//
+	// Create an API key. The response is the only time the key is shown.
+	//
+	// POST /apikey
+	ApikeyCreate(ctx context.Context, request *APIKey) (*APIKey, error)
+	// ApikeyList invokes apikey-list operation.
+	//
+	// Retrieve the API keys of the workspace. Owners and admins see every key, other members
+	// their own keys.
+	//
+	// GET /apikey
+	ApikeyList(ctx context.Context, params ApikeyListParams) ([]APIKey, error)
+	// ApikeyRevoke invokes apikey-revoke operation.
+	//
+	// Revoke an API key. Members revoke their own keys, owners and admins any key.
+	//
+	// DELETE /apikey/{uuid}
+	ApikeyRevoke(ctx context.Context, params ApikeyRevokeParams) error
+	// AuditExport invokes audit-export operation.
+	//
+	// Export the matching audit events of the workspace as newline delimited JSON, one
+	// AuditEvent per line, newest first. Owners and admins only.
+	//
+	// GET /audit/export
+	AuditExport(ctx context.Context, params AuditExportParams) (AuditExportOK, error)
+	// AuditList invokes audit-list operation.
+	//
+	// Retrieve the audit log of the workspace, newest first. Owners and admins only.
+	//
+	// GET /audit
+	AuditList(ctx context.Context, params AuditListParams) ([]AuditEvent, error)
 	// CreateContact invokes createContact operation.
 	//
 	// Create a new contact record.
@@ -59,6 +91,42 @@ type Invoker interface {
 	//
 	// GET /datasource/email/{uuid}
 	DatasourceEmailGet(ctx context.Context, params DatasourceEmailGetParams) (*DatasourceEmail, error)
+	// DatasourceEmailGraphCreate invokes datasource-email-graph-create operation.
+	//
+	// Create a new Microsoft Graph mail datasource.
+	//
+	// POST /datasource/email_graph
+	DatasourceEmailGraphCreate(ctx context.Context, request *DatasourceEmailGraph) (*DatasourceEmailGraph, error)
+	// DatasourceEmailGraphDelete invokes datasource-email-graph-delete operation.
+	//
+	// Delete a Microsoft Graph mail datasource.
+	//
+	// DELETE /datasource/email_graph/{uuid}
+	DatasourceEmailGraphDelete(ctx context.Context, params DatasourceEmailGraphDeleteParams) error
+	// DatasourceEmailGraphGet invokes datasource-email-graph-get operation.
+	//
+	// Retrieve a Microsoft Graph mail datasource.
+	//
+	// GET /datasource/email_graph/{uuid}
+	DatasourceEmailGraphGet(ctx context.Context, params DatasourceEmailGraphGetParams) (*DatasourceEmailGraph, error)
+	// DatasourceEmailGraphList invokes datasource-email-graph-list operation.
+	//
+	// List Microsoft Graph mail datasources.
+	//
+	// GET /datasource/email_graph
+	DatasourceEmailGraphList(ctx context.Context, params DatasourceEmailGraphListParams) ([]DatasourceEmailGraph, error)
+	// DatasourceEmailGraphSend invokes datasource-email-graph-send operation.
+	//
+	// Send a mail from the mailbox of a Microsoft Graph mail datasource.
+	//
+	// POST /datasource/email_graph/{uuid}/send
+	DatasourceEmailGraphSend(ctx context.Context, request *EmailSend, params DatasourceEmailGraphSendParams) error
+	// DatasourceEmailGraphUpdate invokes datasource-email-graph-update operation.
+	//
+	// Update an existing Microsoft Graph mail datasource.
+	//
+	// PUT /datasource/email_graph/{uuid}
+	DatasourceEmailGraphUpdate(ctx context.Context, request *DatasourceEmailGraph, params DatasourceEmailGraphUpdateParams) (*DatasourceEmailGraph, error)
 	// DatasourceEmailList invokes datasource-email-list operation.
 	//
 	// List email datasources.
@@ -215,6 +283,25 @@ type Invoker interface {
 	//
 	// DELETE /user/{uuid}
 	DeleteUser(ctx context.Context, params DeleteUserParams) error
+	// ErasureCreate invokes erasure-create operation.
+	//
+	// Find every message, file blob and contact referencing an email address or phone
+	// number across all storages, delete or anonymise them and return the erasure report.
+	//
+	// POST /erasure
+	ErasureCreate(ctx context.Context, request *ErasureRequest) (*ErasureRequest, error)
+	// ErasureList invokes erasure-list operation.
+	//
+	// Retrieve a list of executed erasure requests with their reports.
+	//
+	// GET /erasure
+	ErasureList(ctx context.Context, params ErasureListParams) ([]ErasureRequest, error)
+	// EventList invokes event-list operation.
+	//
+	// Retrieve the events of the workspace, newest first. Events are kept for 7 days.
+	//
+	// GET /event
+	EventList(ctx context.Context, params EventListParams) ([]Event, error)
 	// FileCreate invokes file-create operation.
 	//
 	// Upload a new file and create its record.
@@ -365,12 +452,24 @@ type Invoker interface {
 	//
 	// GET /oauth2/client/{datasource_uuid}/token
 	OAuth2ClientTokenList(ctx context.Context, params OAuth2ClientTokenListParams) ([]OAuth2ClientToken, error)
+	// OAuth2ClientTokenRefresh invokes oauth2-client-token-refresh operation.
+	//
+	// Refresh the OAuth2 client token now, whatever its expiry.
+	//
+	// POST /oauth2/client/{datasource_uuid}/token/{uuid}/refresh
+	OAuth2ClientTokenRefresh(ctx context.Context, params OAuth2ClientTokenRefreshParams) error
 	// OAuth2ClientUpdate invokes oauth2-client-update operation.
 	//
 	// Update OAuth2 client.
 	//
 	// PUT /oauth2/client/{uuid}
 	OAuth2ClientUpdate(ctx context.Context, request *OAuth2ClientUpdateReq, params OAuth2ClientUpdateParams) (*OAuth2Client, error)
+	// OAuth2ProviderList invokes oauth2-provider-list operation.
+	//
+	// List the OAuth2 providers OAuth2 clients can use.
+	//
+	// GET /oauth2/provider
+	OAuth2ProviderList(ctx context.Context) ([]OAuth2Provider, error)
 	// PipelineCreate invokes pipeline-create operation.
 	//
 	// Create a new pipeline for a datasource.
@@ -395,12 +494,87 @@ type Invoker interface {
 	//
 	// GET /pipeline
 	PipelineList(ctx context.Context, params PipelineListParams) (*PipelineListOK, error)
+	// PipelineRun invokes pipeline-run operation.
+	//
+	// Enqueue a fetch job for the pipeline outside its schedule, optionally as a
+	// date range backfill, capped, as a dry run or into another storage. The job
+	// is recorded in worker jobs as queued.
+	//
+	// POST /pipeline/{uuid}/run
+	PipelineRun(ctx context.Context, request OptPipelineRun, params PipelineRunParams) (*PipelineRun, error)
 	// PipelineUpdate invokes pipeline-update operation.
 	//
 	// Update an existing pipeline.
 	//
 	// PUT /pipeline/{uuid}
 	PipelineUpdate(ctx context.Context, request *Pipeline, params PipelineUpdateParams) (*Pipeline, error)
+	// PolicyCreate invokes policy-create operation.
+	//
+	// Create a new access policy. Requires a system administrator.
+	//
+	// POST /policy
+	PolicyCreate(ctx context.Context, request *Policy) (*Policy, error)
+	// PolicyDelete invokes policy-delete operation.
+	//
+	// Delete an access policy by id. Built-in policies cannot be deleted. Requires a system
+	// administrator.
+	//
+	// DELETE /policy/{id}
+	PolicyDelete(ctx context.Context, params PolicyDeleteParams) error
+	// PolicyGet invokes policy-get operation.
+	//
+	// Retrieve an access policy by id.
+	//
+	// GET /policy/{id}
+	PolicyGet(ctx context.Context, params PolicyGetParams) (*Policy, error)
+	// PolicyList invokes policy-list operation.
+	//
+	// Retrieve a list of access policies.
+	//
+	// GET /policy
+	PolicyList(ctx context.Context, params PolicyListParams) ([]Policy, error)
+	// PolicyUpdate invokes policy-update operation.
+	//
+	// Update an access policy by id. Requires a system administrator.
+	//
+	// PUT /policy/{id}
+	PolicyUpdate(ctx context.Context, request *Policy, params PolicyUpdateParams) (*Policy, error)
+	// RetentionPolicyCreate invokes retention-policy-create operation.
+	//
+	// Create a new retention policy.
+	//
+	// POST /retention
+	RetentionPolicyCreate(ctx context.Context, request *RetentionPolicy) (*RetentionPolicy, error)
+	// RetentionPolicyDelete invokes retention-policy-delete operation.
+	//
+	// Delete a retention policy by UUID.
+	//
+	// DELETE /retention/{uuid}
+	RetentionPolicyDelete(ctx context.Context, params RetentionPolicyDeleteParams) error
+	// RetentionPolicyGet invokes retention-policy-get operation.
+	//
+	// Retrieve a retention policy by UUID.
+	//
+	// GET /retention/{uuid}
+	RetentionPolicyGet(ctx context.Context, params RetentionPolicyGetParams) (*RetentionPolicy, error)
+	// RetentionPolicyList invokes retention-policy-list operation.
+	//
+	// Retrieve a list of retention policies.
+	//
+	// GET /retention
+	RetentionPolicyList(ctx context.Context, params RetentionPolicyListParams) ([]RetentionPolicy, error)
+	// RetentionPolicyRun invokes retention-policy-run operation.
+	//
+	// Apply a retention policy now instead of waiting for the scheduled retention job.
+	//
+	// POST /retention/{uuid}/run
+	RetentionPolicyRun(ctx context.Context, params RetentionPolicyRunParams) error
+	// RetentionPolicyUpdate invokes retention-policy-update operation.
+	//
+	// Update a retention policy by UUID.
+	//
+	// PUT /retention/{uuid}
+	RetentionPolicyUpdate(ctx context.Context, request *RetentionPolicy, params RetentionPolicyUpdateParams) (*RetentionPolicy, error)
 	// SchedulerCreate invokes scheduler-create operation.
 	//
 	// Create scheduler.
@@ -431,9 +605,21 @@ type Invoker interface {
 	//
 	// PUT /scheduler/{uuid}
 	SchedulerUpdate(ctx context.Context, request *Scheduler, params SchedulerUpdateParams) (*Scheduler, error)
+	// SessionRevoke invokes session-revoke operation.
+	//
+	// Revoke one session of the current user.
+	//
+	// DELETE /session/{uuid}
+	SessionRevoke(ctx context.Context, params SessionRevokeParams) error
+	// SessionRevokeAll invokes session-revoke-all operation.
+	//
+	// Log out everywhere by revoking every session of the current user.
+	//
+	// DELETE /session
+	SessionRevokeAll(ctx context.Context, params SessionRevokeAllParams) error
 	// SessionStatus invokes session-status operation.
 	//
-	// Introspect current session status.
+	// Introspect current session status and list the active sessions of the user.
 	//
 	// GET /session
 	SessionStatus(ctx context.Context) (*SessionStatus, error)
@@ -467,6 +653,21 @@ type Invoker interface {
 	//
 	// GET /storage
 	StorageList(ctx context.Context, params StorageListParams) ([]Storage, error)
+	// StorageMigrate invokes storage-migrate operation.
+	//
+	// Start moving message bodies and files from this storage to the target storage. If an
+	// unfinished migration with the same target and options exists it is resumed from its
+	// checkpoint. A migration to the same target still held by a run answers 409, a run that
+	// stopped renewing its lease is taken over.
+	//
+	// POST /storage/{uuid}/migrate
+	StorageMigrate(ctx context.Context, request *StorageMigration, params StorageMigrateParams) (*StorageMigration, error)
+	// StorageMigrationList invokes storage-migration-list operation.
+	//
+	// List migrations started from this storage.
+	//
+	// GET /storage/{uuid}/migrate
+	StorageMigrationList(ctx context.Context, params StorageMigrationListParams) ([]StorageMigration, error)
 	// StoragePostgresCreate invokes storage-postgres-create operation.
 	//
 	// Create a new PostgreSQL storage instance.
@@ -587,6 +788,36 @@ type Invoker interface {
 	//
 	// POST /storage/upload
 	UploadFile(ctx context.Context, request *UploadFileRequest) (*UploadFileResponse, error)
+	// WebhookCreate invokes webhook-create operation.
+	//
+	// Create a webhook. It receives the events emitted after its creation.
+	//
+	// POST /webhook
+	WebhookCreate(ctx context.Context, request *Webhook) (*Webhook, error)
+	// WebhookDelete invokes webhook-delete operation.
+	//
+	// Delete a webhook.
+	//
+	// DELETE /webhook/{uuid}
+	WebhookDelete(ctx context.Context, params WebhookDeleteParams) error
+	// WebhookGet invokes webhook-get operation.
+	//
+	// Retrieve a webhook.
+	//
+	// GET /webhook/{uuid}
+	WebhookGet(ctx context.Context, params WebhookGetParams) (*Webhook, error)
+	// WebhookList invokes webhook-list operation.
+	//
+	// Retrieve the webhooks of the workspace.
+	//
+	// GET /webhook
+	WebhookList(ctx context.Context) ([]Webhook, error)
+	// WebhookUpdate invokes webhook-update operation.
+	//
+	// Update a webhook.
+	//
+	// PUT /webhook/{uuid}
+	WebhookUpdate(ctx context.Context, request *Webhook, params WebhookUpdateParams) (*Webhook, error)
 	// WorkerJobsCancel invokes worker-jobs-cancel operation.
 	//
 	// Signal cancellation for a running job; returns 204 if accepted.
@@ -611,6 +842,60 @@ type Invoker interface {
 	//
 	// GET /workerjobs
 	WorkerJobsList(ctx context.Context, params WorkerJobsListParams) (*WorkerJobsListOK, error)
+	// WorkerJobsRetry invokes worker-jobs-retry operation.
+	//
+	// Publish the job again under a new uuid with the arguments it was queued with. Returns the new job.
+	//
+	// POST /workerjobs/{uuid}/retry
+	WorkerJobsRetry(ctx context.Context, params WorkerJobsRetryParams) (*WorkerJobs, error)
+	// WorkspaceCreate invokes workspace-create operation.
+	//
+	// Create a new workspace, the caller becomes its owner.
+	//
+	// POST /workspace
+	WorkspaceCreate(ctx context.Context, request *Workspace) (*Workspace, error)
+	// WorkspaceDelete invokes workspace-delete operation.
+	//
+	// Delete a workspace and every resource it owns. Requires the owner role.
+	//
+	// DELETE /workspace/{uuid}
+	WorkspaceDelete(ctx context.Context, params WorkspaceDeleteParams) error
+	// WorkspaceGet invokes workspace-get operation.
+	//
+	// Retrieve a workspace by UUID.
+	//
+	// GET /workspace/{uuid}
+	WorkspaceGet(ctx context.Context, params WorkspaceGetParams) (*Workspace, error)
+	// WorkspaceList invokes workspace-list operation.
+	//
+	// Retrieve the workspaces of the caller. The bearer token sees every workspace.
+	//
+	// GET /workspace
+	WorkspaceList(ctx context.Context, params WorkspaceListParams) ([]Workspace, error)
+	// WorkspaceMemberDelete invokes workspace-member-delete operation.
+	//
+	// Remove a user from a workspace. The last owner cannot be removed.
+	//
+	// DELETE /workspace/{uuid}/member/{user_uuid}
+	WorkspaceMemberDelete(ctx context.Context, params WorkspaceMemberDeleteParams) error
+	// WorkspaceMemberList invokes workspace-member-list operation.
+	//
+	// Retrieve the members of a workspace.
+	//
+	// GET /workspace/{uuid}/member
+	WorkspaceMemberList(ctx context.Context, params WorkspaceMemberListParams) ([]WorkspaceMember, error)
+	// WorkspaceMemberSet invokes workspace-member-set operation.
+	//
+	// Add a user to a workspace or change the role of a member. Requires the owner or admin role.
+	//
+	// POST /workspace/{uuid}/member
+	WorkspaceMemberSet(ctx context.Context, request *WorkspaceMember, params WorkspaceMemberSetParams) (*WorkspaceMember, error)
+	// WorkspaceUpdate invokes workspace-update operation.
+	//
+	// Update a workspace by UUID. Requires the owner or admin role.
+	//
+	// PUT /workspace/{uuid}
+	WorkspaceUpdate(ctx context.Context, request *Workspace, params WorkspaceUpdateParams) (*Workspace, error)
 }
 
 // Client implements OAS client.
@@ -662,21 +947,21 @@ func (c *Client) requestURL(ctx context.Context) *url.URL {
 	return u
 }
 
-// CreateContact invokes createContact operation.
+// ApikeyCreate invokes apikey-create operation.
 //
-// Create a new contact record.
+// Create an API key. The response is the only time the key is shown.
 //
-// POST /contact
-func (c *Client) CreateContact(ctx context.Context, request *Contact) (*Contact, error) {
-	res, err := c.sendCreateContact(ctx, request)
+// POST /apikey
+func (c *Client) ApikeyCreate(ctx context.Context, request *APIKey) (*APIKey, error) {
+	res, err := c.sendApikeyCreate(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendCreateContact(ctx context.Context, request *Contact) (res *Contact, err error) {
+func (c *Client) sendApikeyCreate(ctx context.Context, request *APIKey) (res *APIKey, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createContact"),
+		otelogen.OperationID("apikey-create"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/contact"),
+		semconv.HTTPRouteKey.String("/apikey"),
 	}
 
 	// Run stopwatch.
@@ -691,7 +976,7 @@ func (c *Client) sendCreateContact(ctx context.Context, request *Contact) (res *
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateContactOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ApikeyCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -709,7 +994,7 @@ func (c *Client) sendCreateContact(ctx context.Context, request *Contact) (res *
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/contact"
+	pathParts[0] = "/apikey"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -717,7 +1002,7 @@ func (c *Client) sendCreateContact(ctx context.Context, request *Contact) (res *
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateContactRequest(request, r); err != nil {
+	if err := encodeApikeyCreateRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -726,7 +1011,7 @@ func (c *Client) sendCreateContact(ctx context.Context, request *Contact) (res *
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, CreateContactOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, ApikeyCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -737,7 +1022,7 @@ func (c *Client) sendCreateContact(ctx context.Context, request *Contact) (res *
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CreateContactOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ApikeyCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -748,7 +1033,7 @@ func (c *Client) sendCreateContact(ctx context.Context, request *Contact) (res *
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, CreateContactOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, ApikeyCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -786,7 +1071,7 @@ func (c *Client) sendCreateContact(ctx context.Context, request *Contact) (res *
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateContactResponse(resp)
+	result, err := decodeApikeyCreateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -794,21 +1079,22 @@ func (c *Client) sendCreateContact(ctx context.Context, request *Contact) (res *
 	return result, nil
 }
 
-// CreateUser invokes createUser operation.
+// ApikeyList invokes apikey-list operation.
 //
-// Create a new user.
+// Retrieve the API keys of the workspace. Owners and admins see every key, other members
+// their own keys.
 //
-// POST /user
-func (c *Client) CreateUser(ctx context.Context, request *User) (*User, error) {
-	res, err := c.sendCreateUser(ctx, request)
+// GET /apikey
+func (c *Client) ApikeyList(ctx context.Context, params ApikeyListParams) ([]APIKey, error) {
+	res, err := c.sendApikeyList(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendCreateUser(ctx context.Context, request *User) (res *User, err error) {
+func (c *Client) sendApikeyList(ctx context.Context, params ApikeyListParams) (res []APIKey, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createUser"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/user"),
+		otelogen.OperationID("apikey-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/apikey"),
 	}
 
 	// Run stopwatch.
@@ -823,7 +1109,7 @@ func (c *Client) sendCreateUser(ctx context.Context, request *User) (res *User,
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateUserOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ApikeyListOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -841,24 +1127,76 @@ func (c *Client) sendCreateUser(ctx context.Context, request *User) (res *User,
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/user"
+	pathParts[0] = "/apikey"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "include_revoked" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "include_revoked",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IncludeRevoked.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateUserRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, CreateUserOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, ApikeyListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -869,7 +1207,7 @@ func (c *Client) sendCreateUser(ctx context.Context, request *User) (res *User,
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CreateUserOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ApikeyListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -880,7 +1218,7 @@ func (c *Client) sendCreateUser(ctx context.Context, request *User) (res *User,
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, CreateUserOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, ApikeyListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -918,7 +1256,7 @@ func (c *Client) sendCreateUser(ctx context.Context, request *User) (res *User,
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateUserResponse(resp)
+	result, err := decodeApikeyListResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -926,21 +1264,21 @@ func (c *Client) sendCreateUser(ctx context.Context, request *User) (res *User,
 	return result, nil
 }
 
-// DatasourceEmailCreate invokes datasource-email-create operation.
+// ApikeyRevoke invokes apikey-revoke operation.
 //
-// Create a new email datasource.
+// Revoke an API key. Members revoke their own keys, owners and admins any key.
 //
-// POST /datasource/email
-func (c *Client) DatasourceEmailCreate(ctx context.Context, request *DatasourceEmail) (*DatasourceEmail, error) {
-	res, err := c.sendDatasourceEmailCreate(ctx, request)
-	return res, err
+// DELETE /apikey/{uuid}
+func (c *Client) ApikeyRevoke(ctx context.Context, params ApikeyRevokeParams) error {
+	_, err := c.sendApikeyRevoke(ctx, params)
+	return err
 }
 
-func (c *Client) sendDatasourceEmailCreate(ctx context.Context, request *DatasourceEmail) (res *DatasourceEmail, err error) {
+func (c *Client) sendApikeyRevoke(ctx context.Context, params ApikeyRevokeParams) (res *ApikeyRevokeOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-email-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/datasource/email"),
+		otelogen.OperationID("apikey-revoke"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/apikey/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -955,7 +1293,7 @@ func (c *Client) sendDatasourceEmailCreate(ctx context.Context, request *Datasou
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailCreateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ApikeyRevokeOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -972,25 +1310,40 @@ func (c *Client) sendDatasourceEmailCreate(ctx context.Context, request *Datasou
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/datasource/email"
+	var pathParts [2]string
+	pathParts[0] = "/apikey/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeDatasourceEmailCreateRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailCreateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, ApikeyRevokeOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1001,7 +1354,7 @@ func (c *Client) sendDatasourceEmailCreate(ctx context.Context, request *Datasou
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceEmailCreateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ApikeyRevokeOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1012,7 +1365,7 @@ func (c *Client) sendDatasourceEmailCreate(ctx context.Context, request *Datasou
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailCreateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, ApikeyRevokeOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1050,7 +1403,7 @@ func (c *Client) sendDatasourceEmailCreate(ctx context.Context, request *Datasou
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceEmailCreateResponse(resp)
+	result, err := decodeApikeyRevokeResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1058,21 +1411,22 @@ func (c *Client) sendDatasourceEmailCreate(ctx context.Context, request *Datasou
 	return result, nil
 }
 
-// DatasourceEmailDelete invokes datasource-email-delete operation.
+// AuditExport invokes audit-export operation.
 //
-// Delete an email datasource.
+// Export the matching audit events of the workspace as newline delimited JSON, one
+// AuditEvent per line, newest first. Owners and admins only.
 //
-// DELETE /datasource/email/{uuid}
-func (c *Client) DatasourceEmailDelete(ctx context.Context, params DatasourceEmailDeleteParams) error {
-	_, err := c.sendDatasourceEmailDelete(ctx, params)
-	return err
+// GET /audit/export
+func (c *Client) AuditExport(ctx context.Context, params AuditExportParams) (AuditExportOK, error) {
+	res, err := c.sendAuditExport(ctx, params)
+	return res, err
 }
 
-func (c *Client) sendDatasourceEmailDelete(ctx context.Context, params DatasourceEmailDeleteParams) (res *DatasourceEmailDeleteOK, err error) {
+func (c *Client) sendAuditExport(ctx context.Context, params AuditExportParams) (res AuditExportOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-email-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/datasource/email/{uuid}"),
+		otelogen.OperationID("audit-export"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/audit/export"),
 	}
 
 	// Run stopwatch.
@@ -1087,7 +1441,7 @@ func (c *Client) sendDatasourceEmailDelete(ctx context.Context, params Datasourc
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AuditExportOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1104,19 +1458,6726 @@ func (c *Client) sendDatasourceEmailDelete(ctx context.Context, params Datasourc
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/datasource/email/"
+	var pathParts [1]string
+	pathParts[0] = "/audit/export"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "uuid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "actor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "actor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Actor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "source" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "source",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Source.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "operation_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "operation_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.OperationID.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "resource_kind" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "resource_kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ResourceKind.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "resource_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "resource_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ResourceID.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "outcome" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "outcome",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Outcome.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Since.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "until" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "until",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Until.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, AuditExportOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, AuditExportOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, AuditExportOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAuditExportResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// AuditList invokes audit-list operation.
+//
+// Retrieve the audit log of the workspace, newest first. Owners and admins only.
+//
+// GET /audit
+func (c *Client) AuditList(ctx context.Context, params AuditListParams) ([]AuditEvent, error) {
+	res, err := c.sendAuditList(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAuditList(ctx context.Context, params AuditListParams) (res []AuditEvent, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("audit-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/audit"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AuditListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/audit"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "actor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "actor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Actor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "source" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "source",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Source.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "operation_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "operation_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.OperationID.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "resource_kind" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "resource_kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ResourceKind.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "resource_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "resource_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ResourceID.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "outcome" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "outcome",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Outcome.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Since.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "until" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "until",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Until.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, AuditListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, AuditListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, AuditListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAuditListResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CreateContact invokes createContact operation.
+//
+// Create a new contact record.
+//
+// POST /contact
+func (c *Client) CreateContact(ctx context.Context, request *Contact) (*Contact, error) {
+	res, err := c.sendCreateContact(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendCreateContact(ctx context.Context, request *Contact) (res *Contact, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createContact"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/contact"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateContactOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/contact"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeCreateContactRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, CreateContactOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, CreateContactOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, CreateContactOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCreateContactResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CreateUser invokes createUser operation.
+//
+// Create a new user.
+//
+// POST /user
+func (c *Client) CreateUser(ctx context.Context, request *User) (*User, error) {
+	res, err := c.sendCreateUser(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendCreateUser(ctx context.Context, request *User) (res *User, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createUser"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/user"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateUserOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/user"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeCreateUserRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, CreateUserOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, CreateUserOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, CreateUserOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCreateUserResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceEmailCreate invokes datasource-email-create operation.
+//
+// Create a new email datasource.
+//
+// POST /datasource/email
+func (c *Client) DatasourceEmailCreate(ctx context.Context, request *DatasourceEmail) (*DatasourceEmail, error) {
+	res, err := c.sendDatasourceEmailCreate(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendDatasourceEmailCreate(ctx context.Context, request *DatasourceEmail) (res *DatasourceEmail, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-email-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/datasource/email"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailCreateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/datasource/email"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeDatasourceEmailCreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceEmailCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceEmailCreateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceEmailDelete invokes datasource-email-delete operation.
+//
+// Delete an email datasource.
+//
+// DELETE /datasource/email/{uuid}
+func (c *Client) DatasourceEmailDelete(ctx context.Context, params DatasourceEmailDeleteParams) error {
+	_, err := c.sendDatasourceEmailDelete(ctx, params)
+	return err
+}
+
+func (c *Client) sendDatasourceEmailDelete(ctx context.Context, params DatasourceEmailDeleteParams) (res *DatasourceEmailDeleteOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-email-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/datasource/email/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/datasource/email/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceEmailDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceEmailDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceEmailGet invokes datasource-email-get operation.
+//
+// Get email datasources.
+//
+// GET /datasource/email/{uuid}
+func (c *Client) DatasourceEmailGet(ctx context.Context, params DatasourceEmailGetParams) (*DatasourceEmail, error) {
+	res, err := c.sendDatasourceEmailGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDatasourceEmailGet(ctx context.Context, params DatasourceEmailGetParams) (res *DatasourceEmail, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-email-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource/email/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/datasource/email/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceEmailGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceEmailGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceEmailGraphCreate invokes datasource-email-graph-create operation.
+//
+// Create a new Microsoft Graph mail datasource.
+//
+// POST /datasource/email_graph
+func (c *Client) DatasourceEmailGraphCreate(ctx context.Context, request *DatasourceEmailGraph) (*DatasourceEmailGraph, error) {
+	res, err := c.sendDatasourceEmailGraphCreate(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendDatasourceEmailGraphCreate(ctx context.Context, request *DatasourceEmailGraph) (res *DatasourceEmailGraph, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-email-graph-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/datasource/email_graph"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailGraphCreateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/datasource/email_graph"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeDatasourceEmailGraphCreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailGraphCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceEmailGraphCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailGraphCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceEmailGraphCreateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceEmailGraphDelete invokes datasource-email-graph-delete operation.
+//
+// Delete a Microsoft Graph mail datasource.
+//
+// DELETE /datasource/email_graph/{uuid}
+func (c *Client) DatasourceEmailGraphDelete(ctx context.Context, params DatasourceEmailGraphDeleteParams) error {
+	_, err := c.sendDatasourceEmailGraphDelete(ctx, params)
+	return err
+}
+
+func (c *Client) sendDatasourceEmailGraphDelete(ctx context.Context, params DatasourceEmailGraphDeleteParams) (res *DatasourceEmailGraphDeleteOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-email-graph-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/datasource/email_graph/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailGraphDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/datasource/email_graph/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailGraphDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceEmailGraphDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailGraphDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceEmailGraphDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceEmailGraphGet invokes datasource-email-graph-get operation.
+//
+// Retrieve a Microsoft Graph mail datasource.
+//
+// GET /datasource/email_graph/{uuid}
+func (c *Client) DatasourceEmailGraphGet(ctx context.Context, params DatasourceEmailGraphGetParams) (*DatasourceEmailGraph, error) {
+	res, err := c.sendDatasourceEmailGraphGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDatasourceEmailGraphGet(ctx context.Context, params DatasourceEmailGraphGetParams) (res *DatasourceEmailGraph, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-email-graph-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource/email_graph/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailGraphGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/datasource/email_graph/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailGraphGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceEmailGraphGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailGraphGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceEmailGraphGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceEmailGraphList invokes datasource-email-graph-list operation.
+//
+// List Microsoft Graph mail datasources.
+//
+// GET /datasource/email_graph
+func (c *Client) DatasourceEmailGraphList(ctx context.Context, params DatasourceEmailGraphListParams) ([]DatasourceEmailGraph, error) {
+	res, err := c.sendDatasourceEmailGraphList(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDatasourceEmailGraphList(ctx context.Context, params DatasourceEmailGraphListParams) (res []DatasourceEmailGraph, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-email-graph-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource/email_graph"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailGraphListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/datasource/email_graph"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailGraphListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceEmailGraphListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailGraphListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceEmailGraphListResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceEmailGraphSend invokes datasource-email-graph-send operation.
+//
+// Send a mail from the mailbox of a Microsoft Graph mail datasource.
+//
+// POST /datasource/email_graph/{uuid}/send
+func (c *Client) DatasourceEmailGraphSend(ctx context.Context, request *EmailSend, params DatasourceEmailGraphSendParams) error {
+	_, err := c.sendDatasourceEmailGraphSend(ctx, request, params)
+	return err
+}
+
+func (c *Client) sendDatasourceEmailGraphSend(ctx context.Context, request *EmailSend, params DatasourceEmailGraphSendParams) (res *DatasourceEmailGraphSendAccepted, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-email-graph-send"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/datasource/email_graph/{uuid}/send"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailGraphSendOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/datasource/email_graph/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/send"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeDatasourceEmailGraphSendRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailGraphSendOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceEmailGraphSendOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailGraphSendOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceEmailGraphSendResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceEmailGraphUpdate invokes datasource-email-graph-update operation.
+//
+// Update an existing Microsoft Graph mail datasource.
+//
+// PUT /datasource/email_graph/{uuid}
+func (c *Client) DatasourceEmailGraphUpdate(ctx context.Context, request *DatasourceEmailGraph, params DatasourceEmailGraphUpdateParams) (*DatasourceEmailGraph, error) {
+	res, err := c.sendDatasourceEmailGraphUpdate(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendDatasourceEmailGraphUpdate(ctx context.Context, request *DatasourceEmailGraph, params DatasourceEmailGraphUpdateParams) (res *DatasourceEmailGraph, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-email-graph-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/datasource/email_graph/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailGraphUpdateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/datasource/email_graph/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeDatasourceEmailGraphUpdateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailGraphUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceEmailGraphUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailGraphUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceEmailGraphUpdateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceEmailList invokes datasource-email-list operation.
+//
+// List email datasources.
+//
+// GET /datasource/email
+func (c *Client) DatasourceEmailList(ctx context.Context, params DatasourceEmailListParams) ([]DatasourceEmail, error) {
+	res, err := c.sendDatasourceEmailList(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDatasourceEmailList(ctx context.Context, params DatasourceEmailListParams) (res []DatasourceEmail, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-email-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource/email"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/datasource/email"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceEmailListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceEmailListResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceEmailOAuthCreate invokes datasource-email-oauth-create operation.
+//
+// Create a new email OAuth datasource.
+//
+// POST /datasource/email_oauth
+func (c *Client) DatasourceEmailOAuthCreate(ctx context.Context, request *DatasourceEmailOAuth) (*DatasourceEmailOAuth, error) {
+	res, err := c.sendDatasourceEmailOAuthCreate(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendDatasourceEmailOAuthCreate(ctx context.Context, request *DatasourceEmailOAuth) (res *DatasourceEmailOAuth, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-email-oauth-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/datasource/email_oauth"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailOAuthCreateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/datasource/email_oauth"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeDatasourceEmailOAuthCreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailOAuthCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceEmailOAuthCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailOAuthCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceEmailOAuthCreateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceEmailOAuthDelete invokes datasource-email-oauth-delete operation.
+//
+// Delete an email OAuth datasource.
+//
+// DELETE /datasource/email_oauth/{uuid}
+func (c *Client) DatasourceEmailOAuthDelete(ctx context.Context, params DatasourceEmailOAuthDeleteParams) error {
+	_, err := c.sendDatasourceEmailOAuthDelete(ctx, params)
+	return err
+}
+
+func (c *Client) sendDatasourceEmailOAuthDelete(ctx context.Context, params DatasourceEmailOAuthDeleteParams) (res *DatasourceEmailOAuthDeleteOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-email-oauth-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/datasource/email_oauth/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailOAuthDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/datasource/email_oauth/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailOAuthDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceEmailOAuthDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailOAuthDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceEmailOAuthDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceEmailOAuthGet invokes datasource-email-oauth-get operation.
+//
+// Retrieve an OAuth2‑based email datasource.
+//
+// GET /datasource/email_oauth/{uuid}
+func (c *Client) DatasourceEmailOAuthGet(ctx context.Context, params DatasourceEmailOAuthGetParams) (*DatasourceEmailOAuth, error) {
+	res, err := c.sendDatasourceEmailOAuthGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDatasourceEmailOAuthGet(ctx context.Context, params DatasourceEmailOAuthGetParams) (res *DatasourceEmailOAuth, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-email-oauth-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource/email_oauth/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailOAuthGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/datasource/email_oauth/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailOAuthGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceEmailOAuthGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailOAuthGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceEmailOAuthGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceEmailOAuthList invokes datasource-email-oauth-list operation.
+//
+// List OAuth2‑based email datasources.
+//
+// GET /datasource/email_oauth
+func (c *Client) DatasourceEmailOAuthList(ctx context.Context, params DatasourceEmailOAuthListParams) ([]DatasourceEmailOAuth, error) {
+	res, err := c.sendDatasourceEmailOAuthList(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDatasourceEmailOAuthList(ctx context.Context, params DatasourceEmailOAuthListParams) (res []DatasourceEmailOAuth, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-email-oauth-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource/email_oauth"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailOAuthListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/datasource/email_oauth"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailOAuthListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceEmailOAuthListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailOAuthListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceEmailOAuthListResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceEmailOAuthUpdate invokes datasource-email-oauth-update operation.
+//
+// Update an existing email OAuth datasource.
+//
+// PUT /datasource/email_oauth/{uuid}
+func (c *Client) DatasourceEmailOAuthUpdate(ctx context.Context, request *DatasourceEmailOAuth, params DatasourceEmailOAuthUpdateParams) (*DatasourceEmailOAuth, error) {
+	res, err := c.sendDatasourceEmailOAuthUpdate(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendDatasourceEmailOAuthUpdate(ctx context.Context, request *DatasourceEmailOAuth, params DatasourceEmailOAuthUpdateParams) (res *DatasourceEmailOAuth, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-email-oauth-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/datasource/email_oauth/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailOAuthUpdateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/datasource/email_oauth/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeDatasourceEmailOAuthUpdateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailOAuthUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceEmailOAuthUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailOAuthUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceEmailOAuthUpdateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceEmailUpdate invokes datasource-email-update operation.
+//
+// Update an email datasource.
+//
+// PUT /datasource/email/{uuid}
+func (c *Client) DatasourceEmailUpdate(ctx context.Context, request *DatasourceEmail, params DatasourceEmailUpdateParams) (*DatasourceEmail, error) {
+	res, err := c.sendDatasourceEmailUpdate(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendDatasourceEmailUpdate(ctx context.Context, request *DatasourceEmail, params DatasourceEmailUpdateParams) (res *DatasourceEmail, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-email-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/datasource/email/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailUpdateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/datasource/email/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeDatasourceEmailUpdateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceEmailUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceEmailUpdateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceLinkedinCreate invokes datasource-linkedin-create operation.
+//
+// Create a new LinkedIn datasource.
+//
+// POST /datasource/linkedin
+func (c *Client) DatasourceLinkedinCreate(ctx context.Context, request *DatasourceLinkedin) (*DatasourceLinkedin, error) {
+	res, err := c.sendDatasourceLinkedinCreate(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendDatasourceLinkedinCreate(ctx context.Context, request *DatasourceLinkedin) (res *DatasourceLinkedin, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-linkedin-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/datasource/linkedin"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceLinkedinCreateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/datasource/linkedin"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeDatasourceLinkedinCreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceLinkedinCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceLinkedinCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceLinkedinCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceLinkedinCreateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceLinkedinDelete invokes datasource-linkedin-delete operation.
+//
+// Delete a LinkedIn datasource.
+//
+// DELETE /datasource/linkedin/{uuid}
+func (c *Client) DatasourceLinkedinDelete(ctx context.Context, params DatasourceLinkedinDeleteParams) error {
+	_, err := c.sendDatasourceLinkedinDelete(ctx, params)
+	return err
+}
+
+func (c *Client) sendDatasourceLinkedinDelete(ctx context.Context, params DatasourceLinkedinDeleteParams) (res *DatasourceLinkedinDeleteOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-linkedin-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/datasource/linkedin/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceLinkedinDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/datasource/linkedin/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceLinkedinDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceLinkedinDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceLinkedinDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceLinkedinDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceLinkedinGet invokes datasource-linkedin-get operation.
+//
+// Get a LinkedIn datasource.
+//
+// GET /datasource/linkedin/{uuid}
+func (c *Client) DatasourceLinkedinGet(ctx context.Context, params DatasourceLinkedinGetParams) (*DatasourceLinkedin, error) {
+	res, err := c.sendDatasourceLinkedinGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDatasourceLinkedinGet(ctx context.Context, params DatasourceLinkedinGetParams) (res *DatasourceLinkedin, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-linkedin-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource/linkedin/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceLinkedinGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/datasource/linkedin/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceLinkedinGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceLinkedinGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceLinkedinGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceLinkedinGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceLinkedinList invokes datasource-linkedin-list operation.
+//
+// List all LinkedIn datasources.
+//
+// GET /datasource/linkedin
+func (c *Client) DatasourceLinkedinList(ctx context.Context, params DatasourceLinkedinListParams) ([]DatasourceLinkedin, error) {
+	res, err := c.sendDatasourceLinkedinList(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDatasourceLinkedinList(ctx context.Context, params DatasourceLinkedinListParams) (res []DatasourceLinkedin, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-linkedin-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource/linkedin"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceLinkedinListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/datasource/linkedin"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceLinkedinListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceLinkedinListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceLinkedinListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceLinkedinListResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceLinkedinUpdate invokes datasource-linkedin-update operation.
+//
+// Update a LinkedIn datasource.
+//
+// PUT /datasource/linkedin/{uuid}
+func (c *Client) DatasourceLinkedinUpdate(ctx context.Context, request *DatasourceLinkedin, params DatasourceLinkedinUpdateParams) (*DatasourceLinkedin, error) {
+	res, err := c.sendDatasourceLinkedinUpdate(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendDatasourceLinkedinUpdate(ctx context.Context, request *DatasourceLinkedin, params DatasourceLinkedinUpdateParams) (res *DatasourceLinkedin, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-linkedin-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/datasource/linkedin/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceLinkedinUpdateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/datasource/linkedin/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeDatasourceLinkedinUpdateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceLinkedinUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceLinkedinUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceLinkedinUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceLinkedinUpdateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceList invokes datasource-list operation.
+//
+// Retrieve a list of datasource objects.
+//
+// GET /datasource
+func (c *Client) DatasourceList(ctx context.Context, params DatasourceListParams) ([]Datasource, error) {
+	res, err := c.sendDatasourceList(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDatasourceList(ctx context.Context, params DatasourceListParams) (res []Datasource, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/datasource"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceListResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceSetOAuth2Client invokes datasource-set-oauth2-client operation.
+//
+// Set OAuth2 client to the datasource.
+//
+// PUT /datasource/{uuid}/oauth2/client
+func (c *Client) DatasourceSetOAuth2Client(ctx context.Context, request *DatasourceSetOAuth2ClientReq, params DatasourceSetOAuth2ClientParams) error {
+	_, err := c.sendDatasourceSetOAuth2Client(ctx, request, params)
+	return err
+}
+
+func (c *Client) sendDatasourceSetOAuth2Client(ctx context.Context, request *DatasourceSetOAuth2ClientReq, params DatasourceSetOAuth2ClientParams) (res *DatasourceSetOAuth2ClientNoContent, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-set-oauth2-client"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/datasource/{uuid}/oauth2/client"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceSetOAuth2ClientOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/datasource/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/oauth2/client"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeDatasourceSetOAuth2ClientRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceSetOAuth2ClientOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceSetOAuth2ClientOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceSetOAuth2ClientOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceSetOAuth2ClientResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceTelegramCreate invokes datasource-telegram-create operation.
+//
+// Create a new Telegram datasource.
+//
+// POST /datasource/telegram
+func (c *Client) DatasourceTelegramCreate(ctx context.Context, request *DatasourceTelegram) (*DatasourceTelegram, error) {
+	res, err := c.sendDatasourceTelegramCreate(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendDatasourceTelegramCreate(ctx context.Context, request *DatasourceTelegram) (res *DatasourceTelegram, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-telegram-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/datasource/telegram"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceTelegramCreateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/datasource/telegram"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeDatasourceTelegramCreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceTelegramCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceTelegramCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceTelegramCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceTelegramCreateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceTelegramDelete invokes datasource-telegram-delete operation.
+//
+// Delete a Telegram datasource.
+//
+// DELETE /datasource/telegram/{uuid}
+func (c *Client) DatasourceTelegramDelete(ctx context.Context, params DatasourceTelegramDeleteParams) error {
+	_, err := c.sendDatasourceTelegramDelete(ctx, params)
+	return err
+}
+
+func (c *Client) sendDatasourceTelegramDelete(ctx context.Context, params DatasourceTelegramDeleteParams) (res *DatasourceTelegramDeleteOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-telegram-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/datasource/telegram/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceTelegramDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/datasource/telegram/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceTelegramDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceTelegramDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceTelegramDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceTelegramDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceTelegramGet invokes datasource-telegram-get operation.
+//
+// Get a Telegram datasource.
+//
+// GET /datasource/telegram/{uuid}
+func (c *Client) DatasourceTelegramGet(ctx context.Context, params DatasourceTelegramGetParams) (*DatasourceTelegram, error) {
+	res, err := c.sendDatasourceTelegramGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDatasourceTelegramGet(ctx context.Context, params DatasourceTelegramGetParams) (res *DatasourceTelegram, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-telegram-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource/telegram/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceTelegramGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/datasource/telegram/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceTelegramGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceTelegramGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceTelegramGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceTelegramGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceTelegramList invokes datasource-telegram-list operation.
+//
+// List all Telegram datasources.
+//
+// GET /datasource/telegram
+func (c *Client) DatasourceTelegramList(ctx context.Context, params DatasourceTelegramListParams) ([]DatasourceTelegram, error) {
+	res, err := c.sendDatasourceTelegramList(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDatasourceTelegramList(ctx context.Context, params DatasourceTelegramListParams) (res []DatasourceTelegram, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-telegram-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource/telegram"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceTelegramListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/datasource/telegram"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceTelegramListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceTelegramListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceTelegramListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceTelegramListResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceTelegramUpdate invokes datasource-telegram-update operation.
+//
+// Update a Telegram datasource.
+//
+// PUT /datasource/telegram/{uuid}
+func (c *Client) DatasourceTelegramUpdate(ctx context.Context, request *DatasourceTelegram, params DatasourceTelegramUpdateParams) (*DatasourceTelegram, error) {
+	res, err := c.sendDatasourceTelegramUpdate(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendDatasourceTelegramUpdate(ctx context.Context, request *DatasourceTelegram, params DatasourceTelegramUpdateParams) (res *DatasourceTelegram, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-telegram-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/datasource/telegram/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceTelegramUpdateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/datasource/telegram/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeDatasourceTelegramUpdateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceTelegramUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceTelegramUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceTelegramUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceTelegramUpdateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceWhatsappCreate invokes datasource-whatsapp-create operation.
+//
+// Create a new WhatsApp datasource.
+//
+// POST /datasource/whatsapp
+func (c *Client) DatasourceWhatsappCreate(ctx context.Context, request *DatasourceWhatsapp) (*DatasourceWhatsapp, error) {
+	res, err := c.sendDatasourceWhatsappCreate(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendDatasourceWhatsappCreate(ctx context.Context, request *DatasourceWhatsapp) (res *DatasourceWhatsapp, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-whatsapp-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/datasource/whatsapp"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceWhatsappCreateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/datasource/whatsapp"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeDatasourceWhatsappCreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceWhatsappCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceWhatsappCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceWhatsappCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceWhatsappCreateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceWhatsappDelete invokes datasource-whatsapp-delete operation.
+//
+// Delete a WhatsApp datasource.
+//
+// DELETE /datasource/whatsapp/{uuid}
+func (c *Client) DatasourceWhatsappDelete(ctx context.Context, params DatasourceWhatsappDeleteParams) error {
+	_, err := c.sendDatasourceWhatsappDelete(ctx, params)
+	return err
+}
+
+func (c *Client) sendDatasourceWhatsappDelete(ctx context.Context, params DatasourceWhatsappDeleteParams) (res *DatasourceWhatsappDeleteOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-whatsapp-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/datasource/whatsapp/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceWhatsappDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/datasource/whatsapp/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceWhatsappDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceWhatsappDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceWhatsappDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceWhatsappDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceWhatsappGet invokes datasource-whatsapp-get operation.
+//
+// Get a WhatsApp datasource.
+//
+// GET /datasource/whatsapp/{uuid}
+func (c *Client) DatasourceWhatsappGet(ctx context.Context, params DatasourceWhatsappGetParams) (*DatasourceWhatsapp, error) {
+	res, err := c.sendDatasourceWhatsappGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDatasourceWhatsappGet(ctx context.Context, params DatasourceWhatsappGetParams) (res *DatasourceWhatsapp, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-whatsapp-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource/whatsapp/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceWhatsappGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/datasource/whatsapp/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceWhatsappGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceWhatsappGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceWhatsappGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceWhatsappGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceWhatsappList invokes datasource-whatsapp-list operation.
+//
+// List all WhatsApp datasources.
+//
+// GET /datasource/whatsapp
+func (c *Client) DatasourceWhatsappList(ctx context.Context, params DatasourceWhatsappListParams) ([]DatasourceWhatsapp, error) {
+	res, err := c.sendDatasourceWhatsappList(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDatasourceWhatsappList(ctx context.Context, params DatasourceWhatsappListParams) (res []DatasourceWhatsapp, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-whatsapp-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource/whatsapp"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceWhatsappListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/datasource/whatsapp"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceWhatsappListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceWhatsappListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceWhatsappListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceWhatsappListResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DatasourceWhatsappUpdate invokes datasource-whatsapp-update operation.
+//
+// Update a WhatsApp datasource.
+//
+// PUT /datasource/whatsapp/{uuid}
+func (c *Client) DatasourceWhatsappUpdate(ctx context.Context, request *DatasourceWhatsapp, params DatasourceWhatsappUpdateParams) (*DatasourceWhatsapp, error) {
+	res, err := c.sendDatasourceWhatsappUpdate(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendDatasourceWhatsappUpdate(ctx context.Context, request *DatasourceWhatsapp, params DatasourceWhatsappUpdateParams) (res *DatasourceWhatsapp, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("datasource-whatsapp-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/datasource/whatsapp/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceWhatsappUpdateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/datasource/whatsapp/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeDatasourceWhatsappUpdateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DatasourceWhatsappUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DatasourceWhatsappUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DatasourceWhatsappUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDatasourceWhatsappUpdateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeleteContact invokes deleteContact operation.
+//
+// Delete a contact record.
+//
+// DELETE /contact/{uuid}
+func (c *Client) DeleteContact(ctx context.Context, params DeleteContactParams) error {
+	_, err := c.sendDeleteContact(ctx, params)
+	return err
+}
+
+func (c *Client) sendDeleteContact(ctx context.Context, params DeleteContactParams) (res *DeleteContactOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deleteContact"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/contact/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteContactOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/contact/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DeleteContactOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DeleteContactOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DeleteContactOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeleteContactResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeleteUser invokes deleteUser operation.
+//
+// Delete user.
+//
+// DELETE /user/{uuid}
+func (c *Client) DeleteUser(ctx context.Context, params DeleteUserParams) error {
+	_, err := c.sendDeleteUser(ctx, params)
+	return err
+}
+
+func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (res *DeleteUserOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deleteUser"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/user/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteUserOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/user/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, DeleteUserOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DeleteUserOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, DeleteUserOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeleteUserResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ErasureCreate invokes erasure-create operation.
+//
+// Find every message, file blob and contact referencing an email address or phone
+// number across all storages, delete or anonymise them and return the erasure report.
+//
+// POST /erasure
+func (c *Client) ErasureCreate(ctx context.Context, request *ErasureRequest) (*ErasureRequest, error) {
+	res, err := c.sendErasureCreate(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendErasureCreate(ctx context.Context, request *ErasureRequest) (res *ErasureRequest, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("erasure-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/erasure"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ErasureCreateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/erasure"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeErasureCreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, ErasureCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ErasureCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, ErasureCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeErasureCreateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ErasureList invokes erasure-list operation.
+//
+// Retrieve a list of executed erasure requests with their reports.
+//
+// GET /erasure
+func (c *Client) ErasureList(ctx context.Context, params ErasureListParams) ([]ErasureRequest, error) {
+	res, err := c.sendErasureList(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendErasureList(ctx context.Context, params ErasureListParams) (res []ErasureRequest, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("erasure-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/erasure"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ErasureListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/erasure"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, ErasureListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ErasureListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, ErasureListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeErasureListResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// EventList invokes event-list operation.
+//
+// Retrieve the events of the workspace, newest first. Events are kept for 7 days.
+//
+// GET /event
+func (c *Client) EventList(ctx context.Context, params EventListParams) ([]Event, error) {
+	res, err := c.sendEventList(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendEventList(ctx context.Context, params EventListParams) (res []Event, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("event-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/event"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, EventListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/event"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "type" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "type",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Type.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "subject_uuid" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "subject_uuid",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.SubjectUUID.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "after" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "after",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.After.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, EventListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, EventListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, EventListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeEventListResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// FileCreate invokes file-create operation.
+//
+// Upload a new file and create its record.
+//
+// POST /file
+func (c *Client) FileCreate(ctx context.Context, request *UploadFileRequest) (*UploadFileResponse, error) {
+	res, err := c.sendFileCreate(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendFileCreate(ctx context.Context, request *UploadFileRequest) (res *UploadFileResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("file-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/file"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, FileCreateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/file"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeFileCreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, FileCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, FileCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, FileCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeFileCreateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// FileDelete invokes file-delete operation.
+//
+// Delete a stored file.
+//
+// DELETE /file/{uuid}
+func (c *Client) FileDelete(ctx context.Context, params FileDeleteParams) error {
+	_, err := c.sendFileDelete(ctx, params)
+	return err
+}
+
+func (c *Client) sendFileDelete(ctx context.Context, params FileDeleteParams) (res *FileDeleteOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("file-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/file/{uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, FileDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/file/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
 		encoded, err := e.Result()
 		if err != nil {
@@ -1137,7 +8198,7 @@ func (c *Client) sendDatasourceEmailDelete(ctx context.Context, params Datasourc
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailDeleteOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, FileDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1148,7 +8209,7 @@ func (c *Client) sendDatasourceEmailDelete(ctx context.Context, params Datasourc
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceEmailDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, FileDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1159,7 +8220,7 @@ func (c *Client) sendDatasourceEmailDelete(ctx context.Context, params Datasourc
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailDeleteOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, FileDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1197,7 +8258,7 @@ func (c *Client) sendDatasourceEmailDelete(ctx context.Context, params Datasourc
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceEmailDeleteResponse(resp)
+	result, err := decodeFileDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1205,21 +8266,21 @@ func (c *Client) sendDatasourceEmailDelete(ctx context.Context, params Datasourc
 	return result, nil
 }
 
-// DatasourceEmailGet invokes datasource-email-get operation.
+// FileGet invokes file-get operation.
 //
-// Get email datasources.
+// Retrieve details of a stored file.
 //
-// GET /datasource/email/{uuid}
-func (c *Client) DatasourceEmailGet(ctx context.Context, params DatasourceEmailGetParams) (*DatasourceEmail, error) {
-	res, err := c.sendDatasourceEmailGet(ctx, params)
+// GET /file/{uuid}
+func (c *Client) FileGet(ctx context.Context, params FileGetParams) (*FileObject, error) {
+	res, err := c.sendFileGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDatasourceEmailGet(ctx context.Context, params DatasourceEmailGetParams) (res *DatasourceEmail, err error) {
+func (c *Client) sendFileGet(ctx context.Context, params FileGetParams) (res *FileObject, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-email-get"),
+		otelogen.OperationID("file-get"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource/email/{uuid}"),
+		semconv.HTTPRouteKey.String("/file/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -1234,7 +8295,7 @@ func (c *Client) sendDatasourceEmailGet(ctx context.Context, params DatasourceEm
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, FileGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1252,7 +8313,7 @@ func (c *Client) sendDatasourceEmailGet(ctx context.Context, params DatasourceEm
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/datasource/email/"
+	pathParts[0] = "/file/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -1284,7 +8345,7 @@ func (c *Client) sendDatasourceEmailGet(ctx context.Context, params DatasourceEm
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailGetOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, FileGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1295,7 +8356,7 @@ func (c *Client) sendDatasourceEmailGet(ctx context.Context, params DatasourceEm
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceEmailGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, FileGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1306,7 +8367,7 @@ func (c *Client) sendDatasourceEmailGet(ctx context.Context, params DatasourceEm
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailGetOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, FileGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1344,7 +8405,7 @@ func (c *Client) sendDatasourceEmailGet(ctx context.Context, params DatasourceEm
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceEmailGetResponse(resp)
+	result, err := decodeFileGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1352,21 +8413,21 @@ func (c *Client) sendDatasourceEmailGet(ctx context.Context, params DatasourceEm
 	return result, nil
 }
 
-// DatasourceEmailList invokes datasource-email-list operation.
+// FileList invokes file-list operation.
 //
-// List email datasources.
+// Retrieve a list of stored files.
 //
-// GET /datasource/email
-func (c *Client) DatasourceEmailList(ctx context.Context, params DatasourceEmailListParams) ([]DatasourceEmail, error) {
-	res, err := c.sendDatasourceEmailList(ctx, params)
+// GET /file
+func (c *Client) FileList(ctx context.Context, params FileListParams) ([]FileObject, error) {
+	res, err := c.sendFileList(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDatasourceEmailList(ctx context.Context, params DatasourceEmailListParams) (res []DatasourceEmail, err error) {
+func (c *Client) sendFileList(ctx context.Context, params FileListParams) (res []FileObject, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-email-list"),
+		otelogen.OperationID("file-list"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource/email"),
+		semconv.HTTPRouteKey.String("/file"),
 	}
 
 	// Run stopwatch.
@@ -1381,7 +8442,7 @@ func (c *Client) sendDatasourceEmailList(ctx context.Context, params DatasourceE
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailListOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, FileListOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1399,7 +8460,7 @@ func (c *Client) sendDatasourceEmailList(ctx context.Context, params DatasourceE
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/datasource/email"
+	pathParts[0] = "/file"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
@@ -1409,7 +8470,7 @@ func (c *Client) sendDatasourceEmailList(ctx context.Context, params DatasourceE
 		cfg := uri.QueryParameterEncodingConfig{
 			Name:    "offset",
 			Style:   uri.QueryStyleForm,
-			Explode: false,
+			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
@@ -1426,7 +8487,7 @@ func (c *Client) sendDatasourceEmailList(ctx context.Context, params DatasourceE
 		cfg := uri.QueryParameterEncodingConfig{
 			Name:    "limit",
 			Style:   uri.QueryStyleForm,
-			Explode: false,
+			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
@@ -1451,7 +8512,7 @@ func (c *Client) sendDatasourceEmailList(ctx context.Context, params DatasourceE
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailListOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, FileListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1462,7 +8523,7 @@ func (c *Client) sendDatasourceEmailList(ctx context.Context, params DatasourceE
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceEmailListOperation, r); {
+			switch err := c.securityBearerAuth(ctx, FileListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1473,7 +8534,7 @@ func (c *Client) sendDatasourceEmailList(ctx context.Context, params DatasourceE
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailListOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, FileListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1511,7 +8572,7 @@ func (c *Client) sendDatasourceEmailList(ctx context.Context, params DatasourceE
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceEmailListResponse(resp)
+	result, err := decodeFileListResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1519,21 +8580,21 @@ func (c *Client) sendDatasourceEmailList(ctx context.Context, params DatasourceE
 	return result, nil
 }
 
-// DatasourceEmailOAuthCreate invokes datasource-email-oauth-create operation.
+// FileUpdate invokes file-update operation.
 //
-// Create a new email OAuth datasource.
+// Update metadata of a stored file.
 //
-// POST /datasource/email_oauth
-func (c *Client) DatasourceEmailOAuthCreate(ctx context.Context, request *DatasourceEmailOAuth) (*DatasourceEmailOAuth, error) {
-	res, err := c.sendDatasourceEmailOAuthCreate(ctx, request)
+// PUT /file/{uuid}
+func (c *Client) FileUpdate(ctx context.Context, request *FileUpdateReq, params FileUpdateParams) (*FileObject, error) {
+	res, err := c.sendFileUpdate(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendDatasourceEmailOAuthCreate(ctx context.Context, request *DatasourceEmailOAuth) (res *DatasourceEmailOAuth, err error) {
+func (c *Client) sendFileUpdate(ctx context.Context, request *FileUpdateReq, params FileUpdateParams) (res *FileObject, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-email-oauth-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/datasource/email_oauth"),
+		otelogen.OperationID("file-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/file/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -1548,7 +8609,7 @@ func (c *Client) sendDatasourceEmailOAuthCreate(ctx context.Context, request *Da
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailOAuthCreateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, FileUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1565,16 +8626,34 @@ func (c *Client) sendDatasourceEmailOAuthCreate(ctx context.Context, request *Da
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/datasource/email_oauth"
+	var pathParts [2]string
+	pathParts[0] = "/file/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeDatasourceEmailOAuthCreateRequest(request, r); err != nil {
+	if err := encodeFileUpdateRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1583,7 +8662,7 @@ func (c *Client) sendDatasourceEmailOAuthCreate(ctx context.Context, request *Da
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailOAuthCreateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, FileUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1594,7 +8673,7 @@ func (c *Client) sendDatasourceEmailOAuthCreate(ctx context.Context, request *Da
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceEmailOAuthCreateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, FileUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1605,7 +8684,7 @@ func (c *Client) sendDatasourceEmailOAuthCreate(ctx context.Context, request *Da
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailOAuthCreateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, FileUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1643,7 +8722,7 @@ func (c *Client) sendDatasourceEmailOAuthCreate(ctx context.Context, request *Da
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceEmailOAuthCreateResponse(resp)
+	result, err := decodeFileUpdateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1651,21 +8730,21 @@ func (c *Client) sendDatasourceEmailOAuthCreate(ctx context.Context, request *Da
 	return result, nil
 }
 
-// DatasourceEmailOAuthDelete invokes datasource-email-oauth-delete operation.
+// GenerateDownloadLink invokes generateDownloadLink operation.
 //
-// Delete an email OAuth datasource.
+// Generate a download link for a stored file.
 //
-// DELETE /datasource/email_oauth/{uuid}
-func (c *Client) DatasourceEmailOAuthDelete(ctx context.Context, params DatasourceEmailOAuthDeleteParams) error {
-	_, err := c.sendDatasourceEmailOAuthDelete(ctx, params)
-	return err
+// POST /storage/file-link
+func (c *Client) GenerateDownloadLink(ctx context.Context, request *GenerateDownloadLinkRequest) (*GenerateDownloadLinkResponse, error) {
+	res, err := c.sendGenerateDownloadLink(ctx, request)
+	return res, err
 }
 
-func (c *Client) sendDatasourceEmailOAuthDelete(ctx context.Context, params DatasourceEmailOAuthDeleteParams) (res *DatasourceEmailOAuthDeleteOK, err error) {
+func (c *Client) sendGenerateDownloadLink(ctx context.Context, request *GenerateDownloadLinkRequest) (res *GenerateDownloadLinkResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-email-oauth-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/datasource/email_oauth/{uuid}"),
+		otelogen.OperationID("generateDownloadLink"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/storage/file-link"),
 	}
 
 	// Run stopwatch.
@@ -1680,7 +8759,7 @@ func (c *Client) sendDatasourceEmailOAuthDelete(ctx context.Context, params Data
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailOAuthDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GenerateDownloadLinkOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1689,48 +8768,33 @@ func (c *Client) sendDatasourceEmailOAuthDelete(ctx context.Context, params Data
 	defer func() {
 		if err != nil {
 			span.RecordError(err)
-			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
-		}
-		span.End()
-	}()
-
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/datasource/email_oauth/"
-	{
-		// Encode "uuid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		pathParts[1] = encoded
-	}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/storage/file-link"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeGenerateDownloadLinkRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailOAuthDeleteOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, GenerateDownloadLinkOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1741,7 +8805,7 @@ func (c *Client) sendDatasourceEmailOAuthDelete(ctx context.Context, params Data
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceEmailOAuthDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GenerateDownloadLinkOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1752,7 +8816,7 @@ func (c *Client) sendDatasourceEmailOAuthDelete(ctx context.Context, params Data
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailOAuthDeleteOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, GenerateDownloadLinkOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1790,7 +8854,7 @@ func (c *Client) sendDatasourceEmailOAuthDelete(ctx context.Context, params Data
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceEmailOAuthDeleteResponse(resp)
+	result, err := decodeGenerateDownloadLinkResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1798,21 +8862,21 @@ func (c *Client) sendDatasourceEmailOAuthDelete(ctx context.Context, params Data
 	return result, nil
 }
 
-// DatasourceEmailOAuthGet invokes datasource-email-oauth-get operation.
+// GeneratePresignedUploadUrl invokes generatePresignedUploadUrl operation.
 //
-// Retrieve an OAuth2‑based email datasource.
+// Generate a pre-signed URL for file upload.
 //
-// GET /datasource/email_oauth/{uuid}
-func (c *Client) DatasourceEmailOAuthGet(ctx context.Context, params DatasourceEmailOAuthGetParams) (*DatasourceEmailOAuth, error) {
-	res, err := c.sendDatasourceEmailOAuthGet(ctx, params)
+// POST /storage/upload-url
+func (c *Client) GeneratePresignedUploadUrl(ctx context.Context, request *UploadPresignedUrlRequest) (*UploadPresignedUrlResponse, error) {
+	res, err := c.sendGeneratePresignedUploadUrl(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDatasourceEmailOAuthGet(ctx context.Context, params DatasourceEmailOAuthGetParams) (res *DatasourceEmailOAuth, err error) {
+func (c *Client) sendGeneratePresignedUploadUrl(ctx context.Context, request *UploadPresignedUrlRequest) (res *UploadPresignedUrlResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-email-oauth-get"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource/email_oauth/{uuid}"),
+		otelogen.OperationID("generatePresignedUploadUrl"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/storage/upload-url"),
 	}
 
 	// Run stopwatch.
@@ -1827,7 +8891,7 @@ func (c *Client) sendDatasourceEmailOAuthGet(ctx context.Context, params Datasou
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailOAuthGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GeneratePresignedUploadUrlOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1844,40 +8908,25 @@ func (c *Client) sendDatasourceEmailOAuthGet(ctx context.Context, params Datasou
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/datasource/email_oauth/"
-	{
-		// Encode "uuid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/storage/upload-url"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeGeneratePresignedUploadUrlRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailOAuthGetOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, GeneratePresignedUploadUrlOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1888,7 +8937,7 @@ func (c *Client) sendDatasourceEmailOAuthGet(ctx context.Context, params Datasou
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceEmailOAuthGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GeneratePresignedUploadUrlOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1899,7 +8948,7 @@ func (c *Client) sendDatasourceEmailOAuthGet(ctx context.Context, params Datasou
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailOAuthGetOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, GeneratePresignedUploadUrlOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1937,7 +8986,7 @@ func (c *Client) sendDatasourceEmailOAuthGet(ctx context.Context, params Datasou
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceEmailOAuthGetResponse(resp)
+	result, err := decodeGeneratePresignedUploadUrlResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1945,21 +8994,21 @@ func (c *Client) sendDatasourceEmailOAuthGet(ctx context.Context, params Datasou
 	return result, nil
 }
 
-// DatasourceEmailOAuthList invokes datasource-email-oauth-list operation.
+// GetContact invokes getContact operation.
 //
-// List OAuth2‑based email datasources.
+// Get contact details.
 //
-// GET /datasource/email_oauth
-func (c *Client) DatasourceEmailOAuthList(ctx context.Context, params DatasourceEmailOAuthListParams) ([]DatasourceEmailOAuth, error) {
-	res, err := c.sendDatasourceEmailOAuthList(ctx, params)
+// GET /contact/{uuid}
+func (c *Client) GetContact(ctx context.Context, params GetContactParams) (*Contact, error) {
+	res, err := c.sendGetContact(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDatasourceEmailOAuthList(ctx context.Context, params DatasourceEmailOAuthListParams) (res []DatasourceEmailOAuth, err error) {
+func (c *Client) sendGetContact(ctx context.Context, params GetContactParams) (res *Contact, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-email-oauth-list"),
+		otelogen.OperationID("getContact"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource/email_oauth"),
+		semconv.HTTPRouteKey.String("/contact/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -1974,7 +9023,7 @@ func (c *Client) sendDatasourceEmailOAuthList(ctx context.Context, params Dataso
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailOAuthListOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetContactOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1991,47 +9040,27 @@ func (c *Client) sendDatasourceEmailOAuthList(ctx context.Context, params Dataso
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/datasource/email_oauth"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "offset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Offset.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	var pathParts [2]string
+	pathParts[0] = "/contact/"
 	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -2044,7 +9073,7 @@ func (c *Client) sendDatasourceEmailOAuthList(ctx context.Context, params Dataso
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailOAuthListOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, GetContactOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2055,7 +9084,7 @@ func (c *Client) sendDatasourceEmailOAuthList(ctx context.Context, params Dataso
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceEmailOAuthListOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetContactOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2066,7 +9095,7 @@ func (c *Client) sendDatasourceEmailOAuthList(ctx context.Context, params Dataso
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailOAuthListOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, GetContactOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2104,7 +9133,7 @@ func (c *Client) sendDatasourceEmailOAuthList(ctx context.Context, params Dataso
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceEmailOAuthListResponse(resp)
+	result, err := decodeGetContactResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2112,21 +9141,21 @@ func (c *Client) sendDatasourceEmailOAuthList(ctx context.Context, params Dataso
 	return result, nil
 }
 
-// DatasourceEmailOAuthUpdate invokes datasource-email-oauth-update operation.
+// GetProfile invokes getProfile operation.
 //
-// Update an existing email OAuth datasource.
+// Get current user profile.
 //
-// PUT /datasource/email_oauth/{uuid}
-func (c *Client) DatasourceEmailOAuthUpdate(ctx context.Context, request *DatasourceEmailOAuth, params DatasourceEmailOAuthUpdateParams) (*DatasourceEmailOAuth, error) {
-	res, err := c.sendDatasourceEmailOAuthUpdate(ctx, request, params)
+// GET /profile
+func (c *Client) GetProfile(ctx context.Context) (*User, error) {
+	res, err := c.sendGetProfile(ctx)
 	return res, err
 }
 
-func (c *Client) sendDatasourceEmailOAuthUpdate(ctx context.Context, request *DatasourceEmailOAuth, params DatasourceEmailOAuthUpdateParams) (res *DatasourceEmailOAuth, err error) {
+func (c *Client) sendGetProfile(ctx context.Context) (res *User, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-email-oauth-update"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/datasource/email_oauth/{uuid}"),
+		otelogen.OperationID("getProfile"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/profile"),
 	}
 
 	// Run stopwatch.
@@ -2141,7 +9170,7 @@ func (c *Client) sendDatasourceEmailOAuthUpdate(ctx context.Context, request *Da
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailOAuthUpdateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProfileOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2158,43 +9187,22 @@ func (c *Client) sendDatasourceEmailOAuthUpdate(ctx context.Context, request *Da
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/datasource/email_oauth/"
-	{
-		// Encode "uuid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/profile"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeDatasourceEmailOAuthUpdateRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailOAuthUpdateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, GetProfileOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2205,7 +9213,7 @@ func (c *Client) sendDatasourceEmailOAuthUpdate(ctx context.Context, request *Da
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceEmailOAuthUpdateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetProfileOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2216,7 +9224,7 @@ func (c *Client) sendDatasourceEmailOAuthUpdate(ctx context.Context, request *Da
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailOAuthUpdateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, GetProfileOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2254,7 +9262,7 @@ func (c *Client) sendDatasourceEmailOAuthUpdate(ctx context.Context, request *Da
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceEmailOAuthUpdateResponse(resp)
+	result, err := decodeGetProfileResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2262,21 +9270,21 @@ func (c *Client) sendDatasourceEmailOAuthUpdate(ctx context.Context, request *Da
 	return result, nil
 }
 
-// DatasourceEmailUpdate invokes datasource-email-update operation.
+// GetUser invokes getUser operation.
 //
-// Update an email datasource.
+// Get user details.
 //
-// PUT /datasource/email/{uuid}
-func (c *Client) DatasourceEmailUpdate(ctx context.Context, request *DatasourceEmail, params DatasourceEmailUpdateParams) (*DatasourceEmail, error) {
-	res, err := c.sendDatasourceEmailUpdate(ctx, request, params)
+// GET /user/{uuid}
+func (c *Client) GetUser(ctx context.Context, params GetUserParams) (*User, error) {
+	res, err := c.sendGetUser(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDatasourceEmailUpdate(ctx context.Context, request *DatasourceEmail, params DatasourceEmailUpdateParams) (res *DatasourceEmail, err error) {
+func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *User, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-email-update"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/datasource/email/{uuid}"),
+		otelogen.OperationID("getUser"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/user/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -2291,7 +9299,7 @@ func (c *Client) sendDatasourceEmailUpdate(ctx context.Context, request *Datasou
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceEmailUpdateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2309,7 +9317,7 @@ func (c *Client) sendDatasourceEmailUpdate(ctx context.Context, request *Datasou
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/datasource/email/"
+	pathParts[0] = "/user/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -2331,20 +9339,17 @@ func (c *Client) sendDatasourceEmailUpdate(ctx context.Context, request *Datasou
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeDatasourceEmailUpdateRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceEmailUpdateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, GetUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2355,7 +9360,7 @@ func (c *Client) sendDatasourceEmailUpdate(ctx context.Context, request *Datasou
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceEmailUpdateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2366,7 +9371,7 @@ func (c *Client) sendDatasourceEmailUpdate(ctx context.Context, request *Datasou
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceEmailUpdateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, GetUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2404,7 +9409,7 @@ func (c *Client) sendDatasourceEmailUpdate(ctx context.Context, request *Datasou
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceEmailUpdateResponse(resp)
+	result, err := decodeGetUserResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2412,21 +9417,21 @@ func (c *Client) sendDatasourceEmailUpdate(ctx context.Context, request *Datasou
 	return result, nil
 }
 
-// DatasourceLinkedinCreate invokes datasource-linkedin-create operation.
+// ListContacts invokes listContacts operation.
 //
-// Create a new LinkedIn datasource.
+// List all contacts.
 //
-// POST /datasource/linkedin
-func (c *Client) DatasourceLinkedinCreate(ctx context.Context, request *DatasourceLinkedin) (*DatasourceLinkedin, error) {
-	res, err := c.sendDatasourceLinkedinCreate(ctx, request)
+// GET /contact
+func (c *Client) ListContacts(ctx context.Context) ([]Contact, error) {
+	res, err := c.sendListContacts(ctx)
 	return res, err
 }
 
-func (c *Client) sendDatasourceLinkedinCreate(ctx context.Context, request *DatasourceLinkedin) (res *DatasourceLinkedin, err error) {
+func (c *Client) sendListContacts(ctx context.Context) (res []Contact, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-linkedin-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/datasource/linkedin"),
+		otelogen.OperationID("listContacts"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/contact"),
 	}
 
 	// Run stopwatch.
@@ -2441,7 +9446,7 @@ func (c *Client) sendDatasourceLinkedinCreate(ctx context.Context, request *Data
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceLinkedinCreateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ListContactsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2459,24 +9464,21 @@ func (c *Client) sendDatasourceLinkedinCreate(ctx context.Context, request *Data
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/datasource/linkedin"
+	pathParts[0] = "/contact"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeDatasourceLinkedinCreateRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceLinkedinCreateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, ListContactsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2487,7 +9489,7 @@ func (c *Client) sendDatasourceLinkedinCreate(ctx context.Context, request *Data
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceLinkedinCreateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ListContactsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2498,7 +9500,7 @@ func (c *Client) sendDatasourceLinkedinCreate(ctx context.Context, request *Data
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceLinkedinCreateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, ListContactsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2536,7 +9538,7 @@ func (c *Client) sendDatasourceLinkedinCreate(ctx context.Context, request *Data
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceLinkedinCreateResponse(resp)
+	result, err := decodeListContactsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2544,21 +9546,21 @@ func (c *Client) sendDatasourceLinkedinCreate(ctx context.Context, request *Data
 	return result, nil
 }
 
-// DatasourceLinkedinDelete invokes datasource-linkedin-delete operation.
+// ListUsers invokes listUsers operation.
 //
-// Delete a LinkedIn datasource.
+// List all users.
 //
-// DELETE /datasource/linkedin/{uuid}
-func (c *Client) DatasourceLinkedinDelete(ctx context.Context, params DatasourceLinkedinDeleteParams) error {
-	_, err := c.sendDatasourceLinkedinDelete(ctx, params)
-	return err
+// GET /user
+func (c *Client) ListUsers(ctx context.Context) ([]User, error) {
+	res, err := c.sendListUsers(ctx)
+	return res, err
 }
 
-func (c *Client) sendDatasourceLinkedinDelete(ctx context.Context, params DatasourceLinkedinDeleteParams) (res *DatasourceLinkedinDeleteOK, err error) {
+func (c *Client) sendListUsers(ctx context.Context) (res []User, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-linkedin-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/datasource/linkedin/{uuid}"),
+		otelogen.OperationID("listUsers"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/user"),
 	}
 
 	// Run stopwatch.
@@ -2573,7 +9575,7 @@ func (c *Client) sendDatasourceLinkedinDelete(ctx context.Context, params Dataso
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceLinkedinDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ListUsersOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2590,30 +9592,12 @@ func (c *Client) sendDatasourceLinkedinDelete(ctx context.Context, params Dataso
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/datasource/linkedin/"
-	{
-		// Encode "uuid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/user"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -2623,7 +9607,7 @@ func (c *Client) sendDatasourceLinkedinDelete(ctx context.Context, params Dataso
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceLinkedinDeleteOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, ListUsersOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2634,7 +9618,7 @@ func (c *Client) sendDatasourceLinkedinDelete(ctx context.Context, params Dataso
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceLinkedinDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ListUsersOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2645,7 +9629,7 @@ func (c *Client) sendDatasourceLinkedinDelete(ctx context.Context, params Dataso
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceLinkedinDeleteOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, ListUsersOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2683,7 +9667,7 @@ func (c *Client) sendDatasourceLinkedinDelete(ctx context.Context, params Dataso
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceLinkedinDeleteResponse(resp)
+	result, err := decodeListUsersResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2691,21 +9675,21 @@ func (c *Client) sendDatasourceLinkedinDelete(ctx context.Context, params Dataso
 	return result, nil
 }
 
-// DatasourceLinkedinGet invokes datasource-linkedin-get operation.
+// MessageEmailQuery invokes messageEmailQuery operation.
 //
-// Get a LinkedIn datasource.
+// Execute a search query on email messages.
 //
-// GET /datasource/linkedin/{uuid}
-func (c *Client) DatasourceLinkedinGet(ctx context.Context, params DatasourceLinkedinGetParams) (*DatasourceLinkedin, error) {
-	res, err := c.sendDatasourceLinkedinGet(ctx, params)
+// POST /message/email/query
+func (c *Client) MessageEmailQuery(ctx context.Context, request *MessageQuery) (*MessageEmailQueryOK, error) {
+	res, err := c.sendMessageEmailQuery(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDatasourceLinkedinGet(ctx context.Context, params DatasourceLinkedinGetParams) (res *DatasourceLinkedin, err error) {
+func (c *Client) sendMessageEmailQuery(ctx context.Context, request *MessageQuery) (res *MessageEmailQueryOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-linkedin-get"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource/linkedin/{uuid}"),
+		otelogen.OperationID("messageEmailQuery"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/message/email/query"),
 	}
 
 	// Run stopwatch.
@@ -2720,7 +9704,7 @@ func (c *Client) sendDatasourceLinkedinGet(ctx context.Context, params Datasourc
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceLinkedinGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, MessageEmailQueryOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2737,40 +9721,25 @@ func (c *Client) sendDatasourceLinkedinGet(ctx context.Context, params Datasourc
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/datasource/linkedin/"
-	{
-		// Encode "uuid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/message/email/query"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeMessageEmailQueryRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceLinkedinGetOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, MessageEmailQueryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2781,7 +9750,7 @@ func (c *Client) sendDatasourceLinkedinGet(ctx context.Context, params Datasourc
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceLinkedinGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, MessageEmailQueryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2792,7 +9761,7 @@ func (c *Client) sendDatasourceLinkedinGet(ctx context.Context, params Datasourc
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceLinkedinGetOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, MessageEmailQueryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2830,7 +9799,7 @@ func (c *Client) sendDatasourceLinkedinGet(ctx context.Context, params Datasourc
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceLinkedinGetResponse(resp)
+	result, err := decodeMessageEmailQueryResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2838,21 +9807,21 @@ func (c *Client) sendDatasourceLinkedinGet(ctx context.Context, params Datasourc
 	return result, nil
 }
 
-// DatasourceLinkedinList invokes datasource-linkedin-list operation.
+// MessageLinkedinQuery invokes messageLinkedinQuery operation.
 //
-// List all LinkedIn datasources.
+// Execute a search query on LinkedIn messages.
 //
-// GET /datasource/linkedin
-func (c *Client) DatasourceLinkedinList(ctx context.Context, params DatasourceLinkedinListParams) ([]DatasourceLinkedin, error) {
-	res, err := c.sendDatasourceLinkedinList(ctx, params)
+// POST /message/linkedin/query
+func (c *Client) MessageLinkedinQuery(ctx context.Context, request *MessageQuery) (*MessageLinkedinQueryOK, error) {
+	res, err := c.sendMessageLinkedinQuery(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDatasourceLinkedinList(ctx context.Context, params DatasourceLinkedinListParams) (res []DatasourceLinkedin, err error) {
+func (c *Client) sendMessageLinkedinQuery(ctx context.Context, request *MessageQuery) (res *MessageLinkedinQueryOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-linkedin-list"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource/linkedin"),
+		otelogen.OperationID("messageLinkedinQuery"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/message/linkedin/query"),
 	}
 
 	// Run stopwatch.
@@ -2867,7 +9836,7 @@ func (c *Client) sendDatasourceLinkedinList(ctx context.Context, params Datasour
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceLinkedinListOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, MessageLinkedinQueryOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2885,59 +9854,24 @@ func (c *Client) sendDatasourceLinkedinList(ctx context.Context, params Datasour
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/datasource/linkedin"
+	pathParts[0] = "/message/linkedin/query"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "offset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Offset.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeMessageLinkedinQueryRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceLinkedinListOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, MessageLinkedinQueryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2948,7 +9882,7 @@ func (c *Client) sendDatasourceLinkedinList(ctx context.Context, params Datasour
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceLinkedinListOperation, r); {
+			switch err := c.securityBearerAuth(ctx, MessageLinkedinQueryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2959,7 +9893,7 @@ func (c *Client) sendDatasourceLinkedinList(ctx context.Context, params Datasour
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceLinkedinListOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, MessageLinkedinQueryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2997,7 +9931,7 @@ func (c *Client) sendDatasourceLinkedinList(ctx context.Context, params Datasour
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceLinkedinListResponse(resp)
+	result, err := decodeMessageLinkedinQueryResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3005,21 +9939,21 @@ func (c *Client) sendDatasourceLinkedinList(ctx context.Context, params Datasour
 	return result, nil
 }
 
-// DatasourceLinkedinUpdate invokes datasource-linkedin-update operation.
+// MessageQuery invokes messageQuery operation.
 //
-// Update a LinkedIn datasource.
+// Execute a search query on unified messages.
 //
-// PUT /datasource/linkedin/{uuid}
-func (c *Client) DatasourceLinkedinUpdate(ctx context.Context, request *DatasourceLinkedin, params DatasourceLinkedinUpdateParams) (*DatasourceLinkedin, error) {
-	res, err := c.sendDatasourceLinkedinUpdate(ctx, request, params)
+// POST /message/query
+func (c *Client) MessageQuery(ctx context.Context, request *MessageQuery) (*MessageQueryOK, error) {
+	res, err := c.sendMessageQuery(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDatasourceLinkedinUpdate(ctx context.Context, request *DatasourceLinkedin, params DatasourceLinkedinUpdateParams) (res *DatasourceLinkedin, err error) {
+func (c *Client) sendMessageQuery(ctx context.Context, request *MessageQuery) (res *MessageQueryOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-linkedin-update"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/datasource/linkedin/{uuid}"),
+		otelogen.OperationID("messageQuery"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/message/query"),
 	}
 
 	// Run stopwatch.
@@ -3034,7 +9968,7 @@ func (c *Client) sendDatasourceLinkedinUpdate(ctx context.Context, request *Data
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceLinkedinUpdateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, MessageQueryOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3042,43 +9976,25 @@ func (c *Client) sendDatasourceLinkedinUpdate(ctx context.Context, request *Data
 	var stage string
 	defer func() {
 		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
-		}
-		span.End()
-	}()
-
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/datasource/linkedin/"
-	{
-		// Encode "uuid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		pathParts[1] = encoded
-	}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/message/query"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeDatasourceLinkedinUpdateRequest(request, r); err != nil {
+	if err := encodeMessageQueryRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -3087,7 +10003,7 @@ func (c *Client) sendDatasourceLinkedinUpdate(ctx context.Context, request *Data
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceLinkedinUpdateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, MessageQueryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3098,7 +10014,7 @@ func (c *Client) sendDatasourceLinkedinUpdate(ctx context.Context, request *Data
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceLinkedinUpdateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, MessageQueryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3109,7 +10025,7 @@ func (c *Client) sendDatasourceLinkedinUpdate(ctx context.Context, request *Data
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceLinkedinUpdateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, MessageQueryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3147,7 +10063,7 @@ func (c *Client) sendDatasourceLinkedinUpdate(ctx context.Context, request *Data
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceLinkedinUpdateResponse(resp)
+	result, err := decodeMessageQueryResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3155,21 +10071,21 @@ func (c *Client) sendDatasourceLinkedinUpdate(ctx context.Context, request *Data
 	return result, nil
 }
 
-// DatasourceList invokes datasource-list operation.
+// MessageTelegramQuery invokes messageTelegramQuery operation.
 //
-// Retrieve a list of datasource objects.
+// Execute a search query on Telegram messages.
 //
-// GET /datasource
-func (c *Client) DatasourceList(ctx context.Context, params DatasourceListParams) ([]Datasource, error) {
-	res, err := c.sendDatasourceList(ctx, params)
+// POST /message/telegram/query
+func (c *Client) MessageTelegramQuery(ctx context.Context, request *MessageQuery) (*MessageTelegramQueryOK, error) {
+	res, err := c.sendMessageTelegramQuery(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDatasourceList(ctx context.Context, params DatasourceListParams) (res []Datasource, err error) {
+func (c *Client) sendMessageTelegramQuery(ctx context.Context, request *MessageQuery) (res *MessageTelegramQueryOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-list"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource"),
+		otelogen.OperationID("messageTelegramQuery"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/message/telegram/query"),
 	}
 
 	// Run stopwatch.
@@ -3184,7 +10100,7 @@ func (c *Client) sendDatasourceList(ctx context.Context, params DatasourceListPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceListOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, MessageTelegramQueryOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3202,59 +10118,24 @@ func (c *Client) sendDatasourceList(ctx context.Context, params DatasourceListPa
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/datasource"
+	pathParts[0] = "/message/telegram/query"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "offset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Offset.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeMessageTelegramQueryRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceListOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, MessageTelegramQueryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3265,7 +10146,7 @@ func (c *Client) sendDatasourceList(ctx context.Context, params DatasourceListPa
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceListOperation, r); {
+			switch err := c.securityBearerAuth(ctx, MessageTelegramQueryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3276,7 +10157,7 @@ func (c *Client) sendDatasourceList(ctx context.Context, params DatasourceListPa
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceListOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, MessageTelegramQueryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3314,7 +10195,7 @@ func (c *Client) sendDatasourceList(ctx context.Context, params DatasourceListPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceListResponse(resp)
+	result, err := decodeMessageTelegramQueryResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3322,21 +10203,21 @@ func (c *Client) sendDatasourceList(ctx context.Context, params DatasourceListPa
 	return result, nil
 }
 
-// DatasourceSetOAuth2Client invokes datasource-set-oauth2-client operation.
+// MessageWhatsappQuery invokes messageWhatsappQuery operation.
 //
-// Set OAuth2 client to the datasource.
+// Execute a search query on WhatsApp messages.
 //
-// PUT /datasource/{uuid}/oauth2/client
-func (c *Client) DatasourceSetOAuth2Client(ctx context.Context, request *DatasourceSetOAuth2ClientReq, params DatasourceSetOAuth2ClientParams) error {
-	_, err := c.sendDatasourceSetOAuth2Client(ctx, request, params)
-	return err
+// POST /message/whatsapp/query
+func (c *Client) MessageWhatsappQuery(ctx context.Context, request *MessageQuery) (*MessageWhatsappQueryOK, error) {
+	res, err := c.sendMessageWhatsappQuery(ctx, request)
+	return res, err
 }
 
-func (c *Client) sendDatasourceSetOAuth2Client(ctx context.Context, request *DatasourceSetOAuth2ClientReq, params DatasourceSetOAuth2ClientParams) (res *DatasourceSetOAuth2ClientNoContent, err error) {
+func (c *Client) sendMessageWhatsappQuery(ctx context.Context, request *MessageQuery) (res *MessageWhatsappQueryOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-set-oauth2-client"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/datasource/{uuid}/oauth2/client"),
+		otelogen.OperationID("messageWhatsappQuery"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/message/whatsapp/query"),
 	}
 
 	// Run stopwatch.
@@ -3351,7 +10232,7 @@ func (c *Client) sendDatasourceSetOAuth2Client(ctx context.Context, request *Dat
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceSetOAuth2ClientOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, MessageWhatsappQueryOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3368,35 +10249,16 @@ func (c *Client) sendDatasourceSetOAuth2Client(ctx context.Context, request *Dat
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/datasource/"
-	{
-		// Encode "uuid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/oauth2/client"
+	var pathParts [1]string
+	pathParts[0] = "/message/whatsapp/query"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeDatasourceSetOAuth2ClientRequest(request, r); err != nil {
+	if err := encodeMessageWhatsappQueryRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -3405,7 +10267,7 @@ func (c *Client) sendDatasourceSetOAuth2Client(ctx context.Context, request *Dat
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceSetOAuth2ClientOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, MessageWhatsappQueryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3416,7 +10278,7 @@ func (c *Client) sendDatasourceSetOAuth2Client(ctx context.Context, request *Dat
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceSetOAuth2ClientOperation, r); {
+			switch err := c.securityBearerAuth(ctx, MessageWhatsappQueryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3427,7 +10289,7 @@ func (c *Client) sendDatasourceSetOAuth2Client(ctx context.Context, request *Dat
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceSetOAuth2ClientOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, MessageWhatsappQueryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3465,7 +10327,117 @@ func (c *Client) sendDatasourceSetOAuth2Client(ctx context.Context, request *Dat
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceSetOAuth2ClientResponse(resp)
+	result, err := decodeMessageWhatsappQueryResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// OAuth2ClientCallback invokes oauth2-client-callback operation.
+//
+// Serve OAuth2 client callback.
+//
+// GET /oauth2/callback
+func (c *Client) OAuth2ClientCallback(ctx context.Context, params OAuth2ClientCallbackParams) (*OAuth2ClientCallbackFound, error) {
+	res, err := c.sendOAuth2ClientCallback(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendOAuth2ClientCallback(ctx context.Context, params OAuth2ClientCallbackParams) (res *OAuth2ClientCallbackFound, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("oauth2-client-callback"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/oauth2/callback"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, OAuth2ClientCallbackOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/oauth2/callback"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "state" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "state",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.State.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "code" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "code",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Code.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeOAuth2ClientCallbackResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3473,21 +10445,21 @@ func (c *Client) sendDatasourceSetOAuth2Client(ctx context.Context, request *Dat
 	return result, nil
 }
 
-// DatasourceTelegramCreate invokes datasource-telegram-create operation.
+// OAuth2ClientCreate invokes oauth2-client-create operation.
 //
-// Create a new Telegram datasource.
+// Create OAuth2 client.
 //
-// POST /datasource/telegram
-func (c *Client) DatasourceTelegramCreate(ctx context.Context, request *DatasourceTelegram) (*DatasourceTelegram, error) {
-	res, err := c.sendDatasourceTelegramCreate(ctx, request)
+// POST /oauth2/client
+func (c *Client) OAuth2ClientCreate(ctx context.Context, request *OAuth2ClientCreateReq) (*OAuth2Client, error) {
+	res, err := c.sendOAuth2ClientCreate(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDatasourceTelegramCreate(ctx context.Context, request *DatasourceTelegram) (res *DatasourceTelegram, err error) {
+func (c *Client) sendOAuth2ClientCreate(ctx context.Context, request *OAuth2ClientCreateReq) (res *OAuth2Client, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-telegram-create"),
+		otelogen.OperationID("oauth2-client-create"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/datasource/telegram"),
+		semconv.HTTPRouteKey.String("/oauth2/client"),
 	}
 
 	// Run stopwatch.
@@ -3502,7 +10474,7 @@ func (c *Client) sendDatasourceTelegramCreate(ctx context.Context, request *Data
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceTelegramCreateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, OAuth2ClientCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3520,7 +10492,7 @@ func (c *Client) sendDatasourceTelegramCreate(ctx context.Context, request *Data
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/datasource/telegram"
+	pathParts[0] = "/oauth2/client"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -3528,7 +10500,7 @@ func (c *Client) sendDatasourceTelegramCreate(ctx context.Context, request *Data
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeDatasourceTelegramCreateRequest(request, r); err != nil {
+	if err := encodeOAuth2ClientCreateRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -3537,7 +10509,7 @@ func (c *Client) sendDatasourceTelegramCreate(ctx context.Context, request *Data
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceTelegramCreateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, OAuth2ClientCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3548,7 +10520,7 @@ func (c *Client) sendDatasourceTelegramCreate(ctx context.Context, request *Data
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceTelegramCreateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, OAuth2ClientCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3559,7 +10531,7 @@ func (c *Client) sendDatasourceTelegramCreate(ctx context.Context, request *Data
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceTelegramCreateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, OAuth2ClientCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3597,7 +10569,7 @@ func (c *Client) sendDatasourceTelegramCreate(ctx context.Context, request *Data
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceTelegramCreateResponse(resp)
+	result, err := decodeOAuth2ClientCreateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3605,21 +10577,21 @@ func (c *Client) sendDatasourceTelegramCreate(ctx context.Context, request *Data
 	return result, nil
 }
 
-// DatasourceTelegramDelete invokes datasource-telegram-delete operation.
+// OAuth2ClientDelete invokes oauth2-client-delete operation.
 //
-// Delete a Telegram datasource.
+// Delete OAuth2 client.
 //
-// DELETE /datasource/telegram/{uuid}
-func (c *Client) DatasourceTelegramDelete(ctx context.Context, params DatasourceTelegramDeleteParams) error {
-	_, err := c.sendDatasourceTelegramDelete(ctx, params)
+// DELETE /oauth2/client/{uuid}
+func (c *Client) OAuth2ClientDelete(ctx context.Context, params OAuth2ClientDeleteParams) error {
+	_, err := c.sendOAuth2ClientDelete(ctx, params)
 	return err
 }
 
-func (c *Client) sendDatasourceTelegramDelete(ctx context.Context, params DatasourceTelegramDeleteParams) (res *DatasourceTelegramDeleteOK, err error) {
+func (c *Client) sendOAuth2ClientDelete(ctx context.Context, params OAuth2ClientDeleteParams) (res *OAuth2ClientDeleteOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-telegram-delete"),
+		otelogen.OperationID("oauth2-client-delete"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/datasource/telegram/{uuid}"),
+		semconv.HTTPRouteKey.String("/oauth2/client/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -3634,7 +10606,7 @@ func (c *Client) sendDatasourceTelegramDelete(ctx context.Context, params Dataso
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceTelegramDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, OAuth2ClientDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3652,7 +10624,7 @@ func (c *Client) sendDatasourceTelegramDelete(ctx context.Context, params Dataso
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/datasource/telegram/"
+	pathParts[0] = "/oauth2/client/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -3684,7 +10656,7 @@ func (c *Client) sendDatasourceTelegramDelete(ctx context.Context, params Dataso
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceTelegramDeleteOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, OAuth2ClientDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3695,7 +10667,7 @@ func (c *Client) sendDatasourceTelegramDelete(ctx context.Context, params Dataso
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceTelegramDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, OAuth2ClientDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3706,7 +10678,7 @@ func (c *Client) sendDatasourceTelegramDelete(ctx context.Context, params Dataso
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceTelegramDeleteOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, OAuth2ClientDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3744,7 +10716,7 @@ func (c *Client) sendDatasourceTelegramDelete(ctx context.Context, params Dataso
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceTelegramDeleteResponse(resp)
+	result, err := decodeOAuth2ClientDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3752,21 +10724,21 @@ func (c *Client) sendDatasourceTelegramDelete(ctx context.Context, params Dataso
 	return result, nil
 }
 
-// DatasourceTelegramGet invokes datasource-telegram-get operation.
+// OAuth2ClientGet invokes oauth2-client-get operation.
 //
-// Get a Telegram datasource.
+// Get OAuth2 client details.
 //
-// GET /datasource/telegram/{uuid}
-func (c *Client) DatasourceTelegramGet(ctx context.Context, params DatasourceTelegramGetParams) (*DatasourceTelegram, error) {
-	res, err := c.sendDatasourceTelegramGet(ctx, params)
+// GET /oauth2/client/{uuid}
+func (c *Client) OAuth2ClientGet(ctx context.Context, params OAuth2ClientGetParams) (*OAuth2Client, error) {
+	res, err := c.sendOAuth2ClientGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDatasourceTelegramGet(ctx context.Context, params DatasourceTelegramGetParams) (res *DatasourceTelegram, err error) {
+func (c *Client) sendOAuth2ClientGet(ctx context.Context, params OAuth2ClientGetParams) (res *OAuth2Client, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-telegram-get"),
+		otelogen.OperationID("oauth2-client-get"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource/telegram/{uuid}"),
+		semconv.HTTPRouteKey.String("/oauth2/client/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -3781,7 +10753,7 @@ func (c *Client) sendDatasourceTelegramGet(ctx context.Context, params Datasourc
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceTelegramGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, OAuth2ClientGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3799,7 +10771,7 @@ func (c *Client) sendDatasourceTelegramGet(ctx context.Context, params Datasourc
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/datasource/telegram/"
+	pathParts[0] = "/oauth2/client/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -3831,7 +10803,7 @@ func (c *Client) sendDatasourceTelegramGet(ctx context.Context, params Datasourc
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceTelegramGetOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, OAuth2ClientGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3842,7 +10814,7 @@ func (c *Client) sendDatasourceTelegramGet(ctx context.Context, params Datasourc
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceTelegramGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, OAuth2ClientGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3853,7 +10825,7 @@ func (c *Client) sendDatasourceTelegramGet(ctx context.Context, params Datasourc
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceTelegramGetOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, OAuth2ClientGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3891,7 +10863,7 @@ func (c *Client) sendDatasourceTelegramGet(ctx context.Context, params Datasourc
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceTelegramGetResponse(resp)
+	result, err := decodeOAuth2ClientGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3899,21 +10871,21 @@ func (c *Client) sendDatasourceTelegramGet(ctx context.Context, params Datasourc
 	return result, nil
 }
 
-// DatasourceTelegramList invokes datasource-telegram-list operation.
+// OAuth2ClientList invokes oauth2-client-list operation.
 //
-// List all Telegram datasources.
+// List OAuth2 clients.
 //
-// GET /datasource/telegram
-func (c *Client) DatasourceTelegramList(ctx context.Context, params DatasourceTelegramListParams) ([]DatasourceTelegram, error) {
-	res, err := c.sendDatasourceTelegramList(ctx, params)
+// GET /oauth2/client
+func (c *Client) OAuth2ClientList(ctx context.Context, params OAuth2ClientListParams) (*OAuth2ClientListOK, error) {
+	res, err := c.sendOAuth2ClientList(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDatasourceTelegramList(ctx context.Context, params DatasourceTelegramListParams) (res []DatasourceTelegram, err error) {
+func (c *Client) sendOAuth2ClientList(ctx context.Context, params OAuth2ClientListParams) (res *OAuth2ClientListOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-telegram-list"),
+		otelogen.OperationID("oauth2-client-list"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource/telegram"),
+		semconv.HTTPRouteKey.String("/oauth2/client"),
 	}
 
 	// Run stopwatch.
@@ -3928,7 +10900,7 @@ func (c *Client) sendDatasourceTelegramList(ctx context.Context, params Datasour
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceTelegramListOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, OAuth2ClientListOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3946,7 +10918,7 @@ func (c *Client) sendDatasourceTelegramList(ctx context.Context, params Datasour
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/datasource/telegram"
+	pathParts[0] = "/oauth2/client"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
@@ -3998,7 +10970,7 @@ func (c *Client) sendDatasourceTelegramList(ctx context.Context, params Datasour
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceTelegramListOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, OAuth2ClientListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4009,7 +10981,7 @@ func (c *Client) sendDatasourceTelegramList(ctx context.Context, params Datasour
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceTelegramListOperation, r); {
+			switch err := c.securityBearerAuth(ctx, OAuth2ClientListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4020,7 +10992,7 @@ func (c *Client) sendDatasourceTelegramList(ctx context.Context, params Datasour
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceTelegramListOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, OAuth2ClientListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4058,7 +11030,7 @@ func (c *Client) sendDatasourceTelegramList(ctx context.Context, params Datasour
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceTelegramListResponse(resp)
+	result, err := decodeOAuth2ClientListResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4066,21 +11038,153 @@ func (c *Client) sendDatasourceTelegramList(ctx context.Context, params Datasour
 	return result, nil
 }
 
-// DatasourceTelegramUpdate invokes datasource-telegram-update operation.
+// OAuth2ClientLogin invokes oauth2-client-login operation.
+//
+// Start OAuth2 login flow.
+//
+// POST /oauth2/login
+func (c *Client) OAuth2ClientLogin(ctx context.Context, request *OAuth2ClientLoginReq) (*OAuth2ClientLoginOK, error) {
+	res, err := c.sendOAuth2ClientLogin(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendOAuth2ClientLogin(ctx context.Context, request *OAuth2ClientLoginReq) (res *OAuth2ClientLoginOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("oauth2-client-login"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/oauth2/login"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, OAuth2ClientLoginOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/oauth2/login"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeOAuth2ClientLoginRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, OAuth2ClientLoginOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, OAuth2ClientLoginOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, OAuth2ClientLoginOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeOAuth2ClientLoginResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// OAuth2ClientTokenDelete invokes oauth2-client-token-delete operation.
 //
-// Update a Telegram datasource.
+// Delete OAuth2 client token.
 //
-// PUT /datasource/telegram/{uuid}
-func (c *Client) DatasourceTelegramUpdate(ctx context.Context, request *DatasourceTelegram, params DatasourceTelegramUpdateParams) (*DatasourceTelegram, error) {
-	res, err := c.sendDatasourceTelegramUpdate(ctx, request, params)
-	return res, err
+// DELETE /oauth2/client/{datasource_uuid}/token/{uuid}
+func (c *Client) OAuth2ClientTokenDelete(ctx context.Context, params OAuth2ClientTokenDeleteParams) error {
+	_, err := c.sendOAuth2ClientTokenDelete(ctx, params)
+	return err
 }
 
-func (c *Client) sendDatasourceTelegramUpdate(ctx context.Context, request *DatasourceTelegram, params DatasourceTelegramUpdateParams) (res *DatasourceTelegram, err error) {
+func (c *Client) sendOAuth2ClientTokenDelete(ctx context.Context, params OAuth2ClientTokenDeleteParams) (res *OAuth2ClientTokenDeleteOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-telegram-update"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/datasource/telegram/{uuid}"),
+		otelogen.OperationID("oauth2-client-token-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/oauth2/client/{datasource_uuid}/token/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -4095,7 +11199,7 @@ func (c *Client) sendDatasourceTelegramUpdate(ctx context.Context, request *Data
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceTelegramUpdateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, OAuth2ClientTokenDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4112,8 +11216,27 @@ func (c *Client) sendDatasourceTelegramUpdate(ctx context.Context, request *Data
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/datasource/telegram/"
+	var pathParts [4]string
+	pathParts[0] = "/oauth2/client/"
+	{
+		// Encode "datasource_uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "datasource_uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.DatasourceUUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/token/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -4130,25 +11253,22 @@ func (c *Client) sendDatasourceTelegramUpdate(ctx context.Context, request *Data
 		if err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
-		pathParts[1] = encoded
+		pathParts[3] = encoded
 	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeDatasourceTelegramUpdateRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceTelegramUpdateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, OAuth2ClientTokenDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4159,7 +11279,7 @@ func (c *Client) sendDatasourceTelegramUpdate(ctx context.Context, request *Data
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceTelegramUpdateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, OAuth2ClientTokenDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4170,7 +11290,7 @@ func (c *Client) sendDatasourceTelegramUpdate(ctx context.Context, request *Data
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceTelegramUpdateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, OAuth2ClientTokenDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4208,7 +11328,7 @@ func (c *Client) sendDatasourceTelegramUpdate(ctx context.Context, request *Data
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceTelegramUpdateResponse(resp)
+	result, err := decodeOAuth2ClientTokenDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4216,21 +11336,21 @@ func (c *Client) sendDatasourceTelegramUpdate(ctx context.Context, request *Data
 	return result, nil
 }
 
-// DatasourceWhatsappCreate invokes datasource-whatsapp-create operation.
+// OAuth2ClientTokenList invokes oauth2-client-token-list operation.
 //
-// Create a new WhatsApp datasource.
+// List OAuth2 client tokens.
 //
-// POST /datasource/whatsapp
-func (c *Client) DatasourceWhatsappCreate(ctx context.Context, request *DatasourceWhatsapp) (*DatasourceWhatsapp, error) {
-	res, err := c.sendDatasourceWhatsappCreate(ctx, request)
+// GET /oauth2/client/{datasource_uuid}/token
+func (c *Client) OAuth2ClientTokenList(ctx context.Context, params OAuth2ClientTokenListParams) ([]OAuth2ClientToken, error) {
+	res, err := c.sendOAuth2ClientTokenList(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDatasourceWhatsappCreate(ctx context.Context, request *DatasourceWhatsapp) (res *DatasourceWhatsapp, err error) {
+func (c *Client) sendOAuth2ClientTokenList(ctx context.Context, params OAuth2ClientTokenListParams) (res []OAuth2ClientToken, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-whatsapp-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/datasource/whatsapp"),
+		otelogen.OperationID("oauth2-client-token-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/oauth2/client/{datasource_uuid}/token"),
 	}
 
 	// Run stopwatch.
@@ -4245,7 +11365,7 @@ func (c *Client) sendDatasourceWhatsappCreate(ctx context.Context, request *Data
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceWhatsappCreateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, OAuth2ClientTokenListOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4262,25 +11382,41 @@ func (c *Client) sendDatasourceWhatsappCreate(ctx context.Context, request *Data
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/datasource/whatsapp"
+	var pathParts [3]string
+	pathParts[0] = "/oauth2/client/"
+	{
+		// Encode "datasource_uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "datasource_uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.DatasourceUUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/token"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeDatasourceWhatsappCreateRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceWhatsappCreateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, OAuth2ClientTokenListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4291,7 +11427,7 @@ func (c *Client) sendDatasourceWhatsappCreate(ctx context.Context, request *Data
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceWhatsappCreateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, OAuth2ClientTokenListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4302,7 +11438,7 @@ func (c *Client) sendDatasourceWhatsappCreate(ctx context.Context, request *Data
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceWhatsappCreateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, OAuth2ClientTokenListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4340,7 +11476,7 @@ func (c *Client) sendDatasourceWhatsappCreate(ctx context.Context, request *Data
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceWhatsappCreateResponse(resp)
+	result, err := decodeOAuth2ClientTokenListResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4348,21 +11484,21 @@ func (c *Client) sendDatasourceWhatsappCreate(ctx context.Context, request *Data
 	return result, nil
 }
 
-// DatasourceWhatsappDelete invokes datasource-whatsapp-delete operation.
+// OAuth2ClientTokenRefresh invokes oauth2-client-token-refresh operation.
 //
-// Delete a WhatsApp datasource.
+// Refresh the OAuth2 client token now, whatever its expiry.
 //
-// DELETE /datasource/whatsapp/{uuid}
-func (c *Client) DatasourceWhatsappDelete(ctx context.Context, params DatasourceWhatsappDeleteParams) error {
-	_, err := c.sendDatasourceWhatsappDelete(ctx, params)
+// POST /oauth2/client/{datasource_uuid}/token/{uuid}/refresh
+func (c *Client) OAuth2ClientTokenRefresh(ctx context.Context, params OAuth2ClientTokenRefreshParams) error {
+	_, err := c.sendOAuth2ClientTokenRefresh(ctx, params)
 	return err
 }
 
-func (c *Client) sendDatasourceWhatsappDelete(ctx context.Context, params DatasourceWhatsappDeleteParams) (res *DatasourceWhatsappDeleteOK, err error) {
+func (c *Client) sendOAuth2ClientTokenRefresh(ctx context.Context, params OAuth2ClientTokenRefreshParams) (res *OAuth2ClientTokenRefreshNoContent, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-whatsapp-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/datasource/whatsapp/{uuid}"),
+		otelogen.OperationID("oauth2-client-token-refresh"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/oauth2/client/{datasource_uuid}/token/{uuid}/refresh"),
 	}
 
 	// Run stopwatch.
@@ -4377,7 +11513,7 @@ func (c *Client) sendDatasourceWhatsappDelete(ctx context.Context, params Dataso
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceWhatsappDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, OAuth2ClientTokenRefreshOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4394,8 +11530,27 @@ func (c *Client) sendDatasourceWhatsappDelete(ctx context.Context, params Dataso
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/datasource/whatsapp/"
+	var pathParts [5]string
+	pathParts[0] = "/oauth2/client/"
+	{
+		// Encode "datasource_uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "datasource_uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.DatasourceUUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/token/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -4412,12 +11567,13 @@ func (c *Client) sendDatasourceWhatsappDelete(ctx context.Context, params Dataso
 		if err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
-		pathParts[1] = encoded
+		pathParts[3] = encoded
 	}
+	pathParts[4] = "/refresh"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -4427,7 +11583,7 @@ func (c *Client) sendDatasourceWhatsappDelete(ctx context.Context, params Dataso
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceWhatsappDeleteOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, OAuth2ClientTokenRefreshOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4438,7 +11594,7 @@ func (c *Client) sendDatasourceWhatsappDelete(ctx context.Context, params Dataso
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceWhatsappDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, OAuth2ClientTokenRefreshOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4449,7 +11605,7 @@ func (c *Client) sendDatasourceWhatsappDelete(ctx context.Context, params Dataso
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceWhatsappDeleteOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, OAuth2ClientTokenRefreshOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4487,7 +11643,7 @@ func (c *Client) sendDatasourceWhatsappDelete(ctx context.Context, params Dataso
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceWhatsappDeleteResponse(resp)
+	result, err := decodeOAuth2ClientTokenRefreshResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4495,21 +11651,21 @@ func (c *Client) sendDatasourceWhatsappDelete(ctx context.Context, params Dataso
 	return result, nil
 }
 
-// DatasourceWhatsappGet invokes datasource-whatsapp-get operation.
+// OAuth2ClientUpdate invokes oauth2-client-update operation.
 //
-// Get a WhatsApp datasource.
+// Update OAuth2 client.
 //
-// GET /datasource/whatsapp/{uuid}
-func (c *Client) DatasourceWhatsappGet(ctx context.Context, params DatasourceWhatsappGetParams) (*DatasourceWhatsapp, error) {
-	res, err := c.sendDatasourceWhatsappGet(ctx, params)
+// PUT /oauth2/client/{uuid}
+func (c *Client) OAuth2ClientUpdate(ctx context.Context, request *OAuth2ClientUpdateReq, params OAuth2ClientUpdateParams) (*OAuth2Client, error) {
+	res, err := c.sendOAuth2ClientUpdate(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendDatasourceWhatsappGet(ctx context.Context, params DatasourceWhatsappGetParams) (res *DatasourceWhatsapp, err error) {
+func (c *Client) sendOAuth2ClientUpdate(ctx context.Context, request *OAuth2ClientUpdateReq, params OAuth2ClientUpdateParams) (res *OAuth2Client, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-whatsapp-get"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource/whatsapp/{uuid}"),
+		otelogen.OperationID("oauth2-client-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/oauth2/client/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -4524,7 +11680,7 @@ func (c *Client) sendDatasourceWhatsappGet(ctx context.Context, params Datasourc
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceWhatsappGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, OAuth2ClientUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4542,7 +11698,7 @@ func (c *Client) sendDatasourceWhatsappGet(ctx context.Context, params Datasourc
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/datasource/whatsapp/"
+	pathParts[0] = "/oauth2/client/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -4564,17 +11720,20 @@ func (c *Client) sendDatasourceWhatsappGet(ctx context.Context, params Datasourc
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeOAuth2ClientUpdateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceWhatsappGetOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, OAuth2ClientUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4585,7 +11744,7 @@ func (c *Client) sendDatasourceWhatsappGet(ctx context.Context, params Datasourc
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceWhatsappGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, OAuth2ClientUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4596,7 +11755,7 @@ func (c *Client) sendDatasourceWhatsappGet(ctx context.Context, params Datasourc
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceWhatsappGetOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, OAuth2ClientUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4634,7 +11793,7 @@ func (c *Client) sendDatasourceWhatsappGet(ctx context.Context, params Datasourc
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceWhatsappGetResponse(resp)
+	result, err := decodeOAuth2ClientUpdateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4642,93 +11801,55 @@ func (c *Client) sendDatasourceWhatsappGet(ctx context.Context, params Datasourc
 	return result, nil
 }
 
-// DatasourceWhatsappList invokes datasource-whatsapp-list operation.
+// OAuth2ProviderList invokes oauth2-provider-list operation.
 //
-// List all WhatsApp datasources.
+// List the OAuth2 providers OAuth2 clients can use.
 //
-// GET /datasource/whatsapp
-func (c *Client) DatasourceWhatsappList(ctx context.Context, params DatasourceWhatsappListParams) ([]DatasourceWhatsapp, error) {
-	res, err := c.sendDatasourceWhatsappList(ctx, params)
+// GET /oauth2/provider
+func (c *Client) OAuth2ProviderList(ctx context.Context) ([]OAuth2Provider, error) {
+	res, err := c.sendOAuth2ProviderList(ctx)
 	return res, err
 }
 
-func (c *Client) sendDatasourceWhatsappList(ctx context.Context, params DatasourceWhatsappListParams) (res []DatasourceWhatsapp, err error) {
+func (c *Client) sendOAuth2ProviderList(ctx context.Context) (res []OAuth2Provider, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-whatsapp-list"),
+		otelogen.OperationID("oauth2-provider-list"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource/whatsapp"),
+		semconv.HTTPRouteKey.String("/oauth2/provider"),
 	}
 
 	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
-
-	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceWhatsappListOperation,
-		trace.WithAttributes(otelAttrs...),
-		clientSpanKind,
-	)
-	// Track stage for error reporting.
-	var stage string
-	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
-		}
-		span.End()
-	}()
-
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/datasource/whatsapp"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "offset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Offset.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, OAuth2ProviderListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-	}
-	u.RawQuery = q.Values().Encode()
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/oauth2/provider"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -4741,7 +11862,7 @@ func (c *Client) sendDatasourceWhatsappList(ctx context.Context, params Datasour
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceWhatsappListOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, OAuth2ProviderListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4752,7 +11873,7 @@ func (c *Client) sendDatasourceWhatsappList(ctx context.Context, params Datasour
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceWhatsappListOperation, r); {
+			switch err := c.securityBearerAuth(ctx, OAuth2ProviderListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4763,7 +11884,7 @@ func (c *Client) sendDatasourceWhatsappList(ctx context.Context, params Datasour
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceWhatsappListOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, OAuth2ProviderListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4801,7 +11922,7 @@ func (c *Client) sendDatasourceWhatsappList(ctx context.Context, params Datasour
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceWhatsappListResponse(resp)
+	result, err := decodeOAuth2ProviderListResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4809,21 +11930,21 @@ func (c *Client) sendDatasourceWhatsappList(ctx context.Context, params Datasour
 	return result, nil
 }
 
-// DatasourceWhatsappUpdate invokes datasource-whatsapp-update operation.
+// PipelineCreate invokes pipeline-create operation.
 //
-// Update a WhatsApp datasource.
+// Create a new pipeline for a datasource.
 //
-// PUT /datasource/whatsapp/{uuid}
-func (c *Client) DatasourceWhatsappUpdate(ctx context.Context, request *DatasourceWhatsapp, params DatasourceWhatsappUpdateParams) (*DatasourceWhatsapp, error) {
-	res, err := c.sendDatasourceWhatsappUpdate(ctx, request, params)
+// POST /pipeline
+func (c *Client) PipelineCreate(ctx context.Context, request *Pipeline) (*Pipeline, error) {
+	res, err := c.sendPipelineCreate(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDatasourceWhatsappUpdate(ctx context.Context, request *DatasourceWhatsapp, params DatasourceWhatsappUpdateParams) (res *DatasourceWhatsapp, err error) {
+func (c *Client) sendPipelineCreate(ctx context.Context, request *Pipeline) (res *Pipeline, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-whatsapp-update"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/datasource/whatsapp/{uuid}"),
+		otelogen.OperationID("pipeline-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/pipeline"),
 	}
 
 	// Run stopwatch.
@@ -4838,7 +11959,7 @@ func (c *Client) sendDatasourceWhatsappUpdate(ctx context.Context, request *Data
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DatasourceWhatsappUpdateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PipelineCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4855,34 +11976,16 @@ func (c *Client) sendDatasourceWhatsappUpdate(ctx context.Context, request *Data
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/datasource/whatsapp/"
-	{
-		// Encode "uuid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/pipeline"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeDatasourceWhatsappUpdateRequest(request, r); err != nil {
+	if err := encodePipelineCreateRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -4891,7 +11994,7 @@ func (c *Client) sendDatasourceWhatsappUpdate(ctx context.Context, request *Data
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DatasourceWhatsappUpdateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, PipelineCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4902,7 +12005,7 @@ func (c *Client) sendDatasourceWhatsappUpdate(ctx context.Context, request *Data
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DatasourceWhatsappUpdateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PipelineCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4913,7 +12016,7 @@ func (c *Client) sendDatasourceWhatsappUpdate(ctx context.Context, request *Data
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DatasourceWhatsappUpdateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, PipelineCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4951,7 +12054,7 @@ func (c *Client) sendDatasourceWhatsappUpdate(ctx context.Context, request *Data
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDatasourceWhatsappUpdateResponse(resp)
+	result, err := decodePipelineCreateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4959,21 +12062,21 @@ func (c *Client) sendDatasourceWhatsappUpdate(ctx context.Context, request *Data
 	return result, nil
 }
 
-// DeleteContact invokes deleteContact operation.
+// PipelineDelete invokes pipeline-delete operation.
 //
-// Delete a contact record.
+// Delete a specific pipeline by UUID.
 //
-// DELETE /contact/{uuid}
-func (c *Client) DeleteContact(ctx context.Context, params DeleteContactParams) error {
-	_, err := c.sendDeleteContact(ctx, params)
+// DELETE /pipeline/{uuid}
+func (c *Client) PipelineDelete(ctx context.Context, params PipelineDeleteParams) error {
+	_, err := c.sendPipelineDelete(ctx, params)
 	return err
 }
 
-func (c *Client) sendDeleteContact(ctx context.Context, params DeleteContactParams) (res *DeleteContactOK, err error) {
+func (c *Client) sendPipelineDelete(ctx context.Context, params PipelineDeleteParams) (res *PipelineDeleteOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteContact"),
+		otelogen.OperationID("pipeline-delete"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/contact/{uuid}"),
+		semconv.HTTPRouteKey.String("/pipeline/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -4988,7 +12091,7 @@ func (c *Client) sendDeleteContact(ctx context.Context, params DeleteContactPara
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteContactOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PipelineDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5006,7 +12109,7 @@ func (c *Client) sendDeleteContact(ctx context.Context, params DeleteContactPara
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/contact/"
+	pathParts[0] = "/pipeline/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -5015,7 +12118,7 @@ func (c *Client) sendDeleteContact(ctx context.Context, params DeleteContactPara
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
+			return e.EncodeValue(conv.UUIDToString(params.UUID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -5038,7 +12141,7 @@ func (c *Client) sendDeleteContact(ctx context.Context, params DeleteContactPara
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DeleteContactOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, PipelineDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5049,7 +12152,7 @@ func (c *Client) sendDeleteContact(ctx context.Context, params DeleteContactPara
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteContactOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PipelineDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5060,7 +12163,7 @@ func (c *Client) sendDeleteContact(ctx context.Context, params DeleteContactPara
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DeleteContactOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, PipelineDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5098,7 +12201,7 @@ func (c *Client) sendDeleteContact(ctx context.Context, params DeleteContactPara
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteContactResponse(resp)
+	result, err := decodePipelineDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5106,21 +12209,21 @@ func (c *Client) sendDeleteContact(ctx context.Context, params DeleteContactPara
 	return result, nil
 }
 
-// DeleteUser invokes deleteUser operation.
+// PipelineGet invokes pipeline-get operation.
 //
-// Delete user.
+// Retrieve a specific pipeline by its UUID.
 //
-// DELETE /user/{uuid}
-func (c *Client) DeleteUser(ctx context.Context, params DeleteUserParams) error {
-	_, err := c.sendDeleteUser(ctx, params)
-	return err
+// GET /pipeline/{uuid}
+func (c *Client) PipelineGet(ctx context.Context, params PipelineGetParams) (*Pipeline, error) {
+	res, err := c.sendPipelineGet(ctx, params)
+	return res, err
 }
 
-func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (res *DeleteUserOK, err error) {
+func (c *Client) sendPipelineGet(ctx context.Context, params PipelineGetParams) (res *Pipeline, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteUser"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/user/{uuid}"),
+		otelogen.OperationID("pipeline-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/pipeline/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -5135,7 +12238,7 @@ func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteUserOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PipelineGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5153,7 +12256,7 @@ func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (r
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/user/"
+	pathParts[0] = "/pipeline/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -5162,7 +12265,7 @@ func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (r
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
+			return e.EncodeValue(conv.UUIDToString(params.UUID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -5175,7 +12278,7 @@ func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (r
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -5185,7 +12288,7 @@ func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (r
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, DeleteUserOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, PipelineGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5196,7 +12299,7 @@ func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (r
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteUserOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PipelineGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5207,7 +12310,7 @@ func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (r
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, DeleteUserOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, PipelineGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5245,7 +12348,7 @@ func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteUserResponse(resp)
+	result, err := decodePipelineGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5253,21 +12356,21 @@ func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (r
 	return result, nil
 }
 
-// FileCreate invokes file-create operation.
+// PipelineList invokes pipeline-list operation.
 //
-// Upload a new file and create its record.
+// Get all pipelines.
 //
-// POST /file
-func (c *Client) FileCreate(ctx context.Context, request *UploadFileRequest) (*UploadFileResponse, error) {
-	res, err := c.sendFileCreate(ctx, request)
+// GET /pipeline
+func (c *Client) PipelineList(ctx context.Context, params PipelineListParams) (*PipelineListOK, error) {
+	res, err := c.sendPipelineList(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendFileCreate(ctx context.Context, request *UploadFileRequest) (res *UploadFileResponse, err error) {
+func (c *Client) sendPipelineList(ctx context.Context, params PipelineListParams) (res *PipelineListOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("file-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/file"),
+		otelogen.OperationID("pipeline-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/pipeline"),
 	}
 
 	// Run stopwatch.
@@ -5282,7 +12385,7 @@ func (c *Client) sendFileCreate(ctx context.Context, request *UploadFileRequest)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, FileCreateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PipelineListOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5294,30 +12397,99 @@ func (c *Client) sendFileCreate(ctx context.Context, request *UploadFileRequest)
 			span.SetStatus(codes.Error, stage)
 			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		span.End()
-	}()
-
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/file"
-	uri.AddPathParts(u, pathParts[:]...)
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/pipeline"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "datasource_uuid" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "datasource_uuid",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.DatasourceUUID.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "storage_uuid" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "storage_uuid",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.StorageUUID.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeFileCreateRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, FileCreateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, PipelineListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5328,7 +12500,7 @@ func (c *Client) sendFileCreate(ctx context.Context, request *UploadFileRequest)
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, FileCreateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PipelineListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5339,7 +12511,7 @@ func (c *Client) sendFileCreate(ctx context.Context, request *UploadFileRequest)
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, FileCreateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, PipelineListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5377,7 +12549,7 @@ func (c *Client) sendFileCreate(ctx context.Context, request *UploadFileRequest)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeFileCreateResponse(resp)
+	result, err := decodePipelineListResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5385,21 +12557,23 @@ func (c *Client) sendFileCreate(ctx context.Context, request *UploadFileRequest)
 	return result, nil
 }
 
-// FileDelete invokes file-delete operation.
+// PipelineRun invokes pipeline-run operation.
 //
-// Delete a stored file.
+// Enqueue a fetch job for the pipeline outside its schedule, optionally as a
+// date range backfill, capped, as a dry run or into another storage. The job
+// is recorded in worker jobs as queued.
 //
-// DELETE /file/{uuid}
-func (c *Client) FileDelete(ctx context.Context, params FileDeleteParams) error {
-	_, err := c.sendFileDelete(ctx, params)
-	return err
+// POST /pipeline/{uuid}/run
+func (c *Client) PipelineRun(ctx context.Context, request OptPipelineRun, params PipelineRunParams) (*PipelineRun, error) {
+	res, err := c.sendPipelineRun(ctx, request, params)
+	return res, err
 }
 
-func (c *Client) sendFileDelete(ctx context.Context, params FileDeleteParams) (res *FileDeleteOK, err error) {
+func (c *Client) sendPipelineRun(ctx context.Context, request OptPipelineRun, params PipelineRunParams) (res *PipelineRun, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("file-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/file/{uuid}"),
+		otelogen.OperationID("pipeline-run"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/pipeline/{uuid}/run"),
 	}
 
 	// Run stopwatch.
@@ -5414,7 +12588,7 @@ func (c *Client) sendFileDelete(ctx context.Context, params FileDeleteParams) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, FileDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PipelineRunOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5431,8 +12605,8 @@ func (c *Client) sendFileDelete(ctx context.Context, params FileDeleteParams) (r
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/file/"
+	var pathParts [3]string
+	pathParts[0] = "/pipeline/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -5441,7 +12615,7 @@ func (c *Client) sendFileDelete(ctx context.Context, params FileDeleteParams) (r
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
+			return e.EncodeValue(conv.UUIDToString(params.UUID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -5451,20 +12625,24 @@ func (c *Client) sendFileDelete(ctx context.Context, params FileDeleteParams) (r
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/run"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePipelineRunRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, FileDeleteOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, PipelineRunOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5475,7 +12653,7 @@ func (c *Client) sendFileDelete(ctx context.Context, params FileDeleteParams) (r
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, FileDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PipelineRunOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5486,7 +12664,7 @@ func (c *Client) sendFileDelete(ctx context.Context, params FileDeleteParams) (r
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, FileDeleteOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, PipelineRunOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5524,7 +12702,7 @@ func (c *Client) sendFileDelete(ctx context.Context, params FileDeleteParams) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeFileDeleteResponse(resp)
+	result, err := decodePipelineRunResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5532,21 +12710,21 @@ func (c *Client) sendFileDelete(ctx context.Context, params FileDeleteParams) (r
 	return result, nil
 }
 
-// FileGet invokes file-get operation.
+// PipelineUpdate invokes pipeline-update operation.
 //
-// Retrieve details of a stored file.
+// Update an existing pipeline.
 //
-// GET /file/{uuid}
-func (c *Client) FileGet(ctx context.Context, params FileGetParams) (*FileObject, error) {
-	res, err := c.sendFileGet(ctx, params)
+// PUT /pipeline/{uuid}
+func (c *Client) PipelineUpdate(ctx context.Context, request *Pipeline, params PipelineUpdateParams) (*Pipeline, error) {
+	res, err := c.sendPipelineUpdate(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendFileGet(ctx context.Context, params FileGetParams) (res *FileObject, err error) {
+func (c *Client) sendPipelineUpdate(ctx context.Context, request *Pipeline, params PipelineUpdateParams) (res *Pipeline, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("file-get"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/file/{uuid}"),
+		otelogen.OperationID("pipeline-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/pipeline/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -5561,7 +12739,7 @@ func (c *Client) sendFileGet(ctx context.Context, params FileGetParams) (res *Fi
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, FileGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PipelineUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5579,7 +12757,7 @@ func (c *Client) sendFileGet(ctx context.Context, params FileGetParams) (res *Fi
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/file/"
+	pathParts[0] = "/pipeline/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -5588,7 +12766,7 @@ func (c *Client) sendFileGet(ctx context.Context, params FileGetParams) (res *Fi
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
+			return e.EncodeValue(conv.UUIDToString(params.UUID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -5601,17 +12779,20 @@ func (c *Client) sendFileGet(ctx context.Context, params FileGetParams) (res *Fi
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePipelineUpdateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, FileGetOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, PipelineUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5622,7 +12803,7 @@ func (c *Client) sendFileGet(ctx context.Context, params FileGetParams) (res *Fi
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, FileGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PipelineUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5633,7 +12814,7 @@ func (c *Client) sendFileGet(ctx context.Context, params FileGetParams) (res *Fi
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, FileGetOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, PipelineUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5671,7 +12852,7 @@ func (c *Client) sendFileGet(ctx context.Context, params FileGetParams) (res *Fi
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeFileGetResponse(resp)
+	result, err := decodePipelineUpdateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5679,21 +12860,21 @@ func (c *Client) sendFileGet(ctx context.Context, params FileGetParams) (res *Fi
 	return result, nil
 }
 
-// FileList invokes file-list operation.
+// PolicyCreate invokes policy-create operation.
 //
-// Retrieve a list of stored files.
+// Create a new access policy. Requires a system administrator.
 //
-// GET /file
-func (c *Client) FileList(ctx context.Context, params FileListParams) ([]FileObject, error) {
-	res, err := c.sendFileList(ctx, params)
+// POST /policy
+func (c *Client) PolicyCreate(ctx context.Context, request *Policy) (*Policy, error) {
+	res, err := c.sendPolicyCreate(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendFileList(ctx context.Context, params FileListParams) (res []FileObject, err error) {
+func (c *Client) sendPolicyCreate(ctx context.Context, request *Policy) (res *Policy, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("file-list"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/file"),
+		otelogen.OperationID("policy-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/policy"),
 	}
 
 	// Run stopwatch.
@@ -5708,7 +12889,7 @@ func (c *Client) sendFileList(ctx context.Context, params FileListParams) (res [
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, FileListOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PolicyCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5726,59 +12907,24 @@ func (c *Client) sendFileList(ctx context.Context, params FileListParams) (res [
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/file"
+	pathParts[0] = "/policy"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "offset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Offset.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePolicyCreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, FileListOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, PolicyCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5789,7 +12935,7 @@ func (c *Client) sendFileList(ctx context.Context, params FileListParams) (res [
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, FileListOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PolicyCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5800,7 +12946,7 @@ func (c *Client) sendFileList(ctx context.Context, params FileListParams) (res [
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, FileListOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, PolicyCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5838,7 +12984,7 @@ func (c *Client) sendFileList(ctx context.Context, params FileListParams) (res [
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeFileListResponse(resp)
+	result, err := decodePolicyCreateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5846,21 +12992,22 @@ func (c *Client) sendFileList(ctx context.Context, params FileListParams) (res [
 	return result, nil
 }
 
-// FileUpdate invokes file-update operation.
+// PolicyDelete invokes policy-delete operation.
 //
-// Update metadata of a stored file.
+// Delete an access policy by id. Built-in policies cannot be deleted. Requires a system
+// administrator.
 //
-// PUT /file/{uuid}
-func (c *Client) FileUpdate(ctx context.Context, request *FileUpdateReq, params FileUpdateParams) (*FileObject, error) {
-	res, err := c.sendFileUpdate(ctx, request, params)
-	return res, err
+// DELETE /policy/{id}
+func (c *Client) PolicyDelete(ctx context.Context, params PolicyDeleteParams) error {
+	_, err := c.sendPolicyDelete(ctx, params)
+	return err
 }
 
-func (c *Client) sendFileUpdate(ctx context.Context, request *FileUpdateReq, params FileUpdateParams) (res *FileObject, err error) {
+func (c *Client) sendPolicyDelete(ctx context.Context, params PolicyDeleteParams) (res *PolicyDeleteOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("file-update"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/file/{uuid}"),
+		otelogen.OperationID("policy-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/policy/{id}"),
 	}
 
 	// Run stopwatch.
@@ -5875,7 +13022,7 @@ func (c *Client) sendFileUpdate(ctx context.Context, request *FileUpdateReq, par
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, FileUpdateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PolicyDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5893,16 +13040,16 @@ func (c *Client) sendFileUpdate(ctx context.Context, request *FileUpdateReq, par
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/file/"
+	pathParts[0] = "/policy/"
 	{
-		// Encode "uuid" parameter.
+		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
+			Param:   "id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
+			return e.EncodeValue(conv.StringToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -5915,20 +13062,17 @@ func (c *Client) sendFileUpdate(ctx context.Context, request *FileUpdateReq, par
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeFileUpdateRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, FileUpdateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, PolicyDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5939,7 +13083,7 @@ func (c *Client) sendFileUpdate(ctx context.Context, request *FileUpdateReq, par
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, FileUpdateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PolicyDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5950,7 +13094,7 @@ func (c *Client) sendFileUpdate(ctx context.Context, request *FileUpdateReq, par
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, FileUpdateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, PolicyDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5988,7 +13132,7 @@ func (c *Client) sendFileUpdate(ctx context.Context, request *FileUpdateReq, par
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeFileUpdateResponse(resp)
+	result, err := decodePolicyDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5996,21 +13140,21 @@ func (c *Client) sendFileUpdate(ctx context.Context, request *FileUpdateReq, par
 	return result, nil
 }
 
-// GenerateDownloadLink invokes generateDownloadLink operation.
+// PolicyGet invokes policy-get operation.
 //
-// Generate a download link for a stored file.
+// Retrieve an access policy by id.
 //
-// POST /storage/file-link
-func (c *Client) GenerateDownloadLink(ctx context.Context, request *GenerateDownloadLinkRequest) (*GenerateDownloadLinkResponse, error) {
-	res, err := c.sendGenerateDownloadLink(ctx, request)
+// GET /policy/{id}
+func (c *Client) PolicyGet(ctx context.Context, params PolicyGetParams) (*Policy, error) {
+	res, err := c.sendPolicyGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGenerateDownloadLink(ctx context.Context, request *GenerateDownloadLinkRequest) (res *GenerateDownloadLinkResponse, err error) {
+func (c *Client) sendPolicyGet(ctx context.Context, params PolicyGetParams) (res *Policy, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("generateDownloadLink"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/storage/file-link"),
+		otelogen.OperationID("policy-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/policy/{id}"),
 	}
 
 	// Run stopwatch.
@@ -6025,7 +13169,7 @@ func (c *Client) sendGenerateDownloadLink(ctx context.Context, request *Generate
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GenerateDownloadLinkOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PolicyGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6042,25 +13186,40 @@ func (c *Client) sendGenerateDownloadLink(ctx context.Context, request *Generate
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/storage/file-link"
+	var pathParts [2]string
+	pathParts[0] = "/policy/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeGenerateDownloadLinkRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, GenerateDownloadLinkOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, PolicyGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6071,7 +13230,7 @@ func (c *Client) sendGenerateDownloadLink(ctx context.Context, request *Generate
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GenerateDownloadLinkOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PolicyGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6082,7 +13241,7 @@ func (c *Client) sendGenerateDownloadLink(ctx context.Context, request *Generate
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, GenerateDownloadLinkOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, PolicyGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6120,7 +13279,7 @@ func (c *Client) sendGenerateDownloadLink(ctx context.Context, request *Generate
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGenerateDownloadLinkResponse(resp)
+	result, err := decodePolicyGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6128,21 +13287,21 @@ func (c *Client) sendGenerateDownloadLink(ctx context.Context, request *Generate
 	return result, nil
 }
 
-// GeneratePresignedUploadUrl invokes generatePresignedUploadUrl operation.
+// PolicyList invokes policy-list operation.
 //
-// Generate a pre-signed URL for file upload.
+// Retrieve a list of access policies.
 //
-// POST /storage/upload-url
-func (c *Client) GeneratePresignedUploadUrl(ctx context.Context, request *UploadPresignedUrlRequest) (*UploadPresignedUrlResponse, error) {
-	res, err := c.sendGeneratePresignedUploadUrl(ctx, request)
+// GET /policy
+func (c *Client) PolicyList(ctx context.Context, params PolicyListParams) ([]Policy, error) {
+	res, err := c.sendPolicyList(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGeneratePresignedUploadUrl(ctx context.Context, request *UploadPresignedUrlRequest) (res *UploadPresignedUrlResponse, err error) {
+func (c *Client) sendPolicyList(ctx context.Context, params PolicyListParams) (res []Policy, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("generatePresignedUploadUrl"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/storage/upload-url"),
+		otelogen.OperationID("policy-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/policy"),
 	}
 
 	// Run stopwatch.
@@ -6157,7 +13316,7 @@ func (c *Client) sendGeneratePresignedUploadUrl(ctx context.Context, request *Up
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GeneratePresignedUploadUrlOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PolicyListOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6175,24 +13334,59 @@ func (c *Client) sendGeneratePresignedUploadUrl(ctx context.Context, request *Up
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/storage/upload-url"
+	pathParts[0] = "/policy"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeGeneratePresignedUploadUrlRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, GeneratePresignedUploadUrlOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, PolicyListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6203,7 +13397,7 @@ func (c *Client) sendGeneratePresignedUploadUrl(ctx context.Context, request *Up
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GeneratePresignedUploadUrlOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PolicyListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6214,7 +13408,7 @@ func (c *Client) sendGeneratePresignedUploadUrl(ctx context.Context, request *Up
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, GeneratePresignedUploadUrlOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, PolicyListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6252,7 +13446,7 @@ func (c *Client) sendGeneratePresignedUploadUrl(ctx context.Context, request *Up
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGeneratePresignedUploadUrlResponse(resp)
+	result, err := decodePolicyListResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6260,21 +13454,21 @@ func (c *Client) sendGeneratePresignedUploadUrl(ctx context.Context, request *Up
 	return result, nil
 }
 
-// GetContact invokes getContact operation.
+// PolicyUpdate invokes policy-update operation.
 //
-// Get contact details.
+// Update an access policy by id. Requires a system administrator.
 //
-// GET /contact/{uuid}
-func (c *Client) GetContact(ctx context.Context, params GetContactParams) (*Contact, error) {
-	res, err := c.sendGetContact(ctx, params)
+// PUT /policy/{id}
+func (c *Client) PolicyUpdate(ctx context.Context, request *Policy, params PolicyUpdateParams) (*Policy, error) {
+	res, err := c.sendPolicyUpdate(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGetContact(ctx context.Context, params GetContactParams) (res *Contact, err error) {
+func (c *Client) sendPolicyUpdate(ctx context.Context, request *Policy, params PolicyUpdateParams) (res *Policy, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getContact"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/contact/{uuid}"),
+		otelogen.OperationID("policy-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/policy/{id}"),
 	}
 
 	// Run stopwatch.
@@ -6289,7 +13483,7 @@ func (c *Client) sendGetContact(ctx context.Context, params GetContactParams) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetContactOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PolicyUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6307,16 +13501,16 @@ func (c *Client) sendGetContact(ctx context.Context, params GetContactParams) (r
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/contact/"
+	pathParts[0] = "/policy/"
 	{
-		// Encode "uuid" parameter.
+		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
+			Param:   "id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
+			return e.EncodeValue(conv.StringToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -6329,17 +13523,20 @@ func (c *Client) sendGetContact(ctx context.Context, params GetContactParams) (r
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePolicyUpdateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, GetContactOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, PolicyUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6350,7 +13547,7 @@ func (c *Client) sendGetContact(ctx context.Context, params GetContactParams) (r
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetContactOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PolicyUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6361,7 +13558,7 @@ func (c *Client) sendGetContact(ctx context.Context, params GetContactParams) (r
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, GetContactOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, PolicyUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6399,7 +13596,7 @@ func (c *Client) sendGetContact(ctx context.Context, params GetContactParams) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetContactResponse(resp)
+	result, err := decodePolicyUpdateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6407,21 +13604,21 @@ func (c *Client) sendGetContact(ctx context.Context, params GetContactParams) (r
 	return result, nil
 }
 
-// GetProfile invokes getProfile operation.
+// RetentionPolicyCreate invokes retention-policy-create operation.
 //
-// Get current user profile.
+// Create a new retention policy.
 //
-// GET /profile
-func (c *Client) GetProfile(ctx context.Context) (*User, error) {
-	res, err := c.sendGetProfile(ctx)
+// POST /retention
+func (c *Client) RetentionPolicyCreate(ctx context.Context, request *RetentionPolicy) (*RetentionPolicy, error) {
+	res, err := c.sendRetentionPolicyCreate(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetProfile(ctx context.Context) (res *User, err error) {
+func (c *Client) sendRetentionPolicyCreate(ctx context.Context, request *RetentionPolicy) (res *RetentionPolicy, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getProfile"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/profile"),
+		otelogen.OperationID("retention-policy-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/retention"),
 	}
 
 	// Run stopwatch.
@@ -6436,7 +13633,7 @@ func (c *Client) sendGetProfile(ctx context.Context) (res *User, err error) {
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetProfileOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RetentionPolicyCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6454,21 +13651,24 @@ func (c *Client) sendGetProfile(ctx context.Context) (res *User, err error) {
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/profile"
+	pathParts[0] = "/retention"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeRetentionPolicyCreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, GetProfileOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, RetentionPolicyCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6479,7 +13679,7 @@ func (c *Client) sendGetProfile(ctx context.Context) (res *User, err error) {
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetProfileOperation, r); {
+			switch err := c.securityBearerAuth(ctx, RetentionPolicyCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6490,7 +13690,7 @@ func (c *Client) sendGetProfile(ctx context.Context) (res *User, err error) {
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, GetProfileOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, RetentionPolicyCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6528,7 +13728,7 @@ func (c *Client) sendGetProfile(ctx context.Context) (res *User, err error) {
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetProfileResponse(resp)
+	result, err := decodeRetentionPolicyCreateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6536,21 +13736,21 @@ func (c *Client) sendGetProfile(ctx context.Context) (res *User, err error) {
 	return result, nil
 }
 
-// GetUser invokes getUser operation.
+// RetentionPolicyDelete invokes retention-policy-delete operation.
 //
-// Get user details.
+// Delete a retention policy by UUID.
 //
-// GET /user/{uuid}
-func (c *Client) GetUser(ctx context.Context, params GetUserParams) (*User, error) {
-	res, err := c.sendGetUser(ctx, params)
-	return res, err
+// DELETE /retention/{uuid}
+func (c *Client) RetentionPolicyDelete(ctx context.Context, params RetentionPolicyDeleteParams) error {
+	_, err := c.sendRetentionPolicyDelete(ctx, params)
+	return err
 }
 
-func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *User, err error) {
+func (c *Client) sendRetentionPolicyDelete(ctx context.Context, params RetentionPolicyDeleteParams) (res *RetentionPolicyDeleteOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUser"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/user/{uuid}"),
+		otelogen.OperationID("retention-policy-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/retention/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -6565,7 +13765,7 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *Us
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetUserOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RetentionPolicyDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6583,7 +13783,7 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *Us
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/user/"
+	pathParts[0] = "/retention/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -6605,7 +13805,7 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *Us
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -6615,7 +13815,7 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *Us
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, GetUserOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, RetentionPolicyDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6626,7 +13826,7 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *Us
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetUserOperation, r); {
+			switch err := c.securityBearerAuth(ctx, RetentionPolicyDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6637,7 +13837,7 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *Us
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, GetUserOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, RetentionPolicyDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6675,7 +13875,7 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *Us
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetUserResponse(resp)
+	result, err := decodeRetentionPolicyDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6683,21 +13883,21 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *Us
 	return result, nil
 }
 
-// ListContacts invokes listContacts operation.
+// RetentionPolicyGet invokes retention-policy-get operation.
 //
-// List all contacts.
+// Retrieve a retention policy by UUID.
 //
-// GET /contact
-func (c *Client) ListContacts(ctx context.Context) ([]Contact, error) {
-	res, err := c.sendListContacts(ctx)
+// GET /retention/{uuid}
+func (c *Client) RetentionPolicyGet(ctx context.Context, params RetentionPolicyGetParams) (*RetentionPolicy, error) {
+	res, err := c.sendRetentionPolicyGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListContacts(ctx context.Context) (res []Contact, err error) {
+func (c *Client) sendRetentionPolicyGet(ctx context.Context, params RetentionPolicyGetParams) (res *RetentionPolicy, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listContacts"),
+		otelogen.OperationID("retention-policy-get"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/contact"),
+		semconv.HTTPRouteKey.String("/retention/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -6712,7 +13912,7 @@ func (c *Client) sendListContacts(ctx context.Context) (res []Contact, err error
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListContactsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RetentionPolicyGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6729,8 +13929,26 @@ func (c *Client) sendListContacts(ctx context.Context) (res []Contact, err error
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/contact"
+	var pathParts [2]string
+	pathParts[0] = "/retention/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -6744,7 +13962,7 @@ func (c *Client) sendListContacts(ctx context.Context) (res []Contact, err error
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, ListContactsOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, RetentionPolicyGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6755,7 +13973,7 @@ func (c *Client) sendListContacts(ctx context.Context) (res []Contact, err error
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListContactsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, RetentionPolicyGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6766,7 +13984,7 @@ func (c *Client) sendListContacts(ctx context.Context) (res []Contact, err error
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, ListContactsOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, RetentionPolicyGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6804,7 +14022,7 @@ func (c *Client) sendListContacts(ctx context.Context) (res []Contact, err error
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListContactsResponse(resp)
+	result, err := decodeRetentionPolicyGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6812,21 +14030,21 @@ func (c *Client) sendListContacts(ctx context.Context) (res []Contact, err error
 	return result, nil
 }
 
-// ListUsers invokes listUsers operation.
+// RetentionPolicyList invokes retention-policy-list operation.
 //
-// List all users.
+// Retrieve a list of retention policies.
 //
-// GET /user
-func (c *Client) ListUsers(ctx context.Context) ([]User, error) {
-	res, err := c.sendListUsers(ctx)
+// GET /retention
+func (c *Client) RetentionPolicyList(ctx context.Context, params RetentionPolicyListParams) ([]RetentionPolicy, error) {
+	res, err := c.sendRetentionPolicyList(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListUsers(ctx context.Context) (res []User, err error) {
+func (c *Client) sendRetentionPolicyList(ctx context.Context, params RetentionPolicyListParams) (res []RetentionPolicy, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listUsers"),
+		otelogen.OperationID("retention-policy-list"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/user"),
+		semconv.HTTPRouteKey.String("/retention"),
 	}
 
 	// Run stopwatch.
@@ -6841,7 +14059,7 @@ func (c *Client) sendListUsers(ctx context.Context) (res []User, err error) {
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListUsersOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RetentionPolicyListOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6859,9 +14077,47 @@ func (c *Client) sendListUsers(ctx context.Context) (res []User, err error) {
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/user"
+	pathParts[0] = "/retention"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -6873,7 +14129,7 @@ func (c *Client) sendListUsers(ctx context.Context) (res []User, err error) {
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, ListUsersOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, RetentionPolicyListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6884,7 +14140,7 @@ func (c *Client) sendListUsers(ctx context.Context) (res []User, err error) {
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListUsersOperation, r); {
+			switch err := c.securityBearerAuth(ctx, RetentionPolicyListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6895,7 +14151,7 @@ func (c *Client) sendListUsers(ctx context.Context) (res []User, err error) {
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, ListUsersOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, RetentionPolicyListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6933,7 +14189,7 @@ func (c *Client) sendListUsers(ctx context.Context) (res []User, err error) {
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListUsersResponse(resp)
+	result, err := decodeRetentionPolicyListResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6941,21 +14197,21 @@ func (c *Client) sendListUsers(ctx context.Context) (res []User, err error) {
 	return result, nil
 }
 
-// MessageEmailQuery invokes messageEmailQuery operation.
+// RetentionPolicyRun invokes retention-policy-run operation.
 //
-// Execute a search query on email messages.
+// Apply a retention policy now instead of waiting for the scheduled retention job.
 //
-// POST /message/email/query
-func (c *Client) MessageEmailQuery(ctx context.Context, request *MessageQuery) (*MessageEmailQueryOK, error) {
-	res, err := c.sendMessageEmailQuery(ctx, request)
-	return res, err
+// POST /retention/{uuid}/run
+func (c *Client) RetentionPolicyRun(ctx context.Context, params RetentionPolicyRunParams) error {
+	_, err := c.sendRetentionPolicyRun(ctx, params)
+	return err
 }
 
-func (c *Client) sendMessageEmailQuery(ctx context.Context, request *MessageQuery) (res *MessageEmailQueryOK, err error) {
+func (c *Client) sendRetentionPolicyRun(ctx context.Context, params RetentionPolicyRunParams) (res *RetentionPolicyRunAccepted, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("messageEmailQuery"),
+		otelogen.OperationID("retention-policy-run"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/message/email/query"),
+		semconv.HTTPRouteKey.String("/retention/{uuid}/run"),
 	}
 
 	// Run stopwatch.
@@ -6970,7 +14226,7 @@ func (c *Client) sendMessageEmailQuery(ctx context.Context, request *MessageQuer
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, MessageEmailQueryOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RetentionPolicyRunOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6987,8 +14243,27 @@ func (c *Client) sendMessageEmailQuery(ctx context.Context, request *MessageQuer
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/message/email/query"
+	var pathParts [3]string
+	pathParts[0] = "/retention/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/run"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -6996,16 +14271,13 @@ func (c *Client) sendMessageEmailQuery(ctx context.Context, request *MessageQuer
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeMessageEmailQueryRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, MessageEmailQueryOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, RetentionPolicyRunOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7016,7 +14288,7 @@ func (c *Client) sendMessageEmailQuery(ctx context.Context, request *MessageQuer
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, MessageEmailQueryOperation, r); {
+			switch err := c.securityBearerAuth(ctx, RetentionPolicyRunOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7027,7 +14299,7 @@ func (c *Client) sendMessageEmailQuery(ctx context.Context, request *MessageQuer
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, MessageEmailQueryOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, RetentionPolicyRunOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7065,7 +14337,7 @@ func (c *Client) sendMessageEmailQuery(ctx context.Context, request *MessageQuer
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeMessageEmailQueryResponse(resp)
+	result, err := decodeRetentionPolicyRunResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7073,21 +14345,21 @@ func (c *Client) sendMessageEmailQuery(ctx context.Context, request *MessageQuer
 	return result, nil
 }
 
-// MessageLinkedinQuery invokes messageLinkedinQuery operation.
+// RetentionPolicyUpdate invokes retention-policy-update operation.
 //
-// Execute a search query on LinkedIn messages.
+// Update a retention policy by UUID.
 //
-// POST /message/linkedin/query
-func (c *Client) MessageLinkedinQuery(ctx context.Context, request *MessageQuery) (*MessageLinkedinQueryOK, error) {
-	res, err := c.sendMessageLinkedinQuery(ctx, request)
+// PUT /retention/{uuid}
+func (c *Client) RetentionPolicyUpdate(ctx context.Context, request *RetentionPolicy, params RetentionPolicyUpdateParams) (*RetentionPolicy, error) {
+	res, err := c.sendRetentionPolicyUpdate(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendMessageLinkedinQuery(ctx context.Context, request *MessageQuery) (res *MessageLinkedinQueryOK, err error) {
+func (c *Client) sendRetentionPolicyUpdate(ctx context.Context, request *RetentionPolicy, params RetentionPolicyUpdateParams) (res *RetentionPolicy, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("messageLinkedinQuery"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/message/linkedin/query"),
+		otelogen.OperationID("retention-policy-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/retention/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -7102,7 +14374,7 @@ func (c *Client) sendMessageLinkedinQuery(ctx context.Context, request *MessageQ
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, MessageLinkedinQueryOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RetentionPolicyUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7119,16 +14391,34 @@ func (c *Client) sendMessageLinkedinQuery(ctx context.Context, request *MessageQ
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/message/linkedin/query"
+	var pathParts [2]string
+	pathParts[0] = "/retention/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeMessageLinkedinQueryRequest(request, r); err != nil {
+	if err := encodeRetentionPolicyUpdateRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -7137,7 +14427,7 @@ func (c *Client) sendMessageLinkedinQuery(ctx context.Context, request *MessageQ
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, MessageLinkedinQueryOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, RetentionPolicyUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7148,7 +14438,7 @@ func (c *Client) sendMessageLinkedinQuery(ctx context.Context, request *MessageQ
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, MessageLinkedinQueryOperation, r); {
+			switch err := c.securityBearerAuth(ctx, RetentionPolicyUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7159,7 +14449,7 @@ func (c *Client) sendMessageLinkedinQuery(ctx context.Context, request *MessageQ
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, MessageLinkedinQueryOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, RetentionPolicyUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7197,7 +14487,7 @@ func (c *Client) sendMessageLinkedinQuery(ctx context.Context, request *MessageQ
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeMessageLinkedinQueryResponse(resp)
+	result, err := decodeRetentionPolicyUpdateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7205,21 +14495,21 @@ func (c *Client) sendMessageLinkedinQuery(ctx context.Context, request *MessageQ
 	return result, nil
 }
 
-// MessageQuery invokes messageQuery operation.
+// SchedulerCreate invokes scheduler-create operation.
 //
-// Execute a search query on unified messages.
+// Create scheduler.
 //
-// POST /message/query
-func (c *Client) MessageQuery(ctx context.Context, request *MessageQuery) (*MessageQueryOK, error) {
-	res, err := c.sendMessageQuery(ctx, request)
+// POST /scheduler
+func (c *Client) SchedulerCreate(ctx context.Context, request *Scheduler) (*Scheduler, error) {
+	res, err := c.sendSchedulerCreate(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendMessageQuery(ctx context.Context, request *MessageQuery) (res *MessageQueryOK, err error) {
+func (c *Client) sendSchedulerCreate(ctx context.Context, request *Scheduler) (res *Scheduler, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("messageQuery"),
+		otelogen.OperationID("scheduler-create"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/message/query"),
+		semconv.HTTPRouteKey.String("/scheduler"),
 	}
 
 	// Run stopwatch.
@@ -7234,7 +14524,7 @@ func (c *Client) sendMessageQuery(ctx context.Context, request *MessageQuery) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, MessageQueryOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SchedulerCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7252,7 +14542,7 @@ func (c *Client) sendMessageQuery(ctx context.Context, request *MessageQuery) (r
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/message/query"
+	pathParts[0] = "/scheduler"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -7260,7 +14550,7 @@ func (c *Client) sendMessageQuery(ctx context.Context, request *MessageQuery) (r
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeMessageQueryRequest(request, r); err != nil {
+	if err := encodeSchedulerCreateRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -7269,7 +14559,7 @@ func (c *Client) sendMessageQuery(ctx context.Context, request *MessageQuery) (r
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, MessageQueryOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, SchedulerCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7280,7 +14570,7 @@ func (c *Client) sendMessageQuery(ctx context.Context, request *MessageQuery) (r
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, MessageQueryOperation, r); {
+			switch err := c.securityBearerAuth(ctx, SchedulerCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7291,7 +14581,7 @@ func (c *Client) sendMessageQuery(ctx context.Context, request *MessageQuery) (r
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, MessageQueryOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, SchedulerCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7329,7 +14619,7 @@ func (c *Client) sendMessageQuery(ctx context.Context, request *MessageQuery) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeMessageQueryResponse(resp)
+	result, err := decodeSchedulerCreateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7337,21 +14627,21 @@ func (c *Client) sendMessageQuery(ctx context.Context, request *MessageQuery) (r
 	return result, nil
 }
 
-// MessageTelegramQuery invokes messageTelegramQuery operation.
+// SchedulerDelete invokes scheduler-delete operation.
 //
-// Execute a search query on Telegram messages.
+// Delete scheduler.
 //
-// POST /message/telegram/query
-func (c *Client) MessageTelegramQuery(ctx context.Context, request *MessageQuery) (*MessageTelegramQueryOK, error) {
-	res, err := c.sendMessageTelegramQuery(ctx, request)
-	return res, err
+// DELETE /scheduler/{uuid}
+func (c *Client) SchedulerDelete(ctx context.Context, params SchedulerDeleteParams) error {
+	_, err := c.sendSchedulerDelete(ctx, params)
+	return err
 }
 
-func (c *Client) sendMessageTelegramQuery(ctx context.Context, request *MessageQuery) (res *MessageTelegramQueryOK, err error) {
+func (c *Client) sendSchedulerDelete(ctx context.Context, params SchedulerDeleteParams) (res *SchedulerDeleteOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("messageTelegramQuery"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/message/telegram/query"),
+		otelogen.OperationID("scheduler-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/scheduler/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -7366,7 +14656,7 @@ func (c *Client) sendMessageTelegramQuery(ctx context.Context, request *MessageQ
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, MessageTelegramQueryOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SchedulerDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7383,25 +14673,40 @@ func (c *Client) sendMessageTelegramQuery(ctx context.Context, request *MessageQ
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/message/telegram/query"
+	var pathParts [2]string
+	pathParts[0] = "/scheduler/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeMessageTelegramQueryRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, MessageTelegramQueryOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, SchedulerDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7412,7 +14717,7 @@ func (c *Client) sendMessageTelegramQuery(ctx context.Context, request *MessageQ
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, MessageTelegramQueryOperation, r); {
+			switch err := c.securityBearerAuth(ctx, SchedulerDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7423,7 +14728,7 @@ func (c *Client) sendMessageTelegramQuery(ctx context.Context, request *MessageQ
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, MessageTelegramQueryOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, SchedulerDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7461,7 +14766,7 @@ func (c *Client) sendMessageTelegramQuery(ctx context.Context, request *MessageQ
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeMessageTelegramQueryResponse(resp)
+	result, err := decodeSchedulerDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7469,21 +14774,21 @@ func (c *Client) sendMessageTelegramQuery(ctx context.Context, request *MessageQ
 	return result, nil
 }
 
-// MessageWhatsappQuery invokes messageWhatsappQuery operation.
+// SchedulerGet invokes scheduler-get operation.
 //
-// Execute a search query on WhatsApp messages.
+// Get scheduler by UUID.
 //
-// POST /message/whatsapp/query
-func (c *Client) MessageWhatsappQuery(ctx context.Context, request *MessageQuery) (*MessageWhatsappQueryOK, error) {
-	res, err := c.sendMessageWhatsappQuery(ctx, request)
+// GET /scheduler/{uuid}
+func (c *Client) SchedulerGet(ctx context.Context, params SchedulerGetParams) (*Scheduler, error) {
+	res, err := c.sendSchedulerGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendMessageWhatsappQuery(ctx context.Context, request *MessageQuery) (res *MessageWhatsappQueryOK, err error) {
+func (c *Client) sendSchedulerGet(ctx context.Context, params SchedulerGetParams) (res *Scheduler, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("messageWhatsappQuery"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/message/whatsapp/query"),
+		otelogen.OperationID("scheduler-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/scheduler/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -7498,7 +14803,7 @@ func (c *Client) sendMessageWhatsappQuery(ctx context.Context, request *MessageQ
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, MessageWhatsappQueryOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SchedulerGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7515,25 +14820,40 @@ func (c *Client) sendMessageWhatsappQuery(ctx context.Context, request *MessageQ
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/message/whatsapp/query"
+	var pathParts [2]string
+	pathParts[0] = "/scheduler/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeMessageWhatsappQueryRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, MessageWhatsappQueryOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, SchedulerGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7544,7 +14864,7 @@ func (c *Client) sendMessageWhatsappQuery(ctx context.Context, request *MessageQ
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, MessageWhatsappQueryOperation, r); {
+			switch err := c.securityBearerAuth(ctx, SchedulerGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7555,7 +14875,7 @@ func (c *Client) sendMessageWhatsappQuery(ctx context.Context, request *MessageQ
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, MessageWhatsappQueryOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, SchedulerGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7593,7 +14913,7 @@ func (c *Client) sendMessageWhatsappQuery(ctx context.Context, request *MessageQ
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeMessageWhatsappQueryResponse(resp)
+	result, err := decodeSchedulerGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7601,21 +14921,21 @@ func (c *Client) sendMessageWhatsappQuery(ctx context.Context, request *MessageQ
 	return result, nil
 }
 
-// OAuth2ClientCallback invokes oauth2-client-callback operation.
+// SchedulerList invokes scheduler-list operation.
 //
-// Serve OAuth2 client callback.
+// Retrieve all schedulers for the authenticated user.
 //
-// GET /oauth2/callback
-func (c *Client) OAuth2ClientCallback(ctx context.Context, params OAuth2ClientCallbackParams) (*OAuth2ClientCallbackFound, error) {
-	res, err := c.sendOAuth2ClientCallback(ctx, params)
+// GET /scheduler
+func (c *Client) SchedulerList(ctx context.Context, params SchedulerListParams) ([]Scheduler, error) {
+	res, err := c.sendSchedulerList(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendOAuth2ClientCallback(ctx context.Context, params OAuth2ClientCallbackParams) (res *OAuth2ClientCallbackFound, err error) {
+func (c *Client) sendSchedulerList(ctx context.Context, params SchedulerListParams) (res []Scheduler, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("oauth2-client-callback"),
+		otelogen.OperationID("scheduler-list"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/oauth2/callback"),
+		semconv.HTTPRouteKey.String("/scheduler"),
 	}
 
 	// Run stopwatch.
@@ -7630,7 +14950,7 @@ func (c *Client) sendOAuth2ClientCallback(ctx context.Context, params OAuth2Clie
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, OAuth2ClientCallbackOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SchedulerListOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7648,22 +14968,22 @@ func (c *Client) sendOAuth2ClientCallback(ctx context.Context, params OAuth2Clie
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/oauth2/callback"
+	pathParts[0] = "/scheduler"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "state" parameter.
+		// Encode "pipeline_uuid" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "state",
+			Name:    "pipeline_uuid",
 			Style:   uri.QueryStyleForm,
-			Explode: false,
+			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.State.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+			if val, ok := params.PipelineUUID.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -7671,16 +14991,33 @@ func (c *Client) sendOAuth2ClientCallback(ctx context.Context, params OAuth2Clie
 		}
 	}
 	{
-		// Encode "code" parameter.
+		// Encode "offset" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "code",
+			Name:    "offset",
 			Style:   uri.QueryStyleForm,
-			Explode: false,
+			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Code.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -7695,6 +15032,63 @@ func (c *Client) sendOAuth2ClientCallback(ctx context.Context, params OAuth2Clie
 		return res, errors.Wrap(err, "create request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, SchedulerListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, SchedulerListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, SchedulerListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -7703,7 +15097,7 @@ func (c *Client) sendOAuth2ClientCallback(ctx context.Context, params OAuth2Clie
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeOAuth2ClientCallbackResponse(resp)
+	result, err := decodeSchedulerListResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7711,21 +15105,21 @@ func (c *Client) sendOAuth2ClientCallback(ctx context.Context, params OAuth2Clie
 	return result, nil
 }
 
-// OAuth2ClientCreate invokes oauth2-client-create operation.
+// SchedulerUpdate invokes scheduler-update operation.
 //
-// Create OAuth2 client.
+// Update scheduler.
 //
-// POST /oauth2/client
-func (c *Client) OAuth2ClientCreate(ctx context.Context, request *OAuth2ClientCreateReq) (*OAuth2Client, error) {
-	res, err := c.sendOAuth2ClientCreate(ctx, request)
+// PUT /scheduler/{uuid}
+func (c *Client) SchedulerUpdate(ctx context.Context, request *Scheduler, params SchedulerUpdateParams) (*Scheduler, error) {
+	res, err := c.sendSchedulerUpdate(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendOAuth2ClientCreate(ctx context.Context, request *OAuth2ClientCreateReq) (res *OAuth2Client, err error) {
+func (c *Client) sendSchedulerUpdate(ctx context.Context, request *Scheduler, params SchedulerUpdateParams) (res *Scheduler, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("oauth2-client-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/oauth2/client"),
+		otelogen.OperationID("scheduler-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/scheduler/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -7740,7 +15134,7 @@ func (c *Client) sendOAuth2ClientCreate(ctx context.Context, request *OAuth2Clie
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, OAuth2ClientCreateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SchedulerUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7757,16 +15151,34 @@ func (c *Client) sendOAuth2ClientCreate(ctx context.Context, request *OAuth2Clie
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/oauth2/client"
+	var pathParts [2]string
+	pathParts[0] = "/scheduler/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeOAuth2ClientCreateRequest(request, r); err != nil {
+	if err := encodeSchedulerUpdateRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -7775,7 +15187,7 @@ func (c *Client) sendOAuth2ClientCreate(ctx context.Context, request *OAuth2Clie
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, OAuth2ClientCreateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, SchedulerUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7786,7 +15198,7 @@ func (c *Client) sendOAuth2ClientCreate(ctx context.Context, request *OAuth2Clie
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, OAuth2ClientCreateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, SchedulerUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7797,7 +15209,7 @@ func (c *Client) sendOAuth2ClientCreate(ctx context.Context, request *OAuth2Clie
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, OAuth2ClientCreateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, SchedulerUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7835,7 +15247,7 @@ func (c *Client) sendOAuth2ClientCreate(ctx context.Context, request *OAuth2Clie
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeOAuth2ClientCreateResponse(resp)
+	result, err := decodeSchedulerUpdateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7843,21 +15255,21 @@ func (c *Client) sendOAuth2ClientCreate(ctx context.Context, request *OAuth2Clie
 	return result, nil
 }
 
-// OAuth2ClientDelete invokes oauth2-client-delete operation.
+// SessionRevoke invokes session-revoke operation.
 //
-// Delete OAuth2 client.
+// Revoke one session of the current user.
 //
-// DELETE /oauth2/client/{uuid}
-func (c *Client) OAuth2ClientDelete(ctx context.Context, params OAuth2ClientDeleteParams) error {
-	_, err := c.sendOAuth2ClientDelete(ctx, params)
+// DELETE /session/{uuid}
+func (c *Client) SessionRevoke(ctx context.Context, params SessionRevokeParams) error {
+	_, err := c.sendSessionRevoke(ctx, params)
 	return err
 }
 
-func (c *Client) sendOAuth2ClientDelete(ctx context.Context, params OAuth2ClientDeleteParams) (res *OAuth2ClientDeleteOK, err error) {
+func (c *Client) sendSessionRevoke(ctx context.Context, params SessionRevokeParams) (res *SessionRevokeOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("oauth2-client-delete"),
+		otelogen.OperationID("session-revoke"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/oauth2/client/{uuid}"),
+		semconv.HTTPRouteKey.String("/session/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -7872,7 +15284,7 @@ func (c *Client) sendOAuth2ClientDelete(ctx context.Context, params OAuth2Client
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, OAuth2ClientDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SessionRevokeOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7890,7 +15302,7 @@ func (c *Client) sendOAuth2ClientDelete(ctx context.Context, params OAuth2Client
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/oauth2/client/"
+	pathParts[0] = "/session/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -7922,7 +15334,7 @@ func (c *Client) sendOAuth2ClientDelete(ctx context.Context, params OAuth2Client
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, OAuth2ClientDeleteOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, SessionRevokeOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7933,7 +15345,7 @@ func (c *Client) sendOAuth2ClientDelete(ctx context.Context, params OAuth2Client
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, OAuth2ClientDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, SessionRevokeOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7944,7 +15356,7 @@ func (c *Client) sendOAuth2ClientDelete(ctx context.Context, params OAuth2Client
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, OAuth2ClientDeleteOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, SessionRevokeOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7982,7 +15394,7 @@ func (c *Client) sendOAuth2ClientDelete(ctx context.Context, params OAuth2Client
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeOAuth2ClientDeleteResponse(resp)
+	result, err := decodeSessionRevokeResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7990,21 +15402,21 @@ func (c *Client) sendOAuth2ClientDelete(ctx context.Context, params OAuth2Client
 	return result, nil
 }
 
-// OAuth2ClientGet invokes oauth2-client-get operation.
+// SessionRevokeAll invokes session-revoke-all operation.
 //
-// Get OAuth2 client details.
+// Log out everywhere by revoking every session of the current user.
 //
-// GET /oauth2/client/{uuid}
-func (c *Client) OAuth2ClientGet(ctx context.Context, params OAuth2ClientGetParams) (*OAuth2Client, error) {
-	res, err := c.sendOAuth2ClientGet(ctx, params)
-	return res, err
+// DELETE /session
+func (c *Client) SessionRevokeAll(ctx context.Context, params SessionRevokeAllParams) error {
+	_, err := c.sendSessionRevokeAll(ctx, params)
+	return err
 }
 
-func (c *Client) sendOAuth2ClientGet(ctx context.Context, params OAuth2ClientGetParams) (res *OAuth2Client, err error) {
+func (c *Client) sendSessionRevokeAll(ctx context.Context, params SessionRevokeAllParams) (res *SessionRevokeAllOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("oauth2-client-get"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/oauth2/client/{uuid}"),
+		otelogen.OperationID("session-revoke-all"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/session"),
 	}
 
 	// Run stopwatch.
@@ -8019,7 +15431,7 @@ func (c *Client) sendOAuth2ClientGet(ctx context.Context, params OAuth2ClientGet
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, OAuth2ClientGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SessionRevokeAllOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8036,30 +15448,33 @@ func (c *Client) sendOAuth2ClientGet(ctx context.Context, params OAuth2ClientGet
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/oauth2/client/"
+	var pathParts [1]string
+	pathParts[0] = "/session"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "uuid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "keep_current" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "keep_current",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.KeepCurrent.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[1] = encoded
 	}
-	uri.AddPathParts(u, pathParts[:]...)
+	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -8069,7 +15484,7 @@ func (c *Client) sendOAuth2ClientGet(ctx context.Context, params OAuth2ClientGet
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, OAuth2ClientGetOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, SessionRevokeAllOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8080,7 +15495,7 @@ func (c *Client) sendOAuth2ClientGet(ctx context.Context, params OAuth2ClientGet
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, OAuth2ClientGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, SessionRevokeAllOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8091,7 +15506,7 @@ func (c *Client) sendOAuth2ClientGet(ctx context.Context, params OAuth2ClientGet
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, OAuth2ClientGetOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, SessionRevokeAllOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8129,7 +15544,7 @@ func (c *Client) sendOAuth2ClientGet(ctx context.Context, params OAuth2ClientGet
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeOAuth2ClientGetResponse(resp)
+	result, err := decodeSessionRevokeAllResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8137,21 +15552,21 @@ func (c *Client) sendOAuth2ClientGet(ctx context.Context, params OAuth2ClientGet
 	return result, nil
 }
 
-// OAuth2ClientList invokes oauth2-client-list operation.
+// SessionStatus invokes session-status operation.
 //
-// List OAuth2 clients.
+// Introspect current session status and list the active sessions of the user.
 //
-// GET /oauth2/client
-func (c *Client) OAuth2ClientList(ctx context.Context, params OAuth2ClientListParams) (*OAuth2ClientListOK, error) {
-	res, err := c.sendOAuth2ClientList(ctx, params)
+// GET /session
+func (c *Client) SessionStatus(ctx context.Context) (*SessionStatus, error) {
+	res, err := c.sendSessionStatus(ctx)
 	return res, err
 }
 
-func (c *Client) sendOAuth2ClientList(ctx context.Context, params OAuth2ClientListParams) (res *OAuth2ClientListOK, err error) {
+func (c *Client) sendSessionStatus(ctx context.Context) (res *SessionStatus, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("oauth2-client-list"),
+		otelogen.OperationID("session-status"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/oauth2/client"),
+		semconv.HTTPRouteKey.String("/session"),
 	}
 
 	// Run stopwatch.
@@ -8166,7 +15581,7 @@ func (c *Client) sendOAuth2ClientList(ctx context.Context, params OAuth2ClientLi
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, OAuth2ClientListOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SessionStatusOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8184,59 +15599,96 @@ func (c *Client) sendOAuth2ClientList(ctx context.Context, params OAuth2ClientLi
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/oauth2/client"
+	pathParts[0] = "/session"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "offset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Offset.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
 	}
-	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
+	defer resp.Body.Close()
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "DecodeResponse"
+	result, err := decodeSessionStatusResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
 	}
-	u.RawQuery = q.Values().Encode()
+
+	return result, nil
+}
+
+// StorageHostfilesCreate invokes storage-hostfiles-create operation.
+//
+// Create a new Host Files storage instance.
+//
+// POST /storage/hostfiles
+func (c *Client) StorageHostfilesCreate(ctx context.Context, request *StorageHostfiles) (*StorageHostfiles, error) {
+	res, err := c.sendStorageHostfilesCreate(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendStorageHostfilesCreate(ctx context.Context, request *StorageHostfiles) (res *StorageHostfiles, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("storage-hostfiles-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/storage/hostfiles"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, StorageHostfilesCreateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/storage/hostfiles"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeStorageHostfilesCreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, OAuth2ClientListOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, StorageHostfilesCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8247,7 +15699,7 @@ func (c *Client) sendOAuth2ClientList(ctx context.Context, params OAuth2ClientLi
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, OAuth2ClientListOperation, r); {
+			switch err := c.securityBearerAuth(ctx, StorageHostfilesCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8258,7 +15710,7 @@ func (c *Client) sendOAuth2ClientList(ctx context.Context, params OAuth2ClientLi
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, OAuth2ClientListOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, StorageHostfilesCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8296,7 +15748,7 @@ func (c *Client) sendOAuth2ClientList(ctx context.Context, params OAuth2ClientLi
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeOAuth2ClientListResponse(resp)
+	result, err := decodeStorageHostfilesCreateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8304,21 +15756,21 @@ func (c *Client) sendOAuth2ClientList(ctx context.Context, params OAuth2ClientLi
 	return result, nil
 }
 
-// OAuth2ClientLogin invokes oauth2-client-login operation.
-//
-// Start OAuth2 login flow.
+// StorageHostfilesDelete invokes storage-hostfiles-delete operation.
 //
-// POST /oauth2/login
-func (c *Client) OAuth2ClientLogin(ctx context.Context, request *OAuth2ClientLoginReq) (*OAuth2ClientLoginOK, error) {
-	res, err := c.sendOAuth2ClientLogin(ctx, request)
-	return res, err
+// Delete a specific Host Files storage instance by UUID.
+//
+// DELETE /storage/hostfiles/{uuid}
+func (c *Client) StorageHostfilesDelete(ctx context.Context, params StorageHostfilesDeleteParams) error {
+	_, err := c.sendStorageHostfilesDelete(ctx, params)
+	return err
 }
 
-func (c *Client) sendOAuth2ClientLogin(ctx context.Context, request *OAuth2ClientLoginReq) (res *OAuth2ClientLoginOK, err error) {
+func (c *Client) sendStorageHostfilesDelete(ctx context.Context, params StorageHostfilesDeleteParams) (res *StorageHostfilesDeleteOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("oauth2-client-login"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/oauth2/login"),
+		otelogen.OperationID("storage-hostfiles-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/storage/hostfiles/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -8333,7 +15785,7 @@ func (c *Client) sendOAuth2ClientLogin(ctx context.Context, request *OAuth2Clien
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, OAuth2ClientLoginOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, StorageHostfilesDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8350,25 +15802,40 @@ func (c *Client) sendOAuth2ClientLogin(ctx context.Context, request *OAuth2Clien
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/oauth2/login"
+	var pathParts [2]string
+	pathParts[0] = "/storage/hostfiles/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeOAuth2ClientLoginRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, OAuth2ClientLoginOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, StorageHostfilesDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8379,7 +15846,7 @@ func (c *Client) sendOAuth2ClientLogin(ctx context.Context, request *OAuth2Clien
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, OAuth2ClientLoginOperation, r); {
+			switch err := c.securityBearerAuth(ctx, StorageHostfilesDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8390,7 +15857,7 @@ func (c *Client) sendOAuth2ClientLogin(ctx context.Context, request *OAuth2Clien
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, OAuth2ClientLoginOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, StorageHostfilesDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8428,7 +15895,7 @@ func (c *Client) sendOAuth2ClientLogin(ctx context.Context, request *OAuth2Clien
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeOAuth2ClientLoginResponse(resp)
+	result, err := decodeStorageHostfilesDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8436,21 +15903,21 @@ func (c *Client) sendOAuth2ClientLogin(ctx context.Context, request *OAuth2Clien
 	return result, nil
 }
 
-// OAuth2ClientTokenDelete invokes oauth2-client-token-delete operation.
+// StorageHostfilesGet invokes storage-hostfiles-get operation.
 //
-// Delete OAuth2 client token.
+// Retrieve details of a specific Host Files storage instance by UUID.
 //
-// DELETE /oauth2/client/{datasource_uuid}/token/{uuid}
-func (c *Client) OAuth2ClientTokenDelete(ctx context.Context, params OAuth2ClientTokenDeleteParams) error {
-	_, err := c.sendOAuth2ClientTokenDelete(ctx, params)
-	return err
+// GET /storage/hostfiles/{uuid}
+func (c *Client) StorageHostfilesGet(ctx context.Context, params StorageHostfilesGetParams) (*StorageHostfiles, error) {
+	res, err := c.sendStorageHostfilesGet(ctx, params)
+	return res, err
 }
 
-func (c *Client) sendOAuth2ClientTokenDelete(ctx context.Context, params OAuth2ClientTokenDeleteParams) (res *OAuth2ClientTokenDeleteOK, err error) {
+func (c *Client) sendStorageHostfilesGet(ctx context.Context, params StorageHostfilesGetParams) (res *StorageHostfiles, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("oauth2-client-token-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/oauth2/client/{datasource_uuid}/token/{uuid}"),
+		otelogen.OperationID("storage-hostfiles-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/storage/hostfiles/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -8465,7 +15932,7 @@ func (c *Client) sendOAuth2ClientTokenDelete(ctx context.Context, params OAuth2C
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, OAuth2ClientTokenDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, StorageHostfilesGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8482,27 +15949,8 @@ func (c *Client) sendOAuth2ClientTokenDelete(ctx context.Context, params OAuth2C
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
-	pathParts[0] = "/oauth2/client/"
-	{
-		// Encode "datasource_uuid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "datasource_uuid",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.DatasourceUUID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/token/"
+	var pathParts [2]string
+	pathParts[0] = "/storage/hostfiles/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -8519,12 +15967,12 @@ func (c *Client) sendOAuth2ClientTokenDelete(ctx context.Context, params OAuth2C
 		if err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
-		pathParts[3] = encoded
+		pathParts[1] = encoded
 	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -8534,7 +15982,7 @@ func (c *Client) sendOAuth2ClientTokenDelete(ctx context.Context, params OAuth2C
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, OAuth2ClientTokenDeleteOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, StorageHostfilesGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8545,7 +15993,7 @@ func (c *Client) sendOAuth2ClientTokenDelete(ctx context.Context, params OAuth2C
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, OAuth2ClientTokenDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, StorageHostfilesGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8556,7 +16004,7 @@ func (c *Client) sendOAuth2ClientTokenDelete(ctx context.Context, params OAuth2C
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, OAuth2ClientTokenDeleteOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, StorageHostfilesGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8594,7 +16042,7 @@ func (c *Client) sendOAuth2ClientTokenDelete(ctx context.Context, params OAuth2C
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeOAuth2ClientTokenDeleteResponse(resp)
+	result, err := decodeStorageHostfilesGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8602,21 +16050,21 @@ func (c *Client) sendOAuth2ClientTokenDelete(ctx context.Context, params OAuth2C
 	return result, nil
 }
 
-// OAuth2ClientTokenList invokes oauth2-client-token-list operation.
+// StorageHostfilesUpdate invokes storage-hostfiles-update operation.
 //
-// List OAuth2 client tokens.
+// Update details of a specific Host Files storage instance by UUID.
 //
-// GET /oauth2/client/{datasource_uuid}/token
-func (c *Client) OAuth2ClientTokenList(ctx context.Context, params OAuth2ClientTokenListParams) ([]OAuth2ClientToken, error) {
-	res, err := c.sendOAuth2ClientTokenList(ctx, params)
+// PUT /storage/hostfiles/{uuid}
+func (c *Client) StorageHostfilesUpdate(ctx context.Context, request *StorageHostfiles, params StorageHostfilesUpdateParams) (*StorageHostfiles, error) {
+	res, err := c.sendStorageHostfilesUpdate(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendOAuth2ClientTokenList(ctx context.Context, params OAuth2ClientTokenListParams) (res []OAuth2ClientToken, err error) {
+func (c *Client) sendStorageHostfilesUpdate(ctx context.Context, request *StorageHostfiles, params StorageHostfilesUpdateParams) (res *StorageHostfiles, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("oauth2-client-token-list"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/oauth2/client/{datasource_uuid}/token"),
+		otelogen.OperationID("storage-hostfiles-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/storage/hostfiles/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -8631,7 +16079,7 @@ func (c *Client) sendOAuth2ClientTokenList(ctx context.Context, params OAuth2Cli
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, OAuth2ClientTokenListOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, StorageHostfilesUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8648,17 +16096,17 @@ func (c *Client) sendOAuth2ClientTokenList(ctx context.Context, params OAuth2Cli
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/oauth2/client/"
+	var pathParts [2]string
+	pathParts[0] = "/storage/hostfiles/"
 	{
-		// Encode "datasource_uuid" parameter.
+		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "datasource_uuid",
+			Param:   "uuid",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.DatasourceUUID))
+			return e.EncodeValue(conv.StringToString(params.UUID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -8668,21 +16116,23 @@ func (c *Client) sendOAuth2ClientTokenList(ctx context.Context, params OAuth2Cli
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/token"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeStorageHostfilesUpdateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, OAuth2ClientTokenListOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, StorageHostfilesUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8693,7 +16143,7 @@ func (c *Client) sendOAuth2ClientTokenList(ctx context.Context, params OAuth2Cli
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, OAuth2ClientTokenListOperation, r); {
+			switch err := c.securityBearerAuth(ctx, StorageHostfilesUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8704,7 +16154,7 @@ func (c *Client) sendOAuth2ClientTokenList(ctx context.Context, params OAuth2Cli
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, OAuth2ClientTokenListOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, StorageHostfilesUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8742,7 +16192,7 @@ func (c *Client) sendOAuth2ClientTokenList(ctx context.Context, params OAuth2Cli
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeOAuth2ClientTokenListResponse(resp)
+	result, err := decodeStorageHostfilesUpdateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8750,21 +16200,21 @@ func (c *Client) sendOAuth2ClientTokenList(ctx context.Context, params OAuth2Cli
 	return result, nil
 }
 
-// OAuth2ClientUpdate invokes oauth2-client-update operation.
+// StorageList invokes storage-list operation.
 //
-// Update OAuth2 client.
+// Retrieve a list of data storage objects.
 //
-// PUT /oauth2/client/{uuid}
-func (c *Client) OAuth2ClientUpdate(ctx context.Context, request *OAuth2ClientUpdateReq, params OAuth2ClientUpdateParams) (*OAuth2Client, error) {
-	res, err := c.sendOAuth2ClientUpdate(ctx, request, params)
+// GET /storage
+func (c *Client) StorageList(ctx context.Context, params StorageListParams) ([]Storage, error) {
+	res, err := c.sendStorageList(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendOAuth2ClientUpdate(ctx context.Context, request *OAuth2ClientUpdateReq, params OAuth2ClientUpdateParams) (res *OAuth2Client, err error) {
+func (c *Client) sendStorageList(ctx context.Context, params StorageListParams) (res []Storage, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("oauth2-client-update"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/oauth2/client/{uuid}"),
+		otelogen.OperationID("storage-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/storage"),
 	}
 
 	// Run stopwatch.
@@ -8778,61 +16228,163 @@ func (c *Client) sendOAuth2ClientUpdate(ctx context.Context, request *OAuth2Clie
 	// Increment request counter.
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
-	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, OAuth2ClientUpdateOperation,
-		trace.WithAttributes(otelAttrs...),
-		clientSpanKind,
-	)
-	// Track stage for error reporting.
-	var stage string
-	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, StorageListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/storage"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "type" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "type",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Type.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "is_enabled" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "is_enabled",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IsEnabled.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Name.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "order_by" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "order_by",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
 		}
-		span.End()
-	}()
 
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/oauth2/client/"
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.OrderBy.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	{
-		// Encode "uuid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
-			Style:   uri.PathStyleSimple,
+		// Encode "order_direction" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "order_direction",
+			Style:   uri.QueryStyleForm,
 			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.OrderDirection.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[1] = encoded
 	}
-	uri.AddPathParts(u, pathParts[:]...)
+	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeOAuth2ClientUpdateRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, OAuth2ClientUpdateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, StorageListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8843,7 +16395,7 @@ func (c *Client) sendOAuth2ClientUpdate(ctx context.Context, request *OAuth2Clie
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, OAuth2ClientUpdateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, StorageListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8854,7 +16406,7 @@ func (c *Client) sendOAuth2ClientUpdate(ctx context.Context, request *OAuth2Clie
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, OAuth2ClientUpdateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, StorageListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8892,7 +16444,7 @@ func (c *Client) sendOAuth2ClientUpdate(ctx context.Context, request *OAuth2Clie
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeOAuth2ClientUpdateResponse(resp)
+	result, err := decodeStorageListResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8900,21 +16452,24 @@ func (c *Client) sendOAuth2ClientUpdate(ctx context.Context, request *OAuth2Clie
 	return result, nil
 }
 
-// PipelineCreate invokes pipeline-create operation.
+// StorageMigrate invokes storage-migrate operation.
 //
-// Create a new pipeline for a datasource.
+// Start moving message bodies and files from this storage to the target storage. If an
+// unfinished migration with the same target and options exists it is resumed from its
+// checkpoint. A migration to the same target still held by a run answers 409, a run that
+// stopped renewing its lease is taken over.
 //
-// POST /pipeline
-func (c *Client) PipelineCreate(ctx context.Context, request *Pipeline) (*Pipeline, error) {
-	res, err := c.sendPipelineCreate(ctx, request)
+// POST /storage/{uuid}/migrate
+func (c *Client) StorageMigrate(ctx context.Context, request *StorageMigration, params StorageMigrateParams) (*StorageMigration, error) {
+	res, err := c.sendStorageMigrate(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPipelineCreate(ctx context.Context, request *Pipeline) (res *Pipeline, err error) {
+func (c *Client) sendStorageMigrate(ctx context.Context, request *StorageMigration, params StorageMigrateParams) (res *StorageMigration, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("pipeline-create"),
+		otelogen.OperationID("storage-migrate"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/pipeline"),
+		semconv.HTTPRouteKey.String("/storage/{uuid}/migrate"),
 	}
 
 	// Run stopwatch.
@@ -8929,7 +16484,7 @@ func (c *Client) sendPipelineCreate(ctx context.Context, request *Pipeline) (res
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PipelineCreateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, StorageMigrateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8946,8 +16501,27 @@ func (c *Client) sendPipelineCreate(ctx context.Context, request *Pipeline) (res
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/pipeline"
+	var pathParts [3]string
+	pathParts[0] = "/storage/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/migrate"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -8955,7 +16529,7 @@ func (c *Client) sendPipelineCreate(ctx context.Context, request *Pipeline) (res
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePipelineCreateRequest(request, r); err != nil {
+	if err := encodeStorageMigrateRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -8964,7 +16538,7 @@ func (c *Client) sendPipelineCreate(ctx context.Context, request *Pipeline) (res
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, PipelineCreateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, StorageMigrateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8975,7 +16549,7 @@ func (c *Client) sendPipelineCreate(ctx context.Context, request *Pipeline) (res
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, PipelineCreateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, StorageMigrateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8986,7 +16560,7 @@ func (c *Client) sendPipelineCreate(ctx context.Context, request *Pipeline) (res
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, PipelineCreateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, StorageMigrateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9024,7 +16598,7 @@ func (c *Client) sendPipelineCreate(ctx context.Context, request *Pipeline) (res
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePipelineCreateResponse(resp)
+	result, err := decodeStorageMigrateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -9032,21 +16606,21 @@ func (c *Client) sendPipelineCreate(ctx context.Context, request *Pipeline) (res
 	return result, nil
 }
 
-// PipelineDelete invokes pipeline-delete operation.
+// StorageMigrationList invokes storage-migration-list operation.
 //
-// Delete a specific pipeline by UUID.
+// List migrations started from this storage.
 //
-// DELETE /pipeline/{uuid}
-func (c *Client) PipelineDelete(ctx context.Context, params PipelineDeleteParams) error {
-	_, err := c.sendPipelineDelete(ctx, params)
-	return err
+// GET /storage/{uuid}/migrate
+func (c *Client) StorageMigrationList(ctx context.Context, params StorageMigrationListParams) ([]StorageMigration, error) {
+	res, err := c.sendStorageMigrationList(ctx, params)
+	return res, err
 }
 
-func (c *Client) sendPipelineDelete(ctx context.Context, params PipelineDeleteParams) (res *PipelineDeleteOK, err error) {
+func (c *Client) sendStorageMigrationList(ctx context.Context, params StorageMigrationListParams) (res []StorageMigration, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("pipeline-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/pipeline/{uuid}"),
+		otelogen.OperationID("storage-migration-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/storage/{uuid}/migrate"),
 	}
 
 	// Run stopwatch.
@@ -9061,7 +16635,7 @@ func (c *Client) sendPipelineDelete(ctx context.Context, params PipelineDeletePa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PipelineDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, StorageMigrationListOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -9078,8 +16652,8 @@ func (c *Client) sendPipelineDelete(ctx context.Context, params PipelineDeletePa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/pipeline/"
+	var pathParts [3]string
+	pathParts[0] = "/storage/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -9088,7 +16662,7 @@ func (c *Client) sendPipelineDelete(ctx context.Context, params PipelineDeletePa
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.UUID))
+			return e.EncodeValue(conv.StringToString(params.UUID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -9098,10 +16672,49 @@ func (c *Client) sendPipelineDelete(ctx context.Context, params PipelineDeletePa
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/migrate"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -9111,7 +16724,7 @@ func (c *Client) sendPipelineDelete(ctx context.Context, params PipelineDeletePa
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, PipelineDeleteOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, StorageMigrationListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9122,7 +16735,7 @@ func (c *Client) sendPipelineDelete(ctx context.Context, params PipelineDeletePa
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, PipelineDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, StorageMigrationListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9133,7 +16746,7 @@ func (c *Client) sendPipelineDelete(ctx context.Context, params PipelineDeletePa
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, PipelineDeleteOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, StorageMigrationListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9171,7 +16784,7 @@ func (c *Client) sendPipelineDelete(ctx context.Context, params PipelineDeletePa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePipelineDeleteResponse(resp)
+	result, err := decodeStorageMigrationListResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -9179,21 +16792,21 @@ func (c *Client) sendPipelineDelete(ctx context.Context, params PipelineDeletePa
 	return result, nil
 }
 
-// PipelineGet invokes pipeline-get operation.
+// StoragePostgresCreate invokes storage-postgres-create operation.
 //
-// Retrieve a specific pipeline by its UUID.
+// Create a new PostgreSQL storage instance.
 //
-// GET /pipeline/{uuid}
-func (c *Client) PipelineGet(ctx context.Context, params PipelineGetParams) (*Pipeline, error) {
-	res, err := c.sendPipelineGet(ctx, params)
+// POST /storage/postgres
+func (c *Client) StoragePostgresCreate(ctx context.Context, request *StoragePostgres) (*StoragePostgres, error) {
+	res, err := c.sendStoragePostgresCreate(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendPipelineGet(ctx context.Context, params PipelineGetParams) (res *Pipeline, err error) {
+func (c *Client) sendStoragePostgresCreate(ctx context.Context, request *StoragePostgres) (res *StoragePostgres, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("pipeline-get"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/pipeline/{uuid}"),
+		otelogen.OperationID("storage-postgres-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/storage/postgres"),
 	}
 
 	// Run stopwatch.
@@ -9208,7 +16821,7 @@ func (c *Client) sendPipelineGet(ctx context.Context, params PipelineGetParams)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PipelineGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, StoragePostgresCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -9225,40 +16838,25 @@ func (c *Client) sendPipelineGet(ctx context.Context, params PipelineGetParams)
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/pipeline/"
-	{
-		// Encode "uuid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.UUID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/storage/postgres"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeStoragePostgresCreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, PipelineGetOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, StoragePostgresCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9269,7 +16867,7 @@ func (c *Client) sendPipelineGet(ctx context.Context, params PipelineGetParams)
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, PipelineGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, StoragePostgresCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9280,7 +16878,7 @@ func (c *Client) sendPipelineGet(ctx context.Context, params PipelineGetParams)
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, PipelineGetOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, StoragePostgresCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9318,7 +16916,7 @@ func (c *Client) sendPipelineGet(ctx context.Context, params PipelineGetParams)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePipelineGetResponse(resp)
+	result, err := decodeStoragePostgresCreateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -9326,21 +16924,21 @@ func (c *Client) sendPipelineGet(ctx context.Context, params PipelineGetParams)
 	return result, nil
 }
 
-// PipelineList invokes pipeline-list operation.
-//
-// Get all pipelines.
-//
-// GET /pipeline
-func (c *Client) PipelineList(ctx context.Context, params PipelineListParams) (*PipelineListOK, error) {
-	res, err := c.sendPipelineList(ctx, params)
-	return res, err
+// StoragePostgresDelete invokes storage-postgres-delete operation.
+//
+// Delete a specific PostgreSQL storage instance by UUID.
+//
+// DELETE /storage/postgres/{uuid}
+func (c *Client) StoragePostgresDelete(ctx context.Context, params StoragePostgresDeleteParams) error {
+	_, err := c.sendStoragePostgresDelete(ctx, params)
+	return err
 }
 
-func (c *Client) sendPipelineList(ctx context.Context, params PipelineListParams) (res *PipelineListOK, err error) {
+func (c *Client) sendStoragePostgresDelete(ctx context.Context, params StoragePostgresDeleteParams) (res *StoragePostgresDeleteOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("pipeline-list"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/pipeline"),
+		otelogen.OperationID("storage-postgres-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/storage/postgres/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -9355,7 +16953,7 @@ func (c *Client) sendPipelineList(ctx context.Context, params PipelineListParams
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PipelineListOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, StoragePostgresDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -9372,84 +16970,30 @@ func (c *Client) sendPipelineList(ctx context.Context, params PipelineListParams
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/pipeline"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "datasource_uuid" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "datasource_uuid",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.DatasourceUUID.Get(); ok {
-				return e.EncodeValue(conv.UUIDToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "storage_uuid" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "storage_uuid",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.StorageUUID.Get(); ok {
-				return e.EncodeValue(conv.UUIDToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "offset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Offset.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	var pathParts [2]string
+	pathParts[0] = "/storage/postgres/"
 	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -9459,7 +17003,7 @@ func (c *Client) sendPipelineList(ctx context.Context, params PipelineListParams
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, PipelineListOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, StoragePostgresDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9470,7 +17014,7 @@ func (c *Client) sendPipelineList(ctx context.Context, params PipelineListParams
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, PipelineListOperation, r); {
+			switch err := c.securityBearerAuth(ctx, StoragePostgresDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9481,7 +17025,7 @@ func (c *Client) sendPipelineList(ctx context.Context, params PipelineListParams
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, PipelineListOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, StoragePostgresDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9519,7 +17063,7 @@ func (c *Client) sendPipelineList(ctx context.Context, params PipelineListParams
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePipelineListResponse(resp)
+	result, err := decodeStoragePostgresDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -9527,21 +17071,21 @@ func (c *Client) sendPipelineList(ctx context.Context, params PipelineListParams
 	return result, nil
 }
 
-// PipelineUpdate invokes pipeline-update operation.
+// StoragePostgresGet invokes storage-postgres-get operation.
 //
-// Update an existing pipeline.
+// Retrieve details of a specific PostgreSQL storage instance by UUID.
 //
-// PUT /pipeline/{uuid}
-func (c *Client) PipelineUpdate(ctx context.Context, request *Pipeline, params PipelineUpdateParams) (*Pipeline, error) {
-	res, err := c.sendPipelineUpdate(ctx, request, params)
+// GET /storage/postgres/{uuid}
+func (c *Client) StoragePostgresGet(ctx context.Context, params StoragePostgresGetParams) (*StoragePostgres, error) {
+	res, err := c.sendStoragePostgresGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPipelineUpdate(ctx context.Context, request *Pipeline, params PipelineUpdateParams) (res *Pipeline, err error) {
+func (c *Client) sendStoragePostgresGet(ctx context.Context, params StoragePostgresGetParams) (res *StoragePostgres, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("pipeline-update"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/pipeline/{uuid}"),
+		otelogen.OperationID("storage-postgres-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/storage/postgres/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -9556,7 +17100,7 @@ func (c *Client) sendPipelineUpdate(ctx context.Context, request *Pipeline, para
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PipelineUpdateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, StoragePostgresGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -9574,7 +17118,7 @@ func (c *Client) sendPipelineUpdate(ctx context.Context, request *Pipeline, para
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/pipeline/"
+	pathParts[0] = "/storage/postgres/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -9583,7 +17127,7 @@ func (c *Client) sendPipelineUpdate(ctx context.Context, request *Pipeline, para
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.UUID))
+			return e.EncodeValue(conv.StringToString(params.UUID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -9596,20 +17140,17 @@ func (c *Client) sendPipelineUpdate(ctx context.Context, request *Pipeline, para
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePipelineUpdateRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, PipelineUpdateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, StoragePostgresGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9620,7 +17161,7 @@ func (c *Client) sendPipelineUpdate(ctx context.Context, request *Pipeline, para
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, PipelineUpdateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, StoragePostgresGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9631,7 +17172,7 @@ func (c *Client) sendPipelineUpdate(ctx context.Context, request *Pipeline, para
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, PipelineUpdateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, StoragePostgresGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9669,7 +17210,7 @@ func (c *Client) sendPipelineUpdate(ctx context.Context, request *Pipeline, para
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePipelineUpdateResponse(resp)
+	result, err := decodeStoragePostgresGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -9677,21 +17218,21 @@ func (c *Client) sendPipelineUpdate(ctx context.Context, request *Pipeline, para
 	return result, nil
 }
 
-// SchedulerCreate invokes scheduler-create operation.
+// StoragePostgresUpdate invokes storage-postgres-update operation.
 //
-// Create scheduler.
+// Update details of a specific PostgreSQL storage instance by UUID.
 //
-// POST /scheduler
-func (c *Client) SchedulerCreate(ctx context.Context, request *Scheduler) (*Scheduler, error) {
-	res, err := c.sendSchedulerCreate(ctx, request)
+// PUT /storage/postgres/{uuid}
+func (c *Client) StoragePostgresUpdate(ctx context.Context, request *StoragePostgres, params StoragePostgresUpdateParams) (*StoragePostgres, error) {
+	res, err := c.sendStoragePostgresUpdate(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendSchedulerCreate(ctx context.Context, request *Scheduler) (res *Scheduler, err error) {
+func (c *Client) sendStoragePostgresUpdate(ctx context.Context, request *StoragePostgres, params StoragePostgresUpdateParams) (res *StoragePostgres, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("scheduler-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/scheduler"),
+		otelogen.OperationID("storage-postgres-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/storage/postgres/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -9706,7 +17247,7 @@ func (c *Client) sendSchedulerCreate(ctx context.Context, request *Scheduler) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SchedulerCreateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, StoragePostgresUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -9723,16 +17264,34 @@ func (c *Client) sendSchedulerCreate(ctx context.Context, request *Scheduler) (r
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/scheduler"
+	var pathParts [2]string
+	pathParts[0] = "/storage/postgres/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeSchedulerCreateRequest(request, r); err != nil {
+	if err := encodeStoragePostgresUpdateRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -9741,7 +17300,7 @@ func (c *Client) sendSchedulerCreate(ctx context.Context, request *Scheduler) (r
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, SchedulerCreateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, StoragePostgresUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9752,7 +17311,7 @@ func (c *Client) sendSchedulerCreate(ctx context.Context, request *Scheduler) (r
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SchedulerCreateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, StoragePostgresUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9763,7 +17322,7 @@ func (c *Client) sendSchedulerCreate(ctx context.Context, request *Scheduler) (r
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, SchedulerCreateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, StoragePostgresUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9801,7 +17360,7 @@ func (c *Client) sendSchedulerCreate(ctx context.Context, request *Scheduler) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSchedulerCreateResponse(resp)
+	result, err := decodeStoragePostgresUpdateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -9809,21 +17368,21 @@ func (c *Client) sendSchedulerCreate(ctx context.Context, request *Scheduler) (r
 	return result, nil
 }
 
-// SchedulerDelete invokes scheduler-delete operation.
+// StorageS3Create invokes storage-s3-create operation.
 //
-// Delete scheduler.
+// Create a new S3 storage instance.
 //
-// DELETE /scheduler/{uuid}
-func (c *Client) SchedulerDelete(ctx context.Context, params SchedulerDeleteParams) error {
-	_, err := c.sendSchedulerDelete(ctx, params)
-	return err
+// POST /storage/s3
+func (c *Client) StorageS3Create(ctx context.Context, request *StorageS3) (*StorageS3, error) {
+	res, err := c.sendStorageS3Create(ctx, request)
+	return res, err
 }
 
-func (c *Client) sendSchedulerDelete(ctx context.Context, params SchedulerDeleteParams) (res *SchedulerDeleteOK, err error) {
+func (c *Client) sendStorageS3Create(ctx context.Context, request *StorageS3) (res *StorageS3, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("scheduler-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/scheduler/{uuid}"),
+		otelogen.OperationID("storage-s3-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/storage/s3"),
 	}
 
 	// Run stopwatch.
@@ -9838,57 +17397,42 @@ func (c *Client) sendSchedulerDelete(ctx context.Context, params SchedulerDelete
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SchedulerDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, StorageS3CreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
-	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
-		}
-		span.End()
-	}()
-
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/scheduler/"
-	{
-		// Encode "uuid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.UUID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
 		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		pathParts[1] = encoded
-	}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/storage/s3"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeStorageS3CreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, SchedulerDeleteOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, StorageS3CreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9899,7 +17443,7 @@ func (c *Client) sendSchedulerDelete(ctx context.Context, params SchedulerDelete
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SchedulerDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, StorageS3CreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9910,7 +17454,7 @@ func (c *Client) sendSchedulerDelete(ctx context.Context, params SchedulerDelete
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, SchedulerDeleteOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, StorageS3CreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9948,7 +17492,7 @@ func (c *Client) sendSchedulerDelete(ctx context.Context, params SchedulerDelete
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSchedulerDeleteResponse(resp)
+	result, err := decodeStorageS3CreateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -9956,21 +17500,21 @@ func (c *Client) sendSchedulerDelete(ctx context.Context, params SchedulerDelete
 	return result, nil
 }
 
-// SchedulerGet invokes scheduler-get operation.
+// StorageS3Delete invokes storage-s3-delete operation.
 //
-// Get scheduler by UUID.
+// Delete a specific S3 storage instance by UUID.
 //
-// GET /scheduler/{uuid}
-func (c *Client) SchedulerGet(ctx context.Context, params SchedulerGetParams) (*Scheduler, error) {
-	res, err := c.sendSchedulerGet(ctx, params)
-	return res, err
+// DELETE /storage/s3/{uuid}
+func (c *Client) StorageS3Delete(ctx context.Context, params StorageS3DeleteParams) error {
+	_, err := c.sendStorageS3Delete(ctx, params)
+	return err
 }
 
-func (c *Client) sendSchedulerGet(ctx context.Context, params SchedulerGetParams) (res *Scheduler, err error) {
+func (c *Client) sendStorageS3Delete(ctx context.Context, params StorageS3DeleteParams) (res *StorageS3DeleteOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("scheduler-get"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/scheduler/{uuid}"),
+		otelogen.OperationID("storage-s3-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/storage/s3/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -9985,7 +17529,7 @@ func (c *Client) sendSchedulerGet(ctx context.Context, params SchedulerGetParams
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SchedulerGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, StorageS3DeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -10003,7 +17547,7 @@ func (c *Client) sendSchedulerGet(ctx context.Context, params SchedulerGetParams
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/scheduler/"
+	pathParts[0] = "/storage/s3/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -10012,7 +17556,7 @@ func (c *Client) sendSchedulerGet(ctx context.Context, params SchedulerGetParams
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.UUID))
+			return e.EncodeValue(conv.StringToString(params.UUID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -10025,7 +17569,7 @@ func (c *Client) sendSchedulerGet(ctx context.Context, params SchedulerGetParams
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -10035,7 +17579,7 @@ func (c *Client) sendSchedulerGet(ctx context.Context, params SchedulerGetParams
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, SchedulerGetOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, StorageS3DeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10046,7 +17590,7 @@ func (c *Client) sendSchedulerGet(ctx context.Context, params SchedulerGetParams
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SchedulerGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, StorageS3DeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10057,7 +17601,7 @@ func (c *Client) sendSchedulerGet(ctx context.Context, params SchedulerGetParams
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, SchedulerGetOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, StorageS3DeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10095,7 +17639,7 @@ func (c *Client) sendSchedulerGet(ctx context.Context, params SchedulerGetParams
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSchedulerGetResponse(resp)
+	result, err := decodeStorageS3DeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -10103,21 +17647,21 @@ func (c *Client) sendSchedulerGet(ctx context.Context, params SchedulerGetParams
 	return result, nil
 }
 
-// SchedulerList invokes scheduler-list operation.
+// StorageS3Get invokes storage-s3-get operation.
 //
-// Retrieve all schedulers for the authenticated user.
+// Retrieve details of a specific S3 storage instance by UUID.
 //
-// GET /scheduler
-func (c *Client) SchedulerList(ctx context.Context, params SchedulerListParams) ([]Scheduler, error) {
-	res, err := c.sendSchedulerList(ctx, params)
+// GET /storage/s3/{uuid}
+func (c *Client) StorageS3Get(ctx context.Context, params StorageS3GetParams) (*StorageS3, error) {
+	res, err := c.sendStorageS3Get(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendSchedulerList(ctx context.Context, params SchedulerListParams) (res []Scheduler, err error) {
+func (c *Client) sendStorageS3Get(ctx context.Context, params StorageS3GetParams) (res *StorageS3, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("scheduler-list"),
+		otelogen.OperationID("storage-s3-get"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/scheduler"),
+		semconv.HTTPRouteKey.String("/storage/s3/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -10132,7 +17676,7 @@ func (c *Client) sendSchedulerList(ctx context.Context, params SchedulerListPara
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SchedulerListOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, StorageS3GetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -10149,64 +17693,27 @@ func (c *Client) sendSchedulerList(ctx context.Context, params SchedulerListPara
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/scheduler"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "pipeline_uuid" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "pipeline_uuid",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.PipelineUUID.Get(); ok {
-				return e.EncodeValue(conv.UUIDToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "offset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Offset.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	var pathParts [2]string
+	pathParts[0] = "/storage/s3/"
 	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -10219,7 +17726,7 @@ func (c *Client) sendSchedulerList(ctx context.Context, params SchedulerListPara
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, SchedulerListOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, StorageS3GetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10230,7 +17737,7 @@ func (c *Client) sendSchedulerList(ctx context.Context, params SchedulerListPara
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SchedulerListOperation, r); {
+			switch err := c.securityBearerAuth(ctx, StorageS3GetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10241,7 +17748,7 @@ func (c *Client) sendSchedulerList(ctx context.Context, params SchedulerListPara
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, SchedulerListOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, StorageS3GetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10279,7 +17786,7 @@ func (c *Client) sendSchedulerList(ctx context.Context, params SchedulerListPara
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSchedulerListResponse(resp)
+	result, err := decodeStorageS3GetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -10287,21 +17794,21 @@ func (c *Client) sendSchedulerList(ctx context.Context, params SchedulerListPara
 	return result, nil
 }
 
-// SchedulerUpdate invokes scheduler-update operation.
+// StorageS3Update invokes storage-s3-update operation.
 //
-// Update scheduler.
+// Update details of a specific S3 storage instance by UUID.
 //
-// PUT /scheduler/{uuid}
-func (c *Client) SchedulerUpdate(ctx context.Context, request *Scheduler, params SchedulerUpdateParams) (*Scheduler, error) {
-	res, err := c.sendSchedulerUpdate(ctx, request, params)
+// PUT /storage/s3/{uuid}
+func (c *Client) StorageS3Update(ctx context.Context, request *StorageS3, params StorageS3UpdateParams) (*StorageS3, error) {
+	res, err := c.sendStorageS3Update(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendSchedulerUpdate(ctx context.Context, request *Scheduler, params SchedulerUpdateParams) (res *Scheduler, err error) {
+func (c *Client) sendStorageS3Update(ctx context.Context, request *StorageS3, params StorageS3UpdateParams) (res *StorageS3, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("scheduler-update"),
+		otelogen.OperationID("storage-s3-update"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/scheduler/{uuid}"),
+		semconv.HTTPRouteKey.String("/storage/s3/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -10316,7 +17823,7 @@ func (c *Client) sendSchedulerUpdate(ctx context.Context, request *Scheduler, pa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SchedulerUpdateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, StorageS3UpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -10334,7 +17841,7 @@ func (c *Client) sendSchedulerUpdate(ctx context.Context, request *Scheduler, pa
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/scheduler/"
+	pathParts[0] = "/storage/s3/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -10343,7 +17850,7 @@ func (c *Client) sendSchedulerUpdate(ctx context.Context, request *Scheduler, pa
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.UUID))
+			return e.EncodeValue(conv.StringToString(params.UUID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -10360,7 +17867,7 @@ func (c *Client) sendSchedulerUpdate(ctx context.Context, request *Scheduler, pa
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeSchedulerUpdateRequest(request, r); err != nil {
+	if err := encodeStorageS3UpdateRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -10369,7 +17876,7 @@ func (c *Client) sendSchedulerUpdate(ctx context.Context, request *Scheduler, pa
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, SchedulerUpdateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, StorageS3UpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10380,7 +17887,7 @@ func (c *Client) sendSchedulerUpdate(ctx context.Context, request *Scheduler, pa
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SchedulerUpdateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, StorageS3UpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10391,7 +17898,7 @@ func (c *Client) sendSchedulerUpdate(ctx context.Context, request *Scheduler, pa
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, SchedulerUpdateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, StorageS3UpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10429,7 +17936,7 @@ func (c *Client) sendSchedulerUpdate(ctx context.Context, request *Scheduler, pa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSchedulerUpdateResponse(resp)
+	result, err := decodeStorageS3UpdateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -10437,21 +17944,21 @@ func (c *Client) sendSchedulerUpdate(ctx context.Context, request *Scheduler, pa
 	return result, nil
 }
 
-// SessionStatus invokes session-status operation.
+// SyncpolicyCreate invokes syncpolicy-create operation.
 //
-// Introspect current session status.
+// Create a new sync policy.
 //
-// GET /session
-func (c *Client) SessionStatus(ctx context.Context) (*SessionStatus, error) {
-	res, err := c.sendSessionStatus(ctx)
+// POST /syncpolicy
+func (c *Client) SyncpolicyCreate(ctx context.Context, request *SyncPolicy) (*SyncPolicy, error) {
+	res, err := c.sendSyncpolicyCreate(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendSessionStatus(ctx context.Context) (res *SessionStatus, err error) {
+func (c *Client) sendSyncpolicyCreate(ctx context.Context, request *SyncPolicy) (res *SyncPolicy, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("session-status"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/session"),
+		otelogen.OperationID("syncpolicy-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/syncpolicy"),
 	}
 
 	// Run stopwatch.
@@ -10466,7 +17973,7 @@ func (c *Client) sendSessionStatus(ctx context.Context) (res *SessionStatus, err
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SessionStatusOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SyncpolicyCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -10478,19 +17985,79 @@ func (c *Client) sendSessionStatus(ctx context.Context) (res *SessionStatus, err
 			span.SetStatus(codes.Error, stage)
 			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		span.End()
-	}()
-
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/session"
-	uri.AddPathParts(u, pathParts[:]...)
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/syncpolicy"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeSyncpolicyCreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ZitadelCookieAuth"
+			switch err := c.securityZitadelCookieAuth(ctx, SyncpolicyCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ZitadelCookieAuth\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, SyncpolicyCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:PlainCookieAuth"
+			switch err := c.securityPlainCookieAuth(ctx, SyncpolicyCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PlainCookieAuth\"")
+			}
+		}
 
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
 	}
 
 	stage = "SendRequest"
@@ -10501,7 +18068,7 @@ func (c *Client) sendSessionStatus(ctx context.Context) (res *SessionStatus, err
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSessionStatusResponse(resp)
+	result, err := decodeSyncpolicyCreateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -10509,21 +18076,21 @@ func (c *Client) sendSessionStatus(ctx context.Context) (res *SessionStatus, err
 	return result, nil
 }
 
-// StorageHostfilesCreate invokes storage-hostfiles-create operation.
+// SyncpolicyDelete invokes syncpolicy-delete operation.
 //
-// Create a new Host Files storage instance.
+// Delete a sync policy by uuid.
 //
-// POST /storage/hostfiles
-func (c *Client) StorageHostfilesCreate(ctx context.Context, request *StorageHostfiles) (*StorageHostfiles, error) {
-	res, err := c.sendStorageHostfilesCreate(ctx, request)
-	return res, err
+// DELETE /syncpolicy/{uuid}
+func (c *Client) SyncpolicyDelete(ctx context.Context, params SyncpolicyDeleteParams) error {
+	_, err := c.sendSyncpolicyDelete(ctx, params)
+	return err
 }
 
-func (c *Client) sendStorageHostfilesCreate(ctx context.Context, request *StorageHostfiles) (res *StorageHostfiles, err error) {
+func (c *Client) sendSyncpolicyDelete(ctx context.Context, params SyncpolicyDeleteParams) (res *SyncpolicyDeleteOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-hostfiles-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/storage/hostfiles"),
+		otelogen.OperationID("syncpolicy-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/syncpolicy/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -10538,7 +18105,7 @@ func (c *Client) sendStorageHostfilesCreate(ctx context.Context, request *Storag
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, StorageHostfilesCreateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SyncpolicyDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -10555,25 +18122,40 @@ func (c *Client) sendStorageHostfilesCreate(ctx context.Context, request *Storag
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/storage/hostfiles"
+	var pathParts [2]string
+	pathParts[0] = "/syncpolicy/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeStorageHostfilesCreateRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, StorageHostfilesCreateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, SyncpolicyDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10584,7 +18166,7 @@ func (c *Client) sendStorageHostfilesCreate(ctx context.Context, request *Storag
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, StorageHostfilesCreateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, SyncpolicyDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10595,7 +18177,7 @@ func (c *Client) sendStorageHostfilesCreate(ctx context.Context, request *Storag
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, StorageHostfilesCreateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, SyncpolicyDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10633,7 +18215,7 @@ func (c *Client) sendStorageHostfilesCreate(ctx context.Context, request *Storag
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeStorageHostfilesCreateResponse(resp)
+	result, err := decodeSyncpolicyDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -10641,21 +18223,21 @@ func (c *Client) sendStorageHostfilesCreate(ctx context.Context, request *Storag
 	return result, nil
 }
 
-// StorageHostfilesDelete invokes storage-hostfiles-delete operation.
+// SyncpolicyGet invokes syncpolicy-get operation.
 //
-// Delete a specific Host Files storage instance by UUID.
+// Retrieve a specific sync policy by uuid.
 //
-// DELETE /storage/hostfiles/{uuid}
-func (c *Client) StorageHostfilesDelete(ctx context.Context, params StorageHostfilesDeleteParams) error {
-	_, err := c.sendStorageHostfilesDelete(ctx, params)
-	return err
+// GET /syncpolicy/{uuid}
+func (c *Client) SyncpolicyGet(ctx context.Context, params SyncpolicyGetParams) (*SyncPolicy, error) {
+	res, err := c.sendSyncpolicyGet(ctx, params)
+	return res, err
 }
 
-func (c *Client) sendStorageHostfilesDelete(ctx context.Context, params StorageHostfilesDeleteParams) (res *StorageHostfilesDeleteOK, err error) {
+func (c *Client) sendSyncpolicyGet(ctx context.Context, params SyncpolicyGetParams) (res *SyncPolicy, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-hostfiles-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/storage/hostfiles/{uuid}"),
+		otelogen.OperationID("syncpolicy-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/syncpolicy/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -10670,7 +18252,7 @@ func (c *Client) sendStorageHostfilesDelete(ctx context.Context, params StorageH
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, StorageHostfilesDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SyncpolicyGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -10688,7 +18270,7 @@ func (c *Client) sendStorageHostfilesDelete(ctx context.Context, params StorageH
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/storage/hostfiles/"
+	pathParts[0] = "/syncpolicy/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -10710,7 +18292,7 @@ func (c *Client) sendStorageHostfilesDelete(ctx context.Context, params StorageH
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -10720,7 +18302,7 @@ func (c *Client) sendStorageHostfilesDelete(ctx context.Context, params StorageH
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, StorageHostfilesDeleteOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, SyncpolicyGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10731,7 +18313,7 @@ func (c *Client) sendStorageHostfilesDelete(ctx context.Context, params StorageH
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, StorageHostfilesDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, SyncpolicyGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10742,7 +18324,7 @@ func (c *Client) sendStorageHostfilesDelete(ctx context.Context, params StorageH
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, StorageHostfilesDeleteOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, SyncpolicyGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10780,7 +18362,7 @@ func (c *Client) sendStorageHostfilesDelete(ctx context.Context, params StorageH
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeStorageHostfilesDeleteResponse(resp)
+	result, err := decodeSyncpolicyGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -10788,21 +18370,21 @@ func (c *Client) sendStorageHostfilesDelete(ctx context.Context, params StorageH
 	return result, nil
 }
 
-// StorageHostfilesGet invokes storage-hostfiles-get operation.
+// SyncpolicyList invokes syncpolicy-list operation.
 //
-// Retrieve details of a specific Host Files storage instance by UUID.
+// Retrieve a list of sync policies for the authenticated user.
 //
-// GET /storage/hostfiles/{uuid}
-func (c *Client) StorageHostfilesGet(ctx context.Context, params StorageHostfilesGetParams) (*StorageHostfiles, error) {
-	res, err := c.sendStorageHostfilesGet(ctx, params)
+// GET /syncpolicy
+func (c *Client) SyncpolicyList(ctx context.Context, params SyncpolicyListParams) (*SyncpolicyListOK, error) {
+	res, err := c.sendSyncpolicyList(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendStorageHostfilesGet(ctx context.Context, params StorageHostfilesGetParams) (res *StorageHostfiles, err error) {
+func (c *Client) sendSyncpolicyList(ctx context.Context, params SyncpolicyListParams) (res *SyncpolicyListOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-hostfiles-get"),
+		otelogen.OperationID("syncpolicy-list"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/storage/hostfiles/{uuid}"),
+		semconv.HTTPRouteKey.String("/syncpolicy"),
 	}
 
 	// Run stopwatch.
@@ -10817,7 +18399,7 @@ func (c *Client) sendStorageHostfilesGet(ctx context.Context, params StorageHost
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, StorageHostfilesGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SyncpolicyListOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -10834,27 +18416,47 @@ func (c *Client) sendStorageHostfilesGet(ctx context.Context, params StorageHost
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/storage/hostfiles/"
+	var pathParts [1]string
+	pathParts[0] = "/syncpolicy"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "uuid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[1] = encoded
 	}
-	uri.AddPathParts(u, pathParts[:]...)
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -10867,7 +18469,7 @@ func (c *Client) sendStorageHostfilesGet(ctx context.Context, params StorageHost
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, StorageHostfilesGetOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, SyncpolicyListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10878,7 +18480,7 @@ func (c *Client) sendStorageHostfilesGet(ctx context.Context, params StorageHost
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, StorageHostfilesGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, SyncpolicyListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10889,7 +18491,7 @@ func (c *Client) sendStorageHostfilesGet(ctx context.Context, params StorageHost
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, StorageHostfilesGetOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, SyncpolicyListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10927,7 +18529,7 @@ func (c *Client) sendStorageHostfilesGet(ctx context.Context, params StorageHost
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeStorageHostfilesGetResponse(resp)
+	result, err := decodeSyncpolicyListResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -10935,21 +18537,21 @@ func (c *Client) sendStorageHostfilesGet(ctx context.Context, params StorageHost
 	return result, nil
 }
 
-// StorageHostfilesUpdate invokes storage-hostfiles-update operation.
+// SyncpolicyUpdate invokes syncpolicy-update operation.
 //
-// Update details of a specific Host Files storage instance by UUID.
+// Update a sync policy by uuid.
 //
-// PUT /storage/hostfiles/{uuid}
-func (c *Client) StorageHostfilesUpdate(ctx context.Context, request *StorageHostfiles, params StorageHostfilesUpdateParams) (*StorageHostfiles, error) {
-	res, err := c.sendStorageHostfilesUpdate(ctx, request, params)
+// PUT /syncpolicy/{uuid}
+func (c *Client) SyncpolicyUpdate(ctx context.Context, request *SyncPolicy, params SyncpolicyUpdateParams) (*SyncPolicy, error) {
+	res, err := c.sendSyncpolicyUpdate(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendStorageHostfilesUpdate(ctx context.Context, request *StorageHostfiles, params StorageHostfilesUpdateParams) (res *StorageHostfiles, err error) {
+func (c *Client) sendSyncpolicyUpdate(ctx context.Context, request *SyncPolicy, params SyncpolicyUpdateParams) (res *SyncPolicy, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-hostfiles-update"),
+		otelogen.OperationID("syncpolicy-update"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/storage/hostfiles/{uuid}"),
+		semconv.HTTPRouteKey.String("/syncpolicy/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -10964,7 +18566,7 @@ func (c *Client) sendStorageHostfilesUpdate(ctx context.Context, request *Storag
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, StorageHostfilesUpdateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SyncpolicyUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -10982,7 +18584,7 @@ func (c *Client) sendStorageHostfilesUpdate(ctx context.Context, request *Storag
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/storage/hostfiles/"
+	pathParts[0] = "/syncpolicy/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -11008,7 +18610,7 @@ func (c *Client) sendStorageHostfilesUpdate(ctx context.Context, request *Storag
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeStorageHostfilesUpdateRequest(request, r); err != nil {
+	if err := encodeSyncpolicyUpdateRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -11017,7 +18619,7 @@ func (c *Client) sendStorageHostfilesUpdate(ctx context.Context, request *Storag
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, StorageHostfilesUpdateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, SyncpolicyUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11028,7 +18630,7 @@ func (c *Client) sendStorageHostfilesUpdate(ctx context.Context, request *Storag
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, StorageHostfilesUpdateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, SyncpolicyUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11039,7 +18641,7 @@ func (c *Client) sendStorageHostfilesUpdate(ctx context.Context, request *Storag
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, StorageHostfilesUpdateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, SyncpolicyUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11077,7 +18679,7 @@ func (c *Client) sendStorageHostfilesUpdate(ctx context.Context, request *Storag
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeStorageHostfilesUpdateResponse(resp)
+	result, err := decodeSyncpolicyUpdateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -11085,21 +18687,21 @@ func (c *Client) sendStorageHostfilesUpdate(ctx context.Context, request *Storag
 	return result, nil
 }
 
-// StorageList invokes storage-list operation.
+// TgSessionCreate invokes tg-session-create operation.
 //
-// Retrieve a list of data storage objects.
+// Create a new Telegram session.
 //
-// GET /storage
-func (c *Client) StorageList(ctx context.Context, params StorageListParams) ([]Storage, error) {
-	res, err := c.sendStorageList(ctx, params)
+// POST /telegram
+func (c *Client) TgSessionCreate(ctx context.Context, request *TgSessionCreateReq) (*Telegram, error) {
+	res, err := c.sendTgSessionCreate(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendStorageList(ctx context.Context, params StorageListParams) (res []Storage, err error) {
+func (c *Client) sendTgSessionCreate(ctx context.Context, request *TgSessionCreateReq) (res *Telegram, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-list"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/storage"),
+		otelogen.OperationID("tg-session-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/telegram"),
 	}
 
 	// Run stopwatch.
@@ -11114,7 +18716,7 @@ func (c *Client) sendStorageList(ctx context.Context, params StorageListParams)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, StorageListOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, TgSessionCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -11132,144 +18734,24 @@ func (c *Client) sendStorageList(ctx context.Context, params StorageListParams)
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/storage"
+	pathParts[0] = "/telegram"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "offset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Offset.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "type" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "type",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Type.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "is_enabled" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "is_enabled",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.IsEnabled.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "name" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "name",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Name.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "order_by" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "order_by",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.OrderBy.Get(); ok {
-				return e.EncodeValue(conv.StringToString(string(val)))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "order_direction" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "order_direction",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.OrderDirection.Get(); ok {
-				return e.EncodeValue(conv.StringToString(string(val)))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeTgSessionCreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, StorageListOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, TgSessionCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11280,7 +18762,7 @@ func (c *Client) sendStorageList(ctx context.Context, params StorageListParams)
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, StorageListOperation, r); {
+			switch err := c.securityBearerAuth(ctx, TgSessionCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11291,7 +18773,7 @@ func (c *Client) sendStorageList(ctx context.Context, params StorageListParams)
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, StorageListOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, TgSessionCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11329,7 +18811,7 @@ func (c *Client) sendStorageList(ctx context.Context, params StorageListParams)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeStorageListResponse(resp)
+	result, err := decodeTgSessionCreateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -11337,21 +18819,21 @@ func (c *Client) sendStorageList(ctx context.Context, params StorageListParams)
 	return result, nil
 }
 
-// StoragePostgresCreate invokes storage-postgres-create operation.
+// TgSessionList invokes tg-session-list operation.
 //
-// Create a new PostgreSQL storage instance.
+// List all Telegram sessions for the authenticated user.
 //
-// POST /storage/postgres
-func (c *Client) StoragePostgresCreate(ctx context.Context, request *StoragePostgres) (*StoragePostgres, error) {
-	res, err := c.sendStoragePostgresCreate(ctx, request)
+// GET /telegram
+func (c *Client) TgSessionList(ctx context.Context) (*TgSessionListOK, error) {
+	res, err := c.sendTgSessionList(ctx)
 	return res, err
 }
 
-func (c *Client) sendStoragePostgresCreate(ctx context.Context, request *StoragePostgres) (res *StoragePostgres, err error) {
+func (c *Client) sendTgSessionList(ctx context.Context) (res *TgSessionListOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-postgres-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/storage/postgres"),
+		otelogen.OperationID("tg-session-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/telegram"),
 	}
 
 	// Run stopwatch.
@@ -11366,7 +18848,7 @@ func (c *Client) sendStoragePostgresCreate(ctx context.Context, request *Storage
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, StoragePostgresCreateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, TgSessionListOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -11384,24 +18866,21 @@ func (c *Client) sendStoragePostgresCreate(ctx context.Context, request *Storage
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/storage/postgres"
+	pathParts[0] = "/telegram"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeStoragePostgresCreateRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, StoragePostgresCreateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, TgSessionListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11412,7 +18891,7 @@ func (c *Client) sendStoragePostgresCreate(ctx context.Context, request *Storage
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, StoragePostgresCreateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, TgSessionListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11423,7 +18902,7 @@ func (c *Client) sendStoragePostgresCreate(ctx context.Context, request *Storage
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, StoragePostgresCreateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, TgSessionListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11461,7 +18940,7 @@ func (c *Client) sendStoragePostgresCreate(ctx context.Context, request *Storage
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeStoragePostgresCreateResponse(resp)
+	result, err := decodeTgSessionListResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -11469,21 +18948,21 @@ func (c *Client) sendStoragePostgresCreate(ctx context.Context, request *Storage
 	return result, nil
 }
 
-// StoragePostgresDelete invokes storage-postgres-delete operation.
+// TgSessionVerify invokes tg-session-verify operation.
 //
-// Delete a specific PostgreSQL storage instance by UUID.
+// Complete the session creation process by verifying the code.
 //
-// DELETE /storage/postgres/{uuid}
-func (c *Client) StoragePostgresDelete(ctx context.Context, params StoragePostgresDeleteParams) error {
-	_, err := c.sendStoragePostgresDelete(ctx, params)
-	return err
+// PUT /telegram/{id}
+func (c *Client) TgSessionVerify(ctx context.Context, request *TgSessionVerifyReq, params TgSessionVerifyParams) (*Telegram, error) {
+	res, err := c.sendTgSessionVerify(ctx, request, params)
+	return res, err
 }
 
-func (c *Client) sendStoragePostgresDelete(ctx context.Context, params StoragePostgresDeleteParams) (res *StoragePostgresDeleteOK, err error) {
+func (c *Client) sendTgSessionVerify(ctx context.Context, request *TgSessionVerifyReq, params TgSessionVerifyParams) (res *Telegram, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-postgres-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/storage/postgres/{uuid}"),
+		otelogen.OperationID("tg-session-verify"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/telegram/{id}"),
 	}
 
 	// Run stopwatch.
@@ -11498,7 +18977,7 @@ func (c *Client) sendStoragePostgresDelete(ctx context.Context, params StoragePo
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, StoragePostgresDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, TgSessionVerifyOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -11516,16 +18995,16 @@ func (c *Client) sendStoragePostgresDelete(ctx context.Context, params StoragePo
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/storage/postgres/"
+	pathParts[0] = "/telegram/"
 	{
-		// Encode "uuid" parameter.
+		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
+			Param:   "id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
+			return e.EncodeValue(conv.IntToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -11538,17 +19017,20 @@ func (c *Client) sendStoragePostgresDelete(ctx context.Context, params StoragePo
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeTgSessionVerifyRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, StoragePostgresDeleteOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, TgSessionVerifyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11559,7 +19041,7 @@ func (c *Client) sendStoragePostgresDelete(ctx context.Context, params StoragePo
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, StoragePostgresDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, TgSessionVerifyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11570,7 +19052,7 @@ func (c *Client) sendStoragePostgresDelete(ctx context.Context, params StoragePo
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, StoragePostgresDeleteOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, TgSessionVerifyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11608,7 +19090,7 @@ func (c *Client) sendStoragePostgresDelete(ctx context.Context, params StoragePo
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeStoragePostgresDeleteResponse(resp)
+	result, err := decodeTgSessionVerifyResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -11616,21 +19098,21 @@ func (c *Client) sendStoragePostgresDelete(ctx context.Context, params StoragePo
 	return result, nil
 }
 
-// StoragePostgresGet invokes storage-postgres-get operation.
+// UpdateContact invokes updateContact operation.
 //
-// Retrieve details of a specific PostgreSQL storage instance by UUID.
+// Update contact details.
 //
-// GET /storage/postgres/{uuid}
-func (c *Client) StoragePostgresGet(ctx context.Context, params StoragePostgresGetParams) (*StoragePostgres, error) {
-	res, err := c.sendStoragePostgresGet(ctx, params)
+// PUT /contact/{uuid}
+func (c *Client) UpdateContact(ctx context.Context, request *Contact, params UpdateContactParams) (*Contact, error) {
+	res, err := c.sendUpdateContact(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendStoragePostgresGet(ctx context.Context, params StoragePostgresGetParams) (res *StoragePostgres, err error) {
+func (c *Client) sendUpdateContact(ctx context.Context, request *Contact, params UpdateContactParams) (res *Contact, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-postgres-get"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/storage/postgres/{uuid}"),
+		otelogen.OperationID("updateContact"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/contact/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -11645,7 +19127,7 @@ func (c *Client) sendStoragePostgresGet(ctx context.Context, params StoragePostg
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, StoragePostgresGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateContactOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -11663,7 +19145,7 @@ func (c *Client) sendStoragePostgresGet(ctx context.Context, params StoragePostg
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/storage/postgres/"
+	pathParts[0] = "/contact/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -11685,17 +19167,20 @@ func (c *Client) sendStoragePostgresGet(ctx context.Context, params StoragePostg
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUpdateContactRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, StoragePostgresGetOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, UpdateContactOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11706,7 +19191,7 @@ func (c *Client) sendStoragePostgresGet(ctx context.Context, params StoragePostg
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, StoragePostgresGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateContactOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11717,7 +19202,7 @@ func (c *Client) sendStoragePostgresGet(ctx context.Context, params StoragePostg
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, StoragePostgresGetOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, UpdateContactOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11755,7 +19240,7 @@ func (c *Client) sendStoragePostgresGet(ctx context.Context, params StoragePostg
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeStoragePostgresGetResponse(resp)
+	result, err := decodeUpdateContactResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -11763,21 +19248,21 @@ func (c *Client) sendStoragePostgresGet(ctx context.Context, params StoragePostg
 	return result, nil
 }
 
-// StoragePostgresUpdate invokes storage-postgres-update operation.
+// UpdateProfile invokes updateProfile operation.
 //
-// Update details of a specific PostgreSQL storage instance by UUID.
+// Update current user profile.
 //
-// PUT /storage/postgres/{uuid}
-func (c *Client) StoragePostgresUpdate(ctx context.Context, request *StoragePostgres, params StoragePostgresUpdateParams) (*StoragePostgres, error) {
-	res, err := c.sendStoragePostgresUpdate(ctx, request, params)
+// PUT /profile
+func (c *Client) UpdateProfile(ctx context.Context, request *UserProfile) (*User, error) {
+	res, err := c.sendUpdateProfile(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendStoragePostgresUpdate(ctx context.Context, request *StoragePostgres, params StoragePostgresUpdateParams) (res *StoragePostgres, err error) {
+func (c *Client) sendUpdateProfile(ctx context.Context, request *UserProfile) (res *User, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-postgres-update"),
+		otelogen.OperationID("updateProfile"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/storage/postgres/{uuid}"),
+		semconv.HTTPRouteKey.String("/profile"),
 	}
 
 	// Run stopwatch.
@@ -11792,7 +19277,7 @@ func (c *Client) sendStoragePostgresUpdate(ctx context.Context, request *Storage
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, StoragePostgresUpdateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateProfileOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -11809,26 +19294,8 @@ func (c *Client) sendStoragePostgresUpdate(ctx context.Context, request *Storage
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/storage/postgres/"
-	{
-		// Encode "uuid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/profile"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -11836,7 +19303,7 @@ func (c *Client) sendStoragePostgresUpdate(ctx context.Context, request *Storage
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeStoragePostgresUpdateRequest(request, r); err != nil {
+	if err := encodeUpdateProfileRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -11845,7 +19312,7 @@ func (c *Client) sendStoragePostgresUpdate(ctx context.Context, request *Storage
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, StoragePostgresUpdateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, UpdateProfileOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11856,7 +19323,7 @@ func (c *Client) sendStoragePostgresUpdate(ctx context.Context, request *Storage
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, StoragePostgresUpdateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateProfileOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11867,7 +19334,7 @@ func (c *Client) sendStoragePostgresUpdate(ctx context.Context, request *Storage
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, StoragePostgresUpdateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, UpdateProfileOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11905,7 +19372,7 @@ func (c *Client) sendStoragePostgresUpdate(ctx context.Context, request *Storage
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeStoragePostgresUpdateResponse(resp)
+	result, err := decodeUpdateProfileResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -11913,21 +19380,21 @@ func (c *Client) sendStoragePostgresUpdate(ctx context.Context, request *Storage
 	return result, nil
 }
 
-// StorageS3Create invokes storage-s3-create operation.
+// UpdateUser invokes updateUser operation.
 //
-// Create a new S3 storage instance.
+// Update user details.
 //
-// POST /storage/s3
-func (c *Client) StorageS3Create(ctx context.Context, request *StorageS3) (*StorageS3, error) {
-	res, err := c.sendStorageS3Create(ctx, request)
+// PUT /user/{uuid}
+func (c *Client) UpdateUser(ctx context.Context, request *User, params UpdateUserParams) (*User, error) {
+	res, err := c.sendUpdateUser(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendStorageS3Create(ctx context.Context, request *StorageS3) (res *StorageS3, err error) {
+func (c *Client) sendUpdateUser(ctx context.Context, request *User, params UpdateUserParams) (res *User, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-s3-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/storage/s3"),
+		otelogen.OperationID("updateUser"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/user/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -11942,7 +19409,7 @@ func (c *Client) sendStorageS3Create(ctx context.Context, request *StorageS3) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, StorageS3CreateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -11959,16 +19426,34 @@ func (c *Client) sendStorageS3Create(ctx context.Context, request *StorageS3) (r
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/storage/s3"
+	var pathParts [2]string
+	pathParts[0] = "/user/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeStorageS3CreateRequest(request, r); err != nil {
+	if err := encodeUpdateUserRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -11977,7 +19462,7 @@ func (c *Client) sendStorageS3Create(ctx context.Context, request *StorageS3) (r
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, StorageS3CreateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, UpdateUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11988,7 +19473,7 @@ func (c *Client) sendStorageS3Create(ctx context.Context, request *StorageS3) (r
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, StorageS3CreateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11999,7 +19484,7 @@ func (c *Client) sendStorageS3Create(ctx context.Context, request *StorageS3) (r
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, StorageS3CreateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, UpdateUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12037,7 +19522,7 @@ func (c *Client) sendStorageS3Create(ctx context.Context, request *StorageS3) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeStorageS3CreateResponse(resp)
+	result, err := decodeUpdateUserResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -12045,21 +19530,21 @@ func (c *Client) sendStorageS3Create(ctx context.Context, request *StorageS3) (r
 	return result, nil
 }
 
-// StorageS3Delete invokes storage-s3-delete operation.
+// UploadFile invokes uploadFile operation.
 //
-// Delete a specific S3 storage instance by UUID.
+// Upload a file.
 //
-// DELETE /storage/s3/{uuid}
-func (c *Client) StorageS3Delete(ctx context.Context, params StorageS3DeleteParams) error {
-	_, err := c.sendStorageS3Delete(ctx, params)
-	return err
+// POST /storage/upload
+func (c *Client) UploadFile(ctx context.Context, request *UploadFileRequest) (*UploadFileResponse, error) {
+	res, err := c.sendUploadFile(ctx, request)
+	return res, err
 }
 
-func (c *Client) sendStorageS3Delete(ctx context.Context, params StorageS3DeleteParams) (res *StorageS3DeleteOK, err error) {
+func (c *Client) sendUploadFile(ctx context.Context, request *UploadFileRequest) (res *UploadFileResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-s3-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/storage/s3/{uuid}"),
+		otelogen.OperationID("uploadFile"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/storage/upload"),
 	}
 
 	// Run stopwatch.
@@ -12074,7 +19559,7 @@ func (c *Client) sendStorageS3Delete(ctx context.Context, params StorageS3Delete
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, StorageS3DeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UploadFileOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -12091,40 +19576,25 @@ func (c *Client) sendStorageS3Delete(ctx context.Context, params StorageS3Delete
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/storage/s3/"
-	{
-		// Encode "uuid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/storage/upload"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUploadFileRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, StorageS3DeleteOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, UploadFileOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12135,7 +19605,7 @@ func (c *Client) sendStorageS3Delete(ctx context.Context, params StorageS3Delete
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, StorageS3DeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UploadFileOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12146,7 +19616,7 @@ func (c *Client) sendStorageS3Delete(ctx context.Context, params StorageS3Delete
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, StorageS3DeleteOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, UploadFileOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12184,7 +19654,7 @@ func (c *Client) sendStorageS3Delete(ctx context.Context, params StorageS3Delete
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeStorageS3DeleteResponse(resp)
+	result, err := decodeUploadFileResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -12192,21 +19662,21 @@ func (c *Client) sendStorageS3Delete(ctx context.Context, params StorageS3Delete
 	return result, nil
 }
 
-// StorageS3Get invokes storage-s3-get operation.
+// WebhookCreate invokes webhook-create operation.
 //
-// Retrieve details of a specific S3 storage instance by UUID.
+// Create a webhook. It receives the events emitted after its creation.
 //
-// GET /storage/s3/{uuid}
-func (c *Client) StorageS3Get(ctx context.Context, params StorageS3GetParams) (*StorageS3, error) {
-	res, err := c.sendStorageS3Get(ctx, params)
+// POST /webhook
+func (c *Client) WebhookCreate(ctx context.Context, request *Webhook) (*Webhook, error) {
+	res, err := c.sendWebhookCreate(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendStorageS3Get(ctx context.Context, params StorageS3GetParams) (res *StorageS3, err error) {
+func (c *Client) sendWebhookCreate(ctx context.Context, request *Webhook) (res *Webhook, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-s3-get"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/storage/s3/{uuid}"),
+		otelogen.OperationID("webhook-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/webhook"),
 	}
 
 	// Run stopwatch.
@@ -12221,7 +19691,7 @@ func (c *Client) sendStorageS3Get(ctx context.Context, params StorageS3GetParams
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, StorageS3GetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WebhookCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -12238,40 +19708,25 @@ func (c *Client) sendStorageS3Get(ctx context.Context, params StorageS3GetParams
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/storage/s3/"
-	{
-		// Encode "uuid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/webhook"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeWebhookCreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, StorageS3GetOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, WebhookCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12282,7 +19737,7 @@ func (c *Client) sendStorageS3Get(ctx context.Context, params StorageS3GetParams
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, StorageS3GetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, WebhookCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12293,7 +19748,7 @@ func (c *Client) sendStorageS3Get(ctx context.Context, params StorageS3GetParams
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, StorageS3GetOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, WebhookCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12331,7 +19786,7 @@ func (c *Client) sendStorageS3Get(ctx context.Context, params StorageS3GetParams
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeStorageS3GetResponse(resp)
+	result, err := decodeWebhookCreateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -12339,21 +19794,21 @@ func (c *Client) sendStorageS3Get(ctx context.Context, params StorageS3GetParams
 	return result, nil
 }
 
-// StorageS3Update invokes storage-s3-update operation.
+// WebhookDelete invokes webhook-delete operation.
 //
-// Update details of a specific S3 storage instance by UUID.
+// Delete a webhook.
 //
-// PUT /storage/s3/{uuid}
-func (c *Client) StorageS3Update(ctx context.Context, request *StorageS3, params StorageS3UpdateParams) (*StorageS3, error) {
-	res, err := c.sendStorageS3Update(ctx, request, params)
-	return res, err
+// DELETE /webhook/{uuid}
+func (c *Client) WebhookDelete(ctx context.Context, params WebhookDeleteParams) error {
+	_, err := c.sendWebhookDelete(ctx, params)
+	return err
 }
 
-func (c *Client) sendStorageS3Update(ctx context.Context, request *StorageS3, params StorageS3UpdateParams) (res *StorageS3, err error) {
+func (c *Client) sendWebhookDelete(ctx context.Context, params WebhookDeleteParams) (res *WebhookDeleteOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-s3-update"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/storage/s3/{uuid}"),
+		otelogen.OperationID("webhook-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/webhook/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -12368,7 +19823,7 @@ func (c *Client) sendStorageS3Update(ctx context.Context, request *StorageS3, pa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, StorageS3UpdateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WebhookDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -12386,7 +19841,7 @@ func (c *Client) sendStorageS3Update(ctx context.Context, request *StorageS3, pa
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/storage/s3/"
+	pathParts[0] = "/webhook/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -12408,20 +19863,17 @@ func (c *Client) sendStorageS3Update(ctx context.Context, request *StorageS3, pa
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeStorageS3UpdateRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, StorageS3UpdateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, WebhookDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12432,7 +19884,7 @@ func (c *Client) sendStorageS3Update(ctx context.Context, request *StorageS3, pa
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, StorageS3UpdateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, WebhookDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12443,7 +19895,7 @@ func (c *Client) sendStorageS3Update(ctx context.Context, request *StorageS3, pa
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, StorageS3UpdateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, WebhookDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12481,7 +19933,7 @@ func (c *Client) sendStorageS3Update(ctx context.Context, request *StorageS3, pa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeStorageS3UpdateResponse(resp)
+	result, err := decodeWebhookDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -12489,21 +19941,21 @@ func (c *Client) sendStorageS3Update(ctx context.Context, request *StorageS3, pa
 	return result, nil
 }
 
-// SyncpolicyCreate invokes syncpolicy-create operation.
+// WebhookGet invokes webhook-get operation.
 //
-// Create a new sync policy.
+// Retrieve a webhook.
 //
-// POST /syncpolicy
-func (c *Client) SyncpolicyCreate(ctx context.Context, request *SyncPolicy) (*SyncPolicy, error) {
-	res, err := c.sendSyncpolicyCreate(ctx, request)
+// GET /webhook/{uuid}
+func (c *Client) WebhookGet(ctx context.Context, params WebhookGetParams) (*Webhook, error) {
+	res, err := c.sendWebhookGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendSyncpolicyCreate(ctx context.Context, request *SyncPolicy) (res *SyncPolicy, err error) {
+func (c *Client) sendWebhookGet(ctx context.Context, params WebhookGetParams) (res *Webhook, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("syncpolicy-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/syncpolicy"),
+		otelogen.OperationID("webhook-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/webhook/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -12518,7 +19970,7 @@ func (c *Client) sendSyncpolicyCreate(ctx context.Context, request *SyncPolicy)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SyncpolicyCreateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WebhookGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -12526,34 +19978,49 @@ func (c *Client) sendSyncpolicyCreate(ctx context.Context, request *SyncPolicy)
 	var stage string
 	defer func() {
 		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/webhook/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-		span.End()
-	}()
-
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/syncpolicy"
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeSyncpolicyCreateRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, SyncpolicyCreateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, WebhookGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12564,7 +20031,7 @@ func (c *Client) sendSyncpolicyCreate(ctx context.Context, request *SyncPolicy)
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SyncpolicyCreateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, WebhookGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12575,7 +20042,7 @@ func (c *Client) sendSyncpolicyCreate(ctx context.Context, request *SyncPolicy)
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, SyncpolicyCreateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, WebhookGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12613,7 +20080,7 @@ func (c *Client) sendSyncpolicyCreate(ctx context.Context, request *SyncPolicy)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSyncpolicyCreateResponse(resp)
+	result, err := decodeWebhookGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -12621,21 +20088,21 @@ func (c *Client) sendSyncpolicyCreate(ctx context.Context, request *SyncPolicy)
 	return result, nil
 }
 
-// SyncpolicyDelete invokes syncpolicy-delete operation.
+// WebhookList invokes webhook-list operation.
 //
-// Delete a sync policy by uuid.
+// Retrieve the webhooks of the workspace.
 //
-// DELETE /syncpolicy/{uuid}
-func (c *Client) SyncpolicyDelete(ctx context.Context, params SyncpolicyDeleteParams) error {
-	_, err := c.sendSyncpolicyDelete(ctx, params)
-	return err
+// GET /webhook
+func (c *Client) WebhookList(ctx context.Context) ([]Webhook, error) {
+	res, err := c.sendWebhookList(ctx)
+	return res, err
 }
 
-func (c *Client) sendSyncpolicyDelete(ctx context.Context, params SyncpolicyDeleteParams) (res *SyncpolicyDeleteOK, err error) {
+func (c *Client) sendWebhookList(ctx context.Context) (res []Webhook, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("syncpolicy-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/syncpolicy/{uuid}"),
+		otelogen.OperationID("webhook-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/webhook"),
 	}
 
 	// Run stopwatch.
@@ -12650,7 +20117,7 @@ func (c *Client) sendSyncpolicyDelete(ctx context.Context, params SyncpolicyDele
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SyncpolicyDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WebhookListOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -12667,30 +20134,12 @@ func (c *Client) sendSyncpolicyDelete(ctx context.Context, params SyncpolicyDele
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/syncpolicy/"
-	{
-		// Encode "uuid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/webhook"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -12700,7 +20149,7 @@ func (c *Client) sendSyncpolicyDelete(ctx context.Context, params SyncpolicyDele
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, SyncpolicyDeleteOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, WebhookListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12711,7 +20160,7 @@ func (c *Client) sendSyncpolicyDelete(ctx context.Context, params SyncpolicyDele
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SyncpolicyDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, WebhookListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12722,7 +20171,7 @@ func (c *Client) sendSyncpolicyDelete(ctx context.Context, params SyncpolicyDele
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, SyncpolicyDeleteOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, WebhookListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12760,7 +20209,7 @@ func (c *Client) sendSyncpolicyDelete(ctx context.Context, params SyncpolicyDele
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSyncpolicyDeleteResponse(resp)
+	result, err := decodeWebhookListResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -12768,21 +20217,21 @@ func (c *Client) sendSyncpolicyDelete(ctx context.Context, params SyncpolicyDele
 	return result, nil
 }
 
-// SyncpolicyGet invokes syncpolicy-get operation.
+// WebhookUpdate invokes webhook-update operation.
 //
-// Retrieve a specific sync policy by uuid.
+// Update a webhook.
 //
-// GET /syncpolicy/{uuid}
-func (c *Client) SyncpolicyGet(ctx context.Context, params SyncpolicyGetParams) (*SyncPolicy, error) {
-	res, err := c.sendSyncpolicyGet(ctx, params)
+// PUT /webhook/{uuid}
+func (c *Client) WebhookUpdate(ctx context.Context, request *Webhook, params WebhookUpdateParams) (*Webhook, error) {
+	res, err := c.sendWebhookUpdate(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendSyncpolicyGet(ctx context.Context, params SyncpolicyGetParams) (res *SyncPolicy, err error) {
+func (c *Client) sendWebhookUpdate(ctx context.Context, request *Webhook, params WebhookUpdateParams) (res *Webhook, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("syncpolicy-get"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/syncpolicy/{uuid}"),
+		otelogen.OperationID("webhook-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/webhook/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -12797,7 +20246,7 @@ func (c *Client) sendSyncpolicyGet(ctx context.Context, params SyncpolicyGetPara
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SyncpolicyGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WebhookUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -12815,7 +20264,7 @@ func (c *Client) sendSyncpolicyGet(ctx context.Context, params SyncpolicyGetPara
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/syncpolicy/"
+	pathParts[0] = "/webhook/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -12837,17 +20286,20 @@ func (c *Client) sendSyncpolicyGet(ctx context.Context, params SyncpolicyGetPara
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeWebhookUpdateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, SyncpolicyGetOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, WebhookUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12858,7 +20310,7 @@ func (c *Client) sendSyncpolicyGet(ctx context.Context, params SyncpolicyGetPara
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SyncpolicyGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, WebhookUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12869,7 +20321,7 @@ func (c *Client) sendSyncpolicyGet(ctx context.Context, params SyncpolicyGetPara
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, SyncpolicyGetOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, WebhookUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12907,7 +20359,7 @@ func (c *Client) sendSyncpolicyGet(ctx context.Context, params SyncpolicyGetPara
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSyncpolicyGetResponse(resp)
+	result, err := decodeWebhookUpdateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -12915,21 +20367,21 @@ func (c *Client) sendSyncpolicyGet(ctx context.Context, params SyncpolicyGetPara
 	return result, nil
 }
 
-// SyncpolicyList invokes syncpolicy-list operation.
+// WorkerJobsCancel invokes worker-jobs-cancel operation.
 //
-// Retrieve a list of sync policies for the authenticated user.
+// Signal cancellation for a running job; returns 204 if accepted.
 //
-// GET /syncpolicy
-func (c *Client) SyncpolicyList(ctx context.Context, params SyncpolicyListParams) (*SyncpolicyListOK, error) {
-	res, err := c.sendSyncpolicyList(ctx, params)
-	return res, err
+// POST /workerjobs/{uuid}/cancel
+func (c *Client) WorkerJobsCancel(ctx context.Context, params WorkerJobsCancelParams) error {
+	_, err := c.sendWorkerJobsCancel(ctx, params)
+	return err
 }
 
-func (c *Client) sendSyncpolicyList(ctx context.Context, params SyncpolicyListParams) (res *SyncpolicyListOK, err error) {
+func (c *Client) sendWorkerJobsCancel(ctx context.Context, params WorkerJobsCancelParams) (res *WorkerJobsCancelNoContent, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("syncpolicy-list"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/syncpolicy"),
+		otelogen.OperationID("worker-jobs-cancel"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/workerjobs/{uuid}/cancel"),
 	}
 
 	// Run stopwatch.
@@ -12944,7 +20396,7 @@ func (c *Client) sendSyncpolicyList(ctx context.Context, params SyncpolicyListPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SyncpolicyListOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WorkerJobsCancelOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -12961,50 +20413,31 @@ func (c *Client) sendSyncpolicyList(ctx context.Context, params SyncpolicyListPa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/syncpolicy"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "offset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Offset.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	var pathParts [3]string
+	pathParts[0] = "/workerjobs/"
 	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/cancel"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -13014,7 +20447,7 @@ func (c *Client) sendSyncpolicyList(ctx context.Context, params SyncpolicyListPa
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, SyncpolicyListOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, WorkerJobsCancelOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13025,7 +20458,7 @@ func (c *Client) sendSyncpolicyList(ctx context.Context, params SyncpolicyListPa
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SyncpolicyListOperation, r); {
+			switch err := c.securityBearerAuth(ctx, WorkerJobsCancelOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13036,7 +20469,7 @@ func (c *Client) sendSyncpolicyList(ctx context.Context, params SyncpolicyListPa
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, SyncpolicyListOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, WorkerJobsCancelOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13074,7 +20507,7 @@ func (c *Client) sendSyncpolicyList(ctx context.Context, params SyncpolicyListPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSyncpolicyListResponse(resp)
+	result, err := decodeWorkerJobsCancelResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -13082,21 +20515,21 @@ func (c *Client) sendSyncpolicyList(ctx context.Context, params SyncpolicyListPa
 	return result, nil
 }
 
-// SyncpolicyUpdate invokes syncpolicy-update operation.
+// WorkerJobsDelete invokes worker-jobs-delete operation.
 //
-// Update a sync policy by uuid.
+// Delete a worker job by uuid.
 //
-// PUT /syncpolicy/{uuid}
-func (c *Client) SyncpolicyUpdate(ctx context.Context, request *SyncPolicy, params SyncpolicyUpdateParams) (*SyncPolicy, error) {
-	res, err := c.sendSyncpolicyUpdate(ctx, request, params)
-	return res, err
+// DELETE /workerjobs/{uuid}
+func (c *Client) WorkerJobsDelete(ctx context.Context, params WorkerJobsDeleteParams) error {
+	_, err := c.sendWorkerJobsDelete(ctx, params)
+	return err
 }
 
-func (c *Client) sendSyncpolicyUpdate(ctx context.Context, request *SyncPolicy, params SyncpolicyUpdateParams) (res *SyncPolicy, err error) {
+func (c *Client) sendWorkerJobsDelete(ctx context.Context, params WorkerJobsDeleteParams) (res *WorkerJobsDeleteOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("syncpolicy-update"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/syncpolicy/{uuid}"),
+		otelogen.OperationID("worker-jobs-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/workerjobs/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -13111,7 +20544,7 @@ func (c *Client) sendSyncpolicyUpdate(ctx context.Context, request *SyncPolicy,
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SyncpolicyUpdateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WorkerJobsDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -13129,7 +20562,7 @@ func (c *Client) sendSyncpolicyUpdate(ctx context.Context, request *SyncPolicy,
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/syncpolicy/"
+	pathParts[0] = "/workerjobs/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -13151,20 +20584,17 @@ func (c *Client) sendSyncpolicyUpdate(ctx context.Context, request *SyncPolicy,
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeSyncpolicyUpdateRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, SyncpolicyUpdateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, WorkerJobsDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13175,7 +20605,7 @@ func (c *Client) sendSyncpolicyUpdate(ctx context.Context, request *SyncPolicy,
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SyncpolicyUpdateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, WorkerJobsDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13186,7 +20616,7 @@ func (c *Client) sendSyncpolicyUpdate(ctx context.Context, request *SyncPolicy,
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, SyncpolicyUpdateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, WorkerJobsDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13224,7 +20654,7 @@ func (c *Client) sendSyncpolicyUpdate(ctx context.Context, request *SyncPolicy,
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSyncpolicyUpdateResponse(resp)
+	result, err := decodeWorkerJobsDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -13232,21 +20662,21 @@ func (c *Client) sendSyncpolicyUpdate(ctx context.Context, request *SyncPolicy,
 	return result, nil
 }
 
-// TgSessionCreate invokes tg-session-create operation.
+// WorkerJobsGet invokes worker-jobs-get operation.
 //
-// Create a new Telegram session.
+// Retrieve a specific worker job by uuid.
 //
-// POST /telegram
-func (c *Client) TgSessionCreate(ctx context.Context, request *TgSessionCreateReq) (*Telegram, error) {
-	res, err := c.sendTgSessionCreate(ctx, request)
+// GET /workerjobs/{uuid}
+func (c *Client) WorkerJobsGet(ctx context.Context, params WorkerJobsGetParams) (*WorkerJobs, error) {
+	res, err := c.sendWorkerJobsGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendTgSessionCreate(ctx context.Context, request *TgSessionCreateReq) (res *Telegram, err error) {
+func (c *Client) sendWorkerJobsGet(ctx context.Context, params WorkerJobsGetParams) (res *WorkerJobs, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("tg-session-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/telegram"),
+		otelogen.OperationID("worker-jobs-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/workerjobs/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -13261,7 +20691,7 @@ func (c *Client) sendTgSessionCreate(ctx context.Context, request *TgSessionCrea
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, TgSessionCreateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WorkerJobsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -13269,34 +20699,49 @@ func (c *Client) sendTgSessionCreate(ctx context.Context, request *TgSessionCrea
 	var stage string
 	defer func() {
 		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/workerjobs/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-		span.End()
-	}()
-
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/telegram"
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeTgSessionCreateRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, TgSessionCreateOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, WorkerJobsGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13307,7 +20752,7 @@ func (c *Client) sendTgSessionCreate(ctx context.Context, request *TgSessionCrea
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, TgSessionCreateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, WorkerJobsGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13318,7 +20763,7 @@ func (c *Client) sendTgSessionCreate(ctx context.Context, request *TgSessionCrea
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, TgSessionCreateOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, WorkerJobsGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13356,7 +20801,7 @@ func (c *Client) sendTgSessionCreate(ctx context.Context, request *TgSessionCrea
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeTgSessionCreateResponse(resp)
+	result, err := decodeWorkerJobsGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -13364,21 +20809,21 @@ func (c *Client) sendTgSessionCreate(ctx context.Context, request *TgSessionCrea
 	return result, nil
 }
 
-// TgSessionList invokes tg-session-list operation.
+// WorkerJobsList invokes worker-jobs-list operation.
 //
-// List all Telegram sessions for the authenticated user.
+// Retrieve a list of worker jobs.
 //
-// GET /telegram
-func (c *Client) TgSessionList(ctx context.Context) (*TgSessionListOK, error) {
-	res, err := c.sendTgSessionList(ctx)
+// GET /workerjobs
+func (c *Client) WorkerJobsList(ctx context.Context, params WorkerJobsListParams) (*WorkerJobsListOK, error) {
+	res, err := c.sendWorkerJobsList(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendTgSessionList(ctx context.Context) (res *TgSessionListOK, err error) {
+func (c *Client) sendWorkerJobsList(ctx context.Context, params WorkerJobsListParams) (res *WorkerJobsListOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("tg-session-list"),
+		otelogen.OperationID("worker-jobs-list"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/telegram"),
+		semconv.HTTPRouteKey.String("/workerjobs"),
 	}
 
 	// Run stopwatch.
@@ -13393,7 +20838,7 @@ func (c *Client) sendTgSessionList(ctx context.Context) (res *TgSessionListOK, e
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, TgSessionListOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WorkerJobsListOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -13411,9 +20856,115 @@ func (c *Client) sendTgSessionList(ctx context.Context) (res *TgSessionListOK, e
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/telegram"
+	pathParts[0] = "/workerjobs"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "status" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Status.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "subject" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "subject",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Subject.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "scheduler_uuid" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "scheduler_uuid",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.SchedulerUUID.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "pipeline_uuid" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "pipeline_uuid",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.PipelineUUID.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -13425,7 +20976,7 @@ func (c *Client) sendTgSessionList(ctx context.Context) (res *TgSessionListOK, e
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, TgSessionListOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, WorkerJobsListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13436,7 +20987,7 @@ func (c *Client) sendTgSessionList(ctx context.Context) (res *TgSessionListOK, e
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, TgSessionListOperation, r); {
+			switch err := c.securityBearerAuth(ctx, WorkerJobsListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13447,7 +20998,7 @@ func (c *Client) sendTgSessionList(ctx context.Context) (res *TgSessionListOK, e
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, TgSessionListOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, WorkerJobsListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13485,7 +21036,7 @@ func (c *Client) sendTgSessionList(ctx context.Context) (res *TgSessionListOK, e
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeTgSessionListResponse(resp)
+	result, err := decodeWorkerJobsListResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -13493,21 +21044,21 @@ func (c *Client) sendTgSessionList(ctx context.Context) (res *TgSessionListOK, e
 	return result, nil
 }
 
-// TgSessionVerify invokes tg-session-verify operation.
+// WorkerJobsRetry invokes worker-jobs-retry operation.
 //
-// Complete the session creation process by verifying the code.
+// Publish the job again under a new uuid with the arguments it was queued with. Returns the new job.
 //
-// PUT /telegram/{id}
-func (c *Client) TgSessionVerify(ctx context.Context, request *TgSessionVerifyReq, params TgSessionVerifyParams) (*Telegram, error) {
-	res, err := c.sendTgSessionVerify(ctx, request, params)
+// POST /workerjobs/{uuid}/retry
+func (c *Client) WorkerJobsRetry(ctx context.Context, params WorkerJobsRetryParams) (*WorkerJobs, error) {
+	res, err := c.sendWorkerJobsRetry(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendTgSessionVerify(ctx context.Context, request *TgSessionVerifyReq, params TgSessionVerifyParams) (res *Telegram, err error) {
+func (c *Client) sendWorkerJobsRetry(ctx context.Context, params WorkerJobsRetryParams) (res *WorkerJobs, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("tg-session-verify"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/telegram/{id}"),
+		otelogen.OperationID("worker-jobs-retry"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/workerjobs/{uuid}/retry"),
 	}
 
 	// Run stopwatch.
@@ -13522,7 +21073,7 @@ func (c *Client) sendTgSessionVerify(ctx context.Context, request *TgSessionVeri
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, TgSessionVerifyOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WorkerJobsRetryOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -13539,17 +21090,17 @@ func (c *Client) sendTgSessionVerify(ctx context.Context, request *TgSessionVeri
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/telegram/"
+	var pathParts [3]string
+	pathParts[0] = "/workerjobs/"
 	{
-		// Encode "id" parameter.
+		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
+			Param:   "uuid",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
+			return e.EncodeValue(conv.StringToString(params.UUID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -13559,23 +21110,21 @@ func (c *Client) sendTgSessionVerify(ctx context.Context, request *TgSessionVeri
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/retry"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeTgSessionVerifyRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, TgSessionVerifyOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, WorkerJobsRetryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13586,7 +21135,7 @@ func (c *Client) sendTgSessionVerify(ctx context.Context, request *TgSessionVeri
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, TgSessionVerifyOperation, r); {
+			switch err := c.securityBearerAuth(ctx, WorkerJobsRetryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13597,7 +21146,7 @@ func (c *Client) sendTgSessionVerify(ctx context.Context, request *TgSessionVeri
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, TgSessionVerifyOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, WorkerJobsRetryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13635,7 +21184,7 @@ func (c *Client) sendTgSessionVerify(ctx context.Context, request *TgSessionVeri
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeTgSessionVerifyResponse(resp)
+	result, err := decodeWorkerJobsRetryResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -13643,21 +21192,21 @@ func (c *Client) sendTgSessionVerify(ctx context.Context, request *TgSessionVeri
 	return result, nil
 }
 
-// UpdateContact invokes updateContact operation.
+// WorkspaceCreate invokes workspace-create operation.
 //
-// Update contact details.
+// Create a new workspace, the caller becomes its owner.
 //
-// PUT /contact/{uuid}
-func (c *Client) UpdateContact(ctx context.Context, request *Contact, params UpdateContactParams) (*Contact, error) {
-	res, err := c.sendUpdateContact(ctx, request, params)
+// POST /workspace
+func (c *Client) WorkspaceCreate(ctx context.Context, request *Workspace) (*Workspace, error) {
+	res, err := c.sendWorkspaceCreate(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendUpdateContact(ctx context.Context, request *Contact, params UpdateContactParams) (res *Contact, err error) {
+func (c *Client) sendWorkspaceCreate(ctx context.Context, request *Workspace) (res *Workspace, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateContact"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/contact/{uuid}"),
+		otelogen.OperationID("workspace-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/workspace"),
 	}
 
 	// Run stopwatch.
@@ -13672,7 +21221,7 @@ func (c *Client) sendUpdateContact(ctx context.Context, request *Contact, params
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateContactOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WorkspaceCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -13689,34 +21238,16 @@ func (c *Client) sendUpdateContact(ctx context.Context, request *Contact, params
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/contact/"
-	{
-		// Encode "uuid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uuid",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UUID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/workspace"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateContactRequest(request, r); err != nil {
+	if err := encodeWorkspaceCreateRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -13725,7 +21256,7 @@ func (c *Client) sendUpdateContact(ctx context.Context, request *Contact, params
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, UpdateContactOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, WorkspaceCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13736,7 +21267,7 @@ func (c *Client) sendUpdateContact(ctx context.Context, request *Contact, params
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UpdateContactOperation, r); {
+			switch err := c.securityBearerAuth(ctx, WorkspaceCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13747,7 +21278,7 @@ func (c *Client) sendUpdateContact(ctx context.Context, request *Contact, params
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, UpdateContactOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, WorkspaceCreateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13785,7 +21316,7 @@ func (c *Client) sendUpdateContact(ctx context.Context, request *Contact, params
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateContactResponse(resp)
+	result, err := decodeWorkspaceCreateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -13793,21 +21324,21 @@ func (c *Client) sendUpdateContact(ctx context.Context, request *Contact, params
 	return result, nil
 }
 
-// UpdateProfile invokes updateProfile operation.
+// WorkspaceDelete invokes workspace-delete operation.
 //
-// Update current user profile.
+// Delete a workspace and every resource it owns. Requires the owner role.
 //
-// PUT /profile
-func (c *Client) UpdateProfile(ctx context.Context, request *UserProfile) (*User, error) {
-	res, err := c.sendUpdateProfile(ctx, request)
-	return res, err
+// DELETE /workspace/{uuid}
+func (c *Client) WorkspaceDelete(ctx context.Context, params WorkspaceDeleteParams) error {
+	_, err := c.sendWorkspaceDelete(ctx, params)
+	return err
 }
 
-func (c *Client) sendUpdateProfile(ctx context.Context, request *UserProfile) (res *User, err error) {
+func (c *Client) sendWorkspaceDelete(ctx context.Context, params WorkspaceDeleteParams) (res *WorkspaceDeleteOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateProfile"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/profile"),
+		otelogen.OperationID("workspace-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/workspace/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -13822,7 +21353,7 @@ func (c *Client) sendUpdateProfile(ctx context.Context, request *UserProfile) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateProfileOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WorkspaceDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -13830,34 +21361,49 @@ func (c *Client) sendUpdateProfile(ctx context.Context, request *UserProfile) (r
 	var stage string
 	defer func() {
 		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/workspace/"
+	{
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-		span.End()
-	}()
-
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/profile"
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateProfileRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, UpdateProfileOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, WorkspaceDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13868,7 +21414,7 @@ func (c *Client) sendUpdateProfile(ctx context.Context, request *UserProfile) (r
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UpdateProfileOperation, r); {
+			switch err := c.securityBearerAuth(ctx, WorkspaceDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13879,7 +21425,7 @@ func (c *Client) sendUpdateProfile(ctx context.Context, request *UserProfile) (r
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, UpdateProfileOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, WorkspaceDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13917,7 +21463,7 @@ func (c *Client) sendUpdateProfile(ctx context.Context, request *UserProfile) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateProfileResponse(resp)
+	result, err := decodeWorkspaceDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -13925,21 +21471,21 @@ func (c *Client) sendUpdateProfile(ctx context.Context, request *UserProfile) (r
 	return result, nil
 }
 
-// UpdateUser invokes updateUser operation.
+// WorkspaceGet invokes workspace-get operation.
 //
-// Update user details.
+// Retrieve a workspace by UUID.
 //
-// PUT /user/{uuid}
-func (c *Client) UpdateUser(ctx context.Context, request *User, params UpdateUserParams) (*User, error) {
-	res, err := c.sendUpdateUser(ctx, request, params)
+// GET /workspace/{uuid}
+func (c *Client) WorkspaceGet(ctx context.Context, params WorkspaceGetParams) (*Workspace, error) {
+	res, err := c.sendWorkspaceGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendUpdateUser(ctx context.Context, request *User, params UpdateUserParams) (res *User, err error) {
+func (c *Client) sendWorkspaceGet(ctx context.Context, params WorkspaceGetParams) (res *Workspace, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateUser"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/user/{uuid}"),
+		otelogen.OperationID("workspace-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/workspace/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -13954,7 +21500,7 @@ func (c *Client) sendUpdateUser(ctx context.Context, request *User, params Updat
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateUserOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WorkspaceGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -13972,7 +21518,7 @@ func (c *Client) sendUpdateUser(ctx context.Context, request *User, params Updat
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/user/"
+	pathParts[0] = "/workspace/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -13994,20 +21540,17 @@ func (c *Client) sendUpdateUser(ctx context.Context, request *User, params Updat
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateUserRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, UpdateUserOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, WorkspaceGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -14018,7 +21561,7 @@ func (c *Client) sendUpdateUser(ctx context.Context, request *User, params Updat
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UpdateUserOperation, r); {
+			switch err := c.securityBearerAuth(ctx, WorkspaceGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -14029,7 +21572,7 @@ func (c *Client) sendUpdateUser(ctx context.Context, request *User, params Updat
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, UpdateUserOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, WorkspaceGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -14067,7 +21610,7 @@ func (c *Client) sendUpdateUser(ctx context.Context, request *User, params Updat
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateUserResponse(resp)
+	result, err := decodeWorkspaceGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -14075,21 +21618,21 @@ func (c *Client) sendUpdateUser(ctx context.Context, request *User, params Updat
 	return result, nil
 }
 
-// UploadFile invokes uploadFile operation.
+// WorkspaceList invokes workspace-list operation.
 //
-// Upload a file.
+// Retrieve the workspaces of the caller. The bearer token sees every workspace.
 //
-// POST /storage/upload
-func (c *Client) UploadFile(ctx context.Context, request *UploadFileRequest) (*UploadFileResponse, error) {
-	res, err := c.sendUploadFile(ctx, request)
+// GET /workspace
+func (c *Client) WorkspaceList(ctx context.Context, params WorkspaceListParams) ([]Workspace, error) {
+	res, err := c.sendWorkspaceList(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendUploadFile(ctx context.Context, request *UploadFileRequest) (res *UploadFileResponse, err error) {
+func (c *Client) sendWorkspaceList(ctx context.Context, params WorkspaceListParams) (res []Workspace, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("uploadFile"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/storage/upload"),
+		otelogen.OperationID("workspace-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/workspace"),
 	}
 
 	// Run stopwatch.
@@ -14104,7 +21647,7 @@ func (c *Client) sendUploadFile(ctx context.Context, request *UploadFileRequest)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UploadFileOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WorkspaceListOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -14122,24 +21665,59 @@ func (c *Client) sendUploadFile(ctx context.Context, request *UploadFileRequest)
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/storage/upload"
+	pathParts[0] = "/workspace"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUploadFileRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, UploadFileOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, WorkspaceListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -14150,7 +21728,7 @@ func (c *Client) sendUploadFile(ctx context.Context, request *UploadFileRequest)
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UploadFileOperation, r); {
+			switch err := c.securityBearerAuth(ctx, WorkspaceListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -14161,7 +21739,7 @@ func (c *Client) sendUploadFile(ctx context.Context, request *UploadFileRequest)
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, UploadFileOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, WorkspaceListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -14199,7 +21777,7 @@ func (c *Client) sendUploadFile(ctx context.Context, request *UploadFileRequest)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUploadFileResponse(resp)
+	result, err := decodeWorkspaceListResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -14207,21 +21785,21 @@ func (c *Client) sendUploadFile(ctx context.Context, request *UploadFileRequest)
 	return result, nil
 }
 
-// WorkerJobsCancel invokes worker-jobs-cancel operation.
+// WorkspaceMemberDelete invokes workspace-member-delete operation.
 //
-// Signal cancellation for a running job; returns 204 if accepted.
+// Remove a user from a workspace. The last owner cannot be removed.
 //
-// POST /workerjobs/{uuid}/cancel
-func (c *Client) WorkerJobsCancel(ctx context.Context, params WorkerJobsCancelParams) error {
-	_, err := c.sendWorkerJobsCancel(ctx, params)
+// DELETE /workspace/{uuid}/member/{user_uuid}
+func (c *Client) WorkspaceMemberDelete(ctx context.Context, params WorkspaceMemberDeleteParams) error {
+	_, err := c.sendWorkspaceMemberDelete(ctx, params)
 	return err
 }
 
-func (c *Client) sendWorkerJobsCancel(ctx context.Context, params WorkerJobsCancelParams) (res *WorkerJobsCancelNoContent, err error) {
+func (c *Client) sendWorkspaceMemberDelete(ctx context.Context, params WorkspaceMemberDeleteParams) (res *WorkspaceMemberDeleteOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("worker-jobs-cancel"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/workerjobs/{uuid}/cancel"),
+		otelogen.OperationID("workspace-member-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/workspace/{uuid}/member/{user_uuid}"),
 	}
 
 	// Run stopwatch.
@@ -14236,7 +21814,7 @@ func (c *Client) sendWorkerJobsCancel(ctx context.Context, params WorkerJobsCanc
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, WorkerJobsCancelOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WorkspaceMemberDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -14253,8 +21831,8 @@ func (c *Client) sendWorkerJobsCancel(ctx context.Context, params WorkerJobsCanc
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/workerjobs/"
+	var pathParts [4]string
+	pathParts[0] = "/workspace/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -14273,11 +21851,29 @@ func (c *Client) sendWorkerJobsCancel(ctx context.Context, params WorkerJobsCanc
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/cancel"
+	pathParts[2] = "/member/"
+	{
+		// Encode "user_uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "user_uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UserUUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -14287,7 +21883,7 @@ func (c *Client) sendWorkerJobsCancel(ctx context.Context, params WorkerJobsCanc
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, WorkerJobsCancelOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, WorkspaceMemberDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -14298,7 +21894,7 @@ func (c *Client) sendWorkerJobsCancel(ctx context.Context, params WorkerJobsCanc
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, WorkerJobsCancelOperation, r); {
+			switch err := c.securityBearerAuth(ctx, WorkspaceMemberDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -14309,7 +21905,7 @@ func (c *Client) sendWorkerJobsCancel(ctx context.Context, params WorkerJobsCanc
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, WorkerJobsCancelOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, WorkspaceMemberDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -14347,7 +21943,7 @@ func (c *Client) sendWorkerJobsCancel(ctx context.Context, params WorkerJobsCanc
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeWorkerJobsCancelResponse(resp)
+	result, err := decodeWorkspaceMemberDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -14355,21 +21951,21 @@ func (c *Client) sendWorkerJobsCancel(ctx context.Context, params WorkerJobsCanc
 	return result, nil
 }
 
-// WorkerJobsDelete invokes worker-jobs-delete operation.
+// WorkspaceMemberList invokes workspace-member-list operation.
 //
-// Delete a worker job by uuid.
+// Retrieve the members of a workspace.
 //
-// DELETE /workerjobs/{uuid}
-func (c *Client) WorkerJobsDelete(ctx context.Context, params WorkerJobsDeleteParams) error {
-	_, err := c.sendWorkerJobsDelete(ctx, params)
-	return err
+// GET /workspace/{uuid}/member
+func (c *Client) WorkspaceMemberList(ctx context.Context, params WorkspaceMemberListParams) ([]WorkspaceMember, error) {
+	res, err := c.sendWorkspaceMemberList(ctx, params)
+	return res, err
 }
 
-func (c *Client) sendWorkerJobsDelete(ctx context.Context, params WorkerJobsDeleteParams) (res *WorkerJobsDeleteOK, err error) {
+func (c *Client) sendWorkspaceMemberList(ctx context.Context, params WorkspaceMemberListParams) (res []WorkspaceMember, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("worker-jobs-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/workerjobs/{uuid}"),
+		otelogen.OperationID("workspace-member-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/workspace/{uuid}/member"),
 	}
 
 	// Run stopwatch.
@@ -14384,7 +21980,7 @@ func (c *Client) sendWorkerJobsDelete(ctx context.Context, params WorkerJobsDele
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, WorkerJobsDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WorkspaceMemberListOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -14401,8 +21997,8 @@ func (c *Client) sendWorkerJobsDelete(ctx context.Context, params WorkerJobsDele
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/workerjobs/"
+	var pathParts [3]string
+	pathParts[0] = "/workspace/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -14421,10 +22017,11 @@ func (c *Client) sendWorkerJobsDelete(ctx context.Context, params WorkerJobsDele
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/member"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -14434,7 +22031,7 @@ func (c *Client) sendWorkerJobsDelete(ctx context.Context, params WorkerJobsDele
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, WorkerJobsDeleteOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, WorkspaceMemberListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -14445,7 +22042,7 @@ func (c *Client) sendWorkerJobsDelete(ctx context.Context, params WorkerJobsDele
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, WorkerJobsDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, WorkspaceMemberListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -14456,7 +22053,7 @@ func (c *Client) sendWorkerJobsDelete(ctx context.Context, params WorkerJobsDele
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, WorkerJobsDeleteOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, WorkspaceMemberListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -14494,7 +22091,7 @@ func (c *Client) sendWorkerJobsDelete(ctx context.Context, params WorkerJobsDele
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeWorkerJobsDeleteResponse(resp)
+	result, err := decodeWorkspaceMemberListResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -14502,21 +22099,21 @@ func (c *Client) sendWorkerJobsDelete(ctx context.Context, params WorkerJobsDele
 	return result, nil
 }
 
-// WorkerJobsGet invokes worker-jobs-get operation.
+// WorkspaceMemberSet invokes workspace-member-set operation.
 //
-// Retrieve a specific worker job by uuid.
+// Add a user to a workspace or change the role of a member. Requires the owner or admin role.
 //
-// GET /workerjobs/{uuid}
-func (c *Client) WorkerJobsGet(ctx context.Context, params WorkerJobsGetParams) (*WorkerJobs, error) {
-	res, err := c.sendWorkerJobsGet(ctx, params)
+// POST /workspace/{uuid}/member
+func (c *Client) WorkspaceMemberSet(ctx context.Context, request *WorkspaceMember, params WorkspaceMemberSetParams) (*WorkspaceMember, error) {
+	res, err := c.sendWorkspaceMemberSet(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendWorkerJobsGet(ctx context.Context, params WorkerJobsGetParams) (res *WorkerJobs, err error) {
+func (c *Client) sendWorkspaceMemberSet(ctx context.Context, request *WorkspaceMember, params WorkspaceMemberSetParams) (res *WorkspaceMember, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("worker-jobs-get"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/workerjobs/{uuid}"),
+		otelogen.OperationID("workspace-member-set"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/workspace/{uuid}/member"),
 	}
 
 	// Run stopwatch.
@@ -14531,7 +22128,7 @@ func (c *Client) sendWorkerJobsGet(ctx context.Context, params WorkerJobsGetPara
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, WorkerJobsGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WorkspaceMemberSetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -14548,8 +22145,8 @@ func (c *Client) sendWorkerJobsGet(ctx context.Context, params WorkerJobsGetPara
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/workerjobs/"
+	var pathParts [3]string
+	pathParts[0] = "/workspace/"
 	{
 		// Encode "uuid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -14568,20 +22165,24 @@ func (c *Client) sendWorkerJobsGet(ctx context.Context, params WorkerJobsGetPara
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/member"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeWorkspaceMemberSetRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, WorkerJobsGetOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, WorkspaceMemberSetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -14592,7 +22193,7 @@ func (c *Client) sendWorkerJobsGet(ctx context.Context, params WorkerJobsGetPara
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, WorkerJobsGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, WorkspaceMemberSetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -14603,7 +22204,7 @@ func (c *Client) sendWorkerJobsGet(ctx context.Context, params WorkerJobsGetPara
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, WorkerJobsGetOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, WorkspaceMemberSetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -14641,7 +22242,7 @@ func (c *Client) sendWorkerJobsGet(ctx context.Context, params WorkerJobsGetPara
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeWorkerJobsGetResponse(resp)
+	result, err := decodeWorkspaceMemberSetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -14649,21 +22250,21 @@ func (c *Client) sendWorkerJobsGet(ctx context.Context, params WorkerJobsGetPara
 	return result, nil
 }
 
-// WorkerJobsList invokes worker-jobs-list operation.
+// WorkspaceUpdate invokes workspace-update operation.
 //
-// Retrieve a list of worker jobs.
+// Update a workspace by UUID. Requires the owner or admin role.
 //
-// GET /workerjobs
-func (c *Client) WorkerJobsList(ctx context.Context, params WorkerJobsListParams) (*WorkerJobsListOK, error) {
-	res, err := c.sendWorkerJobsList(ctx, params)
+// PUT /workspace/{uuid}
+func (c *Client) WorkspaceUpdate(ctx context.Context, request *Workspace, params WorkspaceUpdateParams) (*Workspace, error) {
+	res, err := c.sendWorkspaceUpdate(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendWorkerJobsList(ctx context.Context, params WorkerJobsListParams) (res *WorkerJobsListOK, err error) {
+func (c *Client) sendWorkspaceUpdate(ctx context.Context, request *Workspace, params WorkspaceUpdateParams) (res *Workspace, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("worker-jobs-list"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/workerjobs"),
+		otelogen.OperationID("workspace-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/workspace/{uuid}"),
 	}
 
 	// Run stopwatch.
@@ -14678,7 +22279,7 @@ func (c *Client) sendWorkerJobsList(ctx context.Context, params WorkerJobsListPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, WorkerJobsListOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WorkspaceUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -14695,60 +22296,43 @@ func (c *Client) sendWorkerJobsList(ctx context.Context, params WorkerJobsListPa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/workerjobs"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "offset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Offset.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	var pathParts [2]string
+	pathParts[0] = "/workspace/"
 	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.Int32ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeWorkspaceUpdateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:ZitadelCookieAuth"
-			switch err := c.securityZitadelCookieAuth(ctx, WorkerJobsListOperation, r); {
+			switch err := c.securityZitadelCookieAuth(ctx, WorkspaceUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -14759,7 +22343,7 @@ func (c *Client) sendWorkerJobsList(ctx context.Context, params WorkerJobsListPa
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, WorkerJobsListOperation, r); {
+			switch err := c.securityBearerAuth(ctx, WorkspaceUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -14770,7 +22354,7 @@ func (c *Client) sendWorkerJobsList(ctx context.Context, params WorkerJobsListPa
 		}
 		{
 			stage = "Security:PlainCookieAuth"
-			switch err := c.securityPlainCookieAuth(ctx, WorkerJobsListOperation, r); {
+			switch err := c.securityPlainCookieAuth(ctx, WorkspaceUpdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -14808,7 +22392,7 @@ func (c *Client) sendWorkerJobsList(ctx context.Context, params WorkerJobsListPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeWorkerJobsListResponse(resp)
+	result, err := decodeWorkspaceUpdateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
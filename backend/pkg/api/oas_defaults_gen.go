@@ -0,0 +1,15 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// setDefaults set default value of fields.
+func (s *EmailSend) setDefaults() {
+	{
+		val := EmailSendContentType("text")
+		s.ContentType.SetTo(val)
+	}
+	{
+		val := bool(true)
+		s.SaveToSentItems.SetTo(val)
+	}
+}
@@ -30,22 +30,22 @@ func (c *codeRecorder) WriteHeader(status int) {
 	c.ResponseWriter.WriteHeader(status)
 }
 
-// handleCreateContactRequest handles createContact operation.
+// handleApikeyCreateRequest handles apikey-create operation.
 //
-// Create a new contact record.
+// Create an API key. The response is the only time the key is shown.
 //
-// POST /contact
-func (s *Server) handleCreateContactRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /apikey
+func (s *Server) handleApikeyCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createContact"),
+		otelogen.OperationID("apikey-create"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/contact"),
+		semconv.HTTPRouteKey.String("/apikey"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateContactOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ApikeyCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -100,15 +100,15 @@ func (s *Server) handleCreateContactRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateContactOperation,
-			ID:   "createContact",
+			Name: ApikeyCreateOperation,
+			ID:   "apikey-create",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, CreateContactOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, ApikeyCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -126,7 +126,7 @@ func (s *Server) handleCreateContactRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CreateContactOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, ApikeyCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -144,7 +144,7 @@ func (s *Server) handleCreateContactRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, CreateContactOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, ApikeyCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -188,7 +188,7 @@ func (s *Server) handleCreateContactRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	request, close, err := s.decodeCreateContactRequest(r)
+	request, close, err := s.decodeApikeyCreateRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -204,22 +204,22 @@ func (s *Server) handleCreateContactRequest(args [0]string, argsEscaped bool, w
 		}
 	}()
 
-	var response *Contact
+	var response *APIKey
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateContactOperation,
-			OperationSummary: "Create a new contact record",
-			OperationID:      "createContact",
+			OperationName:    ApikeyCreateOperation,
+			OperationSummary: "",
+			OperationID:      "apikey-create",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *Contact
+			Request  = *APIKey
 			Params   = struct{}
-			Response = *Contact
+			Response = *APIKey
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -230,12 +230,12 @@ func (s *Server) handleCreateContactRequest(args [0]string, argsEscaped bool, w
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateContact(ctx, request)
+				response, err = s.h.ApikeyCreate(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateContact(ctx, request)
+		response, err = s.h.ApikeyCreate(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -254,7 +254,7 @@ func (s *Server) handleCreateContactRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeCreateContactResponse(response, w, span); err != nil {
+	if err := encodeApikeyCreateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -263,22 +263,23 @@ func (s *Server) handleCreateContactRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleCreateUserRequest handles createUser operation.
+// handleApikeyListRequest handles apikey-list operation.
 //
-// Create a new user.
+// Retrieve the API keys of the workspace. Owners and admins see every key, other members
+// their own keys.
 //
-// POST /user
-func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /apikey
+func (s *Server) handleApikeyListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createUser"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/user"),
+		otelogen.OperationID("apikey-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/apikey"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateUserOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ApikeyListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -333,15 +334,15 @@ func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateUserOperation,
-			ID:   "createUser",
+			Name: ApikeyListOperation,
+			ID:   "apikey-list",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, CreateUserOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, ApikeyListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -359,7 +360,7 @@ func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CreateUserOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, ApikeyListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -377,7 +378,7 @@ func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, CreateUserOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, ApikeyListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -421,38 +422,46 @@ func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	request, close, err := s.decodeCreateUserRequest(r)
+	params, err := decodeApikeyListParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *User
+	var response []APIKey
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateUserOperation,
-			OperationSummary: "Create a new user",
-			OperationID:      "createUser",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    ApikeyListOperation,
+			OperationSummary: "",
+			OperationID:      "apikey-list",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "include_revoked",
+					In:   "query",
+				}: params.IncludeRevoked,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *User
-			Params   = struct{}
-			Response = *User
+			Request  = struct{}
+			Params   = ApikeyListParams
+			Response = []APIKey
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -461,14 +470,14 @@ func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			nil,
+			unpackApikeyListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateUser(ctx, request)
+				response, err = s.h.ApikeyList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateUser(ctx, request)
+		response, err = s.h.ApikeyList(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -487,7 +496,7 @@ func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeCreateUserResponse(response, w, span); err != nil {
+	if err := encodeApikeyListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -496,22 +505,22 @@ func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleDatasourceEmailCreateRequest handles datasource-email-create operation.
+// handleApikeyRevokeRequest handles apikey-revoke operation.
 //
-// Create a new email datasource.
+// Revoke an API key. Members revoke their own keys, owners and admins any key.
 //
-// POST /datasource/email
-func (s *Server) handleDatasourceEmailCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /apikey/{uuid}
+func (s *Server) handleApikeyRevokeRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-email-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/datasource/email"),
+		otelogen.OperationID("apikey-revoke"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/apikey/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailCreateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ApikeyRevokeOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -566,15 +575,15 @@ func (s *Server) handleDatasourceEmailCreateRequest(args [0]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceEmailCreateOperation,
-			ID:   "datasource-email-create",
+			Name: ApikeyRevokeOperation,
+			ID:   "apikey-revoke",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailCreateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, ApikeyRevokeOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -592,7 +601,7 @@ func (s *Server) handleDatasourceEmailCreateRequest(args [0]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailCreateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, ApikeyRevokeOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -610,7 +619,7 @@ func (s *Server) handleDatasourceEmailCreateRequest(args [0]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailCreateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, ApikeyRevokeOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -654,38 +663,38 @@ func (s *Server) handleDatasourceEmailCreateRequest(args [0]string, argsEscaped
 			return
 		}
 	}
-	request, close, err := s.decodeDatasourceEmailCreateRequest(r)
+	params, err := decodeApikeyRevokeParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *DatasourceEmail
+	var response *ApikeyRevokeOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceEmailCreateOperation,
+			OperationName:    ApikeyRevokeOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-email-create",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationID:      "apikey-revoke",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *DatasourceEmail
-			Params   = struct{}
-			Response = *DatasourceEmail
+			Request  = struct{}
+			Params   = ApikeyRevokeParams
+			Response = *ApikeyRevokeOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -694,14 +703,14 @@ func (s *Server) handleDatasourceEmailCreateRequest(args [0]string, argsEscaped
 		](
 			m,
 			mreq,
-			nil,
+			unpackApikeyRevokeParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceEmailCreate(ctx, request)
+				err = s.h.ApikeyRevoke(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceEmailCreate(ctx, request)
+		err = s.h.ApikeyRevoke(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -720,7 +729,7 @@ func (s *Server) handleDatasourceEmailCreateRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeDatasourceEmailCreateResponse(response, w, span); err != nil {
+	if err := encodeApikeyRevokeResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -729,22 +738,23 @@ func (s *Server) handleDatasourceEmailCreateRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleDatasourceEmailDeleteRequest handles datasource-email-delete operation.
+// handleAuditExportRequest handles audit-export operation.
 //
-// Delete an email datasource.
+// Export the matching audit events of the workspace as newline delimited JSON, one
+// AuditEvent per line, newest first. Owners and admins only.
 //
-// DELETE /datasource/email/{uuid}
-func (s *Server) handleDatasourceEmailDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /audit/export
+func (s *Server) handleAuditExportRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-email-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/datasource/email/{uuid}"),
+		otelogen.OperationID("audit-export"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/audit/export"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailDeleteOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), AuditExportOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -799,15 +809,15 @@ func (s *Server) handleDatasourceEmailDeleteRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceEmailDeleteOperation,
-			ID:   "datasource-email-delete",
+			Name: AuditExportOperation,
+			ID:   "audit-export",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailDeleteOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, AuditExportOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -825,7 +835,7 @@ func (s *Server) handleDatasourceEmailDeleteRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailDeleteOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, AuditExportOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -843,7 +853,7 @@ func (s *Server) handleDatasourceEmailDeleteRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailDeleteOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, AuditExportOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -887,7 +897,7 @@ func (s *Server) handleDatasourceEmailDeleteRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeDatasourceEmailDeleteParams(args, argsEscaped, r)
+	params, err := decodeAuditExportParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -898,27 +908,55 @@ func (s *Server) handleDatasourceEmailDeleteRequest(args [1]string, argsEscaped
 		return
 	}
 
-	var response *DatasourceEmailDeleteOK
+	var response AuditExportOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceEmailDeleteOperation,
+			OperationName:    AuditExportOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-email-delete",
+			OperationID:      "audit-export",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
+					Name: "actor",
+					In:   "query",
+				}: params.Actor,
+				{
+					Name: "source",
+					In:   "query",
+				}: params.Source,
+				{
+					Name: "operation_id",
+					In:   "query",
+				}: params.OperationID,
+				{
+					Name: "resource_kind",
+					In:   "query",
+				}: params.ResourceKind,
+				{
+					Name: "resource_id",
+					In:   "query",
+				}: params.ResourceID,
+				{
+					Name: "outcome",
+					In:   "query",
+				}: params.Outcome,
+				{
+					Name: "since",
+					In:   "query",
+				}: params.Since,
+				{
+					Name: "until",
+					In:   "query",
+				}: params.Until,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DatasourceEmailDeleteParams
-			Response = *DatasourceEmailDeleteOK
+			Params   = AuditExportParams
+			Response = AuditExportOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -927,14 +965,14 @@ func (s *Server) handleDatasourceEmailDeleteRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackDatasourceEmailDeleteParams,
+			unpackAuditExportParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.DatasourceEmailDelete(ctx, params)
+				response, err = s.h.AuditExport(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.DatasourceEmailDelete(ctx, params)
+		response, err = s.h.AuditExport(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -953,7 +991,7 @@ func (s *Server) handleDatasourceEmailDeleteRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeDatasourceEmailDeleteResponse(response, w, span); err != nil {
+	if err := encodeAuditExportResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -962,22 +1000,22 @@ func (s *Server) handleDatasourceEmailDeleteRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleDatasourceEmailGetRequest handles datasource-email-get operation.
+// handleAuditListRequest handles audit-list operation.
 //
-// Get email datasources.
+// Retrieve the audit log of the workspace, newest first. Owners and admins only.
 //
-// GET /datasource/email/{uuid}
-func (s *Server) handleDatasourceEmailGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /audit
+func (s *Server) handleAuditListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-email-get"),
+		otelogen.OperationID("audit-list"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource/email/{uuid}"),
+		semconv.HTTPRouteKey.String("/audit"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), AuditListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1032,15 +1070,15 @@ func (s *Server) handleDatasourceEmailGetRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceEmailGetOperation,
-			ID:   "datasource-email-get",
+			Name: AuditListOperation,
+			ID:   "audit-list",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailGetOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, AuditListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1058,7 +1096,7 @@ func (s *Server) handleDatasourceEmailGetRequest(args [1]string, argsEscaped boo
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, AuditListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1076,7 +1114,7 @@ func (s *Server) handleDatasourceEmailGetRequest(args [1]string, argsEscaped boo
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailGetOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, AuditListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1120,7 +1158,7 @@ func (s *Server) handleDatasourceEmailGetRequest(args [1]string, argsEscaped boo
 			return
 		}
 	}
-	params, err := decodeDatasourceEmailGetParams(args, argsEscaped, r)
+	params, err := decodeAuditListParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1131,27 +1169,63 @@ func (s *Server) handleDatasourceEmailGetRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	var response *DatasourceEmail
+	var response []AuditEvent
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceEmailGetOperation,
+			OperationName:    AuditListOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-email-get",
+			OperationID:      "audit-list",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
+					Name: "actor",
+					In:   "query",
+				}: params.Actor,
+				{
+					Name: "source",
+					In:   "query",
+				}: params.Source,
+				{
+					Name: "operation_id",
+					In:   "query",
+				}: params.OperationID,
+				{
+					Name: "resource_kind",
+					In:   "query",
+				}: params.ResourceKind,
+				{
+					Name: "resource_id",
+					In:   "query",
+				}: params.ResourceID,
+				{
+					Name: "outcome",
+					In:   "query",
+				}: params.Outcome,
+				{
+					Name: "since",
+					In:   "query",
+				}: params.Since,
+				{
+					Name: "until",
+					In:   "query",
+				}: params.Until,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DatasourceEmailGetParams
-			Response = *DatasourceEmail
+			Params   = AuditListParams
+			Response = []AuditEvent
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1160,14 +1234,14 @@ func (s *Server) handleDatasourceEmailGetRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackDatasourceEmailGetParams,
+			unpackAuditListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceEmailGet(ctx, params)
+				response, err = s.h.AuditList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceEmailGet(ctx, params)
+		response, err = s.h.AuditList(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1186,7 +1260,7 @@ func (s *Server) handleDatasourceEmailGetRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeDatasourceEmailGetResponse(response, w, span); err != nil {
+	if err := encodeAuditListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1195,22 +1269,22 @@ func (s *Server) handleDatasourceEmailGetRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleDatasourceEmailListRequest handles datasource-email-list operation.
+// handleCreateContactRequest handles createContact operation.
 //
-// List email datasources.
+// Create a new contact record.
 //
-// GET /datasource/email
-func (s *Server) handleDatasourceEmailListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /contact
+func (s *Server) handleCreateContactRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-email-list"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource/email"),
+		otelogen.OperationID("createContact"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/contact"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailListOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateContactOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1265,15 +1339,15 @@ func (s *Server) handleDatasourceEmailListRequest(args [0]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceEmailListOperation,
-			ID:   "datasource-email-list",
+			Name: CreateContactOperation,
+			ID:   "createContact",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailListOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, CreateContactOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1291,7 +1365,7 @@ func (s *Server) handleDatasourceEmailListRequest(args [0]string, argsEscaped bo
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailListOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateContactOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1309,7 +1383,7 @@ func (s *Server) handleDatasourceEmailListRequest(args [0]string, argsEscaped bo
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailListOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, CreateContactOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1353,42 +1427,38 @@ func (s *Server) handleDatasourceEmailListRequest(args [0]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeDatasourceEmailListParams(args, argsEscaped, r)
+	request, close, err := s.decodeCreateContactRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response []DatasourceEmail
+	var response *Contact
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceEmailListOperation,
-			OperationSummary: "",
-			OperationID:      "datasource-email-list",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-			},
-			Raw: r,
+			OperationName:    CreateContactOperation,
+			OperationSummary: "Create a new contact record",
+			OperationID:      "createContact",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DatasourceEmailListParams
-			Response = []DatasourceEmail
+			Request  = *Contact
+			Params   = struct{}
+			Response = *Contact
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1397,14 +1467,14 @@ func (s *Server) handleDatasourceEmailListRequest(args [0]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackDatasourceEmailListParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceEmailList(ctx, params)
+				response, err = s.h.CreateContact(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceEmailList(ctx, params)
+		response, err = s.h.CreateContact(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1423,7 +1493,7 @@ func (s *Server) handleDatasourceEmailListRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeDatasourceEmailListResponse(response, w, span); err != nil {
+	if err := encodeCreateContactResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1432,22 +1502,22 @@ func (s *Server) handleDatasourceEmailListRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleDatasourceEmailOAuthCreateRequest handles datasource-email-oauth-create operation.
+// handleCreateUserRequest handles createUser operation.
 //
-// Create a new email OAuth datasource.
+// Create a new user.
 //
-// POST /datasource/email_oauth
-func (s *Server) handleDatasourceEmailOAuthCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /user
+func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-email-oauth-create"),
+		otelogen.OperationID("createUser"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/datasource/email_oauth"),
+		semconv.HTTPRouteKey.String("/user"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailOAuthCreateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1502,15 +1572,15 @@ func (s *Server) handleDatasourceEmailOAuthCreateRequest(args [0]string, argsEsc
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceEmailOAuthCreateOperation,
-			ID:   "datasource-email-oauth-create",
+			Name: CreateUserOperation,
+			ID:   "createUser",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailOAuthCreateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, CreateUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1528,7 +1598,7 @@ func (s *Server) handleDatasourceEmailOAuthCreateRequest(args [0]string, argsEsc
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailOAuthCreateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1546,7 +1616,7 @@ func (s *Server) handleDatasourceEmailOAuthCreateRequest(args [0]string, argsEsc
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailOAuthCreateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, CreateUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1590,7 +1660,7 @@ func (s *Server) handleDatasourceEmailOAuthCreateRequest(args [0]string, argsEsc
 			return
 		}
 	}
-	request, close, err := s.decodeDatasourceEmailOAuthCreateRequest(r)
+	request, close, err := s.decodeCreateUserRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1606,22 +1676,22 @@ func (s *Server) handleDatasourceEmailOAuthCreateRequest(args [0]string, argsEsc
 		}
 	}()
 
-	var response *DatasourceEmailOAuth
+	var response *User
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceEmailOAuthCreateOperation,
-			OperationSummary: "",
-			OperationID:      "datasource-email-oauth-create",
+			OperationName:    CreateUserOperation,
+			OperationSummary: "Create a new user",
+			OperationID:      "createUser",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *DatasourceEmailOAuth
+			Request  = *User
 			Params   = struct{}
-			Response = *DatasourceEmailOAuth
+			Response = *User
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1632,12 +1702,12 @@ func (s *Server) handleDatasourceEmailOAuthCreateRequest(args [0]string, argsEsc
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceEmailOAuthCreate(ctx, request)
+				response, err = s.h.CreateUser(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceEmailOAuthCreate(ctx, request)
+		response, err = s.h.CreateUser(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1656,7 +1726,7 @@ func (s *Server) handleDatasourceEmailOAuthCreateRequest(args [0]string, argsEsc
 		return
 	}
 
-	if err := encodeDatasourceEmailOAuthCreateResponse(response, w, span); err != nil {
+	if err := encodeCreateUserResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1665,22 +1735,22 @@ func (s *Server) handleDatasourceEmailOAuthCreateRequest(args [0]string, argsEsc
 	}
 }
 
-// handleDatasourceEmailOAuthDeleteRequest handles datasource-email-oauth-delete operation.
+// handleDatasourceEmailCreateRequest handles datasource-email-create operation.
 //
-// Delete an email OAuth datasource.
+// Create a new email datasource.
 //
-// DELETE /datasource/email_oauth/{uuid}
-func (s *Server) handleDatasourceEmailOAuthDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /datasource/email
+func (s *Server) handleDatasourceEmailCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-email-oauth-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/datasource/email_oauth/{uuid}"),
+		otelogen.OperationID("datasource-email-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/datasource/email"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailOAuthDeleteOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1735,15 +1805,15 @@ func (s *Server) handleDatasourceEmailOAuthDeleteRequest(args [1]string, argsEsc
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceEmailOAuthDeleteOperation,
-			ID:   "datasource-email-oauth-delete",
+			Name: DatasourceEmailCreateOperation,
+			ID:   "datasource-email-create",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailOAuthDeleteOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1761,7 +1831,7 @@ func (s *Server) handleDatasourceEmailOAuthDeleteRequest(args [1]string, argsEsc
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailOAuthDeleteOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1779,7 +1849,7 @@ func (s *Server) handleDatasourceEmailOAuthDeleteRequest(args [1]string, argsEsc
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailOAuthDeleteOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1823,38 +1893,38 @@ func (s *Server) handleDatasourceEmailOAuthDeleteRequest(args [1]string, argsEsc
 			return
 		}
 	}
-	params, err := decodeDatasourceEmailOAuthDeleteParams(args, argsEscaped, r)
+	request, close, err := s.decodeDatasourceEmailCreateRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *DatasourceEmailOAuthDeleteOK
+	var response *DatasourceEmail
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceEmailOAuthDeleteOperation,
+			OperationName:    DatasourceEmailCreateOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-email-oauth-delete",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
-			},
-			Raw: r,
+			OperationID:      "datasource-email-create",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DatasourceEmailOAuthDeleteParams
-			Response = *DatasourceEmailOAuthDeleteOK
+			Request  = *DatasourceEmail
+			Params   = struct{}
+			Response = *DatasourceEmail
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1863,14 +1933,14 @@ func (s *Server) handleDatasourceEmailOAuthDeleteRequest(args [1]string, argsEsc
 		](
 			m,
 			mreq,
-			unpackDatasourceEmailOAuthDeleteParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.DatasourceEmailOAuthDelete(ctx, params)
+				response, err = s.h.DatasourceEmailCreate(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.DatasourceEmailOAuthDelete(ctx, params)
+		response, err = s.h.DatasourceEmailCreate(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1889,7 +1959,7 @@ func (s *Server) handleDatasourceEmailOAuthDeleteRequest(args [1]string, argsEsc
 		return
 	}
 
-	if err := encodeDatasourceEmailOAuthDeleteResponse(response, w, span); err != nil {
+	if err := encodeDatasourceEmailCreateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1898,22 +1968,22 @@ func (s *Server) handleDatasourceEmailOAuthDeleteRequest(args [1]string, argsEsc
 	}
 }
 
-// handleDatasourceEmailOAuthGetRequest handles datasource-email-oauth-get operation.
+// handleDatasourceEmailDeleteRequest handles datasource-email-delete operation.
 //
-// Retrieve an OAuth2‑based email datasource.
+// Delete an email datasource.
 //
-// GET /datasource/email_oauth/{uuid}
-func (s *Server) handleDatasourceEmailOAuthGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /datasource/email/{uuid}
+func (s *Server) handleDatasourceEmailDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-email-oauth-get"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource/email_oauth/{uuid}"),
+		otelogen.OperationID("datasource-email-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/datasource/email/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailOAuthGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1968,15 +2038,15 @@ func (s *Server) handleDatasourceEmailOAuthGetRequest(args [1]string, argsEscape
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceEmailOAuthGetOperation,
-			ID:   "datasource-email-oauth-get",
+			Name: DatasourceEmailDeleteOperation,
+			ID:   "datasource-email-delete",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailOAuthGetOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1994,7 +2064,7 @@ func (s *Server) handleDatasourceEmailOAuthGetRequest(args [1]string, argsEscape
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailOAuthGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2012,7 +2082,7 @@ func (s *Server) handleDatasourceEmailOAuthGetRequest(args [1]string, argsEscape
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailOAuthGetOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2056,7 +2126,7 @@ func (s *Server) handleDatasourceEmailOAuthGetRequest(args [1]string, argsEscape
 			return
 		}
 	}
-	params, err := decodeDatasourceEmailOAuthGetParams(args, argsEscaped, r)
+	params, err := decodeDatasourceEmailDeleteParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2067,13 +2137,13 @@ func (s *Server) handleDatasourceEmailOAuthGetRequest(args [1]string, argsEscape
 		return
 	}
 
-	var response *DatasourceEmailOAuth
+	var response *DatasourceEmailDeleteOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceEmailOAuthGetOperation,
+			OperationName:    DatasourceEmailDeleteOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-email-oauth-get",
+			OperationID:      "datasource-email-delete",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -2086,8 +2156,8 @@ func (s *Server) handleDatasourceEmailOAuthGetRequest(args [1]string, argsEscape
 
 		type (
 			Request  = struct{}
-			Params   = DatasourceEmailOAuthGetParams
-			Response = *DatasourceEmailOAuth
+			Params   = DatasourceEmailDeleteParams
+			Response = *DatasourceEmailDeleteOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2096,14 +2166,14 @@ func (s *Server) handleDatasourceEmailOAuthGetRequest(args [1]string, argsEscape
 		](
 			m,
 			mreq,
-			unpackDatasourceEmailOAuthGetParams,
+			unpackDatasourceEmailDeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceEmailOAuthGet(ctx, params)
+				err = s.h.DatasourceEmailDelete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceEmailOAuthGet(ctx, params)
+		err = s.h.DatasourceEmailDelete(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2122,7 +2192,7 @@ func (s *Server) handleDatasourceEmailOAuthGetRequest(args [1]string, argsEscape
 		return
 	}
 
-	if err := encodeDatasourceEmailOAuthGetResponse(response, w, span); err != nil {
+	if err := encodeDatasourceEmailDeleteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2131,22 +2201,22 @@ func (s *Server) handleDatasourceEmailOAuthGetRequest(args [1]string, argsEscape
 	}
 }
 
-// handleDatasourceEmailOAuthListRequest handles datasource-email-oauth-list operation.
+// handleDatasourceEmailGetRequest handles datasource-email-get operation.
 //
-// List OAuth2‑based email datasources.
+// Get email datasources.
 //
-// GET /datasource/email_oauth
-func (s *Server) handleDatasourceEmailOAuthListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /datasource/email/{uuid}
+func (s *Server) handleDatasourceEmailGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-email-oauth-list"),
+		otelogen.OperationID("datasource-email-get"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource/email_oauth"),
+		semconv.HTTPRouteKey.String("/datasource/email/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailOAuthListOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2201,15 +2271,15 @@ func (s *Server) handleDatasourceEmailOAuthListRequest(args [0]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceEmailOAuthListOperation,
-			ID:   "datasource-email-oauth-list",
+			Name: DatasourceEmailGetOperation,
+			ID:   "datasource-email-get",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailOAuthListOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2227,7 +2297,7 @@ func (s *Server) handleDatasourceEmailOAuthListRequest(args [0]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailOAuthListOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2245,7 +2315,7 @@ func (s *Server) handleDatasourceEmailOAuthListRequest(args [0]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailOAuthListOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2289,7 +2359,7 @@ func (s *Server) handleDatasourceEmailOAuthListRequest(args [0]string, argsEscap
 			return
 		}
 	}
-	params, err := decodeDatasourceEmailOAuthListParams(args, argsEscaped, r)
+	params, err := decodeDatasourceEmailGetParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2300,31 +2370,27 @@ func (s *Server) handleDatasourceEmailOAuthListRequest(args [0]string, argsEscap
 		return
 	}
 
-	var response []DatasourceEmailOAuth
+	var response *DatasourceEmail
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceEmailOAuthListOperation,
+			OperationName:    DatasourceEmailGetOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-email-oauth-list",
+			OperationID:      "datasource-email-get",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DatasourceEmailOAuthListParams
-			Response = []DatasourceEmailOAuth
+			Params   = DatasourceEmailGetParams
+			Response = *DatasourceEmail
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2333,14 +2399,14 @@ func (s *Server) handleDatasourceEmailOAuthListRequest(args [0]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackDatasourceEmailOAuthListParams,
+			unpackDatasourceEmailGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceEmailOAuthList(ctx, params)
+				response, err = s.h.DatasourceEmailGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceEmailOAuthList(ctx, params)
+		response, err = s.h.DatasourceEmailGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2359,7 +2425,7 @@ func (s *Server) handleDatasourceEmailOAuthListRequest(args [0]string, argsEscap
 		return
 	}
 
-	if err := encodeDatasourceEmailOAuthListResponse(response, w, span); err != nil {
+	if err := encodeDatasourceEmailGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2368,22 +2434,22 @@ func (s *Server) handleDatasourceEmailOAuthListRequest(args [0]string, argsEscap
 	}
 }
 
-// handleDatasourceEmailOAuthUpdateRequest handles datasource-email-oauth-update operation.
+// handleDatasourceEmailGraphCreateRequest handles datasource-email-graph-create operation.
 //
-// Update an existing email OAuth datasource.
+// Create a new Microsoft Graph mail datasource.
 //
-// PUT /datasource/email_oauth/{uuid}
-func (s *Server) handleDatasourceEmailOAuthUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /datasource/email_graph
+func (s *Server) handleDatasourceEmailGraphCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-email-oauth-update"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/datasource/email_oauth/{uuid}"),
+		otelogen.OperationID("datasource-email-graph-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/datasource/email_graph"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailOAuthUpdateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailGraphCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2438,15 +2504,15 @@ func (s *Server) handleDatasourceEmailOAuthUpdateRequest(args [1]string, argsEsc
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceEmailOAuthUpdateOperation,
-			ID:   "datasource-email-oauth-update",
+			Name: DatasourceEmailGraphCreateOperation,
+			ID:   "datasource-email-graph-create",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailOAuthUpdateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailGraphCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2464,7 +2530,7 @@ func (s *Server) handleDatasourceEmailOAuthUpdateRequest(args [1]string, argsEsc
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailOAuthUpdateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailGraphCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2482,7 +2548,7 @@ func (s *Server) handleDatasourceEmailOAuthUpdateRequest(args [1]string, argsEsc
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailOAuthUpdateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailGraphCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2526,17 +2592,7 @@ func (s *Server) handleDatasourceEmailOAuthUpdateRequest(args [1]string, argsEsc
 			return
 		}
 	}
-	params, err := decodeDatasourceEmailOAuthUpdateParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	request, close, err := s.decodeDatasourceEmailOAuthUpdateRequest(r)
+	request, close, err := s.decodeDatasourceEmailGraphCreateRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -2552,27 +2608,22 @@ func (s *Server) handleDatasourceEmailOAuthUpdateRequest(args [1]string, argsEsc
 		}
 	}()
 
-	var response *DatasourceEmailOAuth
+	var response *DatasourceEmailGraph
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceEmailOAuthUpdateOperation,
+			OperationName:    DatasourceEmailGraphCreateOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-email-oauth-update",
+			OperationID:      "datasource-email-graph-create",
 			Body:             request,
-			Params: middleware.Parameters{
-				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = *DatasourceEmailOAuth
-			Params   = DatasourceEmailOAuthUpdateParams
-			Response = *DatasourceEmailOAuth
+			Request  = *DatasourceEmailGraph
+			Params   = struct{}
+			Response = *DatasourceEmailGraph
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2581,14 +2632,14 @@ func (s *Server) handleDatasourceEmailOAuthUpdateRequest(args [1]string, argsEsc
 		](
 			m,
 			mreq,
-			unpackDatasourceEmailOAuthUpdateParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceEmailOAuthUpdate(ctx, request, params)
+				response, err = s.h.DatasourceEmailGraphCreate(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceEmailOAuthUpdate(ctx, request, params)
+		response, err = s.h.DatasourceEmailGraphCreate(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2607,7 +2658,7 @@ func (s *Server) handleDatasourceEmailOAuthUpdateRequest(args [1]string, argsEsc
 		return
 	}
 
-	if err := encodeDatasourceEmailOAuthUpdateResponse(response, w, span); err != nil {
+	if err := encodeDatasourceEmailGraphCreateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2616,22 +2667,22 @@ func (s *Server) handleDatasourceEmailOAuthUpdateRequest(args [1]string, argsEsc
 	}
 }
 
-// handleDatasourceEmailUpdateRequest handles datasource-email-update operation.
+// handleDatasourceEmailGraphDeleteRequest handles datasource-email-graph-delete operation.
 //
-// Update an email datasource.
+// Delete a Microsoft Graph mail datasource.
 //
-// PUT /datasource/email/{uuid}
-func (s *Server) handleDatasourceEmailUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /datasource/email_graph/{uuid}
+func (s *Server) handleDatasourceEmailGraphDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-email-update"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/datasource/email/{uuid}"),
+		otelogen.OperationID("datasource-email-graph-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/datasource/email_graph/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailUpdateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailGraphDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2686,15 +2737,15 @@ func (s *Server) handleDatasourceEmailUpdateRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceEmailUpdateOperation,
-			ID:   "datasource-email-update",
+			Name: DatasourceEmailGraphDeleteOperation,
+			ID:   "datasource-email-graph-delete",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailUpdateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailGraphDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2712,7 +2763,7 @@ func (s *Server) handleDatasourceEmailUpdateRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailUpdateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailGraphDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2730,7 +2781,7 @@ func (s *Server) handleDatasourceEmailUpdateRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailUpdateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailGraphDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2774,7 +2825,7 @@ func (s *Server) handleDatasourceEmailUpdateRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeDatasourceEmailUpdateParams(args, argsEscaped, r)
+	params, err := decodeDatasourceEmailGraphDeleteParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2784,30 +2835,15 @@ func (s *Server) handleDatasourceEmailUpdateRequest(args [1]string, argsEscaped
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeDatasourceEmailUpdateRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *DatasourceEmail
+	var response *DatasourceEmailGraphDeleteOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceEmailUpdateOperation,
+			OperationName:    DatasourceEmailGraphDeleteOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-email-update",
-			Body:             request,
+			OperationID:      "datasource-email-graph-delete",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "uuid",
@@ -2818,9 +2854,9 @@ func (s *Server) handleDatasourceEmailUpdateRequest(args [1]string, argsEscaped
 		}
 
 		type (
-			Request  = *DatasourceEmail
-			Params   = DatasourceEmailUpdateParams
-			Response = *DatasourceEmail
+			Request  = struct{}
+			Params   = DatasourceEmailGraphDeleteParams
+			Response = *DatasourceEmailGraphDeleteOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2829,14 +2865,14 @@ func (s *Server) handleDatasourceEmailUpdateRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackDatasourceEmailUpdateParams,
+			unpackDatasourceEmailGraphDeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceEmailUpdate(ctx, request, params)
+				err = s.h.DatasourceEmailGraphDelete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceEmailUpdate(ctx, request, params)
+		err = s.h.DatasourceEmailGraphDelete(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2855,7 +2891,7 @@ func (s *Server) handleDatasourceEmailUpdateRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeDatasourceEmailUpdateResponse(response, w, span); err != nil {
+	if err := encodeDatasourceEmailGraphDeleteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2864,22 +2900,22 @@ func (s *Server) handleDatasourceEmailUpdateRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleDatasourceLinkedinCreateRequest handles datasource-linkedin-create operation.
+// handleDatasourceEmailGraphGetRequest handles datasource-email-graph-get operation.
 //
-// Create a new LinkedIn datasource.
+// Retrieve a Microsoft Graph mail datasource.
 //
-// POST /datasource/linkedin
-func (s *Server) handleDatasourceLinkedinCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /datasource/email_graph/{uuid}
+func (s *Server) handleDatasourceEmailGraphGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-linkedin-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/datasource/linkedin"),
+		otelogen.OperationID("datasource-email-graph-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource/email_graph/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceLinkedinCreateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailGraphGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2934,15 +2970,15 @@ func (s *Server) handleDatasourceLinkedinCreateRequest(args [0]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceLinkedinCreateOperation,
-			ID:   "datasource-linkedin-create",
+			Name: DatasourceEmailGraphGetOperation,
+			ID:   "datasource-email-graph-get",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceLinkedinCreateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailGraphGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2960,7 +2996,7 @@ func (s *Server) handleDatasourceLinkedinCreateRequest(args [0]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceLinkedinCreateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailGraphGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2978,7 +3014,7 @@ func (s *Server) handleDatasourceLinkedinCreateRequest(args [0]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceLinkedinCreateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailGraphGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3022,38 +3058,38 @@ func (s *Server) handleDatasourceLinkedinCreateRequest(args [0]string, argsEscap
 			return
 		}
 	}
-	request, close, err := s.decodeDatasourceLinkedinCreateRequest(r)
+	params, err := decodeDatasourceEmailGraphGetParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *DatasourceLinkedin
+	var response *DatasourceEmailGraph
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceLinkedinCreateOperation,
+			OperationName:    DatasourceEmailGraphGetOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-linkedin-create",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationID:      "datasource-email-graph-get",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *DatasourceLinkedin
-			Params   = struct{}
-			Response = *DatasourceLinkedin
+			Request  = struct{}
+			Params   = DatasourceEmailGraphGetParams
+			Response = *DatasourceEmailGraph
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3062,14 +3098,14 @@ func (s *Server) handleDatasourceLinkedinCreateRequest(args [0]string, argsEscap
 		](
 			m,
 			mreq,
-			nil,
+			unpackDatasourceEmailGraphGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceLinkedinCreate(ctx, request)
+				response, err = s.h.DatasourceEmailGraphGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceLinkedinCreate(ctx, request)
+		response, err = s.h.DatasourceEmailGraphGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3088,7 +3124,7 @@ func (s *Server) handleDatasourceLinkedinCreateRequest(args [0]string, argsEscap
 		return
 	}
 
-	if err := encodeDatasourceLinkedinCreateResponse(response, w, span); err != nil {
+	if err := encodeDatasourceEmailGraphGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3097,22 +3133,22 @@ func (s *Server) handleDatasourceLinkedinCreateRequest(args [0]string, argsEscap
 	}
 }
 
-// handleDatasourceLinkedinDeleteRequest handles datasource-linkedin-delete operation.
+// handleDatasourceEmailGraphListRequest handles datasource-email-graph-list operation.
 //
-// Delete a LinkedIn datasource.
+// List Microsoft Graph mail datasources.
 //
-// DELETE /datasource/linkedin/{uuid}
-func (s *Server) handleDatasourceLinkedinDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /datasource/email_graph
+func (s *Server) handleDatasourceEmailGraphListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-linkedin-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/datasource/linkedin/{uuid}"),
+		otelogen.OperationID("datasource-email-graph-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource/email_graph"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceLinkedinDeleteOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailGraphListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3167,15 +3203,15 @@ func (s *Server) handleDatasourceLinkedinDeleteRequest(args [1]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceLinkedinDeleteOperation,
-			ID:   "datasource-linkedin-delete",
+			Name: DatasourceEmailGraphListOperation,
+			ID:   "datasource-email-graph-list",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceLinkedinDeleteOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailGraphListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3193,7 +3229,7 @@ func (s *Server) handleDatasourceLinkedinDeleteRequest(args [1]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceLinkedinDeleteOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailGraphListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3211,7 +3247,7 @@ func (s *Server) handleDatasourceLinkedinDeleteRequest(args [1]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceLinkedinDeleteOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailGraphListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3255,7 +3291,7 @@ func (s *Server) handleDatasourceLinkedinDeleteRequest(args [1]string, argsEscap
 			return
 		}
 	}
-	params, err := decodeDatasourceLinkedinDeleteParams(args, argsEscaped, r)
+	params, err := decodeDatasourceEmailGraphListParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3266,27 +3302,31 @@ func (s *Server) handleDatasourceLinkedinDeleteRequest(args [1]string, argsEscap
 		return
 	}
 
-	var response *DatasourceLinkedinDeleteOK
+	var response []DatasourceEmailGraph
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceLinkedinDeleteOperation,
+			OperationName:    DatasourceEmailGraphListOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-linkedin-delete",
+			OperationID:      "datasource-email-graph-list",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DatasourceLinkedinDeleteParams
-			Response = *DatasourceLinkedinDeleteOK
+			Params   = DatasourceEmailGraphListParams
+			Response = []DatasourceEmailGraph
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3295,14 +3335,14 @@ func (s *Server) handleDatasourceLinkedinDeleteRequest(args [1]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackDatasourceLinkedinDeleteParams,
+			unpackDatasourceEmailGraphListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.DatasourceLinkedinDelete(ctx, params)
+				response, err = s.h.DatasourceEmailGraphList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.DatasourceLinkedinDelete(ctx, params)
+		response, err = s.h.DatasourceEmailGraphList(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3321,7 +3361,7 @@ func (s *Server) handleDatasourceLinkedinDeleteRequest(args [1]string, argsEscap
 		return
 	}
 
-	if err := encodeDatasourceLinkedinDeleteResponse(response, w, span); err != nil {
+	if err := encodeDatasourceEmailGraphListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3330,22 +3370,22 @@ func (s *Server) handleDatasourceLinkedinDeleteRequest(args [1]string, argsEscap
 	}
 }
 
-// handleDatasourceLinkedinGetRequest handles datasource-linkedin-get operation.
+// handleDatasourceEmailGraphSendRequest handles datasource-email-graph-send operation.
 //
-// Get a LinkedIn datasource.
+// Send a mail from the mailbox of a Microsoft Graph mail datasource.
 //
-// GET /datasource/linkedin/{uuid}
-func (s *Server) handleDatasourceLinkedinGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /datasource/email_graph/{uuid}/send
+func (s *Server) handleDatasourceEmailGraphSendRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-linkedin-get"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource/linkedin/{uuid}"),
+		otelogen.OperationID("datasource-email-graph-send"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/datasource/email_graph/{uuid}/send"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceLinkedinGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailGraphSendOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3400,15 +3440,15 @@ func (s *Server) handleDatasourceLinkedinGetRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceLinkedinGetOperation,
-			ID:   "datasource-linkedin-get",
+			Name: DatasourceEmailGraphSendOperation,
+			ID:   "datasource-email-graph-send",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceLinkedinGetOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailGraphSendOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3426,7 +3466,7 @@ func (s *Server) handleDatasourceLinkedinGetRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceLinkedinGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailGraphSendOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3444,7 +3484,7 @@ func (s *Server) handleDatasourceLinkedinGetRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceLinkedinGetOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailGraphSendOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3488,7 +3528,7 @@ func (s *Server) handleDatasourceLinkedinGetRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeDatasourceLinkedinGetParams(args, argsEscaped, r)
+	params, err := decodeDatasourceEmailGraphSendParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3498,15 +3538,30 @@ func (s *Server) handleDatasourceLinkedinGetRequest(args [1]string, argsEscaped
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeDatasourceEmailGraphSendRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *DatasourceLinkedin
+	var response *DatasourceEmailGraphSendAccepted
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceLinkedinGetOperation,
+			OperationName:    DatasourceEmailGraphSendOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-linkedin-get",
-			Body:             nil,
+			OperationID:      "datasource-email-graph-send",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "uuid",
@@ -3517,9 +3572,9 @@ func (s *Server) handleDatasourceLinkedinGetRequest(args [1]string, argsEscaped
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DatasourceLinkedinGetParams
-			Response = *DatasourceLinkedin
+			Request  = *EmailSend
+			Params   = DatasourceEmailGraphSendParams
+			Response = *DatasourceEmailGraphSendAccepted
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3528,14 +3583,14 @@ func (s *Server) handleDatasourceLinkedinGetRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackDatasourceLinkedinGetParams,
+			unpackDatasourceEmailGraphSendParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceLinkedinGet(ctx, params)
+				err = s.h.DatasourceEmailGraphSend(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceLinkedinGet(ctx, params)
+		err = s.h.DatasourceEmailGraphSend(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3554,7 +3609,7 @@ func (s *Server) handleDatasourceLinkedinGetRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeDatasourceLinkedinGetResponse(response, w, span); err != nil {
+	if err := encodeDatasourceEmailGraphSendResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3563,22 +3618,22 @@ func (s *Server) handleDatasourceLinkedinGetRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleDatasourceLinkedinListRequest handles datasource-linkedin-list operation.
+// handleDatasourceEmailGraphUpdateRequest handles datasource-email-graph-update operation.
 //
-// List all LinkedIn datasources.
+// Update an existing Microsoft Graph mail datasource.
 //
-// GET /datasource/linkedin
-func (s *Server) handleDatasourceLinkedinListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /datasource/email_graph/{uuid}
+func (s *Server) handleDatasourceEmailGraphUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-linkedin-list"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource/linkedin"),
+		otelogen.OperationID("datasource-email-graph-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/datasource/email_graph/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceLinkedinListOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailGraphUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3633,15 +3688,15 @@ func (s *Server) handleDatasourceLinkedinListRequest(args [0]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceLinkedinListOperation,
-			ID:   "datasource-linkedin-list",
+			Name: DatasourceEmailGraphUpdateOperation,
+			ID:   "datasource-email-graph-update",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceLinkedinListOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailGraphUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3659,7 +3714,7 @@ func (s *Server) handleDatasourceLinkedinListRequest(args [0]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceLinkedinListOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailGraphUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3677,7 +3732,7 @@ func (s *Server) handleDatasourceLinkedinListRequest(args [0]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceLinkedinListOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailGraphUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3721,7 +3776,7 @@ func (s *Server) handleDatasourceLinkedinListRequest(args [0]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeDatasourceLinkedinListParams(args, argsEscaped, r)
+	params, err := decodeDatasourceEmailGraphUpdateParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3731,32 +3786,43 @@ func (s *Server) handleDatasourceLinkedinListRequest(args [0]string, argsEscaped
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeDatasourceEmailGraphUpdateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response []DatasourceLinkedin
+	var response *DatasourceEmailGraph
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceLinkedinListOperation,
+			OperationName:    DatasourceEmailGraphUpdateOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-linkedin-list",
-			Body:             nil,
+			OperationID:      "datasource-email-graph-update",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DatasourceLinkedinListParams
-			Response = []DatasourceLinkedin
+			Request  = *DatasourceEmailGraph
+			Params   = DatasourceEmailGraphUpdateParams
+			Response = *DatasourceEmailGraph
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3765,14 +3831,14 @@ func (s *Server) handleDatasourceLinkedinListRequest(args [0]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackDatasourceLinkedinListParams,
+			unpackDatasourceEmailGraphUpdateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceLinkedinList(ctx, params)
+				response, err = s.h.DatasourceEmailGraphUpdate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceLinkedinList(ctx, params)
+		response, err = s.h.DatasourceEmailGraphUpdate(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3791,7 +3857,7 @@ func (s *Server) handleDatasourceLinkedinListRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeDatasourceLinkedinListResponse(response, w, span); err != nil {
+	if err := encodeDatasourceEmailGraphUpdateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3800,22 +3866,22 @@ func (s *Server) handleDatasourceLinkedinListRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleDatasourceLinkedinUpdateRequest handles datasource-linkedin-update operation.
+// handleDatasourceEmailListRequest handles datasource-email-list operation.
 //
-// Update a LinkedIn datasource.
+// List email datasources.
 //
-// PUT /datasource/linkedin/{uuid}
-func (s *Server) handleDatasourceLinkedinUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /datasource/email
+func (s *Server) handleDatasourceEmailListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-linkedin-update"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/datasource/linkedin/{uuid}"),
+		otelogen.OperationID("datasource-email-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource/email"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceLinkedinUpdateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3870,15 +3936,15 @@ func (s *Server) handleDatasourceLinkedinUpdateRequest(args [1]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceLinkedinUpdateOperation,
-			ID:   "datasource-linkedin-update",
+			Name: DatasourceEmailListOperation,
+			ID:   "datasource-email-list",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceLinkedinUpdateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3896,7 +3962,7 @@ func (s *Server) handleDatasourceLinkedinUpdateRequest(args [1]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceLinkedinUpdateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3914,7 +3980,7 @@ func (s *Server) handleDatasourceLinkedinUpdateRequest(args [1]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceLinkedinUpdateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3958,7 +4024,7 @@ func (s *Server) handleDatasourceLinkedinUpdateRequest(args [1]string, argsEscap
 			return
 		}
 	}
-	params, err := decodeDatasourceLinkedinUpdateParams(args, argsEscaped, r)
+	params, err := decodeDatasourceEmailListParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3968,43 +4034,32 @@ func (s *Server) handleDatasourceLinkedinUpdateRequest(args [1]string, argsEscap
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeDatasourceLinkedinUpdateRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *DatasourceLinkedin
+	var response []DatasourceEmail
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceLinkedinUpdateOperation,
+			OperationName:    DatasourceEmailListOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-linkedin-update",
-			Body:             request,
+			OperationID:      "datasource-email-list",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *DatasourceLinkedin
-			Params   = DatasourceLinkedinUpdateParams
-			Response = *DatasourceLinkedin
+			Request  = struct{}
+			Params   = DatasourceEmailListParams
+			Response = []DatasourceEmail
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4013,14 +4068,14 @@ func (s *Server) handleDatasourceLinkedinUpdateRequest(args [1]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackDatasourceLinkedinUpdateParams,
+			unpackDatasourceEmailListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceLinkedinUpdate(ctx, request, params)
+				response, err = s.h.DatasourceEmailList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceLinkedinUpdate(ctx, request, params)
+		response, err = s.h.DatasourceEmailList(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4039,7 +4094,7 @@ func (s *Server) handleDatasourceLinkedinUpdateRequest(args [1]string, argsEscap
 		return
 	}
 
-	if err := encodeDatasourceLinkedinUpdateResponse(response, w, span); err != nil {
+	if err := encodeDatasourceEmailListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4048,22 +4103,22 @@ func (s *Server) handleDatasourceLinkedinUpdateRequest(args [1]string, argsEscap
 	}
 }
 
-// handleDatasourceListRequest handles datasource-list operation.
+// handleDatasourceEmailOAuthCreateRequest handles datasource-email-oauth-create operation.
 //
-// Retrieve a list of datasource objects.
+// Create a new email OAuth datasource.
 //
-// GET /datasource
-func (s *Server) handleDatasourceListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /datasource/email_oauth
+func (s *Server) handleDatasourceEmailOAuthCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-list"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource"),
+		otelogen.OperationID("datasource-email-oauth-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/datasource/email_oauth"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceListOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailOAuthCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4118,15 +4173,15 @@ func (s *Server) handleDatasourceListRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceListOperation,
-			ID:   "datasource-list",
+			Name: DatasourceEmailOAuthCreateOperation,
+			ID:   "datasource-email-oauth-create",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceListOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailOAuthCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4144,7 +4199,7 @@ func (s *Server) handleDatasourceListRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceListOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailOAuthCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4162,7 +4217,7 @@ func (s *Server) handleDatasourceListRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceListOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailOAuthCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4206,42 +4261,38 @@ func (s *Server) handleDatasourceListRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeDatasourceListParams(args, argsEscaped, r)
+	request, close, err := s.decodeDatasourceEmailOAuthCreateRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response []Datasource
+	var response *DatasourceEmailOAuth
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceListOperation,
+			OperationName:    DatasourceEmailOAuthCreateOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-list",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-			},
-			Raw: r,
+			OperationID:      "datasource-email-oauth-create",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DatasourceListParams
-			Response = []Datasource
+			Request  = *DatasourceEmailOAuth
+			Params   = struct{}
+			Response = *DatasourceEmailOAuth
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4250,14 +4301,14 @@ func (s *Server) handleDatasourceListRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackDatasourceListParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceList(ctx, params)
+				response, err = s.h.DatasourceEmailOAuthCreate(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceList(ctx, params)
+		response, err = s.h.DatasourceEmailOAuthCreate(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4276,7 +4327,7 @@ func (s *Server) handleDatasourceListRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeDatasourceListResponse(response, w, span); err != nil {
+	if err := encodeDatasourceEmailOAuthCreateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4285,22 +4336,22 @@ func (s *Server) handleDatasourceListRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleDatasourceSetOAuth2ClientRequest handles datasource-set-oauth2-client operation.
+// handleDatasourceEmailOAuthDeleteRequest handles datasource-email-oauth-delete operation.
 //
-// Set OAuth2 client to the datasource.
+// Delete an email OAuth datasource.
 //
-// PUT /datasource/{uuid}/oauth2/client
-func (s *Server) handleDatasourceSetOAuth2ClientRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /datasource/email_oauth/{uuid}
+func (s *Server) handleDatasourceEmailOAuthDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-set-oauth2-client"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/datasource/{uuid}/oauth2/client"),
+		otelogen.OperationID("datasource-email-oauth-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/datasource/email_oauth/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceSetOAuth2ClientOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailOAuthDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4355,15 +4406,15 @@ func (s *Server) handleDatasourceSetOAuth2ClientRequest(args [1]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceSetOAuth2ClientOperation,
-			ID:   "datasource-set-oauth2-client",
+			Name: DatasourceEmailOAuthDeleteOperation,
+			ID:   "datasource-email-oauth-delete",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceSetOAuth2ClientOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailOAuthDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4381,7 +4432,7 @@ func (s *Server) handleDatasourceSetOAuth2ClientRequest(args [1]string, argsEsca
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceSetOAuth2ClientOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailOAuthDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4399,7 +4450,7 @@ func (s *Server) handleDatasourceSetOAuth2ClientRequest(args [1]string, argsEsca
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceSetOAuth2ClientOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailOAuthDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4443,7 +4494,7 @@ func (s *Server) handleDatasourceSetOAuth2ClientRequest(args [1]string, argsEsca
 			return
 		}
 	}
-	params, err := decodeDatasourceSetOAuth2ClientParams(args, argsEscaped, r)
+	params, err := decodeDatasourceEmailOAuthDeleteParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4453,30 +4504,15 @@ func (s *Server) handleDatasourceSetOAuth2ClientRequest(args [1]string, argsEsca
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeDatasourceSetOAuth2ClientRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *DatasourceSetOAuth2ClientNoContent
+	var response *DatasourceEmailOAuthDeleteOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceSetOAuth2ClientOperation,
+			OperationName:    DatasourceEmailOAuthDeleteOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-set-oauth2-client",
-			Body:             request,
+			OperationID:      "datasource-email-oauth-delete",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "uuid",
@@ -4487,9 +4523,9 @@ func (s *Server) handleDatasourceSetOAuth2ClientRequest(args [1]string, argsEsca
 		}
 
 		type (
-			Request  = *DatasourceSetOAuth2ClientReq
-			Params   = DatasourceSetOAuth2ClientParams
-			Response = *DatasourceSetOAuth2ClientNoContent
+			Request  = struct{}
+			Params   = DatasourceEmailOAuthDeleteParams
+			Response = *DatasourceEmailOAuthDeleteOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4498,14 +4534,14 @@ func (s *Server) handleDatasourceSetOAuth2ClientRequest(args [1]string, argsEsca
 		](
 			m,
 			mreq,
-			unpackDatasourceSetOAuth2ClientParams,
+			unpackDatasourceEmailOAuthDeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.DatasourceSetOAuth2Client(ctx, request, params)
+				err = s.h.DatasourceEmailOAuthDelete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.DatasourceSetOAuth2Client(ctx, request, params)
+		err = s.h.DatasourceEmailOAuthDelete(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4524,7 +4560,7 @@ func (s *Server) handleDatasourceSetOAuth2ClientRequest(args [1]string, argsEsca
 		return
 	}
 
-	if err := encodeDatasourceSetOAuth2ClientResponse(response, w, span); err != nil {
+	if err := encodeDatasourceEmailOAuthDeleteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4533,22 +4569,22 @@ func (s *Server) handleDatasourceSetOAuth2ClientRequest(args [1]string, argsEsca
 	}
 }
 
-// handleDatasourceTelegramCreateRequest handles datasource-telegram-create operation.
+// handleDatasourceEmailOAuthGetRequest handles datasource-email-oauth-get operation.
 //
-// Create a new Telegram datasource.
+// Retrieve an OAuth2‑based email datasource.
 //
-// POST /datasource/telegram
-func (s *Server) handleDatasourceTelegramCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /datasource/email_oauth/{uuid}
+func (s *Server) handleDatasourceEmailOAuthGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-telegram-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/datasource/telegram"),
+		otelogen.OperationID("datasource-email-oauth-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource/email_oauth/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceTelegramCreateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailOAuthGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4603,15 +4639,15 @@ func (s *Server) handleDatasourceTelegramCreateRequest(args [0]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceTelegramCreateOperation,
-			ID:   "datasource-telegram-create",
+			Name: DatasourceEmailOAuthGetOperation,
+			ID:   "datasource-email-oauth-get",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceTelegramCreateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailOAuthGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4629,7 +4665,7 @@ func (s *Server) handleDatasourceTelegramCreateRequest(args [0]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceTelegramCreateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailOAuthGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4647,7 +4683,7 @@ func (s *Server) handleDatasourceTelegramCreateRequest(args [0]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceTelegramCreateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailOAuthGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4691,39 +4727,39 @@ func (s *Server) handleDatasourceTelegramCreateRequest(args [0]string, argsEscap
 			return
 		}
 	}
-	request, close, err := s.decodeDatasourceTelegramCreateRequest(r)
+	params, err := decodeDatasourceEmailOAuthGetParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *DatasourceTelegram
+	var response *DatasourceEmailOAuth
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceTelegramCreateOperation,
+			OperationName:    DatasourceEmailOAuthGetOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-telegram-create",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
-		}
-
-		type (
-			Request  = *DatasourceTelegram
-			Params   = struct{}
-			Response = *DatasourceTelegram
-		)
+			OperationID:      "datasource-email-oauth-get",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DatasourceEmailOAuthGetParams
+			Response = *DatasourceEmailOAuth
+		)
 		response, err = middleware.HookMiddleware[
 			Request,
 			Params,
@@ -4731,14 +4767,14 @@ func (s *Server) handleDatasourceTelegramCreateRequest(args [0]string, argsEscap
 		](
 			m,
 			mreq,
-			nil,
+			unpackDatasourceEmailOAuthGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceTelegramCreate(ctx, request)
+				response, err = s.h.DatasourceEmailOAuthGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceTelegramCreate(ctx, request)
+		response, err = s.h.DatasourceEmailOAuthGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4757,7 +4793,7 @@ func (s *Server) handleDatasourceTelegramCreateRequest(args [0]string, argsEscap
 		return
 	}
 
-	if err := encodeDatasourceTelegramCreateResponse(response, w, span); err != nil {
+	if err := encodeDatasourceEmailOAuthGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4766,22 +4802,22 @@ func (s *Server) handleDatasourceTelegramCreateRequest(args [0]string, argsEscap
 	}
 }
 
-// handleDatasourceTelegramDeleteRequest handles datasource-telegram-delete operation.
+// handleDatasourceEmailOAuthListRequest handles datasource-email-oauth-list operation.
 //
-// Delete a Telegram datasource.
+// List OAuth2‑based email datasources.
 //
-// DELETE /datasource/telegram/{uuid}
-func (s *Server) handleDatasourceTelegramDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /datasource/email_oauth
+func (s *Server) handleDatasourceEmailOAuthListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-telegram-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/datasource/telegram/{uuid}"),
+		otelogen.OperationID("datasource-email-oauth-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource/email_oauth"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceTelegramDeleteOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailOAuthListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4836,15 +4872,15 @@ func (s *Server) handleDatasourceTelegramDeleteRequest(args [1]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceTelegramDeleteOperation,
-			ID:   "datasource-telegram-delete",
+			Name: DatasourceEmailOAuthListOperation,
+			ID:   "datasource-email-oauth-list",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceTelegramDeleteOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailOAuthListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4862,7 +4898,7 @@ func (s *Server) handleDatasourceTelegramDeleteRequest(args [1]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceTelegramDeleteOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailOAuthListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4880,7 +4916,7 @@ func (s *Server) handleDatasourceTelegramDeleteRequest(args [1]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceTelegramDeleteOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailOAuthListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4924,7 +4960,7 @@ func (s *Server) handleDatasourceTelegramDeleteRequest(args [1]string, argsEscap
 			return
 		}
 	}
-	params, err := decodeDatasourceTelegramDeleteParams(args, argsEscaped, r)
+	params, err := decodeDatasourceEmailOAuthListParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4935,27 +4971,31 @@ func (s *Server) handleDatasourceTelegramDeleteRequest(args [1]string, argsEscap
 		return
 	}
 
-	var response *DatasourceTelegramDeleteOK
+	var response []DatasourceEmailOAuth
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceTelegramDeleteOperation,
+			OperationName:    DatasourceEmailOAuthListOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-telegram-delete",
+			OperationID:      "datasource-email-oauth-list",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DatasourceTelegramDeleteParams
-			Response = *DatasourceTelegramDeleteOK
+			Params   = DatasourceEmailOAuthListParams
+			Response = []DatasourceEmailOAuth
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4964,14 +5004,14 @@ func (s *Server) handleDatasourceTelegramDeleteRequest(args [1]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackDatasourceTelegramDeleteParams,
+			unpackDatasourceEmailOAuthListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.DatasourceTelegramDelete(ctx, params)
+				response, err = s.h.DatasourceEmailOAuthList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.DatasourceTelegramDelete(ctx, params)
+		response, err = s.h.DatasourceEmailOAuthList(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4990,7 +5030,7 @@ func (s *Server) handleDatasourceTelegramDeleteRequest(args [1]string, argsEscap
 		return
 	}
 
-	if err := encodeDatasourceTelegramDeleteResponse(response, w, span); err != nil {
+	if err := encodeDatasourceEmailOAuthListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4999,22 +5039,22 @@ func (s *Server) handleDatasourceTelegramDeleteRequest(args [1]string, argsEscap
 	}
 }
 
-// handleDatasourceTelegramGetRequest handles datasource-telegram-get operation.
+// handleDatasourceEmailOAuthUpdateRequest handles datasource-email-oauth-update operation.
 //
-// Get a Telegram datasource.
+// Update an existing email OAuth datasource.
 //
-// GET /datasource/telegram/{uuid}
-func (s *Server) handleDatasourceTelegramGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /datasource/email_oauth/{uuid}
+func (s *Server) handleDatasourceEmailOAuthUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-telegram-get"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource/telegram/{uuid}"),
+		otelogen.OperationID("datasource-email-oauth-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/datasource/email_oauth/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceTelegramGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailOAuthUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5069,15 +5109,15 @@ func (s *Server) handleDatasourceTelegramGetRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceTelegramGetOperation,
-			ID:   "datasource-telegram-get",
+			Name: DatasourceEmailOAuthUpdateOperation,
+			ID:   "datasource-email-oauth-update",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceTelegramGetOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailOAuthUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5095,7 +5135,7 @@ func (s *Server) handleDatasourceTelegramGetRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceTelegramGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailOAuthUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5113,7 +5153,7 @@ func (s *Server) handleDatasourceTelegramGetRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceTelegramGetOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailOAuthUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5157,7 +5197,7 @@ func (s *Server) handleDatasourceTelegramGetRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeDatasourceTelegramGetParams(args, argsEscaped, r)
+	params, err := decodeDatasourceEmailOAuthUpdateParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5167,15 +5207,30 @@ func (s *Server) handleDatasourceTelegramGetRequest(args [1]string, argsEscaped
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeDatasourceEmailOAuthUpdateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *DatasourceTelegram
+	var response *DatasourceEmailOAuth
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceTelegramGetOperation,
+			OperationName:    DatasourceEmailOAuthUpdateOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-telegram-get",
-			Body:             nil,
+			OperationID:      "datasource-email-oauth-update",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "uuid",
@@ -5186,9 +5241,9 @@ func (s *Server) handleDatasourceTelegramGetRequest(args [1]string, argsEscaped
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DatasourceTelegramGetParams
-			Response = *DatasourceTelegram
+			Request  = *DatasourceEmailOAuth
+			Params   = DatasourceEmailOAuthUpdateParams
+			Response = *DatasourceEmailOAuth
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5197,14 +5252,14 @@ func (s *Server) handleDatasourceTelegramGetRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackDatasourceTelegramGetParams,
+			unpackDatasourceEmailOAuthUpdateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceTelegramGet(ctx, params)
+				response, err = s.h.DatasourceEmailOAuthUpdate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceTelegramGet(ctx, params)
+		response, err = s.h.DatasourceEmailOAuthUpdate(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5223,7 +5278,7 @@ func (s *Server) handleDatasourceTelegramGetRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeDatasourceTelegramGetResponse(response, w, span); err != nil {
+	if err := encodeDatasourceEmailOAuthUpdateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5232,22 +5287,22 @@ func (s *Server) handleDatasourceTelegramGetRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleDatasourceTelegramListRequest handles datasource-telegram-list operation.
+// handleDatasourceEmailUpdateRequest handles datasource-email-update operation.
 //
-// List all Telegram datasources.
+// Update an email datasource.
 //
-// GET /datasource/telegram
-func (s *Server) handleDatasourceTelegramListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /datasource/email/{uuid}
+func (s *Server) handleDatasourceEmailUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-telegram-list"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource/telegram"),
+		otelogen.OperationID("datasource-email-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/datasource/email/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceTelegramListOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceEmailUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5302,15 +5357,15 @@ func (s *Server) handleDatasourceTelegramListRequest(args [0]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceTelegramListOperation,
-			ID:   "datasource-telegram-list",
+			Name: DatasourceEmailUpdateOperation,
+			ID:   "datasource-email-update",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceTelegramListOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceEmailUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5328,7 +5383,7 @@ func (s *Server) handleDatasourceTelegramListRequest(args [0]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceTelegramListOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceEmailUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5346,7 +5401,7 @@ func (s *Server) handleDatasourceTelegramListRequest(args [0]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceTelegramListOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceEmailUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5390,7 +5445,7 @@ func (s *Server) handleDatasourceTelegramListRequest(args [0]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeDatasourceTelegramListParams(args, argsEscaped, r)
+	params, err := decodeDatasourceEmailUpdateParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5400,32 +5455,43 @@ func (s *Server) handleDatasourceTelegramListRequest(args [0]string, argsEscaped
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeDatasourceEmailUpdateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response []DatasourceTelegram
+	var response *DatasourceEmail
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceTelegramListOperation,
+			OperationName:    DatasourceEmailUpdateOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-telegram-list",
-			Body:             nil,
+			OperationID:      "datasource-email-update",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DatasourceTelegramListParams
-			Response = []DatasourceTelegram
+			Request  = *DatasourceEmail
+			Params   = DatasourceEmailUpdateParams
+			Response = *DatasourceEmail
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5434,14 +5500,14 @@ func (s *Server) handleDatasourceTelegramListRequest(args [0]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackDatasourceTelegramListParams,
+			unpackDatasourceEmailUpdateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceTelegramList(ctx, params)
+				response, err = s.h.DatasourceEmailUpdate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceTelegramList(ctx, params)
+		response, err = s.h.DatasourceEmailUpdate(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5460,7 +5526,7 @@ func (s *Server) handleDatasourceTelegramListRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeDatasourceTelegramListResponse(response, w, span); err != nil {
+	if err := encodeDatasourceEmailUpdateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5469,22 +5535,22 @@ func (s *Server) handleDatasourceTelegramListRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleDatasourceTelegramUpdateRequest handles datasource-telegram-update operation.
+// handleDatasourceLinkedinCreateRequest handles datasource-linkedin-create operation.
 //
-// Update a Telegram datasource.
+// Create a new LinkedIn datasource.
 //
-// PUT /datasource/telegram/{uuid}
-func (s *Server) handleDatasourceTelegramUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /datasource/linkedin
+func (s *Server) handleDatasourceLinkedinCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-telegram-update"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/datasource/telegram/{uuid}"),
+		otelogen.OperationID("datasource-linkedin-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/datasource/linkedin"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceTelegramUpdateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceLinkedinCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5539,15 +5605,15 @@ func (s *Server) handleDatasourceTelegramUpdateRequest(args [1]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceTelegramUpdateOperation,
-			ID:   "datasource-telegram-update",
+			Name: DatasourceLinkedinCreateOperation,
+			ID:   "datasource-linkedin-create",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceTelegramUpdateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceLinkedinCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5565,7 +5631,7 @@ func (s *Server) handleDatasourceTelegramUpdateRequest(args [1]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceTelegramUpdateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceLinkedinCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5583,7 +5649,7 @@ func (s *Server) handleDatasourceTelegramUpdateRequest(args [1]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceTelegramUpdateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceLinkedinCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5627,23 +5693,13 @@ func (s *Server) handleDatasourceTelegramUpdateRequest(args [1]string, argsEscap
 			return
 		}
 	}
-	params, err := decodeDatasourceTelegramUpdateParams(args, argsEscaped, r)
+	request, close, err := s.decodeDatasourceLinkedinCreateRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	request, close, err := s.decodeDatasourceTelegramUpdateRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
@@ -5653,27 +5709,22 @@ func (s *Server) handleDatasourceTelegramUpdateRequest(args [1]string, argsEscap
 		}
 	}()
 
-	var response *DatasourceTelegram
+	var response *DatasourceLinkedin
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceTelegramUpdateOperation,
+			OperationName:    DatasourceLinkedinCreateOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-telegram-update",
+			OperationID:      "datasource-linkedin-create",
 			Body:             request,
-			Params: middleware.Parameters{
-				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = *DatasourceTelegram
-			Params   = DatasourceTelegramUpdateParams
-			Response = *DatasourceTelegram
+			Request  = *DatasourceLinkedin
+			Params   = struct{}
+			Response = *DatasourceLinkedin
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5682,14 +5733,14 @@ func (s *Server) handleDatasourceTelegramUpdateRequest(args [1]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackDatasourceTelegramUpdateParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceTelegramUpdate(ctx, request, params)
+				response, err = s.h.DatasourceLinkedinCreate(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceTelegramUpdate(ctx, request, params)
+		response, err = s.h.DatasourceLinkedinCreate(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5708,7 +5759,7 @@ func (s *Server) handleDatasourceTelegramUpdateRequest(args [1]string, argsEscap
 		return
 	}
 
-	if err := encodeDatasourceTelegramUpdateResponse(response, w, span); err != nil {
+	if err := encodeDatasourceLinkedinCreateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5717,22 +5768,22 @@ func (s *Server) handleDatasourceTelegramUpdateRequest(args [1]string, argsEscap
 	}
 }
 
-// handleDatasourceWhatsappCreateRequest handles datasource-whatsapp-create operation.
+// handleDatasourceLinkedinDeleteRequest handles datasource-linkedin-delete operation.
 //
-// Create a new WhatsApp datasource.
+// Delete a LinkedIn datasource.
 //
-// POST /datasource/whatsapp
-func (s *Server) handleDatasourceWhatsappCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /datasource/linkedin/{uuid}
+func (s *Server) handleDatasourceLinkedinDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-whatsapp-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/datasource/whatsapp"),
+		otelogen.OperationID("datasource-linkedin-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/datasource/linkedin/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceWhatsappCreateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceLinkedinDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5787,15 +5838,15 @@ func (s *Server) handleDatasourceWhatsappCreateRequest(args [0]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceWhatsappCreateOperation,
-			ID:   "datasource-whatsapp-create",
+			Name: DatasourceLinkedinDeleteOperation,
+			ID:   "datasource-linkedin-delete",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceWhatsappCreateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceLinkedinDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5813,7 +5864,7 @@ func (s *Server) handleDatasourceWhatsappCreateRequest(args [0]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceWhatsappCreateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceLinkedinDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5831,7 +5882,7 @@ func (s *Server) handleDatasourceWhatsappCreateRequest(args [0]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceWhatsappCreateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceLinkedinDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5875,38 +5926,38 @@ func (s *Server) handleDatasourceWhatsappCreateRequest(args [0]string, argsEscap
 			return
 		}
 	}
-	request, close, err := s.decodeDatasourceWhatsappCreateRequest(r)
+	params, err := decodeDatasourceLinkedinDeleteParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *DatasourceWhatsapp
+	var response *DatasourceLinkedinDeleteOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceWhatsappCreateOperation,
+			OperationName:    DatasourceLinkedinDeleteOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-whatsapp-create",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationID:      "datasource-linkedin-delete",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *DatasourceWhatsapp
-			Params   = struct{}
-			Response = *DatasourceWhatsapp
+			Request  = struct{}
+			Params   = DatasourceLinkedinDeleteParams
+			Response = *DatasourceLinkedinDeleteOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5915,14 +5966,14 @@ func (s *Server) handleDatasourceWhatsappCreateRequest(args [0]string, argsEscap
 		](
 			m,
 			mreq,
-			nil,
+			unpackDatasourceLinkedinDeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceWhatsappCreate(ctx, request)
+				err = s.h.DatasourceLinkedinDelete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceWhatsappCreate(ctx, request)
+		err = s.h.DatasourceLinkedinDelete(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5941,7 +5992,7 @@ func (s *Server) handleDatasourceWhatsappCreateRequest(args [0]string, argsEscap
 		return
 	}
 
-	if err := encodeDatasourceWhatsappCreateResponse(response, w, span); err != nil {
+	if err := encodeDatasourceLinkedinDeleteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5950,22 +6001,22 @@ func (s *Server) handleDatasourceWhatsappCreateRequest(args [0]string, argsEscap
 	}
 }
 
-// handleDatasourceWhatsappDeleteRequest handles datasource-whatsapp-delete operation.
+// handleDatasourceLinkedinGetRequest handles datasource-linkedin-get operation.
 //
-// Delete a WhatsApp datasource.
+// Get a LinkedIn datasource.
 //
-// DELETE /datasource/whatsapp/{uuid}
-func (s *Server) handleDatasourceWhatsappDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /datasource/linkedin/{uuid}
+func (s *Server) handleDatasourceLinkedinGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-whatsapp-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/datasource/whatsapp/{uuid}"),
+		otelogen.OperationID("datasource-linkedin-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource/linkedin/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceWhatsappDeleteOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceLinkedinGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6020,15 +6071,15 @@ func (s *Server) handleDatasourceWhatsappDeleteRequest(args [1]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceWhatsappDeleteOperation,
-			ID:   "datasource-whatsapp-delete",
+			Name: DatasourceLinkedinGetOperation,
+			ID:   "datasource-linkedin-get",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceWhatsappDeleteOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceLinkedinGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6046,7 +6097,7 @@ func (s *Server) handleDatasourceWhatsappDeleteRequest(args [1]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceWhatsappDeleteOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceLinkedinGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6064,7 +6115,7 @@ func (s *Server) handleDatasourceWhatsappDeleteRequest(args [1]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceWhatsappDeleteOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceLinkedinGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6108,7 +6159,7 @@ func (s *Server) handleDatasourceWhatsappDeleteRequest(args [1]string, argsEscap
 			return
 		}
 	}
-	params, err := decodeDatasourceWhatsappDeleteParams(args, argsEscaped, r)
+	params, err := decodeDatasourceLinkedinGetParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6119,13 +6170,13 @@ func (s *Server) handleDatasourceWhatsappDeleteRequest(args [1]string, argsEscap
 		return
 	}
 
-	var response *DatasourceWhatsappDeleteOK
+	var response *DatasourceLinkedin
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceWhatsappDeleteOperation,
+			OperationName:    DatasourceLinkedinGetOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-whatsapp-delete",
+			OperationID:      "datasource-linkedin-get",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -6138,8 +6189,8 @@ func (s *Server) handleDatasourceWhatsappDeleteRequest(args [1]string, argsEscap
 
 		type (
 			Request  = struct{}
-			Params   = DatasourceWhatsappDeleteParams
-			Response = *DatasourceWhatsappDeleteOK
+			Params   = DatasourceLinkedinGetParams
+			Response = *DatasourceLinkedin
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6148,14 +6199,14 @@ func (s *Server) handleDatasourceWhatsappDeleteRequest(args [1]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackDatasourceWhatsappDeleteParams,
+			unpackDatasourceLinkedinGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.DatasourceWhatsappDelete(ctx, params)
+				response, err = s.h.DatasourceLinkedinGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.DatasourceWhatsappDelete(ctx, params)
+		response, err = s.h.DatasourceLinkedinGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6174,7 +6225,7 @@ func (s *Server) handleDatasourceWhatsappDeleteRequest(args [1]string, argsEscap
 		return
 	}
 
-	if err := encodeDatasourceWhatsappDeleteResponse(response, w, span); err != nil {
+	if err := encodeDatasourceLinkedinGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6183,22 +6234,22 @@ func (s *Server) handleDatasourceWhatsappDeleteRequest(args [1]string, argsEscap
 	}
 }
 
-// handleDatasourceWhatsappGetRequest handles datasource-whatsapp-get operation.
+// handleDatasourceLinkedinListRequest handles datasource-linkedin-list operation.
 //
-// Get a WhatsApp datasource.
+// List all LinkedIn datasources.
 //
-// GET /datasource/whatsapp/{uuid}
-func (s *Server) handleDatasourceWhatsappGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /datasource/linkedin
+func (s *Server) handleDatasourceLinkedinListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-whatsapp-get"),
+		otelogen.OperationID("datasource-linkedin-list"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource/whatsapp/{uuid}"),
+		semconv.HTTPRouteKey.String("/datasource/linkedin"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceWhatsappGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceLinkedinListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6253,15 +6304,15 @@ func (s *Server) handleDatasourceWhatsappGetRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceWhatsappGetOperation,
-			ID:   "datasource-whatsapp-get",
+			Name: DatasourceLinkedinListOperation,
+			ID:   "datasource-linkedin-list",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceWhatsappGetOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceLinkedinListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6279,7 +6330,7 @@ func (s *Server) handleDatasourceWhatsappGetRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceWhatsappGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceLinkedinListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6297,7 +6348,7 @@ func (s *Server) handleDatasourceWhatsappGetRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceWhatsappGetOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceLinkedinListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6341,7 +6392,7 @@ func (s *Server) handleDatasourceWhatsappGetRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeDatasourceWhatsappGetParams(args, argsEscaped, r)
+	params, err := decodeDatasourceLinkedinListParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6352,27 +6403,31 @@ func (s *Server) handleDatasourceWhatsappGetRequest(args [1]string, argsEscaped
 		return
 	}
 
-	var response *DatasourceWhatsapp
+	var response []DatasourceLinkedin
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceWhatsappGetOperation,
+			OperationName:    DatasourceLinkedinListOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-whatsapp-get",
+			OperationID:      "datasource-linkedin-list",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DatasourceWhatsappGetParams
-			Response = *DatasourceWhatsapp
+			Params   = DatasourceLinkedinListParams
+			Response = []DatasourceLinkedin
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6381,14 +6436,14 @@ func (s *Server) handleDatasourceWhatsappGetRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackDatasourceWhatsappGetParams,
+			unpackDatasourceLinkedinListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceWhatsappGet(ctx, params)
+				response, err = s.h.DatasourceLinkedinList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceWhatsappGet(ctx, params)
+		response, err = s.h.DatasourceLinkedinList(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6407,7 +6462,7 @@ func (s *Server) handleDatasourceWhatsappGetRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeDatasourceWhatsappGetResponse(response, w, span); err != nil {
+	if err := encodeDatasourceLinkedinListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6416,22 +6471,22 @@ func (s *Server) handleDatasourceWhatsappGetRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleDatasourceWhatsappListRequest handles datasource-whatsapp-list operation.
+// handleDatasourceLinkedinUpdateRequest handles datasource-linkedin-update operation.
 //
-// List all WhatsApp datasources.
+// Update a LinkedIn datasource.
 //
-// GET /datasource/whatsapp
-func (s *Server) handleDatasourceWhatsappListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /datasource/linkedin/{uuid}
+func (s *Server) handleDatasourceLinkedinUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-whatsapp-list"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/datasource/whatsapp"),
+		otelogen.OperationID("datasource-linkedin-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/datasource/linkedin/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceWhatsappListOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceLinkedinUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6486,15 +6541,15 @@ func (s *Server) handleDatasourceWhatsappListRequest(args [0]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceWhatsappListOperation,
-			ID:   "datasource-whatsapp-list",
+			Name: DatasourceLinkedinUpdateOperation,
+			ID:   "datasource-linkedin-update",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceWhatsappListOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceLinkedinUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6512,7 +6567,7 @@ func (s *Server) handleDatasourceWhatsappListRequest(args [0]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceWhatsappListOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceLinkedinUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6530,7 +6585,7 @@ func (s *Server) handleDatasourceWhatsappListRequest(args [0]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceWhatsappListOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceLinkedinUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6574,7 +6629,7 @@ func (s *Server) handleDatasourceWhatsappListRequest(args [0]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeDatasourceWhatsappListParams(args, argsEscaped, r)
+	params, err := decodeDatasourceLinkedinUpdateParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6584,32 +6639,43 @@ func (s *Server) handleDatasourceWhatsappListRequest(args [0]string, argsEscaped
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeDatasourceLinkedinUpdateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response []DatasourceWhatsapp
+	var response *DatasourceLinkedin
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceWhatsappListOperation,
+			OperationName:    DatasourceLinkedinUpdateOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-whatsapp-list",
-			Body:             nil,
+			OperationID:      "datasource-linkedin-update",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DatasourceWhatsappListParams
-			Response = []DatasourceWhatsapp
+			Request  = *DatasourceLinkedin
+			Params   = DatasourceLinkedinUpdateParams
+			Response = *DatasourceLinkedin
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6618,14 +6684,14 @@ func (s *Server) handleDatasourceWhatsappListRequest(args [0]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackDatasourceWhatsappListParams,
+			unpackDatasourceLinkedinUpdateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceWhatsappList(ctx, params)
+				response, err = s.h.DatasourceLinkedinUpdate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceWhatsappList(ctx, params)
+		response, err = s.h.DatasourceLinkedinUpdate(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6644,7 +6710,7 @@ func (s *Server) handleDatasourceWhatsappListRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeDatasourceWhatsappListResponse(response, w, span); err != nil {
+	if err := encodeDatasourceLinkedinUpdateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6653,22 +6719,22 @@ func (s *Server) handleDatasourceWhatsappListRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleDatasourceWhatsappUpdateRequest handles datasource-whatsapp-update operation.
+// handleDatasourceListRequest handles datasource-list operation.
 //
-// Update a WhatsApp datasource.
+// Retrieve a list of datasource objects.
 //
-// PUT /datasource/whatsapp/{uuid}
-func (s *Server) handleDatasourceWhatsappUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /datasource
+func (s *Server) handleDatasourceListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("datasource-whatsapp-update"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/datasource/whatsapp/{uuid}"),
+		otelogen.OperationID("datasource-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceWhatsappUpdateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6723,15 +6789,15 @@ func (s *Server) handleDatasourceWhatsappUpdateRequest(args [1]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DatasourceWhatsappUpdateOperation,
-			ID:   "datasource-whatsapp-update",
+			Name: DatasourceListOperation,
+			ID:   "datasource-list",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceWhatsappUpdateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6749,7 +6815,7 @@ func (s *Server) handleDatasourceWhatsappUpdateRequest(args [1]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceWhatsappUpdateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6767,7 +6833,7 @@ func (s *Server) handleDatasourceWhatsappUpdateRequest(args [1]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceWhatsappUpdateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6811,7 +6877,7 @@ func (s *Server) handleDatasourceWhatsappUpdateRequest(args [1]string, argsEscap
 			return
 		}
 	}
-	params, err := decodeDatasourceWhatsappUpdateParams(args, argsEscaped, r)
+	params, err := decodeDatasourceListParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6821,43 +6887,32 @@ func (s *Server) handleDatasourceWhatsappUpdateRequest(args [1]string, argsEscap
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeDatasourceWhatsappUpdateRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *DatasourceWhatsapp
+	var response []Datasource
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DatasourceWhatsappUpdateOperation,
+			OperationName:    DatasourceListOperation,
 			OperationSummary: "",
-			OperationID:      "datasource-whatsapp-update",
-			Body:             request,
+			OperationID:      "datasource-list",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *DatasourceWhatsapp
-			Params   = DatasourceWhatsappUpdateParams
-			Response = *DatasourceWhatsapp
+			Request  = struct{}
+			Params   = DatasourceListParams
+			Response = []Datasource
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6866,14 +6921,14 @@ func (s *Server) handleDatasourceWhatsappUpdateRequest(args [1]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackDatasourceWhatsappUpdateParams,
+			unpackDatasourceListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DatasourceWhatsappUpdate(ctx, request, params)
+				response, err = s.h.DatasourceList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DatasourceWhatsappUpdate(ctx, request, params)
+		response, err = s.h.DatasourceList(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6892,7 +6947,7 @@ func (s *Server) handleDatasourceWhatsappUpdateRequest(args [1]string, argsEscap
 		return
 	}
 
-	if err := encodeDatasourceWhatsappUpdateResponse(response, w, span); err != nil {
+	if err := encodeDatasourceListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6901,22 +6956,22 @@ func (s *Server) handleDatasourceWhatsappUpdateRequest(args [1]string, argsEscap
 	}
 }
 
-// handleDeleteContactRequest handles deleteContact operation.
+// handleDatasourceSetOAuth2ClientRequest handles datasource-set-oauth2-client operation.
 //
-// Delete a contact record.
+// Set OAuth2 client to the datasource.
 //
-// DELETE /contact/{uuid}
-func (s *Server) handleDeleteContactRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /datasource/{uuid}/oauth2/client
+func (s *Server) handleDatasourceSetOAuth2ClientRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteContact"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/contact/{uuid}"),
+		otelogen.OperationID("datasource-set-oauth2-client"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/datasource/{uuid}/oauth2/client"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteContactOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceSetOAuth2ClientOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6971,15 +7026,15 @@ func (s *Server) handleDeleteContactRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteContactOperation,
-			ID:   "deleteContact",
+			Name: DatasourceSetOAuth2ClientOperation,
+			ID:   "datasource-set-oauth2-client",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DeleteContactOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceSetOAuth2ClientOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6997,7 +7052,7 @@ func (s *Server) handleDeleteContactRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DeleteContactOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceSetOAuth2ClientOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7015,7 +7070,7 @@ func (s *Server) handleDeleteContactRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DeleteContactOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceSetOAuth2ClientOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7059,7 +7114,7 @@ func (s *Server) handleDeleteContactRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeDeleteContactParams(args, argsEscaped, r)
+	params, err := decodeDatasourceSetOAuth2ClientParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7069,15 +7124,30 @@ func (s *Server) handleDeleteContactRequest(args [1]string, argsEscaped bool, w
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeDatasourceSetOAuth2ClientRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *DeleteContactOK
+	var response *DatasourceSetOAuth2ClientNoContent
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteContactOperation,
-			OperationSummary: "Delete a contact record",
-			OperationID:      "deleteContact",
-			Body:             nil,
+			OperationName:    DatasourceSetOAuth2ClientOperation,
+			OperationSummary: "",
+			OperationID:      "datasource-set-oauth2-client",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "uuid",
@@ -7088,9 +7158,9 @@ func (s *Server) handleDeleteContactRequest(args [1]string, argsEscaped bool, w
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DeleteContactParams
-			Response = *DeleteContactOK
+			Request  = *DatasourceSetOAuth2ClientReq
+			Params   = DatasourceSetOAuth2ClientParams
+			Response = *DatasourceSetOAuth2ClientNoContent
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7099,14 +7169,14 @@ func (s *Server) handleDeleteContactRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackDeleteContactParams,
+			unpackDatasourceSetOAuth2ClientParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.DeleteContact(ctx, params)
+				err = s.h.DatasourceSetOAuth2Client(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.DeleteContact(ctx, params)
+		err = s.h.DatasourceSetOAuth2Client(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7125,7 +7195,7 @@ func (s *Server) handleDeleteContactRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeDeleteContactResponse(response, w, span); err != nil {
+	if err := encodeDatasourceSetOAuth2ClientResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7134,22 +7204,22 @@ func (s *Server) handleDeleteContactRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleDeleteUserRequest handles deleteUser operation.
+// handleDatasourceTelegramCreateRequest handles datasource-telegram-create operation.
 //
-// Delete user.
+// Create a new Telegram datasource.
 //
-// DELETE /user/{uuid}
-func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /datasource/telegram
+func (s *Server) handleDatasourceTelegramCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteUser"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/user/{uuid}"),
+		otelogen.OperationID("datasource-telegram-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/datasource/telegram"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteUserOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceTelegramCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7204,15 +7274,15 @@ func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteUserOperation,
-			ID:   "deleteUser",
+			Name: DatasourceTelegramCreateOperation,
+			ID:   "datasource-telegram-create",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DeleteUserOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceTelegramCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7230,7 +7300,7 @@ func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DeleteUserOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceTelegramCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7248,7 +7318,7 @@ func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, DeleteUserOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceTelegramCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7292,38 +7362,38 @@ func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeDeleteUserParams(args, argsEscaped, r)
+	request, close, err := s.decodeDatasourceTelegramCreateRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *DeleteUserOK
+	var response *DatasourceTelegram
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteUserOperation,
-			OperationSummary: "Delete user",
-			OperationID:      "deleteUser",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
-			},
-			Raw: r,
+			OperationName:    DatasourceTelegramCreateOperation,
+			OperationSummary: "",
+			OperationID:      "datasource-telegram-create",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DeleteUserParams
-			Response = *DeleteUserOK
+			Request  = *DatasourceTelegram
+			Params   = struct{}
+			Response = *DatasourceTelegram
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7332,14 +7402,14 @@ func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackDeleteUserParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.DeleteUser(ctx, params)
+				response, err = s.h.DatasourceTelegramCreate(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.DeleteUser(ctx, params)
+		response, err = s.h.DatasourceTelegramCreate(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7358,7 +7428,7 @@ func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeDeleteUserResponse(response, w, span); err != nil {
+	if err := encodeDatasourceTelegramCreateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7367,22 +7437,22 @@ func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleFileCreateRequest handles file-create operation.
+// handleDatasourceTelegramDeleteRequest handles datasource-telegram-delete operation.
 //
-// Upload a new file and create its record.
+// Delete a Telegram datasource.
 //
-// POST /file
-func (s *Server) handleFileCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /datasource/telegram/{uuid}
+func (s *Server) handleDatasourceTelegramDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("file-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/file"),
+		otelogen.OperationID("datasource-telegram-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/datasource/telegram/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), FileCreateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceTelegramDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7437,15 +7507,15 @@ func (s *Server) handleFileCreateRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: FileCreateOperation,
-			ID:   "file-create",
+			Name: DatasourceTelegramDeleteOperation,
+			ID:   "datasource-telegram-delete",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, FileCreateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceTelegramDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7463,7 +7533,7 @@ func (s *Server) handleFileCreateRequest(args [0]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, FileCreateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceTelegramDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7481,7 +7551,7 @@ func (s *Server) handleFileCreateRequest(args [0]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, FileCreateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceTelegramDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7525,38 +7595,38 @@ func (s *Server) handleFileCreateRequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	request, close, err := s.decodeFileCreateRequest(r)
+	params, err := decodeDatasourceTelegramDeleteParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *UploadFileResponse
+	var response *DatasourceTelegramDeleteOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    FileCreateOperation,
+			OperationName:    DatasourceTelegramDeleteOperation,
 			OperationSummary: "",
-			OperationID:      "file-create",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationID:      "datasource-telegram-delete",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *UploadFileRequest
-			Params   = struct{}
-			Response = *UploadFileResponse
+			Request  = struct{}
+			Params   = DatasourceTelegramDeleteParams
+			Response = *DatasourceTelegramDeleteOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7565,14 +7635,14 @@ func (s *Server) handleFileCreateRequest(args [0]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			nil,
+			unpackDatasourceTelegramDeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.FileCreate(ctx, request)
+				err = s.h.DatasourceTelegramDelete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.FileCreate(ctx, request)
+		err = s.h.DatasourceTelegramDelete(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7591,7 +7661,7 @@ func (s *Server) handleFileCreateRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeFileCreateResponse(response, w, span); err != nil {
+	if err := encodeDatasourceTelegramDeleteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7600,22 +7670,22 @@ func (s *Server) handleFileCreateRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleFileDeleteRequest handles file-delete operation.
+// handleDatasourceTelegramGetRequest handles datasource-telegram-get operation.
 //
-// Delete a stored file.
+// Get a Telegram datasource.
 //
-// DELETE /file/{uuid}
-func (s *Server) handleFileDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /datasource/telegram/{uuid}
+func (s *Server) handleDatasourceTelegramGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("file-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/file/{uuid}"),
+		otelogen.OperationID("datasource-telegram-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource/telegram/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), FileDeleteOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceTelegramGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7670,15 +7740,15 @@ func (s *Server) handleFileDeleteRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: FileDeleteOperation,
-			ID:   "file-delete",
+			Name: DatasourceTelegramGetOperation,
+			ID:   "datasource-telegram-get",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, FileDeleteOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceTelegramGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7696,7 +7766,7 @@ func (s *Server) handleFileDeleteRequest(args [1]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, FileDeleteOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceTelegramGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7714,7 +7784,7 @@ func (s *Server) handleFileDeleteRequest(args [1]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, FileDeleteOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceTelegramGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7758,7 +7828,7 @@ func (s *Server) handleFileDeleteRequest(args [1]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeFileDeleteParams(args, argsEscaped, r)
+	params, err := decodeDatasourceTelegramGetParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7769,13 +7839,13 @@ func (s *Server) handleFileDeleteRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	var response *FileDeleteOK
+	var response *DatasourceTelegram
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    FileDeleteOperation,
+			OperationName:    DatasourceTelegramGetOperation,
 			OperationSummary: "",
-			OperationID:      "file-delete",
+			OperationID:      "datasource-telegram-get",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -7788,8 +7858,8 @@ func (s *Server) handleFileDeleteRequest(args [1]string, argsEscaped bool, w htt
 
 		type (
 			Request  = struct{}
-			Params   = FileDeleteParams
-			Response = *FileDeleteOK
+			Params   = DatasourceTelegramGetParams
+			Response = *DatasourceTelegram
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7798,14 +7868,14 @@ func (s *Server) handleFileDeleteRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackFileDeleteParams,
+			unpackDatasourceTelegramGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.FileDelete(ctx, params)
+				response, err = s.h.DatasourceTelegramGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.FileDelete(ctx, params)
+		response, err = s.h.DatasourceTelegramGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7824,7 +7894,7 @@ func (s *Server) handleFileDeleteRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeFileDeleteResponse(response, w, span); err != nil {
+	if err := encodeDatasourceTelegramGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7833,22 +7903,22 @@ func (s *Server) handleFileDeleteRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleFileGetRequest handles file-get operation.
+// handleDatasourceTelegramListRequest handles datasource-telegram-list operation.
 //
-// Retrieve details of a stored file.
+// List all Telegram datasources.
 //
-// GET /file/{uuid}
-func (s *Server) handleFileGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /datasource/telegram
+func (s *Server) handleDatasourceTelegramListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("file-get"),
+		otelogen.OperationID("datasource-telegram-list"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/file/{uuid}"),
+		semconv.HTTPRouteKey.String("/datasource/telegram"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), FileGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceTelegramListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7903,15 +7973,15 @@ func (s *Server) handleFileGetRequest(args [1]string, argsEscaped bool, w http.R
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: FileGetOperation,
-			ID:   "file-get",
+			Name: DatasourceTelegramListOperation,
+			ID:   "datasource-telegram-list",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, FileGetOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceTelegramListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7929,7 +7999,7 @@ func (s *Server) handleFileGetRequest(args [1]string, argsEscaped bool, w http.R
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, FileGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceTelegramListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7947,7 +8017,7 @@ func (s *Server) handleFileGetRequest(args [1]string, argsEscaped bool, w http.R
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, FileGetOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceTelegramListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7991,7 +8061,7 @@ func (s *Server) handleFileGetRequest(args [1]string, argsEscaped bool, w http.R
 			return
 		}
 	}
-	params, err := decodeFileGetParams(args, argsEscaped, r)
+	params, err := decodeDatasourceTelegramListParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -8002,27 +8072,31 @@ func (s *Server) handleFileGetRequest(args [1]string, argsEscaped bool, w http.R
 		return
 	}
 
-	var response *FileObject
+	var response []DatasourceTelegram
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    FileGetOperation,
+			OperationName:    DatasourceTelegramListOperation,
 			OperationSummary: "",
-			OperationID:      "file-get",
+			OperationID:      "datasource-telegram-list",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = FileGetParams
-			Response = *FileObject
+			Params   = DatasourceTelegramListParams
+			Response = []DatasourceTelegram
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8031,14 +8105,14 @@ func (s *Server) handleFileGetRequest(args [1]string, argsEscaped bool, w http.R
 		](
 			m,
 			mreq,
-			unpackFileGetParams,
+			unpackDatasourceTelegramListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.FileGet(ctx, params)
+				response, err = s.h.DatasourceTelegramList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.FileGet(ctx, params)
+		response, err = s.h.DatasourceTelegramList(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8057,7 +8131,7 @@ func (s *Server) handleFileGetRequest(args [1]string, argsEscaped bool, w http.R
 		return
 	}
 
-	if err := encodeFileGetResponse(response, w, span); err != nil {
+	if err := encodeDatasourceTelegramListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8066,22 +8140,22 @@ func (s *Server) handleFileGetRequest(args [1]string, argsEscaped bool, w http.R
 	}
 }
 
-// handleFileListRequest handles file-list operation.
+// handleDatasourceTelegramUpdateRequest handles datasource-telegram-update operation.
 //
-// Retrieve a list of stored files.
+// Update a Telegram datasource.
 //
-// GET /file
-func (s *Server) handleFileListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /datasource/telegram/{uuid}
+func (s *Server) handleDatasourceTelegramUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("file-list"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/file"),
+		otelogen.OperationID("datasource-telegram-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/datasource/telegram/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), FileListOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceTelegramUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8136,15 +8210,15 @@ func (s *Server) handleFileListRequest(args [0]string, argsEscaped bool, w http.
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: FileListOperation,
-			ID:   "file-list",
+			Name: DatasourceTelegramUpdateOperation,
+			ID:   "datasource-telegram-update",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, FileListOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceTelegramUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8162,7 +8236,7 @@ func (s *Server) handleFileListRequest(args [0]string, argsEscaped bool, w http.
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, FileListOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceTelegramUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8180,7 +8254,7 @@ func (s *Server) handleFileListRequest(args [0]string, argsEscaped bool, w http.
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, FileListOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceTelegramUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8224,7 +8298,7 @@ func (s *Server) handleFileListRequest(args [0]string, argsEscaped bool, w http.
 			return
 		}
 	}
-	params, err := decodeFileListParams(args, argsEscaped, r)
+	params, err := decodeDatasourceTelegramUpdateParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -8234,32 +8308,43 @@ func (s *Server) handleFileListRequest(args [0]string, argsEscaped bool, w http.
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeDatasourceTelegramUpdateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response []FileObject
+	var response *DatasourceTelegram
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    FileListOperation,
+			OperationName:    DatasourceTelegramUpdateOperation,
 			OperationSummary: "",
-			OperationID:      "file-list",
-			Body:             nil,
+			OperationID:      "datasource-telegram-update",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = FileListParams
-			Response = []FileObject
+			Request  = *DatasourceTelegram
+			Params   = DatasourceTelegramUpdateParams
+			Response = *DatasourceTelegram
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8268,14 +8353,14 @@ func (s *Server) handleFileListRequest(args [0]string, argsEscaped bool, w http.
 		](
 			m,
 			mreq,
-			unpackFileListParams,
+			unpackDatasourceTelegramUpdateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.FileList(ctx, params)
+				response, err = s.h.DatasourceTelegramUpdate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.FileList(ctx, params)
+		response, err = s.h.DatasourceTelegramUpdate(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8294,7 +8379,7 @@ func (s *Server) handleFileListRequest(args [0]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeFileListResponse(response, w, span); err != nil {
+	if err := encodeDatasourceTelegramUpdateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8303,22 +8388,22 @@ func (s *Server) handleFileListRequest(args [0]string, argsEscaped bool, w http.
 	}
 }
 
-// handleFileUpdateRequest handles file-update operation.
+// handleDatasourceWhatsappCreateRequest handles datasource-whatsapp-create operation.
 //
-// Update metadata of a stored file.
+// Create a new WhatsApp datasource.
 //
-// PUT /file/{uuid}
-func (s *Server) handleFileUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /datasource/whatsapp
+func (s *Server) handleDatasourceWhatsappCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("file-update"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/file/{uuid}"),
+		otelogen.OperationID("datasource-whatsapp-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/datasource/whatsapp"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), FileUpdateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceWhatsappCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8373,15 +8458,15 @@ func (s *Server) handleFileUpdateRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: FileUpdateOperation,
-			ID:   "file-update",
+			Name: DatasourceWhatsappCreateOperation,
+			ID:   "datasource-whatsapp-create",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, FileUpdateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceWhatsappCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8399,7 +8484,7 @@ func (s *Server) handleFileUpdateRequest(args [1]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, FileUpdateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceWhatsappCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8417,7 +8502,7 @@ func (s *Server) handleFileUpdateRequest(args [1]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, FileUpdateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceWhatsappCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8461,23 +8546,13 @@ func (s *Server) handleFileUpdateRequest(args [1]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeFileUpdateParams(args, argsEscaped, r)
+	request, close, err := s.decodeDatasourceWhatsappCreateRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	request, close, err := s.decodeFileUpdateRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
@@ -8487,27 +8562,22 @@ func (s *Server) handleFileUpdateRequest(args [1]string, argsEscaped bool, w htt
 		}
 	}()
 
-	var response *FileObject
+	var response *DatasourceWhatsapp
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    FileUpdateOperation,
+			OperationName:    DatasourceWhatsappCreateOperation,
 			OperationSummary: "",
-			OperationID:      "file-update",
+			OperationID:      "datasource-whatsapp-create",
 			Body:             request,
-			Params: middleware.Parameters{
-				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = *FileUpdateReq
-			Params   = FileUpdateParams
-			Response = *FileObject
+			Request  = *DatasourceWhatsapp
+			Params   = struct{}
+			Response = *DatasourceWhatsapp
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8516,14 +8586,14 @@ func (s *Server) handleFileUpdateRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackFileUpdateParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.FileUpdate(ctx, request, params)
+				response, err = s.h.DatasourceWhatsappCreate(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.FileUpdate(ctx, request, params)
+		response, err = s.h.DatasourceWhatsappCreate(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8542,7 +8612,7 @@ func (s *Server) handleFileUpdateRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeFileUpdateResponse(response, w, span); err != nil {
+	if err := encodeDatasourceWhatsappCreateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8551,22 +8621,22 @@ func (s *Server) handleFileUpdateRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleGenerateDownloadLinkRequest handles generateDownloadLink operation.
+// handleDatasourceWhatsappDeleteRequest handles datasource-whatsapp-delete operation.
 //
-// Generate a download link for a stored file.
+// Delete a WhatsApp datasource.
 //
-// POST /storage/file-link
-func (s *Server) handleGenerateDownloadLinkRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /datasource/whatsapp/{uuid}
+func (s *Server) handleDatasourceWhatsappDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("generateDownloadLink"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/storage/file-link"),
+		otelogen.OperationID("datasource-whatsapp-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/datasource/whatsapp/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GenerateDownloadLinkOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceWhatsappDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8621,15 +8691,15 @@ func (s *Server) handleGenerateDownloadLinkRequest(args [0]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GenerateDownloadLinkOperation,
-			ID:   "generateDownloadLink",
+			Name: DatasourceWhatsappDeleteOperation,
+			ID:   "datasource-whatsapp-delete",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, GenerateDownloadLinkOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceWhatsappDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8647,7 +8717,7 @@ func (s *Server) handleGenerateDownloadLinkRequest(args [0]string, argsEscaped b
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GenerateDownloadLinkOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceWhatsappDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8665,7 +8735,7 @@ func (s *Server) handleGenerateDownloadLinkRequest(args [0]string, argsEscaped b
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, GenerateDownloadLinkOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceWhatsappDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8709,38 +8779,38 @@ func (s *Server) handleGenerateDownloadLinkRequest(args [0]string, argsEscaped b
 			return
 		}
 	}
-	request, close, err := s.decodeGenerateDownloadLinkRequest(r)
+	params, err := decodeDatasourceWhatsappDeleteParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *GenerateDownloadLinkResponse
+	var response *DatasourceWhatsappDeleteOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GenerateDownloadLinkOperation,
-			OperationSummary: "Generate a download link for a stored file",
-			OperationID:      "generateDownloadLink",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    DatasourceWhatsappDeleteOperation,
+			OperationSummary: "",
+			OperationID:      "datasource-whatsapp-delete",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *GenerateDownloadLinkRequest
-			Params   = struct{}
-			Response = *GenerateDownloadLinkResponse
+			Request  = struct{}
+			Params   = DatasourceWhatsappDeleteParams
+			Response = *DatasourceWhatsappDeleteOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8749,14 +8819,14 @@ func (s *Server) handleGenerateDownloadLinkRequest(args [0]string, argsEscaped b
 		](
 			m,
 			mreq,
-			nil,
+			unpackDatasourceWhatsappDeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GenerateDownloadLink(ctx, request)
+				err = s.h.DatasourceWhatsappDelete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GenerateDownloadLink(ctx, request)
+		err = s.h.DatasourceWhatsappDelete(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8775,7 +8845,7 @@ func (s *Server) handleGenerateDownloadLinkRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	if err := encodeGenerateDownloadLinkResponse(response, w, span); err != nil {
+	if err := encodeDatasourceWhatsappDeleteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8784,22 +8854,22 @@ func (s *Server) handleGenerateDownloadLinkRequest(args [0]string, argsEscaped b
 	}
 }
 
-// handleGeneratePresignedUploadUrlRequest handles generatePresignedUploadUrl operation.
+// handleDatasourceWhatsappGetRequest handles datasource-whatsapp-get operation.
 //
-// Generate a pre-signed URL for file upload.
+// Get a WhatsApp datasource.
 //
-// POST /storage/upload-url
-func (s *Server) handleGeneratePresignedUploadUrlRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /datasource/whatsapp/{uuid}
+func (s *Server) handleDatasourceWhatsappGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("generatePresignedUploadUrl"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/storage/upload-url"),
+		otelogen.OperationID("datasource-whatsapp-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/datasource/whatsapp/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GeneratePresignedUploadUrlOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceWhatsappGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8854,15 +8924,15 @@ func (s *Server) handleGeneratePresignedUploadUrlRequest(args [0]string, argsEsc
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GeneratePresignedUploadUrlOperation,
-			ID:   "generatePresignedUploadUrl",
+			Name: DatasourceWhatsappGetOperation,
+			ID:   "datasource-whatsapp-get",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, GeneratePresignedUploadUrlOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceWhatsappGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8880,7 +8950,7 @@ func (s *Server) handleGeneratePresignedUploadUrlRequest(args [0]string, argsEsc
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GeneratePresignedUploadUrlOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceWhatsappGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8898,7 +8968,7 @@ func (s *Server) handleGeneratePresignedUploadUrlRequest(args [0]string, argsEsc
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, GeneratePresignedUploadUrlOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceWhatsappGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8942,38 +9012,38 @@ func (s *Server) handleGeneratePresignedUploadUrlRequest(args [0]string, argsEsc
 			return
 		}
 	}
-	request, close, err := s.decodeGeneratePresignedUploadUrlRequest(r)
+	params, err := decodeDatasourceWhatsappGetParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *UploadPresignedUrlResponse
+	var response *DatasourceWhatsapp
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GeneratePresignedUploadUrlOperation,
-			OperationSummary: "Generate a pre-signed URL for file upload",
-			OperationID:      "generatePresignedUploadUrl",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    DatasourceWhatsappGetOperation,
+			OperationSummary: "",
+			OperationID:      "datasource-whatsapp-get",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *UploadPresignedUrlRequest
-			Params   = struct{}
-			Response = *UploadPresignedUrlResponse
+			Request  = struct{}
+			Params   = DatasourceWhatsappGetParams
+			Response = *DatasourceWhatsapp
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8982,14 +9052,14 @@ func (s *Server) handleGeneratePresignedUploadUrlRequest(args [0]string, argsEsc
 		](
 			m,
 			mreq,
-			nil,
+			unpackDatasourceWhatsappGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GeneratePresignedUploadUrl(ctx, request)
+				response, err = s.h.DatasourceWhatsappGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GeneratePresignedUploadUrl(ctx, request)
+		response, err = s.h.DatasourceWhatsappGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9008,7 +9078,7 @@ func (s *Server) handleGeneratePresignedUploadUrlRequest(args [0]string, argsEsc
 		return
 	}
 
-	if err := encodeGeneratePresignedUploadUrlResponse(response, w, span); err != nil {
+	if err := encodeDatasourceWhatsappGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9017,22 +9087,22 @@ func (s *Server) handleGeneratePresignedUploadUrlRequest(args [0]string, argsEsc
 	}
 }
 
-// handleGetContactRequest handles getContact operation.
+// handleDatasourceWhatsappListRequest handles datasource-whatsapp-list operation.
 //
-// Get contact details.
+// List all WhatsApp datasources.
 //
-// GET /contact/{uuid}
-func (s *Server) handleGetContactRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /datasource/whatsapp
+func (s *Server) handleDatasourceWhatsappListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getContact"),
+		otelogen.OperationID("datasource-whatsapp-list"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/contact/{uuid}"),
+		semconv.HTTPRouteKey.String("/datasource/whatsapp"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetContactOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceWhatsappListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9087,15 +9157,15 @@ func (s *Server) handleGetContactRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetContactOperation,
-			ID:   "getContact",
+			Name: DatasourceWhatsappListOperation,
+			ID:   "datasource-whatsapp-list",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, GetContactOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceWhatsappListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9113,7 +9183,7 @@ func (s *Server) handleGetContactRequest(args [1]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetContactOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceWhatsappListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9131,7 +9201,7 @@ func (s *Server) handleGetContactRequest(args [1]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, GetContactOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceWhatsappListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9175,7 +9245,7 @@ func (s *Server) handleGetContactRequest(args [1]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeGetContactParams(args, argsEscaped, r)
+	params, err := decodeDatasourceWhatsappListParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9186,27 +9256,31 @@ func (s *Server) handleGetContactRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	var response *Contact
+	var response []DatasourceWhatsapp
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetContactOperation,
-			OperationSummary: "Get contact details",
-			OperationID:      "getContact",
+			OperationName:    DatasourceWhatsappListOperation,
+			OperationSummary: "",
+			OperationID:      "datasource-whatsapp-list",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetContactParams
-			Response = *Contact
+			Params   = DatasourceWhatsappListParams
+			Response = []DatasourceWhatsapp
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9215,14 +9289,14 @@ func (s *Server) handleGetContactRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackGetContactParams,
+			unpackDatasourceWhatsappListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetContact(ctx, params)
+				response, err = s.h.DatasourceWhatsappList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetContact(ctx, params)
+		response, err = s.h.DatasourceWhatsappList(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9241,7 +9315,7 @@ func (s *Server) handleGetContactRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeGetContactResponse(response, w, span); err != nil {
+	if err := encodeDatasourceWhatsappListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9250,22 +9324,22 @@ func (s *Server) handleGetContactRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleGetProfileRequest handles getProfile operation.
+// handleDatasourceWhatsappUpdateRequest handles datasource-whatsapp-update operation.
 //
-// Get current user profile.
+// Update a WhatsApp datasource.
 //
-// GET /profile
-func (s *Server) handleGetProfileRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /datasource/whatsapp/{uuid}
+func (s *Server) handleDatasourceWhatsappUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getProfile"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/profile"),
+		otelogen.OperationID("datasource-whatsapp-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/datasource/whatsapp/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProfileOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DatasourceWhatsappUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9320,15 +9394,15 @@ func (s *Server) handleGetProfileRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetProfileOperation,
-			ID:   "getProfile",
+			Name: DatasourceWhatsappUpdateOperation,
+			ID:   "datasource-whatsapp-update",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, GetProfileOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DatasourceWhatsappUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9346,7 +9420,7 @@ func (s *Server) handleGetProfileRequest(args [0]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetProfileOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DatasourceWhatsappUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9364,7 +9438,7 @@ func (s *Server) handleGetProfileRequest(args [0]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, GetProfileOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DatasourceWhatsappUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9408,23 +9482,53 @@ func (s *Server) handleGetProfileRequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
+	params, err := decodeDatasourceWhatsappUpdateParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeDatasourceWhatsappUpdateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *User
+	var response *DatasourceWhatsapp
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetProfileOperation,
-			OperationSummary: "Get current user profile",
-			OperationID:      "getProfile",
-			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    DatasourceWhatsappUpdateOperation,
+			OperationSummary: "",
+			OperationID:      "datasource-whatsapp-update",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = *User
+			Request  = *DatasourceWhatsapp
+			Params   = DatasourceWhatsappUpdateParams
+			Response = *DatasourceWhatsapp
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9433,14 +9537,14 @@ func (s *Server) handleGetProfileRequest(args [0]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			nil,
+			unpackDatasourceWhatsappUpdateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetProfile(ctx)
+				response, err = s.h.DatasourceWhatsappUpdate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetProfile(ctx)
+		response, err = s.h.DatasourceWhatsappUpdate(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9459,7 +9563,7 @@ func (s *Server) handleGetProfileRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeGetProfileResponse(response, w, span); err != nil {
+	if err := encodeDatasourceWhatsappUpdateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9468,22 +9572,22 @@ func (s *Server) handleGetProfileRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleGetUserRequest handles getUser operation.
+// handleDeleteContactRequest handles deleteContact operation.
 //
-// Get user details.
+// Delete a contact record.
 //
-// GET /user/{uuid}
-func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /contact/{uuid}
+func (s *Server) handleDeleteContactRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUser"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/user/{uuid}"),
+		otelogen.OperationID("deleteContact"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/contact/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteContactOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9538,15 +9642,15 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetUserOperation,
-			ID:   "getUser",
+			Name: DeleteContactOperation,
+			ID:   "deleteContact",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, GetUserOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DeleteContactOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9564,7 +9668,7 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetUserOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteContactOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9582,7 +9686,7 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, GetUserOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DeleteContactOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9626,7 +9730,7 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 			return
 		}
 	}
-	params, err := decodeGetUserParams(args, argsEscaped, r)
+	params, err := decodeDeleteContactParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9637,13 +9741,13 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 		return
 	}
 
-	var response *User
+	var response *DeleteContactOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetUserOperation,
-			OperationSummary: "Get user details",
-			OperationID:      "getUser",
+			OperationName:    DeleteContactOperation,
+			OperationSummary: "Delete a contact record",
+			OperationID:      "deleteContact",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -9656,8 +9760,8 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 
 		type (
 			Request  = struct{}
-			Params   = GetUserParams
-			Response = *User
+			Params   = DeleteContactParams
+			Response = *DeleteContactOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9666,14 +9770,14 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 		](
 			m,
 			mreq,
-			unpackGetUserParams,
+			unpackDeleteContactParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetUser(ctx, params)
+				err = s.h.DeleteContact(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetUser(ctx, params)
+		err = s.h.DeleteContact(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9692,7 +9796,7 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 		return
 	}
 
-	if err := encodeGetUserResponse(response, w, span); err != nil {
+	if err := encodeDeleteContactResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9701,22 +9805,22 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 	}
 }
 
-// handleListContactsRequest handles listContacts operation.
+// handleDeleteUserRequest handles deleteUser operation.
 //
-// List all contacts.
+// Delete user.
 //
-// GET /contact
-func (s *Server) handleListContactsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /user/{uuid}
+func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listContacts"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/contact"),
+		otelogen.OperationID("deleteUser"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/user/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListContactsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9771,15 +9875,15 @@ func (s *Server) handleListContactsRequest(args [0]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListContactsOperation,
-			ID:   "listContacts",
+			Name: DeleteUserOperation,
+			ID:   "deleteUser",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, ListContactsOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, DeleteUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9797,7 +9901,7 @@ func (s *Server) handleListContactsRequest(args [0]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListContactsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9815,7 +9919,7 @@ func (s *Server) handleListContactsRequest(args [0]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, ListContactsOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, DeleteUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9859,23 +9963,38 @@ func (s *Server) handleListContactsRequest(args [0]string, argsEscaped bool, w h
 			return
 		}
 	}
+	params, err := decodeDeleteUserParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response []Contact
+	var response *DeleteUserOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListContactsOperation,
-			OperationSummary: "List all contacts",
-			OperationID:      "listContacts",
+			OperationName:    DeleteUserOperation,
+			OperationSummary: "Delete user",
+			OperationID:      "deleteUser",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = []Contact
+			Params   = DeleteUserParams
+			Response = *DeleteUserOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9884,14 +10003,14 @@ func (s *Server) handleListContactsRequest(args [0]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			nil,
+			unpackDeleteUserParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListContacts(ctx)
+				err = s.h.DeleteUser(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListContacts(ctx)
+		err = s.h.DeleteUser(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9910,7 +10029,7 @@ func (s *Server) handleListContactsRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeListContactsResponse(response, w, span); err != nil {
+	if err := encodeDeleteUserResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9919,22 +10038,23 @@ func (s *Server) handleListContactsRequest(args [0]string, argsEscaped bool, w h
 	}
 }
 
-// handleListUsersRequest handles listUsers operation.
+// handleErasureCreateRequest handles erasure-create operation.
 //
-// List all users.
+// Find every message, file blob and contact referencing an email address or phone
+// number across all storages, delete or anonymise them and return the erasure report.
 //
-// GET /user
-func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /erasure
+func (s *Server) handleErasureCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listUsers"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/user"),
+		otelogen.OperationID("erasure-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/erasure"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListUsersOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ErasureCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9989,15 +10109,15 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListUsersOperation,
-			ID:   "listUsers",
+			Name: ErasureCreateOperation,
+			ID:   "erasure-create",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, ListUsersOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, ErasureCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10015,7 +10135,7 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListUsersOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, ErasureCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10033,7 +10153,7 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, ListUsersOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, ErasureCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10077,23 +10197,38 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 			return
 		}
 	}
+	request, close, err := s.decodeErasureCreateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response []User
+	var response *ErasureRequest
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListUsersOperation,
-			OperationSummary: "List all users",
-			OperationID:      "listUsers",
-			Body:             nil,
+			OperationName:    ErasureCreateOperation,
+			OperationSummary: "",
+			OperationID:      "erasure-create",
+			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
+			Request  = *ErasureRequest
 			Params   = struct{}
-			Response = []User
+			Response = *ErasureRequest
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10104,12 +10239,12 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListUsers(ctx)
+				response, err = s.h.ErasureCreate(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListUsers(ctx)
+		response, err = s.h.ErasureCreate(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10128,7 +10263,7 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeListUsersResponse(response, w, span); err != nil {
+	if err := encodeErasureCreateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10137,22 +10272,22 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleMessageEmailQueryRequest handles messageEmailQuery operation.
+// handleErasureListRequest handles erasure-list operation.
 //
-// Execute a search query on email messages.
+// Retrieve a list of executed erasure requests with their reports.
 //
-// POST /message/email/query
-func (s *Server) handleMessageEmailQueryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /erasure
+func (s *Server) handleErasureListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("messageEmailQuery"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/message/email/query"),
+		otelogen.OperationID("erasure-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/erasure"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), MessageEmailQueryOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ErasureListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10207,15 +10342,15 @@ func (s *Server) handleMessageEmailQueryRequest(args [0]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: MessageEmailQueryOperation,
-			ID:   "messageEmailQuery",
+			Name: ErasureListOperation,
+			ID:   "erasure-list",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, MessageEmailQueryOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, ErasureListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10233,7 +10368,7 @@ func (s *Server) handleMessageEmailQueryRequest(args [0]string, argsEscaped bool
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, MessageEmailQueryOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, ErasureListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10251,7 +10386,7 @@ func (s *Server) handleMessageEmailQueryRequest(args [0]string, argsEscaped bool
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, MessageEmailQueryOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, ErasureListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10295,38 +10430,42 @@ func (s *Server) handleMessageEmailQueryRequest(args [0]string, argsEscaped bool
 			return
 		}
 	}
-	request, close, err := s.decodeMessageEmailQueryRequest(r)
+	params, err := decodeErasureListParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *MessageEmailQueryOK
+	var response []ErasureRequest
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    MessageEmailQueryOperation,
+			OperationName:    ErasureListOperation,
 			OperationSummary: "",
-			OperationID:      "messageEmailQuery",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationID:      "erasure-list",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *MessageQuery
-			Params   = struct{}
-			Response = *MessageEmailQueryOK
+			Request  = struct{}
+			Params   = ErasureListParams
+			Response = []ErasureRequest
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10335,14 +10474,14 @@ func (s *Server) handleMessageEmailQueryRequest(args [0]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			nil,
+			unpackErasureListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.MessageEmailQuery(ctx, request)
+				response, err = s.h.ErasureList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.MessageEmailQuery(ctx, request)
+		response, err = s.h.ErasureList(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10361,7 +10500,7 @@ func (s *Server) handleMessageEmailQueryRequest(args [0]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeMessageEmailQueryResponse(response, w, span); err != nil {
+	if err := encodeErasureListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10370,22 +10509,22 @@ func (s *Server) handleMessageEmailQueryRequest(args [0]string, argsEscaped bool
 	}
 }
 
-// handleMessageLinkedinQueryRequest handles messageLinkedinQuery operation.
+// handleEventListRequest handles event-list operation.
 //
-// Execute a search query on LinkedIn messages.
+// Retrieve the events of the workspace, newest first. Events are kept for 7 days.
 //
-// POST /message/linkedin/query
-func (s *Server) handleMessageLinkedinQueryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /event
+func (s *Server) handleEventListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("messageLinkedinQuery"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/message/linkedin/query"),
+		otelogen.OperationID("event-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/event"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), MessageLinkedinQueryOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), EventListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10440,15 +10579,15 @@ func (s *Server) handleMessageLinkedinQueryRequest(args [0]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: MessageLinkedinQueryOperation,
-			ID:   "messageLinkedinQuery",
+			Name: EventListOperation,
+			ID:   "event-list",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, MessageLinkedinQueryOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, EventListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10466,7 +10605,7 @@ func (s *Server) handleMessageLinkedinQueryRequest(args [0]string, argsEscaped b
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, MessageLinkedinQueryOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, EventListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10484,7 +10623,7 @@ func (s *Server) handleMessageLinkedinQueryRequest(args [0]string, argsEscaped b
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, MessageLinkedinQueryOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, EventListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10528,38 +10667,54 @@ func (s *Server) handleMessageLinkedinQueryRequest(args [0]string, argsEscaped b
 			return
 		}
 	}
-	request, close, err := s.decodeMessageLinkedinQueryRequest(r)
+	params, err := decodeEventListParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *MessageLinkedinQueryOK
+	var response []Event
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    MessageLinkedinQueryOperation,
+			OperationName:    EventListOperation,
 			OperationSummary: "",
-			OperationID:      "messageLinkedinQuery",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationID:      "event-list",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "type",
+					In:   "query",
+				}: params.Type,
+				{
+					Name: "subject_uuid",
+					In:   "query",
+				}: params.SubjectUUID,
+				{
+					Name: "after",
+					In:   "query",
+				}: params.After,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *MessageQuery
-			Params   = struct{}
-			Response = *MessageLinkedinQueryOK
+			Request  = struct{}
+			Params   = EventListParams
+			Response = []Event
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10568,14 +10723,14 @@ func (s *Server) handleMessageLinkedinQueryRequest(args [0]string, argsEscaped b
 		](
 			m,
 			mreq,
-			nil,
+			unpackEventListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.MessageLinkedinQuery(ctx, request)
+				response, err = s.h.EventList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.MessageLinkedinQuery(ctx, request)
+		response, err = s.h.EventList(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10594,7 +10749,7 @@ func (s *Server) handleMessageLinkedinQueryRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	if err := encodeMessageLinkedinQueryResponse(response, w, span); err != nil {
+	if err := encodeEventListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10603,22 +10758,22 @@ func (s *Server) handleMessageLinkedinQueryRequest(args [0]string, argsEscaped b
 	}
 }
 
-// handleMessageQueryRequest handles messageQuery operation.
+// handleFileCreateRequest handles file-create operation.
 //
-// Execute a search query on unified messages.
+// Upload a new file and create its record.
 //
-// POST /message/query
-func (s *Server) handleMessageQueryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /file
+func (s *Server) handleFileCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("messageQuery"),
+		otelogen.OperationID("file-create"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/message/query"),
+		semconv.HTTPRouteKey.String("/file"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), MessageQueryOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), FileCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10673,15 +10828,15 @@ func (s *Server) handleMessageQueryRequest(args [0]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: MessageQueryOperation,
-			ID:   "messageQuery",
+			Name: FileCreateOperation,
+			ID:   "file-create",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, MessageQueryOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, FileCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10699,7 +10854,7 @@ func (s *Server) handleMessageQueryRequest(args [0]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, MessageQueryOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, FileCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10717,7 +10872,7 @@ func (s *Server) handleMessageQueryRequest(args [0]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, MessageQueryOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, FileCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10761,7 +10916,7 @@ func (s *Server) handleMessageQueryRequest(args [0]string, argsEscaped bool, w h
 			return
 		}
 	}
-	request, close, err := s.decodeMessageQueryRequest(r)
+	request, close, err := s.decodeFileCreateRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -10777,22 +10932,22 @@ func (s *Server) handleMessageQueryRequest(args [0]string, argsEscaped bool, w h
 		}
 	}()
 
-	var response *MessageQueryOK
+	var response *UploadFileResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    MessageQueryOperation,
+			OperationName:    FileCreateOperation,
 			OperationSummary: "",
-			OperationID:      "messageQuery",
+			OperationID:      "file-create",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *MessageQuery
+			Request  = *UploadFileRequest
 			Params   = struct{}
-			Response = *MessageQueryOK
+			Response = *UploadFileResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10803,12 +10958,12 @@ func (s *Server) handleMessageQueryRequest(args [0]string, argsEscaped bool, w h
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.MessageQuery(ctx, request)
+				response, err = s.h.FileCreate(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.MessageQuery(ctx, request)
+		response, err = s.h.FileCreate(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10827,7 +10982,7 @@ func (s *Server) handleMessageQueryRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeMessageQueryResponse(response, w, span); err != nil {
+	if err := encodeFileCreateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10836,22 +10991,22 @@ func (s *Server) handleMessageQueryRequest(args [0]string, argsEscaped bool, w h
 	}
 }
 
-// handleMessageTelegramQueryRequest handles messageTelegramQuery operation.
+// handleFileDeleteRequest handles file-delete operation.
 //
-// Execute a search query on Telegram messages.
+// Delete a stored file.
 //
-// POST /message/telegram/query
-func (s *Server) handleMessageTelegramQueryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /file/{uuid}
+func (s *Server) handleFileDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("messageTelegramQuery"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/message/telegram/query"),
+		otelogen.OperationID("file-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/file/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), MessageTelegramQueryOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), FileDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10906,15 +11061,15 @@ func (s *Server) handleMessageTelegramQueryRequest(args [0]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: MessageTelegramQueryOperation,
-			ID:   "messageTelegramQuery",
+			Name: FileDeleteOperation,
+			ID:   "file-delete",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, MessageTelegramQueryOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, FileDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10932,7 +11087,7 @@ func (s *Server) handleMessageTelegramQueryRequest(args [0]string, argsEscaped b
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, MessageTelegramQueryOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, FileDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10950,7 +11105,7 @@ func (s *Server) handleMessageTelegramQueryRequest(args [0]string, argsEscaped b
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, MessageTelegramQueryOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, FileDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10994,38 +11149,38 @@ func (s *Server) handleMessageTelegramQueryRequest(args [0]string, argsEscaped b
 			return
 		}
 	}
-	request, close, err := s.decodeMessageTelegramQueryRequest(r)
+	params, err := decodeFileDeleteParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *MessageTelegramQueryOK
+	var response *FileDeleteOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    MessageTelegramQueryOperation,
+			OperationName:    FileDeleteOperation,
 			OperationSummary: "",
-			OperationID:      "messageTelegramQuery",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationID:      "file-delete",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *MessageQuery
-			Params   = struct{}
-			Response = *MessageTelegramQueryOK
+			Request  = struct{}
+			Params   = FileDeleteParams
+			Response = *FileDeleteOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11034,14 +11189,14 @@ func (s *Server) handleMessageTelegramQueryRequest(args [0]string, argsEscaped b
 		](
 			m,
 			mreq,
-			nil,
+			unpackFileDeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.MessageTelegramQuery(ctx, request)
+				err = s.h.FileDelete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.MessageTelegramQuery(ctx, request)
+		err = s.h.FileDelete(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11060,7 +11215,7 @@ func (s *Server) handleMessageTelegramQueryRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	if err := encodeMessageTelegramQueryResponse(response, w, span); err != nil {
+	if err := encodeFileDeleteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11069,22 +11224,22 @@ func (s *Server) handleMessageTelegramQueryRequest(args [0]string, argsEscaped b
 	}
 }
 
-// handleMessageWhatsappQueryRequest handles messageWhatsappQuery operation.
+// handleFileGetRequest handles file-get operation.
 //
-// Execute a search query on WhatsApp messages.
+// Retrieve details of a stored file.
 //
-// POST /message/whatsapp/query
-func (s *Server) handleMessageWhatsappQueryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /file/{uuid}
+func (s *Server) handleFileGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("messageWhatsappQuery"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/message/whatsapp/query"),
+		otelogen.OperationID("file-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/file/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), MessageWhatsappQueryOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), FileGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11139,15 +11294,15 @@ func (s *Server) handleMessageWhatsappQueryRequest(args [0]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: MessageWhatsappQueryOperation,
-			ID:   "messageWhatsappQuery",
+			Name: FileGetOperation,
+			ID:   "file-get",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, MessageWhatsappQueryOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, FileGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11165,7 +11320,7 @@ func (s *Server) handleMessageWhatsappQueryRequest(args [0]string, argsEscaped b
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, MessageWhatsappQueryOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, FileGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11183,7 +11338,7 @@ func (s *Server) handleMessageWhatsappQueryRequest(args [0]string, argsEscaped b
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, MessageWhatsappQueryOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, FileGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11227,38 +11382,38 @@ func (s *Server) handleMessageWhatsappQueryRequest(args [0]string, argsEscaped b
 			return
 		}
 	}
-	request, close, err := s.decodeMessageWhatsappQueryRequest(r)
+	params, err := decodeFileGetParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *MessageWhatsappQueryOK
+	var response *FileObject
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    MessageWhatsappQueryOperation,
+			OperationName:    FileGetOperation,
 			OperationSummary: "",
-			OperationID:      "messageWhatsappQuery",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationID:      "file-get",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *MessageQuery
-			Params   = struct{}
-			Response = *MessageWhatsappQueryOK
+			Request  = struct{}
+			Params   = FileGetParams
+			Response = *FileObject
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11267,14 +11422,14 @@ func (s *Server) handleMessageWhatsappQueryRequest(args [0]string, argsEscaped b
 		](
 			m,
 			mreq,
-			nil,
+			unpackFileGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.MessageWhatsappQuery(ctx, request)
+				response, err = s.h.FileGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.MessageWhatsappQuery(ctx, request)
+		response, err = s.h.FileGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11293,7 +11448,7 @@ func (s *Server) handleMessageWhatsappQueryRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	if err := encodeMessageWhatsappQueryResponse(response, w, span); err != nil {
+	if err := encodeFileGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11302,22 +11457,22 @@ func (s *Server) handleMessageWhatsappQueryRequest(args [0]string, argsEscaped b
 	}
 }
 
-// handleOAuth2ClientCallbackRequest handles oauth2-client-callback operation.
+// handleFileListRequest handles file-list operation.
 //
-// Serve OAuth2 client callback.
+// Retrieve a list of stored files.
 //
-// GET /oauth2/callback
-func (s *Server) handleOAuth2ClientCallbackRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /file
+func (s *Server) handleFileListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("oauth2-client-callback"),
+		otelogen.OperationID("file-list"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/oauth2/callback"),
+		semconv.HTTPRouteKey.String("/file"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), OAuth2ClientCallbackOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), FileListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11372,11 +11527,95 @@ func (s *Server) handleOAuth2ClientCallbackRequest(args [0]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: OAuth2ClientCallbackOperation,
-			ID:   "oauth2-client-callback",
+			Name: FileListOperation,
+			ID:   "file-list",
 		}
 	)
-	params, err := decodeOAuth2ClientCallbackParams(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, FileListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, FileListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, FileListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeFileListParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11387,31 +11626,31 @@ func (s *Server) handleOAuth2ClientCallbackRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	var response *OAuth2ClientCallbackFound
+	var response []FileObject
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    OAuth2ClientCallbackOperation,
+			OperationName:    FileListOperation,
 			OperationSummary: "",
-			OperationID:      "oauth2-client-callback",
+			OperationID:      "file-list",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "state",
+					Name: "offset",
 					In:   "query",
-				}: params.State,
+				}: params.Offset,
 				{
-					Name: "code",
+					Name: "limit",
 					In:   "query",
-				}: params.Code,
+				}: params.Limit,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = OAuth2ClientCallbackParams
-			Response = *OAuth2ClientCallbackFound
+			Params   = FileListParams
+			Response = []FileObject
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11420,14 +11659,14 @@ func (s *Server) handleOAuth2ClientCallbackRequest(args [0]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackOAuth2ClientCallbackParams,
+			unpackFileListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.OAuth2ClientCallback(ctx, params)
+				response, err = s.h.FileList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.OAuth2ClientCallback(ctx, params)
+		response, err = s.h.FileList(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11446,7 +11685,7 @@ func (s *Server) handleOAuth2ClientCallbackRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	if err := encodeOAuth2ClientCallbackResponse(response, w, span); err != nil {
+	if err := encodeFileListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11455,22 +11694,22 @@ func (s *Server) handleOAuth2ClientCallbackRequest(args [0]string, argsEscaped b
 	}
 }
 
-// handleOAuth2ClientCreateRequest handles oauth2-client-create operation.
+// handleFileUpdateRequest handles file-update operation.
 //
-// Create OAuth2 client.
+// Update metadata of a stored file.
 //
-// POST /oauth2/client
-func (s *Server) handleOAuth2ClientCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /file/{uuid}
+func (s *Server) handleFileUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("oauth2-client-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/oauth2/client"),
+		otelogen.OperationID("file-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/file/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), OAuth2ClientCreateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), FileUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11525,15 +11764,15 @@ func (s *Server) handleOAuth2ClientCreateRequest(args [0]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: OAuth2ClientCreateOperation,
-			ID:   "oauth2-client-create",
+			Name: FileUpdateOperation,
+			ID:   "file-update",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, OAuth2ClientCreateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, FileUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11551,7 +11790,7 @@ func (s *Server) handleOAuth2ClientCreateRequest(args [0]string, argsEscaped boo
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, OAuth2ClientCreateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, FileUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11569,7 +11808,7 @@ func (s *Server) handleOAuth2ClientCreateRequest(args [0]string, argsEscaped boo
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, OAuth2ClientCreateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, FileUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11613,7 +11852,17 @@ func (s *Server) handleOAuth2ClientCreateRequest(args [0]string, argsEscaped boo
 			return
 		}
 	}
-	request, close, err := s.decodeOAuth2ClientCreateRequest(r)
+	params, err := decodeFileUpdateParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeFileUpdateRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -11629,22 +11878,27 @@ func (s *Server) handleOAuth2ClientCreateRequest(args [0]string, argsEscaped boo
 		}
 	}()
 
-	var response *OAuth2Client
+	var response *FileObject
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    OAuth2ClientCreateOperation,
+			OperationName:    FileUpdateOperation,
 			OperationSummary: "",
-			OperationID:      "oauth2-client-create",
+			OperationID:      "file-update",
 			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *OAuth2ClientCreateReq
-			Params   = struct{}
-			Response = *OAuth2Client
+			Request  = *FileUpdateReq
+			Params   = FileUpdateParams
+			Response = *FileObject
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11653,14 +11907,14 @@ func (s *Server) handleOAuth2ClientCreateRequest(args [0]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			nil,
+			unpackFileUpdateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.OAuth2ClientCreate(ctx, request)
+				response, err = s.h.FileUpdate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.OAuth2ClientCreate(ctx, request)
+		response, err = s.h.FileUpdate(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11679,7 +11933,7 @@ func (s *Server) handleOAuth2ClientCreateRequest(args [0]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeOAuth2ClientCreateResponse(response, w, span); err != nil {
+	if err := encodeFileUpdateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11688,22 +11942,22 @@ func (s *Server) handleOAuth2ClientCreateRequest(args [0]string, argsEscaped boo
 	}
 }
 
-// handleOAuth2ClientDeleteRequest handles oauth2-client-delete operation.
+// handleGenerateDownloadLinkRequest handles generateDownloadLink operation.
 //
-// Delete OAuth2 client.
+// Generate a download link for a stored file.
 //
-// DELETE /oauth2/client/{uuid}
-func (s *Server) handleOAuth2ClientDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /storage/file-link
+func (s *Server) handleGenerateDownloadLinkRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("oauth2-client-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/oauth2/client/{uuid}"),
+		otelogen.OperationID("generateDownloadLink"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/storage/file-link"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), OAuth2ClientDeleteOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GenerateDownloadLinkOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11758,15 +12012,10726 @@ func (s *Server) handleOAuth2ClientDeleteRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: OAuth2ClientDeleteOperation,
-			ID:   "oauth2-client-delete",
+			Name: GenerateDownloadLinkOperation,
+			ID:   "generateDownloadLink",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, OAuth2ClientDeleteOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, GenerateDownloadLinkOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GenerateDownloadLinkOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, GenerateDownloadLinkOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeGenerateDownloadLinkRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *GenerateDownloadLinkResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GenerateDownloadLinkOperation,
+			OperationSummary: "Generate a download link for a stored file",
+			OperationID:      "generateDownloadLink",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GenerateDownloadLinkRequest
+			Params   = struct{}
+			Response = *GenerateDownloadLinkResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GenerateDownloadLink(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GenerateDownloadLink(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGenerateDownloadLinkResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGeneratePresignedUploadUrlRequest handles generatePresignedUploadUrl operation.
+//
+// Generate a pre-signed URL for file upload.
+//
+// POST /storage/upload-url
+func (s *Server) handleGeneratePresignedUploadUrlRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("generatePresignedUploadUrl"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/storage/upload-url"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GeneratePresignedUploadUrlOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GeneratePresignedUploadUrlOperation,
+			ID:   "generatePresignedUploadUrl",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, GeneratePresignedUploadUrlOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GeneratePresignedUploadUrlOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, GeneratePresignedUploadUrlOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeGeneratePresignedUploadUrlRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *UploadPresignedUrlResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GeneratePresignedUploadUrlOperation,
+			OperationSummary: "Generate a pre-signed URL for file upload",
+			OperationID:      "generatePresignedUploadUrl",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *UploadPresignedUrlRequest
+			Params   = struct{}
+			Response = *UploadPresignedUrlResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GeneratePresignedUploadUrl(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GeneratePresignedUploadUrl(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGeneratePresignedUploadUrlResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetContactRequest handles getContact operation.
+//
+// Get contact details.
+//
+// GET /contact/{uuid}
+func (s *Server) handleGetContactRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getContact"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/contact/{uuid}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetContactOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetContactOperation,
+			ID:   "getContact",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, GetContactOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetContactOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, GetContactOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetContactParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *Contact
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetContactOperation,
+			OperationSummary: "Get contact details",
+			OperationID:      "getContact",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetContactParams
+			Response = *Contact
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetContactParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetContact(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetContact(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetContactResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetProfileRequest handles getProfile operation.
+//
+// Get current user profile.
+//
+// GET /profile
+func (s *Server) handleGetProfileRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getProfile"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/profile"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProfileOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetProfileOperation,
+			ID:   "getProfile",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, GetProfileOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetProfileOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, GetProfileOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var response *User
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetProfileOperation,
+			OperationSummary: "Get current user profile",
+			OperationID:      "getProfile",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = *User
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetProfile(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetProfile(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetProfileResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetUserRequest handles getUser operation.
+//
+// Get user details.
+//
+// GET /user/{uuid}
+func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getUser"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/user/{uuid}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetUserOperation,
+			ID:   "getUser",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, GetUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, GetUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetUserParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *User
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetUserOperation,
+			OperationSummary: "Get user details",
+			OperationID:      "getUser",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetUserParams
+			Response = *User
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetUserParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUser(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUser(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetUserResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListContactsRequest handles listContacts operation.
+//
+// List all contacts.
+//
+// GET /contact
+func (s *Server) handleListContactsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listContacts"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/contact"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListContactsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListContactsOperation,
+			ID:   "listContacts",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, ListContactsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListContactsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, ListContactsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var response []Contact
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListContactsOperation,
+			OperationSummary: "List all contacts",
+			OperationID:      "listContacts",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = []Contact
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListContacts(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListContacts(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListContactsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListUsersRequest handles listUsers operation.
+//
+// List all users.
+//
+// GET /user
+func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listUsers"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/user"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListUsersOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListUsersOperation,
+			ID:   "listUsers",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, ListUsersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListUsersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, ListUsersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var response []User
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListUsersOperation,
+			OperationSummary: "List all users",
+			OperationID:      "listUsers",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = []User
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListUsers(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListUsers(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListUsersResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleMessageEmailQueryRequest handles messageEmailQuery operation.
+//
+// Execute a search query on email messages.
+//
+// POST /message/email/query
+func (s *Server) handleMessageEmailQueryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("messageEmailQuery"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/message/email/query"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), MessageEmailQueryOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: MessageEmailQueryOperation,
+			ID:   "messageEmailQuery",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, MessageEmailQueryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, MessageEmailQueryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, MessageEmailQueryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeMessageEmailQueryRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *MessageEmailQueryOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    MessageEmailQueryOperation,
+			OperationSummary: "",
+			OperationID:      "messageEmailQuery",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *MessageQuery
+			Params   = struct{}
+			Response = *MessageEmailQueryOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.MessageEmailQuery(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.MessageEmailQuery(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeMessageEmailQueryResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleMessageLinkedinQueryRequest handles messageLinkedinQuery operation.
+//
+// Execute a search query on LinkedIn messages.
+//
+// POST /message/linkedin/query
+func (s *Server) handleMessageLinkedinQueryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("messageLinkedinQuery"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/message/linkedin/query"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), MessageLinkedinQueryOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: MessageLinkedinQueryOperation,
+			ID:   "messageLinkedinQuery",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, MessageLinkedinQueryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, MessageLinkedinQueryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, MessageLinkedinQueryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeMessageLinkedinQueryRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *MessageLinkedinQueryOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    MessageLinkedinQueryOperation,
+			OperationSummary: "",
+			OperationID:      "messageLinkedinQuery",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *MessageQuery
+			Params   = struct{}
+			Response = *MessageLinkedinQueryOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.MessageLinkedinQuery(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.MessageLinkedinQuery(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeMessageLinkedinQueryResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleMessageQueryRequest handles messageQuery operation.
+//
+// Execute a search query on unified messages.
+//
+// POST /message/query
+func (s *Server) handleMessageQueryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("messageQuery"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/message/query"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), MessageQueryOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: MessageQueryOperation,
+			ID:   "messageQuery",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, MessageQueryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, MessageQueryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, MessageQueryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeMessageQueryRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *MessageQueryOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    MessageQueryOperation,
+			OperationSummary: "",
+			OperationID:      "messageQuery",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *MessageQuery
+			Params   = struct{}
+			Response = *MessageQueryOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.MessageQuery(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.MessageQuery(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeMessageQueryResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleMessageTelegramQueryRequest handles messageTelegramQuery operation.
+//
+// Execute a search query on Telegram messages.
+//
+// POST /message/telegram/query
+func (s *Server) handleMessageTelegramQueryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("messageTelegramQuery"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/message/telegram/query"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), MessageTelegramQueryOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: MessageTelegramQueryOperation,
+			ID:   "messageTelegramQuery",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, MessageTelegramQueryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, MessageTelegramQueryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, MessageTelegramQueryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeMessageTelegramQueryRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *MessageTelegramQueryOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    MessageTelegramQueryOperation,
+			OperationSummary: "",
+			OperationID:      "messageTelegramQuery",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *MessageQuery
+			Params   = struct{}
+			Response = *MessageTelegramQueryOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.MessageTelegramQuery(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.MessageTelegramQuery(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeMessageTelegramQueryResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleMessageWhatsappQueryRequest handles messageWhatsappQuery operation.
+//
+// Execute a search query on WhatsApp messages.
+//
+// POST /message/whatsapp/query
+func (s *Server) handleMessageWhatsappQueryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("messageWhatsappQuery"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/message/whatsapp/query"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), MessageWhatsappQueryOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: MessageWhatsappQueryOperation,
+			ID:   "messageWhatsappQuery",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, MessageWhatsappQueryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, MessageWhatsappQueryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, MessageWhatsappQueryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeMessageWhatsappQueryRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *MessageWhatsappQueryOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    MessageWhatsappQueryOperation,
+			OperationSummary: "",
+			OperationID:      "messageWhatsappQuery",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *MessageQuery
+			Params   = struct{}
+			Response = *MessageWhatsappQueryOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.MessageWhatsappQuery(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.MessageWhatsappQuery(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeMessageWhatsappQueryResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleOAuth2ClientCallbackRequest handles oauth2-client-callback operation.
+//
+// Serve OAuth2 client callback.
+//
+// GET /oauth2/callback
+func (s *Server) handleOAuth2ClientCallbackRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("oauth2-client-callback"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/oauth2/callback"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), OAuth2ClientCallbackOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: OAuth2ClientCallbackOperation,
+			ID:   "oauth2-client-callback",
+		}
+	)
+	params, err := decodeOAuth2ClientCallbackParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *OAuth2ClientCallbackFound
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    OAuth2ClientCallbackOperation,
+			OperationSummary: "",
+			OperationID:      "oauth2-client-callback",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "state",
+					In:   "query",
+				}: params.State,
+				{
+					Name: "code",
+					In:   "query",
+				}: params.Code,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = OAuth2ClientCallbackParams
+			Response = *OAuth2ClientCallbackFound
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackOAuth2ClientCallbackParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.OAuth2ClientCallback(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.OAuth2ClientCallback(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeOAuth2ClientCallbackResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleOAuth2ClientCreateRequest handles oauth2-client-create operation.
+//
+// Create OAuth2 client.
+//
+// POST /oauth2/client
+func (s *Server) handleOAuth2ClientCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("oauth2-client-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/oauth2/client"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), OAuth2ClientCreateOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: OAuth2ClientCreateOperation,
+			ID:   "oauth2-client-create",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, OAuth2ClientCreateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, OAuth2ClientCreateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, OAuth2ClientCreateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeOAuth2ClientCreateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *OAuth2Client
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    OAuth2ClientCreateOperation,
+			OperationSummary: "",
+			OperationID:      "oauth2-client-create",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *OAuth2ClientCreateReq
+			Params   = struct{}
+			Response = *OAuth2Client
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.OAuth2ClientCreate(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.OAuth2ClientCreate(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeOAuth2ClientCreateResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleOAuth2ClientDeleteRequest handles oauth2-client-delete operation.
+//
+// Delete OAuth2 client.
+//
+// DELETE /oauth2/client/{uuid}
+func (s *Server) handleOAuth2ClientDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("oauth2-client-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/oauth2/client/{uuid}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), OAuth2ClientDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: OAuth2ClientDeleteOperation,
+			ID:   "oauth2-client-delete",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, OAuth2ClientDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, OAuth2ClientDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, OAuth2ClientDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeOAuth2ClientDeleteParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *OAuth2ClientDeleteOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    OAuth2ClientDeleteOperation,
+			OperationSummary: "",
+			OperationID:      "oauth2-client-delete",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = OAuth2ClientDeleteParams
+			Response = *OAuth2ClientDeleteOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackOAuth2ClientDeleteParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				err = s.h.OAuth2ClientDelete(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		err = s.h.OAuth2ClientDelete(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeOAuth2ClientDeleteResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleOAuth2ClientGetRequest handles oauth2-client-get operation.
+//
+// Get OAuth2 client details.
+//
+// GET /oauth2/client/{uuid}
+func (s *Server) handleOAuth2ClientGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("oauth2-client-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/oauth2/client/{uuid}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), OAuth2ClientGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: OAuth2ClientGetOperation,
+			ID:   "oauth2-client-get",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, OAuth2ClientGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, OAuth2ClientGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, OAuth2ClientGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeOAuth2ClientGetParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *OAuth2Client
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    OAuth2ClientGetOperation,
+			OperationSummary: "",
+			OperationID:      "oauth2-client-get",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = OAuth2ClientGetParams
+			Response = *OAuth2Client
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackOAuth2ClientGetParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.OAuth2ClientGet(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.OAuth2ClientGet(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeOAuth2ClientGetResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleOAuth2ClientListRequest handles oauth2-client-list operation.
+//
+// List OAuth2 clients.
+//
+// GET /oauth2/client
+func (s *Server) handleOAuth2ClientListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("oauth2-client-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/oauth2/client"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), OAuth2ClientListOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: OAuth2ClientListOperation,
+			ID:   "oauth2-client-list",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, OAuth2ClientListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, OAuth2ClientListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, OAuth2ClientListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeOAuth2ClientListParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *OAuth2ClientListOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    OAuth2ClientListOperation,
+			OperationSummary: "",
+			OperationID:      "oauth2-client-list",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = OAuth2ClientListParams
+			Response = *OAuth2ClientListOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackOAuth2ClientListParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.OAuth2ClientList(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.OAuth2ClientList(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeOAuth2ClientListResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleOAuth2ClientLoginRequest handles oauth2-client-login operation.
+//
+// Start OAuth2 login flow.
+//
+// POST /oauth2/login
+func (s *Server) handleOAuth2ClientLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("oauth2-client-login"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/oauth2/login"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), OAuth2ClientLoginOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: OAuth2ClientLoginOperation,
+			ID:   "oauth2-client-login",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, OAuth2ClientLoginOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, OAuth2ClientLoginOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, OAuth2ClientLoginOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeOAuth2ClientLoginRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *OAuth2ClientLoginOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    OAuth2ClientLoginOperation,
+			OperationSummary: "",
+			OperationID:      "oauth2-client-login",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *OAuth2ClientLoginReq
+			Params   = struct{}
+			Response = *OAuth2ClientLoginOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.OAuth2ClientLogin(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.OAuth2ClientLogin(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeOAuth2ClientLoginResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleOAuth2ClientTokenDeleteRequest handles oauth2-client-token-delete operation.
+//
+// Delete OAuth2 client token.
+//
+// DELETE /oauth2/client/{datasource_uuid}/token/{uuid}
+func (s *Server) handleOAuth2ClientTokenDeleteRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("oauth2-client-token-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/oauth2/client/{datasource_uuid}/token/{uuid}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), OAuth2ClientTokenDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: OAuth2ClientTokenDeleteOperation,
+			ID:   "oauth2-client-token-delete",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, OAuth2ClientTokenDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, OAuth2ClientTokenDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, OAuth2ClientTokenDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeOAuth2ClientTokenDeleteParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *OAuth2ClientTokenDeleteOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    OAuth2ClientTokenDeleteOperation,
+			OperationSummary: "",
+			OperationID:      "oauth2-client-token-delete",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+				{
+					Name: "datasource_uuid",
+					In:   "path",
+				}: params.DatasourceUUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = OAuth2ClientTokenDeleteParams
+			Response = *OAuth2ClientTokenDeleteOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackOAuth2ClientTokenDeleteParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				err = s.h.OAuth2ClientTokenDelete(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		err = s.h.OAuth2ClientTokenDelete(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeOAuth2ClientTokenDeleteResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleOAuth2ClientTokenListRequest handles oauth2-client-token-list operation.
+//
+// List OAuth2 client tokens.
+//
+// GET /oauth2/client/{datasource_uuid}/token
+func (s *Server) handleOAuth2ClientTokenListRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("oauth2-client-token-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/oauth2/client/{datasource_uuid}/token"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), OAuth2ClientTokenListOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: OAuth2ClientTokenListOperation,
+			ID:   "oauth2-client-token-list",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, OAuth2ClientTokenListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, OAuth2ClientTokenListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, OAuth2ClientTokenListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeOAuth2ClientTokenListParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response []OAuth2ClientToken
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    OAuth2ClientTokenListOperation,
+			OperationSummary: "",
+			OperationID:      "oauth2-client-token-list",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "datasource_uuid",
+					In:   "path",
+				}: params.DatasourceUUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = OAuth2ClientTokenListParams
+			Response = []OAuth2ClientToken
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackOAuth2ClientTokenListParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.OAuth2ClientTokenList(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.OAuth2ClientTokenList(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeOAuth2ClientTokenListResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleOAuth2ClientTokenRefreshRequest handles oauth2-client-token-refresh operation.
+//
+// Refresh the OAuth2 client token now, whatever its expiry.
+//
+// POST /oauth2/client/{datasource_uuid}/token/{uuid}/refresh
+func (s *Server) handleOAuth2ClientTokenRefreshRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("oauth2-client-token-refresh"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/oauth2/client/{datasource_uuid}/token/{uuid}/refresh"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), OAuth2ClientTokenRefreshOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: OAuth2ClientTokenRefreshOperation,
+			ID:   "oauth2-client-token-refresh",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, OAuth2ClientTokenRefreshOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, OAuth2ClientTokenRefreshOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, OAuth2ClientTokenRefreshOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeOAuth2ClientTokenRefreshParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *OAuth2ClientTokenRefreshNoContent
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    OAuth2ClientTokenRefreshOperation,
+			OperationSummary: "",
+			OperationID:      "oauth2-client-token-refresh",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+				{
+					Name: "datasource_uuid",
+					In:   "path",
+				}: params.DatasourceUUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = OAuth2ClientTokenRefreshParams
+			Response = *OAuth2ClientTokenRefreshNoContent
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackOAuth2ClientTokenRefreshParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				err = s.h.OAuth2ClientTokenRefresh(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		err = s.h.OAuth2ClientTokenRefresh(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeOAuth2ClientTokenRefreshResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleOAuth2ClientUpdateRequest handles oauth2-client-update operation.
+//
+// Update OAuth2 client.
+//
+// PUT /oauth2/client/{uuid}
+func (s *Server) handleOAuth2ClientUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("oauth2-client-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/oauth2/client/{uuid}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), OAuth2ClientUpdateOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: OAuth2ClientUpdateOperation,
+			ID:   "oauth2-client-update",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, OAuth2ClientUpdateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, OAuth2ClientUpdateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, OAuth2ClientUpdateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeOAuth2ClientUpdateParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeOAuth2ClientUpdateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *OAuth2Client
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    OAuth2ClientUpdateOperation,
+			OperationSummary: "",
+			OperationID:      "oauth2-client-update",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *OAuth2ClientUpdateReq
+			Params   = OAuth2ClientUpdateParams
+			Response = *OAuth2Client
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackOAuth2ClientUpdateParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.OAuth2ClientUpdate(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.OAuth2ClientUpdate(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeOAuth2ClientUpdateResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleOAuth2ProviderListRequest handles oauth2-provider-list operation.
+//
+// List the OAuth2 providers OAuth2 clients can use.
+//
+// GET /oauth2/provider
+func (s *Server) handleOAuth2ProviderListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("oauth2-provider-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/oauth2/provider"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), OAuth2ProviderListOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: OAuth2ProviderListOperation,
+			ID:   "oauth2-provider-list",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, OAuth2ProviderListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, OAuth2ProviderListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, OAuth2ProviderListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var response []OAuth2Provider
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    OAuth2ProviderListOperation,
+			OperationSummary: "",
+			OperationID:      "oauth2-provider-list",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = []OAuth2Provider
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.OAuth2ProviderList(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.OAuth2ProviderList(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeOAuth2ProviderListResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePipelineCreateRequest handles pipeline-create operation.
+//
+// Create a new pipeline for a datasource.
+//
+// POST /pipeline
+func (s *Server) handlePipelineCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("pipeline-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/pipeline"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PipelineCreateOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PipelineCreateOperation,
+			ID:   "pipeline-create",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, PipelineCreateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PipelineCreateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, PipelineCreateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodePipelineCreateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *Pipeline
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PipelineCreateOperation,
+			OperationSummary: "Create a new pipeline",
+			OperationID:      "pipeline-create",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *Pipeline
+			Params   = struct{}
+			Response = *Pipeline
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PipelineCreate(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PipelineCreate(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodePipelineCreateResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePipelineDeleteRequest handles pipeline-delete operation.
+//
+// Delete a specific pipeline by UUID.
+//
+// DELETE /pipeline/{uuid}
+func (s *Server) handlePipelineDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("pipeline-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/pipeline/{uuid}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PipelineDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PipelineDeleteOperation,
+			ID:   "pipeline-delete",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, PipelineDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PipelineDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, PipelineDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodePipelineDeleteParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *PipelineDeleteOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PipelineDeleteOperation,
+			OperationSummary: "Delete pipeline",
+			OperationID:      "pipeline-delete",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = PipelineDeleteParams
+			Response = *PipelineDeleteOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPipelineDeleteParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				err = s.h.PipelineDelete(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		err = s.h.PipelineDelete(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodePipelineDeleteResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePipelineGetRequest handles pipeline-get operation.
+//
+// Retrieve a specific pipeline by its UUID.
+//
+// GET /pipeline/{uuid}
+func (s *Server) handlePipelineGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("pipeline-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/pipeline/{uuid}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PipelineGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PipelineGetOperation,
+			ID:   "pipeline-get",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, PipelineGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PipelineGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, PipelineGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodePipelineGetParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *Pipeline
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PipelineGetOperation,
+			OperationSummary: "Get pipeline by UUID",
+			OperationID:      "pipeline-get",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = PipelineGetParams
+			Response = *Pipeline
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPipelineGetParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PipelineGet(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PipelineGet(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodePipelineGetResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePipelineListRequest handles pipeline-list operation.
+//
+// Get all pipelines.
+//
+// GET /pipeline
+func (s *Server) handlePipelineListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("pipeline-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/pipeline"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PipelineListOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PipelineListOperation,
+			ID:   "pipeline-list",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, PipelineListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PipelineListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, PipelineListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodePipelineListParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *PipelineListOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PipelineListOperation,
+			OperationSummary: "List pipelines",
+			OperationID:      "pipeline-list",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "datasource_uuid",
+					In:   "query",
+				}: params.DatasourceUUID,
+				{
+					Name: "storage_uuid",
+					In:   "query",
+				}: params.StorageUUID,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = PipelineListParams
+			Response = *PipelineListOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPipelineListParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PipelineList(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PipelineList(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodePipelineListResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePipelineRunRequest handles pipeline-run operation.
+//
+// Enqueue a fetch job for the pipeline outside its schedule, optionally as a
+// date range backfill, capped, as a dry run or into another storage. The job
+// is recorded in worker jobs as queued.
+//
+// POST /pipeline/{uuid}/run
+func (s *Server) handlePipelineRunRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("pipeline-run"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/pipeline/{uuid}/run"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PipelineRunOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PipelineRunOperation,
+			ID:   "pipeline-run",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, PipelineRunOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PipelineRunOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, PipelineRunOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodePipelineRunParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodePipelineRunRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *PipelineRun
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PipelineRunOperation,
+			OperationSummary: "Run a pipeline now",
+			OperationID:      "pipeline-run",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = OptPipelineRun
+			Params   = PipelineRunParams
+			Response = *PipelineRun
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPipelineRunParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PipelineRun(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PipelineRun(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodePipelineRunResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePipelineUpdateRequest handles pipeline-update operation.
+//
+// Update an existing pipeline.
+//
+// PUT /pipeline/{uuid}
+func (s *Server) handlePipelineUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("pipeline-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/pipeline/{uuid}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PipelineUpdateOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PipelineUpdateOperation,
+			ID:   "pipeline-update",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, PipelineUpdateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PipelineUpdateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, PipelineUpdateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodePipelineUpdateParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodePipelineUpdateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *Pipeline
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PipelineUpdateOperation,
+			OperationSummary: "Update pipeline",
+			OperationID:      "pipeline-update",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *Pipeline
+			Params   = PipelineUpdateParams
+			Response = *Pipeline
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPipelineUpdateParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PipelineUpdate(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PipelineUpdate(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodePipelineUpdateResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePolicyCreateRequest handles policy-create operation.
+//
+// Create a new access policy. Requires a system administrator.
+//
+// POST /policy
+func (s *Server) handlePolicyCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("policy-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/policy"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PolicyCreateOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PolicyCreateOperation,
+			ID:   "policy-create",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, PolicyCreateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PolicyCreateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, PolicyCreateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodePolicyCreateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *Policy
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PolicyCreateOperation,
+			OperationSummary: "",
+			OperationID:      "policy-create",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *Policy
+			Params   = struct{}
+			Response = *Policy
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PolicyCreate(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PolicyCreate(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodePolicyCreateResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePolicyDeleteRequest handles policy-delete operation.
+//
+// Delete an access policy by id. Built-in policies cannot be deleted. Requires a system
+// administrator.
+//
+// DELETE /policy/{id}
+func (s *Server) handlePolicyDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("policy-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/policy/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PolicyDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PolicyDeleteOperation,
+			ID:   "policy-delete",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, PolicyDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PolicyDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, PolicyDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodePolicyDeleteParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *PolicyDeleteOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PolicyDeleteOperation,
+			OperationSummary: "",
+			OperationID:      "policy-delete",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = PolicyDeleteParams
+			Response = *PolicyDeleteOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPolicyDeleteParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				err = s.h.PolicyDelete(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		err = s.h.PolicyDelete(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodePolicyDeleteResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePolicyGetRequest handles policy-get operation.
+//
+// Retrieve an access policy by id.
+//
+// GET /policy/{id}
+func (s *Server) handlePolicyGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("policy-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/policy/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PolicyGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PolicyGetOperation,
+			ID:   "policy-get",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, PolicyGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PolicyGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, PolicyGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodePolicyGetParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *Policy
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PolicyGetOperation,
+			OperationSummary: "",
+			OperationID:      "policy-get",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = PolicyGetParams
+			Response = *Policy
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPolicyGetParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PolicyGet(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PolicyGet(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodePolicyGetResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePolicyListRequest handles policy-list operation.
+//
+// Retrieve a list of access policies.
+//
+// GET /policy
+func (s *Server) handlePolicyListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("policy-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/policy"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PolicyListOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PolicyListOperation,
+			ID:   "policy-list",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, PolicyListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PolicyListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, PolicyListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodePolicyListParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response []Policy
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PolicyListOperation,
+			OperationSummary: "",
+			OperationID:      "policy-list",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = PolicyListParams
+			Response = []Policy
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPolicyListParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PolicyList(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PolicyList(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodePolicyListResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePolicyUpdateRequest handles policy-update operation.
+//
+// Update an access policy by id. Requires a system administrator.
+//
+// PUT /policy/{id}
+func (s *Server) handlePolicyUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("policy-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/policy/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PolicyUpdateOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PolicyUpdateOperation,
+			ID:   "policy-update",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, PolicyUpdateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PolicyUpdateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, PolicyUpdateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodePolicyUpdateParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodePolicyUpdateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *Policy
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PolicyUpdateOperation,
+			OperationSummary: "",
+			OperationID:      "policy-update",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *Policy
+			Params   = PolicyUpdateParams
+			Response = *Policy
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPolicyUpdateParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PolicyUpdate(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PolicyUpdate(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodePolicyUpdateResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRetentionPolicyCreateRequest handles retention-policy-create operation.
+//
+// Create a new retention policy.
+//
+// POST /retention
+func (s *Server) handleRetentionPolicyCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("retention-policy-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/retention"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RetentionPolicyCreateOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: RetentionPolicyCreateOperation,
+			ID:   "retention-policy-create",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, RetentionPolicyCreateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, RetentionPolicyCreateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, RetentionPolicyCreateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeRetentionPolicyCreateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *RetentionPolicy
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    RetentionPolicyCreateOperation,
+			OperationSummary: "",
+			OperationID:      "retention-policy-create",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *RetentionPolicy
+			Params   = struct{}
+			Response = *RetentionPolicy
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.RetentionPolicyCreate(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.RetentionPolicyCreate(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRetentionPolicyCreateResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRetentionPolicyDeleteRequest handles retention-policy-delete operation.
+//
+// Delete a retention policy by UUID.
+//
+// DELETE /retention/{uuid}
+func (s *Server) handleRetentionPolicyDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("retention-policy-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/retention/{uuid}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RetentionPolicyDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: RetentionPolicyDeleteOperation,
+			ID:   "retention-policy-delete",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, RetentionPolicyDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, RetentionPolicyDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, RetentionPolicyDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeRetentionPolicyDeleteParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *RetentionPolicyDeleteOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    RetentionPolicyDeleteOperation,
+			OperationSummary: "",
+			OperationID:      "retention-policy-delete",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = RetentionPolicyDeleteParams
+			Response = *RetentionPolicyDeleteOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackRetentionPolicyDeleteParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				err = s.h.RetentionPolicyDelete(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		err = s.h.RetentionPolicyDelete(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRetentionPolicyDeleteResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRetentionPolicyGetRequest handles retention-policy-get operation.
+//
+// Retrieve a retention policy by UUID.
+//
+// GET /retention/{uuid}
+func (s *Server) handleRetentionPolicyGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("retention-policy-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/retention/{uuid}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RetentionPolicyGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: RetentionPolicyGetOperation,
+			ID:   "retention-policy-get",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, RetentionPolicyGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, RetentionPolicyGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, RetentionPolicyGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeRetentionPolicyGetParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *RetentionPolicy
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    RetentionPolicyGetOperation,
+			OperationSummary: "",
+			OperationID:      "retention-policy-get",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = RetentionPolicyGetParams
+			Response = *RetentionPolicy
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackRetentionPolicyGetParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.RetentionPolicyGet(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.RetentionPolicyGet(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRetentionPolicyGetResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRetentionPolicyListRequest handles retention-policy-list operation.
+//
+// Retrieve a list of retention policies.
+//
+// GET /retention
+func (s *Server) handleRetentionPolicyListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("retention-policy-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/retention"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RetentionPolicyListOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: RetentionPolicyListOperation,
+			ID:   "retention-policy-list",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, RetentionPolicyListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, RetentionPolicyListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, RetentionPolicyListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeRetentionPolicyListParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response []RetentionPolicy
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    RetentionPolicyListOperation,
+			OperationSummary: "",
+			OperationID:      "retention-policy-list",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = RetentionPolicyListParams
+			Response = []RetentionPolicy
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackRetentionPolicyListParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.RetentionPolicyList(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.RetentionPolicyList(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRetentionPolicyListResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRetentionPolicyRunRequest handles retention-policy-run operation.
+//
+// Apply a retention policy now instead of waiting for the scheduled retention job.
+//
+// POST /retention/{uuid}/run
+func (s *Server) handleRetentionPolicyRunRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("retention-policy-run"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/retention/{uuid}/run"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RetentionPolicyRunOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: RetentionPolicyRunOperation,
+			ID:   "retention-policy-run",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, RetentionPolicyRunOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, RetentionPolicyRunOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, RetentionPolicyRunOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeRetentionPolicyRunParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *RetentionPolicyRunAccepted
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    RetentionPolicyRunOperation,
+			OperationSummary: "",
+			OperationID:      "retention-policy-run",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = RetentionPolicyRunParams
+			Response = *RetentionPolicyRunAccepted
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackRetentionPolicyRunParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				err = s.h.RetentionPolicyRun(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		err = s.h.RetentionPolicyRun(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRetentionPolicyRunResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRetentionPolicyUpdateRequest handles retention-policy-update operation.
+//
+// Update a retention policy by UUID.
+//
+// PUT /retention/{uuid}
+func (s *Server) handleRetentionPolicyUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("retention-policy-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/retention/{uuid}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RetentionPolicyUpdateOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: RetentionPolicyUpdateOperation,
+			ID:   "retention-policy-update",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, RetentionPolicyUpdateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, RetentionPolicyUpdateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, RetentionPolicyUpdateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeRetentionPolicyUpdateParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeRetentionPolicyUpdateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *RetentionPolicy
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    RetentionPolicyUpdateOperation,
+			OperationSummary: "",
+			OperationID:      "retention-policy-update",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *RetentionPolicy
+			Params   = RetentionPolicyUpdateParams
+			Response = *RetentionPolicy
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackRetentionPolicyUpdateParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.RetentionPolicyUpdate(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.RetentionPolicyUpdate(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRetentionPolicyUpdateResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSchedulerCreateRequest handles scheduler-create operation.
+//
+// Create scheduler.
+//
+// POST /scheduler
+func (s *Server) handleSchedulerCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("scheduler-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/scheduler"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SchedulerCreateOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SchedulerCreateOperation,
+			ID:   "scheduler-create",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SchedulerCreateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SchedulerCreateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, SchedulerCreateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeSchedulerCreateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *Scheduler
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    SchedulerCreateOperation,
+			OperationSummary: "Create scheduler",
+			OperationID:      "scheduler-create",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *Scheduler
+			Params   = struct{}
+			Response = *Scheduler
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.SchedulerCreate(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.SchedulerCreate(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeSchedulerCreateResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSchedulerDeleteRequest handles scheduler-delete operation.
+//
+// Delete scheduler.
+//
+// DELETE /scheduler/{uuid}
+func (s *Server) handleSchedulerDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("scheduler-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/scheduler/{uuid}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SchedulerDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SchedulerDeleteOperation,
+			ID:   "scheduler-delete",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SchedulerDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SchedulerDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, SchedulerDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeSchedulerDeleteParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *SchedulerDeleteOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    SchedulerDeleteOperation,
+			OperationSummary: "Delete scheduler",
+			OperationID:      "scheduler-delete",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = SchedulerDeleteParams
+			Response = *SchedulerDeleteOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackSchedulerDeleteParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				err = s.h.SchedulerDelete(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		err = s.h.SchedulerDelete(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeSchedulerDeleteResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSchedulerGetRequest handles scheduler-get operation.
+//
+// Get scheduler by UUID.
+//
+// GET /scheduler/{uuid}
+func (s *Server) handleSchedulerGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("scheduler-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/scheduler/{uuid}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SchedulerGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SchedulerGetOperation,
+			ID:   "scheduler-get",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SchedulerGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SchedulerGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, SchedulerGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeSchedulerGetParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *Scheduler
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    SchedulerGetOperation,
+			OperationSummary: "Get scheduler by UUID",
+			OperationID:      "scheduler-get",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = SchedulerGetParams
+			Response = *Scheduler
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackSchedulerGetParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.SchedulerGet(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.SchedulerGet(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeSchedulerGetResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSchedulerListRequest handles scheduler-list operation.
+//
+// Retrieve all schedulers for the authenticated user.
+//
+// GET /scheduler
+func (s *Server) handleSchedulerListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("scheduler-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/scheduler"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SchedulerListOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SchedulerListOperation,
+			ID:   "scheduler-list",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SchedulerListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SchedulerListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, SchedulerListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeSchedulerListParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response []Scheduler
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    SchedulerListOperation,
+			OperationSummary: "List schedulers",
+			OperationID:      "scheduler-list",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "pipeline_uuid",
+					In:   "query",
+				}: params.PipelineUUID,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = SchedulerListParams
+			Response = []Scheduler
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackSchedulerListParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.SchedulerList(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.SchedulerList(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeSchedulerListResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSchedulerUpdateRequest handles scheduler-update operation.
+//
+// Update scheduler.
+//
+// PUT /scheduler/{uuid}
+func (s *Server) handleSchedulerUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("scheduler-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/scheduler/{uuid}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SchedulerUpdateOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SchedulerUpdateOperation,
+			ID:   "scheduler-update",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SchedulerUpdateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SchedulerUpdateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, SchedulerUpdateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeSchedulerUpdateParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeSchedulerUpdateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *Scheduler
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    SchedulerUpdateOperation,
+			OperationSummary: "Update scheduler",
+			OperationID:      "scheduler-update",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *Scheduler
+			Params   = SchedulerUpdateParams
+			Response = *Scheduler
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackSchedulerUpdateParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.SchedulerUpdate(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.SchedulerUpdate(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeSchedulerUpdateResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSessionRevokeRequest handles session-revoke operation.
+//
+// Revoke one session of the current user.
+//
+// DELETE /session/{uuid}
+func (s *Server) handleSessionRevokeRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("session-revoke"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/session/{uuid}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SessionRevokeOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SessionRevokeOperation,
+			ID:   "session-revoke",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SessionRevokeOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SessionRevokeOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, SessionRevokeOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeSessionRevokeParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *SessionRevokeOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    SessionRevokeOperation,
+			OperationSummary: "",
+			OperationID:      "session-revoke",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = SessionRevokeParams
+			Response = *SessionRevokeOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackSessionRevokeParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				err = s.h.SessionRevoke(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		err = s.h.SessionRevoke(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeSessionRevokeResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSessionRevokeAllRequest handles session-revoke-all operation.
+//
+// Log out everywhere by revoking every session of the current user.
+//
+// DELETE /session
+func (s *Server) handleSessionRevokeAllRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("session-revoke-all"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/session"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SessionRevokeAllOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SessionRevokeAllOperation,
+			ID:   "session-revoke-all",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SessionRevokeAllOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11784,7 +22749,7 @@ func (s *Server) handleOAuth2ClientDeleteRequest(args [1]string, argsEscaped boo
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, OAuth2ClientDeleteOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, SessionRevokeAllOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11802,7 +22767,7 @@ func (s *Server) handleOAuth2ClientDeleteRequest(args [1]string, argsEscaped boo
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, OAuth2ClientDeleteOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, SessionRevokeAllOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11846,7 +22811,7 @@ func (s *Server) handleOAuth2ClientDeleteRequest(args [1]string, argsEscaped boo
 			return
 		}
 	}
-	params, err := decodeOAuth2ClientDeleteParams(args, argsEscaped, r)
+	params, err := decodeSessionRevokeAllParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11857,27 +22822,27 @@ func (s *Server) handleOAuth2ClientDeleteRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	var response *OAuth2ClientDeleteOK
+	var response *SessionRevokeAllOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    OAuth2ClientDeleteOperation,
+			OperationName:    SessionRevokeAllOperation,
 			OperationSummary: "",
-			OperationID:      "oauth2-client-delete",
+			OperationID:      "session-revoke-all",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
+					Name: "keep_current",
+					In:   "query",
+				}: params.KeepCurrent,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = OAuth2ClientDeleteParams
-			Response = *OAuth2ClientDeleteOK
+			Params   = SessionRevokeAllParams
+			Response = *SessionRevokeAllOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11886,14 +22851,14 @@ func (s *Server) handleOAuth2ClientDeleteRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackOAuth2ClientDeleteParams,
+			unpackSessionRevokeAllParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.OAuth2ClientDelete(ctx, params)
+				err = s.h.SessionRevokeAll(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.OAuth2ClientDelete(ctx, params)
+		err = s.h.SessionRevokeAll(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11912,7 +22877,7 @@ func (s *Server) handleOAuth2ClientDeleteRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeOAuth2ClientDeleteResponse(response, w, span); err != nil {
+	if err := encodeSessionRevokeAllResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11921,22 +22886,22 @@ func (s *Server) handleOAuth2ClientDeleteRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleOAuth2ClientGetRequest handles oauth2-client-get operation.
+// handleSessionStatusRequest handles session-status operation.
 //
-// Get OAuth2 client details.
+// Introspect current session status and list the active sessions of the user.
 //
-// GET /oauth2/client/{uuid}
-func (s *Server) handleOAuth2ClientGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /session
+func (s *Server) handleSessionStatusRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("oauth2-client-get"),
+		otelogen.OperationID("session-status"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/oauth2/client/{uuid}"),
+		semconv.HTTPRouteKey.String("/session"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), OAuth2ClientGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SessionStatusOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11989,128 +22954,25 @@ func (s *Server) handleOAuth2ClientGetRequest(args [1]string, argsEscaped bool,
 
 			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: OAuth2ClientGetOperation,
-			ID:   "oauth2-client-get",
-		}
+		err error
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, OAuth2ClientGetOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "ZitadelCookieAuth",
-					Err:              err,
-				}
-				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
-					defer recordError("Security:ZitadelCookieAuth", err)
-				}
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-		{
-			sctx, ok, err := s.securityBearerAuth(ctx, OAuth2ClientGetOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "BearerAuth",
-					Err:              err,
-				}
-				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
-					defer recordError("Security:BearerAuth", err)
-				}
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 1
-				ctx = sctx
-			}
-		}
-		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, OAuth2ClientGetOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "PlainCookieAuth",
-					Err:              err,
-				}
-				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
-					defer recordError("Security:PlainCookieAuth", err)
-				}
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 2
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-				{0b00000010},
-				{0b00000100},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
-				defer recordError("Security", err)
-			}
-			return
-		}
-	}
-	params, err := decodeOAuth2ClientGetParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var response *OAuth2Client
+	var response *SessionStatus
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    OAuth2ClientGetOperation,
+			OperationName:    SessionStatusOperation,
 			OperationSummary: "",
-			OperationID:      "oauth2-client-get",
+			OperationID:      "session-status",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = OAuth2ClientGetParams
-			Response = *OAuth2Client
+			Params   = struct{}
+			Response = *SessionStatus
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12119,14 +22981,14 @@ func (s *Server) handleOAuth2ClientGetRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackOAuth2ClientGetParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.OAuth2ClientGet(ctx, params)
+				response, err = s.h.SessionStatus(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.OAuth2ClientGet(ctx, params)
+		response, err = s.h.SessionStatus(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -12145,7 +23007,7 @@ func (s *Server) handleOAuth2ClientGetRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeOAuth2ClientGetResponse(response, w, span); err != nil {
+	if err := encodeSessionStatusResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12154,22 +23016,22 @@ func (s *Server) handleOAuth2ClientGetRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleOAuth2ClientListRequest handles oauth2-client-list operation.
+// handleStorageHostfilesCreateRequest handles storage-hostfiles-create operation.
 //
-// List OAuth2 clients.
+// Create a new Host Files storage instance.
 //
-// GET /oauth2/client
-func (s *Server) handleOAuth2ClientListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /storage/hostfiles
+func (s *Server) handleStorageHostfilesCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("oauth2-client-list"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/oauth2/client"),
+		otelogen.OperationID("storage-hostfiles-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/storage/hostfiles"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), OAuth2ClientListOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), StorageHostfilesCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12224,15 +23086,15 @@ func (s *Server) handleOAuth2ClientListRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: OAuth2ClientListOperation,
-			ID:   "oauth2-client-list",
+			Name: StorageHostfilesCreateOperation,
+			ID:   "storage-hostfiles-create",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, OAuth2ClientListOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StorageHostfilesCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12250,7 +23112,7 @@ func (s *Server) handleOAuth2ClientListRequest(args [0]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, OAuth2ClientListOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, StorageHostfilesCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12268,7 +23130,7 @@ func (s *Server) handleOAuth2ClientListRequest(args [0]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, OAuth2ClientListOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, StorageHostfilesCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12312,42 +23174,38 @@ func (s *Server) handleOAuth2ClientListRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeOAuth2ClientListParams(args, argsEscaped, r)
+	request, close, err := s.decodeStorageHostfilesCreateRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *OAuth2ClientListOK
+	var response *StorageHostfiles
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    OAuth2ClientListOperation,
+			OperationName:    StorageHostfilesCreateOperation,
 			OperationSummary: "",
-			OperationID:      "oauth2-client-list",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-			},
-			Raw: r,
+			OperationID:      "storage-hostfiles-create",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = OAuth2ClientListParams
-			Response = *OAuth2ClientListOK
+			Request  = *StorageHostfiles
+			Params   = struct{}
+			Response = *StorageHostfiles
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12356,14 +23214,14 @@ func (s *Server) handleOAuth2ClientListRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackOAuth2ClientListParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.OAuth2ClientList(ctx, params)
+				response, err = s.h.StorageHostfilesCreate(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.OAuth2ClientList(ctx, params)
+		response, err = s.h.StorageHostfilesCreate(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -12382,7 +23240,7 @@ func (s *Server) handleOAuth2ClientListRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeOAuth2ClientListResponse(response, w, span); err != nil {
+	if err := encodeStorageHostfilesCreateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12391,22 +23249,22 @@ func (s *Server) handleOAuth2ClientListRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleOAuth2ClientLoginRequest handles oauth2-client-login operation.
+// handleStorageHostfilesDeleteRequest handles storage-hostfiles-delete operation.
 //
-// Start OAuth2 login flow.
+// Delete a specific Host Files storage instance by UUID.
 //
-// POST /oauth2/login
-func (s *Server) handleOAuth2ClientLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /storage/hostfiles/{uuid}
+func (s *Server) handleStorageHostfilesDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("oauth2-client-login"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/oauth2/login"),
+		otelogen.OperationID("storage-hostfiles-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/storage/hostfiles/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), OAuth2ClientLoginOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), StorageHostfilesDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12461,15 +23319,15 @@ func (s *Server) handleOAuth2ClientLoginRequest(args [0]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: OAuth2ClientLoginOperation,
-			ID:   "oauth2-client-login",
+			Name: StorageHostfilesDeleteOperation,
+			ID:   "storage-hostfiles-delete",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, OAuth2ClientLoginOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StorageHostfilesDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12487,7 +23345,7 @@ func (s *Server) handleOAuth2ClientLoginRequest(args [0]string, argsEscaped bool
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, OAuth2ClientLoginOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, StorageHostfilesDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12505,7 +23363,7 @@ func (s *Server) handleOAuth2ClientLoginRequest(args [0]string, argsEscaped bool
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, OAuth2ClientLoginOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, StorageHostfilesDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12549,38 +23407,38 @@ func (s *Server) handleOAuth2ClientLoginRequest(args [0]string, argsEscaped bool
 			return
 		}
 	}
-	request, close, err := s.decodeOAuth2ClientLoginRequest(r)
+	params, err := decodeStorageHostfilesDeleteParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *OAuth2ClientLoginOK
+	var response *StorageHostfilesDeleteOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    OAuth2ClientLoginOperation,
+			OperationName:    StorageHostfilesDeleteOperation,
 			OperationSummary: "",
-			OperationID:      "oauth2-client-login",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationID:      "storage-hostfiles-delete",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *OAuth2ClientLoginReq
-			Params   = struct{}
-			Response = *OAuth2ClientLoginOK
+			Request  = struct{}
+			Params   = StorageHostfilesDeleteParams
+			Response = *StorageHostfilesDeleteOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12589,14 +23447,14 @@ func (s *Server) handleOAuth2ClientLoginRequest(args [0]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			nil,
+			unpackStorageHostfilesDeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.OAuth2ClientLogin(ctx, request)
+				err = s.h.StorageHostfilesDelete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.OAuth2ClientLogin(ctx, request)
+		err = s.h.StorageHostfilesDelete(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -12615,7 +23473,7 @@ func (s *Server) handleOAuth2ClientLoginRequest(args [0]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeOAuth2ClientLoginResponse(response, w, span); err != nil {
+	if err := encodeStorageHostfilesDeleteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12624,22 +23482,22 @@ func (s *Server) handleOAuth2ClientLoginRequest(args [0]string, argsEscaped bool
 	}
 }
 
-// handleOAuth2ClientTokenDeleteRequest handles oauth2-client-token-delete operation.
+// handleStorageHostfilesGetRequest handles storage-hostfiles-get operation.
 //
-// Delete OAuth2 client token.
+// Retrieve details of a specific Host Files storage instance by UUID.
 //
-// DELETE /oauth2/client/{datasource_uuid}/token/{uuid}
-func (s *Server) handleOAuth2ClientTokenDeleteRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /storage/hostfiles/{uuid}
+func (s *Server) handleStorageHostfilesGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("oauth2-client-token-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/oauth2/client/{datasource_uuid}/token/{uuid}"),
+		otelogen.OperationID("storage-hostfiles-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/storage/hostfiles/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), OAuth2ClientTokenDeleteOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), StorageHostfilesGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12694,15 +23552,15 @@ func (s *Server) handleOAuth2ClientTokenDeleteRequest(args [2]string, argsEscape
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: OAuth2ClientTokenDeleteOperation,
-			ID:   "oauth2-client-token-delete",
+			Name: StorageHostfilesGetOperation,
+			ID:   "storage-hostfiles-get",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, OAuth2ClientTokenDeleteOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StorageHostfilesGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12720,7 +23578,7 @@ func (s *Server) handleOAuth2ClientTokenDeleteRequest(args [2]string, argsEscape
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, OAuth2ClientTokenDeleteOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, StorageHostfilesGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12738,7 +23596,7 @@ func (s *Server) handleOAuth2ClientTokenDeleteRequest(args [2]string, argsEscape
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, OAuth2ClientTokenDeleteOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, StorageHostfilesGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12782,7 +23640,7 @@ func (s *Server) handleOAuth2ClientTokenDeleteRequest(args [2]string, argsEscape
 			return
 		}
 	}
-	params, err := decodeOAuth2ClientTokenDeleteParams(args, argsEscaped, r)
+	params, err := decodeStorageHostfilesGetParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -12793,31 +23651,27 @@ func (s *Server) handleOAuth2ClientTokenDeleteRequest(args [2]string, argsEscape
 		return
 	}
 
-	var response *OAuth2ClientTokenDeleteOK
+	var response *StorageHostfiles
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    OAuth2ClientTokenDeleteOperation,
+			OperationName:    StorageHostfilesGetOperation,
 			OperationSummary: "",
-			OperationID:      "oauth2-client-token-delete",
+			OperationID:      "storage-hostfiles-get",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "uuid",
 					In:   "path",
 				}: params.UUID,
-				{
-					Name: "datasource_uuid",
-					In:   "path",
-				}: params.DatasourceUUID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = OAuth2ClientTokenDeleteParams
-			Response = *OAuth2ClientTokenDeleteOK
+			Params   = StorageHostfilesGetParams
+			Response = *StorageHostfiles
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12826,14 +23680,14 @@ func (s *Server) handleOAuth2ClientTokenDeleteRequest(args [2]string, argsEscape
 		](
 			m,
 			mreq,
-			unpackOAuth2ClientTokenDeleteParams,
+			unpackStorageHostfilesGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.OAuth2ClientTokenDelete(ctx, params)
+				response, err = s.h.StorageHostfilesGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.OAuth2ClientTokenDelete(ctx, params)
+		response, err = s.h.StorageHostfilesGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -12852,7 +23706,7 @@ func (s *Server) handleOAuth2ClientTokenDeleteRequest(args [2]string, argsEscape
 		return
 	}
 
-	if err := encodeOAuth2ClientTokenDeleteResponse(response, w, span); err != nil {
+	if err := encodeStorageHostfilesGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12861,22 +23715,22 @@ func (s *Server) handleOAuth2ClientTokenDeleteRequest(args [2]string, argsEscape
 	}
 }
 
-// handleOAuth2ClientTokenListRequest handles oauth2-client-token-list operation.
+// handleStorageHostfilesUpdateRequest handles storage-hostfiles-update operation.
 //
-// List OAuth2 client tokens.
+// Update details of a specific Host Files storage instance by UUID.
 //
-// GET /oauth2/client/{datasource_uuid}/token
-func (s *Server) handleOAuth2ClientTokenListRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /storage/hostfiles/{uuid}
+func (s *Server) handleStorageHostfilesUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("oauth2-client-token-list"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/oauth2/client/{datasource_uuid}/token"),
+		otelogen.OperationID("storage-hostfiles-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/storage/hostfiles/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), OAuth2ClientTokenListOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), StorageHostfilesUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12931,15 +23785,15 @@ func (s *Server) handleOAuth2ClientTokenListRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: OAuth2ClientTokenListOperation,
-			ID:   "oauth2-client-token-list",
+			Name: StorageHostfilesUpdateOperation,
+			ID:   "storage-hostfiles-update",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, OAuth2ClientTokenListOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StorageHostfilesUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12957,7 +23811,7 @@ func (s *Server) handleOAuth2ClientTokenListRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, OAuth2ClientTokenListOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, StorageHostfilesUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12975,7 +23829,7 @@ func (s *Server) handleOAuth2ClientTokenListRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, OAuth2ClientTokenListOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, StorageHostfilesUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -13019,7 +23873,7 @@ func (s *Server) handleOAuth2ClientTokenListRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeOAuth2ClientTokenListParams(args, argsEscaped, r)
+	params, err := decodeStorageHostfilesUpdateParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -13029,28 +23883,43 @@ func (s *Server) handleOAuth2ClientTokenListRequest(args [1]string, argsEscaped
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeStorageHostfilesUpdateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response []OAuth2ClientToken
+	var response *StorageHostfiles
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    OAuth2ClientTokenListOperation,
+			OperationName:    StorageHostfilesUpdateOperation,
 			OperationSummary: "",
-			OperationID:      "oauth2-client-token-list",
-			Body:             nil,
+			OperationID:      "storage-hostfiles-update",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "datasource_uuid",
+					Name: "uuid",
 					In:   "path",
-				}: params.DatasourceUUID,
+				}: params.UUID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = OAuth2ClientTokenListParams
-			Response = []OAuth2ClientToken
+			Request  = *StorageHostfiles
+			Params   = StorageHostfilesUpdateParams
+			Response = *StorageHostfiles
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -13059,14 +23928,14 @@ func (s *Server) handleOAuth2ClientTokenListRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackOAuth2ClientTokenListParams,
+			unpackStorageHostfilesUpdateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.OAuth2ClientTokenList(ctx, params)
+				response, err = s.h.StorageHostfilesUpdate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.OAuth2ClientTokenList(ctx, params)
+		response, err = s.h.StorageHostfilesUpdate(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -13085,7 +23954,7 @@ func (s *Server) handleOAuth2ClientTokenListRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeOAuth2ClientTokenListResponse(response, w, span); err != nil {
+	if err := encodeStorageHostfilesUpdateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -13094,22 +23963,22 @@ func (s *Server) handleOAuth2ClientTokenListRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleOAuth2ClientUpdateRequest handles oauth2-client-update operation.
+// handleStorageListRequest handles storage-list operation.
 //
-// Update OAuth2 client.
+// Retrieve a list of data storage objects.
 //
-// PUT /oauth2/client/{uuid}
-func (s *Server) handleOAuth2ClientUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /storage
+func (s *Server) handleStorageListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("oauth2-client-update"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/oauth2/client/{uuid}"),
+		otelogen.OperationID("storage-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/storage"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), OAuth2ClientUpdateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), StorageListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -13164,15 +24033,15 @@ func (s *Server) handleOAuth2ClientUpdateRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: OAuth2ClientUpdateOperation,
-			ID:   "oauth2-client-update",
+			Name: StorageListOperation,
+			ID:   "storage-list",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, OAuth2ClientUpdateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StorageListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -13190,7 +24059,7 @@ func (s *Server) handleOAuth2ClientUpdateRequest(args [1]string, argsEscaped boo
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, OAuth2ClientUpdateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, StorageListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -13208,7 +24077,7 @@ func (s *Server) handleOAuth2ClientUpdateRequest(args [1]string, argsEscaped boo
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, OAuth2ClientUpdateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, StorageListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -13252,7 +24121,7 @@ func (s *Server) handleOAuth2ClientUpdateRequest(args [1]string, argsEscaped boo
 			return
 		}
 	}
-	params, err := decodeOAuth2ClientUpdateParams(args, argsEscaped, r)
+	params, err := decodeStorageListParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -13262,43 +24131,52 @@ func (s *Server) handleOAuth2ClientUpdateRequest(args [1]string, argsEscaped boo
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeOAuth2ClientUpdateRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *OAuth2Client
+	var response []Storage
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    OAuth2ClientUpdateOperation,
+			OperationName:    StorageListOperation,
 			OperationSummary: "",
-			OperationID:      "oauth2-client-update",
-			Body:             request,
+			OperationID:      "storage-list",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "type",
+					In:   "query",
+				}: params.Type,
+				{
+					Name: "is_enabled",
+					In:   "query",
+				}: params.IsEnabled,
+				{
+					Name: "name",
+					In:   "query",
+				}: params.Name,
+				{
+					Name: "order_by",
+					In:   "query",
+				}: params.OrderBy,
+				{
+					Name: "order_direction",
+					In:   "query",
+				}: params.OrderDirection,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *OAuth2ClientUpdateReq
-			Params   = OAuth2ClientUpdateParams
-			Response = *OAuth2Client
+			Request  = struct{}
+			Params   = StorageListParams
+			Response = []Storage
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -13307,14 +24185,14 @@ func (s *Server) handleOAuth2ClientUpdateRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackOAuth2ClientUpdateParams,
+			unpackStorageListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.OAuth2ClientUpdate(ctx, request, params)
+				response, err = s.h.StorageList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.OAuth2ClientUpdate(ctx, request, params)
+		response, err = s.h.StorageList(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -13333,7 +24211,7 @@ func (s *Server) handleOAuth2ClientUpdateRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeOAuth2ClientUpdateResponse(response, w, span); err != nil {
+	if err := encodeStorageListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -13342,22 +24220,25 @@ func (s *Server) handleOAuth2ClientUpdateRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handlePipelineCreateRequest handles pipeline-create operation.
+// handleStorageMigrateRequest handles storage-migrate operation.
 //
-// Create a new pipeline for a datasource.
+// Start moving message bodies and files from this storage to the target storage. If an
+// unfinished migration with the same target and options exists it is resumed from its
+// checkpoint. A migration to the same target still held by a run answers 409, a run that
+// stopped renewing its lease is taken over.
 //
-// POST /pipeline
-func (s *Server) handlePipelineCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /storage/{uuid}/migrate
+func (s *Server) handleStorageMigrateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("pipeline-create"),
+		otelogen.OperationID("storage-migrate"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/pipeline"),
+		semconv.HTTPRouteKey.String("/storage/{uuid}/migrate"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), PipelineCreateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), StorageMigrateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -13412,15 +24293,15 @@ func (s *Server) handlePipelineCreateRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PipelineCreateOperation,
-			ID:   "pipeline-create",
+			Name: StorageMigrateOperation,
+			ID:   "storage-migrate",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, PipelineCreateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StorageMigrateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -13438,7 +24319,7 @@ func (s *Server) handlePipelineCreateRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, PipelineCreateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, StorageMigrateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -13456,7 +24337,7 @@ func (s *Server) handlePipelineCreateRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, PipelineCreateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, StorageMigrateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -13500,7 +24381,17 @@ func (s *Server) handlePipelineCreateRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	request, close, err := s.decodePipelineCreateRequest(r)
+	params, err := decodeStorageMigrateParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeStorageMigrateRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -13516,22 +24407,27 @@ func (s *Server) handlePipelineCreateRequest(args [0]string, argsEscaped bool, w
 		}
 	}()
 
-	var response *Pipeline
+	var response *StorageMigration
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PipelineCreateOperation,
-			OperationSummary: "Create a new pipeline",
-			OperationID:      "pipeline-create",
+			OperationName:    StorageMigrateOperation,
+			OperationSummary: "",
+			OperationID:      "storage-migrate",
 			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *Pipeline
-			Params   = struct{}
-			Response = *Pipeline
+			Request  = *StorageMigration
+			Params   = StorageMigrateParams
+			Response = *StorageMigration
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -13540,14 +24436,14 @@ func (s *Server) handlePipelineCreateRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackStorageMigrateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PipelineCreate(ctx, request)
+				response, err = s.h.StorageMigrate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PipelineCreate(ctx, request)
+		response, err = s.h.StorageMigrate(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -13566,7 +24462,7 @@ func (s *Server) handlePipelineCreateRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodePipelineCreateResponse(response, w, span); err != nil {
+	if err := encodeStorageMigrateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -13575,22 +24471,22 @@ func (s *Server) handlePipelineCreateRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handlePipelineDeleteRequest handles pipeline-delete operation.
+// handleStorageMigrationListRequest handles storage-migration-list operation.
 //
-// Delete a specific pipeline by UUID.
+// List migrations started from this storage.
 //
-// DELETE /pipeline/{uuid}
-func (s *Server) handlePipelineDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /storage/{uuid}/migrate
+func (s *Server) handleStorageMigrationListRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("pipeline-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/pipeline/{uuid}"),
+		otelogen.OperationID("storage-migration-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/storage/{uuid}/migrate"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), PipelineDeleteOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), StorageMigrationListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -13645,15 +24541,15 @@ func (s *Server) handlePipelineDeleteRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PipelineDeleteOperation,
-			ID:   "pipeline-delete",
+			Name: StorageMigrationListOperation,
+			ID:   "storage-migration-list",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, PipelineDeleteOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StorageMigrationListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -13671,7 +24567,7 @@ func (s *Server) handlePipelineDeleteRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, PipelineDeleteOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, StorageMigrationListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -13689,7 +24585,7 @@ func (s *Server) handlePipelineDeleteRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, PipelineDeleteOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, StorageMigrationListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -13733,7 +24629,7 @@ func (s *Server) handlePipelineDeleteRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodePipelineDeleteParams(args, argsEscaped, r)
+	params, err := decodeStorageMigrationListParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -13744,27 +24640,35 @@ func (s *Server) handlePipelineDeleteRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	var response *PipelineDeleteOK
+	var response []StorageMigration
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PipelineDeleteOperation,
-			OperationSummary: "Delete pipeline",
-			OperationID:      "pipeline-delete",
+			OperationName:    StorageMigrationListOperation,
+			OperationSummary: "",
+			OperationID:      "storage-migration-list",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "uuid",
 					In:   "path",
 				}: params.UUID,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = PipelineDeleteParams
-			Response = *PipelineDeleteOK
+			Params   = StorageMigrationListParams
+			Response = []StorageMigration
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -13773,14 +24677,14 @@ func (s *Server) handlePipelineDeleteRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackPipelineDeleteParams,
+			unpackStorageMigrationListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.PipelineDelete(ctx, params)
+				response, err = s.h.StorageMigrationList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.PipelineDelete(ctx, params)
+		response, err = s.h.StorageMigrationList(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -13799,7 +24703,7 @@ func (s *Server) handlePipelineDeleteRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodePipelineDeleteResponse(response, w, span); err != nil {
+	if err := encodeStorageMigrationListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -13808,22 +24712,22 @@ func (s *Server) handlePipelineDeleteRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handlePipelineGetRequest handles pipeline-get operation.
+// handleStoragePostgresCreateRequest handles storage-postgres-create operation.
 //
-// Retrieve a specific pipeline by its UUID.
+// Create a new PostgreSQL storage instance.
 //
-// GET /pipeline/{uuid}
-func (s *Server) handlePipelineGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /storage/postgres
+func (s *Server) handleStoragePostgresCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("pipeline-get"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/pipeline/{uuid}"),
+		otelogen.OperationID("storage-postgres-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/storage/postgres"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), PipelineGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), StoragePostgresCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -13878,15 +24782,15 @@ func (s *Server) handlePipelineGetRequest(args [1]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PipelineGetOperation,
-			ID:   "pipeline-get",
+			Name: StoragePostgresCreateOperation,
+			ID:   "storage-postgres-create",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, PipelineGetOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StoragePostgresCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -13904,7 +24808,7 @@ func (s *Server) handlePipelineGetRequest(args [1]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, PipelineGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, StoragePostgresCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -13922,7 +24826,7 @@ func (s *Server) handlePipelineGetRequest(args [1]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, PipelineGetOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, StoragePostgresCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -13966,38 +24870,38 @@ func (s *Server) handlePipelineGetRequest(args [1]string, argsEscaped bool, w ht
 			return
 		}
 	}
-	params, err := decodePipelineGetParams(args, argsEscaped, r)
+	request, close, err := s.decodeStoragePostgresCreateRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *Pipeline
+	var response *StoragePostgres
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PipelineGetOperation,
-			OperationSummary: "Get pipeline by UUID",
-			OperationID:      "pipeline-get",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
-			},
-			Raw: r,
+			OperationName:    StoragePostgresCreateOperation,
+			OperationSummary: "",
+			OperationID:      "storage-postgres-create",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = PipelineGetParams
-			Response = *Pipeline
+			Request  = *StoragePostgres
+			Params   = struct{}
+			Response = *StoragePostgres
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -14006,14 +24910,14 @@ func (s *Server) handlePipelineGetRequest(args [1]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackPipelineGetParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PipelineGet(ctx, params)
+				response, err = s.h.StoragePostgresCreate(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PipelineGet(ctx, params)
+		response, err = s.h.StoragePostgresCreate(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -14032,7 +24936,7 @@ func (s *Server) handlePipelineGetRequest(args [1]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodePipelineGetResponse(response, w, span); err != nil {
+	if err := encodeStoragePostgresCreateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -14041,22 +24945,22 @@ func (s *Server) handlePipelineGetRequest(args [1]string, argsEscaped bool, w ht
 	}
 }
 
-// handlePipelineListRequest handles pipeline-list operation.
+// handleStoragePostgresDeleteRequest handles storage-postgres-delete operation.
 //
-// Get all pipelines.
+// Delete a specific PostgreSQL storage instance by UUID.
 //
-// GET /pipeline
-func (s *Server) handlePipelineListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /storage/postgres/{uuid}
+func (s *Server) handleStoragePostgresDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("pipeline-list"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/pipeline"),
+		otelogen.OperationID("storage-postgres-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/storage/postgres/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), PipelineListOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), StoragePostgresDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -14111,15 +25015,15 @@ func (s *Server) handlePipelineListRequest(args [0]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PipelineListOperation,
-			ID:   "pipeline-list",
+			Name: StoragePostgresDeleteOperation,
+			ID:   "storage-postgres-delete",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, PipelineListOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StoragePostgresDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14137,7 +25041,7 @@ func (s *Server) handlePipelineListRequest(args [0]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, PipelineListOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, StoragePostgresDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14155,7 +25059,7 @@ func (s *Server) handlePipelineListRequest(args [0]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, PipelineListOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, StoragePostgresDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14199,7 +25103,7 @@ func (s *Server) handlePipelineListRequest(args [0]string, argsEscaped bool, w h
 			return
 		}
 	}
-	params, err := decodePipelineListParams(args, argsEscaped, r)
+	params, err := decodeStoragePostgresDeleteParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -14210,39 +25114,27 @@ func (s *Server) handlePipelineListRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	var response *PipelineListOK
+	var response *StoragePostgresDeleteOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PipelineListOperation,
-			OperationSummary: "List pipelines",
-			OperationID:      "pipeline-list",
+			OperationName:    StoragePostgresDeleteOperation,
+			OperationSummary: "",
+			OperationID:      "storage-postgres-delete",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "datasource_uuid",
-					In:   "query",
-				}: params.DatasourceUUID,
-				{
-					Name: "storage_uuid",
-					In:   "query",
-				}: params.StorageUUID,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = PipelineListParams
-			Response = *PipelineListOK
+			Params   = StoragePostgresDeleteParams
+			Response = *StoragePostgresDeleteOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -14251,14 +25143,14 @@ func (s *Server) handlePipelineListRequest(args [0]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackPipelineListParams,
+			unpackStoragePostgresDeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PipelineList(ctx, params)
+				err = s.h.StoragePostgresDelete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PipelineList(ctx, params)
+		err = s.h.StoragePostgresDelete(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -14277,7 +25169,7 @@ func (s *Server) handlePipelineListRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodePipelineListResponse(response, w, span); err != nil {
+	if err := encodeStoragePostgresDeleteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -14286,22 +25178,22 @@ func (s *Server) handlePipelineListRequest(args [0]string, argsEscaped bool, w h
 	}
 }
 
-// handlePipelineUpdateRequest handles pipeline-update operation.
+// handleStoragePostgresGetRequest handles storage-postgres-get operation.
 //
-// Update an existing pipeline.
+// Retrieve details of a specific PostgreSQL storage instance by UUID.
 //
-// PUT /pipeline/{uuid}
-func (s *Server) handlePipelineUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /storage/postgres/{uuid}
+func (s *Server) handleStoragePostgresGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("pipeline-update"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/pipeline/{uuid}"),
+		otelogen.OperationID("storage-postgres-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/storage/postgres/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), PipelineUpdateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), StoragePostgresGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -14356,15 +25248,15 @@ func (s *Server) handlePipelineUpdateRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PipelineUpdateOperation,
-			ID:   "pipeline-update",
+			Name: StoragePostgresGetOperation,
+			ID:   "storage-postgres-get",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, PipelineUpdateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StoragePostgresGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14382,7 +25274,7 @@ func (s *Server) handlePipelineUpdateRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, PipelineUpdateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, StoragePostgresGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14400,7 +25292,7 @@ func (s *Server) handlePipelineUpdateRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, PipelineUpdateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, StoragePostgresGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14444,7 +25336,7 @@ func (s *Server) handlePipelineUpdateRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodePipelineUpdateParams(args, argsEscaped, r)
+	params, err := decodeStoragePostgresGetParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -14452,32 +25344,17 @@ func (s *Server) handlePipelineUpdateRequest(args [1]string, argsEscaped bool, w
 		}
 		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	request, close, err := s.decodePipelineUpdateRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
+		return
+	}
 
-	var response *Pipeline
+	var response *StoragePostgres
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PipelineUpdateOperation,
-			OperationSummary: "Update pipeline",
-			OperationID:      "pipeline-update",
-			Body:             request,
+			OperationName:    StoragePostgresGetOperation,
+			OperationSummary: "",
+			OperationID:      "storage-postgres-get",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "uuid",
@@ -14488,9 +25365,9 @@ func (s *Server) handlePipelineUpdateRequest(args [1]string, argsEscaped bool, w
 		}
 
 		type (
-			Request  = *Pipeline
-			Params   = PipelineUpdateParams
-			Response = *Pipeline
+			Request  = struct{}
+			Params   = StoragePostgresGetParams
+			Response = *StoragePostgres
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -14499,14 +25376,14 @@ func (s *Server) handlePipelineUpdateRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackPipelineUpdateParams,
+			unpackStoragePostgresGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PipelineUpdate(ctx, request, params)
+				response, err = s.h.StoragePostgresGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PipelineUpdate(ctx, request, params)
+		response, err = s.h.StoragePostgresGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -14525,7 +25402,7 @@ func (s *Server) handlePipelineUpdateRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodePipelineUpdateResponse(response, w, span); err != nil {
+	if err := encodeStoragePostgresGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -14534,22 +25411,22 @@ func (s *Server) handlePipelineUpdateRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleSchedulerCreateRequest handles scheduler-create operation.
+// handleStoragePostgresUpdateRequest handles storage-postgres-update operation.
 //
-// Create scheduler.
+// Update details of a specific PostgreSQL storage instance by UUID.
 //
-// POST /scheduler
-func (s *Server) handleSchedulerCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /storage/postgres/{uuid}
+func (s *Server) handleStoragePostgresUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("scheduler-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/scheduler"),
+		otelogen.OperationID("storage-postgres-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/storage/postgres/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SchedulerCreateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), StoragePostgresUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -14604,15 +25481,15 @@ func (s *Server) handleSchedulerCreateRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SchedulerCreateOperation,
-			ID:   "scheduler-create",
+			Name: StoragePostgresUpdateOperation,
+			ID:   "storage-postgres-update",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SchedulerCreateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StoragePostgresUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14630,7 +25507,7 @@ func (s *Server) handleSchedulerCreateRequest(args [0]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SchedulerCreateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, StoragePostgresUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14648,7 +25525,7 @@ func (s *Server) handleSchedulerCreateRequest(args [0]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, SchedulerCreateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, StoragePostgresUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14692,7 +25569,17 @@ func (s *Server) handleSchedulerCreateRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
-	request, close, err := s.decodeSchedulerCreateRequest(r)
+	params, err := decodeStoragePostgresUpdateParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeStoragePostgresUpdateRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -14708,22 +25595,27 @@ func (s *Server) handleSchedulerCreateRequest(args [0]string, argsEscaped bool,
 		}
 	}()
 
-	var response *Scheduler
+	var response *StoragePostgres
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SchedulerCreateOperation,
-			OperationSummary: "Create scheduler",
-			OperationID:      "scheduler-create",
+			OperationName:    StoragePostgresUpdateOperation,
+			OperationSummary: "",
+			OperationID:      "storage-postgres-update",
 			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *Scheduler
-			Params   = struct{}
-			Response = *Scheduler
+			Request  = *StoragePostgres
+			Params   = StoragePostgresUpdateParams
+			Response = *StoragePostgres
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -14732,14 +25624,14 @@ func (s *Server) handleSchedulerCreateRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			nil,
+			unpackStoragePostgresUpdateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SchedulerCreate(ctx, request)
+				response, err = s.h.StoragePostgresUpdate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SchedulerCreate(ctx, request)
+		response, err = s.h.StoragePostgresUpdate(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -14758,7 +25650,7 @@ func (s *Server) handleSchedulerCreateRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeSchedulerCreateResponse(response, w, span); err != nil {
+	if err := encodeStoragePostgresUpdateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -14767,22 +25659,22 @@ func (s *Server) handleSchedulerCreateRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleSchedulerDeleteRequest handles scheduler-delete operation.
+// handleStorageS3CreateRequest handles storage-s3-create operation.
 //
-// Delete scheduler.
+// Create a new S3 storage instance.
 //
-// DELETE /scheduler/{uuid}
-func (s *Server) handleSchedulerDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /storage/s3
+func (s *Server) handleStorageS3CreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("scheduler-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/scheduler/{uuid}"),
+		otelogen.OperationID("storage-s3-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/storage/s3"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SchedulerDeleteOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), StorageS3CreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -14837,15 +25729,15 @@ func (s *Server) handleSchedulerDeleteRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SchedulerDeleteOperation,
-			ID:   "scheduler-delete",
+			Name: StorageS3CreateOperation,
+			ID:   "storage-s3-create",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SchedulerDeleteOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StorageS3CreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14863,7 +25755,7 @@ func (s *Server) handleSchedulerDeleteRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SchedulerDeleteOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, StorageS3CreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14881,7 +25773,7 @@ func (s *Server) handleSchedulerDeleteRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, SchedulerDeleteOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, StorageS3CreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14925,38 +25817,38 @@ func (s *Server) handleSchedulerDeleteRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeSchedulerDeleteParams(args, argsEscaped, r)
+	request, close, err := s.decodeStorageS3CreateRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *SchedulerDeleteOK
+	var response *StorageS3
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SchedulerDeleteOperation,
-			OperationSummary: "Delete scheduler",
-			OperationID:      "scheduler-delete",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
-			},
-			Raw: r,
+			OperationName:    StorageS3CreateOperation,
+			OperationSummary: "",
+			OperationID:      "storage-s3-create",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = SchedulerDeleteParams
-			Response = *SchedulerDeleteOK
+			Request  = *StorageS3
+			Params   = struct{}
+			Response = *StorageS3
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -14965,14 +25857,14 @@ func (s *Server) handleSchedulerDeleteRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackSchedulerDeleteParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.SchedulerDelete(ctx, params)
+				response, err = s.h.StorageS3Create(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.SchedulerDelete(ctx, params)
+		response, err = s.h.StorageS3Create(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -14991,7 +25883,7 @@ func (s *Server) handleSchedulerDeleteRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeSchedulerDeleteResponse(response, w, span); err != nil {
+	if err := encodeStorageS3CreateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -15000,22 +25892,22 @@ func (s *Server) handleSchedulerDeleteRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleSchedulerGetRequest handles scheduler-get operation.
+// handleStorageS3DeleteRequest handles storage-s3-delete operation.
 //
-// Get scheduler by UUID.
+// Delete a specific S3 storage instance by UUID.
 //
-// GET /scheduler/{uuid}
-func (s *Server) handleSchedulerGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /storage/s3/{uuid}
+func (s *Server) handleStorageS3DeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("scheduler-get"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/scheduler/{uuid}"),
+		otelogen.OperationID("storage-s3-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/storage/s3/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SchedulerGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), StorageS3DeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -15070,15 +25962,15 @@ func (s *Server) handleSchedulerGetRequest(args [1]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SchedulerGetOperation,
-			ID:   "scheduler-get",
+			Name: StorageS3DeleteOperation,
+			ID:   "storage-s3-delete",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SchedulerGetOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StorageS3DeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -15096,7 +25988,7 @@ func (s *Server) handleSchedulerGetRequest(args [1]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SchedulerGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, StorageS3DeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -15114,7 +26006,7 @@ func (s *Server) handleSchedulerGetRequest(args [1]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, SchedulerGetOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, StorageS3DeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -15158,7 +26050,7 @@ func (s *Server) handleSchedulerGetRequest(args [1]string, argsEscaped bool, w h
 			return
 		}
 	}
-	params, err := decodeSchedulerGetParams(args, argsEscaped, r)
+	params, err := decodeStorageS3DeleteParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -15169,13 +26061,13 @@ func (s *Server) handleSchedulerGetRequest(args [1]string, argsEscaped bool, w h
 		return
 	}
 
-	var response *Scheduler
+	var response *StorageS3DeleteOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SchedulerGetOperation,
-			OperationSummary: "Get scheduler by UUID",
-			OperationID:      "scheduler-get",
+			OperationName:    StorageS3DeleteOperation,
+			OperationSummary: "",
+			OperationID:      "storage-s3-delete",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -15188,8 +26080,8 @@ func (s *Server) handleSchedulerGetRequest(args [1]string, argsEscaped bool, w h
 
 		type (
 			Request  = struct{}
-			Params   = SchedulerGetParams
-			Response = *Scheduler
+			Params   = StorageS3DeleteParams
+			Response = *StorageS3DeleteOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -15198,14 +26090,14 @@ func (s *Server) handleSchedulerGetRequest(args [1]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackSchedulerGetParams,
+			unpackStorageS3DeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SchedulerGet(ctx, params)
+				err = s.h.StorageS3Delete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SchedulerGet(ctx, params)
+		err = s.h.StorageS3Delete(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -15224,7 +26116,7 @@ func (s *Server) handleSchedulerGetRequest(args [1]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeSchedulerGetResponse(response, w, span); err != nil {
+	if err := encodeStorageS3DeleteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -15233,22 +26125,22 @@ func (s *Server) handleSchedulerGetRequest(args [1]string, argsEscaped bool, w h
 	}
 }
 
-// handleSchedulerListRequest handles scheduler-list operation.
+// handleStorageS3GetRequest handles storage-s3-get operation.
 //
-// Retrieve all schedulers for the authenticated user.
+// Retrieve details of a specific S3 storage instance by UUID.
 //
-// GET /scheduler
-func (s *Server) handleSchedulerListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /storage/s3/{uuid}
+func (s *Server) handleStorageS3GetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("scheduler-list"),
+		otelogen.OperationID("storage-s3-get"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/scheduler"),
+		semconv.HTTPRouteKey.String("/storage/s3/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SchedulerListOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), StorageS3GetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -15303,15 +26195,15 @@ func (s *Server) handleSchedulerListRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SchedulerListOperation,
-			ID:   "scheduler-list",
+			Name: StorageS3GetOperation,
+			ID:   "storage-s3-get",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SchedulerListOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StorageS3GetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -15329,7 +26221,7 @@ func (s *Server) handleSchedulerListRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SchedulerListOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, StorageS3GetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -15347,7 +26239,7 @@ func (s *Server) handleSchedulerListRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, SchedulerListOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, StorageS3GetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -15391,7 +26283,7 @@ func (s *Server) handleSchedulerListRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeSchedulerListParams(args, argsEscaped, r)
+	params, err := decodeStorageS3GetParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -15402,35 +26294,27 @@ func (s *Server) handleSchedulerListRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	var response []Scheduler
+	var response *StorageS3
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SchedulerListOperation,
-			OperationSummary: "List schedulers",
-			OperationID:      "scheduler-list",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "pipeline_uuid",
-					In:   "query",
-				}: params.PipelineUUID,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
+			OperationName:    StorageS3GetOperation,
+			OperationSummary: "",
+			OperationID:      "storage-s3-get",
+			Body:             nil,
+			Params: middleware.Parameters{
 				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = SchedulerListParams
-			Response = []Scheduler
+			Params   = StorageS3GetParams
+			Response = *StorageS3
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -15439,14 +26323,14 @@ func (s *Server) handleSchedulerListRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackSchedulerListParams,
+			unpackStorageS3GetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SchedulerList(ctx, params)
+				response, err = s.h.StorageS3Get(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SchedulerList(ctx, params)
+		response, err = s.h.StorageS3Get(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -15465,7 +26349,7 @@ func (s *Server) handleSchedulerListRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeSchedulerListResponse(response, w, span); err != nil {
+	if err := encodeStorageS3GetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -15474,22 +26358,22 @@ func (s *Server) handleSchedulerListRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleSchedulerUpdateRequest handles scheduler-update operation.
+// handleStorageS3UpdateRequest handles storage-s3-update operation.
 //
-// Update scheduler.
+// Update details of a specific S3 storage instance by UUID.
 //
-// PUT /scheduler/{uuid}
-func (s *Server) handleSchedulerUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /storage/s3/{uuid}
+func (s *Server) handleStorageS3UpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("scheduler-update"),
+		otelogen.OperationID("storage-s3-update"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/scheduler/{uuid}"),
+		semconv.HTTPRouteKey.String("/storage/s3/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SchedulerUpdateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), StorageS3UpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -15544,15 +26428,15 @@ func (s *Server) handleSchedulerUpdateRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SchedulerUpdateOperation,
-			ID:   "scheduler-update",
+			Name: StorageS3UpdateOperation,
+			ID:   "storage-s3-update",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SchedulerUpdateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StorageS3UpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -15570,7 +26454,7 @@ func (s *Server) handleSchedulerUpdateRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SchedulerUpdateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, StorageS3UpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -15588,7 +26472,7 @@ func (s *Server) handleSchedulerUpdateRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, SchedulerUpdateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, StorageS3UpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -15632,7 +26516,7 @@ func (s *Server) handleSchedulerUpdateRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeSchedulerUpdateParams(args, argsEscaped, r)
+	params, err := decodeStorageS3UpdateParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -15642,7 +26526,7 @@ func (s *Server) handleSchedulerUpdateRequest(args [1]string, argsEscaped bool,
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeSchedulerUpdateRequest(r)
+	request, close, err := s.decodeStorageS3UpdateRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -15658,13 +26542,13 @@ func (s *Server) handleSchedulerUpdateRequest(args [1]string, argsEscaped bool,
 		}
 	}()
 
-	var response *Scheduler
+	var response *StorageS3
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SchedulerUpdateOperation,
-			OperationSummary: "Update scheduler",
-			OperationID:      "scheduler-update",
+			OperationName:    StorageS3UpdateOperation,
+			OperationSummary: "",
+			OperationID:      "storage-s3-update",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
@@ -15676,9 +26560,9 @@ func (s *Server) handleSchedulerUpdateRequest(args [1]string, argsEscaped bool,
 		}
 
 		type (
-			Request  = *Scheduler
-			Params   = SchedulerUpdateParams
-			Response = *Scheduler
+			Request  = *StorageS3
+			Params   = StorageS3UpdateParams
+			Response = *StorageS3
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -15687,14 +26571,14 @@ func (s *Server) handleSchedulerUpdateRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackSchedulerUpdateParams,
+			unpackStorageS3UpdateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SchedulerUpdate(ctx, request, params)
+				response, err = s.h.StorageS3Update(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SchedulerUpdate(ctx, request, params)
+		response, err = s.h.StorageS3Update(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -15713,7 +26597,7 @@ func (s *Server) handleSchedulerUpdateRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeSchedulerUpdateResponse(response, w, span); err != nil {
+	if err := encodeStorageS3UpdateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -15722,22 +26606,22 @@ func (s *Server) handleSchedulerUpdateRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleSessionStatusRequest handles session-status operation.
+// handleSyncpolicyCreateRequest handles syncpolicy-create operation.
 //
-// Introspect current session status.
+// Create a new sync policy.
 //
-// GET /session
-func (s *Server) handleSessionStatusRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /syncpolicy
+func (s *Server) handleSyncpolicyCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("session-status"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/session"),
+		otelogen.OperationID("syncpolicy-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/syncpolicy"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SessionStatusOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SyncpolicyCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -15790,25 +26674,128 @@ func (s *Server) handleSessionStatusRequest(args [0]string, argsEscaped bool, w
 
 			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SyncpolicyCreateOperation,
+			ID:   "syncpolicy-create",
+		}
 	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SyncpolicyCreateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ZitadelCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:ZitadelCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SyncpolicyCreateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, SyncpolicyCreateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PlainCookieAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:PlainCookieAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
 
-	var response *SessionStatus
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeSyncpolicyCreateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *SyncPolicy
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SessionStatusOperation,
+			OperationName:    SyncpolicyCreateOperation,
 			OperationSummary: "",
-			OperationID:      "session-status",
-			Body:             nil,
+			OperationID:      "syncpolicy-create",
+			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
+			Request  = *SyncPolicy
 			Params   = struct{}
-			Response = *SessionStatus
+			Response = *SyncPolicy
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -15819,12 +26806,12 @@ func (s *Server) handleSessionStatusRequest(args [0]string, argsEscaped bool, w
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SessionStatus(ctx)
+				response, err = s.h.SyncpolicyCreate(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SessionStatus(ctx)
+		response, err = s.h.SyncpolicyCreate(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -15843,7 +26830,7 @@ func (s *Server) handleSessionStatusRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeSessionStatusResponse(response, w, span); err != nil {
+	if err := encodeSyncpolicyCreateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -15852,22 +26839,22 @@ func (s *Server) handleSessionStatusRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleStorageHostfilesCreateRequest handles storage-hostfiles-create operation.
+// handleSyncpolicyDeleteRequest handles syncpolicy-delete operation.
 //
-// Create a new Host Files storage instance.
+// Delete a sync policy by uuid.
 //
-// POST /storage/hostfiles
-func (s *Server) handleStorageHostfilesCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /syncpolicy/{uuid}
+func (s *Server) handleSyncpolicyDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-hostfiles-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/storage/hostfiles"),
+		otelogen.OperationID("syncpolicy-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/syncpolicy/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), StorageHostfilesCreateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SyncpolicyDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -15922,15 +26909,15 @@ func (s *Server) handleStorageHostfilesCreateRequest(args [0]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: StorageHostfilesCreateOperation,
-			ID:   "storage-hostfiles-create",
+			Name: SyncpolicyDeleteOperation,
+			ID:   "syncpolicy-delete",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StorageHostfilesCreateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SyncpolicyDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -15948,7 +26935,7 @@ func (s *Server) handleStorageHostfilesCreateRequest(args [0]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, StorageHostfilesCreateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, SyncpolicyDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -15966,7 +26953,7 @@ func (s *Server) handleStorageHostfilesCreateRequest(args [0]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, StorageHostfilesCreateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, SyncpolicyDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16010,38 +26997,38 @@ func (s *Server) handleStorageHostfilesCreateRequest(args [0]string, argsEscaped
 			return
 		}
 	}
-	request, close, err := s.decodeStorageHostfilesCreateRequest(r)
+	params, err := decodeSyncpolicyDeleteParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *StorageHostfiles
+	var response *SyncpolicyDeleteOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    StorageHostfilesCreateOperation,
+			OperationName:    SyncpolicyDeleteOperation,
 			OperationSummary: "",
-			OperationID:      "storage-hostfiles-create",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationID:      "syncpolicy-delete",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *StorageHostfiles
-			Params   = struct{}
-			Response = *StorageHostfiles
+			Request  = struct{}
+			Params   = SyncpolicyDeleteParams
+			Response = *SyncpolicyDeleteOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -16050,14 +27037,14 @@ func (s *Server) handleStorageHostfilesCreateRequest(args [0]string, argsEscaped
 		](
 			m,
 			mreq,
-			nil,
+			unpackSyncpolicyDeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.StorageHostfilesCreate(ctx, request)
+				err = s.h.SyncpolicyDelete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.StorageHostfilesCreate(ctx, request)
+		err = s.h.SyncpolicyDelete(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -16076,7 +27063,7 @@ func (s *Server) handleStorageHostfilesCreateRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeStorageHostfilesCreateResponse(response, w, span); err != nil {
+	if err := encodeSyncpolicyDeleteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -16085,22 +27072,22 @@ func (s *Server) handleStorageHostfilesCreateRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleStorageHostfilesDeleteRequest handles storage-hostfiles-delete operation.
+// handleSyncpolicyGetRequest handles syncpolicy-get operation.
 //
-// Delete a specific Host Files storage instance by UUID.
+// Retrieve a specific sync policy by uuid.
 //
-// DELETE /storage/hostfiles/{uuid}
-func (s *Server) handleStorageHostfilesDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /syncpolicy/{uuid}
+func (s *Server) handleSyncpolicyGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-hostfiles-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/storage/hostfiles/{uuid}"),
+		otelogen.OperationID("syncpolicy-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/syncpolicy/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), StorageHostfilesDeleteOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SyncpolicyGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -16155,15 +27142,15 @@ func (s *Server) handleStorageHostfilesDeleteRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: StorageHostfilesDeleteOperation,
-			ID:   "storage-hostfiles-delete",
+			Name: SyncpolicyGetOperation,
+			ID:   "syncpolicy-get",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StorageHostfilesDeleteOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SyncpolicyGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16181,7 +27168,7 @@ func (s *Server) handleStorageHostfilesDeleteRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, StorageHostfilesDeleteOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, SyncpolicyGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16199,7 +27186,7 @@ func (s *Server) handleStorageHostfilesDeleteRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, StorageHostfilesDeleteOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, SyncpolicyGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16243,7 +27230,7 @@ func (s *Server) handleStorageHostfilesDeleteRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeStorageHostfilesDeleteParams(args, argsEscaped, r)
+	params, err := decodeSyncpolicyGetParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -16254,13 +27241,13 @@ func (s *Server) handleStorageHostfilesDeleteRequest(args [1]string, argsEscaped
 		return
 	}
 
-	var response *StorageHostfilesDeleteOK
+	var response *SyncPolicy
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    StorageHostfilesDeleteOperation,
+			OperationName:    SyncpolicyGetOperation,
 			OperationSummary: "",
-			OperationID:      "storage-hostfiles-delete",
+			OperationID:      "syncpolicy-get",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -16273,8 +27260,8 @@ func (s *Server) handleStorageHostfilesDeleteRequest(args [1]string, argsEscaped
 
 		type (
 			Request  = struct{}
-			Params   = StorageHostfilesDeleteParams
-			Response = *StorageHostfilesDeleteOK
+			Params   = SyncpolicyGetParams
+			Response = *SyncPolicy
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -16283,14 +27270,14 @@ func (s *Server) handleStorageHostfilesDeleteRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackStorageHostfilesDeleteParams,
+			unpackSyncpolicyGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.StorageHostfilesDelete(ctx, params)
+				response, err = s.h.SyncpolicyGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.StorageHostfilesDelete(ctx, params)
+		response, err = s.h.SyncpolicyGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -16309,7 +27296,7 @@ func (s *Server) handleStorageHostfilesDeleteRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeStorageHostfilesDeleteResponse(response, w, span); err != nil {
+	if err := encodeSyncpolicyGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -16318,22 +27305,22 @@ func (s *Server) handleStorageHostfilesDeleteRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleStorageHostfilesGetRequest handles storage-hostfiles-get operation.
+// handleSyncpolicyListRequest handles syncpolicy-list operation.
 //
-// Retrieve details of a specific Host Files storage instance by UUID.
+// Retrieve a list of sync policies for the authenticated user.
 //
-// GET /storage/hostfiles/{uuid}
-func (s *Server) handleStorageHostfilesGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /syncpolicy
+func (s *Server) handleSyncpolicyListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-hostfiles-get"),
+		otelogen.OperationID("syncpolicy-list"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/storage/hostfiles/{uuid}"),
+		semconv.HTTPRouteKey.String("/syncpolicy"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), StorageHostfilesGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SyncpolicyListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -16388,15 +27375,15 @@ func (s *Server) handleStorageHostfilesGetRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: StorageHostfilesGetOperation,
-			ID:   "storage-hostfiles-get",
+			Name: SyncpolicyListOperation,
+			ID:   "syncpolicy-list",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StorageHostfilesGetOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SyncpolicyListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16414,7 +27401,7 @@ func (s *Server) handleStorageHostfilesGetRequest(args [1]string, argsEscaped bo
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, StorageHostfilesGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, SyncpolicyListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16432,7 +27419,7 @@ func (s *Server) handleStorageHostfilesGetRequest(args [1]string, argsEscaped bo
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, StorageHostfilesGetOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, SyncpolicyListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16476,7 +27463,7 @@ func (s *Server) handleStorageHostfilesGetRequest(args [1]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeStorageHostfilesGetParams(args, argsEscaped, r)
+	params, err := decodeSyncpolicyListParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -16487,27 +27474,31 @@ func (s *Server) handleStorageHostfilesGetRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	var response *StorageHostfiles
+	var response *SyncpolicyListOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    StorageHostfilesGetOperation,
+			OperationName:    SyncpolicyListOperation,
 			OperationSummary: "",
-			OperationID:      "storage-hostfiles-get",
+			OperationID:      "syncpolicy-list",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = StorageHostfilesGetParams
-			Response = *StorageHostfiles
+			Params   = SyncpolicyListParams
+			Response = *SyncpolicyListOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -16516,14 +27507,14 @@ func (s *Server) handleStorageHostfilesGetRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackStorageHostfilesGetParams,
+			unpackSyncpolicyListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.StorageHostfilesGet(ctx, params)
+				response, err = s.h.SyncpolicyList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.StorageHostfilesGet(ctx, params)
+		response, err = s.h.SyncpolicyList(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -16542,7 +27533,7 @@ func (s *Server) handleStorageHostfilesGetRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeStorageHostfilesGetResponse(response, w, span); err != nil {
+	if err := encodeSyncpolicyListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -16551,22 +27542,22 @@ func (s *Server) handleStorageHostfilesGetRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleStorageHostfilesUpdateRequest handles storage-hostfiles-update operation.
+// handleSyncpolicyUpdateRequest handles syncpolicy-update operation.
 //
-// Update details of a specific Host Files storage instance by UUID.
+// Update a sync policy by uuid.
 //
-// PUT /storage/hostfiles/{uuid}
-func (s *Server) handleStorageHostfilesUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /syncpolicy/{uuid}
+func (s *Server) handleSyncpolicyUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-hostfiles-update"),
+		otelogen.OperationID("syncpolicy-update"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/storage/hostfiles/{uuid}"),
+		semconv.HTTPRouteKey.String("/syncpolicy/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), StorageHostfilesUpdateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SyncpolicyUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -16621,15 +27612,15 @@ func (s *Server) handleStorageHostfilesUpdateRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: StorageHostfilesUpdateOperation,
-			ID:   "storage-hostfiles-update",
+			Name: SyncpolicyUpdateOperation,
+			ID:   "syncpolicy-update",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StorageHostfilesUpdateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SyncpolicyUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16647,7 +27638,7 @@ func (s *Server) handleStorageHostfilesUpdateRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, StorageHostfilesUpdateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, SyncpolicyUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16665,7 +27656,7 @@ func (s *Server) handleStorageHostfilesUpdateRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, StorageHostfilesUpdateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, SyncpolicyUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16709,7 +27700,7 @@ func (s *Server) handleStorageHostfilesUpdateRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeStorageHostfilesUpdateParams(args, argsEscaped, r)
+	params, err := decodeSyncpolicyUpdateParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -16719,7 +27710,7 @@ func (s *Server) handleStorageHostfilesUpdateRequest(args [1]string, argsEscaped
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeStorageHostfilesUpdateRequest(r)
+	request, close, err := s.decodeSyncpolicyUpdateRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -16735,13 +27726,13 @@ func (s *Server) handleStorageHostfilesUpdateRequest(args [1]string, argsEscaped
 		}
 	}()
 
-	var response *StorageHostfiles
+	var response *SyncPolicy
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    StorageHostfilesUpdateOperation,
+			OperationName:    SyncpolicyUpdateOperation,
 			OperationSummary: "",
-			OperationID:      "storage-hostfiles-update",
+			OperationID:      "syncpolicy-update",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
@@ -16753,9 +27744,9 @@ func (s *Server) handleStorageHostfilesUpdateRequest(args [1]string, argsEscaped
 		}
 
 		type (
-			Request  = *StorageHostfiles
-			Params   = StorageHostfilesUpdateParams
-			Response = *StorageHostfiles
+			Request  = *SyncPolicy
+			Params   = SyncpolicyUpdateParams
+			Response = *SyncPolicy
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -16764,14 +27755,14 @@ func (s *Server) handleStorageHostfilesUpdateRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackStorageHostfilesUpdateParams,
+			unpackSyncpolicyUpdateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.StorageHostfilesUpdate(ctx, request, params)
+				response, err = s.h.SyncpolicyUpdate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.StorageHostfilesUpdate(ctx, request, params)
+		response, err = s.h.SyncpolicyUpdate(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -16790,7 +27781,7 @@ func (s *Server) handleStorageHostfilesUpdateRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeStorageHostfilesUpdateResponse(response, w, span); err != nil {
+	if err := encodeSyncpolicyUpdateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -16799,22 +27790,22 @@ func (s *Server) handleStorageHostfilesUpdateRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleStorageListRequest handles storage-list operation.
+// handleTgSessionCreateRequest handles tg-session-create operation.
 //
-// Retrieve a list of data storage objects.
+// Create a new Telegram session.
 //
-// GET /storage
-func (s *Server) handleStorageListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /telegram
+func (s *Server) handleTgSessionCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-list"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/storage"),
+		otelogen.OperationID("tg-session-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/telegram"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), StorageListOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), TgSessionCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -16869,15 +27860,15 @@ func (s *Server) handleStorageListRequest(args [0]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: StorageListOperation,
-			ID:   "storage-list",
+			Name: TgSessionCreateOperation,
+			ID:   "tg-session-create",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StorageListOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, TgSessionCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16895,7 +27886,7 @@ func (s *Server) handleStorageListRequest(args [0]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, StorageListOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, TgSessionCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16913,7 +27904,7 @@ func (s *Server) handleStorageListRequest(args [0]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, StorageListOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, TgSessionCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16957,62 +27948,38 @@ func (s *Server) handleStorageListRequest(args [0]string, argsEscaped bool, w ht
 			return
 		}
 	}
-	params, err := decodeStorageListParams(args, argsEscaped, r)
+	request, close, err := s.decodeTgSessionCreateRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response []Storage
+	var response *Telegram
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    StorageListOperation,
+			OperationName:    TgSessionCreateOperation,
 			OperationSummary: "",
-			OperationID:      "storage-list",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "type",
-					In:   "query",
-				}: params.Type,
-				{
-					Name: "is_enabled",
-					In:   "query",
-				}: params.IsEnabled,
-				{
-					Name: "name",
-					In:   "query",
-				}: params.Name,
-				{
-					Name: "order_by",
-					In:   "query",
-				}: params.OrderBy,
-				{
-					Name: "order_direction",
-					In:   "query",
-				}: params.OrderDirection,
-			},
-			Raw: r,
+			OperationID:      "tg-session-create",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = StorageListParams
-			Response = []Storage
+			Request  = *TgSessionCreateReq
+			Params   = struct{}
+			Response = *Telegram
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -17021,14 +27988,14 @@ func (s *Server) handleStorageListRequest(args [0]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackStorageListParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.StorageList(ctx, params)
+				response, err = s.h.TgSessionCreate(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.StorageList(ctx, params)
+		response, err = s.h.TgSessionCreate(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -17047,7 +28014,7 @@ func (s *Server) handleStorageListRequest(args [0]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeStorageListResponse(response, w, span); err != nil {
+	if err := encodeTgSessionCreateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -17056,22 +28023,22 @@ func (s *Server) handleStorageListRequest(args [0]string, argsEscaped bool, w ht
 	}
 }
 
-// handleStoragePostgresCreateRequest handles storage-postgres-create operation.
+// handleTgSessionListRequest handles tg-session-list operation.
 //
-// Create a new PostgreSQL storage instance.
+// List all Telegram sessions for the authenticated user.
 //
-// POST /storage/postgres
-func (s *Server) handleStoragePostgresCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /telegram
+func (s *Server) handleTgSessionListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-postgres-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/storage/postgres"),
+		otelogen.OperationID("tg-session-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/telegram"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), StoragePostgresCreateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), TgSessionListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -17126,15 +28093,15 @@ func (s *Server) handleStoragePostgresCreateRequest(args [0]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: StoragePostgresCreateOperation,
-			ID:   "storage-postgres-create",
+			Name: TgSessionListOperation,
+			ID:   "tg-session-list",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StoragePostgresCreateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, TgSessionListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17152,7 +28119,7 @@ func (s *Server) handleStoragePostgresCreateRequest(args [0]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, StoragePostgresCreateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, TgSessionListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17170,7 +28137,7 @@ func (s *Server) handleStoragePostgresCreateRequest(args [0]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, StoragePostgresCreateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, TgSessionListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17214,38 +28181,23 @@ func (s *Server) handleStoragePostgresCreateRequest(args [0]string, argsEscaped
 			return
 		}
 	}
-	request, close, err := s.decodeStoragePostgresCreateRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *StoragePostgres
+	var response *TgSessionListOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    StoragePostgresCreateOperation,
+			OperationName:    TgSessionListOperation,
 			OperationSummary: "",
-			OperationID:      "storage-postgres-create",
-			Body:             request,
+			OperationID:      "tg-session-list",
+			Body:             nil,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *StoragePostgres
+			Request  = struct{}
 			Params   = struct{}
-			Response = *StoragePostgres
+			Response = *TgSessionListOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -17256,12 +28208,12 @@ func (s *Server) handleStoragePostgresCreateRequest(args [0]string, argsEscaped
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.StoragePostgresCreate(ctx, request)
+				response, err = s.h.TgSessionList(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.StoragePostgresCreate(ctx, request)
+		response, err = s.h.TgSessionList(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -17280,7 +28232,7 @@ func (s *Server) handleStoragePostgresCreateRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeStoragePostgresCreateResponse(response, w, span); err != nil {
+	if err := encodeTgSessionListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -17289,22 +28241,22 @@ func (s *Server) handleStoragePostgresCreateRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleStoragePostgresDeleteRequest handles storage-postgres-delete operation.
+// handleTgSessionVerifyRequest handles tg-session-verify operation.
 //
-// Delete a specific PostgreSQL storage instance by UUID.
+// Complete the session creation process by verifying the code.
 //
-// DELETE /storage/postgres/{uuid}
-func (s *Server) handleStoragePostgresDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /telegram/{id}
+func (s *Server) handleTgSessionVerifyRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-postgres-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/storage/postgres/{uuid}"),
+		otelogen.OperationID("tg-session-verify"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/telegram/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), StoragePostgresDeleteOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), TgSessionVerifyOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -17359,15 +28311,15 @@ func (s *Server) handleStoragePostgresDeleteRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: StoragePostgresDeleteOperation,
-			ID:   "storage-postgres-delete",
+			Name: TgSessionVerifyOperation,
+			ID:   "tg-session-verify",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StoragePostgresDeleteOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, TgSessionVerifyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17385,7 +28337,7 @@ func (s *Server) handleStoragePostgresDeleteRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, StoragePostgresDeleteOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, TgSessionVerifyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17403,7 +28355,7 @@ func (s *Server) handleStoragePostgresDeleteRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, StoragePostgresDeleteOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, TgSessionVerifyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17447,7 +28399,7 @@ func (s *Server) handleStoragePostgresDeleteRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeStoragePostgresDeleteParams(args, argsEscaped, r)
+	params, err := decodeTgSessionVerifyParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -17457,28 +28409,43 @@ func (s *Server) handleStoragePostgresDeleteRequest(args [1]string, argsEscaped
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeTgSessionVerifyRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *StoragePostgresDeleteOK
+	var response *Telegram
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    StoragePostgresDeleteOperation,
+			OperationName:    TgSessionVerifyOperation,
 			OperationSummary: "",
-			OperationID:      "storage-postgres-delete",
-			Body:             nil,
+			OperationID:      "tg-session-verify",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "uuid",
+					Name: "id",
 					In:   "path",
-				}: params.UUID,
+				}: params.ID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = StoragePostgresDeleteParams
-			Response = *StoragePostgresDeleteOK
+			Request  = *TgSessionVerifyReq
+			Params   = TgSessionVerifyParams
+			Response = *Telegram
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -17487,14 +28454,14 @@ func (s *Server) handleStoragePostgresDeleteRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackStoragePostgresDeleteParams,
+			unpackTgSessionVerifyParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.StoragePostgresDelete(ctx, params)
+				response, err = s.h.TgSessionVerify(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.StoragePostgresDelete(ctx, params)
+		response, err = s.h.TgSessionVerify(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -17513,7 +28480,7 @@ func (s *Server) handleStoragePostgresDeleteRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeStoragePostgresDeleteResponse(response, w, span); err != nil {
+	if err := encodeTgSessionVerifyResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -17522,22 +28489,22 @@ func (s *Server) handleStoragePostgresDeleteRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleStoragePostgresGetRequest handles storage-postgres-get operation.
+// handleUpdateContactRequest handles updateContact operation.
 //
-// Retrieve details of a specific PostgreSQL storage instance by UUID.
+// Update contact details.
 //
-// GET /storage/postgres/{uuid}
-func (s *Server) handleStoragePostgresGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /contact/{uuid}
+func (s *Server) handleUpdateContactRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-postgres-get"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/storage/postgres/{uuid}"),
+		otelogen.OperationID("updateContact"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/contact/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), StoragePostgresGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateContactOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -17592,15 +28559,15 @@ func (s *Server) handleStoragePostgresGetRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: StoragePostgresGetOperation,
-			ID:   "storage-postgres-get",
+			Name: UpdateContactOperation,
+			ID:   "updateContact",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StoragePostgresGetOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, UpdateContactOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17618,7 +28585,7 @@ func (s *Server) handleStoragePostgresGetRequest(args [1]string, argsEscaped boo
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, StoragePostgresGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateContactOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17636,7 +28603,7 @@ func (s *Server) handleStoragePostgresGetRequest(args [1]string, argsEscaped boo
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, StoragePostgresGetOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, UpdateContactOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17680,7 +28647,7 @@ func (s *Server) handleStoragePostgresGetRequest(args [1]string, argsEscaped boo
 			return
 		}
 	}
-	params, err := decodeStoragePostgresGetParams(args, argsEscaped, r)
+	params, err := decodeUpdateContactParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -17690,15 +28657,30 @@ func (s *Server) handleStoragePostgresGetRequest(args [1]string, argsEscaped boo
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeUpdateContactRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *StoragePostgres
+	var response *Contact
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    StoragePostgresGetOperation,
-			OperationSummary: "",
-			OperationID:      "storage-postgres-get",
-			Body:             nil,
+			OperationName:    UpdateContactOperation,
+			OperationSummary: "Update contact details",
+			OperationID:      "updateContact",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "uuid",
@@ -17709,9 +28691,9 @@ func (s *Server) handleStoragePostgresGetRequest(args [1]string, argsEscaped boo
 		}
 
 		type (
-			Request  = struct{}
-			Params   = StoragePostgresGetParams
-			Response = *StoragePostgres
+			Request  = *Contact
+			Params   = UpdateContactParams
+			Response = *Contact
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -17720,14 +28702,14 @@ func (s *Server) handleStoragePostgresGetRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackStoragePostgresGetParams,
+			unpackUpdateContactParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.StoragePostgresGet(ctx, params)
+				response, err = s.h.UpdateContact(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.StoragePostgresGet(ctx, params)
+		response, err = s.h.UpdateContact(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -17746,7 +28728,7 @@ func (s *Server) handleStoragePostgresGetRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeStoragePostgresGetResponse(response, w, span); err != nil {
+	if err := encodeUpdateContactResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -17755,22 +28737,22 @@ func (s *Server) handleStoragePostgresGetRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleStoragePostgresUpdateRequest handles storage-postgres-update operation.
+// handleUpdateProfileRequest handles updateProfile operation.
 //
-// Update details of a specific PostgreSQL storage instance by UUID.
+// Update current user profile.
 //
-// PUT /storage/postgres/{uuid}
-func (s *Server) handleStoragePostgresUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /profile
+func (s *Server) handleUpdateProfileRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-postgres-update"),
+		otelogen.OperationID("updateProfile"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/storage/postgres/{uuid}"),
+		semconv.HTTPRouteKey.String("/profile"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), StoragePostgresUpdateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateProfileOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -17825,15 +28807,15 @@ func (s *Server) handleStoragePostgresUpdateRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: StoragePostgresUpdateOperation,
-			ID:   "storage-postgres-update",
+			Name: UpdateProfileOperation,
+			ID:   "updateProfile",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StoragePostgresUpdateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, UpdateProfileOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17851,7 +28833,7 @@ func (s *Server) handleStoragePostgresUpdateRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, StoragePostgresUpdateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateProfileOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17869,7 +28851,7 @@ func (s *Server) handleStoragePostgresUpdateRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, StoragePostgresUpdateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, UpdateProfileOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17913,17 +28895,7 @@ func (s *Server) handleStoragePostgresUpdateRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeStoragePostgresUpdateParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	request, close, err := s.decodeStoragePostgresUpdateRequest(r)
+	request, close, err := s.decodeUpdateProfileRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -17939,27 +28911,22 @@ func (s *Server) handleStoragePostgresUpdateRequest(args [1]string, argsEscaped
 		}
 	}()
 
-	var response *StoragePostgres
+	var response *User
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    StoragePostgresUpdateOperation,
-			OperationSummary: "",
-			OperationID:      "storage-postgres-update",
+			OperationName:    UpdateProfileOperation,
+			OperationSummary: "Update current user profile",
+			OperationID:      "updateProfile",
 			Body:             request,
-			Params: middleware.Parameters{
-				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = *StoragePostgres
-			Params   = StoragePostgresUpdateParams
-			Response = *StoragePostgres
+			Request  = *UserProfile
+			Params   = struct{}
+			Response = *User
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -17968,14 +28935,14 @@ func (s *Server) handleStoragePostgresUpdateRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackStoragePostgresUpdateParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.StoragePostgresUpdate(ctx, request, params)
+				response, err = s.h.UpdateProfile(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.StoragePostgresUpdate(ctx, request, params)
+		response, err = s.h.UpdateProfile(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -17994,7 +28961,7 @@ func (s *Server) handleStoragePostgresUpdateRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeStoragePostgresUpdateResponse(response, w, span); err != nil {
+	if err := encodeUpdateProfileResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -18003,22 +28970,22 @@ func (s *Server) handleStoragePostgresUpdateRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleStorageS3CreateRequest handles storage-s3-create operation.
+// handleUpdateUserRequest handles updateUser operation.
 //
-// Create a new S3 storage instance.
+// Update user details.
 //
-// POST /storage/s3
-func (s *Server) handleStorageS3CreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /user/{uuid}
+func (s *Server) handleUpdateUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-s3-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/storage/s3"),
+		otelogen.OperationID("updateUser"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/user/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), StorageS3CreateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -18073,15 +29040,15 @@ func (s *Server) handleStorageS3CreateRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: StorageS3CreateOperation,
-			ID:   "storage-s3-create",
+			Name: UpdateUserOperation,
+			ID:   "updateUser",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StorageS3CreateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, UpdateUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18099,7 +29066,7 @@ func (s *Server) handleStorageS3CreateRequest(args [0]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, StorageS3CreateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18117,7 +29084,7 @@ func (s *Server) handleStorageS3CreateRequest(args [0]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, StorageS3CreateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, UpdateUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18161,7 +29128,17 @@ func (s *Server) handleStorageS3CreateRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
-	request, close, err := s.decodeStorageS3CreateRequest(r)
+	params, err := decodeUpdateUserParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUpdateUserRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -18177,22 +29154,27 @@ func (s *Server) handleStorageS3CreateRequest(args [0]string, argsEscaped bool,
 		}
 	}()
 
-	var response *StorageS3
+	var response *User
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    StorageS3CreateOperation,
-			OperationSummary: "",
-			OperationID:      "storage-s3-create",
+			OperationName:    UpdateUserOperation,
+			OperationSummary: "Update user details",
+			OperationID:      "updateUser",
 			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *StorageS3
-			Params   = struct{}
-			Response = *StorageS3
+			Request  = *User
+			Params   = UpdateUserParams
+			Response = *User
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -18201,14 +29183,14 @@ func (s *Server) handleStorageS3CreateRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			nil,
+			unpackUpdateUserParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.StorageS3Create(ctx, request)
+				response, err = s.h.UpdateUser(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.StorageS3Create(ctx, request)
+		response, err = s.h.UpdateUser(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -18227,7 +29209,7 @@ func (s *Server) handleStorageS3CreateRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeStorageS3CreateResponse(response, w, span); err != nil {
+	if err := encodeUpdateUserResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -18236,22 +29218,22 @@ func (s *Server) handleStorageS3CreateRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleStorageS3DeleteRequest handles storage-s3-delete operation.
+// handleUploadFileRequest handles uploadFile operation.
 //
-// Delete a specific S3 storage instance by UUID.
+// Upload a file.
 //
-// DELETE /storage/s3/{uuid}
-func (s *Server) handleStorageS3DeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /storage/upload
+func (s *Server) handleUploadFileRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-s3-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/storage/s3/{uuid}"),
+		otelogen.OperationID("uploadFile"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/storage/upload"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), StorageS3DeleteOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UploadFileOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -18306,15 +29288,15 @@ func (s *Server) handleStorageS3DeleteRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: StorageS3DeleteOperation,
-			ID:   "storage-s3-delete",
+			Name: UploadFileOperation,
+			ID:   "uploadFile",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StorageS3DeleteOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, UploadFileOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18332,7 +29314,7 @@ func (s *Server) handleStorageS3DeleteRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, StorageS3DeleteOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UploadFileOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18350,7 +29332,7 @@ func (s *Server) handleStorageS3DeleteRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, StorageS3DeleteOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, UploadFileOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18394,38 +29376,38 @@ func (s *Server) handleStorageS3DeleteRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeStorageS3DeleteParams(args, argsEscaped, r)
+	request, close, err := s.decodeUploadFileRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *StorageS3DeleteOK
+	var response *UploadFileResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    StorageS3DeleteOperation,
-			OperationSummary: "",
-			OperationID:      "storage-s3-delete",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
-			},
-			Raw: r,
+			OperationName:    UploadFileOperation,
+			OperationSummary: "Upload a file",
+			OperationID:      "uploadFile",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = StorageS3DeleteParams
-			Response = *StorageS3DeleteOK
+			Request  = *UploadFileRequest
+			Params   = struct{}
+			Response = *UploadFileResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -18434,14 +29416,14 @@ func (s *Server) handleStorageS3DeleteRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackStorageS3DeleteParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.StorageS3Delete(ctx, params)
+				response, err = s.h.UploadFile(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.StorageS3Delete(ctx, params)
+		response, err = s.h.UploadFile(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -18460,7 +29442,7 @@ func (s *Server) handleStorageS3DeleteRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeStorageS3DeleteResponse(response, w, span); err != nil {
+	if err := encodeUploadFileResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -18469,22 +29451,22 @@ func (s *Server) handleStorageS3DeleteRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleStorageS3GetRequest handles storage-s3-get operation.
+// handleWebhookCreateRequest handles webhook-create operation.
 //
-// Retrieve details of a specific S3 storage instance by UUID.
+// Create a webhook. It receives the events emitted after its creation.
 //
-// GET /storage/s3/{uuid}
-func (s *Server) handleStorageS3GetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /webhook
+func (s *Server) handleWebhookCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-s3-get"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/storage/s3/{uuid}"),
+		otelogen.OperationID("webhook-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/webhook"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), StorageS3GetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), WebhookCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -18539,15 +29521,15 @@ func (s *Server) handleStorageS3GetRequest(args [1]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: StorageS3GetOperation,
-			ID:   "storage-s3-get",
+			Name: WebhookCreateOperation,
+			ID:   "webhook-create",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StorageS3GetOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WebhookCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18565,7 +29547,7 @@ func (s *Server) handleStorageS3GetRequest(args [1]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, StorageS3GetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, WebhookCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18583,7 +29565,7 @@ func (s *Server) handleStorageS3GetRequest(args [1]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, StorageS3GetOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, WebhookCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18627,38 +29609,38 @@ func (s *Server) handleStorageS3GetRequest(args [1]string, argsEscaped bool, w h
 			return
 		}
 	}
-	params, err := decodeStorageS3GetParams(args, argsEscaped, r)
+	request, close, err := s.decodeWebhookCreateRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *StorageS3
+	var response *Webhook
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    StorageS3GetOperation,
+			OperationName:    WebhookCreateOperation,
 			OperationSummary: "",
-			OperationID:      "storage-s3-get",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
-			},
-			Raw: r,
+			OperationID:      "webhook-create",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = StorageS3GetParams
-			Response = *StorageS3
+			Request  = *Webhook
+			Params   = struct{}
+			Response = *Webhook
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -18667,14 +29649,14 @@ func (s *Server) handleStorageS3GetRequest(args [1]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackStorageS3GetParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.StorageS3Get(ctx, params)
+				response, err = s.h.WebhookCreate(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.StorageS3Get(ctx, params)
+		response, err = s.h.WebhookCreate(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -18693,7 +29675,7 @@ func (s *Server) handleStorageS3GetRequest(args [1]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeStorageS3GetResponse(response, w, span); err != nil {
+	if err := encodeWebhookCreateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -18702,22 +29684,22 @@ func (s *Server) handleStorageS3GetRequest(args [1]string, argsEscaped bool, w h
 	}
 }
 
-// handleStorageS3UpdateRequest handles storage-s3-update operation.
+// handleWebhookDeleteRequest handles webhook-delete operation.
 //
-// Update details of a specific S3 storage instance by UUID.
+// Delete a webhook.
 //
-// PUT /storage/s3/{uuid}
-func (s *Server) handleStorageS3UpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /webhook/{uuid}
+func (s *Server) handleWebhookDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storage-s3-update"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/storage/s3/{uuid}"),
+		otelogen.OperationID("webhook-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/webhook/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), StorageS3UpdateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), WebhookDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -18772,15 +29754,15 @@ func (s *Server) handleStorageS3UpdateRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: StorageS3UpdateOperation,
-			ID:   "storage-s3-update",
+			Name: WebhookDeleteOperation,
+			ID:   "webhook-delete",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, StorageS3UpdateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WebhookDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18798,7 +29780,7 @@ func (s *Server) handleStorageS3UpdateRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, StorageS3UpdateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, WebhookDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18816,7 +29798,7 @@ func (s *Server) handleStorageS3UpdateRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, StorageS3UpdateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, WebhookDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18860,7 +29842,7 @@ func (s *Server) handleStorageS3UpdateRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeStorageS3UpdateParams(args, argsEscaped, r)
+	params, err := decodeWebhookDeleteParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -18870,30 +29852,15 @@ func (s *Server) handleStorageS3UpdateRequest(args [1]string, argsEscaped bool,
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeStorageS3UpdateRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *StorageS3
+	var response *WebhookDeleteOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    StorageS3UpdateOperation,
+			OperationName:    WebhookDeleteOperation,
 			OperationSummary: "",
-			OperationID:      "storage-s3-update",
-			Body:             request,
+			OperationID:      "webhook-delete",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "uuid",
@@ -18904,9 +29871,9 @@ func (s *Server) handleStorageS3UpdateRequest(args [1]string, argsEscaped bool,
 		}
 
 		type (
-			Request  = *StorageS3
-			Params   = StorageS3UpdateParams
-			Response = *StorageS3
+			Request  = struct{}
+			Params   = WebhookDeleteParams
+			Response = *WebhookDeleteOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -18915,14 +29882,14 @@ func (s *Server) handleStorageS3UpdateRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackStorageS3UpdateParams,
+			unpackWebhookDeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.StorageS3Update(ctx, request, params)
+				err = s.h.WebhookDelete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.StorageS3Update(ctx, request, params)
+		err = s.h.WebhookDelete(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -18941,7 +29908,7 @@ func (s *Server) handleStorageS3UpdateRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeStorageS3UpdateResponse(response, w, span); err != nil {
+	if err := encodeWebhookDeleteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -18950,22 +29917,22 @@ func (s *Server) handleStorageS3UpdateRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleSyncpolicyCreateRequest handles syncpolicy-create operation.
+// handleWebhookGetRequest handles webhook-get operation.
 //
-// Create a new sync policy.
+// Retrieve a webhook.
 //
-// POST /syncpolicy
-func (s *Server) handleSyncpolicyCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /webhook/{uuid}
+func (s *Server) handleWebhookGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("syncpolicy-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/syncpolicy"),
+		otelogen.OperationID("webhook-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/webhook/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SyncpolicyCreateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), WebhookGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -19020,15 +29987,15 @@ func (s *Server) handleSyncpolicyCreateRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SyncpolicyCreateOperation,
-			ID:   "syncpolicy-create",
+			Name: WebhookGetOperation,
+			ID:   "webhook-get",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SyncpolicyCreateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WebhookGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -19046,7 +30013,7 @@ func (s *Server) handleSyncpolicyCreateRequest(args [0]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SyncpolicyCreateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, WebhookGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -19064,7 +30031,7 @@ func (s *Server) handleSyncpolicyCreateRequest(args [0]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, SyncpolicyCreateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, WebhookGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -19108,38 +30075,38 @@ func (s *Server) handleSyncpolicyCreateRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
-	request, close, err := s.decodeSyncpolicyCreateRequest(r)
+	params, err := decodeWebhookGetParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *SyncPolicy
+	var response *Webhook
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SyncpolicyCreateOperation,
+			OperationName:    WebhookGetOperation,
 			OperationSummary: "",
-			OperationID:      "syncpolicy-create",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationID:      "webhook-get",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *SyncPolicy
-			Params   = struct{}
-			Response = *SyncPolicy
+			Request  = struct{}
+			Params   = WebhookGetParams
+			Response = *Webhook
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -19148,14 +30115,14 @@ func (s *Server) handleSyncpolicyCreateRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			nil,
+			unpackWebhookGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SyncpolicyCreate(ctx, request)
+				response, err = s.h.WebhookGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SyncpolicyCreate(ctx, request)
+		response, err = s.h.WebhookGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -19174,7 +30141,7 @@ func (s *Server) handleSyncpolicyCreateRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeSyncpolicyCreateResponse(response, w, span); err != nil {
+	if err := encodeWebhookGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -19183,22 +30150,22 @@ func (s *Server) handleSyncpolicyCreateRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleSyncpolicyDeleteRequest handles syncpolicy-delete operation.
+// handleWebhookListRequest handles webhook-list operation.
 //
-// Delete a sync policy by uuid.
+// Retrieve the webhooks of the workspace.
 //
-// DELETE /syncpolicy/{uuid}
-func (s *Server) handleSyncpolicyDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /webhook
+func (s *Server) handleWebhookListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("syncpolicy-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/syncpolicy/{uuid}"),
+		otelogen.OperationID("webhook-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/webhook"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SyncpolicyDeleteOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), WebhookListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -19253,15 +30220,15 @@ func (s *Server) handleSyncpolicyDeleteRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SyncpolicyDeleteOperation,
-			ID:   "syncpolicy-delete",
+			Name: WebhookListOperation,
+			ID:   "webhook-list",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SyncpolicyDeleteOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WebhookListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -19279,7 +30246,7 @@ func (s *Server) handleSyncpolicyDeleteRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SyncpolicyDeleteOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, WebhookListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -19297,7 +30264,7 @@ func (s *Server) handleSyncpolicyDeleteRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, SyncpolicyDeleteOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, WebhookListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -19341,38 +30308,23 @@ func (s *Server) handleSyncpolicyDeleteRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeSyncpolicyDeleteParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var response *SyncpolicyDeleteOK
+	var response []Webhook
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SyncpolicyDeleteOperation,
+			OperationName:    WebhookListOperation,
 			OperationSummary: "",
-			OperationID:      "syncpolicy-delete",
+			OperationID:      "webhook-list",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = SyncpolicyDeleteParams
-			Response = *SyncpolicyDeleteOK
+			Params   = struct{}
+			Response = []Webhook
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -19381,14 +30333,14 @@ func (s *Server) handleSyncpolicyDeleteRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackSyncpolicyDeleteParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.SyncpolicyDelete(ctx, params)
+				response, err = s.h.WebhookList(ctx)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.SyncpolicyDelete(ctx, params)
+		response, err = s.h.WebhookList(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -19407,7 +30359,7 @@ func (s *Server) handleSyncpolicyDeleteRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeSyncpolicyDeleteResponse(response, w, span); err != nil {
+	if err := encodeWebhookListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -19416,22 +30368,22 @@ func (s *Server) handleSyncpolicyDeleteRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleSyncpolicyGetRequest handles syncpolicy-get operation.
+// handleWebhookUpdateRequest handles webhook-update operation.
 //
-// Retrieve a specific sync policy by uuid.
+// Update a webhook.
 //
-// GET /syncpolicy/{uuid}
-func (s *Server) handleSyncpolicyGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /webhook/{uuid}
+func (s *Server) handleWebhookUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("syncpolicy-get"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/syncpolicy/{uuid}"),
+		otelogen.OperationID("webhook-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/webhook/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SyncpolicyGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), WebhookUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -19486,15 +30438,15 @@ func (s *Server) handleSyncpolicyGetRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SyncpolicyGetOperation,
-			ID:   "syncpolicy-get",
+			Name: WebhookUpdateOperation,
+			ID:   "webhook-update",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SyncpolicyGetOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WebhookUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -19512,7 +30464,7 @@ func (s *Server) handleSyncpolicyGetRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SyncpolicyGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, WebhookUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -19530,7 +30482,7 @@ func (s *Server) handleSyncpolicyGetRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, SyncpolicyGetOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, WebhookUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -19574,7 +30526,7 @@ func (s *Server) handleSyncpolicyGetRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeSyncpolicyGetParams(args, argsEscaped, r)
+	params, err := decodeWebhookUpdateParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -19584,15 +30536,30 @@ func (s *Server) handleSyncpolicyGetRequest(args [1]string, argsEscaped bool, w
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeWebhookUpdateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *SyncPolicy
+	var response *Webhook
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SyncpolicyGetOperation,
+			OperationName:    WebhookUpdateOperation,
 			OperationSummary: "",
-			OperationID:      "syncpolicy-get",
-			Body:             nil,
+			OperationID:      "webhook-update",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "uuid",
@@ -19603,9 +30570,9 @@ func (s *Server) handleSyncpolicyGetRequest(args [1]string, argsEscaped bool, w
 		}
 
 		type (
-			Request  = struct{}
-			Params   = SyncpolicyGetParams
-			Response = *SyncPolicy
+			Request  = *Webhook
+			Params   = WebhookUpdateParams
+			Response = *Webhook
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -19614,14 +30581,14 @@ func (s *Server) handleSyncpolicyGetRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackSyncpolicyGetParams,
+			unpackWebhookUpdateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SyncpolicyGet(ctx, params)
+				response, err = s.h.WebhookUpdate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SyncpolicyGet(ctx, params)
+		response, err = s.h.WebhookUpdate(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -19640,7 +30607,7 @@ func (s *Server) handleSyncpolicyGetRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeSyncpolicyGetResponse(response, w, span); err != nil {
+	if err := encodeWebhookUpdateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -19649,22 +30616,22 @@ func (s *Server) handleSyncpolicyGetRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleSyncpolicyListRequest handles syncpolicy-list operation.
+// handleWorkerJobsCancelRequest handles worker-jobs-cancel operation.
 //
-// Retrieve a list of sync policies for the authenticated user.
+// Signal cancellation for a running job; returns 204 if accepted.
 //
-// GET /syncpolicy
-func (s *Server) handleSyncpolicyListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /workerjobs/{uuid}/cancel
+func (s *Server) handleWorkerJobsCancelRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("syncpolicy-list"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/syncpolicy"),
+		otelogen.OperationID("worker-jobs-cancel"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/workerjobs/{uuid}/cancel"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SyncpolicyListOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), WorkerJobsCancelOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -19719,15 +30686,15 @@ func (s *Server) handleSyncpolicyListRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SyncpolicyListOperation,
-			ID:   "syncpolicy-list",
+			Name: WorkerJobsCancelOperation,
+			ID:   "worker-jobs-cancel",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SyncpolicyListOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WorkerJobsCancelOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -19745,7 +30712,7 @@ func (s *Server) handleSyncpolicyListRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SyncpolicyListOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, WorkerJobsCancelOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -19763,7 +30730,7 @@ func (s *Server) handleSyncpolicyListRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, SyncpolicyListOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, WorkerJobsCancelOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -19807,7 +30774,7 @@ func (s *Server) handleSyncpolicyListRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeSyncpolicyListParams(args, argsEscaped, r)
+	params, err := decodeWorkerJobsCancelParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -19818,31 +30785,27 @@ func (s *Server) handleSyncpolicyListRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	var response *SyncpolicyListOK
+	var response *WorkerJobsCancelNoContent
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SyncpolicyListOperation,
-			OperationSummary: "",
-			OperationID:      "syncpolicy-list",
+			OperationName:    WorkerJobsCancelOperation,
+			OperationSummary: "Cancel a running worker job",
+			OperationID:      "worker-jobs-cancel",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = SyncpolicyListParams
-			Response = *SyncpolicyListOK
+			Params   = WorkerJobsCancelParams
+			Response = *WorkerJobsCancelNoContent
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -19851,14 +30814,14 @@ func (s *Server) handleSyncpolicyListRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackSyncpolicyListParams,
+			unpackWorkerJobsCancelParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SyncpolicyList(ctx, params)
+				err = s.h.WorkerJobsCancel(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SyncpolicyList(ctx, params)
+		err = s.h.WorkerJobsCancel(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -19877,7 +30840,7 @@ func (s *Server) handleSyncpolicyListRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeSyncpolicyListResponse(response, w, span); err != nil {
+	if err := encodeWorkerJobsCancelResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -19886,22 +30849,22 @@ func (s *Server) handleSyncpolicyListRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleSyncpolicyUpdateRequest handles syncpolicy-update operation.
+// handleWorkerJobsDeleteRequest handles worker-jobs-delete operation.
 //
-// Update a sync policy by uuid.
+// Delete a worker job by uuid.
 //
-// PUT /syncpolicy/{uuid}
-func (s *Server) handleSyncpolicyUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /workerjobs/{uuid}
+func (s *Server) handleWorkerJobsDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("syncpolicy-update"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/syncpolicy/{uuid}"),
+		otelogen.OperationID("worker-jobs-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/workerjobs/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SyncpolicyUpdateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), WorkerJobsDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -19956,15 +30919,15 @@ func (s *Server) handleSyncpolicyUpdateRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SyncpolicyUpdateOperation,
-			ID:   "syncpolicy-update",
+			Name: WorkerJobsDeleteOperation,
+			ID:   "worker-jobs-delete",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, SyncpolicyUpdateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WorkerJobsDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -19982,7 +30945,7 @@ func (s *Server) handleSyncpolicyUpdateRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SyncpolicyUpdateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, WorkerJobsDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -20000,7 +30963,7 @@ func (s *Server) handleSyncpolicyUpdateRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, SyncpolicyUpdateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, WorkerJobsDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -20044,7 +31007,7 @@ func (s *Server) handleSyncpolicyUpdateRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeSyncpolicyUpdateParams(args, argsEscaped, r)
+	params, err := decodeWorkerJobsDeleteParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -20054,30 +31017,15 @@ func (s *Server) handleSyncpolicyUpdateRequest(args [1]string, argsEscaped bool,
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeSyncpolicyUpdateRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *SyncPolicy
+	var response *WorkerJobsDeleteOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SyncpolicyUpdateOperation,
+			OperationName:    WorkerJobsDeleteOperation,
 			OperationSummary: "",
-			OperationID:      "syncpolicy-update",
-			Body:             request,
+			OperationID:      "worker-jobs-delete",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "uuid",
@@ -20088,9 +31036,9 @@ func (s *Server) handleSyncpolicyUpdateRequest(args [1]string, argsEscaped bool,
 		}
 
 		type (
-			Request  = *SyncPolicy
-			Params   = SyncpolicyUpdateParams
-			Response = *SyncPolicy
+			Request  = struct{}
+			Params   = WorkerJobsDeleteParams
+			Response = *WorkerJobsDeleteOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -20099,14 +31047,14 @@ func (s *Server) handleSyncpolicyUpdateRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackSyncpolicyUpdateParams,
+			unpackWorkerJobsDeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SyncpolicyUpdate(ctx, request, params)
+				err = s.h.WorkerJobsDelete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SyncpolicyUpdate(ctx, request, params)
+		err = s.h.WorkerJobsDelete(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -20125,7 +31073,7 @@ func (s *Server) handleSyncpolicyUpdateRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeSyncpolicyUpdateResponse(response, w, span); err != nil {
+	if err := encodeWorkerJobsDeleteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -20134,22 +31082,22 @@ func (s *Server) handleSyncpolicyUpdateRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleTgSessionCreateRequest handles tg-session-create operation.
+// handleWorkerJobsGetRequest handles worker-jobs-get operation.
 //
-// Create a new Telegram session.
+// Retrieve a specific worker job by uuid.
 //
-// POST /telegram
-func (s *Server) handleTgSessionCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /workerjobs/{uuid}
+func (s *Server) handleWorkerJobsGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("tg-session-create"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/telegram"),
+		otelogen.OperationID("worker-jobs-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/workerjobs/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), TgSessionCreateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), WorkerJobsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -20204,15 +31152,15 @@ func (s *Server) handleTgSessionCreateRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: TgSessionCreateOperation,
-			ID:   "tg-session-create",
+			Name: WorkerJobsGetOperation,
+			ID:   "worker-jobs-get",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, TgSessionCreateOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WorkerJobsGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -20230,7 +31178,7 @@ func (s *Server) handleTgSessionCreateRequest(args [0]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, TgSessionCreateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, WorkerJobsGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -20248,7 +31196,7 @@ func (s *Server) handleTgSessionCreateRequest(args [0]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, TgSessionCreateOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, WorkerJobsGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -20292,38 +31240,38 @@ func (s *Server) handleTgSessionCreateRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
-	request, close, err := s.decodeTgSessionCreateRequest(r)
+	params, err := decodeWorkerJobsGetParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *Telegram
+	var response *WorkerJobs
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    TgSessionCreateOperation,
+			OperationName:    WorkerJobsGetOperation,
 			OperationSummary: "",
-			OperationID:      "tg-session-create",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationID:      "worker-jobs-get",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *TgSessionCreateReq
-			Params   = struct{}
-			Response = *Telegram
+			Request  = struct{}
+			Params   = WorkerJobsGetParams
+			Response = *WorkerJobs
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -20332,14 +31280,14 @@ func (s *Server) handleTgSessionCreateRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			nil,
+			unpackWorkerJobsGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.TgSessionCreate(ctx, request)
+				response, err = s.h.WorkerJobsGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.TgSessionCreate(ctx, request)
+		response, err = s.h.WorkerJobsGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -20358,7 +31306,7 @@ func (s *Server) handleTgSessionCreateRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeTgSessionCreateResponse(response, w, span); err != nil {
+	if err := encodeWorkerJobsGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -20367,22 +31315,22 @@ func (s *Server) handleTgSessionCreateRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleTgSessionListRequest handles tg-session-list operation.
+// handleWorkerJobsListRequest handles worker-jobs-list operation.
 //
-// List all Telegram sessions for the authenticated user.
+// Retrieve a list of worker jobs.
 //
-// GET /telegram
-func (s *Server) handleTgSessionListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /workerjobs
+func (s *Server) handleWorkerJobsListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("tg-session-list"),
+		otelogen.OperationID("worker-jobs-list"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/telegram"),
+		semconv.HTTPRouteKey.String("/workerjobs"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), TgSessionListOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), WorkerJobsListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -20437,15 +31385,15 @@ func (s *Server) handleTgSessionListRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: TgSessionListOperation,
-			ID:   "tg-session-list",
+			Name: WorkerJobsListOperation,
+			ID:   "worker-jobs-list",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, TgSessionListOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WorkerJobsListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -20463,7 +31411,7 @@ func (s *Server) handleTgSessionListRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, TgSessionListOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, WorkerJobsListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -20481,7 +31429,7 @@ func (s *Server) handleTgSessionListRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, TgSessionListOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, WorkerJobsListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -20525,23 +31473,58 @@ func (s *Server) handleTgSessionListRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
+	params, err := decodeWorkerJobsListParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response *TgSessionListOK
+	var response *WorkerJobsListOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    TgSessionListOperation,
+			OperationName:    WorkerJobsListOperation,
 			OperationSummary: "",
-			OperationID:      "tg-session-list",
+			OperationID:      "worker-jobs-list",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "status",
+					In:   "query",
+				}: params.Status,
+				{
+					Name: "subject",
+					In:   "query",
+				}: params.Subject,
+				{
+					Name: "scheduler_uuid",
+					In:   "query",
+				}: params.SchedulerUUID,
+				{
+					Name: "pipeline_uuid",
+					In:   "query",
+				}: params.PipelineUUID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = *TgSessionListOK
+			Params   = WorkerJobsListParams
+			Response = *WorkerJobsListOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -20550,14 +31533,14 @@ func (s *Server) handleTgSessionListRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackWorkerJobsListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.TgSessionList(ctx)
+				response, err = s.h.WorkerJobsList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.TgSessionList(ctx)
+		response, err = s.h.WorkerJobsList(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -20576,7 +31559,7 @@ func (s *Server) handleTgSessionListRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeTgSessionListResponse(response, w, span); err != nil {
+	if err := encodeWorkerJobsListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -20585,22 +31568,22 @@ func (s *Server) handleTgSessionListRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleTgSessionVerifyRequest handles tg-session-verify operation.
+// handleWorkerJobsRetryRequest handles worker-jobs-retry operation.
 //
-// Complete the session creation process by verifying the code.
+// Publish the job again under a new uuid with the arguments it was queued with. Returns the new job.
 //
-// PUT /telegram/{id}
-func (s *Server) handleTgSessionVerifyRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /workerjobs/{uuid}/retry
+func (s *Server) handleWorkerJobsRetryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("tg-session-verify"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/telegram/{id}"),
+		otelogen.OperationID("worker-jobs-retry"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/workerjobs/{uuid}/retry"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), TgSessionVerifyOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), WorkerJobsRetryOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -20655,15 +31638,15 @@ func (s *Server) handleTgSessionVerifyRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: TgSessionVerifyOperation,
-			ID:   "tg-session-verify",
+			Name: WorkerJobsRetryOperation,
+			ID:   "worker-jobs-retry",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, TgSessionVerifyOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WorkerJobsRetryOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -20681,7 +31664,7 @@ func (s *Server) handleTgSessionVerifyRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, TgSessionVerifyOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, WorkerJobsRetryOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -20699,7 +31682,7 @@ func (s *Server) handleTgSessionVerifyRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, TgSessionVerifyOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, WorkerJobsRetryOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -20743,7 +31726,7 @@ func (s *Server) handleTgSessionVerifyRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeTgSessionVerifyParams(args, argsEscaped, r)
+	params, err := decodeWorkerJobsRetryParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -20753,43 +31736,28 @@ func (s *Server) handleTgSessionVerifyRequest(args [1]string, argsEscaped bool,
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeTgSessionVerifyRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *Telegram
+	var response *WorkerJobs
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    TgSessionVerifyOperation,
-			OperationSummary: "",
-			OperationID:      "tg-session-verify",
-			Body:             request,
+			OperationName:    WorkerJobsRetryOperation,
+			OperationSummary: "Retry a worker job",
+			OperationID:      "worker-jobs-retry",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "id",
+					Name: "uuid",
 					In:   "path",
-				}: params.ID,
+				}: params.UUID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *TgSessionVerifyReq
-			Params   = TgSessionVerifyParams
-			Response = *Telegram
+			Request  = struct{}
+			Params   = WorkerJobsRetryParams
+			Response = *WorkerJobs
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -20798,14 +31766,14 @@ func (s *Server) handleTgSessionVerifyRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackTgSessionVerifyParams,
+			unpackWorkerJobsRetryParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.TgSessionVerify(ctx, request, params)
+				response, err = s.h.WorkerJobsRetry(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.TgSessionVerify(ctx, request, params)
+		response, err = s.h.WorkerJobsRetry(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -20824,7 +31792,7 @@ func (s *Server) handleTgSessionVerifyRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeTgSessionVerifyResponse(response, w, span); err != nil {
+	if err := encodeWorkerJobsRetryResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -20833,22 +31801,22 @@ func (s *Server) handleTgSessionVerifyRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleUpdateContactRequest handles updateContact operation.
+// handleWorkspaceCreateRequest handles workspace-create operation.
 //
-// Update contact details.
+// Create a new workspace, the caller becomes its owner.
 //
-// PUT /contact/{uuid}
-func (s *Server) handleUpdateContactRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /workspace
+func (s *Server) handleWorkspaceCreateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateContact"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/contact/{uuid}"),
+		otelogen.OperationID("workspace-create"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/workspace"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateContactOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), WorkspaceCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -20903,15 +31871,15 @@ func (s *Server) handleUpdateContactRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateContactOperation,
-			ID:   "updateContact",
+			Name: WorkspaceCreateOperation,
+			ID:   "workspace-create",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, UpdateContactOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WorkspaceCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -20929,7 +31897,7 @@ func (s *Server) handleUpdateContactRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateContactOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, WorkspaceCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -20947,7 +31915,7 @@ func (s *Server) handleUpdateContactRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, UpdateContactOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, WorkspaceCreateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -20991,17 +31959,7 @@ func (s *Server) handleUpdateContactRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeUpdateContactParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	request, close, err := s.decodeUpdateContactRequest(r)
+	request, close, err := s.decodeWorkspaceCreateRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -21017,27 +31975,22 @@ func (s *Server) handleUpdateContactRequest(args [1]string, argsEscaped bool, w
 		}
 	}()
 
-	var response *Contact
+	var response *Workspace
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateContactOperation,
-			OperationSummary: "Update contact details",
-			OperationID:      "updateContact",
+			OperationName:    WorkspaceCreateOperation,
+			OperationSummary: "",
+			OperationID:      "workspace-create",
 			Body:             request,
-			Params: middleware.Parameters{
-				{
-					Name: "uuid",
-					In:   "path",
-				}: params.UUID,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = *Contact
-			Params   = UpdateContactParams
-			Response = *Contact
+			Request  = *Workspace
+			Params   = struct{}
+			Response = *Workspace
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -21046,14 +31999,14 @@ func (s *Server) handleUpdateContactRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackUpdateContactParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateContact(ctx, request, params)
+				response, err = s.h.WorkspaceCreate(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateContact(ctx, request, params)
+		response, err = s.h.WorkspaceCreate(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -21072,7 +32025,7 @@ func (s *Server) handleUpdateContactRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeUpdateContactResponse(response, w, span); err != nil {
+	if err := encodeWorkspaceCreateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -21081,22 +32034,22 @@ func (s *Server) handleUpdateContactRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleUpdateProfileRequest handles updateProfile operation.
+// handleWorkspaceDeleteRequest handles workspace-delete operation.
 //
-// Update current user profile.
+// Delete a workspace and every resource it owns. Requires the owner role.
 //
-// PUT /profile
-func (s *Server) handleUpdateProfileRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /workspace/{uuid}
+func (s *Server) handleWorkspaceDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateProfile"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/profile"),
+		otelogen.OperationID("workspace-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/workspace/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateProfileOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), WorkspaceDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -21151,15 +32104,15 @@ func (s *Server) handleUpdateProfileRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateProfileOperation,
-			ID:   "updateProfile",
+			Name: WorkspaceDeleteOperation,
+			ID:   "workspace-delete",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, UpdateProfileOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WorkspaceDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -21177,7 +32130,7 @@ func (s *Server) handleUpdateProfileRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateProfileOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, WorkspaceDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -21195,7 +32148,7 @@ func (s *Server) handleUpdateProfileRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, UpdateProfileOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, WorkspaceDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -21239,38 +32192,38 @@ func (s *Server) handleUpdateProfileRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	request, close, err := s.decodeUpdateProfileRequest(r)
+	params, err := decodeWorkspaceDeleteParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *User
+	var response *WorkspaceDeleteOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateProfileOperation,
-			OperationSummary: "Update current user profile",
-			OperationID:      "updateProfile",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    WorkspaceDeleteOperation,
+			OperationSummary: "",
+			OperationID:      "workspace-delete",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *UserProfile
-			Params   = struct{}
-			Response = *User
+			Request  = struct{}
+			Params   = WorkspaceDeleteParams
+			Response = *WorkspaceDeleteOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -21279,14 +32232,14 @@ func (s *Server) handleUpdateProfileRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackWorkspaceDeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateProfile(ctx, request)
+				err = s.h.WorkspaceDelete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateProfile(ctx, request)
+		err = s.h.WorkspaceDelete(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -21305,7 +32258,7 @@ func (s *Server) handleUpdateProfileRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeUpdateProfileResponse(response, w, span); err != nil {
+	if err := encodeWorkspaceDeleteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -21314,22 +32267,22 @@ func (s *Server) handleUpdateProfileRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleUpdateUserRequest handles updateUser operation.
+// handleWorkspaceGetRequest handles workspace-get operation.
 //
-// Update user details.
+// Retrieve a workspace by UUID.
 //
-// PUT /user/{uuid}
-func (s *Server) handleUpdateUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /workspace/{uuid}
+func (s *Server) handleWorkspaceGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateUser"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/user/{uuid}"),
+		otelogen.OperationID("workspace-get"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/workspace/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateUserOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), WorkspaceGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -21384,15 +32337,15 @@ func (s *Server) handleUpdateUserRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateUserOperation,
-			ID:   "updateUser",
+			Name: WorkspaceGetOperation,
+			ID:   "workspace-get",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, UpdateUserOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WorkspaceGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -21410,7 +32363,7 @@ func (s *Server) handleUpdateUserRequest(args [1]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateUserOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, WorkspaceGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -21428,7 +32381,7 @@ func (s *Server) handleUpdateUserRequest(args [1]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, UpdateUserOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, WorkspaceGetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -21472,7 +32425,7 @@ func (s *Server) handleUpdateUserRequest(args [1]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeUpdateUserParams(args, argsEscaped, r)
+	params, err := decodeWorkspaceGetParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -21482,30 +32435,15 @@ func (s *Server) handleUpdateUserRequest(args [1]string, argsEscaped bool, w htt
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeUpdateUserRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *User
+	var response *Workspace
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateUserOperation,
-			OperationSummary: "Update user details",
-			OperationID:      "updateUser",
-			Body:             request,
+			OperationName:    WorkspaceGetOperation,
+			OperationSummary: "",
+			OperationID:      "workspace-get",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "uuid",
@@ -21516,9 +32454,9 @@ func (s *Server) handleUpdateUserRequest(args [1]string, argsEscaped bool, w htt
 		}
 
 		type (
-			Request  = *User
-			Params   = UpdateUserParams
-			Response = *User
+			Request  = struct{}
+			Params   = WorkspaceGetParams
+			Response = *Workspace
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -21527,14 +32465,14 @@ func (s *Server) handleUpdateUserRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackUpdateUserParams,
+			unpackWorkspaceGetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateUser(ctx, request, params)
+				response, err = s.h.WorkspaceGet(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateUser(ctx, request, params)
+		response, err = s.h.WorkspaceGet(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -21553,7 +32491,7 @@ func (s *Server) handleUpdateUserRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeUpdateUserResponse(response, w, span); err != nil {
+	if err := encodeWorkspaceGetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -21562,22 +32500,22 @@ func (s *Server) handleUpdateUserRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleUploadFileRequest handles uploadFile operation.
+// handleWorkspaceListRequest handles workspace-list operation.
 //
-// Upload a file.
+// Retrieve the workspaces of the caller. The bearer token sees every workspace.
 //
-// POST /storage/upload
-func (s *Server) handleUploadFileRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /workspace
+func (s *Server) handleWorkspaceListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("uploadFile"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/storage/upload"),
+		otelogen.OperationID("workspace-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/workspace"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UploadFileOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), WorkspaceListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -21632,15 +32570,15 @@ func (s *Server) handleUploadFileRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UploadFileOperation,
-			ID:   "uploadFile",
+			Name: WorkspaceListOperation,
+			ID:   "workspace-list",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, UploadFileOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WorkspaceListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -21658,7 +32596,7 @@ func (s *Server) handleUploadFileRequest(args [0]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UploadFileOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, WorkspaceListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -21676,7 +32614,7 @@ func (s *Server) handleUploadFileRequest(args [0]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, UploadFileOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, WorkspaceListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -21720,38 +32658,42 @@ func (s *Server) handleUploadFileRequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	request, close, err := s.decodeUploadFileRequest(r)
+	params, err := decodeWorkspaceListParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *UploadFileResponse
+	var response []Workspace
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UploadFileOperation,
-			OperationSummary: "Upload a file",
-			OperationID:      "uploadFile",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    WorkspaceListOperation,
+			OperationSummary: "",
+			OperationID:      "workspace-list",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *UploadFileRequest
-			Params   = struct{}
-			Response = *UploadFileResponse
+			Request  = struct{}
+			Params   = WorkspaceListParams
+			Response = []Workspace
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -21760,14 +32702,14 @@ func (s *Server) handleUploadFileRequest(args [0]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			nil,
+			unpackWorkspaceListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UploadFile(ctx, request)
+				response, err = s.h.WorkspaceList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UploadFile(ctx, request)
+		response, err = s.h.WorkspaceList(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -21786,7 +32728,7 @@ func (s *Server) handleUploadFileRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeUploadFileResponse(response, w, span); err != nil {
+	if err := encodeWorkspaceListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -21795,22 +32737,22 @@ func (s *Server) handleUploadFileRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleWorkerJobsCancelRequest handles worker-jobs-cancel operation.
+// handleWorkspaceMemberDeleteRequest handles workspace-member-delete operation.
 //
-// Signal cancellation for a running job; returns 204 if accepted.
+// Remove a user from a workspace. The last owner cannot be removed.
 //
-// POST /workerjobs/{uuid}/cancel
-func (s *Server) handleWorkerJobsCancelRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /workspace/{uuid}/member/{user_uuid}
+func (s *Server) handleWorkspaceMemberDeleteRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("worker-jobs-cancel"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/workerjobs/{uuid}/cancel"),
+		otelogen.OperationID("workspace-member-delete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/workspace/{uuid}/member/{user_uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), WorkerJobsCancelOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), WorkspaceMemberDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -21865,15 +32807,15 @@ func (s *Server) handleWorkerJobsCancelRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: WorkerJobsCancelOperation,
-			ID:   "worker-jobs-cancel",
+			Name: WorkspaceMemberDeleteOperation,
+			ID:   "workspace-member-delete",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WorkerJobsCancelOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WorkspaceMemberDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -21891,7 +32833,7 @@ func (s *Server) handleWorkerJobsCancelRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, WorkerJobsCancelOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, WorkspaceMemberDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -21909,7 +32851,7 @@ func (s *Server) handleWorkerJobsCancelRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, WorkerJobsCancelOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, WorkspaceMemberDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -21953,7 +32895,7 @@ func (s *Server) handleWorkerJobsCancelRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeWorkerJobsCancelParams(args, argsEscaped, r)
+	params, err := decodeWorkspaceMemberDeleteParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -21964,27 +32906,31 @@ func (s *Server) handleWorkerJobsCancelRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	var response *WorkerJobsCancelNoContent
+	var response *WorkspaceMemberDeleteOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    WorkerJobsCancelOperation,
-			OperationSummary: "Cancel a running worker job",
-			OperationID:      "worker-jobs-cancel",
+			OperationName:    WorkspaceMemberDeleteOperation,
+			OperationSummary: "",
+			OperationID:      "workspace-member-delete",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "uuid",
 					In:   "path",
 				}: params.UUID,
+				{
+					Name: "user_uuid",
+					In:   "path",
+				}: params.UserUUID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = WorkerJobsCancelParams
-			Response = *WorkerJobsCancelNoContent
+			Params   = WorkspaceMemberDeleteParams
+			Response = *WorkspaceMemberDeleteOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -21993,14 +32939,14 @@ func (s *Server) handleWorkerJobsCancelRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackWorkerJobsCancelParams,
+			unpackWorkspaceMemberDeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.WorkerJobsCancel(ctx, params)
+				err = s.h.WorkspaceMemberDelete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.WorkerJobsCancel(ctx, params)
+		err = s.h.WorkspaceMemberDelete(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -22019,7 +32965,7 @@ func (s *Server) handleWorkerJobsCancelRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeWorkerJobsCancelResponse(response, w, span); err != nil {
+	if err := encodeWorkspaceMemberDeleteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -22028,22 +32974,22 @@ func (s *Server) handleWorkerJobsCancelRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleWorkerJobsDeleteRequest handles worker-jobs-delete operation.
+// handleWorkspaceMemberListRequest handles workspace-member-list operation.
 //
-// Delete a worker job by uuid.
+// Retrieve the members of a workspace.
 //
-// DELETE /workerjobs/{uuid}
-func (s *Server) handleWorkerJobsDeleteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /workspace/{uuid}/member
+func (s *Server) handleWorkspaceMemberListRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("worker-jobs-delete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/workerjobs/{uuid}"),
+		otelogen.OperationID("workspace-member-list"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/workspace/{uuid}/member"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), WorkerJobsDeleteOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), WorkspaceMemberListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -22098,15 +33044,15 @@ func (s *Server) handleWorkerJobsDeleteRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: WorkerJobsDeleteOperation,
-			ID:   "worker-jobs-delete",
+			Name: WorkspaceMemberListOperation,
+			ID:   "workspace-member-list",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WorkerJobsDeleteOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WorkspaceMemberListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -22124,7 +33070,7 @@ func (s *Server) handleWorkerJobsDeleteRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, WorkerJobsDeleteOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, WorkspaceMemberListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -22142,7 +33088,7 @@ func (s *Server) handleWorkerJobsDeleteRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, WorkerJobsDeleteOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, WorkspaceMemberListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -22186,7 +33132,7 @@ func (s *Server) handleWorkerJobsDeleteRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeWorkerJobsDeleteParams(args, argsEscaped, r)
+	params, err := decodeWorkspaceMemberListParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -22197,13 +33143,13 @@ func (s *Server) handleWorkerJobsDeleteRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	var response *WorkerJobsDeleteOK
+	var response []WorkspaceMember
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    WorkerJobsDeleteOperation,
+			OperationName:    WorkspaceMemberListOperation,
 			OperationSummary: "",
-			OperationID:      "worker-jobs-delete",
+			OperationID:      "workspace-member-list",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -22216,8 +33162,8 @@ func (s *Server) handleWorkerJobsDeleteRequest(args [1]string, argsEscaped bool,
 
 		type (
 			Request  = struct{}
-			Params   = WorkerJobsDeleteParams
-			Response = *WorkerJobsDeleteOK
+			Params   = WorkspaceMemberListParams
+			Response = []WorkspaceMember
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -22226,14 +33172,14 @@ func (s *Server) handleWorkerJobsDeleteRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackWorkerJobsDeleteParams,
+			unpackWorkspaceMemberListParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.WorkerJobsDelete(ctx, params)
+				response, err = s.h.WorkspaceMemberList(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.WorkerJobsDelete(ctx, params)
+		response, err = s.h.WorkspaceMemberList(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -22252,7 +33198,7 @@ func (s *Server) handleWorkerJobsDeleteRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeWorkerJobsDeleteResponse(response, w, span); err != nil {
+	if err := encodeWorkspaceMemberListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -22261,22 +33207,22 @@ func (s *Server) handleWorkerJobsDeleteRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleWorkerJobsGetRequest handles worker-jobs-get operation.
+// handleWorkspaceMemberSetRequest handles workspace-member-set operation.
 //
-// Retrieve a specific worker job by uuid.
+// Add a user to a workspace or change the role of a member. Requires the owner or admin role.
 //
-// GET /workerjobs/{uuid}
-func (s *Server) handleWorkerJobsGetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /workspace/{uuid}/member
+func (s *Server) handleWorkspaceMemberSetRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("worker-jobs-get"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/workerjobs/{uuid}"),
+		otelogen.OperationID("workspace-member-set"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/workspace/{uuid}/member"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), WorkerJobsGetOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), WorkspaceMemberSetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -22331,15 +33277,15 @@ func (s *Server) handleWorkerJobsGetRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: WorkerJobsGetOperation,
-			ID:   "worker-jobs-get",
+			Name: WorkspaceMemberSetOperation,
+			ID:   "workspace-member-set",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WorkerJobsGetOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WorkspaceMemberSetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -22357,7 +33303,7 @@ func (s *Server) handleWorkerJobsGetRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, WorkerJobsGetOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, WorkspaceMemberSetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -22375,7 +33321,7 @@ func (s *Server) handleWorkerJobsGetRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, WorkerJobsGetOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, WorkspaceMemberSetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -22419,7 +33365,7 @@ func (s *Server) handleWorkerJobsGetRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeWorkerJobsGetParams(args, argsEscaped, r)
+	params, err := decodeWorkspaceMemberSetParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -22429,15 +33375,30 @@ func (s *Server) handleWorkerJobsGetRequest(args [1]string, argsEscaped bool, w
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeWorkspaceMemberSetRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *WorkerJobs
+	var response *WorkspaceMember
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    WorkerJobsGetOperation,
+			OperationName:    WorkspaceMemberSetOperation,
 			OperationSummary: "",
-			OperationID:      "worker-jobs-get",
-			Body:             nil,
+			OperationID:      "workspace-member-set",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "uuid",
@@ -22448,9 +33409,9 @@ func (s *Server) handleWorkerJobsGetRequest(args [1]string, argsEscaped bool, w
 		}
 
 		type (
-			Request  = struct{}
-			Params   = WorkerJobsGetParams
-			Response = *WorkerJobs
+			Request  = *WorkspaceMember
+			Params   = WorkspaceMemberSetParams
+			Response = *WorkspaceMember
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -22459,14 +33420,14 @@ func (s *Server) handleWorkerJobsGetRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackWorkerJobsGetParams,
+			unpackWorkspaceMemberSetParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.WorkerJobsGet(ctx, params)
+				response, err = s.h.WorkspaceMemberSet(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.WorkerJobsGet(ctx, params)
+		response, err = s.h.WorkspaceMemberSet(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -22485,7 +33446,7 @@ func (s *Server) handleWorkerJobsGetRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeWorkerJobsGetResponse(response, w, span); err != nil {
+	if err := encodeWorkspaceMemberSetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -22494,22 +33455,22 @@ func (s *Server) handleWorkerJobsGetRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleWorkerJobsListRequest handles worker-jobs-list operation.
+// handleWorkspaceUpdateRequest handles workspace-update operation.
 //
-// Retrieve a list of worker jobs.
+// Update a workspace by UUID. Requires the owner or admin role.
 //
-// GET /workerjobs
-func (s *Server) handleWorkerJobsListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /workspace/{uuid}
+func (s *Server) handleWorkspaceUpdateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("worker-jobs-list"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/workerjobs"),
+		otelogen.OperationID("workspace-update"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/workspace/{uuid}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), WorkerJobsListOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), WorkspaceUpdateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -22564,15 +33525,15 @@ func (s *Server) handleWorkerJobsListRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: WorkerJobsListOperation,
-			ID:   "worker-jobs-list",
+			Name: WorkspaceUpdateOperation,
+			ID:   "workspace-update",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WorkerJobsListOperation, r)
+			sctx, ok, err := s.securityZitadelCookieAuth(ctx, WorkspaceUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -22590,7 +33551,7 @@ func (s *Server) handleWorkerJobsListRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, WorkerJobsListOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, WorkspaceUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -22608,7 +33569,7 @@ func (s *Server) handleWorkerJobsListRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityPlainCookieAuth(ctx, WorkerJobsListOperation, r)
+			sctx, ok, err := s.securityPlainCookieAuth(ctx, WorkspaceUpdateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -22652,7 +33613,7 @@ func (s *Server) handleWorkerJobsListRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeWorkerJobsListParams(args, argsEscaped, r)
+	params, err := decodeWorkspaceUpdateParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -22662,32 +33623,43 @@ func (s *Server) handleWorkerJobsListRequest(args [0]string, argsEscaped bool, w
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeWorkspaceUpdateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *WorkerJobsListOK
+	var response *Workspace
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    WorkerJobsListOperation,
+			OperationName:    WorkspaceUpdateOperation,
 			OperationSummary: "",
-			OperationID:      "worker-jobs-list",
-			Body:             nil,
+			OperationID:      "workspace-update",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
+					Name: "uuid",
+					In:   "path",
+				}: params.UUID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = WorkerJobsListParams
-			Response = *WorkerJobsListOK
+			Request  = *Workspace
+			Params   = WorkspaceUpdateParams
+			Response = *Workspace
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -22696,14 +33668,14 @@ func (s *Server) handleWorkerJobsListRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackWorkerJobsListParams,
+			unpackWorkspaceUpdateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.WorkerJobsList(ctx, params)
+				response, err = s.h.WorkspaceUpdate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.WorkerJobsList(ctx, params)
+		response, err = s.h.WorkspaceUpdate(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -22722,7 +33694,7 @@ func (s *Server) handleWorkerJobsListRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeWorkerJobsListResponse(response, w, span); err != nil {
+	if err := encodeWorkspaceUpdateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -15,14 +15,14 @@ import (
 )
 
 // Encode implements json.Marshaler.
-func (s *Contact) Encode(e *jx.Encoder) {
+func (s *APIKey) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Contact) encodeFields(e *jx.Encoder) {
+func (s *APIKey) encodeFields(e *jx.Encoder) {
 	{
 		if s.UUID.Set {
 			e.FieldStart("uuid")
@@ -30,2845 +30,7722 @@ func (s *Contact) encodeFields(e *jx.Encoder) {
 		}
 	}
 	{
-		if s.UserUUID.Set {
-			e.FieldStart("user_uuid")
-			s.UserUUID.Encode(e)
-		}
-	}
-	{
-		if s.InstanceUUID.Set {
-			e.FieldStart("instance_uuid")
-			s.InstanceUUID.Encode(e)
-		}
-	}
-	{
-		if s.Status.Set {
-			e.FieldStart("status")
-			s.Status.Encode(e)
-		}
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 	{
-		if s.Names != nil {
-			e.FieldStart("names")
-			s.Names.Encode(e)
+		if s.Key.Set {
+			e.FieldStart("key")
+			s.Key.Encode(e)
 		}
 	}
 	{
-		if s.NamesSearch.Set {
-			e.FieldStart("names_search")
-			s.NamesSearch.Encode(e)
+		if s.Prefix.Set {
+			e.FieldStart("prefix")
+			s.Prefix.Encode(e)
 		}
 	}
 	{
-		if s.Last.Set {
-			e.FieldStart("last")
-			s.Last.Encode(e)
+		if s.UserUUID.Set {
+			e.FieldStart("user_uuid")
+			s.UserUUID.Encode(e)
 		}
 	}
 	{
-		if s.First.Set {
-			e.FieldStart("first")
-			s.First.Encode(e)
+		if s.ServiceAccount.Set {
+			e.FieldStart("service_account")
+			s.ServiceAccount.Encode(e)
 		}
 	}
 	{
-		if s.Middle.Set {
-			e.FieldStart("middle")
-			s.Middle.Encode(e)
+		if s.Role.Set {
+			e.FieldStart("role")
+			s.Role.Encode(e)
 		}
 	}
 	{
-		if s.Birthday.Set {
-			e.FieldStart("birthday")
-			s.Birthday.Encode(e, json.EncodeDateTime)
+		e.FieldStart("scopes")
+		e.ArrStart()
+		for _, elem := range s.Scopes {
+			e.Str(elem)
 		}
+		e.ArrEnd()
 	}
 	{
-		if s.BirthdayType.Set {
-			e.FieldStart("birthday_type")
-			s.BirthdayType.Encode(e)
+		if s.AllowedIps != nil {
+			e.FieldStart("allowed_ips")
+			e.ArrStart()
+			for _, elem := range s.AllowedIps {
+				e.Str(elem)
+			}
+			e.ArrEnd()
 		}
 	}
 	{
-		if s.Salary.Set {
-			e.FieldStart("salary")
-			s.Salary.Encode(e)
+		if s.ExpiresAt.Set {
+			e.FieldStart("expires_at")
+			s.ExpiresAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.SalaryData != nil {
-			e.FieldStart("salary_data")
-			s.SalaryData.Encode(e)
+		if s.LastUsedAt.Set {
+			e.FieldStart("last_used_at")
+			s.LastUsedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.LastPositions != nil {
-			e.FieldStart("last_positions")
-			s.LastPositions.Encode(e)
+		if s.LastUsedIP.Set {
+			e.FieldStart("last_used_ip")
+			s.LastUsedIP.Encode(e)
 		}
 	}
 	{
-		if s.LastPositionID.Set {
-			e.FieldStart("last_position_id")
-			s.LastPositionID.Encode(e)
+		if s.RevokedAt.Set {
+			e.FieldStart("revoked_at")
+			s.RevokedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.LastPositionCompanyID.Set {
-			e.FieldStart("last_position_company_id")
-			s.LastPositionCompanyID.Encode(e)
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
-	{
-		if s.LastPositionCompanyName.Set {
-			e.FieldStart("last_position_company_name")
-			s.LastPositionCompanyName.Encode(e)
-		}
+}
+
+var jsonFieldsNameOfAPIKey = [14]string{
+	0:  "uuid",
+	1:  "name",
+	2:  "key",
+	3:  "prefix",
+	4:  "user_uuid",
+	5:  "service_account",
+	6:  "role",
+	7:  "scopes",
+	8:  "allowed_ips",
+	9:  "expires_at",
+	10: "last_used_at",
+	11: "last_used_ip",
+	12: "revoked_at",
+	13: "created_at",
+}
+
+// Decode decodes APIKey from json.
+func (s *APIKey) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode APIKey to nil")
 	}
-	{
-		if s.LastPositionTitle.Set {
-			e.FieldStart("last_position_title")
-			s.LastPositionTitle.Encode(e)
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "uuid":
+			if err := func() error {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uuid\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "key":
+			if err := func() error {
+				s.Key.Reset()
+				if err := s.Key.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"key\"")
+			}
+		case "prefix":
+			if err := func() error {
+				s.Prefix.Reset()
+				if err := s.Prefix.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"prefix\"")
+			}
+		case "user_uuid":
+			if err := func() error {
+				s.UserUUID.Reset()
+				if err := s.UserUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"user_uuid\"")
+			}
+		case "service_account":
+			if err := func() error {
+				s.ServiceAccount.Reset()
+				if err := s.ServiceAccount.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"service_account\"")
+			}
+		case "role":
+			if err := func() error {
+				s.Role.Reset()
+				if err := s.Role.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"role\"")
+			}
+		case "scopes":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				s.Scopes = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Scopes = append(s.Scopes, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scopes\"")
+			}
+		case "allowed_ips":
+			if err := func() error {
+				s.AllowedIps = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.AllowedIps = append(s.AllowedIps, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"allowed_ips\"")
+			}
+		case "expires_at":
+			if err := func() error {
+				s.ExpiresAt.Reset()
+				if err := s.ExpiresAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"expires_at\"")
+			}
+		case "last_used_at":
+			if err := func() error {
+				s.LastUsedAt.Reset()
+				if err := s.LastUsedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_used_at\"")
+			}
+		case "last_used_ip":
+			if err := func() error {
+				s.LastUsedIP.Reset()
+				if err := s.LastUsedIP.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_used_ip\"")
+			}
+		case "revoked_at":
+			if err := func() error {
+				s.RevokedAt.Reset()
+				if err := s.RevokedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"revoked_at\"")
+			}
+		case "created_at":
+			if err := func() error {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		default:
+			return errors.Errorf("unexpected field %q", k)
 		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode APIKey")
 	}
-	{
-		if s.LastPositionStartDate.Set {
-			e.FieldStart("last_position_start_date")
-			s.LastPositionStartDate.Encode(e, json.EncodeDateTime)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b10000010,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfAPIKey) {
+					name = jsonFieldsNameOfAPIKey[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
 		}
 	}
-	{
-		if s.LastPositionEndDate.Set {
-			e.FieldStart("last_position_end_date")
-			s.LastPositionEndDate.Encode(e, json.EncodeDateTime)
-		}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
-	{
-		if s.LastPositionEndNow.Set {
-			e.FieldStart("last_position_end_now")
-			s.LastPositionEndNow.Encode(e)
-		}
-	}
-	{
-		if s.LastPositionDescription.Set {
-			e.FieldStart("last_position_description")
-			s.LastPositionDescription.Encode(e)
-		}
-	}
-	{
-		if s.NoteSearch.Set {
-			e.FieldStart("note_search")
-			s.NoteSearch.Encode(e)
-		}
-	}
-	{
-		if s.NoteKpiID != nil {
-			e.FieldStart("note_kpi_id")
-			s.NoteKpiID.Encode(e)
-		}
-	}
-	{
-		if s.Phones != nil {
-			e.FieldStart("phones")
-			s.Phones.Encode(e)
-		}
-	}
-	{
-		if s.PhoneSearch.Set {
-			e.FieldStart("phone_search")
-			s.PhoneSearch.Encode(e)
-		}
-	}
-	{
-		if s.Phone1.Set {
-			e.FieldStart("phone1")
-			s.Phone1.Encode(e)
-		}
-	}
-	{
-		if s.Phone1Type.Set {
-			e.FieldStart("phone1_type")
-			s.Phone1Type.Encode(e)
-		}
-	}
-	{
-		if s.Phone1Country.Set {
-			e.FieldStart("phone1_country")
-			s.Phone1Country.Encode(e)
-		}
-	}
-	{
-		if s.Phone2.Set {
-			e.FieldStart("phone2")
-			s.Phone2.Encode(e)
-		}
-	}
-	{
-		if s.Phone2Type.Set {
-			e.FieldStart("phone2_type")
-			s.Phone2Type.Encode(e)
-		}
-	}
-	{
-		if s.Phone2Country.Set {
-			e.FieldStart("phone2_country")
-			s.Phone2Country.Encode(e)
-		}
-	}
-	{
-		if s.Phone3.Set {
-			e.FieldStart("phone3")
-			s.Phone3.Encode(e)
-		}
-	}
-	{
-		if s.Phone3Type.Set {
-			e.FieldStart("phone3_type")
-			s.Phone3Type.Encode(e)
-		}
-	}
-	{
-		if s.Phone3Country.Set {
-			e.FieldStart("phone3_country")
-			s.Phone3Country.Encode(e)
-		}
-	}
-	{
-		if s.Phone4.Set {
-			e.FieldStart("phone4")
-			s.Phone4.Encode(e)
-		}
-	}
-	{
-		if s.Phone4Type.Set {
-			e.FieldStart("phone4_type")
-			s.Phone4Type.Encode(e)
-		}
-	}
-	{
-		if s.Phone4Country.Set {
-			e.FieldStart("phone4_country")
-			s.Phone4Country.Encode(e)
-		}
-	}
-	{
-		if s.Phone5.Set {
-			e.FieldStart("phone5")
-			s.Phone5.Encode(e)
-		}
-	}
-	{
-		if s.Phone5Type.Set {
-			e.FieldStart("phone5_type")
-			s.Phone5Type.Encode(e)
-		}
-	}
-	{
-		if s.Phone5Country.Set {
-			e.FieldStart("phone5_country")
-			s.Phone5Country.Encode(e)
-		}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *APIKey) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *APIKey) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes APIKeyRole as json.
+func (s APIKeyRole) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes APIKeyRole from json.
+func (s *APIKeyRole) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode APIKeyRole to nil")
 	}
-	{
-		if s.Emails != nil {
-			e.FieldStart("emails")
-			s.Emails.Encode(e)
-		}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	{
-		if s.EmailSearch.Set {
-			e.FieldStart("email_search")
-			s.EmailSearch.Encode(e)
-		}
+	// Try to use constant string.
+	switch APIKeyRole(v) {
+	case APIKeyRoleAdmin:
+		*s = APIKeyRoleAdmin
+	case APIKeyRoleOperator:
+		*s = APIKeyRoleOperator
+	case APIKeyRoleReadonly:
+		*s = APIKeyRoleReadonly
+	case APIKeyRoleAPIClient:
+		*s = APIKeyRoleAPIClient
+	default:
+		*s = APIKeyRole(v)
 	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s APIKeyRole) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *APIKeyRole) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *AuditEvent) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *AuditEvent) encodeFields(e *jx.Encoder) {
 	{
-		if s.Email1.Set {
-			e.FieldStart("email1")
-			s.Email1.Encode(e)
-		}
+		e.FieldStart("uuid")
+		json.EncodeUUID(e, s.UUID)
 	}
 	{
-		if s.Email1Type.Set {
-			e.FieldStart("email1_type")
-			s.Email1Type.Encode(e)
+		if s.WorkspaceUUID.Set {
+			e.FieldStart("workspace_uuid")
+			s.WorkspaceUUID.Encode(e)
 		}
 	}
 	{
-		if s.Email2.Set {
-			e.FieldStart("email2")
-			s.Email2.Encode(e)
-		}
+		e.FieldStart("occurred_at")
+		json.EncodeDateTime(e, s.OccurredAt)
 	}
 	{
-		if s.Email2Type.Set {
-			e.FieldStart("email2_type")
-			s.Email2Type.Encode(e)
-		}
+		e.FieldStart("actor")
+		e.Str(s.Actor)
 	}
 	{
-		if s.Email3.Set {
-			e.FieldStart("email3")
-			s.Email3.Encode(e)
-		}
+		e.FieldStart("source")
+		e.Str(s.Source)
 	}
 	{
-		if s.Email3Type.Set {
-			e.FieldStart("email3_type")
-			s.Email3Type.Encode(e)
+		if s.APIKeyUUID.Set {
+			e.FieldStart("api_key_uuid")
+			s.APIKeyUUID.Encode(e)
 		}
 	}
 	{
-		if s.Email4.Set {
-			e.FieldStart("email4")
-			s.Email4.Encode(e)
-		}
+		e.FieldStart("operation_id")
+		e.Str(s.OperationID)
 	}
 	{
-		if s.Email4Type.Set {
-			e.FieldStart("email4_type")
-			s.Email4Type.Encode(e)
+		if s.ResourceKind.Set {
+			e.FieldStart("resource_kind")
+			s.ResourceKind.Encode(e)
 		}
 	}
 	{
-		if s.Email5.Set {
-			e.FieldStart("email5")
-			s.Email5.Encode(e)
+		if s.Action.Set {
+			e.FieldStart("action")
+			s.Action.Encode(e)
 		}
 	}
 	{
-		if s.Email5Type.Set {
-			e.FieldStart("email5_type")
-			s.Email5Type.Encode(e)
+		if s.ResourceID.Set {
+			e.FieldStart("resource_id")
+			s.ResourceID.Encode(e)
 		}
 	}
 	{
-		if s.Messengers != nil {
-			e.FieldStart("messengers")
-			s.Messengers.Encode(e)
+		if s.Params.Set {
+			e.FieldStart("params")
+			s.Params.Encode(e)
 		}
 	}
 	{
-		if s.MessengersSearch.Set {
-			e.FieldStart("messengers_search")
-			s.MessengersSearch.Encode(e)
+		if s.Diff.Set {
+			e.FieldStart("diff")
+			s.Diff.Encode(e)
 		}
 	}
 	{
-		if s.SkypeUUID.Set {
-			e.FieldStart("skype_uuid")
-			s.SkypeUUID.Encode(e)
-		}
+		e.FieldStart("outcome")
+		s.Outcome.Encode(e)
 	}
 	{
-		if s.Skype.Set {
-			e.FieldStart("skype")
-			s.Skype.Encode(e)
-		}
+		e.FieldStart("status")
+		e.Int32(s.Status)
 	}
 	{
-		if s.WhatsappUUID.Set {
-			e.FieldStart("whatsapp_uuid")
-			s.WhatsappUUID.Encode(e)
+		if s.Error.Set {
+			e.FieldStart("error")
+			s.Error.Encode(e)
 		}
 	}
 	{
-		if s.Whatsapp.Set {
-			e.FieldStart("whatsapp")
-			s.Whatsapp.Encode(e)
+		if s.IP.Set {
+			e.FieldStart("ip")
+			s.IP.Encode(e)
 		}
 	}
 	{
-		if s.TelegramUUID.Set {
-			e.FieldStart("telegram_uuid")
-			s.TelegramUUID.Encode(e)
+		if s.UserAgent.Set {
+			e.FieldStart("user_agent")
+			s.UserAgent.Encode(e)
 		}
 	}
 	{
-		if s.Telegram.Set {
-			e.FieldStart("telegram")
-			s.Telegram.Encode(e)
+		if s.DurationMs.Set {
+			e.FieldStart("duration_ms")
+			s.DurationMs.Encode(e)
 		}
 	}
-	{
-		if s.WechatUUID.Set {
-			e.FieldStart("wechat_uuid")
-			s.WechatUUID.Encode(e)
-		}
+}
+
+var jsonFieldsNameOfAuditEvent = [18]string{
+	0:  "uuid",
+	1:  "workspace_uuid",
+	2:  "occurred_at",
+	3:  "actor",
+	4:  "source",
+	5:  "api_key_uuid",
+	6:  "operation_id",
+	7:  "resource_kind",
+	8:  "action",
+	9:  "resource_id",
+	10: "params",
+	11: "diff",
+	12: "outcome",
+	13: "status",
+	14: "error",
+	15: "ip",
+	16: "user_agent",
+	17: "duration_ms",
+}
+
+// Decode decodes AuditEvent from json.
+func (s *AuditEvent) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode AuditEvent to nil")
 	}
-	{
-		if s.Wechat.Set {
-			e.FieldStart("wechat")
-			s.Wechat.Encode(e)
-		}
-	}
-	{
-		if s.LineUUID.Set {
-			e.FieldStart("line_uuid")
-			s.LineUUID.Encode(e)
-		}
-	}
-	{
-		if s.Line.Set {
-			e.FieldStart("line")
-			s.Line.Encode(e)
-		}
-	}
-	{
-		if s.Socials != nil {
-			e.FieldStart("socials")
-			s.Socials.Encode(e)
-		}
-	}
-	{
-		if s.SocialsSearch.Set {
-			e.FieldStart("socials_search")
-			s.SocialsSearch.Encode(e)
+	var requiredBitSet [3]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "uuid":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.UUID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uuid\"")
+			}
+		case "workspace_uuid":
+			if err := func() error {
+				s.WorkspaceUUID.Reset()
+				if err := s.WorkspaceUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"workspace_uuid\"")
+			}
+		case "occurred_at":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.OccurredAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"occurred_at\"")
+			}
+		case "actor":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.Actor = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"actor\"")
+			}
+		case "source":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.Source = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"source\"")
+			}
+		case "api_key_uuid":
+			if err := func() error {
+				s.APIKeyUUID.Reset()
+				if err := s.APIKeyUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"api_key_uuid\"")
+			}
+		case "operation_id":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Str()
+				s.OperationID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"operation_id\"")
+			}
+		case "resource_kind":
+			if err := func() error {
+				s.ResourceKind.Reset()
+				if err := s.ResourceKind.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resource_kind\"")
+			}
+		case "action":
+			if err := func() error {
+				s.Action.Reset()
+				if err := s.Action.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"action\"")
+			}
+		case "resource_id":
+			if err := func() error {
+				s.ResourceID.Reset()
+				if err := s.ResourceID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resource_id\"")
+			}
+		case "params":
+			if err := func() error {
+				s.Params.Reset()
+				if err := s.Params.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"params\"")
+			}
+		case "diff":
+			if err := func() error {
+				s.Diff.Reset()
+				if err := s.Diff.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"diff\"")
+			}
+		case "outcome":
+			requiredBitSet[1] |= 1 << 4
+			if err := func() error {
+				if err := s.Outcome.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"outcome\"")
+			}
+		case "status":
+			requiredBitSet[1] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int32()
+				s.Status = int32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "error":
+			if err := func() error {
+				s.Error.Reset()
+				if err := s.Error.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"error\"")
+			}
+		case "ip":
+			if err := func() error {
+				s.IP.Reset()
+				if err := s.IP.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ip\"")
+			}
+		case "user_agent":
+			if err := func() error {
+				s.UserAgent.Reset()
+				if err := s.UserAgent.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"user_agent\"")
+			}
+		case "duration_ms":
+			if err := func() error {
+				s.DurationMs.Reset()
+				if err := s.DurationMs.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration_ms\"")
+			}
+		default:
+			return errors.Errorf("unexpected field %q", k)
 		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode AuditEvent")
 	}
-	{
-		if s.LinkedinUUID.Set {
-			e.FieldStart("linkedin_uuid")
-			s.LinkedinUUID.Encode(e)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [3]uint8{
+		0b01011101,
+		0b00110000,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfAuditEvent) {
+					name = jsonFieldsNameOfAuditEvent[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
 		}
 	}
-	{
-		if s.LinkedinURL.Set {
-			e.FieldStart("linkedin_url")
-			s.LinkedinURL.Encode(e)
-		}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
-	{
-		if s.FacebookUUID.Set {
-			e.FieldStart("facebook_uuid")
-			s.FacebookUUID.Encode(e)
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *AuditEvent) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *AuditEvent) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s AuditEventDiff) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s AuditEventDiff) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
 		}
 	}
-	{
-		if s.FacebookURL.Set {
-			e.FieldStart("facebook_url")
-			s.FacebookURL.Encode(e)
-		}
+}
+
+// Decode decodes AuditEventDiff from json.
+func (s *AuditEventDiff) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode AuditEventDiff to nil")
 	}
-	{
-		if s.TwitterUUID.Set {
-			e.FieldStart("twitter_uuid")
-			s.TwitterUUID.Encode(e)
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode AuditEventDiff")
 	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s AuditEventDiff) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *AuditEventDiff) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes AuditEventOutcome as json.
+func (s AuditEventOutcome) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes AuditEventOutcome from json.
+func (s *AuditEventOutcome) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode AuditEventOutcome to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch AuditEventOutcome(v) {
+	case AuditEventOutcomeSuccess:
+		*s = AuditEventOutcomeSuccess
+	case AuditEventOutcomeDenied:
+		*s = AuditEventOutcomeDenied
+	case AuditEventOutcomeUnauthenticated:
+		*s = AuditEventOutcomeUnauthenticated
+	case AuditEventOutcomeError:
+		*s = AuditEventOutcomeError
+	default:
+		*s = AuditEventOutcome(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s AuditEventOutcome) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *AuditEventOutcome) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s AuditEventParams) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s AuditEventParams) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes AuditEventParams from json.
+func (s *AuditEventParams) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode AuditEventParams to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode AuditEventParams")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s AuditEventParams) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *AuditEventParams) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Contact) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Contact) encodeFields(e *jx.Encoder) {
 	{
-		if s.TwitterURL.Set {
-			e.FieldStart("twitter_url")
-			s.TwitterURL.Encode(e)
+		if s.UUID.Set {
+			e.FieldStart("uuid")
+			s.UUID.Encode(e)
 		}
 	}
 	{
-		if s.GithubUUID.Set {
-			e.FieldStart("github_uuid")
-			s.GithubUUID.Encode(e)
+		if s.UserUUID.Set {
+			e.FieldStart("user_uuid")
+			s.UserUUID.Encode(e)
 		}
 	}
 	{
-		if s.GithubURL.Set {
-			e.FieldStart("github_url")
-			s.GithubURL.Encode(e)
+		if s.InstanceUUID.Set {
+			e.FieldStart("instance_uuid")
+			s.InstanceUUID.Encode(e)
 		}
 	}
 	{
-		if s.VkUUID.Set {
-			e.FieldStart("vk_uuid")
-			s.VkUUID.Encode(e)
+		if s.Status.Set {
+			e.FieldStart("status")
+			s.Status.Encode(e)
 		}
 	}
 	{
-		if s.VkURL.Set {
-			e.FieldStart("vk_url")
-			s.VkURL.Encode(e)
+		if s.Names != nil {
+			e.FieldStart("names")
+			s.Names.Encode(e)
 		}
 	}
 	{
-		if s.OdnoUUID.Set {
-			e.FieldStart("odno_uuid")
-			s.OdnoUUID.Encode(e)
+		if s.NamesSearch.Set {
+			e.FieldStart("names_search")
+			s.NamesSearch.Encode(e)
 		}
 	}
 	{
-		if s.OdnoURL.Set {
-			e.FieldStart("odno_url")
-			s.OdnoURL.Encode(e)
+		if s.Last.Set {
+			e.FieldStart("last")
+			s.Last.Encode(e)
 		}
 	}
 	{
-		if s.HhruUUID.Set {
-			e.FieldStart("hhru_uuid")
-			s.HhruUUID.Encode(e)
+		if s.First.Set {
+			e.FieldStart("first")
+			s.First.Encode(e)
 		}
 	}
 	{
-		if s.HhruURL.Set {
-			e.FieldStart("hhru_url")
-			s.HhruURL.Encode(e)
+		if s.Middle.Set {
+			e.FieldStart("middle")
+			s.Middle.Encode(e)
 		}
 	}
 	{
-		if s.HabrUUID.Set {
-			e.FieldStart("habr_uuid")
-			s.HabrUUID.Encode(e)
+		if s.Birthday.Set {
+			e.FieldStart("birthday")
+			s.Birthday.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.HabrURL.Set {
-			e.FieldStart("habr_url")
-			s.HabrURL.Encode(e)
+		if s.BirthdayType.Set {
+			e.FieldStart("birthday_type")
+			s.BirthdayType.Encode(e)
 		}
 	}
 	{
-		if s.MoikrugUUID.Set {
-			e.FieldStart("moikrug_uuid")
-			s.MoikrugUUID.Encode(e)
+		if s.Salary.Set {
+			e.FieldStart("salary")
+			s.Salary.Encode(e)
 		}
 	}
 	{
-		if s.MoikrugURL.Set {
-			e.FieldStart("moikrug_url")
-			s.MoikrugURL.Encode(e)
+		if s.SalaryData != nil {
+			e.FieldStart("salary_data")
+			s.SalaryData.Encode(e)
 		}
 	}
 	{
-		if s.InstagramUUID.Set {
-			e.FieldStart("instagram_uuid")
-			s.InstagramUUID.Encode(e)
+		if s.LastPositions != nil {
+			e.FieldStart("last_positions")
+			s.LastPositions.Encode(e)
 		}
 	}
 	{
-		if s.InstagramURL.Set {
-			e.FieldStart("instagram_url")
-			s.InstagramURL.Encode(e)
+		if s.LastPositionID.Set {
+			e.FieldStart("last_position_id")
+			s.LastPositionID.Encode(e)
 		}
 	}
 	{
-		if s.Social1UUID.Set {
-			e.FieldStart("social1_uuid")
-			s.Social1UUID.Encode(e)
+		if s.LastPositionCompanyID.Set {
+			e.FieldStart("last_position_company_id")
+			s.LastPositionCompanyID.Encode(e)
 		}
 	}
 	{
-		if s.Social1URL.Set {
-			e.FieldStart("social1_url")
-			s.Social1URL.Encode(e)
+		if s.LastPositionCompanyName.Set {
+			e.FieldStart("last_position_company_name")
+			s.LastPositionCompanyName.Encode(e)
 		}
 	}
 	{
-		if s.Social1Type.Set {
-			e.FieldStart("social1_type")
-			s.Social1Type.Encode(e)
+		if s.LastPositionTitle.Set {
+			e.FieldStart("last_position_title")
+			s.LastPositionTitle.Encode(e)
 		}
 	}
 	{
-		if s.Social2UUID.Set {
-			e.FieldStart("social2_uuid")
-			s.Social2UUID.Encode(e)
+		if s.LastPositionStartDate.Set {
+			e.FieldStart("last_position_start_date")
+			s.LastPositionStartDate.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.Social2URL.Set {
-			e.FieldStart("social2_url")
-			s.Social2URL.Encode(e)
+		if s.LastPositionEndDate.Set {
+			e.FieldStart("last_position_end_date")
+			s.LastPositionEndDate.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.Social2Type.Set {
-			e.FieldStart("social2_type")
-			s.Social2Type.Encode(e)
+		if s.LastPositionEndNow.Set {
+			e.FieldStart("last_position_end_now")
+			s.LastPositionEndNow.Encode(e)
 		}
 	}
 	{
-		if s.Social3UUID.Set {
-			e.FieldStart("social3_uuid")
-			s.Social3UUID.Encode(e)
+		if s.LastPositionDescription.Set {
+			e.FieldStart("last_position_description")
+			s.LastPositionDescription.Encode(e)
 		}
 	}
 	{
-		if s.Social3URL.Set {
-			e.FieldStart("social3_url")
-			s.Social3URL.Encode(e)
+		if s.NoteSearch.Set {
+			e.FieldStart("note_search")
+			s.NoteSearch.Encode(e)
+		}
+	}
+	{
+		if s.NoteKpiID != nil {
+			e.FieldStart("note_kpi_id")
+			s.NoteKpiID.Encode(e)
+		}
+	}
+	{
+		if s.Phones != nil {
+			e.FieldStart("phones")
+			s.Phones.Encode(e)
+		}
+	}
+	{
+		if s.PhoneSearch.Set {
+			e.FieldStart("phone_search")
+			s.PhoneSearch.Encode(e)
+		}
+	}
+	{
+		if s.Phone1.Set {
+			e.FieldStart("phone1")
+			s.Phone1.Encode(e)
+		}
+	}
+	{
+		if s.Phone1Type.Set {
+			e.FieldStart("phone1_type")
+			s.Phone1Type.Encode(e)
+		}
+	}
+	{
+		if s.Phone1Country.Set {
+			e.FieldStart("phone1_country")
+			s.Phone1Country.Encode(e)
+		}
+	}
+	{
+		if s.Phone2.Set {
+			e.FieldStart("phone2")
+			s.Phone2.Encode(e)
+		}
+	}
+	{
+		if s.Phone2Type.Set {
+			e.FieldStart("phone2_type")
+			s.Phone2Type.Encode(e)
+		}
+	}
+	{
+		if s.Phone2Country.Set {
+			e.FieldStart("phone2_country")
+			s.Phone2Country.Encode(e)
+		}
+	}
+	{
+		if s.Phone3.Set {
+			e.FieldStart("phone3")
+			s.Phone3.Encode(e)
+		}
+	}
+	{
+		if s.Phone3Type.Set {
+			e.FieldStart("phone3_type")
+			s.Phone3Type.Encode(e)
+		}
+	}
+	{
+		if s.Phone3Country.Set {
+			e.FieldStart("phone3_country")
+			s.Phone3Country.Encode(e)
+		}
+	}
+	{
+		if s.Phone4.Set {
+			e.FieldStart("phone4")
+			s.Phone4.Encode(e)
+		}
+	}
+	{
+		if s.Phone4Type.Set {
+			e.FieldStart("phone4_type")
+			s.Phone4Type.Encode(e)
+		}
+	}
+	{
+		if s.Phone4Country.Set {
+			e.FieldStart("phone4_country")
+			s.Phone4Country.Encode(e)
+		}
+	}
+	{
+		if s.Phone5.Set {
+			e.FieldStart("phone5")
+			s.Phone5.Encode(e)
+		}
+	}
+	{
+		if s.Phone5Type.Set {
+			e.FieldStart("phone5_type")
+			s.Phone5Type.Encode(e)
+		}
+	}
+	{
+		if s.Phone5Country.Set {
+			e.FieldStart("phone5_country")
+			s.Phone5Country.Encode(e)
+		}
+	}
+	{
+		if s.Emails != nil {
+			e.FieldStart("emails")
+			s.Emails.Encode(e)
+		}
+	}
+	{
+		if s.EmailSearch.Set {
+			e.FieldStart("email_search")
+			s.EmailSearch.Encode(e)
+		}
+	}
+	{
+		if s.Email1.Set {
+			e.FieldStart("email1")
+			s.Email1.Encode(e)
+		}
+	}
+	{
+		if s.Email1Type.Set {
+			e.FieldStart("email1_type")
+			s.Email1Type.Encode(e)
+		}
+	}
+	{
+		if s.Email2.Set {
+			e.FieldStart("email2")
+			s.Email2.Encode(e)
+		}
+	}
+	{
+		if s.Email2Type.Set {
+			e.FieldStart("email2_type")
+			s.Email2Type.Encode(e)
+		}
+	}
+	{
+		if s.Email3.Set {
+			e.FieldStart("email3")
+			s.Email3.Encode(e)
+		}
+	}
+	{
+		if s.Email3Type.Set {
+			e.FieldStart("email3_type")
+			s.Email3Type.Encode(e)
+		}
+	}
+	{
+		if s.Email4.Set {
+			e.FieldStart("email4")
+			s.Email4.Encode(e)
+		}
+	}
+	{
+		if s.Email4Type.Set {
+			e.FieldStart("email4_type")
+			s.Email4Type.Encode(e)
+		}
+	}
+	{
+		if s.Email5.Set {
+			e.FieldStart("email5")
+			s.Email5.Encode(e)
+		}
+	}
+	{
+		if s.Email5Type.Set {
+			e.FieldStart("email5_type")
+			s.Email5Type.Encode(e)
+		}
+	}
+	{
+		if s.Messengers != nil {
+			e.FieldStart("messengers")
+			s.Messengers.Encode(e)
+		}
+	}
+	{
+		if s.MessengersSearch.Set {
+			e.FieldStart("messengers_search")
+			s.MessengersSearch.Encode(e)
+		}
+	}
+	{
+		if s.SkypeUUID.Set {
+			e.FieldStart("skype_uuid")
+			s.SkypeUUID.Encode(e)
+		}
+	}
+	{
+		if s.Skype.Set {
+			e.FieldStart("skype")
+			s.Skype.Encode(e)
+		}
+	}
+	{
+		if s.WhatsappUUID.Set {
+			e.FieldStart("whatsapp_uuid")
+			s.WhatsappUUID.Encode(e)
+		}
+	}
+	{
+		if s.Whatsapp.Set {
+			e.FieldStart("whatsapp")
+			s.Whatsapp.Encode(e)
+		}
+	}
+	{
+		if s.TelegramUUID.Set {
+			e.FieldStart("telegram_uuid")
+			s.TelegramUUID.Encode(e)
+		}
+	}
+	{
+		if s.Telegram.Set {
+			e.FieldStart("telegram")
+			s.Telegram.Encode(e)
+		}
+	}
+	{
+		if s.WechatUUID.Set {
+			e.FieldStart("wechat_uuid")
+			s.WechatUUID.Encode(e)
+		}
+	}
+	{
+		if s.Wechat.Set {
+			e.FieldStart("wechat")
+			s.Wechat.Encode(e)
+		}
+	}
+	{
+		if s.LineUUID.Set {
+			e.FieldStart("line_uuid")
+			s.LineUUID.Encode(e)
+		}
+	}
+	{
+		if s.Line.Set {
+			e.FieldStart("line")
+			s.Line.Encode(e)
+		}
+	}
+	{
+		if s.Socials != nil {
+			e.FieldStart("socials")
+			s.Socials.Encode(e)
+		}
+	}
+	{
+		if s.SocialsSearch.Set {
+			e.FieldStart("socials_search")
+			s.SocialsSearch.Encode(e)
+		}
+	}
+	{
+		if s.LinkedinUUID.Set {
+			e.FieldStart("linkedin_uuid")
+			s.LinkedinUUID.Encode(e)
+		}
+	}
+	{
+		if s.LinkedinURL.Set {
+			e.FieldStart("linkedin_url")
+			s.LinkedinURL.Encode(e)
+		}
+	}
+	{
+		if s.FacebookUUID.Set {
+			e.FieldStart("facebook_uuid")
+			s.FacebookUUID.Encode(e)
+		}
+	}
+	{
+		if s.FacebookURL.Set {
+			e.FieldStart("facebook_url")
+			s.FacebookURL.Encode(e)
+		}
+	}
+	{
+		if s.TwitterUUID.Set {
+			e.FieldStart("twitter_uuid")
+			s.TwitterUUID.Encode(e)
+		}
+	}
+	{
+		if s.TwitterURL.Set {
+			e.FieldStart("twitter_url")
+			s.TwitterURL.Encode(e)
+		}
+	}
+	{
+		if s.GithubUUID.Set {
+			e.FieldStart("github_uuid")
+			s.GithubUUID.Encode(e)
+		}
+	}
+	{
+		if s.GithubURL.Set {
+			e.FieldStart("github_url")
+			s.GithubURL.Encode(e)
+		}
+	}
+	{
+		if s.VkUUID.Set {
+			e.FieldStart("vk_uuid")
+			s.VkUUID.Encode(e)
+		}
+	}
+	{
+		if s.VkURL.Set {
+			e.FieldStart("vk_url")
+			s.VkURL.Encode(e)
+		}
+	}
+	{
+		if s.OdnoUUID.Set {
+			e.FieldStart("odno_uuid")
+			s.OdnoUUID.Encode(e)
+		}
+	}
+	{
+		if s.OdnoURL.Set {
+			e.FieldStart("odno_url")
+			s.OdnoURL.Encode(e)
+		}
+	}
+	{
+		if s.HhruUUID.Set {
+			e.FieldStart("hhru_uuid")
+			s.HhruUUID.Encode(e)
+		}
+	}
+	{
+		if s.HhruURL.Set {
+			e.FieldStart("hhru_url")
+			s.HhruURL.Encode(e)
+		}
+	}
+	{
+		if s.HabrUUID.Set {
+			e.FieldStart("habr_uuid")
+			s.HabrUUID.Encode(e)
+		}
+	}
+	{
+		if s.HabrURL.Set {
+			e.FieldStart("habr_url")
+			s.HabrURL.Encode(e)
+		}
+	}
+	{
+		if s.MoikrugUUID.Set {
+			e.FieldStart("moikrug_uuid")
+			s.MoikrugUUID.Encode(e)
+		}
+	}
+	{
+		if s.MoikrugURL.Set {
+			e.FieldStart("moikrug_url")
+			s.MoikrugURL.Encode(e)
+		}
+	}
+	{
+		if s.InstagramUUID.Set {
+			e.FieldStart("instagram_uuid")
+			s.InstagramUUID.Encode(e)
+		}
+	}
+	{
+		if s.InstagramURL.Set {
+			e.FieldStart("instagram_url")
+			s.InstagramURL.Encode(e)
+		}
+	}
+	{
+		if s.Social1UUID.Set {
+			e.FieldStart("social1_uuid")
+			s.Social1UUID.Encode(e)
+		}
+	}
+	{
+		if s.Social1URL.Set {
+			e.FieldStart("social1_url")
+			s.Social1URL.Encode(e)
+		}
+	}
+	{
+		if s.Social1Type.Set {
+			e.FieldStart("social1_type")
+			s.Social1Type.Encode(e)
+		}
+	}
+	{
+		if s.Social2UUID.Set {
+			e.FieldStart("social2_uuid")
+			s.Social2UUID.Encode(e)
+		}
+	}
+	{
+		if s.Social2URL.Set {
+			e.FieldStart("social2_url")
+			s.Social2URL.Encode(e)
+		}
+	}
+	{
+		if s.Social2Type.Set {
+			e.FieldStart("social2_type")
+			s.Social2Type.Encode(e)
+		}
+	}
+	{
+		if s.Social3UUID.Set {
+			e.FieldStart("social3_uuid")
+			s.Social3UUID.Encode(e)
+		}
+	}
+	{
+		if s.Social3URL.Set {
+			e.FieldStart("social3_url")
+			s.Social3URL.Encode(e)
+		}
+	}
+	{
+		if s.Social3Type.Set {
+			e.FieldStart("social3_type")
+			s.Social3Type.Encode(e)
+		}
+	}
+	{
+		if s.Social4UUID.Set {
+			e.FieldStart("social4_uuid")
+			s.Social4UUID.Encode(e)
+		}
+	}
+	{
+		if s.Social4URL.Set {
+			e.FieldStart("social4_url")
+			s.Social4URL.Encode(e)
+		}
+	}
+	{
+		if s.Social4Type.Set {
+			e.FieldStart("social4_type")
+			s.Social4Type.Encode(e)
+		}
+	}
+	{
+		if s.Social5UUID.Set {
+			e.FieldStart("social5_uuid")
+			s.Social5UUID.Encode(e)
+		}
+	}
+	{
+		if s.Social5URL.Set {
+			e.FieldStart("social5_url")
+			s.Social5URL.Encode(e)
+		}
+	}
+	{
+		if s.Social5Type.Set {
+			e.FieldStart("social5_type")
+			s.Social5Type.Encode(e)
+		}
+	}
+	{
+		if s.Social6UUID.Set {
+			e.FieldStart("social6_uuid")
+			s.Social6UUID.Encode(e)
+		}
+	}
+	{
+		if s.Social6URL.Set {
+			e.FieldStart("social6_url")
+			s.Social6URL.Encode(e)
+		}
+	}
+	{
+		if s.Social6Type.Set {
+			e.FieldStart("social6_type")
+			s.Social6Type.Encode(e)
+		}
+	}
+	{
+		if s.Social7UUID.Set {
+			e.FieldStart("social7_uuid")
+			s.Social7UUID.Encode(e)
+		}
+	}
+	{
+		if s.Social7URL.Set {
+			e.FieldStart("social7_url")
+			s.Social7URL.Encode(e)
+		}
+	}
+	{
+		if s.Social7Type.Set {
+			e.FieldStart("social7_type")
+			s.Social7Type.Encode(e)
+		}
+	}
+	{
+		if s.Social8UUID.Set {
+			e.FieldStart("social8_uuid")
+			s.Social8UUID.Encode(e)
+		}
+	}
+	{
+		if s.Social8URL.Set {
+			e.FieldStart("social8_url")
+			s.Social8URL.Encode(e)
+		}
+	}
+	{
+		if s.Social8Type.Set {
+			e.FieldStart("social8_type")
+			s.Social8Type.Encode(e)
+		}
+	}
+	{
+		if s.Social9UUID.Set {
+			e.FieldStart("social9_uuid")
+			s.Social9UUID.Encode(e)
+		}
+	}
+	{
+		if s.Social9URL.Set {
+			e.FieldStart("social9_url")
+			s.Social9URL.Encode(e)
+		}
+	}
+	{
+		if s.Social9Type.Set {
+			e.FieldStart("social9_type")
+			s.Social9Type.Encode(e)
+		}
+	}
+	{
+		if s.TrackingSource.Set {
+			e.FieldStart("tracking_source")
+			s.TrackingSource.Encode(e)
+		}
+	}
+	{
+		if s.TrackingSlug.Set {
+			e.FieldStart("tracking_slug")
+			s.TrackingSlug.Encode(e)
+		}
+	}
+	{
+		if s.CachedImg.Set {
+			e.FieldStart("cached_img")
+			s.CachedImg.Encode(e)
+		}
+	}
+	{
+		if s.CachedImgData != nil {
+			e.FieldStart("cached_img_data")
+			s.CachedImgData.Encode(e)
+		}
+	}
+	{
+		if s.Crawl != nil {
+			e.FieldStart("crawl")
+			s.Crawl.Encode(e)
+		}
+	}
+	{
+		if s.DuplicateUserID.Set {
+			e.FieldStart("duplicate_user_id")
+			s.DuplicateUserID.Encode(e)
+		}
+	}
+	{
+		if s.DuplicateAlternativeID.Set {
+			e.FieldStart("duplicate_alternative_id")
+			s.DuplicateAlternativeID.Encode(e)
+		}
+	}
+	{
+		if s.DuplicateReportDate.Set {
+			e.FieldStart("duplicate_report_date")
+			s.DuplicateReportDate.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.EntryDate.Set {
+			e.FieldStart("entry_date")
+			s.EntryDate.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.EditDate.Set {
+			e.FieldStart("edit_date")
+			s.EditDate.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.LastKpiEntryDate.Set {
+			e.FieldStart("last_kpi_entry_date")
+			s.LastKpiEntryDate.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfContact = [125]string{
+	0:   "uuid",
+	1:   "user_uuid",
+	2:   "instance_uuid",
+	3:   "status",
+	4:   "names",
+	5:   "names_search",
+	6:   "last",
+	7:   "first",
+	8:   "middle",
+	9:   "birthday",
+	10:  "birthday_type",
+	11:  "salary",
+	12:  "salary_data",
+	13:  "last_positions",
+	14:  "last_position_id",
+	15:  "last_position_company_id",
+	16:  "last_position_company_name",
+	17:  "last_position_title",
+	18:  "last_position_start_date",
+	19:  "last_position_end_date",
+	20:  "last_position_end_now",
+	21:  "last_position_description",
+	22:  "note_search",
+	23:  "note_kpi_id",
+	24:  "phones",
+	25:  "phone_search",
+	26:  "phone1",
+	27:  "phone1_type",
+	28:  "phone1_country",
+	29:  "phone2",
+	30:  "phone2_type",
+	31:  "phone2_country",
+	32:  "phone3",
+	33:  "phone3_type",
+	34:  "phone3_country",
+	35:  "phone4",
+	36:  "phone4_type",
+	37:  "phone4_country",
+	38:  "phone5",
+	39:  "phone5_type",
+	40:  "phone5_country",
+	41:  "emails",
+	42:  "email_search",
+	43:  "email1",
+	44:  "email1_type",
+	45:  "email2",
+	46:  "email2_type",
+	47:  "email3",
+	48:  "email3_type",
+	49:  "email4",
+	50:  "email4_type",
+	51:  "email5",
+	52:  "email5_type",
+	53:  "messengers",
+	54:  "messengers_search",
+	55:  "skype_uuid",
+	56:  "skype",
+	57:  "whatsapp_uuid",
+	58:  "whatsapp",
+	59:  "telegram_uuid",
+	60:  "telegram",
+	61:  "wechat_uuid",
+	62:  "wechat",
+	63:  "line_uuid",
+	64:  "line",
+	65:  "socials",
+	66:  "socials_search",
+	67:  "linkedin_uuid",
+	68:  "linkedin_url",
+	69:  "facebook_uuid",
+	70:  "facebook_url",
+	71:  "twitter_uuid",
+	72:  "twitter_url",
+	73:  "github_uuid",
+	74:  "github_url",
+	75:  "vk_uuid",
+	76:  "vk_url",
+	77:  "odno_uuid",
+	78:  "odno_url",
+	79:  "hhru_uuid",
+	80:  "hhru_url",
+	81:  "habr_uuid",
+	82:  "habr_url",
+	83:  "moikrug_uuid",
+	84:  "moikrug_url",
+	85:  "instagram_uuid",
+	86:  "instagram_url",
+	87:  "social1_uuid",
+	88:  "social1_url",
+	89:  "social1_type",
+	90:  "social2_uuid",
+	91:  "social2_url",
+	92:  "social2_type",
+	93:  "social3_uuid",
+	94:  "social3_url",
+	95:  "social3_type",
+	96:  "social4_uuid",
+	97:  "social4_url",
+	98:  "social4_type",
+	99:  "social5_uuid",
+	100: "social5_url",
+	101: "social5_type",
+	102: "social6_uuid",
+	103: "social6_url",
+	104: "social6_type",
+	105: "social7_uuid",
+	106: "social7_url",
+	107: "social7_type",
+	108: "social8_uuid",
+	109: "social8_url",
+	110: "social8_type",
+	111: "social9_uuid",
+	112: "social9_url",
+	113: "social9_type",
+	114: "tracking_source",
+	115: "tracking_slug",
+	116: "cached_img",
+	117: "cached_img_data",
+	118: "crawl",
+	119: "duplicate_user_id",
+	120: "duplicate_alternative_id",
+	121: "duplicate_report_date",
+	122: "entry_date",
+	123: "edit_date",
+	124: "last_kpi_entry_date",
+}
+
+// Decode decodes Contact from json.
+func (s *Contact) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Contact to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "uuid":
+			if err := func() error {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uuid\"")
+			}
+		case "user_uuid":
+			if err := func() error {
+				s.UserUUID.Reset()
+				if err := s.UserUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"user_uuid\"")
+			}
+		case "instance_uuid":
+			if err := func() error {
+				s.InstanceUUID.Reset()
+				if err := s.InstanceUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"instance_uuid\"")
+			}
+		case "status":
+			if err := func() error {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "names":
+			if err := func() error {
+				s.Names = nil
+				var elem ContactNames
+				if err := elem.Decode(d); err != nil {
+					return err
+				}
+				s.Names = &elem
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"names\"")
+			}
+		case "names_search":
+			if err := func() error {
+				s.NamesSearch.Reset()
+				if err := s.NamesSearch.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"names_search\"")
+			}
+		case "last":
+			if err := func() error {
+				s.Last.Reset()
+				if err := s.Last.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last\"")
+			}
+		case "first":
+			if err := func() error {
+				s.First.Reset()
+				if err := s.First.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"first\"")
+			}
+		case "middle":
+			if err := func() error {
+				s.Middle.Reset()
+				if err := s.Middle.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"middle\"")
+			}
+		case "birthday":
+			if err := func() error {
+				s.Birthday.Reset()
+				if err := s.Birthday.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"birthday\"")
+			}
+		case "birthday_type":
+			if err := func() error {
+				s.BirthdayType.Reset()
+				if err := s.BirthdayType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"birthday_type\"")
+			}
+		case "salary":
+			if err := func() error {
+				s.Salary.Reset()
+				if err := s.Salary.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"salary\"")
+			}
+		case "salary_data":
+			if err := func() error {
+				s.SalaryData = nil
+				var elem ContactSalaryData
+				if err := elem.Decode(d); err != nil {
+					return err
+				}
+				s.SalaryData = &elem
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"salary_data\"")
+			}
+		case "last_positions":
+			if err := func() error {
+				s.LastPositions = nil
+				var elem ContactLastPositions
+				if err := elem.Decode(d); err != nil {
+					return err
+				}
+				s.LastPositions = &elem
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_positions\"")
+			}
+		case "last_position_id":
+			if err := func() error {
+				s.LastPositionID.Reset()
+				if err := s.LastPositionID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_position_id\"")
+			}
+		case "last_position_company_id":
+			if err := func() error {
+				s.LastPositionCompanyID.Reset()
+				if err := s.LastPositionCompanyID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_position_company_id\"")
+			}
+		case "last_position_company_name":
+			if err := func() error {
+				s.LastPositionCompanyName.Reset()
+				if err := s.LastPositionCompanyName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_position_company_name\"")
+			}
+		case "last_position_title":
+			if err := func() error {
+				s.LastPositionTitle.Reset()
+				if err := s.LastPositionTitle.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_position_title\"")
+			}
+		case "last_position_start_date":
+			if err := func() error {
+				s.LastPositionStartDate.Reset()
+				if err := s.LastPositionStartDate.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_position_start_date\"")
+			}
+		case "last_position_end_date":
+			if err := func() error {
+				s.LastPositionEndDate.Reset()
+				if err := s.LastPositionEndDate.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_position_end_date\"")
+			}
+		case "last_position_end_now":
+			if err := func() error {
+				s.LastPositionEndNow.Reset()
+				if err := s.LastPositionEndNow.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_position_end_now\"")
+			}
+		case "last_position_description":
+			if err := func() error {
+				s.LastPositionDescription.Reset()
+				if err := s.LastPositionDescription.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_position_description\"")
+			}
+		case "note_search":
+			if err := func() error {
+				s.NoteSearch.Reset()
+				if err := s.NoteSearch.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"note_search\"")
+			}
+		case "note_kpi_id":
+			if err := func() error {
+				s.NoteKpiID = nil
+				var elem ContactNoteKpiID
+				if err := elem.Decode(d); err != nil {
+					return err
+				}
+				s.NoteKpiID = &elem
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"note_kpi_id\"")
+			}
+		case "phones":
+			if err := func() error {
+				s.Phones = nil
+				var elem ContactPhones
+				if err := elem.Decode(d); err != nil {
+					return err
+				}
+				s.Phones = &elem
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"phones\"")
+			}
+		case "phone_search":
+			if err := func() error {
+				s.PhoneSearch.Reset()
+				if err := s.PhoneSearch.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"phone_search\"")
+			}
+		case "phone1":
+			if err := func() error {
+				s.Phone1.Reset()
+				if err := s.Phone1.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"phone1\"")
+			}
+		case "phone1_type":
+			if err := func() error {
+				s.Phone1Type.Reset()
+				if err := s.Phone1Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"phone1_type\"")
+			}
+		case "phone1_country":
+			if err := func() error {
+				s.Phone1Country.Reset()
+				if err := s.Phone1Country.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"phone1_country\"")
+			}
+		case "phone2":
+			if err := func() error {
+				s.Phone2.Reset()
+				if err := s.Phone2.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"phone2\"")
+			}
+		case "phone2_type":
+			if err := func() error {
+				s.Phone2Type.Reset()
+				if err := s.Phone2Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"phone2_type\"")
+			}
+		case "phone2_country":
+			if err := func() error {
+				s.Phone2Country.Reset()
+				if err := s.Phone2Country.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"phone2_country\"")
+			}
+		case "phone3":
+			if err := func() error {
+				s.Phone3.Reset()
+				if err := s.Phone3.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"phone3\"")
+			}
+		case "phone3_type":
+			if err := func() error {
+				s.Phone3Type.Reset()
+				if err := s.Phone3Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"phone3_type\"")
+			}
+		case "phone3_country":
+			if err := func() error {
+				s.Phone3Country.Reset()
+				if err := s.Phone3Country.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"phone3_country\"")
+			}
+		case "phone4":
+			if err := func() error {
+				s.Phone4.Reset()
+				if err := s.Phone4.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"phone4\"")
+			}
+		case "phone4_type":
+			if err := func() error {
+				s.Phone4Type.Reset()
+				if err := s.Phone4Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"phone4_type\"")
+			}
+		case "phone4_country":
+			if err := func() error {
+				s.Phone4Country.Reset()
+				if err := s.Phone4Country.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"phone4_country\"")
+			}
+		case "phone5":
+			if err := func() error {
+				s.Phone5.Reset()
+				if err := s.Phone5.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"phone5\"")
+			}
+		case "phone5_type":
+			if err := func() error {
+				s.Phone5Type.Reset()
+				if err := s.Phone5Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"phone5_type\"")
+			}
+		case "phone5_country":
+			if err := func() error {
+				s.Phone5Country.Reset()
+				if err := s.Phone5Country.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"phone5_country\"")
+			}
+		case "emails":
+			if err := func() error {
+				s.Emails = nil
+				var elem ContactEmails
+				if err := elem.Decode(d); err != nil {
+					return err
+				}
+				s.Emails = &elem
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"emails\"")
+			}
+		case "email_search":
+			if err := func() error {
+				s.EmailSearch.Reset()
+				if err := s.EmailSearch.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email_search\"")
+			}
+		case "email1":
+			if err := func() error {
+				s.Email1.Reset()
+				if err := s.Email1.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email1\"")
+			}
+		case "email1_type":
+			if err := func() error {
+				s.Email1Type.Reset()
+				if err := s.Email1Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email1_type\"")
+			}
+		case "email2":
+			if err := func() error {
+				s.Email2.Reset()
+				if err := s.Email2.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email2\"")
+			}
+		case "email2_type":
+			if err := func() error {
+				s.Email2Type.Reset()
+				if err := s.Email2Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email2_type\"")
+			}
+		case "email3":
+			if err := func() error {
+				s.Email3.Reset()
+				if err := s.Email3.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email3\"")
+			}
+		case "email3_type":
+			if err := func() error {
+				s.Email3Type.Reset()
+				if err := s.Email3Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email3_type\"")
+			}
+		case "email4":
+			if err := func() error {
+				s.Email4.Reset()
+				if err := s.Email4.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email4\"")
+			}
+		case "email4_type":
+			if err := func() error {
+				s.Email4Type.Reset()
+				if err := s.Email4Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email4_type\"")
+			}
+		case "email5":
+			if err := func() error {
+				s.Email5.Reset()
+				if err := s.Email5.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email5\"")
+			}
+		case "email5_type":
+			if err := func() error {
+				s.Email5Type.Reset()
+				if err := s.Email5Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email5_type\"")
+			}
+		case "messengers":
+			if err := func() error {
+				s.Messengers = nil
+				var elem ContactMessengers
+				if err := elem.Decode(d); err != nil {
+					return err
+				}
+				s.Messengers = &elem
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"messengers\"")
+			}
+		case "messengers_search":
+			if err := func() error {
+				s.MessengersSearch.Reset()
+				if err := s.MessengersSearch.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"messengers_search\"")
+			}
+		case "skype_uuid":
+			if err := func() error {
+				s.SkypeUUID.Reset()
+				if err := s.SkypeUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"skype_uuid\"")
+			}
+		case "skype":
+			if err := func() error {
+				s.Skype.Reset()
+				if err := s.Skype.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"skype\"")
+			}
+		case "whatsapp_uuid":
+			if err := func() error {
+				s.WhatsappUUID.Reset()
+				if err := s.WhatsappUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"whatsapp_uuid\"")
+			}
+		case "whatsapp":
+			if err := func() error {
+				s.Whatsapp.Reset()
+				if err := s.Whatsapp.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"whatsapp\"")
+			}
+		case "telegram_uuid":
+			if err := func() error {
+				s.TelegramUUID.Reset()
+				if err := s.TelegramUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"telegram_uuid\"")
+			}
+		case "telegram":
+			if err := func() error {
+				s.Telegram.Reset()
+				if err := s.Telegram.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"telegram\"")
+			}
+		case "wechat_uuid":
+			if err := func() error {
+				s.WechatUUID.Reset()
+				if err := s.WechatUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"wechat_uuid\"")
+			}
+		case "wechat":
+			if err := func() error {
+				s.Wechat.Reset()
+				if err := s.Wechat.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"wechat\"")
+			}
+		case "line_uuid":
+			if err := func() error {
+				s.LineUUID.Reset()
+				if err := s.LineUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"line_uuid\"")
+			}
+		case "line":
+			if err := func() error {
+				s.Line.Reset()
+				if err := s.Line.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"line\"")
+			}
+		case "socials":
+			if err := func() error {
+				s.Socials = nil
+				var elem ContactSocials
+				if err := elem.Decode(d); err != nil {
+					return err
+				}
+				s.Socials = &elem
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"socials\"")
+			}
+		case "socials_search":
+			if err := func() error {
+				s.SocialsSearch.Reset()
+				if err := s.SocialsSearch.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"socials_search\"")
+			}
+		case "linkedin_uuid":
+			if err := func() error {
+				s.LinkedinUUID.Reset()
+				if err := s.LinkedinUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"linkedin_uuid\"")
+			}
+		case "linkedin_url":
+			if err := func() error {
+				s.LinkedinURL.Reset()
+				if err := s.LinkedinURL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"linkedin_url\"")
+			}
+		case "facebook_uuid":
+			if err := func() error {
+				s.FacebookUUID.Reset()
+				if err := s.FacebookUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"facebook_uuid\"")
+			}
+		case "facebook_url":
+			if err := func() error {
+				s.FacebookURL.Reset()
+				if err := s.FacebookURL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"facebook_url\"")
+			}
+		case "twitter_uuid":
+			if err := func() error {
+				s.TwitterUUID.Reset()
+				if err := s.TwitterUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"twitter_uuid\"")
+			}
+		case "twitter_url":
+			if err := func() error {
+				s.TwitterURL.Reset()
+				if err := s.TwitterURL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"twitter_url\"")
+			}
+		case "github_uuid":
+			if err := func() error {
+				s.GithubUUID.Reset()
+				if err := s.GithubUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"github_uuid\"")
+			}
+		case "github_url":
+			if err := func() error {
+				s.GithubURL.Reset()
+				if err := s.GithubURL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"github_url\"")
+			}
+		case "vk_uuid":
+			if err := func() error {
+				s.VkUUID.Reset()
+				if err := s.VkUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vk_uuid\"")
+			}
+		case "vk_url":
+			if err := func() error {
+				s.VkURL.Reset()
+				if err := s.VkURL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vk_url\"")
+			}
+		case "odno_uuid":
+			if err := func() error {
+				s.OdnoUUID.Reset()
+				if err := s.OdnoUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"odno_uuid\"")
+			}
+		case "odno_url":
+			if err := func() error {
+				s.OdnoURL.Reset()
+				if err := s.OdnoURL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"odno_url\"")
+			}
+		case "hhru_uuid":
+			if err := func() error {
+				s.HhruUUID.Reset()
+				if err := s.HhruUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hhru_uuid\"")
+			}
+		case "hhru_url":
+			if err := func() error {
+				s.HhruURL.Reset()
+				if err := s.HhruURL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hhru_url\"")
+			}
+		case "habr_uuid":
+			if err := func() error {
+				s.HabrUUID.Reset()
+				if err := s.HabrUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"habr_uuid\"")
+			}
+		case "habr_url":
+			if err := func() error {
+				s.HabrURL.Reset()
+				if err := s.HabrURL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"habr_url\"")
+			}
+		case "moikrug_uuid":
+			if err := func() error {
+				s.MoikrugUUID.Reset()
+				if err := s.MoikrugUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"moikrug_uuid\"")
+			}
+		case "moikrug_url":
+			if err := func() error {
+				s.MoikrugURL.Reset()
+				if err := s.MoikrugURL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"moikrug_url\"")
+			}
+		case "instagram_uuid":
+			if err := func() error {
+				s.InstagramUUID.Reset()
+				if err := s.InstagramUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"instagram_uuid\"")
+			}
+		case "instagram_url":
+			if err := func() error {
+				s.InstagramURL.Reset()
+				if err := s.InstagramURL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"instagram_url\"")
+			}
+		case "social1_uuid":
+			if err := func() error {
+				s.Social1UUID.Reset()
+				if err := s.Social1UUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social1_uuid\"")
+			}
+		case "social1_url":
+			if err := func() error {
+				s.Social1URL.Reset()
+				if err := s.Social1URL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social1_url\"")
+			}
+		case "social1_type":
+			if err := func() error {
+				s.Social1Type.Reset()
+				if err := s.Social1Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social1_type\"")
+			}
+		case "social2_uuid":
+			if err := func() error {
+				s.Social2UUID.Reset()
+				if err := s.Social2UUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social2_uuid\"")
+			}
+		case "social2_url":
+			if err := func() error {
+				s.Social2URL.Reset()
+				if err := s.Social2URL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social2_url\"")
+			}
+		case "social2_type":
+			if err := func() error {
+				s.Social2Type.Reset()
+				if err := s.Social2Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social2_type\"")
+			}
+		case "social3_uuid":
+			if err := func() error {
+				s.Social3UUID.Reset()
+				if err := s.Social3UUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social3_uuid\"")
+			}
+		case "social3_url":
+			if err := func() error {
+				s.Social3URL.Reset()
+				if err := s.Social3URL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social3_url\"")
+			}
+		case "social3_type":
+			if err := func() error {
+				s.Social3Type.Reset()
+				if err := s.Social3Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social3_type\"")
+			}
+		case "social4_uuid":
+			if err := func() error {
+				s.Social4UUID.Reset()
+				if err := s.Social4UUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social4_uuid\"")
+			}
+		case "social4_url":
+			if err := func() error {
+				s.Social4URL.Reset()
+				if err := s.Social4URL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social4_url\"")
+			}
+		case "social4_type":
+			if err := func() error {
+				s.Social4Type.Reset()
+				if err := s.Social4Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social4_type\"")
+			}
+		case "social5_uuid":
+			if err := func() error {
+				s.Social5UUID.Reset()
+				if err := s.Social5UUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social5_uuid\"")
+			}
+		case "social5_url":
+			if err := func() error {
+				s.Social5URL.Reset()
+				if err := s.Social5URL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social5_url\"")
+			}
+		case "social5_type":
+			if err := func() error {
+				s.Social5Type.Reset()
+				if err := s.Social5Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social5_type\"")
+			}
+		case "social6_uuid":
+			if err := func() error {
+				s.Social6UUID.Reset()
+				if err := s.Social6UUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social6_uuid\"")
+			}
+		case "social6_url":
+			if err := func() error {
+				s.Social6URL.Reset()
+				if err := s.Social6URL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social6_url\"")
+			}
+		case "social6_type":
+			if err := func() error {
+				s.Social6Type.Reset()
+				if err := s.Social6Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social6_type\"")
+			}
+		case "social7_uuid":
+			if err := func() error {
+				s.Social7UUID.Reset()
+				if err := s.Social7UUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social7_uuid\"")
+			}
+		case "social7_url":
+			if err := func() error {
+				s.Social7URL.Reset()
+				if err := s.Social7URL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social7_url\"")
+			}
+		case "social7_type":
+			if err := func() error {
+				s.Social7Type.Reset()
+				if err := s.Social7Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social7_type\"")
+			}
+		case "social8_uuid":
+			if err := func() error {
+				s.Social8UUID.Reset()
+				if err := s.Social8UUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social8_uuid\"")
+			}
+		case "social8_url":
+			if err := func() error {
+				s.Social8URL.Reset()
+				if err := s.Social8URL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social8_url\"")
+			}
+		case "social8_type":
+			if err := func() error {
+				s.Social8Type.Reset()
+				if err := s.Social8Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social8_type\"")
+			}
+		case "social9_uuid":
+			if err := func() error {
+				s.Social9UUID.Reset()
+				if err := s.Social9UUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social9_uuid\"")
+			}
+		case "social9_url":
+			if err := func() error {
+				s.Social9URL.Reset()
+				if err := s.Social9URL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social9_url\"")
+			}
+		case "social9_type":
+			if err := func() error {
+				s.Social9Type.Reset()
+				if err := s.Social9Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"social9_type\"")
+			}
+		case "tracking_source":
+			if err := func() error {
+				s.TrackingSource.Reset()
+				if err := s.TrackingSource.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tracking_source\"")
+			}
+		case "tracking_slug":
+			if err := func() error {
+				s.TrackingSlug.Reset()
+				if err := s.TrackingSlug.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tracking_slug\"")
+			}
+		case "cached_img":
+			if err := func() error {
+				s.CachedImg.Reset()
+				if err := s.CachedImg.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"cached_img\"")
+			}
+		case "cached_img_data":
+			if err := func() error {
+				s.CachedImgData = nil
+				var elem ContactCachedImgData
+				if err := elem.Decode(d); err != nil {
+					return err
+				}
+				s.CachedImgData = &elem
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"cached_img_data\"")
+			}
+		case "crawl":
+			if err := func() error {
+				s.Crawl = nil
+				var elem ContactCrawl
+				if err := elem.Decode(d); err != nil {
+					return err
+				}
+				s.Crawl = &elem
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"crawl\"")
+			}
+		case "duplicate_user_id":
+			if err := func() error {
+				s.DuplicateUserID.Reset()
+				if err := s.DuplicateUserID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duplicate_user_id\"")
+			}
+		case "duplicate_alternative_id":
+			if err := func() error {
+				s.DuplicateAlternativeID.Reset()
+				if err := s.DuplicateAlternativeID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duplicate_alternative_id\"")
+			}
+		case "duplicate_report_date":
+			if err := func() error {
+				s.DuplicateReportDate.Reset()
+				if err := s.DuplicateReportDate.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duplicate_report_date\"")
+			}
+		case "entry_date":
+			if err := func() error {
+				s.EntryDate.Reset()
+				if err := s.EntryDate.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"entry_date\"")
+			}
+		case "edit_date":
+			if err := func() error {
+				s.EditDate.Reset()
+				if err := s.EditDate.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"edit_date\"")
+			}
+		case "last_kpi_entry_date":
+			if err := func() error {
+				s.LastKpiEntryDate.Reset()
+				if err := s.LastKpiEntryDate.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_kpi_entry_date\"")
+			}
+		default:
+			return d.Skip()
 		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Contact")
 	}
-	{
-		if s.Social3Type.Set {
-			e.FieldStart("social3_type")
-			s.Social3Type.Encode(e)
-		}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Contact) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Contact) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ContactCachedImgData) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ContactCachedImgData) encodeFields(e *jx.Encoder) {
+}
+
+var jsonFieldsNameOfContactCachedImgData = [0]string{}
+
+// Decode decodes ContactCachedImgData from json.
+func (s *ContactCachedImgData) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ContactCachedImgData to nil")
 	}
-	{
-		if s.Social4UUID.Set {
-			e.FieldStart("social4_uuid")
-			s.Social4UUID.Encode(e)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		default:
+			return d.Skip()
 		}
+	}); err != nil {
+		return errors.Wrap(err, "decode ContactCachedImgData")
 	}
-	{
-		if s.Social4URL.Set {
-			e.FieldStart("social4_url")
-			s.Social4URL.Encode(e)
-		}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ContactCachedImgData) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ContactCachedImgData) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ContactCrawl) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ContactCrawl) encodeFields(e *jx.Encoder) {
+}
+
+var jsonFieldsNameOfContactCrawl = [0]string{}
+
+// Decode decodes ContactCrawl from json.
+func (s *ContactCrawl) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ContactCrawl to nil")
 	}
-	{
-		if s.Social4Type.Set {
-			e.FieldStart("social4_type")
-			s.Social4Type.Encode(e)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		default:
+			return d.Skip()
 		}
+	}); err != nil {
+		return errors.Wrap(err, "decode ContactCrawl")
 	}
-	{
-		if s.Social5UUID.Set {
-			e.FieldStart("social5_uuid")
-			s.Social5UUID.Encode(e)
-		}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ContactCrawl) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ContactCrawl) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ContactEmails) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ContactEmails) encodeFields(e *jx.Encoder) {
+}
+
+var jsonFieldsNameOfContactEmails = [0]string{}
+
+// Decode decodes ContactEmails from json.
+func (s *ContactEmails) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ContactEmails to nil")
 	}
-	{
-		if s.Social5URL.Set {
-			e.FieldStart("social5_url")
-			s.Social5URL.Encode(e)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		default:
+			return d.Skip()
 		}
+	}); err != nil {
+		return errors.Wrap(err, "decode ContactEmails")
 	}
-	{
-		if s.Social5Type.Set {
-			e.FieldStart("social5_type")
-			s.Social5Type.Encode(e)
-		}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ContactEmails) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ContactEmails) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ContactLastPositions) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ContactLastPositions) encodeFields(e *jx.Encoder) {
+}
+
+var jsonFieldsNameOfContactLastPositions = [0]string{}
+
+// Decode decodes ContactLastPositions from json.
+func (s *ContactLastPositions) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ContactLastPositions to nil")
 	}
-	{
-		if s.Social6UUID.Set {
-			e.FieldStart("social6_uuid")
-			s.Social6UUID.Encode(e)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		default:
+			return d.Skip()
 		}
+	}); err != nil {
+		return errors.Wrap(err, "decode ContactLastPositions")
 	}
-	{
-		if s.Social6URL.Set {
-			e.FieldStart("social6_url")
-			s.Social6URL.Encode(e)
-		}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ContactLastPositions) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ContactLastPositions) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ContactMessengers) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ContactMessengers) encodeFields(e *jx.Encoder) {
+}
+
+var jsonFieldsNameOfContactMessengers = [0]string{}
+
+// Decode decodes ContactMessengers from json.
+func (s *ContactMessengers) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ContactMessengers to nil")
 	}
-	{
-		if s.Social6Type.Set {
-			e.FieldStart("social6_type")
-			s.Social6Type.Encode(e)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		default:
+			return d.Skip()
 		}
+	}); err != nil {
+		return errors.Wrap(err, "decode ContactMessengers")
 	}
-	{
-		if s.Social7UUID.Set {
-			e.FieldStart("social7_uuid")
-			s.Social7UUID.Encode(e)
-		}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ContactMessengers) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ContactMessengers) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ContactNames) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ContactNames) encodeFields(e *jx.Encoder) {
+}
+
+var jsonFieldsNameOfContactNames = [0]string{}
+
+// Decode decodes ContactNames from json.
+func (s *ContactNames) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ContactNames to nil")
 	}
-	{
-		if s.Social7URL.Set {
-			e.FieldStart("social7_url")
-			s.Social7URL.Encode(e)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		default:
+			return d.Skip()
 		}
+	}); err != nil {
+		return errors.Wrap(err, "decode ContactNames")
 	}
-	{
-		if s.Social7Type.Set {
-			e.FieldStart("social7_type")
-			s.Social7Type.Encode(e)
-		}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ContactNames) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ContactNames) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ContactNoteKpiID) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ContactNoteKpiID) encodeFields(e *jx.Encoder) {
+}
+
+var jsonFieldsNameOfContactNoteKpiID = [0]string{}
+
+// Decode decodes ContactNoteKpiID from json.
+func (s *ContactNoteKpiID) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ContactNoteKpiID to nil")
 	}
-	{
-		if s.Social8UUID.Set {
-			e.FieldStart("social8_uuid")
-			s.Social8UUID.Encode(e)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		default:
+			return d.Skip()
 		}
+	}); err != nil {
+		return errors.Wrap(err, "decode ContactNoteKpiID")
 	}
-	{
-		if s.Social8URL.Set {
-			e.FieldStart("social8_url")
-			s.Social8URL.Encode(e)
-		}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ContactNoteKpiID) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ContactNoteKpiID) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ContactPhones) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ContactPhones) encodeFields(e *jx.Encoder) {
+}
+
+var jsonFieldsNameOfContactPhones = [0]string{}
+
+// Decode decodes ContactPhones from json.
+func (s *ContactPhones) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ContactPhones to nil")
 	}
-	{
-		if s.Social8Type.Set {
-			e.FieldStart("social8_type")
-			s.Social8Type.Encode(e)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		default:
+			return d.Skip()
 		}
+	}); err != nil {
+		return errors.Wrap(err, "decode ContactPhones")
 	}
-	{
-		if s.Social9UUID.Set {
-			e.FieldStart("social9_uuid")
-			s.Social9UUID.Encode(e)
-		}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ContactPhones) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ContactPhones) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ContactSalaryData) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ContactSalaryData) encodeFields(e *jx.Encoder) {
+}
+
+var jsonFieldsNameOfContactSalaryData = [0]string{}
+
+// Decode decodes ContactSalaryData from json.
+func (s *ContactSalaryData) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ContactSalaryData to nil")
 	}
-	{
-		if s.Social9URL.Set {
-			e.FieldStart("social9_url")
-			s.Social9URL.Encode(e)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		default:
+			return d.Skip()
 		}
+	}); err != nil {
+		return errors.Wrap(err, "decode ContactSalaryData")
 	}
-	{
-		if s.Social9Type.Set {
-			e.FieldStart("social9_type")
-			s.Social9Type.Encode(e)
-		}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ContactSalaryData) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ContactSalaryData) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ContactSocials) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ContactSocials) encodeFields(e *jx.Encoder) {
+}
+
+var jsonFieldsNameOfContactSocials = [0]string{}
+
+// Decode decodes ContactSocials from json.
+func (s *ContactSocials) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ContactSocials to nil")
 	}
-	{
-		if s.TrackingSource.Set {
-			e.FieldStart("tracking_source")
-			s.TrackingSource.Encode(e)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		default:
+			return d.Skip()
 		}
+	}); err != nil {
+		return errors.Wrap(err, "decode ContactSocials")
 	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ContactSocials) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ContactSocials) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Datasource) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Datasource) encodeFields(e *jx.Encoder) {
 	{
-		if s.TrackingSlug.Set {
-			e.FieldStart("tracking_slug")
-			s.TrackingSlug.Encode(e)
+		if s.UUID.Set {
+			e.FieldStart("uuid")
+			s.UUID.Encode(e)
 		}
 	}
 	{
-		if s.CachedImg.Set {
-			e.FieldStart("cached_img")
-			s.CachedImg.Encode(e)
-		}
+		e.FieldStart("user_uuid")
+		e.Str(s.UserUUID)
 	}
 	{
-		if s.CachedImgData != nil {
-			e.FieldStart("cached_img_data")
-			s.CachedImgData.Encode(e)
-		}
+		e.FieldStart("type")
+		e.Str(s.Type)
 	}
 	{
-		if s.Crawl != nil {
-			e.FieldStart("crawl")
-			s.Crawl.Encode(e)
-		}
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 	{
-		if s.DuplicateUserID.Set {
-			e.FieldStart("duplicate_user_id")
-			s.DuplicateUserID.Encode(e)
+		if s.IsEnabled.Set {
+			e.FieldStart("is_enabled")
+			s.IsEnabled.Encode(e)
 		}
 	}
 	{
-		if s.DuplicateAlternativeID.Set {
-			e.FieldStart("duplicate_alternative_id")
-			s.DuplicateAlternativeID.Encode(e)
-		}
+		e.FieldStart("provider")
+		e.Str(s.Provider)
 	}
 	{
-		if s.DuplicateReportDate.Set {
-			e.FieldStart("duplicate_report_date")
-			s.DuplicateReportDate.Encode(e, json.EncodeDateTime)
+		if s.NeedsReauth.Set {
+			e.FieldStart("needs_reauth")
+			s.NeedsReauth.Encode(e)
 		}
 	}
 	{
-		if s.EntryDate.Set {
-			e.FieldStart("entry_date")
-			s.EntryDate.Encode(e, json.EncodeDateTime)
+		if s.ReauthReason.Set {
+			e.FieldStart("reauth_reason")
+			s.ReauthReason.Encode(e)
 		}
 	}
 	{
-		if s.EditDate.Set {
-			e.FieldStart("edit_date")
-			s.EditDate.Encode(e, json.EncodeDateTime)
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.LastKpiEntryDate.Set {
-			e.FieldStart("last_kpi_entry_date")
-			s.LastKpiEntryDate.Encode(e, json.EncodeDateTime)
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 }
 
-var jsonFieldsNameOfContact = [125]string{
-	0:   "uuid",
-	1:   "user_uuid",
-	2:   "instance_uuid",
-	3:   "status",
-	4:   "names",
-	5:   "names_search",
-	6:   "last",
-	7:   "first",
-	8:   "middle",
-	9:   "birthday",
-	10:  "birthday_type",
-	11:  "salary",
-	12:  "salary_data",
-	13:  "last_positions",
-	14:  "last_position_id",
-	15:  "last_position_company_id",
-	16:  "last_position_company_name",
-	17:  "last_position_title",
-	18:  "last_position_start_date",
-	19:  "last_position_end_date",
-	20:  "last_position_end_now",
-	21:  "last_position_description",
-	22:  "note_search",
-	23:  "note_kpi_id",
-	24:  "phones",
-	25:  "phone_search",
-	26:  "phone1",
-	27:  "phone1_type",
-	28:  "phone1_country",
-	29:  "phone2",
-	30:  "phone2_type",
-	31:  "phone2_country",
-	32:  "phone3",
-	33:  "phone3_type",
-	34:  "phone3_country",
-	35:  "phone4",
-	36:  "phone4_type",
-	37:  "phone4_country",
-	38:  "phone5",
-	39:  "phone5_type",
-	40:  "phone5_country",
-	41:  "emails",
-	42:  "email_search",
-	43:  "email1",
-	44:  "email1_type",
-	45:  "email2",
-	46:  "email2_type",
-	47:  "email3",
-	48:  "email3_type",
-	49:  "email4",
-	50:  "email4_type",
-	51:  "email5",
-	52:  "email5_type",
-	53:  "messengers",
-	54:  "messengers_search",
-	55:  "skype_uuid",
-	56:  "skype",
-	57:  "whatsapp_uuid",
-	58:  "whatsapp",
-	59:  "telegram_uuid",
-	60:  "telegram",
-	61:  "wechat_uuid",
-	62:  "wechat",
-	63:  "line_uuid",
-	64:  "line",
-	65:  "socials",
-	66:  "socials_search",
-	67:  "linkedin_uuid",
-	68:  "linkedin_url",
-	69:  "facebook_uuid",
-	70:  "facebook_url",
-	71:  "twitter_uuid",
-	72:  "twitter_url",
-	73:  "github_uuid",
-	74:  "github_url",
-	75:  "vk_uuid",
-	76:  "vk_url",
-	77:  "odno_uuid",
-	78:  "odno_url",
-	79:  "hhru_uuid",
-	80:  "hhru_url",
-	81:  "habr_uuid",
-	82:  "habr_url",
-	83:  "moikrug_uuid",
-	84:  "moikrug_url",
-	85:  "instagram_uuid",
-	86:  "instagram_url",
-	87:  "social1_uuid",
-	88:  "social1_url",
-	89:  "social1_type",
-	90:  "social2_uuid",
-	91:  "social2_url",
-	92:  "social2_type",
-	93:  "social3_uuid",
-	94:  "social3_url",
-	95:  "social3_type",
-	96:  "social4_uuid",
-	97:  "social4_url",
-	98:  "social4_type",
-	99:  "social5_uuid",
-	100: "social5_url",
-	101: "social5_type",
-	102: "social6_uuid",
-	103: "social6_url",
-	104: "social6_type",
-	105: "social7_uuid",
-	106: "social7_url",
-	107: "social7_type",
-	108: "social8_uuid",
-	109: "social8_url",
-	110: "social8_type",
-	111: "social9_uuid",
-	112: "social9_url",
-	113: "social9_type",
-	114: "tracking_source",
-	115: "tracking_slug",
-	116: "cached_img",
-	117: "cached_img_data",
-	118: "crawl",
-	119: "duplicate_user_id",
-	120: "duplicate_alternative_id",
-	121: "duplicate_report_date",
-	122: "entry_date",
-	123: "edit_date",
-	124: "last_kpi_entry_date",
+var jsonFieldsNameOfDatasource = [10]string{
+	0: "uuid",
+	1: "user_uuid",
+	2: "type",
+	3: "name",
+	4: "is_enabled",
+	5: "provider",
+	6: "needs_reauth",
+	7: "reauth_reason",
+	8: "created_at",
+	9: "updated_at",
 }
 
-// Decode decodes Contact from json.
-func (s *Contact) Decode(d *jx.Decoder) error {
+// Decode decodes Datasource from json.
+func (s *Datasource) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Contact to nil")
+		return errors.New("invalid: unable to decode Datasource to nil")
 	}
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
 		case "uuid":
 			if err := func() error {
 				s.UUID.Reset()
-				if err := s.UUID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uuid\"")
-			}
-		case "user_uuid":
-			if err := func() error {
-				s.UserUUID.Reset()
-				if err := s.UserUUID.Decode(d); err != nil {
+				if err := s.UUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"user_uuid\"")
+				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "instance_uuid":
+		case "user_uuid":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.InstanceUUID.Reset()
-				if err := s.InstanceUUID.Decode(d); err != nil {
+				v, err := d.Str()
+				s.UserUUID = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"instance_uuid\"")
+				return errors.Wrap(err, "decode field \"user_uuid\"")
 			}
-		case "status":
+		case "type":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Status.Reset()
-				if err := s.Status.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Type = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"status\"")
+				return errors.Wrap(err, "decode field \"type\"")
 			}
-		case "names":
+		case "name":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Names = nil
-				var elem ContactNames
-				if err := elem.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
-				s.Names = &elem
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"names\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "names_search":
+		case "is_enabled":
 			if err := func() error {
-				s.NamesSearch.Reset()
-				if err := s.NamesSearch.Decode(d); err != nil {
+				s.IsEnabled.Reset()
+				if err := s.IsEnabled.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"names_search\"")
+				return errors.Wrap(err, "decode field \"is_enabled\"")
 			}
-		case "last":
+		case "provider":
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				s.Last.Reset()
-				if err := s.Last.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Provider = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"last\"")
+				return errors.Wrap(err, "decode field \"provider\"")
 			}
-		case "first":
+		case "needs_reauth":
 			if err := func() error {
-				s.First.Reset()
-				if err := s.First.Decode(d); err != nil {
+				s.NeedsReauth.Reset()
+				if err := s.NeedsReauth.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"first\"")
+				return errors.Wrap(err, "decode field \"needs_reauth\"")
 			}
-		case "middle":
+		case "reauth_reason":
 			if err := func() error {
-				s.Middle.Reset()
-				if err := s.Middle.Decode(d); err != nil {
+				s.ReauthReason.Reset()
+				if err := s.ReauthReason.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"middle\"")
+				return errors.Wrap(err, "decode field \"reauth_reason\"")
 			}
-		case "birthday":
+		case "created_at":
 			if err := func() error {
-				s.Birthday.Reset()
-				if err := s.Birthday.Decode(d, json.DecodeDateTime); err != nil {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"birthday\"")
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
-		case "birthday_type":
+		case "updated_at":
 			if err := func() error {
-				s.BirthdayType.Reset()
-				if err := s.BirthdayType.Decode(d); err != nil {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"birthday_type\"")
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
-		case "salary":
-			if err := func() error {
-				s.Salary.Reset()
-				if err := s.Salary.Decode(d); err != nil {
-					return err
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Datasource")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00101110,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfDatasource) {
+					name = jsonFieldsNameOfDatasource[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"salary\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "salary_data":
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Datasource) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Datasource) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *DatasourceEmail) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *DatasourceEmail) encodeFields(e *jx.Encoder) {
+	{
+		if s.UUID.Set {
+			e.FieldStart("uuid")
+			s.UUID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("user_uuid")
+		e.Str(s.UserUUID)
+	}
+	{
+		e.FieldStart("email")
+		e.Str(s.Email)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.IsEnabled.Set {
+			e.FieldStart("is_enabled")
+			s.IsEnabled.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("provider")
+		e.Str(s.Provider)
+	}
+	{
+		if s.OAuth2ClientID.Set {
+			e.FieldStart("oauth2_client_id")
+			s.OAuth2ClientID.Encode(e)
+		}
+	}
+	{
+		if s.OAuth2ClientUUID.Set {
+			e.FieldStart("oauth2_client_uuid")
+			s.OAuth2ClientUUID.Encode(e)
+		}
+	}
+	{
+		if s.OAuth2TokenUUID.Set {
+			e.FieldStart("oauth2_token_uuid")
+			s.OAuth2TokenUUID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("imap_server")
+		e.Str(s.ImapServer)
+	}
+	{
+		e.FieldStart("smtp_server")
+		e.Str(s.SMTPServer)
+	}
+	{
+		if s.SMTPTLS.Set {
+			e.FieldStart("smtp_tls")
+			s.SMTPTLS.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("password")
+		e.Str(s.Password)
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfDatasourceEmail = [15]string{
+	0:  "uuid",
+	1:  "user_uuid",
+	2:  "email",
+	3:  "name",
+	4:  "is_enabled",
+	5:  "provider",
+	6:  "oauth2_client_id",
+	7:  "oauth2_client_uuid",
+	8:  "oauth2_token_uuid",
+	9:  "imap_server",
+	10: "smtp_server",
+	11: "smtp_tls",
+	12: "password",
+	13: "created_at",
+	14: "updated_at",
+}
+
+// Decode decodes DatasourceEmail from json.
+func (s *DatasourceEmail) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DatasourceEmail to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "uuid":
 			if err := func() error {
-				s.SalaryData = nil
-				var elem ContactSalaryData
-				if err := elem.Decode(d); err != nil {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
 					return err
 				}
-				s.SalaryData = &elem
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"salary_data\"")
+				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "last_positions":
+		case "user_uuid":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.LastPositions = nil
-				var elem ContactLastPositions
-				if err := elem.Decode(d); err != nil {
+				v, err := d.Str()
+				s.UserUUID = string(v)
+				if err != nil {
 					return err
 				}
-				s.LastPositions = &elem
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"last_positions\"")
+				return errors.Wrap(err, "decode field \"user_uuid\"")
 			}
-		case "last_position_id":
+		case "email":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.LastPositionID.Reset()
-				if err := s.LastPositionID.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Email = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"last_position_id\"")
+				return errors.Wrap(err, "decode field \"email\"")
 			}
-		case "last_position_company_id":
+		case "name":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.LastPositionCompanyID.Reset()
-				if err := s.LastPositionCompanyID.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"last_position_company_id\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "last_position_company_name":
+		case "is_enabled":
 			if err := func() error {
-				s.LastPositionCompanyName.Reset()
-				if err := s.LastPositionCompanyName.Decode(d); err != nil {
+				s.IsEnabled.Reset()
+				if err := s.IsEnabled.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"last_position_company_name\"")
+				return errors.Wrap(err, "decode field \"is_enabled\"")
 			}
-		case "last_position_title":
+		case "provider":
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				s.LastPositionTitle.Reset()
-				if err := s.LastPositionTitle.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Provider = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"last_position_title\"")
+				return errors.Wrap(err, "decode field \"provider\"")
 			}
-		case "last_position_start_date":
+		case "oauth2_client_id":
 			if err := func() error {
-				s.LastPositionStartDate.Reset()
-				if err := s.LastPositionStartDate.Decode(d, json.DecodeDateTime); err != nil {
+				s.OAuth2ClientID.Reset()
+				if err := s.OAuth2ClientID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"last_position_start_date\"")
+				return errors.Wrap(err, "decode field \"oauth2_client_id\"")
 			}
-		case "last_position_end_date":
+		case "oauth2_client_uuid":
 			if err := func() error {
-				s.LastPositionEndDate.Reset()
-				if err := s.LastPositionEndDate.Decode(d, json.DecodeDateTime); err != nil {
+				s.OAuth2ClientUUID.Reset()
+				if err := s.OAuth2ClientUUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"last_position_end_date\"")
+				return errors.Wrap(err, "decode field \"oauth2_client_uuid\"")
 			}
-		case "last_position_end_now":
+		case "oauth2_token_uuid":
 			if err := func() error {
-				s.LastPositionEndNow.Reset()
-				if err := s.LastPositionEndNow.Decode(d); err != nil {
+				s.OAuth2TokenUUID.Reset()
+				if err := s.OAuth2TokenUUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"last_position_end_now\"")
+				return errors.Wrap(err, "decode field \"oauth2_token_uuid\"")
 			}
-		case "last_position_description":
+		case "imap_server":
+			requiredBitSet[1] |= 1 << 1
 			if err := func() error {
-				s.LastPositionDescription.Reset()
-				if err := s.LastPositionDescription.Decode(d); err != nil {
+				v, err := d.Str()
+				s.ImapServer = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"last_position_description\"")
+				return errors.Wrap(err, "decode field \"imap_server\"")
 			}
-		case "note_search":
+		case "smtp_server":
+			requiredBitSet[1] |= 1 << 2
 			if err := func() error {
-				s.NoteSearch.Reset()
-				if err := s.NoteSearch.Decode(d); err != nil {
+				v, err := d.Str()
+				s.SMTPServer = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"note_search\"")
+				return errors.Wrap(err, "decode field \"smtp_server\"")
 			}
-		case "note_kpi_id":
+		case "smtp_tls":
 			if err := func() error {
-				s.NoteKpiID = nil
-				var elem ContactNoteKpiID
-				if err := elem.Decode(d); err != nil {
+				s.SMTPTLS.Reset()
+				if err := s.SMTPTLS.Decode(d); err != nil {
 					return err
 				}
-				s.NoteKpiID = &elem
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"note_kpi_id\"")
+				return errors.Wrap(err, "decode field \"smtp_tls\"")
 			}
-		case "phones":
+		case "password":
+			requiredBitSet[1] |= 1 << 4
 			if err := func() error {
-				s.Phones = nil
-				var elem ContactPhones
-				if err := elem.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Password = string(v)
+				if err != nil {
 					return err
 				}
-				s.Phones = &elem
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phones\"")
+				return errors.Wrap(err, "decode field \"password\"")
 			}
-		case "phone_search":
+		case "created_at":
 			if err := func() error {
-				s.PhoneSearch.Reset()
-				if err := s.PhoneSearch.Decode(d); err != nil {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone_search\"")
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
-		case "phone1":
+		case "updated_at":
 			if err := func() error {
-				s.Phone1.Reset()
-				if err := s.Phone1.Decode(d); err != nil {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone1\"")
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
-		case "phone1_type":
-			if err := func() error {
-				s.Phone1Type.Reset()
-				if err := s.Phone1Type.Decode(d); err != nil {
-					return err
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DatasourceEmail")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00101110,
+		0b00010110,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfDatasourceEmail) {
+					name = jsonFieldsNameOfDatasourceEmail[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone1_type\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "phone1_country":
-			if err := func() error {
-				s.Phone1Country.Reset()
-				if err := s.Phone1Country.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone1_country\"")
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DatasourceEmail) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DatasourceEmail) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *DatasourceEmailGraph) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *DatasourceEmailGraph) encodeFields(e *jx.Encoder) {
+	{
+		if s.UUID.Set {
+			e.FieldStart("uuid")
+			s.UUID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("user_uuid")
+		e.Str(s.UserUUID)
+	}
+	{
+		e.FieldStart("email")
+		e.Str(s.Email)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.IsEnabled.Set {
+			e.FieldStart("is_enabled")
+			s.IsEnabled.Encode(e)
+		}
+	}
+	{
+		if s.Provider.Set {
+			e.FieldStart("provider")
+			s.Provider.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("oauth2_client_uuid")
+		e.Str(s.OAuth2ClientUUID)
+	}
+	{
+		if s.Folders != nil {
+			e.FieldStart("folders")
+			e.ArrStart()
+			for _, elem := range s.Folders {
+				e.Str(elem)
 			}
-		case "phone2":
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.NeedsReauth.Set {
+			e.FieldStart("needs_reauth")
+			s.NeedsReauth.Encode(e)
+		}
+	}
+	{
+		if s.ReauthReason.Set {
+			e.FieldStart("reauth_reason")
+			s.ReauthReason.Encode(e)
+		}
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfDatasourceEmailGraph = [12]string{
+	0:  "uuid",
+	1:  "user_uuid",
+	2:  "email",
+	3:  "name",
+	4:  "is_enabled",
+	5:  "provider",
+	6:  "oauth2_client_uuid",
+	7:  "folders",
+	8:  "needs_reauth",
+	9:  "reauth_reason",
+	10: "created_at",
+	11: "updated_at",
+}
+
+// Decode decodes DatasourceEmailGraph from json.
+func (s *DatasourceEmailGraph) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DatasourceEmailGraph to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "uuid":
 			if err := func() error {
-				s.Phone2.Reset()
-				if err := s.Phone2.Decode(d); err != nil {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone2\"")
+				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "phone2_type":
+		case "user_uuid":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Phone2Type.Reset()
-				if err := s.Phone2Type.Decode(d); err != nil {
+				v, err := d.Str()
+				s.UserUUID = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone2_type\"")
+				return errors.Wrap(err, "decode field \"user_uuid\"")
 			}
-		case "phone2_country":
+		case "email":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Phone2Country.Reset()
-				if err := s.Phone2Country.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Email = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone2_country\"")
+				return errors.Wrap(err, "decode field \"email\"")
 			}
-		case "phone3":
+		case "name":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Phone3.Reset()
-				if err := s.Phone3.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone3\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "phone3_type":
+		case "is_enabled":
 			if err := func() error {
-				s.Phone3Type.Reset()
-				if err := s.Phone3Type.Decode(d); err != nil {
+				s.IsEnabled.Reset()
+				if err := s.IsEnabled.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone3_type\"")
+				return errors.Wrap(err, "decode field \"is_enabled\"")
 			}
-		case "phone3_country":
+		case "provider":
 			if err := func() error {
-				s.Phone3Country.Reset()
-				if err := s.Phone3Country.Decode(d); err != nil {
+				s.Provider.Reset()
+				if err := s.Provider.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone3_country\"")
+				return errors.Wrap(err, "decode field \"provider\"")
 			}
-		case "phone4":
+		case "oauth2_client_uuid":
+			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
-				s.Phone4.Reset()
-				if err := s.Phone4.Decode(d); err != nil {
+				v, err := d.Str()
+				s.OAuth2ClientUUID = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone4\"")
+				return errors.Wrap(err, "decode field \"oauth2_client_uuid\"")
 			}
-		case "phone4_type":
+		case "folders":
 			if err := func() error {
-				s.Phone4Type.Reset()
-				if err := s.Phone4Type.Decode(d); err != nil {
+				s.Folders = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Folders = append(s.Folders, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone4_type\"")
+				return errors.Wrap(err, "decode field \"folders\"")
 			}
-		case "phone4_country":
+		case "needs_reauth":
 			if err := func() error {
-				s.Phone4Country.Reset()
-				if err := s.Phone4Country.Decode(d); err != nil {
+				s.NeedsReauth.Reset()
+				if err := s.NeedsReauth.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone4_country\"")
+				return errors.Wrap(err, "decode field \"needs_reauth\"")
 			}
-		case "phone5":
+		case "reauth_reason":
 			if err := func() error {
-				s.Phone5.Reset()
-				if err := s.Phone5.Decode(d); err != nil {
+				s.ReauthReason.Reset()
+				if err := s.ReauthReason.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone5\"")
+				return errors.Wrap(err, "decode field \"reauth_reason\"")
 			}
-		case "phone5_type":
+		case "created_at":
 			if err := func() error {
-				s.Phone5Type.Reset()
-				if err := s.Phone5Type.Decode(d); err != nil {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone5_type\"")
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
-		case "phone5_country":
+		case "updated_at":
 			if err := func() error {
-				s.Phone5Country.Reset()
-				if err := s.Phone5Country.Decode(d); err != nil {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone5_country\"")
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
-		case "emails":
-			if err := func() error {
-				s.Emails = nil
-				var elem ContactEmails
-				if err := elem.Decode(d); err != nil {
-					return err
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DatasourceEmailGraph")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b01001110,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfDatasourceEmailGraph) {
+					name = jsonFieldsNameOfDatasourceEmailGraph[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				s.Emails = &elem
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"emails\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "email_search":
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DatasourceEmailGraph) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DatasourceEmailGraph) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *DatasourceEmailOAuth) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *DatasourceEmailOAuth) encodeFields(e *jx.Encoder) {
+	{
+		if s.UUID.Set {
+			e.FieldStart("uuid")
+			s.UUID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("user_uuid")
+		e.Str(s.UserUUID)
+	}
+	{
+		e.FieldStart("email")
+		e.Str(s.Email)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.IsEnabled.Set {
+			e.FieldStart("is_enabled")
+			s.IsEnabled.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("provider")
+		e.Str(s.Provider)
+	}
+	{
+		e.FieldStart("oauth2_client_uuid")
+		e.Str(s.OAuth2ClientUUID)
+	}
+	{
+		if s.OAuth2TokenUUID.Set {
+			e.FieldStart("oauth2_token_uuid")
+			s.OAuth2TokenUUID.Encode(e)
+		}
+	}
+	{
+		if s.NeedsReauth.Set {
+			e.FieldStart("needs_reauth")
+			s.NeedsReauth.Encode(e)
+		}
+	}
+	{
+		if s.ReauthReason.Set {
+			e.FieldStart("reauth_reason")
+			s.ReauthReason.Encode(e)
+		}
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfDatasourceEmailOAuth = [12]string{
+	0:  "uuid",
+	1:  "user_uuid",
+	2:  "email",
+	3:  "name",
+	4:  "is_enabled",
+	5:  "provider",
+	6:  "oauth2_client_uuid",
+	7:  "oauth2_token_uuid",
+	8:  "needs_reauth",
+	9:  "reauth_reason",
+	10: "created_at",
+	11: "updated_at",
+}
+
+// Decode decodes DatasourceEmailOAuth from json.
+func (s *DatasourceEmailOAuth) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DatasourceEmailOAuth to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "uuid":
 			if err := func() error {
-				s.EmailSearch.Reset()
-				if err := s.EmailSearch.Decode(d); err != nil {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email_search\"")
+				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "email1":
+		case "user_uuid":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Email1.Reset()
-				if err := s.Email1.Decode(d); err != nil {
+				v, err := d.Str()
+				s.UserUUID = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email1\"")
+				return errors.Wrap(err, "decode field \"user_uuid\"")
 			}
-		case "email1_type":
+		case "email":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Email1Type.Reset()
-				if err := s.Email1Type.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Email = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email1_type\"")
+				return errors.Wrap(err, "decode field \"email\"")
 			}
-		case "email2":
+		case "name":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Email2.Reset()
-				if err := s.Email2.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email2\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "email2_type":
+		case "is_enabled":
 			if err := func() error {
-				s.Email2Type.Reset()
-				if err := s.Email2Type.Decode(d); err != nil {
+				s.IsEnabled.Reset()
+				if err := s.IsEnabled.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email2_type\"")
+				return errors.Wrap(err, "decode field \"is_enabled\"")
 			}
-		case "email3":
+		case "provider":
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				s.Email3.Reset()
-				if err := s.Email3.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Provider = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email3\"")
+				return errors.Wrap(err, "decode field \"provider\"")
 			}
-		case "email3_type":
+		case "oauth2_client_uuid":
+			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
-				s.Email3Type.Reset()
-				if err := s.Email3Type.Decode(d); err != nil {
+				v, err := d.Str()
+				s.OAuth2ClientUUID = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email3_type\"")
+				return errors.Wrap(err, "decode field \"oauth2_client_uuid\"")
 			}
-		case "email4":
+		case "oauth2_token_uuid":
 			if err := func() error {
-				s.Email4.Reset()
-				if err := s.Email4.Decode(d); err != nil {
+				s.OAuth2TokenUUID.Reset()
+				if err := s.OAuth2TokenUUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email4\"")
+				return errors.Wrap(err, "decode field \"oauth2_token_uuid\"")
 			}
-		case "email4_type":
+		case "needs_reauth":
 			if err := func() error {
-				s.Email4Type.Reset()
-				if err := s.Email4Type.Decode(d); err != nil {
+				s.NeedsReauth.Reset()
+				if err := s.NeedsReauth.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email4_type\"")
+				return errors.Wrap(err, "decode field \"needs_reauth\"")
 			}
-		case "email5":
+		case "reauth_reason":
 			if err := func() error {
-				s.Email5.Reset()
-				if err := s.Email5.Decode(d); err != nil {
+				s.ReauthReason.Reset()
+				if err := s.ReauthReason.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email5\"")
+				return errors.Wrap(err, "decode field \"reauth_reason\"")
 			}
-		case "email5_type":
+		case "created_at":
 			if err := func() error {
-				s.Email5Type.Reset()
-				if err := s.Email5Type.Decode(d); err != nil {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email5_type\"")
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
-		case "messengers":
+		case "updated_at":
 			if err := func() error {
-				s.Messengers = nil
-				var elem ContactMessengers
-				if err := elem.Decode(d); err != nil {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
-				s.Messengers = &elem
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"messengers\"")
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
-		case "messengers_search":
-			if err := func() error {
-				s.MessengersSearch.Reset()
-				if err := s.MessengersSearch.Decode(d); err != nil {
-					return err
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DatasourceEmailOAuth")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b01101110,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfDatasourceEmailOAuth) {
+					name = jsonFieldsNameOfDatasourceEmailOAuth[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"messengers_search\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "skype_uuid":
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DatasourceEmailOAuth) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DatasourceEmailOAuth) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *DatasourceLinkedin) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *DatasourceLinkedin) encodeFields(e *jx.Encoder) {
+	{
+		if s.UUID.Set {
+			e.FieldStart("uuid")
+			s.UUID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("user_uuid")
+		e.Str(s.UserUUID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.IsEnabled.Set {
+			e.FieldStart("is_enabled")
+			s.IsEnabled.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("provider")
+		e.Str(s.Provider)
+	}
+	{
+		e.FieldStart("username")
+		e.Str(s.Username)
+	}
+	{
+		e.FieldStart("password")
+		e.Str(s.Password)
+	}
+	{
+		if s.Settings.Set {
+			e.FieldStart("settings")
+			s.Settings.Encode(e)
+		}
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfDatasourceLinkedin = [10]string{
+	0: "uuid",
+	1: "user_uuid",
+	2: "name",
+	3: "is_enabled",
+	4: "provider",
+	5: "username",
+	6: "password",
+	7: "settings",
+	8: "created_at",
+	9: "updated_at",
+}
+
+// Decode decodes DatasourceLinkedin from json.
+func (s *DatasourceLinkedin) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DatasourceLinkedin to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "uuid":
 			if err := func() error {
-				s.SkypeUUID.Reset()
-				if err := s.SkypeUUID.Decode(d); err != nil {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"skype_uuid\"")
+				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "skype":
+		case "user_uuid":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Skype.Reset()
-				if err := s.Skype.Decode(d); err != nil {
+				v, err := d.Str()
+				s.UserUUID = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"skype\"")
+				return errors.Wrap(err, "decode field \"user_uuid\"")
 			}
-		case "whatsapp_uuid":
+		case "name":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.WhatsappUUID.Reset()
-				if err := s.WhatsappUUID.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"whatsapp_uuid\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "whatsapp":
+		case "is_enabled":
 			if err := func() error {
-				s.Whatsapp.Reset()
-				if err := s.Whatsapp.Decode(d); err != nil {
+				s.IsEnabled.Reset()
+				if err := s.IsEnabled.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"whatsapp\"")
+				return errors.Wrap(err, "decode field \"is_enabled\"")
 			}
-		case "telegram_uuid":
+		case "provider":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.TelegramUUID.Reset()
-				if err := s.TelegramUUID.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Provider = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"telegram_uuid\"")
+				return errors.Wrap(err, "decode field \"provider\"")
 			}
-		case "telegram":
+		case "username":
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				s.Telegram.Reset()
-				if err := s.Telegram.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Username = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"telegram\"")
+				return errors.Wrap(err, "decode field \"username\"")
 			}
-		case "wechat_uuid":
+		case "password":
+			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
-				s.WechatUUID.Reset()
-				if err := s.WechatUUID.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Password = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"wechat_uuid\"")
+				return errors.Wrap(err, "decode field \"password\"")
 			}
-		case "wechat":
+		case "settings":
 			if err := func() error {
-				s.Wechat.Reset()
-				if err := s.Wechat.Decode(d); err != nil {
+				s.Settings.Reset()
+				if err := s.Settings.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"wechat\"")
+				return errors.Wrap(err, "decode field \"settings\"")
 			}
-		case "line_uuid":
+		case "created_at":
 			if err := func() error {
-				s.LineUUID.Reset()
-				if err := s.LineUUID.Decode(d); err != nil {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"line_uuid\"")
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
-		case "line":
+		case "updated_at":
 			if err := func() error {
-				s.Line.Reset()
-				if err := s.Line.Decode(d); err != nil {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"line\"")
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
-		case "socials":
-			if err := func() error {
-				s.Socials = nil
-				var elem ContactSocials
-				if err := elem.Decode(d); err != nil {
-					return err
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DatasourceLinkedin")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b01110110,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfDatasourceLinkedin) {
+					name = jsonFieldsNameOfDatasourceLinkedin[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				s.Socials = &elem
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"socials\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "socials_search":
-			if err := func() error {
-				s.SocialsSearch.Reset()
-				if err := s.SocialsSearch.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"socials_search\"")
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DatasourceLinkedin) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DatasourceLinkedin) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s DatasourceLinkedinSettings) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s DatasourceLinkedinSettings) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes DatasourceLinkedinSettings from json.
+func (s *DatasourceLinkedinSettings) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DatasourceLinkedinSettings to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
 			}
-		case "linkedin_uuid":
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DatasourceLinkedinSettings")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s DatasourceLinkedinSettings) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DatasourceLinkedinSettings) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *DatasourceSetOAuth2ClientReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *DatasourceSetOAuth2ClientReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("client_id")
+		e.Str(s.ClientID)
+	}
+}
+
+var jsonFieldsNameOfDatasourceSetOAuth2ClientReq = [1]string{
+	0: "client_id",
+}
+
+// Decode decodes DatasourceSetOAuth2ClientReq from json.
+func (s *DatasourceSetOAuth2ClientReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DatasourceSetOAuth2ClientReq to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "client_id":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.LinkedinUUID.Reset()
-				if err := s.LinkedinUUID.Decode(d); err != nil {
+				v, err := d.Str()
+				s.ClientID = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"linkedin_uuid\"")
+				return errors.Wrap(err, "decode field \"client_id\"")
 			}
-		case "linkedin_url":
-			if err := func() error {
-				s.LinkedinURL.Reset()
-				if err := s.LinkedinURL.Decode(d); err != nil {
-					return err
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DatasourceSetOAuth2ClientReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfDatasourceSetOAuth2ClientReq) {
+					name = jsonFieldsNameOfDatasourceSetOAuth2ClientReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"linkedin_url\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "facebook_uuid":
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DatasourceSetOAuth2ClientReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DatasourceSetOAuth2ClientReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *DatasourceTelegram) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *DatasourceTelegram) encodeFields(e *jx.Encoder) {
+	{
+		if s.UUID.Set {
+			e.FieldStart("uuid")
+			s.UUID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("user_uuid")
+		e.Str(s.UserUUID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.IsEnabled.Set {
+			e.FieldStart("is_enabled")
+			s.IsEnabled.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("provider")
+		e.Str(s.Provider)
+	}
+	{
+		e.FieldStart("phone_number")
+		e.Str(s.PhoneNumber)
+	}
+	{
+		e.FieldStart("api_id")
+		e.Str(s.APIID)
+	}
+	{
+		e.FieldStart("api_hash")
+		e.Str(s.APIHash)
+	}
+	{
+		if s.Password.Set {
+			e.FieldStart("password")
+			s.Password.Encode(e)
+		}
+	}
+	{
+		if s.Settings.Set {
+			e.FieldStart("settings")
+			s.Settings.Encode(e)
+		}
+	}
+	{
+		if s.SessionHistory != nil {
+			e.FieldStart("sessionHistory")
+			s.SessionHistory.Encode(e)
+		}
+	}
+	{
+		if s.Participants != nil {
+			e.FieldStart("participants")
+			s.Participants.Encode(e)
+		}
+	}
+	{
+		if s.Meta.Set {
+			e.FieldStart("meta")
+			s.Meta.Encode(e)
+		}
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfDatasourceTelegram = [15]string{
+	0:  "uuid",
+	1:  "user_uuid",
+	2:  "name",
+	3:  "is_enabled",
+	4:  "provider",
+	5:  "phone_number",
+	6:  "api_id",
+	7:  "api_hash",
+	8:  "password",
+	9:  "settings",
+	10: "sessionHistory",
+	11: "participants",
+	12: "meta",
+	13: "created_at",
+	14: "updated_at",
+}
+
+// Decode decodes DatasourceTelegram from json.
+func (s *DatasourceTelegram) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DatasourceTelegram to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "uuid":
 			if err := func() error {
-				s.FacebookUUID.Reset()
-				if err := s.FacebookUUID.Decode(d); err != nil {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"facebook_uuid\"")
+				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "facebook_url":
+		case "user_uuid":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.FacebookURL.Reset()
-				if err := s.FacebookURL.Decode(d); err != nil {
+				v, err := d.Str()
+				s.UserUUID = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"facebook_url\"")
+				return errors.Wrap(err, "decode field \"user_uuid\"")
 			}
-		case "twitter_uuid":
+		case "name":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.TwitterUUID.Reset()
-				if err := s.TwitterUUID.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"twitter_uuid\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "twitter_url":
+		case "is_enabled":
 			if err := func() error {
-				s.TwitterURL.Reset()
-				if err := s.TwitterURL.Decode(d); err != nil {
+				s.IsEnabled.Reset()
+				if err := s.IsEnabled.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"twitter_url\"")
+				return errors.Wrap(err, "decode field \"is_enabled\"")
 			}
-		case "github_uuid":
+		case "provider":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.GithubUUID.Reset()
-				if err := s.GithubUUID.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Provider = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"github_uuid\"")
+				return errors.Wrap(err, "decode field \"provider\"")
 			}
-		case "github_url":
+		case "phone_number":
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				s.GithubURL.Reset()
-				if err := s.GithubURL.Decode(d); err != nil {
+				v, err := d.Str()
+				s.PhoneNumber = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"github_url\"")
+				return errors.Wrap(err, "decode field \"phone_number\"")
 			}
-		case "vk_uuid":
+		case "api_id":
+			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
-				s.VkUUID.Reset()
-				if err := s.VkUUID.Decode(d); err != nil {
+				v, err := d.Str()
+				s.APIID = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vk_uuid\"")
+				return errors.Wrap(err, "decode field \"api_id\"")
 			}
-		case "vk_url":
+		case "api_hash":
+			requiredBitSet[0] |= 1 << 7
 			if err := func() error {
-				s.VkURL.Reset()
-				if err := s.VkURL.Decode(d); err != nil {
+				v, err := d.Str()
+				s.APIHash = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vk_url\"")
+				return errors.Wrap(err, "decode field \"api_hash\"")
 			}
-		case "odno_uuid":
+		case "password":
 			if err := func() error {
-				s.OdnoUUID.Reset()
-				if err := s.OdnoUUID.Decode(d); err != nil {
+				s.Password.Reset()
+				if err := s.Password.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"odno_uuid\"")
+				return errors.Wrap(err, "decode field \"password\"")
 			}
-		case "odno_url":
+		case "settings":
 			if err := func() error {
-				s.OdnoURL.Reset()
-				if err := s.OdnoURL.Decode(d); err != nil {
+				s.Settings.Reset()
+				if err := s.Settings.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"odno_url\"")
+				return errors.Wrap(err, "decode field \"settings\"")
 			}
-		case "hhru_uuid":
+		case "sessionHistory":
 			if err := func() error {
-				s.HhruUUID.Reset()
-				if err := s.HhruUUID.Decode(d); err != nil {
+				if err := s.SessionHistory.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"hhru_uuid\"")
+				return errors.Wrap(err, "decode field \"sessionHistory\"")
 			}
-		case "hhru_url":
+		case "participants":
 			if err := func() error {
-				s.HhruURL.Reset()
-				if err := s.HhruURL.Decode(d); err != nil {
+				if err := s.Participants.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"hhru_url\"")
+				return errors.Wrap(err, "decode field \"participants\"")
 			}
-		case "habr_uuid":
+		case "meta":
 			if err := func() error {
-				s.HabrUUID.Reset()
-				if err := s.HabrUUID.Decode(d); err != nil {
+				s.Meta.Reset()
+				if err := s.Meta.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"habr_uuid\"")
+				return errors.Wrap(err, "decode field \"meta\"")
 			}
-		case "habr_url":
+		case "created_at":
 			if err := func() error {
-				s.HabrURL.Reset()
-				if err := s.HabrURL.Decode(d); err != nil {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"habr_url\"")
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
-		case "moikrug_uuid":
+		case "updated_at":
 			if err := func() error {
-				s.MoikrugUUID.Reset()
-				if err := s.MoikrugUUID.Decode(d); err != nil {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"moikrug_uuid\"")
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
-		case "moikrug_url":
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DatasourceTelegram")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b11110110,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfDatasourceTelegram) {
+					name = jsonFieldsNameOfDatasourceTelegram[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DatasourceTelegram) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DatasourceTelegram) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s DatasourceTelegramMeta) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s DatasourceTelegramMeta) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes DatasourceTelegramMeta from json.
+func (s *DatasourceTelegramMeta) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DatasourceTelegramMeta to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DatasourceTelegramMeta")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s DatasourceTelegramMeta) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DatasourceTelegramMeta) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s DatasourceTelegramSettings) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s DatasourceTelegramSettings) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes DatasourceTelegramSettings from json.
+func (s *DatasourceTelegramSettings) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DatasourceTelegramSettings to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DatasourceTelegramSettings")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s DatasourceTelegramSettings) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DatasourceTelegramSettings) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *DatasourceWhatsapp) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *DatasourceWhatsapp) encodeFields(e *jx.Encoder) {
+	{
+		if s.UUID.Set {
+			e.FieldStart("uuid")
+			s.UUID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("user_uuid")
+		e.Str(s.UserUUID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.IsEnabled.Set {
+			e.FieldStart("is_enabled")
+			s.IsEnabled.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("provider")
+		e.Str(s.Provider)
+	}
+	{
+		e.FieldStart("phone_number")
+		e.Str(s.PhoneNumber)
+	}
+	{
+		if s.DeviceName.Set {
+			e.FieldStart("device_name")
+			s.DeviceName.Encode(e)
+		}
+	}
+	{
+		if s.Settings.Set {
+			e.FieldStart("settings")
+			s.Settings.Encode(e)
+		}
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfDatasourceWhatsapp = [10]string{
+	0: "uuid",
+	1: "user_uuid",
+	2: "name",
+	3: "is_enabled",
+	4: "provider",
+	5: "phone_number",
+	6: "device_name",
+	7: "settings",
+	8: "created_at",
+	9: "updated_at",
+}
+
+// Decode decodes DatasourceWhatsapp from json.
+func (s *DatasourceWhatsapp) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DatasourceWhatsapp to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "uuid":
 			if err := func() error {
-				s.MoikrugURL.Reset()
-				if err := s.MoikrugURL.Decode(d); err != nil {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"moikrug_url\"")
+				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "instagram_uuid":
+		case "user_uuid":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.InstagramUUID.Reset()
-				if err := s.InstagramUUID.Decode(d); err != nil {
+				v, err := d.Str()
+				s.UserUUID = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"instagram_uuid\"")
+				return errors.Wrap(err, "decode field \"user_uuid\"")
 			}
-		case "instagram_url":
+		case "name":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.InstagramURL.Reset()
-				if err := s.InstagramURL.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"instagram_url\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "social1_uuid":
+		case "is_enabled":
 			if err := func() error {
-				s.Social1UUID.Reset()
-				if err := s.Social1UUID.Decode(d); err != nil {
+				s.IsEnabled.Reset()
+				if err := s.IsEnabled.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social1_uuid\"")
+				return errors.Wrap(err, "decode field \"is_enabled\"")
 			}
-		case "social1_url":
+		case "provider":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.Social1URL.Reset()
-				if err := s.Social1URL.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Provider = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social1_url\"")
+				return errors.Wrap(err, "decode field \"provider\"")
 			}
-		case "social1_type":
+		case "phone_number":
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				s.Social1Type.Reset()
-				if err := s.Social1Type.Decode(d); err != nil {
+				v, err := d.Str()
+				s.PhoneNumber = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social1_type\"")
+				return errors.Wrap(err, "decode field \"phone_number\"")
 			}
-		case "social2_uuid":
+		case "device_name":
 			if err := func() error {
-				s.Social2UUID.Reset()
-				if err := s.Social2UUID.Decode(d); err != nil {
+				s.DeviceName.Reset()
+				if err := s.DeviceName.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social2_uuid\"")
+				return errors.Wrap(err, "decode field \"device_name\"")
 			}
-		case "social2_url":
+		case "settings":
 			if err := func() error {
-				s.Social2URL.Reset()
-				if err := s.Social2URL.Decode(d); err != nil {
+				s.Settings.Reset()
+				if err := s.Settings.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social2_url\"")
+				return errors.Wrap(err, "decode field \"settings\"")
 			}
-		case "social2_type":
+		case "created_at":
 			if err := func() error {
-				s.Social2Type.Reset()
-				if err := s.Social2Type.Decode(d); err != nil {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social2_type\"")
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
-		case "social3_uuid":
+		case "updated_at":
 			if err := func() error {
-				s.Social3UUID.Reset()
-				if err := s.Social3UUID.Decode(d); err != nil {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social3_uuid\"")
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
-		case "social3_url":
-			if err := func() error {
-				s.Social3URL.Reset()
-				if err := s.Social3URL.Decode(d); err != nil {
-					return err
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DatasourceWhatsapp")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00110110,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfDatasourceWhatsapp) {
+					name = jsonFieldsNameOfDatasourceWhatsapp[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social3_url\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "social3_type":
-			if err := func() error {
-				s.Social3Type.Reset()
-				if err := s.Social3Type.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social3_type\"")
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DatasourceWhatsapp) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DatasourceWhatsapp) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s DatasourceWhatsappSettings) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s DatasourceWhatsappSettings) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes DatasourceWhatsappSettings from json.
+func (s *DatasourceWhatsappSettings) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DatasourceWhatsappSettings to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
 			}
-		case "social4_uuid":
-			if err := func() error {
-				s.Social4UUID.Reset()
-				if err := s.Social4UUID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social4_uuid\"")
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DatasourceWhatsappSettings")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s DatasourceWhatsappSettings) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DatasourceWhatsappSettings) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *EmailSend) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *EmailSend) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("to")
+		e.ArrStart()
+		for _, elem := range s.To {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		if s.Cc != nil {
+			e.FieldStart("cc")
+			e.ArrStart()
+			for _, elem := range s.Cc {
+				e.Str(elem)
 			}
-		case "social4_url":
-			if err := func() error {
-				s.Social4URL.Reset()
-				if err := s.Social4URL.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social4_url\"")
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Bcc != nil {
+			e.FieldStart("bcc")
+			e.ArrStart()
+			for _, elem := range s.Bcc {
+				e.Str(elem)
 			}
-		case "social4_type":
+			e.ArrEnd()
+		}
+	}
+	{
+		e.FieldStart("subject")
+		e.Str(s.Subject)
+	}
+	{
+		e.FieldStart("body")
+		e.Str(s.Body)
+	}
+	{
+		if s.ContentType.Set {
+			e.FieldStart("content_type")
+			s.ContentType.Encode(e)
+		}
+	}
+	{
+		if s.SaveToSentItems.Set {
+			e.FieldStart("save_to_sent_items")
+			s.SaveToSentItems.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfEmailSend = [7]string{
+	0: "to",
+	1: "cc",
+	2: "bcc",
+	3: "subject",
+	4: "body",
+	5: "content_type",
+	6: "save_to_sent_items",
+}
+
+// Decode decodes EmailSend from json.
+func (s *EmailSend) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EmailSend to nil")
+	}
+	var requiredBitSet [1]uint8
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "to":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Social4Type.Reset()
-				if err := s.Social4Type.Decode(d); err != nil {
+				s.To = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.To = append(s.To, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social4_type\"")
+				return errors.Wrap(err, "decode field \"to\"")
 			}
-		case "social5_uuid":
+		case "cc":
 			if err := func() error {
-				s.Social5UUID.Reset()
-				if err := s.Social5UUID.Decode(d); err != nil {
+				s.Cc = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Cc = append(s.Cc, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social5_uuid\"")
+				return errors.Wrap(err, "decode field \"cc\"")
 			}
-		case "social5_url":
+		case "bcc":
 			if err := func() error {
-				s.Social5URL.Reset()
-				if err := s.Social5URL.Decode(d); err != nil {
+				s.Bcc = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Bcc = append(s.Bcc, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social5_url\"")
+				return errors.Wrap(err, "decode field \"bcc\"")
 			}
-		case "social5_type":
+		case "subject":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Social5Type.Reset()
-				if err := s.Social5Type.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Subject = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social5_type\"")
+				return errors.Wrap(err, "decode field \"subject\"")
 			}
-		case "social6_uuid":
+		case "body":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.Social6UUID.Reset()
-				if err := s.Social6UUID.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Body = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social6_uuid\"")
+				return errors.Wrap(err, "decode field \"body\"")
 			}
-		case "social6_url":
+		case "content_type":
 			if err := func() error {
-				s.Social6URL.Reset()
-				if err := s.Social6URL.Decode(d); err != nil {
+				s.ContentType.Reset()
+				if err := s.ContentType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social6_url\"")
+				return errors.Wrap(err, "decode field \"content_type\"")
 			}
-		case "social6_type":
+		case "save_to_sent_items":
 			if err := func() error {
-				s.Social6Type.Reset()
-				if err := s.Social6Type.Decode(d); err != nil {
+				s.SaveToSentItems.Reset()
+				if err := s.SaveToSentItems.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social6_type\"")
+				return errors.Wrap(err, "decode field \"save_to_sent_items\"")
 			}
-		case "social7_uuid":
-			if err := func() error {
-				s.Social7UUID.Reset()
-				if err := s.Social7UUID.Decode(d); err != nil {
-					return err
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode EmailSend")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfEmailSend) {
+					name = jsonFieldsNameOfEmailSend[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social7_uuid\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "social7_url":
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *EmailSend) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EmailSend) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EmailSendContentType as json.
+func (s EmailSendContentType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes EmailSendContentType from json.
+func (s *EmailSendContentType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EmailSendContentType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch EmailSendContentType(v) {
+	case EmailSendContentTypeText:
+		*s = EmailSendContentTypeText
+	case EmailSendContentTypeHTML:
+		*s = EmailSendContentTypeHTML
+	default:
+		*s = EmailSendContentType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s EmailSendContentType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EmailSendContentType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ErasureReport) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ErasureReport) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("message_uuids")
+		e.ArrStart()
+		for _, elem := range s.MessageUuids {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("file_uuids")
+		e.ArrStart()
+		for _, elem := range s.FileUuids {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("contact_uuids")
+		e.ArrStart()
+		for _, elem := range s.ContactUuids {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		if s.MessagesDeleted.Set {
+			e.FieldStart("messages_deleted")
+			s.MessagesDeleted.Encode(e)
+		}
+	}
+	{
+		if s.MessagesAnonymized.Set {
+			e.FieldStart("messages_anonymized")
+			s.MessagesAnonymized.Encode(e)
+		}
+	}
+	{
+		if s.FilesDeleted.Set {
+			e.FieldStart("files_deleted")
+			s.FilesDeleted.Encode(e)
+		}
+	}
+	{
+		if s.BytesDeleted.Set {
+			e.FieldStart("bytes_deleted")
+			s.BytesDeleted.Encode(e)
+		}
+	}
+	{
+		if s.ContactsDeleted.Set {
+			e.FieldStart("contacts_deleted")
+			s.ContactsDeleted.Encode(e)
+		}
+	}
+	{
+		if s.ContactsAnonymized.Set {
+			e.FieldStart("contacts_anonymized")
+			s.ContactsAnonymized.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfErasureReport = [9]string{
+	0: "message_uuids",
+	1: "file_uuids",
+	2: "contact_uuids",
+	3: "messages_deleted",
+	4: "messages_anonymized",
+	5: "files_deleted",
+	6: "bytes_deleted",
+	7: "contacts_deleted",
+	8: "contacts_anonymized",
+}
+
+// Decode decodes ErasureReport from json.
+func (s *ErasureReport) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ErasureReport to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "message_uuids":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Social7URL.Reset()
-				if err := s.Social7URL.Decode(d); err != nil {
+				s.MessageUuids = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.MessageUuids = append(s.MessageUuids, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social7_url\"")
+				return errors.Wrap(err, "decode field \"message_uuids\"")
 			}
-		case "social7_type":
+		case "file_uuids":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Social7Type.Reset()
-				if err := s.Social7Type.Decode(d); err != nil {
+				s.FileUuids = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.FileUuids = append(s.FileUuids, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social7_type\"")
+				return errors.Wrap(err, "decode field \"file_uuids\"")
 			}
-		case "social8_uuid":
+		case "contact_uuids":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Social8UUID.Reset()
-				if err := s.Social8UUID.Decode(d); err != nil {
+				s.ContactUuids = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.ContactUuids = append(s.ContactUuids, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social8_uuid\"")
+				return errors.Wrap(err, "decode field \"contact_uuids\"")
 			}
-		case "social8_url":
+		case "messages_deleted":
 			if err := func() error {
-				s.Social8URL.Reset()
-				if err := s.Social8URL.Decode(d); err != nil {
+				s.MessagesDeleted.Reset()
+				if err := s.MessagesDeleted.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social8_url\"")
+				return errors.Wrap(err, "decode field \"messages_deleted\"")
 			}
-		case "social8_type":
+		case "messages_anonymized":
 			if err := func() error {
-				s.Social8Type.Reset()
-				if err := s.Social8Type.Decode(d); err != nil {
+				s.MessagesAnonymized.Reset()
+				if err := s.MessagesAnonymized.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social8_type\"")
+				return errors.Wrap(err, "decode field \"messages_anonymized\"")
 			}
-		case "social9_uuid":
+		case "files_deleted":
 			if err := func() error {
-				s.Social9UUID.Reset()
-				if err := s.Social9UUID.Decode(d); err != nil {
+				s.FilesDeleted.Reset()
+				if err := s.FilesDeleted.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social9_uuid\"")
+				return errors.Wrap(err, "decode field \"files_deleted\"")
 			}
-		case "social9_url":
+		case "bytes_deleted":
 			if err := func() error {
-				s.Social9URL.Reset()
-				if err := s.Social9URL.Decode(d); err != nil {
+				s.BytesDeleted.Reset()
+				if err := s.BytesDeleted.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social9_url\"")
+				return errors.Wrap(err, "decode field \"bytes_deleted\"")
 			}
-		case "social9_type":
+		case "contacts_deleted":
 			if err := func() error {
-				s.Social9Type.Reset()
-				if err := s.Social9Type.Decode(d); err != nil {
+				s.ContactsDeleted.Reset()
+				if err := s.ContactsDeleted.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"social9_type\"")
+				return errors.Wrap(err, "decode field \"contacts_deleted\"")
 			}
-		case "tracking_source":
+		case "contacts_anonymized":
 			if err := func() error {
-				s.TrackingSource.Reset()
-				if err := s.TrackingSource.Decode(d); err != nil {
+				s.ContactsAnonymized.Reset()
+				if err := s.ContactsAnonymized.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tracking_source\"")
+				return errors.Wrap(err, "decode field \"contacts_anonymized\"")
 			}
-		case "tracking_slug":
-			if err := func() error {
-				s.TrackingSlug.Reset()
-				if err := s.TrackingSlug.Decode(d); err != nil {
-					return err
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ErasureReport")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00000111,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfErasureReport) {
+					name = jsonFieldsNameOfErasureReport[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tracking_slug\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "cached_img":
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ErasureReport) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ErasureReport) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ErasureRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ErasureRequest) encodeFields(e *jx.Encoder) {
+	{
+		if s.UUID.Set {
+			e.FieldStart("uuid")
+			s.UUID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("subject")
+		e.Str(s.Subject)
+	}
+	{
+		e.FieldStart("mode")
+		s.Mode.Encode(e)
+	}
+	{
+		if s.DryRun.Set {
+			e.FieldStart("dry_run")
+			s.DryRun.Encode(e)
+		}
+	}
+	{
+		if s.Status.Set {
+			e.FieldStart("status")
+			s.Status.Encode(e)
+		}
+	}
+	{
+		if s.Report.Set {
+			e.FieldStart("report")
+			s.Report.Encode(e)
+		}
+	}
+	{
+		if s.Error.Set {
+			e.FieldStart("error")
+			s.Error.Encode(e)
+		}
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.FinishedAt.Set {
+			e.FieldStart("finished_at")
+			s.FinishedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfErasureRequest = [9]string{
+	0: "uuid",
+	1: "subject",
+	2: "mode",
+	3: "dry_run",
+	4: "status",
+	5: "report",
+	6: "error",
+	7: "created_at",
+	8: "finished_at",
+}
+
+// Decode decodes ErasureRequest from json.
+func (s *ErasureRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ErasureRequest to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "uuid":
 			if err := func() error {
-				s.CachedImg.Reset()
-				if err := s.CachedImg.Decode(d); err != nil {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"cached_img\"")
+				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "cached_img_data":
+		case "subject":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.CachedImgData = nil
-				var elem ContactCachedImgData
-				if err := elem.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Subject = string(v)
+				if err != nil {
 					return err
 				}
-				s.CachedImgData = &elem
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"cached_img_data\"")
+				return errors.Wrap(err, "decode field \"subject\"")
 			}
-		case "crawl":
+		case "mode":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Crawl = nil
-				var elem ContactCrawl
-				if err := elem.Decode(d); err != nil {
+				if err := s.Mode.Decode(d); err != nil {
 					return err
 				}
-				s.Crawl = &elem
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"crawl\"")
+				return errors.Wrap(err, "decode field \"mode\"")
 			}
-		case "duplicate_user_id":
+		case "dry_run":
 			if err := func() error {
-				s.DuplicateUserID.Reset()
-				if err := s.DuplicateUserID.Decode(d); err != nil {
+				s.DryRun.Reset()
+				if err := s.DryRun.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"duplicate_user_id\"")
+				return errors.Wrap(err, "decode field \"dry_run\"")
 			}
-		case "duplicate_alternative_id":
+		case "status":
 			if err := func() error {
-				s.DuplicateAlternativeID.Reset()
-				if err := s.DuplicateAlternativeID.Decode(d); err != nil {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"duplicate_alternative_id\"")
+				return errors.Wrap(err, "decode field \"status\"")
 			}
-		case "duplicate_report_date":
+		case "report":
 			if err := func() error {
-				s.DuplicateReportDate.Reset()
-				if err := s.DuplicateReportDate.Decode(d, json.DecodeDateTime); err != nil {
+				s.Report.Reset()
+				if err := s.Report.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"duplicate_report_date\"")
+				return errors.Wrap(err, "decode field \"report\"")
 			}
-		case "entry_date":
+		case "error":
 			if err := func() error {
-				s.EntryDate.Reset()
-				if err := s.EntryDate.Decode(d, json.DecodeDateTime); err != nil {
+				s.Error.Reset()
+				if err := s.Error.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"entry_date\"")
+				return errors.Wrap(err, "decode field \"error\"")
 			}
-		case "edit_date":
+		case "created_at":
 			if err := func() error {
-				s.EditDate.Reset()
-				if err := s.EditDate.Decode(d, json.DecodeDateTime); err != nil {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"edit_date\"")
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
-		case "last_kpi_entry_date":
+		case "finished_at":
 			if err := func() error {
-				s.LastKpiEntryDate.Reset()
-				if err := s.LastKpiEntryDate.Decode(d, json.DecodeDateTime); err != nil {
+				s.FinishedAt.Reset()
+				if err := s.FinishedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"last_kpi_entry_date\"")
+				return errors.Wrap(err, "decode field \"finished_at\"")
 			}
 		default:
-			return d.Skip()
+			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Contact")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *Contact) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Contact) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *ContactCachedImgData) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *ContactCachedImgData) encodeFields(e *jx.Encoder) {
-}
-
-var jsonFieldsNameOfContactCachedImgData = [0]string{}
-
-// Decode decodes ContactCachedImgData from json.
-func (s *ContactCachedImgData) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ContactCachedImgData to nil")
+		return errors.Wrap(err, "decode ErasureRequest")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		default:
-			return d.Skip()
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00000110,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfErasureRequest) {
+					name = jsonFieldsNameOfErasureRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
 		}
-	}); err != nil {
-		return errors.Wrap(err, "decode ContactCachedImgData")
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ContactCachedImgData) MarshalJSON() ([]byte, error) {
+func (s *ErasureRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ContactCachedImgData) UnmarshalJSON(data []byte) error {
+func (s *ErasureRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *ContactCrawl) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *ContactCrawl) encodeFields(e *jx.Encoder) {
+// Encode encodes ErasureRequestMode as json.
+func (s ErasureRequestMode) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-var jsonFieldsNameOfContactCrawl = [0]string{}
-
-// Decode decodes ContactCrawl from json.
-func (s *ContactCrawl) Decode(d *jx.Decoder) error {
+// Decode decodes ErasureRequestMode from json.
+func (s *ErasureRequestMode) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ContactCrawl to nil")
+		return errors.New("invalid: unable to decode ErasureRequestMode to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		default:
-			return d.Skip()
-		}
-	}); err != nil {
-		return errors.Wrap(err, "decode ContactCrawl")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ErasureRequestMode(v) {
+	case ErasureRequestModeDelete:
+		*s = ErasureRequestModeDelete
+	case ErasureRequestModeAnonymize:
+		*s = ErasureRequestModeAnonymize
+	default:
+		*s = ErasureRequestMode(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ContactCrawl) MarshalJSON() ([]byte, error) {
+func (s ErasureRequestMode) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ContactCrawl) UnmarshalJSON(data []byte) error {
+func (s *ErasureRequestMode) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ContactEmails) Encode(e *jx.Encoder) {
+func (s *Error) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ContactEmails) encodeFields(e *jx.Encoder) {
-}
-
-var jsonFieldsNameOfContactEmails = [0]string{}
-
-// Decode decodes ContactEmails from json.
-func (s *ContactEmails) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ContactEmails to nil")
-	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		default:
-			return d.Skip()
+func (s *Error) encodeFields(e *jx.Encoder) {
+	{
+		if s.Detail.Set {
+			e.FieldStart("detail")
+			s.Detail.Encode(e)
 		}
-	}); err != nil {
-		return errors.Wrap(err, "decode ContactEmails")
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *ContactEmails) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ContactEmails) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *ContactLastPositions) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *ContactLastPositions) encodeFields(e *jx.Encoder) {
-}
-
-var jsonFieldsNameOfContactLastPositions = [0]string{}
-
-// Decode decodes ContactLastPositions from json.
-func (s *ContactLastPositions) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ContactLastPositions to nil")
+	{
+		if s.Errors != nil {
+			e.FieldStart("errors")
+			e.ArrStart()
+			for _, elem := range s.Errors {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		default:
-			return d.Skip()
+	{
+		if s.Status.Set {
+			e.FieldStart("status")
+			s.Status.Encode(e)
 		}
-	}); err != nil {
-		return errors.Wrap(err, "decode ContactLastPositions")
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *ContactLastPositions) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ContactLastPositions) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *ContactMessengers) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
 }
 
-// encodeFields encodes fields.
-func (s *ContactMessengers) encodeFields(e *jx.Encoder) {
+var jsonFieldsNameOfError = [3]string{
+	0: "detail",
+	1: "errors",
+	2: "status",
 }
 
-var jsonFieldsNameOfContactMessengers = [0]string{}
-
-// Decode decodes ContactMessengers from json.
-func (s *ContactMessengers) Decode(d *jx.Decoder) error {
+// Decode decodes Error from json.
+func (s *Error) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ContactMessengers to nil")
+		return errors.New("invalid: unable to decode Error to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
+		case "detail":
+			if err := func() error {
+				s.Detail.Reset()
+				if err := s.Detail.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"detail\"")
+			}
+		case "errors":
+			if err := func() error {
+				s.Errors = make([]ErrorErrorsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ErrorErrorsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Errors = append(s.Errors, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"errors\"")
+			}
+		case "status":
+			if err := func() error {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
 		default:
-			return d.Skip()
+			return errors.Errorf("unexpected field %q", k)
 		}
+		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ContactMessengers")
+		return errors.Wrap(err, "decode Error")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ContactMessengers) MarshalJSON() ([]byte, error) {
+func (s *Error) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ContactMessengers) UnmarshalJSON(data []byte) error {
+func (s *Error) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ContactNames) Encode(e *jx.Encoder) {
+func (s *ErrorErrorsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ContactNames) encodeFields(e *jx.Encoder) {
+func (s *ErrorErrorsItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.Location.Set {
+			e.FieldStart("location")
+			s.Location.Encode(e)
+		}
+	}
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
+	}
+	{
+		if s.Value.Set {
+			e.FieldStart("value")
+			s.Value.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfContactNames = [0]string{}
+var jsonFieldsNameOfErrorErrorsItem = [3]string{
+	0: "location",
+	1: "message",
+	2: "value",
+}
 
-// Decode decodes ContactNames from json.
-func (s *ContactNames) Decode(d *jx.Decoder) error {
+// Decode decodes ErrorErrorsItem from json.
+func (s *ErrorErrorsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ContactNames to nil")
+		return errors.New("invalid: unable to decode ErrorErrorsItem to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
+		case "location":
+			if err := func() error {
+				s.Location.Reset()
+				if err := s.Location.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"location\"")
+			}
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		case "value":
+			if err := func() error {
+				s.Value.Reset()
+				if err := s.Value.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"value\"")
+			}
 		default:
-			return d.Skip()
+			return errors.Errorf("unexpected field %q", k)
 		}
+		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ContactNames")
+		return errors.Wrap(err, "decode ErrorErrorsItem")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ContactNames) MarshalJSON() ([]byte, error) {
+func (s *ErrorErrorsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ContactNames) UnmarshalJSON(data []byte) error {
+func (s *ErrorErrorsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ContactNoteKpiID) Encode(e *jx.Encoder) {
+func (s *Event) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ContactNoteKpiID) encodeFields(e *jx.Encoder) {
+func (s *Event) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("uuid")
+		e.Str(s.UUID)
+	}
+	{
+		e.FieldStart("type")
+		e.Str(s.Type)
+	}
+	{
+		if s.SubjectKind.Set {
+			e.FieldStart("subject_kind")
+			s.SubjectKind.Encode(e)
+		}
+	}
+	{
+		if s.SubjectUUID.Set {
+			e.FieldStart("subject_uuid")
+			s.SubjectUUID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("data")
+		s.Data.Encode(e)
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
 }
 
-var jsonFieldsNameOfContactNoteKpiID = [0]string{}
+var jsonFieldsNameOfEvent = [6]string{
+	0: "uuid",
+	1: "type",
+	2: "subject_kind",
+	3: "subject_uuid",
+	4: "data",
+	5: "created_at",
+}
 
-// Decode decodes ContactNoteKpiID from json.
-func (s *ContactNoteKpiID) Decode(d *jx.Decoder) error {
+// Decode decodes Event from json.
+func (s *Event) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ContactNoteKpiID to nil")
+		return errors.New("invalid: unable to decode Event to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
+		case "uuid":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.UUID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uuid\"")
+			}
+		case "type":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Type = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "subject_kind":
+			if err := func() error {
+				s.SubjectKind.Reset()
+				if err := s.SubjectKind.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"subject_kind\"")
+			}
+		case "subject_uuid":
+			if err := func() error {
+				s.SubjectUUID.Reset()
+				if err := s.SubjectUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"subject_uuid\"")
+			}
+		case "data":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				if err := s.Data.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"data\"")
+			}
+		case "created_at":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
 		default:
-			return d.Skip()
+			return errors.Errorf("unexpected field %q", k)
 		}
+		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ContactNoteKpiID")
+		return errors.Wrap(err, "decode Event")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00110011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfEvent) {
+					name = jsonFieldsNameOfEvent[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ContactNoteKpiID) MarshalJSON() ([]byte, error) {
+func (s *Event) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ContactNoteKpiID) UnmarshalJSON(data []byte) error {
+func (s *Event) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ContactPhones) Encode(e *jx.Encoder) {
+func (s EventData) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields encodes fields.
-func (s *ContactPhones) encodeFields(e *jx.Encoder) {
+// encodeFields implements json.Marshaler.
+func (s EventData) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
 }
 
-var jsonFieldsNameOfContactPhones = [0]string{}
-
-// Decode decodes ContactPhones from json.
-func (s *ContactPhones) Decode(d *jx.Decoder) error {
+// Decode decodes EventData from json.
+func (s *EventData) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ContactPhones to nil")
+		return errors.New("invalid: unable to decode EventData to nil")
 	}
-
+	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		default:
-			return d.Skip()
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
+		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ContactPhones")
+		return errors.Wrap(err, "decode EventData")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ContactPhones) MarshalJSON() ([]byte, error) {
+func (s EventData) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ContactPhones) UnmarshalJSON(data []byte) error {
+func (s *EventData) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ContactSalaryData) Encode(e *jx.Encoder) {
+func (s *FileObject) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ContactSalaryData) encodeFields(e *jx.Encoder) {
+func (s *FileObject) encodeFields(e *jx.Encoder) {
+	{
+		if s.UUID.Set {
+			e.FieldStart("uuid")
+			s.UUID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("storage_type")
+		e.Str(s.StorageType)
+	}
+	{
+		e.FieldStart("storage_uuid")
+		e.Str(s.StorageUUID)
+	}
+	{
+		if s.MessageUUID.Set {
+			e.FieldStart("message_uuid")
+			s.MessageUUID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.MimeType.Set {
+			e.FieldStart("mime_type")
+			s.MimeType.Encode(e)
+		}
+	}
+	{
+		if s.Size.Set {
+			e.FieldStart("size")
+			s.Size.Encode(e)
+		}
+	}
+	{
+		if s.Data.Set {
+			e.FieldStart("data")
+			s.Data.Encode(e)
+		}
+	}
+	{
+		if s.Path.Set {
+			e.FieldStart("path")
+			s.Path.Encode(e)
+		}
+	}
+	{
+		if s.IsRaw.Set {
+			e.FieldStart("is_raw")
+			s.IsRaw.Encode(e)
+		}
+	}
+	{
+		if s.RawHeaders.Set {
+			e.FieldStart("raw_headers")
+			s.RawHeaders.Encode(e)
+		}
+	}
+	{
+		if s.HasRawEmail.Set {
+			e.FieldStart("has_raw_email")
+			s.HasRawEmail.Encode(e)
+		}
+	}
+	{
+		if s.IsInline.Set {
+			e.FieldStart("is_inline")
+			s.IsInline.Encode(e)
+		}
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
 }
 
-var jsonFieldsNameOfContactSalaryData = [0]string{}
+var jsonFieldsNameOfFileObject = [15]string{
+	0:  "uuid",
+	1:  "storage_type",
+	2:  "storage_uuid",
+	3:  "message_uuid",
+	4:  "name",
+	5:  "mime_type",
+	6:  "size",
+	7:  "data",
+	8:  "path",
+	9:  "is_raw",
+	10: "raw_headers",
+	11: "has_raw_email",
+	12: "is_inline",
+	13: "created_at",
+	14: "updated_at",
+}
 
-// Decode decodes ContactSalaryData from json.
-func (s *ContactSalaryData) Decode(d *jx.Decoder) error {
+// Decode decodes FileObject from json.
+func (s *FileObject) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ContactSalaryData to nil")
+		return errors.New("invalid: unable to decode FileObject to nil")
 	}
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
+		case "uuid":
+			if err := func() error {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uuid\"")
+			}
+		case "storage_type":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.StorageType = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"storage_type\"")
+			}
+		case "storage_uuid":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.StorageUUID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"storage_uuid\"")
+			}
+		case "message_uuid":
+			if err := func() error {
+				s.MessageUUID.Reset()
+				if err := s.MessageUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message_uuid\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "mime_type":
+			if err := func() error {
+				s.MimeType.Reset()
+				if err := s.MimeType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mime_type\"")
+			}
+		case "size":
+			if err := func() error {
+				s.Size.Reset()
+				if err := s.Size.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"size\"")
+			}
+		case "data":
+			if err := func() error {
+				s.Data.Reset()
+				if err := s.Data.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"data\"")
+			}
+		case "path":
+			if err := func() error {
+				s.Path.Reset()
+				if err := s.Path.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"path\"")
+			}
+		case "is_raw":
+			if err := func() error {
+				s.IsRaw.Reset()
+				if err := s.IsRaw.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_raw\"")
+			}
+		case "raw_headers":
+			if err := func() error {
+				s.RawHeaders.Reset()
+				if err := s.RawHeaders.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"raw_headers\"")
+			}
+		case "has_raw_email":
+			if err := func() error {
+				s.HasRawEmail.Reset()
+				if err := s.HasRawEmail.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"has_raw_email\"")
+			}
+		case "is_inline":
+			if err := func() error {
+				s.IsInline.Reset()
+				if err := s.IsInline.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_inline\"")
+			}
+		case "created_at":
+			if err := func() error {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "updated_at":
+			if err := func() error {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updated_at\"")
+			}
 		default:
 			return d.Skip()
 		}
+		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ContactSalaryData")
+		return errors.Wrap(err, "decode FileObject")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00010110,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfFileObject) {
+					name = jsonFieldsNameOfFileObject[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ContactSalaryData) MarshalJSON() ([]byte, error) {
+func (s *FileObject) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ContactSalaryData) UnmarshalJSON(data []byte) error {
+func (s *FileObject) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ContactSocials) Encode(e *jx.Encoder) {
+func (s *FileUpdateReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ContactSocials) encodeFields(e *jx.Encoder) {
+func (s *FileUpdateReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
 }
 
-var jsonFieldsNameOfContactSocials = [0]string{}
+var jsonFieldsNameOfFileUpdateReq = [1]string{
+	0: "name",
+}
 
-// Decode decodes ContactSocials from json.
-func (s *ContactSocials) Decode(d *jx.Decoder) error {
+// Decode decodes FileUpdateReq from json.
+func (s *FileUpdateReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ContactSocials to nil")
+		return errors.New("invalid: unable to decode FileUpdateReq to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
 		default:
-			return d.Skip()
+			return errors.Errorf("unexpected field %q", k)
 		}
+		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ContactSocials")
+		return errors.Wrap(err, "decode FileUpdateReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfFileUpdateReq) {
+					name = jsonFieldsNameOfFileUpdateReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ContactSocials) MarshalJSON() ([]byte, error) {
+func (s *FileUpdateReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ContactSocials) UnmarshalJSON(data []byte) error {
+func (s *FileUpdateReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Datasource) Encode(e *jx.Encoder) {
+func (s *GenerateDownloadLinkRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Datasource) encodeFields(e *jx.Encoder) {
-	{
-		if s.UUID.Set {
-			e.FieldStart("uuid")
-			s.UUID.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("user_uuid")
-		e.Str(s.UserUUID)
-	}
-	{
-		e.FieldStart("type")
-		e.Str(s.Type)
-	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
-	{
-		if s.IsEnabled.Set {
-			e.FieldStart("is_enabled")
-			s.IsEnabled.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("provider")
-		e.Str(s.Provider)
-	}
+func (s *GenerateDownloadLinkRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.CreatedAt.Set {
-			e.FieldStart("created_at")
-			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		if s.FileUUID.Set {
+			e.FieldStart("file_uuid")
+			s.FileUUID.Encode(e)
 		}
 	}
 	{
-		if s.UpdatedAt.Set {
-			e.FieldStart("updated_at")
-			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		if s.Expiration.Set {
+			e.FieldStart("expiration")
+			s.Expiration.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfDatasource = [8]string{
-	0: "uuid",
-	1: "user_uuid",
-	2: "type",
-	3: "name",
-	4: "is_enabled",
-	5: "provider",
-	6: "created_at",
-	7: "updated_at",
+var jsonFieldsNameOfGenerateDownloadLinkRequest = [2]string{
+	0: "file_uuid",
+	1: "expiration",
 }
 
-// Decode decodes Datasource from json.
-func (s *Datasource) Decode(d *jx.Decoder) error {
+// Decode decodes GenerateDownloadLinkRequest from json.
+func (s *GenerateDownloadLinkRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Datasource to nil")
+		return errors.New("invalid: unable to decode GenerateDownloadLinkRequest to nil")
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "uuid":
-			if err := func() error {
-				s.UUID.Reset()
-				if err := s.UUID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uuid\"")
-			}
-		case "user_uuid":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.UserUUID = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"user_uuid\"")
-			}
-		case "type":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Str()
-				s.Type = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"type\"")
-			}
-		case "name":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "is_enabled":
-			if err := func() error {
-				s.IsEnabled.Reset()
-				if err := s.IsEnabled.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_enabled\"")
-			}
-		case "provider":
-			requiredBitSet[0] |= 1 << 5
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "file_uuid":
 			if err := func() error {
-				v, err := d.Str()
-				s.Provider = string(v)
-				if err != nil {
+				s.FileUUID.Reset()
+				if err := s.FileUUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provider\"")
+				return errors.Wrap(err, "decode field \"file_uuid\"")
 			}
-		case "created_at":
+		case "expiration":
 			if err := func() error {
-				s.CreatedAt.Reset()
-				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.Expiration.Reset()
+				if err := s.Expiration.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
+				return errors.Wrap(err, "decode field \"expiration\"")
 			}
-		case "updated_at":
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode GenerateDownloadLinkRequest")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GenerateDownloadLinkRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GenerateDownloadLinkRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *GenerateDownloadLinkResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *GenerateDownloadLinkResponse) encodeFields(e *jx.Encoder) {
+	{
+		if s.URL.Set {
+			e.FieldStart("url")
+			s.URL.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfGenerateDownloadLinkResponse = [1]string{
+	0: "url",
+}
+
+// Decode decodes GenerateDownloadLinkResponse from json.
+func (s *GenerateDownloadLinkResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GenerateDownloadLinkResponse to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "url":
 			if err := func() error {
-				s.UpdatedAt.Reset()
-				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.URL.Reset()
+				if err := s.URL.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"updated_at\"")
+				return errors.Wrap(err, "decode field \"url\"")
 			}
 		default:
-			return errors.Errorf("unexpected field %q", k)
+			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Datasource")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00101110,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfDatasource) {
-					name = jsonFieldsNameOfDatasource[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		return errors.Wrap(err, "decode GenerateDownloadLinkResponse")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Datasource) MarshalJSON() ([]byte, error) {
+func (s *GenerateDownloadLinkResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Datasource) UnmarshalJSON(data []byte) error {
+func (s *GenerateDownloadLinkResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *DatasourceEmail) Encode(e *jx.Encoder) {
+func (s *Message) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *DatasourceEmail) encodeFields(e *jx.Encoder) {
+func (s *Message) encodeFields(e *jx.Encoder) {
 	{
 		if s.UUID.Set {
 			e.FieldStart("uuid")
@@ -2876,62 +7753,122 @@ func (s *DatasourceEmail) encodeFields(e *jx.Encoder) {
 		}
 	}
 	{
-		e.FieldStart("user_uuid")
-		e.Str(s.UserUUID)
+		e.FieldStart("type")
+		e.Str(s.Type)
 	}
 	{
-		e.FieldStart("email")
-		e.Str(s.Email)
+		e.FieldStart("format")
+		e.Str(s.Format)
 	}
 	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+		if s.ChatUUID.Set {
+			e.FieldStart("chat_uuid")
+			s.ChatUUID.Encode(e)
+		}
 	}
 	{
-		if s.IsEnabled.Set {
-			e.FieldStart("is_enabled")
-			s.IsEnabled.Encode(e)
+		if s.ThreadUUID.Set {
+			e.FieldStart("thread_uuid")
+			s.ThreadUUID.Encode(e)
 		}
 	}
 	{
-		e.FieldStart("provider")
-		e.Str(s.Provider)
+		if s.ExternalMessageID.Set {
+			e.FieldStart("external_message_id")
+			s.ExternalMessageID.Encode(e)
+		}
 	}
 	{
-		if s.OAuth2ClientID.Set {
-			e.FieldStart("oauth2_client_id")
-			s.OAuth2ClientID.Encode(e)
+		if s.PipelineUUID.Set {
+			e.FieldStart("pipeline_uuid")
+			s.PipelineUUID.Encode(e)
 		}
 	}
 	{
-		if s.OAuth2ClientUUID.Set {
-			e.FieldStart("oauth2_client_uuid")
-			s.OAuth2ClientUUID.Encode(e)
+		if s.DatasourceUUID.Set {
+			e.FieldStart("datasource_uuid")
+			s.DatasourceUUID.Encode(e)
 		}
 	}
 	{
-		if s.OAuth2TokenUUID.Set {
-			e.FieldStart("oauth2_token_uuid")
-			s.OAuth2TokenUUID.Encode(e)
+		e.FieldStart("sender")
+		e.Str(s.Sender)
+	}
+	{
+		e.FieldStart("recipients")
+		e.ArrStart()
+		for _, elem := range s.Recipients {
+			e.Str(elem)
 		}
+		e.ArrEnd()
 	}
 	{
-		e.FieldStart("imap_server")
-		e.Str(s.ImapServer)
+		if s.Subject.Set {
+			e.FieldStart("subject")
+			s.Subject.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("smtp_server")
-		e.Str(s.SMTPServer)
+		e.FieldStart("body")
+		e.Str(s.Body)
 	}
 	{
-		if s.SMTPTLS.Set {
-			e.FieldStart("smtp_tls")
-			s.SMTPTLS.Encode(e)
+		if s.BodyParsed.Set {
+			e.FieldStart("body_parsed")
+			s.BodyParsed.Encode(e)
 		}
 	}
 	{
-		e.FieldStart("password")
-		e.Str(s.Password)
+		if s.Reactions.Set {
+			e.FieldStart("reactions")
+			s.Reactions.Encode(e)
+		}
+	}
+	{
+		if s.Attachments != nil {
+			e.FieldStart("attachments")
+			e.ArrStart()
+			for _, elem := range s.Attachments {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.ForwardFrom.Set {
+			e.FieldStart("forward_from")
+			s.ForwardFrom.Encode(e)
+		}
+	}
+	{
+		if s.ReplyToMessageUUID.Set {
+			e.FieldStart("reply_to_message_uuid")
+			s.ReplyToMessageUUID.Encode(e)
+		}
+	}
+	{
+		if s.ForwardFromChatUUID.Set {
+			e.FieldStart("forward_from_chat_uuid")
+			s.ForwardFromChatUUID.Encode(e)
+		}
+	}
+	{
+		if s.ForwardFromMessageUUID.Set {
+			e.FieldStart("forward_from_message_uuid")
+			s.ForwardFromMessageUUID.Encode(e)
+		}
+	}
+	{
+		if s.ForwardMeta.Set {
+			e.FieldStart("forward_meta")
+			s.ForwardMeta.Encode(e)
+		}
+	}
+	{
+		if s.Meta.Set {
+			e.FieldStart("meta")
+			s.Meta.Encode(e)
+		}
 	}
 	{
 		if s.CreatedAt.Set {
@@ -2943,34 +7880,49 @@ func (s *DatasourceEmail) encodeFields(e *jx.Encoder) {
 		if s.UpdatedAt.Set {
 			e.FieldStart("updated_at")
 			s.UpdatedAt.Encode(e, json.EncodeDateTime)
-		}
-	}
-}
-
-var jsonFieldsNameOfDatasourceEmail = [15]string{
-	0:  "uuid",
-	1:  "user_uuid",
-	2:  "email",
-	3:  "name",
-	4:  "is_enabled",
-	5:  "provider",
-	6:  "oauth2_client_id",
-	7:  "oauth2_client_uuid",
-	8:  "oauth2_token_uuid",
-	9:  "imap_server",
-	10: "smtp_server",
-	11: "smtp_tls",
-	12: "password",
-	13: "created_at",
-	14: "updated_at",
+		}
+	}
+	{
+		if s.Score.Set {
+			e.FieldStart("score")
+			s.Score.Encode(e)
+		}
+	}
 }
 
-// Decode decodes DatasourceEmail from json.
-func (s *DatasourceEmail) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfMessage = [24]string{
+	0:  "uuid",
+	1:  "type",
+	2:  "format",
+	3:  "chat_uuid",
+	4:  "thread_uuid",
+	5:  "external_message_id",
+	6:  "pipeline_uuid",
+	7:  "datasource_uuid",
+	8:  "sender",
+	9:  "recipients",
+	10: "subject",
+	11: "body",
+	12: "body_parsed",
+	13: "reactions",
+	14: "attachments",
+	15: "forward_from",
+	16: "reply_to_message_uuid",
+	17: "forward_from_chat_uuid",
+	18: "forward_from_message_uuid",
+	19: "forward_meta",
+	20: "meta",
+	21: "created_at",
+	22: "updated_at",
+	23: "score",
+}
+
+// Decode decodes Message from json.
+func (s *Message) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DatasourceEmail to nil")
+		return errors.New("invalid: unable to decode Message to nil")
 	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [3]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
@@ -2984,139 +7936,230 @@ func (s *DatasourceEmail) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "user_uuid":
+		case "type":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.UserUUID = string(v)
+				s.Type = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"user_uuid\"")
+				return errors.Wrap(err, "decode field \"type\"")
 			}
-		case "email":
+		case "format":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
 				v, err := d.Str()
-				s.Email = string(v)
+				s.Format = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email\"")
+				return errors.Wrap(err, "decode field \"format\"")
 			}
-		case "name":
-			requiredBitSet[0] |= 1 << 3
+		case "chat_uuid":
+			if err := func() error {
+				s.ChatUUID.Reset()
+				if err := s.ChatUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"chat_uuid\"")
+			}
+		case "thread_uuid":
+			if err := func() error {
+				s.ThreadUUID.Reset()
+				if err := s.ThreadUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"thread_uuid\"")
+			}
+		case "external_message_id":
+			if err := func() error {
+				s.ExternalMessageID.Reset()
+				if err := s.ExternalMessageID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"external_message_id\"")
+			}
+		case "pipeline_uuid":
+			if err := func() error {
+				s.PipelineUUID.Reset()
+				if err := s.PipelineUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pipeline_uuid\"")
+			}
+		case "datasource_uuid":
+			if err := func() error {
+				s.DatasourceUUID.Reset()
+				if err := s.DatasourceUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"datasource_uuid\"")
+			}
+		case "sender":
+			requiredBitSet[1] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.Name = string(v)
+				s.Sender = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"sender\"")
 			}
-		case "is_enabled":
+		case "recipients":
+			requiredBitSet[1] |= 1 << 1
 			if err := func() error {
-				s.IsEnabled.Reset()
-				if err := s.IsEnabled.Decode(d); err != nil {
+				s.Recipients = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Recipients = append(s.Recipients, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_enabled\"")
+				return errors.Wrap(err, "decode field \"recipients\"")
 			}
-		case "provider":
-			requiredBitSet[0] |= 1 << 5
+		case "subject":
+			if err := func() error {
+				s.Subject.Reset()
+				if err := s.Subject.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"subject\"")
+			}
+		case "body":
+			requiredBitSet[1] |= 1 << 3
 			if err := func() error {
 				v, err := d.Str()
-				s.Provider = string(v)
+				s.Body = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provider\"")
+				return errors.Wrap(err, "decode field \"body\"")
 			}
-		case "oauth2_client_id":
+		case "body_parsed":
 			if err := func() error {
-				s.OAuth2ClientID.Reset()
-				if err := s.OAuth2ClientID.Decode(d); err != nil {
+				s.BodyParsed.Reset()
+				if err := s.BodyParsed.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"oauth2_client_id\"")
+				return errors.Wrap(err, "decode field \"body_parsed\"")
 			}
-		case "oauth2_client_uuid":
+		case "reactions":
 			if err := func() error {
-				s.OAuth2ClientUUID.Reset()
-				if err := s.OAuth2ClientUUID.Decode(d); err != nil {
+				s.Reactions.Reset()
+				if err := s.Reactions.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"oauth2_client_uuid\"")
+				return errors.Wrap(err, "decode field \"reactions\"")
 			}
-		case "oauth2_token_uuid":
+		case "attachments":
 			if err := func() error {
-				s.OAuth2TokenUUID.Reset()
-				if err := s.OAuth2TokenUUID.Decode(d); err != nil {
+				s.Attachments = make([]FileObject, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem FileObject
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Attachments = append(s.Attachments, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"oauth2_token_uuid\"")
+				return errors.Wrap(err, "decode field \"attachments\"")
 			}
-		case "imap_server":
-			requiredBitSet[1] |= 1 << 1
+		case "forward_from":
 			if err := func() error {
-				v, err := d.Str()
-				s.ImapServer = string(v)
-				if err != nil {
+				s.ForwardFrom.Reset()
+				if err := s.ForwardFrom.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"imap_server\"")
+				return errors.Wrap(err, "decode field \"forward_from\"")
 			}
-		case "smtp_server":
-			requiredBitSet[1] |= 1 << 2
+		case "reply_to_message_uuid":
 			if err := func() error {
-				v, err := d.Str()
-				s.SMTPServer = string(v)
-				if err != nil {
+				s.ReplyToMessageUUID.Reset()
+				if err := s.ReplyToMessageUUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"smtp_server\"")
+				return errors.Wrap(err, "decode field \"reply_to_message_uuid\"")
 			}
-		case "smtp_tls":
+		case "forward_from_chat_uuid":
 			if err := func() error {
-				s.SMTPTLS.Reset()
-				if err := s.SMTPTLS.Decode(d); err != nil {
+				s.ForwardFromChatUUID.Reset()
+				if err := s.ForwardFromChatUUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"smtp_tls\"")
+				return errors.Wrap(err, "decode field \"forward_from_chat_uuid\"")
 			}
-		case "password":
-			requiredBitSet[1] |= 1 << 4
+		case "forward_from_message_uuid":
 			if err := func() error {
-				v, err := d.Str()
-				s.Password = string(v)
-				if err != nil {
+				s.ForwardFromMessageUUID.Reset()
+				if err := s.ForwardFromMessageUUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password\"")
+				return errors.Wrap(err, "decode field \"forward_from_message_uuid\"")
+			}
+		case "forward_meta":
+			if err := func() error {
+				s.ForwardMeta.Reset()
+				if err := s.ForwardMeta.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"forward_meta\"")
+			}
+		case "meta":
+			if err := func() error {
+				s.Meta.Reset()
+				if err := s.Meta.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"meta\"")
 			}
 		case "created_at":
 			if err := func() error {
@@ -3138,18 +8181,29 @@ func (s *DatasourceEmail) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
+		case "score":
+			if err := func() error {
+				s.Score.Reset()
+				if err := s.Score.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"score\"")
+			}
 		default:
 			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode DatasourceEmail")
+		return errors.Wrap(err, "decode Message")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b00101110,
-		0b00010110,
+	for i, mask := range [3]uint8{
+		0b00000110,
+		0b00001011,
+		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3161,8 +8215,8 @@ func (s *DatasourceEmail) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfDatasourceEmail) {
-					name = jsonFieldsNameOfDatasourceEmail[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfMessage) {
+					name = jsonFieldsNameOfMessage[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3183,223 +8237,136 @@ func (s *DatasourceEmail) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DatasourceEmail) MarshalJSON() ([]byte, error) {
+func (s *Message) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DatasourceEmail) UnmarshalJSON(data []byte) error {
+func (s *Message) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *DatasourceEmailOAuth) Encode(e *jx.Encoder) {
+func (s *MessageBodyParsed) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
-
-// encodeFields encodes fields.
-func (s *DatasourceEmailOAuth) encodeFields(e *jx.Encoder) {
-	{
-		if s.UUID.Set {
-			e.FieldStart("uuid")
-			s.UUID.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("user_uuid")
-		e.Str(s.UserUUID)
-	}
-	{
-		e.FieldStart("email")
-		e.Str(s.Email)
-	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
+
+// encodeFields encodes fields.
+func (s *MessageBodyParsed) encodeFields(e *jx.Encoder) {
 	{
-		if s.IsEnabled.Set {
-			e.FieldStart("is_enabled")
-			s.IsEnabled.Encode(e)
+		if s.SubjectText.Set {
+			e.FieldStart("subject_text")
+			s.SubjectText.Encode(e)
 		}
 	}
 	{
-		e.FieldStart("provider")
-		e.Str(s.Provider)
-	}
-	{
-		e.FieldStart("oauth2_client_uuid")
-		e.Str(s.OAuth2ClientUUID)
+		if s.SubjectSlate.Set {
+			e.FieldStart("subject_slate")
+			s.SubjectSlate.Encode(e)
+		}
 	}
 	{
-		if s.OAuth2TokenUUID.Set {
-			e.FieldStart("oauth2_token_uuid")
-			s.OAuth2TokenUUID.Encode(e)
-		}
+		e.FieldStart("body_text")
+		e.Str(s.BodyText)
 	}
 	{
-		if s.CreatedAt.Set {
-			e.FieldStart("created_at")
-			s.CreatedAt.Encode(e, json.EncodeDateTime)
-		}
+		e.FieldStart("body_byte")
+		e.Base64(s.BodyByte)
 	}
 	{
-		if s.UpdatedAt.Set {
-			e.FieldStart("updated_at")
-			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		if s.BodySlate.Set {
+			e.FieldStart("body_slate")
+			s.BodySlate.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfDatasourceEmailOAuth = [10]string{
-	0: "uuid",
-	1: "user_uuid",
-	2: "email",
-	3: "name",
-	4: "is_enabled",
-	5: "provider",
-	6: "oauth2_client_uuid",
-	7: "oauth2_token_uuid",
-	8: "created_at",
-	9: "updated_at",
+var jsonFieldsNameOfMessageBodyParsed = [5]string{
+	0: "subject_text",
+	1: "subject_slate",
+	2: "body_text",
+	3: "body_byte",
+	4: "body_slate",
 }
 
-// Decode decodes DatasourceEmailOAuth from json.
-func (s *DatasourceEmailOAuth) Decode(d *jx.Decoder) error {
+// Decode decodes MessageBodyParsed from json.
+func (s *MessageBodyParsed) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DatasourceEmailOAuth to nil")
+		return errors.New("invalid: unable to decode MessageBodyParsed to nil")
 	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "uuid":
+		case "subject_text":
 			if err := func() error {
-				s.UUID.Reset()
-				if err := s.UUID.Decode(d); err != nil {
+				s.SubjectText.Reset()
+				if err := s.SubjectText.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uuid\"")
+				return errors.Wrap(err, "decode field \"subject_text\"")
 			}
-		case "user_uuid":
-			requiredBitSet[0] |= 1 << 1
+		case "subject_slate":
 			if err := func() error {
-				v, err := d.Str()
-				s.UserUUID = string(v)
-				if err != nil {
+				s.SubjectSlate.Reset()
+				if err := s.SubjectSlate.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"user_uuid\"")
+				return errors.Wrap(err, "decode field \"subject_slate\"")
 			}
-		case "email":
+		case "body_text":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
 				v, err := d.Str()
-				s.Email = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email\"")
-			}
-		case "name":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "is_enabled":
-			if err := func() error {
-				s.IsEnabled.Reset()
-				if err := s.IsEnabled.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_enabled\"")
-			}
-		case "provider":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := d.Str()
-				s.Provider = string(v)
+				s.BodyText = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provider\"")
+				return errors.Wrap(err, "decode field \"body_text\"")
 			}
-		case "oauth2_client_uuid":
-			requiredBitSet[0] |= 1 << 6
+		case "body_byte":
 			if err := func() error {
-				v, err := d.Str()
-				s.OAuth2ClientUUID = string(v)
+				v, err := d.Base64()
+				s.BodyByte = []byte(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"oauth2_client_uuid\"")
-			}
-		case "oauth2_token_uuid":
-			if err := func() error {
-				s.OAuth2TokenUUID.Reset()
-				if err := s.OAuth2TokenUUID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"oauth2_token_uuid\"")
-			}
-		case "created_at":
-			if err := func() error {
-				s.CreatedAt.Reset()
-				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
+				return errors.Wrap(err, "decode field \"body_byte\"")
 			}
-		case "updated_at":
+		case "body_slate":
 			if err := func() error {
-				s.UpdatedAt.Reset()
-				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.BodySlate.Reset()
+				if err := s.BodySlate.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"updated_at\"")
+				return errors.Wrap(err, "decode field \"body_slate\"")
 			}
 		default:
 			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode DatasourceEmailOAuth")
+		return errors.Wrap(err, "decode MessageBodyParsed")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b01101110,
-		0b00000000,
+	for i, mask := range [1]uint8{
+		0b00000100,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3411,8 +8378,8 @@ func (s *DatasourceEmailOAuth) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfDatasourceEmailOAuth) {
-					name = jsonFieldsNameOfDatasourceEmailOAuth[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfMessageBodyParsed) {
+					name = jsonFieldsNameOfMessageBodyParsed[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3425,231 +8392,203 @@ func (s *DatasourceEmailOAuth) Decode(d *jx.Decoder) error {
 			}
 		}
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *MessageBodyParsed) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MessageBodyParsed) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s MessageBodyParsedBodySlate) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s MessageBodyParsedBodySlate) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes MessageBodyParsedBodySlate from json.
+func (s *MessageBodyParsedBodySlate) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MessageBodyParsedBodySlate to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MessageBodyParsedBodySlate")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s MessageBodyParsedBodySlate) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MessageBodyParsedBodySlate) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s MessageBodyParsedSubjectSlate) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s MessageBodyParsedSubjectSlate) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes MessageBodyParsedSubjectSlate from json.
+func (s *MessageBodyParsedSubjectSlate) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MessageBodyParsedSubjectSlate to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MessageBodyParsedSubjectSlate")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DatasourceEmailOAuth) MarshalJSON() ([]byte, error) {
+func (s MessageBodyParsedSubjectSlate) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DatasourceEmailOAuth) UnmarshalJSON(data []byte) error {
+func (s *MessageBodyParsedSubjectSlate) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *DatasourceLinkedin) Encode(e *jx.Encoder) {
+func (s *MessageEmailQueryOK) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *DatasourceLinkedin) encodeFields(e *jx.Encoder) {
-	{
-		if s.UUID.Set {
-			e.FieldStart("uuid")
-			s.UUID.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("user_uuid")
-		e.Str(s.UserUUID)
-	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
-	{
-		if s.IsEnabled.Set {
-			e.FieldStart("is_enabled")
-			s.IsEnabled.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("provider")
-		e.Str(s.Provider)
-	}
-	{
-		e.FieldStart("username")
-		e.Str(s.Username)
-	}
-	{
-		e.FieldStart("password")
-		e.Str(s.Password)
-	}
-	{
-		if s.Settings.Set {
-			e.FieldStart("settings")
-			s.Settings.Encode(e)
-		}
-	}
-	{
-		if s.CreatedAt.Set {
-			e.FieldStart("created_at")
-			s.CreatedAt.Encode(e, json.EncodeDateTime)
-		}
-	}
+func (s *MessageEmailQueryOK) encodeFields(e *jx.Encoder) {
 	{
-		if s.UpdatedAt.Set {
-			e.FieldStart("updated_at")
-			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		e.FieldStart("messages")
+		e.ArrStart()
+		for _, elem := range s.Messages {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfDatasourceLinkedin = [10]string{
-	0: "uuid",
-	1: "user_uuid",
-	2: "name",
-	3: "is_enabled",
-	4: "provider",
-	5: "username",
-	6: "password",
-	7: "settings",
-	8: "created_at",
-	9: "updated_at",
+var jsonFieldsNameOfMessageEmailQueryOK = [1]string{
+	0: "messages",
 }
 
-// Decode decodes DatasourceLinkedin from json.
-func (s *DatasourceLinkedin) Decode(d *jx.Decoder) error {
+// Decode decodes MessageEmailQueryOK from json.
+func (s *MessageEmailQueryOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DatasourceLinkedin to nil")
+		return errors.New("invalid: unable to decode MessageEmailQueryOK to nil")
 	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "uuid":
-			if err := func() error {
-				s.UUID.Reset()
-				if err := s.UUID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uuid\"")
-			}
-		case "user_uuid":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.UserUUID = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"user_uuid\"")
-			}
-		case "name":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "is_enabled":
-			if err := func() error {
-				s.IsEnabled.Reset()
-				if err := s.IsEnabled.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_enabled\"")
-			}
-		case "provider":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Str()
-				s.Provider = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provider\"")
-			}
-		case "username":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := d.Str()
-				s.Username = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"username\"")
-			}
-		case "password":
-			requiredBitSet[0] |= 1 << 6
-			if err := func() error {
-				v, err := d.Str()
-				s.Password = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password\"")
-			}
-		case "settings":
-			if err := func() error {
-				s.Settings.Reset()
-				if err := s.Settings.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"settings\"")
-			}
-		case "created_at":
-			if err := func() error {
-				s.CreatedAt.Reset()
-				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
-			}
-		case "updated_at":
+		case "messages":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.UpdatedAt.Reset()
-				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.Messages = make([]Message, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Message
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Messages = append(s.Messages, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"updated_at\"")
+				return errors.Wrap(err, "decode field \"messages\"")
 			}
 		default:
 			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode DatasourceLinkedin")
+		return errors.Wrap(err, "decode MessageEmailQueryOK")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b01110110,
-		0b00000000,
+	for i, mask := range [1]uint8{
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3661,8 +8600,8 @@ func (s *DatasourceLinkedin) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfDatasourceLinkedin) {
-					name = jsonFieldsNameOfDatasourceLinkedin[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfMessageEmailQueryOK) {
+					name = jsonFieldsNameOfMessageEmailQueryOK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3683,27 +8622,27 @@ func (s *DatasourceLinkedin) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DatasourceLinkedin) MarshalJSON() ([]byte, error) {
+func (s *MessageEmailQueryOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DatasourceLinkedin) UnmarshalJSON(data []byte) error {
+func (s *MessageEmailQueryOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s DatasourceLinkedinSettings) Encode(e *jx.Encoder) {
+func (s MessageForwardMeta) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields implements json.Marshaler.
-func (s DatasourceLinkedinSettings) encodeFields(e *jx.Encoder) {
+func (s MessageForwardMeta) encodeFields(e *jx.Encoder) {
 	for k, elem := range s {
 		e.FieldStart(k)
 
@@ -3713,10 +8652,10 @@ func (s DatasourceLinkedinSettings) encodeFields(e *jx.Encoder) {
 	}
 }
 
-// Decode decodes DatasourceLinkedinSettings from json.
-func (s *DatasourceLinkedinSettings) Decode(d *jx.Decoder) error {
+// Decode decodes MessageForwardMeta from json.
+func (s *MessageForwardMeta) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DatasourceLinkedinSettings to nil")
+		return errors.New("invalid: unable to decode MessageForwardMeta to nil")
 	}
 	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
@@ -3734,71 +8673,81 @@ func (s *DatasourceLinkedinSettings) Decode(d *jx.Decoder) error {
 		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode DatasourceLinkedinSettings")
+		return errors.Wrap(err, "decode MessageForwardMeta")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s DatasourceLinkedinSettings) MarshalJSON() ([]byte, error) {
+func (s MessageForwardMeta) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DatasourceLinkedinSettings) UnmarshalJSON(data []byte) error {
+func (s *MessageForwardMeta) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *DatasourceSetOAuth2ClientReq) Encode(e *jx.Encoder) {
+func (s *MessageLinkedinQueryOK) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *DatasourceSetOAuth2ClientReq) encodeFields(e *jx.Encoder) {
+func (s *MessageLinkedinQueryOK) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("client_id")
-		e.Str(s.ClientID)
+		e.FieldStart("messages")
+		e.ArrStart()
+		for _, elem := range s.Messages {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfDatasourceSetOAuth2ClientReq = [1]string{
-	0: "client_id",
+var jsonFieldsNameOfMessageLinkedinQueryOK = [1]string{
+	0: "messages",
 }
 
-// Decode decodes DatasourceSetOAuth2ClientReq from json.
-func (s *DatasourceSetOAuth2ClientReq) Decode(d *jx.Decoder) error {
+// Decode decodes MessageLinkedinQueryOK from json.
+func (s *MessageLinkedinQueryOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DatasourceSetOAuth2ClientReq to nil")
+		return errors.New("invalid: unable to decode MessageLinkedinQueryOK to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "client_id":
+		case "messages":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.ClientID = string(v)
-				if err != nil {
+				s.Messages = make([]Message, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Message
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Messages = append(s.Messages, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"client_id\"")
+				return errors.Wrap(err, "decode field \"messages\"")
 			}
 		default:
 			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode DatasourceSetOAuth2ClientReq")
+		return errors.Wrap(err, "decode MessageLinkedinQueryOK")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -3815,8 +8764,8 @@ func (s *DatasourceSetOAuth2ClientReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfDatasourceSetOAuth2ClientReq) {
-					name = jsonFieldsNameOfDatasourceSetOAuth2ClientReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfMessageLinkedinQueryOK) {
+					name = jsonFieldsNameOfMessageLinkedinQueryOK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3837,305 +8786,351 @@ func (s *DatasourceSetOAuth2ClientReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DatasourceSetOAuth2ClientReq) MarshalJSON() ([]byte, error) {
+func (s *MessageLinkedinQueryOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DatasourceSetOAuth2ClientReq) UnmarshalJSON(data []byte) error {
+func (s *MessageLinkedinQueryOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *DatasourceTelegram) Encode(e *jx.Encoder) {
+func (s *MessageMeta) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *DatasourceTelegram) encodeFields(e *jx.Encoder) {
+func (s *MessageMeta) encodeFields(e *jx.Encoder) {
 	{
-		if s.UUID.Set {
-			e.FieldStart("uuid")
-			s.UUID.Encode(e)
+		if s.HasRawEmail.Set {
+			e.FieldStart("has_raw_email")
+			s.HasRawEmail.Encode(e)
 		}
 	}
 	{
-		e.FieldStart("user_uuid")
-		e.Str(s.UserUUID)
+		if s.IsIncoming.Set {
+			e.FieldStart("is_incoming")
+			s.IsIncoming.Encode(e)
+		}
 	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+}
+
+var jsonFieldsNameOfMessageMeta = [2]string{
+	0: "has_raw_email",
+	1: "is_incoming",
+}
+
+// Decode decodes MessageMeta from json.
+func (s *MessageMeta) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MessageMeta to nil")
 	}
-	{
-		if s.IsEnabled.Set {
-			e.FieldStart("is_enabled")
-			s.IsEnabled.Encode(e)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "has_raw_email":
+			if err := func() error {
+				s.HasRawEmail.Reset()
+				if err := s.HasRawEmail.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"has_raw_email\"")
+			}
+		case "is_incoming":
+			if err := func() error {
+				s.IsIncoming.Reset()
+				if err := s.IsIncoming.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_incoming\"")
+			}
+		default:
+			return errors.Errorf("unexpected field %q", k)
 		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MessageMeta")
 	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *MessageMeta) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MessageMeta) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *MessageQuery) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *MessageQuery) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("provider")
-		e.Str(s.Provider)
+		e.FieldStart("source")
+		s.Source.Encode(e)
 	}
 	{
-		e.FieldStart("phone_number")
-		e.Str(s.PhoneNumber)
+		if s.Query.Set {
+			e.FieldStart("query")
+			s.Query.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("api_id")
-		e.Str(s.APIID)
+		if s.Mode.Set {
+			e.FieldStart("mode")
+			s.Mode.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("api_hash")
-		e.Str(s.APIHash)
+		if s.ChatID.Set {
+			e.FieldStart("chat_id")
+			s.ChatID.Encode(e)
+		}
 	}
 	{
-		if s.Password.Set {
-			e.FieldStart("password")
-			s.Password.Encode(e)
+		if s.ThreadID.Set {
+			e.FieldStart("thread_id")
+			s.ThreadID.Encode(e)
 		}
 	}
 	{
-		if s.Settings.Set {
-			e.FieldStart("settings")
-			s.Settings.Encode(e)
+		if s.PipelineUUID.Set {
+			e.FieldStart("pipeline_uuid")
+			s.PipelineUUID.Encode(e)
 		}
 	}
 	{
-		if s.SessionHistory != nil {
-			e.FieldStart("sessionHistory")
-			s.SessionHistory.Encode(e)
+		if s.StartDate.Set {
+			e.FieldStart("start_date")
+			s.StartDate.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.Participants != nil {
-			e.FieldStart("participants")
-			s.Participants.Encode(e)
+		if s.EndDate.Set {
+			e.FieldStart("end_date")
+			s.EndDate.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.Meta.Set {
-			e.FieldStart("meta")
-			s.Meta.Encode(e)
+		if s.Order.Set {
+			e.FieldStart("order")
+			s.Order.Encode(e)
 		}
 	}
 	{
-		if s.CreatedAt.Set {
-			e.FieldStart("created_at")
-			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		if s.Limit.Set {
+			e.FieldStart("limit")
+			s.Limit.Encode(e)
 		}
 	}
 	{
-		if s.UpdatedAt.Set {
-			e.FieldStart("updated_at")
-			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		if s.Offset.Set {
+			e.FieldStart("offset")
+			s.Offset.Encode(e)
 		}
 	}
-}
-
-var jsonFieldsNameOfDatasourceTelegram = [15]string{
-	0:  "uuid",
-	1:  "user_uuid",
-	2:  "name",
-	3:  "is_enabled",
-	4:  "provider",
-	5:  "phone_number",
-	6:  "api_id",
-	7:  "api_hash",
-	8:  "password",
-	9:  "settings",
-	10: "sessionHistory",
-	11: "participants",
-	12: "meta",
-	13: "created_at",
-	14: "updated_at",
-}
-
-// Decode decodes DatasourceTelegram from json.
-func (s *DatasourceTelegram) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode DatasourceTelegram to nil")
+	{
+		if s.StorageType.Set {
+			e.FieldStart("storage_type")
+			s.StorageType.Encode(e)
+		}
 	}
-	var requiredBitSet [2]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "uuid":
-			if err := func() error {
-				s.UUID.Reset()
-				if err := s.UUID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uuid\"")
-			}
-		case "user_uuid":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.UserUUID = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"user_uuid\"")
-			}
-		case "name":
-			requiredBitSet[0] |= 1 << 2
+	{
+		if s.Fuzzy.Set {
+			e.FieldStart("fuzzy")
+			s.Fuzzy.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfMessageQuery = [13]string{
+	0:  "source",
+	1:  "query",
+	2:  "mode",
+	3:  "chat_id",
+	4:  "thread_id",
+	5:  "pipeline_uuid",
+	6:  "start_date",
+	7:  "end_date",
+	8:  "order",
+	9:  "limit",
+	10: "offset",
+	11: "storage_type",
+	12: "fuzzy",
+}
+
+// Decode decodes MessageQuery from json.
+func (s *MessageQuery) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MessageQuery to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "source":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
+				if err := s.Source.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"source\"")
 			}
-		case "is_enabled":
+		case "query":
 			if err := func() error {
-				s.IsEnabled.Reset()
-				if err := s.IsEnabled.Decode(d); err != nil {
+				s.Query.Reset()
+				if err := s.Query.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_enabled\"")
+				return errors.Wrap(err, "decode field \"query\"")
 			}
-		case "provider":
-			requiredBitSet[0] |= 1 << 4
+		case "mode":
 			if err := func() error {
-				v, err := d.Str()
-				s.Provider = string(v)
-				if err != nil {
+				s.Mode.Reset()
+				if err := s.Mode.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provider\"")
+				return errors.Wrap(err, "decode field \"mode\"")
 			}
-		case "phone_number":
-			requiredBitSet[0] |= 1 << 5
+		case "chat_id":
 			if err := func() error {
-				v, err := d.Str()
-				s.PhoneNumber = string(v)
-				if err != nil {
+				s.ChatID.Reset()
+				if err := s.ChatID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone_number\"")
+				return errors.Wrap(err, "decode field \"chat_id\"")
 			}
-		case "api_id":
-			requiredBitSet[0] |= 1 << 6
+		case "thread_id":
 			if err := func() error {
-				v, err := d.Str()
-				s.APIID = string(v)
-				if err != nil {
+				s.ThreadID.Reset()
+				if err := s.ThreadID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"api_id\"")
+				return errors.Wrap(err, "decode field \"thread_id\"")
 			}
-		case "api_hash":
-			requiredBitSet[0] |= 1 << 7
+		case "pipeline_uuid":
 			if err := func() error {
-				v, err := d.Str()
-				s.APIHash = string(v)
-				if err != nil {
+				s.PipelineUUID.Reset()
+				if err := s.PipelineUUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"api_hash\"")
+				return errors.Wrap(err, "decode field \"pipeline_uuid\"")
 			}
-		case "password":
+		case "start_date":
 			if err := func() error {
-				s.Password.Reset()
-				if err := s.Password.Decode(d); err != nil {
+				s.StartDate.Reset()
+				if err := s.StartDate.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password\"")
+				return errors.Wrap(err, "decode field \"start_date\"")
 			}
-		case "settings":
+		case "end_date":
 			if err := func() error {
-				s.Settings.Reset()
-				if err := s.Settings.Decode(d); err != nil {
+				s.EndDate.Reset()
+				if err := s.EndDate.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"settings\"")
+				return errors.Wrap(err, "decode field \"end_date\"")
 			}
-		case "sessionHistory":
+		case "order":
 			if err := func() error {
-				if err := s.SessionHistory.Decode(d); err != nil {
+				s.Order.Reset()
+				if err := s.Order.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"sessionHistory\"")
+				return errors.Wrap(err, "decode field \"order\"")
 			}
-		case "participants":
+		case "limit":
 			if err := func() error {
-				if err := s.Participants.Decode(d); err != nil {
+				s.Limit.Reset()
+				if err := s.Limit.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"participants\"")
+				return errors.Wrap(err, "decode field \"limit\"")
 			}
-		case "meta":
+		case "offset":
 			if err := func() error {
-				s.Meta.Reset()
-				if err := s.Meta.Decode(d); err != nil {
+				s.Offset.Reset()
+				if err := s.Offset.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"meta\"")
+				return errors.Wrap(err, "decode field \"offset\"")
 			}
-		case "created_at":
+		case "storage_type":
 			if err := func() error {
-				s.CreatedAt.Reset()
-				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.StorageType.Reset()
+				if err := s.StorageType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
+				return errors.Wrap(err, "decode field \"storage_type\"")
 			}
-		case "updated_at":
+		case "fuzzy":
 			if err := func() error {
-				s.UpdatedAt.Reset()
-				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.Fuzzy.Reset()
+				if err := s.Fuzzy.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"updated_at\"")
+				return errors.Wrap(err, "decode field \"fuzzy\"")
 			}
 		default:
 			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode DatasourceTelegram")
+		return errors.Wrap(err, "decode MessageQuery")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [2]uint8{
-		0b11110110,
+		0b00000001,
 		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
@@ -4148,8 +9143,8 @@ func (s *DatasourceTelegram) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfDatasourceTelegram) {
-					name = jsonFieldsNameOfDatasourceTelegram[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfMessageQuery) {
+					name = jsonFieldsNameOfMessageQuery[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4170,413 +9165,321 @@ func (s *DatasourceTelegram) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DatasourceTelegram) MarshalJSON() ([]byte, error) {
+func (s *MessageQuery) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DatasourceTelegram) UnmarshalJSON(data []byte) error {
+func (s *MessageQuery) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s DatasourceTelegramMeta) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields implements json.Marshaler.
-func (s DatasourceTelegramMeta) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
-		}
-	}
+// Encode encodes MessageQueryMode as json.
+func (s MessageQueryMode) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes DatasourceTelegramMeta from json.
-func (s *DatasourceTelegramMeta) Decode(d *jx.Decoder) error {
+// Decode decodes MessageQueryMode from json.
+func (s *MessageQueryMode) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DatasourceTelegramMeta to nil")
+		return errors.New("invalid: unable to decode MessageQueryMode to nil")
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
-		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode DatasourceTelegramMeta")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch MessageQueryMode(v) {
+	case MessageQueryModeKeyword:
+		*s = MessageQueryModeKeyword
+	case MessageQueryModeSemantic:
+		*s = MessageQueryModeSemantic
+	default:
+		*s = MessageQueryMode(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s DatasourceTelegramMeta) MarshalJSON() ([]byte, error) {
+func (s MessageQueryMode) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DatasourceTelegramMeta) UnmarshalJSON(data []byte) error {
+func (s *MessageQueryMode) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s DatasourceTelegramSettings) Encode(e *jx.Encoder) {
+func (s *MessageQueryOK) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s DatasourceTelegramSettings) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
+// encodeFields encodes fields.
+func (s *MessageQueryOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("messages")
+		e.ArrStart()
+		for _, elem := range s.Messages {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 }
 
-// Decode decodes DatasourceTelegramSettings from json.
-func (s *DatasourceTelegramSettings) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfMessageQueryOK = [1]string{
+	0: "messages",
+}
+
+// Decode decodes MessageQueryOK from json.
+func (s *MessageQueryOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DatasourceTelegramSettings to nil")
+		return errors.New("invalid: unable to decode MessageQueryOK to nil")
 	}
-	m := s.init()
+	var requiredBitSet [1]uint8
+
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
+		switch string(k) {
+		case "messages":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Messages = make([]Message, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Message
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Messages = append(s.Messages, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"messages\"")
+			}
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MessageQueryOK")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfMessageQueryOK) {
+					name = jsonFieldsNameOfMessageQueryOK[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
 		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode DatasourceTelegramSettings")
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s DatasourceTelegramSettings) MarshalJSON() ([]byte, error) {
+func (s *MessageQueryOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DatasourceTelegramSettings) UnmarshalJSON(data []byte) error {
+func (s *MessageQueryOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *DatasourceWhatsapp) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes MessageQueryOrder as json.
+func (s MessageQueryOrder) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// encodeFields encodes fields.
-func (s *DatasourceWhatsapp) encodeFields(e *jx.Encoder) {
-	{
-		if s.UUID.Set {
-			e.FieldStart("uuid")
-			s.UUID.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("user_uuid")
-		e.Str(s.UserUUID)
-	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
-	{
-		if s.IsEnabled.Set {
-			e.FieldStart("is_enabled")
-			s.IsEnabled.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("provider")
-		e.Str(s.Provider)
-	}
-	{
-		e.FieldStart("phone_number")
-		e.Str(s.PhoneNumber)
-	}
-	{
-		if s.DeviceName.Set {
-			e.FieldStart("device_name")
-			s.DeviceName.Encode(e)
-		}
-	}
-	{
-		if s.Settings.Set {
-			e.FieldStart("settings")
-			s.Settings.Encode(e)
-		}
+// Decode decodes MessageQueryOrder from json.
+func (s *MessageQueryOrder) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MessageQueryOrder to nil")
 	}
-	{
-		if s.CreatedAt.Set {
-			e.FieldStart("created_at")
-			s.CreatedAt.Encode(e, json.EncodeDateTime)
-		}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	{
-		if s.UpdatedAt.Set {
-			e.FieldStart("updated_at")
-			s.UpdatedAt.Encode(e, json.EncodeDateTime)
-		}
+	// Try to use constant string.
+	switch MessageQueryOrder(v) {
+	case MessageQueryOrderAsc:
+		*s = MessageQueryOrderAsc
+	case MessageQueryOrderDesc:
+		*s = MessageQueryOrderDesc
+	default:
+		*s = MessageQueryOrder(v)
 	}
+
+	return nil
 }
 
-var jsonFieldsNameOfDatasourceWhatsapp = [10]string{
-	0: "uuid",
-	1: "user_uuid",
-	2: "name",
-	3: "is_enabled",
-	4: "provider",
-	5: "phone_number",
-	6: "device_name",
-	7: "settings",
-	8: "created_at",
-	9: "updated_at",
+// MarshalJSON implements stdjson.Marshaler.
+func (s MessageQueryOrder) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes DatasourceWhatsapp from json.
-func (s *DatasourceWhatsapp) Decode(d *jx.Decoder) error {
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MessageQueryOrder) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes MessageQuerySource as json.
+func (s MessageQuerySource) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes MessageQuerySource from json.
+func (s *MessageQuerySource) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DatasourceWhatsapp to nil")
+		return errors.New("invalid: unable to decode MessageQuerySource to nil")
 	}
-	var requiredBitSet [2]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "uuid":
-			if err := func() error {
-				s.UUID.Reset()
-				if err := s.UUID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uuid\"")
-			}
-		case "user_uuid":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.UserUUID = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"user_uuid\"")
-			}
-		case "name":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "is_enabled":
-			if err := func() error {
-				s.IsEnabled.Reset()
-				if err := s.IsEnabled.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_enabled\"")
-			}
-		case "provider":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Str()
-				s.Provider = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provider\"")
-			}
-		case "phone_number":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := d.Str()
-				s.PhoneNumber = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone_number\"")
-			}
-		case "device_name":
-			if err := func() error {
-				s.DeviceName.Reset()
-				if err := s.DeviceName.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"device_name\"")
-			}
-		case "settings":
-			if err := func() error {
-				s.Settings.Reset()
-				if err := s.Settings.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"settings\"")
-			}
-		case "created_at":
-			if err := func() error {
-				s.CreatedAt.Reset()
-				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
-			}
-		case "updated_at":
-			if err := func() error {
-				s.UpdatedAt.Reset()
-				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"updated_at\"")
-			}
-		default:
-			return errors.Errorf("unexpected field %q", k)
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode DatasourceWhatsapp")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch MessageQuerySource(v) {
+	case MessageQuerySourceEmail:
+		*s = MessageQuerySourceEmail
+	case MessageQuerySourceWhatsapp:
+		*s = MessageQuerySourceWhatsapp
+	case MessageQuerySourceTelegram:
+		*s = MessageQuerySourceTelegram
+	case MessageQuerySourceLinkedin:
+		*s = MessageQuerySourceLinkedin
+	case MessageQuerySourceCustom:
+		*s = MessageQuerySourceCustom
+	case MessageQuerySourceUnified:
+		*s = MessageQuerySourceUnified
+	default:
+		*s = MessageQuerySource(v)
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b00110110,
-		0b00000000,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfDatasourceWhatsapp) {
-					name = jsonFieldsNameOfDatasourceWhatsapp[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s MessageQuerySource) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MessageQuerySource) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes MessageQueryStorageType as json.
+func (s MessageQueryStorageType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes MessageQueryStorageType from json.
+func (s *MessageQueryStorageType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MessageQueryStorageType to nil")
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch MessageQueryStorageType(v) {
+	case MessageQueryStorageTypePostgres:
+		*s = MessageQueryStorageTypePostgres
+	case MessageQueryStorageTypeS3:
+		*s = MessageQueryStorageTypeS3
+	case MessageQueryStorageTypeHostfiles:
+		*s = MessageQueryStorageTypeHostfiles
+	default:
+		*s = MessageQueryStorageType(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DatasourceWhatsapp) MarshalJSON() ([]byte, error) {
+func (s MessageQueryStorageType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DatasourceWhatsapp) UnmarshalJSON(data []byte) error {
+func (s *MessageQueryStorageType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s DatasourceWhatsappSettings) Encode(e *jx.Encoder) {
+func (s MessageReactions) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields implements json.Marshaler.
-func (s DatasourceWhatsappSettings) encodeFields(e *jx.Encoder) {
+func (s MessageReactions) encodeFields(e *jx.Encoder) {
 	for k, elem := range s {
 		e.FieldStart(k)
 
-		if len(elem) != 0 {
-			e.Raw(elem)
-		}
+		e.Int(elem)
 	}
 }
 
-// Decode decodes DatasourceWhatsappSettings from json.
-func (s *DatasourceWhatsappSettings) Decode(d *jx.Decoder) error {
+// Decode decodes MessageReactions from json.
+func (s *MessageReactions) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DatasourceWhatsappSettings to nil")
+		return errors.New("invalid: unable to decode MessageReactions to nil")
 	}
 	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
+		var elem int
 		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
+			v, err := d.Int()
+			elem = int(v)
 			if err != nil {
 				return err
 			}
@@ -4587,503 +9490,738 @@ func (s *DatasourceWhatsappSettings) Decode(d *jx.Decoder) error {
 		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode DatasourceWhatsappSettings")
+		return errors.Wrap(err, "decode MessageReactions")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s DatasourceWhatsappSettings) MarshalJSON() ([]byte, error) {
+func (s MessageReactions) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DatasourceWhatsappSettings) UnmarshalJSON(data []byte) error {
+func (s *MessageReactions) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Error) Encode(e *jx.Encoder) {
+func (s *MessageTelegramQueryOK) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Error) encodeFields(e *jx.Encoder) {
-	{
-		if s.Detail.Set {
-			e.FieldStart("detail")
-			s.Detail.Encode(e)
-		}
-	}
-	{
-		if s.Errors != nil {
-			e.FieldStart("errors")
-			e.ArrStart()
-			for _, elem := range s.Errors {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
-	}
+func (s *MessageTelegramQueryOK) encodeFields(e *jx.Encoder) {
 	{
-		if s.Status.Set {
-			e.FieldStart("status")
-			s.Status.Encode(e)
+		e.FieldStart("messages")
+		e.ArrStart()
+		for _, elem := range s.Messages {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfError = [3]string{
-	0: "detail",
-	1: "errors",
-	2: "status",
+var jsonFieldsNameOfMessageTelegramQueryOK = [1]string{
+	0: "messages",
 }
 
-// Decode decodes Error from json.
-func (s *Error) Decode(d *jx.Decoder) error {
+// Decode decodes MessageTelegramQueryOK from json.
+func (s *MessageTelegramQueryOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Error to nil")
+		return errors.New("invalid: unable to decode MessageTelegramQueryOK to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "detail":
+		case "messages":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Detail.Reset()
-				if err := s.Detail.Decode(d); err != nil {
+				s.Messages = make([]Message, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Message
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Messages = append(s.Messages, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"detail\"")
+				return errors.Wrap(err, "decode field \"messages\"")
 			}
-		case "errors":
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MessageTelegramQueryOK")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfMessageTelegramQueryOK) {
+					name = jsonFieldsNameOfMessageTelegramQueryOK[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *MessageTelegramQueryOK) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MessageTelegramQueryOK) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *MessageWhatsappQueryOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *MessageWhatsappQueryOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("messages")
+		e.ArrStart()
+		for _, elem := range s.Messages {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfMessageWhatsappQueryOK = [1]string{
+	0: "messages",
+}
+
+// Decode decodes MessageWhatsappQueryOK from json.
+func (s *MessageWhatsappQueryOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MessageWhatsappQueryOK to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "messages":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Errors = make([]ErrorErrorsItem, 0)
+				s.Messages = make([]Message, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem ErrorErrorsItem
+					var elem Message
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
-					s.Errors = append(s.Errors, elem)
+					s.Messages = append(s.Messages, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"errors\"")
-			}
-		case "status":
-			if err := func() error {
-				s.Status.Reset()
-				if err := s.Status.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"status\"")
+				return errors.Wrap(err, "decode field \"messages\"")
 			}
 		default:
 			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Error")
+		return errors.Wrap(err, "decode MessageWhatsappQueryOK")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfMessageWhatsappQueryOK) {
+					name = jsonFieldsNameOfMessageWhatsappQueryOK[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Error) MarshalJSON() ([]byte, error) {
+func (s *MessageWhatsappQueryOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Error) UnmarshalJSON(data []byte) error {
+func (s *MessageWhatsappQueryOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ErrorErrorsItem) Encode(e *jx.Encoder) {
+func (s *OAuth2Client) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ErrorErrorsItem) encodeFields(e *jx.Encoder) {
+func (s *OAuth2Client) encodeFields(e *jx.Encoder) {
 	{
-		if s.Location.Set {
-			e.FieldStart("location")
-			s.Location.Encode(e)
+		if s.UUID.Set {
+			e.FieldStart("uuid")
+			s.UUID.Encode(e)
 		}
 	}
 	{
-		if s.Message.Set {
-			e.FieldStart("message")
-			s.Message.Encode(e)
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("provider")
+		e.Str(s.Provider)
+	}
+	{
+		e.FieldStart("client_id")
+		e.Str(s.ClientID)
+	}
+	{
+		e.FieldStart("secret")
+		e.Str(s.Secret)
+	}
+	{
+		if s.IssuerURL.Set {
+			e.FieldStart("issuer_url")
+			s.IssuerURL.Encode(e)
 		}
 	}
 	{
-		if s.Value.Set {
-			e.FieldStart("value")
-			s.Value.Encode(e)
+		if s.Scopes != nil {
+			e.FieldStart("scopes")
+			e.ArrStart()
+			for _, elem := range s.Scopes {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 }
 
-var jsonFieldsNameOfErrorErrorsItem = [3]string{
-	0: "location",
-	1: "message",
-	2: "value",
+var jsonFieldsNameOfOAuth2Client = [9]string{
+	0: "uuid",
+	1: "name",
+	2: "provider",
+	3: "client_id",
+	4: "secret",
+	5: "issuer_url",
+	6: "scopes",
+	7: "created_at",
+	8: "updated_at",
 }
 
-// Decode decodes ErrorErrorsItem from json.
-func (s *ErrorErrorsItem) Decode(d *jx.Decoder) error {
+// Decode decodes OAuth2Client from json.
+func (s *OAuth2Client) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ErrorErrorsItem to nil")
+		return errors.New("invalid: unable to decode OAuth2Client to nil")
 	}
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "location":
+		case "uuid":
 			if err := func() error {
-				s.Location.Reset()
-				if err := s.Location.Decode(d); err != nil {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"location\"")
+				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "message":
+		case "name":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "value":
+		case "provider":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Value.Reset()
-				if err := s.Value.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Provider = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"value\"")
+				return errors.Wrap(err, "decode field \"provider\"")
+			}
+		case "client_id":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.ClientID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"client_id\"")
+			}
+		case "secret":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.Secret = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"secret\"")
+			}
+		case "issuer_url":
+			if err := func() error {
+				s.IssuerURL.Reset()
+				if err := s.IssuerURL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"issuer_url\"")
+			}
+		case "scopes":
+			if err := func() error {
+				s.Scopes = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Scopes = append(s.Scopes, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scopes\"")
+			}
+		case "created_at":
+			if err := func() error {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "updated_at":
+			if err := func() error {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
 		default:
 			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ErrorErrorsItem")
+		return errors.Wrap(err, "decode OAuth2Client")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00011110,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfOAuth2Client) {
+					name = jsonFieldsNameOfOAuth2Client[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ErrorErrorsItem) MarshalJSON() ([]byte, error) {
+func (s *OAuth2Client) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorErrorsItem) UnmarshalJSON(data []byte) error {
+func (s *OAuth2Client) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *FileObject) Encode(e *jx.Encoder) {
+func (s *OAuth2ClientCreateReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *FileObject) encodeFields(e *jx.Encoder) {
-	{
-		if s.UUID.Set {
-			e.FieldStart("uuid")
-			s.UUID.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("storage_type")
-		e.Str(s.StorageType)
-	}
-	{
-		e.FieldStart("storage_uuid")
-		e.Str(s.StorageUUID)
-	}
+func (s *OAuth2ClientCreateReq) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("name")
 		e.Str(s.Name)
 	}
 	{
-		if s.MimeType.Set {
-			e.FieldStart("mime_type")
-			s.MimeType.Encode(e)
-		}
-	}
-	{
-		if s.Size.Set {
-			e.FieldStart("size")
-			s.Size.Encode(e)
-		}
-	}
-	{
-		if s.Data.Set {
-			e.FieldStart("data")
-			s.Data.Encode(e)
-		}
-	}
-	{
-		if s.Path.Set {
-			e.FieldStart("path")
-			s.Path.Encode(e)
-		}
-	}
-	{
-		if s.IsRaw.Set {
-			e.FieldStart("is_raw")
-			s.IsRaw.Encode(e)
-		}
-	}
-	{
-		if s.RawHeaders.Set {
-			e.FieldStart("raw_headers")
-			s.RawHeaders.Encode(e)
-		}
+		e.FieldStart("provider")
+		e.Str(s.Provider)
 	}
 	{
-		if s.HasRawEmail.Set {
-			e.FieldStart("has_raw_email")
-			s.HasRawEmail.Encode(e)
-		}
+		e.FieldStart("secret")
+		e.Str(s.Secret)
 	}
 	{
-		if s.IsInline.Set {
-			e.FieldStart("is_inline")
-			s.IsInline.Encode(e)
-		}
+		e.FieldStart("client_id")
+		e.Str(s.ClientID)
 	}
 	{
-		if s.CreatedAt.Set {
-			e.FieldStart("created_at")
-			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		if s.IssuerURL.Set {
+			e.FieldStart("issuer_url")
+			s.IssuerURL.Encode(e)
 		}
 	}
 	{
-		if s.UpdatedAt.Set {
-			e.FieldStart("updated_at")
-			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		if s.Scopes != nil {
+			e.FieldStart("scopes")
+			e.ArrStart()
+			for _, elem := range s.Scopes {
+				e.Str(elem)
+			}
+			e.ArrEnd()
 		}
 	}
 }
 
-var jsonFieldsNameOfFileObject = [14]string{
-	0:  "uuid",
-	1:  "storage_type",
-	2:  "storage_uuid",
-	3:  "name",
-	4:  "mime_type",
-	5:  "size",
-	6:  "data",
-	7:  "path",
-	8:  "is_raw",
-	9:  "raw_headers",
-	10: "has_raw_email",
-	11: "is_inline",
-	12: "created_at",
-	13: "updated_at",
+var jsonFieldsNameOfOAuth2ClientCreateReq = [6]string{
+	0: "name",
+	1: "provider",
+	2: "secret",
+	3: "client_id",
+	4: "issuer_url",
+	5: "scopes",
 }
 
-// Decode decodes FileObject from json.
-func (s *FileObject) Decode(d *jx.Decoder) error {
+// Decode decodes OAuth2ClientCreateReq from json.
+func (s *OAuth2ClientCreateReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode FileObject to nil")
+		return errors.New("invalid: unable to decode OAuth2ClientCreateReq to nil")
 	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "uuid":
+		case "name":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.UUID.Reset()
-				if err := s.UUID.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uuid\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "storage_type":
+		case "provider":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.StorageType = string(v)
+				s.Provider = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"storage_type\"")
+				return errors.Wrap(err, "decode field \"provider\"")
 			}
-		case "storage_uuid":
+		case "secret":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
 				v, err := d.Str()
-				s.StorageUUID = string(v)
+				s.Secret = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"storage_uuid\"")
+				return errors.Wrap(err, "decode field \"secret\"")
 			}
-		case "name":
+		case "client_id":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
 				v, err := d.Str()
-				s.Name = string(v)
+				s.ClientID = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "mime_type":
-			if err := func() error {
-				s.MimeType.Reset()
-				if err := s.MimeType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"mime_type\"")
-			}
-		case "size":
-			if err := func() error {
-				s.Size.Reset()
-				if err := s.Size.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"size\"")
-			}
-		case "data":
-			if err := func() error {
-				s.Data.Reset()
-				if err := s.Data.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"data\"")
-			}
-		case "path":
-			if err := func() error {
-				s.Path.Reset()
-				if err := s.Path.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"path\"")
-			}
-		case "is_raw":
-			if err := func() error {
-				s.IsRaw.Reset()
-				if err := s.IsRaw.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_raw\"")
-			}
-		case "raw_headers":
-			if err := func() error {
-				s.RawHeaders.Reset()
-				if err := s.RawHeaders.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"raw_headers\"")
+				return errors.Wrap(err, "decode field \"client_id\"")
 			}
-		case "has_raw_email":
+		case "issuer_url":
 			if err := func() error {
-				s.HasRawEmail.Reset()
-				if err := s.HasRawEmail.Decode(d); err != nil {
+				s.IssuerURL.Reset()
+				if err := s.IssuerURL.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"has_raw_email\"")
+				return errors.Wrap(err, "decode field \"issuer_url\"")
 			}
-		case "is_inline":
+		case "scopes":
 			if err := func() error {
-				s.IsInline.Reset()
-				if err := s.IsInline.Decode(d); err != nil {
+				s.Scopes = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Scopes = append(s.Scopes, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_inline\"")
+				return errors.Wrap(err, "decode field \"scopes\"")
 			}
-		case "created_at":
-			if err := func() error {
-				s.CreatedAt.Reset()
-				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
-					return err
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode OAuth2ClientCreateReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfOAuth2ClientCreateReq) {
+					name = jsonFieldsNameOfOAuth2ClientCreateReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "updated_at":
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *OAuth2ClientCreateReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OAuth2ClientCreateReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *OAuth2ClientListOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *OAuth2ClientListOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("clients")
+		e.ArrStart()
+		for _, elem := range s.Clients {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfOAuth2ClientListOK = [1]string{
+	0: "clients",
+}
+
+// Decode decodes OAuth2ClientListOK from json.
+func (s *OAuth2ClientListOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode OAuth2ClientListOK to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "clients":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.UpdatedAt.Reset()
-				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.Clients = make([]OAuth2Client, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem OAuth2Client
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Clients = append(s.Clients, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"updated_at\"")
+				return errors.Wrap(err, "decode field \"clients\"")
 			}
 		default:
-			return d.Skip()
+			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode FileObject")
+		return errors.Wrap(err, "decode OAuth2ClientListOK")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b00001110,
-		0b00000000,
+	for i, mask := range [1]uint8{
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5095,8 +10233,8 @@ func (s *FileObject) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfFileObject) {
-					name = jsonFieldsNameOfFileObject[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfOAuth2ClientListOK) {
+					name = jsonFieldsNameOfOAuth2ClientListOK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5117,64 +10255,64 @@ func (s *FileObject) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *FileObject) MarshalJSON() ([]byte, error) {
+func (s *OAuth2ClientListOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *FileObject) UnmarshalJSON(data []byte) error {
+func (s *OAuth2ClientListOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *FileUpdateReq) Encode(e *jx.Encoder) {
+func (s *OAuth2ClientLoginOK) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *FileUpdateReq) encodeFields(e *jx.Encoder) {
+func (s *OAuth2ClientLoginOK) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+		e.FieldStart("auth_code_url")
+		e.Str(s.AuthCodeURL)
 	}
 }
 
-var jsonFieldsNameOfFileUpdateReq = [1]string{
-	0: "name",
+var jsonFieldsNameOfOAuth2ClientLoginOK = [1]string{
+	0: "auth_code_url",
 }
 
-// Decode decodes FileUpdateReq from json.
-func (s *FileUpdateReq) Decode(d *jx.Decoder) error {
+// Decode decodes OAuth2ClientLoginOK from json.
+func (s *OAuth2ClientLoginOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode FileUpdateReq to nil")
+		return errors.New("invalid: unable to decode OAuth2ClientLoginOK to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
+		case "auth_code_url":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.Name = string(v)
+				s.AuthCodeURL = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"auth_code_url\"")
 			}
 		default:
 			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode FileUpdateReq")
+		return errors.Wrap(err, "decode OAuth2ClientLoginOK")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -5191,8 +10329,8 @@ func (s *FileUpdateReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfFileUpdateReq) {
-					name = jsonFieldsNameOfFileUpdateReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfOAuth2ClientLoginOK) {
+					name = jsonFieldsNameOfOAuth2ClientLoginOK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5213,170 +10351,189 @@ func (s *FileUpdateReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *FileUpdateReq) MarshalJSON() ([]byte, error) {
+func (s *OAuth2ClientLoginOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *FileUpdateReq) UnmarshalJSON(data []byte) error {
+func (s *OAuth2ClientLoginOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *GenerateDownloadLinkRequest) Encode(e *jx.Encoder) {
+func (s *OAuth2ClientLoginReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *GenerateDownloadLinkRequest) encodeFields(e *jx.Encoder) {
-	{
-		if s.FileUUID.Set {
-			e.FieldStart("file_uuid")
-			s.FileUUID.Encode(e)
-		}
-	}
+func (s *OAuth2ClientLoginReq) encodeFields(e *jx.Encoder) {
 	{
-		if s.Expiration.Set {
-			e.FieldStart("expiration")
-			s.Expiration.Encode(e)
-		}
+		e.FieldStart("query")
+		s.Query.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfGenerateDownloadLinkRequest = [2]string{
-	0: "file_uuid",
-	1: "expiration",
+var jsonFieldsNameOfOAuth2ClientLoginReq = [1]string{
+	0: "query",
 }
 
-// Decode decodes GenerateDownloadLinkRequest from json.
-func (s *GenerateDownloadLinkRequest) Decode(d *jx.Decoder) error {
+// Decode decodes OAuth2ClientLoginReq from json.
+func (s *OAuth2ClientLoginReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GenerateDownloadLinkRequest to nil")
+		return errors.New("invalid: unable to decode OAuth2ClientLoginReq to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "file_uuid":
-			if err := func() error {
-				s.FileUUID.Reset()
-				if err := s.FileUUID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"file_uuid\"")
-			}
-		case "expiration":
+		case "query":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Expiration.Reset()
-				if err := s.Expiration.Decode(d); err != nil {
+				if err := s.Query.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"expiration\"")
+				return errors.Wrap(err, "decode field \"query\"")
 			}
 		default:
-			return d.Skip()
+			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode GenerateDownloadLinkRequest")
+		return errors.Wrap(err, "decode OAuth2ClientLoginReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfOAuth2ClientLoginReq) {
+					name = jsonFieldsNameOfOAuth2ClientLoginReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GenerateDownloadLinkRequest) MarshalJSON() ([]byte, error) {
+func (s *OAuth2ClientLoginReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GenerateDownloadLinkRequest) UnmarshalJSON(data []byte) error {
+func (s *OAuth2ClientLoginReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *GenerateDownloadLinkResponse) Encode(e *jx.Encoder) {
+func (s OAuth2ClientLoginReqQuery) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields encodes fields.
-func (s *GenerateDownloadLinkResponse) encodeFields(e *jx.Encoder) {
-	{
-		if s.URL.Set {
-			e.FieldStart("url")
-			s.URL.Encode(e)
+// encodeFields implements json.Marshaler.
+func (s OAuth2ClientLoginReqQuery) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		e.ArrStart()
+		for _, elem := range elem {
+			e.Str(elem)
 		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfGenerateDownloadLinkResponse = [1]string{
-	0: "url",
-}
-
-// Decode decodes GenerateDownloadLinkResponse from json.
-func (s *GenerateDownloadLinkResponse) Decode(d *jx.Decoder) error {
+// Decode decodes OAuth2ClientLoginReqQuery from json.
+func (s *OAuth2ClientLoginReqQuery) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GenerateDownloadLinkResponse to nil")
+		return errors.New("invalid: unable to decode OAuth2ClientLoginReqQuery to nil")
 	}
-
+	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "url":
-			if err := func() error {
-				s.URL.Reset()
-				if err := s.URL.Decode(d); err != nil {
+		var elem []string
+		if err := func() error {
+			elem = make([]string, 0)
+			if err := d.Arr(func(d *jx.Decoder) error {
+				var elemElem string
+				v, err := d.Str()
+				elemElem = string(v)
+				if err != nil {
 					return err
 				}
+				elem = append(elem, elemElem)
 				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"url\"")
+			}); err != nil {
+				return err
 			}
-		default:
-			return d.Skip()
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode GenerateDownloadLinkResponse")
+		return errors.Wrap(err, "decode OAuth2ClientLoginReqQuery")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GenerateDownloadLinkResponse) MarshalJSON() ([]byte, error) {
+func (s OAuth2ClientLoginReqQuery) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GenerateDownloadLinkResponse) UnmarshalJSON(data []byte) error {
+func (s *OAuth2ClientLoginReqQuery) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Message) Encode(e *jx.Encoder) {
+func (s *OAuth2ClientToken) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Message) encodeFields(e *jx.Encoder) {
+func (s *OAuth2ClientToken) encodeFields(e *jx.Encoder) {
 	{
 		if s.UUID.Set {
 			e.FieldStart("uuid")
@@ -5384,569 +10541,287 @@ func (s *Message) encodeFields(e *jx.Encoder) {
 		}
 	}
 	{
-		e.FieldStart("type")
-		e.Str(s.Type)
-	}
-	{
-		e.FieldStart("format")
-		e.Str(s.Format)
+		e.FieldStart("client_uuid")
+		e.Str(s.ClientUUID)
 	}
 	{
-		if s.ChatUUID.Set {
-			e.FieldStart("chat_uuid")
-			s.ChatUUID.Encode(e)
+		if s.UserUUID.Set {
+			e.FieldStart("user_uuid")
+			s.UserUUID.Encode(e)
 		}
 	}
 	{
-		if s.ThreadUUID.Set {
-			e.FieldStart("thread_uuid")
-			s.ThreadUUID.Encode(e)
-		}
+		e.FieldStart("token")
+		s.Token.Encode(e)
 	}
 	{
-		if s.ExternalMessageID.Set {
-			e.FieldStart("external_message_id")
-			s.ExternalMessageID.Encode(e)
+		if s.Status.Set {
+			e.FieldStart("status")
+			s.Status.Encode(e)
 		}
 	}
 	{
-		e.FieldStart("sender")
-		e.Str(s.Sender)
-	}
-	{
-		e.FieldStart("recipients")
-		e.ArrStart()
-		for _, elem := range s.Recipients {
-			e.Str(elem)
+		if s.ExpiresAt.Set {
+			e.FieldStart("expires_at")
+			s.ExpiresAt.Encode(e, json.EncodeDateTime)
 		}
-		e.ArrEnd()
 	}
 	{
-		if s.Subject.Set {
-			e.FieldStart("subject")
-			s.Subject.Encode(e)
+		if s.LastRefreshedAt.Set {
+			e.FieldStart("last_refreshed_at")
+			s.LastRefreshedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		e.FieldStart("body")
-		e.Str(s.Body)
+		if s.LastFailedAt.Set {
+			e.FieldStart("last_failed_at")
+			s.LastFailedAt.Encode(e, json.EncodeDateTime)
+		}
 	}
 	{
-		if s.BodyParsed.Set {
-			e.FieldStart("body_parsed")
-			s.BodyParsed.Encode(e)
+		if s.FailureCount.Set {
+			e.FieldStart("failure_count")
+			s.FailureCount.Encode(e)
 		}
 	}
 	{
-		if s.Reactions.Set {
-			e.FieldStart("reactions")
-			s.Reactions.Encode(e)
+		if s.LastError.Set {
+			e.FieldStart("last_error")
+			s.LastError.Encode(e)
 		}
 	}
 	{
-		if s.Attachments != nil {
-			e.FieldStart("attachments")
+		if s.Scopes != nil {
+			e.FieldStart("scopes")
 			e.ArrStart()
-			for _, elem := range s.Attachments {
-				elem.Encode(e)
+			for _, elem := range s.Scopes {
+				e.Str(elem)
 			}
 			e.ArrEnd()
 		}
 	}
 	{
-		if s.ForwardFrom.Set {
-			e.FieldStart("forward_from")
-			s.ForwardFrom.Encode(e)
-		}
-	}
-	{
-		if s.ReplyToMessageUUID.Set {
-			e.FieldStart("reply_to_message_uuid")
-			s.ReplyToMessageUUID.Encode(e)
-		}
-	}
-	{
-		if s.ForwardFromChatUUID.Set {
-			e.FieldStart("forward_from_chat_uuid")
-			s.ForwardFromChatUUID.Encode(e)
-		}
-	}
-	{
-		if s.ForwardFromMessageUUID.Set {
-			e.FieldStart("forward_from_message_uuid")
-			s.ForwardFromMessageUUID.Encode(e)
-		}
-	}
-	{
-		if s.ForwardMeta.Set {
-			e.FieldStart("forward_meta")
-			s.ForwardMeta.Encode(e)
-		}
-	}
-	{
-		if s.Meta.Set {
-			e.FieldStart("meta")
-			s.Meta.Encode(e)
-		}
-	}
-	{
-		if s.CreatedAt.Set {
-			e.FieldStart("created_at")
-			s.CreatedAt.Encode(e, json.EncodeDateTime)
-		}
-	}
-	{
-		if s.UpdatedAt.Set {
-			e.FieldStart("updated_at")
-			s.UpdatedAt.Encode(e, json.EncodeDateTime)
-		}
-	}
-}
-
-var jsonFieldsNameOfMessage = [21]string{
-	0:  "uuid",
-	1:  "type",
-	2:  "format",
-	3:  "chat_uuid",
-	4:  "thread_uuid",
-	5:  "external_message_id",
-	6:  "sender",
-	7:  "recipients",
-	8:  "subject",
-	9:  "body",
-	10: "body_parsed",
-	11: "reactions",
-	12: "attachments",
-	13: "forward_from",
-	14: "reply_to_message_uuid",
-	15: "forward_from_chat_uuid",
-	16: "forward_from_message_uuid",
-	17: "forward_meta",
-	18: "meta",
-	19: "created_at",
-	20: "updated_at",
-}
-
-// Decode decodes Message from json.
-func (s *Message) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode Message to nil")
-	}
-	var requiredBitSet [3]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "uuid":
-			if err := func() error {
-				s.UUID.Reset()
-				if err := s.UUID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uuid\"")
-			}
-		case "type":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.Type = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"type\"")
-			}
-		case "format":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Str()
-				s.Format = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"format\"")
-			}
-		case "chat_uuid":
-			if err := func() error {
-				s.ChatUUID.Reset()
-				if err := s.ChatUUID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"chat_uuid\"")
-			}
-		case "thread_uuid":
-			if err := func() error {
-				s.ThreadUUID.Reset()
-				if err := s.ThreadUUID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"thread_uuid\"")
-			}
-		case "external_message_id":
-			if err := func() error {
-				s.ExternalMessageID.Reset()
-				if err := s.ExternalMessageID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"external_message_id\"")
-			}
-		case "sender":
-			requiredBitSet[0] |= 1 << 6
-			if err := func() error {
-				v, err := d.Str()
-				s.Sender = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"sender\"")
-			}
-		case "recipients":
-			requiredBitSet[0] |= 1 << 7
-			if err := func() error {
-				s.Recipients = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.Recipients = append(s.Recipients, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"recipients\"")
-			}
-		case "subject":
-			if err := func() error {
-				s.Subject.Reset()
-				if err := s.Subject.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"subject\"")
-			}
-		case "body":
-			requiredBitSet[1] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.Body = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"body\"")
-			}
-		case "body_parsed":
-			if err := func() error {
-				s.BodyParsed.Reset()
-				if err := s.BodyParsed.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"body_parsed\"")
-			}
-		case "reactions":
-			if err := func() error {
-				s.Reactions.Reset()
-				if err := s.Reactions.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"reactions\"")
+		if s.MissingScopes != nil {
+			e.FieldStart("missing_scopes")
+			e.ArrStart()
+			for _, elem := range s.MissingScopes {
+				e.Str(elem)
 			}
-		case "attachments":
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfOAuth2ClientToken = [14]string{
+	0:  "uuid",
+	1:  "client_uuid",
+	2:  "user_uuid",
+	3:  "token",
+	4:  "status",
+	5:  "expires_at",
+	6:  "last_refreshed_at",
+	7:  "last_failed_at",
+	8:  "failure_count",
+	9:  "last_error",
+	10: "scopes",
+	11: "missing_scopes",
+	12: "created_at",
+	13: "updated_at",
+}
+
+// Decode decodes OAuth2ClientToken from json.
+func (s *OAuth2ClientToken) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode OAuth2ClientToken to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "uuid":
 			if err := func() error {
-				s.Attachments = make([]FileObject, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem FileObject
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Attachments = append(s.Attachments, elem)
-					return nil
-				}); err != nil {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"attachments\"")
+				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "forward_from":
+		case "client_uuid":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.ForwardFrom.Reset()
-				if err := s.ForwardFrom.Decode(d); err != nil {
+				v, err := d.Str()
+				s.ClientUUID = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"forward_from\"")
+				return errors.Wrap(err, "decode field \"client_uuid\"")
 			}
-		case "reply_to_message_uuid":
+		case "user_uuid":
 			if err := func() error {
-				s.ReplyToMessageUUID.Reset()
-				if err := s.ReplyToMessageUUID.Decode(d); err != nil {
+				s.UserUUID.Reset()
+				if err := s.UserUUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"reply_to_message_uuid\"")
+				return errors.Wrap(err, "decode field \"user_uuid\"")
 			}
-		case "forward_from_chat_uuid":
+		case "token":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.ForwardFromChatUUID.Reset()
-				if err := s.ForwardFromChatUUID.Decode(d); err != nil {
+				if err := s.Token.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"forward_from_chat_uuid\"")
+				return errors.Wrap(err, "decode field \"token\"")
 			}
-		case "forward_from_message_uuid":
+		case "status":
 			if err := func() error {
-				s.ForwardFromMessageUUID.Reset()
-				if err := s.ForwardFromMessageUUID.Decode(d); err != nil {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"forward_from_message_uuid\"")
+				return errors.Wrap(err, "decode field \"status\"")
 			}
-		case "forward_meta":
+		case "expires_at":
 			if err := func() error {
-				s.ForwardMeta.Reset()
-				if err := s.ForwardMeta.Decode(d); err != nil {
+				s.ExpiresAt.Reset()
+				if err := s.ExpiresAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"forward_meta\"")
+				return errors.Wrap(err, "decode field \"expires_at\"")
 			}
-		case "meta":
+		case "last_refreshed_at":
 			if err := func() error {
-				s.Meta.Reset()
-				if err := s.Meta.Decode(d); err != nil {
+				s.LastRefreshedAt.Reset()
+				if err := s.LastRefreshedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"meta\"")
+				return errors.Wrap(err, "decode field \"last_refreshed_at\"")
 			}
-		case "created_at":
+		case "last_failed_at":
 			if err := func() error {
-				s.CreatedAt.Reset()
-				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.LastFailedAt.Reset()
+				if err := s.LastFailedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
+				return errors.Wrap(err, "decode field \"last_failed_at\"")
 			}
-		case "updated_at":
+		case "failure_count":
 			if err := func() error {
-				s.UpdatedAt.Reset()
-				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.FailureCount.Reset()
+				if err := s.FailureCount.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"updated_at\"")
-			}
-		default:
-			return errors.Errorf("unexpected field %q", k)
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode Message")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [3]uint8{
-		0b11000110,
-		0b00000010,
-		0b00000000,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfMessage) {
-					name = jsonFieldsNameOfMessage[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+				return errors.Wrap(err, "decode field \"failure_count\"")
 			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *Message) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Message) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *MessageBodyParsed) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *MessageBodyParsed) encodeFields(e *jx.Encoder) {
-	{
-		if s.SubjectText.Set {
-			e.FieldStart("subject_text")
-			s.SubjectText.Encode(e)
-		}
-	}
-	{
-		if s.SubjectSlate.Set {
-			e.FieldStart("subject_slate")
-			s.SubjectSlate.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("body_text")
-		e.Str(s.BodyText)
-	}
-	{
-		e.FieldStart("body_byte")
-		e.Base64(s.BodyByte)
-	}
-	{
-		if s.BodySlate.Set {
-			e.FieldStart("body_slate")
-			s.BodySlate.Encode(e)
-		}
-	}
-}
-
-var jsonFieldsNameOfMessageBodyParsed = [5]string{
-	0: "subject_text",
-	1: "subject_slate",
-	2: "body_text",
-	3: "body_byte",
-	4: "body_slate",
-}
-
-// Decode decodes MessageBodyParsed from json.
-func (s *MessageBodyParsed) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MessageBodyParsed to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "subject_text":
+		case "last_error":
 			if err := func() error {
-				s.SubjectText.Reset()
-				if err := s.SubjectText.Decode(d); err != nil {
+				s.LastError.Reset()
+				if err := s.LastError.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"subject_text\"")
+				return errors.Wrap(err, "decode field \"last_error\"")
 			}
-		case "subject_slate":
+		case "scopes":
 			if err := func() error {
-				s.SubjectSlate.Reset()
-				if err := s.SubjectSlate.Decode(d); err != nil {
+				s.Scopes = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Scopes = append(s.Scopes, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"subject_slate\"")
+				return errors.Wrap(err, "decode field \"scopes\"")
 			}
-		case "body_text":
-			requiredBitSet[0] |= 1 << 2
+		case "missing_scopes":
 			if err := func() error {
-				v, err := d.Str()
-				s.BodyText = string(v)
-				if err != nil {
+				s.MissingScopes = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.MissingScopes = append(s.MissingScopes, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"body_text\"")
+				return errors.Wrap(err, "decode field \"missing_scopes\"")
 			}
-		case "body_byte":
+		case "created_at":
 			if err := func() error {
-				v, err := d.Base64()
-				s.BodyByte = []byte(v)
-				if err != nil {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"body_byte\"")
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
-		case "body_slate":
+		case "updated_at":
 			if err := func() error {
-				s.BodySlate.Reset()
-				if err := s.BodySlate.Decode(d); err != nil {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"body_slate\"")
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
 		default:
 			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode MessageBodyParsed")
+		return errors.Wrap(err, "decode OAuth2ClientToken")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000100,
+	for i, mask := range [2]uint8{
+		0b00001010,
+		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5958,8 +10833,8 @@ func (s *MessageBodyParsed) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfMessageBodyParsed) {
-					name = jsonFieldsNameOfMessageBodyParsed[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfOAuth2ClientToken) {
+					name = jsonFieldsNameOfOAuth2ClientToken[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5980,195 +10855,120 @@ func (s *MessageBodyParsed) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MessageBodyParsed) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MessageBodyParsed) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s MessageBodyParsedBodySlate) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields implements json.Marshaler.
-func (s MessageBodyParsedBodySlate) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
-		}
-	}
-}
-
-// Decode decodes MessageBodyParsedBodySlate from json.
-func (s *MessageBodyParsedBodySlate) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MessageBodyParsedBodySlate to nil")
-	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
-		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MessageBodyParsedBodySlate")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s MessageBodyParsedBodySlate) MarshalJSON() ([]byte, error) {
+func (s *OAuth2ClientToken) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MessageBodyParsedBodySlate) UnmarshalJSON(data []byte) error {
+func (s *OAuth2ClientToken) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s MessageBodyParsedSubjectSlate) Encode(e *jx.Encoder) {
+func (s *OAuth2ClientTokenObj) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s MessageBodyParsedSubjectSlate) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
-		}
+// encodeFields encodes fields.
+func (s *OAuth2ClientTokenObj) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("access_token")
+		e.Str(s.AccessToken)
 	}
-}
-
-// Decode decodes MessageBodyParsedSubjectSlate from json.
-func (s *MessageBodyParsedSubjectSlate) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MessageBodyParsedSubjectSlate to nil")
+	{
+		e.FieldStart("refresh_token")
+		e.Str(s.RefreshToken)
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
-		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MessageBodyParsedSubjectSlate")
+	{
+		e.FieldStart("expires_at")
+		json.EncodeDateTime(e, s.ExpiresAt)
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s MessageBodyParsedSubjectSlate) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MessageBodyParsedSubjectSlate) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *MessageEmailQueryOK) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *MessageEmailQueryOK) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("messages")
-		e.ArrStart()
-		for _, elem := range s.Messages {
-			elem.Encode(e)
+		if s.Token.Set {
+			e.FieldStart("token")
+			s.Token.Encode(e)
 		}
-		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfMessageEmailQueryOK = [1]string{
-	0: "messages",
+var jsonFieldsNameOfOAuth2ClientTokenObj = [4]string{
+	0: "access_token",
+	1: "refresh_token",
+	2: "expires_at",
+	3: "token",
 }
 
-// Decode decodes MessageEmailQueryOK from json.
-func (s *MessageEmailQueryOK) Decode(d *jx.Decoder) error {
+// Decode decodes OAuth2ClientTokenObj from json.
+func (s *OAuth2ClientTokenObj) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode MessageEmailQueryOK to nil")
+		return errors.New("invalid: unable to decode OAuth2ClientTokenObj to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "messages":
+		case "access_token":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Messages = make([]Message, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Message
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Messages = append(s.Messages, elem)
-					return nil
-				}); err != nil {
+				v, err := d.Str()
+				s.AccessToken = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"messages\"")
+				return errors.Wrap(err, "decode field \"access_token\"")
+			}
+		case "refresh_token":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.RefreshToken = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"refresh_token\"")
+			}
+		case "expires_at":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.ExpiresAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"expires_at\"")
+			}
+		case "token":
+			if err := func() error {
+				s.Token.Reset()
+				if err := s.Token.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"token\"")
 			}
 		default:
 			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode MessageEmailQueryOK")
+		return errors.Wrap(err, "decode OAuth2ClientTokenObj")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6180,8 +10980,8 @@ func (s *MessageEmailQueryOK) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfMessageEmailQueryOK) {
-					name = jsonFieldsNameOfMessageEmailQueryOK[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfOAuth2ClientTokenObj) {
+					name = jsonFieldsNameOfOAuth2ClientTokenObj[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6202,27 +11002,27 @@ func (s *MessageEmailQueryOK) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MessageEmailQueryOK) MarshalJSON() ([]byte, error) {
+func (s *OAuth2ClientTokenObj) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MessageEmailQueryOK) UnmarshalJSON(data []byte) error {
+func (s *OAuth2ClientTokenObj) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s MessageForwardMeta) Encode(e *jx.Encoder) {
+func (s OAuth2ClientTokenObjToken) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields implements json.Marshaler.
-func (s MessageForwardMeta) encodeFields(e *jx.Encoder) {
+func (s OAuth2ClientTokenObjToken) encodeFields(e *jx.Encoder) {
 	for k, elem := range s {
 		e.FieldStart(k)
 
@@ -6232,10 +11032,10 @@ func (s MessageForwardMeta) encodeFields(e *jx.Encoder) {
 	}
 }
 
-// Decode decodes MessageForwardMeta from json.
-func (s *MessageForwardMeta) Decode(d *jx.Decoder) error {
+// Decode decodes OAuth2ClientTokenObjToken from json.
+func (s *OAuth2ClientTokenObjToken) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode MessageForwardMeta to nil")
+		return errors.New("invalid: unable to decode OAuth2ClientTokenObjToken to nil")
 	}
 	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
@@ -6253,86 +11053,216 @@ func (s *MessageForwardMeta) Decode(d *jx.Decoder) error {
 		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode MessageForwardMeta")
+		return errors.Wrap(err, "decode OAuth2ClientTokenObjToken")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OAuth2ClientTokenObjToken) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OAuth2ClientTokenObjToken) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes OAuth2ClientTokenStatus as json.
+func (s OAuth2ClientTokenStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes OAuth2ClientTokenStatus from json.
+func (s *OAuth2ClientTokenStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode OAuth2ClientTokenStatus to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch OAuth2ClientTokenStatus(v) {
+	case OAuth2ClientTokenStatusOk:
+		*s = OAuth2ClientTokenStatusOk
+	case OAuth2ClientTokenStatusFailing:
+		*s = OAuth2ClientTokenStatusFailing
+	case OAuth2ClientTokenStatusNeedsReauth:
+		*s = OAuth2ClientTokenStatusNeedsReauth
+	default:
+		*s = OAuth2ClientTokenStatus(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s MessageForwardMeta) MarshalJSON() ([]byte, error) {
+func (s OAuth2ClientTokenStatus) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MessageForwardMeta) UnmarshalJSON(data []byte) error {
+func (s *OAuth2ClientTokenStatus) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *MessageLinkedinQueryOK) Encode(e *jx.Encoder) {
+func (s *OAuth2ClientUpdateReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *MessageLinkedinQueryOK) encodeFields(e *jx.Encoder) {
+func (s *OAuth2ClientUpdateReq) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("messages")
-		e.ArrStart()
-		for _, elem := range s.Messages {
-			elem.Encode(e)
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("provider")
+		e.Str(s.Provider)
+	}
+	{
+		e.FieldStart("secret")
+		e.Str(s.Secret)
+	}
+	{
+		e.FieldStart("client_id")
+		e.Str(s.ClientID)
+	}
+	{
+		if s.IssuerURL.Set {
+			e.FieldStart("issuer_url")
+			s.IssuerURL.Encode(e)
+		}
+	}
+	{
+		if s.Scopes != nil {
+			e.FieldStart("scopes")
+			e.ArrStart()
+			for _, elem := range s.Scopes {
+				e.Str(elem)
+			}
+			e.ArrEnd()
 		}
-		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfMessageLinkedinQueryOK = [1]string{
-	0: "messages",
+var jsonFieldsNameOfOAuth2ClientUpdateReq = [6]string{
+	0: "name",
+	1: "provider",
+	2: "secret",
+	3: "client_id",
+	4: "issuer_url",
+	5: "scopes",
 }
 
-// Decode decodes MessageLinkedinQueryOK from json.
-func (s *MessageLinkedinQueryOK) Decode(d *jx.Decoder) error {
+// Decode decodes OAuth2ClientUpdateReq from json.
+func (s *OAuth2ClientUpdateReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode MessageLinkedinQueryOK to nil")
+		return errors.New("invalid: unable to decode OAuth2ClientUpdateReq to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "messages":
+		case "name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Messages = make([]Message, 0)
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "provider":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Provider = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provider\"")
+			}
+		case "secret":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Secret = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"secret\"")
+			}
+		case "client_id":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.ClientID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"client_id\"")
+			}
+		case "issuer_url":
+			if err := func() error {
+				s.IssuerURL.Reset()
+				if err := s.IssuerURL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"issuer_url\"")
+			}
+		case "scopes":
+			if err := func() error {
+				s.Scopes = make([]string, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Message
-					if err := elem.Decode(d); err != nil {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
 						return err
 					}
-					s.Messages = append(s.Messages, elem)
+					s.Scopes = append(s.Scopes, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"messages\"")
+				return errors.Wrap(err, "decode field \"scopes\"")
 			}
 		default:
 			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode MessageLinkedinQueryOK")
+		return errors.Wrap(err, "decode OAuth2ClientUpdateReq")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6344,8 +11274,8 @@ func (s *MessageLinkedinQueryOK) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfMessageLinkedinQueryOK) {
-					name = jsonFieldsNameOfMessageLinkedinQueryOK[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfOAuth2ClientUpdateReq) {
+					name = jsonFieldsNameOfOAuth2ClientUpdateReq[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6366,318 +11296,183 @@ func (s *MessageLinkedinQueryOK) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MessageLinkedinQueryOK) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MessageLinkedinQueryOK) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *MessageMeta) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *MessageMeta) encodeFields(e *jx.Encoder) {
-	{
-		if s.HasRawEmail.Set {
-			e.FieldStart("has_raw_email")
-			s.HasRawEmail.Encode(e)
-		}
-	}
-	{
-		if s.IsIncoming.Set {
-			e.FieldStart("is_incoming")
-			s.IsIncoming.Encode(e)
-		}
-	}
-}
-
-var jsonFieldsNameOfMessageMeta = [2]string{
-	0: "has_raw_email",
-	1: "is_incoming",
-}
-
-// Decode decodes MessageMeta from json.
-func (s *MessageMeta) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MessageMeta to nil")
-	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "has_raw_email":
-			if err := func() error {
-				s.HasRawEmail.Reset()
-				if err := s.HasRawEmail.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"has_raw_email\"")
-			}
-		case "is_incoming":
-			if err := func() error {
-				s.IsIncoming.Reset()
-				if err := s.IsIncoming.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_incoming\"")
-			}
-		default:
-			return errors.Errorf("unexpected field %q", k)
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MessageMeta")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *MessageMeta) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MessageMeta) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *MessageQuery) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *MessageQuery) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("source")
-		s.Source.Encode(e)
-	}
-	{
-		if s.Query.Set {
-			e.FieldStart("query")
-			s.Query.Encode(e)
-		}
-	}
-	{
-		if s.ChatID.Set {
-			e.FieldStart("chat_id")
-			s.ChatID.Encode(e)
-		}
-	}
-	{
-		if s.ThreadID.Set {
-			e.FieldStart("thread_id")
-			s.ThreadID.Encode(e)
-		}
-	}
+func (s *OAuth2ClientUpdateReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OAuth2ClientUpdateReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *OAuth2Provider) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *OAuth2Provider) encodeFields(e *jx.Encoder) {
 	{
-		if s.StartDate.Set {
-			e.FieldStart("start_date")
-			s.StartDate.Encode(e, json.EncodeDateTime)
-		}
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 	{
-		if s.EndDate.Set {
-			e.FieldStart("end_date")
-			s.EndDate.Encode(e, json.EncodeDateTime)
-		}
+		e.FieldStart("title")
+		e.Str(s.Title)
 	}
 	{
-		if s.Order.Set {
-			e.FieldStart("order")
-			s.Order.Encode(e)
+		e.FieldStart("scopes")
+		e.ArrStart()
+		for _, elem := range s.Scopes {
+			e.Str(elem)
 		}
+		e.ArrEnd()
 	}
 	{
-		if s.Limit.Set {
-			e.FieldStart("limit")
-			s.Limit.Encode(e)
-		}
+		e.FieldStart("discovery")
+		e.Bool(s.Discovery)
 	}
 	{
-		if s.Offset.Set {
-			e.FieldStart("offset")
-			s.Offset.Encode(e)
-		}
+		e.FieldStart("pkce")
+		e.Bool(s.Pkce)
 	}
 	{
-		if s.StorageType.Set {
-			e.FieldStart("storage_type")
-			s.StorageType.Encode(e)
-		}
+		e.FieldStart("revocation")
+		e.Bool(s.Revocation)
 	}
 	{
-		if s.Fuzzy.Set {
-			e.FieldStart("fuzzy")
-			s.Fuzzy.Encode(e)
-		}
+		e.FieldStart("userinfo")
+		e.Bool(s.Userinfo)
 	}
 }
 
-var jsonFieldsNameOfMessageQuery = [11]string{
-	0:  "source",
-	1:  "query",
-	2:  "chat_id",
-	3:  "thread_id",
-	4:  "start_date",
-	5:  "end_date",
-	6:  "order",
-	7:  "limit",
-	8:  "offset",
-	9:  "storage_type",
-	10: "fuzzy",
+var jsonFieldsNameOfOAuth2Provider = [7]string{
+	0: "name",
+	1: "title",
+	2: "scopes",
+	3: "discovery",
+	4: "pkce",
+	5: "revocation",
+	6: "userinfo",
 }
 
-// Decode decodes MessageQuery from json.
-func (s *MessageQuery) Decode(d *jx.Decoder) error {
+// Decode decodes OAuth2Provider from json.
+func (s *OAuth2Provider) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode MessageQuery to nil")
+		return errors.New("invalid: unable to decode OAuth2Provider to nil")
 	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "source":
+		case "name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.Source.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"source\"")
-			}
-		case "query":
-			if err := func() error {
-				s.Query.Reset()
-				if err := s.Query.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"query\"")
-			}
-		case "chat_id":
-			if err := func() error {
-				s.ChatID.Reset()
-				if err := s.ChatID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"chat_id\"")
-			}
-		case "thread_id":
-			if err := func() error {
-				s.ThreadID.Reset()
-				if err := s.ThreadID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"thread_id\"")
-			}
-		case "start_date":
-			if err := func() error {
-				s.StartDate.Reset()
-				if err := s.StartDate.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"start_date\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "end_date":
+		case "title":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.EndDate.Reset()
-				if err := s.EndDate.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := d.Str()
+				s.Title = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"end_date\"")
+				return errors.Wrap(err, "decode field \"title\"")
 			}
-		case "order":
+		case "scopes":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Order.Reset()
-				if err := s.Order.Decode(d); err != nil {
+				s.Scopes = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Scopes = append(s.Scopes, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"order\"")
+				return errors.Wrap(err, "decode field \"scopes\"")
 			}
-		case "limit":
+		case "discovery":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Limit.Reset()
-				if err := s.Limit.Decode(d); err != nil {
+				v, err := d.Bool()
+				s.Discovery = bool(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"limit\"")
+				return errors.Wrap(err, "decode field \"discovery\"")
 			}
-		case "offset":
+		case "pkce":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.Offset.Reset()
-				if err := s.Offset.Decode(d); err != nil {
+				v, err := d.Bool()
+				s.Pkce = bool(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"offset\"")
+				return errors.Wrap(err, "decode field \"pkce\"")
 			}
-		case "storage_type":
+		case "revocation":
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				s.StorageType.Reset()
-				if err := s.StorageType.Decode(d); err != nil {
+				v, err := d.Bool()
+				s.Revocation = bool(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"storage_type\"")
+				return errors.Wrap(err, "decode field \"revocation\"")
 			}
-		case "fuzzy":
+		case "userinfo":
+			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
-				s.Fuzzy.Reset()
-				if err := s.Fuzzy.Decode(d); err != nil {
+				v, err := d.Bool()
+				s.Userinfo = bool(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"fuzzy\"")
+				return errors.Wrap(err, "decode field \"userinfo\"")
 			}
 		default:
 			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode MessageQuery")
+		return errors.Wrap(err, "decode OAuth2Provider")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b00000001,
-		0b00000000,
+	for i, mask := range [1]uint8{
+		0b01111111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6689,8 +11484,8 @@ func (s *MessageQuery) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfMessageQuery) {
-					name = jsonFieldsNameOfMessageQuery[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfOAuth2Provider) {
+					name = jsonFieldsNameOfOAuth2Provider[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6711,1812 +11506,1166 @@ func (s *MessageQuery) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MessageQuery) MarshalJSON() ([]byte, error) {
+func (s *OAuth2Provider) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OAuth2Provider) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes APIKeyRole as json.
+func (o OptAPIKeyRole) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes APIKeyRole from json.
+func (o *OptAPIKeyRole) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptAPIKeyRole to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptAPIKeyRole) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptAPIKeyRole) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes AuditEventDiff as json.
+func (o OptAuditEventDiff) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes AuditEventDiff from json.
+func (o *OptAuditEventDiff) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptAuditEventDiff to nil")
+	}
+	o.Set = true
+	o.Value = make(AuditEventDiff)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptAuditEventDiff) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptAuditEventDiff) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes AuditEventParams as json.
+func (o OptAuditEventParams) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes AuditEventParams from json.
+func (o *OptAuditEventParams) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptAuditEventParams to nil")
+	}
+	o.Set = true
+	o.Value = make(AuditEventParams)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptAuditEventParams) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptAuditEventParams) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes bool as json.
+func (o OptBool) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Bool(bool(o.Value))
+}
+
+// Decode decodes bool from json.
+func (o *OptBool) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBool to nil")
+	}
+	o.Set = true
+	v, err := d.Bool()
+	if err != nil {
+		return err
+	}
+	o.Value = bool(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBool) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MessageQuery) UnmarshalJSON(data []byte) error {
+func (s *OptBool) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *MessageQueryOK) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes DatasourceLinkedinSettings as json.
+func (o OptDatasourceLinkedinSettings) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *MessageQueryOK) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("messages")
-		e.ArrStart()
-		for _, elem := range s.Messages {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
+// Decode decodes DatasourceLinkedinSettings from json.
+func (o *OptDatasourceLinkedinSettings) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDatasourceLinkedinSettings to nil")
+	}
+	o.Set = true
+	o.Value = make(DatasourceLinkedinSettings)
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
+	return nil
 }
 
-var jsonFieldsNameOfMessageQueryOK = [1]string{
-	0: "messages",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptDatasourceLinkedinSettings) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes MessageQueryOK from json.
-func (s *MessageQueryOK) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MessageQueryOK to nil")
-	}
-	var requiredBitSet [1]uint8
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptDatasourceLinkedinSettings) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "messages":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				s.Messages = make([]Message, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Message
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Messages = append(s.Messages, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"messages\"")
-			}
-		default:
-			return errors.Errorf("unexpected field %q", k)
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MessageQueryOK")
+// Encode encodes DatasourceTelegramMeta as json.
+func (o OptDatasourceTelegramMeta) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfMessageQueryOK) {
-					name = jsonFieldsNameOfMessageQueryOK[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
+	o.Value.Encode(e)
+}
+
+// Decode decodes DatasourceTelegramMeta from json.
+func (o *OptDatasourceTelegramMeta) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDatasourceTelegramMeta to nil")
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	o.Set = true
+	o.Value = make(DatasourceTelegramMeta)
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MessageQueryOK) MarshalJSON() ([]byte, error) {
+func (s OptDatasourceTelegramMeta) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MessageQueryOK) UnmarshalJSON(data []byte) error {
+func (s *OptDatasourceTelegramMeta) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MessageQueryOrder as json.
-func (s MessageQueryOrder) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode encodes DatasourceTelegramSettings as json.
+func (o OptDatasourceTelegramSettings) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
 }
 
-// Decode decodes MessageQueryOrder from json.
-func (s *MessageQueryOrder) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MessageQueryOrder to nil")
+// Decode decodes DatasourceTelegramSettings from json.
+func (o *OptDatasourceTelegramSettings) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDatasourceTelegramSettings to nil")
 	}
-	v, err := d.StrBytes()
-	if err != nil {
+	o.Set = true
+	o.Value = make(DatasourceTelegramSettings)
+	if err := o.Value.Decode(d); err != nil {
 		return err
 	}
-	// Try to use constant string.
-	switch MessageQueryOrder(v) {
-	case MessageQueryOrderAsc:
-		*s = MessageQueryOrderAsc
-	case MessageQueryOrderDesc:
-		*s = MessageQueryOrderDesc
-	default:
-		*s = MessageQueryOrder(v)
-	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s MessageQueryOrder) MarshalJSON() ([]byte, error) {
+func (s OptDatasourceTelegramSettings) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MessageQueryOrder) UnmarshalJSON(data []byte) error {
+func (s *OptDatasourceTelegramSettings) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MessageQuerySource as json.
-func (s MessageQuerySource) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode encodes DatasourceWhatsappSettings as json.
+func (o OptDatasourceWhatsappSettings) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
 }
 
-// Decode decodes MessageQuerySource from json.
-func (s *MessageQuerySource) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MessageQuerySource to nil")
+// Decode decodes DatasourceWhatsappSettings from json.
+func (o *OptDatasourceWhatsappSettings) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDatasourceWhatsappSettings to nil")
 	}
-	v, err := d.StrBytes()
-	if err != nil {
+	o.Set = true
+	o.Value = make(DatasourceWhatsappSettings)
+	if err := o.Value.Decode(d); err != nil {
 		return err
 	}
-	// Try to use constant string.
-	switch MessageQuerySource(v) {
-	case MessageQuerySourceEmail:
-		*s = MessageQuerySourceEmail
-	case MessageQuerySourceWhatsapp:
-		*s = MessageQuerySourceWhatsapp
-	case MessageQuerySourceTelegram:
-		*s = MessageQuerySourceTelegram
-	case MessageQuerySourceLinkedin:
-		*s = MessageQuerySourceLinkedin
-	case MessageQuerySourceCustom:
-		*s = MessageQuerySourceCustom
-	case MessageQuerySourceUnified:
-		*s = MessageQuerySourceUnified
-	default:
-		*s = MessageQuerySource(v)
-	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s MessageQuerySource) MarshalJSON() ([]byte, error) {
+func (s OptDatasourceWhatsappSettings) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MessageQuerySource) UnmarshalJSON(data []byte) error {
+func (s *OptDatasourceWhatsappSettings) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MessageQueryStorageType as json.
-func (s MessageQueryStorageType) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode encodes time.Time as json.
+func (o OptDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
+	if !o.Set {
+		return
+	}
+	format(e, o.Value)
 }
 
-// Decode decodes MessageQueryStorageType from json.
-func (s *MessageQueryStorageType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MessageQueryStorageType to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch MessageQueryStorageType(v) {
-	case MessageQueryStorageTypePostgres:
-		*s = MessageQueryStorageTypePostgres
-	case MessageQueryStorageTypeS3:
-		*s = MessageQueryStorageTypeS3
-	case MessageQueryStorageTypeHostfiles:
-		*s = MessageQueryStorageTypeHostfiles
-	default:
-		*s = MessageQueryStorageType(v)
+// Decode decodes time.Time from json.
+func (o *OptDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDateTime to nil")
 	}
-
+	o.Set = true
+	v, err := format(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s MessageQueryStorageType) MarshalJSON() ([]byte, error) {
+func (s OptDateTime) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
-	s.Encode(&e)
+	s.Encode(&e, json.EncodeDateTime)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MessageQueryStorageType) UnmarshalJSON(data []byte) error {
+func (s *OptDateTime) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s MessageReactions) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+	return s.Decode(d, json.DecodeDateTime)
 }
 
-// encodeFields implements json.Marshaler.
-func (s MessageReactions) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		e.Int(elem)
+// Encode encodes EmailSendContentType as json.
+func (o OptEmailSendContentType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	e.Str(string(o.Value))
 }
 
-// Decode decodes MessageReactions from json.
-func (s *MessageReactions) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MessageReactions to nil")
+// Decode decodes EmailSendContentType from json.
+func (o *OptEmailSendContentType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEmailSendContentType to nil")
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem int
-		if err := func() error {
-			v, err := d.Int()
-			elem = int(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
-		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MessageReactions")
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s MessageReactions) MarshalJSON() ([]byte, error) {
+func (s OptEmailSendContentType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MessageReactions) UnmarshalJSON(data []byte) error {
+func (s *OptEmailSendContentType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *MessageTelegramQueryOK) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *MessageTelegramQueryOK) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("messages")
-		e.ArrStart()
-		for _, elem := range s.Messages {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
+// Encode encodes ErasureReport as json.
+func (o OptErasureReport) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	o.Value.Encode(e)
 }
 
-var jsonFieldsNameOfMessageTelegramQueryOK = [1]string{
-	0: "messages",
-}
-
-// Decode decodes MessageTelegramQueryOK from json.
-func (s *MessageTelegramQueryOK) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MessageTelegramQueryOK to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "messages":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				s.Messages = make([]Message, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Message
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Messages = append(s.Messages, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"messages\"")
-			}
-		default:
-			return errors.Errorf("unexpected field %q", k)
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MessageTelegramQueryOK")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfMessageTelegramQueryOK) {
-					name = jsonFieldsNameOfMessageTelegramQueryOK[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
+// Decode decodes ErasureReport from json.
+func (o *OptErasureReport) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptErasureReport to nil")
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MessageTelegramQueryOK) MarshalJSON() ([]byte, error) {
+func (s OptErasureReport) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MessageTelegramQueryOK) UnmarshalJSON(data []byte) error {
+func (s *OptErasureReport) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *MessageWhatsappQueryOK) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *MessageWhatsappQueryOK) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("messages")
-		e.ArrStart()
-		for _, elem := range s.Messages {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
+// Encode encodes FileObject as json.
+func (o OptFileObject) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	o.Value.Encode(e)
 }
 
-var jsonFieldsNameOfMessageWhatsappQueryOK = [1]string{
-	0: "messages",
-}
-
-// Decode decodes MessageWhatsappQueryOK from json.
-func (s *MessageWhatsappQueryOK) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MessageWhatsappQueryOK to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "messages":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				s.Messages = make([]Message, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Message
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Messages = append(s.Messages, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"messages\"")
-			}
-		default:
-			return errors.Errorf("unexpected field %q", k)
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MessageWhatsappQueryOK")
+// Decode decodes FileObject from json.
+func (o *OptFileObject) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptFileObject to nil")
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfMessageWhatsappQueryOK) {
-					name = jsonFieldsNameOfMessageWhatsappQueryOK[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptFileObject) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptFileObject) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes float64 as json.
+func (o OptFloat64) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	e.Float64(float64(o.Value))
+}
 
+// Decode decodes float64 from json.
+func (o *OptFloat64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptFloat64 to nil")
+	}
+	o.Set = true
+	v, err := d.Float64()
+	if err != nil {
+		return err
+	}
+	o.Value = float64(v)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MessageWhatsappQueryOK) MarshalJSON() ([]byte, error) {
+func (s OptFloat64) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MessageWhatsappQueryOK) UnmarshalJSON(data []byte) error {
+func (s *OptFloat64) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *OAuth2Client) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes int as json.
+func (o OptInt) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int(int(o.Value))
 }
 
-// encodeFields encodes fields.
-func (s *OAuth2Client) encodeFields(e *jx.Encoder) {
-	{
-		if s.UUID.Set {
-			e.FieldStart("uuid")
-			s.UUID.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
-	{
-		e.FieldStart("provider")
-		e.Str(s.Provider)
+// Decode decodes int from json.
+func (o *OptInt) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt to nil")
 	}
-	{
-		e.FieldStart("client_id")
-		e.Str(s.ClientID)
+	o.Set = true
+	v, err := d.Int()
+	if err != nil {
+		return err
 	}
-	{
-		e.FieldStart("secret")
-		e.Str(s.Secret)
+	o.Value = int(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptInt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptInt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int32 as json.
+func (o OptInt32) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	{
-		if s.CreatedAt.Set {
-			e.FieldStart("created_at")
-			s.CreatedAt.Encode(e, json.EncodeDateTime)
-		}
+	e.Int32(int32(o.Value))
+}
+
+// Decode decodes int32 from json.
+func (o *OptInt32) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt32 to nil")
 	}
-	{
-		if s.UpdatedAt.Set {
-			e.FieldStart("updated_at")
-			s.UpdatedAt.Encode(e, json.EncodeDateTime)
-		}
+	o.Set = true
+	v, err := d.Int32()
+	if err != nil {
+		return err
 	}
+	o.Value = int32(v)
+	return nil
 }
 
-var jsonFieldsNameOfOAuth2Client = [7]string{
-	0: "uuid",
-	1: "name",
-	2: "provider",
-	3: "client_id",
-	4: "secret",
-	5: "created_at",
-	6: "updated_at",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptInt32) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes OAuth2Client from json.
-func (s *OAuth2Client) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode OAuth2Client to nil")
-	}
-	var requiredBitSet [1]uint8
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptInt32) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "uuid":
-			if err := func() error {
-				s.UUID.Reset()
-				if err := s.UUID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uuid\"")
-			}
-		case "name":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "provider":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Str()
-				s.Provider = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provider\"")
-			}
-		case "client_id":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Str()
-				s.ClientID = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"client_id\"")
-			}
-		case "secret":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Str()
-				s.Secret = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"secret\"")
-			}
-		case "created_at":
-			if err := func() error {
-				s.CreatedAt.Reset()
-				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
-			}
-		case "updated_at":
-			if err := func() error {
-				s.UpdatedAt.Reset()
-				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"updated_at\"")
-			}
-		default:
-			return errors.Errorf("unexpected field %q", k)
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode OAuth2Client")
+// Encode encodes int64 as json.
+func (o OptInt64) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00011110,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfOAuth2Client) {
-					name = jsonFieldsNameOfOAuth2Client[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
+	e.Int64(int64(o.Value))
+}
+
+// Decode decodes int64 from json.
+func (o *OptInt64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt64 to nil")
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	o.Set = true
+	v, err := d.Int64()
+	if err != nil {
+		return err
 	}
-
+	o.Value = int64(v)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *OAuth2Client) MarshalJSON() ([]byte, error) {
+func (s OptInt64) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OAuth2Client) UnmarshalJSON(data []byte) error {
+func (s *OptInt64) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *OAuth2ClientCreateReq) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *OAuth2ClientCreateReq) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
-	{
-		e.FieldStart("provider")
-		e.Str(s.Provider)
-	}
-	{
-		e.FieldStart("secret")
-		e.Str(s.Secret)
-	}
-	{
-		e.FieldStart("client_id")
-		e.Str(s.ClientID)
+// Encode encodes MessageBodyParsed as json.
+func (o OptMessageBodyParsed) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	o.Value.Encode(e)
 }
 
-var jsonFieldsNameOfOAuth2ClientCreateReq = [4]string{
-	0: "name",
-	1: "provider",
-	2: "secret",
-	3: "client_id",
-}
-
-// Decode decodes OAuth2ClientCreateReq from json.
-func (s *OAuth2ClientCreateReq) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode OAuth2ClientCreateReq to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "name":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "provider":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.Provider = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provider\"")
-			}
-		case "secret":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Str()
-				s.Secret = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"secret\"")
-			}
-		case "client_id":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Str()
-				s.ClientID = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"client_id\"")
-			}
-		default:
-			return errors.Errorf("unexpected field %q", k)
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode OAuth2ClientCreateReq")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00001111,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfOAuth2ClientCreateReq) {
-					name = jsonFieldsNameOfOAuth2ClientCreateReq[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
+// Decode decodes MessageBodyParsed from json.
+func (o *OptMessageBodyParsed) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptMessageBodyParsed to nil")
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *OAuth2ClientCreateReq) MarshalJSON() ([]byte, error) {
+func (s OptMessageBodyParsed) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OAuth2ClientCreateReq) UnmarshalJSON(data []byte) error {
+func (s *OptMessageBodyParsed) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *OAuth2ClientListOK) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes MessageBodyParsedBodySlate as json.
+func (o OptMessageBodyParsedBodySlate) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *OAuth2ClientListOK) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("clients")
-		e.ArrStart()
-		for _, elem := range s.Clients {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
+// Decode decodes MessageBodyParsedBodySlate from json.
+func (o *OptMessageBodyParsedBodySlate) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptMessageBodyParsedBodySlate to nil")
+	}
+	o.Set = true
+	o.Value = make(MessageBodyParsedBodySlate)
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
+	return nil
 }
 
-var jsonFieldsNameOfOAuth2ClientListOK = [1]string{
-	0: "clients",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptMessageBodyParsedBodySlate) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes OAuth2ClientListOK from json.
-func (s *OAuth2ClientListOK) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode OAuth2ClientListOK to nil")
-	}
-	var requiredBitSet [1]uint8
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptMessageBodyParsedBodySlate) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "clients":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				s.Clients = make([]OAuth2Client, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem OAuth2Client
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Clients = append(s.Clients, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"clients\"")
-			}
-		default:
-			return errors.Errorf("unexpected field %q", k)
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode OAuth2ClientListOK")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfOAuth2ClientListOK) {
-					name = jsonFieldsNameOfOAuth2ClientListOK[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+// Encode encodes MessageBodyParsedSubjectSlate as json.
+func (o OptMessageBodyParsedSubjectSlate) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	o.Value.Encode(e)
+}
 
+// Decode decodes MessageBodyParsedSubjectSlate from json.
+func (o *OptMessageBodyParsedSubjectSlate) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptMessageBodyParsedSubjectSlate to nil")
+	}
+	o.Set = true
+	o.Value = make(MessageBodyParsedSubjectSlate)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *OAuth2ClientListOK) MarshalJSON() ([]byte, error) {
+func (s OptMessageBodyParsedSubjectSlate) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OAuth2ClientListOK) UnmarshalJSON(data []byte) error {
+func (s *OptMessageBodyParsedSubjectSlate) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *OAuth2ClientLoginOK) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes MessageForwardMeta as json.
+func (o OptMessageForwardMeta) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *OAuth2ClientLoginOK) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("auth_code_url")
-		e.Str(s.AuthCodeURL)
+// Decode decodes MessageForwardMeta from json.
+func (o *OptMessageForwardMeta) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptMessageForwardMeta to nil")
+	}
+	o.Set = true
+	o.Value = make(MessageForwardMeta)
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
+	return nil
 }
 
-var jsonFieldsNameOfOAuth2ClientLoginOK = [1]string{
-	0: "auth_code_url",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptMessageForwardMeta) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes OAuth2ClientLoginOK from json.
-func (s *OAuth2ClientLoginOK) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode OAuth2ClientLoginOK to nil")
-	}
-	var requiredBitSet [1]uint8
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptMessageForwardMeta) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "auth_code_url":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.AuthCodeURL = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"auth_code_url\"")
-			}
-		default:
-			return errors.Errorf("unexpected field %q", k)
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode OAuth2ClientLoginOK")
+// Encode encodes MessageMeta as json.
+func (o OptMessageMeta) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfOAuth2ClientLoginOK) {
-					name = jsonFieldsNameOfOAuth2ClientLoginOK[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
+	o.Value.Encode(e)
+}
+
+// Decode decodes MessageMeta from json.
+func (o *OptMessageMeta) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptMessageMeta to nil")
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *OAuth2ClientLoginOK) MarshalJSON() ([]byte, error) {
+func (s OptMessageMeta) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OAuth2ClientLoginOK) UnmarshalJSON(data []byte) error {
+func (s *OptMessageMeta) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *OAuth2ClientLoginReq) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes MessageQueryMode as json.
+func (o OptMessageQueryMode) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
 }
 
-// encodeFields encodes fields.
-func (s *OAuth2ClientLoginReq) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("query")
-		s.Query.Encode(e)
+// Decode decodes MessageQueryMode from json.
+func (o *OptMessageQueryMode) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptMessageQueryMode to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
+	return nil
 }
 
-var jsonFieldsNameOfOAuth2ClientLoginReq = [1]string{
-	0: "query",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptMessageQueryMode) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes OAuth2ClientLoginReq from json.
-func (s *OAuth2ClientLoginReq) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode OAuth2ClientLoginReq to nil")
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptMessageQueryMode) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes MessageQueryOrder as json.
+func (o OptMessageQueryOrder) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	var requiredBitSet [1]uint8
+	e.Str(string(o.Value))
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "query":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				if err := s.Query.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"query\"")
-			}
-		default:
-			return errors.Errorf("unexpected field %q", k)
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode OAuth2ClientLoginReq")
+// Decode decodes MessageQueryOrder from json.
+func (o *OptMessageQueryOrder) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptMessageQueryOrder to nil")
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfOAuth2ClientLoginReq) {
-					name = jsonFieldsNameOfOAuth2ClientLoginReq[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptMessageQueryOrder) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptMessageQueryOrder) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes MessageQueryStorageType as json.
+func (o OptMessageQueryStorageType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes MessageQueryStorageType from json.
+func (o *OptMessageQueryStorageType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptMessageQueryStorageType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *OAuth2ClientLoginReq) MarshalJSON() ([]byte, error) {
+func (s OptMessageQueryStorageType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OAuth2ClientLoginReq) UnmarshalJSON(data []byte) error {
+func (s *OptMessageQueryStorageType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s OAuth2ClientLoginReqQuery) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields implements json.Marshaler.
-func (s OAuth2ClientLoginReqQuery) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		e.ArrStart()
-		for _, elem := range elem {
-			e.Str(elem)
-		}
-		e.ArrEnd()
+// Encode encodes MessageReactions as json.
+func (o OptMessageReactions) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	o.Value.Encode(e)
 }
 
-// Decode decodes OAuth2ClientLoginReqQuery from json.
-func (s *OAuth2ClientLoginReqQuery) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode OAuth2ClientLoginReqQuery to nil")
+// Decode decodes MessageReactions from json.
+func (o *OptMessageReactions) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptMessageReactions to nil")
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem []string
-		if err := func() error {
-			elem = make([]string, 0)
-			if err := d.Arr(func(d *jx.Decoder) error {
-				var elemElem string
-				v, err := d.Str()
-				elemElem = string(v)
-				if err != nil {
-					return err
-				}
-				elem = append(elem, elemElem)
-				return nil
-			}); err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
-		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode OAuth2ClientLoginReqQuery")
+	o.Set = true
+	o.Value = make(MessageReactions)
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OAuth2ClientLoginReqQuery) MarshalJSON() ([]byte, error) {
+func (s OptMessageReactions) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OAuth2ClientLoginReqQuery) UnmarshalJSON(data []byte) error {
+func (s *OptMessageReactions) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *OAuth2ClientToken) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *OAuth2ClientToken) encodeFields(e *jx.Encoder) {
-	{
-		if s.UUID.Set {
-			e.FieldStart("uuid")
-			s.UUID.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("client_uuid")
-		e.Str(s.ClientUUID)
+// Encode encodes time.Time as json.
+func (o OptNilDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
+	if !o.Set {
+		return
 	}
-	{
-		if s.UserUUID.Set {
-			e.FieldStart("user_uuid")
-			s.UserUUID.Encode(e)
-		}
+	if o.Null {
+		e.Null()
+		return
 	}
-	{
-		e.FieldStart("token")
-		s.Token.Encode(e)
+	format(e, o.Value)
+}
+
+// Decode decodes time.Time from json.
+func (o *OptNilDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilDateTime to nil")
 	}
-	{
-		if s.CreatedAt.Set {
-			e.FieldStart("created_at")
-			s.CreatedAt.Encode(e, json.EncodeDateTime)
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v time.Time
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
 	}
-	{
-		if s.UpdatedAt.Set {
-			e.FieldStart("updated_at")
-			s.UpdatedAt.Encode(e, json.EncodeDateTime)
-		}
+	o.Set = true
+	o.Null = false
+	v, err := format(d)
+	if err != nil {
+		return err
 	}
+	o.Value = v
+	return nil
 }
 
-var jsonFieldsNameOfOAuth2ClientToken = [6]string{
-	0: "uuid",
-	1: "client_uuid",
-	2: "user_uuid",
-	3: "token",
-	4: "created_at",
-	5: "updated_at",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilDateTime) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e, json.EncodeDateTime)
+	return e.Bytes(), nil
 }
 
-// Decode decodes OAuth2ClientToken from json.
-func (s *OAuth2ClientToken) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode OAuth2ClientToken to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "uuid":
-			if err := func() error {
-				s.UUID.Reset()
-				if err := s.UUID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uuid\"")
-			}
-		case "client_uuid":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.ClientUUID = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"client_uuid\"")
-			}
-		case "user_uuid":
-			if err := func() error {
-				s.UserUUID.Reset()
-				if err := s.UserUUID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"user_uuid\"")
-			}
-		case "token":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				if err := s.Token.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"token\"")
-			}
-		case "created_at":
-			if err := func() error {
-				s.CreatedAt.Reset()
-				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
-			}
-		case "updated_at":
-			if err := func() error {
-				s.UpdatedAt.Reset()
-				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"updated_at\"")
-			}
-		default:
-			return errors.Errorf("unexpected field %q", k)
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode OAuth2ClientToken")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00001010,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfOAuth2ClientToken) {
-					name = jsonFieldsNameOfOAuth2ClientToken[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilDateTime) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d, json.DecodeDateTime)
+}
+
+// Encode encodes int32 as json.
+func (o OptNilInt32) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Int32(int32(o.Value))
+}
+
+// Decode decodes int32 from json.
+func (o *OptNilInt32) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilInt32 to nil")
 	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
 
+		var v int32
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	v, err := d.Int32()
+	if err != nil {
+		return err
+	}
+	o.Value = int32(v)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *OAuth2ClientToken) MarshalJSON() ([]byte, error) {
+func (s OptNilInt32) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OAuth2ClientToken) UnmarshalJSON(data []byte) error {
+func (s *OptNilInt32) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *OAuth2ClientTokenObj) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *OAuth2ClientTokenObj) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("access_token")
-		e.Str(s.AccessToken)
-	}
-	{
-		e.FieldStart("refresh_token")
-		e.Str(s.RefreshToken)
-	}
-	{
-		e.FieldStart("expires_at")
-		json.EncodeDateTime(e, s.ExpiresAt)
+// Encode encodes string as json.
+func (o OptNilString) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	{
-		if s.Token.Set {
-			e.FieldStart("token")
-			s.Token.Encode(e)
-		}
+	if o.Null {
+		e.Null()
+		return
 	}
+	e.Str(string(o.Value))
 }
 
-var jsonFieldsNameOfOAuth2ClientTokenObj = [4]string{
-	0: "access_token",
-	1: "refresh_token",
-	2: "expires_at",
-	3: "token",
-}
-
-// Decode decodes OAuth2ClientTokenObj from json.
-func (s *OAuth2ClientTokenObj) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode OAuth2ClientTokenObj to nil")
+// Decode decodes string from json.
+func (o *OptNilString) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilString to nil")
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "access_token":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.AccessToken = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"access_token\"")
-			}
-		case "refresh_token":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.RefreshToken = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"refresh_token\"")
-			}
-		case "expires_at":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.ExpiresAt = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"expires_at\"")
-			}
-		case "token":
-			if err := func() error {
-				s.Token.Reset()
-				if err := s.Token.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"token\"")
-			}
-		default:
-			return errors.Errorf("unexpected field %q", k)
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v string
+		o.Value = v
+		o.Set = true
+		o.Null = true
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode OAuth2ClientTokenObj")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000111,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfOAuth2ClientTokenObj) {
-					name = jsonFieldsNameOfOAuth2ClientTokenObj[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	o.Set = true
+	o.Null = false
+	v, err := d.Str()
+	if err != nil {
+		return err
 	}
-
+	o.Value = string(v)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *OAuth2ClientTokenObj) MarshalJSON() ([]byte, error) {
+func (s OptNilString) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OAuth2ClientTokenObj) UnmarshalJSON(data []byte) error {
+func (s *OptNilString) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s OAuth2ClientTokenObjToken) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields implements json.Marshaler.
-func (s OAuth2ClientTokenObjToken) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
-		}
+// Encode encodes OAuth2ClientTokenObjToken as json.
+func (o OptOAuth2ClientTokenObjToken) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	o.Value.Encode(e)
 }
 
 // Decode decodes OAuth2ClientTokenObjToken from json.
-func (s *OAuth2ClientTokenObjToken) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode OAuth2ClientTokenObjToken to nil")
+func (o *OptOAuth2ClientTokenObjToken) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptOAuth2ClientTokenObjToken to nil")
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
-		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode OAuth2ClientTokenObjToken")
+	o.Set = true
+	o.Value = make(OAuth2ClientTokenObjToken)
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OAuth2ClientTokenObjToken) MarshalJSON() ([]byte, error) {
+func (s OptOAuth2ClientTokenObjToken) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OAuth2ClientTokenObjToken) UnmarshalJSON(data []byte) error {
+func (s *OptOAuth2ClientTokenObjToken) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *OAuth2ClientUpdateReq) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *OAuth2ClientUpdateReq) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
-	{
-		e.FieldStart("provider")
-		e.Str(s.Provider)
-	}
-	{
-		e.FieldStart("secret")
-		e.Str(s.Secret)
+// Encode encodes OAuth2ClientTokenStatus as json.
+func (o OptOAuth2ClientTokenStatus) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	{
-		e.FieldStart("client_id")
-		e.Str(s.ClientID)
+	e.Str(string(o.Value))
+}
+
+// Decode decodes OAuth2ClientTokenStatus from json.
+func (o *OptOAuth2ClientTokenStatus) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptOAuth2ClientTokenStatus to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
+	return nil
 }
 
-var jsonFieldsNameOfOAuth2ClientUpdateReq = [4]string{
-	0: "name",
-	1: "provider",
-	2: "secret",
-	3: "client_id",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptOAuth2ClientTokenStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes OAuth2ClientUpdateReq from json.
-func (s *OAuth2ClientUpdateReq) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode OAuth2ClientUpdateReq to nil")
-	}
-	var requiredBitSet [1]uint8
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptOAuth2ClientTokenStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "name":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "provider":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.Provider = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provider\"")
-			}
-		case "secret":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Str()
-				s.Secret = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"secret\"")
-			}
-		case "client_id":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Str()
-				s.ClientID = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"client_id\"")
-			}
-		default:
-			return errors.Errorf("unexpected field %q", k)
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode OAuth2ClientUpdateReq")
+// Encode encodes PipelineEdgeType as json.
+func (o OptPipelineEdgeType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00001111,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfOAuth2ClientUpdateReq) {
-					name = jsonFieldsNameOfOAuth2ClientUpdateReq[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes PipelineEdgeType from json.
+func (o *OptPipelineEdgeType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptPipelineEdgeType to nil")
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *OAuth2ClientUpdateReq) MarshalJSON() ([]byte, error) {
+func (s OptPipelineEdgeType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OAuth2ClientUpdateReq) UnmarshalJSON(data []byte) error {
+func (s *OptPipelineEdgeType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes bool as json.
-func (o OptBool) Encode(e *jx.Encoder) {
+// Encode encodes PipelineFlow as json.
+func (o OptPipelineFlow) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
-	e.Bool(bool(o.Value))
+	o.Value.Encode(e)
 }
 
-// Decode decodes bool from json.
-func (o *OptBool) Decode(d *jx.Decoder) error {
+// Decode decodes PipelineFlow from json.
+func (o *OptPipelineFlow) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptBool to nil")
+		return errors.New("invalid: unable to decode OptPipelineFlow to nil")
 	}
 	o.Set = true
-	v, err := d.Bool()
-	if err != nil {
+	if err := o.Value.Decode(d); err != nil {
 		return err
 	}
-	o.Value = bool(v)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptBool) MarshalJSON() ([]byte, error) {
+func (s OptPipelineFlow) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptBool) UnmarshalJSON(data []byte) error {
+func (s *OptPipelineFlow) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DatasourceLinkedinSettings as json.
-func (o OptDatasourceLinkedinSettings) Encode(e *jx.Encoder) {
+// Encode encodes PipelineNodeDataConfig as json.
+func (o OptPipelineNodeDataConfig) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
 	o.Value.Encode(e)
 }
 
-// Decode decodes DatasourceLinkedinSettings from json.
-func (o *OptDatasourceLinkedinSettings) Decode(d *jx.Decoder) error {
+// Decode decodes PipelineNodeDataConfig from json.
+func (o *OptPipelineNodeDataConfig) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptDatasourceLinkedinSettings to nil")
+		return errors.New("invalid: unable to decode OptPipelineNodeDataConfig to nil")
 	}
 	o.Set = true
-	o.Value = make(DatasourceLinkedinSettings)
+	o.Value = make(PipelineNodeDataConfig)
 	if err := o.Value.Decode(d); err != nil {
 		return err
 	}
@@ -8524,33 +12673,32 @@ func (o *OptDatasourceLinkedinSettings) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptDatasourceLinkedinSettings) MarshalJSON() ([]byte, error) {
+func (s OptPipelineNodeDataConfig) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptDatasourceLinkedinSettings) UnmarshalJSON(data []byte) error {
+func (s *OptPipelineNodeDataConfig) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DatasourceTelegramMeta as json.
-func (o OptDatasourceTelegramMeta) Encode(e *jx.Encoder) {
+// Encode encodes PipelineRun as json.
+func (o OptPipelineRun) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
 	o.Value.Encode(e)
 }
 
-// Decode decodes DatasourceTelegramMeta from json.
-func (o *OptDatasourceTelegramMeta) Decode(d *jx.Decoder) error {
+// Decode decodes PipelineRun from json.
+func (o *OptPipelineRun) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptDatasourceTelegramMeta to nil")
+		return errors.New("invalid: unable to decode OptPipelineRun to nil")
 	}
 	o.Set = true
-	o.Value = make(DatasourceTelegramMeta)
 	if err := o.Value.Decode(d); err != nil {
 		return err
 	}
@@ -8558,33 +12706,33 @@ func (o *OptDatasourceTelegramMeta) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptDatasourceTelegramMeta) MarshalJSON() ([]byte, error) {
+func (s OptPipelineRun) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptDatasourceTelegramMeta) UnmarshalJSON(data []byte) error {
+func (s *OptPipelineRun) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DatasourceTelegramSettings as json.
-func (o OptDatasourceTelegramSettings) Encode(e *jx.Encoder) {
+// Encode encodes PolicyConditions as json.
+func (o OptPolicyConditions) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
 	o.Value.Encode(e)
 }
 
-// Decode decodes DatasourceTelegramSettings from json.
-func (o *OptDatasourceTelegramSettings) Decode(d *jx.Decoder) error {
+// Decode decodes PolicyConditions from json.
+func (o *OptPolicyConditions) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptDatasourceTelegramSettings to nil")
+		return errors.New("invalid: unable to decode OptPolicyConditions to nil")
 	}
 	o.Set = true
-	o.Value = make(DatasourceTelegramSettings)
+	o.Value = make(PolicyConditions)
 	if err := o.Value.Decode(d); err != nil {
 		return err
 	}
@@ -8592,101 +12740,102 @@ func (o *OptDatasourceTelegramSettings) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptDatasourceTelegramSettings) MarshalJSON() ([]byte, error) {
+func (s OptPolicyConditions) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptDatasourceTelegramSettings) UnmarshalJSON(data []byte) error {
+func (s *OptPolicyConditions) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DatasourceWhatsappSettings as json.
-func (o OptDatasourceWhatsappSettings) Encode(e *jx.Encoder) {
+// Encode encodes string as json.
+func (o OptString) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
-	o.Value.Encode(e)
+	e.Str(string(o.Value))
 }
 
-// Decode decodes DatasourceWhatsappSettings from json.
-func (o *OptDatasourceWhatsappSettings) Decode(d *jx.Decoder) error {
+// Decode decodes string from json.
+func (o *OptString) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptDatasourceWhatsappSettings to nil")
+		return errors.New("invalid: unable to decode OptString to nil")
 	}
 	o.Set = true
-	o.Value = make(DatasourceWhatsappSettings)
-	if err := o.Value.Decode(d); err != nil {
+	v, err := d.Str()
+	if err != nil {
 		return err
 	}
+	o.Value = string(v)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptDatasourceWhatsappSettings) MarshalJSON() ([]byte, error) {
+func (s OptString) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptDatasourceWhatsappSettings) UnmarshalJSON(data []byte) error {
+func (s *OptString) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes time.Time as json.
-func (o OptDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
+// Encode encodes SyncPolicySettings as json.
+func (o OptSyncPolicySettings) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
-	format(e, o.Value)
+	o.Value.Encode(e)
 }
 
-// Decode decodes time.Time from json.
-func (o *OptDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
+// Decode decodes SyncPolicySettings from json.
+func (o *OptSyncPolicySettings) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptDateTime to nil")
+		return errors.New("invalid: unable to decode OptSyncPolicySettings to nil")
 	}
 	o.Set = true
-	v, err := format(d)
-	if err != nil {
+	o.Value = make(SyncPolicySettings)
+	if err := o.Value.Decode(d); err != nil {
 		return err
 	}
-	o.Value = v
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptDateTime) MarshalJSON() ([]byte, error) {
+func (s OptSyncPolicySettings) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
-	s.Encode(&e, json.EncodeDateTime)
+	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptDateTime) UnmarshalJSON(data []byte) error {
+func (s *OptSyncPolicySettings) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
-	return s.Decode(d, json.DecodeDateTime)
+	return s.Decode(d)
 }
 
-// Encode encodes FileObject as json.
-func (o OptFileObject) Encode(e *jx.Encoder) {
+// Encode encodes TelegramParticipantsItemMeta as json.
+func (o OptTelegramParticipantsItemMeta) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
 	o.Value.Encode(e)
 }
 
-// Decode decodes FileObject from json.
-func (o *OptFileObject) Decode(d *jx.Decoder) error {
+// Decode decodes TelegramParticipantsItemMeta from json.
+func (o *OptTelegramParticipantsItemMeta) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptFileObject to nil")
+		return errors.New("invalid: unable to decode OptTelegramParticipantsItemMeta to nil")
 	}
 	o.Set = true
+	o.Value = make(TelegramParticipantsItemMeta)
 	if err := o.Value.Decode(d); err != nil {
 		return err
 	}
@@ -8694,137 +12843,135 @@ func (o *OptFileObject) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptFileObject) MarshalJSON() ([]byte, error) {
+func (s OptTelegramParticipantsItemMeta) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptFileObject) UnmarshalJSON(data []byte) error {
+func (s *OptTelegramParticipantsItemMeta) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes float64 as json.
-func (o OptFloat64) Encode(e *jx.Encoder) {
+// Encode encodes TelegramSessionHistoryItemMeta as json.
+func (o OptTelegramSessionHistoryItemMeta) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
-	e.Float64(float64(o.Value))
+	o.Value.Encode(e)
 }
 
-// Decode decodes float64 from json.
-func (o *OptFloat64) Decode(d *jx.Decoder) error {
+// Decode decodes TelegramSessionHistoryItemMeta from json.
+func (o *OptTelegramSessionHistoryItemMeta) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptFloat64 to nil")
+		return errors.New("invalid: unable to decode OptTelegramSessionHistoryItemMeta to nil")
 	}
 	o.Set = true
-	v, err := d.Float64()
-	if err != nil {
+	o.Value = make(TelegramSessionHistoryItemMeta)
+	if err := o.Value.Decode(d); err != nil {
 		return err
 	}
-	o.Value = float64(v)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptFloat64) MarshalJSON() ([]byte, error) {
+func (s OptTelegramSessionHistoryItemMeta) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptFloat64) UnmarshalJSON(data []byte) error {
+func (s *OptTelegramSessionHistoryItemMeta) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes int as json.
-func (o OptInt) Encode(e *jx.Encoder) {
+// Encode encodes uuid.UUID as json.
+func (o OptUUID) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
-	e.Int(int(o.Value))
+	json.EncodeUUID(e, o.Value)
 }
 
-// Decode decodes int from json.
-func (o *OptInt) Decode(d *jx.Decoder) error {
+// Decode decodes uuid.UUID from json.
+func (o *OptUUID) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptInt to nil")
+		return errors.New("invalid: unable to decode OptUUID to nil")
 	}
 	o.Set = true
-	v, err := d.Int()
+	v, err := json.DecodeUUID(d)
 	if err != nil {
 		return err
 	}
-	o.Value = int(v)
+	o.Value = v
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptInt) MarshalJSON() ([]byte, error) {
+func (s OptUUID) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptInt) UnmarshalJSON(data []byte) error {
+func (s *OptUUID) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes int64 as json.
-func (o OptInt64) Encode(e *jx.Encoder) {
+// Encode encodes UploadPresignedUrlRequestStorageType as json.
+func (o OptUploadPresignedUrlRequestStorageType) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
-	e.Int64(int64(o.Value))
+	e.Str(string(o.Value))
 }
 
-// Decode decodes int64 from json.
-func (o *OptInt64) Decode(d *jx.Decoder) error {
+// Decode decodes UploadPresignedUrlRequestStorageType from json.
+func (o *OptUploadPresignedUrlRequestStorageType) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptInt64 to nil")
+		return errors.New("invalid: unable to decode OptUploadPresignedUrlRequestStorageType to nil")
 	}
 	o.Set = true
-	v, err := d.Int64()
-	if err != nil {
+	if err := o.Value.Decode(d); err != nil {
 		return err
 	}
-	o.Value = int64(v)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptInt64) MarshalJSON() ([]byte, error) {
+func (s OptUploadPresignedUrlRequestStorageType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptInt64) UnmarshalJSON(data []byte) error {
+func (s *OptUploadPresignedUrlRequestStorageType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MessageBodyParsed as json.
-func (o OptMessageBodyParsed) Encode(e *jx.Encoder) {
+// Encode encodes UserMeta as json.
+func (o OptUserMeta) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
 	o.Value.Encode(e)
 }
 
-// Decode decodes MessageBodyParsed from json.
-func (o *OptMessageBodyParsed) Decode(d *jx.Decoder) error {
+// Decode decodes UserMeta from json.
+func (o *OptUserMeta) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptMessageBodyParsed to nil")
+		return errors.New("invalid: unable to decode OptUserMeta to nil")
 	}
 	o.Set = true
+	o.Value = make(UserMeta)
 	if err := o.Value.Decode(d); err != nil {
 		return err
 	}
@@ -8832,33 +12979,33 @@ func (o *OptMessageBodyParsed) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptMessageBodyParsed) MarshalJSON() ([]byte, error) {
+func (s OptUserMeta) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMessageBodyParsed) UnmarshalJSON(data []byte) error {
+func (s *OptUserMeta) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MessageBodyParsedBodySlate as json.
-func (o OptMessageBodyParsedBodySlate) Encode(e *jx.Encoder) {
+// Encode encodes WorkerJobsData as json.
+func (o OptWorkerJobsData) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
 	o.Value.Encode(e)
 }
 
-// Decode decodes MessageBodyParsedBodySlate from json.
-func (o *OptMessageBodyParsedBodySlate) Decode(d *jx.Decoder) error {
+// Decode decodes WorkerJobsData from json.
+func (o *OptWorkerJobsData) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptMessageBodyParsedBodySlate to nil")
+		return errors.New("invalid: unable to decode OptWorkerJobsData to nil")
 	}
 	o.Set = true
-	o.Value = make(MessageBodyParsedBodySlate)
+	o.Value = make(WorkerJobsData)
 	if err := o.Value.Decode(d); err != nil {
 		return err
 	}
@@ -8866,737 +13013,1563 @@ func (o *OptMessageBodyParsedBodySlate) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptMessageBodyParsedBodySlate) MarshalJSON() ([]byte, error) {
+func (s OptWorkerJobsData) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMessageBodyParsedBodySlate) UnmarshalJSON(data []byte) error {
+func (s *OptWorkerJobsData) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MessageBodyParsedSubjectSlate as json.
-func (o OptMessageBodyParsedSubjectSlate) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *Pipeline) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Pipeline) encodeFields(e *jx.Encoder) {
+	{
+		if s.UUID.Set {
+			e.FieldStart("uuid")
+			s.UUID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("datasource_uuid")
+		e.Str(s.DatasourceUUID)
+	}
+	{
+		e.FieldStart("storage_uuid")
+		e.Str(s.StorageUUID)
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.IsEnabled.Set {
+			e.FieldStart("is_enabled")
+			s.IsEnabled.Encode(e)
+		}
+	}
+	{
+		if s.Flow.Set {
+			e.FieldStart("flow")
+			s.Flow.Encode(e)
+		}
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		}
 	}
-	o.Value.Encode(e)
 }
 
-// Decode decodes MessageBodyParsedSubjectSlate from json.
-func (o *OptMessageBodyParsedSubjectSlate) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMessageBodyParsedSubjectSlate to nil")
+var jsonFieldsNameOfPipeline = [9]string{
+	0: "uuid",
+	1: "datasource_uuid",
+	2: "storage_uuid",
+	3: "type",
+	4: "name",
+	5: "is_enabled",
+	6: "flow",
+	7: "created_at",
+	8: "updated_at",
+}
+
+// Decode decodes Pipeline from json.
+func (s *Pipeline) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Pipeline to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "uuid":
+			if err := func() error {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uuid\"")
+			}
+		case "datasource_uuid":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.DatasourceUUID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"datasource_uuid\"")
+			}
+		case "storage_uuid":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.StorageUUID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"storage_uuid\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "is_enabled":
+			if err := func() error {
+				s.IsEnabled.Reset()
+				if err := s.IsEnabled.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_enabled\"")
+			}
+		case "flow":
+			if err := func() error {
+				s.Flow.Reset()
+				if err := s.Flow.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"flow\"")
+			}
+		case "created_at":
+			if err := func() error {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "updated_at":
+			if err := func() error {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updated_at\"")
+			}
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Pipeline")
 	}
-	o.Set = true
-	o.Value = make(MessageBodyParsedSubjectSlate)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00010110,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPipeline) {
+					name = jsonFieldsNameOfPipeline[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptMessageBodyParsedSubjectSlate) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMessageBodyParsedSubjectSlate) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes MessageForwardMeta as json.
-func (o OptMessageForwardMeta) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes MessageForwardMeta from json.
-func (o *OptMessageForwardMeta) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMessageForwardMeta to nil")
-	}
-	o.Set = true
-	o.Value = make(MessageForwardMeta)
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptMessageForwardMeta) MarshalJSON() ([]byte, error) {
+func (s *Pipeline) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMessageForwardMeta) UnmarshalJSON(data []byte) error {
+func (s *Pipeline) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MessageMeta as json.
-func (o OptMessageMeta) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *PipelineEdge) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes MessageMeta from json.
-func (o *OptMessageMeta) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMessageMeta to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+// encodeFields encodes fields.
+func (s *PipelineEdge) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Str(s.ID)
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptMessageMeta) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMessageMeta) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes MessageQueryOrder as json.
-func (o OptMessageQueryOrder) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	{
+		e.FieldStart("source")
+		e.Str(s.Source)
 	}
-	e.Str(string(o.Value))
-}
-
-// Decode decodes MessageQueryOrder from json.
-func (o *OptMessageQueryOrder) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMessageQueryOrder to nil")
+	{
+		e.FieldStart("target")
+		e.Str(s.Target)
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptMessageQueryOrder) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMessageQueryOrder) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfPipelineEdge = [4]string{
+	0: "id",
+	1: "source",
+	2: "target",
+	3: "type",
 }
 
-// Encode encodes MessageQueryStorageType as json.
-func (o OptMessageQueryStorageType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes PipelineEdge from json.
+func (s *PipelineEdge) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PipelineEdge to nil")
 	}
-	e.Str(string(o.Value))
-}
+	var requiredBitSet [1]uint8
 
-// Decode decodes MessageQueryStorageType from json.
-func (o *OptMessageQueryStorageType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMessageQueryStorageType to nil")
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.ID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "source":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Source = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"source\"")
+			}
+		case "target":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Target = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"target\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PipelineEdge")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPipelineEdge) {
+					name = jsonFieldsNameOfPipelineEdge[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptMessageQueryStorageType) MarshalJSON() ([]byte, error) {
+func (s *PipelineEdge) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMessageQueryStorageType) UnmarshalJSON(data []byte) error {
+func (s *PipelineEdge) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MessageReactions as json.
-func (o OptMessageReactions) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode encodes PipelineEdgeType as json.
+func (s PipelineEdgeType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes MessageReactions from json.
-func (o *OptMessageReactions) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMessageReactions to nil")
+// Decode decodes PipelineEdgeType from json.
+func (s *PipelineEdgeType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PipelineEdgeType to nil")
 	}
-	o.Set = true
-	o.Value = make(MessageReactions)
-	if err := o.Value.Decode(d); err != nil {
+	v, err := d.StrBytes()
+	if err != nil {
 		return err
 	}
+	// Try to use constant string.
+	switch PipelineEdgeType(v) {
+	case PipelineEdgeTypeDefault:
+		*s = PipelineEdgeTypeDefault
+	case PipelineEdgeTypeStep:
+		*s = PipelineEdgeTypeStep
+	default:
+		*s = PipelineEdgeType(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptMessageReactions) MarshalJSON() ([]byte, error) {
+func (s PipelineEdgeType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMessageReactions) UnmarshalJSON(data []byte) error {
+func (s *PipelineEdgeType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes time.Time as json.
-func (o OptNilDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *PipelineFlow) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PipelineFlow) encodeFields(e *jx.Encoder) {
+	{
+		if s.Nodes != nil {
+			e.FieldStart("nodes")
+			e.ArrStart()
+			for _, elem := range s.Nodes {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
 	}
-	if o.Null {
-		e.Null()
-		return
+	{
+		if s.Edges != nil {
+			e.FieldStart("edges")
+			e.ArrStart()
+			for _, elem := range s.Edges {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
 	}
-	format(e, o.Value)
 }
 
-// Decode decodes time.Time from json.
-func (o *OptNilDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilDateTime to nil")
+var jsonFieldsNameOfPipelineFlow = [2]string{
+	0: "nodes",
+	1: "edges",
+}
+
+// Decode decodes PipelineFlow from json.
+func (s *PipelineFlow) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PipelineFlow to nil")
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
-		}
 
-		var v time.Time
-		o.Value = v
-		o.Set = true
-		o.Null = true
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "nodes":
+			if err := func() error {
+				s.Nodes = make([]PipelineNode, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem PipelineNode
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Nodes = append(s.Nodes, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"nodes\"")
+			}
+		case "edges":
+			if err := func() error {
+				s.Edges = make([]PipelineEdge, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem PipelineEdge
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Edges = append(s.Edges, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"edges\"")
+			}
+		default:
+			return d.Skip()
+		}
 		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PipelineFlow")
 	}
-	o.Set = true
-	o.Null = false
-	v, err := format(d)
-	if err != nil {
-		return err
-	}
-	o.Value = v
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilDateTime) MarshalJSON() ([]byte, error) {
+func (s *PipelineFlow) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
-	s.Encode(&e, json.EncodeDateTime)
+	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilDateTime) UnmarshalJSON(data []byte) error {
+func (s *PipelineFlow) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
-	return s.Decode(d, json.DecodeDateTime)
+	return s.Decode(d)
 }
 
-// Encode encodes string as json.
-func (o OptNilString) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	if o.Null {
-		e.Null()
-		return
+// Encode implements json.Marshaler.
+func (s *PipelineListOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PipelineListOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("pipelines")
+		e.ArrStart()
+		for _, elem := range s.Pipelines {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
-	e.Str(string(o.Value))
 }
 
-// Decode decodes string from json.
-func (o *OptNilString) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilString to nil")
+var jsonFieldsNameOfPipelineListOK = [1]string{
+	0: "pipelines",
+}
+
+// Decode decodes PipelineListOK from json.
+func (s *PipelineListOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PipelineListOK to nil")
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "pipelines":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Pipelines = make([]Pipeline, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Pipeline
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Pipelines = append(s.Pipelines, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pipelines\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PipelineListOK")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPipelineListOK) {
+					name = jsonFieldsNameOfPipelineListOK[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
 		}
-
-		var v string
-		o.Value = v
-		o.Set = true
-		o.Null = true
-		return nil
 	}
-	o.Set = true
-	o.Null = false
-	v, err := d.Str()
-	if err != nil {
-		return err
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
-	o.Value = string(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilString) MarshalJSON() ([]byte, error) {
+func (s *PipelineListOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilString) UnmarshalJSON(data []byte) error {
+func (s *PipelineListOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes OAuth2ClientTokenObjToken as json.
-func (o OptOAuth2ClientTokenObjToken) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *PipelineNode) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PipelineNode) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Str(s.ID)
+	}
+	{
+		e.FieldStart("type")
+		e.Str(s.Type)
+	}
+	{
+		e.FieldStart("position")
+		s.Position.Encode(e)
+	}
+	{
+		e.FieldStart("data")
+		s.Data.Encode(e)
 	}
-	o.Value.Encode(e)
 }
 
-// Decode decodes OAuth2ClientTokenObjToken from json.
-func (o *OptOAuth2ClientTokenObjToken) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptOAuth2ClientTokenObjToken to nil")
+var jsonFieldsNameOfPipelineNode = [4]string{
+	0: "id",
+	1: "type",
+	2: "position",
+	3: "data",
+}
+
+// Decode decodes PipelineNode from json.
+func (s *PipelineNode) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PipelineNode to nil")
 	}
-	o.Set = true
-	o.Value = make(OAuth2ClientTokenObjToken)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.ID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "type":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Type = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "position":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Position.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"position\"")
+			}
+		case "data":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.Data.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"data\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PipelineNode")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPipelineNode) {
+					name = jsonFieldsNameOfPipelineNode[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptOAuth2ClientTokenObjToken) MarshalJSON() ([]byte, error) {
+func (s *PipelineNode) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptOAuth2ClientTokenObjToken) UnmarshalJSON(data []byte) error {
+func (s *PipelineNode) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes PipelineEdgeType as json.
-func (o OptPipelineEdgeType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *PipelineNodeData) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PipelineNodeData) encodeFields(e *jx.Encoder) {
+	{
+		if s.Label.Set {
+			e.FieldStart("label")
+			s.Label.Encode(e)
+		}
+	}
+	{
+		if s.EntryUUID.Set {
+			e.FieldStart("entry_uuid")
+			s.EntryUUID.Encode(e)
+		}
+	}
+	{
+		if s.Config.Set {
+			e.FieldStart("config")
+			s.Config.Encode(e)
+		}
 	}
-	e.Str(string(o.Value))
 }
 
-// Decode decodes PipelineEdgeType from json.
-func (o *OptPipelineEdgeType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptPipelineEdgeType to nil")
+var jsonFieldsNameOfPipelineNodeData = [3]string{
+	0: "label",
+	1: "entry_uuid",
+	2: "config",
+}
+
+// Decode decodes PipelineNodeData from json.
+func (s *PipelineNodeData) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PipelineNodeData to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "label":
+			if err := func() error {
+				s.Label.Reset()
+				if err := s.Label.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"label\"")
+			}
+		case "entry_uuid":
+			if err := func() error {
+				s.EntryUUID.Reset()
+				if err := s.EntryUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"entry_uuid\"")
+			}
+		case "config":
+			if err := func() error {
+				s.Config.Reset()
+				if err := s.Config.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"config\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PipelineNodeData")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptPipelineEdgeType) MarshalJSON() ([]byte, error) {
+func (s *PipelineNodeData) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptPipelineEdgeType) UnmarshalJSON(data []byte) error {
+func (s *PipelineNodeData) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes PipelineFlow as json.
-func (o OptPipelineFlow) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s PipelineNodeDataConfig) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s PipelineNodeDataConfig) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
 	}
-	o.Value.Encode(e)
 }
 
-// Decode decodes PipelineFlow from json.
-func (o *OptPipelineFlow) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptPipelineFlow to nil")
+// Decode decodes PipelineNodeDataConfig from json.
+func (s *PipelineNodeDataConfig) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PipelineNodeDataConfig to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PipelineNodeDataConfig")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptPipelineFlow) MarshalJSON() ([]byte, error) {
+func (s PipelineNodeDataConfig) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptPipelineFlow) UnmarshalJSON(data []byte) error {
+func (s *PipelineNodeDataConfig) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes PipelineNodeDataConfig as json.
-func (o OptPipelineNodeDataConfig) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *PipelineNodePosition) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes PipelineNodeDataConfig from json.
-func (o *OptPipelineNodeDataConfig) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptPipelineNodeDataConfig to nil")
+// encodeFields encodes fields.
+func (s *PipelineNodePosition) encodeFields(e *jx.Encoder) {
+	{
+		if s.X.Set {
+			e.FieldStart("x")
+			s.X.Encode(e)
+		}
 	}
-	o.Set = true
-	o.Value = make(PipelineNodeDataConfig)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.Y.Set {
+			e.FieldStart("y")
+			s.Y.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptPipelineNodeDataConfig) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptPipelineNodeDataConfig) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfPipelineNodePosition = [2]string{
+	0: "x",
+	1: "y",
 }
 
-// Encode encodes string as json.
-func (o OptString) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes PipelineNodePosition from json.
+func (s *PipelineNodePosition) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PipelineNodePosition to nil")
 	}
-	e.Str(string(o.Value))
-}
 
-// Decode decodes string from json.
-func (o *OptString) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptString to nil")
-	}
-	o.Set = true
-	v, err := d.Str()
-	if err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "x":
+			if err := func() error {
+				s.X.Reset()
+				if err := s.X.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"x\"")
+			}
+		case "y":
+			if err := func() error {
+				s.Y.Reset()
+				if err := s.Y.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"y\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PipelineNodePosition")
 	}
-	o.Value = string(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptString) MarshalJSON() ([]byte, error) {
+func (s *PipelineNodePosition) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptString) UnmarshalJSON(data []byte) error {
+func (s *PipelineNodePosition) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes SyncPolicySettings as json.
-func (o OptSyncPolicySettings) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *PipelineRun) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes SyncPolicySettings from json.
-func (o *OptSyncPolicySettings) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptSyncPolicySettings to nil")
+// encodeFields encodes fields.
+func (s *PipelineRun) encodeFields(e *jx.Encoder) {
+	{
+		if s.JobUUID.Set {
+			e.FieldStart("job_uuid")
+			s.JobUUID.Encode(e)
+		}
 	}
-	o.Set = true
-	o.Value = make(SyncPolicySettings)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.Since.Set {
+			e.FieldStart("since")
+			s.Since.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.Until.Set {
+			e.FieldStart("until")
+			s.Until.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.Limit.Set {
+			e.FieldStart("limit")
+			s.Limit.Encode(e)
+		}
+	}
+	{
+		if s.DryRun.Set {
+			e.FieldStart("dry_run")
+			s.DryRun.Encode(e)
+		}
+	}
+	{
+		if s.StorageUUID.Set {
+			e.FieldStart("storage_uuid")
+			s.StorageUUID.Encode(e)
+		}
 	}
-	return nil
 }
 
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptSyncPolicySettings) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
+var jsonFieldsNameOfPipelineRun = [6]string{
+	0: "job_uuid",
+	1: "since",
+	2: "until",
+	3: "limit",
+	4: "dry_run",
+	5: "storage_uuid",
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptSyncPolicySettings) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
+// Decode decodes PipelineRun from json.
+func (s *PipelineRun) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PipelineRun to nil")
+	}
 
-// Encode encodes TelegramParticipantsItemMeta as json.
-func (o OptTelegramParticipantsItemMeta) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "job_uuid":
+			if err := func() error {
+				s.JobUUID.Reset()
+				if err := s.JobUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"job_uuid\"")
+			}
+		case "since":
+			if err := func() error {
+				s.Since.Reset()
+				if err := s.Since.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"since\"")
+			}
+		case "until":
+			if err := func() error {
+				s.Until.Reset()
+				if err := s.Until.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"until\"")
+			}
+		case "limit":
+			if err := func() error {
+				s.Limit.Reset()
+				if err := s.Limit.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"limit\"")
+			}
+		case "dry_run":
+			if err := func() error {
+				s.DryRun.Reset()
+				if err := s.DryRun.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"dry_run\"")
+			}
+		case "storage_uuid":
+			if err := func() error {
+				s.StorageUUID.Reset()
+				if err := s.StorageUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"storage_uuid\"")
+			}
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PipelineRun")
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes TelegramParticipantsItemMeta from json.
-func (o *OptTelegramParticipantsItemMeta) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptTelegramParticipantsItemMeta to nil")
-	}
-	o.Set = true
-	o.Value = make(TelegramParticipantsItemMeta)
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptTelegramParticipantsItemMeta) MarshalJSON() ([]byte, error) {
+func (s *PipelineRun) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptTelegramParticipantsItemMeta) UnmarshalJSON(data []byte) error {
+func (s *PipelineRun) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes TelegramSessionHistoryItemMeta as json.
-func (o OptTelegramSessionHistoryItemMeta) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *Policy) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes TelegramSessionHistoryItemMeta from json.
-func (o *OptTelegramSessionHistoryItemMeta) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptTelegramSessionHistoryItemMeta to nil")
+// encodeFields encodes fields.
+func (s *Policy) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Str(s.ID)
 	}
-	o.Set = true
-	o.Value = make(TelegramSessionHistoryItemMeta)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("subjects")
+		e.ArrStart()
+		for _, elem := range s.Subjects {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("resources")
+		e.ArrStart()
+		for _, elem := range s.Resources {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("actions")
+		e.ArrStart()
+		for _, elem := range s.Actions {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("effect")
+		s.Effect.Encode(e)
+	}
+	{
+		if s.Conditions.Set {
+			e.FieldStart("conditions")
+			s.Conditions.Encode(e)
+		}
+	}
+	{
+		if s.IsBuiltin.Set {
+			e.FieldStart("is_builtin")
+			s.IsBuiltin.Encode(e)
+		}
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptTelegramSessionHistoryItemMeta) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptTelegramSessionHistoryItemMeta) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfPolicy = [10]string{
+	0: "id",
+	1: "description",
+	2: "subjects",
+	3: "resources",
+	4: "actions",
+	5: "effect",
+	6: "conditions",
+	7: "is_builtin",
+	8: "created_at",
+	9: "updated_at",
 }
 
-// Encode encodes uuid.UUID as json.
-func (o OptUUID) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes Policy from json.
+func (s *Policy) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Policy to nil")
 	}
-	json.EncodeUUID(e, o.Value)
-}
+	var requiredBitSet [2]uint8
 
-// Decode decodes uuid.UUID from json.
-func (o *OptUUID) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptUUID to nil")
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.ID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		case "subjects":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.Subjects = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Subjects = append(s.Subjects, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"subjects\"")
+			}
+		case "resources":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.Resources = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Resources = append(s.Resources, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resources\"")
+			}
+		case "actions":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				s.Actions = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Actions = append(s.Actions, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"actions\"")
+			}
+		case "effect":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				if err := s.Effect.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"effect\"")
+			}
+		case "conditions":
+			if err := func() error {
+				s.Conditions.Reset()
+				if err := s.Conditions.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"conditions\"")
+			}
+		case "is_builtin":
+			if err := func() error {
+				s.IsBuiltin.Reset()
+				if err := s.IsBuiltin.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_builtin\"")
+			}
+		case "created_at":
+			if err := func() error {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "updated_at":
+			if err := func() error {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updated_at\"")
+			}
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Policy")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00111101,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPolicy) {
+					name = jsonFieldsNameOfPolicy[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	o.Set = true
-	v, err := json.DecodeUUID(d)
-	if err != nil {
-		return err
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
-	o.Value = v
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptUUID) MarshalJSON() ([]byte, error) {
+func (s *Policy) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptUUID) UnmarshalJSON(data []byte) error {
+func (s *Policy) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes UploadPresignedUrlRequestStorageType as json.
-func (o OptUploadPresignedUrlRequestStorageType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Str(string(o.Value))
-}
-
-// Decode decodes UploadPresignedUrlRequestStorageType from json.
-func (o *OptUploadPresignedUrlRequestStorageType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptUploadPresignedUrlRequestStorageType to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptUploadPresignedUrlRequestStorageType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
+// Encode implements json.Marshaler.
+func (s PolicyConditions) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptUploadPresignedUrlRequestStorageType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
+// encodeFields implements json.Marshaler.
+func (s PolicyConditions) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
 
-// Encode encodes UserMeta as json.
-func (o OptUserMeta) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
 	}
-	o.Value.Encode(e)
 }
 
-// Decode decodes UserMeta from json.
-func (o *OptUserMeta) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptUserMeta to nil")
+// Decode decodes PolicyConditions from json.
+func (s *PolicyConditions) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PolicyConditions to nil")
 	}
-	o.Set = true
-	o.Value = make(UserMeta)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PolicyConditions")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptUserMeta) MarshalJSON() ([]byte, error) {
+func (s PolicyConditions) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptUserMeta) UnmarshalJSON(data []byte) error {
+func (s *PolicyConditions) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes WorkerJobsData as json.
-func (o OptWorkerJobsData) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode encodes PolicyEffect as json.
+func (s PolicyEffect) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes WorkerJobsData from json.
-func (o *OptWorkerJobsData) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptWorkerJobsData to nil")
+// Decode decodes PolicyEffect from json.
+func (s *PolicyEffect) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PolicyEffect to nil")
 	}
-	o.Set = true
-	o.Value = make(WorkerJobsData)
-	if err := o.Value.Decode(d); err != nil {
+	v, err := d.StrBytes()
+	if err != nil {
 		return err
 	}
+	// Try to use constant string.
+	switch PolicyEffect(v) {
+	case PolicyEffectAllow:
+		*s = PolicyEffectAllow
+	case PolicyEffectDeny:
+		*s = PolicyEffectDeny
+	default:
+		*s = PolicyEffect(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptWorkerJobsData) MarshalJSON() ([]byte, error) {
+func (s PolicyEffect) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptWorkerJobsData) UnmarshalJSON(data []byte) error {
+func (s *PolicyEffect) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Pipeline) Encode(e *jx.Encoder) {
+func (s *RetentionPolicy) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Pipeline) encodeFields(e *jx.Encoder) {
+func (s *RetentionPolicy) encodeFields(e *jx.Encoder) {
 	{
 		if s.UUID.Set {
 			e.FieldStart("uuid")
@@ -9604,22 +14577,38 @@ func (s *Pipeline) encodeFields(e *jx.Encoder) {
 		}
 	}
 	{
-		e.FieldStart("datasource_uuid")
-		e.Str(s.DatasourceUUID)
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("storage_uuid")
-		e.Str(s.StorageUUID)
+		if s.DatasourceUUID.Set {
+			e.FieldStart("datasource_uuid")
+			s.DatasourceUUID.Encode(e)
+		}
 	}
 	{
-		if s.Type.Set {
-			e.FieldStart("type")
-			s.Type.Encode(e)
+		if s.PipelineUUID.Set {
+			e.FieldStart("pipeline_uuid")
+			s.PipelineUUID.Encode(e)
 		}
 	}
 	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+		if s.KeepDays.Set {
+			e.FieldStart("keep_days")
+			s.KeepDays.Encode(e)
+		}
+	}
+	{
+		if s.KeepLast.Set {
+			e.FieldStart("keep_last")
+			s.KeepLast.Encode(e)
+		}
+	}
+	{
+		if s.AttachmentsDays.Set {
+			e.FieldStart("attachments_days")
+			s.AttachmentsDays.Encode(e)
+		}
 	}
 	{
 		if s.IsEnabled.Set {
@@ -9628,9 +14617,9 @@ func (s *Pipeline) encodeFields(e *jx.Encoder) {
 		}
 	}
 	{
-		if s.Flow.Set {
-			e.FieldStart("flow")
-			s.Flow.Encode(e)
+		if s.LastRunAt.Set {
+			e.FieldStart("last_run_at")
+			s.LastRunAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
@@ -9647,22 +14636,24 @@ func (s *Pipeline) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfPipeline = [9]string{
-	0: "uuid",
-	1: "datasource_uuid",
-	2: "storage_uuid",
-	3: "type",
-	4: "name",
-	5: "is_enabled",
-	6: "flow",
-	7: "created_at",
-	8: "updated_at",
+var jsonFieldsNameOfRetentionPolicy = [11]string{
+	0:  "uuid",
+	1:  "name",
+	2:  "datasource_uuid",
+	3:  "pipeline_uuid",
+	4:  "keep_days",
+	5:  "keep_last",
+	6:  "attachments_days",
+	7:  "is_enabled",
+	8:  "last_run_at",
+	9:  "created_at",
+	10: "updated_at",
 }
 
-// Decode decodes Pipeline from json.
-func (s *Pipeline) Decode(d *jx.Decoder) error {
+// Decode decodes RetentionPolicy from json.
+func (s *RetentionPolicy) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Pipeline to nil")
+		return errors.New("invalid: unable to decode RetentionPolicy to nil")
 	}
 	var requiredBitSet [2]uint8
 
@@ -9678,51 +14669,67 @@ func (s *Pipeline) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "datasource_uuid":
+		case "name":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.DatasourceUUID = string(v)
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "datasource_uuid":
+			if err := func() error {
+				s.DatasourceUUID.Reset()
+				if err := s.DatasourceUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"datasource_uuid\"")
 			}
-		case "storage_uuid":
-			requiredBitSet[0] |= 1 << 2
+		case "pipeline_uuid":
 			if err := func() error {
-				v, err := d.Str()
-				s.StorageUUID = string(v)
-				if err != nil {
+				s.PipelineUUID.Reset()
+				if err := s.PipelineUUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"storage_uuid\"")
+				return errors.Wrap(err, "decode field \"pipeline_uuid\"")
 			}
-		case "type":
+		case "keep_days":
 			if err := func() error {
-				s.Type.Reset()
-				if err := s.Type.Decode(d); err != nil {
+				s.KeepDays.Reset()
+				if err := s.KeepDays.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"type\"")
+				return errors.Wrap(err, "decode field \"keep_days\"")
 			}
-		case "name":
-			requiredBitSet[0] |= 1 << 4
+		case "keep_last":
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
+				s.KeepLast.Reset()
+				if err := s.KeepLast.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"keep_last\"")
+			}
+		case "attachments_days":
+			if err := func() error {
+				s.AttachmentsDays.Reset()
+				if err := s.AttachmentsDays.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"attachments_days\"")
 			}
 		case "is_enabled":
 			if err := func() error {
@@ -9734,15 +14741,15 @@ func (s *Pipeline) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"is_enabled\"")
 			}
-		case "flow":
+		case "last_run_at":
 			if err := func() error {
-				s.Flow.Reset()
-				if err := s.Flow.Decode(d); err != nil {
+				s.LastRunAt.Reset()
+				if err := s.LastRunAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"flow\"")
+				return errors.Wrap(err, "decode field \"last_run_at\"")
 			}
 		case "created_at":
 			if err := func() error {
@@ -9769,12 +14776,12 @@ func (s *Pipeline) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Pipeline")
+		return errors.Wrap(err, "decode RetentionPolicy")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [2]uint8{
-		0b00010110,
+		0b00000010,
 		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
@@ -9787,8 +14794,8 @@ func (s *Pipeline) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPipeline) {
-					name = jsonFieldsNameOfPipeline[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfRetentionPolicy) {
+					name = jsonFieldsNameOfRetentionPolicy[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -9809,368 +14816,538 @@ func (s *Pipeline) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Pipeline) MarshalJSON() ([]byte, error) {
+func (s *RetentionPolicy) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Pipeline) UnmarshalJSON(data []byte) error {
+func (s *RetentionPolicy) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PipelineEdge) Encode(e *jx.Encoder) {
+func (s *Scheduler) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PipelineEdge) encodeFields(e *jx.Encoder) {
+func (s *Scheduler) encodeFields(e *jx.Encoder) {
+	{
+		if s.UUID.Set {
+			e.FieldStart("uuid")
+			s.UUID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("pipeline_uuid")
+		e.Str(s.PipelineUUID)
+	}
+	{
+		e.FieldStart("schedule_type")
+		e.Str(s.ScheduleType)
+	}
+	{
+		if s.CronExpression.Set {
+			e.FieldStart("cron_expression")
+			s.CronExpression.Encode(e)
+		}
+	}
+	{
+		if s.RunAt.Set {
+			e.FieldStart("run_at")
+			s.RunAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.IntervalSeconds.Set {
+			e.FieldStart("interval_seconds")
+			s.IntervalSeconds.Encode(e)
+		}
+	}
+	{
+		if s.JitterSeconds.Set {
+			e.FieldStart("jitter_seconds")
+			s.JitterSeconds.Encode(e)
+		}
+	}
+	{
+		if s.OverlapPolicy.Set {
+			e.FieldStart("overlap_policy")
+			s.OverlapPolicy.Encode(e)
+		}
+	}
+	{
+		if s.Timezone.Set {
+			e.FieldStart("timezone")
+			s.Timezone.Encode(e)
+		}
+	}
+	{
+		if s.NextRun.Set {
+			e.FieldStart("next_run")
+			s.NextRun.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.LastRun.Set {
+			e.FieldStart("last_run")
+			s.LastRun.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.IsEnabled.Set {
+			e.FieldStart("is_enabled")
+			s.IsEnabled.Encode(e)
+		}
+	}
 	{
-		e.FieldStart("id")
-		e.Str(s.ID)
+		if s.IsPaused.Set {
+			e.FieldStart("is_paused")
+			s.IsPaused.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("source")
-		e.Str(s.Source)
+		if s.PausedReason.Set {
+			e.FieldStart("paused_reason")
+			s.PausedReason.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("target")
-		e.Str(s.Target)
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		}
 	}
 	{
-		if s.Type.Set {
-			e.FieldStart("type")
-			s.Type.Encode(e)
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 }
 
-var jsonFieldsNameOfPipelineEdge = [4]string{
-	0: "id",
-	1: "source",
-	2: "target",
-	3: "type",
+var jsonFieldsNameOfScheduler = [16]string{
+	0:  "uuid",
+	1:  "pipeline_uuid",
+	2:  "schedule_type",
+	3:  "cron_expression",
+	4:  "run_at",
+	5:  "interval_seconds",
+	6:  "jitter_seconds",
+	7:  "overlap_policy",
+	8:  "timezone",
+	9:  "next_run",
+	10: "last_run",
+	11: "is_enabled",
+	12: "is_paused",
+	13: "paused_reason",
+	14: "created_at",
+	15: "updated_at",
 }
 
-// Decode decodes PipelineEdge from json.
-func (s *PipelineEdge) Decode(d *jx.Decoder) error {
+// Decode decodes Scheduler from json.
+func (s *Scheduler) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PipelineEdge to nil")
+		return errors.New("invalid: unable to decode Scheduler to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
+		case "uuid":
 			if err := func() error {
-				v, err := d.Str()
-				s.ID = string(v)
-				if err != nil {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "source":
+		case "pipeline_uuid":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.Source = string(v)
+				s.PipelineUUID = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"source\"")
+				return errors.Wrap(err, "decode field \"pipeline_uuid\"")
 			}
-		case "target":
+		case "schedule_type":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
 				v, err := d.Str()
-				s.Target = string(v)
+				s.ScheduleType = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"target\"")
+				return errors.Wrap(err, "decode field \"schedule_type\"")
 			}
-		case "type":
+		case "cron_expression":
 			if err := func() error {
-				s.Type.Reset()
-				if err := s.Type.Decode(d); err != nil {
+				s.CronExpression.Reset()
+				if err := s.CronExpression.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"type\"")
+				return errors.Wrap(err, "decode field \"cron_expression\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode PipelineEdge")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000111,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfPipelineEdge) {
-					name = jsonFieldsNameOfPipelineEdge[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
+		case "run_at":
+			if err := func() error {
+				s.RunAt.Reset()
+				if err := s.RunAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
 				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"run_at\"")
 			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *PipelineEdge) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PipelineEdge) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes PipelineEdgeType as json.
-func (s PipelineEdgeType) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes PipelineEdgeType from json.
-func (s *PipelineEdgeType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode PipelineEdgeType to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch PipelineEdgeType(v) {
-	case PipelineEdgeTypeDefault:
-		*s = PipelineEdgeTypeDefault
-	case PipelineEdgeTypeStep:
-		*s = PipelineEdgeTypeStep
-	default:
-		*s = PipelineEdgeType(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s PipelineEdgeType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PipelineEdgeType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *PipelineFlow) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *PipelineFlow) encodeFields(e *jx.Encoder) {
-	{
-		if s.Nodes != nil {
-			e.FieldStart("nodes")
-			e.ArrStart()
-			for _, elem := range s.Nodes {
-				elem.Encode(e)
+		case "interval_seconds":
+			if err := func() error {
+				s.IntervalSeconds.Reset()
+				if err := s.IntervalSeconds.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"interval_seconds\"")
+			}
+		case "jitter_seconds":
+			if err := func() error {
+				s.JitterSeconds.Reset()
+				if err := s.JitterSeconds.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"jitter_seconds\"")
+			}
+		case "overlap_policy":
+			if err := func() error {
+				s.OverlapPolicy.Reset()
+				if err := s.OverlapPolicy.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"overlap_policy\"")
+			}
+		case "timezone":
+			if err := func() error {
+				s.Timezone.Reset()
+				if err := s.Timezone.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"timezone\"")
+			}
+		case "next_run":
+			if err := func() error {
+				s.NextRun.Reset()
+				if err := s.NextRun.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"next_run\"")
+			}
+		case "last_run":
+			if err := func() error {
+				s.LastRun.Reset()
+				if err := s.LastRun.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_run\"")
+			}
+		case "is_enabled":
+			if err := func() error {
+				s.IsEnabled.Reset()
+				if err := s.IsEnabled.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_enabled\"")
+			}
+		case "is_paused":
+			if err := func() error {
+				s.IsPaused.Reset()
+				if err := s.IsPaused.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_paused\"")
 			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.Edges != nil {
-			e.FieldStart("edges")
-			e.ArrStart()
-			for _, elem := range s.Edges {
-				elem.Encode(e)
+		case "paused_reason":
+			if err := func() error {
+				s.PausedReason.Reset()
+				if err := s.PausedReason.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"paused_reason\"")
 			}
-			e.ArrEnd()
-		}
-	}
-}
-
-var jsonFieldsNameOfPipelineFlow = [2]string{
-	0: "nodes",
-	1: "edges",
-}
-
-// Decode decodes PipelineFlow from json.
-func (s *PipelineFlow) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode PipelineFlow to nil")
-	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "nodes":
+		case "created_at":
 			if err := func() error {
-				s.Nodes = make([]PipelineNode, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem PipelineNode
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Nodes = append(s.Nodes, elem)
-					return nil
-				}); err != nil {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"nodes\"")
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
-		case "edges":
+		case "updated_at":
 			if err := func() error {
-				s.Edges = make([]PipelineEdge, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem PipelineEdge
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Edges = append(s.Edges, elem)
-					return nil
-				}); err != nil {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"edges\"")
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PipelineFlow")
+		return errors.Wrap(err, "decode Scheduler")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00000110,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfScheduler) {
+					name = jsonFieldsNameOfScheduler[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PipelineFlow) MarshalJSON() ([]byte, error) {
+func (s *Scheduler) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PipelineFlow) UnmarshalJSON(data []byte) error {
+func (s *Scheduler) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PipelineListOK) Encode(e *jx.Encoder) {
+func (s *Session) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PipelineListOK) encodeFields(e *jx.Encoder) {
+func (s *Session) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("pipelines")
-		e.ArrStart()
-		for _, elem := range s.Pipelines {
-			elem.Encode(e)
+		e.FieldStart("uuid")
+		json.EncodeUUID(e, s.UUID)
+	}
+	{
+		e.FieldStart("source")
+		s.Source.Encode(e)
+	}
+	{
+		if s.UserAgent.Set {
+			e.FieldStart("user_agent")
+			s.UserAgent.Encode(e)
+		}
+	}
+	{
+		if s.IP.Set {
+			e.FieldStart("ip")
+			s.IP.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		e.FieldStart("last_seen_at")
+		json.EncodeDateTime(e, s.LastSeenAt)
+	}
+	{
+		e.FieldStart("expires_at")
+		json.EncodeDateTime(e, s.ExpiresAt)
+	}
+	{
+		if s.Current.Set {
+			e.FieldStart("current")
+			s.Current.Encode(e)
 		}
-		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfPipelineListOK = [1]string{
-	0: "pipelines",
+var jsonFieldsNameOfSession = [8]string{
+	0: "uuid",
+	1: "source",
+	2: "user_agent",
+	3: "ip",
+	4: "created_at",
+	5: "last_seen_at",
+	6: "expires_at",
+	7: "current",
 }
 
-// Decode decodes PipelineListOK from json.
-func (s *PipelineListOK) Decode(d *jx.Decoder) error {
+// Decode decodes Session from json.
+func (s *Session) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PipelineListOK to nil")
+		return errors.New("invalid: unable to decode Session to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "pipelines":
+		case "uuid":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Pipelines = make([]Pipeline, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Pipeline
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Pipelines = append(s.Pipelines, elem)
-					return nil
-				}); err != nil {
+				v, err := json.DecodeUUID(d)
+				s.UUID = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"pipelines\"")
+				return errors.Wrap(err, "decode field \"uuid\"")
+			}
+		case "source":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Source.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"source\"")
+			}
+		case "user_agent":
+			if err := func() error {
+				s.UserAgent.Reset()
+				if err := s.UserAgent.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"user_agent\"")
+			}
+		case "ip":
+			if err := func() error {
+				s.IP.Reset()
+				if err := s.IP.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ip\"")
+			}
+		case "created_at":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "last_seen_at":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.LastSeenAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_seen_at\"")
+			}
+		case "expires_at":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.ExpiresAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"expires_at\"")
+			}
+		case "current":
+			if err := func() error {
+				s.Current.Reset()
+				if err := s.Current.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"current\"")
 			}
 		default:
-			return d.Skip()
+			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PipelineListOK")
+		return errors.Wrap(err, "decode Session")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b01110011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -10182,8 +15359,8 @@ func (s *PipelineListOK) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPipelineListOK) {
-					name = jsonFieldsNameOfPipelineListOK[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfSession) {
+					name = jsonFieldsNameOfSession[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -10204,116 +15381,188 @@ func (s *PipelineListOK) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PipelineListOK) MarshalJSON() ([]byte, error) {
+func (s *Session) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PipelineListOK) UnmarshalJSON(data []byte) error {
+func (s *Session) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SessionSource as json.
+func (s SessionSource) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes SessionSource from json.
+func (s *SessionSource) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SessionSource to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch SessionSource(v) {
+	case SessionSourcePassword:
+		*s = SessionSourcePassword
+	case SessionSourceZitadel:
+		*s = SessionSourceZitadel
+	default:
+		*s = SessionSource(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s SessionSource) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SessionSource) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PipelineNode) Encode(e *jx.Encoder) {
+func (s *SessionStatus) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PipelineNode) encodeFields(e *jx.Encoder) {
+func (s *SessionStatus) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("active")
+		e.Bool(s.Active)
+	}
 	{
-		e.FieldStart("id")
-		e.Str(s.ID)
+		if s.UUID.Set {
+			e.FieldStart("uuid")
+			s.UUID.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("type")
-		e.Str(s.Type)
+		if s.Reason.Set {
+			e.FieldStart("reason")
+			s.Reason.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("position")
-		s.Position.Encode(e)
+		if s.SessionUUID.Set {
+			e.FieldStart("session_uuid")
+			s.SessionUUID.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("data")
-		s.Data.Encode(e)
+		if s.Sessions != nil {
+			e.FieldStart("sessions")
+			e.ArrStart()
+			for _, elem := range s.Sessions {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
 	}
 }
 
-var jsonFieldsNameOfPipelineNode = [4]string{
-	0: "id",
-	1: "type",
-	2: "position",
-	3: "data",
+var jsonFieldsNameOfSessionStatus = [5]string{
+	0: "active",
+	1: "uuid",
+	2: "reason",
+	3: "session_uuid",
+	4: "sessions",
 }
 
-// Decode decodes PipelineNode from json.
-func (s *PipelineNode) Decode(d *jx.Decoder) error {
+// Decode decodes SessionStatus from json.
+func (s *SessionStatus) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PipelineNode to nil")
+		return errors.New("invalid: unable to decode SessionStatus to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "active":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.ID = string(v)
+				v, err := d.Bool()
+				s.Active = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"active\"")
 			}
-		case "type":
-			requiredBitSet[0] |= 1 << 1
+		case "uuid":
 			if err := func() error {
-				v, err := d.Str()
-				s.Type = string(v)
-				if err != nil {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"type\"")
+				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "position":
-			requiredBitSet[0] |= 1 << 2
+		case "reason":
 			if err := func() error {
-				if err := s.Position.Decode(d); err != nil {
+				s.Reason.Reset()
+				if err := s.Reason.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"position\"")
+				return errors.Wrap(err, "decode field \"reason\"")
 			}
-		case "data":
-			requiredBitSet[0] |= 1 << 3
+		case "session_uuid":
 			if err := func() error {
-				if err := s.Data.Decode(d); err != nil {
+				s.SessionUUID.Reset()
+				if err := s.SessionUUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"data\"")
+				return errors.Wrap(err, "decode field \"session_uuid\"")
+			}
+		case "sessions":
+			if err := func() error {
+				s.Sessions = make([]Session, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Session
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Sessions = append(s.Sessions, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sessions\"")
 			}
 		default:
-			return d.Skip()
+			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PipelineNode")
+		return errors.Wrap(err, "decode SessionStatus")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00001111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -10325,8 +15574,8 @@ func (s *PipelineNode) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPipelineNode) {
-					name = jsonFieldsNameOfPipelineNode[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfSessionStatus) {
+					name = jsonFieldsNameOfSessionStatus[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -10347,262 +15596,355 @@ func (s *PipelineNode) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PipelineNode) MarshalJSON() ([]byte, error) {
+func (s *SessionStatus) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PipelineNode) UnmarshalJSON(data []byte) error {
+func (s *SessionStatus) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PipelineNodeData) Encode(e *jx.Encoder) {
+func (s *Storage) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PipelineNodeData) encodeFields(e *jx.Encoder) {
+func (s *Storage) encodeFields(e *jx.Encoder) {
 	{
-		if s.Label.Set {
-			e.FieldStart("label")
-			s.Label.Encode(e)
+		e.FieldStart("uuid")
+		e.Str(s.UUID)
+	}
+	{
+		e.FieldStart("type")
+		e.Str(s.Type)
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
 		}
 	}
 	{
-		if s.EntryUUID.Set {
-			e.FieldStart("entry_uuid")
-			s.EntryUUID.Encode(e)
+		e.FieldStart("is_enabled")
+		e.Bool(s.IsEnabled)
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.Config.Set {
-			e.FieldStart("config")
-			s.Config.Encode(e)
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 }
 
-var jsonFieldsNameOfPipelineNodeData = [3]string{
-	0: "label",
-	1: "entry_uuid",
-	2: "config",
+var jsonFieldsNameOfStorage = [6]string{
+	0: "uuid",
+	1: "type",
+	2: "name",
+	3: "is_enabled",
+	4: "created_at",
+	5: "updated_at",
 }
 
-// Decode decodes PipelineNodeData from json.
-func (s *PipelineNodeData) Decode(d *jx.Decoder) error {
+// Decode decodes Storage from json.
+func (s *Storage) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PipelineNodeData to nil")
+		return errors.New("invalid: unable to decode Storage to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "label":
+		case "uuid":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Label.Reset()
-				if err := s.Label.Decode(d); err != nil {
+				v, err := d.Str()
+				s.UUID = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"label\"")
+				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "entry_uuid":
+		case "type":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.EntryUUID.Reset()
-				if err := s.EntryUUID.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Type = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"entry_uuid\"")
+				return errors.Wrap(err, "decode field \"type\"")
 			}
-		case "config":
+		case "name":
 			if err := func() error {
-				s.Config.Reset()
-				if err := s.Config.Decode(d); err != nil {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"config\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode PipelineNodeData")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *PipelineNodeData) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PipelineNodeData) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s PipelineNodeDataConfig) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields implements json.Marshaler.
-func (s PipelineNodeDataConfig) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
-		}
-	}
-}
-
-// Decode decodes PipelineNodeDataConfig from json.
-func (s *PipelineNodeDataConfig) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode PipelineNodeDataConfig to nil")
-	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
+		case "is_enabled":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Bool()
+				s.IsEnabled = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_enabled\"")
+			}
+		case "created_at":
+			if err := func() error {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+		case "updated_at":
+			if err := func() error {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updated_at\"")
+			}
+		default:
+			return errors.Errorf("unexpected field %q", k)
 		}
-		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PipelineNodeDataConfig")
+		return errors.Wrap(err, "decode Storage")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStorage) {
+					name = jsonFieldsNameOfStorage[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s PipelineNodeDataConfig) MarshalJSON() ([]byte, error) {
+func (s *Storage) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PipelineNodeDataConfig) UnmarshalJSON(data []byte) error {
+func (s *Storage) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PipelineNodePosition) Encode(e *jx.Encoder) {
+func (s *StorageHostfiles) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PipelineNodePosition) encodeFields(e *jx.Encoder) {
+func (s *StorageHostfiles) encodeFields(e *jx.Encoder) {
 	{
-		if s.X.Set {
-			e.FieldStart("x")
-			s.X.Encode(e)
+		if s.UUID.Set {
+			e.FieldStart("uuid")
+			s.UUID.Encode(e)
 		}
 	}
 	{
-		if s.Y.Set {
-			e.FieldStart("y")
-			s.Y.Encode(e)
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.IsEnabled.Set {
+			e.FieldStart("is_enabled")
+			s.IsEnabled.Encode(e)
 		}
 	}
+	{
+		e.FieldStart("path")
+		e.Str(s.Path)
+	}
 }
 
-var jsonFieldsNameOfPipelineNodePosition = [2]string{
-	0: "x",
-	1: "y",
+var jsonFieldsNameOfStorageHostfiles = [4]string{
+	0: "uuid",
+	1: "name",
+	2: "is_enabled",
+	3: "path",
 }
 
-// Decode decodes PipelineNodePosition from json.
-func (s *PipelineNodePosition) Decode(d *jx.Decoder) error {
+// Decode decodes StorageHostfiles from json.
+func (s *StorageHostfiles) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PipelineNodePosition to nil")
+		return errors.New("invalid: unable to decode StorageHostfiles to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "x":
+		case "uuid":
 			if err := func() error {
-				s.X.Reset()
-				if err := s.X.Decode(d); err != nil {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"x\"")
+				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "y":
+		case "name":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Y.Reset()
-				if err := s.Y.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"y\"")
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "is_enabled":
+			if err := func() error {
+				s.IsEnabled.Reset()
+				if err := s.IsEnabled.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_enabled\"")
+			}
+		case "path":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.Path = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"path\"")
 			}
 		default:
-			return d.Skip()
+			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PipelineNodePosition")
+		return errors.Wrap(err, "decode StorageHostfiles")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001010,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStorageHostfiles) {
+					name = jsonFieldsNameOfStorageHostfiles[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PipelineNodePosition) MarshalJSON() ([]byte, error) {
+func (s *StorageHostfiles) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PipelineNodePosition) UnmarshalJSON(data []byte) error {
+func (s *StorageHostfiles) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Scheduler) Encode(e *jx.Encoder) {
+func (s *StorageMigration) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Scheduler) encodeFields(e *jx.Encoder) {
+func (s *StorageMigration) encodeFields(e *jx.Encoder) {
 	{
 		if s.UUID.Set {
 			e.FieldStart("uuid")
@@ -10610,53 +15952,67 @@ func (s *Scheduler) encodeFields(e *jx.Encoder) {
 		}
 	}
 	{
-		e.FieldStart("pipeline_uuid")
-		e.Str(s.PipelineUUID)
+		if s.SourceStorageUUID.Set {
+			e.FieldStart("source_storage_uuid")
+			s.SourceStorageUUID.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("schedule_type")
-		e.Str(s.ScheduleType)
+		e.FieldStart("target_storage_uuid")
+		e.Str(s.TargetStorageUUID)
 	}
 	{
-		if s.CronExpression.Set {
-			e.FieldStart("cron_expression")
-			s.CronExpression.Encode(e)
+		if s.Status.Set {
+			e.FieldStart("status")
+			s.Status.Encode(e)
 		}
 	}
 	{
-		if s.RunAt.Set {
-			e.FieldStart("run_at")
-			s.RunAt.Encode(e, json.EncodeDateTime)
+		if s.OlderThanDays.Set {
+			e.FieldStart("older_than_days")
+			s.OlderThanDays.Encode(e)
 		}
 	}
 	{
-		if s.Timezone.Set {
-			e.FieldStart("timezone")
-			s.Timezone.Encode(e)
+		if s.DeleteSource.Set {
+			e.FieldStart("delete_source")
+			s.DeleteSource.Encode(e)
 		}
 	}
 	{
-		if s.NextRun.Set {
-			e.FieldStart("next_run")
-			s.NextRun.Encode(e, json.EncodeDateTime)
+		if s.BatchSize.Set {
+			e.FieldStart("batch_size")
+			s.BatchSize.Encode(e)
 		}
 	}
 	{
-		if s.LastRun.Set {
-			e.FieldStart("last_run")
-			s.LastRun.Encode(e, json.EncodeDateTime)
+		if s.CheckpointUUID.Set {
+			e.FieldStart("checkpoint_uuid")
+			s.CheckpointUUID.Encode(e)
 		}
 	}
 	{
-		if s.IsEnabled.Set {
-			e.FieldStart("is_enabled")
-			s.IsEnabled.Encode(e)
+		if s.MessagesMigrated.Set {
+			e.FieldStart("messages_migrated")
+			s.MessagesMigrated.Encode(e)
 		}
 	}
 	{
-		if s.IsPaused.Set {
-			e.FieldStart("is_paused")
-			s.IsPaused.Encode(e)
+		if s.FilesMigrated.Set {
+			e.FieldStart("files_migrated")
+			s.FilesMigrated.Encode(e)
+		}
+	}
+	{
+		if s.BytesMigrated.Set {
+			e.FieldStart("bytes_migrated")
+			s.BytesMigrated.Encode(e)
+		}
+	}
+	{
+		if s.Error.Set {
+			e.FieldStart("error")
+			s.Error.Encode(e)
 		}
 	}
 	{
@@ -10671,27 +16027,36 @@ func (s *Scheduler) encodeFields(e *jx.Encoder) {
 			s.UpdatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
+	{
+		if s.FinishedAt.Set {
+			e.FieldStart("finished_at")
+			s.FinishedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
 }
 
-var jsonFieldsNameOfScheduler = [12]string{
+var jsonFieldsNameOfStorageMigration = [15]string{
 	0:  "uuid",
-	1:  "pipeline_uuid",
-	2:  "schedule_type",
-	3:  "cron_expression",
-	4:  "run_at",
-	5:  "timezone",
-	6:  "next_run",
-	7:  "last_run",
-	8:  "is_enabled",
-	9:  "is_paused",
-	10: "created_at",
-	11: "updated_at",
+	1:  "source_storage_uuid",
+	2:  "target_storage_uuid",
+	3:  "status",
+	4:  "older_than_days",
+	5:  "delete_source",
+	6:  "batch_size",
+	7:  "checkpoint_uuid",
+	8:  "messages_migrated",
+	9:  "files_migrated",
+	10: "bytes_migrated",
+	11: "error",
+	12: "created_at",
+	13: "updated_at",
+	14: "finished_at",
 }
 
-// Decode decodes Scheduler from json.
-func (s *Scheduler) Decode(d *jx.Decoder) error {
+// Decode decodes StorageMigration from json.
+func (s *StorageMigration) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Scheduler to nil")
+		return errors.New("invalid: unable to decode StorageMigration to nil")
 	}
 	var requiredBitSet [2]uint8
 
@@ -10707,99 +16072,117 @@ func (s *Scheduler) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "pipeline_uuid":
-			requiredBitSet[0] |= 1 << 1
+		case "source_storage_uuid":
 			if err := func() error {
-				v, err := d.Str()
-				s.PipelineUUID = string(v)
-				if err != nil {
+				s.SourceStorageUUID.Reset()
+				if err := s.SourceStorageUUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"pipeline_uuid\"")
+				return errors.Wrap(err, "decode field \"source_storage_uuid\"")
 			}
-		case "schedule_type":
+		case "target_storage_uuid":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
 				v, err := d.Str()
-				s.ScheduleType = string(v)
+				s.TargetStorageUUID = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"schedule_type\"")
+				return errors.Wrap(err, "decode field \"target_storage_uuid\"")
 			}
-		case "cron_expression":
+		case "status":
 			if err := func() error {
-				s.CronExpression.Reset()
-				if err := s.CronExpression.Decode(d); err != nil {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"cron_expression\"")
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "older_than_days":
+			if err := func() error {
+				s.OlderThanDays.Reset()
+				if err := s.OlderThanDays.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"older_than_days\"")
+			}
+		case "delete_source":
+			if err := func() error {
+				s.DeleteSource.Reset()
+				if err := s.DeleteSource.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"delete_source\"")
 			}
-		case "run_at":
+		case "batch_size":
 			if err := func() error {
-				s.RunAt.Reset()
-				if err := s.RunAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.BatchSize.Reset()
+				if err := s.BatchSize.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"run_at\"")
+				return errors.Wrap(err, "decode field \"batch_size\"")
 			}
-		case "timezone":
+		case "checkpoint_uuid":
 			if err := func() error {
-				s.Timezone.Reset()
-				if err := s.Timezone.Decode(d); err != nil {
+				s.CheckpointUUID.Reset()
+				if err := s.CheckpointUUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"timezone\"")
+				return errors.Wrap(err, "decode field \"checkpoint_uuid\"")
 			}
-		case "next_run":
+		case "messages_migrated":
 			if err := func() error {
-				s.NextRun.Reset()
-				if err := s.NextRun.Decode(d, json.DecodeDateTime); err != nil {
+				s.MessagesMigrated.Reset()
+				if err := s.MessagesMigrated.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"next_run\"")
+				return errors.Wrap(err, "decode field \"messages_migrated\"")
 			}
-		case "last_run":
+		case "files_migrated":
 			if err := func() error {
-				s.LastRun.Reset()
-				if err := s.LastRun.Decode(d, json.DecodeDateTime); err != nil {
+				s.FilesMigrated.Reset()
+				if err := s.FilesMigrated.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"last_run\"")
+				return errors.Wrap(err, "decode field \"files_migrated\"")
 			}
-		case "is_enabled":
+		case "bytes_migrated":
 			if err := func() error {
-				s.IsEnabled.Reset()
-				if err := s.IsEnabled.Decode(d); err != nil {
+				s.BytesMigrated.Reset()
+				if err := s.BytesMigrated.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_enabled\"")
+				return errors.Wrap(err, "decode field \"bytes_migrated\"")
 			}
-		case "is_paused":
+		case "error":
 			if err := func() error {
-				s.IsPaused.Reset()
-				if err := s.IsPaused.Decode(d); err != nil {
+				s.Error.Reset()
+				if err := s.Error.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_paused\"")
+				return errors.Wrap(err, "decode field \"error\"")
 			}
 		case "created_at":
 			if err := func() error {
@@ -10821,17 +16204,27 @@ func (s *Scheduler) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
+		case "finished_at":
+			if err := func() error {
+				s.FinishedAt.Reset()
+				if err := s.FinishedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"finished_at\"")
+			}
 		default:
-			return d.Skip()
+			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Scheduler")
+		return errors.Wrap(err, "decode StorageMigration")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [2]uint8{
-		0b00000110,
+		0b00000100,
 		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
@@ -10844,8 +16237,8 @@ func (s *Scheduler) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfScheduler) {
-					name = jsonFieldsNameOfScheduler[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfStorageMigration) {
+					name = jsonFieldsNameOfStorageMigration[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -10866,31 +16259,27 @@ func (s *Scheduler) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Scheduler) MarshalJSON() ([]byte, error) {
+func (s *StorageMigration) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Scheduler) UnmarshalJSON(data []byte) error {
+func (s *StorageMigration) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *SessionStatus) Encode(e *jx.Encoder) {
+func (s *StoragePostgres) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *SessionStatus) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("active")
-		e.Bool(s.Active)
-	}
+func (s *StoragePostgres) encodeFields(e *jx.Encoder) {
 	{
 		if s.UUID.Set {
 			e.FieldStart("uuid")
@@ -10898,252 +16287,178 @@ func (s *SessionStatus) encodeFields(e *jx.Encoder) {
 		}
 	}
 	{
-		if s.Reason.Set {
-			e.FieldStart("reason")
-			s.Reason.Encode(e)
-		}
-	}
-}
-
-var jsonFieldsNameOfSessionStatus = [3]string{
-	0: "active",
-	1: "uuid",
-	2: "reason",
-}
-
-// Decode decodes SessionStatus from json.
-func (s *SessionStatus) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode SessionStatus to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "active":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Bool()
-				s.Active = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"active\"")
-			}
-		case "uuid":
-			if err := func() error {
-				s.UUID.Reset()
-				if err := s.UUID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uuid\"")
-			}
-		case "reason":
-			if err := func() error {
-				s.Reason.Reset()
-				if err := s.Reason.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"reason\"")
-			}
-		default:
-			return errors.Errorf("unexpected field %q", k)
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode SessionStatus")
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfSessionStatus) {
-					name = jsonFieldsNameOfSessionStatus[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
+	{
+		if s.IsEnabled.Set {
+			e.FieldStart("is_enabled")
+			s.IsEnabled.Encode(e)
 		}
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *SessionStatus) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SessionStatus) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *Storage) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *Storage) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("uuid")
-		e.Str(s.UUID)
+		if s.IsSameDatabase.Set {
+			e.FieldStart("is_same_database")
+			s.IsSameDatabase.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("type")
-		e.Str(s.Type)
+		if s.User.Set {
+			e.FieldStart("user")
+			s.User.Encode(e)
+		}
 	}
 	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
+		if s.Password.Set {
+			e.FieldStart("password")
+			s.Password.Encode(e)
 		}
 	}
 	{
-		e.FieldStart("is_enabled")
-		e.Bool(s.IsEnabled)
+		if s.Host.Set {
+			e.FieldStart("host")
+			s.Host.Encode(e)
+		}
 	}
 	{
-		if s.CreatedAt.Set {
-			e.FieldStart("created_at")
-			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		if s.Port.Set {
+			e.FieldStart("port")
+			s.Port.Encode(e)
 		}
 	}
 	{
-		if s.UpdatedAt.Set {
-			e.FieldStart("updated_at")
-			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		if s.Options.Set {
+			e.FieldStart("options")
+			s.Options.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfStorage = [6]string{
+var jsonFieldsNameOfStoragePostgres = [9]string{
 	0: "uuid",
-	1: "type",
-	2: "name",
-	3: "is_enabled",
-	4: "created_at",
-	5: "updated_at",
+	1: "name",
+	2: "is_enabled",
+	3: "is_same_database",
+	4: "user",
+	5: "password",
+	6: "host",
+	7: "port",
+	8: "options",
 }
 
-// Decode decodes Storage from json.
-func (s *Storage) Decode(d *jx.Decoder) error {
+// Decode decodes StoragePostgres from json.
+func (s *StoragePostgres) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Storage to nil")
+		return errors.New("invalid: unable to decode StoragePostgres to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
 		case "uuid":
-			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.UUID = string(v)
-				if err != nil {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uuid\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "is_enabled":
+			if err := func() error {
+				s.IsEnabled.Reset()
+				if err := s.IsEnabled.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_enabled\"")
+			}
+		case "is_same_database":
+			if err := func() error {
+				s.IsSameDatabase.Reset()
+				if err := s.IsSameDatabase.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uuid\"")
+				return errors.Wrap(err, "decode field \"is_same_database\"")
 			}
-		case "type":
-			requiredBitSet[0] |= 1 << 1
+		case "user":
 			if err := func() error {
-				v, err := d.Str()
-				s.Type = string(v)
-				if err != nil {
+				s.User.Reset()
+				if err := s.User.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"type\"")
+				return errors.Wrap(err, "decode field \"user\"")
 			}
-		case "name":
+		case "password":
 			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
+				s.Password.Reset()
+				if err := s.Password.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"password\"")
 			}
-		case "is_enabled":
-			requiredBitSet[0] |= 1 << 3
+		case "host":
 			if err := func() error {
-				v, err := d.Bool()
-				s.IsEnabled = bool(v)
-				if err != nil {
+				s.Host.Reset()
+				if err := s.Host.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_enabled\"")
+				return errors.Wrap(err, "decode field \"host\"")
 			}
-		case "created_at":
+		case "port":
 			if err := func() error {
-				s.CreatedAt.Reset()
-				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.Port.Reset()
+				if err := s.Port.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
+				return errors.Wrap(err, "decode field \"port\"")
 			}
-		case "updated_at":
+		case "options":
 			if err := func() error {
-				s.UpdatedAt.Reset()
-				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.Options.Reset()
+				if err := s.Options.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"updated_at\"")
+				return errors.Wrap(err, "decode field \"options\"")
 			}
 		default:
 			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Storage")
+		return errors.Wrap(err, "decode StoragePostgres")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00001011,
+	for i, mask := range [2]uint8{
+		0b00000010,
+		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -11155,8 +16470,8 @@ func (s *Storage) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfStorage) {
-					name = jsonFieldsNameOfStorage[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfStoragePostgres) {
+					name = jsonFieldsNameOfStoragePostgres[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -11177,27 +16492,27 @@ func (s *Storage) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Storage) MarshalJSON() ([]byte, error) {
+func (s *StoragePostgres) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Storage) UnmarshalJSON(data []byte) error {
+func (s *StoragePostgres) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *StorageHostfiles) Encode(e *jx.Encoder) {
+func (s *StorageS3) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *StorageHostfiles) encodeFields(e *jx.Encoder) {
+func (s *StorageS3) encodeFields(e *jx.Encoder) {
 	{
 		if s.UUID.Set {
 			e.FieldStart("uuid")
@@ -11215,22 +16530,42 @@ func (s *StorageHostfiles) encodeFields(e *jx.Encoder) {
 		}
 	}
 	{
-		e.FieldStart("path")
-		e.Str(s.Path)
+		e.FieldStart("provider")
+		e.Str(s.Provider)
+	}
+	{
+		e.FieldStart("region")
+		e.Str(s.Region)
+	}
+	{
+		e.FieldStart("bucket")
+		e.Str(s.Bucket)
+	}
+	{
+		e.FieldStart("access_key_id")
+		e.Str(s.AccessKeyID)
+	}
+	{
+		e.FieldStart("secret_access_key")
+		e.Str(s.SecretAccessKey)
 	}
 }
 
-var jsonFieldsNameOfStorageHostfiles = [4]string{
+var jsonFieldsNameOfStorageS3 = [8]string{
 	0: "uuid",
 	1: "name",
 	2: "is_enabled",
-	3: "path",
+	3: "provider",
+	4: "region",
+	5: "bucket",
+	6: "access_key_id",
+	7: "secret_access_key",
 }
 
-// Decode decodes StorageHostfiles from json.
-func (s *StorageHostfiles) Decode(d *jx.Decoder) error {
+// Decode decodes StorageS3 from json.
+func (s *StorageS3) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StorageHostfiles to nil")
+		return errors.New("invalid: unable to decode StorageS3 to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -11268,29 +16603,77 @@ func (s *StorageHostfiles) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"is_enabled\"")
 			}
-		case "path":
+		case "provider":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
 				v, err := d.Str()
-				s.Path = string(v)
+				s.Provider = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"path\"")
+				return errors.Wrap(err, "decode field \"provider\"")
+			}
+		case "region":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.Region = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"region\"")
+			}
+		case "bucket":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Str()
+				s.Bucket = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bucket\"")
+			}
+		case "access_key_id":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Str()
+				s.AccessKeyID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"access_key_id\"")
+			}
+		case "secret_access_key":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Str()
+				s.SecretAccessKey = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"secret_access_key\"")
 			}
 		default:
 			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode StorageHostfiles")
+		return errors.Wrap(err, "decode StorageS3")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00001010,
+		0b11111010,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -11302,8 +16685,8 @@ func (s *StorageHostfiles) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfStorageHostfiles) {
-					name = jsonFieldsNameOfStorageHostfiles[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfStorageS3) {
+					name = jsonFieldsNameOfStorageS3[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -11324,33 +16707,43 @@ func (s *StorageHostfiles) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StorageHostfiles) MarshalJSON() ([]byte, error) {
+func (s *StorageS3) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StorageHostfiles) UnmarshalJSON(data []byte) error {
+func (s *StorageS3) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *StoragePostgres) Encode(e *jx.Encoder) {
+func (s *SyncPolicy) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *StoragePostgres) encodeFields(e *jx.Encoder) {
+func (s *SyncPolicy) encodeFields(e *jx.Encoder) {
 	{
 		if s.UUID.Set {
 			e.FieldStart("uuid")
 			s.UUID.Encode(e)
 		}
 	}
+	{
+		e.FieldStart("pipeline_uuid")
+		e.Str(s.PipelineUUID)
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
+	}
 	{
 		e.FieldStart("name")
 		e.Str(s.Name)
@@ -11362,59 +16755,69 @@ func (s *StoragePostgres) encodeFields(e *jx.Encoder) {
 		}
 	}
 	{
-		if s.IsSameDatabase.Set {
-			e.FieldStart("is_same_database")
-			s.IsSameDatabase.Encode(e)
+		if s.Blocklist != nil {
+			e.FieldStart("blocklist")
+			e.ArrStart()
+			for _, elem := range s.Blocklist {
+				e.Str(elem)
+			}
+			e.ArrEnd()
 		}
 	}
 	{
-		if s.User.Set {
-			e.FieldStart("user")
-			s.User.Encode(e)
+		if s.ExcludeList != nil {
+			e.FieldStart("exclude_list")
+			e.ArrStart()
+			for _, elem := range s.ExcludeList {
+				e.Str(elem)
+			}
+			e.ArrEnd()
 		}
 	}
 	{
-		if s.Password.Set {
-			e.FieldStart("password")
-			s.Password.Encode(e)
+		if s.SyncAll.Set {
+			e.FieldStart("sync_all")
+			s.SyncAll.Encode(e)
 		}
 	}
 	{
-		if s.Host.Set {
-			e.FieldStart("host")
-			s.Host.Encode(e)
+		if s.Settings.Set {
+			e.FieldStart("settings")
+			s.Settings.Encode(e)
 		}
 	}
 	{
-		if s.Port.Set {
-			e.FieldStart("port")
-			s.Port.Encode(e)
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.Options.Set {
-			e.FieldStart("options")
-			s.Options.Encode(e)
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 }
 
-var jsonFieldsNameOfStoragePostgres = [9]string{
-	0: "uuid",
-	1: "name",
-	2: "is_enabled",
-	3: "is_same_database",
-	4: "user",
-	5: "password",
-	6: "host",
-	7: "port",
-	8: "options",
+var jsonFieldsNameOfSyncPolicy = [11]string{
+	0:  "uuid",
+	1:  "pipeline_uuid",
+	2:  "type",
+	3:  "name",
+	4:  "is_enabled",
+	5:  "blocklist",
+	6:  "exclude_list",
+	7:  "sync_all",
+	8:  "settings",
+	9:  "created_at",
+	10: "updated_at",
 }
 
-// Decode decodes StoragePostgres from json.
-func (s *StoragePostgres) Decode(d *jx.Decoder) error {
+// Decode decodes SyncPolicy from json.
+func (s *SyncPolicy) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StoragePostgres to nil")
+		return errors.New("invalid: unable to decode SyncPolicy to nil")
 	}
 	var requiredBitSet [2]uint8
 
@@ -11430,8 +16833,30 @@ func (s *StoragePostgres) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "name":
+		case "pipeline_uuid":
 			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.PipelineUUID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pipeline_uuid\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
 				v, err := d.Str()
 				s.Name = string(v)
@@ -11452,77 +16877,95 @@ func (s *StoragePostgres) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"is_enabled\"")
 			}
-		case "is_same_database":
+		case "blocklist":
 			if err := func() error {
-				s.IsSameDatabase.Reset()
-				if err := s.IsSameDatabase.Decode(d); err != nil {
+				s.Blocklist = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Blocklist = append(s.Blocklist, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_same_database\"")
+				return errors.Wrap(err, "decode field \"blocklist\"")
 			}
-		case "user":
+		case "exclude_list":
 			if err := func() error {
-				s.User.Reset()
-				if err := s.User.Decode(d); err != nil {
+				s.ExcludeList = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.ExcludeList = append(s.ExcludeList, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"user\"")
+				return errors.Wrap(err, "decode field \"exclude_list\"")
 			}
-		case "password":
+		case "sync_all":
 			if err := func() error {
-				s.Password.Reset()
-				if err := s.Password.Decode(d); err != nil {
+				s.SyncAll.Reset()
+				if err := s.SyncAll.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password\"")
+				return errors.Wrap(err, "decode field \"sync_all\"")
 			}
-		case "host":
+		case "settings":
 			if err := func() error {
-				s.Host.Reset()
-				if err := s.Host.Decode(d); err != nil {
+				s.Settings.Reset()
+				if err := s.Settings.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"host\"")
+				return errors.Wrap(err, "decode field \"settings\"")
 			}
-		case "port":
+		case "created_at":
 			if err := func() error {
-				s.Port.Reset()
-				if err := s.Port.Decode(d); err != nil {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"port\"")
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
-		case "options":
+		case "updated_at":
 			if err := func() error {
-				s.Options.Reset()
-				if err := s.Options.Decode(d); err != nil {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"options\"")
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
 		default:
 			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode StoragePostgres")
+		return errors.Wrap(err, "decode SyncPolicy")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [2]uint8{
-		0b00000010,
+		0b00001010,
 		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
@@ -11535,8 +16978,8 @@ func (s *StoragePostgres) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfStoragePostgres) {
-					name = jsonFieldsNameOfStoragePostgres[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfSyncPolicy) {
+					name = jsonFieldsNameOfSyncPolicy[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -11557,188 +17000,137 @@ func (s *StoragePostgres) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StoragePostgres) MarshalJSON() ([]byte, error) {
+func (s *SyncPolicy) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StoragePostgres) UnmarshalJSON(data []byte) error {
+func (s *SyncPolicy) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *StorageS3) Encode(e *jx.Encoder) {
+func (s SyncPolicySettings) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields encodes fields.
-func (s *StorageS3) encodeFields(e *jx.Encoder) {
-	{
-		if s.UUID.Set {
-			e.FieldStart("uuid")
-			s.UUID.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
-	{
-		if s.IsEnabled.Set {
-			e.FieldStart("is_enabled")
-			s.IsEnabled.Encode(e)
+// encodeFields implements json.Marshaler.
+func (s SyncPolicySettings) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
 		}
 	}
-	{
-		e.FieldStart("provider")
-		e.Str(s.Provider)
-	}
-	{
-		e.FieldStart("region")
-		e.Str(s.Region)
-	}
-	{
-		e.FieldStart("bucket")
-		e.Str(s.Bucket)
-	}
-	{
-		e.FieldStart("access_key_id")
-		e.Str(s.AccessKeyID)
-	}
-	{
-		e.FieldStart("secret_access_key")
-		e.Str(s.SecretAccessKey)
-	}
-}
-
-var jsonFieldsNameOfStorageS3 = [8]string{
-	0: "uuid",
-	1: "name",
-	2: "is_enabled",
-	3: "provider",
-	4: "region",
-	5: "bucket",
-	6: "access_key_id",
-	7: "secret_access_key",
 }
 
-// Decode decodes StorageS3 from json.
-func (s *StorageS3) Decode(d *jx.Decoder) error {
+// Decode decodes SyncPolicySettings from json.
+func (s *SyncPolicySettings) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StorageS3 to nil")
+		return errors.New("invalid: unable to decode SyncPolicySettings to nil")
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "uuid":
-			if err := func() error {
-				s.UUID.Reset()
-				if err := s.UUID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uuid\"")
-			}
-		case "name":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "is_enabled":
-			if err := func() error {
-				s.IsEnabled.Reset()
-				if err := s.IsEnabled.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_enabled\"")
-			}
-		case "provider":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Str()
-				s.Provider = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provider\"")
-			}
-		case "region":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Str()
-				s.Region = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"region\"")
-			}
-		case "bucket":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := d.Str()
-				s.Bucket = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bucket\"")
-			}
-		case "access_key_id":
-			requiredBitSet[0] |= 1 << 6
-			if err := func() error {
-				v, err := d.Str()
-				s.AccessKeyID = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"access_key_id\"")
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
 			}
-		case "secret_access_key":
-			requiredBitSet[0] |= 1 << 7
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SyncPolicySettings")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s SyncPolicySettings) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SyncPolicySettings) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SyncpolicyListOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SyncpolicyListOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("policies")
+		e.ArrStart()
+		for _, elem := range s.Policies {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfSyncpolicyListOK = [1]string{
+	0: "policies",
+}
+
+// Decode decodes SyncpolicyListOK from json.
+func (s *SyncpolicyListOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SyncpolicyListOK to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "policies":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.SecretAccessKey = string(v)
-				if err != nil {
+				s.Policies = make([]SyncPolicy, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem SyncPolicy
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Policies = append(s.Policies, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"secret_access_key\"")
+				return errors.Wrap(err, "decode field \"policies\"")
 			}
 		default:
-			return errors.Errorf("unexpected field %q", k)
+			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode StorageS3")
+		return errors.Wrap(err, "decode SyncpolicyListOK")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b11111010,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -11750,8 +17142,8 @@ func (s *StorageS3) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfStorageS3) {
-					name = jsonFieldsNameOfStorageS3[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfSyncpolicyListOK) {
+					name = jsonFieldsNameOfSyncpolicyListOK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -11772,266 +17164,152 @@ func (s *StorageS3) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StorageS3) MarshalJSON() ([]byte, error) {
+func (s *SyncpolicyListOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StorageS3) UnmarshalJSON(data []byte) error {
+func (s *SyncpolicyListOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *SyncPolicy) Encode(e *jx.Encoder) {
+func (s *Telegram) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *SyncPolicy) encodeFields(e *jx.Encoder) {
-	{
-		if s.UUID.Set {
-			e.FieldStart("uuid")
-			s.UUID.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("pipeline_uuid")
-		e.Str(s.PipelineUUID)
-	}
-	{
-		if s.Type.Set {
-			e.FieldStart("type")
-			s.Type.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
-	{
-		if s.IsEnabled.Set {
-			e.FieldStart("is_enabled")
-			s.IsEnabled.Encode(e)
-		}
-	}
+func (s *Telegram) encodeFields(e *jx.Encoder) {
 	{
-		if s.Blocklist != nil {
-			e.FieldStart("blocklist")
-			e.ArrStart()
-			for _, elem := range s.Blocklist {
-				e.Str(elem)
-			}
-			e.ArrEnd()
-		}
+		e.FieldStart("id")
+		e.Int(s.ID)
 	}
 	{
-		if s.ExcludeList != nil {
-			e.FieldStart("exclude_list")
-			e.ArrStart()
-			for _, elem := range s.ExcludeList {
-				e.Str(elem)
-			}
-			e.ArrEnd()
-		}
+		e.FieldStart("phone")
+		e.Str(s.Phone)
 	}
 	{
-		if s.SyncAll.Set {
-			e.FieldStart("sync_all")
-			s.SyncAll.Encode(e)
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
 		}
 	}
 	{
-		if s.Settings.Set {
-			e.FieldStart("settings")
-			s.Settings.Encode(e)
-		}
+		e.FieldStart("updated_at")
+		json.EncodeDateTime(e, s.UpdatedAt)
 	}
 	{
-		if s.CreatedAt.Set {
-			e.FieldStart("created_at")
-			s.CreatedAt.Encode(e, json.EncodeDateTime)
-		}
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
 	}
 	{
-		if s.UpdatedAt.Set {
-			e.FieldStart("updated_at")
-			s.UpdatedAt.Encode(e, json.EncodeDateTime)
-		}
+		e.FieldStart("user")
+		s.User.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfSyncPolicy = [11]string{
-	0:  "uuid",
-	1:  "pipeline_uuid",
-	2:  "type",
-	3:  "name",
-	4:  "is_enabled",
-	5:  "blocklist",
-	6:  "exclude_list",
-	7:  "sync_all",
-	8:  "settings",
-	9:  "created_at",
-	10: "updated_at",
-}
-
-// Decode decodes SyncPolicy from json.
-func (s *SyncPolicy) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode SyncPolicy to nil")
-	}
-	var requiredBitSet [2]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "uuid":
-			if err := func() error {
-				s.UUID.Reset()
-				if err := s.UUID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uuid\"")
-			}
-		case "pipeline_uuid":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.PipelineUUID = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"pipeline_uuid\"")
-			}
-		case "type":
-			if err := func() error {
-				s.Type.Reset()
-				if err := s.Type.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"type\"")
-			}
-		case "name":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "is_enabled":
-			if err := func() error {
-				s.IsEnabled.Reset()
-				if err := s.IsEnabled.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_enabled\"")
-			}
-		case "blocklist":
+var jsonFieldsNameOfTelegram = [6]string{
+	0: "id",
+	1: "phone",
+	2: "description",
+	3: "updated_at",
+	4: "created_at",
+	5: "user",
+}
+
+// Decode decodes Telegram from json.
+func (s *Telegram) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Telegram to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Blocklist = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.Blocklist = append(s.Blocklist, elem)
-					return nil
-				}); err != nil {
+				v, err := d.Int()
+				s.ID = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"blocklist\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "exclude_list":
+		case "phone":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.ExcludeList = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.ExcludeList = append(s.ExcludeList, elem)
-					return nil
-				}); err != nil {
+				v, err := d.Str()
+				s.Phone = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"exclude_list\"")
+				return errors.Wrap(err, "decode field \"phone\"")
 			}
-		case "sync_all":
+		case "description":
 			if err := func() error {
-				s.SyncAll.Reset()
-				if err := s.SyncAll.Decode(d); err != nil {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"sync_all\"")
+				return errors.Wrap(err, "decode field \"description\"")
 			}
-		case "settings":
+		case "updated_at":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Settings.Reset()
-				if err := s.Settings.Decode(d); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.UpdatedAt = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"settings\"")
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
 		case "created_at":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.CreatedAt.Reset()
-				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"created_at\"")
 			}
-		case "updated_at":
+		case "user":
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				s.UpdatedAt.Reset()
-				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+				if err := s.User.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"updated_at\"")
+				return errors.Wrap(err, "decode field \"user\"")
 			}
 		default:
 			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode SyncPolicy")
+		return errors.Wrap(err, "decode Telegram")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b00001010,
-		0b00000000,
+	for i, mask := range [1]uint8{
+		0b00111011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -12043,8 +17321,8 @@ func (s *SyncPolicy) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfSyncPolicy) {
-					name = jsonFieldsNameOfSyncPolicy[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTelegram) {
+					name = jsonFieldsNameOfTelegram[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -12065,27 +17343,162 @@ func (s *SyncPolicy) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SyncPolicy) MarshalJSON() ([]byte, error) {
+func (s *Telegram) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SyncPolicy) UnmarshalJSON(data []byte) error {
+func (s *Telegram) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TelegramParticipants as json.
+func (s TelegramParticipants) Encode(e *jx.Encoder) {
+	unwrapped := []TelegramParticipantsItem(s)
+	if unwrapped == nil {
+		e.ArrEmpty()
+		return
+	}
+	if unwrapped != nil {
+		e.ArrStart()
+		for _, elem := range unwrapped {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+// Decode decodes TelegramParticipants from json.
+func (s *TelegramParticipants) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TelegramParticipants to nil")
+	}
+	var unwrapped []TelegramParticipantsItem
+	if err := func() error {
+		unwrapped = make([]TelegramParticipantsItem, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem TelegramParticipantsItem
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = TelegramParticipants(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s TelegramParticipants) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TelegramParticipants) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s SyncPolicySettings) Encode(e *jx.Encoder) {
+func (s *TelegramParticipantsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TelegramParticipantsItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.ParticipantId.Set {
+			e.FieldStart("participantId")
+			s.ParticipantId.Encode(e)
+		}
+	}
+	{
+		if s.Meta.Set {
+			e.FieldStart("meta")
+			s.Meta.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfTelegramParticipantsItem = [2]string{
+	0: "participantId",
+	1: "meta",
+}
+
+// Decode decodes TelegramParticipantsItem from json.
+func (s *TelegramParticipantsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TelegramParticipantsItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "participantId":
+			if err := func() error {
+				s.ParticipantId.Reset()
+				if err := s.ParticipantId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"participantId\"")
+			}
+		case "meta":
+			if err := func() error {
+				s.Meta.Reset()
+				if err := s.Meta.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"meta\"")
+			}
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TelegramParticipantsItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *TelegramParticipantsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TelegramParticipantsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s TelegramParticipantsItemMeta) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields implements json.Marshaler.
-func (s SyncPolicySettings) encodeFields(e *jx.Encoder) {
+func (s TelegramParticipantsItemMeta) encodeFields(e *jx.Encoder) {
 	for k, elem := range s {
 		e.FieldStart(k)
 
@@ -12095,10 +17508,10 @@ func (s SyncPolicySettings) encodeFields(e *jx.Encoder) {
 	}
 }
 
-// Decode decodes SyncPolicySettings from json.
-func (s *SyncPolicySettings) Decode(d *jx.Decoder) error {
+// Decode decodes TelegramParticipantsItemMeta from json.
+func (s *TelegramParticipantsItemMeta) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SyncPolicySettings to nil")
+		return errors.New("invalid: unable to decode TelegramParticipantsItemMeta to nil")
 	}
 	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
@@ -12116,1230 +17529,1284 @@ func (s *SyncPolicySettings) Decode(d *jx.Decoder) error {
 		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode SyncPolicySettings")
+		return errors.Wrap(err, "decode TelegramParticipantsItemMeta")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s SyncPolicySettings) MarshalJSON() ([]byte, error) {
+func (s TelegramParticipantsItemMeta) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SyncPolicySettings) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *SyncpolicyListOK) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TelegramParticipantsItemMeta) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
 }
 
-// encodeFields encodes fields.
-func (s *SyncpolicyListOK) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("policies")
+// Encode encodes TelegramSessionHistory as json.
+func (s TelegramSessionHistory) Encode(e *jx.Encoder) {
+	unwrapped := []TelegramSessionHistoryItem(s)
+	if unwrapped == nil {
+		e.ArrEmpty()
+		return
+	}
+	if unwrapped != nil {
 		e.ArrStart()
-		for _, elem := range s.Policies {
+		for _, elem := range unwrapped {
 			elem.Encode(e)
 		}
 		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfSyncpolicyListOK = [1]string{
-	0: "policies",
-}
-
-// Decode decodes SyncpolicyListOK from json.
-func (s *SyncpolicyListOK) Decode(d *jx.Decoder) error {
+// Decode decodes TelegramSessionHistory from json.
+func (s *TelegramSessionHistory) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SyncpolicyListOK to nil")
+		return errors.New("invalid: unable to decode TelegramSessionHistory to nil")
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "policies":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				s.Policies = make([]SyncPolicy, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem SyncPolicy
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Policies = append(s.Policies, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"policies\"")
+	var unwrapped []TelegramSessionHistoryItem
+	if err := func() error {
+		unwrapped = make([]TelegramSessionHistoryItem, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem TelegramSessionHistoryItem
+			if err := elem.Decode(d); err != nil {
+				return err
 			}
-		default:
-			return d.Skip()
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode SyncpolicyListOK")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfSyncpolicyListOK) {
-					name = jsonFieldsNameOfSyncpolicyListOK[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = TelegramSessionHistory(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SyncpolicyListOK) MarshalJSON() ([]byte, error) {
+func (s TelegramSessionHistory) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SyncpolicyListOK) UnmarshalJSON(data []byte) error {
+func (s *TelegramSessionHistory) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Telegram) Encode(e *jx.Encoder) {
+func (s *TelegramSessionHistoryItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Telegram) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int(s.ID)
-	}
-	{
-		e.FieldStart("phone")
-		e.Str(s.Phone)
-	}
+func (s *TelegramSessionHistoryItem) encodeFields(e *jx.Encoder) {
 	{
-		if s.Description.Set {
-			e.FieldStart("description")
-			s.Description.Encode(e)
+		if s.SessionId.Set {
+			e.FieldStart("sessionId")
+			s.SessionId.Encode(e)
 		}
 	}
 	{
-		e.FieldStart("updated_at")
-		json.EncodeDateTime(e, s.UpdatedAt)
+		if s.StartedAt.Set {
+			e.FieldStart("started_at")
+			s.StartedAt.Encode(e, json.EncodeDateTime)
+		}
 	}
 	{
-		e.FieldStart("created_at")
-		json.EncodeDateTime(e, s.CreatedAt)
+		if s.EndedAt.Set {
+			e.FieldStart("ended_at")
+			s.EndedAt.Encode(e, json.EncodeDateTime)
+		}
 	}
 	{
-		e.FieldStart("user")
-		s.User.Encode(e)
+		if s.Meta.Set {
+			e.FieldStart("meta")
+			s.Meta.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfTelegram = [6]string{
-	0: "id",
-	1: "phone",
-	2: "description",
-	3: "updated_at",
-	4: "created_at",
-	5: "user",
+var jsonFieldsNameOfTelegramSessionHistoryItem = [4]string{
+	0: "sessionId",
+	1: "started_at",
+	2: "ended_at",
+	3: "meta",
 }
 
-// Decode decodes Telegram from json.
-func (s *Telegram) Decode(d *jx.Decoder) error {
+// Decode decodes TelegramSessionHistoryItem from json.
+func (s *TelegramSessionHistoryItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Telegram to nil")
+		return errors.New("invalid: unable to decode TelegramSessionHistoryItem to nil")
 	}
-	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Int()
-				s.ID = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "phone":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.Phone = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone\"")
-			}
-		case "description":
-			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description\"")
-			}
-		case "updated_at":
-			requiredBitSet[0] |= 1 << 3
+		case "sessionId":
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.UpdatedAt = v
-				if err != nil {
+				s.SessionId.Reset()
+				if err := s.SessionId.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"updated_at\"")
+				return errors.Wrap(err, "decode field \"sessionId\"")
 			}
-		case "created_at":
-			requiredBitSet[0] |= 1 << 4
+		case "started_at":
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
-				if err != nil {
+				s.StartedAt.Reset()
+				if err := s.StartedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
+				return errors.Wrap(err, "decode field \"started_at\"")
 			}
-		case "user":
-			requiredBitSet[0] |= 1 << 5
+		case "ended_at":
 			if err := func() error {
-				if err := s.User.Decode(d); err != nil {
+				s.EndedAt.Reset()
+				if err := s.EndedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"user\"")
+				return errors.Wrap(err, "decode field \"ended_at\"")
 			}
-		default:
-			return errors.Errorf("unexpected field %q", k)
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode Telegram")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00111011,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfTelegram) {
-					name = jsonFieldsNameOfTelegram[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+		case "meta":
+			if err := func() error {
+				s.Meta.Reset()
+				if err := s.Meta.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"meta\"")
 			}
+		default:
+			return errors.Errorf("unexpected field %q", k)
 		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TelegramSessionHistoryItem")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Telegram) MarshalJSON() ([]byte, error) {
+func (s *TelegramSessionHistoryItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Telegram) UnmarshalJSON(data []byte) error {
+func (s *TelegramSessionHistoryItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes TelegramParticipants as json.
-func (s TelegramParticipants) Encode(e *jx.Encoder) {
-	unwrapped := []TelegramParticipantsItem(s)
-	if unwrapped == nil {
-		e.ArrEmpty()
-		return
-	}
-	if unwrapped != nil {
-		e.ArrStart()
-		for _, elem := range unwrapped {
-			elem.Encode(e)
+// Encode implements json.Marshaler.
+func (s TelegramSessionHistoryItemMeta) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s TelegramSessionHistoryItemMeta) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
 		}
-		e.ArrEnd()
 	}
 }
 
-// Decode decodes TelegramParticipants from json.
-func (s *TelegramParticipants) Decode(d *jx.Decoder) error {
+// Decode decodes TelegramSessionHistoryItemMeta from json.
+func (s *TelegramSessionHistoryItemMeta) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TelegramParticipants to nil")
+		return errors.New("invalid: unable to decode TelegramSessionHistoryItemMeta to nil")
 	}
-	var unwrapped []TelegramParticipantsItem
-	if err := func() error {
-		unwrapped = make([]TelegramParticipantsItem, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem TelegramParticipantsItem
-			if err := elem.Decode(d); err != nil {
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
 				return err
 			}
-			unwrapped = append(unwrapped, elem)
 			return nil
-		}); err != nil {
-			return err
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode TelegramSessionHistoryItemMeta")
 	}
-	*s = TelegramParticipants(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s TelegramParticipants) MarshalJSON() ([]byte, error) {
+func (s TelegramSessionHistoryItemMeta) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TelegramParticipants) UnmarshalJSON(data []byte) error {
+func (s *TelegramSessionHistoryItemMeta) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TelegramParticipantsItem) Encode(e *jx.Encoder) {
+func (s *TelegramUser) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TelegramParticipantsItem) encodeFields(e *jx.Encoder) {
+func (s *TelegramUser) encodeFields(e *jx.Encoder) {
 	{
-		if s.ParticipantId.Set {
-			e.FieldStart("participantId")
-			s.ParticipantId.Encode(e)
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
 		}
 	}
 	{
-		if s.Meta.Set {
-			e.FieldStart("meta")
-			s.Meta.Encode(e)
+		if s.Username.Set {
+			e.FieldStart("username")
+			s.Username.Encode(e)
+		}
+	}
+	{
+		if s.FirstName.Set {
+			e.FieldStart("first_name")
+			s.FirstName.Encode(e)
+		}
+	}
+	{
+		if s.LastName.Set {
+			e.FieldStart("last_name")
+			s.LastName.Encode(e)
+		}
+	}
+	{
+		if s.Phone.Set {
+			e.FieldStart("phone")
+			s.Phone.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfTelegramParticipantsItem = [2]string{
-	0: "participantId",
-	1: "meta",
+var jsonFieldsNameOfTelegramUser = [5]string{
+	0: "id",
+	1: "username",
+	2: "first_name",
+	3: "last_name",
+	4: "phone",
 }
 
-// Decode decodes TelegramParticipantsItem from json.
-func (s *TelegramParticipantsItem) Decode(d *jx.Decoder) error {
+// Decode decodes TelegramUser from json.
+func (s *TelegramUser) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TelegramParticipantsItem to nil")
+		return errors.New("invalid: unable to decode TelegramUser to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "participantId":
+		case "id":
 			if err := func() error {
-				s.ParticipantId.Reset()
-				if err := s.ParticipantId.Decode(d); err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"participantId\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "meta":
+		case "username":
 			if err := func() error {
-				s.Meta.Reset()
-				if err := s.Meta.Decode(d); err != nil {
+				s.Username.Reset()
+				if err := s.Username.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"meta\"")
+				return errors.Wrap(err, "decode field \"username\"")
+			}
+		case "first_name":
+			if err := func() error {
+				s.FirstName.Reset()
+				if err := s.FirstName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"first_name\"")
+			}
+		case "last_name":
+			if err := func() error {
+				s.LastName.Reset()
+				if err := s.LastName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_name\"")
+			}
+		case "phone":
+			if err := func() error {
+				s.Phone.Reset()
+				if err := s.Phone.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"phone\"")
 			}
 		default:
-			return errors.Errorf("unexpected field %q", k)
+			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TelegramParticipantsItem")
+		return errors.Wrap(err, "decode TelegramUser")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TelegramParticipantsItem) MarshalJSON() ([]byte, error) {
+func (s *TelegramUser) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TelegramParticipantsItem) UnmarshalJSON(data []byte) error {
+func (s *TelegramUser) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s TelegramParticipantsItemMeta) Encode(e *jx.Encoder) {
+func (s *TgSessionCreateReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s TelegramParticipantsItemMeta) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
-		}
+// encodeFields encodes fields.
+func (s *TgSessionCreateReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("phone")
+		e.Str(s.Phone)
 	}
 }
 
-// Decode decodes TelegramParticipantsItemMeta from json.
-func (s *TelegramParticipantsItemMeta) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfTgSessionCreateReq = [1]string{
+	0: "phone",
+}
+
+// Decode decodes TgSessionCreateReq from json.
+func (s *TgSessionCreateReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TelegramParticipantsItemMeta to nil")
+		return errors.New("invalid: unable to decode TgSessionCreateReq to nil")
 	}
-	m := s.init()
+	var requiredBitSet [1]uint8
+
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
+		switch string(k) {
+		case "phone":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Phone = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"phone\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TgSessionCreateReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTgSessionCreateReq) {
+					name = jsonFieldsNameOfTgSessionCreateReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
 		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode TelegramParticipantsItemMeta")
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s TelegramParticipantsItemMeta) MarshalJSON() ([]byte, error) {
+func (s *TgSessionCreateReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TelegramParticipantsItemMeta) UnmarshalJSON(data []byte) error {
+func (s *TgSessionCreateReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes TelegramSessionHistory as json.
-func (s TelegramSessionHistory) Encode(e *jx.Encoder) {
-	unwrapped := []TelegramSessionHistoryItem(s)
-	if unwrapped == nil {
-		e.ArrEmpty()
-		return
+// Encode implements json.Marshaler.
+func (s *TgSessionListOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TgSessionListOK) encodeFields(e *jx.Encoder) {
+	{
+		if s.Total.Set {
+			e.FieldStart("total")
+			s.Total.Encode(e)
+		}
 	}
-	if unwrapped != nil {
-		e.ArrStart()
-		for _, elem := range unwrapped {
-			elem.Encode(e)
+	{
+		if s.Sessions != nil {
+			e.FieldStart("sessions")
+			e.ArrStart()
+			for _, elem := range s.Sessions {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
-		e.ArrEnd()
 	}
 }
 
-// Decode decodes TelegramSessionHistory from json.
-func (s *TelegramSessionHistory) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfTgSessionListOK = [2]string{
+	0: "total",
+	1: "sessions",
+}
+
+// Decode decodes TgSessionListOK from json.
+func (s *TgSessionListOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TelegramSessionHistory to nil")
+		return errors.New("invalid: unable to decode TgSessionListOK to nil")
 	}
-	var unwrapped []TelegramSessionHistoryItem
-	if err := func() error {
-		unwrapped = make([]TelegramSessionHistoryItem, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem TelegramSessionHistoryItem
-			if err := elem.Decode(d); err != nil {
-				return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "total":
+			if err := func() error {
+				s.Total.Reset()
+				if err := s.Total.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"total\"")
 			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
+		case "sessions":
+			if err := func() error {
+				s.Sessions = make([]Telegram, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Telegram
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Sessions = append(s.Sessions, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sessions\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode TgSessionListOK")
 	}
-	*s = TelegramSessionHistory(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s TelegramSessionHistory) MarshalJSON() ([]byte, error) {
+func (s *TgSessionListOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TelegramSessionHistory) UnmarshalJSON(data []byte) error {
+func (s *TgSessionListOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TelegramSessionHistoryItem) Encode(e *jx.Encoder) {
+func (s *TgSessionVerifyReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TelegramSessionHistoryItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.SessionId.Set {
-			e.FieldStart("sessionId")
-			s.SessionId.Encode(e)
-		}
-	}
+func (s *TgSessionVerifyReq) encodeFields(e *jx.Encoder) {
 	{
-		if s.StartedAt.Set {
-			e.FieldStart("started_at")
-			s.StartedAt.Encode(e, json.EncodeDateTime)
+		if s.PhoneCodeHash.Set {
+			e.FieldStart("phone_code_hash")
+			s.PhoneCodeHash.Encode(e)
 		}
 	}
 	{
-		if s.EndedAt.Set {
-			e.FieldStart("ended_at")
-			s.EndedAt.Encode(e, json.EncodeDateTime)
+		if s.Code.Set {
+			e.FieldStart("code")
+			s.Code.Encode(e)
 		}
 	}
 	{
-		if s.Meta.Set {
-			e.FieldStart("meta")
-			s.Meta.Encode(e)
+		if s.Password.Set {
+			e.FieldStart("password")
+			s.Password.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfTelegramSessionHistoryItem = [4]string{
-	0: "sessionId",
-	1: "started_at",
-	2: "ended_at",
-	3: "meta",
+var jsonFieldsNameOfTgSessionVerifyReq = [3]string{
+	0: "phone_code_hash",
+	1: "code",
+	2: "password",
 }
 
-// Decode decodes TelegramSessionHistoryItem from json.
-func (s *TelegramSessionHistoryItem) Decode(d *jx.Decoder) error {
+// Decode decodes TgSessionVerifyReq from json.
+func (s *TgSessionVerifyReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TelegramSessionHistoryItem to nil")
+		return errors.New("invalid: unable to decode TgSessionVerifyReq to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "sessionId":
-			if err := func() error {
-				s.SessionId.Reset()
-				if err := s.SessionId.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"sessionId\"")
-			}
-		case "started_at":
+		case "phone_code_hash":
 			if err := func() error {
-				s.StartedAt.Reset()
-				if err := s.StartedAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.PhoneCodeHash.Reset()
+				if err := s.PhoneCodeHash.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"started_at\"")
+				return errors.Wrap(err, "decode field \"phone_code_hash\"")
 			}
-		case "ended_at":
+		case "code":
 			if err := func() error {
-				s.EndedAt.Reset()
-				if err := s.EndedAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.Code.Reset()
+				if err := s.Code.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ended_at\"")
+				return errors.Wrap(err, "decode field \"code\"")
 			}
-		case "meta":
+		case "password":
 			if err := func() error {
-				s.Meta.Reset()
-				if err := s.Meta.Decode(d); err != nil {
+				s.Password.Reset()
+				if err := s.Password.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"meta\"")
+				return errors.Wrap(err, "decode field \"password\"")
 			}
 		default:
-			return errors.Errorf("unexpected field %q", k)
+			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TelegramSessionHistoryItem")
+		return errors.Wrap(err, "decode TgSessionVerifyReq")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TelegramSessionHistoryItem) MarshalJSON() ([]byte, error) {
+func (s *TgSessionVerifyReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TelegramSessionHistoryItem) UnmarshalJSON(data []byte) error {
+func (s *TgSessionVerifyReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s TelegramSessionHistoryItemMeta) Encode(e *jx.Encoder) {
+func (s *UploadFileRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s TelegramSessionHistoryItemMeta) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
+// encodeFields encodes fields.
+func (s *UploadFileRequest) encodeFields(e *jx.Encoder) {
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.MimeType.Set {
+			e.FieldStart("mime_type")
+			s.MimeType.Encode(e)
 		}
 	}
+	{
+		e.FieldStart("storage_uuid")
+		e.Str(s.StorageUUID)
+	}
 }
 
-// Decode decodes TelegramSessionHistoryItemMeta from json.
-func (s *TelegramSessionHistoryItemMeta) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfUploadFileRequest = [3]string{
+	0: "name",
+	1: "mime_type",
+	2: "storage_uuid",
+}
+
+// Decode decodes UploadFileRequest from json.
+func (s *UploadFileRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TelegramSessionHistoryItemMeta to nil")
+		return errors.New("invalid: unable to decode UploadFileRequest to nil")
 	}
-	m := s.init()
+	var requiredBitSet [1]uint8
+
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
+		switch string(k) {
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+		case "mime_type":
+			if err := func() error {
+				s.MimeType.Reset()
+				if err := s.MimeType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mime_type\"")
+			}
+		case "storage_uuid":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.StorageUUID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"storage_uuid\"")
+			}
+		default:
+			return errors.Errorf("unexpected field %q", k)
 		}
-		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TelegramSessionHistoryItemMeta")
+		return errors.Wrap(err, "decode UploadFileRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000100,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfUploadFileRequest) {
+					name = jsonFieldsNameOfUploadFileRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s TelegramSessionHistoryItemMeta) MarshalJSON() ([]byte, error) {
+func (s *UploadFileRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TelegramSessionHistoryItemMeta) UnmarshalJSON(data []byte) error {
+func (s *UploadFileRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TelegramUser) Encode(e *jx.Encoder) {
+func (s *UploadFileResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TelegramUser) encodeFields(e *jx.Encoder) {
-	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
-	}
-	{
-		if s.Username.Set {
-			e.FieldStart("username")
-			s.Username.Encode(e)
-		}
-	}
-	{
-		if s.FirstName.Set {
-			e.FieldStart("first_name")
-			s.FirstName.Encode(e)
-		}
-	}
-	{
-		if s.LastName.Set {
-			e.FieldStart("last_name")
-			s.LastName.Encode(e)
-		}
-	}
+func (s *UploadFileResponse) encodeFields(e *jx.Encoder) {
 	{
-		if s.Phone.Set {
-			e.FieldStart("phone")
-			s.Phone.Encode(e)
+		if s.File.Set {
+			e.FieldStart("file")
+			s.File.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfTelegramUser = [5]string{
-	0: "id",
-	1: "username",
-	2: "first_name",
-	3: "last_name",
-	4: "phone",
+var jsonFieldsNameOfUploadFileResponse = [1]string{
+	0: "file",
 }
 
-// Decode decodes TelegramUser from json.
-func (s *TelegramUser) Decode(d *jx.Decoder) error {
+// Decode decodes UploadFileResponse from json.
+func (s *UploadFileResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TelegramUser to nil")
+		return errors.New("invalid: unable to decode UploadFileResponse to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
-			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "username":
-			if err := func() error {
-				s.Username.Reset()
-				if err := s.Username.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"username\"")
-			}
-		case "first_name":
-			if err := func() error {
-				s.FirstName.Reset()
-				if err := s.FirstName.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"first_name\"")
-			}
-		case "last_name":
-			if err := func() error {
-				s.LastName.Reset()
-				if err := s.LastName.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"last_name\"")
-			}
-		case "phone":
+		case "file":
 			if err := func() error {
-				s.Phone.Reset()
-				if err := s.Phone.Decode(d); err != nil {
+				s.File.Reset()
+				if err := s.File.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone\"")
+				return errors.Wrap(err, "decode field \"file\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TelegramUser")
+		return errors.Wrap(err, "decode UploadFileResponse")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TelegramUser) MarshalJSON() ([]byte, error) {
+func (s *UploadFileResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TelegramUser) UnmarshalJSON(data []byte) error {
+func (s *UploadFileResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TgSessionCreateReq) Encode(e *jx.Encoder) {
+func (s *UploadPresignedUrlRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TgSessionCreateReq) encodeFields(e *jx.Encoder) {
+func (s *UploadPresignedUrlRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("phone")
-		e.Str(s.Phone)
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.MimeType.Set {
+			e.FieldStart("mime_type")
+			s.MimeType.Encode(e)
+		}
+	}
+	{
+		if s.StorageType.Set {
+			e.FieldStart("storage_type")
+			s.StorageType.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfTgSessionCreateReq = [1]string{
-	0: "phone",
+var jsonFieldsNameOfUploadPresignedUrlRequest = [3]string{
+	0: "name",
+	1: "mime_type",
+	2: "storage_type",
 }
 
-// Decode decodes TgSessionCreateReq from json.
-func (s *TgSessionCreateReq) Decode(d *jx.Decoder) error {
+// Decode decodes UploadPresignedUrlRequest from json.
+func (s *UploadPresignedUrlRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TgSessionCreateReq to nil")
+		return errors.New("invalid: unable to decode UploadPresignedUrlRequest to nil")
 	}
-	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "phone":
-			requiredBitSet[0] |= 1 << 0
+		case "name":
 			if err := func() error {
-				v, err := d.Str()
-				s.Phone = string(v)
-				if err != nil {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode TgSessionCreateReq")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfTgSessionCreateReq) {
-					name = jsonFieldsNameOfTgSessionCreateReq[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
+		case "mime_type":
+			if err := func() error {
+				s.MimeType.Reset()
+				if err := s.MimeType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mime_type\"")
+			}
+		case "storage_type":
+			if err := func() error {
+				s.StorageType.Reset()
+				if err := s.StorageType.Decode(d); err != nil {
+					return err
 				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"storage_type\"")
 			}
+		default:
+			return d.Skip()
 		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UploadPresignedUrlRequest")
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UploadPresignedUrlRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UploadPresignedUrlRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UploadPresignedUrlRequestStorageType as json.
+func (s UploadPresignedUrlRequestStorageType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes UploadPresignedUrlRequestStorageType from json.
+func (s *UploadPresignedUrlRequestStorageType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UploadPresignedUrlRequestStorageType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch UploadPresignedUrlRequestStorageType(v) {
+	case UploadPresignedUrlRequestStorageTypeS3:
+		*s = UploadPresignedUrlRequestStorageTypeS3
+	case UploadPresignedUrlRequestStorageTypePostgres:
+		*s = UploadPresignedUrlRequestStorageTypePostgres
+	case UploadPresignedUrlRequestStorageTypeHostfiles:
+		*s = UploadPresignedUrlRequestStorageTypeHostfiles
+	default:
+		*s = UploadPresignedUrlRequestStorageType(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TgSessionCreateReq) MarshalJSON() ([]byte, error) {
+func (s UploadPresignedUrlRequestStorageType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TgSessionCreateReq) UnmarshalJSON(data []byte) error {
+func (s *UploadPresignedUrlRequestStorageType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TgSessionListOK) Encode(e *jx.Encoder) {
+func (s *UploadPresignedUrlResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TgSessionListOK) encodeFields(e *jx.Encoder) {
+func (s *UploadPresignedUrlResponse) encodeFields(e *jx.Encoder) {
 	{
-		if s.Total.Set {
-			e.FieldStart("total")
-			s.Total.Encode(e)
+		if s.UploadURL.Set {
+			e.FieldStart("upload_url")
+			s.UploadURL.Encode(e)
 		}
 	}
 	{
-		if s.Sessions != nil {
-			e.FieldStart("sessions")
-			e.ArrStart()
-			for _, elem := range s.Sessions {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.File.Set {
+			e.FieldStart("file")
+			s.File.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfTgSessionListOK = [2]string{
-	0: "total",
-	1: "sessions",
+var jsonFieldsNameOfUploadPresignedUrlResponse = [2]string{
+	0: "upload_url",
+	1: "file",
 }
 
-// Decode decodes TgSessionListOK from json.
-func (s *TgSessionListOK) Decode(d *jx.Decoder) error {
+// Decode decodes UploadPresignedUrlResponse from json.
+func (s *UploadPresignedUrlResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TgSessionListOK to nil")
+		return errors.New("invalid: unable to decode UploadPresignedUrlResponse to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "total":
+		case "upload_url":
 			if err := func() error {
-				s.Total.Reset()
-				if err := s.Total.Decode(d); err != nil {
+				s.UploadURL.Reset()
+				if err := s.UploadURL.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"total\"")
+				return errors.Wrap(err, "decode field \"upload_url\"")
 			}
-		case "sessions":
+		case "file":
 			if err := func() error {
-				s.Sessions = make([]Telegram, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Telegram
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Sessions = append(s.Sessions, elem)
-					return nil
-				}); err != nil {
+				s.File.Reset()
+				if err := s.File.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"sessions\"")
+				return errors.Wrap(err, "decode field \"file\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TgSessionListOK")
+		return errors.Wrap(err, "decode UploadPresignedUrlResponse")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TgSessionListOK) MarshalJSON() ([]byte, error) {
+func (s *UploadPresignedUrlResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TgSessionListOK) UnmarshalJSON(data []byte) error {
+func (s *UploadPresignedUrlResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TgSessionVerifyReq) Encode(e *jx.Encoder) {
+func (s *User) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TgSessionVerifyReq) encodeFields(e *jx.Encoder) {
+func (s *User) encodeFields(e *jx.Encoder) {
 	{
-		if s.PhoneCodeHash.Set {
-			e.FieldStart("phone_code_hash")
-			s.PhoneCodeHash.Encode(e)
+		if s.UUID.Set {
+			e.FieldStart("uuid")
+			s.UUID.Encode(e)
 		}
 	}
 	{
-		if s.Code.Set {
-			e.FieldStart("code")
-			s.Code.Encode(e)
+		e.FieldStart("email")
+		e.Str(s.Email)
+	}
+	{
+		e.FieldStart("password")
+		e.Str(s.Password)
+	}
+	{
+		e.FieldStart("first_name")
+		e.Str(s.FirstName)
+	}
+	{
+		e.FieldStart("last_name")
+		e.Str(s.LastName)
+	}
+	{
+		if s.IsEnabled.Set {
+			e.FieldStart("is_enabled")
+			s.IsEnabled.Encode(e)
 		}
 	}
 	{
-		if s.Password.Set {
-			e.FieldStart("password")
-			s.Password.Encode(e)
+		if s.IsAdmin.Set {
+			e.FieldStart("is_admin")
+			s.IsAdmin.Encode(e)
+		}
+	}
+	{
+		if s.Meta.Set {
+			e.FieldStart("meta")
+			s.Meta.Encode(e)
+		}
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 }
 
-var jsonFieldsNameOfTgSessionVerifyReq = [3]string{
-	0: "phone_code_hash",
-	1: "code",
+var jsonFieldsNameOfUser = [10]string{
+	0: "uuid",
+	1: "email",
 	2: "password",
+	3: "first_name",
+	4: "last_name",
+	5: "is_enabled",
+	6: "is_admin",
+	7: "meta",
+	8: "created_at",
+	9: "updated_at",
 }
 
-// Decode decodes TgSessionVerifyReq from json.
-func (s *TgSessionVerifyReq) Decode(d *jx.Decoder) error {
+// Decode decodes User from json.
+func (s *User) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TgSessionVerifyReq to nil")
+		return errors.New("invalid: unable to decode User to nil")
 	}
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "phone_code_hash":
+		case "uuid":
 			if err := func() error {
-				s.PhoneCodeHash.Reset()
-				if err := s.PhoneCodeHash.Decode(d); err != nil {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"phone_code_hash\"")
+				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "code":
+		case "email":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Email = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email\"")
+			}
+		case "password":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Password = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"password\"")
+			}
+		case "first_name":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.FirstName = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"first_name\"")
+			}
+		case "last_name":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.LastName = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_name\"")
+			}
+		case "is_enabled":
 			if err := func() error {
-				s.Code.Reset()
-				if err := s.Code.Decode(d); err != nil {
+				s.IsEnabled.Reset()
+				if err := s.IsEnabled.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"code\"")
+				return errors.Wrap(err, "decode field \"is_enabled\"")
 			}
-		case "password":
+		case "is_admin":
 			if err := func() error {
-				s.Password.Reset()
-				if err := s.Password.Decode(d); err != nil {
+				s.IsAdmin.Reset()
+				if err := s.IsAdmin.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password\"")
+				return errors.Wrap(err, "decode field \"is_admin\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode TgSessionVerifyReq")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *TgSessionVerifyReq) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TgSessionVerifyReq) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *UploadFileRequest) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *UploadFileRequest) encodeFields(e *jx.Encoder) {
-	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
-		}
-	}
-	{
-		if s.MimeType.Set {
-			e.FieldStart("mime_type")
-			s.MimeType.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("storage_uuid")
-		e.Str(s.StorageUUID)
-	}
-}
-
-var jsonFieldsNameOfUploadFileRequest = [3]string{
-	0: "name",
-	1: "mime_type",
-	2: "storage_uuid",
-}
-
-// Decode decodes UploadFileRequest from json.
-func (s *UploadFileRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode UploadFileRequest to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "name":
+		case "meta":
 			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
+				s.Meta.Reset()
+				if err := s.Meta.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"meta\"")
 			}
-		case "mime_type":
+		case "created_at":
 			if err := func() error {
-				s.MimeType.Reset()
-				if err := s.MimeType.Decode(d); err != nil {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"mime_type\"")
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
-		case "storage_uuid":
-			requiredBitSet[0] |= 1 << 2
+		case "updated_at":
 			if err := func() error {
-				v, err := d.Str()
-				s.StorageUUID = string(v)
-				if err != nil {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"storage_uuid\"")
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
 		default:
 			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UploadFileRequest")
+		return errors.Wrap(err, "decode User")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000100,
+	for i, mask := range [2]uint8{
+		0b00011110,
+		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -13351,8 +18818,8 @@ func (s *UploadFileRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUploadFileRequest) {
-					name = jsonFieldsNameOfUploadFileRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUser) {
+					name = jsonFieldsNameOfUser[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -13373,309 +18840,478 @@ func (s *UploadFileRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UploadFileRequest) MarshalJSON() ([]byte, error) {
+func (s *User) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UploadFileRequest) UnmarshalJSON(data []byte) error {
+func (s *User) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UploadFileResponse) Encode(e *jx.Encoder) {
+func (s UserMeta) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields encodes fields.
-func (s *UploadFileResponse) encodeFields(e *jx.Encoder) {
-	{
-		if s.File.Set {
-			e.FieldStart("file")
-			s.File.Encode(e)
+// encodeFields implements json.Marshaler.
+func (s UserMeta) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
 		}
 	}
 }
 
-var jsonFieldsNameOfUploadFileResponse = [1]string{
-	0: "file",
-}
-
-// Decode decodes UploadFileResponse from json.
-func (s *UploadFileResponse) Decode(d *jx.Decoder) error {
+// Decode decodes UserMeta from json.
+func (s *UserMeta) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UploadFileResponse to nil")
+		return errors.New("invalid: unable to decode UserMeta to nil")
 	}
-
+	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "file":
-			if err := func() error {
-				s.File.Reset()
-				if err := s.File.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"file\"")
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
 			}
-		default:
-			return d.Skip()
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UploadFileResponse")
+		return errors.Wrap(err, "decode UserMeta")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UploadFileResponse) MarshalJSON() ([]byte, error) {
+func (s UserMeta) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UploadFileResponse) UnmarshalJSON(data []byte) error {
+func (s *UserMeta) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UploadPresignedUrlRequest) Encode(e *jx.Encoder) {
+func (s *UserProfile) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UploadPresignedUrlRequest) encodeFields(e *jx.Encoder) {
-	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
-		}
-	}
+func (s *UserProfile) encodeFields(e *jx.Encoder) {
 	{
-		if s.MimeType.Set {
-			e.FieldStart("mime_type")
-			s.MimeType.Encode(e)
-		}
+		e.FieldStart("first_name")
+		e.Str(s.FirstName)
 	}
 	{
-		if s.StorageType.Set {
-			e.FieldStart("storage_type")
-			s.StorageType.Encode(e)
-		}
+		e.FieldStart("last_name")
+		e.Str(s.LastName)
 	}
 }
 
-var jsonFieldsNameOfUploadPresignedUrlRequest = [3]string{
-	0: "name",
-	1: "mime_type",
-	2: "storage_type",
+var jsonFieldsNameOfUserProfile = [2]string{
+	0: "first_name",
+	1: "last_name",
 }
 
-// Decode decodes UploadPresignedUrlRequest from json.
-func (s *UploadPresignedUrlRequest) Decode(d *jx.Decoder) error {
+// Decode decodes UserProfile from json.
+func (s *UserProfile) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UploadPresignedUrlRequest to nil")
+		return errors.New("invalid: unable to decode UserProfile to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
+		case "first_name":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
+				v, err := d.Str()
+				s.FirstName = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"first_name\"")
 			}
-		case "mime_type":
+		case "last_name":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.MimeType.Reset()
-				if err := s.MimeType.Decode(d); err != nil {
+				v, err := d.Str()
+				s.LastName = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"mime_type\"")
+				return errors.Wrap(err, "decode field \"last_name\"")
 			}
-		case "storage_type":
-			if err := func() error {
-				s.StorageType.Reset()
-				if err := s.StorageType.Decode(d); err != nil {
-					return err
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UserProfile")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfUserProfile) {
+					name = jsonFieldsNameOfUserProfile[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"storage_type\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		default:
-			return d.Skip()
 		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode UploadPresignedUrlRequest")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *UploadPresignedUrlRequest) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UploadPresignedUrlRequest) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes UploadPresignedUrlRequestStorageType as json.
-func (s UploadPresignedUrlRequestStorageType) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes UploadPresignedUrlRequestStorageType from json.
-func (s *UploadPresignedUrlRequestStorageType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode UploadPresignedUrlRequestStorageType to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
 	}
-	// Try to use constant string.
-	switch UploadPresignedUrlRequestStorageType(v) {
-	case UploadPresignedUrlRequestStorageTypeS3:
-		*s = UploadPresignedUrlRequestStorageTypeS3
-	case UploadPresignedUrlRequestStorageTypePostgres:
-		*s = UploadPresignedUrlRequestStorageTypePostgres
-	case UploadPresignedUrlRequestStorageTypeHostfiles:
-		*s = UploadPresignedUrlRequestStorageTypeHostfiles
-	default:
-		*s = UploadPresignedUrlRequestStorageType(v)
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s UploadPresignedUrlRequestStorageType) MarshalJSON() ([]byte, error) {
+func (s *UserProfile) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UploadPresignedUrlRequestStorageType) UnmarshalJSON(data []byte) error {
+func (s *UserProfile) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UploadPresignedUrlResponse) Encode(e *jx.Encoder) {
+func (s *Webhook) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UploadPresignedUrlResponse) encodeFields(e *jx.Encoder) {
+func (s *Webhook) encodeFields(e *jx.Encoder) {
 	{
-		if s.UploadURL.Set {
-			e.FieldStart("upload_url")
-			s.UploadURL.Encode(e)
+		if s.UUID.Set {
+			e.FieldStart("uuid")
+			s.UUID.Encode(e)
 		}
 	}
 	{
-		if s.File.Set {
-			e.FieldStart("file")
-			s.File.Encode(e)
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("url")
+		e.Str(s.URL)
+	}
+	{
+		if s.Secret.Set {
+			e.FieldStart("secret")
+			s.Secret.Encode(e)
+		}
+	}
+	{
+		if s.EventTypes != nil {
+			e.FieldStart("event_types")
+			e.ArrStart()
+			for _, elem := range s.EventTypes {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.IsEnabled.Set {
+			e.FieldStart("is_enabled")
+			s.IsEnabled.Encode(e)
+		}
+	}
+	{
+		if s.LastStatus.Set {
+			e.FieldStart("last_status")
+			s.LastStatus.Encode(e)
+		}
+	}
+	{
+		if s.LastError.Set {
+			e.FieldStart("last_error")
+			s.LastError.Encode(e)
+		}
+	}
+	{
+		if s.FailureCount.Set {
+			e.FieldStart("failure_count")
+			s.FailureCount.Encode(e)
+		}
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 }
 
-var jsonFieldsNameOfUploadPresignedUrlResponse = [2]string{
-	0: "upload_url",
-	1: "file",
+var jsonFieldsNameOfWebhook = [11]string{
+	0:  "uuid",
+	1:  "name",
+	2:  "url",
+	3:  "secret",
+	4:  "event_types",
+	5:  "is_enabled",
+	6:  "last_status",
+	7:  "last_error",
+	8:  "failure_count",
+	9:  "created_at",
+	10: "updated_at",
 }
 
-// Decode decodes UploadPresignedUrlResponse from json.
-func (s *UploadPresignedUrlResponse) Decode(d *jx.Decoder) error {
+// Decode decodes Webhook from json.
+func (s *Webhook) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UploadPresignedUrlResponse to nil")
+		return errors.New("invalid: unable to decode Webhook to nil")
 	}
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "upload_url":
+		case "uuid":
 			if err := func() error {
-				s.UploadURL.Reset()
-				if err := s.UploadURL.Decode(d); err != nil {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"upload_url\"")
+				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "file":
+		case "name":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.File.Reset()
-				if err := s.File.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "url":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.URL = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"url\"")
+			}
+		case "secret":
+			if err := func() error {
+				s.Secret.Reset()
+				if err := s.Secret.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"secret\"")
+			}
+		case "event_types":
+			if err := func() error {
+				s.EventTypes = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.EventTypes = append(s.EventTypes, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"event_types\"")
+			}
+		case "is_enabled":
+			if err := func() error {
+				s.IsEnabled.Reset()
+				if err := s.IsEnabled.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_enabled\"")
+			}
+		case "last_status":
+			if err := func() error {
+				s.LastStatus.Reset()
+				if err := s.LastStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_status\"")
+			}
+		case "last_error":
+			if err := func() error {
+				s.LastError.Reset()
+				if err := s.LastError.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_error\"")
+			}
+		case "failure_count":
+			if err := func() error {
+				s.FailureCount.Reset()
+				if err := s.FailureCount.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"failure_count\"")
+			}
+		case "created_at":
+			if err := func() error {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "updated_at":
+			if err := func() error {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"file\"")
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updated_at\"")
+			}
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Webhook")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00000110,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfWebhook) {
+					name = jsonFieldsNameOfWebhook[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		default:
-			return d.Skip()
 		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode UploadPresignedUrlResponse")
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UploadPresignedUrlResponse) MarshalJSON() ([]byte, error) {
+func (s *Webhook) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UploadPresignedUrlResponse) UnmarshalJSON(data []byte) error {
+func (s *Webhook) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *User) Encode(e *jx.Encoder) {
+func (s *WorkerJobs) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *User) encodeFields(e *jx.Encoder) {
+func (s *WorkerJobs) encodeFields(e *jx.Encoder) {
 	{
 		if s.UUID.Set {
 			e.FieldStart("uuid")
@@ -13683,70 +19319,67 @@ func (s *User) encodeFields(e *jx.Encoder) {
 		}
 	}
 	{
-		e.FieldStart("email")
-		e.Str(s.Email)
-	}
-	{
-		e.FieldStart("password")
-		e.Str(s.Password)
+		if s.SchedulerUUID.Set {
+			e.FieldStart("scheduler_uuid")
+			s.SchedulerUUID.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("first_name")
-		e.Str(s.FirstName)
+		if s.PipelineUUID.Set {
+			e.FieldStart("pipeline_uuid")
+			s.PipelineUUID.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("last_name")
-		e.Str(s.LastName)
+		if s.JobUUID.Set {
+			e.FieldStart("job_uuid")
+			s.JobUUID.Encode(e)
+		}
 	}
 	{
-		if s.IsEnabled.Set {
-			e.FieldStart("is_enabled")
-			s.IsEnabled.Encode(e)
-		}
+		e.FieldStart("subject")
+		e.Str(s.Subject)
 	}
 	{
-		if s.IsAdmin.Set {
-			e.FieldStart("is_admin")
-			s.IsAdmin.Encode(e)
-		}
+		e.FieldStart("status")
+		e.Str(s.Status)
 	}
 	{
-		if s.Meta.Set {
-			e.FieldStart("meta")
-			s.Meta.Encode(e)
+		if s.Data.Set {
+			e.FieldStart("data")
+			s.Data.Encode(e)
 		}
 	}
 	{
-		if s.CreatedAt.Set {
-			e.FieldStart("created_at")
-			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		if s.StartedAt.Set {
+			e.FieldStart("started_at")
+			s.StartedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.UpdatedAt.Set {
-			e.FieldStart("updated_at")
-			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		if s.FinishedAt.Set {
+			e.FieldStart("finished_at")
+			s.FinishedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 }
 
-var jsonFieldsNameOfUser = [10]string{
+var jsonFieldsNameOfWorkerJobs = [9]string{
 	0: "uuid",
-	1: "email",
-	2: "password",
-	3: "first_name",
-	4: "last_name",
-	5: "is_enabled",
-	6: "is_admin",
-	7: "meta",
-	8: "created_at",
-	9: "updated_at",
+	1: "scheduler_uuid",
+	2: "pipeline_uuid",
+	3: "job_uuid",
+	4: "subject",
+	5: "status",
+	6: "data",
+	7: "started_at",
+	8: "finished_at",
 }
 
-// Decode decodes User from json.
-func (s *User) Decode(d *jx.Decoder) error {
+// Decode decodes WorkerJobs from json.
+func (s *WorkerJobs) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode User to nil")
+		return errors.New("invalid: unable to decode WorkerJobs to nil")
 	}
 	var requiredBitSet [2]uint8
 
@@ -13762,115 +19395,101 @@ func (s *User) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "email":
-			requiredBitSet[0] |= 1 << 1
+		case "scheduler_uuid":
 			if err := func() error {
-				v, err := d.Str()
-				s.Email = string(v)
-				if err != nil {
+				s.SchedulerUUID.Reset()
+				if err := s.SchedulerUUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email\"")
+				return errors.Wrap(err, "decode field \"scheduler_uuid\"")
 			}
-		case "password":
-			requiredBitSet[0] |= 1 << 2
+		case "pipeline_uuid":
 			if err := func() error {
-				v, err := d.Str()
-				s.Password = string(v)
-				if err != nil {
+				s.PipelineUUID.Reset()
+				if err := s.PipelineUUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password\"")
+				return errors.Wrap(err, "decode field \"pipeline_uuid\"")
 			}
-		case "first_name":
-			requiredBitSet[0] |= 1 << 3
+		case "job_uuid":
 			if err := func() error {
-				v, err := d.Str()
-				s.FirstName = string(v)
-				if err != nil {
+				s.JobUUID.Reset()
+				if err := s.JobUUID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"first_name\"")
+				return errors.Wrap(err, "decode field \"job_uuid\"")
 			}
-		case "last_name":
+		case "subject":
 			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
 				v, err := d.Str()
-				s.LastName = string(v)
+				s.Subject = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"last_name\"")
-			}
-		case "is_enabled":
-			if err := func() error {
-				s.IsEnabled.Reset()
-				if err := s.IsEnabled.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_enabled\"")
+				return errors.Wrap(err, "decode field \"subject\"")
 			}
-		case "is_admin":
+		case "status":
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				s.IsAdmin.Reset()
-				if err := s.IsAdmin.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Status = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_admin\"")
+				return errors.Wrap(err, "decode field \"status\"")
 			}
-		case "meta":
+		case "data":
 			if err := func() error {
-				s.Meta.Reset()
-				if err := s.Meta.Decode(d); err != nil {
+				s.Data.Reset()
+				if err := s.Data.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"meta\"")
+				return errors.Wrap(err, "decode field \"data\"")
 			}
-		case "created_at":
+		case "started_at":
 			if err := func() error {
-				s.CreatedAt.Reset()
-				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.StartedAt.Reset()
+				if err := s.StartedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
+				return errors.Wrap(err, "decode field \"started_at\"")
 			}
-		case "updated_at":
+		case "finished_at":
 			if err := func() error {
-				s.UpdatedAt.Reset()
-				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.FinishedAt.Reset()
+				if err := s.FinishedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"updated_at\"")
+				return errors.Wrap(err, "decode field \"finished_at\"")
 			}
 		default:
 			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode User")
+		return errors.Wrap(err, "decode WorkerJobs")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [2]uint8{
-		0b00011110,
+		0b00110000,
 		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
@@ -13883,8 +19502,8 @@ func (s *User) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUser) {
-					name = jsonFieldsNameOfUser[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfWorkerJobs) {
+					name = jsonFieldsNameOfWorkerJobs[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -13905,27 +19524,27 @@ func (s *User) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *User) MarshalJSON() ([]byte, error) {
+func (s *WorkerJobs) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *User) UnmarshalJSON(data []byte) error {
+func (s *WorkerJobs) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s UserMeta) Encode(e *jx.Encoder) {
+func (s WorkerJobsData) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields implements json.Marshaler.
-func (s UserMeta) encodeFields(e *jx.Encoder) {
+func (s WorkerJobsData) encodeFields(e *jx.Encoder) {
 	for k, elem := range s {
 		e.FieldStart(k)
 
@@ -13935,10 +19554,10 @@ func (s UserMeta) encodeFields(e *jx.Encoder) {
 	}
 }
 
-// Decode decodes UserMeta from json.
-func (s *UserMeta) Decode(d *jx.Decoder) error {
+// Decode decodes WorkerJobsData from json.
+func (s *WorkerJobsData) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UserMeta to nil")
+		return errors.New("invalid: unable to decode WorkerJobsData to nil")
 	}
 	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
@@ -13956,93 +19575,86 @@ func (s *UserMeta) Decode(d *jx.Decoder) error {
 		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UserMeta")
+		return errors.Wrap(err, "decode WorkerJobsData")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s UserMeta) MarshalJSON() ([]byte, error) {
+func (s WorkerJobsData) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UserMeta) UnmarshalJSON(data []byte) error {
+func (s *WorkerJobsData) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UserProfile) Encode(e *jx.Encoder) {
+func (s *WorkerJobsListOK) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UserProfile) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("first_name")
-		e.Str(s.FirstName)
-	}
+func (s *WorkerJobsListOK) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("last_name")
-		e.Str(s.LastName)
+		e.FieldStart("jobs")
+		e.ArrStart()
+		for _, elem := range s.Jobs {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfUserProfile = [2]string{
-	0: "first_name",
-	1: "last_name",
+var jsonFieldsNameOfWorkerJobsListOK = [1]string{
+	0: "jobs",
 }
 
-// Decode decodes UserProfile from json.
-func (s *UserProfile) Decode(d *jx.Decoder) error {
+// Decode decodes WorkerJobsListOK from json.
+func (s *WorkerJobsListOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UserProfile to nil")
+		return errors.New("invalid: unable to decode WorkerJobsListOK to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "first_name":
+		case "jobs":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.FirstName = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"first_name\"")
-			}
-		case "last_name":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.LastName = string(v)
-				if err != nil {
+				s.Jobs = make([]WorkerJobs, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem WorkerJobs
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Jobs = append(s.Jobs, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"last_name\"")
+				return errors.Wrap(err, "decode field \"jobs\"")
 			}
 		default:
-			return errors.Errorf("unexpected field %q", k)
+			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UserProfile")
+		return errors.Wrap(err, "decode WorkerJobsListOK")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -14054,8 +19666,8 @@ func (s *UserProfile) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUserProfile) {
-					name = jsonFieldsNameOfUserProfile[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfWorkerJobsListOK) {
+					name = jsonFieldsNameOfWorkerJobsListOK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -14076,27 +19688,27 @@ func (s *UserProfile) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UserProfile) MarshalJSON() ([]byte, error) {
+func (s *WorkerJobsListOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UserProfile) UnmarshalJSON(data []byte) error {
+func (s *WorkerJobsListOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *WorkerJobs) Encode(e *jx.Encoder) {
+func (s *Workspace) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *WorkerJobs) encodeFields(e *jx.Encoder) {
+func (s *Workspace) encodeFields(e *jx.Encoder) {
 	{
 		if s.UUID.Set {
 			e.FieldStart("uuid")
@@ -14104,58 +19716,48 @@ func (s *WorkerJobs) encodeFields(e *jx.Encoder) {
 		}
 	}
 	{
-		e.FieldStart("scheduler_uuid")
-		e.Str(s.SchedulerUUID)
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 	{
-		if s.JobUUID.Set {
-			e.FieldStart("job_uuid")
-			s.JobUUID.Encode(e)
+		if s.Slug.Set {
+			e.FieldStart("slug")
+			s.Slug.Encode(e)
 		}
 	}
 	{
-		e.FieldStart("subject")
-		e.Str(s.Subject)
-	}
-	{
-		e.FieldStart("status")
-		e.Str(s.Status)
-	}
-	{
-		if s.Data.Set {
-			e.FieldStart("data")
-			s.Data.Encode(e)
+		if s.Role.Set {
+			e.FieldStart("role")
+			s.Role.Encode(e)
 		}
 	}
 	{
-		if s.StartedAt.Set {
-			e.FieldStart("started_at")
-			s.StartedAt.Encode(e, json.EncodeDateTime)
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.FinishedAt.Set {
-			e.FieldStart("finished_at")
-			s.FinishedAt.Encode(e, json.EncodeDateTime)
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 }
 
-var jsonFieldsNameOfWorkerJobs = [8]string{
+var jsonFieldsNameOfWorkspace = [6]string{
 	0: "uuid",
-	1: "scheduler_uuid",
-	2: "job_uuid",
-	3: "subject",
-	4: "status",
-	5: "data",
-	6: "started_at",
-	7: "finished_at",
+	1: "name",
+	2: "slug",
+	3: "role",
+	4: "created_at",
+	5: "updated_at",
 }
 
-// Decode decodes WorkerJobs from json.
-func (s *WorkerJobs) Decode(d *jx.Decoder) error {
+// Decode decodes Workspace from json.
+func (s *Workspace) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode WorkerJobs to nil")
+		return errors.New("invalid: unable to decode Workspace to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -14171,93 +19773,69 @@ func (s *WorkerJobs) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"uuid\"")
 			}
-		case "scheduler_uuid":
+		case "name":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.SchedulerUUID = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"scheduler_uuid\"")
-			}
-		case "job_uuid":
-			if err := func() error {
-				s.JobUUID.Reset()
-				if err := s.JobUUID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"job_uuid\"")
-			}
-		case "subject":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Str()
-				s.Subject = string(v)
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"subject\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "status":
-			requiredBitSet[0] |= 1 << 4
+		case "slug":
 			if err := func() error {
-				v, err := d.Str()
-				s.Status = string(v)
-				if err != nil {
+				s.Slug.Reset()
+				if err := s.Slug.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"status\"")
+				return errors.Wrap(err, "decode field \"slug\"")
 			}
-		case "data":
+		case "role":
 			if err := func() error {
-				s.Data.Reset()
-				if err := s.Data.Decode(d); err != nil {
+				s.Role.Reset()
+				if err := s.Role.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"data\"")
+				return errors.Wrap(err, "decode field \"role\"")
 			}
-		case "started_at":
+		case "created_at":
 			if err := func() error {
-				s.StartedAt.Reset()
-				if err := s.StartedAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"started_at\"")
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
-		case "finished_at":
+		case "updated_at":
 			if err := func() error {
-				s.FinishedAt.Reset()
-				if err := s.FinishedAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"finished_at\"")
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
 		default:
 			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode WorkerJobs")
+		return errors.Wrap(err, "decode Workspace")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00011010,
+		0b00000010,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -14269,8 +19847,8 @@ func (s *WorkerJobs) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfWorkerJobs) {
-					name = jsonFieldsNameOfWorkerJobs[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfWorkspace) {
+					name = jsonFieldsNameOfWorkspace[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -14291,137 +19869,118 @@ func (s *WorkerJobs) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *WorkerJobs) MarshalJSON() ([]byte, error) {
+func (s *Workspace) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *WorkerJobs) UnmarshalJSON(data []byte) error {
+func (s *Workspace) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s WorkerJobsData) Encode(e *jx.Encoder) {
+func (s *WorkspaceMember) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s WorkerJobsData) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
-		}
-	}
-}
-
-// Decode decodes WorkerJobsData from json.
-func (s *WorkerJobsData) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode WorkerJobsData to nil")
+// encodeFields encodes fields.
+func (s *WorkspaceMember) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("user_uuid")
+		e.Str(s.UserUUID)
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+	{
+		if s.Email.Set {
+			e.FieldStart("email")
+			s.Email.Encode(e)
 		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode WorkerJobsData")
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s WorkerJobsData) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *WorkerJobsData) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *WorkerJobsListOK) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *WorkerJobsListOK) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("jobs")
-		e.ArrStart()
-		for _, elem := range s.Jobs {
-			elem.Encode(e)
+		e.FieldStart("role")
+		s.Role.Encode(e)
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
 		}
-		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfWorkerJobsListOK = [1]string{
-	0: "jobs",
+var jsonFieldsNameOfWorkspaceMember = [4]string{
+	0: "user_uuid",
+	1: "email",
+	2: "role",
+	3: "created_at",
 }
 
-// Decode decodes WorkerJobsListOK from json.
-func (s *WorkerJobsListOK) Decode(d *jx.Decoder) error {
+// Decode decodes WorkspaceMember from json.
+func (s *WorkspaceMember) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode WorkerJobsListOK to nil")
+		return errors.New("invalid: unable to decode WorkspaceMember to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "jobs":
+		case "user_uuid":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Jobs = make([]WorkerJobs, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem WorkerJobs
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Jobs = append(s.Jobs, elem)
-					return nil
-				}); err != nil {
+				v, err := d.Str()
+				s.UserUUID = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"jobs\"")
+				return errors.Wrap(err, "decode field \"user_uuid\"")
+			}
+		case "email":
+			if err := func() error {
+				s.Email.Reset()
+				if err := s.Email.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email\"")
+			}
+		case "role":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Role.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"role\"")
+			}
+		case "created_at":
+			if err := func() error {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
 		default:
-			return d.Skip()
+			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode WorkerJobsListOK")
+		return errors.Wrap(err, "decode WorkspaceMember")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000101,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -14433,8 +19992,8 @@ func (s *WorkerJobsListOK) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfWorkerJobsListOK) {
-					name = jsonFieldsNameOfWorkerJobsListOK[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfWorkspaceMember) {
+					name = jsonFieldsNameOfWorkspaceMember[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -14455,14 +20014,60 @@ func (s *WorkerJobsListOK) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *WorkerJobsListOK) MarshalJSON() ([]byte, error) {
+func (s *WorkspaceMember) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *WorkerJobsListOK) UnmarshalJSON(data []byte) error {
+func (s *WorkspaceMember) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes WorkspaceMemberRole as json.
+func (s WorkspaceMemberRole) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes WorkspaceMemberRole from json.
+func (s *WorkspaceMemberRole) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode WorkspaceMemberRole to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch WorkspaceMemberRole(v) {
+	case WorkspaceMemberRoleOwner:
+		*s = WorkspaceMemberRoleOwner
+	case WorkspaceMemberRoleAdmin:
+		*s = WorkspaceMemberRoleAdmin
+	case WorkspaceMemberRoleOperator:
+		*s = WorkspaceMemberRoleOperator
+	case WorkspaceMemberRoleReadonly:
+		*s = WorkspaceMemberRoleReadonly
+	case WorkspaceMemberRoleAPIClient:
+		*s = WorkspaceMemberRoleAPIClient
+	default:
+		*s = WorkspaceMemberRole(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s WorkspaceMemberRole) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *WorkspaceMemberRole) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -6,11 +6,22 @@ package api
 type OperationName = string
 
 const (
+	ApikeyCreateOperation               OperationName = "ApikeyCreate"
+	ApikeyListOperation                 OperationName = "ApikeyList"
+	ApikeyRevokeOperation               OperationName = "ApikeyRevoke"
+	AuditExportOperation                OperationName = "AuditExport"
+	AuditListOperation                  OperationName = "AuditList"
 	CreateContactOperation              OperationName = "CreateContact"
 	CreateUserOperation                 OperationName = "CreateUser"
 	DatasourceEmailCreateOperation      OperationName = "DatasourceEmailCreate"
 	DatasourceEmailDeleteOperation      OperationName = "DatasourceEmailDelete"
 	DatasourceEmailGetOperation         OperationName = "DatasourceEmailGet"
+	DatasourceEmailGraphCreateOperation OperationName = "DatasourceEmailGraphCreate"
+	DatasourceEmailGraphDeleteOperation OperationName = "DatasourceEmailGraphDelete"
+	DatasourceEmailGraphGetOperation    OperationName = "DatasourceEmailGraphGet"
+	DatasourceEmailGraphListOperation   OperationName = "DatasourceEmailGraphList"
+	DatasourceEmailGraphSendOperation   OperationName = "DatasourceEmailGraphSend"
+	DatasourceEmailGraphUpdateOperation OperationName = "DatasourceEmailGraphUpdate"
 	DatasourceEmailListOperation        OperationName = "DatasourceEmailList"
 	DatasourceEmailOAuthCreateOperation OperationName = "DatasourceEmailOAuthCreate"
 	DatasourceEmailOAuthDeleteOperation OperationName = "DatasourceEmailOAuthDelete"
@@ -37,6 +48,9 @@ const (
 	DatasourceWhatsappUpdateOperation   OperationName = "DatasourceWhatsappUpdate"
 	DeleteContactOperation              OperationName = "DeleteContact"
 	DeleteUserOperation                 OperationName = "DeleteUser"
+	ErasureCreateOperation              OperationName = "ErasureCreate"
+	ErasureListOperation                OperationName = "ErasureList"
+	EventListOperation                  OperationName = "EventList"
 	FileCreateOperation                 OperationName = "FileCreate"
 	FileDeleteOperation                 OperationName = "FileDelete"
 	FileGetOperation                    OperationName = "FileGet"
@@ -62,23 +76,41 @@ const (
 	OAuth2ClientLoginOperation          OperationName = "OAuth2ClientLogin"
 	OAuth2ClientTokenDeleteOperation    OperationName = "OAuth2ClientTokenDelete"
 	OAuth2ClientTokenListOperation      OperationName = "OAuth2ClientTokenList"
+	OAuth2ClientTokenRefreshOperation   OperationName = "OAuth2ClientTokenRefresh"
 	OAuth2ClientUpdateOperation         OperationName = "OAuth2ClientUpdate"
+	OAuth2ProviderListOperation         OperationName = "OAuth2ProviderList"
 	PipelineCreateOperation             OperationName = "PipelineCreate"
 	PipelineDeleteOperation             OperationName = "PipelineDelete"
 	PipelineGetOperation                OperationName = "PipelineGet"
 	PipelineListOperation               OperationName = "PipelineList"
+	PipelineRunOperation                OperationName = "PipelineRun"
 	PipelineUpdateOperation             OperationName = "PipelineUpdate"
+	PolicyCreateOperation               OperationName = "PolicyCreate"
+	PolicyDeleteOperation               OperationName = "PolicyDelete"
+	PolicyGetOperation                  OperationName = "PolicyGet"
+	PolicyListOperation                 OperationName = "PolicyList"
+	PolicyUpdateOperation               OperationName = "PolicyUpdate"
+	RetentionPolicyCreateOperation      OperationName = "RetentionPolicyCreate"
+	RetentionPolicyDeleteOperation      OperationName = "RetentionPolicyDelete"
+	RetentionPolicyGetOperation         OperationName = "RetentionPolicyGet"
+	RetentionPolicyListOperation        OperationName = "RetentionPolicyList"
+	RetentionPolicyRunOperation         OperationName = "RetentionPolicyRun"
+	RetentionPolicyUpdateOperation      OperationName = "RetentionPolicyUpdate"
 	SchedulerCreateOperation            OperationName = "SchedulerCreate"
 	SchedulerDeleteOperation            OperationName = "SchedulerDelete"
 	SchedulerGetOperation               OperationName = "SchedulerGet"
 	SchedulerListOperation              OperationName = "SchedulerList"
 	SchedulerUpdateOperation            OperationName = "SchedulerUpdate"
+	SessionRevokeOperation              OperationName = "SessionRevoke"
+	SessionRevokeAllOperation           OperationName = "SessionRevokeAll"
 	SessionStatusOperation              OperationName = "SessionStatus"
 	StorageHostfilesCreateOperation     OperationName = "StorageHostfilesCreate"
 	StorageHostfilesDeleteOperation     OperationName = "StorageHostfilesDelete"
 	StorageHostfilesGetOperation        OperationName = "StorageHostfilesGet"
 	StorageHostfilesUpdateOperation     OperationName = "StorageHostfilesUpdate"
 	StorageListOperation                OperationName = "StorageList"
+	StorageMigrateOperation             OperationName = "StorageMigrate"
+	StorageMigrationListOperation       OperationName = "StorageMigrationList"
 	StoragePostgresCreateOperation      OperationName = "StoragePostgresCreate"
 	StoragePostgresDeleteOperation      OperationName = "StoragePostgresDelete"
 	StoragePostgresGetOperation         OperationName = "StoragePostgresGet"
@@ -99,8 +131,22 @@ const (
 	UpdateProfileOperation              OperationName = "UpdateProfile"
 	UpdateUserOperation                 OperationName = "UpdateUser"
 	UploadFileOperation                 OperationName = "UploadFile"
+	WebhookCreateOperation              OperationName = "WebhookCreate"
+	WebhookDeleteOperation              OperationName = "WebhookDelete"
+	WebhookGetOperation                 OperationName = "WebhookGet"
+	WebhookListOperation                OperationName = "WebhookList"
+	WebhookUpdateOperation              OperationName = "WebhookUpdate"
 	WorkerJobsCancelOperation           OperationName = "WorkerJobsCancel"
 	WorkerJobsDeleteOperation           OperationName = "WorkerJobsDelete"
 	WorkerJobsGetOperation              OperationName = "WorkerJobsGet"
 	WorkerJobsListOperation             OperationName = "WorkerJobsList"
+	WorkerJobsRetryOperation            OperationName = "WorkerJobsRetry"
+	WorkspaceCreateOperation            OperationName = "WorkspaceCreate"
+	WorkspaceDeleteOperation            OperationName = "WorkspaceDelete"
+	WorkspaceGetOperation               OperationName = "WorkspaceGet"
+	WorkspaceListOperation              OperationName = "WorkspaceList"
+	WorkspaceMemberDeleteOperation      OperationName = "WorkspaceMemberDelete"
+	WorkspaceMemberListOperation        OperationName = "WorkspaceMemberList"
+	WorkspaceMemberSetOperation         OperationName = "WorkspaceMemberSet"
+	WorkspaceUpdateOperation            OperationName = "WorkspaceUpdate"
 )
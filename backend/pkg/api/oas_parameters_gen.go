@@ -5,6 +5,7 @@ package api
 import (
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/google/uuid"
@@ -16,176 +17,96 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
-// DatasourceEmailDeleteParams is parameters of datasource-email-delete operation.
-type DatasourceEmailDeleteParams struct {
-	// UUID of the email datasource.
-	UUID string
+// ApikeyListParams is parameters of apikey-list operation.
+type ApikeyListParams struct {
+	// Include revoked keys.
+	IncludeRevoked OptBool
+	// Offset records.
+	Offset OptInt32
+	// Limit records.
+	Limit OptInt32
 }
 
-func unpackDatasourceEmailDeleteParams(packed middleware.Parameters) (params DatasourceEmailDeleteParams) {
+func unpackApikeyListParams(packed middleware.Parameters) (params ApikeyListParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "uuid",
-			In:   "path",
+			Name: "include_revoked",
+			In:   "query",
 		}
-		params.UUID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeDatasourceEmailDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceEmailDeleteParams, _ error) {
-	// Decode path: uuid.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		if v, ok := packed[key]; ok {
+			params.IncludeRevoked = v.(OptBool)
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "uuid",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.UUID = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "uuid",
-			In:   "path",
-			Err:  err,
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
 		}
 	}
-	return params, nil
-}
-
-// DatasourceEmailGetParams is parameters of datasource-email-get operation.
-type DatasourceEmailGetParams struct {
-	// UUID of the email datasource.
-	UUID string
-}
-
-func unpackDatasourceEmailGetParams(packed middleware.Parameters) (params DatasourceEmailGetParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "uuid",
-			In:   "path",
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
 		}
-		params.UUID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeDatasourceEmailGetParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceEmailGetParams, _ error) {
-	// Decode path: uuid.
+func decodeApikeyListParams(args [0]string, argsEscaped bool, r *http.Request) (params ApikeyListParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: include_revoked.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "include_revoked",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "uuid",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIncludeRevokedVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIncludeRevokedVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.UUID = c
+				params.IncludeRevoked.SetTo(paramsDotIncludeRevokedVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "uuid",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// DatasourceEmailListParams is parameters of datasource-email-list operation.
-type DatasourceEmailListParams struct {
-	// Offset records.
-	Offset OptInt32
-	// Limit records.
-	Limit OptInt32
-}
-
-func unpackDatasourceEmailListParams(packed middleware.Parameters) (params DatasourceEmailListParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt32)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
+			Name: "include_revoked",
 			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt32)
+			Err:  err,
 		}
 	}
-	return params
-}
-
-func decodeDatasourceEmailListParams(args [0]string, argsEscaped bool, r *http.Request) (params DatasourceEmailListParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode query: offset.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
 			Name:    "offset",
 			Style:   uri.QueryStyleForm,
-			Explode: false,
+			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
@@ -226,7 +147,7 @@ func decodeDatasourceEmailListParams(args [0]string, argsEscaped bool, r *http.R
 		cfg := uri.QueryParameterDecodingConfig{
 			Name:    "limit",
 			Style:   uri.QueryStyleForm,
-			Explode: false,
+			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
@@ -265,13 +186,12 @@ func decodeDatasourceEmailListParams(args [0]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// DatasourceEmailOAuthDeleteParams is parameters of datasource-email-oauth-delete operation.
-type DatasourceEmailOAuthDeleteParams struct {
-	// UUID of the email OAuth datasource.
+// ApikeyRevokeParams is parameters of apikey-revoke operation.
+type ApikeyRevokeParams struct {
 	UUID string
 }
 
-func unpackDatasourceEmailOAuthDeleteParams(packed middleware.Parameters) (params DatasourceEmailOAuthDeleteParams) {
+func unpackApikeyRevokeParams(packed middleware.Parameters) (params ApikeyRevokeParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -282,7 +202,7 @@ func unpackDatasourceEmailOAuthDeleteParams(packed middleware.Parameters) (param
 	return params
 }
 
-func decodeDatasourceEmailOAuthDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceEmailOAuthDeleteParams, _ error) {
+func decodeApikeyRevokeParams(args [1]string, argsEscaped bool, r *http.Request) (params ApikeyRevokeParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -331,132 +251,132 @@ func decodeDatasourceEmailOAuthDeleteParams(args [1]string, argsEscaped bool, r
 	return params, nil
 }
 
-// DatasourceEmailOAuthGetParams is parameters of datasource-email-oauth-get operation.
-type DatasourceEmailOAuthGetParams struct {
-	// UUID of the email OAuth datasource.
-	UUID string
-}
-
-func unpackDatasourceEmailOAuthGetParams(packed middleware.Parameters) (params DatasourceEmailOAuthGetParams) {
+// AuditExportParams is parameters of audit-export operation.
+type AuditExportParams struct {
+	// Actor, a user UUID, "0" or apikey:<uuid>.
+	Actor OptString
+	// Identity source, session, bearer or api_key.
+	Source OptString
+	// API operation id, e.g. datasource-email-create.
+	OperationID OptString
+	// Resource kind, e.g. datasource.
+	ResourceKind OptString
+	// UUID or id of the resource.
+	ResourceID OptString
+	// Outcome of the operation.
+	Outcome OptAuditExportOutcome
+	// Events at or after this time.
+	Since OptDateTime
+	// Events before this time.
+	Until OptDateTime
+}
+
+func unpackAuditExportParams(packed middleware.Parameters) (params AuditExportParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "uuid",
-			In:   "path",
-		}
-		params.UUID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeDatasourceEmailOAuthGetParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceEmailOAuthGetParams, _ error) {
-	// Decode path: uuid.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "uuid",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.UUID = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
+			Name: "actor",
+			In:   "query",
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "uuid",
-			In:   "path",
-			Err:  err,
+		if v, ok := packed[key]; ok {
+			params.Actor = v.(OptString)
 		}
 	}
-	return params, nil
-}
-
-// DatasourceEmailOAuthListParams is parameters of datasource-email-oauth-list operation.
-type DatasourceEmailOAuthListParams struct {
-	// Offset records.
-	Offset OptInt32
-	// Limit records.
-	Limit OptInt32
-}
-
-func unpackDatasourceEmailOAuthListParams(packed middleware.Parameters) (params DatasourceEmailOAuthListParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "offset",
+			Name: "source",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt32)
+			params.Source = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
+			Name: "operation_id",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt32)
+			params.OperationID = v.(OptString)
 		}
 	}
-	return params
+	{
+		key := middleware.ParameterKey{
+			Name: "resource_kind",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ResourceKind = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "resource_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ResourceID = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "outcome",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Outcome = v.(OptAuditExportOutcome)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "since",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Since = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "until",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Until = v.(OptDateTime)
+		}
+	}
+	return params
 }
 
-func decodeDatasourceEmailOAuthListParams(args [0]string, argsEscaped bool, r *http.Request) (params DatasourceEmailOAuthListParams, _ error) {
+func decodeAuditExportParams(args [0]string, argsEscaped bool, r *http.Request) (params AuditExportParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: offset.
+	// Decode query: actor.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
+			Name:    "actor",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int32
+				var paramsDotActorVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt32(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotOffsetVal = c
+					paramsDotActorVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+				params.Actor.SetTo(paramsDotActorVal)
 				return nil
 			}); err != nil {
 				return err
@@ -465,39 +385,39 @@ func decodeDatasourceEmailOAuthListParams(args [0]string, argsEscaped bool, r *h
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
+			Name: "actor",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: limit.
+	// Decode query: source.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "source",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int32
+				var paramsDotSourceVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt32(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotLimitVal = c
+					paramsDotSourceVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+				params.Source.SetTo(paramsDotSourceVal)
 				return nil
 			}); err != nil {
 				return err
@@ -506,287 +426,372 @@ func decodeDatasourceEmailOAuthListParams(args [0]string, argsEscaped bool, r *h
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "source",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// DatasourceEmailOAuthUpdateParams is parameters of datasource-email-oauth-update operation.
-type DatasourceEmailOAuthUpdateParams struct {
-	// UUID of the email OAuth datasource.
-	UUID string
-}
-
-func unpackDatasourceEmailOAuthUpdateParams(packed middleware.Parameters) (params DatasourceEmailOAuthUpdateParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "uuid",
-			In:   "path",
-		}
-		params.UUID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeDatasourceEmailOAuthUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceEmailOAuthUpdateParams, _ error) {
-	// Decode path: uuid.
+	// Decode query: operation_id.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "operation_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "uuid",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOperationIDVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOperationIDVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.UUID = c
+				params.OperationID.SetTo(paramsDotOperationIDVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "uuid",
-			In:   "path",
+			Name: "operation_id",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// DatasourceEmailUpdateParams is parameters of datasource-email-update operation.
-type DatasourceEmailUpdateParams struct {
-	// UUID of the datasource.
-	UUID string
-}
-
-func unpackDatasourceEmailUpdateParams(packed middleware.Parameters) (params DatasourceEmailUpdateParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "uuid",
-			In:   "path",
-		}
-		params.UUID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeDatasourceEmailUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceEmailUpdateParams, _ error) {
-	// Decode path: uuid.
+	// Decode query: resource_kind.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "resource_kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "uuid",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotResourceKindVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotResourceKindVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.UUID = c
+				params.ResourceKind.SetTo(paramsDotResourceKindVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "uuid",
-			In:   "path",
+			Name: "resource_kind",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// DatasourceLinkedinDeleteParams is parameters of datasource-linkedin-delete operation.
-type DatasourceLinkedinDeleteParams struct {
-	// UUID of the LinkedIn datasource.
-	UUID string
-}
-
-func unpackDatasourceLinkedinDeleteParams(packed middleware.Parameters) (params DatasourceLinkedinDeleteParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "uuid",
-			In:   "path",
-		}
-		params.UUID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeDatasourceLinkedinDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceLinkedinDeleteParams, _ error) {
-	// Decode path: uuid.
+	// Decode query: resource_id.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "resource_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "uuid",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotResourceIDVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotResourceIDVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.UUID = c
+				params.ResourceID.SetTo(paramsDotResourceIDVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "uuid",
-			In:   "path",
+			Name: "resource_id",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
+	// Decode query: outcome.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "outcome",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-// DatasourceLinkedinGetParams is parameters of datasource-linkedin-get operation.
-type DatasourceLinkedinGetParams struct {
-	// UUID of the LinkedIn datasource.
-	UUID string
-}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOutcomeVal AuditExportOutcome
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-func unpackDatasourceLinkedinGetParams(packed middleware.Parameters) (params DatasourceLinkedinGetParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "uuid",
-			In:   "path",
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOutcomeVal = AuditExportOutcome(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Outcome.SetTo(paramsDotOutcomeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Outcome.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "outcome",
+			In:   "query",
+			Err:  err,
 		}
-		params.UUID = packed[key].(string)
 	}
-	return params
-}
-
-func decodeDatasourceLinkedinGetParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceLinkedinGetParams, _ error) {
-	// Decode path: uuid.
+	// Decode query: since.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "uuid",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSinceVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSinceVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
+				params.Since.SetTo(paramsDotSinceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "since",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: until.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "until",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUntilVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUntilVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.UUID = c
+				params.Until.SetTo(paramsDotUntilVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "uuid",
-			In:   "path",
+			Name: "until",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// DatasourceLinkedinListParams is parameters of datasource-linkedin-list operation.
-type DatasourceLinkedinListParams struct {
+// AuditListParams is parameters of audit-list operation.
+type AuditListParams struct {
+	// Actor, a user UUID, "0" or apikey:<uuid>.
+	Actor OptString
+	// Identity source, session, bearer or api_key.
+	Source OptString
+	// API operation id, e.g. datasource-email-create.
+	OperationID OptString
+	// Resource kind, e.g. datasource.
+	ResourceKind OptString
+	// UUID or id of the resource.
+	ResourceID OptString
+	// Outcome of the operation.
+	Outcome OptAuditListOutcome
+	// Events at or after this time.
+	Since OptDateTime
+	// Events before this time.
+	Until OptDateTime
 	// Offset records.
 	Offset OptInt32
 	// Limit records.
 	Limit OptInt32
 }
 
-func unpackDatasourceLinkedinListParams(packed middleware.Parameters) (params DatasourceLinkedinListParams) {
+func unpackAuditListParams(packed middleware.Parameters) (params AuditListParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "actor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Actor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "source",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Source = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "operation_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OperationID = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "resource_kind",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ResourceKind = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "resource_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ResourceID = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "outcome",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Outcome = v.(OptAuditListOutcome)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "since",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Since = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "until",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Until = v.(OptDateTime)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "offset",
@@ -808,36 +813,36 @@ func unpackDatasourceLinkedinListParams(packed middleware.Parameters) (params Da
 	return params
 }
 
-func decodeDatasourceLinkedinListParams(args [0]string, argsEscaped bool, r *http.Request) (params DatasourceLinkedinListParams, _ error) {
+func decodeAuditListParams(args [0]string, argsEscaped bool, r *http.Request) (params AuditListParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: offset.
+	// Decode query: actor.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
+			Name:    "actor",
 			Style:   uri.QueryStyleForm,
-			Explode: false,
+			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int32
+				var paramsDotActorVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt32(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotOffsetVal = c
+					paramsDotActorVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+				params.Actor.SetTo(paramsDotActorVal)
 				return nil
 			}); err != nil {
 				return err
@@ -846,39 +851,39 @@ func decodeDatasourceLinkedinListParams(args [0]string, argsEscaped bool, r *htt
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
+			Name: "actor",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: limit.
+	// Decode query: source.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "source",
 			Style:   uri.QueryStyleForm,
-			Explode: false,
+			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int32
+				var paramsDotSourceVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt32(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotLimitVal = c
+					paramsDotSourceVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+				params.Source.SetTo(paramsDotSourceVal)
 				return nil
 			}); err != nil {
 				return err
@@ -887,118 +892,278 @@ func decodeDatasourceLinkedinListParams(args [0]string, argsEscaped bool, r *htt
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "source",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
+	// Decode query: operation_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "operation_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-// DatasourceLinkedinUpdateParams is parameters of datasource-linkedin-update operation.
-type DatasourceLinkedinUpdateParams struct {
-	// UUID of the datasource.
-	UUID string
-}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOperationIDVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-func unpackDatasourceLinkedinUpdateParams(packed middleware.Parameters) (params DatasourceLinkedinUpdateParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "uuid",
-			In:   "path",
-		}
-		params.UUID = packed[key].(string)
-	}
-	return params
-}
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
 
-func decodeDatasourceLinkedinUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceLinkedinUpdateParams, _ error) {
-	// Decode path: uuid.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
+					paramsDotOperationIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.OperationID.SetTo(paramsDotOperationIDVal)
+				return nil
+			}); err != nil {
+				return err
 			}
-			param = unescaped
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "uuid",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "operation_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: resource_kind.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "resource_kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotResourceKindVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotResourceKindVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
+				params.ResourceKind.SetTo(paramsDotResourceKindVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "resource_kind",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: resource_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "resource_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotResourceIDVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotResourceIDVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
+				params.ResourceID.SetTo(paramsDotResourceIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "resource_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: outcome.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "outcome",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-				params.UUID = c
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOutcomeVal AuditListOutcome
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOutcomeVal = AuditListOutcome(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Outcome.SetTo(paramsDotOutcomeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Outcome.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "uuid",
-			In:   "path",
+			Name: "outcome",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
+	// Decode query: since.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-// DatasourceListParams is parameters of datasource-list operation.
-type DatasourceListParams struct {
-	// The number of records to skip for pagination.
-	Offset OptInt32
-	// The maximum number of records to return.
-	Limit OptInt32
-}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSinceVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-func unpackDatasourceListParams(packed middleware.Parameters) (params DatasourceListParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSinceVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Since.SetTo(paramsDotSinceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
 		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt32)
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "since",
+			In:   "query",
+			Err:  err,
 		}
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
+	// Decode query: until.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "until",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt32)
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUntilVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUntilVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Until.SetTo(paramsDotUntilVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "until",
+			In:   "query",
+			Err:  err,
 		}
 	}
-	return params
-}
-
-func decodeDatasourceListParams(args [0]string, argsEscaped bool, r *http.Request) (params DatasourceListParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode query: offset.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
 			Name:    "offset",
 			Style:   uri.QueryStyleForm,
-			Explode: false,
+			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
@@ -1039,7 +1204,7 @@ func decodeDatasourceListParams(args [0]string, argsEscaped bool, r *http.Reques
 		cfg := uri.QueryParameterDecodingConfig{
 			Name:    "limit",
 			Style:   uri.QueryStyleForm,
-			Explode: false,
+			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
@@ -1078,13 +1243,13 @@ func decodeDatasourceListParams(args [0]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// DatasourceSetOAuth2ClientParams is parameters of datasource-set-oauth2-client operation.
-type DatasourceSetOAuth2ClientParams struct {
+// DatasourceEmailDeleteParams is parameters of datasource-email-delete operation.
+type DatasourceEmailDeleteParams struct {
 	// UUID of the email datasource.
 	UUID string
 }
 
-func unpackDatasourceSetOAuth2ClientParams(packed middleware.Parameters) (params DatasourceSetOAuth2ClientParams) {
+func unpackDatasourceEmailDeleteParams(packed middleware.Parameters) (params DatasourceEmailDeleteParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -1095,7 +1260,7 @@ func unpackDatasourceSetOAuth2ClientParams(packed middleware.Parameters) (params
 	return params
 }
 
-func decodeDatasourceSetOAuth2ClientParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceSetOAuth2ClientParams, _ error) {
+func decodeDatasourceEmailDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceEmailDeleteParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -1144,13 +1309,13 @@ func decodeDatasourceSetOAuth2ClientParams(args [1]string, argsEscaped bool, r *
 	return params, nil
 }
 
-// DatasourceTelegramDeleteParams is parameters of datasource-telegram-delete operation.
-type DatasourceTelegramDeleteParams struct {
-	// UUID of the Telegram datasource.
+// DatasourceEmailGetParams is parameters of datasource-email-get operation.
+type DatasourceEmailGetParams struct {
+	// UUID of the email datasource.
 	UUID string
 }
 
-func unpackDatasourceTelegramDeleteParams(packed middleware.Parameters) (params DatasourceTelegramDeleteParams) {
+func unpackDatasourceEmailGetParams(packed middleware.Parameters) (params DatasourceEmailGetParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -1161,7 +1326,7 @@ func unpackDatasourceTelegramDeleteParams(packed middleware.Parameters) (params
 	return params
 }
 
-func decodeDatasourceTelegramDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceTelegramDeleteParams, _ error) {
+func decodeDatasourceEmailGetParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceEmailGetParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -1210,13 +1375,13 @@ func decodeDatasourceTelegramDeleteParams(args [1]string, argsEscaped bool, r *h
 	return params, nil
 }
 
-// DatasourceTelegramGetParams is parameters of datasource-telegram-get operation.
-type DatasourceTelegramGetParams struct {
-	// UUID of the Telegram datasource.
+// DatasourceEmailGraphDeleteParams is parameters of datasource-email-graph-delete operation.
+type DatasourceEmailGraphDeleteParams struct {
+	// UUID of the Microsoft Graph mail datasource.
 	UUID string
 }
 
-func unpackDatasourceTelegramGetParams(packed middleware.Parameters) (params DatasourceTelegramGetParams) {
+func unpackDatasourceEmailGraphDeleteParams(packed middleware.Parameters) (params DatasourceEmailGraphDeleteParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -1227,7 +1392,7 @@ func unpackDatasourceTelegramGetParams(packed middleware.Parameters) (params Dat
 	return params
 }
 
-func decodeDatasourceTelegramGetParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceTelegramGetParams, _ error) {
+func decodeDatasourceEmailGraphDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceEmailGraphDeleteParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -1276,15 +1441,81 @@ func decodeDatasourceTelegramGetParams(args [1]string, argsEscaped bool, r *http
 	return params, nil
 }
 
-// DatasourceTelegramListParams is parameters of datasource-telegram-list operation.
-type DatasourceTelegramListParams struct {
+// DatasourceEmailGraphGetParams is parameters of datasource-email-graph-get operation.
+type DatasourceEmailGraphGetParams struct {
+	// UUID of the Microsoft Graph mail datasource.
+	UUID string
+}
+
+func unpackDatasourceEmailGraphGetParams(packed middleware.Parameters) (params DatasourceEmailGraphGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeDatasourceEmailGraphGetParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceEmailGraphGetParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DatasourceEmailGraphListParams is parameters of datasource-email-graph-list operation.
+type DatasourceEmailGraphListParams struct {
 	// Offset records.
 	Offset OptInt32
 	// Limit records.
 	Limit OptInt32
 }
 
-func unpackDatasourceTelegramListParams(packed middleware.Parameters) (params DatasourceTelegramListParams) {
+func unpackDatasourceEmailGraphListParams(packed middleware.Parameters) (params DatasourceEmailGraphListParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "offset",
@@ -1306,14 +1537,14 @@ func unpackDatasourceTelegramListParams(packed middleware.Parameters) (params Da
 	return params
 }
 
-func decodeDatasourceTelegramListParams(args [0]string, argsEscaped bool, r *http.Request) (params DatasourceTelegramListParams, _ error) {
+func decodeDatasourceEmailGraphListParams(args [0]string, argsEscaped bool, r *http.Request) (params DatasourceEmailGraphListParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode query: offset.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
 			Name:    "offset",
 			Style:   uri.QueryStyleForm,
-			Explode: false,
+			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
@@ -1354,7 +1585,7 @@ func decodeDatasourceTelegramListParams(args [0]string, argsEscaped bool, r *htt
 		cfg := uri.QueryParameterDecodingConfig{
 			Name:    "limit",
 			Style:   uri.QueryStyleForm,
-			Explode: false,
+			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
@@ -1393,79 +1624,13 @@ func decodeDatasourceTelegramListParams(args [0]string, argsEscaped bool, r *htt
 	return params, nil
 }
 
-// DatasourceTelegramUpdateParams is parameters of datasource-telegram-update operation.
-type DatasourceTelegramUpdateParams struct {
-	// UUID of the datasource.
-	UUID string
-}
-
-func unpackDatasourceTelegramUpdateParams(packed middleware.Parameters) (params DatasourceTelegramUpdateParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "uuid",
-			In:   "path",
-		}
-		params.UUID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeDatasourceTelegramUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceTelegramUpdateParams, _ error) {
-	// Decode path: uuid.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "uuid",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.UUID = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "uuid",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// DatasourceWhatsappDeleteParams is parameters of datasource-whatsapp-delete operation.
-type DatasourceWhatsappDeleteParams struct {
-	// UUID of the WhatsApp datasource.
+// DatasourceEmailGraphSendParams is parameters of datasource-email-graph-send operation.
+type DatasourceEmailGraphSendParams struct {
+	// UUID of the Microsoft Graph mail datasource.
 	UUID string
 }
 
-func unpackDatasourceWhatsappDeleteParams(packed middleware.Parameters) (params DatasourceWhatsappDeleteParams) {
+func unpackDatasourceEmailGraphSendParams(packed middleware.Parameters) (params DatasourceEmailGraphSendParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -1476,7 +1641,7 @@ func unpackDatasourceWhatsappDeleteParams(packed middleware.Parameters) (params
 	return params
 }
 
-func decodeDatasourceWhatsappDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceWhatsappDeleteParams, _ error) {
+func decodeDatasourceEmailGraphSendParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceEmailGraphSendParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -1525,13 +1690,13 @@ func decodeDatasourceWhatsappDeleteParams(args [1]string, argsEscaped bool, r *h
 	return params, nil
 }
 
-// DatasourceWhatsappGetParams is parameters of datasource-whatsapp-get operation.
-type DatasourceWhatsappGetParams struct {
-	// UUID of the WhatsApp datasource.
+// DatasourceEmailGraphUpdateParams is parameters of datasource-email-graph-update operation.
+type DatasourceEmailGraphUpdateParams struct {
+	// UUID of the Microsoft Graph mail datasource.
 	UUID string
 }
 
-func unpackDatasourceWhatsappGetParams(packed middleware.Parameters) (params DatasourceWhatsappGetParams) {
+func unpackDatasourceEmailGraphUpdateParams(packed middleware.Parameters) (params DatasourceEmailGraphUpdateParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -1542,7 +1707,7 @@ func unpackDatasourceWhatsappGetParams(packed middleware.Parameters) (params Dat
 	return params
 }
 
-func decodeDatasourceWhatsappGetParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceWhatsappGetParams, _ error) {
+func decodeDatasourceEmailGraphUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceEmailGraphUpdateParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -1591,15 +1756,15 @@ func decodeDatasourceWhatsappGetParams(args [1]string, argsEscaped bool, r *http
 	return params, nil
 }
 
-// DatasourceWhatsappListParams is parameters of datasource-whatsapp-list operation.
-type DatasourceWhatsappListParams struct {
+// DatasourceEmailListParams is parameters of datasource-email-list operation.
+type DatasourceEmailListParams struct {
 	// Offset records.
 	Offset OptInt32
 	// Limit records.
 	Limit OptInt32
 }
 
-func unpackDatasourceWhatsappListParams(packed middleware.Parameters) (params DatasourceWhatsappListParams) {
+func unpackDatasourceEmailListParams(packed middleware.Parameters) (params DatasourceEmailListParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "offset",
@@ -1621,7 +1786,7 @@ func unpackDatasourceWhatsappListParams(packed middleware.Parameters) (params Da
 	return params
 }
 
-func decodeDatasourceWhatsappListParams(args [0]string, argsEscaped bool, r *http.Request) (params DatasourceWhatsappListParams, _ error) {
+func decodeDatasourceEmailListParams(args [0]string, argsEscaped bool, r *http.Request) (params DatasourceEmailListParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode query: offset.
 	if err := func() error {
@@ -1708,13 +1873,13 @@ func decodeDatasourceWhatsappListParams(args [0]string, argsEscaped bool, r *htt
 	return params, nil
 }
 
-// DatasourceWhatsappUpdateParams is parameters of datasource-whatsapp-update operation.
-type DatasourceWhatsappUpdateParams struct {
-	// UUID of the datasource.
+// DatasourceEmailOAuthDeleteParams is parameters of datasource-email-oauth-delete operation.
+type DatasourceEmailOAuthDeleteParams struct {
+	// UUID of the email OAuth datasource.
 	UUID string
 }
 
-func unpackDatasourceWhatsappUpdateParams(packed middleware.Parameters) (params DatasourceWhatsappUpdateParams) {
+func unpackDatasourceEmailOAuthDeleteParams(packed middleware.Parameters) (params DatasourceEmailOAuthDeleteParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -1725,7 +1890,7 @@ func unpackDatasourceWhatsappUpdateParams(packed middleware.Parameters) (params
 	return params
 }
 
-func decodeDatasourceWhatsappUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceWhatsappUpdateParams, _ error) {
+func decodeDatasourceEmailOAuthDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceEmailOAuthDeleteParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -1774,12 +1939,13 @@ func decodeDatasourceWhatsappUpdateParams(args [1]string, argsEscaped bool, r *h
 	return params, nil
 }
 
-// DeleteContactParams is parameters of deleteContact operation.
-type DeleteContactParams struct {
+// DatasourceEmailOAuthGetParams is parameters of datasource-email-oauth-get operation.
+type DatasourceEmailOAuthGetParams struct {
+	// UUID of the email OAuth datasource.
 	UUID string
 }
 
-func unpackDeleteContactParams(packed middleware.Parameters) (params DeleteContactParams) {
+func unpackDatasourceEmailOAuthGetParams(packed middleware.Parameters) (params DatasourceEmailOAuthGetParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -1790,7 +1956,7 @@ func unpackDeleteContactParams(packed middleware.Parameters) (params DeleteConta
 	return params
 }
 
-func decodeDeleteContactParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteContactParams, _ error) {
+func decodeDatasourceEmailOAuthGetParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceEmailOAuthGetParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -1839,78 +2005,130 @@ func decodeDeleteContactParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// DeleteUserParams is parameters of deleteUser operation.
-type DeleteUserParams struct {
-	UUID string
+// DatasourceEmailOAuthListParams is parameters of datasource-email-oauth-list operation.
+type DatasourceEmailOAuthListParams struct {
+	// Offset records.
+	Offset OptInt32
+	// Limit records.
+	Limit OptInt32
 }
 
-func unpackDeleteUserParams(packed middleware.Parameters) (params DeleteUserParams) {
+func unpackDatasourceEmailOAuthListParams(packed middleware.Parameters) (params DatasourceEmailOAuthListParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "uuid",
-			In:   "path",
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
 		}
-		params.UUID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeDeleteUserParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteUserParams, _ error) {
-	// Decode path: uuid.
+func decodeDatasourceEmailOAuthListParams(args [0]string, argsEscaped bool, r *http.Request) (params DatasourceEmailOAuthListParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: offset.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "uuid",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.UUID = c
+				params.Offset.SetTo(paramsDotOffsetVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "uuid",
-			In:   "path",
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// FileDeleteParams is parameters of file-delete operation.
-type FileDeleteParams struct {
-	// UUID of the file.
+// DatasourceEmailOAuthUpdateParams is parameters of datasource-email-oauth-update operation.
+type DatasourceEmailOAuthUpdateParams struct {
+	// UUID of the email OAuth datasource.
 	UUID string
 }
 
-func unpackFileDeleteParams(packed middleware.Parameters) (params FileDeleteParams) {
+func unpackDatasourceEmailOAuthUpdateParams(packed middleware.Parameters) (params DatasourceEmailOAuthUpdateParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -1921,7 +2139,7 @@ func unpackFileDeleteParams(packed middleware.Parameters) (params FileDeletePara
 	return params
 }
 
-func decodeFileDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params FileDeleteParams, _ error) {
+func decodeDatasourceEmailOAuthUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceEmailOAuthUpdateParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -1970,13 +2188,13 @@ func decodeFileDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// FileGetParams is parameters of file-get operation.
-type FileGetParams struct {
-	// UUID of the file.
+// DatasourceEmailUpdateParams is parameters of datasource-email-update operation.
+type DatasourceEmailUpdateParams struct {
+	// UUID of the datasource.
 	UUID string
 }
 
-func unpackFileGetParams(packed middleware.Parameters) (params FileGetParams) {
+func unpackDatasourceEmailUpdateParams(packed middleware.Parameters) (params DatasourceEmailUpdateParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -1987,7 +2205,7 @@ func unpackFileGetParams(packed middleware.Parameters) (params FileGetParams) {
 	return params
 }
 
-func decodeFileGetParams(args [1]string, argsEscaped bool, r *http.Request) (params FileGetParams, _ error) {
+func decodeDatasourceEmailUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceEmailUpdateParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -2036,15 +2254,147 @@ func decodeFileGetParams(args [1]string, argsEscaped bool, r *http.Request) (par
 	return params, nil
 }
 
-// FileListParams is parameters of file-list operation.
-type FileListParams struct {
-	// The number of records to skip for pagination.
+// DatasourceLinkedinDeleteParams is parameters of datasource-linkedin-delete operation.
+type DatasourceLinkedinDeleteParams struct {
+	// UUID of the LinkedIn datasource.
+	UUID string
+}
+
+func unpackDatasourceLinkedinDeleteParams(packed middleware.Parameters) (params DatasourceLinkedinDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeDatasourceLinkedinDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceLinkedinDeleteParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DatasourceLinkedinGetParams is parameters of datasource-linkedin-get operation.
+type DatasourceLinkedinGetParams struct {
+	// UUID of the LinkedIn datasource.
+	UUID string
+}
+
+func unpackDatasourceLinkedinGetParams(packed middleware.Parameters) (params DatasourceLinkedinGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeDatasourceLinkedinGetParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceLinkedinGetParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DatasourceLinkedinListParams is parameters of datasource-linkedin-list operation.
+type DatasourceLinkedinListParams struct {
+	// Offset records.
 	Offset OptInt32
-	// The maximum number of records to return.
+	// Limit records.
 	Limit OptInt32
 }
 
-func unpackFileListParams(packed middleware.Parameters) (params FileListParams) {
+func unpackDatasourceLinkedinListParams(packed middleware.Parameters) (params DatasourceLinkedinListParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "offset",
@@ -2066,14 +2416,14 @@ func unpackFileListParams(packed middleware.Parameters) (params FileListParams)
 	return params
 }
 
-func decodeFileListParams(args [0]string, argsEscaped bool, r *http.Request) (params FileListParams, _ error) {
+func decodeDatasourceLinkedinListParams(args [0]string, argsEscaped bool, r *http.Request) (params DatasourceLinkedinListParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode query: offset.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
 			Name:    "offset",
 			Style:   uri.QueryStyleForm,
-			Explode: true,
+			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
@@ -2114,7 +2464,7 @@ func decodeFileListParams(args [0]string, argsEscaped bool, r *http.Request) (pa
 		cfg := uri.QueryParameterDecodingConfig{
 			Name:    "limit",
 			Style:   uri.QueryStyleForm,
-			Explode: true,
+			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
@@ -2153,13 +2503,13 @@ func decodeFileListParams(args [0]string, argsEscaped bool, r *http.Request) (pa
 	return params, nil
 }
 
-// FileUpdateParams is parameters of file-update operation.
-type FileUpdateParams struct {
-	// UUID of the file.
+// DatasourceLinkedinUpdateParams is parameters of datasource-linkedin-update operation.
+type DatasourceLinkedinUpdateParams struct {
+	// UUID of the datasource.
 	UUID string
 }
 
-func unpackFileUpdateParams(packed middleware.Parameters) (params FileUpdateParams) {
+func unpackDatasourceLinkedinUpdateParams(packed middleware.Parameters) (params DatasourceLinkedinUpdateParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -2170,7 +2520,7 @@ func unpackFileUpdateParams(packed middleware.Parameters) (params FileUpdatePara
 	return params
 }
 
-func decodeFileUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params FileUpdateParams, _ error) {
+func decodeDatasourceLinkedinUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceLinkedinUpdateParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -2219,77 +2569,130 @@ func decodeFileUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// GetContactParams is parameters of getContact operation.
-type GetContactParams struct {
-	UUID string
+// DatasourceListParams is parameters of datasource-list operation.
+type DatasourceListParams struct {
+	// The number of records to skip for pagination.
+	Offset OptInt32
+	// The maximum number of records to return.
+	Limit OptInt32
 }
 
-func unpackGetContactParams(packed middleware.Parameters) (params GetContactParams) {
+func unpackDatasourceListParams(packed middleware.Parameters) (params DatasourceListParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "uuid",
-			In:   "path",
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
 		}
-		params.UUID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetContactParams(args [1]string, argsEscaped bool, r *http.Request) (params GetContactParams, _ error) {
-	// Decode path: uuid.
+func decodeDatasourceListParams(args [0]string, argsEscaped bool, r *http.Request) (params DatasourceListParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: offset.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "uuid",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.UUID = c
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "uuid",
-			In:   "path",
+			Name: "limit",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetUserParams is parameters of getUser operation.
-type GetUserParams struct {
+// DatasourceSetOAuth2ClientParams is parameters of datasource-set-oauth2-client operation.
+type DatasourceSetOAuth2ClientParams struct {
+	// UUID of the email datasource.
 	UUID string
 }
 
-func unpackGetUserParams(packed middleware.Parameters) (params GetUserParams) {
+func unpackDatasourceSetOAuth2ClientParams(packed middleware.Parameters) (params DatasourceSetOAuth2ClientParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -2300,7 +2703,7 @@ func unpackGetUserParams(packed middleware.Parameters) (params GetUserParams) {
 	return params
 }
 
-func decodeGetUserParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserParams, _ error) {
+func decodeDatasourceSetOAuth2ClientParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceSetOAuth2ClientParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -2349,130 +2752,13 @@ func decodeGetUserParams(args [1]string, argsEscaped bool, r *http.Request) (par
 	return params, nil
 }
 
-// OAuth2ClientCallbackParams is parameters of oauth2-client-callback operation.
-type OAuth2ClientCallbackParams struct {
-	// State UUID.
-	State OptString
-	// OAuth2 code.
-	Code OptString
-}
-
-func unpackOAuth2ClientCallbackParams(packed middleware.Parameters) (params OAuth2ClientCallbackParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "state",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.State = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "code",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Code = v.(OptString)
-		}
-	}
-	return params
-}
-
-func decodeOAuth2ClientCallbackParams(args [0]string, argsEscaped bool, r *http.Request) (params OAuth2ClientCallbackParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: state.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "state",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStateVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotStateVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.State.SetTo(paramsDotStateVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "state",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: code.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "code",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCodeVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotCodeVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Code.SetTo(paramsDotCodeVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "code",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// OAuth2ClientDeleteParams is parameters of oauth2-client-delete operation.
-type OAuth2ClientDeleteParams struct {
-	// ID of the connection base object.
+// DatasourceTelegramDeleteParams is parameters of datasource-telegram-delete operation.
+type DatasourceTelegramDeleteParams struct {
+	// UUID of the Telegram datasource.
 	UUID string
 }
 
-func unpackOAuth2ClientDeleteParams(packed middleware.Parameters) (params OAuth2ClientDeleteParams) {
+func unpackDatasourceTelegramDeleteParams(packed middleware.Parameters) (params DatasourceTelegramDeleteParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -2483,7 +2769,7 @@ func unpackOAuth2ClientDeleteParams(packed middleware.Parameters) (params OAuth2
 	return params
 }
 
-func decodeOAuth2ClientDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params OAuth2ClientDeleteParams, _ error) {
+func decodeDatasourceTelegramDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceTelegramDeleteParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -2532,13 +2818,13 @@ func decodeOAuth2ClientDeleteParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// OAuth2ClientGetParams is parameters of oauth2-client-get operation.
-type OAuth2ClientGetParams struct {
-	// ClientID of the OAuth2 client.
+// DatasourceTelegramGetParams is parameters of datasource-telegram-get operation.
+type DatasourceTelegramGetParams struct {
+	// UUID of the Telegram datasource.
 	UUID string
 }
 
-func unpackOAuth2ClientGetParams(packed middleware.Parameters) (params OAuth2ClientGetParams) {
+func unpackDatasourceTelegramGetParams(packed middleware.Parameters) (params DatasourceTelegramGetParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -2549,7 +2835,7 @@ func unpackOAuth2ClientGetParams(packed middleware.Parameters) (params OAuth2Cli
 	return params
 }
 
-func decodeOAuth2ClientGetParams(args [1]string, argsEscaped bool, r *http.Request) (params OAuth2ClientGetParams, _ error) {
+func decodeDatasourceTelegramGetParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceTelegramGetParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -2598,15 +2884,15 @@ func decodeOAuth2ClientGetParams(args [1]string, argsEscaped bool, r *http.Reque
 	return params, nil
 }
 
-// OAuth2ClientListParams is parameters of oauth2-client-list operation.
-type OAuth2ClientListParams struct {
+// DatasourceTelegramListParams is parameters of datasource-telegram-list operation.
+type DatasourceTelegramListParams struct {
 	// Offset records.
 	Offset OptInt32
 	// Limit records.
 	Limit OptInt32
 }
 
-func unpackOAuth2ClientListParams(packed middleware.Parameters) (params OAuth2ClientListParams) {
+func unpackDatasourceTelegramListParams(packed middleware.Parameters) (params DatasourceTelegramListParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "offset",
@@ -2628,7 +2914,7 @@ func unpackOAuth2ClientListParams(packed middleware.Parameters) (params OAuth2Cl
 	return params
 }
 
-func decodeOAuth2ClientListParams(args [0]string, argsEscaped bool, r *http.Request) (params OAuth2ClientListParams, _ error) {
+func decodeDatasourceTelegramListParams(args [0]string, argsEscaped bool, r *http.Request) (params DatasourceTelegramListParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode query: offset.
 	if err := func() error {
@@ -2715,15 +3001,13 @@ func decodeOAuth2ClientListParams(args [0]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// OAuth2ClientTokenDeleteParams is parameters of oauth2-client-token-delete operation.
-type OAuth2ClientTokenDeleteParams struct {
-	// UUID of the token to delete.
+// DatasourceTelegramUpdateParams is parameters of datasource-telegram-update operation.
+type DatasourceTelegramUpdateParams struct {
+	// UUID of the datasource.
 	UUID string
-	// Datasource UUID to get tokens for.
-	DatasourceUUID string
 }
 
-func unpackOAuth2ClientTokenDeleteParams(packed middleware.Parameters) (params OAuth2ClientTokenDeleteParams) {
+func unpackDatasourceTelegramUpdateParams(packed middleware.Parameters) (params DatasourceTelegramUpdateParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -2731,22 +3015,15 @@ func unpackOAuth2ClientTokenDeleteParams(packed middleware.Parameters) (params O
 		}
 		params.UUID = packed[key].(string)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "datasource_uuid",
-			In:   "path",
-		}
-		params.DatasourceUUID = packed[key].(string)
-	}
 	return params
 }
 
-func decodeOAuth2ClientTokenDeleteParams(args [2]string, argsEscaped bool, r *http.Request) (params OAuth2ClientTokenDeleteParams, _ error) {
+func decodeDatasourceTelegramUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceTelegramUpdateParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
-		param := args[1]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -2787,7 +3064,28 @@ func decodeOAuth2ClientTokenDeleteParams(args [2]string, argsEscaped bool, r *ht
 			Err:  err,
 		}
 	}
-	// Decode path: datasource_uuid.
+	return params, nil
+}
+
+// DatasourceWhatsappDeleteParams is parameters of datasource-whatsapp-delete operation.
+type DatasourceWhatsappDeleteParams struct {
+	// UUID of the WhatsApp datasource.
+	UUID string
+}
+
+func unpackDatasourceWhatsappDeleteParams(packed middleware.Parameters) (params DatasourceWhatsappDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeDatasourceWhatsappDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceWhatsappDeleteParams, _ error) {
+	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -2799,7 +3097,7 @@ func decodeOAuth2ClientTokenDeleteParams(args [2]string, argsEscaped bool, r *ht
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "datasource_uuid",
+				Param:   "uuid",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2816,7 +3114,7 @@ func decodeOAuth2ClientTokenDeleteParams(args [2]string, argsEscaped bool, r *ht
 					return err
 				}
 
-				params.DatasourceUUID = c
+				params.UUID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2827,7 +3125,7 @@ func decodeOAuth2ClientTokenDeleteParams(args [2]string, argsEscaped bool, r *ht
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "datasource_uuid",
+			Name: "uuid",
 			In:   "path",
 			Err:  err,
 		}
@@ -2835,25 +3133,25 @@ func decodeOAuth2ClientTokenDeleteParams(args [2]string, argsEscaped bool, r *ht
 	return params, nil
 }
 
-// OAuth2ClientTokenListParams is parameters of oauth2-client-token-list operation.
-type OAuth2ClientTokenListParams struct {
-	// UUID of the datasource base object.
-	DatasourceUUID string
+// DatasourceWhatsappGetParams is parameters of datasource-whatsapp-get operation.
+type DatasourceWhatsappGetParams struct {
+	// UUID of the WhatsApp datasource.
+	UUID string
 }
 
-func unpackOAuth2ClientTokenListParams(packed middleware.Parameters) (params OAuth2ClientTokenListParams) {
+func unpackDatasourceWhatsappGetParams(packed middleware.Parameters) (params DatasourceWhatsappGetParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "datasource_uuid",
+			Name: "uuid",
 			In:   "path",
 		}
-		params.DatasourceUUID = packed[key].(string)
+		params.UUID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeOAuth2ClientTokenListParams(args [1]string, argsEscaped bool, r *http.Request) (params OAuth2ClientTokenListParams, _ error) {
-	// Decode path: datasource_uuid.
+func decodeDatasourceWhatsappGetParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceWhatsappGetParams, _ error) {
+	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -2865,7 +3163,7 @@ func decodeOAuth2ClientTokenListParams(args [1]string, argsEscaped bool, r *http
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "datasource_uuid",
+				Param:   "uuid",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2882,7 +3180,7 @@ func decodeOAuth2ClientTokenListParams(args [1]string, argsEscaped bool, r *http
 					return err
 				}
 
-				params.DatasourceUUID = c
+				params.UUID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2893,7 +3191,7 @@ func decodeOAuth2ClientTokenListParams(args [1]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "datasource_uuid",
+			Name: "uuid",
 			In:   "path",
 			Err:  err,
 		}
@@ -2901,13 +3199,130 @@ func decodeOAuth2ClientTokenListParams(args [1]string, argsEscaped bool, r *http
 	return params, nil
 }
 
-// OAuth2ClientUpdateParams is parameters of oauth2-client-update operation.
-type OAuth2ClientUpdateParams struct {
-	// ClientID of the OAuth2 client details.
+// DatasourceWhatsappListParams is parameters of datasource-whatsapp-list operation.
+type DatasourceWhatsappListParams struct {
+	// Offset records.
+	Offset OptInt32
+	// Limit records.
+	Limit OptInt32
+}
+
+func unpackDatasourceWhatsappListParams(packed middleware.Parameters) (params DatasourceWhatsappListParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	return params
+}
+
+func decodeDatasourceWhatsappListParams(args [0]string, argsEscaped bool, r *http.Request) (params DatasourceWhatsappListParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DatasourceWhatsappUpdateParams is parameters of datasource-whatsapp-update operation.
+type DatasourceWhatsappUpdateParams struct {
+	// UUID of the datasource.
 	UUID string
 }
 
-func unpackOAuth2ClientUpdateParams(packed middleware.Parameters) (params OAuth2ClientUpdateParams) {
+func unpackDatasourceWhatsappUpdateParams(packed middleware.Parameters) (params DatasourceWhatsappUpdateParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -2918,7 +3333,7 @@ func unpackOAuth2ClientUpdateParams(packed middleware.Parameters) (params OAuth2
 	return params
 }
 
-func decodeOAuth2ClientUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params OAuth2ClientUpdateParams, _ error) {
+func decodeDatasourceWhatsappUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params DatasourceWhatsappUpdateParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -2967,24 +3382,23 @@ func decodeOAuth2ClientUpdateParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// PipelineDeleteParams is parameters of pipeline-delete operation.
-type PipelineDeleteParams struct {
-	// UUID of the pipeline.
-	UUID uuid.UUID
+// DeleteContactParams is parameters of deleteContact operation.
+type DeleteContactParams struct {
+	UUID string
 }
 
-func unpackPipelineDeleteParams(packed middleware.Parameters) (params PipelineDeleteParams) {
+func unpackDeleteContactParams(packed middleware.Parameters) (params DeleteContactParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
 			In:   "path",
 		}
-		params.UUID = packed[key].(uuid.UUID)
+		params.UUID = packed[key].(string)
 	}
 	return params
 }
 
-func decodePipelineDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params PipelineDeleteParams, _ error) {
+func decodeDeleteContactParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteContactParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -3009,7 +3423,7 @@ func decodePipelineDeleteParams(args [1]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
@@ -3033,24 +3447,23 @@ func decodePipelineDeleteParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// PipelineGetParams is parameters of pipeline-get operation.
-type PipelineGetParams struct {
-	// UUID of the pipeline.
-	UUID uuid.UUID
+// DeleteUserParams is parameters of deleteUser operation.
+type DeleteUserParams struct {
+	UUID string
 }
 
-func unpackPipelineGetParams(packed middleware.Parameters) (params PipelineGetParams) {
+func unpackDeleteUserParams(packed middleware.Parameters) (params DeleteUserParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
 			In:   "path",
 		}
-		params.UUID = packed[key].(uuid.UUID)
+		params.UUID = packed[key].(string)
 	}
 	return params
 }
 
-func decodePipelineGetParams(args [1]string, argsEscaped bool, r *http.Request) (params PipelineGetParams, _ error) {
+func decodeDeleteUserParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteUserParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -3075,7 +3488,7 @@ func decodePipelineGetParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
@@ -3099,37 +3512,15 @@ func decodePipelineGetParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// PipelineListParams is parameters of pipeline-list operation.
-type PipelineListParams struct {
-	// Filter pipelines by datasource UUID.
-	DatasourceUUID OptUUID
-	// Filter pipelines by storage UUID.
-	StorageUUID OptUUID
+// ErasureListParams is parameters of erasure-list operation.
+type ErasureListParams struct {
 	// Offset records.
 	Offset OptInt32
 	// Limit records.
 	Limit OptInt32
 }
 
-func unpackPipelineListParams(packed middleware.Parameters) (params PipelineListParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "datasource_uuid",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.DatasourceUUID = v.(OptUUID)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "storage_uuid",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.StorageUUID = v.(OptUUID)
-		}
-	}
+func unpackErasureListParams(packed middleware.Parameters) (params ErasureListParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "offset",
@@ -3151,36 +3542,36 @@ func unpackPipelineListParams(packed middleware.Parameters) (params PipelineList
 	return params
 }
 
-func decodePipelineListParams(args [0]string, argsEscaped bool, r *http.Request) (params PipelineListParams, _ error) {
+func decodeErasureListParams(args [0]string, argsEscaped bool, r *http.Request) (params ErasureListParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: datasource_uuid.
+	// Decode query: offset.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "datasource_uuid",
+			Name:    "offset",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotDatasourceUUIDVal uuid.UUID
+				var paramsDotOffsetVal int32
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToUUID(val)
+					c, err := conv.ToInt32(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotDatasourceUUIDVal = c
+					paramsDotOffsetVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.DatasourceUUID.SetTo(paramsDotDatasourceUUIDVal)
+				params.Offset.SetTo(paramsDotOffsetVal)
 				return nil
 			}); err != nil {
 				return err
@@ -3189,39 +3580,39 @@ func decodePipelineListParams(args [0]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "datasource_uuid",
+			Name: "offset",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: storage_uuid.
+	// Decode query: limit.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "storage_uuid",
+			Name:    "limit",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStorageUUIDVal uuid.UUID
+				var paramsDotLimitVal int32
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToUUID(val)
+					c, err := conv.ToInt32(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotStorageUUIDVal = c
+					paramsDotLimitVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.StorageUUID.SetTo(paramsDotStorageUUIDVal)
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
 			}); err != nil {
 				return err
@@ -3230,7 +3621,198 @@ func decodePipelineListParams(args [0]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "storage_uuid",
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// EventListParams is parameters of event-list operation.
+type EventListParams struct {
+	// Event type, e.g. datasource.needs_reauth.
+	Type OptString
+	// UUID of the object the events are about.
+	SubjectUUID OptString
+	// Only events after this event UUID.
+	After OptString
+	// Offset records.
+	Offset OptInt32
+	// Limit records.
+	Limit OptInt32
+}
+
+func unpackEventListParams(packed middleware.Parameters) (params EventListParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "type",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Type = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "subject_uuid",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SubjectUUID = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "after",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.After = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	return params
+}
+
+func decodeEventListParams(args [0]string, argsEscaped bool, r *http.Request) (params EventListParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: type.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "type",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTypeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTypeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Type.SetTo(paramsDotTypeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "type",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: subject_uuid.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "subject_uuid",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSubjectUUIDVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSubjectUUIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SubjectUUID.SetTo(paramsDotSubjectUUIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "subject_uuid",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: after.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "after",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAfterVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAfterVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.After.SetTo(paramsDotAfterVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "after",
 			In:   "query",
 			Err:  err,
 		}
@@ -3320,24 +3902,24 @@ func decodePipelineListParams(args [0]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// PipelineUpdateParams is parameters of pipeline-update operation.
-type PipelineUpdateParams struct {
-	// UUID of the pipeline.
-	UUID uuid.UUID
+// FileDeleteParams is parameters of file-delete operation.
+type FileDeleteParams struct {
+	// UUID of the file.
+	UUID string
 }
 
-func unpackPipelineUpdateParams(packed middleware.Parameters) (params PipelineUpdateParams) {
+func unpackFileDeleteParams(packed middleware.Parameters) (params FileDeleteParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
 			In:   "path",
 		}
-		params.UUID = packed[key].(uuid.UUID)
+		params.UUID = packed[key].(string)
 	}
 	return params
 }
 
-func decodePipelineUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params PipelineUpdateParams, _ error) {
+func decodeFileDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params FileDeleteParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -3362,7 +3944,7 @@ func decodePipelineUpdateParams(args [1]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
@@ -3386,24 +3968,24 @@ func decodePipelineUpdateParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// SchedulerDeleteParams is parameters of scheduler-delete operation.
-type SchedulerDeleteParams struct {
-	// UUID of the scheduler.
-	UUID uuid.UUID
+// FileGetParams is parameters of file-get operation.
+type FileGetParams struct {
+	// UUID of the file.
+	UUID string
 }
 
-func unpackSchedulerDeleteParams(packed middleware.Parameters) (params SchedulerDeleteParams) {
+func unpackFileGetParams(packed middleware.Parameters) (params FileGetParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
 			In:   "path",
 		}
-		params.UUID = packed[key].(uuid.UUID)
+		params.UUID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeSchedulerDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params SchedulerDeleteParams, _ error) {
+func decodeFileGetParams(args [1]string, argsEscaped bool, r *http.Request) (params FileGetParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -3428,7 +4010,7 @@ func decodeSchedulerDeleteParams(args [1]string, argsEscaped bool, r *http.Reque
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
@@ -3452,154 +4034,39 @@ func decodeSchedulerDeleteParams(args [1]string, argsEscaped bool, r *http.Reque
 	return params, nil
 }
 
-// SchedulerGetParams is parameters of scheduler-get operation.
-type SchedulerGetParams struct {
-	// UUID of the scheduler.
-	UUID uuid.UUID
+// FileListParams is parameters of file-list operation.
+type FileListParams struct {
+	// The number of records to skip for pagination.
+	Offset OptInt32
+	// The maximum number of records to return.
+	Limit OptInt32
 }
 
-func unpackSchedulerGetParams(packed middleware.Parameters) (params SchedulerGetParams) {
+func unpackFileListParams(packed middleware.Parameters) (params FileListParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "uuid",
-			In:   "path",
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
 		}
-		params.UUID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeSchedulerGetParams(args [1]string, argsEscaped bool, r *http.Request) (params SchedulerGetParams, _ error) {
-	// Decode path: uuid.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "uuid",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToUUID(val)
-				if err != nil {
-					return err
-				}
-
-				params.UUID = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "uuid",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// SchedulerListParams is parameters of scheduler-list operation.
-type SchedulerListParams struct {
-	PipelineUUID OptUUID
-	Offset       OptInt32
-	Limit        OptInt32
-}
-
-func unpackSchedulerListParams(packed middleware.Parameters) (params SchedulerListParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "pipeline_uuid",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.PipelineUUID = v.(OptUUID)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt32)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt32)
-		}
-	}
-	return params
-}
-
-func decodeSchedulerListParams(args [0]string, argsEscaped bool, r *http.Request) (params SchedulerListParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: pipeline_uuid.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "pipeline_uuid",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPipelineUUIDVal uuid.UUID
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToUUID(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotPipelineUUIDVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.PipelineUUID.SetTo(paramsDotPipelineUUIDVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "pipeline_uuid",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: offset.
+func decodeFileListParams(args [0]string, argsEscaped bool, r *http.Request) (params FileListParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: offset.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
 			Name:    "offset",
@@ -3684,24 +4151,24 @@ func decodeSchedulerListParams(args [0]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// SchedulerUpdateParams is parameters of scheduler-update operation.
-type SchedulerUpdateParams struct {
-	// UUID of the scheduler.
-	UUID uuid.UUID
+// FileUpdateParams is parameters of file-update operation.
+type FileUpdateParams struct {
+	// UUID of the file.
+	UUID string
 }
 
-func unpackSchedulerUpdateParams(packed middleware.Parameters) (params SchedulerUpdateParams) {
+func unpackFileUpdateParams(packed middleware.Parameters) (params FileUpdateParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
 			In:   "path",
 		}
-		params.UUID = packed[key].(uuid.UUID)
+		params.UUID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeSchedulerUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params SchedulerUpdateParams, _ error) {
+func decodeFileUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params FileUpdateParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -3726,7 +4193,7 @@ func decodeSchedulerUpdateParams(args [1]string, argsEscaped bool, r *http.Reque
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
@@ -3750,13 +4217,12 @@ func decodeSchedulerUpdateParams(args [1]string, argsEscaped bool, r *http.Reque
 	return params, nil
 }
 
-// StorageHostfilesDeleteParams is parameters of storage-hostfiles-delete operation.
-type StorageHostfilesDeleteParams struct {
-	// The UUID of the Host Files storage instance to delete.
+// GetContactParams is parameters of getContact operation.
+type GetContactParams struct {
 	UUID string
 }
 
-func unpackStorageHostfilesDeleteParams(packed middleware.Parameters) (params StorageHostfilesDeleteParams) {
+func unpackGetContactParams(packed middleware.Parameters) (params GetContactParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -3767,7 +4233,7 @@ func unpackStorageHostfilesDeleteParams(packed middleware.Parameters) (params St
 	return params
 }
 
-func decodeStorageHostfilesDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params StorageHostfilesDeleteParams, _ error) {
+func decodeGetContactParams(args [1]string, argsEscaped bool, r *http.Request) (params GetContactParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -3816,13 +4282,12 @@ func decodeStorageHostfilesDeleteParams(args [1]string, argsEscaped bool, r *htt
 	return params, nil
 }
 
-// StorageHostfilesGetParams is parameters of storage-hostfiles-get operation.
-type StorageHostfilesGetParams struct {
-	// The UUID of the Host Files storage instance to retrieve.
+// GetUserParams is parameters of getUser operation.
+type GetUserParams struct {
 	UUID string
 }
 
-func unpackStorageHostfilesGetParams(packed middleware.Parameters) (params StorageHostfilesGetParams) {
+func unpackGetUserParams(packed middleware.Parameters) (params GetUserParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -3833,7 +4298,7 @@ func unpackStorageHostfilesGetParams(packed middleware.Parameters) (params Stora
 	return params
 }
 
-func decodeStorageHostfilesGetParams(args [1]string, argsEscaped bool, r *http.Request) (params StorageHostfilesGetParams, _ error) {
+func decodeGetUserParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -3882,158 +4347,286 @@ func decodeStorageHostfilesGetParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// StorageHostfilesUpdateParams is parameters of storage-hostfiles-update operation.
-type StorageHostfilesUpdateParams struct {
-	// The UUID of the Host Files storage instance to update.
-	UUID string
+// OAuth2ClientCallbackParams is parameters of oauth2-client-callback operation.
+type OAuth2ClientCallbackParams struct {
+	// State UUID.
+	State OptString
+	// OAuth2 code.
+	Code OptString
 }
 
-func unpackStorageHostfilesUpdateParams(packed middleware.Parameters) (params StorageHostfilesUpdateParams) {
+func unpackOAuth2ClientCallbackParams(packed middleware.Parameters) (params OAuth2ClientCallbackParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "uuid",
-			In:   "path",
+			Name: "state",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.State = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "code",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Code = v.(OptString)
 		}
-		params.UUID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeStorageHostfilesUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params StorageHostfilesUpdateParams, _ error) {
-	// Decode path: uuid.
+func decodeOAuth2ClientCallbackParams(args [0]string, argsEscaped bool, r *http.Request) (params OAuth2ClientCallbackParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: state.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "state",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "uuid",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStateVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStateVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.UUID = c
+				params.State.SetTo(paramsDotStateVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "uuid",
-			In:   "path",
+			Name: "state",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
+	// Decode query: code.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "code",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
 
-// StorageListParams is parameters of storage-list operation.
-type StorageListParams struct {
-	// The number of records to skip for pagination.
-	Offset OptInt32
-	// The maximum number of records to return.
-	Limit OptInt32
-	// Filter storage objects by type.
-	Type OptString
-	// Filter storage objects by the is_enabled boolean field.
-	IsEnabled OptBool
-	// Filter storage objects by name (e.g., partial or exact match).
-	Name OptString
-	// Sort results by a specific column.
-	OrderBy OptStorageListOrderBy
-	// Specify the sorting direction (ascending or descending).
-	OrderDirection OptStorageListOrderDirection
-}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCodeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-func unpackStorageListParams(packed middleware.Parameters) (params StorageListParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCodeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Code.SetTo(paramsDotCodeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
 		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt32)
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "code",
+			In:   "query",
+			Err:  err,
 		}
 	}
+	return params, nil
+}
+
+// OAuth2ClientDeleteParams is parameters of oauth2-client-delete operation.
+type OAuth2ClientDeleteParams struct {
+	// ID of the connection base object.
+	UUID string
+}
+
+func unpackOAuth2ClientDeleteParams(packed middleware.Parameters) (params OAuth2ClientDeleteParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt32)
+			Name: "uuid",
+			In:   "path",
 		}
+		params.UUID = packed[key].(string)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "type",
-			In:   "query",
+	return params
+}
+
+func decodeOAuth2ClientDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params OAuth2ClientDeleteParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
-		if v, ok := packed[key]; ok {
-			params.Type = v.(OptString)
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
 		}
 	}
+	return params, nil
+}
+
+// OAuth2ClientGetParams is parameters of oauth2-client-get operation.
+type OAuth2ClientGetParams struct {
+	// ClientID of the OAuth2 client.
+	UUID string
+}
+
+func unpackOAuth2ClientGetParams(packed middleware.Parameters) (params OAuth2ClientGetParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "is_enabled",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.IsEnabled = v.(OptBool)
+			Name: "uuid",
+			In:   "path",
 		}
+		params.UUID = packed[key].(string)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "name",
-			In:   "query",
+	return params
+}
+
+func decodeOAuth2ClientGetParams(args [1]string, argsEscaped bool, r *http.Request) (params OAuth2ClientGetParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
-		if v, ok := packed[key]; ok {
-			params.Name = v.(OptString)
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
 		}
 	}
+	return params, nil
+}
+
+// OAuth2ClientListParams is parameters of oauth2-client-list operation.
+type OAuth2ClientListParams struct {
+	// Offset records.
+	Offset OptInt32
+	// Limit records.
+	Limit OptInt32
+}
+
+func unpackOAuth2ClientListParams(packed middleware.Parameters) (params OAuth2ClientListParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "order_by",
+			Name: "offset",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.OrderBy = v.(OptStorageListOrderBy)
+			params.Offset = v.(OptInt32)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "order_direction",
+			Name: "limit",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.OrderDirection = v.(OptStorageListOrderDirection)
+			params.Limit = v.(OptInt32)
 		}
 	}
 	return params
 }
 
-func decodeStorageListParams(args [0]string, argsEscaped bool, r *http.Request) (params StorageListParams, _ error) {
+func decodeOAuth2ClientListParams(args [0]string, argsEscaped bool, r *http.Request) (params OAuth2ClientListParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode query: offset.
 	if err := func() error {
@@ -4117,251 +4710,3939 @@ func decodeStorageListParams(args [0]string, argsEscaped bool, r *http.Request)
 			Err:  err,
 		}
 	}
-	// Decode query: type.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "type",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotTypeVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+	return params, nil
+}
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+// OAuth2ClientTokenDeleteParams is parameters of oauth2-client-token-delete operation.
+type OAuth2ClientTokenDeleteParams struct {
+	// UUID of the token to delete.
+	UUID string
+	// Datasource UUID to get tokens for.
+	DatasourceUUID string
+}
 
-					paramsDotTypeVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Type.SetTo(paramsDotTypeVal)
-				return nil
-			}); err != nil {
-				return err
-			}
+func unpackOAuth2ClientTokenDeleteParams(packed middleware.Parameters) (params OAuth2ClientTokenDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "type",
-			In:   "query",
-			Err:  err,
+		params.UUID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "datasource_uuid",
+			In:   "path",
 		}
+		params.DatasourceUUID = packed[key].(string)
 	}
-	// Decode query: is_enabled.
+	return params
+}
+
+func decodeOAuth2ClientTokenDeleteParams(args [2]string, argsEscaped bool, r *http.Request) (params OAuth2ClientTokenDeleteParams, _ error) {
+	// Decode path: uuid.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "is_enabled",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotIsEnabledVal bool
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToBool(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotIsEnabledVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.IsEnabled.SetTo(paramsDotIsEnabledVal)
+
+				params.UUID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "is_enabled",
-			In:   "query",
+			Name: "uuid",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: name.
+	// Decode path: datasource_uuid.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "name",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "datasource_uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotNameVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotNameVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Name.SetTo(paramsDotNameVal)
+
+				params.DatasourceUUID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "name",
-			In:   "query",
+			Name: "datasource_uuid",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: order_by.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "order_by",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOrderByVal StorageListOrderBy
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+// OAuth2ClientTokenListParams is parameters of oauth2-client-token-list operation.
+type OAuth2ClientTokenListParams struct {
+	// UUID of the datasource base object.
+	DatasourceUUID string
+}
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+func unpackOAuth2ClientTokenListParams(packed middleware.Parameters) (params OAuth2ClientTokenListParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "datasource_uuid",
+			In:   "path",
+		}
+		params.DatasourceUUID = packed[key].(string)
+	}
+	return params
+}
 
-					paramsDotOrderByVal = StorageListOrderBy(c)
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.OrderBy.SetTo(paramsDotOrderByVal)
-				return nil
-			}); err != nil {
-				return err
+func decodeOAuth2ClientTokenListParams(args [1]string, argsEscaped bool, r *http.Request) (params OAuth2ClientTokenListParams, _ error) {
+	// Decode path: datasource_uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
 			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "datasource_uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
 			if err := func() error {
-				if value, ok := params.OrderBy.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
 				}
+
+				params.DatasourceUUID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "order_by",
-			In:   "query",
+			Name: "datasource_uuid",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: order_direction.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "order_direction",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOrderDirectionVal StorageListOrderDirection
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+// OAuth2ClientTokenRefreshParams is parameters of oauth2-client-token-refresh operation.
+type OAuth2ClientTokenRefreshParams struct {
+	// UUID of the token to refresh.
+	UUID string
+	// Datasource UUID to get tokens for.
+	DatasourceUUID string
+}
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+func unpackOAuth2ClientTokenRefreshParams(packed middleware.Parameters) (params OAuth2ClientTokenRefreshParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "datasource_uuid",
+			In:   "path",
+		}
+		params.DatasourceUUID = packed[key].(string)
+	}
+	return params
+}
 
-					paramsDotOrderDirectionVal = StorageListOrderDirection(c)
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.OrderDirection.SetTo(paramsDotOrderDirectionVal)
-				return nil
+func decodeOAuth2ClientTokenRefreshParams(args [2]string, argsEscaped bool, r *http.Request) (params OAuth2ClientTokenRefreshParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: datasource_uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "datasource_uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.DatasourceUUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "datasource_uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// OAuth2ClientUpdateParams is parameters of oauth2-client-update operation.
+type OAuth2ClientUpdateParams struct {
+	// ClientID of the OAuth2 client details.
+	UUID string
+}
+
+func unpackOAuth2ClientUpdateParams(packed middleware.Parameters) (params OAuth2ClientUpdateParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeOAuth2ClientUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params OAuth2ClientUpdateParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PipelineDeleteParams is parameters of pipeline-delete operation.
+type PipelineDeleteParams struct {
+	// UUID of the pipeline.
+	UUID uuid.UUID
+}
+
+func unpackPipelineDeleteParams(packed middleware.Parameters) (params PipelineDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodePipelineDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params PipelineDeleteParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PipelineGetParams is parameters of pipeline-get operation.
+type PipelineGetParams struct {
+	// UUID of the pipeline.
+	UUID uuid.UUID
+}
+
+func unpackPipelineGetParams(packed middleware.Parameters) (params PipelineGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodePipelineGetParams(args [1]string, argsEscaped bool, r *http.Request) (params PipelineGetParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PipelineListParams is parameters of pipeline-list operation.
+type PipelineListParams struct {
+	// Filter pipelines by datasource UUID.
+	DatasourceUUID OptUUID
+	// Filter pipelines by storage UUID.
+	StorageUUID OptUUID
+	// Offset records.
+	Offset OptInt32
+	// Limit records.
+	Limit OptInt32
+}
+
+func unpackPipelineListParams(packed middleware.Parameters) (params PipelineListParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "datasource_uuid",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.DatasourceUUID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "storage_uuid",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.StorageUUID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	return params
+}
+
+func decodePipelineListParams(args [0]string, argsEscaped bool, r *http.Request) (params PipelineListParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: datasource_uuid.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "datasource_uuid",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDatasourceUUIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDatasourceUUIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.DatasourceUUID.SetTo(paramsDotDatasourceUUIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "datasource_uuid",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: storage_uuid.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "storage_uuid",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStorageUUIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStorageUUIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.StorageUUID.SetTo(paramsDotStorageUUIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "storage_uuid",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PipelineRunParams is parameters of pipeline-run operation.
+type PipelineRunParams struct {
+	// UUID of the pipeline.
+	UUID uuid.UUID
+}
+
+func unpackPipelineRunParams(packed middleware.Parameters) (params PipelineRunParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodePipelineRunParams(args [1]string, argsEscaped bool, r *http.Request) (params PipelineRunParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PipelineUpdateParams is parameters of pipeline-update operation.
+type PipelineUpdateParams struct {
+	// UUID of the pipeline.
+	UUID uuid.UUID
+}
+
+func unpackPipelineUpdateParams(packed middleware.Parameters) (params PipelineUpdateParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodePipelineUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params PipelineUpdateParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PolicyDeleteParams is parameters of policy-delete operation.
+type PolicyDeleteParams struct {
+	ID string
+}
+
+func unpackPolicyDeleteParams(packed middleware.Parameters) (params PolicyDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodePolicyDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params PolicyDeleteParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PolicyGetParams is parameters of policy-get operation.
+type PolicyGetParams struct {
+	ID string
+}
+
+func unpackPolicyGetParams(packed middleware.Parameters) (params PolicyGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodePolicyGetParams(args [1]string, argsEscaped bool, r *http.Request) (params PolicyGetParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PolicyListParams is parameters of policy-list operation.
+type PolicyListParams struct {
+	// Offset records.
+	Offset OptInt32
+	// Limit records.
+	Limit OptInt32
+}
+
+func unpackPolicyListParams(packed middleware.Parameters) (params PolicyListParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	return params
+}
+
+func decodePolicyListParams(args [0]string, argsEscaped bool, r *http.Request) (params PolicyListParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PolicyUpdateParams is parameters of policy-update operation.
+type PolicyUpdateParams struct {
+	ID string
+}
+
+func unpackPolicyUpdateParams(packed middleware.Parameters) (params PolicyUpdateParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodePolicyUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params PolicyUpdateParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RetentionPolicyDeleteParams is parameters of retention-policy-delete operation.
+type RetentionPolicyDeleteParams struct {
+	UUID string
+}
+
+func unpackRetentionPolicyDeleteParams(packed middleware.Parameters) (params RetentionPolicyDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeRetentionPolicyDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params RetentionPolicyDeleteParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RetentionPolicyGetParams is parameters of retention-policy-get operation.
+type RetentionPolicyGetParams struct {
+	UUID string
+}
+
+func unpackRetentionPolicyGetParams(packed middleware.Parameters) (params RetentionPolicyGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeRetentionPolicyGetParams(args [1]string, argsEscaped bool, r *http.Request) (params RetentionPolicyGetParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RetentionPolicyListParams is parameters of retention-policy-list operation.
+type RetentionPolicyListParams struct {
+	// Offset records.
+	Offset OptInt32
+	// Limit records.
+	Limit OptInt32
+}
+
+func unpackRetentionPolicyListParams(packed middleware.Parameters) (params RetentionPolicyListParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	return params
+}
+
+func decodeRetentionPolicyListParams(args [0]string, argsEscaped bool, r *http.Request) (params RetentionPolicyListParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RetentionPolicyRunParams is parameters of retention-policy-run operation.
+type RetentionPolicyRunParams struct {
+	UUID string
+}
+
+func unpackRetentionPolicyRunParams(packed middleware.Parameters) (params RetentionPolicyRunParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeRetentionPolicyRunParams(args [1]string, argsEscaped bool, r *http.Request) (params RetentionPolicyRunParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RetentionPolicyUpdateParams is parameters of retention-policy-update operation.
+type RetentionPolicyUpdateParams struct {
+	UUID string
+}
+
+func unpackRetentionPolicyUpdateParams(packed middleware.Parameters) (params RetentionPolicyUpdateParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeRetentionPolicyUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params RetentionPolicyUpdateParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SchedulerDeleteParams is parameters of scheduler-delete operation.
+type SchedulerDeleteParams struct {
+	// UUID of the scheduler.
+	UUID uuid.UUID
+}
+
+func unpackSchedulerDeleteParams(packed middleware.Parameters) (params SchedulerDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeSchedulerDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params SchedulerDeleteParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SchedulerGetParams is parameters of scheduler-get operation.
+type SchedulerGetParams struct {
+	// UUID of the scheduler.
+	UUID uuid.UUID
+}
+
+func unpackSchedulerGetParams(packed middleware.Parameters) (params SchedulerGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeSchedulerGetParams(args [1]string, argsEscaped bool, r *http.Request) (params SchedulerGetParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SchedulerListParams is parameters of scheduler-list operation.
+type SchedulerListParams struct {
+	PipelineUUID OptUUID
+	Offset       OptInt32
+	Limit        OptInt32
+}
+
+func unpackSchedulerListParams(packed middleware.Parameters) (params SchedulerListParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "pipeline_uuid",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PipelineUUID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	return params
+}
+
+func decodeSchedulerListParams(args [0]string, argsEscaped bool, r *http.Request) (params SchedulerListParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: pipeline_uuid.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "pipeline_uuid",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPipelineUUIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPipelineUUIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PipelineUUID.SetTo(paramsDotPipelineUUIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "pipeline_uuid",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SchedulerUpdateParams is parameters of scheduler-update operation.
+type SchedulerUpdateParams struct {
+	// UUID of the scheduler.
+	UUID uuid.UUID
+}
+
+func unpackSchedulerUpdateParams(packed middleware.Parameters) (params SchedulerUpdateParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeSchedulerUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params SchedulerUpdateParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SessionRevokeParams is parameters of session-revoke operation.
+type SessionRevokeParams struct {
+	UUID string
+}
+
+func unpackSessionRevokeParams(packed middleware.Parameters) (params SessionRevokeParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeSessionRevokeParams(args [1]string, argsEscaped bool, r *http.Request) (params SessionRevokeParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SessionRevokeAllParams is parameters of session-revoke-all operation.
+type SessionRevokeAllParams struct {
+	// Keep the session of this request.
+	KeepCurrent OptBool
+}
+
+func unpackSessionRevokeAllParams(packed middleware.Parameters) (params SessionRevokeAllParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "keep_current",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.KeepCurrent = v.(OptBool)
+		}
+	}
+	return params
+}
+
+func decodeSessionRevokeAllParams(args [0]string, argsEscaped bool, r *http.Request) (params SessionRevokeAllParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: keep_current.
+	{
+		val := bool(false)
+		params.KeepCurrent.SetTo(val)
+	}
+	// Decode query: keep_current.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "keep_current",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotKeepCurrentVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotKeepCurrentVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.KeepCurrent.SetTo(paramsDotKeepCurrentVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "keep_current",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// StorageHostfilesDeleteParams is parameters of storage-hostfiles-delete operation.
+type StorageHostfilesDeleteParams struct {
+	// The UUID of the Host Files storage instance to delete.
+	UUID string
+}
+
+func unpackStorageHostfilesDeleteParams(packed middleware.Parameters) (params StorageHostfilesDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeStorageHostfilesDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params StorageHostfilesDeleteParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// StorageHostfilesGetParams is parameters of storage-hostfiles-get operation.
+type StorageHostfilesGetParams struct {
+	// The UUID of the Host Files storage instance to retrieve.
+	UUID string
+}
+
+func unpackStorageHostfilesGetParams(packed middleware.Parameters) (params StorageHostfilesGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeStorageHostfilesGetParams(args [1]string, argsEscaped bool, r *http.Request) (params StorageHostfilesGetParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// StorageHostfilesUpdateParams is parameters of storage-hostfiles-update operation.
+type StorageHostfilesUpdateParams struct {
+	// The UUID of the Host Files storage instance to update.
+	UUID string
+}
+
+func unpackStorageHostfilesUpdateParams(packed middleware.Parameters) (params StorageHostfilesUpdateParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeStorageHostfilesUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params StorageHostfilesUpdateParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// StorageListParams is parameters of storage-list operation.
+type StorageListParams struct {
+	// The number of records to skip for pagination.
+	Offset OptInt32
+	// The maximum number of records to return.
+	Limit OptInt32
+	// Filter storage objects by type.
+	Type OptString
+	// Filter storage objects by the is_enabled boolean field.
+	IsEnabled OptBool
+	// Filter storage objects by name (e.g., partial or exact match).
+	Name OptString
+	// Sort results by a specific column.
+	OrderBy OptStorageListOrderBy
+	// Specify the sorting direction (ascending or descending).
+	OrderDirection OptStorageListOrderDirection
+}
+
+func unpackStorageListParams(packed middleware.Parameters) (params StorageListParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "type",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Type = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "is_enabled",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.IsEnabled = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "name",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Name = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "order_by",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OrderBy = v.(OptStorageListOrderBy)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "order_direction",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OrderDirection = v.(OptStorageListOrderDirection)
+		}
+	}
+	return params
+}
+
+func decodeStorageListParams(args [0]string, argsEscaped bool, r *http.Request) (params StorageListParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: type.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "type",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTypeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTypeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Type.SetTo(paramsDotTypeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "type",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: is_enabled.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "is_enabled",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIsEnabledVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIsEnabledVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IsEnabled.SetTo(paramsDotIsEnabledVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "is_enabled",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: name.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotNameVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotNameVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Name.SetTo(paramsDotNameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "name",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: order_by.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "order_by",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOrderByVal StorageListOrderBy
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOrderByVal = StorageListOrderBy(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.OrderBy.SetTo(paramsDotOrderByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.OrderBy.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "order_by",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: order_direction.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "order_direction",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOrderDirectionVal StorageListOrderDirection
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOrderDirectionVal = StorageListOrderDirection(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.OrderDirection.SetTo(paramsDotOrderDirectionVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.OrderDirection.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "order_direction",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// StorageMigrateParams is parameters of storage-migrate operation.
+type StorageMigrateParams struct {
+	// The UUID of the source storage.
+	UUID string
+}
+
+func unpackStorageMigrateParams(packed middleware.Parameters) (params StorageMigrateParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeStorageMigrateParams(args [1]string, argsEscaped bool, r *http.Request) (params StorageMigrateParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// StorageMigrationListParams is parameters of storage-migration-list operation.
+type StorageMigrationListParams struct {
+	// The UUID of the source storage.
+	UUID string
+	// The number of records to skip for pagination.
+	Offset OptInt32
+	// The maximum number of records to return.
+	Limit OptInt32
+}
+
+func unpackStorageMigrationListParams(packed middleware.Parameters) (params StorageMigrationListParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	return params
+}
+
+func decodeStorageMigrationListParams(args [1]string, argsEscaped bool, r *http.Request) (params StorageMigrationListParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// StoragePostgresDeleteParams is parameters of storage-postgres-delete operation.
+type StoragePostgresDeleteParams struct {
+	// The UUID of the PostgreSQL storage instance to delete.
+	UUID string
+}
+
+func unpackStoragePostgresDeleteParams(packed middleware.Parameters) (params StoragePostgresDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeStoragePostgresDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params StoragePostgresDeleteParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// StoragePostgresGetParams is parameters of storage-postgres-get operation.
+type StoragePostgresGetParams struct {
+	// The UUID of the PostgreSQL storage instance to retrieve.
+	UUID string
+}
+
+func unpackStoragePostgresGetParams(packed middleware.Parameters) (params StoragePostgresGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeStoragePostgresGetParams(args [1]string, argsEscaped bool, r *http.Request) (params StoragePostgresGetParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// StoragePostgresUpdateParams is parameters of storage-postgres-update operation.
+type StoragePostgresUpdateParams struct {
+	// The UUID of the PostgreSQL storage instance to update.
+	UUID string
+}
+
+func unpackStoragePostgresUpdateParams(packed middleware.Parameters) (params StoragePostgresUpdateParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeStoragePostgresUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params StoragePostgresUpdateParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// StorageS3DeleteParams is parameters of storage-s3-delete operation.
+type StorageS3DeleteParams struct {
+	// The UUID of the S3 storage instance to delete.
+	UUID string
+}
+
+func unpackStorageS3DeleteParams(packed middleware.Parameters) (params StorageS3DeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeStorageS3DeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params StorageS3DeleteParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// StorageS3GetParams is parameters of storage-s3-get operation.
+type StorageS3GetParams struct {
+	// The UUID of the S3 storage instance to retrieve.
+	UUID string
+}
+
+func unpackStorageS3GetParams(packed middleware.Parameters) (params StorageS3GetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeStorageS3GetParams(args [1]string, argsEscaped bool, r *http.Request) (params StorageS3GetParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// StorageS3UpdateParams is parameters of storage-s3-update operation.
+type StorageS3UpdateParams struct {
+	// The UUID of the S3 storage instance to update.
+	UUID string
+}
+
+func unpackStorageS3UpdateParams(packed middleware.Parameters) (params StorageS3UpdateParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeStorageS3UpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params StorageS3UpdateParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SyncpolicyDeleteParams is parameters of syncpolicy-delete operation.
+type SyncpolicyDeleteParams struct {
+	// Unique identifier of the sync policy.
+	UUID string
+}
+
+func unpackSyncpolicyDeleteParams(packed middleware.Parameters) (params SyncpolicyDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeSyncpolicyDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params SyncpolicyDeleteParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SyncpolicyGetParams is parameters of syncpolicy-get operation.
+type SyncpolicyGetParams struct {
+	// Unique identifier of the sync policy.
+	UUID string
+}
+
+func unpackSyncpolicyGetParams(packed middleware.Parameters) (params SyncpolicyGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeSyncpolicyGetParams(args [1]string, argsEscaped bool, r *http.Request) (params SyncpolicyGetParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SyncpolicyListParams is parameters of syncpolicy-list operation.
+type SyncpolicyListParams struct {
+	// Offset records.
+	Offset OptInt32
+	// Limit records.
+	Limit OptInt32
+}
+
+func unpackSyncpolicyListParams(packed middleware.Parameters) (params SyncpolicyListParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	return params
+}
+
+func decodeSyncpolicyListParams(args [0]string, argsEscaped bool, r *http.Request) (params SyncpolicyListParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
 			}); err != nil {
 				return err
 			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SyncpolicyUpdateParams is parameters of syncpolicy-update operation.
+type SyncpolicyUpdateParams struct {
+	// Unique identifier of the sync policy.
+	UUID string
+}
+
+func unpackSyncpolicyUpdateParams(packed middleware.Parameters) (params SyncpolicyUpdateParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeSyncpolicyUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params SyncpolicyUpdateParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// TgSessionVerifyParams is parameters of tg-session-verify operation.
+type TgSessionVerifyParams struct {
+	// Session ID.
+	ID int
+}
+
+func unpackTgSessionVerifyParams(packed middleware.Parameters) (params TgSessionVerifyParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeTgSessionVerifyParams(args [1]string, argsEscaped bool, r *http.Request) (params TgSessionVerifyParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UpdateContactParams is parameters of updateContact operation.
+type UpdateContactParams struct {
+	UUID string
+}
+
+func unpackUpdateContactParams(packed middleware.Parameters) (params UpdateContactParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeUpdateContactParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateContactParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
 			if err := func() error {
-				if value, ok := params.OrderDirection.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UpdateUserParams is parameters of updateUser operation.
+type UpdateUserParams struct {
+	UUID string
+}
+
+func unpackUpdateUserParams(packed middleware.Parameters) (params UpdateUserParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeUpdateUserParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateUserParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// WebhookDeleteParams is parameters of webhook-delete operation.
+type WebhookDeleteParams struct {
+	UUID string
+}
+
+func unpackWebhookDeleteParams(packed middleware.Parameters) (params WebhookDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeWebhookDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params WebhookDeleteParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// WebhookGetParams is parameters of webhook-get operation.
+type WebhookGetParams struct {
+	UUID string
+}
+
+func unpackWebhookGetParams(packed middleware.Parameters) (params WebhookGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uuid",
+			In:   "path",
+		}
+		params.UUID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeWebhookGetParams(args [1]string, argsEscaped bool, r *http.Request) (params WebhookGetParams, _ error) {
+	// Decode path: uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
 				}
+
+				params.UUID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "order_direction",
-			In:   "query",
+			Name: "uuid",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// StoragePostgresDeleteParams is parameters of storage-postgres-delete operation.
-type StoragePostgresDeleteParams struct {
-	// The UUID of the PostgreSQL storage instance to delete.
+// WebhookUpdateParams is parameters of webhook-update operation.
+type WebhookUpdateParams struct {
 	UUID string
 }
 
-func unpackStoragePostgresDeleteParams(packed middleware.Parameters) (params StoragePostgresDeleteParams) {
+func unpackWebhookUpdateParams(packed middleware.Parameters) (params WebhookUpdateParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -4372,7 +8653,7 @@ func unpackStoragePostgresDeleteParams(packed middleware.Parameters) (params Sto
 	return params
 }
 
-func decodeStoragePostgresDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params StoragePostgresDeleteParams, _ error) {
+func decodeWebhookUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params WebhookUpdateParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -4421,13 +8702,13 @@ func decodeStoragePostgresDeleteParams(args [1]string, argsEscaped bool, r *http
 	return params, nil
 }
 
-// StoragePostgresGetParams is parameters of storage-postgres-get operation.
-type StoragePostgresGetParams struct {
-	// The UUID of the PostgreSQL storage instance to retrieve.
+// WorkerJobsCancelParams is parameters of worker-jobs-cancel operation.
+type WorkerJobsCancelParams struct {
+	// Unique identifier of the worker job to cancel.
 	UUID string
 }
 
-func unpackStoragePostgresGetParams(packed middleware.Parameters) (params StoragePostgresGetParams) {
+func unpackWorkerJobsCancelParams(packed middleware.Parameters) (params WorkerJobsCancelParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -4438,7 +8719,7 @@ func unpackStoragePostgresGetParams(packed middleware.Parameters) (params Storag
 	return params
 }
 
-func decodeStoragePostgresGetParams(args [1]string, argsEscaped bool, r *http.Request) (params StoragePostgresGetParams, _ error) {
+func decodeWorkerJobsCancelParams(args [1]string, argsEscaped bool, r *http.Request) (params WorkerJobsCancelParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -4487,13 +8768,13 @@ func decodeStoragePostgresGetParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// StoragePostgresUpdateParams is parameters of storage-postgres-update operation.
-type StoragePostgresUpdateParams struct {
-	// The UUID of the PostgreSQL storage instance to update.
+// WorkerJobsDeleteParams is parameters of worker-jobs-delete operation.
+type WorkerJobsDeleteParams struct {
+	// Unique identifier of the worker job.
 	UUID string
 }
 
-func unpackStoragePostgresUpdateParams(packed middleware.Parameters) (params StoragePostgresUpdateParams) {
+func unpackWorkerJobsDeleteParams(packed middleware.Parameters) (params WorkerJobsDeleteParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -4504,7 +8785,7 @@ func unpackStoragePostgresUpdateParams(packed middleware.Parameters) (params Sto
 	return params
 }
 
-func decodeStoragePostgresUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params StoragePostgresUpdateParams, _ error) {
+func decodeWorkerJobsDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params WorkerJobsDeleteParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -4553,13 +8834,13 @@ func decodeStoragePostgresUpdateParams(args [1]string, argsEscaped bool, r *http
 	return params, nil
 }
 
-// StorageS3DeleteParams is parameters of storage-s3-delete operation.
-type StorageS3DeleteParams struct {
-	// The UUID of the S3 storage instance to delete.
+// WorkerJobsGetParams is parameters of worker-jobs-get operation.
+type WorkerJobsGetParams struct {
+	// Unique identifier of the worker job.
 	UUID string
 }
 
-func unpackStorageS3DeleteParams(packed middleware.Parameters) (params StorageS3DeleteParams) {
+func unpackWorkerJobsGetParams(packed middleware.Parameters) (params WorkerJobsGetParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -4570,7 +8851,7 @@ func unpackStorageS3DeleteParams(packed middleware.Parameters) (params StorageS3
 	return params
 }
 
-func decodeStorageS3DeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params StorageS3DeleteParams, _ error) {
+func decodeWorkerJobsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params WorkerJobsGetParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -4589,109 +8870,368 @@ func decodeStorageS3DeleteParams(args [1]string, argsEscaped bool, r *http.Reque
 				Explode: false,
 			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// WorkerJobsListParams is parameters of worker-jobs-list operation.
+type WorkerJobsListParams struct {
+	// The number of records to skip for pagination.
+	Offset OptInt32
+	// The maximum number of records to return.
+	Limit OptInt32
+	// Only jobs with this status, e.g. queued, running, done, failed or cancelled.
+	Status OptString
+	// Only jobs of this subject.
+	Subject OptString
+	// Only jobs triggered by this scheduler, policy or migration.
+	SchedulerUUID OptUUID
+	// Only the runs of this pipeline, scheduled or triggered by hand.
+	PipelineUUID OptUUID
+}
+
+func unpackWorkerJobsListParams(packed middleware.Parameters) (params WorkerJobsListParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "subject",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Subject = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "scheduler_uuid",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SchedulerUUID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "pipeline_uuid",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PipelineUUID = v.(OptUUID)
+		}
+	}
+	return params
+}
+
+func decodeWorkerJobsListParams(args [0]string, argsEscaped bool, r *http.Request) (params WorkerJobsListParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Status.SetTo(paramsDotStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: subject.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "subject",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSubjectVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSubjectVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.UUID = c
+				params.Subject.SetTo(paramsDotSubjectVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "uuid",
-			In:   "path",
+			Name: "subject",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
+	// Decode query: scheduler_uuid.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "scheduler_uuid",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-// StorageS3GetParams is parameters of storage-s3-get operation.
-type StorageS3GetParams struct {
-	// The UUID of the S3 storage instance to retrieve.
-	UUID string
-}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSchedulerUUIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-func unpackStorageS3GetParams(packed middleware.Parameters) (params StorageS3GetParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "uuid",
-			In:   "path",
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSchedulerUUIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SchedulerUUID.SetTo(paramsDotSchedulerUUIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "scheduler_uuid",
+			In:   "query",
+			Err:  err,
 		}
-		params.UUID = packed[key].(string)
 	}
-	return params
-}
-
-func decodeStorageS3GetParams(args [1]string, argsEscaped bool, r *http.Request) (params StorageS3GetParams, _ error) {
-	// Decode path: uuid.
+	// Decode query: pipeline_uuid.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "pipeline_uuid",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "uuid",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPipelineUUIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPipelineUUIDVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.UUID = c
+				params.PipelineUUID.SetTo(paramsDotPipelineUUIDVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "uuid",
-			In:   "path",
+			Name: "pipeline_uuid",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// StorageS3UpdateParams is parameters of storage-s3-update operation.
-type StorageS3UpdateParams struct {
-	// The UUID of the S3 storage instance to update.
+// WorkerJobsRetryParams is parameters of worker-jobs-retry operation.
+type WorkerJobsRetryParams struct {
+	// Unique identifier of the worker job to retry.
 	UUID string
 }
 
-func unpackStorageS3UpdateParams(packed middleware.Parameters) (params StorageS3UpdateParams) {
+func unpackWorkerJobsRetryParams(packed middleware.Parameters) (params WorkerJobsRetryParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -4702,7 +9242,7 @@ func unpackStorageS3UpdateParams(packed middleware.Parameters) (params StorageS3
 	return params
 }
 
-func decodeStorageS3UpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params StorageS3UpdateParams, _ error) {
+func decodeWorkerJobsRetryParams(args [1]string, argsEscaped bool, r *http.Request) (params WorkerJobsRetryParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -4751,13 +9291,12 @@ func decodeStorageS3UpdateParams(args [1]string, argsEscaped bool, r *http.Reque
 	return params, nil
 }
 
-// SyncpolicyDeleteParams is parameters of syncpolicy-delete operation.
-type SyncpolicyDeleteParams struct {
-	// Unique identifier of the sync policy.
+// WorkspaceDeleteParams is parameters of workspace-delete operation.
+type WorkspaceDeleteParams struct {
 	UUID string
 }
 
-func unpackSyncpolicyDeleteParams(packed middleware.Parameters) (params SyncpolicyDeleteParams) {
+func unpackWorkspaceDeleteParams(packed middleware.Parameters) (params WorkspaceDeleteParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -4768,7 +9307,7 @@ func unpackSyncpolicyDeleteParams(packed middleware.Parameters) (params Syncpoli
 	return params
 }
 
-func decodeSyncpolicyDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params SyncpolicyDeleteParams, _ error) {
+func decodeWorkspaceDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params WorkspaceDeleteParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -4817,13 +9356,12 @@ func decodeSyncpolicyDeleteParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// SyncpolicyGetParams is parameters of syncpolicy-get operation.
-type SyncpolicyGetParams struct {
-	// Unique identifier of the sync policy.
+// WorkspaceGetParams is parameters of workspace-get operation.
+type WorkspaceGetParams struct {
 	UUID string
 }
 
-func unpackSyncpolicyGetParams(packed middleware.Parameters) (params SyncpolicyGetParams) {
+func unpackWorkspaceGetParams(packed middleware.Parameters) (params WorkspaceGetParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -4834,7 +9372,7 @@ func unpackSyncpolicyGetParams(packed middleware.Parameters) (params SyncpolicyG
 	return params
 }
 
-func decodeSyncpolicyGetParams(args [1]string, argsEscaped bool, r *http.Request) (params SyncpolicyGetParams, _ error) {
+func decodeWorkspaceGetParams(args [1]string, argsEscaped bool, r *http.Request) (params WorkspaceGetParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -4883,15 +9421,15 @@ func decodeSyncpolicyGetParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// SyncpolicyListParams is parameters of syncpolicy-list operation.
-type SyncpolicyListParams struct {
+// WorkspaceListParams is parameters of workspace-list operation.
+type WorkspaceListParams struct {
 	// Offset records.
 	Offset OptInt32
 	// Limit records.
 	Limit OptInt32
 }
 
-func unpackSyncpolicyListParams(packed middleware.Parameters) (params SyncpolicyListParams) {
+func unpackWorkspaceListParams(packed middleware.Parameters) (params WorkspaceListParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "offset",
@@ -4913,7 +9451,7 @@ func unpackSyncpolicyListParams(packed middleware.Parameters) (params Syncpolicy
 	return params
 }
 
-func decodeSyncpolicyListParams(args [0]string, argsEscaped bool, r *http.Request) (params SyncpolicyListParams, _ error) {
+func decodeWorkspaceListParams(args [0]string, argsEscaped bool, r *http.Request) (params WorkspaceListParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode query: offset.
 	if err := func() error {
@@ -4942,202 +9480,71 @@ func decodeSyncpolicyListParams(args [0]string, argsEscaped bool, r *http.Reques
 				}(); err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: limit.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int32
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt32(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// SyncpolicyUpdateParams is parameters of syncpolicy-update operation.
-type SyncpolicyUpdateParams struct {
-	// Unique identifier of the sync policy.
-	UUID string
-}
-
-func unpackSyncpolicyUpdateParams(packed middleware.Parameters) (params SyncpolicyUpdateParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "uuid",
-			In:   "path",
-		}
-		params.UUID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeSyncpolicyUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params SyncpolicyUpdateParams, _ error) {
-	// Decode path: uuid.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "uuid",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.UUID = c
+				params.Offset.SetTo(paramsDotOffsetVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "uuid",
-			In:   "path",
+			Name: "offset",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// TgSessionVerifyParams is parameters of tg-session-verify operation.
-type TgSessionVerifyParams struct {
-	// Session ID.
-	ID int
-}
-
-func unpackTgSessionVerifyParams(packed middleware.Parameters) (params TgSessionVerifyParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(int)
-	}
-	return params
-}
-
-func decodeTgSessionVerifyParams(args [1]string, argsEscaped bool, r *http.Request) (params TgSessionVerifyParams, _ error) {
-	// Decode path: id.
+	// Decode query: limit.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToInt(val)
-				if err != nil {
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.ID = c
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
-			In:   "path",
+			Name: "limit",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// UpdateContactParams is parameters of updateContact operation.
-type UpdateContactParams struct {
-	UUID string
+// WorkspaceMemberDeleteParams is parameters of workspace-member-delete operation.
+type WorkspaceMemberDeleteParams struct {
+	UUID     string
+	UserUUID string
 }
 
-func unpackUpdateContactParams(packed middleware.Parameters) (params UpdateContactParams) {
+func unpackWorkspaceMemberDeleteParams(packed middleware.Parameters) (params WorkspaceMemberDeleteParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -5145,10 +9552,17 @@ func unpackUpdateContactParams(packed middleware.Parameters) (params UpdateConta
 		}
 		params.UUID = packed[key].(string)
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "user_uuid",
+			In:   "path",
+		}
+		params.UserUUID = packed[key].(string)
+	}
 	return params
 }
 
-func decodeUpdateContactParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateContactParams, _ error) {
+func decodeWorkspaceMemberDeleteParams(args [2]string, argsEscaped bool, r *http.Request) (params WorkspaceMemberDeleteParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -5194,31 +9608,11 @@ func decodeUpdateContactParams(args [1]string, argsEscaped bool, r *http.Request
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// UpdateUserParams is parameters of updateUser operation.
-type UpdateUserParams struct {
-	UUID string
-}
-
-func unpackUpdateUserParams(packed middleware.Parameters) (params UpdateUserParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "uuid",
-			In:   "path",
-		}
-		params.UUID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeUpdateUserParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateUserParams, _ error) {
-	// Decode path: uuid.
+	// Decode path: user_uuid.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -5226,7 +9620,7 @@ func decodeUpdateUserParams(args [1]string, argsEscaped bool, r *http.Request) (
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "uuid",
+				Param:   "user_uuid",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -5243,7 +9637,7 @@ func decodeUpdateUserParams(args [1]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				params.UUID = c
+				params.UserUUID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -5254,7 +9648,7 @@ func decodeUpdateUserParams(args [1]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "uuid",
+			Name: "user_uuid",
 			In:   "path",
 			Err:  err,
 		}
@@ -5262,13 +9656,12 @@ func decodeUpdateUserParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// WorkerJobsCancelParams is parameters of worker-jobs-cancel operation.
-type WorkerJobsCancelParams struct {
-	// Unique identifier of the worker job to cancel.
+// WorkspaceMemberListParams is parameters of workspace-member-list operation.
+type WorkspaceMemberListParams struct {
 	UUID string
 }
 
-func unpackWorkerJobsCancelParams(packed middleware.Parameters) (params WorkerJobsCancelParams) {
+func unpackWorkspaceMemberListParams(packed middleware.Parameters) (params WorkspaceMemberListParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -5279,7 +9672,7 @@ func unpackWorkerJobsCancelParams(packed middleware.Parameters) (params WorkerJo
 	return params
 }
 
-func decodeWorkerJobsCancelParams(args [1]string, argsEscaped bool, r *http.Request) (params WorkerJobsCancelParams, _ error) {
+func decodeWorkspaceMemberListParams(args [1]string, argsEscaped bool, r *http.Request) (params WorkspaceMemberListParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -5328,13 +9721,12 @@ func decodeWorkerJobsCancelParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// WorkerJobsDeleteParams is parameters of worker-jobs-delete operation.
-type WorkerJobsDeleteParams struct {
-	// Unique identifier of the worker job.
+// WorkspaceMemberSetParams is parameters of workspace-member-set operation.
+type WorkspaceMemberSetParams struct {
 	UUID string
 }
 
-func unpackWorkerJobsDeleteParams(packed middleware.Parameters) (params WorkerJobsDeleteParams) {
+func unpackWorkspaceMemberSetParams(packed middleware.Parameters) (params WorkspaceMemberSetParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -5345,7 +9737,7 @@ func unpackWorkerJobsDeleteParams(packed middleware.Parameters) (params WorkerJo
 	return params
 }
 
-func decodeWorkerJobsDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params WorkerJobsDeleteParams, _ error) {
+func decodeWorkspaceMemberSetParams(args [1]string, argsEscaped bool, r *http.Request) (params WorkspaceMemberSetParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -5394,13 +9786,12 @@ func decodeWorkerJobsDeleteParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// WorkerJobsGetParams is parameters of worker-jobs-get operation.
-type WorkerJobsGetParams struct {
-	// Unique identifier of the worker job.
+// WorkspaceUpdateParams is parameters of workspace-update operation.
+type WorkspaceUpdateParams struct {
 	UUID string
 }
 
-func unpackWorkerJobsGetParams(packed middleware.Parameters) (params WorkerJobsGetParams) {
+func unpackWorkspaceUpdateParams(packed middleware.Parameters) (params WorkspaceUpdateParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "uuid",
@@ -5411,7 +9802,7 @@ func unpackWorkerJobsGetParams(packed middleware.Parameters) (params WorkerJobsG
 	return params
 }
 
-func decodeWorkerJobsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params WorkerJobsGetParams, _ error) {
+func decodeWorkspaceUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params WorkspaceUpdateParams, _ error) {
 	// Decode path: uuid.
 	if err := func() error {
 		param := args[0]
@@ -5459,120 +9850,3 @@ func decodeWorkerJobsGetParams(args [1]string, argsEscaped bool, r *http.Request
 	}
 	return params, nil
 }
-
-// WorkerJobsListParams is parameters of worker-jobs-list operation.
-type WorkerJobsListParams struct {
-	// The number of records to skip for pagination.
-	Offset OptInt32
-	// The maximum number of records to return.
-	Limit OptInt32
-}
-
-func unpackWorkerJobsListParams(packed middleware.Parameters) (params WorkerJobsListParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt32)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt32)
-		}
-	}
-	return params
-}
-
-func decodeWorkerJobsListParams(args [0]string, argsEscaped bool, r *http.Request) (params WorkerJobsListParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: offset.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int32
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt32(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Offset.SetTo(paramsDotOffsetVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: limit.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int32
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt32(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
@@ -15,6 +15,77 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
+func (s *Server) decodeApikeyCreateRequest(r *http.Request) (
+	req *APIKey,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request APIKey
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
 func (s *Server) decodeCreateContactRequest(r *http.Request) (
 	req *Contact,
 	close func() error,
@@ -212,6 +283,203 @@ func (s *Server) decodeDatasourceEmailCreateRequest(r *http.Request) (
 	}
 }
 
+func (s *Server) decodeDatasourceEmailGraphCreateRequest(r *http.Request) (
+	req *DatasourceEmailGraph,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request DatasourceEmailGraph
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeDatasourceEmailGraphSendRequest(r *http.Request) (
+	req *EmailSend,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request EmailSend
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeDatasourceEmailGraphUpdateRequest(r *http.Request) (
+	req *DatasourceEmailGraph,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request DatasourceEmailGraph
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
 func (s *Server) decodeDatasourceEmailOAuthCreateRequest(r *http.Request) (
 	req *DatasourceEmailOAuth,
 	close func() error,
@@ -653,8 +921,812 @@ func (s *Server) decodeDatasourceTelegramCreateRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeDatasourceTelegramUpdateRequest(r *http.Request) (
-	req *DatasourceTelegram,
+func (s *Server) decodeDatasourceTelegramUpdateRequest(r *http.Request) (
+	req *DatasourceTelegram,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request DatasourceTelegram
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeDatasourceWhatsappCreateRequest(r *http.Request) (
+	req *DatasourceWhatsapp,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request DatasourceWhatsapp
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeDatasourceWhatsappUpdateRequest(r *http.Request) (
+	req *DatasourceWhatsapp,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request DatasourceWhatsapp
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeErasureCreateRequest(r *http.Request) (
+	req *ErasureRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request ErasureRequest
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeFileCreateRequest(r *http.Request) (
+	req *UploadFileRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request UploadFileRequest
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeFileUpdateRequest(r *http.Request) (
+	req *FileUpdateReq,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request FileUpdateReq
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeGenerateDownloadLinkRequest(r *http.Request) (
+	req *GenerateDownloadLinkRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request GenerateDownloadLinkRequest
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeGeneratePresignedUploadUrlRequest(r *http.Request) (
+	req *UploadPresignedUrlRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request UploadPresignedUrlRequest
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeMessageEmailQueryRequest(r *http.Request) (
+	req *MessageQuery,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request MessageQuery
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeMessageLinkedinQueryRequest(r *http.Request) (
+	req *MessageQuery,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request MessageQuery
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeMessageQueryRequest(r *http.Request) (
+	req *MessageQuery,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request MessageQuery
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeMessageTelegramQueryRequest(r *http.Request) (
+	req *MessageQuery,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request MessageQuery
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeMessageWhatsappQueryRequest(r *http.Request) (
+	req *MessageQuery,
 	close func() error,
 	rerr error,
 ) {
@@ -693,7 +1765,7 @@ func (s *Server) decodeDatasourceTelegramUpdateRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request DatasourceTelegram
+		var request MessageQuery
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -710,14 +1782,22 @@ func (s *Server) decodeDatasourceTelegramUpdateRequest(r *http.Request) (
 			}
 			return req, close, err
 		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodeDatasourceWhatsappCreateRequest(r *http.Request) (
-	req *DatasourceWhatsapp,
+func (s *Server) decodeOAuth2ClientCreateRequest(r *http.Request) (
+	req *OAuth2ClientCreateReq,
 	close func() error,
 	rerr error,
 ) {
@@ -756,7 +1836,7 @@ func (s *Server) decodeDatasourceWhatsappCreateRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request DatasourceWhatsapp
+		var request OAuth2ClientCreateReq
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -779,8 +1859,8 @@ func (s *Server) decodeDatasourceWhatsappCreateRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeDatasourceWhatsappUpdateRequest(r *http.Request) (
-	req *DatasourceWhatsapp,
+func (s *Server) decodeOAuth2ClientLoginRequest(r *http.Request) (
+	req *OAuth2ClientLoginReq,
 	close func() error,
 	rerr error,
 ) {
@@ -819,7 +1899,7 @@ func (s *Server) decodeDatasourceWhatsappUpdateRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request DatasourceWhatsapp
+		var request OAuth2ClientLoginReq
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -836,14 +1916,22 @@ func (s *Server) decodeDatasourceWhatsappUpdateRequest(r *http.Request) (
 			}
 			return req, close, err
 		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodeFileCreateRequest(r *http.Request) (
-	req *UploadFileRequest,
+func (s *Server) decodeOAuth2ClientUpdateRequest(r *http.Request) (
+	req *OAuth2ClientUpdateReq,
 	close func() error,
 	rerr error,
 ) {
@@ -882,7 +1970,7 @@ func (s *Server) decodeFileCreateRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request UploadFileRequest
+		var request OAuth2ClientUpdateReq
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -905,8 +1993,8 @@ func (s *Server) decodeFileCreateRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeFileUpdateRequest(r *http.Request) (
-	req *FileUpdateReq,
+func (s *Server) decodePipelineCreateRequest(r *http.Request) (
+	req *Pipeline,
 	close func() error,
 	rerr error,
 ) {
@@ -945,7 +2033,7 @@ func (s *Server) decodeFileUpdateRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request FileUpdateReq
+		var request Pipeline
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -962,14 +2050,22 @@ func (s *Server) decodeFileUpdateRequest(r *http.Request) (
 			}
 			return req, close, err
 		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodeGenerateDownloadLinkRequest(r *http.Request) (
-	req *GenerateDownloadLinkRequest,
+func (s *Server) decodePipelineRunRequest(r *http.Request) (
+	req OptPipelineRun,
 	close func() error,
 	rerr error,
 ) {
@@ -988,6 +2084,9 @@ func (s *Server) decodeGenerateDownloadLinkRequest(r *http.Request) (
 			rerr = multierr.Append(rerr, close())
 		}
 	}()
+	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
+		return req, close, nil
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, close, errors.Wrap(err, "parse media type")
@@ -995,7 +2094,7 @@ func (s *Server) decodeGenerateDownloadLinkRequest(r *http.Request) (
 	switch {
 	case ct == "application/json":
 		if r.ContentLength == 0 {
-			return req, close, validate.ErrBodyRequired
+			return req, close, nil
 		}
 		buf, err := io.ReadAll(r.Body)
 		if err != nil {
@@ -1003,13 +2102,14 @@ func (s *Server) decodeGenerateDownloadLinkRequest(r *http.Request) (
 		}
 
 		if len(buf) == 0 {
-			return req, close, validate.ErrBodyRequired
+			return req, close, nil
 		}
 
 		d := jx.DecodeBytes(buf)
 
-		var request GenerateDownloadLinkRequest
+		var request OptPipelineRun
 		if err := func() error {
+			request.Reset()
 			if err := request.Decode(d); err != nil {
 				return err
 			}
@@ -1025,14 +2125,29 @@ func (s *Server) decodeGenerateDownloadLinkRequest(r *http.Request) (
 			}
 			return req, close, err
 		}
-		return &request, close, nil
+		if err := func() error {
+			if value, ok := request.Get(); ok {
+				if err := func() error {
+					if err := value.Validate(); err != nil {
+						return err
+					}
+					return nil
+				}(); err != nil {
+					return err
+				}
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
+		return request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodeGeneratePresignedUploadUrlRequest(r *http.Request) (
-	req *UploadPresignedUrlRequest,
+func (s *Server) decodePipelineUpdateRequest(r *http.Request) (
+	req *Pipeline,
 	close func() error,
 	rerr error,
 ) {
@@ -1071,7 +2186,7 @@ func (s *Server) decodeGeneratePresignedUploadUrlRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request UploadPresignedUrlRequest
+		var request Pipeline
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -1102,8 +2217,8 @@ func (s *Server) decodeGeneratePresignedUploadUrlRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeMessageEmailQueryRequest(r *http.Request) (
-	req *MessageQuery,
+func (s *Server) decodePolicyCreateRequest(r *http.Request) (
+	req *Policy,
 	close func() error,
 	rerr error,
 ) {
@@ -1142,7 +2257,7 @@ func (s *Server) decodeMessageEmailQueryRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request MessageQuery
+		var request Policy
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -1173,8 +2288,8 @@ func (s *Server) decodeMessageEmailQueryRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeMessageLinkedinQueryRequest(r *http.Request) (
-	req *MessageQuery,
+func (s *Server) decodePolicyUpdateRequest(r *http.Request) (
+	req *Policy,
 	close func() error,
 	rerr error,
 ) {
@@ -1213,7 +2328,7 @@ func (s *Server) decodeMessageLinkedinQueryRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request MessageQuery
+		var request Policy
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -1244,8 +2359,8 @@ func (s *Server) decodeMessageLinkedinQueryRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeMessageQueryRequest(r *http.Request) (
-	req *MessageQuery,
+func (s *Server) decodeRetentionPolicyCreateRequest(r *http.Request) (
+	req *RetentionPolicy,
 	close func() error,
 	rerr error,
 ) {
@@ -1284,7 +2399,7 @@ func (s *Server) decodeMessageQueryRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request MessageQuery
+		var request RetentionPolicy
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -1301,22 +2416,14 @@ func (s *Server) decodeMessageQueryRequest(r *http.Request) (
 			}
 			return req, close, err
 		}
-		if err := func() error {
-			if err := request.Validate(); err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return req, close, errors.Wrap(err, "validate")
-		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodeMessageTelegramQueryRequest(r *http.Request) (
-	req *MessageQuery,
+func (s *Server) decodeRetentionPolicyUpdateRequest(r *http.Request) (
+	req *RetentionPolicy,
 	close func() error,
 	rerr error,
 ) {
@@ -1355,7 +2462,7 @@ func (s *Server) decodeMessageTelegramQueryRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request MessageQuery
+		var request RetentionPolicy
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -1372,22 +2479,14 @@ func (s *Server) decodeMessageTelegramQueryRequest(r *http.Request) (
 			}
 			return req, close, err
 		}
-		if err := func() error {
-			if err := request.Validate(); err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return req, close, errors.Wrap(err, "validate")
-		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodeMessageWhatsappQueryRequest(r *http.Request) (
-	req *MessageQuery,
+func (s *Server) decodeSchedulerCreateRequest(r *http.Request) (
+	req *Scheduler,
 	close func() error,
 	rerr error,
 ) {
@@ -1426,7 +2525,7 @@ func (s *Server) decodeMessageWhatsappQueryRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request MessageQuery
+		var request Scheduler
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -1457,8 +2556,8 @@ func (s *Server) decodeMessageWhatsappQueryRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeOAuth2ClientCreateRequest(r *http.Request) (
-	req *OAuth2ClientCreateReq,
+func (s *Server) decodeSchedulerUpdateRequest(r *http.Request) (
+	req *Scheduler,
 	close func() error,
 	rerr error,
 ) {
@@ -1497,7 +2596,7 @@ func (s *Server) decodeOAuth2ClientCreateRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request OAuth2ClientCreateReq
+		var request Scheduler
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -1514,14 +2613,22 @@ func (s *Server) decodeOAuth2ClientCreateRequest(r *http.Request) (
 			}
 			return req, close, err
 		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodeOAuth2ClientLoginRequest(r *http.Request) (
-	req *OAuth2ClientLoginReq,
+func (s *Server) decodeStorageHostfilesCreateRequest(r *http.Request) (
+	req *StorageHostfiles,
 	close func() error,
 	rerr error,
 ) {
@@ -1560,7 +2667,7 @@ func (s *Server) decodeOAuth2ClientLoginRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request OAuth2ClientLoginReq
+		var request StorageHostfiles
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -1577,22 +2684,14 @@ func (s *Server) decodeOAuth2ClientLoginRequest(r *http.Request) (
 			}
 			return req, close, err
 		}
-		if err := func() error {
-			if err := request.Validate(); err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return req, close, errors.Wrap(err, "validate")
-		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodeOAuth2ClientUpdateRequest(r *http.Request) (
-	req *OAuth2ClientUpdateReq,
+func (s *Server) decodeStorageHostfilesUpdateRequest(r *http.Request) (
+	req *StorageHostfiles,
 	close func() error,
 	rerr error,
 ) {
@@ -1631,7 +2730,7 @@ func (s *Server) decodeOAuth2ClientUpdateRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request OAuth2ClientUpdateReq
+		var request StorageHostfiles
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -1654,8 +2753,8 @@ func (s *Server) decodeOAuth2ClientUpdateRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodePipelineCreateRequest(r *http.Request) (
-	req *Pipeline,
+func (s *Server) decodeStorageMigrateRequest(r *http.Request) (
+	req *StorageMigration,
 	close func() error,
 	rerr error,
 ) {
@@ -1694,7 +2793,7 @@ func (s *Server) decodePipelineCreateRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request Pipeline
+		var request StorageMigration
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -1711,22 +2810,14 @@ func (s *Server) decodePipelineCreateRequest(r *http.Request) (
 			}
 			return req, close, err
 		}
-		if err := func() error {
-			if err := request.Validate(); err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return req, close, errors.Wrap(err, "validate")
-		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodePipelineUpdateRequest(r *http.Request) (
-	req *Pipeline,
+func (s *Server) decodeStoragePostgresCreateRequest(r *http.Request) (
+	req *StoragePostgres,
 	close func() error,
 	rerr error,
 ) {
@@ -1765,7 +2856,7 @@ func (s *Server) decodePipelineUpdateRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request Pipeline
+		var request StoragePostgres
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -1782,22 +2873,14 @@ func (s *Server) decodePipelineUpdateRequest(r *http.Request) (
 			}
 			return req, close, err
 		}
-		if err := func() error {
-			if err := request.Validate(); err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return req, close, errors.Wrap(err, "validate")
-		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodeSchedulerCreateRequest(r *http.Request) (
-	req *Scheduler,
+func (s *Server) decodeStoragePostgresUpdateRequest(r *http.Request) (
+	req *StoragePostgres,
 	close func() error,
 	rerr error,
 ) {
@@ -1836,7 +2919,7 @@ func (s *Server) decodeSchedulerCreateRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request Scheduler
+		var request StoragePostgres
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -1859,8 +2942,8 @@ func (s *Server) decodeSchedulerCreateRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeSchedulerUpdateRequest(r *http.Request) (
-	req *Scheduler,
+func (s *Server) decodeStorageS3CreateRequest(r *http.Request) (
+	req *StorageS3,
 	close func() error,
 	rerr error,
 ) {
@@ -1899,7 +2982,7 @@ func (s *Server) decodeSchedulerUpdateRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request Scheduler
+		var request StorageS3
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -1922,8 +3005,8 @@ func (s *Server) decodeSchedulerUpdateRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeStorageHostfilesCreateRequest(r *http.Request) (
-	req *StorageHostfiles,
+func (s *Server) decodeStorageS3UpdateRequest(r *http.Request) (
+	req *StorageS3,
 	close func() error,
 	rerr error,
 ) {
@@ -1962,7 +3045,7 @@ func (s *Server) decodeStorageHostfilesCreateRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request StorageHostfiles
+		var request StorageS3
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -1985,8 +3068,8 @@ func (s *Server) decodeStorageHostfilesCreateRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeStorageHostfilesUpdateRequest(r *http.Request) (
-	req *StorageHostfiles,
+func (s *Server) decodeSyncpolicyCreateRequest(r *http.Request) (
+	req *SyncPolicy,
 	close func() error,
 	rerr error,
 ) {
@@ -2025,7 +3108,7 @@ func (s *Server) decodeStorageHostfilesUpdateRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request StorageHostfiles
+		var request SyncPolicy
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -2048,8 +3131,8 @@ func (s *Server) decodeStorageHostfilesUpdateRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeStoragePostgresCreateRequest(r *http.Request) (
-	req *StoragePostgres,
+func (s *Server) decodeSyncpolicyUpdateRequest(r *http.Request) (
+	req *SyncPolicy,
 	close func() error,
 	rerr error,
 ) {
@@ -2088,7 +3171,7 @@ func (s *Server) decodeStoragePostgresCreateRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request StoragePostgres
+		var request SyncPolicy
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -2111,8 +3194,8 @@ func (s *Server) decodeStoragePostgresCreateRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeStoragePostgresUpdateRequest(r *http.Request) (
-	req *StoragePostgres,
+func (s *Server) decodeTgSessionCreateRequest(r *http.Request) (
+	req *TgSessionCreateReq,
 	close func() error,
 	rerr error,
 ) {
@@ -2151,7 +3234,7 @@ func (s *Server) decodeStoragePostgresUpdateRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request StoragePostgres
+		var request TgSessionCreateReq
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -2174,8 +3257,8 @@ func (s *Server) decodeStoragePostgresUpdateRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeStorageS3CreateRequest(r *http.Request) (
-	req *StorageS3,
+func (s *Server) decodeTgSessionVerifyRequest(r *http.Request) (
+	req *TgSessionVerifyReq,
 	close func() error,
 	rerr error,
 ) {
@@ -2214,7 +3297,7 @@ func (s *Server) decodeStorageS3CreateRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request StorageS3
+		var request TgSessionVerifyReq
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -2237,8 +3320,8 @@ func (s *Server) decodeStorageS3CreateRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeStorageS3UpdateRequest(r *http.Request) (
-	req *StorageS3,
+func (s *Server) decodeUpdateContactRequest(r *http.Request) (
+	req *Contact,
 	close func() error,
 	rerr error,
 ) {
@@ -2277,7 +3360,7 @@ func (s *Server) decodeStorageS3UpdateRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request StorageS3
+		var request Contact
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -2300,8 +3383,8 @@ func (s *Server) decodeStorageS3UpdateRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeSyncpolicyCreateRequest(r *http.Request) (
-	req *SyncPolicy,
+func (s *Server) decodeUpdateProfileRequest(r *http.Request) (
+	req *UserProfile,
 	close func() error,
 	rerr error,
 ) {
@@ -2340,7 +3423,7 @@ func (s *Server) decodeSyncpolicyCreateRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request SyncPolicy
+		var request UserProfile
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -2363,8 +3446,8 @@ func (s *Server) decodeSyncpolicyCreateRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeSyncpolicyUpdateRequest(r *http.Request) (
-	req *SyncPolicy,
+func (s *Server) decodeUpdateUserRequest(r *http.Request) (
+	req *User,
 	close func() error,
 	rerr error,
 ) {
@@ -2403,7 +3486,7 @@ func (s *Server) decodeSyncpolicyUpdateRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request SyncPolicy
+		var request User
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -2420,14 +3503,22 @@ func (s *Server) decodeSyncpolicyUpdateRequest(r *http.Request) (
 			}
 			return req, close, err
 		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodeTgSessionCreateRequest(r *http.Request) (
-	req *TgSessionCreateReq,
+func (s *Server) decodeUploadFileRequest(r *http.Request) (
+	req *UploadFileRequest,
 	close func() error,
 	rerr error,
 ) {
@@ -2466,7 +3557,7 @@ func (s *Server) decodeTgSessionCreateRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request TgSessionCreateReq
+		var request UploadFileRequest
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -2489,8 +3580,8 @@ func (s *Server) decodeTgSessionCreateRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeTgSessionVerifyRequest(r *http.Request) (
-	req *TgSessionVerifyReq,
+func (s *Server) decodeWebhookCreateRequest(r *http.Request) (
+	req *Webhook,
 	close func() error,
 	rerr error,
 ) {
@@ -2529,7 +3620,7 @@ func (s *Server) decodeTgSessionVerifyRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request TgSessionVerifyReq
+		var request Webhook
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -2552,8 +3643,8 @@ func (s *Server) decodeTgSessionVerifyRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeUpdateContactRequest(r *http.Request) (
-	req *Contact,
+func (s *Server) decodeWebhookUpdateRequest(r *http.Request) (
+	req *Webhook,
 	close func() error,
 	rerr error,
 ) {
@@ -2592,7 +3683,7 @@ func (s *Server) decodeUpdateContactRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request Contact
+		var request Webhook
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -2615,8 +3706,8 @@ func (s *Server) decodeUpdateContactRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeUpdateProfileRequest(r *http.Request) (
-	req *UserProfile,
+func (s *Server) decodeWorkspaceCreateRequest(r *http.Request) (
+	req *Workspace,
 	close func() error,
 	rerr error,
 ) {
@@ -2655,7 +3746,7 @@ func (s *Server) decodeUpdateProfileRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request UserProfile
+		var request Workspace
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -2678,8 +3769,8 @@ func (s *Server) decodeUpdateProfileRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeUpdateUserRequest(r *http.Request) (
-	req *User,
+func (s *Server) decodeWorkspaceMemberSetRequest(r *http.Request) (
+	req *WorkspaceMember,
 	close func() error,
 	rerr error,
 ) {
@@ -2718,7 +3809,7 @@ func (s *Server) decodeUpdateUserRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request User
+		var request WorkspaceMember
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -2749,8 +3840,8 @@ func (s *Server) decodeUpdateUserRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeUploadFileRequest(r *http.Request) (
-	req *UploadFileRequest,
+func (s *Server) decodeWorkspaceUpdateRequest(r *http.Request) (
+	req *Workspace,
 	close func() error,
 	rerr error,
 ) {
@@ -2789,7 +3880,7 @@ func (s *Server) decodeUploadFileRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request UploadFileRequest
+		var request Workspace
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -3,6 +3,7 @@
 package api
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"mime"
@@ -18,7 +19,7 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
-func decodeCreateContactResponse(resp *http.Response) (res *Contact, _ error) {
+func decodeApikeyCreateResponse(resp *http.Response) (res *APIKey, _ error) {
 	switch resp.StatusCode {
 	case 201:
 		// Code 201.
@@ -34,7 +35,7 @@ func decodeCreateContactResponse(resp *http.Response) (res *Contact, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Contact
+			var response APIKey
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -51,6 +52,15 @@ func decodeCreateContactResponse(resp *http.Response) (res *Contact, _ error) {
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -101,10 +111,10 @@ func decodeCreateContactResponse(resp *http.Response) (res *Contact, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeCreateUserResponse(resp *http.Response) (res *User, _ error) {
+func decodeApikeyListResponse(resp *http.Response) (res []APIKey, _ error) {
 	switch resp.StatusCode {
-	case 201:
-		// Code 201.
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -117,9 +127,17 @@ func decodeCreateUserResponse(resp *http.Response) (res *User, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response User
+			var response []APIKey
 			if err := func() error {
-				if err := response.Decode(d); err != nil {
+				response = make([]APIKey, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem APIKey
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -136,14 +154,31 @@ func decodeCreateUserResponse(resp *http.Response) (res *User, _ error) {
 			}
 			// Validate response.
 			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				var failures []validate.FieldError
+				for i, elem := range response {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
 				}
 				return nil
 			}(); err != nil {
 				return res, errors.Wrap(err, "validate")
 			}
-			return &response, nil
+			return response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -193,10 +228,14 @@ func decodeCreateUserResponse(resp *http.Response) (res *User, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceEmailCreateResponse(resp *http.Response) (res *DatasourceEmail, _ error) {
+func decodeApikeyRevokeResponse(resp *http.Response) (res *ApikeyRevokeOK, _ error) {
 	switch resp.StatusCode {
-	case 201:
-		// Code 201.
+	case 200:
+		// Code 200.
+		return &ApikeyRevokeOK{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -209,7 +248,7 @@ func decodeDatasourceEmailCreateResponse(resp *http.Response) (res *DatasourceEm
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DatasourceEmail
+			var response Error
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -226,7 +265,38 @@ func decodeDatasourceEmailCreateResponse(resp *http.Response) (res *DatasourceEm
 				}
 				return res, err
 			}
-			return &response, nil
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeAuditExportResponse(resp *http.Response) (res AuditExportOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/x-ndjson":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := AuditExportOK{Data: bytes.NewReader(b)}
+			return response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -276,11 +346,77 @@ func decodeDatasourceEmailCreateResponse(resp *http.Response) (res *DatasourceEm
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceEmailDeleteResponse(resp *http.Response) (res *DatasourceEmailDeleteOK, _ error) {
+func decodeAuditListResponse(resp *http.Response) (res []AuditEvent, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
-		return &DatasourceEmailDeleteOK{}, nil
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []AuditEvent
+			if err := func() error {
+				response = make([]AuditEvent, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem AuditEvent
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				var failures []validate.FieldError
+				for i, elem := range response {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -327,10 +463,10 @@ func decodeDatasourceEmailDeleteResponse(resp *http.Response) (res *DatasourceEm
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceEmailGetResponse(resp *http.Response) (res *DatasourceEmail, _ error) {
+func decodeCreateContactResponse(resp *http.Response) (res *Contact, _ error) {
 	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 201:
+		// Code 201.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -343,7 +479,7 @@ func decodeDatasourceEmailGetResponse(resp *http.Response) (res *DatasourceEmail
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DatasourceEmail
+			var response Contact
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -410,10 +546,10 @@ func decodeDatasourceEmailGetResponse(resp *http.Response) (res *DatasourceEmail
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceEmailListResponse(resp *http.Response) (res []DatasourceEmail, _ error) {
+func decodeCreateUserResponse(resp *http.Response) (res *User, _ error) {
 	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 201:
+		// Code 201.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -426,17 +562,9 @@ func decodeDatasourceEmailListResponse(resp *http.Response) (res []DatasourceEma
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response []DatasourceEmail
+			var response User
 			if err := func() error {
-				response = make([]DatasourceEmail, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem DatasourceEmail
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					response = append(response, elem)
-					return nil
-				}); err != nil {
+				if err := response.Decode(d); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -453,14 +581,14 @@ func decodeDatasourceEmailListResponse(resp *http.Response) (res []DatasourceEma
 			}
 			// Validate response.
 			if err := func() error {
-				if response == nil {
-					return errors.New("nil is invalid value")
+				if err := response.Validate(); err != nil {
+					return err
 				}
 				return nil
 			}(); err != nil {
 				return res, errors.Wrap(err, "validate")
 			}
-			return response, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -510,7 +638,7 @@ func decodeDatasourceEmailListResponse(resp *http.Response) (res []DatasourceEma
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceEmailOAuthCreateResponse(resp *http.Response) (res *DatasourceEmailOAuth, _ error) {
+func decodeDatasourceEmailCreateResponse(resp *http.Response) (res *DatasourceEmail, _ error) {
 	switch resp.StatusCode {
 	case 201:
 		// Code 201.
@@ -526,7 +654,7 @@ func decodeDatasourceEmailOAuthCreateResponse(resp *http.Response) (res *Datasou
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DatasourceEmailOAuth
+			var response DatasourceEmail
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -593,11 +721,11 @@ func decodeDatasourceEmailOAuthCreateResponse(resp *http.Response) (res *Datasou
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceEmailOAuthDeleteResponse(resp *http.Response) (res *DatasourceEmailOAuthDeleteOK, _ error) {
+func decodeDatasourceEmailDeleteResponse(resp *http.Response) (res *DatasourceEmailDeleteOK, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
-		return &DatasourceEmailOAuthDeleteOK{}, nil
+		return &DatasourceEmailDeleteOK{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -644,7 +772,7 @@ func decodeDatasourceEmailOAuthDeleteResponse(resp *http.Response) (res *Datasou
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceEmailOAuthGetResponse(resp *http.Response) (res *DatasourceEmailOAuth, _ error) {
+func decodeDatasourceEmailGetResponse(resp *http.Response) (res *DatasourceEmail, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -660,7 +788,7 @@ func decodeDatasourceEmailOAuthGetResponse(resp *http.Response) (res *Datasource
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DatasourceEmailOAuth
+			var response DatasourceEmail
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -727,10 +855,10 @@ func decodeDatasourceEmailOAuthGetResponse(resp *http.Response) (res *Datasource
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceEmailOAuthListResponse(resp *http.Response) (res []DatasourceEmailOAuth, _ error) {
+func decodeDatasourceEmailGraphCreateResponse(resp *http.Response) (res *DatasourceEmailGraph, _ error) {
 	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 201:
+		// Code 201.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -743,17 +871,9 @@ func decodeDatasourceEmailOAuthListResponse(resp *http.Response) (res []Datasour
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response []DatasourceEmailOAuth
+			var response DatasourceEmailGraph
 			if err := func() error {
-				response = make([]DatasourceEmailOAuth, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem DatasourceEmailOAuth
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					response = append(response, elem)
-					return nil
-				}); err != nil {
+				if err := response.Decode(d); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -768,16 +888,7 @@ func decodeDatasourceEmailOAuthListResponse(resp *http.Response) (res []Datasour
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if response == nil {
-					return errors.New("nil is invalid value")
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
-			return response, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -827,10 +938,14 @@ func decodeDatasourceEmailOAuthListResponse(resp *http.Response) (res []Datasour
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceEmailOAuthUpdateResponse(resp *http.Response) (res *DatasourceEmailOAuth, _ error) {
+func decodeDatasourceEmailGraphDeleteResponse(resp *http.Response) (res *DatasourceEmailGraphDeleteOK, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
+		return &DatasourceEmailGraphDeleteOK{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -843,7 +958,7 @@ func decodeDatasourceEmailOAuthUpdateResponse(resp *http.Response) (res *Datasou
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DatasourceEmailOAuth
+			var response Error
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -860,13 +975,24 @@ func decodeDatasourceEmailOAuthUpdateResponse(resp *http.Response) (res *Datasou
 				}
 				return res, err
 			}
-			return &response, nil
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
 	}
-	// Convenient error response.
-	defRes, err := func() (res *ErrorStatusCode, err error) {
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeDatasourceEmailGraphGetResponse(resp *http.Response) (res *DatasourceEmailGraph, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -879,7 +1005,7 @@ func decodeDatasourceEmailOAuthUpdateResponse(resp *http.Response) (res *Datasou
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response DatasourceEmailGraph
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -896,54 +1022,7 @@ func decodeDatasourceEmailOAuthUpdateResponse(resp *http.Response) (res *Datasou
 				}
 				return res, err
 			}
-			return &ErrorStatusCode{
-				StatusCode: resp.StatusCode,
-				Response:   response,
-			}, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	}()
-	if err != nil {
-		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
-	}
-	return res, errors.Wrap(defRes, "error")
-}
-
-func decodeDatasourceEmailUpdateResponse(resp *http.Response) (res *DatasourceEmail, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
-
-			var response DatasourceEmail
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			return &response, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -993,10 +1072,10 @@ func decodeDatasourceEmailUpdateResponse(resp *http.Response) (res *DatasourceEm
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceLinkedinCreateResponse(resp *http.Response) (res *DatasourceLinkedin, _ error) {
+func decodeDatasourceEmailGraphListResponse(resp *http.Response) (res []DatasourceEmailGraph, _ error) {
 	switch resp.StatusCode {
-	case 201:
-		// Code 201.
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -1009,9 +1088,17 @@ func decodeDatasourceLinkedinCreateResponse(resp *http.Response) (res *Datasourc
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DatasourceLinkedin
+			var response []DatasourceEmailGraph
 			if err := func() error {
-				if err := response.Decode(d); err != nil {
+				response = make([]DatasourceEmailGraph, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem DatasourceEmailGraph
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -1026,7 +1113,16 @@ func decodeDatasourceLinkedinCreateResponse(resp *http.Response) (res *Datasourc
 				}
 				return res, err
 			}
-			return &response, nil
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -1076,11 +1172,11 @@ func decodeDatasourceLinkedinCreateResponse(resp *http.Response) (res *Datasourc
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceLinkedinDeleteResponse(resp *http.Response) (res *DatasourceLinkedinDeleteOK, _ error) {
+func decodeDatasourceEmailGraphSendResponse(resp *http.Response) (res *DatasourceEmailGraphSendAccepted, _ error) {
 	switch resp.StatusCode {
-	case 200:
-		// Code 200.
-		return &DatasourceLinkedinDeleteOK{}, nil
+	case 202:
+		// Code 202.
+		return &DatasourceEmailGraphSendAccepted{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -1127,7 +1223,7 @@ func decodeDatasourceLinkedinDeleteResponse(resp *http.Response) (res *Datasourc
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceLinkedinGetResponse(resp *http.Response) (res *DatasourceLinkedin, _ error) {
+func decodeDatasourceEmailGraphUpdateResponse(resp *http.Response) (res *DatasourceEmailGraph, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -1143,7 +1239,7 @@ func decodeDatasourceLinkedinGetResponse(resp *http.Response) (res *DatasourceLi
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DatasourceLinkedin
+			var response DatasourceEmailGraph
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1210,7 +1306,7 @@ func decodeDatasourceLinkedinGetResponse(resp *http.Response) (res *DatasourceLi
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceLinkedinListResponse(resp *http.Response) (res []DatasourceLinkedin, _ error) {
+func decodeDatasourceEmailListResponse(resp *http.Response) (res []DatasourceEmail, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -1226,11 +1322,11 @@ func decodeDatasourceLinkedinListResponse(resp *http.Response) (res []Datasource
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response []DatasourceLinkedin
+			var response []DatasourceEmail
 			if err := func() error {
-				response = make([]DatasourceLinkedin, 0)
+				response = make([]DatasourceEmail, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem DatasourceLinkedin
+					var elem DatasourceEmail
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -1310,10 +1406,10 @@ func decodeDatasourceLinkedinListResponse(resp *http.Response) (res []Datasource
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceLinkedinUpdateResponse(resp *http.Response) (res *DatasourceLinkedin, _ error) {
+func decodeDatasourceEmailOAuthCreateResponse(resp *http.Response) (res *DatasourceEmailOAuth, _ error) {
 	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 201:
+		// Code 201.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -1326,7 +1422,7 @@ func decodeDatasourceLinkedinUpdateResponse(resp *http.Response) (res *Datasourc
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DatasourceLinkedin
+			var response DatasourceEmailOAuth
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1393,10 +1489,14 @@ func decodeDatasourceLinkedinUpdateResponse(resp *http.Response) (res *Datasourc
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceListResponse(resp *http.Response) (res []Datasource, _ error) {
+func decodeDatasourceEmailOAuthDeleteResponse(resp *http.Response) (res *DatasourceEmailOAuthDeleteOK, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
+		return &DatasourceEmailOAuthDeleteOK{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -1409,17 +1509,9 @@ func decodeDatasourceListResponse(resp *http.Response) (res []Datasource, _ erro
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response []Datasource
+			var response Error
 			if err := func() error {
-				response = make([]Datasource, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Datasource
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					response = append(response, elem)
-					return nil
-				}); err != nil {
+				if err := response.Decode(d); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -1434,22 +1526,24 @@ func decodeDatasourceListResponse(resp *http.Response) (res []Datasource, _ erro
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if response == nil {
-					return errors.New("nil is invalid value")
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
-			return response, nil
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
 	}
-	// Convenient error response.
-	defRes, err := func() (res *ErrorStatusCode, err error) {
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeDatasourceEmailOAuthGetResponse(resp *http.Response) (res *DatasourceEmailOAuth, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -1462,7 +1556,7 @@ func decodeDatasourceListResponse(resp *http.Response) (res []Datasource, _ erro
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response DatasourceEmailOAuth
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1479,25 +1573,10 @@ func decodeDatasourceListResponse(resp *http.Response) (res []Datasource, _ erro
 				}
 				return res, err
 			}
-			return &ErrorStatusCode{
-				StatusCode: resp.StatusCode,
-				Response:   response,
-			}, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}()
-	if err != nil {
-		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
-	}
-	return res, errors.Wrap(defRes, "error")
-}
-
-func decodeDatasourceSetOAuth2ClientResponse(resp *http.Response) (res *DatasourceSetOAuth2ClientNoContent, _ error) {
-	switch resp.StatusCode {
-	case 204:
-		// Code 204.
-		return &DatasourceSetOAuth2ClientNoContent{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -1544,10 +1623,10 @@ func decodeDatasourceSetOAuth2ClientResponse(resp *http.Response) (res *Datasour
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceTelegramCreateResponse(resp *http.Response) (res *DatasourceTelegram, _ error) {
+func decodeDatasourceEmailOAuthListResponse(resp *http.Response) (res []DatasourceEmailOAuth, _ error) {
 	switch resp.StatusCode {
-	case 201:
-		// Code 201.
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -1560,9 +1639,17 @@ func decodeDatasourceTelegramCreateResponse(resp *http.Response) (res *Datasourc
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DatasourceTelegram
+			var response []DatasourceEmailOAuth
 			if err := func() error {
-				if err := response.Decode(d); err != nil {
+				response = make([]DatasourceEmailOAuth, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem DatasourceEmailOAuth
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -1577,61 +1664,19 @@ func decodeDatasourceTelegramCreateResponse(resp *http.Response) (res *Datasourc
 				}
 				return res, err
 			}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	}
-	// Convenient error response.
-	defRes, err := func() (res *ErrorStatusCode, err error) {
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
-
-			var response Error
+			// Validate response.
 			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
+				if response == nil {
+					return errors.New("nil is invalid value")
 				}
 				return nil
 			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
+				return res, errors.Wrap(err, "validate")
 			}
-			return &ErrorStatusCode{
-				StatusCode: resp.StatusCode,
-				Response:   response,
-			}, nil
+			return response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}()
-	if err != nil {
-		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
-	}
-	return res, errors.Wrap(defRes, "error")
-}
-
-func decodeDatasourceTelegramDeleteResponse(resp *http.Response) (res *DatasourceTelegramDeleteOK, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
-		return &DatasourceTelegramDeleteOK{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -1678,7 +1723,7 @@ func decodeDatasourceTelegramDeleteResponse(resp *http.Response) (res *Datasourc
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceTelegramGetResponse(resp *http.Response) (res *DatasourceTelegram, _ error) {
+func decodeDatasourceEmailOAuthUpdateResponse(resp *http.Response) (res *DatasourceEmailOAuth, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -1694,7 +1739,7 @@ func decodeDatasourceTelegramGetResponse(resp *http.Response) (res *DatasourceTe
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DatasourceTelegram
+			var response DatasourceEmailOAuth
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1761,7 +1806,7 @@ func decodeDatasourceTelegramGetResponse(resp *http.Response) (res *DatasourceTe
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceTelegramListResponse(resp *http.Response) (res []DatasourceTelegram, _ error) {
+func decodeDatasourceEmailUpdateResponse(resp *http.Response) (res *DatasourceEmail, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -1777,17 +1822,9 @@ func decodeDatasourceTelegramListResponse(resp *http.Response) (res []Datasource
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response []DatasourceTelegram
+			var response DatasourceEmail
 			if err := func() error {
-				response = make([]DatasourceTelegram, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem DatasourceTelegram
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					response = append(response, elem)
-					return nil
-				}); err != nil {
+				if err := response.Decode(d); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -1802,16 +1839,7 @@ func decodeDatasourceTelegramListResponse(resp *http.Response) (res []Datasource
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if response == nil {
-					return errors.New("nil is invalid value")
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
-			return response, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -1861,10 +1889,10 @@ func decodeDatasourceTelegramListResponse(resp *http.Response) (res []Datasource
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceTelegramUpdateResponse(resp *http.Response) (res *DatasourceTelegram, _ error) {
+func decodeDatasourceLinkedinCreateResponse(resp *http.Response) (res *DatasourceLinkedin, _ error) {
 	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 201:
+		// Code 201.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -1877,7 +1905,7 @@ func decodeDatasourceTelegramUpdateResponse(resp *http.Response) (res *Datasourc
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DatasourceTelegram
+			var response DatasourceLinkedin
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1944,94 +1972,11 @@ func decodeDatasourceTelegramUpdateResponse(resp *http.Response) (res *Datasourc
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceWhatsappCreateResponse(resp *http.Response) (res *DatasourceWhatsapp, _ error) {
-	switch resp.StatusCode {
-	case 201:
-		// Code 201.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
-
-			var response DatasourceWhatsapp
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	}
-	// Convenient error response.
-	defRes, err := func() (res *ErrorStatusCode, err error) {
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
-
-			var response Error
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			return &ErrorStatusCode{
-				StatusCode: resp.StatusCode,
-				Response:   response,
-			}, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	}()
-	if err != nil {
-		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
-	}
-	return res, errors.Wrap(defRes, "error")
-}
-
-func decodeDatasourceWhatsappDeleteResponse(resp *http.Response) (res *DatasourceWhatsappDeleteOK, _ error) {
+func decodeDatasourceLinkedinDeleteResponse(resp *http.Response) (res *DatasourceLinkedinDeleteOK, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
-		return &DatasourceWhatsappDeleteOK{}, nil
+		return &DatasourceLinkedinDeleteOK{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -2078,7 +2023,7 @@ func decodeDatasourceWhatsappDeleteResponse(resp *http.Response) (res *Datasourc
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceWhatsappGetResponse(resp *http.Response) (res *DatasourceWhatsapp, _ error) {
+func decodeDatasourceLinkedinGetResponse(resp *http.Response) (res *DatasourceLinkedin, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -2094,7 +2039,7 @@ func decodeDatasourceWhatsappGetResponse(resp *http.Response) (res *DatasourceWh
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DatasourceWhatsapp
+			var response DatasourceLinkedin
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2161,7 +2106,7 @@ func decodeDatasourceWhatsappGetResponse(resp *http.Response) (res *DatasourceWh
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceWhatsappListResponse(resp *http.Response) (res []DatasourceWhatsapp, _ error) {
+func decodeDatasourceLinkedinListResponse(resp *http.Response) (res []DatasourceLinkedin, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -2177,11 +2122,11 @@ func decodeDatasourceWhatsappListResponse(resp *http.Response) (res []Datasource
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response []DatasourceWhatsapp
+			var response []DatasourceLinkedin
 			if err := func() error {
-				response = make([]DatasourceWhatsapp, 0)
+				response = make([]DatasourceLinkedin, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem DatasourceWhatsapp
+					var elem DatasourceLinkedin
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -2261,7 +2206,7 @@ func decodeDatasourceWhatsappListResponse(resp *http.Response) (res []Datasource
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDatasourceWhatsappUpdateResponse(resp *http.Response) (res *DatasourceWhatsapp, _ error) {
+func decodeDatasourceLinkedinUpdateResponse(resp *http.Response) (res *DatasourceLinkedin, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -2277,7 +2222,7 @@ func decodeDatasourceWhatsappUpdateResponse(resp *http.Response) (res *Datasourc
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DatasourceWhatsapp
+			var response DatasourceLinkedin
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2344,11 +2289,60 @@ func decodeDatasourceWhatsappUpdateResponse(resp *http.Response) (res *Datasourc
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDeleteContactResponse(resp *http.Response) (res *DeleteContactOK, _ error) {
+func decodeDatasourceListResponse(resp *http.Response) (res []Datasource, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
-		return &DeleteContactOK{}, nil
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []Datasource
+			if err := func() error {
+				response = make([]Datasource, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Datasource
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -2395,11 +2389,11 @@ func decodeDeleteContactResponse(resp *http.Response) (res *DeleteContactOK, _ e
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDeleteUserResponse(resp *http.Response) (res *DeleteUserOK, _ error) {
+func decodeDatasourceSetOAuth2ClientResponse(resp *http.Response) (res *DatasourceSetOAuth2ClientNoContent, _ error) {
 	switch resp.StatusCode {
-	case 200:
-		// Code 200.
-		return &DeleteUserOK{}, nil
+	case 204:
+		// Code 204.
+		return &DatasourceSetOAuth2ClientNoContent{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -2446,7 +2440,7 @@ func decodeDeleteUserResponse(resp *http.Response) (res *DeleteUserOK, _ error)
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeFileCreateResponse(resp *http.Response) (res *UploadFileResponse, _ error) {
+func decodeDatasourceTelegramCreateResponse(resp *http.Response) (res *DatasourceTelegram, _ error) {
 	switch resp.StatusCode {
 	case 201:
 		// Code 201.
@@ -2462,7 +2456,7 @@ func decodeFileCreateResponse(resp *http.Response) (res *UploadFileResponse, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response UploadFileResponse
+			var response DatasourceTelegram
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2529,11 +2523,11 @@ func decodeFileCreateResponse(resp *http.Response) (res *UploadFileResponse, _ e
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeFileDeleteResponse(resp *http.Response) (res *FileDeleteOK, _ error) {
+func decodeDatasourceTelegramDeleteResponse(resp *http.Response) (res *DatasourceTelegramDeleteOK, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
-		return &FileDeleteOK{}, nil
+		return &DatasourceTelegramDeleteOK{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -2580,7 +2574,7 @@ func decodeFileDeleteResponse(resp *http.Response) (res *FileDeleteOK, _ error)
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeFileGetResponse(resp *http.Response) (res *FileObject, _ error) {
+func decodeDatasourceTelegramGetResponse(resp *http.Response) (res *DatasourceTelegram, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -2596,7 +2590,7 @@ func decodeFileGetResponse(resp *http.Response) (res *FileObject, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response FileObject
+			var response DatasourceTelegram
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2663,7 +2657,7 @@ func decodeFileGetResponse(resp *http.Response) (res *FileObject, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeFileListResponse(resp *http.Response) (res []FileObject, _ error) {
+func decodeDatasourceTelegramListResponse(resp *http.Response) (res []DatasourceTelegram, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -2679,11 +2673,11 @@ func decodeFileListResponse(resp *http.Response) (res []FileObject, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response []FileObject
+			var response []DatasourceTelegram
 			if err := func() error {
-				response = make([]FileObject, 0)
+				response = make([]DatasourceTelegram, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem FileObject
+					var elem DatasourceTelegram
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -2763,7 +2757,7 @@ func decodeFileListResponse(resp *http.Response) (res []FileObject, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeFileUpdateResponse(resp *http.Response) (res *FileObject, _ error) {
+func decodeDatasourceTelegramUpdateResponse(resp *http.Response) (res *DatasourceTelegram, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -2779,7 +2773,7 @@ func decodeFileUpdateResponse(resp *http.Response) (res *FileObject, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response FileObject
+			var response DatasourceTelegram
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2846,10 +2840,10 @@ func decodeFileUpdateResponse(resp *http.Response) (res *FileObject, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeGenerateDownloadLinkResponse(resp *http.Response) (res *GenerateDownloadLinkResponse, _ error) {
+func decodeDatasourceWhatsappCreateResponse(resp *http.Response) (res *DatasourceWhatsapp, _ error) {
 	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 201:
+		// Code 201.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -2862,7 +2856,7 @@ func decodeGenerateDownloadLinkResponse(resp *http.Response) (res *GenerateDownl
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GenerateDownloadLinkResponse
+			var response DatasourceWhatsapp
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2929,43 +2923,11 @@ func decodeGenerateDownloadLinkResponse(resp *http.Response) (res *GenerateDownl
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeGeneratePresignedUploadUrlResponse(resp *http.Response) (res *UploadPresignedUrlResponse, _ error) {
+func decodeDatasourceWhatsappDeleteResponse(resp *http.Response) (res *DatasourceWhatsappDeleteOK, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
-
-			var response UploadPresignedUrlResponse
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
+		return &DatasourceWhatsappDeleteOK{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -3012,7 +2974,7 @@ func decodeGeneratePresignedUploadUrlResponse(resp *http.Response) (res *UploadP
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeGetContactResponse(resp *http.Response) (res *Contact, _ error) {
+func decodeDatasourceWhatsappGetResponse(resp *http.Response) (res *DatasourceWhatsapp, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -3028,7 +2990,7 @@ func decodeGetContactResponse(resp *http.Response) (res *Contact, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Contact
+			var response DatasourceWhatsapp
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3095,7 +3057,7 @@ func decodeGetContactResponse(resp *http.Response) (res *Contact, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeGetProfileResponse(resp *http.Response) (res *User, _ error) {
+func decodeDatasourceWhatsappListResponse(resp *http.Response) (res []DatasourceWhatsapp, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -3111,9 +3073,17 @@ func decodeGetProfileResponse(resp *http.Response) (res *User, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response User
+			var response []DatasourceWhatsapp
 			if err := func() error {
-				if err := response.Decode(d); err != nil {
+				response = make([]DatasourceWhatsapp, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem DatasourceWhatsapp
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -3130,14 +3100,14 @@ func decodeGetProfileResponse(resp *http.Response) (res *User, _ error) {
 			}
 			// Validate response.
 			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
+				if response == nil {
+					return errors.New("nil is invalid value")
 				}
 				return nil
 			}(); err != nil {
 				return res, errors.Wrap(err, "validate")
 			}
-			return &response, nil
+			return response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -3187,7 +3157,7 @@ func decodeGetProfileResponse(resp *http.Response) (res *User, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeGetUserResponse(resp *http.Response) (res *User, _ error) {
+func decodeDatasourceWhatsappUpdateResponse(resp *http.Response) (res *DatasourceWhatsapp, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -3203,7 +3173,7 @@ func decodeGetUserResponse(resp *http.Response) (res *User, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response User
+			var response DatasourceWhatsapp
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3220,19 +3190,61 @@ func decodeGetUserResponse(resp *http.Response) (res *User, _ error) {
 				}
 				return res, err
 			}
-			// Validate response.
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
 			if err := func() error {
-				if err := response.Validate(); err != nil {
+				if err := response.Decode(d); err != nil {
 					return err
 				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
 			}
-			return &response, nil
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeDeleteContactResponse(resp *http.Response) (res *DeleteContactOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		return &DeleteContactOK{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -3279,10 +3291,14 @@ func decodeGetUserResponse(resp *http.Response) (res *User, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeListContactsResponse(resp *http.Response) (res []Contact, _ error) {
+func decodeDeleteUserResponse(resp *http.Response) (res *DeleteUserOK, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
+		return &DeleteUserOK{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3295,17 +3311,56 @@ func decodeListContactsResponse(resp *http.Response) (res []Contact, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response []Contact
+			var response Error
 			if err := func() error {
-				response = make([]Contact, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Contact
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					response = append(response, elem)
-					return nil
-				}); err != nil {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeErasureCreateResponse(resp *http.Response) (res *ErasureRequest, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ErasureRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -3322,14 +3377,14 @@ func decodeListContactsResponse(resp *http.Response) (res []Contact, _ error) {
 			}
 			// Validate response.
 			if err := func() error {
-				if response == nil {
-					return errors.New("nil is invalid value")
+				if err := response.Validate(); err != nil {
+					return err
 				}
 				return nil
 			}(); err != nil {
 				return res, errors.Wrap(err, "validate")
 			}
-			return response, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -3379,7 +3434,7 @@ func decodeListContactsResponse(resp *http.Response) (res []Contact, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeListUsersResponse(resp *http.Response) (res []User, _ error) {
+func decodeErasureListResponse(resp *http.Response) (res []ErasureRequest, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -3395,11 +3450,11 @@ func decodeListUsersResponse(resp *http.Response) (res []User, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response []User
+			var response []ErasureRequest
 			if err := func() error {
-				response = make([]User, 0)
+				response = make([]ErasureRequest, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem User
+					var elem ErasureRequest
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -3496,7 +3551,7 @@ func decodeListUsersResponse(resp *http.Response) (res []User, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeMessageEmailQueryResponse(resp *http.Response) (res *MessageEmailQueryOK, _ error) {
+func decodeEventListResponse(resp *http.Response) (res []Event, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -3512,9 +3567,17 @@ func decodeMessageEmailQueryResponse(resp *http.Response) (res *MessageEmailQuer
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response MessageEmailQueryOK
+			var response []Event
 			if err := func() error {
-				if err := response.Decode(d); err != nil {
+				response = make([]Event, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Event
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -3531,14 +3594,14 @@ func decodeMessageEmailQueryResponse(resp *http.Response) (res *MessageEmailQuer
 			}
 			// Validate response.
 			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
+				if response == nil {
+					return errors.New("nil is invalid value")
 				}
 				return nil
 			}(); err != nil {
 				return res, errors.Wrap(err, "validate")
 			}
-			return &response, nil
+			return response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -3588,10 +3651,10 @@ func decodeMessageEmailQueryResponse(resp *http.Response) (res *MessageEmailQuer
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeMessageLinkedinQueryResponse(resp *http.Response) (res *MessageLinkedinQueryOK, _ error) {
+func decodeFileCreateResponse(resp *http.Response) (res *UploadFileResponse, _ error) {
 	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 201:
+		// Code 201.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3604,7 +3667,7 @@ func decodeMessageLinkedinQueryResponse(resp *http.Response) (res *MessageLinked
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response MessageLinkedinQueryOK
+			var response UploadFileResponse
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3621,15 +3684,6 @@ func decodeMessageLinkedinQueryResponse(resp *http.Response) (res *MessageLinked
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -3680,10 +3734,14 @@ func decodeMessageLinkedinQueryResponse(resp *http.Response) (res *MessageLinked
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeMessageQueryResponse(resp *http.Response) (res *MessageQueryOK, _ error) {
+func decodeFileDeleteResponse(resp *http.Response) (res *FileDeleteOK, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
+		return &FileDeleteOK{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3696,7 +3754,7 @@ func decodeMessageQueryResponse(resp *http.Response) (res *MessageQueryOK, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response MessageQueryOK
+			var response Error
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3713,14 +3771,52 @@ func decodeMessageQueryResponse(resp *http.Response) (res *MessageQueryOK, _ err
 				}
 				return res, err
 			}
-			// Validate response.
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeFileGetResponse(resp *http.Response) (res *FileObject, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response FileObject
 			if err := func() error {
-				if err := response.Validate(); err != nil {
+				if err := response.Decode(d); err != nil {
 					return err
 				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
 			}
 			return &response, nil
 		default:
@@ -3772,7 +3868,7 @@ func decodeMessageQueryResponse(resp *http.Response) (res *MessageQueryOK, _ err
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeMessageTelegramQueryResponse(resp *http.Response) (res *MessageTelegramQueryOK, _ error) {
+func decodeFileListResponse(resp *http.Response) (res []FileObject, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -3788,9 +3884,17 @@ func decodeMessageTelegramQueryResponse(resp *http.Response) (res *MessageTelegr
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response MessageTelegramQueryOK
+			var response []FileObject
 			if err := func() error {
-				if err := response.Decode(d); err != nil {
+				response = make([]FileObject, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem FileObject
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -3807,14 +3911,14 @@ func decodeMessageTelegramQueryResponse(resp *http.Response) (res *MessageTelegr
 			}
 			// Validate response.
 			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
+				if response == nil {
+					return errors.New("nil is invalid value")
 				}
 				return nil
 			}(); err != nil {
 				return res, errors.Wrap(err, "validate")
 			}
-			return &response, nil
+			return response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -3864,7 +3968,7 @@ func decodeMessageTelegramQueryResponse(resp *http.Response) (res *MessageTelegr
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeMessageWhatsappQueryResponse(resp *http.Response) (res *MessageWhatsappQueryOK, _ error) {
+func decodeFileUpdateResponse(resp *http.Response) (res *FileObject, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -3880,7 +3984,7 @@ func decodeMessageWhatsappQueryResponse(resp *http.Response) (res *MessageWhatsa
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response MessageWhatsappQueryOK
+			var response FileObject
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3897,15 +4001,6 @@ func decodeMessageWhatsappQueryResponse(resp *http.Response) (res *MessageWhatsa
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -3956,50 +4051,3729 @@ func decodeMessageWhatsappQueryResponse(resp *http.Response) (res *MessageWhatsa
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeOAuth2ClientCallbackResponse(resp *http.Response) (res *OAuth2ClientCallbackFound, _ error) {
+func decodeGenerateDownloadLinkResponse(resp *http.Response) (res *GenerateDownloadLinkResponse, _ error) {
 	switch resp.StatusCode {
-	case 302:
-		// Code 302.
-		var wrapper OAuth2ClientCallbackFound
-		h := uri.NewHeaderDecoder(resp.Header)
-		// Parse "Location" header.
-		{
-			cfg := uri.HeaderParameterDecodingConfig{
-				Name:    "Location",
-				Explode: false,
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response GenerateDownloadLinkResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeGeneratePresignedUploadUrlResponse(resp *http.Response) (res *UploadPresignedUrlResponse, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response UploadPresignedUrlResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeGetContactResponse(resp *http.Response) (res *Contact, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Contact
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeGetProfileResponse(resp *http.Response) (res *User, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response User
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeGetUserResponse(resp *http.Response) (res *User, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response User
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeListContactsResponse(resp *http.Response) (res []Contact, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []Contact
+			if err := func() error {
+				response = make([]Contact, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Contact
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeListUsersResponse(resp *http.Response) (res []User, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []User
+			if err := func() error {
+				response = make([]User, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem User
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				var failures []validate.FieldError
+				for i, elem := range response {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeMessageEmailQueryResponse(resp *http.Response) (res *MessageEmailQueryOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response MessageEmailQueryOK
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeMessageLinkedinQueryResponse(resp *http.Response) (res *MessageLinkedinQueryOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response MessageLinkedinQueryOK
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeMessageQueryResponse(resp *http.Response) (res *MessageQueryOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response MessageQueryOK
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeMessageTelegramQueryResponse(resp *http.Response) (res *MessageTelegramQueryOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response MessageTelegramQueryOK
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeMessageWhatsappQueryResponse(resp *http.Response) (res *MessageWhatsappQueryOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response MessageWhatsappQueryOK
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeOAuth2ClientCallbackResponse(resp *http.Response) (res *OAuth2ClientCallbackFound, _ error) {
+	switch resp.StatusCode {
+	case 302:
+		// Code 302.
+		var wrapper OAuth2ClientCallbackFound
+		h := uri.NewHeaderDecoder(resp.Header)
+		// Parse "Location" header.
+		{
+			cfg := uri.HeaderParameterDecodingConfig{
+				Name:    "Location",
+				Explode: false,
+			}
+			if err := func() error {
+				if err := h.HasParam(cfg); err == nil {
+					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+						var wrapperDotLocationVal url.URL
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToURL(val)
+							if err != nil {
+								return err
+							}
+
+							wrapperDotLocationVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						wrapper.Location.SetTo(wrapperDotLocationVal)
+						return nil
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "parse Location header")
+			}
+		}
+		return &wrapper, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeOAuth2ClientCreateResponse(resp *http.Response) (res *OAuth2Client, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response OAuth2Client
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeOAuth2ClientDeleteResponse(resp *http.Response) (res *OAuth2ClientDeleteOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		return &OAuth2ClientDeleteOK{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeOAuth2ClientGetResponse(resp *http.Response) (res *OAuth2Client, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response OAuth2Client
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeOAuth2ClientListResponse(resp *http.Response) (res *OAuth2ClientListOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response OAuth2ClientListOK
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeOAuth2ClientLoginResponse(resp *http.Response) (res *OAuth2ClientLoginOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response OAuth2ClientLoginOK
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeOAuth2ClientTokenDeleteResponse(resp *http.Response) (res *OAuth2ClientTokenDeleteOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		return &OAuth2ClientTokenDeleteOK{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeOAuth2ClientTokenListResponse(resp *http.Response) (res []OAuth2ClientToken, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []OAuth2ClientToken
+			if err := func() error {
+				response = make([]OAuth2ClientToken, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem OAuth2ClientToken
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				var failures []validate.FieldError
+				for i, elem := range response {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeOAuth2ClientTokenRefreshResponse(resp *http.Response) (res *OAuth2ClientTokenRefreshNoContent, _ error) {
+	switch resp.StatusCode {
+	case 204:
+		// Code 204.
+		return &OAuth2ClientTokenRefreshNoContent{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeOAuth2ClientUpdateResponse(resp *http.Response) (res *OAuth2Client, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response OAuth2Client
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeOAuth2ProviderListResponse(resp *http.Response) (res []OAuth2Provider, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []OAuth2Provider
+			if err := func() error {
+				response = make([]OAuth2Provider, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem OAuth2Provider
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				var failures []validate.FieldError
+				for i, elem := range response {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodePipelineCreateResponse(resp *http.Response) (res *Pipeline, _ error) {
+	switch resp.StatusCode {
+	case 201:
+		// Code 201.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Pipeline
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodePipelineDeleteResponse(resp *http.Response) (res *PipelineDeleteOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		return &PipelineDeleteOK{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodePipelineGetResponse(resp *http.Response) (res *Pipeline, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Pipeline
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodePipelineListResponse(resp *http.Response) (res *PipelineListOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response PipelineListOK
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodePipelineRunResponse(resp *http.Response) (res *PipelineRun, _ error) {
+	switch resp.StatusCode {
+	case 202:
+		// Code 202.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response PipelineRun
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodePipelineUpdateResponse(resp *http.Response) (res *Pipeline, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Pipeline
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodePolicyCreateResponse(resp *http.Response) (res *Policy, _ error) {
+	switch resp.StatusCode {
+	case 201:
+		// Code 201.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Policy
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodePolicyDeleteResponse(resp *http.Response) (res *PolicyDeleteOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		return &PolicyDeleteOK{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodePolicyGetResponse(resp *http.Response) (res *Policy, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Policy
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodePolicyListResponse(resp *http.Response) (res []Policy, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []Policy
+			if err := func() error {
+				response = make([]Policy, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Policy
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				var failures []validate.FieldError
+				for i, elem := range response {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodePolicyUpdateResponse(resp *http.Response) (res *Policy, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Policy
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeRetentionPolicyCreateResponse(resp *http.Response) (res *RetentionPolicy, _ error) {
+	switch resp.StatusCode {
+	case 201:
+		// Code 201.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RetentionPolicy
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeRetentionPolicyDeleteResponse(resp *http.Response) (res *RetentionPolicyDeleteOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		return &RetentionPolicyDeleteOK{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeRetentionPolicyGetResponse(resp *http.Response) (res *RetentionPolicy, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RetentionPolicy
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeRetentionPolicyListResponse(resp *http.Response) (res []RetentionPolicy, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []RetentionPolicy
+			if err := func() error {
+				response = make([]RetentionPolicy, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem RetentionPolicy
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeRetentionPolicyRunResponse(resp *http.Response) (res *RetentionPolicyRunAccepted, _ error) {
+	switch resp.StatusCode {
+	case 202:
+		// Code 202.
+		return &RetentionPolicyRunAccepted{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeRetentionPolicyUpdateResponse(resp *http.Response) (res *RetentionPolicy, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RetentionPolicy
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeSchedulerCreateResponse(resp *http.Response) (res *Scheduler, _ error) {
+	switch resp.StatusCode {
+	case 201:
+		// Code 201.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Scheduler
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeSchedulerDeleteResponse(resp *http.Response) (res *SchedulerDeleteOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		return &SchedulerDeleteOK{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
 			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
 			if err := func() error {
-				if err := h.HasParam(cfg); err == nil {
-					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-						var wrapperDotLocationVal url.URL
-						if err := func() error {
-							val, err := d.DecodeValue()
-							if err != nil {
-								return err
-							}
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
 
-							c, err := conv.ToURL(val)
-							if err != nil {
-								return err
-							}
+func decodeSchedulerGetResponse(resp *http.Response) (res *Scheduler, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
 
-							wrapperDotLocationVal = c
-							return nil
-						}(); err != nil {
+			var response Scheduler
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeSchedulerListResponse(resp *http.Response) (res []Scheduler, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []Scheduler
+			if err := func() error {
+				response = make([]Scheduler, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Scheduler
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				var failures []validate.FieldError
+				for i, elem := range response {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
 							return err
 						}
-						wrapper.Location.SetTo(wrapperDotLocationVal)
 						return nil
-					}); err != nil {
-						return err
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
 					}
 				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "parse Location header")
+				return res, errors.Wrap(err, "validate")
 			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
 		}
-		return &wrapper, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -4046,7 +7820,7 @@ func decodeOAuth2ClientCallbackResponse(resp *http.Response) (res *OAuth2ClientC
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeOAuth2ClientCreateResponse(resp *http.Response) (res *OAuth2Client, _ error) {
+func decodeSchedulerUpdateResponse(resp *http.Response) (res *Scheduler, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4062,7 +7836,7 @@ func decodeOAuth2ClientCreateResponse(resp *http.Response) (res *OAuth2Client, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response OAuth2Client
+			var response Scheduler
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4079,6 +7853,15 @@ func decodeOAuth2ClientCreateResponse(resp *http.Response) (res *OAuth2Client, _
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -4129,11 +7912,11 @@ func decodeOAuth2ClientCreateResponse(resp *http.Response) (res *OAuth2Client, _
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeOAuth2ClientDeleteResponse(resp *http.Response) (res *OAuth2ClientDeleteOK, _ error) {
+func decodeSessionRevokeResponse(resp *http.Response) (res *SessionRevokeOK, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
-		return &OAuth2ClientDeleteOK{}, nil
+		return &SessionRevokeOK{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -4180,43 +7963,11 @@ func decodeOAuth2ClientDeleteResponse(resp *http.Response) (res *OAuth2ClientDel
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeOAuth2ClientGetResponse(resp *http.Response) (res *OAuth2Client, _ error) {
+func decodeSessionRevokeAllResponse(resp *http.Response) (res *SessionRevokeAllOK, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
-
-			var response OAuth2Client
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
+		return &SessionRevokeAllOK{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -4263,7 +8014,7 @@ func decodeOAuth2ClientGetResponse(resp *http.Response) (res *OAuth2Client, _ er
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeOAuth2ClientListResponse(resp *http.Response) (res *OAuth2ClientListOK, _ error) {
+func decodeSessionStatusResponse(resp *http.Response) (res *SessionStatus, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4279,7 +8030,7 @@ func decodeOAuth2ClientListResponse(resp *http.Response) (res *OAuth2ClientListO
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response OAuth2ClientListOK
+			var response SessionStatus
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4355,10 +8106,10 @@ func decodeOAuth2ClientListResponse(resp *http.Response) (res *OAuth2ClientListO
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeOAuth2ClientLoginResponse(resp *http.Response) (res *OAuth2ClientLoginOK, _ error) {
+func decodeStorageHostfilesCreateResponse(resp *http.Response) (res *StorageHostfiles, _ error) {
 	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 201:
+		// Code 201.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -4371,7 +8122,7 @@ func decodeOAuth2ClientLoginResponse(resp *http.Response) (res *OAuth2ClientLogi
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response OAuth2ClientLoginOK
+			var response StorageHostfiles
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4438,11 +8189,11 @@ func decodeOAuth2ClientLoginResponse(resp *http.Response) (res *OAuth2ClientLogi
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeOAuth2ClientTokenDeleteResponse(resp *http.Response) (res *OAuth2ClientTokenDeleteOK, _ error) {
+func decodeStorageHostfilesDeleteResponse(resp *http.Response) (res *StorageHostfilesDeleteOK, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
-		return &OAuth2ClientTokenDeleteOK{}, nil
+		return &StorageHostfilesDeleteOK{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -4489,7 +8240,7 @@ func decodeOAuth2ClientTokenDeleteResponse(resp *http.Response) (res *OAuth2Clie
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeOAuth2ClientTokenListResponse(resp *http.Response) (res []OAuth2ClientToken, _ error) {
+func decodeStorageHostfilesGetResponse(resp *http.Response) (res *StorageHostfiles, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4505,17 +8256,9 @@ func decodeOAuth2ClientTokenListResponse(resp *http.Response) (res []OAuth2Clien
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response []OAuth2ClientToken
+			var response StorageHostfiles
 			if err := func() error {
-				response = make([]OAuth2ClientToken, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem OAuth2ClientToken
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					response = append(response, elem)
-					return nil
-				}); err != nil {
+				if err := response.Decode(d); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -4530,16 +8273,7 @@ func decodeOAuth2ClientTokenListResponse(resp *http.Response) (res []OAuth2Clien
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if response == nil {
-					return errors.New("nil is invalid value")
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
-			return response, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -4589,7 +8323,7 @@ func decodeOAuth2ClientTokenListResponse(resp *http.Response) (res []OAuth2Clien
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeOAuth2ClientUpdateResponse(resp *http.Response) (res *OAuth2Client, _ error) {
+func decodeStorageHostfilesUpdateResponse(resp *http.Response) (res *StorageHostfiles, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4605,7 +8339,7 @@ func decodeOAuth2ClientUpdateResponse(resp *http.Response) (res *OAuth2Client, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response OAuth2Client
+			var response StorageHostfiles
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4672,10 +8406,10 @@ func decodeOAuth2ClientUpdateResponse(resp *http.Response) (res *OAuth2Client, _
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodePipelineCreateResponse(resp *http.Response) (res *Pipeline, _ error) {
+func decodeStorageListResponse(resp *http.Response) (res []Storage, _ error) {
 	switch resp.StatusCode {
-	case 201:
-		// Code 201.
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -4688,9 +8422,17 @@ func decodePipelineCreateResponse(resp *http.Response) (res *Pipeline, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Pipeline
+			var response []Storage
 			if err := func() error {
-				if err := response.Decode(d); err != nil {
+				response = make([]Storage, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Storage
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -4707,68 +8449,17 @@ func decodePipelineCreateResponse(resp *http.Response) (res *Pipeline, _ error)
 			}
 			// Validate response.
 			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
+				if response == nil {
+					return errors.New("nil is invalid value")
 				}
 				return nil
 			}(); err != nil {
 				return res, errors.Wrap(err, "validate")
 			}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	}
-	// Convenient error response.
-	defRes, err := func() (res *ErrorStatusCode, err error) {
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
-
-			var response Error
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			return &ErrorStatusCode{
-				StatusCode: resp.StatusCode,
-				Response:   response,
-			}, nil
+			return response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}()
-	if err != nil {
-		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
-	}
-	return res, errors.Wrap(defRes, "error")
-}
-
-func decodePipelineDeleteResponse(resp *http.Response) (res *PipelineDeleteOK, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
-		return &PipelineDeleteOK{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -4815,10 +8506,10 @@ func decodePipelineDeleteResponse(resp *http.Response) (res *PipelineDeleteOK, _
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodePipelineGetResponse(resp *http.Response) (res *Pipeline, _ error) {
+func decodeStorageMigrateResponse(resp *http.Response) (res *StorageMigration, _ error) {
 	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 202:
+		// Code 202.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -4831,7 +8522,7 @@ func decodePipelineGetResponse(resp *http.Response) (res *Pipeline, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Pipeline
+			var response StorageMigration
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4848,15 +8539,6 @@ func decodePipelineGetResponse(resp *http.Response) (res *Pipeline, _ error) {
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -4907,7 +8589,7 @@ func decodePipelineGetResponse(resp *http.Response) (res *Pipeline, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodePipelineListResponse(resp *http.Response) (res *PipelineListOK, _ error) {
+func decodeStorageMigrationListResponse(resp *http.Response) (res []StorageMigration, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4923,9 +8605,17 @@ func decodePipelineListResponse(resp *http.Response) (res *PipelineListOK, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response PipelineListOK
+			var response []StorageMigration
 			if err := func() error {
-				if err := response.Decode(d); err != nil {
+				response = make([]StorageMigration, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem StorageMigration
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -4942,14 +8632,14 @@ func decodePipelineListResponse(resp *http.Response) (res *PipelineListOK, _ err
 			}
 			// Validate response.
 			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
+				if response == nil {
+					return errors.New("nil is invalid value")
 				}
 				return nil
 			}(); err != nil {
 				return res, errors.Wrap(err, "validate")
 			}
-			return &response, nil
+			return response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -4999,10 +8689,10 @@ func decodePipelineListResponse(resp *http.Response) (res *PipelineListOK, _ err
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodePipelineUpdateResponse(resp *http.Response) (res *Pipeline, _ error) {
+func decodeStoragePostgresCreateResponse(resp *http.Response) (res *StoragePostgres, _ error) {
 	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 201:
+		// Code 201.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -5015,7 +8705,7 @@ func decodePipelineUpdateResponse(resp *http.Response) (res *Pipeline, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Pipeline
+			var response StoragePostgres
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5032,15 +8722,6 @@ func decodePipelineUpdateResponse(resp *http.Response) (res *Pipeline, _ error)
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -5091,10 +8772,14 @@ func decodePipelineUpdateResponse(resp *http.Response) (res *Pipeline, _ error)
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeSchedulerCreateResponse(resp *http.Response) (res *Scheduler, _ error) {
+func decodeStoragePostgresDeleteResponse(resp *http.Response) (res *StoragePostgresDeleteOK, _ error) {
 	switch resp.StatusCode {
-	case 201:
-		// Code 201.
+	case 200:
+		// Code 200.
+		return &StoragePostgresDeleteOK{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -5107,7 +8792,7 @@ func decodeSchedulerCreateResponse(resp *http.Response) (res *Scheduler, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Scheduler
+			var response Error
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5124,13 +8809,24 @@ func decodeSchedulerCreateResponse(resp *http.Response) (res *Scheduler, _ error
 				}
 				return res, err
 			}
-			return &response, nil
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
 	}
-	// Convenient error response.
-	defRes, err := func() (res *ErrorStatusCode, err error) {
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeStoragePostgresGetResponse(resp *http.Response) (res *StoragePostgres, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -5143,7 +8839,7 @@ func decodeSchedulerCreateResponse(resp *http.Response) (res *Scheduler, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response StoragePostgres
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5156,29 +8852,14 @@ func decodeSchedulerCreateResponse(resp *http.Response) (res *Scheduler, _ error
 				err = &ogenerrors.DecodeBodyError{
 					ContentType: ct,
 					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			return &ErrorStatusCode{
-				StatusCode: resp.StatusCode,
-				Response:   response,
-			}, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	}()
-	if err != nil {
-		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
-	}
-	return res, errors.Wrap(defRes, "error")
-}
-
-func decodeSchedulerDeleteResponse(resp *http.Response) (res *SchedulerDeleteOK, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
-		return &SchedulerDeleteOK{}, nil
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -5225,7 +8906,7 @@ func decodeSchedulerDeleteResponse(resp *http.Response) (res *SchedulerDeleteOK,
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeSchedulerGetResponse(resp *http.Response) (res *Scheduler, _ error) {
+func decodeStoragePostgresUpdateResponse(resp *http.Response) (res *StoragePostgres, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5241,7 +8922,7 @@ func decodeSchedulerGetResponse(resp *http.Response) (res *Scheduler, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Scheduler
+			var response StoragePostgres
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5308,10 +8989,10 @@ func decodeSchedulerGetResponse(resp *http.Response) (res *Scheduler, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeSchedulerListResponse(resp *http.Response) (res []Scheduler, _ error) {
+func decodeStorageS3CreateResponse(resp *http.Response) (res *StorageS3, _ error) {
 	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 201:
+		// Code 201.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -5324,17 +9005,9 @@ func decodeSchedulerListResponse(resp *http.Response) (res []Scheduler, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response []Scheduler
+			var response StorageS3
 			if err := func() error {
-				response = make([]Scheduler, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Scheduler
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					response = append(response, elem)
-					return nil
-				}); err != nil {
+				if err := response.Decode(d); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -5349,19 +9022,61 @@ func decodeSchedulerListResponse(resp *http.Response) (res []Scheduler, _ error)
 				}
 				return res, err
 			}
-			// Validate response.
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
 			if err := func() error {
-				if response == nil {
-					return errors.New("nil is invalid value")
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
 			}
-			return response, nil
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeStorageS3DeleteResponse(resp *http.Response) (res *StorageS3DeleteOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		return &StorageS3DeleteOK{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -5408,7 +9123,7 @@ func decodeSchedulerListResponse(resp *http.Response) (res []Scheduler, _ error)
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeSchedulerUpdateResponse(resp *http.Response) (res *Scheduler, _ error) {
+func decodeStorageS3GetResponse(resp *http.Response) (res *StorageS3, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5424,7 +9139,7 @@ func decodeSchedulerUpdateResponse(resp *http.Response) (res *Scheduler, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Scheduler
+			var response StorageS3
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5491,7 +9206,7 @@ func decodeSchedulerUpdateResponse(resp *http.Response) (res *Scheduler, _ error
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeSessionStatusResponse(resp *http.Response) (res *SessionStatus, _ error) {
+func decodeStorageS3UpdateResponse(resp *http.Response) (res *StorageS3, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5507,7 +9222,7 @@ func decodeSessionStatusResponse(resp *http.Response) (res *SessionStatus, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response SessionStatus
+			var response StorageS3
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5574,7 +9289,7 @@ func decodeSessionStatusResponse(resp *http.Response) (res *SessionStatus, _ err
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeStorageHostfilesCreateResponse(resp *http.Response) (res *StorageHostfiles, _ error) {
+func decodeSyncpolicyCreateResponse(resp *http.Response) (res *SyncPolicy, _ error) {
 	switch resp.StatusCode {
 	case 201:
 		// Code 201.
@@ -5590,7 +9305,7 @@ func decodeStorageHostfilesCreateResponse(resp *http.Response) (res *StorageHost
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response StorageHostfiles
+			var response SyncPolicy
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5657,11 +9372,11 @@ func decodeStorageHostfilesCreateResponse(resp *http.Response) (res *StorageHost
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeStorageHostfilesDeleteResponse(resp *http.Response) (res *StorageHostfilesDeleteOK, _ error) {
+func decodeSyncpolicyDeleteResponse(resp *http.Response) (res *SyncpolicyDeleteOK, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
-		return &StorageHostfilesDeleteOK{}, nil
+		return &SyncpolicyDeleteOK{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -5708,7 +9423,7 @@ func decodeStorageHostfilesDeleteResponse(resp *http.Response) (res *StorageHost
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeStorageHostfilesGetResponse(resp *http.Response) (res *StorageHostfiles, _ error) {
+func decodeSyncpolicyGetResponse(resp *http.Response) (res *SyncPolicy, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5724,7 +9439,7 @@ func decodeStorageHostfilesGetResponse(resp *http.Response) (res *StorageHostfil
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response StorageHostfiles
+			var response SyncPolicy
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5791,7 +9506,7 @@ func decodeStorageHostfilesGetResponse(resp *http.Response) (res *StorageHostfil
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeStorageHostfilesUpdateResponse(resp *http.Response) (res *StorageHostfiles, _ error) {
+func decodeSyncpolicyListResponse(resp *http.Response) (res *SyncpolicyListOK, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5807,7 +9522,7 @@ func decodeStorageHostfilesUpdateResponse(resp *http.Response) (res *StorageHost
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response StorageHostfiles
+			var response SyncpolicyListOK
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5824,6 +9539,15 @@ func decodeStorageHostfilesUpdateResponse(resp *http.Response) (res *StorageHost
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -5874,7 +9598,7 @@ func decodeStorageHostfilesUpdateResponse(resp *http.Response) (res *StorageHost
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeStorageListResponse(resp *http.Response) (res []Storage, _ error) {
+func decodeSyncpolicyUpdateResponse(resp *http.Response) (res *SyncPolicy, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5890,17 +9614,9 @@ func decodeStorageListResponse(resp *http.Response) (res []Storage, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response []Storage
+			var response SyncPolicy
 			if err := func() error {
-				response = make([]Storage, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Storage
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					response = append(response, elem)
-					return nil
-				}); err != nil {
+				if err := response.Decode(d); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -5915,16 +9631,7 @@ func decodeStorageListResponse(resp *http.Response) (res []Storage, _ error) {
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if response == nil {
-					return errors.New("nil is invalid value")
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
-			return response, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -5974,7 +9681,7 @@ func decodeStorageListResponse(resp *http.Response) (res []Storage, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeStoragePostgresCreateResponse(resp *http.Response) (res *StoragePostgres, _ error) {
+func decodeTgSessionCreateResponse(resp *http.Response) (res *Telegram, _ error) {
 	switch resp.StatusCode {
 	case 201:
 		// Code 201.
@@ -5990,7 +9697,7 @@ func decodeStoragePostgresCreateResponse(resp *http.Response) (res *StoragePostg
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response StoragePostgres
+			var response Telegram
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6057,11 +9764,43 @@ func decodeStoragePostgresCreateResponse(resp *http.Response) (res *StoragePostg
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeStoragePostgresDeleteResponse(resp *http.Response) (res *StoragePostgresDeleteOK, _ error) {
+func decodeTgSessionListResponse(resp *http.Response) (res *TgSessionListOK, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
-		return &StoragePostgresDeleteOK{}, nil
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response TgSessionListOK
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -6108,7 +9847,7 @@ func decodeStoragePostgresDeleteResponse(resp *http.Response) (res *StoragePostg
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeStoragePostgresGetResponse(resp *http.Response) (res *StoragePostgres, _ error) {
+func decodeTgSessionVerifyResponse(resp *http.Response) (res *Telegram, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -6124,7 +9863,7 @@ func decodeStoragePostgresGetResponse(resp *http.Response) (res *StoragePostgres
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response StoragePostgres
+			var response Telegram
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6191,7 +9930,7 @@ func decodeStoragePostgresGetResponse(resp *http.Response) (res *StoragePostgres
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeStoragePostgresUpdateResponse(resp *http.Response) (res *StoragePostgres, _ error) {
+func decodeUpdateContactResponse(resp *http.Response) (res *Contact, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -6207,7 +9946,7 @@ func decodeStoragePostgresUpdateResponse(resp *http.Response) (res *StoragePostg
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response StoragePostgres
+			var response Contact
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6274,10 +10013,10 @@ func decodeStoragePostgresUpdateResponse(resp *http.Response) (res *StoragePostg
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeStorageS3CreateResponse(resp *http.Response) (res *StorageS3, _ error) {
+func decodeUpdateProfileResponse(resp *http.Response) (res *User, _ error) {
 	switch resp.StatusCode {
-	case 201:
-		// Code 201.
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -6290,7 +10029,7 @@ func decodeStorageS3CreateResponse(resp *http.Response) (res *StorageS3, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response StorageS3
+			var response User
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6307,6 +10046,15 @@ func decodeStorageS3CreateResponse(resp *http.Response) (res *StorageS3, _ error
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -6357,14 +10105,10 @@ func decodeStorageS3CreateResponse(resp *http.Response) (res *StorageS3, _ error
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeStorageS3DeleteResponse(resp *http.Response) (res *StorageS3DeleteOK, _ error) {
+func decodeUpdateUserResponse(resp *http.Response) (res *User, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
-		return &StorageS3DeleteOK{}, nil
-	}
-	// Convenient error response.
-	defRes, err := func() (res *ErrorStatusCode, err error) {
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -6377,7 +10121,7 @@ func decodeStorageS3DeleteResponse(resp *http.Response) (res *StorageS3DeleteOK,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response User
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6394,52 +10138,14 @@ func decodeStorageS3DeleteResponse(resp *http.Response) (res *StorageS3DeleteOK,
 				}
 				return res, err
 			}
-			return &ErrorStatusCode{
-				StatusCode: resp.StatusCode,
-				Response:   response,
-			}, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	}()
-	if err != nil {
-		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
-	}
-	return res, errors.Wrap(defRes, "error")
-}
-
-func decodeStorageS3GetResponse(resp *http.Response) (res *StorageS3, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
-
-			var response StorageS3
+			// Validate response.
 			if err := func() error {
-				if err := response.Decode(d); err != nil {
+				if err := response.Validate(); err != nil {
 					return err
 				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
 				return nil
 			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
+				return res, errors.Wrap(err, "validate")
 			}
 			return &response, nil
 		default:
@@ -6491,7 +10197,7 @@ func decodeStorageS3GetResponse(resp *http.Response) (res *StorageS3, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeStorageS3UpdateResponse(resp *http.Response) (res *StorageS3, _ error) {
+func decodeUploadFileResponse(resp *http.Response) (res *UploadFileResponse, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -6507,7 +10213,7 @@ func decodeStorageS3UpdateResponse(resp *http.Response) (res *StorageS3, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response StorageS3
+			var response UploadFileResponse
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6574,7 +10280,7 @@ func decodeStorageS3UpdateResponse(resp *http.Response) (res *StorageS3, _ error
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeSyncpolicyCreateResponse(resp *http.Response) (res *SyncPolicy, _ error) {
+func decodeWebhookCreateResponse(resp *http.Response) (res *Webhook, _ error) {
 	switch resp.StatusCode {
 	case 201:
 		// Code 201.
@@ -6590,7 +10296,7 @@ func decodeSyncpolicyCreateResponse(resp *http.Response) (res *SyncPolicy, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response SyncPolicy
+			var response Webhook
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6657,11 +10363,11 @@ func decodeSyncpolicyCreateResponse(resp *http.Response) (res *SyncPolicy, _ err
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeSyncpolicyDeleteResponse(resp *http.Response) (res *SyncpolicyDeleteOK, _ error) {
+func decodeWebhookDeleteResponse(resp *http.Response) (res *WebhookDeleteOK, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
-		return &SyncpolicyDeleteOK{}, nil
+		return &WebhookDeleteOK{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -6708,7 +10414,7 @@ func decodeSyncpolicyDeleteResponse(resp *http.Response) (res *SyncpolicyDeleteO
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeSyncpolicyGetResponse(resp *http.Response) (res *SyncPolicy, _ error) {
+func decodeWebhookGetResponse(resp *http.Response) (res *Webhook, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -6724,7 +10430,7 @@ func decodeSyncpolicyGetResponse(resp *http.Response) (res *SyncPolicy, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response SyncPolicy
+			var response Webhook
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6791,7 +10497,7 @@ func decodeSyncpolicyGetResponse(resp *http.Response) (res *SyncPolicy, _ error)
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeSyncpolicyListResponse(resp *http.Response) (res *SyncpolicyListOK, _ error) {
+func decodeWebhookListResponse(resp *http.Response) (res []Webhook, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -6807,9 +10513,17 @@ func decodeSyncpolicyListResponse(resp *http.Response) (res *SyncpolicyListOK, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response SyncpolicyListOK
+			var response []Webhook
 			if err := func() error {
-				if err := response.Decode(d); err != nil {
+				response = make([]Webhook, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Webhook
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -6826,14 +10540,14 @@ func decodeSyncpolicyListResponse(resp *http.Response) (res *SyncpolicyListOK, _
 			}
 			// Validate response.
 			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
+				if response == nil {
+					return errors.New("nil is invalid value")
 				}
 				return nil
 			}(); err != nil {
 				return res, errors.Wrap(err, "validate")
 			}
-			return &response, nil
+			return response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -6883,7 +10597,7 @@ func decodeSyncpolicyListResponse(resp *http.Response) (res *SyncpolicyListOK, _
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeSyncpolicyUpdateResponse(resp *http.Response) (res *SyncPolicy, _ error) {
+func decodeWebhookUpdateResponse(resp *http.Response) (res *Webhook, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -6899,7 +10613,7 @@ func decodeSyncpolicyUpdateResponse(resp *http.Response) (res *SyncPolicy, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response SyncPolicy
+			var response Webhook
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6966,10 +10680,14 @@ func decodeSyncpolicyUpdateResponse(resp *http.Response) (res *SyncPolicy, _ err
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeTgSessionCreateResponse(resp *http.Response) (res *Telegram, _ error) {
+func decodeWorkerJobsCancelResponse(resp *http.Response) (res *WorkerJobsCancelNoContent, _ error) {
 	switch resp.StatusCode {
-	case 201:
-		// Code 201.
+	case 204:
+		// Code 204.
+		return &WorkerJobsCancelNoContent{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -6982,7 +10700,7 @@ func decodeTgSessionCreateResponse(resp *http.Response) (res *Telegram, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Telegram
+			var response Error
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6999,10 +10717,25 @@ func decodeTgSessionCreateResponse(resp *http.Response) (res *Telegram, _ error)
 				}
 				return res, err
 			}
-			return &response, nil
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeWorkerJobsDeleteResponse(resp *http.Response) (res *WorkerJobsDeleteOK, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		return &WorkerJobsDeleteOK{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -7049,7 +10782,7 @@ func decodeTgSessionCreateResponse(resp *http.Response) (res *Telegram, _ error)
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeTgSessionListResponse(resp *http.Response) (res *TgSessionListOK, _ error) {
+func decodeWorkerJobsGetResponse(resp *http.Response) (res *WorkerJobs, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -7065,7 +10798,7 @@ func decodeTgSessionListResponse(resp *http.Response) (res *TgSessionListOK, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response TgSessionListOK
+			var response WorkerJobs
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7132,7 +10865,7 @@ func decodeTgSessionListResponse(resp *http.Response) (res *TgSessionListOK, _ e
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeTgSessionVerifyResponse(resp *http.Response) (res *Telegram, _ error) {
+func decodeWorkerJobsListResponse(resp *http.Response) (res *WorkerJobsListOK, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -7148,7 +10881,7 @@ func decodeTgSessionVerifyResponse(resp *http.Response) (res *Telegram, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Telegram
+			var response WorkerJobsListOK
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7165,6 +10898,15 @@ func decodeTgSessionVerifyResponse(resp *http.Response) (res *Telegram, _ error)
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -7215,7 +10957,7 @@ func decodeTgSessionVerifyResponse(resp *http.Response) (res *Telegram, _ error)
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeUpdateContactResponse(resp *http.Response) (res *Contact, _ error) {
+func decodeWorkerJobsRetryResponse(resp *http.Response) (res *WorkerJobs, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -7231,7 +10973,7 @@ func decodeUpdateContactResponse(resp *http.Response) (res *Contact, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Contact
+			var response WorkerJobs
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7298,10 +11040,10 @@ func decodeUpdateContactResponse(resp *http.Response) (res *Contact, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeUpdateProfileResponse(resp *http.Response) (res *User, _ error) {
+func decodeWorkspaceCreateResponse(resp *http.Response) (res *Workspace, _ error) {
 	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 201:
+		// Code 201.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7314,7 +11056,7 @@ func decodeUpdateProfileResponse(resp *http.Response) (res *User, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response User
+			var response Workspace
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7331,15 +11073,6 @@ func decodeUpdateProfileResponse(resp *http.Response) (res *User, _ error) {
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -7390,10 +11123,14 @@ func decodeUpdateProfileResponse(resp *http.Response) (res *User, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeUpdateUserResponse(resp *http.Response) (res *User, _ error) {
+func decodeWorkspaceDeleteResponse(resp *http.Response) (res *WorkspaceDeleteOK, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
+		return &WorkspaceDeleteOK{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7406,7 +11143,7 @@ func decodeUpdateUserResponse(resp *http.Response) (res *User, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response User
+			var response Error
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7423,14 +11160,52 @@ func decodeUpdateUserResponse(resp *http.Response) (res *User, _ error) {
 				}
 				return res, err
 			}
-			// Validate response.
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeWorkspaceGetResponse(resp *http.Response) (res *Workspace, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Workspace
 			if err := func() error {
-				if err := response.Validate(); err != nil {
+				if err := response.Decode(d); err != nil {
 					return err
 				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
 			}
 			return &response, nil
 		default:
@@ -7482,7 +11257,7 @@ func decodeUpdateUserResponse(resp *http.Response) (res *User, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeUploadFileResponse(resp *http.Response) (res *UploadFileResponse, _ error) {
+func decodeWorkspaceListResponse(resp *http.Response) (res []Workspace, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -7498,9 +11273,17 @@ func decodeUploadFileResponse(resp *http.Response) (res *UploadFileResponse, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response UploadFileResponse
+			var response []Workspace
 			if err := func() error {
-				if err := response.Decode(d); err != nil {
+				response = make([]Workspace, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Workspace
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -7515,7 +11298,16 @@ func decodeUploadFileResponse(resp *http.Response) (res *UploadFileResponse, _ e
 				}
 				return res, err
 			}
-			return &response, nil
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -7565,11 +11357,11 @@ func decodeUploadFileResponse(resp *http.Response) (res *UploadFileResponse, _ e
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeWorkerJobsCancelResponse(resp *http.Response) (res *WorkerJobsCancelNoContent, _ error) {
+func decodeWorkspaceMemberDeleteResponse(resp *http.Response) (res *WorkspaceMemberDeleteOK, _ error) {
 	switch resp.StatusCode {
-	case 204:
-		// Code 204.
-		return &WorkerJobsCancelNoContent{}, nil
+	case 200:
+		// Code 200.
+		return &WorkspaceMemberDeleteOK{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -7616,11 +11408,77 @@ func decodeWorkerJobsCancelResponse(resp *http.Response) (res *WorkerJobsCancelN
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeWorkerJobsDeleteResponse(resp *http.Response) (res *WorkerJobsDeleteOK, _ error) {
+func decodeWorkspaceMemberListResponse(resp *http.Response) (res []WorkspaceMember, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
-		return &WorkerJobsDeleteOK{}, nil
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []WorkspaceMember
+			if err := func() error {
+				response = make([]WorkspaceMember, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem WorkspaceMember
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				var failures []validate.FieldError
+				for i, elem := range response {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -7667,7 +11525,7 @@ func decodeWorkerJobsDeleteResponse(resp *http.Response) (res *WorkerJobsDeleteO
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeWorkerJobsGetResponse(resp *http.Response) (res *WorkerJobs, _ error) {
+func decodeWorkspaceMemberSetResponse(resp *http.Response) (res *WorkspaceMember, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -7683,7 +11541,7 @@ func decodeWorkerJobsGetResponse(resp *http.Response) (res *WorkerJobs, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response WorkerJobs
+			var response WorkspaceMember
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7700,6 +11558,15 @@ func decodeWorkerJobsGetResponse(resp *http.Response) (res *WorkerJobs, _ error)
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -7750,7 +11617,7 @@ func decodeWorkerJobsGetResponse(resp *http.Response) (res *WorkerJobs, _ error)
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeWorkerJobsListResponse(resp *http.Response) (res *WorkerJobsListOK, _ error) {
+func decodeWorkspaceUpdateResponse(resp *http.Response) (res *Workspace, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -7766,7 +11633,7 @@ func decodeWorkerJobsListResponse(resp *http.Response) (res *WorkerJobsListOK, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response WorkerJobsListOK
+			var response Workspace
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7783,15 +11650,6 @@ func decodeWorkerJobsListResponse(resp *http.Response) (res *WorkerJobsListOK, _
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -61,6 +61,116 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
+			case 'a': // Prefix: "a"
+				origElem := elem
+				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'p': // Prefix: "pikey"
+					origElem := elem
+					if l := len("pikey"); len(elem) >= l && elem[0:l] == "pikey" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleApikeyListRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleApikeyCreateRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "uuid"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleApikeyRevokeRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				case 'u': // Prefix: "udit"
+					origElem := elem
+					if l := len("udit"); len(elem) >= l && elem[0:l] == "udit" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleAuditListRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/export"
+						origElem := elem
+						if l := len("/export"); len(elem) >= l && elem[0:l] == "/export" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleAuditExportRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
 			case 'c': // Prefix: "contact"
 				origElem := elem
 				if l := len("contact"); len(elem) >= l && elem[0:l] == "contact" {
@@ -209,61 +319,164 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							}
 
 							elem = origElem
-						case '_': // Prefix: "_oauth"
+						case '_': // Prefix: "_"
 							origElem := elem
-							if l := len("_oauth"); len(elem) >= l && elem[0:l] == "_oauth" {
+							if l := len("_"); len(elem) >= l && elem[0:l] == "_" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								switch r.Method {
-								case "GET":
-									s.handleDatasourceEmailOAuthListRequest([0]string{}, elemIsEscaped, w, r)
-								case "POST":
-									s.handleDatasourceEmailOAuthCreateRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET,POST")
-								}
-
-								return
+								break
 							}
 							switch elem[0] {
-							case '/': // Prefix: "/"
+							case 'g': // Prefix: "graph"
 								origElem := elem
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								if l := len("graph"); len(elem) >= l && elem[0:l] == "graph" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
-								// Param: "uuid"
-								// Leaf parameter
-								args[0] = elem
-								elem = ""
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleDatasourceEmailGraphListRequest([0]string{}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleDatasourceEmailGraphCreateRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,POST")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+									origElem := elem
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "uuid"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[0] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "DELETE":
+											s.handleDatasourceEmailGraphDeleteRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleDatasourceEmailGraphGetRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "PUT":
+											s.handleDatasourceEmailGraphUpdateRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE,GET,PUT")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/send"
+										origElem := elem
+										if l := len("/send"); len(elem) >= l && elem[0:l] == "/send" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleDatasourceEmailGraphSendRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+										elem = origElem
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							case 'o': // Prefix: "oauth"
+								origElem := elem
+								if l := len("oauth"); len(elem) >= l && elem[0:l] == "oauth" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
 								if len(elem) == 0 {
-									// Leaf node.
 									switch r.Method {
-									case "DELETE":
-										s.handleDatasourceEmailOAuthDeleteRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
 									case "GET":
-										s.handleDatasourceEmailOAuthGetRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									case "PUT":
-										s.handleDatasourceEmailOAuthUpdateRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
+										s.handleDatasourceEmailOAuthListRequest([0]string{}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleDatasourceEmailOAuthCreateRequest([0]string{}, elemIsEscaped, w, r)
 									default:
-										s.notAllowed(w, r, "DELETE,GET,PUT")
+										s.notAllowed(w, r, "GET,POST")
 									}
 
 									return
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+									origElem := elem
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "uuid"
+									// Leaf parameter
+									args[0] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleDatasourceEmailOAuthDeleteRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleDatasourceEmailOAuthGetRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "PUT":
+											s.handleDatasourceEmailOAuthUpdateRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE,GET,PUT")
+										}
+
+										return
+									}
+
+									elem = origElem
+								}
 
 								elem = origElem
 							}
@@ -494,6 +707,65 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					elem = origElem
 				}
 
+				elem = origElem
+			case 'e': // Prefix: "e"
+				origElem := elem
+				if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'r': // Prefix: "rasure"
+					origElem := elem
+					if l := len("rasure"); len(elem) >= l && elem[0:l] == "rasure" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleErasureListRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleErasureCreateRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+
+					elem = origElem
+				case 'v': // Prefix: "vent"
+					origElem := elem
+					if l := len("vent"); len(elem) >= l && elem[0:l] == "vent" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleEventListRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+					elem = origElem
+				}
+
 				elem = origElem
 			case 'f': // Prefix: "file"
 				origElem := elem
@@ -809,12 +1081,15 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									}
 
 									// Param: "uuid"
-									// Leaf parameter
-									args[1] = elem
-									elem = ""
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[1] = elem[:idx]
+									elem = elem[idx:]
 
 									if len(elem) == 0 {
-										// Leaf node.
 										switch r.Method {
 										case "DELETE":
 											s.handleOAuth2ClientTokenDeleteRequest([2]string{
@@ -827,6 +1102,32 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 										return
 									}
+									switch elem[0] {
+									case '/': // Prefix: "/refresh"
+										origElem := elem
+										if l := len("/refresh"); len(elem) >= l && elem[0:l] == "/refresh" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleOAuth2ClientTokenRefreshRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+										elem = origElem
+									}
 
 									elem = origElem
 								}
@@ -861,6 +1162,27 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						return
 					}
 
+					elem = origElem
+				case 'p': // Prefix: "provider"
+					origElem := elem
+					if l := len("provider"); len(elem) >= l && elem[0:l] == "provider" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleOAuth2ProviderListRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
 					elem = origElem
 				}
 
@@ -907,6 +1229,94 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						}
 
 						// Param: "uuid"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "DELETE":
+								s.handlePipelineDeleteRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handlePipelineGetRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handlePipelineUpdateRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET,PUT")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/run"
+							origElem := elem
+							if l := len("/run"); len(elem) >= l && elem[0:l] == "/run" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handlePipelineRunRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				case 'o': // Prefix: "olicy"
+					origElem := elem
+					if l := len("olicy"); len(elem) >= l && elem[0:l] == "olicy" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handlePolicyListRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handlePolicyCreateRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
 						// Leaf parameter
 						args[0] = elem
 						elem = ""
@@ -915,15 +1325,15 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							// Leaf node.
 							switch r.Method {
 							case "DELETE":
-								s.handlePipelineDeleteRequest([1]string{
+								s.handlePolicyDeleteRequest([1]string{
 									args[0],
 								}, elemIsEscaped, w, r)
 							case "GET":
-								s.handlePipelineGetRequest([1]string{
+								s.handlePolicyGetRequest([1]string{
 									args[0],
 								}, elemIsEscaped, w, r)
 							case "PUT":
-								s.handlePipelineUpdateRequest([1]string{
+								s.handlePolicyUpdateRequest([1]string{
 									args[0],
 								}, elemIsEscaped, w, r)
 							default:
@@ -962,6 +1372,94 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					elem = origElem
 				}
 
+				elem = origElem
+			case 'r': // Prefix: "retention"
+				origElem := elem
+				if l := len("retention"); len(elem) >= l && elem[0:l] == "retention" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleRetentionPolicyListRequest([0]string{}, elemIsEscaped, w, r)
+					case "POST":
+						s.handleRetentionPolicyCreateRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET,POST")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					origElem := elem
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "uuid"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "DELETE":
+							s.handleRetentionPolicyDeleteRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "GET":
+							s.handleRetentionPolicyGetRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "PUT":
+							s.handleRetentionPolicyUpdateRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE,GET,PUT")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/run"
+						origElem := elem
+						if l := len("/run"); len(elem) >= l && elem[0:l] == "/run" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleRetentionPolicyRunRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
+
 				elem = origElem
 			case 's': // Prefix: "s"
 				origElem := elem
@@ -1044,16 +1542,47 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch r.Method {
+						case "DELETE":
+							s.handleSessionRevokeAllRequest([0]string{}, elemIsEscaped, w, r)
 						case "GET":
 							s.handleSessionStatusRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "GET")
+							s.notAllowed(w, r, "DELETE,GET")
 						}
 
 						return
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "uuid"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleSessionRevokeRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
 
 					elem = origElem
 				case 't': // Prefix: "torage"
@@ -1326,6 +1855,47 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 							elem = origElem
 						}
+						// Param: "uuid"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/migrate"
+							origElem := elem
+							if l := len("/migrate"); len(elem) >= l && elem[0:l] == "/migrate" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleStorageMigrationListRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleStorageMigrateRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET,POST")
+								}
+
+								return
+							}
+
+							elem = origElem
+						}
 
 						elem = origElem
 					}
@@ -1506,76 +2076,69 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				}
 
 				elem = origElem
-			case 'w': // Prefix: "workerjobs"
+			case 'w': // Prefix: "w"
 				origElem := elem
-				if l := len("workerjobs"); len(elem) >= l && elem[0:l] == "workerjobs" {
+				if l := len("w"); len(elem) >= l && elem[0:l] == "w" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					switch r.Method {
-					case "GET":
-						s.handleWorkerJobsListRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET")
-					}
-
-					return
+					break
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/"
+				case 'e': // Prefix: "ebhook"
 					origElem := elem
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					if l := len("ebhook"); len(elem) >= l && elem[0:l] == "ebhook" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					// Param: "uuid"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
-
 					if len(elem) == 0 {
 						switch r.Method {
-						case "DELETE":
-							s.handleWorkerJobsDeleteRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
 						case "GET":
-							s.handleWorkerJobsGetRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
+							s.handleWebhookListRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleWebhookCreateRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "DELETE,GET")
+							s.notAllowed(w, r, "GET,POST")
 						}
 
 						return
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/cancel"
+					case '/': // Prefix: "/"
 						origElem := elem
-						if l := len("/cancel"); len(elem) >= l && elem[0:l] == "/cancel" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "uuid"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
+
 						if len(elem) == 0 {
 							// Leaf node.
 							switch r.Method {
-							case "POST":
-								s.handleWorkerJobsCancelRequest([1]string{
+							case "DELETE":
+								s.handleWebhookDeleteRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleWebhookGetRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleWebhookUpdateRequest([1]string{
 									args[0],
 								}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "POST")
+								s.notAllowed(w, r, "DELETE,GET,PUT")
 							}
 
 							return
@@ -1585,210 +2148,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					}
 
 					elem = origElem
-				}
-
-				elem = origElem
-			}
-
-			elem = origElem
-		}
-	}
-	s.notFound(w, r)
-}
-
-// Route is route object.
-type Route struct {
-	name        string
-	summary     string
-	operationID string
-	pathPattern string
-	count       int
-	args        [2]string
-}
-
-// Name returns ogen operation name.
-//
-// It is guaranteed to be unique and not empty.
-func (r Route) Name() string {
-	return r.name
-}
-
-// Summary returns OpenAPI summary.
-func (r Route) Summary() string {
-	return r.summary
-}
-
-// OperationID returns OpenAPI operationId.
-func (r Route) OperationID() string {
-	return r.operationID
-}
-
-// PathPattern returns OpenAPI path.
-func (r Route) PathPattern() string {
-	return r.pathPattern
-}
-
-// Args returns parsed arguments.
-func (r Route) Args() []string {
-	return r.args[:r.count]
-}
-
-// FindRoute finds Route for given method and path.
-//
-// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
-func (s *Server) FindRoute(method, path string) (Route, bool) {
-	return s.FindPath(method, &url.URL{Path: path})
-}
-
-// FindPath finds Route for given method and URL.
-func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
-	var (
-		elem = u.Path
-		args = r.args
-	)
-	if rawPath := u.RawPath; rawPath != "" {
-		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
-			elem = normalized
-		}
-		defer func() {
-			for i, arg := range r.args[:r.count] {
-				if unescaped, err := url.PathUnescape(arg); err == nil {
-					r.args[i] = unescaped
-				}
-			}
-		}()
-	}
-
-	elem, ok := s.cutPrefix(elem)
-	if !ok {
-		return r, false
-	}
-
-	// Static code generated router with unwrapped path search.
-	switch {
-	default:
-		if len(elem) == 0 {
-			break
-		}
-		switch elem[0] {
-		case '/': // Prefix: "/"
-			origElem := elem
-			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-				elem = elem[l:]
-			} else {
-				break
-			}
-
-			if len(elem) == 0 {
-				break
-			}
-			switch elem[0] {
-			case 'c': // Prefix: "contact"
-				origElem := elem
-				if l := len("contact"); len(elem) >= l && elem[0:l] == "contact" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					switch method {
-					case "GET":
-						r.name = ListContactsOperation
-						r.summary = "List all contacts"
-						r.operationID = "listContacts"
-						r.pathPattern = "/contact"
-						r.args = args
-						r.count = 0
-						return r, true
-					case "POST":
-						r.name = CreateContactOperation
-						r.summary = "Create a new contact record"
-						r.operationID = "createContact"
-						r.pathPattern = "/contact"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
-					origElem := elem
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					// Param: "uuid"
-					// Leaf parameter
-					args[0] = elem
-					elem = ""
-
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "DELETE":
-							r.name = DeleteContactOperation
-							r.summary = "Delete a contact record"
-							r.operationID = "deleteContact"
-							r.pathPattern = "/contact/{uuid}"
-							r.args = args
-							r.count = 1
-							return r, true
-						case "GET":
-							r.name = GetContactOperation
-							r.summary = "Get contact details"
-							r.operationID = "getContact"
-							r.pathPattern = "/contact/{uuid}"
-							r.args = args
-							r.count = 1
-							return r, true
-						case "PUT":
-							r.name = UpdateContactOperation
-							r.summary = "Update contact details"
-							r.operationID = "updateContact"
-							r.pathPattern = "/contact/{uuid}"
-							r.args = args
-							r.count = 1
-							return r, true
-						default:
-							return
-						}
-					}
-
-					elem = origElem
-				}
-
-				elem = origElem
-			case 'd': // Prefix: "datasource"
-				origElem := elem
-				if l := len("datasource"); len(elem) >= l && elem[0:l] == "datasource" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					switch method {
-					case "GET":
-						r.name = DatasourceListOperation
-						r.summary = ""
-						r.operationID = "datasource-list"
-						r.pathPattern = "/datasource"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
+				case 'o': // Prefix: "ork"
 					origElem := elem
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					if l := len("ork"); len(elem) >= l && elem[0:l] == "ork" {
 						elem = elem[l:]
 					} else {
 						break
@@ -1798,35 +2160,23 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 					switch elem[0] {
-					case 'e': // Prefix: "email"
+					case 'e': // Prefix: "erjobs"
 						origElem := elem
-						if l := len("email"); len(elem) >= l && elem[0:l] == "email" {
+						if l := len("erjobs"); len(elem) >= l && elem[0:l] == "erjobs" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							switch method {
+							switch r.Method {
 							case "GET":
-								r.name = DatasourceEmailListOperation
-								r.summary = ""
-								r.operationID = "datasource-email-list"
-								r.pathPattern = "/datasource/email"
-								r.args = args
-								r.count = 0
-								return r, true
-							case "POST":
-								r.name = DatasourceEmailCreateOperation
-								r.summary = ""
-								r.operationID = "datasource-email-create"
-								r.pathPattern = "/datasource/email"
-								r.args = args
-								r.count = 0
-								return r, true
+								s.handleWorkerJobsListRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								return
+								s.notAllowed(w, r, "GET")
 							}
+
+							return
 						}
 						switch elem[0] {
 						case '/': // Prefix: "/"
@@ -1838,72 +2188,29 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 
 							// Param: "uuid"
-							// Leaf parameter
-							args[0] = elem
-							elem = ""
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
 
 							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
+								switch r.Method {
 								case "DELETE":
-									r.name = DatasourceEmailDeleteOperation
-									r.summary = ""
-									r.operationID = "datasource-email-delete"
-									r.pathPattern = "/datasource/email/{uuid}"
-									r.args = args
-									r.count = 1
-									return r, true
+									s.handleWorkerJobsDeleteRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
 								case "GET":
-									r.name = DatasourceEmailGetOperation
-									r.summary = ""
-									r.operationID = "datasource-email-get"
-									r.pathPattern = "/datasource/email/{uuid}"
-									r.args = args
-									r.count = 1
-									return r, true
-								case "PUT":
-									r.name = DatasourceEmailUpdateOperation
-									r.summary = ""
-									r.operationID = "datasource-email-update"
-									r.pathPattern = "/datasource/email/{uuid}"
-									r.args = args
-									r.count = 1
-									return r, true
+									s.handleWorkerJobsGetRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
 								default:
-									return
+									s.notAllowed(w, r, "DELETE,GET")
 								}
-							}
-
-							elem = origElem
-						case '_': // Prefix: "_oauth"
-							origElem := elem
-							if l := len("_oauth"); len(elem) >= l && elem[0:l] == "_oauth" {
-								elem = elem[l:]
-							} else {
-								break
-							}
 
-							if len(elem) == 0 {
-								switch method {
-								case "GET":
-									r.name = DatasourceEmailOAuthListOperation
-									r.summary = ""
-									r.operationID = "datasource-email-oauth-list"
-									r.pathPattern = "/datasource/email_oauth"
-									r.args = args
-									r.count = 0
-									return r, true
-								case "POST":
-									r.name = DatasourceEmailOAuthCreateOperation
-									r.summary = ""
-									r.operationID = "datasource-email-oauth-create"
-									r.pathPattern = "/datasource/email_oauth"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
+								return
 							}
 							switch elem[0] {
 							case '/': // Prefix: "/"
@@ -1914,41 +2221,56 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									break
 								}
 
-								// Param: "uuid"
-								// Leaf parameter
-								args[0] = elem
-								elem = ""
-
 								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "DELETE":
-										r.name = DatasourceEmailOAuthDeleteOperation
-										r.summary = ""
-										r.operationID = "datasource-email-oauth-delete"
-										r.pathPattern = "/datasource/email_oauth/{uuid}"
-										r.args = args
-										r.count = 1
-										return r, true
-									case "GET":
-										r.name = DatasourceEmailOAuthGetOperation
-										r.summary = ""
-										r.operationID = "datasource-email-oauth-get"
-										r.pathPattern = "/datasource/email_oauth/{uuid}"
-										r.args = args
-										r.count = 1
-										return r, true
-									case "PUT":
-										r.name = DatasourceEmailOAuthUpdateOperation
-										r.summary = ""
-										r.operationID = "datasource-email-oauth-update"
-										r.pathPattern = "/datasource/email_oauth/{uuid}"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
+									break
+								}
+								switch elem[0] {
+								case 'c': // Prefix: "cancel"
+									origElem := elem
+									if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleWorkerJobsCancelRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+									elem = origElem
+								case 'r': // Prefix: "retry"
+									origElem := elem
+									if l := len("retry"); len(elem) >= l && elem[0:l] == "retry" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleWorkerJobsRetryRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
 										return
 									}
+
+									elem = origElem
 								}
 
 								elem = origElem
@@ -1958,35 +2280,25 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 
 						elem = origElem
-					case 'l': // Prefix: "linkedin"
+					case 's': // Prefix: "space"
 						origElem := elem
-						if l := len("linkedin"); len(elem) >= l && elem[0:l] == "linkedin" {
+						if l := len("space"); len(elem) >= l && elem[0:l] == "space" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							switch method {
+							switch r.Method {
 							case "GET":
-								r.name = DatasourceLinkedinListOperation
-								r.summary = ""
-								r.operationID = "datasource-linkedin-list"
-								r.pathPattern = "/datasource/linkedin"
-								r.args = args
-								r.count = 0
-								return r, true
+								s.handleWorkspaceListRequest([0]string{}, elemIsEscaped, w, r)
 							case "POST":
-								r.name = DatasourceLinkedinCreateOperation
-								r.summary = ""
-								r.operationID = "datasource-linkedin-create"
-								r.pathPattern = "/datasource/linkedin"
-								r.args = args
-								r.count = 0
-								return r, true
+								s.handleWorkspaceCreateRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								return
+								s.notAllowed(w, r, "GET,POST")
 							}
+
+							return
 						}
 						switch elem[0] {
 						case '/': // Prefix: "/"
@@ -1998,149 +2310,314 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 
 							// Param: "uuid"
-							// Leaf parameter
-							args[0] = elem
-							elem = ""
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
 
 							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
+								switch r.Method {
 								case "DELETE":
-									r.name = DatasourceLinkedinDeleteOperation
-									r.summary = ""
-									r.operationID = "datasource-linkedin-delete"
-									r.pathPattern = "/datasource/linkedin/{uuid}"
-									r.args = args
-									r.count = 1
-									return r, true
+									s.handleWorkspaceDeleteRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
 								case "GET":
-									r.name = DatasourceLinkedinGetOperation
-									r.summary = ""
-									r.operationID = "datasource-linkedin-get"
-									r.pathPattern = "/datasource/linkedin/{uuid}"
-									r.args = args
-									r.count = 1
-									return r, true
+									s.handleWorkspaceGetRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
 								case "PUT":
-									r.name = DatasourceLinkedinUpdateOperation
-									r.summary = ""
-									r.operationID = "datasource-linkedin-update"
-									r.pathPattern = "/datasource/linkedin/{uuid}"
-									r.args = args
-									r.count = 1
-									return r, true
+									s.handleWorkspaceUpdateRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
 								default:
+									s.notAllowed(w, r, "DELETE,GET,PUT")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/member"
+								origElem := elem
+								if l := len("/member"); len(elem) >= l && elem[0:l] == "/member" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleWorkspaceMemberListRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleWorkspaceMemberSetRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,POST")
+									}
+
 									return
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+									origElem := elem
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "user_uuid"
+									// Leaf parameter
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleWorkspaceMemberDeleteRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE")
+										}
+
+										return
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
 							}
 
 							elem = origElem
 						}
 
 						elem = origElem
-					case 't': // Prefix: "telegram"
-						origElem := elem
-						if l := len("telegram"); len(elem) >= l && elem[0:l] == "telegram" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+					}
 
-						if len(elem) == 0 {
-							switch method {
-							case "GET":
-								r.name = DatasourceTelegramListOperation
-								r.summary = ""
-								r.operationID = "datasource-telegram-list"
-								r.pathPattern = "/datasource/telegram"
-								r.args = args
-								r.count = 0
-								return r, true
-							case "POST":
-								r.name = DatasourceTelegramCreateOperation
+					elem = origElem
+				}
+
+				elem = origElem
+			}
+
+			elem = origElem
+		}
+	}
+	s.notFound(w, r)
+}
+
+// Route is route object.
+type Route struct {
+	name        string
+	summary     string
+	operationID string
+	pathPattern string
+	count       int
+	args        [2]string
+}
+
+// Name returns ogen operation name.
+//
+// It is guaranteed to be unique and not empty.
+func (r Route) Name() string {
+	return r.name
+}
+
+// Summary returns OpenAPI summary.
+func (r Route) Summary() string {
+	return r.summary
+}
+
+// OperationID returns OpenAPI operationId.
+func (r Route) OperationID() string {
+	return r.operationID
+}
+
+// PathPattern returns OpenAPI path.
+func (r Route) PathPattern() string {
+	return r.pathPattern
+}
+
+// Args returns parsed arguments.
+func (r Route) Args() []string {
+	return r.args[:r.count]
+}
+
+// FindRoute finds Route for given method and path.
+//
+// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
+func (s *Server) FindRoute(method, path string) (Route, bool) {
+	return s.FindPath(method, &url.URL{Path: path})
+}
+
+// FindPath finds Route for given method and URL.
+func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
+	var (
+		elem = u.Path
+		args = r.args
+	)
+	if rawPath := u.RawPath; rawPath != "" {
+		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
+			elem = normalized
+		}
+		defer func() {
+			for i, arg := range r.args[:r.count] {
+				if unescaped, err := url.PathUnescape(arg); err == nil {
+					r.args[i] = unescaped
+				}
+			}
+		}()
+	}
+
+	elem, ok := s.cutPrefix(elem)
+	if !ok {
+		return r, false
+	}
+
+	// Static code generated router with unwrapped path search.
+	switch {
+	default:
+		if len(elem) == 0 {
+			break
+		}
+		switch elem[0] {
+		case '/': // Prefix: "/"
+			origElem := elem
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				elem = elem[l:]
+			} else {
+				break
+			}
+
+			if len(elem) == 0 {
+				break
+			}
+			switch elem[0] {
+			case 'a': // Prefix: "a"
+				origElem := elem
+				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'p': // Prefix: "pikey"
+					origElem := elem
+					if l := len("pikey"); len(elem) >= l && elem[0:l] == "pikey" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = ApikeyListOperation
+							r.summary = ""
+							r.operationID = "apikey-list"
+							r.pathPattern = "/apikey"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = ApikeyCreateOperation
+							r.summary = ""
+							r.operationID = "apikey-create"
+							r.pathPattern = "/apikey"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "uuid"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = ApikeyRevokeOperation
 								r.summary = ""
-								r.operationID = "datasource-telegram-create"
-								r.pathPattern = "/datasource/telegram"
+								r.operationID = "apikey-revoke"
+								r.pathPattern = "/apikey/{uuid}"
 								r.args = args
-								r.count = 0
+								r.count = 1
 								return r, true
 							default:
 								return
 							}
 						}
-						switch elem[0] {
-						case '/': // Prefix: "/"
-							origElem := elem
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
 
-							// Param: "uuid"
-							// Leaf parameter
-							args[0] = elem
-							elem = ""
+						elem = origElem
+					}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "DELETE":
-									r.name = DatasourceTelegramDeleteOperation
-									r.summary = ""
-									r.operationID = "datasource-telegram-delete"
-									r.pathPattern = "/datasource/telegram/{uuid}"
-									r.args = args
-									r.count = 1
-									return r, true
-								case "GET":
-									r.name = DatasourceTelegramGetOperation
-									r.summary = ""
-									r.operationID = "datasource-telegram-get"
-									r.pathPattern = "/datasource/telegram/{uuid}"
-									r.args = args
-									r.count = 1
-									return r, true
-								case "PUT":
-									r.name = DatasourceTelegramUpdateOperation
-									r.summary = ""
-									r.operationID = "datasource-telegram-update"
-									r.pathPattern = "/datasource/telegram/{uuid}"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
-								}
-							}
+					elem = origElem
+				case 'u': // Prefix: "udit"
+					origElem := elem
+					if l := len("udit"); len(elem) >= l && elem[0:l] == "udit" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-							elem = origElem
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = AuditListOperation
+							r.summary = ""
+							r.operationID = "audit-list"
+							r.pathPattern = "/audit"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
 						}
-
-						elem = origElem
-					case 'w': // Prefix: "whatsapp"
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/export"
 						origElem := elem
-						if l := len("whatsapp"); len(elem) >= l && elem[0:l] == "whatsapp" {
+						if l := len("/export"); len(elem) >= l && elem[0:l] == "/export" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
+							// Leaf node.
 							switch method {
 							case "GET":
-								r.name = DatasourceWhatsappListOperation
-								r.summary = ""
-								r.operationID = "datasource-whatsapp-list"
-								r.pathPattern = "/datasource/whatsapp"
-								r.args = args
-								r.count = 0
-								return r, true
-							case "POST":
-								r.name = DatasourceWhatsappCreateOperation
+								r.name = AuditExportOperation
 								r.summary = ""
-								r.operationID = "datasource-whatsapp-create"
-								r.pathPattern = "/datasource/whatsapp"
+								r.operationID = "audit-export"
+								r.pathPattern = "/audit/export"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -2148,104 +2625,17 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								return
 							}
 						}
-						switch elem[0] {
-						case '/': // Prefix: "/"
-							origElem := elem
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
 
-							// Param: "uuid"
-							// Leaf parameter
-							args[0] = elem
-							elem = ""
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "DELETE":
-									r.name = DatasourceWhatsappDeleteOperation
-									r.summary = ""
-									r.operationID = "datasource-whatsapp-delete"
-									r.pathPattern = "/datasource/whatsapp/{uuid}"
-									r.args = args
-									r.count = 1
-									return r, true
-								case "GET":
-									r.name = DatasourceWhatsappGetOperation
-									r.summary = ""
-									r.operationID = "datasource-whatsapp-get"
-									r.pathPattern = "/datasource/whatsapp/{uuid}"
-									r.args = args
-									r.count = 1
-									return r, true
-								case "PUT":
-									r.name = DatasourceWhatsappUpdateOperation
-									r.summary = ""
-									r.operationID = "datasource-whatsapp-update"
-									r.pathPattern = "/datasource/whatsapp/{uuid}"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
-								}
-							}
-
-							elem = origElem
-						}
-
-						elem = origElem
-					}
-					// Param: "uuid"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
-
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/oauth2/client"
-						origElem := elem
-						if l := len("/oauth2/client"); len(elem) >= l && elem[0:l] == "/oauth2/client" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "PUT":
-								r.name = DatasourceSetOAuth2ClientOperation
-								r.summary = ""
-								r.operationID = "datasource-set-oauth2-client"
-								r.pathPattern = "/datasource/{uuid}/oauth2/client"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
-							}
-						}
-
-						elem = origElem
-					}
+						elem = origElem
+					}
 
 					elem = origElem
 				}
 
 				elem = origElem
-			case 'f': // Prefix: "file"
+			case 'c': // Prefix: "contact"
 				origElem := elem
-				if l := len("file"); len(elem) >= l && elem[0:l] == "file" {
+				if l := len("contact"); len(elem) >= l && elem[0:l] == "contact" {
 					elem = elem[l:]
 				} else {
 					break
@@ -2254,18 +2644,18 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				if len(elem) == 0 {
 					switch method {
 					case "GET":
-						r.name = FileListOperation
-						r.summary = ""
-						r.operationID = "file-list"
-						r.pathPattern = "/file"
+						r.name = ListContactsOperation
+						r.summary = "List all contacts"
+						r.operationID = "listContacts"
+						r.pathPattern = "/contact"
 						r.args = args
 						r.count = 0
 						return r, true
 					case "POST":
-						r.name = FileCreateOperation
-						r.summary = ""
-						r.operationID = "file-create"
-						r.pathPattern = "/file"
+						r.name = CreateContactOperation
+						r.summary = "Create a new contact record"
+						r.operationID = "createContact"
+						r.pathPattern = "/contact"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -2291,26 +2681,26 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						// Leaf node.
 						switch method {
 						case "DELETE":
-							r.name = FileDeleteOperation
-							r.summary = ""
-							r.operationID = "file-delete"
-							r.pathPattern = "/file/{uuid}"
+							r.name = DeleteContactOperation
+							r.summary = "Delete a contact record"
+							r.operationID = "deleteContact"
+							r.pathPattern = "/contact/{uuid}"
 							r.args = args
 							r.count = 1
 							return r, true
 						case "GET":
-							r.name = FileGetOperation
-							r.summary = ""
-							r.operationID = "file-get"
-							r.pathPattern = "/file/{uuid}"
+							r.name = GetContactOperation
+							r.summary = "Get contact details"
+							r.operationID = "getContact"
+							r.pathPattern = "/contact/{uuid}"
 							r.args = args
 							r.count = 1
 							return r, true
 						case "PUT":
-							r.name = FileUpdateOperation
-							r.summary = ""
-							r.operationID = "file-update"
-							r.pathPattern = "/file/{uuid}"
+							r.name = UpdateContactOperation
+							r.summary = "Update contact details"
+							r.operationID = "updateContact"
+							r.pathPattern = "/contact/{uuid}"
 							r.args = args
 							r.count = 1
 							return r, true
@@ -2323,266 +2713,109 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				}
 
 				elem = origElem
-			case 'm': // Prefix: "message/"
+			case 'd': // Prefix: "datasource"
 				origElem := elem
-				if l := len("message/"); len(elem) >= l && elem[0:l] == "message/" {
+				if l := len("datasource"); len(elem) >= l && elem[0:l] == "datasource" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					break
+					switch method {
+					case "GET":
+						r.name = DatasourceListOperation
+						r.summary = ""
+						r.operationID = "datasource-list"
+						r.pathPattern = "/datasource"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
 				}
 				switch elem[0] {
-				case 'e': // Prefix: "email/query"
+				case '/': // Prefix: "/"
 					origElem := elem
-					if l := len("email/query"); len(elem) >= l && elem[0:l] == "email/query" {
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "POST":
-							r.name = MessageEmailQueryOperation
-							r.summary = ""
-							r.operationID = "messageEmailQuery"
-							r.pathPattern = "/message/email/query"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
-
-					elem = origElem
-				case 'l': // Prefix: "linkedin/query"
-					origElem := elem
-					if l := len("linkedin/query"); len(elem) >= l && elem[0:l] == "linkedin/query" {
-						elem = elem[l:]
-					} else {
 						break
 					}
-
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "POST":
-							r.name = MessageLinkedinQueryOperation
-							r.summary = ""
-							r.operationID = "messageLinkedinQuery"
-							r.pathPattern = "/message/linkedin/query"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
+					switch elem[0] {
+					case 'e': // Prefix: "email"
+						origElem := elem
+						if l := len("email"); len(elem) >= l && elem[0:l] == "email" {
+							elem = elem[l:]
+						} else {
+							break
 						}
-					}
-
-					elem = origElem
-				case 'q': // Prefix: "query"
-					origElem := elem
-					if l := len("query"); len(elem) >= l && elem[0:l] == "query" {
-						elem = elem[l:]
-					} else {
-						break
-					}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "POST":
-							r.name = MessageQueryOperation
-							r.summary = ""
-							r.operationID = "messageQuery"
-							r.pathPattern = "/message/query"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = DatasourceEmailListOperation
+								r.summary = ""
+								r.operationID = "datasource-email-list"
+								r.pathPattern = "/datasource/email"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = DatasourceEmailCreateOperation
+								r.summary = ""
+								r.operationID = "datasource-email-create"
+								r.pathPattern = "/datasource/email"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
 						}
-					}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+							origElem := elem
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-					elem = origElem
-				case 't': // Prefix: "telegram/query"
-					origElem := elem
-					if l := len("telegram/query"); len(elem) >= l && elem[0:l] == "telegram/query" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "POST":
-							r.name = MessageTelegramQueryOperation
-							r.summary = ""
-							r.operationID = "messageTelegramQuery"
-							r.pathPattern = "/message/telegram/query"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
-
-					elem = origElem
-				case 'w': // Prefix: "whatsapp/query"
-					origElem := elem
-					if l := len("whatsapp/query"); len(elem) >= l && elem[0:l] == "whatsapp/query" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "POST":
-							r.name = MessageWhatsappQueryOperation
-							r.summary = ""
-							r.operationID = "messageWhatsappQuery"
-							r.pathPattern = "/message/whatsapp/query"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
-
-					elem = origElem
-				}
-
-				elem = origElem
-			case 'o': // Prefix: "oauth2/"
-				origElem := elem
-				if l := len("oauth2/"); len(elem) >= l && elem[0:l] == "oauth2/" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'c': // Prefix: "c"
-					origElem := elem
-					if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'a': // Prefix: "allback"
-						origElem := elem
-						if l := len("allback"); len(elem) >= l && elem[0:l] == "allback" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = OAuth2ClientCallbackOperation
-								r.summary = ""
-								r.operationID = "oauth2-client-callback"
-								r.pathPattern = "/oauth2/callback"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
-						}
-
-						elem = origElem
-					case 'l': // Prefix: "lient"
-						origElem := elem
-						if l := len("lient"); len(elem) >= l && elem[0:l] == "lient" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							switch method {
-							case "GET":
-								r.name = OAuth2ClientListOperation
-								r.summary = ""
-								r.operationID = "oauth2-client-list"
-								r.pathPattern = "/oauth2/client"
-								r.args = args
-								r.count = 0
-								return r, true
-							case "POST":
-								r.name = OAuth2ClientCreateOperation
-								r.summary = ""
-								r.operationID = "oauth2-client-create"
-								r.pathPattern = "/oauth2/client"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
-						}
-						switch elem[0] {
-						case '/': // Prefix: "/"
-							origElem := elem
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							// Param: "uuid"
-							// Match until "/"
-							idx := strings.IndexByte(elem, '/')
-							if idx < 0 {
-								idx = len(elem)
-							}
-							args[0] = elem[:idx]
-							elem = elem[idx:]
+							// Param: "uuid"
+							// Leaf parameter
+							args[0] = elem
+							elem = ""
 
 							if len(elem) == 0 {
+								// Leaf node.
 								switch method {
 								case "DELETE":
-									r.name = OAuth2ClientDeleteOperation
+									r.name = DatasourceEmailDeleteOperation
 									r.summary = ""
-									r.operationID = "oauth2-client-delete"
-									r.pathPattern = "/oauth2/client/{uuid}"
+									r.operationID = "datasource-email-delete"
+									r.pathPattern = "/datasource/email/{uuid}"
 									r.args = args
 									r.count = 1
 									return r, true
 								case "GET":
-									r.name = OAuth2ClientGetOperation
+									r.name = DatasourceEmailGetOperation
 									r.summary = ""
-									r.operationID = "oauth2-client-get"
-									r.pathPattern = "/oauth2/client/{uuid}"
+									r.operationID = "datasource-email-get"
+									r.pathPattern = "/datasource/email/{uuid}"
 									r.args = args
 									r.count = 1
 									return r, true
 								case "PUT":
-									r.name = OAuth2ClientUpdateOperation
+									r.name = DatasourceEmailUpdateOperation
 									r.summary = ""
-									r.operationID = "oauth2-client-update"
-									r.pathPattern = "/oauth2/client/{uuid}"
+									r.operationID = "datasource-email-update"
+									r.pathPattern = "/datasource/email/{uuid}"
 									r.args = args
 									r.count = 1
 									return r, true
@@ -2590,10 +2823,23 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									return
 								}
 							}
+
+							elem = origElem
+						case '_': // Prefix: "_"
+							origElem := elem
+							if l := len("_"); len(elem) >= l && elem[0:l] == "_" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
 							switch elem[0] {
-							case '/': // Prefix: "/token"
+							case 'g': // Prefix: "graph"
 								origElem := elem
-								if l := len("/token"); len(elem) >= l && elem[0:l] == "/token" {
+								if l := len("graph"); len(elem) >= l && elem[0:l] == "graph" {
 									elem = elem[l:]
 								} else {
 									break
@@ -2602,12 +2848,130 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								if len(elem) == 0 {
 									switch method {
 									case "GET":
-										r.name = OAuth2ClientTokenListOperation
+										r.name = DatasourceEmailGraphListOperation
 										r.summary = ""
-										r.operationID = "oauth2-client-token-list"
-										r.pathPattern = "/oauth2/client/{datasource_uuid}/token"
+										r.operationID = "datasource-email-graph-list"
+										r.pathPattern = "/datasource/email_graph"
 										r.args = args
-										r.count = 1
+										r.count = 0
+										return r, true
+									case "POST":
+										r.name = DatasourceEmailGraphCreateOperation
+										r.summary = ""
+										r.operationID = "datasource-email-graph-create"
+										r.pathPattern = "/datasource/email_graph"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+									origElem := elem
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "uuid"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[0] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										switch method {
+										case "DELETE":
+											r.name = DatasourceEmailGraphDeleteOperation
+											r.summary = ""
+											r.operationID = "datasource-email-graph-delete"
+											r.pathPattern = "/datasource/email_graph/{uuid}"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "GET":
+											r.name = DatasourceEmailGraphGetOperation
+											r.summary = ""
+											r.operationID = "datasource-email-graph-get"
+											r.pathPattern = "/datasource/email_graph/{uuid}"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "PUT":
+											r.name = DatasourceEmailGraphUpdateOperation
+											r.summary = ""
+											r.operationID = "datasource-email-graph-update"
+											r.pathPattern = "/datasource/email_graph/{uuid}"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/send"
+										origElem := elem
+										if l := len("/send"); len(elem) >= l && elem[0:l] == "/send" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = DatasourceEmailGraphSendOperation
+												r.summary = ""
+												r.operationID = "datasource-email-graph-send"
+												r.pathPattern = "/datasource/email_graph/{uuid}/send"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+										elem = origElem
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							case 'o': // Prefix: "oauth"
+								origElem := elem
+								if l := len("oauth"); len(elem) >= l && elem[0:l] == "oauth" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = DatasourceEmailOAuthListOperation
+										r.summary = ""
+										r.operationID = "datasource-email-oauth-list"
+										r.pathPattern = "/datasource/email_oauth"
+										r.args = args
+										r.count = 0
+										return r, true
+									case "POST":
+										r.name = DatasourceEmailOAuthCreateOperation
+										r.summary = ""
+										r.operationID = "datasource-email-oauth-create"
+										r.pathPattern = "/datasource/email_oauth"
+										r.args = args
+										r.count = 0
 										return r, true
 									default:
 										return
@@ -2624,19 +2988,35 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 									// Param: "uuid"
 									// Leaf parameter
-									args[1] = elem
+									args[0] = elem
 									elem = ""
 
 									if len(elem) == 0 {
 										// Leaf node.
 										switch method {
 										case "DELETE":
-											r.name = OAuth2ClientTokenDeleteOperation
+											r.name = DatasourceEmailOAuthDeleteOperation
 											r.summary = ""
-											r.operationID = "oauth2-client-token-delete"
-											r.pathPattern = "/oauth2/client/{datasource_uuid}/token/{uuid}"
+											r.operationID = "datasource-email-oauth-delete"
+											r.pathPattern = "/datasource/email_oauth/{uuid}"
 											r.args = args
-											r.count = 2
+											r.count = 1
+											return r, true
+										case "GET":
+											r.name = DatasourceEmailOAuthGetOperation
+											r.summary = ""
+											r.operationID = "datasource-email-oauth-get"
+											r.pathPattern = "/datasource/email_oauth/{uuid}"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "PUT":
+											r.name = DatasourceEmailOAuthUpdateOperation
+											r.summary = ""
+											r.operationID = "datasource-email-oauth-update"
+											r.pathPattern = "/datasource/email_oauth/{uuid}"
+											r.args = args
+											r.count = 1
 											return r, true
 										default:
 											return
@@ -2653,245 +3033,276 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 
 						elem = origElem
-					}
+					case 'l': // Prefix: "linkedin"
+						origElem := elem
+						if l := len("linkedin"); len(elem) >= l && elem[0:l] == "linkedin" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-					elem = origElem
-				case 'l': // Prefix: "login"
-					origElem := elem
-					if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "POST":
-							r.name = OAuth2ClientLoginOperation
-							r.summary = ""
-							r.operationID = "oauth2-client-login"
-							r.pathPattern = "/oauth2/login"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = DatasourceLinkedinListOperation
+								r.summary = ""
+								r.operationID = "datasource-linkedin-list"
+								r.pathPattern = "/datasource/linkedin"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = DatasourceLinkedinCreateOperation
+								r.summary = ""
+								r.operationID = "datasource-linkedin-create"
+								r.pathPattern = "/datasource/linkedin"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
 						}
-					}
-
-					elem = origElem
-				}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+							origElem := elem
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-				elem = origElem
-			case 'p': // Prefix: "p"
-				origElem := elem
-				if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+							// Param: "uuid"
+							// Leaf parameter
+							args[0] = elem
+							elem = ""
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'i': // Prefix: "ipeline"
-					origElem := elem
-					if l := len("ipeline"); len(elem) >= l && elem[0:l] == "ipeline" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = DatasourceLinkedinDeleteOperation
+									r.summary = ""
+									r.operationID = "datasource-linkedin-delete"
+									r.pathPattern = "/datasource/linkedin/{uuid}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = DatasourceLinkedinGetOperation
+									r.summary = ""
+									r.operationID = "datasource-linkedin-get"
+									r.pathPattern = "/datasource/linkedin/{uuid}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = DatasourceLinkedinUpdateOperation
+									r.summary = ""
+									r.operationID = "datasource-linkedin-update"
+									r.pathPattern = "/datasource/linkedin/{uuid}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
 
-					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							r.name = PipelineListOperation
-							r.summary = "List pipelines"
-							r.operationID = "pipeline-list"
-							r.pathPattern = "/pipeline"
-							r.args = args
-							r.count = 0
-							return r, true
-						case "POST":
-							r.name = PipelineCreateOperation
-							r.summary = "Create a new pipeline"
-							r.operationID = "pipeline-create"
-							r.pathPattern = "/pipeline"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
+							elem = origElem
 						}
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/"
+
+						elem = origElem
+					case 't': // Prefix: "telegram"
 						origElem := elem
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("telegram"); len(elem) >= l && elem[0:l] == "telegram" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "uuid"
-						// Leaf parameter
-						args[0] = elem
-						elem = ""
-
 						if len(elem) == 0 {
-							// Leaf node.
 							switch method {
-							case "DELETE":
-								r.name = PipelineDeleteOperation
-								r.summary = "Delete pipeline"
-								r.operationID = "pipeline-delete"
-								r.pathPattern = "/pipeline/{uuid}"
-								r.args = args
-								r.count = 1
-								return r, true
 							case "GET":
-								r.name = PipelineGetOperation
-								r.summary = "Get pipeline by UUID"
-								r.operationID = "pipeline-get"
-								r.pathPattern = "/pipeline/{uuid}"
+								r.name = DatasourceTelegramListOperation
+								r.summary = ""
+								r.operationID = "datasource-telegram-list"
+								r.pathPattern = "/datasource/telegram"
 								r.args = args
-								r.count = 1
+								r.count = 0
 								return r, true
-							case "PUT":
-								r.name = PipelineUpdateOperation
-								r.summary = "Update pipeline"
-								r.operationID = "pipeline-update"
-								r.pathPattern = "/pipeline/{uuid}"
+							case "POST":
+								r.name = DatasourceTelegramCreateOperation
+								r.summary = ""
+								r.operationID = "datasource-telegram-create"
+								r.pathPattern = "/datasource/telegram"
 								r.args = args
-								r.count = 1
+								r.count = 0
 								return r, true
 							default:
 								return
 							}
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+							origElem := elem
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-						elem = origElem
-					}
+							// Param: "uuid"
+							// Leaf parameter
+							args[0] = elem
+							elem = ""
 
-					elem = origElem
-				case 'r': // Prefix: "rofile"
-					origElem := elem
-					if l := len("rofile"); len(elem) >= l && elem[0:l] == "rofile" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = DatasourceTelegramDeleteOperation
+									r.summary = ""
+									r.operationID = "datasource-telegram-delete"
+									r.pathPattern = "/datasource/telegram/{uuid}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = DatasourceTelegramGetOperation
+									r.summary = ""
+									r.operationID = "datasource-telegram-get"
+									r.pathPattern = "/datasource/telegram/{uuid}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = DatasourceTelegramUpdateOperation
+									r.summary = ""
+									r.operationID = "datasource-telegram-update"
+									r.pathPattern = "/datasource/telegram/{uuid}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "GET":
-							r.name = GetProfileOperation
-							r.summary = "Get current user profile"
-							r.operationID = "getProfile"
-							r.pathPattern = "/profile"
-							r.args = args
-							r.count = 0
-							return r, true
-						case "PUT":
-							r.name = UpdateProfileOperation
-							r.summary = "Update current user profile"
-							r.operationID = "updateProfile"
-							r.pathPattern = "/profile"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
+							elem = origElem
 						}
-					}
-
-					elem = origElem
-				}
 
-				elem = origElem
-			case 's': // Prefix: "s"
-				origElem := elem
-				if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+						elem = origElem
+					case 'w': // Prefix: "whatsapp"
+						origElem := elem
+						if l := len("whatsapp"); len(elem) >= l && elem[0:l] == "whatsapp" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'c': // Prefix: "cheduler"
-					origElem := elem
-					if l := len("cheduler"); len(elem) >= l && elem[0:l] == "cheduler" {
-						elem = elem[l:]
-					} else {
-						break
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = DatasourceWhatsappListOperation
+								r.summary = ""
+								r.operationID = "datasource-whatsapp-list"
+								r.pathPattern = "/datasource/whatsapp"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = DatasourceWhatsappCreateOperation
+								r.summary = ""
+								r.operationID = "datasource-whatsapp-create"
+								r.pathPattern = "/datasource/whatsapp"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+							origElem := elem
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "uuid"
+							// Leaf parameter
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = DatasourceWhatsappDeleteOperation
+									r.summary = ""
+									r.operationID = "datasource-whatsapp-delete"
+									r.pathPattern = "/datasource/whatsapp/{uuid}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = DatasourceWhatsappGetOperation
+									r.summary = ""
+									r.operationID = "datasource-whatsapp-get"
+									r.pathPattern = "/datasource/whatsapp/{uuid}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = DatasourceWhatsappUpdateOperation
+									r.summary = ""
+									r.operationID = "datasource-whatsapp-update"
+									r.pathPattern = "/datasource/whatsapp/{uuid}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
+					// Param: "uuid"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
 					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
 
 					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							r.name = SchedulerListOperation
-							r.summary = "List schedulers"
-							r.operationID = "scheduler-list"
-							r.pathPattern = "/scheduler"
-							r.args = args
-							r.count = 0
-							return r, true
-						case "POST":
-							r.name = SchedulerCreateOperation
-							r.summary = "Create scheduler"
-							r.operationID = "scheduler-create"
-							r.pathPattern = "/scheduler"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
+						break
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/"
+					case '/': // Prefix: "/oauth2/client"
 						origElem := elem
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("/oauth2/client"); len(elem) >= l && elem[0:l] == "/oauth2/client" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "uuid"
-						// Leaf parameter
-						args[0] = elem
-						elem = ""
-
 						if len(elem) == 0 {
 							// Leaf node.
 							switch method {
-							case "DELETE":
-								r.name = SchedulerDeleteOperation
-								r.summary = "Delete scheduler"
-								r.operationID = "scheduler-delete"
-								r.pathPattern = "/scheduler/{uuid}"
-								r.args = args
-								r.count = 1
-								return r, true
-							case "GET":
-								r.name = SchedulerGetOperation
-								r.summary = "Get scheduler by UUID"
-								r.operationID = "scheduler-get"
-								r.pathPattern = "/scheduler/{uuid}"
-								r.args = args
-								r.count = 1
-								return r, true
 							case "PUT":
-								r.name = SchedulerUpdateOperation
-								r.summary = "Update scheduler"
-								r.operationID = "scheduler-update"
-								r.pathPattern = "/scheduler/{uuid}"
+								r.name = DatasourceSetOAuth2ClientOperation
+								r.summary = ""
+								r.operationID = "datasource-set-oauth2-client"
+								r.pathPattern = "/datasource/{uuid}/oauth2/client"
 								r.args = args
 								r.count = 1
 								return r, true
@@ -2904,9 +3315,24 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					}
 
 					elem = origElem
-				case 'e': // Prefix: "ession"
+				}
+
+				elem = origElem
+			case 'e': // Prefix: "e"
+				origElem := elem
+				if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'r': // Prefix: "rasure"
 					origElem := elem
-					if l := len("ession"); len(elem) >= l && elem[0:l] == "ession" {
+					if l := len("rasure"); len(elem) >= l && elem[0:l] == "rasure" {
 						elem = elem[l:]
 					} else {
 						break
@@ -2916,10 +3342,18 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						// Leaf node.
 						switch method {
 						case "GET":
-							r.name = SessionStatusOperation
+							r.name = ErasureListOperation
 							r.summary = ""
-							r.operationID = "session-status"
-							r.pathPattern = "/session"
+							r.operationID = "erasure-list"
+							r.pathPattern = "/erasure"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = ErasureCreateOperation
+							r.summary = ""
+							r.operationID = "erasure-create"
+							r.pathPattern = "/erasure"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -2929,21 +3363,22 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					}
 
 					elem = origElem
-				case 't': // Prefix: "torage"
+				case 'v': // Prefix: "vent"
 					origElem := elem
-					if l := len("torage"); len(elem) >= l && elem[0:l] == "torage" {
+					if l := len("vent"); len(elem) >= l && elem[0:l] == "vent" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
+						// Leaf node.
 						switch method {
 						case "GET":
-							r.name = StorageListOperation
+							r.name = EventListOperation
 							r.summary = ""
-							r.operationID = "storage-list"
-							r.pathPattern = "/storage"
+							r.operationID = "event-list"
+							r.pathPattern = "/event"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -2951,70 +3386,1097 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							return
 						}
 					}
-					switch elem[0] {
-					case '/': // Prefix: "/"
-						origElem := elem
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'f': // Prefix: "file-link"
-							origElem := elem
-							if l := len("file-link"); len(elem) >= l && elem[0:l] == "file-link" {
-								elem = elem[l:]
-							} else {
-								break
-							}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "POST":
-									r.name = GenerateDownloadLinkOperation
-									r.summary = "Generate a download link for a stored file"
-									r.operationID = "generateDownloadLink"
-									r.pathPattern = "/storage/file-link"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
+					elem = origElem
+				}
 
-							elem = origElem
-						case 'h': // Prefix: "hostfiles"
-							origElem := elem
-							if l := len("hostfiles"); len(elem) >= l && elem[0:l] == "hostfiles" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+				elem = origElem
+			case 'f': // Prefix: "file"
+				origElem := elem
+				if l := len("file"); len(elem) >= l && elem[0:l] == "file" {
+					elem = elem[l:]
+				} else {
+					break
+				}
 
-							if len(elem) == 0 {
-								switch method {
-								case "POST":
-									r.name = StorageHostfilesCreateOperation
-									r.summary = ""
-									r.operationID = "storage-hostfiles-create"
-									r.pathPattern = "/storage/hostfiles"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
-							switch elem[0] {
-							case '/': // Prefix: "/"
-								origElem := elem
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = FileListOperation
+						r.summary = ""
+						r.operationID = "file-list"
+						r.pathPattern = "/file"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "POST":
+						r.name = FileCreateOperation
+						r.summary = ""
+						r.operationID = "file-create"
+						r.pathPattern = "/file"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					origElem := elem
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "uuid"
+					// Leaf parameter
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "DELETE":
+							r.name = FileDeleteOperation
+							r.summary = ""
+							r.operationID = "file-delete"
+							r.pathPattern = "/file/{uuid}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "GET":
+							r.name = FileGetOperation
+							r.summary = ""
+							r.operationID = "file-get"
+							r.pathPattern = "/file/{uuid}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "PUT":
+							r.name = FileUpdateOperation
+							r.summary = ""
+							r.operationID = "file-update"
+							r.pathPattern = "/file/{uuid}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'm': // Prefix: "message/"
+				origElem := elem
+				if l := len("message/"); len(elem) >= l && elem[0:l] == "message/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'e': // Prefix: "email/query"
+					origElem := elem
+					if l := len("email/query"); len(elem) >= l && elem[0:l] == "email/query" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = MessageEmailQueryOperation
+							r.summary = ""
+							r.operationID = "messageEmailQuery"
+							r.pathPattern = "/message/email/query"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				case 'l': // Prefix: "linkedin/query"
+					origElem := elem
+					if l := len("linkedin/query"); len(elem) >= l && elem[0:l] == "linkedin/query" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = MessageLinkedinQueryOperation
+							r.summary = ""
+							r.operationID = "messageLinkedinQuery"
+							r.pathPattern = "/message/linkedin/query"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				case 'q': // Prefix: "query"
+					origElem := elem
+					if l := len("query"); len(elem) >= l && elem[0:l] == "query" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = MessageQueryOperation
+							r.summary = ""
+							r.operationID = "messageQuery"
+							r.pathPattern = "/message/query"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				case 't': // Prefix: "telegram/query"
+					origElem := elem
+					if l := len("telegram/query"); len(elem) >= l && elem[0:l] == "telegram/query" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = MessageTelegramQueryOperation
+							r.summary = ""
+							r.operationID = "messageTelegramQuery"
+							r.pathPattern = "/message/telegram/query"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				case 'w': // Prefix: "whatsapp/query"
+					origElem := elem
+					if l := len("whatsapp/query"); len(elem) >= l && elem[0:l] == "whatsapp/query" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = MessageWhatsappQueryOperation
+							r.summary = ""
+							r.operationID = "messageWhatsappQuery"
+							r.pathPattern = "/message/whatsapp/query"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'o': // Prefix: "oauth2/"
+				origElem := elem
+				if l := len("oauth2/"); len(elem) >= l && elem[0:l] == "oauth2/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'c': // Prefix: "c"
+					origElem := elem
+					if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "allback"
+						origElem := elem
+						if l := len("allback"); len(elem) >= l && elem[0:l] == "allback" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = OAuth2ClientCallbackOperation
+								r.summary = ""
+								r.operationID = "oauth2-client-callback"
+								r.pathPattern = "/oauth2/callback"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					case 'l': // Prefix: "lient"
+						origElem := elem
+						if l := len("lient"); len(elem) >= l && elem[0:l] == "lient" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = OAuth2ClientListOperation
+								r.summary = ""
+								r.operationID = "oauth2-client-list"
+								r.pathPattern = "/oauth2/client"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = OAuth2ClientCreateOperation
+								r.summary = ""
+								r.operationID = "oauth2-client-create"
+								r.pathPattern = "/oauth2/client"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+							origElem := elem
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "uuid"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									r.name = OAuth2ClientDeleteOperation
+									r.summary = ""
+									r.operationID = "oauth2-client-delete"
+									r.pathPattern = "/oauth2/client/{uuid}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = OAuth2ClientGetOperation
+									r.summary = ""
+									r.operationID = "oauth2-client-get"
+									r.pathPattern = "/oauth2/client/{uuid}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = OAuth2ClientUpdateOperation
+									r.summary = ""
+									r.operationID = "oauth2-client-update"
+									r.pathPattern = "/oauth2/client/{uuid}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/token"
+								origElem := elem
+								if l := len("/token"); len(elem) >= l && elem[0:l] == "/token" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = OAuth2ClientTokenListOperation
+										r.summary = ""
+										r.operationID = "oauth2-client-token-list"
+										r.pathPattern = "/oauth2/client/{datasource_uuid}/token"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+									origElem := elem
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "uuid"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[1] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										switch method {
+										case "DELETE":
+											r.name = OAuth2ClientTokenDeleteOperation
+											r.summary = ""
+											r.operationID = "oauth2-client-token-delete"
+											r.pathPattern = "/oauth2/client/{datasource_uuid}/token/{uuid}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/refresh"
+										origElem := elem
+										if l := len("/refresh"); len(elem) >= l && elem[0:l] == "/refresh" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = OAuth2ClientTokenRefreshOperation
+												r.summary = ""
+												r.operationID = "oauth2-client-token-refresh"
+												r.pathPattern = "/oauth2/client/{datasource_uuid}/token/{uuid}/refresh"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
+
+										elem = origElem
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				case 'l': // Prefix: "login"
+					origElem := elem
+					if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = OAuth2ClientLoginOperation
+							r.summary = ""
+							r.operationID = "oauth2-client-login"
+							r.pathPattern = "/oauth2/login"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				case 'p': // Prefix: "provider"
+					origElem := elem
+					if l := len("provider"); len(elem) >= l && elem[0:l] == "provider" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = OAuth2ProviderListOperation
+							r.summary = ""
+							r.operationID = "oauth2-provider-list"
+							r.pathPattern = "/oauth2/provider"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'p': // Prefix: "p"
+				origElem := elem
+				if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'i': // Prefix: "ipeline"
+					origElem := elem
+					if l := len("ipeline"); len(elem) >= l && elem[0:l] == "ipeline" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = PipelineListOperation
+							r.summary = "List pipelines"
+							r.operationID = "pipeline-list"
+							r.pathPattern = "/pipeline"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = PipelineCreateOperation
+							r.summary = "Create a new pipeline"
+							r.operationID = "pipeline-create"
+							r.pathPattern = "/pipeline"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "uuid"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								r.name = PipelineDeleteOperation
+								r.summary = "Delete pipeline"
+								r.operationID = "pipeline-delete"
+								r.pathPattern = "/pipeline/{uuid}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = PipelineGetOperation
+								r.summary = "Get pipeline by UUID"
+								r.operationID = "pipeline-get"
+								r.pathPattern = "/pipeline/{uuid}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = PipelineUpdateOperation
+								r.summary = "Update pipeline"
+								r.operationID = "pipeline-update"
+								r.pathPattern = "/pipeline/{uuid}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/run"
+							origElem := elem
+							if l := len("/run"); len(elem) >= l && elem[0:l] == "/run" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = PipelineRunOperation
+									r.summary = "Run a pipeline now"
+									r.operationID = "pipeline-run"
+									r.pathPattern = "/pipeline/{uuid}/run"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				case 'o': // Prefix: "olicy"
+					origElem := elem
+					if l := len("olicy"); len(elem) >= l && elem[0:l] == "olicy" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = PolicyListOperation
+							r.summary = ""
+							r.operationID = "policy-list"
+							r.pathPattern = "/policy"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = PolicyCreateOperation
+							r.summary = ""
+							r.operationID = "policy-create"
+							r.pathPattern = "/policy"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = PolicyDeleteOperation
+								r.summary = ""
+								r.operationID = "policy-delete"
+								r.pathPattern = "/policy/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = PolicyGetOperation
+								r.summary = ""
+								r.operationID = "policy-get"
+								r.pathPattern = "/policy/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = PolicyUpdateOperation
+								r.summary = ""
+								r.operationID = "policy-update"
+								r.pathPattern = "/policy/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				case 'r': // Prefix: "rofile"
+					origElem := elem
+					if l := len("rofile"); len(elem) >= l && elem[0:l] == "rofile" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = GetProfileOperation
+							r.summary = "Get current user profile"
+							r.operationID = "getProfile"
+							r.pathPattern = "/profile"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "PUT":
+							r.name = UpdateProfileOperation
+							r.summary = "Update current user profile"
+							r.operationID = "updateProfile"
+							r.pathPattern = "/profile"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'r': // Prefix: "retention"
+				origElem := elem
+				if l := len("retention"); len(elem) >= l && elem[0:l] == "retention" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = RetentionPolicyListOperation
+						r.summary = ""
+						r.operationID = "retention-policy-list"
+						r.pathPattern = "/retention"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "POST":
+						r.name = RetentionPolicyCreateOperation
+						r.summary = ""
+						r.operationID = "retention-policy-create"
+						r.pathPattern = "/retention"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					origElem := elem
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "uuid"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch method {
+						case "DELETE":
+							r.name = RetentionPolicyDeleteOperation
+							r.summary = ""
+							r.operationID = "retention-policy-delete"
+							r.pathPattern = "/retention/{uuid}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "GET":
+							r.name = RetentionPolicyGetOperation
+							r.summary = ""
+							r.operationID = "retention-policy-get"
+							r.pathPattern = "/retention/{uuid}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "PUT":
+							r.name = RetentionPolicyUpdateOperation
+							r.summary = ""
+							r.operationID = "retention-policy-update"
+							r.pathPattern = "/retention/{uuid}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/run"
+						origElem := elem
+						if l := len("/run"); len(elem) >= l && elem[0:l] == "/run" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = RetentionPolicyRunOperation
+								r.summary = ""
+								r.operationID = "retention-policy-run"
+								r.pathPattern = "/retention/{uuid}/run"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 's': // Prefix: "s"
+				origElem := elem
+				if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'c': // Prefix: "cheduler"
+					origElem := elem
+					if l := len("cheduler"); len(elem) >= l && elem[0:l] == "cheduler" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = SchedulerListOperation
+							r.summary = "List schedulers"
+							r.operationID = "scheduler-list"
+							r.pathPattern = "/scheduler"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = SchedulerCreateOperation
+							r.summary = "Create scheduler"
+							r.operationID = "scheduler-create"
+							r.pathPattern = "/scheduler"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "uuid"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = SchedulerDeleteOperation
+								r.summary = "Delete scheduler"
+								r.operationID = "scheduler-delete"
+								r.pathPattern = "/scheduler/{uuid}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = SchedulerGetOperation
+								r.summary = "Get scheduler by UUID"
+								r.operationID = "scheduler-get"
+								r.pathPattern = "/scheduler/{uuid}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = SchedulerUpdateOperation
+								r.summary = "Update scheduler"
+								r.operationID = "scheduler-update"
+								r.pathPattern = "/scheduler/{uuid}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				case 'e': // Prefix: "ession"
+					origElem := elem
+					if l := len("ession"); len(elem) >= l && elem[0:l] == "ession" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "DELETE":
+							r.name = SessionRevokeAllOperation
+							r.summary = ""
+							r.operationID = "session-revoke-all"
+							r.pathPattern = "/session"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "GET":
+							r.name = SessionStatusOperation
+							r.summary = ""
+							r.operationID = "session-status"
+							r.pathPattern = "/session"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "uuid"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = SessionRevokeOperation
+								r.summary = ""
+								r.operationID = "session-revoke"
+								r.pathPattern = "/session/{uuid}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				case 't': // Prefix: "torage"
+					origElem := elem
+					if l := len("torage"); len(elem) >= l && elem[0:l] == "torage" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = StorageListOperation
+							r.summary = ""
+							r.operationID = "storage-list"
+							r.pathPattern = "/storage"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'f': // Prefix: "file-link"
+							origElem := elem
+							if l := len("file-link"); len(elem) >= l && elem[0:l] == "file-link" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = GenerateDownloadLinkOperation
+									r.summary = "Generate a download link for a stored file"
+									r.operationID = "generateDownloadLink"
+									r.pathPattern = "/storage/file-link"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'h': // Prefix: "hostfiles"
+							origElem := elem
+							if l := len("hostfiles"); len(elem) >= l && elem[0:l] == "hostfiles" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									r.name = StorageHostfilesCreateOperation
+									r.summary = ""
+									r.operationID = "storage-hostfiles-create"
+									r.pathPattern = "/storage/hostfiles"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+								origElem := elem
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 									elem = elem[l:]
 								} else {
 									break
@@ -3257,6 +4719,53 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 							elem = origElem
 						}
+						// Param: "uuid"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/migrate"
+							origElem := elem
+							if l := len("/migrate"); len(elem) >= l && elem[0:l] == "/migrate" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = StorageMigrationListOperation
+									r.summary = ""
+									r.operationID = "storage-migration-list"
+									r.pathPattern = "/storage/{uuid}/migrate"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "POST":
+									r.name = StorageMigrateOperation
+									r.summary = ""
+									r.operationID = "storage-migrate"
+									r.pathPattern = "/storage/{uuid}/migrate"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						}
 
 						elem = origElem
 					}
@@ -3489,92 +4998,391 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				}
 
 				elem = origElem
-			case 'w': // Prefix: "workerjobs"
+			case 'w': // Prefix: "w"
 				origElem := elem
-				if l := len("workerjobs"); len(elem) >= l && elem[0:l] == "workerjobs" {
+				if l := len("w"); len(elem) >= l && elem[0:l] == "w" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
-				if len(elem) == 0 {
-					switch method {
-					case "GET":
-						r.name = WorkerJobsListOperation
-						r.summary = ""
-						r.operationID = "worker-jobs-list"
-						r.pathPattern = "/workerjobs"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'e': // Prefix: "ebhook"
+					origElem := elem
+					if l := len("ebhook"); len(elem) >= l && elem[0:l] == "ebhook" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = WebhookListOperation
+							r.summary = ""
+							r.operationID = "webhook-list"
+							r.pathPattern = "/webhook"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = WebhookCreateOperation
+							r.summary = ""
+							r.operationID = "webhook-create"
+							r.pathPattern = "/webhook"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "uuid"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = WebhookDeleteOperation
+								r.summary = ""
+								r.operationID = "webhook-delete"
+								r.pathPattern = "/webhook/{uuid}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = WebhookGetOperation
+								r.summary = ""
+								r.operationID = "webhook-get"
+								r.pathPattern = "/webhook/{uuid}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = WebhookUpdateOperation
+								r.summary = ""
+								r.operationID = "webhook-update"
+								r.pathPattern = "/webhook/{uuid}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
 					}
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
+
+					elem = origElem
+				case 'o': // Prefix: "ork"
 					origElem := elem
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					if l := len("ork"); len(elem) >= l && elem[0:l] == "ork" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					// Param: "uuid"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
-
 					if len(elem) == 0 {
-						switch method {
-						case "DELETE":
-							r.name = WorkerJobsDeleteOperation
-							r.summary = ""
-							r.operationID = "worker-jobs-delete"
-							r.pathPattern = "/workerjobs/{uuid}"
-							r.args = args
-							r.count = 1
-							return r, true
-						case "GET":
-							r.name = WorkerJobsGetOperation
-							r.summary = ""
-							r.operationID = "worker-jobs-get"
-							r.pathPattern = "/workerjobs/{uuid}"
-							r.args = args
-							r.count = 1
-							return r, true
-						default:
-							return
-						}
+						break
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/cancel"
+					case 'e': // Prefix: "erjobs"
 						origElem := elem
-						if l := len("/cancel"); len(elem) >= l && elem[0:l] == "/cancel" {
+						if l := len("erjobs"); len(elem) >= l && elem[0:l] == "erjobs" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = WorkerJobsListOperation
+								r.summary = ""
+								r.operationID = "worker-jobs-list"
+								r.pathPattern = "/workerjobs"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+							origElem := elem
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "uuid"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									r.name = WorkerJobsDeleteOperation
+									r.summary = ""
+									r.operationID = "worker-jobs-delete"
+									r.pathPattern = "/workerjobs/{uuid}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = WorkerJobsGetOperation
+									r.summary = ""
+									r.operationID = "worker-jobs-get"
+									r.pathPattern = "/workerjobs/{uuid}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+								origElem := elem
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'c': // Prefix: "cancel"
+									origElem := elem
+									if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = WorkerJobsCancelOperation
+											r.summary = "Cancel a running worker job"
+											r.operationID = "worker-jobs-cancel"
+											r.pathPattern = "/workerjobs/{uuid}/cancel"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								case 'r': // Prefix: "retry"
+									origElem := elem
+									if l := len("retry"); len(elem) >= l && elem[0:l] == "retry" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = WorkerJobsRetryOperation
+											r.summary = "Retry a worker job"
+											r.operationID = "worker-jobs-retry"
+											r.pathPattern = "/workerjobs/{uuid}/retry"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					case 's': // Prefix: "space"
+						origElem := elem
+						if l := len("space"); len(elem) >= l && elem[0:l] == "space" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
 							switch method {
+							case "GET":
+								r.name = WorkspaceListOperation
+								r.summary = ""
+								r.operationID = "workspace-list"
+								r.pathPattern = "/workspace"
+								r.args = args
+								r.count = 0
+								return r, true
 							case "POST":
-								r.name = WorkerJobsCancelOperation
-								r.summary = "Cancel a running worker job"
-								r.operationID = "worker-jobs-cancel"
-								r.pathPattern = "/workerjobs/{uuid}/cancel"
+								r.name = WorkspaceCreateOperation
+								r.summary = ""
+								r.operationID = "workspace-create"
+								r.pathPattern = "/workspace"
 								r.args = args
-								r.count = 1
+								r.count = 0
 								return r, true
 							default:
 								return
 							}
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+							origElem := elem
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "uuid"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									r.name = WorkspaceDeleteOperation
+									r.summary = ""
+									r.operationID = "workspace-delete"
+									r.pathPattern = "/workspace/{uuid}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = WorkspaceGetOperation
+									r.summary = ""
+									r.operationID = "workspace-get"
+									r.pathPattern = "/workspace/{uuid}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = WorkspaceUpdateOperation
+									r.summary = ""
+									r.operationID = "workspace-update"
+									r.pathPattern = "/workspace/{uuid}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/member"
+								origElem := elem
+								if l := len("/member"); len(elem) >= l && elem[0:l] == "/member" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = WorkspaceMemberListOperation
+										r.summary = ""
+										r.operationID = "workspace-member-list"
+										r.pathPattern = "/workspace/{uuid}/member"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = WorkspaceMemberSetOperation
+										r.summary = ""
+										r.operationID = "workspace-member-set"
+										r.pathPattern = "/workspace/{uuid}/member"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+									origElem := elem
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "user_uuid"
+									// Leaf parameter
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = WorkspaceMemberDeleteOperation
+											r.summary = ""
+											r.operationID = "workspace-member-delete"
+											r.pathPattern = "/workspace/{uuid}/member/{user_uuid}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						}
 
 						elem = origElem
 					}
@@ -4,6 +4,7 @@ package api
 
 import (
 	"fmt"
+	"io"
 	"net/url"
 	"time"
 
@@ -16,577 +17,1223 @@ func (s *ErrorStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
-type BearerAuth struct {
-	Token string
+// An API key, sent as Authorization: Bearer KEY. A personal key acts as its user with the
+// user's role in the workspace, a service account key with its own role. Keys only work in
+// the workspace they were created in, and only for the operations their scopes allow.
+// Ref: #
+type APIKey struct {
+	// Unique identifier of the key.
+	UUID OptString `json:"uuid"`
+	// What the key is used for, the name of the service account for service account keys.
+	Name string `json:"name"`
+	// The key itself, only returned when the key is created.
+	Key OptString `json:"key"`
+	// Start of the key, identifies it in listings.
+	Prefix OptString `json:"prefix"`
+	// Owner of a personal key, empty for service account keys.
+	UserUUID OptString `json:"user_uuid"`
+	// Create a key for a service account instead of the caller. Requires the owner or admin role.
+	ServiceAccount OptBool `json:"service_account"`
+	// Workspace role of a service account key, api_client by default. It may not exceed the role of the
+	// caller.
+	Role OptAPIKeyRole `json:"role"`
+	// Operations the key may perform, as RESOURCE:LEVEL where LEVEL is read, write or *,
+	// e.g. messages:read, pipelines:write or *:read. Write includes read.
+	Scopes []string `json:"scopes"`
+	// IP addresses or CIDRs the key may be used from, any address when empty.
+	AllowedIps []string `json:"allowed_ips"`
+	// When the key stops working, never when empty.
+	ExpiresAt  OptDateTime `json:"expires_at"`
+	LastUsedAt OptDateTime `json:"last_used_at"`
+	LastUsedIP OptString   `json:"last_used_ip"`
+	RevokedAt  OptDateTime `json:"revoked_at"`
+	CreatedAt  OptDateTime `json:"created_at"`
 }
 
-// GetToken returns the value of Token.
-func (s *BearerAuth) GetToken() string {
-	return s.Token
+// GetUUID returns the value of UUID.
+func (s *APIKey) GetUUID() OptString {
+	return s.UUID
 }
 
-// SetToken sets the value of Token.
-func (s *BearerAuth) SetToken(val string) {
-	s.Token = val
+// GetName returns the value of Name.
+func (s *APIKey) GetName() string {
+	return s.Name
 }
 
-// Ref: #
-type Contact struct {
-	UUID                    OptString             `json:"uuid"`
-	UserUUID                OptString             `json:"user_uuid"`
-	InstanceUUID            OptString             `json:"instance_uuid"`
-	Status                  OptString             `json:"status"`
-	Names                   *ContactNames         `json:"names"`
-	NamesSearch             OptString             `json:"names_search"`
-	Last                    OptString             `json:"last"`
-	First                   OptString             `json:"first"`
-	Middle                  OptString             `json:"middle"`
-	Birthday                OptDateTime           `json:"birthday"`
-	BirthdayType            OptString             `json:"birthday_type"`
-	Salary                  OptString             `json:"salary"`
-	SalaryData              *ContactSalaryData    `json:"salary_data"`
-	LastPositions           *ContactLastPositions `json:"last_positions"`
-	LastPositionID          OptInt                `json:"last_position_id"`
-	LastPositionCompanyID   OptInt                `json:"last_position_company_id"`
-	LastPositionCompanyName OptString             `json:"last_position_company_name"`
-	LastPositionTitle       OptString             `json:"last_position_title"`
-	LastPositionStartDate   OptDateTime           `json:"last_position_start_date"`
-	LastPositionEndDate     OptDateTime           `json:"last_position_end_date"`
-	LastPositionEndNow      OptBool               `json:"last_position_end_now"`
-	LastPositionDescription OptString             `json:"last_position_description"`
-	NoteSearch              OptString             `json:"note_search"`
-	NoteKpiID               *ContactNoteKpiID     `json:"note_kpi_id"`
-	Phones                  *ContactPhones        `json:"phones"`
-	PhoneSearch             OptString             `json:"phone_search"`
-	Phone1                  OptString             `json:"phone1"`
-	Phone1Type              OptString             `json:"phone1_type"`
-	Phone1Country           OptString             `json:"phone1_country"`
-	Phone2                  OptString             `json:"phone2"`
-	Phone2Type              OptString             `json:"phone2_type"`
-	Phone2Country           OptString             `json:"phone2_country"`
-	Phone3                  OptString             `json:"phone3"`
-	Phone3Type              OptString             `json:"phone3_type"`
-	Phone3Country           OptString             `json:"phone3_country"`
-	Phone4                  OptString             `json:"phone4"`
-	Phone4Type              OptString             `json:"phone4_type"`
-	Phone4Country           OptString             `json:"phone4_country"`
-	Phone5                  OptString             `json:"phone5"`
-	Phone5Type              OptString             `json:"phone5_type"`
-	Phone5Country           OptString             `json:"phone5_country"`
-	Emails                  *ContactEmails        `json:"emails"`
-	EmailSearch             OptString             `json:"email_search"`
-	Email1                  OptString             `json:"email1"`
-	Email1Type              OptString             `json:"email1_type"`
-	Email2                  OptString             `json:"email2"`
-	Email2Type              OptString             `json:"email2_type"`
-	Email3                  OptString             `json:"email3"`
-	Email3Type              OptString             `json:"email3_type"`
-	Email4                  OptString             `json:"email4"`
-	Email4Type              OptString             `json:"email4_type"`
-	Email5                  OptString             `json:"email5"`
-	Email5Type              OptString             `json:"email5_type"`
-	Messengers              *ContactMessengers    `json:"messengers"`
-	MessengersSearch        OptString             `json:"messengers_search"`
-	SkypeUUID               OptString             `json:"skype_uuid"`
-	Skype                   OptString             `json:"skype"`
-	WhatsappUUID            OptString             `json:"whatsapp_uuid"`
-	Whatsapp                OptString             `json:"whatsapp"`
-	TelegramUUID            OptString             `json:"telegram_uuid"`
-	Telegram                OptString             `json:"telegram"`
-	WechatUUID              OptString             `json:"wechat_uuid"`
-	Wechat                  OptString             `json:"wechat"`
-	LineUUID                OptString             `json:"line_uuid"`
-	Line                    OptString             `json:"line"`
-	Socials                 *ContactSocials       `json:"socials"`
-	SocialsSearch           OptString             `json:"socials_search"`
-	LinkedinUUID            OptString             `json:"linkedin_uuid"`
-	LinkedinURL             OptString             `json:"linkedin_url"`
-	FacebookUUID            OptString             `json:"facebook_uuid"`
-	FacebookURL             OptString             `json:"facebook_url"`
-	TwitterUUID             OptString             `json:"twitter_uuid"`
-	TwitterURL              OptString             `json:"twitter_url"`
-	GithubUUID              OptString             `json:"github_uuid"`
-	GithubURL               OptString             `json:"github_url"`
-	VkUUID                  OptString             `json:"vk_uuid"`
-	VkURL                   OptString             `json:"vk_url"`
-	OdnoUUID                OptString             `json:"odno_uuid"`
-	OdnoURL                 OptString             `json:"odno_url"`
-	HhruUUID                OptString             `json:"hhru_uuid"`
-	HhruURL                 OptString             `json:"hhru_url"`
-	HabrUUID                OptString             `json:"habr_uuid"`
-	HabrURL                 OptString             `json:"habr_url"`
-	MoikrugUUID             OptString             `json:"moikrug_uuid"`
-	MoikrugURL              OptString             `json:"moikrug_url"`
-	InstagramUUID           OptString             `json:"instagram_uuid"`
-	InstagramURL            OptString             `json:"instagram_url"`
-	Social1UUID             OptString             `json:"social1_uuid"`
-	Social1URL              OptString             `json:"social1_url"`
-	Social1Type             OptString             `json:"social1_type"`
-	Social2UUID             OptString             `json:"social2_uuid"`
-	Social2URL              OptString             `json:"social2_url"`
-	Social2Type             OptString             `json:"social2_type"`
-	Social3UUID             OptString             `json:"social3_uuid"`
-	Social3URL              OptString             `json:"social3_url"`
-	Social3Type             OptString             `json:"social3_type"`
-	Social4UUID             OptString             `json:"social4_uuid"`
-	Social4URL              OptString             `json:"social4_url"`
-	Social4Type             OptString             `json:"social4_type"`
-	Social5UUID             OptString             `json:"social5_uuid"`
-	Social5URL              OptString             `json:"social5_url"`
-	Social5Type             OptString             `json:"social5_type"`
-	Social6UUID             OptString             `json:"social6_uuid"`
-	Social6URL              OptString             `json:"social6_url"`
-	Social6Type             OptString             `json:"social6_type"`
-	Social7UUID             OptString             `json:"social7_uuid"`
-	Social7URL              OptString             `json:"social7_url"`
-	Social7Type             OptString             `json:"social7_type"`
-	Social8UUID             OptString             `json:"social8_uuid"`
-	Social8URL              OptString             `json:"social8_url"`
-	Social8Type             OptString             `json:"social8_type"`
-	Social9UUID             OptString             `json:"social9_uuid"`
-	Social9URL              OptString             `json:"social9_url"`
-	Social9Type             OptString             `json:"social9_type"`
-	TrackingSource          OptString             `json:"tracking_source"`
-	TrackingSlug            OptString             `json:"tracking_slug"`
-	CachedImg               OptString             `json:"cached_img"`
-	CachedImgData           *ContactCachedImgData `json:"cached_img_data"`
-	Crawl                   *ContactCrawl         `json:"crawl"`
-	DuplicateUserID         OptString             `json:"duplicate_user_id"`
-	DuplicateAlternativeID  OptString             `json:"duplicate_alternative_id"`
-	DuplicateReportDate     OptDateTime           `json:"duplicate_report_date"`
-	EntryDate               OptDateTime           `json:"entry_date"`
-	EditDate                OptDateTime           `json:"edit_date"`
-	LastKpiEntryDate        OptDateTime           `json:"last_kpi_entry_date"`
+// GetKey returns the value of Key.
+func (s *APIKey) GetKey() OptString {
+	return s.Key
 }
 
-// GetUUID returns the value of UUID.
-func (s *Contact) GetUUID() OptString {
-	return s.UUID
+// GetPrefix returns the value of Prefix.
+func (s *APIKey) GetPrefix() OptString {
+	return s.Prefix
 }
 
 // GetUserUUID returns the value of UserUUID.
-func (s *Contact) GetUserUUID() OptString {
+func (s *APIKey) GetUserUUID() OptString {
 	return s.UserUUID
 }
 
-// GetInstanceUUID returns the value of InstanceUUID.
-func (s *Contact) GetInstanceUUID() OptString {
-	return s.InstanceUUID
+// GetServiceAccount returns the value of ServiceAccount.
+func (s *APIKey) GetServiceAccount() OptBool {
+	return s.ServiceAccount
 }
 
-// GetStatus returns the value of Status.
-func (s *Contact) GetStatus() OptString {
-	return s.Status
+// GetRole returns the value of Role.
+func (s *APIKey) GetRole() OptAPIKeyRole {
+	return s.Role
 }
 
-// GetNames returns the value of Names.
-func (s *Contact) GetNames() *ContactNames {
-	return s.Names
+// GetScopes returns the value of Scopes.
+func (s *APIKey) GetScopes() []string {
+	return s.Scopes
 }
 
-// GetNamesSearch returns the value of NamesSearch.
-func (s *Contact) GetNamesSearch() OptString {
-	return s.NamesSearch
+// GetAllowedIps returns the value of AllowedIps.
+func (s *APIKey) GetAllowedIps() []string {
+	return s.AllowedIps
 }
 
-// GetLast returns the value of Last.
-func (s *Contact) GetLast() OptString {
-	return s.Last
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *APIKey) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
 }
 
-// GetFirst returns the value of First.
-func (s *Contact) GetFirst() OptString {
-	return s.First
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *APIKey) GetLastUsedAt() OptDateTime {
+	return s.LastUsedAt
 }
 
-// GetMiddle returns the value of Middle.
-func (s *Contact) GetMiddle() OptString {
-	return s.Middle
+// GetLastUsedIP returns the value of LastUsedIP.
+func (s *APIKey) GetLastUsedIP() OptString {
+	return s.LastUsedIP
 }
 
-// GetBirthday returns the value of Birthday.
-func (s *Contact) GetBirthday() OptDateTime {
-	return s.Birthday
+// GetRevokedAt returns the value of RevokedAt.
+func (s *APIKey) GetRevokedAt() OptDateTime {
+	return s.RevokedAt
 }
 
-// GetBirthdayType returns the value of BirthdayType.
-func (s *Contact) GetBirthdayType() OptString {
-	return s.BirthdayType
+// GetCreatedAt returns the value of CreatedAt.
+func (s *APIKey) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
 }
 
-// GetSalary returns the value of Salary.
-func (s *Contact) GetSalary() OptString {
-	return s.Salary
+// SetUUID sets the value of UUID.
+func (s *APIKey) SetUUID(val OptString) {
+	s.UUID = val
 }
 
-// GetSalaryData returns the value of SalaryData.
-func (s *Contact) GetSalaryData() *ContactSalaryData {
-	return s.SalaryData
+// SetName sets the value of Name.
+func (s *APIKey) SetName(val string) {
+	s.Name = val
 }
 
-// GetLastPositions returns the value of LastPositions.
-func (s *Contact) GetLastPositions() *ContactLastPositions {
-	return s.LastPositions
+// SetKey sets the value of Key.
+func (s *APIKey) SetKey(val OptString) {
+	s.Key = val
 }
 
-// GetLastPositionID returns the value of LastPositionID.
-func (s *Contact) GetLastPositionID() OptInt {
-	return s.LastPositionID
+// SetPrefix sets the value of Prefix.
+func (s *APIKey) SetPrefix(val OptString) {
+	s.Prefix = val
 }
 
-// GetLastPositionCompanyID returns the value of LastPositionCompanyID.
-func (s *Contact) GetLastPositionCompanyID() OptInt {
-	return s.LastPositionCompanyID
+// SetUserUUID sets the value of UserUUID.
+func (s *APIKey) SetUserUUID(val OptString) {
+	s.UserUUID = val
 }
 
-// GetLastPositionCompanyName returns the value of LastPositionCompanyName.
-func (s *Contact) GetLastPositionCompanyName() OptString {
-	return s.LastPositionCompanyName
+// SetServiceAccount sets the value of ServiceAccount.
+func (s *APIKey) SetServiceAccount(val OptBool) {
+	s.ServiceAccount = val
 }
 
-// GetLastPositionTitle returns the value of LastPositionTitle.
-func (s *Contact) GetLastPositionTitle() OptString {
-	return s.LastPositionTitle
+// SetRole sets the value of Role.
+func (s *APIKey) SetRole(val OptAPIKeyRole) {
+	s.Role = val
 }
 
-// GetLastPositionStartDate returns the value of LastPositionStartDate.
-func (s *Contact) GetLastPositionStartDate() OptDateTime {
-	return s.LastPositionStartDate
+// SetScopes sets the value of Scopes.
+func (s *APIKey) SetScopes(val []string) {
+	s.Scopes = val
 }
 
-// GetLastPositionEndDate returns the value of LastPositionEndDate.
-func (s *Contact) GetLastPositionEndDate() OptDateTime {
-	return s.LastPositionEndDate
+// SetAllowedIps sets the value of AllowedIps.
+func (s *APIKey) SetAllowedIps(val []string) {
+	s.AllowedIps = val
 }
 
-// GetLastPositionEndNow returns the value of LastPositionEndNow.
-func (s *Contact) GetLastPositionEndNow() OptBool {
-	return s.LastPositionEndNow
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *APIKey) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
 }
 
-// GetLastPositionDescription returns the value of LastPositionDescription.
-func (s *Contact) GetLastPositionDescription() OptString {
-	return s.LastPositionDescription
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *APIKey) SetLastUsedAt(val OptDateTime) {
+	s.LastUsedAt = val
 }
 
-// GetNoteSearch returns the value of NoteSearch.
-func (s *Contact) GetNoteSearch() OptString {
-	return s.NoteSearch
+// SetLastUsedIP sets the value of LastUsedIP.
+func (s *APIKey) SetLastUsedIP(val OptString) {
+	s.LastUsedIP = val
 }
 
-// GetNoteKpiID returns the value of NoteKpiID.
-func (s *Contact) GetNoteKpiID() *ContactNoteKpiID {
-	return s.NoteKpiID
+// SetRevokedAt sets the value of RevokedAt.
+func (s *APIKey) SetRevokedAt(val OptDateTime) {
+	s.RevokedAt = val
 }
 
-// GetPhones returns the value of Phones.
-func (s *Contact) GetPhones() *ContactPhones {
-	return s.Phones
+// SetCreatedAt sets the value of CreatedAt.
+func (s *APIKey) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// GetPhoneSearch returns the value of PhoneSearch.
-func (s *Contact) GetPhoneSearch() OptString {
-	return s.PhoneSearch
-}
+// Workspace role of a service account key, api_client by default. It may not exceed the role of the
+// caller.
+type APIKeyRole string
 
-// GetPhone1 returns the value of Phone1.
-func (s *Contact) GetPhone1() OptString {
-	return s.Phone1
-}
+const (
+	APIKeyRoleAdmin     APIKeyRole = "admin"
+	APIKeyRoleOperator  APIKeyRole = "operator"
+	APIKeyRoleReadonly  APIKeyRole = "readonly"
+	APIKeyRoleAPIClient APIKeyRole = "api_client"
+)
 
-// GetPhone1Type returns the value of Phone1Type.
-func (s *Contact) GetPhone1Type() OptString {
-	return s.Phone1Type
+// AllValues returns all APIKeyRole values.
+func (APIKeyRole) AllValues() []APIKeyRole {
+	return []APIKeyRole{
+		APIKeyRoleAdmin,
+		APIKeyRoleOperator,
+		APIKeyRoleReadonly,
+		APIKeyRoleAPIClient,
+	}
 }
 
-// GetPhone1Country returns the value of Phone1Country.
-func (s *Contact) GetPhone1Country() OptString {
-	return s.Phone1Country
+// MarshalText implements encoding.TextMarshaler.
+func (s APIKeyRole) MarshalText() ([]byte, error) {
+	switch s {
+	case APIKeyRoleAdmin:
+		return []byte(s), nil
+	case APIKeyRoleOperator:
+		return []byte(s), nil
+	case APIKeyRoleReadonly:
+		return []byte(s), nil
+	case APIKeyRoleAPIClient:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetPhone2 returns the value of Phone2.
-func (s *Contact) GetPhone2() OptString {
-	return s.Phone2
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *APIKeyRole) UnmarshalText(data []byte) error {
+	switch APIKeyRole(data) {
+	case APIKeyRoleAdmin:
+		*s = APIKeyRoleAdmin
+		return nil
+	case APIKeyRoleOperator:
+		*s = APIKeyRoleOperator
+		return nil
+	case APIKeyRoleReadonly:
+		*s = APIKeyRoleReadonly
+		return nil
+	case APIKeyRoleAPIClient:
+		*s = APIKeyRoleAPIClient
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetPhone2Type returns the value of Phone2Type.
-func (s *Contact) GetPhone2Type() OptString {
-	return s.Phone2Type
-}
+// ApikeyRevokeOK is response for ApikeyRevoke operation.
+type ApikeyRevokeOK struct{}
 
-// GetPhone2Country returns the value of Phone2Country.
-func (s *Contact) GetPhone2Country() OptString {
-	return s.Phone2Country
+// An audited API operation.
+// Ref: #
+type AuditEvent struct {
+	UUID          uuid.UUID `json:"uuid"`
+	WorkspaceUUID OptUUID   `json:"workspace_uuid"`
+	OccurredAt    time.Time `json:"occurred_at"`
+	// User UUID, "0" for the bearer token or apikey:<uuid> for service account keys. Empty when
+	// unauthenticated.
+	Actor string `json:"actor"`
+	// How the actor authenticated, session, bearer or api_key.
+	Source       string    `json:"source"`
+	APIKeyUUID   OptUUID   `json:"api_key_uuid"`
+	OperationID  string    `json:"operation_id"`
+	ResourceKind OptString `json:"resource_kind"`
+	Action       OptString `json:"action"`
+	// UUID of the object, or the id of an access policy.
+	ResourceID OptString `json:"resource_id"`
+	// Path and query parameters, credentials redacted.
+	Params OptAuditEventParams `json:"params"`
+	// Request body, credentials redacted.
+	Diff    OptAuditEventDiff `json:"diff"`
+	Outcome AuditEventOutcome `json:"outcome"`
+	// HTTP status of the response.
+	Status     int32     `json:"status"`
+	Error      OptString `json:"error"`
+	IP         OptString `json:"ip"`
+	UserAgent  OptString `json:"user_agent"`
+	DurationMs OptInt32  `json:"duration_ms"`
 }
 
-// GetPhone3 returns the value of Phone3.
-func (s *Contact) GetPhone3() OptString {
-	return s.Phone3
+// GetUUID returns the value of UUID.
+func (s *AuditEvent) GetUUID() uuid.UUID {
+	return s.UUID
 }
 
-// GetPhone3Type returns the value of Phone3Type.
-func (s *Contact) GetPhone3Type() OptString {
-	return s.Phone3Type
+// GetWorkspaceUUID returns the value of WorkspaceUUID.
+func (s *AuditEvent) GetWorkspaceUUID() OptUUID {
+	return s.WorkspaceUUID
 }
 
-// GetPhone3Country returns the value of Phone3Country.
-func (s *Contact) GetPhone3Country() OptString {
-	return s.Phone3Country
+// GetOccurredAt returns the value of OccurredAt.
+func (s *AuditEvent) GetOccurredAt() time.Time {
+	return s.OccurredAt
 }
 
-// GetPhone4 returns the value of Phone4.
-func (s *Contact) GetPhone4() OptString {
-	return s.Phone4
+// GetActor returns the value of Actor.
+func (s *AuditEvent) GetActor() string {
+	return s.Actor
 }
 
-// GetPhone4Type returns the value of Phone4Type.
-func (s *Contact) GetPhone4Type() OptString {
-	return s.Phone4Type
+// GetSource returns the value of Source.
+func (s *AuditEvent) GetSource() string {
+	return s.Source
 }
 
-// GetPhone4Country returns the value of Phone4Country.
-func (s *Contact) GetPhone4Country() OptString {
-	return s.Phone4Country
+// GetAPIKeyUUID returns the value of APIKeyUUID.
+func (s *AuditEvent) GetAPIKeyUUID() OptUUID {
+	return s.APIKeyUUID
 }
 
-// GetPhone5 returns the value of Phone5.
-func (s *Contact) GetPhone5() OptString {
-	return s.Phone5
+// GetOperationID returns the value of OperationID.
+func (s *AuditEvent) GetOperationID() string {
+	return s.OperationID
 }
 
-// GetPhone5Type returns the value of Phone5Type.
-func (s *Contact) GetPhone5Type() OptString {
-	return s.Phone5Type
+// GetResourceKind returns the value of ResourceKind.
+func (s *AuditEvent) GetResourceKind() OptString {
+	return s.ResourceKind
 }
 
-// GetPhone5Country returns the value of Phone5Country.
-func (s *Contact) GetPhone5Country() OptString {
-	return s.Phone5Country
+// GetAction returns the value of Action.
+func (s *AuditEvent) GetAction() OptString {
+	return s.Action
 }
 
-// GetEmails returns the value of Emails.
-func (s *Contact) GetEmails() *ContactEmails {
-	return s.Emails
+// GetResourceID returns the value of ResourceID.
+func (s *AuditEvent) GetResourceID() OptString {
+	return s.ResourceID
 }
 
-// GetEmailSearch returns the value of EmailSearch.
-func (s *Contact) GetEmailSearch() OptString {
-	return s.EmailSearch
+// GetParams returns the value of Params.
+func (s *AuditEvent) GetParams() OptAuditEventParams {
+	return s.Params
 }
 
-// GetEmail1 returns the value of Email1.
-func (s *Contact) GetEmail1() OptString {
-	return s.Email1
+// GetDiff returns the value of Diff.
+func (s *AuditEvent) GetDiff() OptAuditEventDiff {
+	return s.Diff
 }
 
-// GetEmail1Type returns the value of Email1Type.
-func (s *Contact) GetEmail1Type() OptString {
-	return s.Email1Type
+// GetOutcome returns the value of Outcome.
+func (s *AuditEvent) GetOutcome() AuditEventOutcome {
+	return s.Outcome
 }
 
-// GetEmail2 returns the value of Email2.
-func (s *Contact) GetEmail2() OptString {
-	return s.Email2
+// GetStatus returns the value of Status.
+func (s *AuditEvent) GetStatus() int32 {
+	return s.Status
 }
 
-// GetEmail2Type returns the value of Email2Type.
-func (s *Contact) GetEmail2Type() OptString {
-	return s.Email2Type
+// GetError returns the value of Error.
+func (s *AuditEvent) GetError() OptString {
+	return s.Error
 }
 
-// GetEmail3 returns the value of Email3.
-func (s *Contact) GetEmail3() OptString {
-	return s.Email3
+// GetIP returns the value of IP.
+func (s *AuditEvent) GetIP() OptString {
+	return s.IP
 }
 
-// GetEmail3Type returns the value of Email3Type.
-func (s *Contact) GetEmail3Type() OptString {
-	return s.Email3Type
+// GetUserAgent returns the value of UserAgent.
+func (s *AuditEvent) GetUserAgent() OptString {
+	return s.UserAgent
 }
 
-// GetEmail4 returns the value of Email4.
-func (s *Contact) GetEmail4() OptString {
-	return s.Email4
+// GetDurationMs returns the value of DurationMs.
+func (s *AuditEvent) GetDurationMs() OptInt32 {
+	return s.DurationMs
 }
 
-// GetEmail4Type returns the value of Email4Type.
-func (s *Contact) GetEmail4Type() OptString {
-	return s.Email4Type
+// SetUUID sets the value of UUID.
+func (s *AuditEvent) SetUUID(val uuid.UUID) {
+	s.UUID = val
 }
 
-// GetEmail5 returns the value of Email5.
-func (s *Contact) GetEmail5() OptString {
-	return s.Email5
+// SetWorkspaceUUID sets the value of WorkspaceUUID.
+func (s *AuditEvent) SetWorkspaceUUID(val OptUUID) {
+	s.WorkspaceUUID = val
 }
 
-// GetEmail5Type returns the value of Email5Type.
-func (s *Contact) GetEmail5Type() OptString {
-	return s.Email5Type
+// SetOccurredAt sets the value of OccurredAt.
+func (s *AuditEvent) SetOccurredAt(val time.Time) {
+	s.OccurredAt = val
 }
 
-// GetMessengers returns the value of Messengers.
-func (s *Contact) GetMessengers() *ContactMessengers {
-	return s.Messengers
+// SetActor sets the value of Actor.
+func (s *AuditEvent) SetActor(val string) {
+	s.Actor = val
 }
 
-// GetMessengersSearch returns the value of MessengersSearch.
-func (s *Contact) GetMessengersSearch() OptString {
-	return s.MessengersSearch
+// SetSource sets the value of Source.
+func (s *AuditEvent) SetSource(val string) {
+	s.Source = val
 }
 
-// GetSkypeUUID returns the value of SkypeUUID.
-func (s *Contact) GetSkypeUUID() OptString {
-	return s.SkypeUUID
+// SetAPIKeyUUID sets the value of APIKeyUUID.
+func (s *AuditEvent) SetAPIKeyUUID(val OptUUID) {
+	s.APIKeyUUID = val
 }
 
-// GetSkype returns the value of Skype.
-func (s *Contact) GetSkype() OptString {
-	return s.Skype
+// SetOperationID sets the value of OperationID.
+func (s *AuditEvent) SetOperationID(val string) {
+	s.OperationID = val
 }
 
-// GetWhatsappUUID returns the value of WhatsappUUID.
-func (s *Contact) GetWhatsappUUID() OptString {
-	return s.WhatsappUUID
+// SetResourceKind sets the value of ResourceKind.
+func (s *AuditEvent) SetResourceKind(val OptString) {
+	s.ResourceKind = val
 }
 
-// GetWhatsapp returns the value of Whatsapp.
-func (s *Contact) GetWhatsapp() OptString {
-	return s.Whatsapp
+// SetAction sets the value of Action.
+func (s *AuditEvent) SetAction(val OptString) {
+	s.Action = val
 }
 
-// GetTelegramUUID returns the value of TelegramUUID.
-func (s *Contact) GetTelegramUUID() OptString {
-	return s.TelegramUUID
+// SetResourceID sets the value of ResourceID.
+func (s *AuditEvent) SetResourceID(val OptString) {
+	s.ResourceID = val
 }
 
-// GetTelegram returns the value of Telegram.
-func (s *Contact) GetTelegram() OptString {
-	return s.Telegram
+// SetParams sets the value of Params.
+func (s *AuditEvent) SetParams(val OptAuditEventParams) {
+	s.Params = val
 }
 
-// GetWechatUUID returns the value of WechatUUID.
-func (s *Contact) GetWechatUUID() OptString {
-	return s.WechatUUID
+// SetDiff sets the value of Diff.
+func (s *AuditEvent) SetDiff(val OptAuditEventDiff) {
+	s.Diff = val
 }
 
-// GetWechat returns the value of Wechat.
-func (s *Contact) GetWechat() OptString {
-	return s.Wechat
+// SetOutcome sets the value of Outcome.
+func (s *AuditEvent) SetOutcome(val AuditEventOutcome) {
+	s.Outcome = val
 }
 
-// GetLineUUID returns the value of LineUUID.
-func (s *Contact) GetLineUUID() OptString {
-	return s.LineUUID
+// SetStatus sets the value of Status.
+func (s *AuditEvent) SetStatus(val int32) {
+	s.Status = val
 }
 
-// GetLine returns the value of Line.
-func (s *Contact) GetLine() OptString {
-	return s.Line
+// SetError sets the value of Error.
+func (s *AuditEvent) SetError(val OptString) {
+	s.Error = val
 }
 
-// GetSocials returns the value of Socials.
-func (s *Contact) GetSocials() *ContactSocials {
-	return s.Socials
+// SetIP sets the value of IP.
+func (s *AuditEvent) SetIP(val OptString) {
+	s.IP = val
 }
 
-// GetSocialsSearch returns the value of SocialsSearch.
-func (s *Contact) GetSocialsSearch() OptString {
-	return s.SocialsSearch
+// SetUserAgent sets the value of UserAgent.
+func (s *AuditEvent) SetUserAgent(val OptString) {
+	s.UserAgent = val
 }
 
-// GetLinkedinUUID returns the value of LinkedinUUID.
-func (s *Contact) GetLinkedinUUID() OptString {
-	return s.LinkedinUUID
+// SetDurationMs sets the value of DurationMs.
+func (s *AuditEvent) SetDurationMs(val OptInt32) {
+	s.DurationMs = val
 }
 
-// GetLinkedinURL returns the value of LinkedinURL.
-func (s *Contact) GetLinkedinURL() OptString {
-	return s.LinkedinURL
-}
+// Request body, credentials redacted.
+type AuditEventDiff map[string]jx.Raw
 
-// GetFacebookUUID returns the value of FacebookUUID.
-func (s *Contact) GetFacebookUUID() OptString {
-	return s.FacebookUUID
+func (s *AuditEventDiff) init() AuditEventDiff {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// GetFacebookURL returns the value of FacebookURL.
-func (s *Contact) GetFacebookURL() OptString {
-	return s.FacebookURL
-}
+type AuditEventOutcome string
 
-// GetTwitterUUID returns the value of TwitterUUID.
-func (s *Contact) GetTwitterUUID() OptString {
-	return s.TwitterUUID
-}
+const (
+	AuditEventOutcomeSuccess         AuditEventOutcome = "success"
+	AuditEventOutcomeDenied          AuditEventOutcome = "denied"
+	AuditEventOutcomeUnauthenticated AuditEventOutcome = "unauthenticated"
+	AuditEventOutcomeError           AuditEventOutcome = "error"
+)
 
-// GetTwitterURL returns the value of TwitterURL.
-func (s *Contact) GetTwitterURL() OptString {
-	return s.TwitterURL
+// AllValues returns all AuditEventOutcome values.
+func (AuditEventOutcome) AllValues() []AuditEventOutcome {
+	return []AuditEventOutcome{
+		AuditEventOutcomeSuccess,
+		AuditEventOutcomeDenied,
+		AuditEventOutcomeUnauthenticated,
+		AuditEventOutcomeError,
+	}
 }
 
-// GetGithubUUID returns the value of GithubUUID.
-func (s *Contact) GetGithubUUID() OptString {
-	return s.GithubUUID
+// MarshalText implements encoding.TextMarshaler.
+func (s AuditEventOutcome) MarshalText() ([]byte, error) {
+	switch s {
+	case AuditEventOutcomeSuccess:
+		return []byte(s), nil
+	case AuditEventOutcomeDenied:
+		return []byte(s), nil
+	case AuditEventOutcomeUnauthenticated:
+		return []byte(s), nil
+	case AuditEventOutcomeError:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetGithubURL returns the value of GithubURL.
-func (s *Contact) GetGithubURL() OptString {
-	return s.GithubURL
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AuditEventOutcome) UnmarshalText(data []byte) error {
+	switch AuditEventOutcome(data) {
+	case AuditEventOutcomeSuccess:
+		*s = AuditEventOutcomeSuccess
+		return nil
+	case AuditEventOutcomeDenied:
+		*s = AuditEventOutcomeDenied
+		return nil
+	case AuditEventOutcomeUnauthenticated:
+		*s = AuditEventOutcomeUnauthenticated
+		return nil
+	case AuditEventOutcomeError:
+		*s = AuditEventOutcomeError
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetVkUUID returns the value of VkUUID.
-func (s *Contact) GetVkUUID() OptString {
-	return s.VkUUID
-}
+// Path and query parameters, credentials redacted.
+type AuditEventParams map[string]jx.Raw
 
-// GetVkURL returns the value of VkURL.
-func (s *Contact) GetVkURL() OptString {
-	return s.VkURL
+func (s *AuditEventParams) init() AuditEventParams {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// GetOdnoUUID returns the value of OdnoUUID.
-func (s *Contact) GetOdnoUUID() OptString {
-	return s.OdnoUUID
+type AuditExportOK struct {
+	Data io.Reader
 }
 
-// GetOdnoURL returns the value of OdnoURL.
-func (s *Contact) GetOdnoURL() OptString {
-	return s.OdnoURL
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s AuditExportOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetHhruUUID returns the value of HhruUUID.
-func (s *Contact) GetHhruUUID() OptString {
-	return s.HhruUUID
-}
+type AuditExportOutcome string
 
-// GetHhruURL returns the value of HhruURL.
-func (s *Contact) GetHhruURL() OptString {
-	return s.HhruURL
-}
+const (
+	AuditExportOutcomeSuccess         AuditExportOutcome = "success"
+	AuditExportOutcomeDenied          AuditExportOutcome = "denied"
+	AuditExportOutcomeUnauthenticated AuditExportOutcome = "unauthenticated"
+	AuditExportOutcomeError           AuditExportOutcome = "error"
+)
 
-// GetHabrUUID returns the value of HabrUUID.
-func (s *Contact) GetHabrUUID() OptString {
-	return s.HabrUUID
+// AllValues returns all AuditExportOutcome values.
+func (AuditExportOutcome) AllValues() []AuditExportOutcome {
+	return []AuditExportOutcome{
+		AuditExportOutcomeSuccess,
+		AuditExportOutcomeDenied,
+		AuditExportOutcomeUnauthenticated,
+		AuditExportOutcomeError,
+	}
 }
 
-// GetHabrURL returns the value of HabrURL.
-func (s *Contact) GetHabrURL() OptString {
-	return s.HabrURL
+// MarshalText implements encoding.TextMarshaler.
+func (s AuditExportOutcome) MarshalText() ([]byte, error) {
+	switch s {
+	case AuditExportOutcomeSuccess:
+		return []byte(s), nil
+	case AuditExportOutcomeDenied:
+		return []byte(s), nil
+	case AuditExportOutcomeUnauthenticated:
+		return []byte(s), nil
+	case AuditExportOutcomeError:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetMoikrugUUID returns the value of MoikrugUUID.
-func (s *Contact) GetMoikrugUUID() OptString {
-	return s.MoikrugUUID
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AuditExportOutcome) UnmarshalText(data []byte) error {
+	switch AuditExportOutcome(data) {
+	case AuditExportOutcomeSuccess:
+		*s = AuditExportOutcomeSuccess
+		return nil
+	case AuditExportOutcomeDenied:
+		*s = AuditExportOutcomeDenied
+		return nil
+	case AuditExportOutcomeUnauthenticated:
+		*s = AuditExportOutcomeUnauthenticated
+		return nil
+	case AuditExportOutcomeError:
+		*s = AuditExportOutcomeError
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetMoikrugURL returns the value of MoikrugURL.
-func (s *Contact) GetMoikrugURL() OptString {
-	return s.MoikrugURL
-}
+type AuditListOutcome string
 
-// GetInstagramUUID returns the value of InstagramUUID.
-func (s *Contact) GetInstagramUUID() OptString {
-	return s.InstagramUUID
+const (
+	AuditListOutcomeSuccess         AuditListOutcome = "success"
+	AuditListOutcomeDenied          AuditListOutcome = "denied"
+	AuditListOutcomeUnauthenticated AuditListOutcome = "unauthenticated"
+	AuditListOutcomeError           AuditListOutcome = "error"
+)
+
+// AllValues returns all AuditListOutcome values.
+func (AuditListOutcome) AllValues() []AuditListOutcome {
+	return []AuditListOutcome{
+		AuditListOutcomeSuccess,
+		AuditListOutcomeDenied,
+		AuditListOutcomeUnauthenticated,
+		AuditListOutcomeError,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AuditListOutcome) MarshalText() ([]byte, error) {
+	switch s {
+	case AuditListOutcomeSuccess:
+		return []byte(s), nil
+	case AuditListOutcomeDenied:
+		return []byte(s), nil
+	case AuditListOutcomeUnauthenticated:
+		return []byte(s), nil
+	case AuditListOutcomeError:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AuditListOutcome) UnmarshalText(data []byte) error {
+	switch AuditListOutcome(data) {
+	case AuditListOutcomeSuccess:
+		*s = AuditListOutcomeSuccess
+		return nil
+	case AuditListOutcomeDenied:
+		*s = AuditListOutcomeDenied
+		return nil
+	case AuditListOutcomeUnauthenticated:
+		*s = AuditListOutcomeUnauthenticated
+		return nil
+	case AuditListOutcomeError:
+		*s = AuditListOutcomeError
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type BearerAuth struct {
+	Token string
+}
+
+// GetToken returns the value of Token.
+func (s *BearerAuth) GetToken() string {
+	return s.Token
+}
+
+// SetToken sets the value of Token.
+func (s *BearerAuth) SetToken(val string) {
+	s.Token = val
+}
+
+// Ref: #
+type Contact struct {
+	UUID                    OptString             `json:"uuid"`
+	UserUUID                OptString             `json:"user_uuid"`
+	InstanceUUID            OptString             `json:"instance_uuid"`
+	Status                  OptString             `json:"status"`
+	Names                   *ContactNames         `json:"names"`
+	NamesSearch             OptString             `json:"names_search"`
+	Last                    OptString             `json:"last"`
+	First                   OptString             `json:"first"`
+	Middle                  OptString             `json:"middle"`
+	Birthday                OptDateTime           `json:"birthday"`
+	BirthdayType            OptString             `json:"birthday_type"`
+	Salary                  OptString             `json:"salary"`
+	SalaryData              *ContactSalaryData    `json:"salary_data"`
+	LastPositions           *ContactLastPositions `json:"last_positions"`
+	LastPositionID          OptInt                `json:"last_position_id"`
+	LastPositionCompanyID   OptInt                `json:"last_position_company_id"`
+	LastPositionCompanyName OptString             `json:"last_position_company_name"`
+	LastPositionTitle       OptString             `json:"last_position_title"`
+	LastPositionStartDate   OptDateTime           `json:"last_position_start_date"`
+	LastPositionEndDate     OptDateTime           `json:"last_position_end_date"`
+	LastPositionEndNow      OptBool               `json:"last_position_end_now"`
+	LastPositionDescription OptString             `json:"last_position_description"`
+	NoteSearch              OptString             `json:"note_search"`
+	NoteKpiID               *ContactNoteKpiID     `json:"note_kpi_id"`
+	Phones                  *ContactPhones        `json:"phones"`
+	PhoneSearch             OptString             `json:"phone_search"`
+	Phone1                  OptString             `json:"phone1"`
+	Phone1Type              OptString             `json:"phone1_type"`
+	Phone1Country           OptString             `json:"phone1_country"`
+	Phone2                  OptString             `json:"phone2"`
+	Phone2Type              OptString             `json:"phone2_type"`
+	Phone2Country           OptString             `json:"phone2_country"`
+	Phone3                  OptString             `json:"phone3"`
+	Phone3Type              OptString             `json:"phone3_type"`
+	Phone3Country           OptString             `json:"phone3_country"`
+	Phone4                  OptString             `json:"phone4"`
+	Phone4Type              OptString             `json:"phone4_type"`
+	Phone4Country           OptString             `json:"phone4_country"`
+	Phone5                  OptString             `json:"phone5"`
+	Phone5Type              OptString             `json:"phone5_type"`
+	Phone5Country           OptString             `json:"phone5_country"`
+	Emails                  *ContactEmails        `json:"emails"`
+	EmailSearch             OptString             `json:"email_search"`
+	Email1                  OptString             `json:"email1"`
+	Email1Type              OptString             `json:"email1_type"`
+	Email2                  OptString             `json:"email2"`
+	Email2Type              OptString             `json:"email2_type"`
+	Email3                  OptString             `json:"email3"`
+	Email3Type              OptString             `json:"email3_type"`
+	Email4                  OptString             `json:"email4"`
+	Email4Type              OptString             `json:"email4_type"`
+	Email5                  OptString             `json:"email5"`
+	Email5Type              OptString             `json:"email5_type"`
+	Messengers              *ContactMessengers    `json:"messengers"`
+	MessengersSearch        OptString             `json:"messengers_search"`
+	SkypeUUID               OptString             `json:"skype_uuid"`
+	Skype                   OptString             `json:"skype"`
+	WhatsappUUID            OptString             `json:"whatsapp_uuid"`
+	Whatsapp                OptString             `json:"whatsapp"`
+	TelegramUUID            OptString             `json:"telegram_uuid"`
+	Telegram                OptString             `json:"telegram"`
+	WechatUUID              OptString             `json:"wechat_uuid"`
+	Wechat                  OptString             `json:"wechat"`
+	LineUUID                OptString             `json:"line_uuid"`
+	Line                    OptString             `json:"line"`
+	Socials                 *ContactSocials       `json:"socials"`
+	SocialsSearch           OptString             `json:"socials_search"`
+	LinkedinUUID            OptString             `json:"linkedin_uuid"`
+	LinkedinURL             OptString             `json:"linkedin_url"`
+	FacebookUUID            OptString             `json:"facebook_uuid"`
+	FacebookURL             OptString             `json:"facebook_url"`
+	TwitterUUID             OptString             `json:"twitter_uuid"`
+	TwitterURL              OptString             `json:"twitter_url"`
+	GithubUUID              OptString             `json:"github_uuid"`
+	GithubURL               OptString             `json:"github_url"`
+	VkUUID                  OptString             `json:"vk_uuid"`
+	VkURL                   OptString             `json:"vk_url"`
+	OdnoUUID                OptString             `json:"odno_uuid"`
+	OdnoURL                 OptString             `json:"odno_url"`
+	HhruUUID                OptString             `json:"hhru_uuid"`
+	HhruURL                 OptString             `json:"hhru_url"`
+	HabrUUID                OptString             `json:"habr_uuid"`
+	HabrURL                 OptString             `json:"habr_url"`
+	MoikrugUUID             OptString             `json:"moikrug_uuid"`
+	MoikrugURL              OptString             `json:"moikrug_url"`
+	InstagramUUID           OptString             `json:"instagram_uuid"`
+	InstagramURL            OptString             `json:"instagram_url"`
+	Social1UUID             OptString             `json:"social1_uuid"`
+	Social1URL              OptString             `json:"social1_url"`
+	Social1Type             OptString             `json:"social1_type"`
+	Social2UUID             OptString             `json:"social2_uuid"`
+	Social2URL              OptString             `json:"social2_url"`
+	Social2Type             OptString             `json:"social2_type"`
+	Social3UUID             OptString             `json:"social3_uuid"`
+	Social3URL              OptString             `json:"social3_url"`
+	Social3Type             OptString             `json:"social3_type"`
+	Social4UUID             OptString             `json:"social4_uuid"`
+	Social4URL              OptString             `json:"social4_url"`
+	Social4Type             OptString             `json:"social4_type"`
+	Social5UUID             OptString             `json:"social5_uuid"`
+	Social5URL              OptString             `json:"social5_url"`
+	Social5Type             OptString             `json:"social5_type"`
+	Social6UUID             OptString             `json:"social6_uuid"`
+	Social6URL              OptString             `json:"social6_url"`
+	Social6Type             OptString             `json:"social6_type"`
+	Social7UUID             OptString             `json:"social7_uuid"`
+	Social7URL              OptString             `json:"social7_url"`
+	Social7Type             OptString             `json:"social7_type"`
+	Social8UUID             OptString             `json:"social8_uuid"`
+	Social8URL              OptString             `json:"social8_url"`
+	Social8Type             OptString             `json:"social8_type"`
+	Social9UUID             OptString             `json:"social9_uuid"`
+	Social9URL              OptString             `json:"social9_url"`
+	Social9Type             OptString             `json:"social9_type"`
+	TrackingSource          OptString             `json:"tracking_source"`
+	TrackingSlug            OptString             `json:"tracking_slug"`
+	CachedImg               OptString             `json:"cached_img"`
+	CachedImgData           *ContactCachedImgData `json:"cached_img_data"`
+	Crawl                   *ContactCrawl         `json:"crawl"`
+	DuplicateUserID         OptString             `json:"duplicate_user_id"`
+	DuplicateAlternativeID  OptString             `json:"duplicate_alternative_id"`
+	DuplicateReportDate     OptDateTime           `json:"duplicate_report_date"`
+	EntryDate               OptDateTime           `json:"entry_date"`
+	EditDate                OptDateTime           `json:"edit_date"`
+	LastKpiEntryDate        OptDateTime           `json:"last_kpi_entry_date"`
+}
+
+// GetUUID returns the value of UUID.
+func (s *Contact) GetUUID() OptString {
+	return s.UUID
+}
+
+// GetUserUUID returns the value of UserUUID.
+func (s *Contact) GetUserUUID() OptString {
+	return s.UserUUID
+}
+
+// GetInstanceUUID returns the value of InstanceUUID.
+func (s *Contact) GetInstanceUUID() OptString {
+	return s.InstanceUUID
+}
+
+// GetStatus returns the value of Status.
+func (s *Contact) GetStatus() OptString {
+	return s.Status
+}
+
+// GetNames returns the value of Names.
+func (s *Contact) GetNames() *ContactNames {
+	return s.Names
+}
+
+// GetNamesSearch returns the value of NamesSearch.
+func (s *Contact) GetNamesSearch() OptString {
+	return s.NamesSearch
+}
+
+// GetLast returns the value of Last.
+func (s *Contact) GetLast() OptString {
+	return s.Last
+}
+
+// GetFirst returns the value of First.
+func (s *Contact) GetFirst() OptString {
+	return s.First
+}
+
+// GetMiddle returns the value of Middle.
+func (s *Contact) GetMiddle() OptString {
+	return s.Middle
+}
+
+// GetBirthday returns the value of Birthday.
+func (s *Contact) GetBirthday() OptDateTime {
+	return s.Birthday
+}
+
+// GetBirthdayType returns the value of BirthdayType.
+func (s *Contact) GetBirthdayType() OptString {
+	return s.BirthdayType
+}
+
+// GetSalary returns the value of Salary.
+func (s *Contact) GetSalary() OptString {
+	return s.Salary
+}
+
+// GetSalaryData returns the value of SalaryData.
+func (s *Contact) GetSalaryData() *ContactSalaryData {
+	return s.SalaryData
+}
+
+// GetLastPositions returns the value of LastPositions.
+func (s *Contact) GetLastPositions() *ContactLastPositions {
+	return s.LastPositions
+}
+
+// GetLastPositionID returns the value of LastPositionID.
+func (s *Contact) GetLastPositionID() OptInt {
+	return s.LastPositionID
+}
+
+// GetLastPositionCompanyID returns the value of LastPositionCompanyID.
+func (s *Contact) GetLastPositionCompanyID() OptInt {
+	return s.LastPositionCompanyID
+}
+
+// GetLastPositionCompanyName returns the value of LastPositionCompanyName.
+func (s *Contact) GetLastPositionCompanyName() OptString {
+	return s.LastPositionCompanyName
+}
+
+// GetLastPositionTitle returns the value of LastPositionTitle.
+func (s *Contact) GetLastPositionTitle() OptString {
+	return s.LastPositionTitle
+}
+
+// GetLastPositionStartDate returns the value of LastPositionStartDate.
+func (s *Contact) GetLastPositionStartDate() OptDateTime {
+	return s.LastPositionStartDate
+}
+
+// GetLastPositionEndDate returns the value of LastPositionEndDate.
+func (s *Contact) GetLastPositionEndDate() OptDateTime {
+	return s.LastPositionEndDate
+}
+
+// GetLastPositionEndNow returns the value of LastPositionEndNow.
+func (s *Contact) GetLastPositionEndNow() OptBool {
+	return s.LastPositionEndNow
+}
+
+// GetLastPositionDescription returns the value of LastPositionDescription.
+func (s *Contact) GetLastPositionDescription() OptString {
+	return s.LastPositionDescription
+}
+
+// GetNoteSearch returns the value of NoteSearch.
+func (s *Contact) GetNoteSearch() OptString {
+	return s.NoteSearch
+}
+
+// GetNoteKpiID returns the value of NoteKpiID.
+func (s *Contact) GetNoteKpiID() *ContactNoteKpiID {
+	return s.NoteKpiID
+}
+
+// GetPhones returns the value of Phones.
+func (s *Contact) GetPhones() *ContactPhones {
+	return s.Phones
+}
+
+// GetPhoneSearch returns the value of PhoneSearch.
+func (s *Contact) GetPhoneSearch() OptString {
+	return s.PhoneSearch
+}
+
+// GetPhone1 returns the value of Phone1.
+func (s *Contact) GetPhone1() OptString {
+	return s.Phone1
+}
+
+// GetPhone1Type returns the value of Phone1Type.
+func (s *Contact) GetPhone1Type() OptString {
+	return s.Phone1Type
+}
+
+// GetPhone1Country returns the value of Phone1Country.
+func (s *Contact) GetPhone1Country() OptString {
+	return s.Phone1Country
+}
+
+// GetPhone2 returns the value of Phone2.
+func (s *Contact) GetPhone2() OptString {
+	return s.Phone2
+}
+
+// GetPhone2Type returns the value of Phone2Type.
+func (s *Contact) GetPhone2Type() OptString {
+	return s.Phone2Type
+}
+
+// GetPhone2Country returns the value of Phone2Country.
+func (s *Contact) GetPhone2Country() OptString {
+	return s.Phone2Country
+}
+
+// GetPhone3 returns the value of Phone3.
+func (s *Contact) GetPhone3() OptString {
+	return s.Phone3
+}
+
+// GetPhone3Type returns the value of Phone3Type.
+func (s *Contact) GetPhone3Type() OptString {
+	return s.Phone3Type
+}
+
+// GetPhone3Country returns the value of Phone3Country.
+func (s *Contact) GetPhone3Country() OptString {
+	return s.Phone3Country
+}
+
+// GetPhone4 returns the value of Phone4.
+func (s *Contact) GetPhone4() OptString {
+	return s.Phone4
+}
+
+// GetPhone4Type returns the value of Phone4Type.
+func (s *Contact) GetPhone4Type() OptString {
+	return s.Phone4Type
+}
+
+// GetPhone4Country returns the value of Phone4Country.
+func (s *Contact) GetPhone4Country() OptString {
+	return s.Phone4Country
+}
+
+// GetPhone5 returns the value of Phone5.
+func (s *Contact) GetPhone5() OptString {
+	return s.Phone5
+}
+
+// GetPhone5Type returns the value of Phone5Type.
+func (s *Contact) GetPhone5Type() OptString {
+	return s.Phone5Type
+}
+
+// GetPhone5Country returns the value of Phone5Country.
+func (s *Contact) GetPhone5Country() OptString {
+	return s.Phone5Country
+}
+
+// GetEmails returns the value of Emails.
+func (s *Contact) GetEmails() *ContactEmails {
+	return s.Emails
+}
+
+// GetEmailSearch returns the value of EmailSearch.
+func (s *Contact) GetEmailSearch() OptString {
+	return s.EmailSearch
+}
+
+// GetEmail1 returns the value of Email1.
+func (s *Contact) GetEmail1() OptString {
+	return s.Email1
+}
+
+// GetEmail1Type returns the value of Email1Type.
+func (s *Contact) GetEmail1Type() OptString {
+	return s.Email1Type
+}
+
+// GetEmail2 returns the value of Email2.
+func (s *Contact) GetEmail2() OptString {
+	return s.Email2
+}
+
+// GetEmail2Type returns the value of Email2Type.
+func (s *Contact) GetEmail2Type() OptString {
+	return s.Email2Type
+}
+
+// GetEmail3 returns the value of Email3.
+func (s *Contact) GetEmail3() OptString {
+	return s.Email3
+}
+
+// GetEmail3Type returns the value of Email3Type.
+func (s *Contact) GetEmail3Type() OptString {
+	return s.Email3Type
+}
+
+// GetEmail4 returns the value of Email4.
+func (s *Contact) GetEmail4() OptString {
+	return s.Email4
+}
+
+// GetEmail4Type returns the value of Email4Type.
+func (s *Contact) GetEmail4Type() OptString {
+	return s.Email4Type
+}
+
+// GetEmail5 returns the value of Email5.
+func (s *Contact) GetEmail5() OptString {
+	return s.Email5
+}
+
+// GetEmail5Type returns the value of Email5Type.
+func (s *Contact) GetEmail5Type() OptString {
+	return s.Email5Type
+}
+
+// GetMessengers returns the value of Messengers.
+func (s *Contact) GetMessengers() *ContactMessengers {
+	return s.Messengers
+}
+
+// GetMessengersSearch returns the value of MessengersSearch.
+func (s *Contact) GetMessengersSearch() OptString {
+	return s.MessengersSearch
+}
+
+// GetSkypeUUID returns the value of SkypeUUID.
+func (s *Contact) GetSkypeUUID() OptString {
+	return s.SkypeUUID
+}
+
+// GetSkype returns the value of Skype.
+func (s *Contact) GetSkype() OptString {
+	return s.Skype
+}
+
+// GetWhatsappUUID returns the value of WhatsappUUID.
+func (s *Contact) GetWhatsappUUID() OptString {
+	return s.WhatsappUUID
+}
+
+// GetWhatsapp returns the value of Whatsapp.
+func (s *Contact) GetWhatsapp() OptString {
+	return s.Whatsapp
+}
+
+// GetTelegramUUID returns the value of TelegramUUID.
+func (s *Contact) GetTelegramUUID() OptString {
+	return s.TelegramUUID
+}
+
+// GetTelegram returns the value of Telegram.
+func (s *Contact) GetTelegram() OptString {
+	return s.Telegram
+}
+
+// GetWechatUUID returns the value of WechatUUID.
+func (s *Contact) GetWechatUUID() OptString {
+	return s.WechatUUID
+}
+
+// GetWechat returns the value of Wechat.
+func (s *Contact) GetWechat() OptString {
+	return s.Wechat
+}
+
+// GetLineUUID returns the value of LineUUID.
+func (s *Contact) GetLineUUID() OptString {
+	return s.LineUUID
+}
+
+// GetLine returns the value of Line.
+func (s *Contact) GetLine() OptString {
+	return s.Line
+}
+
+// GetSocials returns the value of Socials.
+func (s *Contact) GetSocials() *ContactSocials {
+	return s.Socials
+}
+
+// GetSocialsSearch returns the value of SocialsSearch.
+func (s *Contact) GetSocialsSearch() OptString {
+	return s.SocialsSearch
+}
+
+// GetLinkedinUUID returns the value of LinkedinUUID.
+func (s *Contact) GetLinkedinUUID() OptString {
+	return s.LinkedinUUID
+}
+
+// GetLinkedinURL returns the value of LinkedinURL.
+func (s *Contact) GetLinkedinURL() OptString {
+	return s.LinkedinURL
+}
+
+// GetFacebookUUID returns the value of FacebookUUID.
+func (s *Contact) GetFacebookUUID() OptString {
+	return s.FacebookUUID
+}
+
+// GetFacebookURL returns the value of FacebookURL.
+func (s *Contact) GetFacebookURL() OptString {
+	return s.FacebookURL
+}
+
+// GetTwitterUUID returns the value of TwitterUUID.
+func (s *Contact) GetTwitterUUID() OptString {
+	return s.TwitterUUID
+}
+
+// GetTwitterURL returns the value of TwitterURL.
+func (s *Contact) GetTwitterURL() OptString {
+	return s.TwitterURL
+}
+
+// GetGithubUUID returns the value of GithubUUID.
+func (s *Contact) GetGithubUUID() OptString {
+	return s.GithubUUID
+}
+
+// GetGithubURL returns the value of GithubURL.
+func (s *Contact) GetGithubURL() OptString {
+	return s.GithubURL
+}
+
+// GetVkUUID returns the value of VkUUID.
+func (s *Contact) GetVkUUID() OptString {
+	return s.VkUUID
+}
+
+// GetVkURL returns the value of VkURL.
+func (s *Contact) GetVkURL() OptString {
+	return s.VkURL
+}
+
+// GetOdnoUUID returns the value of OdnoUUID.
+func (s *Contact) GetOdnoUUID() OptString {
+	return s.OdnoUUID
+}
+
+// GetOdnoURL returns the value of OdnoURL.
+func (s *Contact) GetOdnoURL() OptString {
+	return s.OdnoURL
+}
+
+// GetHhruUUID returns the value of HhruUUID.
+func (s *Contact) GetHhruUUID() OptString {
+	return s.HhruUUID
+}
+
+// GetHhruURL returns the value of HhruURL.
+func (s *Contact) GetHhruURL() OptString {
+	return s.HhruURL
+}
+
+// GetHabrUUID returns the value of HabrUUID.
+func (s *Contact) GetHabrUUID() OptString {
+	return s.HabrUUID
+}
+
+// GetHabrURL returns the value of HabrURL.
+func (s *Contact) GetHabrURL() OptString {
+	return s.HabrURL
+}
+
+// GetMoikrugUUID returns the value of MoikrugUUID.
+func (s *Contact) GetMoikrugUUID() OptString {
+	return s.MoikrugUUID
+}
+
+// GetMoikrugURL returns the value of MoikrugURL.
+func (s *Contact) GetMoikrugURL() OptString {
+	return s.MoikrugURL
+}
+
+// GetInstagramUUID returns the value of InstagramUUID.
+func (s *Contact) GetInstagramUUID() OptString {
+	return s.InstagramUUID
 }
 
 // GetInstagramURL returns the value of InstagramURL.
@@ -594,1352 +1241,2481 @@ func (s *Contact) GetInstagramURL() OptString {
 	return s.InstagramURL
 }
 
-// GetSocial1UUID returns the value of Social1UUID.
-func (s *Contact) GetSocial1UUID() OptString {
-	return s.Social1UUID
+// GetSocial1UUID returns the value of Social1UUID.
+func (s *Contact) GetSocial1UUID() OptString {
+	return s.Social1UUID
+}
+
+// GetSocial1URL returns the value of Social1URL.
+func (s *Contact) GetSocial1URL() OptString {
+	return s.Social1URL
+}
+
+// GetSocial1Type returns the value of Social1Type.
+func (s *Contact) GetSocial1Type() OptString {
+	return s.Social1Type
+}
+
+// GetSocial2UUID returns the value of Social2UUID.
+func (s *Contact) GetSocial2UUID() OptString {
+	return s.Social2UUID
+}
+
+// GetSocial2URL returns the value of Social2URL.
+func (s *Contact) GetSocial2URL() OptString {
+	return s.Social2URL
+}
+
+// GetSocial2Type returns the value of Social2Type.
+func (s *Contact) GetSocial2Type() OptString {
+	return s.Social2Type
+}
+
+// GetSocial3UUID returns the value of Social3UUID.
+func (s *Contact) GetSocial3UUID() OptString {
+	return s.Social3UUID
+}
+
+// GetSocial3URL returns the value of Social3URL.
+func (s *Contact) GetSocial3URL() OptString {
+	return s.Social3URL
+}
+
+// GetSocial3Type returns the value of Social3Type.
+func (s *Contact) GetSocial3Type() OptString {
+	return s.Social3Type
+}
+
+// GetSocial4UUID returns the value of Social4UUID.
+func (s *Contact) GetSocial4UUID() OptString {
+	return s.Social4UUID
+}
+
+// GetSocial4URL returns the value of Social4URL.
+func (s *Contact) GetSocial4URL() OptString {
+	return s.Social4URL
+}
+
+// GetSocial4Type returns the value of Social4Type.
+func (s *Contact) GetSocial4Type() OptString {
+	return s.Social4Type
+}
+
+// GetSocial5UUID returns the value of Social5UUID.
+func (s *Contact) GetSocial5UUID() OptString {
+	return s.Social5UUID
+}
+
+// GetSocial5URL returns the value of Social5URL.
+func (s *Contact) GetSocial5URL() OptString {
+	return s.Social5URL
+}
+
+// GetSocial5Type returns the value of Social5Type.
+func (s *Contact) GetSocial5Type() OptString {
+	return s.Social5Type
+}
+
+// GetSocial6UUID returns the value of Social6UUID.
+func (s *Contact) GetSocial6UUID() OptString {
+	return s.Social6UUID
+}
+
+// GetSocial6URL returns the value of Social6URL.
+func (s *Contact) GetSocial6URL() OptString {
+	return s.Social6URL
+}
+
+// GetSocial6Type returns the value of Social6Type.
+func (s *Contact) GetSocial6Type() OptString {
+	return s.Social6Type
+}
+
+// GetSocial7UUID returns the value of Social7UUID.
+func (s *Contact) GetSocial7UUID() OptString {
+	return s.Social7UUID
+}
+
+// GetSocial7URL returns the value of Social7URL.
+func (s *Contact) GetSocial7URL() OptString {
+	return s.Social7URL
+}
+
+// GetSocial7Type returns the value of Social7Type.
+func (s *Contact) GetSocial7Type() OptString {
+	return s.Social7Type
+}
+
+// GetSocial8UUID returns the value of Social8UUID.
+func (s *Contact) GetSocial8UUID() OptString {
+	return s.Social8UUID
+}
+
+// GetSocial8URL returns the value of Social8URL.
+func (s *Contact) GetSocial8URL() OptString {
+	return s.Social8URL
+}
+
+// GetSocial8Type returns the value of Social8Type.
+func (s *Contact) GetSocial8Type() OptString {
+	return s.Social8Type
+}
+
+// GetSocial9UUID returns the value of Social9UUID.
+func (s *Contact) GetSocial9UUID() OptString {
+	return s.Social9UUID
+}
+
+// GetSocial9URL returns the value of Social9URL.
+func (s *Contact) GetSocial9URL() OptString {
+	return s.Social9URL
+}
+
+// GetSocial9Type returns the value of Social9Type.
+func (s *Contact) GetSocial9Type() OptString {
+	return s.Social9Type
+}
+
+// GetTrackingSource returns the value of TrackingSource.
+func (s *Contact) GetTrackingSource() OptString {
+	return s.TrackingSource
+}
+
+// GetTrackingSlug returns the value of TrackingSlug.
+func (s *Contact) GetTrackingSlug() OptString {
+	return s.TrackingSlug
+}
+
+// GetCachedImg returns the value of CachedImg.
+func (s *Contact) GetCachedImg() OptString {
+	return s.CachedImg
+}
+
+// GetCachedImgData returns the value of CachedImgData.
+func (s *Contact) GetCachedImgData() *ContactCachedImgData {
+	return s.CachedImgData
+}
+
+// GetCrawl returns the value of Crawl.
+func (s *Contact) GetCrawl() *ContactCrawl {
+	return s.Crawl
+}
+
+// GetDuplicateUserID returns the value of DuplicateUserID.
+func (s *Contact) GetDuplicateUserID() OptString {
+	return s.DuplicateUserID
+}
+
+// GetDuplicateAlternativeID returns the value of DuplicateAlternativeID.
+func (s *Contact) GetDuplicateAlternativeID() OptString {
+	return s.DuplicateAlternativeID
+}
+
+// GetDuplicateReportDate returns the value of DuplicateReportDate.
+func (s *Contact) GetDuplicateReportDate() OptDateTime {
+	return s.DuplicateReportDate
+}
+
+// GetEntryDate returns the value of EntryDate.
+func (s *Contact) GetEntryDate() OptDateTime {
+	return s.EntryDate
+}
+
+// GetEditDate returns the value of EditDate.
+func (s *Contact) GetEditDate() OptDateTime {
+	return s.EditDate
+}
+
+// GetLastKpiEntryDate returns the value of LastKpiEntryDate.
+func (s *Contact) GetLastKpiEntryDate() OptDateTime {
+	return s.LastKpiEntryDate
+}
+
+// SetUUID sets the value of UUID.
+func (s *Contact) SetUUID(val OptString) {
+	s.UUID = val
+}
+
+// SetUserUUID sets the value of UserUUID.
+func (s *Contact) SetUserUUID(val OptString) {
+	s.UserUUID = val
+}
+
+// SetInstanceUUID sets the value of InstanceUUID.
+func (s *Contact) SetInstanceUUID(val OptString) {
+	s.InstanceUUID = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Contact) SetStatus(val OptString) {
+	s.Status = val
+}
+
+// SetNames sets the value of Names.
+func (s *Contact) SetNames(val *ContactNames) {
+	s.Names = val
+}
+
+// SetNamesSearch sets the value of NamesSearch.
+func (s *Contact) SetNamesSearch(val OptString) {
+	s.NamesSearch = val
+}
+
+// SetLast sets the value of Last.
+func (s *Contact) SetLast(val OptString) {
+	s.Last = val
+}
+
+// SetFirst sets the value of First.
+func (s *Contact) SetFirst(val OptString) {
+	s.First = val
+}
+
+// SetMiddle sets the value of Middle.
+func (s *Contact) SetMiddle(val OptString) {
+	s.Middle = val
+}
+
+// SetBirthday sets the value of Birthday.
+func (s *Contact) SetBirthday(val OptDateTime) {
+	s.Birthday = val
+}
+
+// SetBirthdayType sets the value of BirthdayType.
+func (s *Contact) SetBirthdayType(val OptString) {
+	s.BirthdayType = val
+}
+
+// SetSalary sets the value of Salary.
+func (s *Contact) SetSalary(val OptString) {
+	s.Salary = val
+}
+
+// SetSalaryData sets the value of SalaryData.
+func (s *Contact) SetSalaryData(val *ContactSalaryData) {
+	s.SalaryData = val
+}
+
+// SetLastPositions sets the value of LastPositions.
+func (s *Contact) SetLastPositions(val *ContactLastPositions) {
+	s.LastPositions = val
+}
+
+// SetLastPositionID sets the value of LastPositionID.
+func (s *Contact) SetLastPositionID(val OptInt) {
+	s.LastPositionID = val
+}
+
+// SetLastPositionCompanyID sets the value of LastPositionCompanyID.
+func (s *Contact) SetLastPositionCompanyID(val OptInt) {
+	s.LastPositionCompanyID = val
+}
+
+// SetLastPositionCompanyName sets the value of LastPositionCompanyName.
+func (s *Contact) SetLastPositionCompanyName(val OptString) {
+	s.LastPositionCompanyName = val
+}
+
+// SetLastPositionTitle sets the value of LastPositionTitle.
+func (s *Contact) SetLastPositionTitle(val OptString) {
+	s.LastPositionTitle = val
+}
+
+// SetLastPositionStartDate sets the value of LastPositionStartDate.
+func (s *Contact) SetLastPositionStartDate(val OptDateTime) {
+	s.LastPositionStartDate = val
+}
+
+// SetLastPositionEndDate sets the value of LastPositionEndDate.
+func (s *Contact) SetLastPositionEndDate(val OptDateTime) {
+	s.LastPositionEndDate = val
+}
+
+// SetLastPositionEndNow sets the value of LastPositionEndNow.
+func (s *Contact) SetLastPositionEndNow(val OptBool) {
+	s.LastPositionEndNow = val
+}
+
+// SetLastPositionDescription sets the value of LastPositionDescription.
+func (s *Contact) SetLastPositionDescription(val OptString) {
+	s.LastPositionDescription = val
+}
+
+// SetNoteSearch sets the value of NoteSearch.
+func (s *Contact) SetNoteSearch(val OptString) {
+	s.NoteSearch = val
+}
+
+// SetNoteKpiID sets the value of NoteKpiID.
+func (s *Contact) SetNoteKpiID(val *ContactNoteKpiID) {
+	s.NoteKpiID = val
+}
+
+// SetPhones sets the value of Phones.
+func (s *Contact) SetPhones(val *ContactPhones) {
+	s.Phones = val
+}
+
+// SetPhoneSearch sets the value of PhoneSearch.
+func (s *Contact) SetPhoneSearch(val OptString) {
+	s.PhoneSearch = val
+}
+
+// SetPhone1 sets the value of Phone1.
+func (s *Contact) SetPhone1(val OptString) {
+	s.Phone1 = val
+}
+
+// SetPhone1Type sets the value of Phone1Type.
+func (s *Contact) SetPhone1Type(val OptString) {
+	s.Phone1Type = val
+}
+
+// SetPhone1Country sets the value of Phone1Country.
+func (s *Contact) SetPhone1Country(val OptString) {
+	s.Phone1Country = val
+}
+
+// SetPhone2 sets the value of Phone2.
+func (s *Contact) SetPhone2(val OptString) {
+	s.Phone2 = val
+}
+
+// SetPhone2Type sets the value of Phone2Type.
+func (s *Contact) SetPhone2Type(val OptString) {
+	s.Phone2Type = val
+}
+
+// SetPhone2Country sets the value of Phone2Country.
+func (s *Contact) SetPhone2Country(val OptString) {
+	s.Phone2Country = val
+}
+
+// SetPhone3 sets the value of Phone3.
+func (s *Contact) SetPhone3(val OptString) {
+	s.Phone3 = val
+}
+
+// SetPhone3Type sets the value of Phone3Type.
+func (s *Contact) SetPhone3Type(val OptString) {
+	s.Phone3Type = val
+}
+
+// SetPhone3Country sets the value of Phone3Country.
+func (s *Contact) SetPhone3Country(val OptString) {
+	s.Phone3Country = val
+}
+
+// SetPhone4 sets the value of Phone4.
+func (s *Contact) SetPhone4(val OptString) {
+	s.Phone4 = val
+}
+
+// SetPhone4Type sets the value of Phone4Type.
+func (s *Contact) SetPhone4Type(val OptString) {
+	s.Phone4Type = val
+}
+
+// SetPhone4Country sets the value of Phone4Country.
+func (s *Contact) SetPhone4Country(val OptString) {
+	s.Phone4Country = val
+}
+
+// SetPhone5 sets the value of Phone5.
+func (s *Contact) SetPhone5(val OptString) {
+	s.Phone5 = val
+}
+
+// SetPhone5Type sets the value of Phone5Type.
+func (s *Contact) SetPhone5Type(val OptString) {
+	s.Phone5Type = val
+}
+
+// SetPhone5Country sets the value of Phone5Country.
+func (s *Contact) SetPhone5Country(val OptString) {
+	s.Phone5Country = val
+}
+
+// SetEmails sets the value of Emails.
+func (s *Contact) SetEmails(val *ContactEmails) {
+	s.Emails = val
+}
+
+// SetEmailSearch sets the value of EmailSearch.
+func (s *Contact) SetEmailSearch(val OptString) {
+	s.EmailSearch = val
+}
+
+// SetEmail1 sets the value of Email1.
+func (s *Contact) SetEmail1(val OptString) {
+	s.Email1 = val
+}
+
+// SetEmail1Type sets the value of Email1Type.
+func (s *Contact) SetEmail1Type(val OptString) {
+	s.Email1Type = val
+}
+
+// SetEmail2 sets the value of Email2.
+func (s *Contact) SetEmail2(val OptString) {
+	s.Email2 = val
+}
+
+// SetEmail2Type sets the value of Email2Type.
+func (s *Contact) SetEmail2Type(val OptString) {
+	s.Email2Type = val
+}
+
+// SetEmail3 sets the value of Email3.
+func (s *Contact) SetEmail3(val OptString) {
+	s.Email3 = val
+}
+
+// SetEmail3Type sets the value of Email3Type.
+func (s *Contact) SetEmail3Type(val OptString) {
+	s.Email3Type = val
+}
+
+// SetEmail4 sets the value of Email4.
+func (s *Contact) SetEmail4(val OptString) {
+	s.Email4 = val
+}
+
+// SetEmail4Type sets the value of Email4Type.
+func (s *Contact) SetEmail4Type(val OptString) {
+	s.Email4Type = val
+}
+
+// SetEmail5 sets the value of Email5.
+func (s *Contact) SetEmail5(val OptString) {
+	s.Email5 = val
+}
+
+// SetEmail5Type sets the value of Email5Type.
+func (s *Contact) SetEmail5Type(val OptString) {
+	s.Email5Type = val
+}
+
+// SetMessengers sets the value of Messengers.
+func (s *Contact) SetMessengers(val *ContactMessengers) {
+	s.Messengers = val
+}
+
+// SetMessengersSearch sets the value of MessengersSearch.
+func (s *Contact) SetMessengersSearch(val OptString) {
+	s.MessengersSearch = val
+}
+
+// SetSkypeUUID sets the value of SkypeUUID.
+func (s *Contact) SetSkypeUUID(val OptString) {
+	s.SkypeUUID = val
+}
+
+// SetSkype sets the value of Skype.
+func (s *Contact) SetSkype(val OptString) {
+	s.Skype = val
+}
+
+// SetWhatsappUUID sets the value of WhatsappUUID.
+func (s *Contact) SetWhatsappUUID(val OptString) {
+	s.WhatsappUUID = val
+}
+
+// SetWhatsapp sets the value of Whatsapp.
+func (s *Contact) SetWhatsapp(val OptString) {
+	s.Whatsapp = val
+}
+
+// SetTelegramUUID sets the value of TelegramUUID.
+func (s *Contact) SetTelegramUUID(val OptString) {
+	s.TelegramUUID = val
+}
+
+// SetTelegram sets the value of Telegram.
+func (s *Contact) SetTelegram(val OptString) {
+	s.Telegram = val
+}
+
+// SetWechatUUID sets the value of WechatUUID.
+func (s *Contact) SetWechatUUID(val OptString) {
+	s.WechatUUID = val
+}
+
+// SetWechat sets the value of Wechat.
+func (s *Contact) SetWechat(val OptString) {
+	s.Wechat = val
+}
+
+// SetLineUUID sets the value of LineUUID.
+func (s *Contact) SetLineUUID(val OptString) {
+	s.LineUUID = val
+}
+
+// SetLine sets the value of Line.
+func (s *Contact) SetLine(val OptString) {
+	s.Line = val
+}
+
+// SetSocials sets the value of Socials.
+func (s *Contact) SetSocials(val *ContactSocials) {
+	s.Socials = val
+}
+
+// SetSocialsSearch sets the value of SocialsSearch.
+func (s *Contact) SetSocialsSearch(val OptString) {
+	s.SocialsSearch = val
+}
+
+// SetLinkedinUUID sets the value of LinkedinUUID.
+func (s *Contact) SetLinkedinUUID(val OptString) {
+	s.LinkedinUUID = val
+}
+
+// SetLinkedinURL sets the value of LinkedinURL.
+func (s *Contact) SetLinkedinURL(val OptString) {
+	s.LinkedinURL = val
+}
+
+// SetFacebookUUID sets the value of FacebookUUID.
+func (s *Contact) SetFacebookUUID(val OptString) {
+	s.FacebookUUID = val
+}
+
+// SetFacebookURL sets the value of FacebookURL.
+func (s *Contact) SetFacebookURL(val OptString) {
+	s.FacebookURL = val
+}
+
+// SetTwitterUUID sets the value of TwitterUUID.
+func (s *Contact) SetTwitterUUID(val OptString) {
+	s.TwitterUUID = val
+}
+
+// SetTwitterURL sets the value of TwitterURL.
+func (s *Contact) SetTwitterURL(val OptString) {
+	s.TwitterURL = val
+}
+
+// SetGithubUUID sets the value of GithubUUID.
+func (s *Contact) SetGithubUUID(val OptString) {
+	s.GithubUUID = val
+}
+
+// SetGithubURL sets the value of GithubURL.
+func (s *Contact) SetGithubURL(val OptString) {
+	s.GithubURL = val
+}
+
+// SetVkUUID sets the value of VkUUID.
+func (s *Contact) SetVkUUID(val OptString) {
+	s.VkUUID = val
+}
+
+// SetVkURL sets the value of VkURL.
+func (s *Contact) SetVkURL(val OptString) {
+	s.VkURL = val
+}
+
+// SetOdnoUUID sets the value of OdnoUUID.
+func (s *Contact) SetOdnoUUID(val OptString) {
+	s.OdnoUUID = val
+}
+
+// SetOdnoURL sets the value of OdnoURL.
+func (s *Contact) SetOdnoURL(val OptString) {
+	s.OdnoURL = val
+}
+
+// SetHhruUUID sets the value of HhruUUID.
+func (s *Contact) SetHhruUUID(val OptString) {
+	s.HhruUUID = val
+}
+
+// SetHhruURL sets the value of HhruURL.
+func (s *Contact) SetHhruURL(val OptString) {
+	s.HhruURL = val
+}
+
+// SetHabrUUID sets the value of HabrUUID.
+func (s *Contact) SetHabrUUID(val OptString) {
+	s.HabrUUID = val
+}
+
+// SetHabrURL sets the value of HabrURL.
+func (s *Contact) SetHabrURL(val OptString) {
+	s.HabrURL = val
+}
+
+// SetMoikrugUUID sets the value of MoikrugUUID.
+func (s *Contact) SetMoikrugUUID(val OptString) {
+	s.MoikrugUUID = val
+}
+
+// SetMoikrugURL sets the value of MoikrugURL.
+func (s *Contact) SetMoikrugURL(val OptString) {
+	s.MoikrugURL = val
+}
+
+// SetInstagramUUID sets the value of InstagramUUID.
+func (s *Contact) SetInstagramUUID(val OptString) {
+	s.InstagramUUID = val
+}
+
+// SetInstagramURL sets the value of InstagramURL.
+func (s *Contact) SetInstagramURL(val OptString) {
+	s.InstagramURL = val
+}
+
+// SetSocial1UUID sets the value of Social1UUID.
+func (s *Contact) SetSocial1UUID(val OptString) {
+	s.Social1UUID = val
+}
+
+// SetSocial1URL sets the value of Social1URL.
+func (s *Contact) SetSocial1URL(val OptString) {
+	s.Social1URL = val
+}
+
+// SetSocial1Type sets the value of Social1Type.
+func (s *Contact) SetSocial1Type(val OptString) {
+	s.Social1Type = val
+}
+
+// SetSocial2UUID sets the value of Social2UUID.
+func (s *Contact) SetSocial2UUID(val OptString) {
+	s.Social2UUID = val
+}
+
+// SetSocial2URL sets the value of Social2URL.
+func (s *Contact) SetSocial2URL(val OptString) {
+	s.Social2URL = val
+}
+
+// SetSocial2Type sets the value of Social2Type.
+func (s *Contact) SetSocial2Type(val OptString) {
+	s.Social2Type = val
+}
+
+// SetSocial3UUID sets the value of Social3UUID.
+func (s *Contact) SetSocial3UUID(val OptString) {
+	s.Social3UUID = val
+}
+
+// SetSocial3URL sets the value of Social3URL.
+func (s *Contact) SetSocial3URL(val OptString) {
+	s.Social3URL = val
+}
+
+// SetSocial3Type sets the value of Social3Type.
+func (s *Contact) SetSocial3Type(val OptString) {
+	s.Social3Type = val
+}
+
+// SetSocial4UUID sets the value of Social4UUID.
+func (s *Contact) SetSocial4UUID(val OptString) {
+	s.Social4UUID = val
+}
+
+// SetSocial4URL sets the value of Social4URL.
+func (s *Contact) SetSocial4URL(val OptString) {
+	s.Social4URL = val
+}
+
+// SetSocial4Type sets the value of Social4Type.
+func (s *Contact) SetSocial4Type(val OptString) {
+	s.Social4Type = val
+}
+
+// SetSocial5UUID sets the value of Social5UUID.
+func (s *Contact) SetSocial5UUID(val OptString) {
+	s.Social5UUID = val
+}
+
+// SetSocial5URL sets the value of Social5URL.
+func (s *Contact) SetSocial5URL(val OptString) {
+	s.Social5URL = val
+}
+
+// SetSocial5Type sets the value of Social5Type.
+func (s *Contact) SetSocial5Type(val OptString) {
+	s.Social5Type = val
+}
+
+// SetSocial6UUID sets the value of Social6UUID.
+func (s *Contact) SetSocial6UUID(val OptString) {
+	s.Social6UUID = val
+}
+
+// SetSocial6URL sets the value of Social6URL.
+func (s *Contact) SetSocial6URL(val OptString) {
+	s.Social6URL = val
+}
+
+// SetSocial6Type sets the value of Social6Type.
+func (s *Contact) SetSocial6Type(val OptString) {
+	s.Social6Type = val
+}
+
+// SetSocial7UUID sets the value of Social7UUID.
+func (s *Contact) SetSocial7UUID(val OptString) {
+	s.Social7UUID = val
+}
+
+// SetSocial7URL sets the value of Social7URL.
+func (s *Contact) SetSocial7URL(val OptString) {
+	s.Social7URL = val
+}
+
+// SetSocial7Type sets the value of Social7Type.
+func (s *Contact) SetSocial7Type(val OptString) {
+	s.Social7Type = val
+}
+
+// SetSocial8UUID sets the value of Social8UUID.
+func (s *Contact) SetSocial8UUID(val OptString) {
+	s.Social8UUID = val
+}
+
+// SetSocial8URL sets the value of Social8URL.
+func (s *Contact) SetSocial8URL(val OptString) {
+	s.Social8URL = val
+}
+
+// SetSocial8Type sets the value of Social8Type.
+func (s *Contact) SetSocial8Type(val OptString) {
+	s.Social8Type = val
+}
+
+// SetSocial9UUID sets the value of Social9UUID.
+func (s *Contact) SetSocial9UUID(val OptString) {
+	s.Social9UUID = val
+}
+
+// SetSocial9URL sets the value of Social9URL.
+func (s *Contact) SetSocial9URL(val OptString) {
+	s.Social9URL = val
+}
+
+// SetSocial9Type sets the value of Social9Type.
+func (s *Contact) SetSocial9Type(val OptString) {
+	s.Social9Type = val
+}
+
+// SetTrackingSource sets the value of TrackingSource.
+func (s *Contact) SetTrackingSource(val OptString) {
+	s.TrackingSource = val
+}
+
+// SetTrackingSlug sets the value of TrackingSlug.
+func (s *Contact) SetTrackingSlug(val OptString) {
+	s.TrackingSlug = val
+}
+
+// SetCachedImg sets the value of CachedImg.
+func (s *Contact) SetCachedImg(val OptString) {
+	s.CachedImg = val
+}
+
+// SetCachedImgData sets the value of CachedImgData.
+func (s *Contact) SetCachedImgData(val *ContactCachedImgData) {
+	s.CachedImgData = val
+}
+
+// SetCrawl sets the value of Crawl.
+func (s *Contact) SetCrawl(val *ContactCrawl) {
+	s.Crawl = val
+}
+
+// SetDuplicateUserID sets the value of DuplicateUserID.
+func (s *Contact) SetDuplicateUserID(val OptString) {
+	s.DuplicateUserID = val
+}
+
+// SetDuplicateAlternativeID sets the value of DuplicateAlternativeID.
+func (s *Contact) SetDuplicateAlternativeID(val OptString) {
+	s.DuplicateAlternativeID = val
+}
+
+// SetDuplicateReportDate sets the value of DuplicateReportDate.
+func (s *Contact) SetDuplicateReportDate(val OptDateTime) {
+	s.DuplicateReportDate = val
+}
+
+// SetEntryDate sets the value of EntryDate.
+func (s *Contact) SetEntryDate(val OptDateTime) {
+	s.EntryDate = val
+}
+
+// SetEditDate sets the value of EditDate.
+func (s *Contact) SetEditDate(val OptDateTime) {
+	s.EditDate = val
+}
+
+// SetLastKpiEntryDate sets the value of LastKpiEntryDate.
+func (s *Contact) SetLastKpiEntryDate(val OptDateTime) {
+	s.LastKpiEntryDate = val
+}
+
+type ContactCachedImgData struct{}
+
+type ContactCrawl struct{}
+
+type ContactEmails struct{}
+
+type ContactLastPositions struct{}
+
+type ContactMessengers struct{}
+
+type ContactNames struct{}
+
+type ContactNoteKpiID struct{}
+
+type ContactPhones struct{}
+
+type ContactSalaryData struct{}
+
+type ContactSocials struct{}
+
+// Ref: #
+type Datasource struct {
+	UUID      OptString `json:"uuid"`
+	UserUUID  string    `json:"user_uuid"`
+	Type      string    `json:"type"`
+	Name      string    `json:"name"`
+	IsEnabled OptBool   `json:"is_enabled"`
+	Provider  string    `json:"provider"`
+	// The OAuth2 grant is revoked, expired or lacks scopes, log in again to resume the schedulers.
+	NeedsReauth  OptBool     `json:"needs_reauth"`
+	ReauthReason OptString   `json:"reauth_reason"`
+	CreatedAt    OptDateTime `json:"created_at"`
+	UpdatedAt    OptDateTime `json:"updated_at"`
+}
+
+// GetUUID returns the value of UUID.
+func (s *Datasource) GetUUID() OptString {
+	return s.UUID
 }
 
-// GetSocial1URL returns the value of Social1URL.
-func (s *Contact) GetSocial1URL() OptString {
-	return s.Social1URL
+// GetUserUUID returns the value of UserUUID.
+func (s *Datasource) GetUserUUID() string {
+	return s.UserUUID
 }
 
-// GetSocial1Type returns the value of Social1Type.
-func (s *Contact) GetSocial1Type() OptString {
-	return s.Social1Type
+// GetType returns the value of Type.
+func (s *Datasource) GetType() string {
+	return s.Type
 }
 
-// GetSocial2UUID returns the value of Social2UUID.
-func (s *Contact) GetSocial2UUID() OptString {
-	return s.Social2UUID
+// GetName returns the value of Name.
+func (s *Datasource) GetName() string {
+	return s.Name
 }
 
-// GetSocial2URL returns the value of Social2URL.
-func (s *Contact) GetSocial2URL() OptString {
-	return s.Social2URL
+// GetIsEnabled returns the value of IsEnabled.
+func (s *Datasource) GetIsEnabled() OptBool {
+	return s.IsEnabled
 }
 
-// GetSocial2Type returns the value of Social2Type.
-func (s *Contact) GetSocial2Type() OptString {
-	return s.Social2Type
+// GetProvider returns the value of Provider.
+func (s *Datasource) GetProvider() string {
+	return s.Provider
 }
 
-// GetSocial3UUID returns the value of Social3UUID.
-func (s *Contact) GetSocial3UUID() OptString {
-	return s.Social3UUID
+// GetNeedsReauth returns the value of NeedsReauth.
+func (s *Datasource) GetNeedsReauth() OptBool {
+	return s.NeedsReauth
 }
 
-// GetSocial3URL returns the value of Social3URL.
-func (s *Contact) GetSocial3URL() OptString {
-	return s.Social3URL
+// GetReauthReason returns the value of ReauthReason.
+func (s *Datasource) GetReauthReason() OptString {
+	return s.ReauthReason
 }
 
-// GetSocial3Type returns the value of Social3Type.
-func (s *Contact) GetSocial3Type() OptString {
-	return s.Social3Type
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Datasource) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
 }
 
-// GetSocial4UUID returns the value of Social4UUID.
-func (s *Contact) GetSocial4UUID() OptString {
-	return s.Social4UUID
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Datasource) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
 }
 
-// GetSocial4URL returns the value of Social4URL.
-func (s *Contact) GetSocial4URL() OptString {
-	return s.Social4URL
+// SetUUID sets the value of UUID.
+func (s *Datasource) SetUUID(val OptString) {
+	s.UUID = val
 }
 
-// GetSocial4Type returns the value of Social4Type.
-func (s *Contact) GetSocial4Type() OptString {
-	return s.Social4Type
+// SetUserUUID sets the value of UserUUID.
+func (s *Datasource) SetUserUUID(val string) {
+	s.UserUUID = val
 }
 
-// GetSocial5UUID returns the value of Social5UUID.
-func (s *Contact) GetSocial5UUID() OptString {
-	return s.Social5UUID
+// SetType sets the value of Type.
+func (s *Datasource) SetType(val string) {
+	s.Type = val
 }
 
-// GetSocial5URL returns the value of Social5URL.
-func (s *Contact) GetSocial5URL() OptString {
-	return s.Social5URL
+// SetName sets the value of Name.
+func (s *Datasource) SetName(val string) {
+	s.Name = val
 }
 
-// GetSocial5Type returns the value of Social5Type.
-func (s *Contact) GetSocial5Type() OptString {
-	return s.Social5Type
+// SetIsEnabled sets the value of IsEnabled.
+func (s *Datasource) SetIsEnabled(val OptBool) {
+	s.IsEnabled = val
 }
 
-// GetSocial6UUID returns the value of Social6UUID.
-func (s *Contact) GetSocial6UUID() OptString {
-	return s.Social6UUID
+// SetProvider sets the value of Provider.
+func (s *Datasource) SetProvider(val string) {
+	s.Provider = val
 }
 
-// GetSocial6URL returns the value of Social6URL.
-func (s *Contact) GetSocial6URL() OptString {
-	return s.Social6URL
+// SetNeedsReauth sets the value of NeedsReauth.
+func (s *Datasource) SetNeedsReauth(val OptBool) {
+	s.NeedsReauth = val
 }
 
-// GetSocial6Type returns the value of Social6Type.
-func (s *Contact) GetSocial6Type() OptString {
-	return s.Social6Type
+// SetReauthReason sets the value of ReauthReason.
+func (s *Datasource) SetReauthReason(val OptString) {
+	s.ReauthReason = val
 }
 
-// GetSocial7UUID returns the value of Social7UUID.
-func (s *Contact) GetSocial7UUID() OptString {
-	return s.Social7UUID
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Datasource) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// GetSocial7URL returns the value of Social7URL.
-func (s *Contact) GetSocial7URL() OptString {
-	return s.Social7URL
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Datasource) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
 }
 
-// GetSocial7Type returns the value of Social7Type.
-func (s *Contact) GetSocial7Type() OptString {
-	return s.Social7Type
+// Ref: #
+type DatasourceEmail struct {
+	UUID             OptString `json:"uuid"`
+	UserUUID         string    `json:"user_uuid"`
+	Email            string    `json:"email"`
+	Name             string    `json:"name"`
+	IsEnabled        OptBool   `json:"is_enabled"`
+	Provider         string    `json:"provider"`
+	OAuth2ClientID   OptString `json:"oauth2_client_id"`
+	OAuth2ClientUUID OptString `json:"oauth2_client_uuid"`
+	OAuth2TokenUUID  OptString `json:"oauth2_token_uuid"`
+	ImapServer       string    `json:"imap_server"`
+	SMTPServer       string    `json:"smtp_server"`
+	SMTPTLS          OptBool   `json:"smtp_tls"`
+	// Mailbox password. Encrypted at rest and returned as '********'; sending '********' back on update
+	// keeps the stored value.
+	Password  string      `json:"password"`
+	CreatedAt OptDateTime `json:"created_at"`
+	UpdatedAt OptDateTime `json:"updated_at"`
 }
 
-// GetSocial8UUID returns the value of Social8UUID.
-func (s *Contact) GetSocial8UUID() OptString {
-	return s.Social8UUID
+// GetUUID returns the value of UUID.
+func (s *DatasourceEmail) GetUUID() OptString {
+	return s.UUID
 }
 
-// GetSocial8URL returns the value of Social8URL.
-func (s *Contact) GetSocial8URL() OptString {
-	return s.Social8URL
+// GetUserUUID returns the value of UserUUID.
+func (s *DatasourceEmail) GetUserUUID() string {
+	return s.UserUUID
 }
 
-// GetSocial8Type returns the value of Social8Type.
-func (s *Contact) GetSocial8Type() OptString {
-	return s.Social8Type
+// GetEmail returns the value of Email.
+func (s *DatasourceEmail) GetEmail() string {
+	return s.Email
 }
 
-// GetSocial9UUID returns the value of Social9UUID.
-func (s *Contact) GetSocial9UUID() OptString {
-	return s.Social9UUID
+// GetName returns the value of Name.
+func (s *DatasourceEmail) GetName() string {
+	return s.Name
+}
+
+// GetIsEnabled returns the value of IsEnabled.
+func (s *DatasourceEmail) GetIsEnabled() OptBool {
+	return s.IsEnabled
+}
+
+// GetProvider returns the value of Provider.
+func (s *DatasourceEmail) GetProvider() string {
+	return s.Provider
+}
+
+// GetOAuth2ClientID returns the value of OAuth2ClientID.
+func (s *DatasourceEmail) GetOAuth2ClientID() OptString {
+	return s.OAuth2ClientID
+}
+
+// GetOAuth2ClientUUID returns the value of OAuth2ClientUUID.
+func (s *DatasourceEmail) GetOAuth2ClientUUID() OptString {
+	return s.OAuth2ClientUUID
+}
+
+// GetOAuth2TokenUUID returns the value of OAuth2TokenUUID.
+func (s *DatasourceEmail) GetOAuth2TokenUUID() OptString {
+	return s.OAuth2TokenUUID
+}
+
+// GetImapServer returns the value of ImapServer.
+func (s *DatasourceEmail) GetImapServer() string {
+	return s.ImapServer
+}
+
+// GetSMTPServer returns the value of SMTPServer.
+func (s *DatasourceEmail) GetSMTPServer() string {
+	return s.SMTPServer
+}
+
+// GetSMTPTLS returns the value of SMTPTLS.
+func (s *DatasourceEmail) GetSMTPTLS() OptBool {
+	return s.SMTPTLS
+}
+
+// GetPassword returns the value of Password.
+func (s *DatasourceEmail) GetPassword() string {
+	return s.Password
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *DatasourceEmail) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *DatasourceEmail) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetUUID sets the value of UUID.
+func (s *DatasourceEmail) SetUUID(val OptString) {
+	s.UUID = val
+}
+
+// SetUserUUID sets the value of UserUUID.
+func (s *DatasourceEmail) SetUserUUID(val string) {
+	s.UserUUID = val
+}
+
+// SetEmail sets the value of Email.
+func (s *DatasourceEmail) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetName sets the value of Name.
+func (s *DatasourceEmail) SetName(val string) {
+	s.Name = val
+}
+
+// SetIsEnabled sets the value of IsEnabled.
+func (s *DatasourceEmail) SetIsEnabled(val OptBool) {
+	s.IsEnabled = val
+}
+
+// SetProvider sets the value of Provider.
+func (s *DatasourceEmail) SetProvider(val string) {
+	s.Provider = val
+}
+
+// SetOAuth2ClientID sets the value of OAuth2ClientID.
+func (s *DatasourceEmail) SetOAuth2ClientID(val OptString) {
+	s.OAuth2ClientID = val
 }
 
-// GetSocial9URL returns the value of Social9URL.
-func (s *Contact) GetSocial9URL() OptString {
-	return s.Social9URL
+// SetOAuth2ClientUUID sets the value of OAuth2ClientUUID.
+func (s *DatasourceEmail) SetOAuth2ClientUUID(val OptString) {
+	s.OAuth2ClientUUID = val
 }
 
-// GetSocial9Type returns the value of Social9Type.
-func (s *Contact) GetSocial9Type() OptString {
-	return s.Social9Type
+// SetOAuth2TokenUUID sets the value of OAuth2TokenUUID.
+func (s *DatasourceEmail) SetOAuth2TokenUUID(val OptString) {
+	s.OAuth2TokenUUID = val
 }
 
-// GetTrackingSource returns the value of TrackingSource.
-func (s *Contact) GetTrackingSource() OptString {
-	return s.TrackingSource
+// SetImapServer sets the value of ImapServer.
+func (s *DatasourceEmail) SetImapServer(val string) {
+	s.ImapServer = val
 }
 
-// GetTrackingSlug returns the value of TrackingSlug.
-func (s *Contact) GetTrackingSlug() OptString {
-	return s.TrackingSlug
+// SetSMTPServer sets the value of SMTPServer.
+func (s *DatasourceEmail) SetSMTPServer(val string) {
+	s.SMTPServer = val
 }
 
-// GetCachedImg returns the value of CachedImg.
-func (s *Contact) GetCachedImg() OptString {
-	return s.CachedImg
+// SetSMTPTLS sets the value of SMTPTLS.
+func (s *DatasourceEmail) SetSMTPTLS(val OptBool) {
+	s.SMTPTLS = val
 }
 
-// GetCachedImgData returns the value of CachedImgData.
-func (s *Contact) GetCachedImgData() *ContactCachedImgData {
-	return s.CachedImgData
+// SetPassword sets the value of Password.
+func (s *DatasourceEmail) SetPassword(val string) {
+	s.Password = val
 }
 
-// GetCrawl returns the value of Crawl.
-func (s *Contact) GetCrawl() *ContactCrawl {
-	return s.Crawl
+// SetCreatedAt sets the value of CreatedAt.
+func (s *DatasourceEmail) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// GetDuplicateUserID returns the value of DuplicateUserID.
-func (s *Contact) GetDuplicateUserID() OptString {
-	return s.DuplicateUserID
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *DatasourceEmail) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
 }
 
-// GetDuplicateAlternativeID returns the value of DuplicateAlternativeID.
-func (s *Contact) GetDuplicateAlternativeID() OptString {
-	return s.DuplicateAlternativeID
+// DatasourceEmailDeleteOK is response for DatasourceEmailDelete operation.
+type DatasourceEmailDeleteOK struct{}
+
+// Microsoft 365 / Outlook mailbox read and sent through Microsoft Graph.
+// Ref: #
+type DatasourceEmailGraph struct {
+	UUID      OptString `json:"uuid"`
+	UserUUID  string    `json:"user_uuid"`
+	Email     string    `json:"email"`
+	Name      string    `json:"name"`
+	IsEnabled OptBool   `json:"is_enabled"`
+	// OAuth2 provider of the client, always microsoft.
+	Provider OptString `json:"provider"`
+	// Identifier of the Microsoft OAuth2 client bound to this datasource.
+	OAuth2ClientUUID string `json:"oauth2_client_uuid"`
+	// Mail folders to fetch, well-known names (inbox, sentitems, archive) or folder ids. Defaults to
+	// inbox.
+	Folders []string `json:"folders"`
+	// The OAuth2 grant is revoked, expired or lacks scopes, log in again to resume the schedulers.
+	NeedsReauth  OptBool     `json:"needs_reauth"`
+	ReauthReason OptString   `json:"reauth_reason"`
+	CreatedAt    OptDateTime `json:"created_at"`
+	UpdatedAt    OptDateTime `json:"updated_at"`
 }
 
-// GetDuplicateReportDate returns the value of DuplicateReportDate.
-func (s *Contact) GetDuplicateReportDate() OptDateTime {
-	return s.DuplicateReportDate
+// GetUUID returns the value of UUID.
+func (s *DatasourceEmailGraph) GetUUID() OptString {
+	return s.UUID
 }
 
-// GetEntryDate returns the value of EntryDate.
-func (s *Contact) GetEntryDate() OptDateTime {
-	return s.EntryDate
+// GetUserUUID returns the value of UserUUID.
+func (s *DatasourceEmailGraph) GetUserUUID() string {
+	return s.UserUUID
 }
 
-// GetEditDate returns the value of EditDate.
-func (s *Contact) GetEditDate() OptDateTime {
-	return s.EditDate
+// GetEmail returns the value of Email.
+func (s *DatasourceEmailGraph) GetEmail() string {
+	return s.Email
 }
 
-// GetLastKpiEntryDate returns the value of LastKpiEntryDate.
-func (s *Contact) GetLastKpiEntryDate() OptDateTime {
-	return s.LastKpiEntryDate
+// GetName returns the value of Name.
+func (s *DatasourceEmailGraph) GetName() string {
+	return s.Name
 }
 
-// SetUUID sets the value of UUID.
-func (s *Contact) SetUUID(val OptString) {
-	s.UUID = val
+// GetIsEnabled returns the value of IsEnabled.
+func (s *DatasourceEmailGraph) GetIsEnabled() OptBool {
+	return s.IsEnabled
 }
 
-// SetUserUUID sets the value of UserUUID.
-func (s *Contact) SetUserUUID(val OptString) {
-	s.UserUUID = val
+// GetProvider returns the value of Provider.
+func (s *DatasourceEmailGraph) GetProvider() OptString {
+	return s.Provider
 }
 
-// SetInstanceUUID sets the value of InstanceUUID.
-func (s *Contact) SetInstanceUUID(val OptString) {
-	s.InstanceUUID = val
+// GetOAuth2ClientUUID returns the value of OAuth2ClientUUID.
+func (s *DatasourceEmailGraph) GetOAuth2ClientUUID() string {
+	return s.OAuth2ClientUUID
 }
 
-// SetStatus sets the value of Status.
-func (s *Contact) SetStatus(val OptString) {
-	s.Status = val
+// GetFolders returns the value of Folders.
+func (s *DatasourceEmailGraph) GetFolders() []string {
+	return s.Folders
 }
 
-// SetNames sets the value of Names.
-func (s *Contact) SetNames(val *ContactNames) {
-	s.Names = val
+// GetNeedsReauth returns the value of NeedsReauth.
+func (s *DatasourceEmailGraph) GetNeedsReauth() OptBool {
+	return s.NeedsReauth
 }
 
-// SetNamesSearch sets the value of NamesSearch.
-func (s *Contact) SetNamesSearch(val OptString) {
-	s.NamesSearch = val
+// GetReauthReason returns the value of ReauthReason.
+func (s *DatasourceEmailGraph) GetReauthReason() OptString {
+	return s.ReauthReason
 }
 
-// SetLast sets the value of Last.
-func (s *Contact) SetLast(val OptString) {
-	s.Last = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *DatasourceEmailGraph) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
 }
 
-// SetFirst sets the value of First.
-func (s *Contact) SetFirst(val OptString) {
-	s.First = val
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *DatasourceEmailGraph) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
 }
 
-// SetMiddle sets the value of Middle.
-func (s *Contact) SetMiddle(val OptString) {
-	s.Middle = val
+// SetUUID sets the value of UUID.
+func (s *DatasourceEmailGraph) SetUUID(val OptString) {
+	s.UUID = val
 }
 
-// SetBirthday sets the value of Birthday.
-func (s *Contact) SetBirthday(val OptDateTime) {
-	s.Birthday = val
+// SetUserUUID sets the value of UserUUID.
+func (s *DatasourceEmailGraph) SetUserUUID(val string) {
+	s.UserUUID = val
 }
 
-// SetBirthdayType sets the value of BirthdayType.
-func (s *Contact) SetBirthdayType(val OptString) {
-	s.BirthdayType = val
+// SetEmail sets the value of Email.
+func (s *DatasourceEmailGraph) SetEmail(val string) {
+	s.Email = val
 }
 
-// SetSalary sets the value of Salary.
-func (s *Contact) SetSalary(val OptString) {
-	s.Salary = val
+// SetName sets the value of Name.
+func (s *DatasourceEmailGraph) SetName(val string) {
+	s.Name = val
 }
 
-// SetSalaryData sets the value of SalaryData.
-func (s *Contact) SetSalaryData(val *ContactSalaryData) {
-	s.SalaryData = val
+// SetIsEnabled sets the value of IsEnabled.
+func (s *DatasourceEmailGraph) SetIsEnabled(val OptBool) {
+	s.IsEnabled = val
 }
 
-// SetLastPositions sets the value of LastPositions.
-func (s *Contact) SetLastPositions(val *ContactLastPositions) {
-	s.LastPositions = val
+// SetProvider sets the value of Provider.
+func (s *DatasourceEmailGraph) SetProvider(val OptString) {
+	s.Provider = val
 }
 
-// SetLastPositionID sets the value of LastPositionID.
-func (s *Contact) SetLastPositionID(val OptInt) {
-	s.LastPositionID = val
+// SetOAuth2ClientUUID sets the value of OAuth2ClientUUID.
+func (s *DatasourceEmailGraph) SetOAuth2ClientUUID(val string) {
+	s.OAuth2ClientUUID = val
 }
 
-// SetLastPositionCompanyID sets the value of LastPositionCompanyID.
-func (s *Contact) SetLastPositionCompanyID(val OptInt) {
-	s.LastPositionCompanyID = val
+// SetFolders sets the value of Folders.
+func (s *DatasourceEmailGraph) SetFolders(val []string) {
+	s.Folders = val
 }
 
-// SetLastPositionCompanyName sets the value of LastPositionCompanyName.
-func (s *Contact) SetLastPositionCompanyName(val OptString) {
-	s.LastPositionCompanyName = val
+// SetNeedsReauth sets the value of NeedsReauth.
+func (s *DatasourceEmailGraph) SetNeedsReauth(val OptBool) {
+	s.NeedsReauth = val
 }
 
-// SetLastPositionTitle sets the value of LastPositionTitle.
-func (s *Contact) SetLastPositionTitle(val OptString) {
-	s.LastPositionTitle = val
+// SetReauthReason sets the value of ReauthReason.
+func (s *DatasourceEmailGraph) SetReauthReason(val OptString) {
+	s.ReauthReason = val
 }
 
-// SetLastPositionStartDate sets the value of LastPositionStartDate.
-func (s *Contact) SetLastPositionStartDate(val OptDateTime) {
-	s.LastPositionStartDate = val
+// SetCreatedAt sets the value of CreatedAt.
+func (s *DatasourceEmailGraph) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// SetLastPositionEndDate sets the value of LastPositionEndDate.
-func (s *Contact) SetLastPositionEndDate(val OptDateTime) {
-	s.LastPositionEndDate = val
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *DatasourceEmailGraph) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
 }
 
-// SetLastPositionEndNow sets the value of LastPositionEndNow.
-func (s *Contact) SetLastPositionEndNow(val OptBool) {
-	s.LastPositionEndNow = val
+// DatasourceEmailGraphDeleteOK is response for DatasourceEmailGraphDelete operation.
+type DatasourceEmailGraphDeleteOK struct{}
+
+// DatasourceEmailGraphSendAccepted is response for DatasourceEmailGraphSend operation.
+type DatasourceEmailGraphSendAccepted struct{}
+
+// OAuth2‑enabled email datasource object representation.
+// Ref: #
+type DatasourceEmailOAuth struct {
+	UUID      OptString `json:"uuid"`
+	UserUUID  string    `json:"user_uuid"`
+	Email     string    `json:"email"`
+	Name      string    `json:"name"`
+	IsEnabled OptBool   `json:"is_enabled"`
+	Provider  string    `json:"provider"`
+	// Identifier of the OAuth2 client bound to this datasource.
+	OAuth2ClientUUID string `json:"oauth2_client_uuid"`
+	// Identifier of the linked OAuth2 token (set after successful auth flow).
+	OAuth2TokenUUID OptString `json:"oauth2_token_uuid"`
+	// The OAuth2 grant is revoked, expired or lacks scopes, log in again to resume the schedulers.
+	NeedsReauth  OptBool     `json:"needs_reauth"`
+	ReauthReason OptString   `json:"reauth_reason"`
+	CreatedAt    OptDateTime `json:"created_at"`
+	UpdatedAt    OptDateTime `json:"updated_at"`
 }
 
-// SetLastPositionDescription sets the value of LastPositionDescription.
-func (s *Contact) SetLastPositionDescription(val OptString) {
-	s.LastPositionDescription = val
+// GetUUID returns the value of UUID.
+func (s *DatasourceEmailOAuth) GetUUID() OptString {
+	return s.UUID
 }
 
-// SetNoteSearch sets the value of NoteSearch.
-func (s *Contact) SetNoteSearch(val OptString) {
-	s.NoteSearch = val
+// GetUserUUID returns the value of UserUUID.
+func (s *DatasourceEmailOAuth) GetUserUUID() string {
+	return s.UserUUID
 }
 
-// SetNoteKpiID sets the value of NoteKpiID.
-func (s *Contact) SetNoteKpiID(val *ContactNoteKpiID) {
-	s.NoteKpiID = val
+// GetEmail returns the value of Email.
+func (s *DatasourceEmailOAuth) GetEmail() string {
+	return s.Email
 }
 
-// SetPhones sets the value of Phones.
-func (s *Contact) SetPhones(val *ContactPhones) {
-	s.Phones = val
+// GetName returns the value of Name.
+func (s *DatasourceEmailOAuth) GetName() string {
+	return s.Name
 }
 
-// SetPhoneSearch sets the value of PhoneSearch.
-func (s *Contact) SetPhoneSearch(val OptString) {
-	s.PhoneSearch = val
+// GetIsEnabled returns the value of IsEnabled.
+func (s *DatasourceEmailOAuth) GetIsEnabled() OptBool {
+	return s.IsEnabled
 }
 
-// SetPhone1 sets the value of Phone1.
-func (s *Contact) SetPhone1(val OptString) {
-	s.Phone1 = val
+// GetProvider returns the value of Provider.
+func (s *DatasourceEmailOAuth) GetProvider() string {
+	return s.Provider
 }
 
-// SetPhone1Type sets the value of Phone1Type.
-func (s *Contact) SetPhone1Type(val OptString) {
-	s.Phone1Type = val
+// GetOAuth2ClientUUID returns the value of OAuth2ClientUUID.
+func (s *DatasourceEmailOAuth) GetOAuth2ClientUUID() string {
+	return s.OAuth2ClientUUID
 }
 
-// SetPhone1Country sets the value of Phone1Country.
-func (s *Contact) SetPhone1Country(val OptString) {
-	s.Phone1Country = val
+// GetOAuth2TokenUUID returns the value of OAuth2TokenUUID.
+func (s *DatasourceEmailOAuth) GetOAuth2TokenUUID() OptString {
+	return s.OAuth2TokenUUID
 }
 
-// SetPhone2 sets the value of Phone2.
-func (s *Contact) SetPhone2(val OptString) {
-	s.Phone2 = val
+// GetNeedsReauth returns the value of NeedsReauth.
+func (s *DatasourceEmailOAuth) GetNeedsReauth() OptBool {
+	return s.NeedsReauth
 }
 
-// SetPhone2Type sets the value of Phone2Type.
-func (s *Contact) SetPhone2Type(val OptString) {
-	s.Phone2Type = val
+// GetReauthReason returns the value of ReauthReason.
+func (s *DatasourceEmailOAuth) GetReauthReason() OptString {
+	return s.ReauthReason
 }
 
-// SetPhone2Country sets the value of Phone2Country.
-func (s *Contact) SetPhone2Country(val OptString) {
-	s.Phone2Country = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *DatasourceEmailOAuth) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
 }
 
-// SetPhone3 sets the value of Phone3.
-func (s *Contact) SetPhone3(val OptString) {
-	s.Phone3 = val
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *DatasourceEmailOAuth) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
 }
 
-// SetPhone3Type sets the value of Phone3Type.
-func (s *Contact) SetPhone3Type(val OptString) {
-	s.Phone3Type = val
+// SetUUID sets the value of UUID.
+func (s *DatasourceEmailOAuth) SetUUID(val OptString) {
+	s.UUID = val
 }
 
-// SetPhone3Country sets the value of Phone3Country.
-func (s *Contact) SetPhone3Country(val OptString) {
-	s.Phone3Country = val
+// SetUserUUID sets the value of UserUUID.
+func (s *DatasourceEmailOAuth) SetUserUUID(val string) {
+	s.UserUUID = val
 }
 
-// SetPhone4 sets the value of Phone4.
-func (s *Contact) SetPhone4(val OptString) {
-	s.Phone4 = val
+// SetEmail sets the value of Email.
+func (s *DatasourceEmailOAuth) SetEmail(val string) {
+	s.Email = val
 }
 
-// SetPhone4Type sets the value of Phone4Type.
-func (s *Contact) SetPhone4Type(val OptString) {
-	s.Phone4Type = val
+// SetName sets the value of Name.
+func (s *DatasourceEmailOAuth) SetName(val string) {
+	s.Name = val
 }
 
-// SetPhone4Country sets the value of Phone4Country.
-func (s *Contact) SetPhone4Country(val OptString) {
-	s.Phone4Country = val
+// SetIsEnabled sets the value of IsEnabled.
+func (s *DatasourceEmailOAuth) SetIsEnabled(val OptBool) {
+	s.IsEnabled = val
 }
 
-// SetPhone5 sets the value of Phone5.
-func (s *Contact) SetPhone5(val OptString) {
-	s.Phone5 = val
+// SetProvider sets the value of Provider.
+func (s *DatasourceEmailOAuth) SetProvider(val string) {
+	s.Provider = val
 }
 
-// SetPhone5Type sets the value of Phone5Type.
-func (s *Contact) SetPhone5Type(val OptString) {
-	s.Phone5Type = val
+// SetOAuth2ClientUUID sets the value of OAuth2ClientUUID.
+func (s *DatasourceEmailOAuth) SetOAuth2ClientUUID(val string) {
+	s.OAuth2ClientUUID = val
 }
 
-// SetPhone5Country sets the value of Phone5Country.
-func (s *Contact) SetPhone5Country(val OptString) {
-	s.Phone5Country = val
+// SetOAuth2TokenUUID sets the value of OAuth2TokenUUID.
+func (s *DatasourceEmailOAuth) SetOAuth2TokenUUID(val OptString) {
+	s.OAuth2TokenUUID = val
 }
 
-// SetEmails sets the value of Emails.
-func (s *Contact) SetEmails(val *ContactEmails) {
-	s.Emails = val
+// SetNeedsReauth sets the value of NeedsReauth.
+func (s *DatasourceEmailOAuth) SetNeedsReauth(val OptBool) {
+	s.NeedsReauth = val
 }
 
-// SetEmailSearch sets the value of EmailSearch.
-func (s *Contact) SetEmailSearch(val OptString) {
-	s.EmailSearch = val
+// SetReauthReason sets the value of ReauthReason.
+func (s *DatasourceEmailOAuth) SetReauthReason(val OptString) {
+	s.ReauthReason = val
 }
 
-// SetEmail1 sets the value of Email1.
-func (s *Contact) SetEmail1(val OptString) {
-	s.Email1 = val
+// SetCreatedAt sets the value of CreatedAt.
+func (s *DatasourceEmailOAuth) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// SetEmail1Type sets the value of Email1Type.
-func (s *Contact) SetEmail1Type(val OptString) {
-	s.Email1Type = val
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *DatasourceEmailOAuth) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
 }
 
-// SetEmail2 sets the value of Email2.
-func (s *Contact) SetEmail2(val OptString) {
-	s.Email2 = val
-}
+// DatasourceEmailOAuthDeleteOK is response for DatasourceEmailOAuthDelete operation.
+type DatasourceEmailOAuthDeleteOK struct{}
 
-// SetEmail2Type sets the value of Email2Type.
-func (s *Contact) SetEmail2Type(val OptString) {
-	s.Email2Type = val
+// LinkedIn datasource object representation.
+// Ref: #
+type DatasourceLinkedin struct {
+	UUID      OptString `json:"uuid"`
+	UserUUID  string    `json:"user_uuid"`
+	Name      string    `json:"name"`
+	IsEnabled OptBool   `json:"is_enabled"`
+	Provider  string    `json:"provider"`
+	// LinkedIn username or email for login.
+	Username string `json:"username"`
+	// LinkedIn password (or OAuth2 tokens if relevant).
+	Password string `json:"password"`
+	// Arbitrary LinkedIn bridging config pulled from linkedin.tpl.yaml
+	// (presence bridging, encryption, etc.).
+	Settings  OptDatasourceLinkedinSettings `json:"settings"`
+	CreatedAt OptDateTime                   `json:"created_at"`
+	UpdatedAt OptDateTime                   `json:"updated_at"`
 }
 
-// SetEmail3 sets the value of Email3.
-func (s *Contact) SetEmail3(val OptString) {
-	s.Email3 = val
+// GetUUID returns the value of UUID.
+func (s *DatasourceLinkedin) GetUUID() OptString {
+	return s.UUID
 }
 
-// SetEmail3Type sets the value of Email3Type.
-func (s *Contact) SetEmail3Type(val OptString) {
-	s.Email3Type = val
+// GetUserUUID returns the value of UserUUID.
+func (s *DatasourceLinkedin) GetUserUUID() string {
+	return s.UserUUID
 }
 
-// SetEmail4 sets the value of Email4.
-func (s *Contact) SetEmail4(val OptString) {
-	s.Email4 = val
+// GetName returns the value of Name.
+func (s *DatasourceLinkedin) GetName() string {
+	return s.Name
 }
 
-// SetEmail4Type sets the value of Email4Type.
-func (s *Contact) SetEmail4Type(val OptString) {
-	s.Email4Type = val
+// GetIsEnabled returns the value of IsEnabled.
+func (s *DatasourceLinkedin) GetIsEnabled() OptBool {
+	return s.IsEnabled
 }
 
-// SetEmail5 sets the value of Email5.
-func (s *Contact) SetEmail5(val OptString) {
-	s.Email5 = val
+// GetProvider returns the value of Provider.
+func (s *DatasourceLinkedin) GetProvider() string {
+	return s.Provider
 }
 
-// SetEmail5Type sets the value of Email5Type.
-func (s *Contact) SetEmail5Type(val OptString) {
-	s.Email5Type = val
+// GetUsername returns the value of Username.
+func (s *DatasourceLinkedin) GetUsername() string {
+	return s.Username
 }
 
-// SetMessengers sets the value of Messengers.
-func (s *Contact) SetMessengers(val *ContactMessengers) {
-	s.Messengers = val
+// GetPassword returns the value of Password.
+func (s *DatasourceLinkedin) GetPassword() string {
+	return s.Password
 }
 
-// SetMessengersSearch sets the value of MessengersSearch.
-func (s *Contact) SetMessengersSearch(val OptString) {
-	s.MessengersSearch = val
+// GetSettings returns the value of Settings.
+func (s *DatasourceLinkedin) GetSettings() OptDatasourceLinkedinSettings {
+	return s.Settings
 }
 
-// SetSkypeUUID sets the value of SkypeUUID.
-func (s *Contact) SetSkypeUUID(val OptString) {
-	s.SkypeUUID = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *DatasourceLinkedin) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
 }
 
-// SetSkype sets the value of Skype.
-func (s *Contact) SetSkype(val OptString) {
-	s.Skype = val
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *DatasourceLinkedin) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
 }
 
-// SetWhatsappUUID sets the value of WhatsappUUID.
-func (s *Contact) SetWhatsappUUID(val OptString) {
-	s.WhatsappUUID = val
+// SetUUID sets the value of UUID.
+func (s *DatasourceLinkedin) SetUUID(val OptString) {
+	s.UUID = val
 }
 
-// SetWhatsapp sets the value of Whatsapp.
-func (s *Contact) SetWhatsapp(val OptString) {
-	s.Whatsapp = val
+// SetUserUUID sets the value of UserUUID.
+func (s *DatasourceLinkedin) SetUserUUID(val string) {
+	s.UserUUID = val
 }
 
-// SetTelegramUUID sets the value of TelegramUUID.
-func (s *Contact) SetTelegramUUID(val OptString) {
-	s.TelegramUUID = val
+// SetName sets the value of Name.
+func (s *DatasourceLinkedin) SetName(val string) {
+	s.Name = val
 }
 
-// SetTelegram sets the value of Telegram.
-func (s *Contact) SetTelegram(val OptString) {
-	s.Telegram = val
+// SetIsEnabled sets the value of IsEnabled.
+func (s *DatasourceLinkedin) SetIsEnabled(val OptBool) {
+	s.IsEnabled = val
 }
 
-// SetWechatUUID sets the value of WechatUUID.
-func (s *Contact) SetWechatUUID(val OptString) {
-	s.WechatUUID = val
+// SetProvider sets the value of Provider.
+func (s *DatasourceLinkedin) SetProvider(val string) {
+	s.Provider = val
 }
 
-// SetWechat sets the value of Wechat.
-func (s *Contact) SetWechat(val OptString) {
-	s.Wechat = val
+// SetUsername sets the value of Username.
+func (s *DatasourceLinkedin) SetUsername(val string) {
+	s.Username = val
 }
 
-// SetLineUUID sets the value of LineUUID.
-func (s *Contact) SetLineUUID(val OptString) {
-	s.LineUUID = val
+// SetPassword sets the value of Password.
+func (s *DatasourceLinkedin) SetPassword(val string) {
+	s.Password = val
 }
 
-// SetLine sets the value of Line.
-func (s *Contact) SetLine(val OptString) {
-	s.Line = val
+// SetSettings sets the value of Settings.
+func (s *DatasourceLinkedin) SetSettings(val OptDatasourceLinkedinSettings) {
+	s.Settings = val
 }
 
-// SetSocials sets the value of Socials.
-func (s *Contact) SetSocials(val *ContactSocials) {
-	s.Socials = val
+// SetCreatedAt sets the value of CreatedAt.
+func (s *DatasourceLinkedin) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// SetSocialsSearch sets the value of SocialsSearch.
-func (s *Contact) SetSocialsSearch(val OptString) {
-	s.SocialsSearch = val
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *DatasourceLinkedin) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
 }
 
-// SetLinkedinUUID sets the value of LinkedinUUID.
-func (s *Contact) SetLinkedinUUID(val OptString) {
-	s.LinkedinUUID = val
+// DatasourceLinkedinDeleteOK is response for DatasourceLinkedinDelete operation.
+type DatasourceLinkedinDeleteOK struct{}
+
+// Arbitrary LinkedIn bridging config pulled from linkedin.tpl.yaml
+// (presence bridging, encryption, etc.).
+type DatasourceLinkedinSettings map[string]jx.Raw
+
+func (s *DatasourceLinkedinSettings) init() DatasourceLinkedinSettings {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// SetLinkedinURL sets the value of LinkedinURL.
-func (s *Contact) SetLinkedinURL(val OptString) {
-	s.LinkedinURL = val
+// DatasourceSetOAuth2ClientNoContent is response for DatasourceSetOAuth2Client operation.
+type DatasourceSetOAuth2ClientNoContent struct{}
+
+type DatasourceSetOAuth2ClientReq struct {
+	// OAuth2 client ID.
+	ClientID string `json:"client_id"`
 }
 
-// SetFacebookUUID sets the value of FacebookUUID.
-func (s *Contact) SetFacebookUUID(val OptString) {
-	s.FacebookUUID = val
+// GetClientID returns the value of ClientID.
+func (s *DatasourceSetOAuth2ClientReq) GetClientID() string {
+	return s.ClientID
 }
 
-// SetFacebookURL sets the value of FacebookURL.
-func (s *Contact) SetFacebookURL(val OptString) {
-	s.FacebookURL = val
+// SetClientID sets the value of ClientID.
+func (s *DatasourceSetOAuth2ClientReq) SetClientID(val string) {
+	s.ClientID = val
 }
 
-// SetTwitterUUID sets the value of TwitterUUID.
-func (s *Contact) SetTwitterUUID(val OptString) {
-	s.TwitterUUID = val
+// Ref: #
+type DatasourceTelegram struct {
+	UUID      OptString `json:"uuid"`
+	UserUUID  string    `json:"user_uuid"`
+	Name      string    `json:"name"`
+	IsEnabled OptBool   `json:"is_enabled"`
+	Provider  string    `json:"provider"`
+	// The phone number for the Telegram account (international format).
+	PhoneNumber string `json:"phone_number"`
+	// Telegram API ID from my.telegram.org.
+	APIID string `json:"api_id"`
+	// Telegram API hash.
+	APIHash string `json:"api_hash"`
+	// Optional 2FA password.
+	Password OptString `json:"password"`
+	// Additional Telegram bridging config from telegram.tpl.yaml
+	// (proxy, concurrency, presence bridging, encryption, etc.).
+	Settings       OptDatasourceTelegramSettings `json:"settings"`
+	SessionHistory TelegramSessionHistory        `json:"sessionHistory"`
+	Participants   TelegramParticipants          `json:"participants"`
+	// Arbitrary key-value metadata about the account.
+	Meta      OptDatasourceTelegramMeta `json:"meta"`
+	CreatedAt OptDateTime               `json:"created_at"`
+	UpdatedAt OptDateTime               `json:"updated_at"`
 }
 
-// SetTwitterURL sets the value of TwitterURL.
-func (s *Contact) SetTwitterURL(val OptString) {
-	s.TwitterURL = val
+// GetUUID returns the value of UUID.
+func (s *DatasourceTelegram) GetUUID() OptString {
+	return s.UUID
 }
 
-// SetGithubUUID sets the value of GithubUUID.
-func (s *Contact) SetGithubUUID(val OptString) {
-	s.GithubUUID = val
+// GetUserUUID returns the value of UserUUID.
+func (s *DatasourceTelegram) GetUserUUID() string {
+	return s.UserUUID
 }
 
-// SetGithubURL sets the value of GithubURL.
-func (s *Contact) SetGithubURL(val OptString) {
-	s.GithubURL = val
+// GetName returns the value of Name.
+func (s *DatasourceTelegram) GetName() string {
+	return s.Name
 }
 
-// SetVkUUID sets the value of VkUUID.
-func (s *Contact) SetVkUUID(val OptString) {
-	s.VkUUID = val
+// GetIsEnabled returns the value of IsEnabled.
+func (s *DatasourceTelegram) GetIsEnabled() OptBool {
+	return s.IsEnabled
 }
 
-// SetVkURL sets the value of VkURL.
-func (s *Contact) SetVkURL(val OptString) {
-	s.VkURL = val
+// GetProvider returns the value of Provider.
+func (s *DatasourceTelegram) GetProvider() string {
+	return s.Provider
 }
 
-// SetOdnoUUID sets the value of OdnoUUID.
-func (s *Contact) SetOdnoUUID(val OptString) {
-	s.OdnoUUID = val
+// GetPhoneNumber returns the value of PhoneNumber.
+func (s *DatasourceTelegram) GetPhoneNumber() string {
+	return s.PhoneNumber
 }
 
-// SetOdnoURL sets the value of OdnoURL.
-func (s *Contact) SetOdnoURL(val OptString) {
-	s.OdnoURL = val
+// GetAPIID returns the value of APIID.
+func (s *DatasourceTelegram) GetAPIID() string {
+	return s.APIID
 }
 
-// SetHhruUUID sets the value of HhruUUID.
-func (s *Contact) SetHhruUUID(val OptString) {
-	s.HhruUUID = val
+// GetAPIHash returns the value of APIHash.
+func (s *DatasourceTelegram) GetAPIHash() string {
+	return s.APIHash
 }
 
-// SetHhruURL sets the value of HhruURL.
-func (s *Contact) SetHhruURL(val OptString) {
-	s.HhruURL = val
+// GetPassword returns the value of Password.
+func (s *DatasourceTelegram) GetPassword() OptString {
+	return s.Password
 }
 
-// SetHabrUUID sets the value of HabrUUID.
-func (s *Contact) SetHabrUUID(val OptString) {
-	s.HabrUUID = val
+// GetSettings returns the value of Settings.
+func (s *DatasourceTelegram) GetSettings() OptDatasourceTelegramSettings {
+	return s.Settings
 }
 
-// SetHabrURL sets the value of HabrURL.
-func (s *Contact) SetHabrURL(val OptString) {
-	s.HabrURL = val
+// GetSessionHistory returns the value of SessionHistory.
+func (s *DatasourceTelegram) GetSessionHistory() TelegramSessionHistory {
+	return s.SessionHistory
 }
 
-// SetMoikrugUUID sets the value of MoikrugUUID.
-func (s *Contact) SetMoikrugUUID(val OptString) {
-	s.MoikrugUUID = val
+// GetParticipants returns the value of Participants.
+func (s *DatasourceTelegram) GetParticipants() TelegramParticipants {
+	return s.Participants
 }
 
-// SetMoikrugURL sets the value of MoikrugURL.
-func (s *Contact) SetMoikrugURL(val OptString) {
-	s.MoikrugURL = val
+// GetMeta returns the value of Meta.
+func (s *DatasourceTelegram) GetMeta() OptDatasourceTelegramMeta {
+	return s.Meta
 }
 
-// SetInstagramUUID sets the value of InstagramUUID.
-func (s *Contact) SetInstagramUUID(val OptString) {
-	s.InstagramUUID = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *DatasourceTelegram) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
 }
 
-// SetInstagramURL sets the value of InstagramURL.
-func (s *Contact) SetInstagramURL(val OptString) {
-	s.InstagramURL = val
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *DatasourceTelegram) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
 }
 
-// SetSocial1UUID sets the value of Social1UUID.
-func (s *Contact) SetSocial1UUID(val OptString) {
-	s.Social1UUID = val
+// SetUUID sets the value of UUID.
+func (s *DatasourceTelegram) SetUUID(val OptString) {
+	s.UUID = val
 }
 
-// SetSocial1URL sets the value of Social1URL.
-func (s *Contact) SetSocial1URL(val OptString) {
-	s.Social1URL = val
+// SetUserUUID sets the value of UserUUID.
+func (s *DatasourceTelegram) SetUserUUID(val string) {
+	s.UserUUID = val
 }
 
-// SetSocial1Type sets the value of Social1Type.
-func (s *Contact) SetSocial1Type(val OptString) {
-	s.Social1Type = val
+// SetName sets the value of Name.
+func (s *DatasourceTelegram) SetName(val string) {
+	s.Name = val
 }
 
-// SetSocial2UUID sets the value of Social2UUID.
-func (s *Contact) SetSocial2UUID(val OptString) {
-	s.Social2UUID = val
+// SetIsEnabled sets the value of IsEnabled.
+func (s *DatasourceTelegram) SetIsEnabled(val OptBool) {
+	s.IsEnabled = val
 }
 
-// SetSocial2URL sets the value of Social2URL.
-func (s *Contact) SetSocial2URL(val OptString) {
-	s.Social2URL = val
+// SetProvider sets the value of Provider.
+func (s *DatasourceTelegram) SetProvider(val string) {
+	s.Provider = val
 }
 
-// SetSocial2Type sets the value of Social2Type.
-func (s *Contact) SetSocial2Type(val OptString) {
-	s.Social2Type = val
+// SetPhoneNumber sets the value of PhoneNumber.
+func (s *DatasourceTelegram) SetPhoneNumber(val string) {
+	s.PhoneNumber = val
 }
 
-// SetSocial3UUID sets the value of Social3UUID.
-func (s *Contact) SetSocial3UUID(val OptString) {
-	s.Social3UUID = val
+// SetAPIID sets the value of APIID.
+func (s *DatasourceTelegram) SetAPIID(val string) {
+	s.APIID = val
 }
 
-// SetSocial3URL sets the value of Social3URL.
-func (s *Contact) SetSocial3URL(val OptString) {
-	s.Social3URL = val
+// SetAPIHash sets the value of APIHash.
+func (s *DatasourceTelegram) SetAPIHash(val string) {
+	s.APIHash = val
 }
 
-// SetSocial3Type sets the value of Social3Type.
-func (s *Contact) SetSocial3Type(val OptString) {
-	s.Social3Type = val
+// SetPassword sets the value of Password.
+func (s *DatasourceTelegram) SetPassword(val OptString) {
+	s.Password = val
 }
 
-// SetSocial4UUID sets the value of Social4UUID.
-func (s *Contact) SetSocial4UUID(val OptString) {
-	s.Social4UUID = val
+// SetSettings sets the value of Settings.
+func (s *DatasourceTelegram) SetSettings(val OptDatasourceTelegramSettings) {
+	s.Settings = val
 }
 
-// SetSocial4URL sets the value of Social4URL.
-func (s *Contact) SetSocial4URL(val OptString) {
-	s.Social4URL = val
+// SetSessionHistory sets the value of SessionHistory.
+func (s *DatasourceTelegram) SetSessionHistory(val TelegramSessionHistory) {
+	s.SessionHistory = val
 }
 
-// SetSocial4Type sets the value of Social4Type.
-func (s *Contact) SetSocial4Type(val OptString) {
-	s.Social4Type = val
+// SetParticipants sets the value of Participants.
+func (s *DatasourceTelegram) SetParticipants(val TelegramParticipants) {
+	s.Participants = val
 }
 
-// SetSocial5UUID sets the value of Social5UUID.
-func (s *Contact) SetSocial5UUID(val OptString) {
-	s.Social5UUID = val
+// SetMeta sets the value of Meta.
+func (s *DatasourceTelegram) SetMeta(val OptDatasourceTelegramMeta) {
+	s.Meta = val
 }
 
-// SetSocial5URL sets the value of Social5URL.
-func (s *Contact) SetSocial5URL(val OptString) {
-	s.Social5URL = val
+// SetCreatedAt sets the value of CreatedAt.
+func (s *DatasourceTelegram) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// SetSocial5Type sets the value of Social5Type.
-func (s *Contact) SetSocial5Type(val OptString) {
-	s.Social5Type = val
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *DatasourceTelegram) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
 }
 
-// SetSocial6UUID sets the value of Social6UUID.
-func (s *Contact) SetSocial6UUID(val OptString) {
-	s.Social6UUID = val
-}
+// DatasourceTelegramDeleteOK is response for DatasourceTelegramDelete operation.
+type DatasourceTelegramDeleteOK struct{}
 
-// SetSocial6URL sets the value of Social6URL.
-func (s *Contact) SetSocial6URL(val OptString) {
-	s.Social6URL = val
-}
+// Arbitrary key-value metadata about the account.
+type DatasourceTelegramMeta map[string]jx.Raw
 
-// SetSocial6Type sets the value of Social6Type.
-func (s *Contact) SetSocial6Type(val OptString) {
-	s.Social6Type = val
+func (s *DatasourceTelegramMeta) init() DatasourceTelegramMeta {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// SetSocial7UUID sets the value of Social7UUID.
-func (s *Contact) SetSocial7UUID(val OptString) {
-	s.Social7UUID = val
-}
+// Additional Telegram bridging config from telegram.tpl.yaml
+// (proxy, concurrency, presence bridging, encryption, etc.).
+type DatasourceTelegramSettings map[string]jx.Raw
 
-// SetSocial7URL sets the value of Social7URL.
-func (s *Contact) SetSocial7URL(val OptString) {
-	s.Social7URL = val
+func (s *DatasourceTelegramSettings) init() DatasourceTelegramSettings {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// SetSocial7Type sets the value of Social7Type.
-func (s *Contact) SetSocial7Type(val OptString) {
-	s.Social7Type = val
+// WhatsApp datasource object representation.
+// Ref: #
+type DatasourceWhatsapp struct {
+	UUID      OptString `json:"uuid"`
+	UserUUID  string    `json:"user_uuid"`
+	Name      string    `json:"name"`
+	IsEnabled OptBool   `json:"is_enabled"`
+	Provider  string    `json:"provider"`
+	// The phone number for the WhatsApp account.
+	PhoneNumber string `json:"phone_number"`
+	// Label for the WhatsApp device connection.
+	DeviceName OptString `json:"device_name"`
+	// Additional WhatsApp bridging config from whatsapp.tpl.yaml
+	// (proxy, presence bridging, call notices, status broadcast, etc.).
+	Settings  OptDatasourceWhatsappSettings `json:"settings"`
+	CreatedAt OptDateTime                   `json:"created_at"`
+	UpdatedAt OptDateTime                   `json:"updated_at"`
 }
 
-// SetSocial8UUID sets the value of Social8UUID.
-func (s *Contact) SetSocial8UUID(val OptString) {
-	s.Social8UUID = val
+// GetUUID returns the value of UUID.
+func (s *DatasourceWhatsapp) GetUUID() OptString {
+	return s.UUID
 }
 
-// SetSocial8URL sets the value of Social8URL.
-func (s *Contact) SetSocial8URL(val OptString) {
-	s.Social8URL = val
+// GetUserUUID returns the value of UserUUID.
+func (s *DatasourceWhatsapp) GetUserUUID() string {
+	return s.UserUUID
 }
 
-// SetSocial8Type sets the value of Social8Type.
-func (s *Contact) SetSocial8Type(val OptString) {
-	s.Social8Type = val
+// GetName returns the value of Name.
+func (s *DatasourceWhatsapp) GetName() string {
+	return s.Name
 }
 
-// SetSocial9UUID sets the value of Social9UUID.
-func (s *Contact) SetSocial9UUID(val OptString) {
-	s.Social9UUID = val
+// GetIsEnabled returns the value of IsEnabled.
+func (s *DatasourceWhatsapp) GetIsEnabled() OptBool {
+	return s.IsEnabled
 }
 
-// SetSocial9URL sets the value of Social9URL.
-func (s *Contact) SetSocial9URL(val OptString) {
-	s.Social9URL = val
+// GetProvider returns the value of Provider.
+func (s *DatasourceWhatsapp) GetProvider() string {
+	return s.Provider
 }
 
-// SetSocial9Type sets the value of Social9Type.
-func (s *Contact) SetSocial9Type(val OptString) {
-	s.Social9Type = val
+// GetPhoneNumber returns the value of PhoneNumber.
+func (s *DatasourceWhatsapp) GetPhoneNumber() string {
+	return s.PhoneNumber
 }
 
-// SetTrackingSource sets the value of TrackingSource.
-func (s *Contact) SetTrackingSource(val OptString) {
-	s.TrackingSource = val
+// GetDeviceName returns the value of DeviceName.
+func (s *DatasourceWhatsapp) GetDeviceName() OptString {
+	return s.DeviceName
 }
 
-// SetTrackingSlug sets the value of TrackingSlug.
-func (s *Contact) SetTrackingSlug(val OptString) {
-	s.TrackingSlug = val
+// GetSettings returns the value of Settings.
+func (s *DatasourceWhatsapp) GetSettings() OptDatasourceWhatsappSettings {
+	return s.Settings
 }
 
-// SetCachedImg sets the value of CachedImg.
-func (s *Contact) SetCachedImg(val OptString) {
-	s.CachedImg = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *DatasourceWhatsapp) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
 }
 
-// SetCachedImgData sets the value of CachedImgData.
-func (s *Contact) SetCachedImgData(val *ContactCachedImgData) {
-	s.CachedImgData = val
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *DatasourceWhatsapp) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
 }
 
-// SetCrawl sets the value of Crawl.
-func (s *Contact) SetCrawl(val *ContactCrawl) {
-	s.Crawl = val
+// SetUUID sets the value of UUID.
+func (s *DatasourceWhatsapp) SetUUID(val OptString) {
+	s.UUID = val
 }
 
-// SetDuplicateUserID sets the value of DuplicateUserID.
-func (s *Contact) SetDuplicateUserID(val OptString) {
-	s.DuplicateUserID = val
+// SetUserUUID sets the value of UserUUID.
+func (s *DatasourceWhatsapp) SetUserUUID(val string) {
+	s.UserUUID = val
 }
 
-// SetDuplicateAlternativeID sets the value of DuplicateAlternativeID.
-func (s *Contact) SetDuplicateAlternativeID(val OptString) {
-	s.DuplicateAlternativeID = val
+// SetName sets the value of Name.
+func (s *DatasourceWhatsapp) SetName(val string) {
+	s.Name = val
 }
 
-// SetDuplicateReportDate sets the value of DuplicateReportDate.
-func (s *Contact) SetDuplicateReportDate(val OptDateTime) {
-	s.DuplicateReportDate = val
+// SetIsEnabled sets the value of IsEnabled.
+func (s *DatasourceWhatsapp) SetIsEnabled(val OptBool) {
+	s.IsEnabled = val
 }
 
-// SetEntryDate sets the value of EntryDate.
-func (s *Contact) SetEntryDate(val OptDateTime) {
-	s.EntryDate = val
+// SetProvider sets the value of Provider.
+func (s *DatasourceWhatsapp) SetProvider(val string) {
+	s.Provider = val
 }
 
-// SetEditDate sets the value of EditDate.
-func (s *Contact) SetEditDate(val OptDateTime) {
-	s.EditDate = val
+// SetPhoneNumber sets the value of PhoneNumber.
+func (s *DatasourceWhatsapp) SetPhoneNumber(val string) {
+	s.PhoneNumber = val
 }
 
-// SetLastKpiEntryDate sets the value of LastKpiEntryDate.
-func (s *Contact) SetLastKpiEntryDate(val OptDateTime) {
-	s.LastKpiEntryDate = val
+// SetDeviceName sets the value of DeviceName.
+func (s *DatasourceWhatsapp) SetDeviceName(val OptString) {
+	s.DeviceName = val
 }
 
-type ContactCachedImgData struct{}
-
-type ContactCrawl struct{}
-
-type ContactEmails struct{}
+// SetSettings sets the value of Settings.
+func (s *DatasourceWhatsapp) SetSettings(val OptDatasourceWhatsappSettings) {
+	s.Settings = val
+}
 
-type ContactLastPositions struct{}
+// SetCreatedAt sets the value of CreatedAt.
+func (s *DatasourceWhatsapp) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
 
-type ContactMessengers struct{}
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *DatasourceWhatsapp) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
 
-type ContactNames struct{}
+// DatasourceWhatsappDeleteOK is response for DatasourceWhatsappDelete operation.
+type DatasourceWhatsappDeleteOK struct{}
 
-type ContactNoteKpiID struct{}
+// Additional WhatsApp bridging config from whatsapp.tpl.yaml
+// (proxy, presence bridging, call notices, status broadcast, etc.).
+type DatasourceWhatsappSettings map[string]jx.Raw
 
-type ContactPhones struct{}
+func (s *DatasourceWhatsappSettings) init() DatasourceWhatsappSettings {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
 
-type ContactSalaryData struct{}
+// DeleteContactOK is response for DeleteContact operation.
+type DeleteContactOK struct{}
 
-type ContactSocials struct{}
+// DeleteUserOK is response for DeleteUser operation.
+type DeleteUserOK struct{}
 
+// Mail sent from the mailbox of a datasource.
 // Ref: #
-type Datasource struct {
-	UUID      OptString   `json:"uuid"`
-	UserUUID  string      `json:"user_uuid"`
-	Type      string      `json:"type"`
-	Name      string      `json:"name"`
-	IsEnabled OptBool     `json:"is_enabled"`
-	Provider  string      `json:"provider"`
-	CreatedAt OptDateTime `json:"created_at"`
-	UpdatedAt OptDateTime `json:"updated_at"`
+type EmailSend struct {
+	To      []string `json:"to"`
+	Cc      []string `json:"cc"`
+	Bcc     []string `json:"bcc"`
+	Subject string   `json:"subject"`
+	Body    string   `json:"body"`
+	// Content type of the body.
+	ContentType     OptEmailSendContentType `json:"content_type"`
+	SaveToSentItems OptBool                 `json:"save_to_sent_items"`
 }
 
-// GetUUID returns the value of UUID.
-func (s *Datasource) GetUUID() OptString {
-	return s.UUID
+// GetTo returns the value of To.
+func (s *EmailSend) GetTo() []string {
+	return s.To
 }
 
-// GetUserUUID returns the value of UserUUID.
-func (s *Datasource) GetUserUUID() string {
-	return s.UserUUID
+// GetCc returns the value of Cc.
+func (s *EmailSend) GetCc() []string {
+	return s.Cc
 }
 
-// GetType returns the value of Type.
-func (s *Datasource) GetType() string {
-	return s.Type
+// GetBcc returns the value of Bcc.
+func (s *EmailSend) GetBcc() []string {
+	return s.Bcc
 }
 
-// GetName returns the value of Name.
-func (s *Datasource) GetName() string {
-	return s.Name
+// GetSubject returns the value of Subject.
+func (s *EmailSend) GetSubject() string {
+	return s.Subject
 }
 
-// GetIsEnabled returns the value of IsEnabled.
-func (s *Datasource) GetIsEnabled() OptBool {
-	return s.IsEnabled
+// GetBody returns the value of Body.
+func (s *EmailSend) GetBody() string {
+	return s.Body
 }
 
-// GetProvider returns the value of Provider.
-func (s *Datasource) GetProvider() string {
-	return s.Provider
+// GetContentType returns the value of ContentType.
+func (s *EmailSend) GetContentType() OptEmailSendContentType {
+	return s.ContentType
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *Datasource) GetCreatedAt() OptDateTime {
-	return s.CreatedAt
+// GetSaveToSentItems returns the value of SaveToSentItems.
+func (s *EmailSend) GetSaveToSentItems() OptBool {
+	return s.SaveToSentItems
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *Datasource) GetUpdatedAt() OptDateTime {
-	return s.UpdatedAt
+// SetTo sets the value of To.
+func (s *EmailSend) SetTo(val []string) {
+	s.To = val
 }
 
-// SetUUID sets the value of UUID.
-func (s *Datasource) SetUUID(val OptString) {
-	s.UUID = val
+// SetCc sets the value of Cc.
+func (s *EmailSend) SetCc(val []string) {
+	s.Cc = val
 }
 
-// SetUserUUID sets the value of UserUUID.
-func (s *Datasource) SetUserUUID(val string) {
-	s.UserUUID = val
+// SetBcc sets the value of Bcc.
+func (s *EmailSend) SetBcc(val []string) {
+	s.Bcc = val
 }
 
-// SetType sets the value of Type.
-func (s *Datasource) SetType(val string) {
-	s.Type = val
+// SetSubject sets the value of Subject.
+func (s *EmailSend) SetSubject(val string) {
+	s.Subject = val
 }
 
-// SetName sets the value of Name.
-func (s *Datasource) SetName(val string) {
-	s.Name = val
+// SetBody sets the value of Body.
+func (s *EmailSend) SetBody(val string) {
+	s.Body = val
 }
 
-// SetIsEnabled sets the value of IsEnabled.
-func (s *Datasource) SetIsEnabled(val OptBool) {
-	s.IsEnabled = val
+// SetContentType sets the value of ContentType.
+func (s *EmailSend) SetContentType(val OptEmailSendContentType) {
+	s.ContentType = val
 }
 
-// SetProvider sets the value of Provider.
-func (s *Datasource) SetProvider(val string) {
-	s.Provider = val
+// SetSaveToSentItems sets the value of SaveToSentItems.
+func (s *EmailSend) SetSaveToSentItems(val OptBool) {
+	s.SaveToSentItems = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *Datasource) SetCreatedAt(val OptDateTime) {
-	s.CreatedAt = val
-}
+// Content type of the body.
+type EmailSendContentType string
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *Datasource) SetUpdatedAt(val OptDateTime) {
-	s.UpdatedAt = val
+const (
+	EmailSendContentTypeText EmailSendContentType = "text"
+	EmailSendContentTypeHTML EmailSendContentType = "html"
+)
+
+// AllValues returns all EmailSendContentType values.
+func (EmailSendContentType) AllValues() []EmailSendContentType {
+	return []EmailSendContentType{
+		EmailSendContentTypeText,
+		EmailSendContentTypeHTML,
+	}
 }
 
-// Ref: #
-type DatasourceEmail struct {
-	UUID             OptString   `json:"uuid"`
-	UserUUID         string      `json:"user_uuid"`
-	Email            string      `json:"email"`
-	Name             string      `json:"name"`
-	IsEnabled        OptBool     `json:"is_enabled"`
-	Provider         string      `json:"provider"`
-	OAuth2ClientID   OptString   `json:"oauth2_client_id"`
-	OAuth2ClientUUID OptString   `json:"oauth2_client_uuid"`
-	OAuth2TokenUUID  OptString   `json:"oauth2_token_uuid"`
-	ImapServer       string      `json:"imap_server"`
-	SMTPServer       string      `json:"smtp_server"`
-	SMTPTLS          OptBool     `json:"smtp_tls"`
-	Password         string      `json:"password"`
-	CreatedAt        OptDateTime `json:"created_at"`
-	UpdatedAt        OptDateTime `json:"updated_at"`
+// MarshalText implements encoding.TextMarshaler.
+func (s EmailSendContentType) MarshalText() ([]byte, error) {
+	switch s {
+	case EmailSendContentTypeText:
+		return []byte(s), nil
+	case EmailSendContentTypeHTML:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetUUID returns the value of UUID.
-func (s *DatasourceEmail) GetUUID() OptString {
-	return s.UUID
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EmailSendContentType) UnmarshalText(data []byte) error {
+	switch EmailSendContentType(data) {
+	case EmailSendContentTypeText:
+		*s = EmailSendContentTypeText
+		return nil
+	case EmailSendContentTypeHTML:
+		*s = EmailSendContentTypeHTML
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetUserUUID returns the value of UserUUID.
-func (s *DatasourceEmail) GetUserUUID() string {
-	return s.UserUUID
+// Everything an erasure request touched.
+// Ref: #/ErasureReport
+type ErasureReport struct {
+	MessageUuids       []string `json:"message_uuids"`
+	FileUuids          []string `json:"file_uuids"`
+	ContactUuids       []string `json:"contact_uuids"`
+	MessagesDeleted    OptInt64 `json:"messages_deleted"`
+	MessagesAnonymized OptInt64 `json:"messages_anonymized"`
+	FilesDeleted       OptInt64 `json:"files_deleted"`
+	BytesDeleted       OptInt64 `json:"bytes_deleted"`
+	ContactsDeleted    OptInt64 `json:"contacts_deleted"`
+	ContactsAnonymized OptInt64 `json:"contacts_anonymized"`
 }
 
-// GetEmail returns the value of Email.
-func (s *DatasourceEmail) GetEmail() string {
-	return s.Email
+// GetMessageUuids returns the value of MessageUuids.
+func (s *ErasureReport) GetMessageUuids() []string {
+	return s.MessageUuids
 }
 
-// GetName returns the value of Name.
-func (s *DatasourceEmail) GetName() string {
-	return s.Name
+// GetFileUuids returns the value of FileUuids.
+func (s *ErasureReport) GetFileUuids() []string {
+	return s.FileUuids
 }
 
-// GetIsEnabled returns the value of IsEnabled.
-func (s *DatasourceEmail) GetIsEnabled() OptBool {
-	return s.IsEnabled
+// GetContactUuids returns the value of ContactUuids.
+func (s *ErasureReport) GetContactUuids() []string {
+	return s.ContactUuids
 }
 
-// GetProvider returns the value of Provider.
-func (s *DatasourceEmail) GetProvider() string {
-	return s.Provider
+// GetMessagesDeleted returns the value of MessagesDeleted.
+func (s *ErasureReport) GetMessagesDeleted() OptInt64 {
+	return s.MessagesDeleted
 }
 
-// GetOAuth2ClientID returns the value of OAuth2ClientID.
-func (s *DatasourceEmail) GetOAuth2ClientID() OptString {
-	return s.OAuth2ClientID
+// GetMessagesAnonymized returns the value of MessagesAnonymized.
+func (s *ErasureReport) GetMessagesAnonymized() OptInt64 {
+	return s.MessagesAnonymized
 }
 
-// GetOAuth2ClientUUID returns the value of OAuth2ClientUUID.
-func (s *DatasourceEmail) GetOAuth2ClientUUID() OptString {
-	return s.OAuth2ClientUUID
+// GetFilesDeleted returns the value of FilesDeleted.
+func (s *ErasureReport) GetFilesDeleted() OptInt64 {
+	return s.FilesDeleted
 }
 
-// GetOAuth2TokenUUID returns the value of OAuth2TokenUUID.
-func (s *DatasourceEmail) GetOAuth2TokenUUID() OptString {
-	return s.OAuth2TokenUUID
+// GetBytesDeleted returns the value of BytesDeleted.
+func (s *ErasureReport) GetBytesDeleted() OptInt64 {
+	return s.BytesDeleted
 }
 
-// GetImapServer returns the value of ImapServer.
-func (s *DatasourceEmail) GetImapServer() string {
-	return s.ImapServer
+// GetContactsDeleted returns the value of ContactsDeleted.
+func (s *ErasureReport) GetContactsDeleted() OptInt64 {
+	return s.ContactsDeleted
 }
 
-// GetSMTPServer returns the value of SMTPServer.
-func (s *DatasourceEmail) GetSMTPServer() string {
-	return s.SMTPServer
+// GetContactsAnonymized returns the value of ContactsAnonymized.
+func (s *ErasureReport) GetContactsAnonymized() OptInt64 {
+	return s.ContactsAnonymized
 }
 
-// GetSMTPTLS returns the value of SMTPTLS.
-func (s *DatasourceEmail) GetSMTPTLS() OptBool {
-	return s.SMTPTLS
+// SetMessageUuids sets the value of MessageUuids.
+func (s *ErasureReport) SetMessageUuids(val []string) {
+	s.MessageUuids = val
 }
 
-// GetPassword returns the value of Password.
-func (s *DatasourceEmail) GetPassword() string {
-	return s.Password
+// SetFileUuids sets the value of FileUuids.
+func (s *ErasureReport) SetFileUuids(val []string) {
+	s.FileUuids = val
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *DatasourceEmail) GetCreatedAt() OptDateTime {
-	return s.CreatedAt
+// SetContactUuids sets the value of ContactUuids.
+func (s *ErasureReport) SetContactUuids(val []string) {
+	s.ContactUuids = val
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *DatasourceEmail) GetUpdatedAt() OptDateTime {
-	return s.UpdatedAt
+// SetMessagesDeleted sets the value of MessagesDeleted.
+func (s *ErasureReport) SetMessagesDeleted(val OptInt64) {
+	s.MessagesDeleted = val
 }
 
-// SetUUID sets the value of UUID.
-func (s *DatasourceEmail) SetUUID(val OptString) {
-	s.UUID = val
+// SetMessagesAnonymized sets the value of MessagesAnonymized.
+func (s *ErasureReport) SetMessagesAnonymized(val OptInt64) {
+	s.MessagesAnonymized = val
 }
 
-// SetUserUUID sets the value of UserUUID.
-func (s *DatasourceEmail) SetUserUUID(val string) {
-	s.UserUUID = val
+// SetFilesDeleted sets the value of FilesDeleted.
+func (s *ErasureReport) SetFilesDeleted(val OptInt64) {
+	s.FilesDeleted = val
 }
 
-// SetEmail sets the value of Email.
-func (s *DatasourceEmail) SetEmail(val string) {
-	s.Email = val
+// SetBytesDeleted sets the value of BytesDeleted.
+func (s *ErasureReport) SetBytesDeleted(val OptInt64) {
+	s.BytesDeleted = val
 }
 
-// SetName sets the value of Name.
-func (s *DatasourceEmail) SetName(val string) {
-	s.Name = val
+// SetContactsDeleted sets the value of ContactsDeleted.
+func (s *ErasureReport) SetContactsDeleted(val OptInt64) {
+	s.ContactsDeleted = val
 }
 
-// SetIsEnabled sets the value of IsEnabled.
-func (s *DatasourceEmail) SetIsEnabled(val OptBool) {
-	s.IsEnabled = val
+// SetContactsAnonymized sets the value of ContactsAnonymized.
+func (s *ErasureReport) SetContactsAnonymized(val OptInt64) {
+	s.ContactsAnonymized = val
 }
 
-// SetProvider sets the value of Provider.
-func (s *DatasourceEmail) SetProvider(val string) {
-	s.Provider = val
+// Erase every message, file and contact referencing an email address or phone number.
+// Ref: #/ErasureRequest
+type ErasureRequest struct {
+	// Unique identifier of the erasure request, empty for dry runs.
+	UUID OptString `json:"uuid"`
+	// Email address or phone number to erase. Listed requests only carry its keyed digest, or ********
+	// when no secrets master key is configured.
+	Subject string `json:"subject"`
+	// Delete matching records or replace the subject with a placeholder. Files are always deleted.
+	Mode ErasureRequestMode `json:"mode"`
+	// Only report what would be erased.
+	DryRun OptBool `json:"dry_run"`
+	// Erasure status (done, failed).
+	Status     OptString        `json:"status"`
+	Report     OptErasureReport `json:"report"`
+	Error      OptString        `json:"error"`
+	CreatedAt  OptDateTime      `json:"created_at"`
+	FinishedAt OptDateTime      `json:"finished_at"`
 }
 
-// SetOAuth2ClientID sets the value of OAuth2ClientID.
-func (s *DatasourceEmail) SetOAuth2ClientID(val OptString) {
-	s.OAuth2ClientID = val
+// GetUUID returns the value of UUID.
+func (s *ErasureRequest) GetUUID() OptString {
+	return s.UUID
 }
 
-// SetOAuth2ClientUUID sets the value of OAuth2ClientUUID.
-func (s *DatasourceEmail) SetOAuth2ClientUUID(val OptString) {
-	s.OAuth2ClientUUID = val
+// GetSubject returns the value of Subject.
+func (s *ErasureRequest) GetSubject() string {
+	return s.Subject
 }
 
-// SetOAuth2TokenUUID sets the value of OAuth2TokenUUID.
-func (s *DatasourceEmail) SetOAuth2TokenUUID(val OptString) {
-	s.OAuth2TokenUUID = val
+// GetMode returns the value of Mode.
+func (s *ErasureRequest) GetMode() ErasureRequestMode {
+	return s.Mode
 }
 
-// SetImapServer sets the value of ImapServer.
-func (s *DatasourceEmail) SetImapServer(val string) {
-	s.ImapServer = val
+// GetDryRun returns the value of DryRun.
+func (s *ErasureRequest) GetDryRun() OptBool {
+	return s.DryRun
 }
 
-// SetSMTPServer sets the value of SMTPServer.
-func (s *DatasourceEmail) SetSMTPServer(val string) {
-	s.SMTPServer = val
+// GetStatus returns the value of Status.
+func (s *ErasureRequest) GetStatus() OptString {
+	return s.Status
 }
 
-// SetSMTPTLS sets the value of SMTPTLS.
-func (s *DatasourceEmail) SetSMTPTLS(val OptBool) {
-	s.SMTPTLS = val
+// GetReport returns the value of Report.
+func (s *ErasureRequest) GetReport() OptErasureReport {
+	return s.Report
 }
 
-// SetPassword sets the value of Password.
-func (s *DatasourceEmail) SetPassword(val string) {
-	s.Password = val
+// GetError returns the value of Error.
+func (s *ErasureRequest) GetError() OptString {
+	return s.Error
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *DatasourceEmail) SetCreatedAt(val OptDateTime) {
-	s.CreatedAt = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ErasureRequest) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *DatasourceEmail) SetUpdatedAt(val OptDateTime) {
-	s.UpdatedAt = val
+// GetFinishedAt returns the value of FinishedAt.
+func (s *ErasureRequest) GetFinishedAt() OptDateTime {
+	return s.FinishedAt
 }
 
-// DatasourceEmailDeleteOK is response for DatasourceEmailDelete operation.
-type DatasourceEmailDeleteOK struct{}
+// SetUUID sets the value of UUID.
+func (s *ErasureRequest) SetUUID(val OptString) {
+	s.UUID = val
+}
 
-// OAuth2‑enabled email datasource object representation.
-// Ref: #
-type DatasourceEmailOAuth struct {
-	UUID      OptString `json:"uuid"`
-	UserUUID  string    `json:"user_uuid"`
-	Email     string    `json:"email"`
-	Name      string    `json:"name"`
-	IsEnabled OptBool   `json:"is_enabled"`
-	Provider  string    `json:"provider"`
-	// Identifier of the OAuth2 client bound to this datasource.
-	OAuth2ClientUUID string `json:"oauth2_client_uuid"`
-	// Identifier of the linked OAuth2 token (set after successful auth flow).
-	OAuth2TokenUUID OptString   `json:"oauth2_token_uuid"`
-	CreatedAt       OptDateTime `json:"created_at"`
-	UpdatedAt       OptDateTime `json:"updated_at"`
+// SetSubject sets the value of Subject.
+func (s *ErasureRequest) SetSubject(val string) {
+	s.Subject = val
 }
 
-// GetUUID returns the value of UUID.
-func (s *DatasourceEmailOAuth) GetUUID() OptString {
-	return s.UUID
+// SetMode sets the value of Mode.
+func (s *ErasureRequest) SetMode(val ErasureRequestMode) {
+	s.Mode = val
 }
 
-// GetUserUUID returns the value of UserUUID.
-func (s *DatasourceEmailOAuth) GetUserUUID() string {
-	return s.UserUUID
+// SetDryRun sets the value of DryRun.
+func (s *ErasureRequest) SetDryRun(val OptBool) {
+	s.DryRun = val
 }
 
-// GetEmail returns the value of Email.
-func (s *DatasourceEmailOAuth) GetEmail() string {
-	return s.Email
+// SetStatus sets the value of Status.
+func (s *ErasureRequest) SetStatus(val OptString) {
+	s.Status = val
 }
 
-// GetName returns the value of Name.
-func (s *DatasourceEmailOAuth) GetName() string {
-	return s.Name
+// SetReport sets the value of Report.
+func (s *ErasureRequest) SetReport(val OptErasureReport) {
+	s.Report = val
 }
 
-// GetIsEnabled returns the value of IsEnabled.
-func (s *DatasourceEmailOAuth) GetIsEnabled() OptBool {
-	return s.IsEnabled
+// SetError sets the value of Error.
+func (s *ErasureRequest) SetError(val OptString) {
+	s.Error = val
 }
 
-// GetProvider returns the value of Provider.
-func (s *DatasourceEmailOAuth) GetProvider() string {
-	return s.Provider
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ErasureRequest) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// GetOAuth2ClientUUID returns the value of OAuth2ClientUUID.
-func (s *DatasourceEmailOAuth) GetOAuth2ClientUUID() string {
-	return s.OAuth2ClientUUID
+// SetFinishedAt sets the value of FinishedAt.
+func (s *ErasureRequest) SetFinishedAt(val OptDateTime) {
+	s.FinishedAt = val
 }
 
-// GetOAuth2TokenUUID returns the value of OAuth2TokenUUID.
-func (s *DatasourceEmailOAuth) GetOAuth2TokenUUID() OptString {
-	return s.OAuth2TokenUUID
+// Delete matching records or replace the subject with a placeholder. Files are always deleted.
+type ErasureRequestMode string
+
+const (
+	ErasureRequestModeDelete    ErasureRequestMode = "delete"
+	ErasureRequestModeAnonymize ErasureRequestMode = "anonymize"
+)
+
+// AllValues returns all ErasureRequestMode values.
+func (ErasureRequestMode) AllValues() []ErasureRequestMode {
+	return []ErasureRequestMode{
+		ErasureRequestModeDelete,
+		ErasureRequestModeAnonymize,
+	}
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *DatasourceEmailOAuth) GetCreatedAt() OptDateTime {
-	return s.CreatedAt
+// MarshalText implements encoding.TextMarshaler.
+func (s ErasureRequestMode) MarshalText() ([]byte, error) {
+	switch s {
+	case ErasureRequestModeDelete:
+		return []byte(s), nil
+	case ErasureRequestModeAnonymize:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *DatasourceEmailOAuth) GetUpdatedAt() OptDateTime {
-	return s.UpdatedAt
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ErasureRequestMode) UnmarshalText(data []byte) error {
+	switch ErasureRequestMode(data) {
+	case ErasureRequestModeDelete:
+		*s = ErasureRequestModeDelete
+		return nil
+	case ErasureRequestModeAnonymize:
+		*s = ErasureRequestModeAnonymize
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetUUID sets the value of UUID.
-func (s *DatasourceEmailOAuth) SetUUID(val OptString) {
-	s.UUID = val
+// Ref: #
+type Error struct {
+	// A human-readable explanation specific to this occurrence of the problem.
+	Detail OptString `json:"detail"`
+	// Optional list of individual error details.
+	Errors []ErrorErrorsItem `json:"errors"`
+	// HTTP status code.
+	Status OptInt64 `json:"status"`
 }
 
-// SetUserUUID sets the value of UserUUID.
-func (s *DatasourceEmailOAuth) SetUserUUID(val string) {
-	s.UserUUID = val
+// GetDetail returns the value of Detail.
+func (s *Error) GetDetail() OptString {
+	return s.Detail
 }
 
-// SetEmail sets the value of Email.
-func (s *DatasourceEmailOAuth) SetEmail(val string) {
-	s.Email = val
+// GetErrors returns the value of Errors.
+func (s *Error) GetErrors() []ErrorErrorsItem {
+	return s.Errors
 }
 
-// SetName sets the value of Name.
-func (s *DatasourceEmailOAuth) SetName(val string) {
-	s.Name = val
+// GetStatus returns the value of Status.
+func (s *Error) GetStatus() OptInt64 {
+	return s.Status
 }
 
-// SetIsEnabled sets the value of IsEnabled.
-func (s *DatasourceEmailOAuth) SetIsEnabled(val OptBool) {
-	s.IsEnabled = val
+// SetDetail sets the value of Detail.
+func (s *Error) SetDetail(val OptString) {
+	s.Detail = val
 }
 
-// SetProvider sets the value of Provider.
-func (s *DatasourceEmailOAuth) SetProvider(val string) {
-	s.Provider = val
+// SetErrors sets the value of Errors.
+func (s *Error) SetErrors(val []ErrorErrorsItem) {
+	s.Errors = val
 }
 
-// SetOAuth2ClientUUID sets the value of OAuth2ClientUUID.
-func (s *DatasourceEmailOAuth) SetOAuth2ClientUUID(val string) {
-	s.OAuth2ClientUUID = val
+// SetStatus sets the value of Status.
+func (s *Error) SetStatus(val OptInt64) {
+	s.Status = val
 }
 
-// SetOAuth2TokenUUID sets the value of OAuth2TokenUUID.
-func (s *DatasourceEmailOAuth) SetOAuth2TokenUUID(val OptString) {
-	s.OAuth2TokenUUID = val
+type ErrorErrorsItem struct {
+	// Where the error occurred, e.g. 'body.items[3].tags' or 'path.thing-id'.
+	Location OptString `json:"location"`
+	// Error message text.
+	Message OptString `json:"message"`
+	// The value at the given location.
+	Value OptString `json:"value"`
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *DatasourceEmailOAuth) SetCreatedAt(val OptDateTime) {
-	s.CreatedAt = val
+// GetLocation returns the value of Location.
+func (s *ErrorErrorsItem) GetLocation() OptString {
+	return s.Location
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *DatasourceEmailOAuth) SetUpdatedAt(val OptDateTime) {
-	s.UpdatedAt = val
+// GetMessage returns the value of Message.
+func (s *ErrorErrorsItem) GetMessage() OptString {
+	return s.Message
 }
 
-// DatasourceEmailOAuthDeleteOK is response for DatasourceEmailOAuthDelete operation.
-type DatasourceEmailOAuthDeleteOK struct{}
+// GetValue returns the value of Value.
+func (s *ErrorErrorsItem) GetValue() OptString {
+	return s.Value
+}
 
-// LinkedIn datasource object representation.
-// Ref: #
-type DatasourceLinkedin struct {
-	UUID      OptString `json:"uuid"`
-	UserUUID  string    `json:"user_uuid"`
-	Name      string    `json:"name"`
-	IsEnabled OptBool   `json:"is_enabled"`
-	Provider  string    `json:"provider"`
-	// LinkedIn username or email for login.
-	Username string `json:"username"`
-	// LinkedIn password (or OAuth2 tokens if relevant).
-	Password string `json:"password"`
-	// Arbitrary LinkedIn bridging config pulled from linkedin.tpl.yaml
-	// (presence bridging, encryption, etc.).
-	Settings  OptDatasourceLinkedinSettings `json:"settings"`
-	CreatedAt OptDateTime                   `json:"created_at"`
-	UpdatedAt OptDateTime                   `json:"updated_at"`
+// SetLocation sets the value of Location.
+func (s *ErrorErrorsItem) SetLocation(val OptString) {
+	s.Location = val
 }
 
-// GetUUID returns the value of UUID.
-func (s *DatasourceLinkedin) GetUUID() OptString {
-	return s.UUID
+// SetMessage sets the value of Message.
+func (s *ErrorErrorsItem) SetMessage(val OptString) {
+	s.Message = val
 }
 
-// GetUserUUID returns the value of UserUUID.
-func (s *DatasourceLinkedin) GetUserUUID() string {
-	return s.UserUUID
+// SetValue sets the value of Value.
+func (s *ErrorErrorsItem) SetValue(val OptString) {
+	s.Value = val
 }
 
-// GetName returns the value of Name.
-func (s *DatasourceLinkedin) GetName() string {
-	return s.Name
+// ErrorStatusCode wraps Error with StatusCode.
+type ErrorStatusCode struct {
+	StatusCode int
+	Response   Error
 }
 
-// GetIsEnabled returns the value of IsEnabled.
-func (s *DatasourceLinkedin) GetIsEnabled() OptBool {
-	return s.IsEnabled
+// GetStatusCode returns the value of StatusCode.
+func (s *ErrorStatusCode) GetStatusCode() int {
+	return s.StatusCode
 }
 
-// GetProvider returns the value of Provider.
-func (s *DatasourceLinkedin) GetProvider() string {
-	return s.Provider
+// GetResponse returns the value of Response.
+func (s *ErrorStatusCode) GetResponse() Error {
+	return s.Response
 }
 
-// GetUsername returns the value of Username.
-func (s *DatasourceLinkedin) GetUsername() string {
-	return s.Username
+// SetStatusCode sets the value of StatusCode.
+func (s *ErrorStatusCode) SetStatusCode(val int) {
+	s.StatusCode = val
 }
 
-// GetPassword returns the value of Password.
-func (s *DatasourceLinkedin) GetPassword() string {
-	return s.Password
+// SetResponse sets the value of Response.
+func (s *ErrorStatusCode) SetResponse(val Error) {
+	s.Response = val
 }
 
-// GetSettings returns the value of Settings.
-func (s *DatasourceLinkedin) GetSettings() OptDatasourceLinkedinSettings {
-	return s.Settings
+// Something that happened in the workspace, also delivered to the webhooks.
+// Ref: #
+type Event struct {
+	UUID string `json:"uuid"`
+	// E.g. datasource.needs_reauth or datasource.reauthorized.
+	Type string `json:"type"`
+	// Kind of the object the event is about, e.g. datasource.
+	SubjectKind OptString `json:"subject_kind"`
+	SubjectUUID OptString `json:"subject_uuid"`
+	Data        EventData `json:"data"`
+	CreatedAt   time.Time `json:"created_at"`
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *DatasourceLinkedin) GetCreatedAt() OptDateTime {
-	return s.CreatedAt
+// GetUUID returns the value of UUID.
+func (s *Event) GetUUID() string {
+	return s.UUID
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *DatasourceLinkedin) GetUpdatedAt() OptDateTime {
-	return s.UpdatedAt
+// GetType returns the value of Type.
+func (s *Event) GetType() string {
+	return s.Type
 }
 
-// SetUUID sets the value of UUID.
-func (s *DatasourceLinkedin) SetUUID(val OptString) {
-	s.UUID = val
+// GetSubjectKind returns the value of SubjectKind.
+func (s *Event) GetSubjectKind() OptString {
+	return s.SubjectKind
 }
 
-// SetUserUUID sets the value of UserUUID.
-func (s *DatasourceLinkedin) SetUserUUID(val string) {
-	s.UserUUID = val
+// GetSubjectUUID returns the value of SubjectUUID.
+func (s *Event) GetSubjectUUID() OptString {
+	return s.SubjectUUID
 }
 
-// SetName sets the value of Name.
-func (s *DatasourceLinkedin) SetName(val string) {
-	s.Name = val
+// GetData returns the value of Data.
+func (s *Event) GetData() EventData {
+	return s.Data
 }
 
-// SetIsEnabled sets the value of IsEnabled.
-func (s *DatasourceLinkedin) SetIsEnabled(val OptBool) {
-	s.IsEnabled = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Event) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// SetProvider sets the value of Provider.
-func (s *DatasourceLinkedin) SetProvider(val string) {
-	s.Provider = val
+// SetUUID sets the value of UUID.
+func (s *Event) SetUUID(val string) {
+	s.UUID = val
 }
 
-// SetUsername sets the value of Username.
-func (s *DatasourceLinkedin) SetUsername(val string) {
-	s.Username = val
+// SetType sets the value of Type.
+func (s *Event) SetType(val string) {
+	s.Type = val
 }
 
-// SetPassword sets the value of Password.
-func (s *DatasourceLinkedin) SetPassword(val string) {
-	s.Password = val
+// SetSubjectKind sets the value of SubjectKind.
+func (s *Event) SetSubjectKind(val OptString) {
+	s.SubjectKind = val
 }
 
-// SetSettings sets the value of Settings.
-func (s *DatasourceLinkedin) SetSettings(val OptDatasourceLinkedinSettings) {
-	s.Settings = val
+// SetSubjectUUID sets the value of SubjectUUID.
+func (s *Event) SetSubjectUUID(val OptString) {
+	s.SubjectUUID = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *DatasourceLinkedin) SetCreatedAt(val OptDateTime) {
-	s.CreatedAt = val
+// SetData sets the value of Data.
+func (s *Event) SetData(val EventData) {
+	s.Data = val
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *DatasourceLinkedin) SetUpdatedAt(val OptDateTime) {
-	s.UpdatedAt = val
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Event) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// DatasourceLinkedinDeleteOK is response for DatasourceLinkedinDelete operation.
-type DatasourceLinkedinDeleteOK struct{}
-
-// Arbitrary LinkedIn bridging config pulled from linkedin.tpl.yaml
-// (presence bridging, encryption, etc.).
-type DatasourceLinkedinSettings map[string]jx.Raw
+type EventData map[string]jx.Raw
 
-func (s *DatasourceLinkedinSettings) init() DatasourceLinkedinSettings {
+func (s *EventData) init() EventData {
 	m := *s
 	if m == nil {
 		m = map[string]jx.Raw{}
@@ -1948,1883 +3724,2072 @@ func (s *DatasourceLinkedinSettings) init() DatasourceLinkedinSettings {
 	return m
 }
 
-// DatasourceSetOAuth2ClientNoContent is response for DatasourceSetOAuth2Client operation.
-type DatasourceSetOAuth2ClientNoContent struct{}
-
-type DatasourceSetOAuth2ClientReq struct {
-	// OAuth2 client ID.
-	ClientID string `json:"client_id"`
-}
+// FileDeleteOK is response for FileDelete operation.
+type FileDeleteOK struct{}
 
-// GetClientID returns the value of ClientID.
-func (s *DatasourceSetOAuth2ClientReq) GetClientID() string {
-	return s.ClientID
+// Represents a stored file, independent of the storage backend.
+// Ref: #/FileObject
+type FileObject struct {
+	// Unique identifier for the file.
+	UUID OptString `json:"uuid"`
+	// Which storage backend is used, e.g. 's3', 'postgres', or 'hostfiles'.
+	StorageType string `json:"storage_type"`
+	// UUID referencing a 'storage' record (optional).
+	StorageUUID string `json:"storage_uuid"`
+	// UUID of the message this file is attached to (optional).
+	MessageUUID OptString `json:"message_uuid"`
+	// Original filename. e.g. 'photo.jpg' or 'mail.raw'.
+	Name string `json:"name"`
+	// MIME type, e.g. 'application/pdf' or 'message/rfc822'.
+	MimeType OptString `json:"mime_type"`
+	// Size in bytes.
+	Size OptInt `json:"size"`
+	// Optional. If storage_type='postgres', file content can be provided here (base64-encoded).
+	Data OptString `json:"data"`
+	// Optional. If hostfiles or s3, the path or object key. e.g. 'my-bucket/xx/uuid.pdf'.
+	Path OptString `json:"path"`
+	// Indicates if this file is the entire raw email.
+	IsRaw OptBool `json:"is_raw"`
+	// Raw headers if needed (for emails or similar).
+	RawHeaders OptString `json:"raw_headers"`
+	// Indicates whether the raw email is contained in this file.
+	HasRawEmail OptBool `json:"has_raw_email"`
+	// Indicates if this is an inline/embedded file (e.g. images in HTML emails).
+	IsInline OptBool `json:"is_inline"`
+	// Timestamp when the file was created.
+	CreatedAt OptDateTime `json:"created_at"`
+	// Timestamp when the file was last modified.
+	UpdatedAt OptDateTime `json:"updated_at"`
 }
 
-// SetClientID sets the value of ClientID.
-func (s *DatasourceSetOAuth2ClientReq) SetClientID(val string) {
-	s.ClientID = val
+// GetUUID returns the value of UUID.
+func (s *FileObject) GetUUID() OptString {
+	return s.UUID
 }
 
-// Ref: #
-type DatasourceTelegram struct {
-	UUID      OptString `json:"uuid"`
-	UserUUID  string    `json:"user_uuid"`
-	Name      string    `json:"name"`
-	IsEnabled OptBool   `json:"is_enabled"`
-	Provider  string    `json:"provider"`
-	// The phone number for the Telegram account (international format).
-	PhoneNumber string `json:"phone_number"`
-	// Telegram API ID from my.telegram.org.
-	APIID string `json:"api_id"`
-	// Telegram API hash.
-	APIHash string `json:"api_hash"`
-	// Optional 2FA password.
-	Password OptString `json:"password"`
-	// Additional Telegram bridging config from telegram.tpl.yaml
-	// (proxy, concurrency, presence bridging, encryption, etc.).
-	Settings       OptDatasourceTelegramSettings `json:"settings"`
-	SessionHistory TelegramSessionHistory        `json:"sessionHistory"`
-	Participants   TelegramParticipants          `json:"participants"`
-	// Arbitrary key-value metadata about the account.
-	Meta      OptDatasourceTelegramMeta `json:"meta"`
-	CreatedAt OptDateTime               `json:"created_at"`
-	UpdatedAt OptDateTime               `json:"updated_at"`
+// GetStorageType returns the value of StorageType.
+func (s *FileObject) GetStorageType() string {
+	return s.StorageType
 }
 
-// GetUUID returns the value of UUID.
-func (s *DatasourceTelegram) GetUUID() OptString {
-	return s.UUID
+// GetStorageUUID returns the value of StorageUUID.
+func (s *FileObject) GetStorageUUID() string {
+	return s.StorageUUID
 }
 
-// GetUserUUID returns the value of UserUUID.
-func (s *DatasourceTelegram) GetUserUUID() string {
-	return s.UserUUID
+// GetMessageUUID returns the value of MessageUUID.
+func (s *FileObject) GetMessageUUID() OptString {
+	return s.MessageUUID
 }
 
 // GetName returns the value of Name.
-func (s *DatasourceTelegram) GetName() string {
+func (s *FileObject) GetName() string {
 	return s.Name
 }
 
-// GetIsEnabled returns the value of IsEnabled.
-func (s *DatasourceTelegram) GetIsEnabled() OptBool {
-	return s.IsEnabled
+// GetMimeType returns the value of MimeType.
+func (s *FileObject) GetMimeType() OptString {
+	return s.MimeType
 }
 
-// GetProvider returns the value of Provider.
-func (s *DatasourceTelegram) GetProvider() string {
-	return s.Provider
+// GetSize returns the value of Size.
+func (s *FileObject) GetSize() OptInt {
+	return s.Size
 }
 
-// GetPhoneNumber returns the value of PhoneNumber.
-func (s *DatasourceTelegram) GetPhoneNumber() string {
-	return s.PhoneNumber
+// GetData returns the value of Data.
+func (s *FileObject) GetData() OptString {
+	return s.Data
 }
 
-// GetAPIID returns the value of APIID.
-func (s *DatasourceTelegram) GetAPIID() string {
-	return s.APIID
+// GetPath returns the value of Path.
+func (s *FileObject) GetPath() OptString {
+	return s.Path
 }
 
-// GetAPIHash returns the value of APIHash.
-func (s *DatasourceTelegram) GetAPIHash() string {
-	return s.APIHash
+// GetIsRaw returns the value of IsRaw.
+func (s *FileObject) GetIsRaw() OptBool {
+	return s.IsRaw
 }
 
-// GetPassword returns the value of Password.
-func (s *DatasourceTelegram) GetPassword() OptString {
-	return s.Password
+// GetRawHeaders returns the value of RawHeaders.
+func (s *FileObject) GetRawHeaders() OptString {
+	return s.RawHeaders
 }
 
-// GetSettings returns the value of Settings.
-func (s *DatasourceTelegram) GetSettings() OptDatasourceTelegramSettings {
-	return s.Settings
+// GetHasRawEmail returns the value of HasRawEmail.
+func (s *FileObject) GetHasRawEmail() OptBool {
+	return s.HasRawEmail
 }
 
-// GetSessionHistory returns the value of SessionHistory.
-func (s *DatasourceTelegram) GetSessionHistory() TelegramSessionHistory {
-	return s.SessionHistory
+// GetIsInline returns the value of IsInline.
+func (s *FileObject) GetIsInline() OptBool {
+	return s.IsInline
 }
 
-// GetParticipants returns the value of Participants.
-func (s *DatasourceTelegram) GetParticipants() TelegramParticipants {
-	return s.Participants
+// GetCreatedAt returns the value of CreatedAt.
+func (s *FileObject) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
 }
 
-// GetMeta returns the value of Meta.
-func (s *DatasourceTelegram) GetMeta() OptDatasourceTelegramMeta {
-	return s.Meta
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *FileObject) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetUUID sets the value of UUID.
+func (s *FileObject) SetUUID(val OptString) {
+	s.UUID = val
+}
+
+// SetStorageType sets the value of StorageType.
+func (s *FileObject) SetStorageType(val string) {
+	s.StorageType = val
+}
+
+// SetStorageUUID sets the value of StorageUUID.
+func (s *FileObject) SetStorageUUID(val string) {
+	s.StorageUUID = val
+}
+
+// SetMessageUUID sets the value of MessageUUID.
+func (s *FileObject) SetMessageUUID(val OptString) {
+	s.MessageUUID = val
+}
+
+// SetName sets the value of Name.
+func (s *FileObject) SetName(val string) {
+	s.Name = val
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *DatasourceTelegram) GetCreatedAt() OptDateTime {
-	return s.CreatedAt
+// SetMimeType sets the value of MimeType.
+func (s *FileObject) SetMimeType(val OptString) {
+	s.MimeType = val
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *DatasourceTelegram) GetUpdatedAt() OptDateTime {
-	return s.UpdatedAt
+// SetSize sets the value of Size.
+func (s *FileObject) SetSize(val OptInt) {
+	s.Size = val
 }
 
-// SetUUID sets the value of UUID.
-func (s *DatasourceTelegram) SetUUID(val OptString) {
-	s.UUID = val
+// SetData sets the value of Data.
+func (s *FileObject) SetData(val OptString) {
+	s.Data = val
 }
 
-// SetUserUUID sets the value of UserUUID.
-func (s *DatasourceTelegram) SetUserUUID(val string) {
-	s.UserUUID = val
+// SetPath sets the value of Path.
+func (s *FileObject) SetPath(val OptString) {
+	s.Path = val
 }
 
-// SetName sets the value of Name.
-func (s *DatasourceTelegram) SetName(val string) {
-	s.Name = val
+// SetIsRaw sets the value of IsRaw.
+func (s *FileObject) SetIsRaw(val OptBool) {
+	s.IsRaw = val
 }
 
-// SetIsEnabled sets the value of IsEnabled.
-func (s *DatasourceTelegram) SetIsEnabled(val OptBool) {
-	s.IsEnabled = val
+// SetRawHeaders sets the value of RawHeaders.
+func (s *FileObject) SetRawHeaders(val OptString) {
+	s.RawHeaders = val
 }
 
-// SetProvider sets the value of Provider.
-func (s *DatasourceTelegram) SetProvider(val string) {
-	s.Provider = val
+// SetHasRawEmail sets the value of HasRawEmail.
+func (s *FileObject) SetHasRawEmail(val OptBool) {
+	s.HasRawEmail = val
 }
 
-// SetPhoneNumber sets the value of PhoneNumber.
-func (s *DatasourceTelegram) SetPhoneNumber(val string) {
-	s.PhoneNumber = val
+// SetIsInline sets the value of IsInline.
+func (s *FileObject) SetIsInline(val OptBool) {
+	s.IsInline = val
 }
 
-// SetAPIID sets the value of APIID.
-func (s *DatasourceTelegram) SetAPIID(val string) {
-	s.APIID = val
+// SetCreatedAt sets the value of CreatedAt.
+func (s *FileObject) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// SetAPIHash sets the value of APIHash.
-func (s *DatasourceTelegram) SetAPIHash(val string) {
-	s.APIHash = val
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *FileObject) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
 }
 
-// SetPassword sets the value of Password.
-func (s *DatasourceTelegram) SetPassword(val OptString) {
-	s.Password = val
+type FileUpdateReq struct {
+	// Updated name of the file.
+	Name string `json:"name"`
 }
 
-// SetSettings sets the value of Settings.
-func (s *DatasourceTelegram) SetSettings(val OptDatasourceTelegramSettings) {
-	s.Settings = val
+// GetName returns the value of Name.
+func (s *FileUpdateReq) GetName() string {
+	return s.Name
 }
 
-// SetSessionHistory sets the value of SessionHistory.
-func (s *DatasourceTelegram) SetSessionHistory(val TelegramSessionHistory) {
-	s.SessionHistory = val
+// SetName sets the value of Name.
+func (s *FileUpdateReq) SetName(val string) {
+	s.Name = val
 }
 
-// SetParticipants sets the value of Participants.
-func (s *DatasourceTelegram) SetParticipants(val TelegramParticipants) {
-	s.Participants = val
+// Request to generate a download link.
+// Ref: #/GenerateDownloadLinkRequest
+type GenerateDownloadLinkRequest struct {
+	FileUUID OptString `json:"file_uuid"`
+	// Expiration time in seconds for the download link.
+	Expiration OptInt `json:"expiration"`
 }
 
-// SetMeta sets the value of Meta.
-func (s *DatasourceTelegram) SetMeta(val OptDatasourceTelegramMeta) {
-	s.Meta = val
+// GetFileUUID returns the value of FileUUID.
+func (s *GenerateDownloadLinkRequest) GetFileUUID() OptString {
+	return s.FileUUID
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *DatasourceTelegram) SetCreatedAt(val OptDateTime) {
-	s.CreatedAt = val
+// GetExpiration returns the value of Expiration.
+func (s *GenerateDownloadLinkRequest) GetExpiration() OptInt {
+	return s.Expiration
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *DatasourceTelegram) SetUpdatedAt(val OptDateTime) {
-	s.UpdatedAt = val
+// SetFileUUID sets the value of FileUUID.
+func (s *GenerateDownloadLinkRequest) SetFileUUID(val OptString) {
+	s.FileUUID = val
 }
 
-// DatasourceTelegramDeleteOK is response for DatasourceTelegramDelete operation.
-type DatasourceTelegramDeleteOK struct{}
-
-// Arbitrary key-value metadata about the account.
-type DatasourceTelegramMeta map[string]jx.Raw
+// SetExpiration sets the value of Expiration.
+func (s *GenerateDownloadLinkRequest) SetExpiration(val OptInt) {
+	s.Expiration = val
+}
 
-func (s *DatasourceTelegramMeta) init() DatasourceTelegramMeta {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// Response containing a signed download link.
+// Ref: #/GenerateDownloadLinkResponse
+type GenerateDownloadLinkResponse struct {
+	// Signed URL for downloading the file.
+	URL OptString `json:"url"`
 }
 
-// Additional Telegram bridging config from telegram.tpl.yaml
-// (proxy, concurrency, presence bridging, encryption, etc.).
-type DatasourceTelegramSettings map[string]jx.Raw
+// GetURL returns the value of URL.
+func (s *GenerateDownloadLinkResponse) GetURL() OptString {
+	return s.URL
+}
 
-func (s *DatasourceTelegramSettings) init() DatasourceTelegramSettings {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// SetURL sets the value of URL.
+func (s *GenerateDownloadLinkResponse) SetURL(val OptString) {
+	s.URL = val
 }
 
-// WhatsApp datasource object representation.
 // Ref: #
-type DatasourceWhatsapp struct {
-	UUID      OptString `json:"uuid"`
-	UserUUID  string    `json:"user_uuid"`
-	Name      string    `json:"name"`
-	IsEnabled OptBool   `json:"is_enabled"`
-	Provider  string    `json:"provider"`
-	// The phone number for the WhatsApp account.
-	PhoneNumber string `json:"phone_number"`
-	// Label for the WhatsApp device connection.
-	DeviceName OptString `json:"device_name"`
-	// Additional WhatsApp bridging config from whatsapp.tpl.yaml
-	// (proxy, presence bridging, call notices, status broadcast, etc.).
-	Settings  OptDatasourceWhatsappSettings `json:"settings"`
-	CreatedAt OptDateTime                   `json:"created_at"`
-	UpdatedAt OptDateTime                   `json:"updated_at"`
+type Message struct {
+	// Unique identifier for the message.
+	UUID OptString `json:"uuid"`
+	// Data source or platform the message originated from - email, whatsapp, telegram, linkedin, custom.
+	Type string `json:"type"`
+	// Specifies the type or classification of the message - text, media, system, notification,
+	// attachment, invite, event, call.
+	Format string `json:"format"`
+	// ID of the chat/conversation this message belongs to.
+	ChatUUID OptString `json:"chat_uuid"`
+	// ID of a sub-thread if this message is part of a threaded conversation.
+	ThreadUUID OptString `json:"thread_uuid"`
+	// Original system's message ID (e.g., Gmail 'messageId').
+	ExternalMessageID OptString `json:"external_message_id"`
+	// UUID of the pipeline that ingested the message.
+	PipelineUUID OptString `json:"pipeline_uuid"`
+	// UUID of the datasource the message was fetched from.
+	DatasourceUUID OptString `json:"datasource_uuid"`
+	// Identifier of the user or account sending the message.
+	Sender string `json:"sender"`
+	// List of users or accounts receiving the message (e.g., To, CC).
+	Recipients []string `json:"recipients"`
+	// Subject or title of the message (applicable to emails or similar).
+	Subject OptString `json:"subject"`
+	// Text content or body of the message.
+	Body       string               `json:"body"`
+	BodyParsed OptMessageBodyParsed `json:"body_parsed"`
+	// Collection of reactions (e.g., likes, emojis) applied to this message.
+	Reactions OptMessageReactions `json:"reactions"`
+	// List of file attachments associated with this message.
+	Attachments []FileObject `json:"attachments"`
+	// ID or handle of the original sender if this message is forwarded.
+	ForwardFrom OptString `json:"forward_from"`
+	// ID of the message this one is directly replying to, if any.
+	ReplyToMessageUUID OptString `json:"reply_to_message_uuid"`
+	// ID of the original chat if this message is forwarded.
+	ForwardFromChatUUID OptString `json:"forward_from_chat_uuid"`
+	// ID of the original message if this is forwarded.
+	ForwardFromMessageUUID OptString `json:"forward_from_message_uuid"`
+	// Additional context or metadata about the forwarded message.
+	ForwardMeta OptMessageForwardMeta `json:"forward_meta"`
+	Meta        OptMessageMeta        `json:"meta"`
+	// The date and time when the object was created.
+	CreatedAt OptDateTime `json:"created_at"`
+	// The date and time when the message was last updated.
+	UpdatedAt OptDateTime `json:"updated_at"`
+	// Relevance of the message to a semantic query, from 0 to 1. Set by semantic searches only.
+	Score OptFloat64 `json:"score"`
 }
 
 // GetUUID returns the value of UUID.
-func (s *DatasourceWhatsapp) GetUUID() OptString {
+func (s *Message) GetUUID() OptString {
 	return s.UUID
 }
 
-// GetUserUUID returns the value of UserUUID.
-func (s *DatasourceWhatsapp) GetUserUUID() string {
-	return s.UserUUID
+// GetType returns the value of Type.
+func (s *Message) GetType() string {
+	return s.Type
 }
 
-// GetName returns the value of Name.
-func (s *DatasourceWhatsapp) GetName() string {
-	return s.Name
+// GetFormat returns the value of Format.
+func (s *Message) GetFormat() string {
+	return s.Format
 }
 
-// GetIsEnabled returns the value of IsEnabled.
-func (s *DatasourceWhatsapp) GetIsEnabled() OptBool {
-	return s.IsEnabled
+// GetChatUUID returns the value of ChatUUID.
+func (s *Message) GetChatUUID() OptString {
+	return s.ChatUUID
 }
 
-// GetProvider returns the value of Provider.
-func (s *DatasourceWhatsapp) GetProvider() string {
-	return s.Provider
+// GetThreadUUID returns the value of ThreadUUID.
+func (s *Message) GetThreadUUID() OptString {
+	return s.ThreadUUID
 }
 
-// GetPhoneNumber returns the value of PhoneNumber.
-func (s *DatasourceWhatsapp) GetPhoneNumber() string {
-	return s.PhoneNumber
+// GetExternalMessageID returns the value of ExternalMessageID.
+func (s *Message) GetExternalMessageID() OptString {
+	return s.ExternalMessageID
 }
 
-// GetDeviceName returns the value of DeviceName.
-func (s *DatasourceWhatsapp) GetDeviceName() OptString {
-	return s.DeviceName
+// GetPipelineUUID returns the value of PipelineUUID.
+func (s *Message) GetPipelineUUID() OptString {
+	return s.PipelineUUID
 }
 
-// GetSettings returns the value of Settings.
-func (s *DatasourceWhatsapp) GetSettings() OptDatasourceWhatsappSettings {
-	return s.Settings
+// GetDatasourceUUID returns the value of DatasourceUUID.
+func (s *Message) GetDatasourceUUID() OptString {
+	return s.DatasourceUUID
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *DatasourceWhatsapp) GetCreatedAt() OptDateTime {
-	return s.CreatedAt
+// GetSender returns the value of Sender.
+func (s *Message) GetSender() string {
+	return s.Sender
+}
+
+// GetRecipients returns the value of Recipients.
+func (s *Message) GetRecipients() []string {
+	return s.Recipients
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *DatasourceWhatsapp) GetUpdatedAt() OptDateTime {
-	return s.UpdatedAt
+// GetSubject returns the value of Subject.
+func (s *Message) GetSubject() OptString {
+	return s.Subject
 }
 
-// SetUUID sets the value of UUID.
-func (s *DatasourceWhatsapp) SetUUID(val OptString) {
-	s.UUID = val
+// GetBody returns the value of Body.
+func (s *Message) GetBody() string {
+	return s.Body
 }
 
-// SetUserUUID sets the value of UserUUID.
-func (s *DatasourceWhatsapp) SetUserUUID(val string) {
-	s.UserUUID = val
+// GetBodyParsed returns the value of BodyParsed.
+func (s *Message) GetBodyParsed() OptMessageBodyParsed {
+	return s.BodyParsed
 }
 
-// SetName sets the value of Name.
-func (s *DatasourceWhatsapp) SetName(val string) {
-	s.Name = val
+// GetReactions returns the value of Reactions.
+func (s *Message) GetReactions() OptMessageReactions {
+	return s.Reactions
 }
 
-// SetIsEnabled sets the value of IsEnabled.
-func (s *DatasourceWhatsapp) SetIsEnabled(val OptBool) {
-	s.IsEnabled = val
+// GetAttachments returns the value of Attachments.
+func (s *Message) GetAttachments() []FileObject {
+	return s.Attachments
 }
 
-// SetProvider sets the value of Provider.
-func (s *DatasourceWhatsapp) SetProvider(val string) {
-	s.Provider = val
+// GetForwardFrom returns the value of ForwardFrom.
+func (s *Message) GetForwardFrom() OptString {
+	return s.ForwardFrom
 }
 
-// SetPhoneNumber sets the value of PhoneNumber.
-func (s *DatasourceWhatsapp) SetPhoneNumber(val string) {
-	s.PhoneNumber = val
+// GetReplyToMessageUUID returns the value of ReplyToMessageUUID.
+func (s *Message) GetReplyToMessageUUID() OptString {
+	return s.ReplyToMessageUUID
 }
 
-// SetDeviceName sets the value of DeviceName.
-func (s *DatasourceWhatsapp) SetDeviceName(val OptString) {
-	s.DeviceName = val
+// GetForwardFromChatUUID returns the value of ForwardFromChatUUID.
+func (s *Message) GetForwardFromChatUUID() OptString {
+	return s.ForwardFromChatUUID
 }
 
-// SetSettings sets the value of Settings.
-func (s *DatasourceWhatsapp) SetSettings(val OptDatasourceWhatsappSettings) {
-	s.Settings = val
+// GetForwardFromMessageUUID returns the value of ForwardFromMessageUUID.
+func (s *Message) GetForwardFromMessageUUID() OptString {
+	return s.ForwardFromMessageUUID
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *DatasourceWhatsapp) SetCreatedAt(val OptDateTime) {
-	s.CreatedAt = val
+// GetForwardMeta returns the value of ForwardMeta.
+func (s *Message) GetForwardMeta() OptMessageForwardMeta {
+	return s.ForwardMeta
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *DatasourceWhatsapp) SetUpdatedAt(val OptDateTime) {
-	s.UpdatedAt = val
+// GetMeta returns the value of Meta.
+func (s *Message) GetMeta() OptMessageMeta {
+	return s.Meta
 }
 
-// DatasourceWhatsappDeleteOK is response for DatasourceWhatsappDelete operation.
-type DatasourceWhatsappDeleteOK struct{}
-
-// Additional WhatsApp bridging config from whatsapp.tpl.yaml
-// (proxy, presence bridging, call notices, status broadcast, etc.).
-type DatasourceWhatsappSettings map[string]jx.Raw
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Message) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
 
-func (s *DatasourceWhatsappSettings) init() DatasourceWhatsappSettings {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Message) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
 }
 
-// DeleteContactOK is response for DeleteContact operation.
-type DeleteContactOK struct{}
+// GetScore returns the value of Score.
+func (s *Message) GetScore() OptFloat64 {
+	return s.Score
+}
 
-// DeleteUserOK is response for DeleteUser operation.
-type DeleteUserOK struct{}
+// SetUUID sets the value of UUID.
+func (s *Message) SetUUID(val OptString) {
+	s.UUID = val
+}
 
-// Ref: #
-type Error struct {
-	// A human-readable explanation specific to this occurrence of the problem.
-	Detail OptString `json:"detail"`
-	// Optional list of individual error details.
-	Errors []ErrorErrorsItem `json:"errors"`
-	// HTTP status code.
-	Status OptInt64 `json:"status"`
+// SetType sets the value of Type.
+func (s *Message) SetType(val string) {
+	s.Type = val
 }
 
-// GetDetail returns the value of Detail.
-func (s *Error) GetDetail() OptString {
-	return s.Detail
+// SetFormat sets the value of Format.
+func (s *Message) SetFormat(val string) {
+	s.Format = val
 }
 
-// GetErrors returns the value of Errors.
-func (s *Error) GetErrors() []ErrorErrorsItem {
-	return s.Errors
+// SetChatUUID sets the value of ChatUUID.
+func (s *Message) SetChatUUID(val OptString) {
+	s.ChatUUID = val
 }
 
-// GetStatus returns the value of Status.
-func (s *Error) GetStatus() OptInt64 {
-	return s.Status
+// SetThreadUUID sets the value of ThreadUUID.
+func (s *Message) SetThreadUUID(val OptString) {
+	s.ThreadUUID = val
 }
 
-// SetDetail sets the value of Detail.
-func (s *Error) SetDetail(val OptString) {
-	s.Detail = val
+// SetExternalMessageID sets the value of ExternalMessageID.
+func (s *Message) SetExternalMessageID(val OptString) {
+	s.ExternalMessageID = val
 }
 
-// SetErrors sets the value of Errors.
-func (s *Error) SetErrors(val []ErrorErrorsItem) {
-	s.Errors = val
+// SetPipelineUUID sets the value of PipelineUUID.
+func (s *Message) SetPipelineUUID(val OptString) {
+	s.PipelineUUID = val
 }
 
-// SetStatus sets the value of Status.
-func (s *Error) SetStatus(val OptInt64) {
-	s.Status = val
+// SetDatasourceUUID sets the value of DatasourceUUID.
+func (s *Message) SetDatasourceUUID(val OptString) {
+	s.DatasourceUUID = val
 }
 
-type ErrorErrorsItem struct {
-	// Where the error occurred, e.g. 'body.items[3].tags' or 'path.thing-id'.
-	Location OptString `json:"location"`
-	// Error message text.
-	Message OptString `json:"message"`
-	// The value at the given location.
-	Value OptString `json:"value"`
+// SetSender sets the value of Sender.
+func (s *Message) SetSender(val string) {
+	s.Sender = val
 }
 
-// GetLocation returns the value of Location.
-func (s *ErrorErrorsItem) GetLocation() OptString {
-	return s.Location
+// SetRecipients sets the value of Recipients.
+func (s *Message) SetRecipients(val []string) {
+	s.Recipients = val
 }
 
-// GetMessage returns the value of Message.
-func (s *ErrorErrorsItem) GetMessage() OptString {
-	return s.Message
+// SetSubject sets the value of Subject.
+func (s *Message) SetSubject(val OptString) {
+	s.Subject = val
 }
 
-// GetValue returns the value of Value.
-func (s *ErrorErrorsItem) GetValue() OptString {
-	return s.Value
+// SetBody sets the value of Body.
+func (s *Message) SetBody(val string) {
+	s.Body = val
 }
 
-// SetLocation sets the value of Location.
-func (s *ErrorErrorsItem) SetLocation(val OptString) {
-	s.Location = val
+// SetBodyParsed sets the value of BodyParsed.
+func (s *Message) SetBodyParsed(val OptMessageBodyParsed) {
+	s.BodyParsed = val
 }
 
-// SetMessage sets the value of Message.
-func (s *ErrorErrorsItem) SetMessage(val OptString) {
-	s.Message = val
+// SetReactions sets the value of Reactions.
+func (s *Message) SetReactions(val OptMessageReactions) {
+	s.Reactions = val
 }
 
-// SetValue sets the value of Value.
-func (s *ErrorErrorsItem) SetValue(val OptString) {
-	s.Value = val
+// SetAttachments sets the value of Attachments.
+func (s *Message) SetAttachments(val []FileObject) {
+	s.Attachments = val
 }
 
-// ErrorStatusCode wraps Error with StatusCode.
-type ErrorStatusCode struct {
-	StatusCode int
-	Response   Error
+// SetForwardFrom sets the value of ForwardFrom.
+func (s *Message) SetForwardFrom(val OptString) {
+	s.ForwardFrom = val
 }
 
-// GetStatusCode returns the value of StatusCode.
-func (s *ErrorStatusCode) GetStatusCode() int {
-	return s.StatusCode
+// SetReplyToMessageUUID sets the value of ReplyToMessageUUID.
+func (s *Message) SetReplyToMessageUUID(val OptString) {
+	s.ReplyToMessageUUID = val
 }
 
-// GetResponse returns the value of Response.
-func (s *ErrorStatusCode) GetResponse() Error {
-	return s.Response
+// SetForwardFromChatUUID sets the value of ForwardFromChatUUID.
+func (s *Message) SetForwardFromChatUUID(val OptString) {
+	s.ForwardFromChatUUID = val
 }
 
-// SetStatusCode sets the value of StatusCode.
-func (s *ErrorStatusCode) SetStatusCode(val int) {
-	s.StatusCode = val
+// SetForwardFromMessageUUID sets the value of ForwardFromMessageUUID.
+func (s *Message) SetForwardFromMessageUUID(val OptString) {
+	s.ForwardFromMessageUUID = val
 }
 
-// SetResponse sets the value of Response.
-func (s *ErrorStatusCode) SetResponse(val Error) {
-	s.Response = val
+// SetForwardMeta sets the value of ForwardMeta.
+func (s *Message) SetForwardMeta(val OptMessageForwardMeta) {
+	s.ForwardMeta = val
 }
 
-// FileDeleteOK is response for FileDelete operation.
-type FileDeleteOK struct{}
+// SetMeta sets the value of Meta.
+func (s *Message) SetMeta(val OptMessageMeta) {
+	s.Meta = val
+}
 
-// Represents a stored file, independent of the storage backend.
-// Ref: #/FileObject
-type FileObject struct {
-	// Unique identifier for the file.
-	UUID OptString `json:"uuid"`
-	// Which storage backend is used, e.g. 's3', 'postgres', or 'hostfiles'.
-	StorageType string `json:"storage_type"`
-	// UUID referencing a 'storage' record (optional).
-	StorageUUID string `json:"storage_uuid"`
-	// Original filename. e.g. 'photo.jpg' or 'mail.raw'.
-	Name string `json:"name"`
-	// MIME type, e.g. 'application/pdf' or 'message/rfc822'.
-	MimeType OptString `json:"mime_type"`
-	// Size in bytes.
-	Size OptInt `json:"size"`
-	// Optional. If storage_type='postgres', file content can be provided here (base64-encoded).
-	Data OptString `json:"data"`
-	// Optional. If hostfiles or s3, the path or object key. e.g. 'my-bucket/xx/uuid.pdf'.
-	Path OptString `json:"path"`
-	// Indicates if this file is the entire raw email.
-	IsRaw OptBool `json:"is_raw"`
-	// Raw headers if needed (for emails or similar).
-	RawHeaders OptString `json:"raw_headers"`
-	// Indicates whether the raw email is contained in this file.
-	HasRawEmail OptBool `json:"has_raw_email"`
-	// Indicates if this is an inline/embedded file (e.g. images in HTML emails).
-	IsInline OptBool `json:"is_inline"`
-	// Timestamp when the file was created.
-	CreatedAt OptDateTime `json:"created_at"`
-	// Timestamp when the file was last modified.
-	UpdatedAt OptDateTime `json:"updated_at"`
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Message) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// GetUUID returns the value of UUID.
-func (s *FileObject) GetUUID() OptString {
-	return s.UUID
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Message) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
 }
 
-// GetStorageType returns the value of StorageType.
-func (s *FileObject) GetStorageType() string {
-	return s.StorageType
+// SetScore sets the value of Score.
+func (s *Message) SetScore(val OptFloat64) {
+	s.Score = val
 }
 
-// GetStorageUUID returns the value of StorageUUID.
-func (s *FileObject) GetStorageUUID() string {
-	return s.StorageUUID
+// Ref: #
+type MessageBodyParsed struct {
+	// Plain text representation of the subject.
+	SubjectText OptString `json:"subject_text"`
+	// Slate.js JSON representation of the subject.
+	SubjectSlate OptMessageBodyParsedSubjectSlate `json:"subject_slate"`
+	// Plain text representation of the body.
+	BodyText string `json:"body_text"`
+	// Binary representation of the body (e.g., base64-encoded rich content).
+	BodyByte []byte `json:"body_byte"`
+	// Slate.js JSON representation of the message body.
+	BodySlate OptMessageBodyParsedBodySlate `json:"body_slate"`
 }
 
-// GetName returns the value of Name.
-func (s *FileObject) GetName() string {
-	return s.Name
+// GetSubjectText returns the value of SubjectText.
+func (s *MessageBodyParsed) GetSubjectText() OptString {
+	return s.SubjectText
 }
 
-// GetMimeType returns the value of MimeType.
-func (s *FileObject) GetMimeType() OptString {
-	return s.MimeType
+// GetSubjectSlate returns the value of SubjectSlate.
+func (s *MessageBodyParsed) GetSubjectSlate() OptMessageBodyParsedSubjectSlate {
+	return s.SubjectSlate
 }
 
-// GetSize returns the value of Size.
-func (s *FileObject) GetSize() OptInt {
-	return s.Size
+// GetBodyText returns the value of BodyText.
+func (s *MessageBodyParsed) GetBodyText() string {
+	return s.BodyText
 }
 
-// GetData returns the value of Data.
-func (s *FileObject) GetData() OptString {
-	return s.Data
+// GetBodyByte returns the value of BodyByte.
+func (s *MessageBodyParsed) GetBodyByte() []byte {
+	return s.BodyByte
 }
 
-// GetPath returns the value of Path.
-func (s *FileObject) GetPath() OptString {
-	return s.Path
+// GetBodySlate returns the value of BodySlate.
+func (s *MessageBodyParsed) GetBodySlate() OptMessageBodyParsedBodySlate {
+	return s.BodySlate
 }
 
-// GetIsRaw returns the value of IsRaw.
-func (s *FileObject) GetIsRaw() OptBool {
-	return s.IsRaw
+// SetSubjectText sets the value of SubjectText.
+func (s *MessageBodyParsed) SetSubjectText(val OptString) {
+	s.SubjectText = val
 }
 
-// GetRawHeaders returns the value of RawHeaders.
-func (s *FileObject) GetRawHeaders() OptString {
-	return s.RawHeaders
+// SetSubjectSlate sets the value of SubjectSlate.
+func (s *MessageBodyParsed) SetSubjectSlate(val OptMessageBodyParsedSubjectSlate) {
+	s.SubjectSlate = val
 }
 
-// GetHasRawEmail returns the value of HasRawEmail.
-func (s *FileObject) GetHasRawEmail() OptBool {
-	return s.HasRawEmail
+// SetBodyText sets the value of BodyText.
+func (s *MessageBodyParsed) SetBodyText(val string) {
+	s.BodyText = val
 }
 
-// GetIsInline returns the value of IsInline.
-func (s *FileObject) GetIsInline() OptBool {
-	return s.IsInline
+// SetBodyByte sets the value of BodyByte.
+func (s *MessageBodyParsed) SetBodyByte(val []byte) {
+	s.BodyByte = val
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *FileObject) GetCreatedAt() OptDateTime {
-	return s.CreatedAt
+// SetBodySlate sets the value of BodySlate.
+func (s *MessageBodyParsed) SetBodySlate(val OptMessageBodyParsedBodySlate) {
+	s.BodySlate = val
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *FileObject) GetUpdatedAt() OptDateTime {
-	return s.UpdatedAt
-}
+// Slate.js JSON representation of the message body.
+type MessageBodyParsedBodySlate map[string]jx.Raw
 
-// SetUUID sets the value of UUID.
-func (s *FileObject) SetUUID(val OptString) {
-	s.UUID = val
+func (s *MessageBodyParsedBodySlate) init() MessageBodyParsedBodySlate {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// SetStorageType sets the value of StorageType.
-func (s *FileObject) SetStorageType(val string) {
-	s.StorageType = val
+// Slate.js JSON representation of the subject.
+type MessageBodyParsedSubjectSlate map[string]jx.Raw
+
+func (s *MessageBodyParsedSubjectSlate) init() MessageBodyParsedSubjectSlate {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// SetStorageUUID sets the value of StorageUUID.
-func (s *FileObject) SetStorageUUID(val string) {
-	s.StorageUUID = val
+type MessageEmailQueryOK struct {
+	// List of messages matching the query.
+	Messages []Message `json:"messages"`
 }
 
-// SetName sets the value of Name.
-func (s *FileObject) SetName(val string) {
-	s.Name = val
+// GetMessages returns the value of Messages.
+func (s *MessageEmailQueryOK) GetMessages() []Message {
+	return s.Messages
 }
 
-// SetMimeType sets the value of MimeType.
-func (s *FileObject) SetMimeType(val OptString) {
-	s.MimeType = val
+// SetMessages sets the value of Messages.
+func (s *MessageEmailQueryOK) SetMessages(val []Message) {
+	s.Messages = val
 }
 
-// SetSize sets the value of Size.
-func (s *FileObject) SetSize(val OptInt) {
-	s.Size = val
+// Additional context or metadata about the forwarded message.
+type MessageForwardMeta map[string]jx.Raw
+
+func (s *MessageForwardMeta) init() MessageForwardMeta {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// SetData sets the value of Data.
-func (s *FileObject) SetData(val OptString) {
-	s.Data = val
+type MessageLinkedinQueryOK struct {
+	// List of messages matching the query.
+	Messages []Message `json:"messages"`
 }
 
-// SetPath sets the value of Path.
-func (s *FileObject) SetPath(val OptString) {
-	s.Path = val
+// GetMessages returns the value of Messages.
+func (s *MessageLinkedinQueryOK) GetMessages() []Message {
+	return s.Messages
 }
 
-// SetIsRaw sets the value of IsRaw.
-func (s *FileObject) SetIsRaw(val OptBool) {
-	s.IsRaw = val
+// SetMessages sets the value of Messages.
+func (s *MessageLinkedinQueryOK) SetMessages(val []Message) {
+	s.Messages = val
 }
 
-// SetRawHeaders sets the value of RawHeaders.
-func (s *FileObject) SetRawHeaders(val OptString) {
-	s.RawHeaders = val
+// Ref: #
+type MessageMeta struct {
+	// Indicates whether this message has a complete raw email stored as an attachment (with FileObject.
+	// is_raw=true).
+	HasRawEmail OptBool `json:"has_raw_email"`
+	// Optional flag indicating if it's inbound (true) or outbound (false).
+	IsIncoming OptBool `json:"is_incoming"`
 }
 
-// SetHasRawEmail sets the value of HasRawEmail.
-func (s *FileObject) SetHasRawEmail(val OptBool) {
-	s.HasRawEmail = val
+// GetHasRawEmail returns the value of HasRawEmail.
+func (s *MessageMeta) GetHasRawEmail() OptBool {
+	return s.HasRawEmail
 }
 
-// SetIsInline sets the value of IsInline.
-func (s *FileObject) SetIsInline(val OptBool) {
-	s.IsInline = val
+// GetIsIncoming returns the value of IsIncoming.
+func (s *MessageMeta) GetIsIncoming() OptBool {
+	return s.IsIncoming
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *FileObject) SetCreatedAt(val OptDateTime) {
-	s.CreatedAt = val
+// SetHasRawEmail sets the value of HasRawEmail.
+func (s *MessageMeta) SetHasRawEmail(val OptBool) {
+	s.HasRawEmail = val
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *FileObject) SetUpdatedAt(val OptDateTime) {
-	s.UpdatedAt = val
+// SetIsIncoming sets the value of IsIncoming.
+func (s *MessageMeta) SetIsIncoming(val OptBool) {
+	s.IsIncoming = val
 }
 
-type FileUpdateReq struct {
-	// Updated name of the file.
-	Name string `json:"name"`
+// Ref: #
+type MessageQuery struct {
+	// Platform or data source to query from.
+	Source MessageQuerySource `json:"source"`
+	// Free text matched against the subject, the body and the sender.
+	Query OptString `json:"query"`
+	// How the query is matched. keyword (the default) matches the text as is, semantic ranks the
+	// messages indexed by an embedder node by a hybrid of the similarity of their embeddings and BM25,
+	// best first. Semantic searches ignore order and need an embeddings provider.
+	Mode OptMessageQueryMode `json:"mode"`
+	// ID of the chat/conversation to filter messages from.
+	ChatID OptString `json:"chat_id"`
+	// ID of a sub-thread within the conversation.
+	ThreadID OptString `json:"thread_id"`
+	// Only messages stored by this pipeline.
+	PipelineUUID OptUUID `json:"pipeline_uuid"`
+	// Filter messages sent after this date/time.
+	StartDate OptDateTime `json:"start_date"`
+	// Filter messages sent before this date/time.
+	EndDate OptDateTime `json:"end_date"`
+	// Sort order by timestamp ('asc' or 'desc').
+	Order OptMessageQueryOrder `json:"order"`
+	// Maximum number of messages to return.
+	Limit OptInt `json:"limit"`
+	// Number of records to skip for pagination.
+	Offset OptInt `json:"offset"`
+	// Specifies the storage backend for message data.
+	StorageType OptMessageQueryStorageType `json:"storage_type"`
+	// Enable fuzzy matching if true.
+	Fuzzy OptBool `json:"fuzzy"`
 }
 
-// GetName returns the value of Name.
-func (s *FileUpdateReq) GetName() string {
-	return s.Name
+// GetSource returns the value of Source.
+func (s *MessageQuery) GetSource() MessageQuerySource {
+	return s.Source
 }
 
-// SetName sets the value of Name.
-func (s *FileUpdateReq) SetName(val string) {
-	s.Name = val
+// GetQuery returns the value of Query.
+func (s *MessageQuery) GetQuery() OptString {
+	return s.Query
 }
 
-// Request to generate a download link.
-// Ref: #/GenerateDownloadLinkRequest
-type GenerateDownloadLinkRequest struct {
-	FileUUID OptString `json:"file_uuid"`
-	// Expiration time in seconds for the download link.
-	Expiration OptInt `json:"expiration"`
+// GetMode returns the value of Mode.
+func (s *MessageQuery) GetMode() OptMessageQueryMode {
+	return s.Mode
 }
 
-// GetFileUUID returns the value of FileUUID.
-func (s *GenerateDownloadLinkRequest) GetFileUUID() OptString {
-	return s.FileUUID
+// GetChatID returns the value of ChatID.
+func (s *MessageQuery) GetChatID() OptString {
+	return s.ChatID
 }
 
-// GetExpiration returns the value of Expiration.
-func (s *GenerateDownloadLinkRequest) GetExpiration() OptInt {
-	return s.Expiration
+// GetThreadID returns the value of ThreadID.
+func (s *MessageQuery) GetThreadID() OptString {
+	return s.ThreadID
 }
 
-// SetFileUUID sets the value of FileUUID.
-func (s *GenerateDownloadLinkRequest) SetFileUUID(val OptString) {
-	s.FileUUID = val
+// GetPipelineUUID returns the value of PipelineUUID.
+func (s *MessageQuery) GetPipelineUUID() OptUUID {
+	return s.PipelineUUID
 }
 
-// SetExpiration sets the value of Expiration.
-func (s *GenerateDownloadLinkRequest) SetExpiration(val OptInt) {
-	s.Expiration = val
+// GetStartDate returns the value of StartDate.
+func (s *MessageQuery) GetStartDate() OptDateTime {
+	return s.StartDate
 }
 
-// Response containing a signed download link.
-// Ref: #/GenerateDownloadLinkResponse
-type GenerateDownloadLinkResponse struct {
-	// Signed URL for downloading the file.
-	URL OptString `json:"url"`
+// GetEndDate returns the value of EndDate.
+func (s *MessageQuery) GetEndDate() OptDateTime {
+	return s.EndDate
 }
 
-// GetURL returns the value of URL.
-func (s *GenerateDownloadLinkResponse) GetURL() OptString {
-	return s.URL
+// GetOrder returns the value of Order.
+func (s *MessageQuery) GetOrder() OptMessageQueryOrder {
+	return s.Order
 }
 
-// SetURL sets the value of URL.
-func (s *GenerateDownloadLinkResponse) SetURL(val OptString) {
-	s.URL = val
+// GetLimit returns the value of Limit.
+func (s *MessageQuery) GetLimit() OptInt {
+	return s.Limit
 }
 
-// Ref: #
-type Message struct {
-	// Unique identifier for the message.
-	UUID OptString `json:"uuid"`
-	// Data source or platform the message originated from - email, whatsapp, telegram, linkedin, custom.
-	Type string `json:"type"`
-	// Specifies the type or classification of the message - text, media, system, notification,
-	// attachment, invite, event, call.
-	Format string `json:"format"`
-	// ID of the chat/conversation this message belongs to.
-	ChatUUID OptString `json:"chat_uuid"`
-	// ID of a sub-thread if this message is part of a threaded conversation.
-	ThreadUUID OptString `json:"thread_uuid"`
-	// Original system's message ID (e.g., Gmail 'messageId').
-	ExternalMessageID OptString `json:"external_message_id"`
-	// Identifier of the user or account sending the message.
-	Sender string `json:"sender"`
-	// List of users or accounts receiving the message (e.g., To, CC).
-	Recipients []string `json:"recipients"`
-	// Subject or title of the message (applicable to emails or similar).
-	Subject OptString `json:"subject"`
-	// Text content or body of the message.
-	Body       string               `json:"body"`
-	BodyParsed OptMessageBodyParsed `json:"body_parsed"`
-	// Collection of reactions (e.g., likes, emojis) applied to this message.
-	Reactions OptMessageReactions `json:"reactions"`
-	// List of file attachments associated with this message.
-	Attachments []FileObject `json:"attachments"`
-	// ID or handle of the original sender if this message is forwarded.
-	ForwardFrom OptString `json:"forward_from"`
-	// ID of the message this one is directly replying to, if any.
-	ReplyToMessageUUID OptString `json:"reply_to_message_uuid"`
-	// ID of the original chat if this message is forwarded.
-	ForwardFromChatUUID OptString `json:"forward_from_chat_uuid"`
-	// ID of the original message if this is forwarded.
-	ForwardFromMessageUUID OptString `json:"forward_from_message_uuid"`
-	// Additional context or metadata about the forwarded message.
-	ForwardMeta OptMessageForwardMeta `json:"forward_meta"`
-	Meta        OptMessageMeta        `json:"meta"`
-	// The date and time when the object was created.
-	CreatedAt OptDateTime `json:"created_at"`
-	// The date and time when the message was last updated.
-	UpdatedAt OptDateTime `json:"updated_at"`
+// GetOffset returns the value of Offset.
+func (s *MessageQuery) GetOffset() OptInt {
+	return s.Offset
 }
 
-// GetUUID returns the value of UUID.
-func (s *Message) GetUUID() OptString {
-	return s.UUID
+// GetStorageType returns the value of StorageType.
+func (s *MessageQuery) GetStorageType() OptMessageQueryStorageType {
+	return s.StorageType
 }
 
-// GetType returns the value of Type.
-func (s *Message) GetType() string {
-	return s.Type
+// GetFuzzy returns the value of Fuzzy.
+func (s *MessageQuery) GetFuzzy() OptBool {
+	return s.Fuzzy
 }
 
-// GetFormat returns the value of Format.
-func (s *Message) GetFormat() string {
-	return s.Format
+// SetSource sets the value of Source.
+func (s *MessageQuery) SetSource(val MessageQuerySource) {
+	s.Source = val
 }
 
-// GetChatUUID returns the value of ChatUUID.
-func (s *Message) GetChatUUID() OptString {
-	return s.ChatUUID
+// SetQuery sets the value of Query.
+func (s *MessageQuery) SetQuery(val OptString) {
+	s.Query = val
 }
 
-// GetThreadUUID returns the value of ThreadUUID.
-func (s *Message) GetThreadUUID() OptString {
-	return s.ThreadUUID
+// SetMode sets the value of Mode.
+func (s *MessageQuery) SetMode(val OptMessageQueryMode) {
+	s.Mode = val
 }
 
-// GetExternalMessageID returns the value of ExternalMessageID.
-func (s *Message) GetExternalMessageID() OptString {
-	return s.ExternalMessageID
+// SetChatID sets the value of ChatID.
+func (s *MessageQuery) SetChatID(val OptString) {
+	s.ChatID = val
 }
 
-// GetSender returns the value of Sender.
-func (s *Message) GetSender() string {
-	return s.Sender
+// SetThreadID sets the value of ThreadID.
+func (s *MessageQuery) SetThreadID(val OptString) {
+	s.ThreadID = val
 }
 
-// GetRecipients returns the value of Recipients.
-func (s *Message) GetRecipients() []string {
-	return s.Recipients
+// SetPipelineUUID sets the value of PipelineUUID.
+func (s *MessageQuery) SetPipelineUUID(val OptUUID) {
+	s.PipelineUUID = val
 }
 
-// GetSubject returns the value of Subject.
-func (s *Message) GetSubject() OptString {
-	return s.Subject
+// SetStartDate sets the value of StartDate.
+func (s *MessageQuery) SetStartDate(val OptDateTime) {
+	s.StartDate = val
 }
 
-// GetBody returns the value of Body.
-func (s *Message) GetBody() string {
-	return s.Body
+// SetEndDate sets the value of EndDate.
+func (s *MessageQuery) SetEndDate(val OptDateTime) {
+	s.EndDate = val
 }
 
-// GetBodyParsed returns the value of BodyParsed.
-func (s *Message) GetBodyParsed() OptMessageBodyParsed {
-	return s.BodyParsed
+// SetOrder sets the value of Order.
+func (s *MessageQuery) SetOrder(val OptMessageQueryOrder) {
+	s.Order = val
 }
 
-// GetReactions returns the value of Reactions.
-func (s *Message) GetReactions() OptMessageReactions {
-	return s.Reactions
+// SetLimit sets the value of Limit.
+func (s *MessageQuery) SetLimit(val OptInt) {
+	s.Limit = val
 }
 
-// GetAttachments returns the value of Attachments.
-func (s *Message) GetAttachments() []FileObject {
-	return s.Attachments
+// SetOffset sets the value of Offset.
+func (s *MessageQuery) SetOffset(val OptInt) {
+	s.Offset = val
 }
 
-// GetForwardFrom returns the value of ForwardFrom.
-func (s *Message) GetForwardFrom() OptString {
-	return s.ForwardFrom
+// SetStorageType sets the value of StorageType.
+func (s *MessageQuery) SetStorageType(val OptMessageQueryStorageType) {
+	s.StorageType = val
 }
 
-// GetReplyToMessageUUID returns the value of ReplyToMessageUUID.
-func (s *Message) GetReplyToMessageUUID() OptString {
-	return s.ReplyToMessageUUID
+// SetFuzzy sets the value of Fuzzy.
+func (s *MessageQuery) SetFuzzy(val OptBool) {
+	s.Fuzzy = val
 }
 
-// GetForwardFromChatUUID returns the value of ForwardFromChatUUID.
-func (s *Message) GetForwardFromChatUUID() OptString {
-	return s.ForwardFromChatUUID
+// How the query is matched. keyword (the default) matches the text as is, semantic ranks the
+// messages indexed by an embedder node by a hybrid of the similarity of their embeddings and BM25,
+// best first. Semantic searches ignore order and need an embeddings provider.
+type MessageQueryMode string
+
+const (
+	MessageQueryModeKeyword  MessageQueryMode = "keyword"
+	MessageQueryModeSemantic MessageQueryMode = "semantic"
+)
+
+// AllValues returns all MessageQueryMode values.
+func (MessageQueryMode) AllValues() []MessageQueryMode {
+	return []MessageQueryMode{
+		MessageQueryModeKeyword,
+		MessageQueryModeSemantic,
+	}
 }
 
-// GetForwardFromMessageUUID returns the value of ForwardFromMessageUUID.
-func (s *Message) GetForwardFromMessageUUID() OptString {
-	return s.ForwardFromMessageUUID
+// MarshalText implements encoding.TextMarshaler.
+func (s MessageQueryMode) MarshalText() ([]byte, error) {
+	switch s {
+	case MessageQueryModeKeyword:
+		return []byte(s), nil
+	case MessageQueryModeSemantic:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetForwardMeta returns the value of ForwardMeta.
-func (s *Message) GetForwardMeta() OptMessageForwardMeta {
-	return s.ForwardMeta
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *MessageQueryMode) UnmarshalText(data []byte) error {
+	switch MessageQueryMode(data) {
+	case MessageQueryModeKeyword:
+		*s = MessageQueryModeKeyword
+		return nil
+	case MessageQueryModeSemantic:
+		*s = MessageQueryModeSemantic
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetMeta returns the value of Meta.
-func (s *Message) GetMeta() OptMessageMeta {
-	return s.Meta
+type MessageQueryOK struct {
+	// List of messages matching the query.
+	Messages []Message `json:"messages"`
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *Message) GetCreatedAt() OptDateTime {
-	return s.CreatedAt
+// GetMessages returns the value of Messages.
+func (s *MessageQueryOK) GetMessages() []Message {
+	return s.Messages
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *Message) GetUpdatedAt() OptDateTime {
-	return s.UpdatedAt
+// SetMessages sets the value of Messages.
+func (s *MessageQueryOK) SetMessages(val []Message) {
+	s.Messages = val
 }
 
-// SetUUID sets the value of UUID.
-func (s *Message) SetUUID(val OptString) {
-	s.UUID = val
+// Sort order by timestamp ('asc' or 'desc').
+type MessageQueryOrder string
+
+const (
+	MessageQueryOrderAsc  MessageQueryOrder = "asc"
+	MessageQueryOrderDesc MessageQueryOrder = "desc"
+)
+
+// AllValues returns all MessageQueryOrder values.
+func (MessageQueryOrder) AllValues() []MessageQueryOrder {
+	return []MessageQueryOrder{
+		MessageQueryOrderAsc,
+		MessageQueryOrderDesc,
+	}
 }
 
-// SetType sets the value of Type.
-func (s *Message) SetType(val string) {
-	s.Type = val
+// MarshalText implements encoding.TextMarshaler.
+func (s MessageQueryOrder) MarshalText() ([]byte, error) {
+	switch s {
+	case MessageQueryOrderAsc:
+		return []byte(s), nil
+	case MessageQueryOrderDesc:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetFormat sets the value of Format.
-func (s *Message) SetFormat(val string) {
-	s.Format = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *MessageQueryOrder) UnmarshalText(data []byte) error {
+	switch MessageQueryOrder(data) {
+	case MessageQueryOrderAsc:
+		*s = MessageQueryOrderAsc
+		return nil
+	case MessageQueryOrderDesc:
+		*s = MessageQueryOrderDesc
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetChatUUID sets the value of ChatUUID.
-func (s *Message) SetChatUUID(val OptString) {
-	s.ChatUUID = val
+// Platform or data source to query from.
+type MessageQuerySource string
+
+const (
+	MessageQuerySourceEmail    MessageQuerySource = "email"
+	MessageQuerySourceWhatsapp MessageQuerySource = "whatsapp"
+	MessageQuerySourceTelegram MessageQuerySource = "telegram"
+	MessageQuerySourceLinkedin MessageQuerySource = "linkedin"
+	MessageQuerySourceCustom   MessageQuerySource = "custom"
+	MessageQuerySourceUnified  MessageQuerySource = "unified"
+)
+
+// AllValues returns all MessageQuerySource values.
+func (MessageQuerySource) AllValues() []MessageQuerySource {
+	return []MessageQuerySource{
+		MessageQuerySourceEmail,
+		MessageQuerySourceWhatsapp,
+		MessageQuerySourceTelegram,
+		MessageQuerySourceLinkedin,
+		MessageQuerySourceCustom,
+		MessageQuerySourceUnified,
+	}
 }
 
-// SetThreadUUID sets the value of ThreadUUID.
-func (s *Message) SetThreadUUID(val OptString) {
-	s.ThreadUUID = val
+// MarshalText implements encoding.TextMarshaler.
+func (s MessageQuerySource) MarshalText() ([]byte, error) {
+	switch s {
+	case MessageQuerySourceEmail:
+		return []byte(s), nil
+	case MessageQuerySourceWhatsapp:
+		return []byte(s), nil
+	case MessageQuerySourceTelegram:
+		return []byte(s), nil
+	case MessageQuerySourceLinkedin:
+		return []byte(s), nil
+	case MessageQuerySourceCustom:
+		return []byte(s), nil
+	case MessageQuerySourceUnified:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetExternalMessageID sets the value of ExternalMessageID.
-func (s *Message) SetExternalMessageID(val OptString) {
-	s.ExternalMessageID = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *MessageQuerySource) UnmarshalText(data []byte) error {
+	switch MessageQuerySource(data) {
+	case MessageQuerySourceEmail:
+		*s = MessageQuerySourceEmail
+		return nil
+	case MessageQuerySourceWhatsapp:
+		*s = MessageQuerySourceWhatsapp
+		return nil
+	case MessageQuerySourceTelegram:
+		*s = MessageQuerySourceTelegram
+		return nil
+	case MessageQuerySourceLinkedin:
+		*s = MessageQuerySourceLinkedin
+		return nil
+	case MessageQuerySourceCustom:
+		*s = MessageQuerySourceCustom
+		return nil
+	case MessageQuerySourceUnified:
+		*s = MessageQuerySourceUnified
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetSender sets the value of Sender.
-func (s *Message) SetSender(val string) {
-	s.Sender = val
-}
+// Specifies the storage backend for message data.
+type MessageQueryStorageType string
 
-// SetRecipients sets the value of Recipients.
-func (s *Message) SetRecipients(val []string) {
-	s.Recipients = val
-}
+const (
+	MessageQueryStorageTypePostgres  MessageQueryStorageType = "postgres"
+	MessageQueryStorageTypeS3        MessageQueryStorageType = "s3"
+	MessageQueryStorageTypeHostfiles MessageQueryStorageType = "hostfiles"
+)
 
-// SetSubject sets the value of Subject.
-func (s *Message) SetSubject(val OptString) {
-	s.Subject = val
+// AllValues returns all MessageQueryStorageType values.
+func (MessageQueryStorageType) AllValues() []MessageQueryStorageType {
+	return []MessageQueryStorageType{
+		MessageQueryStorageTypePostgres,
+		MessageQueryStorageTypeS3,
+		MessageQueryStorageTypeHostfiles,
+	}
 }
 
-// SetBody sets the value of Body.
-func (s *Message) SetBody(val string) {
-	s.Body = val
+// MarshalText implements encoding.TextMarshaler.
+func (s MessageQueryStorageType) MarshalText() ([]byte, error) {
+	switch s {
+	case MessageQueryStorageTypePostgres:
+		return []byte(s), nil
+	case MessageQueryStorageTypeS3:
+		return []byte(s), nil
+	case MessageQueryStorageTypeHostfiles:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetBodyParsed sets the value of BodyParsed.
-func (s *Message) SetBodyParsed(val OptMessageBodyParsed) {
-	s.BodyParsed = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *MessageQueryStorageType) UnmarshalText(data []byte) error {
+	switch MessageQueryStorageType(data) {
+	case MessageQueryStorageTypePostgres:
+		*s = MessageQueryStorageTypePostgres
+		return nil
+	case MessageQueryStorageTypeS3:
+		*s = MessageQueryStorageTypeS3
+		return nil
+	case MessageQueryStorageTypeHostfiles:
+		*s = MessageQueryStorageTypeHostfiles
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetReactions sets the value of Reactions.
-func (s *Message) SetReactions(val OptMessageReactions) {
-	s.Reactions = val
-}
+// Collection of reactions (e.g., likes, emojis) applied to this message.
+type MessageReactions map[string]int
 
-// SetAttachments sets the value of Attachments.
-func (s *Message) SetAttachments(val []FileObject) {
-	s.Attachments = val
+func (s *MessageReactions) init() MessageReactions {
+	m := *s
+	if m == nil {
+		m = map[string]int{}
+		*s = m
+	}
+	return m
 }
 
-// SetForwardFrom sets the value of ForwardFrom.
-func (s *Message) SetForwardFrom(val OptString) {
-	s.ForwardFrom = val
+type MessageTelegramQueryOK struct {
+	// List of messages matching the query.
+	Messages []Message `json:"messages"`
 }
 
-// SetReplyToMessageUUID sets the value of ReplyToMessageUUID.
-func (s *Message) SetReplyToMessageUUID(val OptString) {
-	s.ReplyToMessageUUID = val
+// GetMessages returns the value of Messages.
+func (s *MessageTelegramQueryOK) GetMessages() []Message {
+	return s.Messages
 }
 
-// SetForwardFromChatUUID sets the value of ForwardFromChatUUID.
-func (s *Message) SetForwardFromChatUUID(val OptString) {
-	s.ForwardFromChatUUID = val
+// SetMessages sets the value of Messages.
+func (s *MessageTelegramQueryOK) SetMessages(val []Message) {
+	s.Messages = val
 }
 
-// SetForwardFromMessageUUID sets the value of ForwardFromMessageUUID.
-func (s *Message) SetForwardFromMessageUUID(val OptString) {
-	s.ForwardFromMessageUUID = val
+type MessageWhatsappQueryOK struct {
+	// List of messages matching the query.
+	Messages []Message `json:"messages"`
 }
 
-// SetForwardMeta sets the value of ForwardMeta.
-func (s *Message) SetForwardMeta(val OptMessageForwardMeta) {
-	s.ForwardMeta = val
+// GetMessages returns the value of Messages.
+func (s *MessageWhatsappQueryOK) GetMessages() []Message {
+	return s.Messages
 }
 
-// SetMeta sets the value of Meta.
-func (s *Message) SetMeta(val OptMessageMeta) {
-	s.Meta = val
+// SetMessages sets the value of Messages.
+func (s *MessageWhatsappQueryOK) SetMessages(val []Message) {
+	s.Messages = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *Message) SetCreatedAt(val OptDateTime) {
-	s.CreatedAt = val
+// Ref: #
+type OAuth2Client struct {
+	// Internal unique ID for the client.
+	UUID OptString `json:"uuid"`
+	// Friendly name for the admin UI.
+	Name string `json:"name"`
+	// Name of the registered OAuth2 provider (e.g., 'github', 'google', 'zitadel').
+	Provider string `json:"provider"`
+	// OAuth2 client ID provided by the external provider.
+	ClientID string `json:"client_id"`
+	// OAuth2 client secret. Encrypted at rest and returned as '********'; sending '********' back on
+	// update keeps the stored value.
+	Secret string `json:"secret"`
+	// Issuer URL of providers using OpenID discovery.
+	IssuerURL OptString `json:"issuer_url"`
+	// Scopes requested instead of the provider defaults.
+	Scopes []string `json:"scopes"`
+	// Timestamp when the client was registered.
+	CreatedAt OptDateTime `json:"created_at"`
+	// Timestamp when the client was last updated.
+	UpdatedAt OptDateTime `json:"updated_at"`
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *Message) SetUpdatedAt(val OptDateTime) {
-	s.UpdatedAt = val
+// GetUUID returns the value of UUID.
+func (s *OAuth2Client) GetUUID() OptString {
+	return s.UUID
 }
 
-// Ref: #
-type MessageBodyParsed struct {
-	// Plain text representation of the subject.
-	SubjectText OptString `json:"subject_text"`
-	// Slate.js JSON representation of the subject.
-	SubjectSlate OptMessageBodyParsedSubjectSlate `json:"subject_slate"`
-	// Plain text representation of the body.
-	BodyText string `json:"body_text"`
-	// Binary representation of the body (e.g., base64-encoded rich content).
-	BodyByte []byte `json:"body_byte"`
-	// Slate.js JSON representation of the message body.
-	BodySlate OptMessageBodyParsedBodySlate `json:"body_slate"`
+// GetName returns the value of Name.
+func (s *OAuth2Client) GetName() string {
+	return s.Name
 }
 
-// GetSubjectText returns the value of SubjectText.
-func (s *MessageBodyParsed) GetSubjectText() OptString {
-	return s.SubjectText
+// GetProvider returns the value of Provider.
+func (s *OAuth2Client) GetProvider() string {
+	return s.Provider
 }
 
-// GetSubjectSlate returns the value of SubjectSlate.
-func (s *MessageBodyParsed) GetSubjectSlate() OptMessageBodyParsedSubjectSlate {
-	return s.SubjectSlate
+// GetClientID returns the value of ClientID.
+func (s *OAuth2Client) GetClientID() string {
+	return s.ClientID
 }
 
-// GetBodyText returns the value of BodyText.
-func (s *MessageBodyParsed) GetBodyText() string {
-	return s.BodyText
+// GetSecret returns the value of Secret.
+func (s *OAuth2Client) GetSecret() string {
+	return s.Secret
 }
 
-// GetBodyByte returns the value of BodyByte.
-func (s *MessageBodyParsed) GetBodyByte() []byte {
-	return s.BodyByte
+// GetIssuerURL returns the value of IssuerURL.
+func (s *OAuth2Client) GetIssuerURL() OptString {
+	return s.IssuerURL
 }
 
-// GetBodySlate returns the value of BodySlate.
-func (s *MessageBodyParsed) GetBodySlate() OptMessageBodyParsedBodySlate {
-	return s.BodySlate
+// GetScopes returns the value of Scopes.
+func (s *OAuth2Client) GetScopes() []string {
+	return s.Scopes
 }
 
-// SetSubjectText sets the value of SubjectText.
-func (s *MessageBodyParsed) SetSubjectText(val OptString) {
-	s.SubjectText = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *OAuth2Client) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
 }
 
-// SetSubjectSlate sets the value of SubjectSlate.
-func (s *MessageBodyParsed) SetSubjectSlate(val OptMessageBodyParsedSubjectSlate) {
-	s.SubjectSlate = val
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *OAuth2Client) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
 }
 
-// SetBodyText sets the value of BodyText.
-func (s *MessageBodyParsed) SetBodyText(val string) {
-	s.BodyText = val
+// SetUUID sets the value of UUID.
+func (s *OAuth2Client) SetUUID(val OptString) {
+	s.UUID = val
 }
 
-// SetBodyByte sets the value of BodyByte.
-func (s *MessageBodyParsed) SetBodyByte(val []byte) {
-	s.BodyByte = val
+// SetName sets the value of Name.
+func (s *OAuth2Client) SetName(val string) {
+	s.Name = val
 }
 
-// SetBodySlate sets the value of BodySlate.
-func (s *MessageBodyParsed) SetBodySlate(val OptMessageBodyParsedBodySlate) {
-	s.BodySlate = val
+// SetProvider sets the value of Provider.
+func (s *OAuth2Client) SetProvider(val string) {
+	s.Provider = val
 }
 
-// Slate.js JSON representation of the message body.
-type MessageBodyParsedBodySlate map[string]jx.Raw
+// SetClientID sets the value of ClientID.
+func (s *OAuth2Client) SetClientID(val string) {
+	s.ClientID = val
+}
 
-func (s *MessageBodyParsedBodySlate) init() MessageBodyParsedBodySlate {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// SetSecret sets the value of Secret.
+func (s *OAuth2Client) SetSecret(val string) {
+	s.Secret = val
 }
 
-// Slate.js JSON representation of the subject.
-type MessageBodyParsedSubjectSlate map[string]jx.Raw
+// SetIssuerURL sets the value of IssuerURL.
+func (s *OAuth2Client) SetIssuerURL(val OptString) {
+	s.IssuerURL = val
+}
 
-func (s *MessageBodyParsedSubjectSlate) init() MessageBodyParsedSubjectSlate {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// SetScopes sets the value of Scopes.
+func (s *OAuth2Client) SetScopes(val []string) {
+	s.Scopes = val
 }
 
-type MessageEmailQueryOK struct {
-	// List of messages matching the query.
-	Messages []Message `json:"messages"`
+// SetCreatedAt sets the value of CreatedAt.
+func (s *OAuth2Client) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// GetMessages returns the value of Messages.
-func (s *MessageEmailQueryOK) GetMessages() []Message {
-	return s.Messages
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *OAuth2Client) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
 }
 
-// SetMessages sets the value of Messages.
-func (s *MessageEmailQueryOK) SetMessages(val []Message) {
-	s.Messages = val
+// OAuth2ClientCallbackFound is response for OAuth2ClientCallback operation.
+type OAuth2ClientCallbackFound struct {
+	Location OptURI
 }
 
-// Additional context or metadata about the forwarded message.
-type MessageForwardMeta map[string]jx.Raw
+// GetLocation returns the value of Location.
+func (s *OAuth2ClientCallbackFound) GetLocation() OptURI {
+	return s.Location
+}
 
-func (s *MessageForwardMeta) init() MessageForwardMeta {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// SetLocation sets the value of Location.
+func (s *OAuth2ClientCallbackFound) SetLocation(val OptURI) {
+	s.Location = val
 }
 
-type MessageLinkedinQueryOK struct {
-	// List of messages matching the query.
-	Messages []Message `json:"messages"`
+type OAuth2ClientCreateReq struct {
+	// Name of the client.
+	Name string `json:"name"`
+	// Provider of the client, one of the registered providers, see GET /oauth2/provider.
+	Provider string `json:"provider"`
+	// Secret of the client.
+	Secret string `json:"secret"`
+	// Client ID.
+	ClientID string `json:"client_id"`
+	// Issuer URL, required by providers using OpenID discovery such as zitadel and oidc.
+	IssuerURL OptString `json:"issuer_url"`
+	// Scopes to request instead of the provider defaults.
+	Scopes []string `json:"scopes"`
 }
 
-// GetMessages returns the value of Messages.
-func (s *MessageLinkedinQueryOK) GetMessages() []Message {
-	return s.Messages
+// GetName returns the value of Name.
+func (s *OAuth2ClientCreateReq) GetName() string {
+	return s.Name
 }
 
-// SetMessages sets the value of Messages.
-func (s *MessageLinkedinQueryOK) SetMessages(val []Message) {
-	s.Messages = val
+// GetProvider returns the value of Provider.
+func (s *OAuth2ClientCreateReq) GetProvider() string {
+	return s.Provider
 }
 
-// Ref: #
-type MessageMeta struct {
-	// Indicates whether this message has a complete raw email stored as an attachment (with FileObject.
-	// is_raw=true).
-	HasRawEmail OptBool `json:"has_raw_email"`
-	// Optional flag indicating if it's inbound (true) or outbound (false).
-	IsIncoming OptBool `json:"is_incoming"`
+// GetSecret returns the value of Secret.
+func (s *OAuth2ClientCreateReq) GetSecret() string {
+	return s.Secret
 }
 
-// GetHasRawEmail returns the value of HasRawEmail.
-func (s *MessageMeta) GetHasRawEmail() OptBool {
-	return s.HasRawEmail
+// GetClientID returns the value of ClientID.
+func (s *OAuth2ClientCreateReq) GetClientID() string {
+	return s.ClientID
 }
 
-// GetIsIncoming returns the value of IsIncoming.
-func (s *MessageMeta) GetIsIncoming() OptBool {
-	return s.IsIncoming
+// GetIssuerURL returns the value of IssuerURL.
+func (s *OAuth2ClientCreateReq) GetIssuerURL() OptString {
+	return s.IssuerURL
 }
 
-// SetHasRawEmail sets the value of HasRawEmail.
-func (s *MessageMeta) SetHasRawEmail(val OptBool) {
-	s.HasRawEmail = val
+// GetScopes returns the value of Scopes.
+func (s *OAuth2ClientCreateReq) GetScopes() []string {
+	return s.Scopes
 }
 
-// SetIsIncoming sets the value of IsIncoming.
-func (s *MessageMeta) SetIsIncoming(val OptBool) {
-	s.IsIncoming = val
+// SetName sets the value of Name.
+func (s *OAuth2ClientCreateReq) SetName(val string) {
+	s.Name = val
 }
 
-// Ref: #
-type MessageQuery struct {
-	// Platform or data source to query from.
-	Source MessageQuerySource `json:"source"`
-	// Free-text or advanced operator query. E.g., 'from:', 'to:', 'subject:', 'after:', 'before:'.
-	Query OptString `json:"query"`
-	// ID of the chat/conversation to filter messages from.
-	ChatID OptString `json:"chat_id"`
-	// ID of a sub-thread within the conversation.
-	ThreadID OptString `json:"thread_id"`
-	// Filter messages sent after this date/time.
-	StartDate OptDateTime `json:"start_date"`
-	// Filter messages sent before this date/time.
-	EndDate OptDateTime `json:"end_date"`
-	// Sort order by timestamp ('asc' or 'desc').
-	Order OptMessageQueryOrder `json:"order"`
-	// Maximum number of messages to return.
-	Limit OptInt `json:"limit"`
-	// Number of records to skip for pagination.
-	Offset OptInt `json:"offset"`
-	// Specifies the storage backend for message data.
-	StorageType OptMessageQueryStorageType `json:"storage_type"`
-	// Enable fuzzy matching if true.
-	Fuzzy OptBool `json:"fuzzy"`
+// SetProvider sets the value of Provider.
+func (s *OAuth2ClientCreateReq) SetProvider(val string) {
+	s.Provider = val
 }
 
-// GetSource returns the value of Source.
-func (s *MessageQuery) GetSource() MessageQuerySource {
-	return s.Source
+// SetSecret sets the value of Secret.
+func (s *OAuth2ClientCreateReq) SetSecret(val string) {
+	s.Secret = val
 }
 
-// GetQuery returns the value of Query.
-func (s *MessageQuery) GetQuery() OptString {
-	return s.Query
+// SetClientID sets the value of ClientID.
+func (s *OAuth2ClientCreateReq) SetClientID(val string) {
+	s.ClientID = val
 }
 
-// GetChatID returns the value of ChatID.
-func (s *MessageQuery) GetChatID() OptString {
-	return s.ChatID
+// SetIssuerURL sets the value of IssuerURL.
+func (s *OAuth2ClientCreateReq) SetIssuerURL(val OptString) {
+	s.IssuerURL = val
 }
 
-// GetThreadID returns the value of ThreadID.
-func (s *MessageQuery) GetThreadID() OptString {
-	return s.ThreadID
+// SetScopes sets the value of Scopes.
+func (s *OAuth2ClientCreateReq) SetScopes(val []string) {
+	s.Scopes = val
 }
 
-// GetStartDate returns the value of StartDate.
-func (s *MessageQuery) GetStartDate() OptDateTime {
-	return s.StartDate
+// OAuth2ClientDeleteOK is response for OAuth2ClientDelete operation.
+type OAuth2ClientDeleteOK struct{}
+
+type OAuth2ClientListOK struct {
+	// List of OAuth2 clients.
+	Clients []OAuth2Client `json:"clients"`
 }
 
-// GetEndDate returns the value of EndDate.
-func (s *MessageQuery) GetEndDate() OptDateTime {
-	return s.EndDate
+// GetClients returns the value of Clients.
+func (s *OAuth2ClientListOK) GetClients() []OAuth2Client {
+	return s.Clients
 }
 
-// GetOrder returns the value of Order.
-func (s *MessageQuery) GetOrder() OptMessageQueryOrder {
-	return s.Order
+// SetClients sets the value of Clients.
+func (s *OAuth2ClientListOK) SetClients(val []OAuth2Client) {
+	s.Clients = val
 }
 
-// GetLimit returns the value of Limit.
-func (s *MessageQuery) GetLimit() OptInt {
-	return s.Limit
+type OAuth2ClientLoginOK struct {
+	// Auth code URL.
+	AuthCodeURL string `json:"auth_code_url"`
 }
 
-// GetOffset returns the value of Offset.
-func (s *MessageQuery) GetOffset() OptInt {
-	return s.Offset
+// GetAuthCodeURL returns the value of AuthCodeURL.
+func (s *OAuth2ClientLoginOK) GetAuthCodeURL() string {
+	return s.AuthCodeURL
 }
 
-// GetStorageType returns the value of StorageType.
-func (s *MessageQuery) GetStorageType() OptMessageQueryStorageType {
-	return s.StorageType
+// SetAuthCodeURL sets the value of AuthCodeURL.
+func (s *OAuth2ClientLoginOK) SetAuthCodeURL(val string) {
+	s.AuthCodeURL = val
 }
 
-// GetFuzzy returns the value of Fuzzy.
-func (s *MessageQuery) GetFuzzy() OptBool {
-	return s.Fuzzy
+type OAuth2ClientLoginReq struct {
+	// Query parameters.
+	Query OAuth2ClientLoginReqQuery `json:"query"`
 }
 
-// SetSource sets the value of Source.
-func (s *MessageQuery) SetSource(val MessageQuerySource) {
-	s.Source = val
+// GetQuery returns the value of Query.
+func (s *OAuth2ClientLoginReq) GetQuery() OAuth2ClientLoginReqQuery {
+	return s.Query
 }
 
 // SetQuery sets the value of Query.
-func (s *MessageQuery) SetQuery(val OptString) {
+func (s *OAuth2ClientLoginReq) SetQuery(val OAuth2ClientLoginReqQuery) {
 	s.Query = val
 }
 
-// SetChatID sets the value of ChatID.
-func (s *MessageQuery) SetChatID(val OptString) {
-	s.ChatID = val
-}
-
-// SetThreadID sets the value of ThreadID.
-func (s *MessageQuery) SetThreadID(val OptString) {
-	s.ThreadID = val
-}
+// Query parameters.
+type OAuth2ClientLoginReqQuery map[string][]string
 
-// SetStartDate sets the value of StartDate.
-func (s *MessageQuery) SetStartDate(val OptDateTime) {
-	s.StartDate = val
+func (s *OAuth2ClientLoginReqQuery) init() OAuth2ClientLoginReqQuery {
+	m := *s
+	if m == nil {
+		m = map[string][]string{}
+		*s = m
+	}
+	return m
 }
 
-// SetEndDate sets the value of EndDate.
-func (s *MessageQuery) SetEndDate(val OptDateTime) {
-	s.EndDate = val
+// Ref: #
+type OAuth2ClientToken struct {
+	// Internal unique ID for the token record.
+	UUID OptString `json:"uuid"`
+	// UUID of the OAuth2 client that issued this token.
+	ClientUUID string `json:"client_uuid"`
+	// Optional. UUID of the user for whom the token was issued.
+	UserUUID OptString            `json:"user_uuid"`
+	Token    OAuth2ClientTokenObj `json:"token"`
+	// Health of the token, needs_reauth tokens are no longer refreshed.
+	Status OptOAuth2ClientTokenStatus `json:"status"`
+	// Expiry of the access token.
+	ExpiresAt OptDateTime `json:"expires_at"`
+	// Timestamp of the last successful refresh.
+	LastRefreshedAt OptDateTime `json:"last_refreshed_at"`
+	// Timestamp of the last failed refresh.
+	LastFailedAt OptDateTime `json:"last_failed_at"`
+	// Failed refreshes since the last successful one.
+	FailureCount OptInt32 `json:"failure_count"`
+	// Error of the last failed refresh.
+	LastError OptString `json:"last_error"`
+	// Scopes granted with the token, absent when the provider does not report them.
+	Scopes []string `json:"scopes"`
+	// Requested scopes that were not granted.
+	MissingScopes []string `json:"missing_scopes"`
+	// Timestamp when the token record was stored.
+	CreatedAt OptDateTime `json:"created_at"`
+	// Timestamp when the token record was last updated.
+	UpdatedAt OptDateTime `json:"updated_at"`
 }
 
-// SetOrder sets the value of Order.
-func (s *MessageQuery) SetOrder(val OptMessageQueryOrder) {
-	s.Order = val
+// GetUUID returns the value of UUID.
+func (s *OAuth2ClientToken) GetUUID() OptString {
+	return s.UUID
 }
 
-// SetLimit sets the value of Limit.
-func (s *MessageQuery) SetLimit(val OptInt) {
-	s.Limit = val
+// GetClientUUID returns the value of ClientUUID.
+func (s *OAuth2ClientToken) GetClientUUID() string {
+	return s.ClientUUID
 }
 
-// SetOffset sets the value of Offset.
-func (s *MessageQuery) SetOffset(val OptInt) {
-	s.Offset = val
+// GetUserUUID returns the value of UserUUID.
+func (s *OAuth2ClientToken) GetUserUUID() OptString {
+	return s.UserUUID
 }
 
-// SetStorageType sets the value of StorageType.
-func (s *MessageQuery) SetStorageType(val OptMessageQueryStorageType) {
-	s.StorageType = val
+// GetToken returns the value of Token.
+func (s *OAuth2ClientToken) GetToken() OAuth2ClientTokenObj {
+	return s.Token
 }
 
-// SetFuzzy sets the value of Fuzzy.
-func (s *MessageQuery) SetFuzzy(val OptBool) {
-	s.Fuzzy = val
+// GetStatus returns the value of Status.
+func (s *OAuth2ClientToken) GetStatus() OptOAuth2ClientTokenStatus {
+	return s.Status
 }
 
-type MessageQueryOK struct {
-	// List of messages matching the query.
-	Messages []Message `json:"messages"`
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *OAuth2ClientToken) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
 }
 
-// GetMessages returns the value of Messages.
-func (s *MessageQueryOK) GetMessages() []Message {
-	return s.Messages
+// GetLastRefreshedAt returns the value of LastRefreshedAt.
+func (s *OAuth2ClientToken) GetLastRefreshedAt() OptDateTime {
+	return s.LastRefreshedAt
 }
 
-// SetMessages sets the value of Messages.
-func (s *MessageQueryOK) SetMessages(val []Message) {
-	s.Messages = val
+// GetLastFailedAt returns the value of LastFailedAt.
+func (s *OAuth2ClientToken) GetLastFailedAt() OptDateTime {
+	return s.LastFailedAt
 }
 
-// Sort order by timestamp ('asc' or 'desc').
-type MessageQueryOrder string
-
-const (
-	MessageQueryOrderAsc  MessageQueryOrder = "asc"
-	MessageQueryOrderDesc MessageQueryOrder = "desc"
-)
-
-// AllValues returns all MessageQueryOrder values.
-func (MessageQueryOrder) AllValues() []MessageQueryOrder {
-	return []MessageQueryOrder{
-		MessageQueryOrderAsc,
-		MessageQueryOrderDesc,
-	}
+// GetFailureCount returns the value of FailureCount.
+func (s *OAuth2ClientToken) GetFailureCount() OptInt32 {
+	return s.FailureCount
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s MessageQueryOrder) MarshalText() ([]byte, error) {
-	switch s {
-	case MessageQueryOrderAsc:
-		return []byte(s), nil
-	case MessageQueryOrderDesc:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetLastError returns the value of LastError.
+func (s *OAuth2ClientToken) GetLastError() OptString {
+	return s.LastError
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *MessageQueryOrder) UnmarshalText(data []byte) error {
-	switch MessageQueryOrder(data) {
-	case MessageQueryOrderAsc:
-		*s = MessageQueryOrderAsc
-		return nil
-	case MessageQueryOrderDesc:
-		*s = MessageQueryOrderDesc
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// GetScopes returns the value of Scopes.
+func (s *OAuth2ClientToken) GetScopes() []string {
+	return s.Scopes
 }
 
-// Platform or data source to query from.
-type MessageQuerySource string
-
-const (
-	MessageQuerySourceEmail    MessageQuerySource = "email"
-	MessageQuerySourceWhatsapp MessageQuerySource = "whatsapp"
-	MessageQuerySourceTelegram MessageQuerySource = "telegram"
-	MessageQuerySourceLinkedin MessageQuerySource = "linkedin"
-	MessageQuerySourceCustom   MessageQuerySource = "custom"
-	MessageQuerySourceUnified  MessageQuerySource = "unified"
-)
-
-// AllValues returns all MessageQuerySource values.
-func (MessageQuerySource) AllValues() []MessageQuerySource {
-	return []MessageQuerySource{
-		MessageQuerySourceEmail,
-		MessageQuerySourceWhatsapp,
-		MessageQuerySourceTelegram,
-		MessageQuerySourceLinkedin,
-		MessageQuerySourceCustom,
-		MessageQuerySourceUnified,
-	}
+// GetMissingScopes returns the value of MissingScopes.
+func (s *OAuth2ClientToken) GetMissingScopes() []string {
+	return s.MissingScopes
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s MessageQuerySource) MarshalText() ([]byte, error) {
-	switch s {
-	case MessageQuerySourceEmail:
-		return []byte(s), nil
-	case MessageQuerySourceWhatsapp:
-		return []byte(s), nil
-	case MessageQuerySourceTelegram:
-		return []byte(s), nil
-	case MessageQuerySourceLinkedin:
-		return []byte(s), nil
-	case MessageQuerySourceCustom:
-		return []byte(s), nil
-	case MessageQuerySourceUnified:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetCreatedAt returns the value of CreatedAt.
+func (s *OAuth2ClientToken) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *MessageQuerySource) UnmarshalText(data []byte) error {
-	switch MessageQuerySource(data) {
-	case MessageQuerySourceEmail:
-		*s = MessageQuerySourceEmail
-		return nil
-	case MessageQuerySourceWhatsapp:
-		*s = MessageQuerySourceWhatsapp
-		return nil
-	case MessageQuerySourceTelegram:
-		*s = MessageQuerySourceTelegram
-		return nil
-	case MessageQuerySourceLinkedin:
-		*s = MessageQuerySourceLinkedin
-		return nil
-	case MessageQuerySourceCustom:
-		*s = MessageQuerySourceCustom
-		return nil
-	case MessageQuerySourceUnified:
-		*s = MessageQuerySourceUnified
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *OAuth2ClientToken) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
 }
 
-// Specifies the storage backend for message data.
-type MessageQueryStorageType string
-
-const (
-	MessageQueryStorageTypePostgres  MessageQueryStorageType = "postgres"
-	MessageQueryStorageTypeS3        MessageQueryStorageType = "s3"
-	MessageQueryStorageTypeHostfiles MessageQueryStorageType = "hostfiles"
-)
-
-// AllValues returns all MessageQueryStorageType values.
-func (MessageQueryStorageType) AllValues() []MessageQueryStorageType {
-	return []MessageQueryStorageType{
-		MessageQueryStorageTypePostgres,
-		MessageQueryStorageTypeS3,
-		MessageQueryStorageTypeHostfiles,
-	}
+// SetUUID sets the value of UUID.
+func (s *OAuth2ClientToken) SetUUID(val OptString) {
+	s.UUID = val
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s MessageQueryStorageType) MarshalText() ([]byte, error) {
-	switch s {
-	case MessageQueryStorageTypePostgres:
-		return []byte(s), nil
-	case MessageQueryStorageTypeS3:
-		return []byte(s), nil
-	case MessageQueryStorageTypeHostfiles:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// SetClientUUID sets the value of ClientUUID.
+func (s *OAuth2ClientToken) SetClientUUID(val string) {
+	s.ClientUUID = val
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *MessageQueryStorageType) UnmarshalText(data []byte) error {
-	switch MessageQueryStorageType(data) {
-	case MessageQueryStorageTypePostgres:
-		*s = MessageQueryStorageTypePostgres
-		return nil
-	case MessageQueryStorageTypeS3:
-		*s = MessageQueryStorageTypeS3
-		return nil
-	case MessageQueryStorageTypeHostfiles:
-		*s = MessageQueryStorageTypeHostfiles
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// SetUserUUID sets the value of UserUUID.
+func (s *OAuth2ClientToken) SetUserUUID(val OptString) {
+	s.UserUUID = val
 }
 
-// Collection of reactions (e.g., likes, emojis) applied to this message.
-type MessageReactions map[string]int
-
-func (s *MessageReactions) init() MessageReactions {
-	m := *s
-	if m == nil {
-		m = map[string]int{}
-		*s = m
-	}
-	return m
+// SetToken sets the value of Token.
+func (s *OAuth2ClientToken) SetToken(val OAuth2ClientTokenObj) {
+	s.Token = val
 }
 
-type MessageTelegramQueryOK struct {
-	// List of messages matching the query.
-	Messages []Message `json:"messages"`
+// SetStatus sets the value of Status.
+func (s *OAuth2ClientToken) SetStatus(val OptOAuth2ClientTokenStatus) {
+	s.Status = val
 }
 
-// GetMessages returns the value of Messages.
-func (s *MessageTelegramQueryOK) GetMessages() []Message {
-	return s.Messages
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *OAuth2ClientToken) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
 }
 
-// SetMessages sets the value of Messages.
-func (s *MessageTelegramQueryOK) SetMessages(val []Message) {
-	s.Messages = val
+// SetLastRefreshedAt sets the value of LastRefreshedAt.
+func (s *OAuth2ClientToken) SetLastRefreshedAt(val OptDateTime) {
+	s.LastRefreshedAt = val
 }
 
-type MessageWhatsappQueryOK struct {
-	// List of messages matching the query.
-	Messages []Message `json:"messages"`
+// SetLastFailedAt sets the value of LastFailedAt.
+func (s *OAuth2ClientToken) SetLastFailedAt(val OptDateTime) {
+	s.LastFailedAt = val
 }
 
-// GetMessages returns the value of Messages.
-func (s *MessageWhatsappQueryOK) GetMessages() []Message {
-	return s.Messages
+// SetFailureCount sets the value of FailureCount.
+func (s *OAuth2ClientToken) SetFailureCount(val OptInt32) {
+	s.FailureCount = val
 }
 
-// SetMessages sets the value of Messages.
-func (s *MessageWhatsappQueryOK) SetMessages(val []Message) {
-	s.Messages = val
+// SetLastError sets the value of LastError.
+func (s *OAuth2ClientToken) SetLastError(val OptString) {
+	s.LastError = val
 }
 
-// Ref: #
-type OAuth2Client struct {
-	// Internal unique ID for the client.
-	UUID OptString `json:"uuid"`
-	// Friendly name for the admin UI.
-	Name string `json:"name"`
-	// Name of the OAuth2 provider (e.g., 'github', 'google', 'zitadel').
-	Provider string `json:"provider"`
-	// OAuth2 client ID provided by the external provider.
-	ClientID string `json:"client_id"`
-	// OAuth2 client secret (should be stored securely, e.g. encrypted).
-	Secret string `json:"secret"`
-	// Timestamp when the client was registered.
-	CreatedAt OptDateTime `json:"created_at"`
-	// Timestamp when the client was last updated.
-	UpdatedAt OptDateTime `json:"updated_at"`
+// SetScopes sets the value of Scopes.
+func (s *OAuth2ClientToken) SetScopes(val []string) {
+	s.Scopes = val
 }
 
-// GetUUID returns the value of UUID.
-func (s *OAuth2Client) GetUUID() OptString {
-	return s.UUID
+// SetMissingScopes sets the value of MissingScopes.
+func (s *OAuth2ClientToken) SetMissingScopes(val []string) {
+	s.MissingScopes = val
 }
 
-// GetName returns the value of Name.
-func (s *OAuth2Client) GetName() string {
-	return s.Name
+// SetCreatedAt sets the value of CreatedAt.
+func (s *OAuth2ClientToken) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// GetProvider returns the value of Provider.
-func (s *OAuth2Client) GetProvider() string {
-	return s.Provider
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *OAuth2ClientToken) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
 }
 
-// GetClientID returns the value of ClientID.
-func (s *OAuth2Client) GetClientID() string {
-	return s.ClientID
-}
+// OAuth2ClientTokenDeleteOK is response for OAuth2ClientTokenDelete operation.
+type OAuth2ClientTokenDeleteOK struct{}
 
-// GetSecret returns the value of Secret.
-func (s *OAuth2Client) GetSecret() string {
-	return s.Secret
+// Ref: #
+type OAuth2ClientTokenObj struct {
+	// Access token (JWT or opaque string).
+	AccessToken string `json:"access_token"`
+	// Refresh token, if available.
+	RefreshToken string `json:"refresh_token"`
+	// Timestamp when the access token expires.
+	ExpiresAt time.Time `json:"expires_at"`
+	// Raw OAuth2 token response stored as JSON (useful for debugging or extra metadata).
+	Token OptOAuth2ClientTokenObjToken `json:"token"`
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *OAuth2Client) GetCreatedAt() OptDateTime {
-	return s.CreatedAt
+// GetAccessToken returns the value of AccessToken.
+func (s *OAuth2ClientTokenObj) GetAccessToken() string {
+	return s.AccessToken
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *OAuth2Client) GetUpdatedAt() OptDateTime {
-	return s.UpdatedAt
+// GetRefreshToken returns the value of RefreshToken.
+func (s *OAuth2ClientTokenObj) GetRefreshToken() string {
+	return s.RefreshToken
 }
 
-// SetUUID sets the value of UUID.
-func (s *OAuth2Client) SetUUID(val OptString) {
-	s.UUID = val
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *OAuth2ClientTokenObj) GetExpiresAt() time.Time {
+	return s.ExpiresAt
 }
 
-// SetName sets the value of Name.
-func (s *OAuth2Client) SetName(val string) {
-	s.Name = val
+// GetToken returns the value of Token.
+func (s *OAuth2ClientTokenObj) GetToken() OptOAuth2ClientTokenObjToken {
+	return s.Token
 }
 
-// SetProvider sets the value of Provider.
-func (s *OAuth2Client) SetProvider(val string) {
-	s.Provider = val
+// SetAccessToken sets the value of AccessToken.
+func (s *OAuth2ClientTokenObj) SetAccessToken(val string) {
+	s.AccessToken = val
 }
 
-// SetClientID sets the value of ClientID.
-func (s *OAuth2Client) SetClientID(val string) {
-	s.ClientID = val
+// SetRefreshToken sets the value of RefreshToken.
+func (s *OAuth2ClientTokenObj) SetRefreshToken(val string) {
+	s.RefreshToken = val
 }
 
-// SetSecret sets the value of Secret.
-func (s *OAuth2Client) SetSecret(val string) {
-	s.Secret = val
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *OAuth2ClientTokenObj) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *OAuth2Client) SetCreatedAt(val OptDateTime) {
-	s.CreatedAt = val
+// SetToken sets the value of Token.
+func (s *OAuth2ClientTokenObj) SetToken(val OptOAuth2ClientTokenObjToken) {
+	s.Token = val
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *OAuth2Client) SetUpdatedAt(val OptDateTime) {
-	s.UpdatedAt = val
+// Raw OAuth2 token response stored as JSON (useful for debugging or extra metadata).
+type OAuth2ClientTokenObjToken map[string]jx.Raw
+
+func (s *OAuth2ClientTokenObjToken) init() OAuth2ClientTokenObjToken {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// OAuth2ClientCallbackFound is response for OAuth2ClientCallback operation.
-type OAuth2ClientCallbackFound struct {
-	Location OptURI
+// OAuth2ClientTokenRefreshNoContent is response for OAuth2ClientTokenRefresh operation.
+type OAuth2ClientTokenRefreshNoContent struct{}
+
+// Health of the token, needs_reauth tokens are no longer refreshed.
+type OAuth2ClientTokenStatus string
+
+const (
+	OAuth2ClientTokenStatusOk          OAuth2ClientTokenStatus = "ok"
+	OAuth2ClientTokenStatusFailing     OAuth2ClientTokenStatus = "failing"
+	OAuth2ClientTokenStatusNeedsReauth OAuth2ClientTokenStatus = "needs_reauth"
+)
+
+// AllValues returns all OAuth2ClientTokenStatus values.
+func (OAuth2ClientTokenStatus) AllValues() []OAuth2ClientTokenStatus {
+	return []OAuth2ClientTokenStatus{
+		OAuth2ClientTokenStatusOk,
+		OAuth2ClientTokenStatusFailing,
+		OAuth2ClientTokenStatusNeedsReauth,
+	}
 }
 
-// GetLocation returns the value of Location.
-func (s *OAuth2ClientCallbackFound) GetLocation() OptURI {
-	return s.Location
+// MarshalText implements encoding.TextMarshaler.
+func (s OAuth2ClientTokenStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case OAuth2ClientTokenStatusOk:
+		return []byte(s), nil
+	case OAuth2ClientTokenStatusFailing:
+		return []byte(s), nil
+	case OAuth2ClientTokenStatusNeedsReauth:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetLocation sets the value of Location.
-func (s *OAuth2ClientCallbackFound) SetLocation(val OptURI) {
-	s.Location = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OAuth2ClientTokenStatus) UnmarshalText(data []byte) error {
+	switch OAuth2ClientTokenStatus(data) {
+	case OAuth2ClientTokenStatusOk:
+		*s = OAuth2ClientTokenStatusOk
+		return nil
+	case OAuth2ClientTokenStatusFailing:
+		*s = OAuth2ClientTokenStatusFailing
+		return nil
+	case OAuth2ClientTokenStatusNeedsReauth:
+		*s = OAuth2ClientTokenStatusNeedsReauth
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-type OAuth2ClientCreateReq struct {
+type OAuth2ClientUpdateReq struct {
 	// Name of the client.
 	Name string `json:"name"`
-	// Provider of the client.
+	// Provider of the client, one of the registered providers, see GET /oauth2/provider.
 	Provider string `json:"provider"`
 	// Secret of the client.
 	Secret string `json:"secret"`
 	// Client ID.
 	ClientID string `json:"client_id"`
+	// Issuer URL, required by providers using OpenID discovery such as zitadel and oidc.
+	IssuerURL OptString `json:"issuer_url"`
+	// Scopes to request instead of the provider defaults.
+	Scopes []string `json:"scopes"`
 }
 
 // GetName returns the value of Name.
-func (s *OAuth2ClientCreateReq) GetName() string {
+func (s *OAuth2ClientUpdateReq) GetName() string {
 	return s.Name
 }
 
 // GetProvider returns the value of Provider.
-func (s *OAuth2ClientCreateReq) GetProvider() string {
+func (s *OAuth2ClientUpdateReq) GetProvider() string {
 	return s.Provider
 }
 
 // GetSecret returns the value of Secret.
-func (s *OAuth2ClientCreateReq) GetSecret() string {
+func (s *OAuth2ClientUpdateReq) GetSecret() string {
 	return s.Secret
 }
 
 // GetClientID returns the value of ClientID.
-func (s *OAuth2ClientCreateReq) GetClientID() string {
+func (s *OAuth2ClientUpdateReq) GetClientID() string {
 	return s.ClientID
 }
 
+// GetIssuerURL returns the value of IssuerURL.
+func (s *OAuth2ClientUpdateReq) GetIssuerURL() OptString {
+	return s.IssuerURL
+}
+
+// GetScopes returns the value of Scopes.
+func (s *OAuth2ClientUpdateReq) GetScopes() []string {
+	return s.Scopes
+}
+
 // SetName sets the value of Name.
-func (s *OAuth2ClientCreateReq) SetName(val string) {
+func (s *OAuth2ClientUpdateReq) SetName(val string) {
 	s.Name = val
 }
 
 // SetProvider sets the value of Provider.
-func (s *OAuth2ClientCreateReq) SetProvider(val string) {
+func (s *OAuth2ClientUpdateReq) SetProvider(val string) {
 	s.Provider = val
 }
 
 // SetSecret sets the value of Secret.
-func (s *OAuth2ClientCreateReq) SetSecret(val string) {
+func (s *OAuth2ClientUpdateReq) SetSecret(val string) {
 	s.Secret = val
 }
 
 // SetClientID sets the value of ClientID.
-func (s *OAuth2ClientCreateReq) SetClientID(val string) {
+func (s *OAuth2ClientUpdateReq) SetClientID(val string) {
 	s.ClientID = val
 }
 
-// OAuth2ClientDeleteOK is response for OAuth2ClientDelete operation.
-type OAuth2ClientDeleteOK struct{}
+// SetIssuerURL sets the value of IssuerURL.
+func (s *OAuth2ClientUpdateReq) SetIssuerURL(val OptString) {
+	s.IssuerURL = val
+}
 
-type OAuth2ClientListOK struct {
-	// List of OAuth2 clients.
-	Clients []OAuth2Client `json:"clients"`
+// SetScopes sets the value of Scopes.
+func (s *OAuth2ClientUpdateReq) SetScopes(val []string) {
+	s.Scopes = val
 }
 
-// GetClients returns the value of Clients.
-func (s *OAuth2ClientListOK) GetClients() []OAuth2Client {
-	return s.Clients
+// An OAuth2 provider OAuth2 clients can use.
+// Ref: #
+type OAuth2Provider struct {
+	// Value of the provider field of OAuth2 clients.
+	Name  string `json:"name"`
+	Title string `json:"title"`
+	// Scopes requested by default.
+	Scopes []string `json:"scopes"`
+	// Endpoints are discovered from the issuer_url of the client.
+	Discovery bool `json:"discovery"`
+	// The login uses a PKCE code challenge. Providers using discovery may turn it off.
+	Pkce bool `json:"pkce"`
+	// Deleting a token revokes the grant at the provider.
+	Revocation bool `json:"revocation"`
+	// The account granting access is recorded.
+	Userinfo bool `json:"userinfo"`
 }
 
-// SetClients sets the value of Clients.
-func (s *OAuth2ClientListOK) SetClients(val []OAuth2Client) {
-	s.Clients = val
+// GetName returns the value of Name.
+func (s *OAuth2Provider) GetName() string {
+	return s.Name
 }
 
-type OAuth2ClientLoginOK struct {
-	// Auth code URL.
-	AuthCodeURL string `json:"auth_code_url"`
+// GetTitle returns the value of Title.
+func (s *OAuth2Provider) GetTitle() string {
+	return s.Title
 }
 
-// GetAuthCodeURL returns the value of AuthCodeURL.
-func (s *OAuth2ClientLoginOK) GetAuthCodeURL() string {
-	return s.AuthCodeURL
+// GetScopes returns the value of Scopes.
+func (s *OAuth2Provider) GetScopes() []string {
+	return s.Scopes
 }
 
-// SetAuthCodeURL sets the value of AuthCodeURL.
-func (s *OAuth2ClientLoginOK) SetAuthCodeURL(val string) {
-	s.AuthCodeURL = val
+// GetDiscovery returns the value of Discovery.
+func (s *OAuth2Provider) GetDiscovery() bool {
+	return s.Discovery
 }
 
-type OAuth2ClientLoginReq struct {
-	// Query parameters.
-	Query OAuth2ClientLoginReqQuery `json:"query"`
+// GetPkce returns the value of Pkce.
+func (s *OAuth2Provider) GetPkce() bool {
+	return s.Pkce
 }
 
-// GetQuery returns the value of Query.
-func (s *OAuth2ClientLoginReq) GetQuery() OAuth2ClientLoginReqQuery {
-	return s.Query
+// GetRevocation returns the value of Revocation.
+func (s *OAuth2Provider) GetRevocation() bool {
+	return s.Revocation
 }
 
-// SetQuery sets the value of Query.
-func (s *OAuth2ClientLoginReq) SetQuery(val OAuth2ClientLoginReqQuery) {
-	s.Query = val
+// GetUserinfo returns the value of Userinfo.
+func (s *OAuth2Provider) GetUserinfo() bool {
+	return s.Userinfo
 }
 
-// Query parameters.
-type OAuth2ClientLoginReqQuery map[string][]string
+// SetName sets the value of Name.
+func (s *OAuth2Provider) SetName(val string) {
+	s.Name = val
+}
 
-func (s *OAuth2ClientLoginReqQuery) init() OAuth2ClientLoginReqQuery {
-	m := *s
-	if m == nil {
-		m = map[string][]string{}
-		*s = m
-	}
-	return m
+// SetTitle sets the value of Title.
+func (s *OAuth2Provider) SetTitle(val string) {
+	s.Title = val
 }
 
-// Ref: #
-type OAuth2ClientToken struct {
-	// Internal unique ID for the token record.
-	UUID OptString `json:"uuid"`
-	// UUID of the OAuth2 client that issued this token.
-	ClientUUID string `json:"client_uuid"`
-	// Optional. UUID of the user for whom the token was issued.
-	UserUUID OptString            `json:"user_uuid"`
-	Token    OAuth2ClientTokenObj `json:"token"`
-	// Timestamp when the token record was stored.
-	CreatedAt OptDateTime `json:"created_at"`
-	// Timestamp when the token record was last updated.
-	UpdatedAt OptDateTime `json:"updated_at"`
+// SetScopes sets the value of Scopes.
+func (s *OAuth2Provider) SetScopes(val []string) {
+	s.Scopes = val
 }
 
-// GetUUID returns the value of UUID.
-func (s *OAuth2ClientToken) GetUUID() OptString {
-	return s.UUID
+// SetDiscovery sets the value of Discovery.
+func (s *OAuth2Provider) SetDiscovery(val bool) {
+	s.Discovery = val
 }
 
-// GetClientUUID returns the value of ClientUUID.
-func (s *OAuth2ClientToken) GetClientUUID() string {
-	return s.ClientUUID
+// SetPkce sets the value of Pkce.
+func (s *OAuth2Provider) SetPkce(val bool) {
+	s.Pkce = val
 }
 
-// GetUserUUID returns the value of UserUUID.
-func (s *OAuth2ClientToken) GetUserUUID() OptString {
-	return s.UserUUID
+// SetRevocation sets the value of Revocation.
+func (s *OAuth2Provider) SetRevocation(val bool) {
+	s.Revocation = val
 }
 
-// GetToken returns the value of Token.
-func (s *OAuth2ClientToken) GetToken() OAuth2ClientTokenObj {
-	return s.Token
+// SetUserinfo sets the value of Userinfo.
+func (s *OAuth2Provider) SetUserinfo(val bool) {
+	s.Userinfo = val
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *OAuth2ClientToken) GetCreatedAt() OptDateTime {
-	return s.CreatedAt
+// NewOptAPIKeyRole returns new OptAPIKeyRole with value set to v.
+func NewOptAPIKeyRole(v APIKeyRole) OptAPIKeyRole {
+	return OptAPIKeyRole{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *OAuth2ClientToken) GetUpdatedAt() OptDateTime {
-	return s.UpdatedAt
+// OptAPIKeyRole is optional APIKeyRole.
+type OptAPIKeyRole struct {
+	Value APIKeyRole
+	Set   bool
 }
 
-// SetUUID sets the value of UUID.
-func (s *OAuth2ClientToken) SetUUID(val OptString) {
-	s.UUID = val
+// IsSet returns true if OptAPIKeyRole was set.
+func (o OptAPIKeyRole) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAPIKeyRole) Reset() {
+	var v APIKeyRole
+	o.Value = v
+	o.Set = false
 }
 
-// SetClientUUID sets the value of ClientUUID.
-func (s *OAuth2ClientToken) SetClientUUID(val string) {
-	s.ClientUUID = val
+// SetTo sets value to v.
+func (o *OptAPIKeyRole) SetTo(v APIKeyRole) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetUserUUID sets the value of UserUUID.
-func (s *OAuth2ClientToken) SetUserUUID(val OptString) {
-	s.UserUUID = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAPIKeyRole) Get() (v APIKeyRole, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAPIKeyRole) Or(d APIKeyRole) APIKeyRole {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptAuditEventDiff returns new OptAuditEventDiff with value set to v.
+func NewOptAuditEventDiff(v AuditEventDiff) OptAuditEventDiff {
+	return OptAuditEventDiff{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAuditEventDiff is optional AuditEventDiff.
+type OptAuditEventDiff struct {
+	Value AuditEventDiff
+	Set   bool
 }
 
-// SetToken sets the value of Token.
-func (s *OAuth2ClientToken) SetToken(val OAuth2ClientTokenObj) {
-	s.Token = val
-}
+// IsSet returns true if OptAuditEventDiff was set.
+func (o OptAuditEventDiff) IsSet() bool { return o.Set }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *OAuth2ClientToken) SetCreatedAt(val OptDateTime) {
-	s.CreatedAt = val
+// Reset unsets value.
+func (o *OptAuditEventDiff) Reset() {
+	var v AuditEventDiff
+	o.Value = v
+	o.Set = false
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *OAuth2ClientToken) SetUpdatedAt(val OptDateTime) {
-	s.UpdatedAt = val
+// SetTo sets value to v.
+func (o *OptAuditEventDiff) SetTo(v AuditEventDiff) {
+	o.Set = true
+	o.Value = v
 }
 
-// OAuth2ClientTokenDeleteOK is response for OAuth2ClientTokenDelete operation.
-type OAuth2ClientTokenDeleteOK struct{}
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAuditEventDiff) Get() (v AuditEventDiff, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
 
-// Ref: #
-type OAuth2ClientTokenObj struct {
-	// Access token (JWT or opaque string).
-	AccessToken string `json:"access_token"`
-	// Refresh token, if available.
-	RefreshToken string `json:"refresh_token"`
-	// Timestamp when the access token expires.
-	ExpiresAt time.Time `json:"expires_at"`
-	// Raw OAuth2 token response stored as JSON (useful for debugging or extra metadata).
-	Token OptOAuth2ClientTokenObjToken `json:"token"`
+// Or returns value if set, or given parameter if does not.
+func (o OptAuditEventDiff) Or(d AuditEventDiff) AuditEventDiff {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetAccessToken returns the value of AccessToken.
-func (s *OAuth2ClientTokenObj) GetAccessToken() string {
-	return s.AccessToken
+// NewOptAuditEventParams returns new OptAuditEventParams with value set to v.
+func NewOptAuditEventParams(v AuditEventParams) OptAuditEventParams {
+	return OptAuditEventParams{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetRefreshToken returns the value of RefreshToken.
-func (s *OAuth2ClientTokenObj) GetRefreshToken() string {
-	return s.RefreshToken
+// OptAuditEventParams is optional AuditEventParams.
+type OptAuditEventParams struct {
+	Value AuditEventParams
+	Set   bool
 }
 
-// GetExpiresAt returns the value of ExpiresAt.
-func (s *OAuth2ClientTokenObj) GetExpiresAt() time.Time {
-	return s.ExpiresAt
+// IsSet returns true if OptAuditEventParams was set.
+func (o OptAuditEventParams) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAuditEventParams) Reset() {
+	var v AuditEventParams
+	o.Value = v
+	o.Set = false
 }
 
-// GetToken returns the value of Token.
-func (s *OAuth2ClientTokenObj) GetToken() OptOAuth2ClientTokenObjToken {
-	return s.Token
+// SetTo sets value to v.
+func (o *OptAuditEventParams) SetTo(v AuditEventParams) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetAccessToken sets the value of AccessToken.
-func (s *OAuth2ClientTokenObj) SetAccessToken(val string) {
-	s.AccessToken = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAuditEventParams) Get() (v AuditEventParams, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// SetRefreshToken sets the value of RefreshToken.
-func (s *OAuth2ClientTokenObj) SetRefreshToken(val string) {
-	s.RefreshToken = val
+// Or returns value if set, or given parameter if does not.
+func (o OptAuditEventParams) Or(d AuditEventParams) AuditEventParams {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// SetExpiresAt sets the value of ExpiresAt.
-func (s *OAuth2ClientTokenObj) SetExpiresAt(val time.Time) {
-	s.ExpiresAt = val
+// NewOptAuditExportOutcome returns new OptAuditExportOutcome with value set to v.
+func NewOptAuditExportOutcome(v AuditExportOutcome) OptAuditExportOutcome {
+	return OptAuditExportOutcome{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetToken sets the value of Token.
-func (s *OAuth2ClientTokenObj) SetToken(val OptOAuth2ClientTokenObjToken) {
-	s.Token = val
+// OptAuditExportOutcome is optional AuditExportOutcome.
+type OptAuditExportOutcome struct {
+	Value AuditExportOutcome
+	Set   bool
 }
 
-// Raw OAuth2 token response stored as JSON (useful for debugging or extra metadata).
-type OAuth2ClientTokenObjToken map[string]jx.Raw
+// IsSet returns true if OptAuditExportOutcome was set.
+func (o OptAuditExportOutcome) IsSet() bool { return o.Set }
 
-func (s *OAuth2ClientTokenObjToken) init() OAuth2ClientTokenObjToken {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// Reset unsets value.
+func (o *OptAuditExportOutcome) Reset() {
+	var v AuditExportOutcome
+	o.Value = v
+	o.Set = false
 }
 
-type OAuth2ClientUpdateReq struct {
-	// Name of the client.
-	Name string `json:"name"`
-	// Provider of the client.
-	Provider string `json:"provider"`
-	// Secret of the client.
-	Secret string `json:"secret"`
-	// Client ID.
-	ClientID string `json:"client_id"`
+// SetTo sets value to v.
+func (o *OptAuditExportOutcome) SetTo(v AuditExportOutcome) {
+	o.Set = true
+	o.Value = v
 }
 
-// GetName returns the value of Name.
-func (s *OAuth2ClientUpdateReq) GetName() string {
-	return s.Name
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAuditExportOutcome) Get() (v AuditExportOutcome, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// GetProvider returns the value of Provider.
-func (s *OAuth2ClientUpdateReq) GetProvider() string {
-	return s.Provider
+// Or returns value if set, or given parameter if does not.
+func (o OptAuditExportOutcome) Or(d AuditExportOutcome) AuditExportOutcome {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetSecret returns the value of Secret.
-func (s *OAuth2ClientUpdateReq) GetSecret() string {
-	return s.Secret
+// NewOptAuditListOutcome returns new OptAuditListOutcome with value set to v.
+func NewOptAuditListOutcome(v AuditListOutcome) OptAuditListOutcome {
+	return OptAuditListOutcome{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetClientID returns the value of ClientID.
-func (s *OAuth2ClientUpdateReq) GetClientID() string {
-	return s.ClientID
+// OptAuditListOutcome is optional AuditListOutcome.
+type OptAuditListOutcome struct {
+	Value AuditListOutcome
+	Set   bool
 }
 
-// SetName sets the value of Name.
-func (s *OAuth2ClientUpdateReq) SetName(val string) {
-	s.Name = val
+// IsSet returns true if OptAuditListOutcome was set.
+func (o OptAuditListOutcome) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAuditListOutcome) Reset() {
+	var v AuditListOutcome
+	o.Value = v
+	o.Set = false
 }
 
-// SetProvider sets the value of Provider.
-func (s *OAuth2ClientUpdateReq) SetProvider(val string) {
-	s.Provider = val
+// SetTo sets value to v.
+func (o *OptAuditListOutcome) SetTo(v AuditListOutcome) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetSecret sets the value of Secret.
-func (s *OAuth2ClientUpdateReq) SetSecret(val string) {
-	s.Secret = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAuditListOutcome) Get() (v AuditListOutcome, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// SetClientID sets the value of ClientID.
-func (s *OAuth2ClientUpdateReq) SetClientID(val string) {
-	s.ClientID = val
+// Or returns value if set, or given parameter if does not.
+func (o OptAuditListOutcome) Or(d AuditListOutcome) AuditListOutcome {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
 // NewOptBool returns new OptBool with value set to v.
@@ -3973,30 +5938,122 @@ func NewOptDatasourceTelegramSettings(v DatasourceTelegramSettings) OptDatasourc
 	}
 }
 
-// OptDatasourceTelegramSettings is optional DatasourceTelegramSettings.
-type OptDatasourceTelegramSettings struct {
-	Value DatasourceTelegramSettings
+// OptDatasourceTelegramSettings is optional DatasourceTelegramSettings.
+type OptDatasourceTelegramSettings struct {
+	Value DatasourceTelegramSettings
+	Set   bool
+}
+
+// IsSet returns true if OptDatasourceTelegramSettings was set.
+func (o OptDatasourceTelegramSettings) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDatasourceTelegramSettings) Reset() {
+	var v DatasourceTelegramSettings
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDatasourceTelegramSettings) SetTo(v DatasourceTelegramSettings) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDatasourceTelegramSettings) Get() (v DatasourceTelegramSettings, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDatasourceTelegramSettings) Or(d DatasourceTelegramSettings) DatasourceTelegramSettings {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDatasourceWhatsappSettings returns new OptDatasourceWhatsappSettings with value set to v.
+func NewOptDatasourceWhatsappSettings(v DatasourceWhatsappSettings) OptDatasourceWhatsappSettings {
+	return OptDatasourceWhatsappSettings{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDatasourceWhatsappSettings is optional DatasourceWhatsappSettings.
+type OptDatasourceWhatsappSettings struct {
+	Value DatasourceWhatsappSettings
+	Set   bool
+}
+
+// IsSet returns true if OptDatasourceWhatsappSettings was set.
+func (o OptDatasourceWhatsappSettings) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDatasourceWhatsappSettings) Reset() {
+	var v DatasourceWhatsappSettings
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDatasourceWhatsappSettings) SetTo(v DatasourceWhatsappSettings) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDatasourceWhatsappSettings) Get() (v DatasourceWhatsappSettings, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDatasourceWhatsappSettings) Or(d DatasourceWhatsappSettings) DatasourceWhatsappSettings {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
 	Set   bool
 }
 
-// IsSet returns true if OptDatasourceTelegramSettings was set.
-func (o OptDatasourceTelegramSettings) IsSet() bool { return o.Set }
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptDatasourceTelegramSettings) Reset() {
-	var v DatasourceTelegramSettings
+func (o *OptDateTime) Reset() {
+	var v time.Time
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptDatasourceTelegramSettings) SetTo(v DatasourceTelegramSettings) {
+func (o *OptDateTime) SetTo(v time.Time) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptDatasourceTelegramSettings) Get() (v DatasourceTelegramSettings, ok bool) {
+func (o OptDateTime) Get() (v time.Time, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -4004,45 +6061,45 @@ func (o OptDatasourceTelegramSettings) Get() (v DatasourceTelegramSettings, ok b
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptDatasourceTelegramSettings) Or(d DatasourceTelegramSettings) DatasourceTelegramSettings {
+func (o OptDateTime) Or(d time.Time) time.Time {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptDatasourceWhatsappSettings returns new OptDatasourceWhatsappSettings with value set to v.
-func NewOptDatasourceWhatsappSettings(v DatasourceWhatsappSettings) OptDatasourceWhatsappSettings {
-	return OptDatasourceWhatsappSettings{
+// NewOptEmailSendContentType returns new OptEmailSendContentType with value set to v.
+func NewOptEmailSendContentType(v EmailSendContentType) OptEmailSendContentType {
+	return OptEmailSendContentType{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptDatasourceWhatsappSettings is optional DatasourceWhatsappSettings.
-type OptDatasourceWhatsappSettings struct {
-	Value DatasourceWhatsappSettings
+// OptEmailSendContentType is optional EmailSendContentType.
+type OptEmailSendContentType struct {
+	Value EmailSendContentType
 	Set   bool
 }
 
-// IsSet returns true if OptDatasourceWhatsappSettings was set.
-func (o OptDatasourceWhatsappSettings) IsSet() bool { return o.Set }
+// IsSet returns true if OptEmailSendContentType was set.
+func (o OptEmailSendContentType) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptDatasourceWhatsappSettings) Reset() {
-	var v DatasourceWhatsappSettings
+func (o *OptEmailSendContentType) Reset() {
+	var v EmailSendContentType
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptDatasourceWhatsappSettings) SetTo(v DatasourceWhatsappSettings) {
+func (o *OptEmailSendContentType) SetTo(v EmailSendContentType) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptDatasourceWhatsappSettings) Get() (v DatasourceWhatsappSettings, ok bool) {
+func (o OptEmailSendContentType) Get() (v EmailSendContentType, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -4050,45 +6107,45 @@ func (o OptDatasourceWhatsappSettings) Get() (v DatasourceWhatsappSettings, ok b
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptDatasourceWhatsappSettings) Or(d DatasourceWhatsappSettings) DatasourceWhatsappSettings {
+func (o OptEmailSendContentType) Or(d EmailSendContentType) EmailSendContentType {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptDateTime returns new OptDateTime with value set to v.
-func NewOptDateTime(v time.Time) OptDateTime {
-	return OptDateTime{
+// NewOptErasureReport returns new OptErasureReport with value set to v.
+func NewOptErasureReport(v ErasureReport) OptErasureReport {
+	return OptErasureReport{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptDateTime is optional time.Time.
-type OptDateTime struct {
-	Value time.Time
+// OptErasureReport is optional ErasureReport.
+type OptErasureReport struct {
+	Value ErasureReport
 	Set   bool
 }
 
-// IsSet returns true if OptDateTime was set.
-func (o OptDateTime) IsSet() bool { return o.Set }
+// IsSet returns true if OptErasureReport was set.
+func (o OptErasureReport) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptDateTime) Reset() {
-	var v time.Time
+func (o *OptErasureReport) Reset() {
+	var v ErasureReport
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptDateTime) SetTo(v time.Time) {
+func (o *OptErasureReport) SetTo(v ErasureReport) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptDateTime) Get() (v time.Time, ok bool) {
+func (o OptErasureReport) Get() (v ErasureReport, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -4096,7 +6153,7 @@ func (o OptDateTime) Get() (v time.Time, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptDateTime) Or(d time.Time) time.Time {
+func (o OptErasureReport) Or(d ErasureReport) ErasureReport {
 	if v, ok := o.Get(); ok {
 		return v
 	}
@@ -4563,6 +6620,52 @@ func (o OptMessageMeta) Or(d MessageMeta) MessageMeta {
 	return d
 }
 
+// NewOptMessageQueryMode returns new OptMessageQueryMode with value set to v.
+func NewOptMessageQueryMode(v MessageQueryMode) OptMessageQueryMode {
+	return OptMessageQueryMode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMessageQueryMode is optional MessageQueryMode.
+type OptMessageQueryMode struct {
+	Value MessageQueryMode
+	Set   bool
+}
+
+// IsSet returns true if OptMessageQueryMode was set.
+func (o OptMessageQueryMode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMessageQueryMode) Reset() {
+	var v MessageQueryMode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMessageQueryMode) SetTo(v MessageQueryMode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMessageQueryMode) Get() (v MessageQueryMode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMessageQueryMode) Or(d MessageQueryMode) MessageQueryMode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptMessageQueryOrder returns new OptMessageQueryOrder with value set to v.
 func NewOptMessageQueryOrder(v MessageQueryOrder) OptMessageQueryOrder {
 	return OptMessageQueryOrder{
@@ -4764,6 +6867,69 @@ func (o OptNilDateTime) Or(d time.Time) time.Time {
 	return d
 }
 
+// NewOptNilInt32 returns new OptNilInt32 with value set to v.
+func NewOptNilInt32(v int32) OptNilInt32 {
+	return OptNilInt32{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilInt32 is optional nullable int32.
+type OptNilInt32 struct {
+	Value int32
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilInt32 was set.
+func (o OptNilInt32) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilInt32) Reset() {
+	var v int32
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilInt32) SetTo(v int32) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilInt32) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilInt32) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v int32
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilInt32) Get() (v int32, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilInt32) Or(d int32) int32 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptNilString returns new OptNilString with value set to v.
 func NewOptNilString(v string) OptNilString {
 	return OptNilString{
@@ -4873,6 +7039,52 @@ func (o OptOAuth2ClientTokenObjToken) Or(d OAuth2ClientTokenObjToken) OAuth2Clie
 	return d
 }
 
+// NewOptOAuth2ClientTokenStatus returns new OptOAuth2ClientTokenStatus with value set to v.
+func NewOptOAuth2ClientTokenStatus(v OAuth2ClientTokenStatus) OptOAuth2ClientTokenStatus {
+	return OptOAuth2ClientTokenStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOAuth2ClientTokenStatus is optional OAuth2ClientTokenStatus.
+type OptOAuth2ClientTokenStatus struct {
+	Value OAuth2ClientTokenStatus
+	Set   bool
+}
+
+// IsSet returns true if OptOAuth2ClientTokenStatus was set.
+func (o OptOAuth2ClientTokenStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOAuth2ClientTokenStatus) Reset() {
+	var v OAuth2ClientTokenStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOAuth2ClientTokenStatus) SetTo(v OAuth2ClientTokenStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOAuth2ClientTokenStatus) Get() (v OAuth2ClientTokenStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOAuth2ClientTokenStatus) Or(d OAuth2ClientTokenStatus) OAuth2ClientTokenStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptPipelineEdgeType returns new OptPipelineEdgeType with value set to v.
 func NewOptPipelineEdgeType(v PipelineEdgeType) OptPipelineEdgeType {
 	return OptPipelineEdgeType{
@@ -4919,38 +7131,130 @@ func (o OptPipelineEdgeType) Or(d PipelineEdgeType) PipelineEdgeType {
 	return d
 }
 
-// NewOptPipelineFlow returns new OptPipelineFlow with value set to v.
-func NewOptPipelineFlow(v PipelineFlow) OptPipelineFlow {
-	return OptPipelineFlow{
+// NewOptPipelineFlow returns new OptPipelineFlow with value set to v.
+func NewOptPipelineFlow(v PipelineFlow) OptPipelineFlow {
+	return OptPipelineFlow{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPipelineFlow is optional PipelineFlow.
+type OptPipelineFlow struct {
+	Value PipelineFlow
+	Set   bool
+}
+
+// IsSet returns true if OptPipelineFlow was set.
+func (o OptPipelineFlow) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPipelineFlow) Reset() {
+	var v PipelineFlow
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPipelineFlow) SetTo(v PipelineFlow) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPipelineFlow) Get() (v PipelineFlow, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPipelineFlow) Or(d PipelineFlow) PipelineFlow {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPipelineNodeDataConfig returns new OptPipelineNodeDataConfig with value set to v.
+func NewOptPipelineNodeDataConfig(v PipelineNodeDataConfig) OptPipelineNodeDataConfig {
+	return OptPipelineNodeDataConfig{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPipelineNodeDataConfig is optional PipelineNodeDataConfig.
+type OptPipelineNodeDataConfig struct {
+	Value PipelineNodeDataConfig
+	Set   bool
+}
+
+// IsSet returns true if OptPipelineNodeDataConfig was set.
+func (o OptPipelineNodeDataConfig) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPipelineNodeDataConfig) Reset() {
+	var v PipelineNodeDataConfig
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPipelineNodeDataConfig) SetTo(v PipelineNodeDataConfig) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPipelineNodeDataConfig) Get() (v PipelineNodeDataConfig, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPipelineNodeDataConfig) Or(d PipelineNodeDataConfig) PipelineNodeDataConfig {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPipelineRun returns new OptPipelineRun with value set to v.
+func NewOptPipelineRun(v PipelineRun) OptPipelineRun {
+	return OptPipelineRun{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptPipelineFlow is optional PipelineFlow.
-type OptPipelineFlow struct {
-	Value PipelineFlow
+// OptPipelineRun is optional PipelineRun.
+type OptPipelineRun struct {
+	Value PipelineRun
 	Set   bool
 }
 
-// IsSet returns true if OptPipelineFlow was set.
-func (o OptPipelineFlow) IsSet() bool { return o.Set }
+// IsSet returns true if OptPipelineRun was set.
+func (o OptPipelineRun) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptPipelineFlow) Reset() {
-	var v PipelineFlow
+func (o *OptPipelineRun) Reset() {
+	var v PipelineRun
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptPipelineFlow) SetTo(v PipelineFlow) {
+func (o *OptPipelineRun) SetTo(v PipelineRun) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptPipelineFlow) Get() (v PipelineFlow, ok bool) {
+func (o OptPipelineRun) Get() (v PipelineRun, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -4958,45 +7262,45 @@ func (o OptPipelineFlow) Get() (v PipelineFlow, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptPipelineFlow) Or(d PipelineFlow) PipelineFlow {
+func (o OptPipelineRun) Or(d PipelineRun) PipelineRun {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptPipelineNodeDataConfig returns new OptPipelineNodeDataConfig with value set to v.
-func NewOptPipelineNodeDataConfig(v PipelineNodeDataConfig) OptPipelineNodeDataConfig {
-	return OptPipelineNodeDataConfig{
+// NewOptPolicyConditions returns new OptPolicyConditions with value set to v.
+func NewOptPolicyConditions(v PolicyConditions) OptPolicyConditions {
+	return OptPolicyConditions{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptPipelineNodeDataConfig is optional PipelineNodeDataConfig.
-type OptPipelineNodeDataConfig struct {
-	Value PipelineNodeDataConfig
+// OptPolicyConditions is optional PolicyConditions.
+type OptPolicyConditions struct {
+	Value PolicyConditions
 	Set   bool
 }
 
-// IsSet returns true if OptPipelineNodeDataConfig was set.
-func (o OptPipelineNodeDataConfig) IsSet() bool { return o.Set }
+// IsSet returns true if OptPolicyConditions was set.
+func (o OptPolicyConditions) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptPipelineNodeDataConfig) Reset() {
-	var v PipelineNodeDataConfig
+func (o *OptPolicyConditions) Reset() {
+	var v PolicyConditions
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptPipelineNodeDataConfig) SetTo(v PipelineNodeDataConfig) {
+func (o *OptPolicyConditions) SetTo(v PolicyConditions) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptPipelineNodeDataConfig) Get() (v PipelineNodeDataConfig, ok bool) {
+func (o OptPolicyConditions) Get() (v PolicyConditions, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -5004,7 +7308,7 @@ func (o OptPipelineNodeDataConfig) Get() (v PipelineNodeDataConfig, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptPipelineNodeDataConfig) Or(d PipelineNodeDataConfig) PipelineNodeDataConfig {
+func (o OptPolicyConditions) Or(d PolicyConditions) PolicyConditions {
 	if v, ok := o.Get(); ok {
 		return v
 	}
@@ -5762,7 +8066,8 @@ func (s *PipelineListOK) SetPipelines(val []Pipeline) {
 // Ref: #
 type PipelineNode struct {
 	ID string `json:"id"`
-	// Required. Ex datasource, extractor, filter, storage.
+	// Required. Ex datasource, extractor, filter, storage, embedder. An embedder node indexes the stored
+	// messages for semantic search, its config takes chunk_size and chunk_overlap in words.
 	Type     string               `json:"type"`
 	Position PipelineNodePosition `json:"position"`
 	Data     PipelineNodeData     `json:"data"`
@@ -5862,55 +8167,473 @@ type PipelineNodePosition struct {
 	Y OptFloat64 `json:"y"`
 }
 
-// GetX returns the value of X.
-func (s *PipelineNodePosition) GetX() OptFloat64 {
-	return s.X
+// GetX returns the value of X.
+func (s *PipelineNodePosition) GetX() OptFloat64 {
+	return s.X
+}
+
+// GetY returns the value of Y.
+func (s *PipelineNodePosition) GetY() OptFloat64 {
+	return s.Y
+}
+
+// SetX sets the value of X.
+func (s *PipelineNodePosition) SetX(val OptFloat64) {
+	s.X = val
+}
+
+// SetY sets the value of Y.
+func (s *PipelineNodePosition) SetY(val OptFloat64) {
+	s.Y = val
+}
+
+// A pipeline run triggered by hand. Without options it fetches the changes since the last run, like
+// a scheduled run, but leaves the sync cursors alone.
+// Ref: #
+type PipelineRun struct {
+	// UUID of the fetch job, watch it with GET /workerjobs/{uuid}.
+	JobUUID OptString `json:"job_uuid"`
+	// Backfill the messages received from this time on.
+	Since OptDateTime `json:"since"`
+	// Backfill the messages received before this time.
+	Until OptDateTime `json:"until"`
+	// Fetch at most this many messages.
+	Limit OptInt32 `json:"limit"`
+	// Evaluate the filters and extractors without storing messages, the counts are recorded in the
+	// result of the job.
+	DryRun OptBool `json:"dry_run"`
+	// Store the messages in this storage instead of the storage of the pipeline.
+	StorageUUID OptString `json:"storage_uuid"`
+}
+
+// GetJobUUID returns the value of JobUUID.
+func (s *PipelineRun) GetJobUUID() OptString {
+	return s.JobUUID
+}
+
+// GetSince returns the value of Since.
+func (s *PipelineRun) GetSince() OptDateTime {
+	return s.Since
+}
+
+// GetUntil returns the value of Until.
+func (s *PipelineRun) GetUntil() OptDateTime {
+	return s.Until
+}
+
+// GetLimit returns the value of Limit.
+func (s *PipelineRun) GetLimit() OptInt32 {
+	return s.Limit
+}
+
+// GetDryRun returns the value of DryRun.
+func (s *PipelineRun) GetDryRun() OptBool {
+	return s.DryRun
+}
+
+// GetStorageUUID returns the value of StorageUUID.
+func (s *PipelineRun) GetStorageUUID() OptString {
+	return s.StorageUUID
+}
+
+// SetJobUUID sets the value of JobUUID.
+func (s *PipelineRun) SetJobUUID(val OptString) {
+	s.JobUUID = val
+}
+
+// SetSince sets the value of Since.
+func (s *PipelineRun) SetSince(val OptDateTime) {
+	s.Since = val
+}
+
+// SetUntil sets the value of Until.
+func (s *PipelineRun) SetUntil(val OptDateTime) {
+	s.Until = val
+}
+
+// SetLimit sets the value of Limit.
+func (s *PipelineRun) SetLimit(val OptInt32) {
+	s.Limit = val
+}
+
+// SetDryRun sets the value of DryRun.
+func (s *PipelineRun) SetDryRun(val OptBool) {
+	s.DryRun = val
+}
+
+// SetStorageUUID sets the value of StorageUUID.
+func (s *PipelineRun) SetStorageUUID(val OptString) {
+	s.StorageUUID = val
+}
+
+type PlainCookieAuth struct {
+	APIKey string
+}
+
+// GetAPIKey returns the value of APIKey.
+func (s *PlainCookieAuth) GetAPIKey() string {
+	return s.APIKey
+}
+
+// SetAPIKey sets the value of APIKey.
+func (s *PlainCookieAuth) SetAPIKey(val string) {
+	s.APIKey = val
+}
+
+// An ory/ladon access policy. Every API operation is checked as an action (read, create,
+// update, delete or a verb such as run) on a resource shadowapi:KIND or shadowapi:KIND:UUID,
+// for the subjects role:ROLE (the caller's workspace role) and user:UUID. Subjects,
+// resources and actions may contain regular expressions in angle brackets. A matching deny
+// policy always wins. Policies apply to every workspace.
+// Ref: #
+type Policy struct {
+	// Unique identifier of the policy.
+	ID string `json:"id"`
+	// What the policy is for.
+	Description OptString `json:"description"`
+	// Subjects the policy applies to, e.g. role:readonly or user:UUID.
+	Subjects []string `json:"subjects"`
+	// Resources the policy applies to, e.g. shadowapi:<(message|file)(:.*)?>.
+	Resources []string `json:"resources"`
+	// Actions the policy applies to, e.g. read.
+	Actions []string `json:"actions"`
+	// Whether matching requests are allowed or denied.
+	Effect PolicyEffect `json:"effect"`
+	// Ladon conditions on the request context: workspace_uuid, user_uuid and operation.
+	Conditions OptPolicyConditions `json:"conditions"`
+	// Built-in policies implement the default roles, they can be updated but not deleted.
+	IsBuiltin OptBool     `json:"is_builtin"`
+	CreatedAt OptDateTime `json:"created_at"`
+	UpdatedAt OptDateTime `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Policy) GetID() string {
+	return s.ID
+}
+
+// GetDescription returns the value of Description.
+func (s *Policy) GetDescription() OptString {
+	return s.Description
+}
+
+// GetSubjects returns the value of Subjects.
+func (s *Policy) GetSubjects() []string {
+	return s.Subjects
+}
+
+// GetResources returns the value of Resources.
+func (s *Policy) GetResources() []string {
+	return s.Resources
+}
+
+// GetActions returns the value of Actions.
+func (s *Policy) GetActions() []string {
+	return s.Actions
+}
+
+// GetEffect returns the value of Effect.
+func (s *Policy) GetEffect() PolicyEffect {
+	return s.Effect
+}
+
+// GetConditions returns the value of Conditions.
+func (s *Policy) GetConditions() OptPolicyConditions {
+	return s.Conditions
+}
+
+// GetIsBuiltin returns the value of IsBuiltin.
+func (s *Policy) GetIsBuiltin() OptBool {
+	return s.IsBuiltin
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Policy) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Policy) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Policy) SetID(val string) {
+	s.ID = val
+}
+
+// SetDescription sets the value of Description.
+func (s *Policy) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetSubjects sets the value of Subjects.
+func (s *Policy) SetSubjects(val []string) {
+	s.Subjects = val
+}
+
+// SetResources sets the value of Resources.
+func (s *Policy) SetResources(val []string) {
+	s.Resources = val
+}
+
+// SetActions sets the value of Actions.
+func (s *Policy) SetActions(val []string) {
+	s.Actions = val
+}
+
+// SetEffect sets the value of Effect.
+func (s *Policy) SetEffect(val PolicyEffect) {
+	s.Effect = val
+}
+
+// SetConditions sets the value of Conditions.
+func (s *Policy) SetConditions(val OptPolicyConditions) {
+	s.Conditions = val
+}
+
+// SetIsBuiltin sets the value of IsBuiltin.
+func (s *Policy) SetIsBuiltin(val OptBool) {
+	s.IsBuiltin = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Policy) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Policy) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// Ladon conditions on the request context: workspace_uuid, user_uuid and operation.
+type PolicyConditions map[string]jx.Raw
+
+func (s *PolicyConditions) init() PolicyConditions {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// PolicyDeleteOK is response for PolicyDelete operation.
+type PolicyDeleteOK struct{}
+
+// Whether matching requests are allowed or denied.
+type PolicyEffect string
+
+const (
+	PolicyEffectAllow PolicyEffect = "allow"
+	PolicyEffectDeny  PolicyEffect = "deny"
+)
+
+// AllValues returns all PolicyEffect values.
+func (PolicyEffect) AllValues() []PolicyEffect {
+	return []PolicyEffect{
+		PolicyEffectAllow,
+		PolicyEffectDeny,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PolicyEffect) MarshalText() ([]byte, error) {
+	switch s {
+	case PolicyEffectAllow:
+		return []byte(s), nil
+	case PolicyEffectDeny:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PolicyEffect) UnmarshalText(data []byte) error {
+	switch PolicyEffect(data) {
+	case PolicyEffectAllow:
+		*s = PolicyEffectAllow
+		return nil
+	case PolicyEffectDeny:
+		*s = PolicyEffectDeny
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Retention rules for stored messages and files. A policy without datasource_uuid
+// and pipeline_uuid applies to all messages. A rule set to 0 is disabled.
+// Ref: #
+type RetentionPolicy struct {
+	// Unique identifier of the retention policy.
+	UUID OptString `json:"uuid"`
+	// Retention policy name.
+	Name string `json:"name"`
+	// Only apply to messages fetched from this datasource.
+	DatasourceUUID OptString `json:"datasource_uuid"`
+	// Only apply to messages ingested by this pipeline.
+	PipelineUUID OptString `json:"pipeline_uuid"`
+	// Delete messages older than this many days.
+	KeepDays OptInt32 `json:"keep_days"`
+	// Keep only the newest N messages.
+	KeepLast OptInt32 `json:"keep_last"`
+	// Delete attachments older than this many days, messages are kept.
+	AttachmentsDays OptInt32 `json:"attachments_days"`
+	// Whether the retention worker applies this policy.
+	IsEnabled OptBool `json:"is_enabled"`
+	// When the policy was last applied.
+	LastRunAt OptDateTime `json:"last_run_at"`
+	CreatedAt OptDateTime `json:"created_at"`
+	UpdatedAt OptDateTime `json:"updated_at"`
+}
+
+// GetUUID returns the value of UUID.
+func (s *RetentionPolicy) GetUUID() OptString {
+	return s.UUID
+}
+
+// GetName returns the value of Name.
+func (s *RetentionPolicy) GetName() string {
+	return s.Name
+}
+
+// GetDatasourceUUID returns the value of DatasourceUUID.
+func (s *RetentionPolicy) GetDatasourceUUID() OptString {
+	return s.DatasourceUUID
+}
+
+// GetPipelineUUID returns the value of PipelineUUID.
+func (s *RetentionPolicy) GetPipelineUUID() OptString {
+	return s.PipelineUUID
+}
+
+// GetKeepDays returns the value of KeepDays.
+func (s *RetentionPolicy) GetKeepDays() OptInt32 {
+	return s.KeepDays
+}
+
+// GetKeepLast returns the value of KeepLast.
+func (s *RetentionPolicy) GetKeepLast() OptInt32 {
+	return s.KeepLast
+}
+
+// GetAttachmentsDays returns the value of AttachmentsDays.
+func (s *RetentionPolicy) GetAttachmentsDays() OptInt32 {
+	return s.AttachmentsDays
+}
+
+// GetIsEnabled returns the value of IsEnabled.
+func (s *RetentionPolicy) GetIsEnabled() OptBool {
+	return s.IsEnabled
+}
+
+// GetLastRunAt returns the value of LastRunAt.
+func (s *RetentionPolicy) GetLastRunAt() OptDateTime {
+	return s.LastRunAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *RetentionPolicy) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *RetentionPolicy) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetUUID sets the value of UUID.
+func (s *RetentionPolicy) SetUUID(val OptString) {
+	s.UUID = val
+}
+
+// SetName sets the value of Name.
+func (s *RetentionPolicy) SetName(val string) {
+	s.Name = val
+}
+
+// SetDatasourceUUID sets the value of DatasourceUUID.
+func (s *RetentionPolicy) SetDatasourceUUID(val OptString) {
+	s.DatasourceUUID = val
+}
+
+// SetPipelineUUID sets the value of PipelineUUID.
+func (s *RetentionPolicy) SetPipelineUUID(val OptString) {
+	s.PipelineUUID = val
+}
+
+// SetKeepDays sets the value of KeepDays.
+func (s *RetentionPolicy) SetKeepDays(val OptInt32) {
+	s.KeepDays = val
 }
 
-// GetY returns the value of Y.
-func (s *PipelineNodePosition) GetY() OptFloat64 {
-	return s.Y
+// SetKeepLast sets the value of KeepLast.
+func (s *RetentionPolicy) SetKeepLast(val OptInt32) {
+	s.KeepLast = val
 }
 
-// SetX sets the value of X.
-func (s *PipelineNodePosition) SetX(val OptFloat64) {
-	s.X = val
+// SetAttachmentsDays sets the value of AttachmentsDays.
+func (s *RetentionPolicy) SetAttachmentsDays(val OptInt32) {
+	s.AttachmentsDays = val
 }
 
-// SetY sets the value of Y.
-func (s *PipelineNodePosition) SetY(val OptFloat64) {
-	s.Y = val
+// SetIsEnabled sets the value of IsEnabled.
+func (s *RetentionPolicy) SetIsEnabled(val OptBool) {
+	s.IsEnabled = val
 }
 
-type PlainCookieAuth struct {
-	APIKey string
+// SetLastRunAt sets the value of LastRunAt.
+func (s *RetentionPolicy) SetLastRunAt(val OptDateTime) {
+	s.LastRunAt = val
 }
 
-// GetAPIKey returns the value of APIKey.
-func (s *PlainCookieAuth) GetAPIKey() string {
-	return s.APIKey
+// SetCreatedAt sets the value of CreatedAt.
+func (s *RetentionPolicy) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// SetAPIKey sets the value of APIKey.
-func (s *PlainCookieAuth) SetAPIKey(val string) {
-	s.APIKey = val
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *RetentionPolicy) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
 }
 
+// RetentionPolicyDeleteOK is response for RetentionPolicyDelete operation.
+type RetentionPolicyDeleteOK struct{}
+
+// RetentionPolicyRunAccepted is response for RetentionPolicyRun operation.
+type RetentionPolicyRunAccepted struct{}
+
 // Ref: #
 type Scheduler struct {
 	// Unique identifier.
-	UUID           OptString      `json:"uuid"`
-	PipelineUUID   string         `json:"pipeline_uuid"`
-	ScheduleType   string         `json:"schedule_type"`
-	CronExpression OptNilString   `json:"cron_expression"`
-	RunAt          OptNilDateTime `json:"run_at"`
-	Timezone       OptString      `json:"timezone"`
-	NextRun        OptDateTime    `json:"next_run"`
-	LastRun        OptDateTime    `json:"last_run"`
-	IsEnabled      OptBool        `json:"is_enabled"`
-	IsPaused       OptBool        `json:"is_paused"`
-	CreatedAt      OptDateTime    `json:"created_at"`
-	UpdatedAt      OptDateTime    `json:"updated_at"`
+	UUID         OptString `json:"uuid"`
+	PipelineUUID string    `json:"pipeline_uuid"`
+	// Cron, interval or one_time. One-time schedulers run once at run_at and are disabled afterwards.
+	ScheduleType string `json:"schedule_type"`
+	// Five-field cron expression or a descriptor like @hourly, for cron schedules.
+	CronExpression OptNilString `json:"cron_expression"`
+	// Run of a one_time schedule.
+	RunAt OptNilDateTime `json:"run_at"`
+	// Period of an interval schedule.
+	IntervalSeconds OptNilInt32 `json:"interval_seconds"`
+	// Delays each run by a random number of seconds up to this value.
+	JitterSeconds OptInt32 `json:"jitter_seconds"`
+	// What a due run does while the previous job of the scheduler is still queued or running, skip
+	// (default) skips the run, queue runs it anyway and cancel cancels the previous job first.
+	OverlapPolicy OptString `json:"overlap_policy"`
+	// IANA timezone the cron expression is evaluated in, UTC by default.
+	Timezone  OptString   `json:"timezone"`
+	NextRun   OptDateTime `json:"next_run"`
+	LastRun   OptDateTime `json:"last_run"`
+	IsEnabled OptBool     `json:"is_enabled"`
+	IsPaused  OptBool     `json:"is_paused"`
+	// Why the system paused the scheduler, e.g. needs_reauth. Empty for schedulers paused by hand.
+	PausedReason OptString   `json:"paused_reason"`
+	CreatedAt    OptDateTime `json:"created_at"`
+	UpdatedAt    OptDateTime `json:"updated_at"`
 }
 
 // GetUUID returns the value of UUID.
@@ -5938,6 +8661,21 @@ func (s *Scheduler) GetRunAt() OptNilDateTime {
 	return s.RunAt
 }
 
+// GetIntervalSeconds returns the value of IntervalSeconds.
+func (s *Scheduler) GetIntervalSeconds() OptNilInt32 {
+	return s.IntervalSeconds
+}
+
+// GetJitterSeconds returns the value of JitterSeconds.
+func (s *Scheduler) GetJitterSeconds() OptInt32 {
+	return s.JitterSeconds
+}
+
+// GetOverlapPolicy returns the value of OverlapPolicy.
+func (s *Scheduler) GetOverlapPolicy() OptString {
+	return s.OverlapPolicy
+}
+
 // GetTimezone returns the value of Timezone.
 func (s *Scheduler) GetTimezone() OptString {
 	return s.Timezone
@@ -5963,6 +8701,11 @@ func (s *Scheduler) GetIsPaused() OptBool {
 	return s.IsPaused
 }
 
+// GetPausedReason returns the value of PausedReason.
+func (s *Scheduler) GetPausedReason() OptString {
+	return s.PausedReason
+}
+
 // GetCreatedAt returns the value of CreatedAt.
 func (s *Scheduler) GetCreatedAt() OptDateTime {
 	return s.CreatedAt
@@ -5998,6 +8741,21 @@ func (s *Scheduler) SetRunAt(val OptNilDateTime) {
 	s.RunAt = val
 }
 
+// SetIntervalSeconds sets the value of IntervalSeconds.
+func (s *Scheduler) SetIntervalSeconds(val OptNilInt32) {
+	s.IntervalSeconds = val
+}
+
+// SetJitterSeconds sets the value of JitterSeconds.
+func (s *Scheduler) SetJitterSeconds(val OptInt32) {
+	s.JitterSeconds = val
+}
+
+// SetOverlapPolicy sets the value of OverlapPolicy.
+func (s *Scheduler) SetOverlapPolicy(val OptString) {
+	s.OverlapPolicy = val
+}
+
 // SetTimezone sets the value of Timezone.
 func (s *Scheduler) SetTimezone(val OptString) {
 	s.Timezone = val
@@ -6023,6 +8781,11 @@ func (s *Scheduler) SetIsPaused(val OptBool) {
 	s.IsPaused = val
 }
 
+// SetPausedReason sets the value of PausedReason.
+func (s *Scheduler) SetPausedReason(val OptString) {
+	s.PausedReason = val
+}
+
 // SetCreatedAt sets the value of CreatedAt.
 func (s *Scheduler) SetCreatedAt(val OptDateTime) {
 	s.CreatedAt = val
@@ -6036,6 +8799,151 @@ func (s *Scheduler) SetUpdatedAt(val OptDateTime) {
 // SchedulerDeleteOK is response for SchedulerDelete operation.
 type SchedulerDeleteOK struct{}
 
+// A browser session of the current user.
+// Ref: #
+type Session struct {
+	UUID uuid.UUID `json:"uuid"`
+	// How the user logged in.
+	Source    SessionSource `json:"source"`
+	UserAgent OptString     `json:"user_agent"`
+	// Address the session was last used from.
+	IP         OptString `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	// When the session ends regardless of activity.
+	ExpiresAt time.Time `json:"expires_at"`
+	// Whether this is the session of the request.
+	Current OptBool `json:"current"`
+}
+
+// GetUUID returns the value of UUID.
+func (s *Session) GetUUID() uuid.UUID {
+	return s.UUID
+}
+
+// GetSource returns the value of Source.
+func (s *Session) GetSource() SessionSource {
+	return s.Source
+}
+
+// GetUserAgent returns the value of UserAgent.
+func (s *Session) GetUserAgent() OptString {
+	return s.UserAgent
+}
+
+// GetIP returns the value of IP.
+func (s *Session) GetIP() OptString {
+	return s.IP
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Session) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetLastSeenAt returns the value of LastSeenAt.
+func (s *Session) GetLastSeenAt() time.Time {
+	return s.LastSeenAt
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *Session) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// GetCurrent returns the value of Current.
+func (s *Session) GetCurrent() OptBool {
+	return s.Current
+}
+
+// SetUUID sets the value of UUID.
+func (s *Session) SetUUID(val uuid.UUID) {
+	s.UUID = val
+}
+
+// SetSource sets the value of Source.
+func (s *Session) SetSource(val SessionSource) {
+	s.Source = val
+}
+
+// SetUserAgent sets the value of UserAgent.
+func (s *Session) SetUserAgent(val OptString) {
+	s.UserAgent = val
+}
+
+// SetIP sets the value of IP.
+func (s *Session) SetIP(val OptString) {
+	s.IP = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Session) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetLastSeenAt sets the value of LastSeenAt.
+func (s *Session) SetLastSeenAt(val time.Time) {
+	s.LastSeenAt = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *Session) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+// SetCurrent sets the value of Current.
+func (s *Session) SetCurrent(val OptBool) {
+	s.Current = val
+}
+
+// SessionRevokeAllOK is response for SessionRevokeAll operation.
+type SessionRevokeAllOK struct{}
+
+// SessionRevokeOK is response for SessionRevoke operation.
+type SessionRevokeOK struct{}
+
+// How the user logged in.
+type SessionSource string
+
+const (
+	SessionSourcePassword SessionSource = "password"
+	SessionSourceZitadel  SessionSource = "zitadel"
+)
+
+// AllValues returns all SessionSource values.
+func (SessionSource) AllValues() []SessionSource {
+	return []SessionSource{
+		SessionSourcePassword,
+		SessionSourceZitadel,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SessionSource) MarshalText() ([]byte, error) {
+	switch s {
+	case SessionSourcePassword:
+		return []byte(s), nil
+	case SessionSourceZitadel:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SessionSource) UnmarshalText(data []byte) error {
+	switch SessionSource(data) {
+	case SessionSourcePassword:
+		*s = SessionSourcePassword
+		return nil
+	case SessionSourceZitadel:
+		*s = SessionSourceZitadel
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #
 type SessionStatus struct {
 	Active bool `json:"active"`
@@ -6043,6 +8951,10 @@ type SessionStatus struct {
 	UUID OptUUID `json:"uuid"`
 	// Why the session is inactive.
 	Reason OptString `json:"reason"`
+	// UUID of the current browser session.
+	SessionUUID OptUUID `json:"session_uuid"`
+	// Active browser sessions of the user.
+	Sessions []Session `json:"sessions"`
 }
 
 // GetActive returns the value of Active.
@@ -6060,6 +8972,16 @@ func (s *SessionStatus) GetReason() OptString {
 	return s.Reason
 }
 
+// GetSessionUUID returns the value of SessionUUID.
+func (s *SessionStatus) GetSessionUUID() OptUUID {
+	return s.SessionUUID
+}
+
+// GetSessions returns the value of Sessions.
+func (s *SessionStatus) GetSessions() []Session {
+	return s.Sessions
+}
+
 // SetActive sets the value of Active.
 func (s *SessionStatus) SetActive(val bool) {
 	s.Active = val
@@ -6075,6 +8997,16 @@ func (s *SessionStatus) SetReason(val OptString) {
 	s.Reason = val
 }
 
+// SetSessionUUID sets the value of SessionUUID.
+func (s *SessionStatus) SetSessionUUID(val OptUUID) {
+	s.SessionUUID = val
+}
+
+// SetSessions sets the value of Sessions.
+func (s *SessionStatus) SetSessions(val []Session) {
+	s.Sessions = val
+}
+
 // Data storage settings object.
 // Ref: #
 type Storage struct {
@@ -6237,62 +9169,243 @@ func (s StorageListOrderBy) MarshalText() ([]byte, error) {
 	}
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *StorageListOrderBy) UnmarshalText(data []byte) error {
-	switch StorageListOrderBy(data) {
-	case StorageListOrderByCreatedAt:
-		*s = StorageListOrderByCreatedAt
-		return nil
-	case StorageListOrderByUpdatedAt:
-		*s = StorageListOrderByUpdatedAt
-		return nil
-	case StorageListOrderByName:
-		*s = StorageListOrderByName
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *StorageListOrderBy) UnmarshalText(data []byte) error {
+	switch StorageListOrderBy(data) {
+	case StorageListOrderByCreatedAt:
+		*s = StorageListOrderByCreatedAt
+		return nil
+	case StorageListOrderByUpdatedAt:
+		*s = StorageListOrderByUpdatedAt
+		return nil
+	case StorageListOrderByName:
+		*s = StorageListOrderByName
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type StorageListOrderDirection string
+
+const (
+	StorageListOrderDirectionAsc  StorageListOrderDirection = "asc"
+	StorageListOrderDirectionDesc StorageListOrderDirection = "desc"
+)
+
+// AllValues returns all StorageListOrderDirection values.
+func (StorageListOrderDirection) AllValues() []StorageListOrderDirection {
+	return []StorageListOrderDirection{
+		StorageListOrderDirectionAsc,
+		StorageListOrderDirectionDesc,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s StorageListOrderDirection) MarshalText() ([]byte, error) {
+	switch s {
+	case StorageListOrderDirectionAsc:
+		return []byte(s), nil
+	case StorageListOrderDirectionDesc:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *StorageListOrderDirection) UnmarshalText(data []byte) error {
+	switch StorageListOrderDirection(data) {
+	case StorageListOrderDirectionAsc:
+		*s = StorageListOrderDirectionAsc
+		return nil
+	case StorageListOrderDirectionDesc:
+		*s = StorageListOrderDirectionDesc
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #
+type StorageMigration struct {
+	// Unique identifier of the storage migration.
+	UUID OptString `json:"uuid"`
+	// UUID of the storage message bodies and files are moved from.
+	SourceStorageUUID OptString `json:"source_storage_uuid"`
+	// UUID of the storage message bodies and files are moved to.
+	TargetStorageUUID string `json:"target_storage_uuid"`
+	// Migration status (pending, running, paused, done, failed).
+	Status OptString `json:"status"`
+	// Only move messages and files created more than this many days ago, 0 moves all of them.
+	OlderThanDays OptInt32 `json:"older_than_days"`
+	// Delete the source copy after the body or file was verified in the target storage.
+	DeleteSource OptBool `json:"delete_source"`
+	// Number of files processed per batch.
+	BatchSize OptInt32 `json:"batch_size"`
+	// UUID of the last migrated file, the migration resumes after it.
+	CheckpointUUID OptString `json:"checkpoint_uuid"`
+	// Number of message bodies migrated so far.
+	MessagesMigrated OptInt64 `json:"messages_migrated"`
+	// Number of files migrated so far.
+	FilesMigrated OptInt64 `json:"files_migrated"`
+	// Number of bytes migrated so far.
+	BytesMigrated OptInt64 `json:"bytes_migrated"`
+	// Last error, set when the migration failed or was paused.
+	Error      OptString   `json:"error"`
+	CreatedAt  OptDateTime `json:"created_at"`
+	UpdatedAt  OptDateTime `json:"updated_at"`
+	FinishedAt OptDateTime `json:"finished_at"`
+}
+
+// GetUUID returns the value of UUID.
+func (s *StorageMigration) GetUUID() OptString {
+	return s.UUID
+}
+
+// GetSourceStorageUUID returns the value of SourceStorageUUID.
+func (s *StorageMigration) GetSourceStorageUUID() OptString {
+	return s.SourceStorageUUID
+}
+
+// GetTargetStorageUUID returns the value of TargetStorageUUID.
+func (s *StorageMigration) GetTargetStorageUUID() string {
+	return s.TargetStorageUUID
+}
+
+// GetStatus returns the value of Status.
+func (s *StorageMigration) GetStatus() OptString {
+	return s.Status
+}
+
+// GetOlderThanDays returns the value of OlderThanDays.
+func (s *StorageMigration) GetOlderThanDays() OptInt32 {
+	return s.OlderThanDays
+}
+
+// GetDeleteSource returns the value of DeleteSource.
+func (s *StorageMigration) GetDeleteSource() OptBool {
+	return s.DeleteSource
+}
+
+// GetBatchSize returns the value of BatchSize.
+func (s *StorageMigration) GetBatchSize() OptInt32 {
+	return s.BatchSize
+}
+
+// GetCheckpointUUID returns the value of CheckpointUUID.
+func (s *StorageMigration) GetCheckpointUUID() OptString {
+	return s.CheckpointUUID
+}
+
+// GetMessagesMigrated returns the value of MessagesMigrated.
+func (s *StorageMigration) GetMessagesMigrated() OptInt64 {
+	return s.MessagesMigrated
+}
+
+// GetFilesMigrated returns the value of FilesMigrated.
+func (s *StorageMigration) GetFilesMigrated() OptInt64 {
+	return s.FilesMigrated
+}
+
+// GetBytesMigrated returns the value of BytesMigrated.
+func (s *StorageMigration) GetBytesMigrated() OptInt64 {
+	return s.BytesMigrated
+}
+
+// GetError returns the value of Error.
+func (s *StorageMigration) GetError() OptString {
+	return s.Error
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *StorageMigration) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *StorageMigration) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// GetFinishedAt returns the value of FinishedAt.
+func (s *StorageMigration) GetFinishedAt() OptDateTime {
+	return s.FinishedAt
+}
+
+// SetUUID sets the value of UUID.
+func (s *StorageMigration) SetUUID(val OptString) {
+	s.UUID = val
+}
+
+// SetSourceStorageUUID sets the value of SourceStorageUUID.
+func (s *StorageMigration) SetSourceStorageUUID(val OptString) {
+	s.SourceStorageUUID = val
+}
+
+// SetTargetStorageUUID sets the value of TargetStorageUUID.
+func (s *StorageMigration) SetTargetStorageUUID(val string) {
+	s.TargetStorageUUID = val
+}
+
+// SetStatus sets the value of Status.
+func (s *StorageMigration) SetStatus(val OptString) {
+	s.Status = val
+}
+
+// SetOlderThanDays sets the value of OlderThanDays.
+func (s *StorageMigration) SetOlderThanDays(val OptInt32) {
+	s.OlderThanDays = val
+}
+
+// SetDeleteSource sets the value of DeleteSource.
+func (s *StorageMigration) SetDeleteSource(val OptBool) {
+	s.DeleteSource = val
+}
+
+// SetBatchSize sets the value of BatchSize.
+func (s *StorageMigration) SetBatchSize(val OptInt32) {
+	s.BatchSize = val
+}
+
+// SetCheckpointUUID sets the value of CheckpointUUID.
+func (s *StorageMigration) SetCheckpointUUID(val OptString) {
+	s.CheckpointUUID = val
 }
 
-type StorageListOrderDirection string
+// SetMessagesMigrated sets the value of MessagesMigrated.
+func (s *StorageMigration) SetMessagesMigrated(val OptInt64) {
+	s.MessagesMigrated = val
+}
 
-const (
-	StorageListOrderDirectionAsc  StorageListOrderDirection = "asc"
-	StorageListOrderDirectionDesc StorageListOrderDirection = "desc"
-)
+// SetFilesMigrated sets the value of FilesMigrated.
+func (s *StorageMigration) SetFilesMigrated(val OptInt64) {
+	s.FilesMigrated = val
+}
 
-// AllValues returns all StorageListOrderDirection values.
-func (StorageListOrderDirection) AllValues() []StorageListOrderDirection {
-	return []StorageListOrderDirection{
-		StorageListOrderDirectionAsc,
-		StorageListOrderDirectionDesc,
-	}
+// SetBytesMigrated sets the value of BytesMigrated.
+func (s *StorageMigration) SetBytesMigrated(val OptInt64) {
+	s.BytesMigrated = val
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s StorageListOrderDirection) MarshalText() ([]byte, error) {
-	switch s {
-	case StorageListOrderDirectionAsc:
-		return []byte(s), nil
-	case StorageListOrderDirectionDesc:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// SetError sets the value of Error.
+func (s *StorageMigration) SetError(val OptString) {
+	s.Error = val
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *StorageListOrderDirection) UnmarshalText(data []byte) error {
-	switch StorageListOrderDirection(data) {
-	case StorageListOrderDirectionAsc:
-		*s = StorageListOrderDirectionAsc
-		return nil
-	case StorageListOrderDirectionDesc:
-		*s = StorageListOrderDirectionDesc
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// SetCreatedAt sets the value of CreatedAt.
+func (s *StorageMigration) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *StorageMigration) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// SetFinishedAt sets the value of FinishedAt.
+func (s *StorageMigration) SetFinishedAt(val OptDateTime) {
+	s.FinishedAt = val
 }
 
 // Ref: #
@@ -6306,7 +9419,8 @@ type StoragePostgres struct {
 	IsSameDatabase OptBool `json:"is_same_database"`
 	// The username used to connect to the PostgreSQL database.
 	User OptString `json:"user"`
-	// The password used to connect to the PostgreSQL database.
+	// The password used to connect to the PostgreSQL database. Encrypted at rest and returned as
+	// '********'; sending '********' back on update keeps the stored value.
 	Password OptString `json:"password"`
 	// The hostname or IP address of the PostgreSQL database server.
 	Host OptString `json:"host"`
@@ -6424,7 +9538,8 @@ type StorageS3 struct {
 	Bucket string `json:"bucket"`
 	// The access key ID.
 	AccessKeyID string `json:"access_key_id"`
-	// The secret access key.
+	// The secret access key. Encrypted at rest and returned as '********'; sending '********' back on
+	// update keeps the stored value.
 	SecretAccessKey string `json:"secret_access_key"`
 }
 
@@ -7340,12 +10455,153 @@ func (s *UserProfile) SetLastName(val string) {
 	s.LastName = val
 }
 
+// An HTTP endpoint receiving the events of the workspace.
+// Ref: #
+type Webhook struct {
+	UUID OptString `json:"uuid"`
+	Name string    `json:"name"`
+	// The events are POSTed as JSON to this http or https URL. Loopback, private and link-local
+	// addresses are refused, also when a name resolves to them, unless the server sets webhooks.
+	// allow_private.
+	URL string `json:"url"`
+	// Key of the X-Shadowapi-Signature header, "t=<unix time>,v1=<hex HMAC-SHA256 of "<unix time>.
+	// <body>">". Returned redacted, an update keeps the stored secret when the redacted value is sent
+	// back.
+	Secret OptString `json:"secret"`
+	// Event types delivered, e.g. datasource.needs_reauth. Empty delivers every event.
+	EventTypes []string `json:"event_types"`
+	IsEnabled  OptBool  `json:"is_enabled"`
+	// HTTP status of the last delivery.
+	LastStatus OptInt32  `json:"last_status"`
+	LastError  OptString `json:"last_error"`
+	// Failed deliveries since the last successful one, failed events are retried in order.
+	FailureCount OptInt32    `json:"failure_count"`
+	CreatedAt    OptDateTime `json:"created_at"`
+	UpdatedAt    OptDateTime `json:"updated_at"`
+}
+
+// GetUUID returns the value of UUID.
+func (s *Webhook) GetUUID() OptString {
+	return s.UUID
+}
+
+// GetName returns the value of Name.
+func (s *Webhook) GetName() string {
+	return s.Name
+}
+
+// GetURL returns the value of URL.
+func (s *Webhook) GetURL() string {
+	return s.URL
+}
+
+// GetSecret returns the value of Secret.
+func (s *Webhook) GetSecret() OptString {
+	return s.Secret
+}
+
+// GetEventTypes returns the value of EventTypes.
+func (s *Webhook) GetEventTypes() []string {
+	return s.EventTypes
+}
+
+// GetIsEnabled returns the value of IsEnabled.
+func (s *Webhook) GetIsEnabled() OptBool {
+	return s.IsEnabled
+}
+
+// GetLastStatus returns the value of LastStatus.
+func (s *Webhook) GetLastStatus() OptInt32 {
+	return s.LastStatus
+}
+
+// GetLastError returns the value of LastError.
+func (s *Webhook) GetLastError() OptString {
+	return s.LastError
+}
+
+// GetFailureCount returns the value of FailureCount.
+func (s *Webhook) GetFailureCount() OptInt32 {
+	return s.FailureCount
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Webhook) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Webhook) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetUUID sets the value of UUID.
+func (s *Webhook) SetUUID(val OptString) {
+	s.UUID = val
+}
+
+// SetName sets the value of Name.
+func (s *Webhook) SetName(val string) {
+	s.Name = val
+}
+
+// SetURL sets the value of URL.
+func (s *Webhook) SetURL(val string) {
+	s.URL = val
+}
+
+// SetSecret sets the value of Secret.
+func (s *Webhook) SetSecret(val OptString) {
+	s.Secret = val
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *Webhook) SetEventTypes(val []string) {
+	s.EventTypes = val
+}
+
+// SetIsEnabled sets the value of IsEnabled.
+func (s *Webhook) SetIsEnabled(val OptBool) {
+	s.IsEnabled = val
+}
+
+// SetLastStatus sets the value of LastStatus.
+func (s *Webhook) SetLastStatus(val OptInt32) {
+	s.LastStatus = val
+}
+
+// SetLastError sets the value of LastError.
+func (s *Webhook) SetLastError(val OptString) {
+	s.LastError = val
+}
+
+// SetFailureCount sets the value of FailureCount.
+func (s *Webhook) SetFailureCount(val OptInt32) {
+	s.FailureCount = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Webhook) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Webhook) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// WebhookDeleteOK is response for WebhookDelete operation.
+type WebhookDeleteOK struct{}
+
 // Ref: #
 type WorkerJobs struct {
 	// Unique identifier.
 	UUID OptString `json:"uuid"`
-	// UUID of the associated scheduler.
-	SchedulerUUID string `json:"scheduler_uuid"`
+	// UUID of the scheduler, policy or migration that triggered the job, missing for pipeline runs
+	// triggered by hand.
+	SchedulerUUID OptString `json:"scheduler_uuid"`
+	// UUID of the pipeline of a pipeline run, scheduled or triggered by hand.
+	PipelineUUID OptString `json:"pipeline_uuid"`
 	// UUID of the associated scheduler.
 	JobUUID OptString `json:"job_uuid"`
 	// NATS subject or job type.
@@ -7366,10 +10622,15 @@ func (s *WorkerJobs) GetUUID() OptString {
 }
 
 // GetSchedulerUUID returns the value of SchedulerUUID.
-func (s *WorkerJobs) GetSchedulerUUID() string {
+func (s *WorkerJobs) GetSchedulerUUID() OptString {
 	return s.SchedulerUUID
 }
 
+// GetPipelineUUID returns the value of PipelineUUID.
+func (s *WorkerJobs) GetPipelineUUID() OptString {
+	return s.PipelineUUID
+}
+
 // GetJobUUID returns the value of JobUUID.
 func (s *WorkerJobs) GetJobUUID() OptString {
 	return s.JobUUID
@@ -7406,10 +10667,15 @@ func (s *WorkerJobs) SetUUID(val OptString) {
 }
 
 // SetSchedulerUUID sets the value of SchedulerUUID.
-func (s *WorkerJobs) SetSchedulerUUID(val string) {
+func (s *WorkerJobs) SetSchedulerUUID(val OptString) {
 	s.SchedulerUUID = val
 }
 
+// SetPipelineUUID sets the value of PipelineUUID.
+func (s *WorkerJobs) SetPipelineUUID(val OptString) {
+	s.PipelineUUID = val
+}
+
 // SetJobUUID sets the value of JobUUID.
 func (s *WorkerJobs) SetJobUUID(val OptString) {
 	s.JobUUID = val
@@ -7472,6 +10738,206 @@ func (s *WorkerJobsListOK) SetJobs(val []WorkerJobs) {
 	s.Jobs = val
 }
 
+// A workspace owns datasources, pipelines, storages, messages, contacts, files and
+// schedulers. Requests select a workspace with the X-Workspace-ID header, without it
+// the oldest membership of the caller is used.
+// Ref: #
+type Workspace struct {
+	// Unique identifier of the workspace.
+	UUID OptString `json:"uuid"`
+	// Workspace name.
+	Name string `json:"name"`
+	// URL friendly unique name, derived from the name when empty.
+	Slug OptString `json:"slug"`
+	// Role of the caller in the workspace.
+	Role      OptString   `json:"role"`
+	CreatedAt OptDateTime `json:"created_at"`
+	UpdatedAt OptDateTime `json:"updated_at"`
+}
+
+// GetUUID returns the value of UUID.
+func (s *Workspace) GetUUID() OptString {
+	return s.UUID
+}
+
+// GetName returns the value of Name.
+func (s *Workspace) GetName() string {
+	return s.Name
+}
+
+// GetSlug returns the value of Slug.
+func (s *Workspace) GetSlug() OptString {
+	return s.Slug
+}
+
+// GetRole returns the value of Role.
+func (s *Workspace) GetRole() OptString {
+	return s.Role
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Workspace) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Workspace) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetUUID sets the value of UUID.
+func (s *Workspace) SetUUID(val OptString) {
+	s.UUID = val
+}
+
+// SetName sets the value of Name.
+func (s *Workspace) SetName(val string) {
+	s.Name = val
+}
+
+// SetSlug sets the value of Slug.
+func (s *Workspace) SetSlug(val OptString) {
+	s.Slug = val
+}
+
+// SetRole sets the value of Role.
+func (s *Workspace) SetRole(val OptString) {
+	s.Role = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Workspace) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Workspace) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// WorkspaceDeleteOK is response for WorkspaceDelete operation.
+type WorkspaceDeleteOK struct{}
+
+// Membership of a user in a workspace.
+// Ref: #
+type WorkspaceMember struct {
+	// UUID of the member.
+	UserUUID string `json:"user_uuid"`
+	// Email of the member.
+	Email OptString `json:"email"`
+	// Owners and admins manage the workspace and its members, operators manage resources,
+	// readonly members only read them and API clients access data but not the workspace.
+	Role      WorkspaceMemberRole `json:"role"`
+	CreatedAt OptDateTime         `json:"created_at"`
+}
+
+// GetUserUUID returns the value of UserUUID.
+func (s *WorkspaceMember) GetUserUUID() string {
+	return s.UserUUID
+}
+
+// GetEmail returns the value of Email.
+func (s *WorkspaceMember) GetEmail() OptString {
+	return s.Email
+}
+
+// GetRole returns the value of Role.
+func (s *WorkspaceMember) GetRole() WorkspaceMemberRole {
+	return s.Role
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *WorkspaceMember) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// SetUserUUID sets the value of UserUUID.
+func (s *WorkspaceMember) SetUserUUID(val string) {
+	s.UserUUID = val
+}
+
+// SetEmail sets the value of Email.
+func (s *WorkspaceMember) SetEmail(val OptString) {
+	s.Email = val
+}
+
+// SetRole sets the value of Role.
+func (s *WorkspaceMember) SetRole(val WorkspaceMemberRole) {
+	s.Role = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *WorkspaceMember) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// WorkspaceMemberDeleteOK is response for WorkspaceMemberDelete operation.
+type WorkspaceMemberDeleteOK struct{}
+
+// Owners and admins manage the workspace and its members, operators manage resources,
+// readonly members only read them and API clients access data but not the workspace.
+type WorkspaceMemberRole string
+
+const (
+	WorkspaceMemberRoleOwner     WorkspaceMemberRole = "owner"
+	WorkspaceMemberRoleAdmin     WorkspaceMemberRole = "admin"
+	WorkspaceMemberRoleOperator  WorkspaceMemberRole = "operator"
+	WorkspaceMemberRoleReadonly  WorkspaceMemberRole = "readonly"
+	WorkspaceMemberRoleAPIClient WorkspaceMemberRole = "api_client"
+)
+
+// AllValues returns all WorkspaceMemberRole values.
+func (WorkspaceMemberRole) AllValues() []WorkspaceMemberRole {
+	return []WorkspaceMemberRole{
+		WorkspaceMemberRoleOwner,
+		WorkspaceMemberRoleAdmin,
+		WorkspaceMemberRoleOperator,
+		WorkspaceMemberRoleReadonly,
+		WorkspaceMemberRoleAPIClient,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s WorkspaceMemberRole) MarshalText() ([]byte, error) {
+	switch s {
+	case WorkspaceMemberRoleOwner:
+		return []byte(s), nil
+	case WorkspaceMemberRoleAdmin:
+		return []byte(s), nil
+	case WorkspaceMemberRoleOperator:
+		return []byte(s), nil
+	case WorkspaceMemberRoleReadonly:
+		return []byte(s), nil
+	case WorkspaceMemberRoleAPIClient:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *WorkspaceMemberRole) UnmarshalText(data []byte) error {
+	switch WorkspaceMemberRole(data) {
+	case WorkspaceMemberRoleOwner:
+		*s = WorkspaceMemberRoleOwner
+		return nil
+	case WorkspaceMemberRoleAdmin:
+		*s = WorkspaceMemberRoleAdmin
+		return nil
+	case WorkspaceMemberRoleOperator:
+		*s = WorkspaceMemberRoleOperator
+		return nil
+	case WorkspaceMemberRoleReadonly:
+		*s = WorkspaceMemberRoleReadonly
+		return nil
+	case WorkspaceMemberRoleAPIClient:
+		*s = WorkspaceMemberRoleAPIClient
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 type ZitadelCookieAuth struct {
 	APIKey string
 }
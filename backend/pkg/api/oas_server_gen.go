@@ -8,6 +8,38 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// ApikeyCreate implements apikey-create operation.
+	//
+	// Create an API key. The response is the only time the key is shown.
+	//
+	// POST /apikey
+	ApikeyCreate(ctx context.Context, req *APIKey) (*APIKey, error)
+	// ApikeyList implements apikey-list operation.
+	//
+	// Retrieve the API keys of the workspace. Owners and admins see every key, other members
+	// their own keys.
+	//
+	// GET /apikey
+	ApikeyList(ctx context.Context, params ApikeyListParams) ([]APIKey, error)
+	// ApikeyRevoke implements apikey-revoke operation.
+	//
+	// Revoke an API key. Members revoke their own keys, owners and admins any key.
+	//
+	// DELETE /apikey/{uuid}
+	ApikeyRevoke(ctx context.Context, params ApikeyRevokeParams) error
+	// AuditExport implements audit-export operation.
+	//
+	// Export the matching audit events of the workspace as newline delimited JSON, one
+	// AuditEvent per line, newest first. Owners and admins only.
+	//
+	// GET /audit/export
+	AuditExport(ctx context.Context, params AuditExportParams) (AuditExportOK, error)
+	// AuditList implements audit-list operation.
+	//
+	// Retrieve the audit log of the workspace, newest first. Owners and admins only.
+	//
+	// GET /audit
+	AuditList(ctx context.Context, params AuditListParams) ([]AuditEvent, error)
 	// CreateContact implements createContact operation.
 	//
 	// Create a new contact record.
@@ -38,6 +70,42 @@ type Handler interface {
 	//
 	// GET /datasource/email/{uuid}
 	DatasourceEmailGet(ctx context.Context, params DatasourceEmailGetParams) (*DatasourceEmail, error)
+	// DatasourceEmailGraphCreate implements datasource-email-graph-create operation.
+	//
+	// Create a new Microsoft Graph mail datasource.
+	//
+	// POST /datasource/email_graph
+	DatasourceEmailGraphCreate(ctx context.Context, req *DatasourceEmailGraph) (*DatasourceEmailGraph, error)
+	// DatasourceEmailGraphDelete implements datasource-email-graph-delete operation.
+	//
+	// Delete a Microsoft Graph mail datasource.
+	//
+	// DELETE /datasource/email_graph/{uuid}
+	DatasourceEmailGraphDelete(ctx context.Context, params DatasourceEmailGraphDeleteParams) error
+	// DatasourceEmailGraphGet implements datasource-email-graph-get operation.
+	//
+	// Retrieve a Microsoft Graph mail datasource.
+	//
+	// GET /datasource/email_graph/{uuid}
+	DatasourceEmailGraphGet(ctx context.Context, params DatasourceEmailGraphGetParams) (*DatasourceEmailGraph, error)
+	// DatasourceEmailGraphList implements datasource-email-graph-list operation.
+	//
+	// List Microsoft Graph mail datasources.
+	//
+	// GET /datasource/email_graph
+	DatasourceEmailGraphList(ctx context.Context, params DatasourceEmailGraphListParams) ([]DatasourceEmailGraph, error)
+	// DatasourceEmailGraphSend implements datasource-email-graph-send operation.
+	//
+	// Send a mail from the mailbox of a Microsoft Graph mail datasource.
+	//
+	// POST /datasource/email_graph/{uuid}/send
+	DatasourceEmailGraphSend(ctx context.Context, req *EmailSend, params DatasourceEmailGraphSendParams) error
+	// DatasourceEmailGraphUpdate implements datasource-email-graph-update operation.
+	//
+	// Update an existing Microsoft Graph mail datasource.
+	//
+	// PUT /datasource/email_graph/{uuid}
+	DatasourceEmailGraphUpdate(ctx context.Context, req *DatasourceEmailGraph, params DatasourceEmailGraphUpdateParams) (*DatasourceEmailGraph, error)
 	// DatasourceEmailList implements datasource-email-list operation.
 	//
 	// List email datasources.
@@ -194,6 +262,25 @@ type Handler interface {
 	//
 	// DELETE /user/{uuid}
 	DeleteUser(ctx context.Context, params DeleteUserParams) error
+	// ErasureCreate implements erasure-create operation.
+	//
+	// Find every message, file blob and contact referencing an email address or phone
+	// number across all storages, delete or anonymise them and return the erasure report.
+	//
+	// POST /erasure
+	ErasureCreate(ctx context.Context, req *ErasureRequest) (*ErasureRequest, error)
+	// ErasureList implements erasure-list operation.
+	//
+	// Retrieve a list of executed erasure requests with their reports.
+	//
+	// GET /erasure
+	ErasureList(ctx context.Context, params ErasureListParams) ([]ErasureRequest, error)
+	// EventList implements event-list operation.
+	//
+	// Retrieve the events of the workspace, newest first. Events are kept for 7 days.
+	//
+	// GET /event
+	EventList(ctx context.Context, params EventListParams) ([]Event, error)
 	// FileCreate implements file-create operation.
 	//
 	// Upload a new file and create its record.
@@ -344,12 +431,24 @@ type Handler interface {
 	//
 	// GET /oauth2/client/{datasource_uuid}/token
 	OAuth2ClientTokenList(ctx context.Context, params OAuth2ClientTokenListParams) ([]OAuth2ClientToken, error)
+	// OAuth2ClientTokenRefresh implements oauth2-client-token-refresh operation.
+	//
+	// Refresh the OAuth2 client token now, whatever its expiry.
+	//
+	// POST /oauth2/client/{datasource_uuid}/token/{uuid}/refresh
+	OAuth2ClientTokenRefresh(ctx context.Context, params OAuth2ClientTokenRefreshParams) error
 	// OAuth2ClientUpdate implements oauth2-client-update operation.
 	//
 	// Update OAuth2 client.
 	//
 	// PUT /oauth2/client/{uuid}
 	OAuth2ClientUpdate(ctx context.Context, req *OAuth2ClientUpdateReq, params OAuth2ClientUpdateParams) (*OAuth2Client, error)
+	// OAuth2ProviderList implements oauth2-provider-list operation.
+	//
+	// List the OAuth2 providers OAuth2 clients can use.
+	//
+	// GET /oauth2/provider
+	OAuth2ProviderList(ctx context.Context) ([]OAuth2Provider, error)
 	// PipelineCreate implements pipeline-create operation.
 	//
 	// Create a new pipeline for a datasource.
@@ -374,12 +473,87 @@ type Handler interface {
 	//
 	// GET /pipeline
 	PipelineList(ctx context.Context, params PipelineListParams) (*PipelineListOK, error)
+	// PipelineRun implements pipeline-run operation.
+	//
+	// Enqueue a fetch job for the pipeline outside its schedule, optionally as a
+	// date range backfill, capped, as a dry run or into another storage. The job
+	// is recorded in worker jobs as queued.
+	//
+	// POST /pipeline/{uuid}/run
+	PipelineRun(ctx context.Context, req OptPipelineRun, params PipelineRunParams) (*PipelineRun, error)
 	// PipelineUpdate implements pipeline-update operation.
 	//
 	// Update an existing pipeline.
 	//
 	// PUT /pipeline/{uuid}
 	PipelineUpdate(ctx context.Context, req *Pipeline, params PipelineUpdateParams) (*Pipeline, error)
+	// PolicyCreate implements policy-create operation.
+	//
+	// Create a new access policy. Requires a system administrator.
+	//
+	// POST /policy
+	PolicyCreate(ctx context.Context, req *Policy) (*Policy, error)
+	// PolicyDelete implements policy-delete operation.
+	//
+	// Delete an access policy by id. Built-in policies cannot be deleted. Requires a system
+	// administrator.
+	//
+	// DELETE /policy/{id}
+	PolicyDelete(ctx context.Context, params PolicyDeleteParams) error
+	// PolicyGet implements policy-get operation.
+	//
+	// Retrieve an access policy by id.
+	//
+	// GET /policy/{id}
+	PolicyGet(ctx context.Context, params PolicyGetParams) (*Policy, error)
+	// PolicyList implements policy-list operation.
+	//
+	// Retrieve a list of access policies.
+	//
+	// GET /policy
+	PolicyList(ctx context.Context, params PolicyListParams) ([]Policy, error)
+	// PolicyUpdate implements policy-update operation.
+	//
+	// Update an access policy by id. Requires a system administrator.
+	//
+	// PUT /policy/{id}
+	PolicyUpdate(ctx context.Context, req *Policy, params PolicyUpdateParams) (*Policy, error)
+	// RetentionPolicyCreate implements retention-policy-create operation.
+	//
+	// Create a new retention policy.
+	//
+	// POST /retention
+	RetentionPolicyCreate(ctx context.Context, req *RetentionPolicy) (*RetentionPolicy, error)
+	// RetentionPolicyDelete implements retention-policy-delete operation.
+	//
+	// Delete a retention policy by UUID.
+	//
+	// DELETE /retention/{uuid}
+	RetentionPolicyDelete(ctx context.Context, params RetentionPolicyDeleteParams) error
+	// RetentionPolicyGet implements retention-policy-get operation.
+	//
+	// Retrieve a retention policy by UUID.
+	//
+	// GET /retention/{uuid}
+	RetentionPolicyGet(ctx context.Context, params RetentionPolicyGetParams) (*RetentionPolicy, error)
+	// RetentionPolicyList implements retention-policy-list operation.
+	//
+	// Retrieve a list of retention policies.
+	//
+	// GET /retention
+	RetentionPolicyList(ctx context.Context, params RetentionPolicyListParams) ([]RetentionPolicy, error)
+	// RetentionPolicyRun implements retention-policy-run operation.
+	//
+	// Apply a retention policy now instead of waiting for the scheduled retention job.
+	//
+	// POST /retention/{uuid}/run
+	RetentionPolicyRun(ctx context.Context, params RetentionPolicyRunParams) error
+	// RetentionPolicyUpdate implements retention-policy-update operation.
+	//
+	// Update a retention policy by UUID.
+	//
+	// PUT /retention/{uuid}
+	RetentionPolicyUpdate(ctx context.Context, req *RetentionPolicy, params RetentionPolicyUpdateParams) (*RetentionPolicy, error)
 	// SchedulerCreate implements scheduler-create operation.
 	//
 	// Create scheduler.
@@ -410,9 +584,21 @@ type Handler interface {
 	//
 	// PUT /scheduler/{uuid}
 	SchedulerUpdate(ctx context.Context, req *Scheduler, params SchedulerUpdateParams) (*Scheduler, error)
+	// SessionRevoke implements session-revoke operation.
+	//
+	// Revoke one session of the current user.
+	//
+	// DELETE /session/{uuid}
+	SessionRevoke(ctx context.Context, params SessionRevokeParams) error
+	// SessionRevokeAll implements session-revoke-all operation.
+	//
+	// Log out everywhere by revoking every session of the current user.
+	//
+	// DELETE /session
+	SessionRevokeAll(ctx context.Context, params SessionRevokeAllParams) error
 	// SessionStatus implements session-status operation.
 	//
-	// Introspect current session status.
+	// Introspect current session status and list the active sessions of the user.
 	//
 	// GET /session
 	SessionStatus(ctx context.Context) (*SessionStatus, error)
@@ -446,6 +632,21 @@ type Handler interface {
 	//
 	// GET /storage
 	StorageList(ctx context.Context, params StorageListParams) ([]Storage, error)
+	// StorageMigrate implements storage-migrate operation.
+	//
+	// Start moving message bodies and files from this storage to the target storage. If an
+	// unfinished migration with the same target and options exists it is resumed from its
+	// checkpoint. A migration to the same target still held by a run answers 409, a run that
+	// stopped renewing its lease is taken over.
+	//
+	// POST /storage/{uuid}/migrate
+	StorageMigrate(ctx context.Context, req *StorageMigration, params StorageMigrateParams) (*StorageMigration, error)
+	// StorageMigrationList implements storage-migration-list operation.
+	//
+	// List migrations started from this storage.
+	//
+	// GET /storage/{uuid}/migrate
+	StorageMigrationList(ctx context.Context, params StorageMigrationListParams) ([]StorageMigration, error)
 	// StoragePostgresCreate implements storage-postgres-create operation.
 	//
 	// Create a new PostgreSQL storage instance.
@@ -566,6 +767,36 @@ type Handler interface {
 	//
 	// POST /storage/upload
 	UploadFile(ctx context.Context, req *UploadFileRequest) (*UploadFileResponse, error)
+	// WebhookCreate implements webhook-create operation.
+	//
+	// Create a webhook. It receives the events emitted after its creation.
+	//
+	// POST /webhook
+	WebhookCreate(ctx context.Context, req *Webhook) (*Webhook, error)
+	// WebhookDelete implements webhook-delete operation.
+	//
+	// Delete a webhook.
+	//
+	// DELETE /webhook/{uuid}
+	WebhookDelete(ctx context.Context, params WebhookDeleteParams) error
+	// WebhookGet implements webhook-get operation.
+	//
+	// Retrieve a webhook.
+	//
+	// GET /webhook/{uuid}
+	WebhookGet(ctx context.Context, params WebhookGetParams) (*Webhook, error)
+	// WebhookList implements webhook-list operation.
+	//
+	// Retrieve the webhooks of the workspace.
+	//
+	// GET /webhook
+	WebhookList(ctx context.Context) ([]Webhook, error)
+	// WebhookUpdate implements webhook-update operation.
+	//
+	// Update a webhook.
+	//
+	// PUT /webhook/{uuid}
+	WebhookUpdate(ctx context.Context, req *Webhook, params WebhookUpdateParams) (*Webhook, error)
 	// WorkerJobsCancel implements worker-jobs-cancel operation.
 	//
 	// Signal cancellation for a running job; returns 204 if accepted.
@@ -590,6 +821,60 @@ type Handler interface {
 	//
 	// GET /workerjobs
 	WorkerJobsList(ctx context.Context, params WorkerJobsListParams) (*WorkerJobsListOK, error)
+	// WorkerJobsRetry implements worker-jobs-retry operation.
+	//
+	// Publish the job again under a new uuid with the arguments it was queued with. Returns the new job.
+	//
+	// POST /workerjobs/{uuid}/retry
+	WorkerJobsRetry(ctx context.Context, params WorkerJobsRetryParams) (*WorkerJobs, error)
+	// WorkspaceCreate implements workspace-create operation.
+	//
+	// Create a new workspace, the caller becomes its owner.
+	//
+	// POST /workspace
+	WorkspaceCreate(ctx context.Context, req *Workspace) (*Workspace, error)
+	// WorkspaceDelete implements workspace-delete operation.
+	//
+	// Delete a workspace and every resource it owns. Requires the owner role.
+	//
+	// DELETE /workspace/{uuid}
+	WorkspaceDelete(ctx context.Context, params WorkspaceDeleteParams) error
+	// WorkspaceGet implements workspace-get operation.
+	//
+	// Retrieve a workspace by UUID.
+	//
+	// GET /workspace/{uuid}
+	WorkspaceGet(ctx context.Context, params WorkspaceGetParams) (*Workspace, error)
+	// WorkspaceList implements workspace-list operation.
+	//
+	// Retrieve the workspaces of the caller. The bearer token sees every workspace.
+	//
+	// GET /workspace
+	WorkspaceList(ctx context.Context, params WorkspaceListParams) ([]Workspace, error)
+	// WorkspaceMemberDelete implements workspace-member-delete operation.
+	//
+	// Remove a user from a workspace. The last owner cannot be removed.
+	//
+	// DELETE /workspace/{uuid}/member/{user_uuid}
+	WorkspaceMemberDelete(ctx context.Context, params WorkspaceMemberDeleteParams) error
+	// WorkspaceMemberList implements workspace-member-list operation.
+	//
+	// Retrieve the members of a workspace.
+	//
+	// GET /workspace/{uuid}/member
+	WorkspaceMemberList(ctx context.Context, params WorkspaceMemberListParams) ([]WorkspaceMember, error)
+	// WorkspaceMemberSet implements workspace-member-set operation.
+	//
+	// Add a user to a workspace or change the role of a member. Requires the owner or admin role.
+	//
+	// POST /workspace/{uuid}/member
+	WorkspaceMemberSet(ctx context.Context, req *WorkspaceMember, params WorkspaceMemberSetParams) (*WorkspaceMember, error)
+	// WorkspaceUpdate implements workspace-update operation.
+	//
+	// Update a workspace by UUID. Requires the owner or admin role.
+	//
+	// PUT /workspace/{uuid}
+	WorkspaceUpdate(ctx context.Context, req *Workspace, params WorkspaceUpdateParams) (*Workspace, error)
 	// NewError creates *ErrorStatusCode from error returned by handler.
 	//
 	// Used for common default response.
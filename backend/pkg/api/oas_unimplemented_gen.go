@@ -13,6 +13,53 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// ApikeyCreate implements apikey-create operation.
+//
+// Create an API key. The response is the only time the key is shown.
+//
+// POST /apikey
+func (UnimplementedHandler) ApikeyCreate(ctx context.Context, req *APIKey) (r *APIKey, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ApikeyList implements apikey-list operation.
+//
+// Retrieve the API keys of the workspace. Owners and admins see every key, other members
+// their own keys.
+//
+// GET /apikey
+func (UnimplementedHandler) ApikeyList(ctx context.Context, params ApikeyListParams) (r []APIKey, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ApikeyRevoke implements apikey-revoke operation.
+//
+// Revoke an API key. Members revoke their own keys, owners and admins any key.
+//
+// DELETE /apikey/{uuid}
+func (UnimplementedHandler) ApikeyRevoke(ctx context.Context, params ApikeyRevokeParams) error {
+	return ht.ErrNotImplemented
+}
+
+// AuditExport implements audit-export operation.
+//
+// Export the matching audit events of the workspace as newline delimited JSON, one
+// AuditEvent per line, newest first. Owners and admins only.
+//
+// GET /audit/export
+func (UnimplementedHandler) AuditExport(ctx context.Context, params AuditExportParams) (r AuditExportOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AuditList implements audit-list operation.
+//
+// Retrieve the audit log of the workspace, newest first. Owners and admins only.
+//
+// GET /audit
+func (UnimplementedHandler) AuditList(ctx context.Context, params AuditListParams) (r []AuditEvent, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateContact implements createContact operation.
 //
 // Create a new contact record.
@@ -58,6 +105,60 @@ func (UnimplementedHandler) DatasourceEmailGet(ctx context.Context, params Datas
 	return r, ht.ErrNotImplemented
 }
 
+// DatasourceEmailGraphCreate implements datasource-email-graph-create operation.
+//
+// Create a new Microsoft Graph mail datasource.
+//
+// POST /datasource/email_graph
+func (UnimplementedHandler) DatasourceEmailGraphCreate(ctx context.Context, req *DatasourceEmailGraph) (r *DatasourceEmailGraph, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DatasourceEmailGraphDelete implements datasource-email-graph-delete operation.
+//
+// Delete a Microsoft Graph mail datasource.
+//
+// DELETE /datasource/email_graph/{uuid}
+func (UnimplementedHandler) DatasourceEmailGraphDelete(ctx context.Context, params DatasourceEmailGraphDeleteParams) error {
+	return ht.ErrNotImplemented
+}
+
+// DatasourceEmailGraphGet implements datasource-email-graph-get operation.
+//
+// Retrieve a Microsoft Graph mail datasource.
+//
+// GET /datasource/email_graph/{uuid}
+func (UnimplementedHandler) DatasourceEmailGraphGet(ctx context.Context, params DatasourceEmailGraphGetParams) (r *DatasourceEmailGraph, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DatasourceEmailGraphList implements datasource-email-graph-list operation.
+//
+// List Microsoft Graph mail datasources.
+//
+// GET /datasource/email_graph
+func (UnimplementedHandler) DatasourceEmailGraphList(ctx context.Context, params DatasourceEmailGraphListParams) (r []DatasourceEmailGraph, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DatasourceEmailGraphSend implements datasource-email-graph-send operation.
+//
+// Send a mail from the mailbox of a Microsoft Graph mail datasource.
+//
+// POST /datasource/email_graph/{uuid}/send
+func (UnimplementedHandler) DatasourceEmailGraphSend(ctx context.Context, req *EmailSend, params DatasourceEmailGraphSendParams) error {
+	return ht.ErrNotImplemented
+}
+
+// DatasourceEmailGraphUpdate implements datasource-email-graph-update operation.
+//
+// Update an existing Microsoft Graph mail datasource.
+//
+// PUT /datasource/email_graph/{uuid}
+func (UnimplementedHandler) DatasourceEmailGraphUpdate(ctx context.Context, req *DatasourceEmailGraph, params DatasourceEmailGraphUpdateParams) (r *DatasourceEmailGraph, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DatasourceEmailList implements datasource-email-list operation.
 //
 // List email datasources.
@@ -292,6 +393,34 @@ func (UnimplementedHandler) DeleteUser(ctx context.Context, params DeleteUserPar
 	return ht.ErrNotImplemented
 }
 
+// ErasureCreate implements erasure-create operation.
+//
+// Find every message, file blob and contact referencing an email address or phone
+// number across all storages, delete or anonymise them and return the erasure report.
+//
+// POST /erasure
+func (UnimplementedHandler) ErasureCreate(ctx context.Context, req *ErasureRequest) (r *ErasureRequest, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ErasureList implements erasure-list operation.
+//
+// Retrieve a list of executed erasure requests with their reports.
+//
+// GET /erasure
+func (UnimplementedHandler) ErasureList(ctx context.Context, params ErasureListParams) (r []ErasureRequest, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EventList implements event-list operation.
+//
+// Retrieve the events of the workspace, newest first. Events are kept for 7 days.
+//
+// GET /event
+func (UnimplementedHandler) EventList(ctx context.Context, params EventListParams) (r []Event, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // FileCreate implements file-create operation.
 //
 // Upload a new file and create its record.
@@ -517,6 +646,15 @@ func (UnimplementedHandler) OAuth2ClientTokenList(ctx context.Context, params OA
 	return r, ht.ErrNotImplemented
 }
 
+// OAuth2ClientTokenRefresh implements oauth2-client-token-refresh operation.
+//
+// Refresh the OAuth2 client token now, whatever its expiry.
+//
+// POST /oauth2/client/{datasource_uuid}/token/{uuid}/refresh
+func (UnimplementedHandler) OAuth2ClientTokenRefresh(ctx context.Context, params OAuth2ClientTokenRefreshParams) error {
+	return ht.ErrNotImplemented
+}
+
 // OAuth2ClientUpdate implements oauth2-client-update operation.
 //
 // Update OAuth2 client.
@@ -526,6 +664,15 @@ func (UnimplementedHandler) OAuth2ClientUpdate(ctx context.Context, req *OAuth2C
 	return r, ht.ErrNotImplemented
 }
 
+// OAuth2ProviderList implements oauth2-provider-list operation.
+//
+// List the OAuth2 providers OAuth2 clients can use.
+//
+// GET /oauth2/provider
+func (UnimplementedHandler) OAuth2ProviderList(ctx context.Context) (r []OAuth2Provider, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PipelineCreate implements pipeline-create operation.
 //
 // Create a new pipeline for a datasource.
@@ -562,6 +709,17 @@ func (UnimplementedHandler) PipelineList(ctx context.Context, params PipelineLis
 	return r, ht.ErrNotImplemented
 }
 
+// PipelineRun implements pipeline-run operation.
+//
+// Enqueue a fetch job for the pipeline outside its schedule, optionally as a
+// date range backfill, capped, as a dry run or into another storage. The job
+// is recorded in worker jobs as queued.
+//
+// POST /pipeline/{uuid}/run
+func (UnimplementedHandler) PipelineRun(ctx context.Context, req OptPipelineRun, params PipelineRunParams) (r *PipelineRun, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PipelineUpdate implements pipeline-update operation.
 //
 // Update an existing pipeline.
@@ -571,6 +729,106 @@ func (UnimplementedHandler) PipelineUpdate(ctx context.Context, req *Pipeline, p
 	return r, ht.ErrNotImplemented
 }
 
+// PolicyCreate implements policy-create operation.
+//
+// Create a new access policy. Requires a system administrator.
+//
+// POST /policy
+func (UnimplementedHandler) PolicyCreate(ctx context.Context, req *Policy) (r *Policy, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PolicyDelete implements policy-delete operation.
+//
+// Delete an access policy by id. Built-in policies cannot be deleted. Requires a system
+// administrator.
+//
+// DELETE /policy/{id}
+func (UnimplementedHandler) PolicyDelete(ctx context.Context, params PolicyDeleteParams) error {
+	return ht.ErrNotImplemented
+}
+
+// PolicyGet implements policy-get operation.
+//
+// Retrieve an access policy by id.
+//
+// GET /policy/{id}
+func (UnimplementedHandler) PolicyGet(ctx context.Context, params PolicyGetParams) (r *Policy, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PolicyList implements policy-list operation.
+//
+// Retrieve a list of access policies.
+//
+// GET /policy
+func (UnimplementedHandler) PolicyList(ctx context.Context, params PolicyListParams) (r []Policy, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PolicyUpdate implements policy-update operation.
+//
+// Update an access policy by id. Requires a system administrator.
+//
+// PUT /policy/{id}
+func (UnimplementedHandler) PolicyUpdate(ctx context.Context, req *Policy, params PolicyUpdateParams) (r *Policy, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RetentionPolicyCreate implements retention-policy-create operation.
+//
+// Create a new retention policy.
+//
+// POST /retention
+func (UnimplementedHandler) RetentionPolicyCreate(ctx context.Context, req *RetentionPolicy) (r *RetentionPolicy, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RetentionPolicyDelete implements retention-policy-delete operation.
+//
+// Delete a retention policy by UUID.
+//
+// DELETE /retention/{uuid}
+func (UnimplementedHandler) RetentionPolicyDelete(ctx context.Context, params RetentionPolicyDeleteParams) error {
+	return ht.ErrNotImplemented
+}
+
+// RetentionPolicyGet implements retention-policy-get operation.
+//
+// Retrieve a retention policy by UUID.
+//
+// GET /retention/{uuid}
+func (UnimplementedHandler) RetentionPolicyGet(ctx context.Context, params RetentionPolicyGetParams) (r *RetentionPolicy, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RetentionPolicyList implements retention-policy-list operation.
+//
+// Retrieve a list of retention policies.
+//
+// GET /retention
+func (UnimplementedHandler) RetentionPolicyList(ctx context.Context, params RetentionPolicyListParams) (r []RetentionPolicy, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RetentionPolicyRun implements retention-policy-run operation.
+//
+// Apply a retention policy now instead of waiting for the scheduled retention job.
+//
+// POST /retention/{uuid}/run
+func (UnimplementedHandler) RetentionPolicyRun(ctx context.Context, params RetentionPolicyRunParams) error {
+	return ht.ErrNotImplemented
+}
+
+// RetentionPolicyUpdate implements retention-policy-update operation.
+//
+// Update a retention policy by UUID.
+//
+// PUT /retention/{uuid}
+func (UnimplementedHandler) RetentionPolicyUpdate(ctx context.Context, req *RetentionPolicy, params RetentionPolicyUpdateParams) (r *RetentionPolicy, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // SchedulerCreate implements scheduler-create operation.
 //
 // Create scheduler.
@@ -616,9 +874,27 @@ func (UnimplementedHandler) SchedulerUpdate(ctx context.Context, req *Scheduler,
 	return r, ht.ErrNotImplemented
 }
 
+// SessionRevoke implements session-revoke operation.
+//
+// Revoke one session of the current user.
+//
+// DELETE /session/{uuid}
+func (UnimplementedHandler) SessionRevoke(ctx context.Context, params SessionRevokeParams) error {
+	return ht.ErrNotImplemented
+}
+
+// SessionRevokeAll implements session-revoke-all operation.
+//
+// Log out everywhere by revoking every session of the current user.
+//
+// DELETE /session
+func (UnimplementedHandler) SessionRevokeAll(ctx context.Context, params SessionRevokeAllParams) error {
+	return ht.ErrNotImplemented
+}
+
 // SessionStatus implements session-status operation.
 //
-// Introspect current session status.
+// Introspect current session status and list the active sessions of the user.
 //
 // GET /session
 func (UnimplementedHandler) SessionStatus(ctx context.Context) (r *SessionStatus, _ error) {
@@ -670,6 +946,27 @@ func (UnimplementedHandler) StorageList(ctx context.Context, params StorageListP
 	return r, ht.ErrNotImplemented
 }
 
+// StorageMigrate implements storage-migrate operation.
+//
+// Start moving message bodies and files from this storage to the target storage. If an
+// unfinished migration with the same target and options exists it is resumed from its
+// checkpoint. A migration to the same target still held by a run answers 409, a run that
+// stopped renewing its lease is taken over.
+//
+// POST /storage/{uuid}/migrate
+func (UnimplementedHandler) StorageMigrate(ctx context.Context, req *StorageMigration, params StorageMigrateParams) (r *StorageMigration, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// StorageMigrationList implements storage-migration-list operation.
+//
+// List migrations started from this storage.
+//
+// GET /storage/{uuid}/migrate
+func (UnimplementedHandler) StorageMigrationList(ctx context.Context, params StorageMigrationListParams) (r []StorageMigration, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // StoragePostgresCreate implements storage-postgres-create operation.
 //
 // Create a new PostgreSQL storage instance.
@@ -850,6 +1147,51 @@ func (UnimplementedHandler) UploadFile(ctx context.Context, req *UploadFileReque
 	return r, ht.ErrNotImplemented
 }
 
+// WebhookCreate implements webhook-create operation.
+//
+// Create a webhook. It receives the events emitted after its creation.
+//
+// POST /webhook
+func (UnimplementedHandler) WebhookCreate(ctx context.Context, req *Webhook) (r *Webhook, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WebhookDelete implements webhook-delete operation.
+//
+// Delete a webhook.
+//
+// DELETE /webhook/{uuid}
+func (UnimplementedHandler) WebhookDelete(ctx context.Context, params WebhookDeleteParams) error {
+	return ht.ErrNotImplemented
+}
+
+// WebhookGet implements webhook-get operation.
+//
+// Retrieve a webhook.
+//
+// GET /webhook/{uuid}
+func (UnimplementedHandler) WebhookGet(ctx context.Context, params WebhookGetParams) (r *Webhook, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WebhookList implements webhook-list operation.
+//
+// Retrieve the webhooks of the workspace.
+//
+// GET /webhook
+func (UnimplementedHandler) WebhookList(ctx context.Context) (r []Webhook, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WebhookUpdate implements webhook-update operation.
+//
+// Update a webhook.
+//
+// PUT /webhook/{uuid}
+func (UnimplementedHandler) WebhookUpdate(ctx context.Context, req *Webhook, params WebhookUpdateParams) (r *Webhook, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // WorkerJobsCancel implements worker-jobs-cancel operation.
 //
 // Signal cancellation for a running job; returns 204 if accepted.
@@ -886,6 +1228,87 @@ func (UnimplementedHandler) WorkerJobsList(ctx context.Context, params WorkerJob
 	return r, ht.ErrNotImplemented
 }
 
+// WorkerJobsRetry implements worker-jobs-retry operation.
+//
+// Publish the job again under a new uuid with the arguments it was queued with. Returns the new job.
+//
+// POST /workerjobs/{uuid}/retry
+func (UnimplementedHandler) WorkerJobsRetry(ctx context.Context, params WorkerJobsRetryParams) (r *WorkerJobs, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WorkspaceCreate implements workspace-create operation.
+//
+// Create a new workspace, the caller becomes its owner.
+//
+// POST /workspace
+func (UnimplementedHandler) WorkspaceCreate(ctx context.Context, req *Workspace) (r *Workspace, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WorkspaceDelete implements workspace-delete operation.
+//
+// Delete a workspace and every resource it owns. Requires the owner role.
+//
+// DELETE /workspace/{uuid}
+func (UnimplementedHandler) WorkspaceDelete(ctx context.Context, params WorkspaceDeleteParams) error {
+	return ht.ErrNotImplemented
+}
+
+// WorkspaceGet implements workspace-get operation.
+//
+// Retrieve a workspace by UUID.
+//
+// GET /workspace/{uuid}
+func (UnimplementedHandler) WorkspaceGet(ctx context.Context, params WorkspaceGetParams) (r *Workspace, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WorkspaceList implements workspace-list operation.
+//
+// Retrieve the workspaces of the caller. The bearer token sees every workspace.
+//
+// GET /workspace
+func (UnimplementedHandler) WorkspaceList(ctx context.Context, params WorkspaceListParams) (r []Workspace, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WorkspaceMemberDelete implements workspace-member-delete operation.
+//
+// Remove a user from a workspace. The last owner cannot be removed.
+//
+// DELETE /workspace/{uuid}/member/{user_uuid}
+func (UnimplementedHandler) WorkspaceMemberDelete(ctx context.Context, params WorkspaceMemberDeleteParams) error {
+	return ht.ErrNotImplemented
+}
+
+// WorkspaceMemberList implements workspace-member-list operation.
+//
+// Retrieve the members of a workspace.
+//
+// GET /workspace/{uuid}/member
+func (UnimplementedHandler) WorkspaceMemberList(ctx context.Context, params WorkspaceMemberListParams) (r []WorkspaceMember, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WorkspaceMemberSet implements workspace-member-set operation.
+//
+// Add a user to a workspace or change the role of a member. Requires the owner or admin role.
+//
+// POST /workspace/{uuid}/member
+func (UnimplementedHandler) WorkspaceMemberSet(ctx context.Context, req *WorkspaceMember, params WorkspaceMemberSetParams) (r *WorkspaceMember, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WorkspaceUpdate implements workspace-update operation.
+//
+// Update a workspace by UUID. Requires the owner or admin role.
+//
+// PUT /workspace/{uuid}
+func (UnimplementedHandler) WorkspaceUpdate(ctx context.Context, req *Workspace, params WorkspaceUpdateParams) (r *Workspace, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // NewError creates *ErrorStatusCode from error returned by handler.
 //
 // Used for common default response.
@@ -0,0 +1,250 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: access_policy.sql
+
+package query
+
+import (
+	"context"
+)
+
+const createAccessPolicy = `-- name: CreateAccessPolicy :one
+INSERT INTO access_policy (
+    id,
+    description,
+    subjects,
+    resources,
+    actions,
+    effect,
+    conditions,
+    meta,
+    is_builtin,
+    created_at,
+    updated_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    NOW(),
+    NOW()
+) RETURNING id, description, subjects, resources, actions, effect, conditions, meta, is_builtin, created_at, updated_at
+`
+
+type CreateAccessPolicyParams struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	Subjects    []byte `json:"subjects"`
+	Resources   []byte `json:"resources"`
+	Actions     []byte `json:"actions"`
+	Effect      string `json:"effect"`
+	Conditions  []byte `json:"conditions"`
+	Meta        []byte `json:"meta"`
+	IsBuiltin   bool   `json:"is_builtin"`
+}
+
+func (q *Queries) CreateAccessPolicy(ctx context.Context, arg CreateAccessPolicyParams) (AccessPolicy, error) {
+	row := q.db.QueryRow(ctx, createAccessPolicy,
+		arg.ID,
+		arg.Description,
+		arg.Subjects,
+		arg.Resources,
+		arg.Actions,
+		arg.Effect,
+		arg.Conditions,
+		arg.Meta,
+		arg.IsBuiltin,
+	)
+	var i AccessPolicy
+	err := row.Scan(
+		&i.ID,
+		&i.Description,
+		&i.Subjects,
+		&i.Resources,
+		&i.Actions,
+		&i.Effect,
+		&i.Conditions,
+		&i.Meta,
+		&i.IsBuiltin,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteAccessPolicy = `-- name: DeleteAccessPolicy :execrows
+DELETE FROM access_policy WHERE id = $1
+`
+
+func (q *Queries) DeleteAccessPolicy(ctx context.Context, id string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteAccessPolicy, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getAccessPolicies = `-- name: GetAccessPolicies :many
+SELECT id, description, subjects, resources, actions, effect, conditions, meta, is_builtin, created_at, updated_at FROM access_policy
+ORDER BY is_builtin DESC, id ASC
+LIMIT NULLIF($2::int, 0)
+    OFFSET $1::int
+`
+
+type GetAccessPoliciesParams struct {
+	Offset int32 `json:"offset"`
+	Limit  int32 `json:"limit"`
+}
+
+func (q *Queries) GetAccessPolicies(ctx context.Context, arg GetAccessPoliciesParams) ([]AccessPolicy, error) {
+	rows, err := q.db.Query(ctx, getAccessPolicies, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AccessPolicy
+	for rows.Next() {
+		var i AccessPolicy
+		if err := rows.Scan(
+			&i.ID,
+			&i.Description,
+			&i.Subjects,
+			&i.Resources,
+			&i.Actions,
+			&i.Effect,
+			&i.Conditions,
+			&i.Meta,
+			&i.IsBuiltin,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAccessPolicy = `-- name: GetAccessPolicy :one
+SELECT id, description, subjects, resources, actions, effect, conditions, meta, is_builtin, created_at, updated_at FROM access_policy WHERE id = $1
+`
+
+func (q *Queries) GetAccessPolicy(ctx context.Context, id string) (AccessPolicy, error) {
+	row := q.db.QueryRow(ctx, getAccessPolicy, id)
+	var i AccessPolicy
+	err := row.Scan(
+		&i.ID,
+		&i.Description,
+		&i.Subjects,
+		&i.Resources,
+		&i.Actions,
+		&i.Effect,
+		&i.Conditions,
+		&i.Meta,
+		&i.IsBuiltin,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const seedAccessPolicy = `-- name: SeedAccessPolicy :exec
+INSERT INTO access_policy (
+    id,
+    description,
+    subjects,
+    resources,
+    actions,
+    effect,
+    conditions,
+    is_builtin,
+    created_at,
+    updated_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    TRUE,
+    NOW(),
+    NOW()
+) ON CONFLICT (id) DO NOTHING
+`
+
+type SeedAccessPolicyParams struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	Subjects    []byte `json:"subjects"`
+	Resources   []byte `json:"resources"`
+	Actions     []byte `json:"actions"`
+	Effect      string `json:"effect"`
+	Conditions  []byte `json:"conditions"`
+}
+
+// Inserts a built-in policy unless it exists, so edits made through the API
+// survive restarts.
+func (q *Queries) SeedAccessPolicy(ctx context.Context, arg SeedAccessPolicyParams) error {
+	_, err := q.db.Exec(ctx, seedAccessPolicy,
+		arg.ID,
+		arg.Description,
+		arg.Subjects,
+		arg.Resources,
+		arg.Actions,
+		arg.Effect,
+		arg.Conditions,
+	)
+	return err
+}
+
+const updateAccessPolicy = `-- name: UpdateAccessPolicy :execrows
+UPDATE access_policy SET
+    description = $1,
+    subjects = $2,
+    resources = $3,
+    actions = $4,
+    effect = $5,
+    conditions = $6,
+    meta = $7,
+    updated_at = NOW()
+WHERE id = $8
+`
+
+type UpdateAccessPolicyParams struct {
+	Description string `json:"description"`
+	Subjects    []byte `json:"subjects"`
+	Resources   []byte `json:"resources"`
+	Actions     []byte `json:"actions"`
+	Effect      string `json:"effect"`
+	Conditions  []byte `json:"conditions"`
+	Meta        []byte `json:"meta"`
+	ID          string `json:"id"`
+}
+
+func (q *Queries) UpdateAccessPolicy(ctx context.Context, arg UpdateAccessPolicyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateAccessPolicy,
+		arg.Description,
+		arg.Subjects,
+		arg.Resources,
+		arg.Actions,
+		arg.Effect,
+		arg.Conditions,
+		arg.Meta,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
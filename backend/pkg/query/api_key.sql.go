@@ -0,0 +1,223 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: api_key.sql
+
+package query
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAPIKey = `-- name: CreateAPIKey :one
+INSERT INTO api_key (
+    uuid,
+    user_uuid,
+    name,
+    prefix,
+    hash,
+    role,
+    scopes,
+    allowed_ips,
+    expires_at,
+    created_at
+) VALUES (
+    $1::uuid,
+    $2::uuid,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7::text[],
+    $8::text[],
+    $9,
+    NOW()
+) RETURNING uuid, workspace_uuid, user_uuid, name, prefix, hash, role, scopes, allowed_ips, expires_at, last_used_at, last_used_ip, revoked_at, created_at
+`
+
+type CreateAPIKeyParams struct {
+	UUID       pgtype.UUID        `json:"uuid"`
+	UserUUID   pgtype.UUID        `json:"user_uuid"`
+	Name       string             `json:"name"`
+	Prefix     string             `json:"prefix"`
+	Hash       string             `json:"hash"`
+	Role       string             `json:"role"`
+	Scopes     []string           `json:"scopes"`
+	AllowedIPs []string           `json:"allowed_ips"`
+	ExpiresAt  pgtype.Timestamptz `json:"expires_at"`
+}
+
+func (q *Queries) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (APIKey, error) {
+	row := q.db.QueryRow(ctx, createAPIKey,
+		arg.UUID,
+		arg.UserUUID,
+		arg.Name,
+		arg.Prefix,
+		arg.Hash,
+		arg.Role,
+		arg.Scopes,
+		arg.AllowedIPs,
+		arg.ExpiresAt,
+	)
+	var i APIKey
+	err := row.Scan(
+		&i.UUID,
+		&i.WorkspaceUUID,
+		&i.UserUUID,
+		&i.Name,
+		&i.Prefix,
+		&i.Hash,
+		&i.Role,
+		&i.Scopes,
+		&i.AllowedIPs,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.LastUsedIP,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAPIKey = `-- name: GetAPIKey :one
+SELECT uuid, workspace_uuid, user_uuid, name, prefix, hash, role, scopes, allowed_ips, expires_at, last_used_at, last_used_ip, revoked_at, created_at FROM api_key WHERE uuid = $1::uuid
+`
+
+func (q *Queries) GetAPIKey(ctx context.Context, uuid pgtype.UUID) (APIKey, error) {
+	row := q.db.QueryRow(ctx, getAPIKey, uuid)
+	var i APIKey
+	err := row.Scan(
+		&i.UUID,
+		&i.WorkspaceUUID,
+		&i.UserUUID,
+		&i.Name,
+		&i.Prefix,
+		&i.Hash,
+		&i.Role,
+		&i.Scopes,
+		&i.AllowedIPs,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.LastUsedIP,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAPIKeyByHash = `-- name: GetAPIKeyByHash :one
+SELECT uuid, workspace_uuid, user_uuid, name, prefix, hash, role, scopes, allowed_ips, expires_at, last_used_at, last_used_ip, revoked_at, created_at FROM api_key WHERE hash = $1
+`
+
+func (q *Queries) GetAPIKeyByHash(ctx context.Context, hash string) (APIKey, error) {
+	row := q.db.QueryRow(ctx, getAPIKeyByHash, hash)
+	var i APIKey
+	err := row.Scan(
+		&i.UUID,
+		&i.WorkspaceUUID,
+		&i.UserUUID,
+		&i.Name,
+		&i.Prefix,
+		&i.Hash,
+		&i.Role,
+		&i.Scopes,
+		&i.AllowedIPs,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.LastUsedIP,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAPIKeys = `-- name: GetAPIKeys :many
+SELECT uuid, workspace_uuid, user_uuid, name, prefix, hash, role, scopes, allowed_ips, expires_at, last_used_at, last_used_ip, revoked_at, created_at FROM api_key
+WHERE
+    ($1::uuid IS NULL OR user_uuid = $1::uuid)
+    AND ($2::bool OR revoked_at IS NULL)
+ORDER BY created_at DESC
+LIMIT NULLIF($4::int, 0)
+    OFFSET $3::int
+`
+
+type GetAPIKeysParams struct {
+	UserUUID       pgtype.UUID `json:"user_uuid"`
+	IncludeRevoked bool        `json:"include_revoked"`
+	Offset         int32       `json:"offset"`
+	Limit          int32       `json:"limit"`
+}
+
+func (q *Queries) GetAPIKeys(ctx context.Context, arg GetAPIKeysParams) ([]APIKey, error) {
+	rows, err := q.db.Query(ctx, getAPIKeys,
+		arg.UserUUID,
+		arg.IncludeRevoked,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []APIKey
+	for rows.Next() {
+		var i APIKey
+		if err := rows.Scan(
+			&i.UUID,
+			&i.WorkspaceUUID,
+			&i.UserUUID,
+			&i.Name,
+			&i.Prefix,
+			&i.Hash,
+			&i.Role,
+			&i.Scopes,
+			&i.AllowedIPs,
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.LastUsedIP,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeAPIKey = `-- name: RevokeAPIKey :execrows
+UPDATE api_key SET revoked_at = NOW()
+WHERE uuid = $1::uuid AND revoked_at IS NULL
+`
+
+func (q *Queries) RevokeAPIKey(ctx context.Context, uuid pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeAPIKey, uuid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const touchAPIKey = `-- name: TouchAPIKey :exec
+UPDATE api_key SET
+    last_used_at = NOW(),
+    last_used_ip = $1
+WHERE uuid = $2::uuid
+    AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute' OR last_used_ip IS DISTINCT FROM $1)
+`
+
+type TouchAPIKeyParams struct {
+	LastUsedIP pgtype.Text `json:"last_used_ip"`
+	UUID       pgtype.UUID `json:"uuid"`
+}
+
+// Records the last use at most once a minute per key.
+func (q *Queries) TouchAPIKey(ctx context.Context, arg TouchAPIKeyParams) error {
+	_, err := q.db.Exec(ctx, touchAPIKey, arg.LastUsedIP, arg.UUID)
+	return err
+}
@@ -0,0 +1,182 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: audit_event.sql
+
+package query
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAuditEvent = `-- name: CreateAuditEvent :exec
+INSERT INTO audit_event (
+    uuid,
+    workspace_uuid,
+    occurred_at,
+    actor,
+    source,
+    api_key_uuid,
+    operation_id,
+    resource_kind,
+    action,
+    resource_id,
+    params,
+    diff,
+    outcome,
+    status,
+    error,
+    ip,
+    user_agent,
+    duration_ms
+) VALUES (
+    $1::uuid,
+    $2::uuid,
+    $3,
+    $4,
+    $5,
+    $6::uuid,
+    $7,
+    $8,
+    $9,
+    $10,
+    $11,
+    $12,
+    $13,
+    $14,
+    $15,
+    $16,
+    $17,
+    $18
+)
+`
+
+type CreateAuditEventParams struct {
+	UUID          pgtype.UUID        `json:"uuid"`
+	WorkspaceUUID pgtype.UUID        `json:"workspace_uuid"`
+	OccurredAt    pgtype.Timestamptz `json:"occurred_at"`
+	Actor         string             `json:"actor"`
+	Source        string             `json:"source"`
+	APIKeyUUID    pgtype.UUID        `json:"api_key_uuid"`
+	OperationID   string             `json:"operation_id"`
+	ResourceKind  string             `json:"resource_kind"`
+	Action        string             `json:"action"`
+	ResourceID    string             `json:"resource_id"`
+	Params        []byte             `json:"params"`
+	Diff          []byte             `json:"diff"`
+	Outcome       string             `json:"outcome"`
+	Status        int32              `json:"status"`
+	Error         string             `json:"error"`
+	IP            string             `json:"ip"`
+	UserAgent     string             `json:"user_agent"`
+	DurationMs    int32              `json:"duration_ms"`
+}
+
+func (q *Queries) CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) error {
+	_, err := q.db.Exec(ctx, createAuditEvent,
+		arg.UUID,
+		arg.WorkspaceUUID,
+		arg.OccurredAt,
+		arg.Actor,
+		arg.Source,
+		arg.APIKeyUUID,
+		arg.OperationID,
+		arg.ResourceKind,
+		arg.Action,
+		arg.ResourceID,
+		arg.Params,
+		arg.Diff,
+		arg.Outcome,
+		arg.Status,
+		arg.Error,
+		arg.IP,
+		arg.UserAgent,
+		arg.DurationMs,
+	)
+	return err
+}
+
+const getAuditEvents = `-- name: GetAuditEvents :many
+SELECT uuid, workspace_uuid, occurred_at, actor, source, api_key_uuid, operation_id, resource_kind, action, resource_id, params, diff, outcome, status, error, ip, user_agent, duration_ms FROM audit_event
+WHERE
+    ($1::varchar IS NULL OR actor = $1::varchar)
+    AND ($2::varchar IS NULL OR source = $2::varchar)
+    AND ($3::varchar IS NULL OR operation_id = $3::varchar)
+    AND ($4::varchar IS NULL OR resource_kind = $4::varchar)
+    AND ($5::varchar IS NULL OR resource_id = $5::varchar)
+    AND ($6::varchar IS NULL OR outcome = $6::varchar)
+    AND ($7::timestamptz IS NULL OR occurred_at >= $7::timestamptz)
+    AND ($8::timestamptz IS NULL OR occurred_at < $8::timestamptz)
+    -- keyset pagination for exports, event uuids are time ordered
+    AND ($9::uuid IS NULL OR uuid < $9::uuid)
+ORDER BY uuid DESC
+LIMIT NULLIF($11::int, 0)
+    OFFSET $10::int
+`
+
+type GetAuditEventsParams struct {
+	Actor        pgtype.Text        `json:"actor"`
+	Source       pgtype.Text        `json:"source"`
+	OperationID  pgtype.Text        `json:"operation_id"`
+	ResourceKind pgtype.Text        `json:"resource_kind"`
+	ResourceID   pgtype.Text        `json:"resource_id"`
+	Outcome      pgtype.Text        `json:"outcome"`
+	Since        pgtype.Timestamptz `json:"since"`
+	Until        pgtype.Timestamptz `json:"until"`
+	BeforeUUID   pgtype.UUID        `json:"before_uuid"`
+	Offset       int32              `json:"offset"`
+	Limit        int32              `json:"limit"`
+}
+
+func (q *Queries) GetAuditEvents(ctx context.Context, arg GetAuditEventsParams) ([]AuditEvent, error) {
+	rows, err := q.db.Query(ctx, getAuditEvents,
+		arg.Actor,
+		arg.Source,
+		arg.OperationID,
+		arg.ResourceKind,
+		arg.ResourceID,
+		arg.Outcome,
+		arg.Since,
+		arg.Until,
+		arg.BeforeUUID,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditEvent
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.UUID,
+			&i.WorkspaceUUID,
+			&i.OccurredAt,
+			&i.Actor,
+			&i.Source,
+			&i.APIKeyUUID,
+			&i.OperationID,
+			&i.ResourceKind,
+			&i.Action,
+			&i.ResourceID,
+			&i.Params,
+			&i.Diff,
+			&i.Outcome,
+			&i.Status,
+			&i.Error,
+			&i.IP,
+			&i.UserAgent,
+			&i.DurationMs,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
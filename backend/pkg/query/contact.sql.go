@@ -265,7 +265,7 @@ INSERT INTO contact (
              $123,
              $124,
              $125
-         ) RETURNING uuid, user_uuid, instance_uuid, status, names, names_search, last, first, middle, birthday, birthday_type, salary, salary_data, last_positions, last_position_id, last_position_company_id, last_position_company_name, last_position_title, last_position_start_date, last_position_end_date, last_position_end_now, last_position_description, note_search, note_kpi_id, phones, phone_search, phone1, phone1_type, phone1_country, phone2, phone2_type, phone2_country, phone3, phone3_type, phone3_country, phone4, phone4_type, phone4_country, phone5, phone5_type, phone5_country, emails, email_search, email1, email1_type, email2, email2_type, email3, email3_type, email4, email4_type, email5, email5_type, messengers, messengers_search, skype_uuid, skype, whatsapp_uuid, whatsapp, telegram_uuid, telegram, wechat_uuid, wechat, line_uuid, line, socials, socials_search, linkedin_uuid, linkedin_url, facebook_uuid, facebook_url, twitter_uuid, twitter_url, github_uuid, github_url, vk_uuid, vk_url, odno_uuid, odno_url, hhru_uuid, hhru_url, habr_uuid, habr_url, moikrug_uuid, moikrug_url, instagram_uuid, instagram_url, social1_uuid, social1_url, social1_type, social2_uuid, social2_url, social2_type, social3_uuid, social3_url, social3_type, social4_uuid, social4_url, social4_type, social5_uuid, social5_url, social5_type, social6_uuid, social6_url, social6_type, social7_uuid, social7_url, social7_type, social8_uuid, social8_url, social8_type, social9_uuid, social9_url, social9_type, tracking_source, tracking_slug, cached_img, cached_img_data, crawl, duplicate_user_id, duplicate_alternative_id, duplicate_report_date, entry_date, edit_date, last_kpi_entry_date
+         ) RETURNING uuid, user_uuid, instance_uuid, status, names, names_search, last, first, middle, birthday, birthday_type, salary, salary_data, last_positions, last_position_id, last_position_company_id, last_position_company_name, last_position_title, last_position_start_date, last_position_end_date, last_position_end_now, last_position_description, note_search, note_kpi_id, phones, phone_search, phone1, phone1_type, phone1_country, phone2, phone2_type, phone2_country, phone3, phone3_type, phone3_country, phone4, phone4_type, phone4_country, phone5, phone5_type, phone5_country, emails, email_search, email1, email1_type, email2, email2_type, email3, email3_type, email4, email4_type, email5, email5_type, messengers, messengers_search, skype_uuid, skype, whatsapp_uuid, whatsapp, telegram_uuid, telegram, wechat_uuid, wechat, line_uuid, line, socials, socials_search, linkedin_uuid, linkedin_url, facebook_uuid, facebook_url, twitter_uuid, twitter_url, github_uuid, github_url, vk_uuid, vk_url, odno_uuid, odno_url, hhru_uuid, hhru_url, habr_uuid, habr_url, moikrug_uuid, moikrug_url, instagram_uuid, instagram_url, social1_uuid, social1_url, social1_type, social2_uuid, social2_url, social2_type, social3_uuid, social3_url, social3_type, social4_uuid, social4_url, social4_type, social5_uuid, social5_url, social5_type, social6_uuid, social6_url, social6_type, social7_uuid, social7_url, social7_type, social8_uuid, social8_url, social8_type, social9_uuid, social9_url, social9_type, tracking_source, tracking_slug, cached_img, cached_img_data, crawl, duplicate_user_id, duplicate_alternative_id, duplicate_report_date, entry_date, edit_date, last_kpi_entry_date, workspace_uuid
 `
 
 type CreateContactParams struct {
@@ -651,6 +651,7 @@ func (q *Queries) CreateContact(ctx context.Context, arg CreateContactParams) (C
 		&i.EntryDate,
 		&i.EditDate,
 		&i.LastKpiEntryDate,
+		&i.WorkspaceUUID,
 	)
 	return i, err
 }
@@ -667,7 +668,7 @@ func (q *Queries) DeleteContact(ctx context.Context, argUuid uuid.UUID) error {
 
 const getContact = `-- name: GetContact :one
 SELECT
-    uuid, user_uuid, instance_uuid, status, names, names_search, last, first, middle, birthday, birthday_type, salary, salary_data, last_positions, last_position_id, last_position_company_id, last_position_company_name, last_position_title, last_position_start_date, last_position_end_date, last_position_end_now, last_position_description, note_search, note_kpi_id, phones, phone_search, phone1, phone1_type, phone1_country, phone2, phone2_type, phone2_country, phone3, phone3_type, phone3_country, phone4, phone4_type, phone4_country, phone5, phone5_type, phone5_country, emails, email_search, email1, email1_type, email2, email2_type, email3, email3_type, email4, email4_type, email5, email5_type, messengers, messengers_search, skype_uuid, skype, whatsapp_uuid, whatsapp, telegram_uuid, telegram, wechat_uuid, wechat, line_uuid, line, socials, socials_search, linkedin_uuid, linkedin_url, facebook_uuid, facebook_url, twitter_uuid, twitter_url, github_uuid, github_url, vk_uuid, vk_url, odno_uuid, odno_url, hhru_uuid, hhru_url, habr_uuid, habr_url, moikrug_uuid, moikrug_url, instagram_uuid, instagram_url, social1_uuid, social1_url, social1_type, social2_uuid, social2_url, social2_type, social3_uuid, social3_url, social3_type, social4_uuid, social4_url, social4_type, social5_uuid, social5_url, social5_type, social6_uuid, social6_url, social6_type, social7_uuid, social7_url, social7_type, social8_uuid, social8_url, social8_type, social9_uuid, social9_url, social9_type, tracking_source, tracking_slug, cached_img, cached_img_data, crawl, duplicate_user_id, duplicate_alternative_id, duplicate_report_date, entry_date, edit_date, last_kpi_entry_date
+    uuid, user_uuid, instance_uuid, status, names, names_search, last, first, middle, birthday, birthday_type, salary, salary_data, last_positions, last_position_id, last_position_company_id, last_position_company_name, last_position_title, last_position_start_date, last_position_end_date, last_position_end_now, last_position_description, note_search, note_kpi_id, phones, phone_search, phone1, phone1_type, phone1_country, phone2, phone2_type, phone2_country, phone3, phone3_type, phone3_country, phone4, phone4_type, phone4_country, phone5, phone5_type, phone5_country, emails, email_search, email1, email1_type, email2, email2_type, email3, email3_type, email4, email4_type, email5, email5_type, messengers, messengers_search, skype_uuid, skype, whatsapp_uuid, whatsapp, telegram_uuid, telegram, wechat_uuid, wechat, line_uuid, line, socials, socials_search, linkedin_uuid, linkedin_url, facebook_uuid, facebook_url, twitter_uuid, twitter_url, github_uuid, github_url, vk_uuid, vk_url, odno_uuid, odno_url, hhru_uuid, hhru_url, habr_uuid, habr_url, moikrug_uuid, moikrug_url, instagram_uuid, instagram_url, social1_uuid, social1_url, social1_type, social2_uuid, social2_url, social2_type, social3_uuid, social3_url, social3_type, social4_uuid, social4_url, social4_type, social5_uuid, social5_url, social5_type, social6_uuid, social6_url, social6_type, social7_uuid, social7_url, social7_type, social8_uuid, social8_url, social8_type, social9_uuid, social9_url, social9_type, tracking_source, tracking_slug, cached_img, cached_img_data, crawl, duplicate_user_id, duplicate_alternative_id, duplicate_report_date, entry_date, edit_date, last_kpi_entry_date, workspace_uuid
 FROM contact
 WHERE
     uuid = $1
@@ -803,13 +804,14 @@ func (q *Queries) GetContact(ctx context.Context, argUuid uuid.UUID) (Contact, e
 		&i.EntryDate,
 		&i.EditDate,
 		&i.LastKpiEntryDate,
+		&i.WorkspaceUUID,
 	)
 	return i, err
 }
 
 const listContacts = `-- name: ListContacts :many
 SELECT
-    uuid, user_uuid, instance_uuid, status, names, names_search, last, first, middle, birthday, birthday_type, salary, salary_data, last_positions, last_position_id, last_position_company_id, last_position_company_name, last_position_title, last_position_start_date, last_position_end_date, last_position_end_now, last_position_description, note_search, note_kpi_id, phones, phone_search, phone1, phone1_type, phone1_country, phone2, phone2_type, phone2_country, phone3, phone3_type, phone3_country, phone4, phone4_type, phone4_country, phone5, phone5_type, phone5_country, emails, email_search, email1, email1_type, email2, email2_type, email3, email3_type, email4, email4_type, email5, email5_type, messengers, messengers_search, skype_uuid, skype, whatsapp_uuid, whatsapp, telegram_uuid, telegram, wechat_uuid, wechat, line_uuid, line, socials, socials_search, linkedin_uuid, linkedin_url, facebook_uuid, facebook_url, twitter_uuid, twitter_url, github_uuid, github_url, vk_uuid, vk_url, odno_uuid, odno_url, hhru_uuid, hhru_url, habr_uuid, habr_url, moikrug_uuid, moikrug_url, instagram_uuid, instagram_url, social1_uuid, social1_url, social1_type, social2_uuid, social2_url, social2_type, social3_uuid, social3_url, social3_type, social4_uuid, social4_url, social4_type, social5_uuid, social5_url, social5_type, social6_uuid, social6_url, social6_type, social7_uuid, social7_url, social7_type, social8_uuid, social8_url, social8_type, social9_uuid, social9_url, social9_type, tracking_source, tracking_slug, cached_img, cached_img_data, crawl, duplicate_user_id, duplicate_alternative_id, duplicate_report_date, entry_date, edit_date, last_kpi_entry_date
+    uuid, user_uuid, instance_uuid, status, names, names_search, last, first, middle, birthday, birthday_type, salary, salary_data, last_positions, last_position_id, last_position_company_id, last_position_company_name, last_position_title, last_position_start_date, last_position_end_date, last_position_end_now, last_position_description, note_search, note_kpi_id, phones, phone_search, phone1, phone1_type, phone1_country, phone2, phone2_type, phone2_country, phone3, phone3_type, phone3_country, phone4, phone4_type, phone4_country, phone5, phone5_type, phone5_country, emails, email_search, email1, email1_type, email2, email2_type, email3, email3_type, email4, email4_type, email5, email5_type, messengers, messengers_search, skype_uuid, skype, whatsapp_uuid, whatsapp, telegram_uuid, telegram, wechat_uuid, wechat, line_uuid, line, socials, socials_search, linkedin_uuid, linkedin_url, facebook_uuid, facebook_url, twitter_uuid, twitter_url, github_uuid, github_url, vk_uuid, vk_url, odno_uuid, odno_url, hhru_uuid, hhru_url, habr_uuid, habr_url, moikrug_uuid, moikrug_url, instagram_uuid, instagram_url, social1_uuid, social1_url, social1_type, social2_uuid, social2_url, social2_type, social3_uuid, social3_url, social3_type, social4_uuid, social4_url, social4_type, social5_uuid, social5_url, social5_type, social6_uuid, social6_url, social6_type, social7_uuid, social7_url, social7_type, social8_uuid, social8_url, social8_type, social9_uuid, social9_url, social9_type, tracking_source, tracking_slug, cached_img, cached_img_data, crawl, duplicate_user_id, duplicate_alternative_id, duplicate_report_date, entry_date, edit_date, last_kpi_entry_date, workspace_uuid
 FROM contact
 ORDER BY entry_date DESC
 LIMIT CASE WHEN $2::int = 0 THEN NULL ELSE $2::int END
@@ -956,6 +958,7 @@ func (q *Queries) ListContacts(ctx context.Context, arg ListContactsParams) ([]C
 			&i.EntryDate,
 			&i.EditDate,
 			&i.LastKpiEntryDate,
+			&i.WorkspaceUUID,
 		); err != nil {
 			return nil, err
 		}
@@ -12,6 +12,61 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const clearDatasourcesNeedsReauth = `-- name: ClearDatasourcesNeedsReauth :many
+UPDATE datasource SET
+    needs_reauth = FALSE,
+    reauth_reason = '',
+    updated_at = NOW()
+WHERE settings->>'oauth2_client_uuid' = $1::text AND needs_reauth
+RETURNING uuid, user_uuid, name, type, is_enabled, provider, settings, created_at, updated_at, workspace_uuid, needs_reauth, reauth_reason
+`
+
+func (q *Queries) ClearDatasourcesNeedsReauth(ctx context.Context, clientUuid string) ([]Datasource, error) {
+	rows, err := q.db.Query(ctx, clearDatasourcesNeedsReauth, clientUuid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Datasource
+	for rows.Next() {
+		var i Datasource
+		if err := rows.Scan(
+			&i.UUID,
+			&i.UserUUID,
+			&i.Name,
+			&i.Type,
+			&i.IsEnabled,
+			&i.Provider,
+			&i.Settings,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.WorkspaceUUID,
+			&i.NeedsReauth,
+			&i.ReauthReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countDatasourcesWithClient = `-- name: CountDatasourcesWithClient :one
+SELECT count(*)
+FROM datasource
+WHERE settings->>'oauth2_client_uuid' = $1::text
+`
+
+func (q *Queries) CountDatasourcesWithClient(ctx context.Context, clientUuid string) (int64, error) {
+	row := q.db.QueryRow(ctx, countDatasourcesWithClient, clientUuid)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createDatasource = `-- name: CreateDatasource :one
 INSERT INTO datasource (
     uuid,
@@ -33,7 +88,7 @@ INSERT INTO datasource (
              $7,
              NOW(),
              NOW()
-         ) RETURNING uuid, user_uuid, name, type, is_enabled, provider, settings, created_at, updated_at
+         ) RETURNING uuid, user_uuid, name, type, is_enabled, provider, settings, created_at, updated_at, workspace_uuid, needs_reauth, reauth_reason
 `
 
 type CreateDatasourceParams struct {
@@ -67,6 +122,9 @@ func (q *Queries) CreateDatasource(ctx context.Context, arg CreateDatasourcePara
 		&i.Settings,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.WorkspaceUUID,
+		&i.NeedsReauth,
+		&i.ReauthReason,
 	)
 	return i, err
 }
@@ -80,9 +138,57 @@ func (q *Queries) DeleteDatasource(ctx context.Context, argUuid pgtype.UUID) err
 	return err
 }
 
+const flagDatasourcesNeedsReauth = `-- name: FlagDatasourcesNeedsReauth :many
+UPDATE datasource SET
+    needs_reauth = TRUE,
+    reauth_reason = $1,
+    updated_at = NOW()
+WHERE settings->>'oauth2_client_uuid' = $2::text AND NOT needs_reauth
+RETURNING uuid, user_uuid, name, type, is_enabled, provider, settings, created_at, updated_at, workspace_uuid, needs_reauth, reauth_reason
+`
+
+type FlagDatasourcesNeedsReauthParams struct {
+	Reason     string `json:"reason"`
+	ClientUuid string `json:"client_uuid"`
+}
+
+// Flags the datasources using the OAuth2 client, returning the ones not flagged before.
+func (q *Queries) FlagDatasourcesNeedsReauth(ctx context.Context, arg FlagDatasourcesNeedsReauthParams) ([]Datasource, error) {
+	rows, err := q.db.Query(ctx, flagDatasourcesNeedsReauth, arg.Reason, arg.ClientUuid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Datasource
+	for rows.Next() {
+		var i Datasource
+		if err := rows.Scan(
+			&i.UUID,
+			&i.UserUUID,
+			&i.Name,
+			&i.Type,
+			&i.IsEnabled,
+			&i.Provider,
+			&i.Settings,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.WorkspaceUUID,
+			&i.NeedsReauth,
+			&i.ReauthReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getDatasource = `-- name: GetDatasource :one
 SELECT
-    datasource.uuid, datasource.user_uuid, datasource.name, datasource.type, datasource.is_enabled, datasource.provider, datasource.settings, datasource.created_at, datasource.updated_at
+    datasource.uuid, datasource.user_uuid, datasource.name, datasource.type, datasource.is_enabled, datasource.provider, datasource.settings, datasource.created_at, datasource.updated_at, datasource.workspace_uuid, datasource.needs_reauth, datasource.reauth_reason
 FROM datasource
 WHERE uuid = $1::uuid
 `
@@ -104,13 +210,16 @@ func (q *Queries) GetDatasource(ctx context.Context, argUuid pgtype.UUID) (GetDa
 		&i.Datasource.Settings,
 		&i.Datasource.CreatedAt,
 		&i.Datasource.UpdatedAt,
+		&i.Datasource.WorkspaceUUID,
+		&i.Datasource.NeedsReauth,
+		&i.Datasource.ReauthReason,
 	)
 	return i, err
 }
 
 const getDatasources = `-- name: GetDatasources :many
 WITH filtered_datasource AS (
-    SELECT d.uuid, d.user_uuid, d.name, d.type, d.is_enabled, d.provider, d.settings, d.created_at, d.updated_at
+    SELECT d.uuid, d.user_uuid, d.name, d.type, d.is_enabled, d.provider, d.settings, d.created_at, d.updated_at, d.workspace_uuid, d.needs_reauth, d.reauth_reason
     FROM datasource d
     WHERE
         (NULLIF($5, '') IS NULL OR sp.uuid = $5::uuid) AND
@@ -121,7 +230,7 @@ WITH filtered_datasource AS (
         (NULLIF($10::int, -1) IS NULL OR sp.sync_all = ($11::int)::boolean)
 )
 SELECT
-    uuid, user_uuid, name, type, is_enabled, provider, settings, created_at, updated_at,
+    uuid, user_uuid, name, type, is_enabled, provider, settings, created_at, updated_at, workspace_uuid, needs_reauth, reauth_reason,
     (SELECT count(*) FROM filtered_datasource) AS total_count
 FROM filtered_datasource
 ORDER BY
@@ -151,16 +260,19 @@ type GetDatasourcesParams struct {
 }
 
 type GetDatasourcesRow struct {
-	UUID       uuid.UUID          `json:"uuid"`
-	UserUUID   *uuid.UUID         `json:"user_uuid"`
-	Name       string             `json:"name"`
-	Type       string             `json:"type"`
-	IsEnabled  bool               `json:"is_enabled"`
-	Provider   string             `json:"provider"`
-	Settings   []byte             `json:"settings"`
-	CreatedAt  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
-	TotalCount int64              `json:"total_count"`
+	UUID          uuid.UUID          `json:"uuid"`
+	UserUUID      *uuid.UUID         `json:"user_uuid"`
+	Name          string             `json:"name"`
+	Type          string             `json:"type"`
+	IsEnabled     bool               `json:"is_enabled"`
+	Provider      string             `json:"provider"`
+	Settings      []byte             `json:"settings"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
+	NeedsReauth   bool               `json:"needs_reauth"`
+	ReauthReason  string             `json:"reauth_reason"`
+	TotalCount    int64              `json:"total_count"`
 }
 
 func (q *Queries) GetDatasources(ctx context.Context, arg GetDatasourcesParams) ([]GetDatasourcesRow, error) {
@@ -194,6 +306,9 @@ func (q *Queries) GetDatasources(ctx context.Context, arg GetDatasourcesParams)
 			&i.Settings,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.WorkspaceUUID,
+			&i.NeedsReauth,
+			&i.ReauthReason,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -208,7 +323,7 @@ func (q *Queries) GetDatasources(ctx context.Context, arg GetDatasourcesParams)
 
 const listDatasources = `-- name: ListDatasources :many
 SELECT
-    datasource.uuid, datasource.user_uuid, datasource.name, datasource.type, datasource.is_enabled, datasource.provider, datasource.settings, datasource.created_at, datasource.updated_at
+    datasource.uuid, datasource.user_uuid, datasource.name, datasource.type, datasource.is_enabled, datasource.provider, datasource.settings, datasource.created_at, datasource.updated_at, datasource.workspace_uuid, datasource.needs_reauth, datasource.reauth_reason
 FROM datasource
 ORDER BY created_at DESC
 LIMIT NULLIF($2::int, 0)
@@ -243,6 +358,9 @@ func (q *Queries) ListDatasources(ctx context.Context, arg ListDatasourcesParams
 			&i.Datasource.Settings,
 			&i.Datasource.CreatedAt,
 			&i.Datasource.UpdatedAt,
+			&i.Datasource.WorkspaceUUID,
+			&i.Datasource.NeedsReauth,
+			&i.Datasource.ReauthReason,
 		); err != nil {
 			return nil, err
 		}
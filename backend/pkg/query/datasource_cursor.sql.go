@@ -0,0 +1,76 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: datasource_cursor.sql
+
+package query
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteDatasourceCursor = `-- name: DeleteDatasourceCursor :exec
+DELETE FROM datasource_cursor
+WHERE datasource_uuid = $1::uuid AND name = $2
+`
+
+type DeleteDatasourceCursorParams struct {
+	DatasourceUUID pgtype.UUID `json:"datasource_uuid"`
+	Name           string      `json:"name"`
+}
+
+func (q *Queries) DeleteDatasourceCursor(ctx context.Context, arg DeleteDatasourceCursorParams) error {
+	_, err := q.db.Exec(ctx, deleteDatasourceCursor, arg.DatasourceUUID, arg.Name)
+	return err
+}
+
+const getDatasourceCursors = `-- name: GetDatasourceCursors :many
+SELECT datasource_uuid, name, state, workspace_uuid, updated_at FROM datasource_cursor
+WHERE datasource_uuid = $1::uuid
+`
+
+func (q *Queries) GetDatasourceCursors(ctx context.Context, datasourceUuid pgtype.UUID) ([]DatasourceCursor, error) {
+	rows, err := q.db.Query(ctx, getDatasourceCursors, datasourceUuid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DatasourceCursor
+	for rows.Next() {
+		var i DatasourceCursor
+		if err := rows.Scan(
+			&i.DatasourceUUID,
+			&i.Name,
+			&i.State,
+			&i.WorkspaceUUID,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setDatasourceCursor = `-- name: SetDatasourceCursor :exec
+INSERT INTO datasource_cursor (datasource_uuid, name, state, updated_at)
+VALUES ($1::uuid, $2, $3, NOW())
+ON CONFLICT (datasource_uuid, name) DO UPDATE
+SET state = EXCLUDED.state, updated_at = NOW()
+`
+
+type SetDatasourceCursorParams struct {
+	DatasourceUUID pgtype.UUID `json:"datasource_uuid"`
+	Name           string      `json:"name"`
+	State          string      `json:"state"`
+}
+
+func (q *Queries) SetDatasourceCursor(ctx context.Context, arg SetDatasourceCursorParams) error {
+	_, err := q.db.Exec(ctx, setDatasourceCursor, arg.DatasourceUUID, arg.Name, arg.State)
+	return err
+}
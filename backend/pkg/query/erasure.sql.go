@@ -0,0 +1,288 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: erasure.sql
+
+package query
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const anonymizeContacts = `-- name: AnonymizeContacts :execrows
+UPDATE contact SET
+    email1 = CASE WHEN email1 ~* $1::text THEN NULL ELSE email1 END,
+    email2 = CASE WHEN email2 ~* $1::text THEN NULL ELSE email2 END,
+    email3 = CASE WHEN email3 ~* $1::text THEN NULL ELSE email3 END,
+    email4 = CASE WHEN email4 ~* $1::text THEN NULL ELSE email4 END,
+    email5 = CASE WHEN email5 ~* $1::text THEN NULL ELSE email5 END,
+    phone1 = CASE WHEN phone1 ~* $1::text THEN NULL ELSE phone1 END,
+    phone2 = CASE WHEN phone2 ~* $1::text THEN NULL ELSE phone2 END,
+    phone3 = CASE WHEN phone3 ~* $1::text THEN NULL ELSE phone3 END,
+    phone4 = CASE WHEN phone4 ~* $1::text THEN NULL ELSE phone4 END,
+    phone5 = CASE WHEN phone5 ~* $1::text THEN NULL ELSE phone5 END,
+    whatsapp = CASE WHEN whatsapp ~* $1::text THEN NULL ELSE whatsapp END,
+    telegram = CASE WHEN telegram ~* $1::text THEN NULL ELSE telegram END,
+    email_search = regexp_replace(email_search, $1::text, '', 'gi'),
+    phone_search = regexp_replace(phone_search, $1::text, '', 'gi'),
+    messengers_search = regexp_replace(messengers_search, $1::text, '', 'gi'),
+    emails = CASE WHEN emails::text ~* $1::text THEN NULL ELSE emails END,
+    phones = CASE WHEN phones::text ~* $1::text THEN NULL ELSE phones END,
+    messengers = CASE WHEN messengers::text ~* $1::text THEN NULL ELSE messengers END,
+    edit_date = NOW()
+WHERE uuid = ANY($2::text[])
+`
+
+type AnonymizeContactsParams struct {
+	Pattern string   `json:"pattern"`
+	Uuids   []string `json:"uuids"`
+}
+
+func (q *Queries) AnonymizeContacts(ctx context.Context, arg AnonymizeContactsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, anonymizeContacts, arg.Pattern, arg.Uuids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const anonymizeMessages = `-- name: AnonymizeMessages :execrows
+UPDATE message SET
+    sender = regexp_replace(sender, $1::text, $2::text, 'gi'),
+    recipients = ARRAY(
+        SELECT regexp_replace(r, $1::text, $2::text, 'gi')
+        FROM unnest(recipients) AS r
+    ),
+    subject = regexp_replace(subject, $1::text, $2::text, 'gi'),
+    body = regexp_replace(body, $1::text, $2::text, 'gi'),
+    body_parsed = NULL,
+    meta = NULL,
+    forward_meta = NULL,
+    attachments = NULL,
+    updated_at = NOW()
+WHERE uuid = ANY($3::uuid[])
+`
+
+type AnonymizeMessagesParams struct {
+	Pattern     string        `json:"pattern"`
+	Replacement string        `json:"replacement"`
+	Uuids       []pgtype.UUID `json:"uuids"`
+}
+
+func (q *Queries) AnonymizeMessages(ctx context.Context, arg AnonymizeMessagesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, anonymizeMessages, arg.Pattern, arg.Replacement, arg.Uuids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createErasureRequest = `-- name: CreateErasureRequest :one
+INSERT INTO erasure_request (
+    uuid,
+    subject,
+    mode,
+    status,
+    report,
+    error,
+    created_at,
+    finished_at
+) VALUES (
+    $1::uuid,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    NOW()
+) RETURNING uuid, subject, mode, status, report, error, created_at, finished_at, workspace_uuid
+`
+
+type CreateErasureRequestParams struct {
+	UUID      pgtype.UUID        `json:"uuid"`
+	Subject   string             `json:"subject"`
+	Mode      string             `json:"mode"`
+	Status    string             `json:"status"`
+	Report    []byte             `json:"report"`
+	Error     pgtype.Text        `json:"error"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) CreateErasureRequest(ctx context.Context, arg CreateErasureRequestParams) (ErasureRequest, error) {
+	row := q.db.QueryRow(ctx, createErasureRequest,
+		arg.UUID,
+		arg.Subject,
+		arg.Mode,
+		arg.Status,
+		arg.Report,
+		arg.Error,
+		arg.CreatedAt,
+	)
+	var i ErasureRequest
+	err := row.Scan(
+		&i.UUID,
+		&i.Subject,
+		&i.Mode,
+		&i.Status,
+		&i.Report,
+		&i.Error,
+		&i.CreatedAt,
+		&i.FinishedAt,
+		&i.WorkspaceUUID,
+	)
+	return i, err
+}
+
+const deleteContactsByUUIDs = `-- name: DeleteContactsByUUIDs :execrows
+DELETE FROM contact WHERE uuid = ANY($1::text[])
+`
+
+func (q *Queries) DeleteContactsByUUIDs(ctx context.Context, uuids []string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteContactsByUUIDs, uuids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const findContactUUIDsBySubject = `-- name: FindContactUUIDsBySubject :many
+SELECT uuid
+FROM contact
+WHERE concat_ws(' ',
+    email1, email2, email3, email4, email5, email_search, emails::text,
+    phone1, phone2, phone3, phone4, phone5, phone_search, phones::text,
+    whatsapp, telegram, messengers::text
+) ~* $1::text
+`
+
+func (q *Queries) FindContactUUIDsBySubject(ctx context.Context, pattern string) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, findContactUUIDsBySubject, pattern)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var uuid uuid.UUID
+		if err := rows.Scan(&uuid); err != nil {
+			return nil, err
+		}
+		items = append(items, uuid)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findMessageUUIDsBySubject = `-- name: FindMessageUUIDsBySubject :many
+SELECT uuid
+FROM message
+WHERE
+    sender ~* $1::text OR
+    array_to_string(recipients, ',') ~* $1::text OR
+    subject ~* $1::text OR
+    body ~* $1::text OR
+    COALESCE(body_parsed::text, '') ~* $1::text OR
+    COALESCE(meta::text, '') ~* $1::text
+`
+
+func (q *Queries) FindMessageUUIDsBySubject(ctx context.Context, pattern string) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, findMessageUUIDsBySubject, pattern)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var uuid uuid.UUID
+		if err := rows.Scan(&uuid); err != nil {
+			return nil, err
+		}
+		items = append(items, uuid)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getErasureRequest = `-- name: GetErasureRequest :one
+SELECT
+    erasure_request.uuid, erasure_request.subject, erasure_request.mode, erasure_request.status, erasure_request.report, erasure_request.error, erasure_request.created_at, erasure_request.finished_at, erasure_request.workspace_uuid
+FROM erasure_request
+WHERE uuid = $1::uuid
+`
+
+type GetErasureRequestRow struct {
+	ErasureRequest ErasureRequest `json:"erasure_request"`
+}
+
+func (q *Queries) GetErasureRequest(ctx context.Context, argUuid pgtype.UUID) (GetErasureRequestRow, error) {
+	row := q.db.QueryRow(ctx, getErasureRequest, argUuid)
+	var i GetErasureRequestRow
+	err := row.Scan(
+		&i.ErasureRequest.UUID,
+		&i.ErasureRequest.Subject,
+		&i.ErasureRequest.Mode,
+		&i.ErasureRequest.Status,
+		&i.ErasureRequest.Report,
+		&i.ErasureRequest.Error,
+		&i.ErasureRequest.CreatedAt,
+		&i.ErasureRequest.FinishedAt,
+		&i.ErasureRequest.WorkspaceUUID,
+	)
+	return i, err
+}
+
+const getErasureRequests = `-- name: GetErasureRequests :many
+SELECT
+    erasure_request.uuid, erasure_request.subject, erasure_request.mode, erasure_request.status, erasure_request.report, erasure_request.error, erasure_request.created_at, erasure_request.finished_at, erasure_request.workspace_uuid
+FROM erasure_request
+ORDER BY created_at DESC
+LIMIT NULLIF($2::int, 0)
+    OFFSET $1::int
+`
+
+type GetErasureRequestsParams struct {
+	Offset int32 `json:"offset"`
+	Limit  int32 `json:"limit"`
+}
+
+type GetErasureRequestsRow struct {
+	ErasureRequest ErasureRequest `json:"erasure_request"`
+}
+
+func (q *Queries) GetErasureRequests(ctx context.Context, arg GetErasureRequestsParams) ([]GetErasureRequestsRow, error) {
+	rows, err := q.db.Query(ctx, getErasureRequests, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetErasureRequestsRow
+	for rows.Next() {
+		var i GetErasureRequestsRow
+		if err := rows.Scan(
+			&i.ErasureRequest.UUID,
+			&i.ErasureRequest.Subject,
+			&i.ErasureRequest.Mode,
+			&i.ErasureRequest.Status,
+			&i.ErasureRequest.Report,
+			&i.ErasureRequest.Error,
+			&i.ErasureRequest.CreatedAt,
+			&i.ErasureRequest.FinishedAt,
+			&i.ErasureRequest.WorkspaceUUID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,177 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: event.sql
+
+package query
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createEvent = `-- name: CreateEvent :exec
+INSERT INTO event (
+    uuid,
+    workspace_uuid,
+    type,
+    subject_kind,
+    subject_uuid,
+    data,
+    created_at
+) VALUES (
+    $1::uuid,
+    COALESCE($2::uuid, current_workspace_uuid(), '00000000-0000-0000-0000-000000000001'),
+    $3,
+    $4,
+    $5::uuid,
+    $6,
+    NOW()
+)
+`
+
+type CreateEventParams struct {
+	UUID          pgtype.UUID `json:"uuid"`
+	WorkspaceUUID pgtype.UUID `json:"workspace_uuid"`
+	Type          string      `json:"type"`
+	SubjectKind   string      `json:"subject_kind"`
+	SubjectUuid   pgtype.UUID `json:"subject_uuid"`
+	Data          []byte      `json:"data"`
+}
+
+func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) error {
+	_, err := q.db.Exec(ctx, createEvent,
+		arg.UUID,
+		arg.WorkspaceUUID,
+		arg.Type,
+		arg.SubjectKind,
+		arg.SubjectUuid,
+		arg.Data,
+	)
+	return err
+}
+
+const deleteEventsBefore = `-- name: DeleteEventsBefore :exec
+DELETE FROM event
+WHERE created_at < $1::timestamptz
+`
+
+func (q *Queries) DeleteEventsBefore(ctx context.Context, before pgtype.Timestamptz) error {
+	_, err := q.db.Exec(ctx, deleteEventsBefore, before)
+	return err
+}
+
+const getWebhookEvents = `-- name: GetWebhookEvents :many
+SELECT uuid, workspace_uuid, type, subject_kind, subject_uuid, data, created_at, txid
+FROM event
+WHERE
+    workspace_uuid = $1::uuid AND
+    ($2::bigint IS NULL OR
+        (txid, uuid) > ($2::bigint, COALESCE($3::uuid, '00000000-0000-0000-0000-000000000000'))) AND
+    txid < pg_snapshot_xmin(pg_current_snapshot())::text::bigint AND
+    created_at >= $4::timestamptz
+ORDER BY txid, uuid
+LIMIT $5::int
+`
+
+type GetWebhookEventsParams struct {
+	WorkspaceUUID pgtype.UUID        `json:"workspace_uuid"`
+	AfterTxid     pgtype.Int8        `json:"after_txid"`
+	AfterUuid     pgtype.UUID        `json:"after_uuid"`
+	CreatedAfter  pgtype.Timestamptz `json:"created_after"`
+	Limit         int32              `json:"limit"`
+}
+
+// Events of the workspace after the cursor of a webhook, in transaction order. Events
+// of transactions still running, or started after the oldest running one, wait: they
+// may be followed by events of earlier transactions.
+func (q *Queries) GetWebhookEvents(ctx context.Context, arg GetWebhookEventsParams) ([]Event, error) {
+	rows, err := q.db.Query(ctx, getWebhookEvents,
+		arg.WorkspaceUUID,
+		arg.AfterTxid,
+		arg.AfterUuid,
+		arg.CreatedAfter,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Event
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.UUID,
+			&i.WorkspaceUUID,
+			&i.Type,
+			&i.SubjectKind,
+			&i.SubjectUuid,
+			&i.Data,
+			&i.CreatedAt,
+			&i.Txid,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEvents = `-- name: ListEvents :many
+SELECT uuid, workspace_uuid, type, subject_kind, subject_uuid, data, created_at, txid
+FROM event
+WHERE
+    (NULLIF($1::text, '') IS NULL OR type = $1::text) AND
+    (NULLIF($2::text, '') IS NULL OR subject_uuid::text = $2::text) AND
+    ($3::uuid IS NULL OR uuid > $3::uuid)
+ORDER BY uuid DESC
+LIMIT NULLIF($5::int, 0)
+OFFSET $4::int
+`
+
+type ListEventsParams struct {
+	Type        string      `json:"type"`
+	SubjectUuid string      `json:"subject_uuid"`
+	AfterUuid   pgtype.UUID `json:"after_uuid"`
+	Offset      int32       `json:"offset"`
+	Limit       int32       `json:"limit"`
+}
+
+func (q *Queries) ListEvents(ctx context.Context, arg ListEventsParams) ([]Event, error) {
+	rows, err := q.db.Query(ctx, listEvents,
+		arg.Type,
+		arg.SubjectUuid,
+		arg.AfterUuid,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Event
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.UUID,
+			&i.WorkspaceUUID,
+			&i.Type,
+			&i.SubjectKind,
+			&i.SubjectUuid,
+			&i.Data,
+			&i.CreatedAt,
+			&i.Txid,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -26,6 +26,7 @@ INSERT INTO "file" (
     raw_headers,
     has_raw_email,
     is_inline,
+    message_uuid,
     created_at,
     updated_at
 ) VALUES (
@@ -41,9 +42,10 @@ INSERT INTO "file" (
     $10,
     $11,
     $12,
+    $13::uuid,
           NOW(),
              NOW()
-         ) RETURNING uuid, storage_type, storage_uuid, name, mime_type, size, data, path, is_raw, raw_headers, has_raw_email, is_inline, created_at, updated_at
+         ) RETURNING uuid, storage_type, storage_uuid, name, mime_type, size, data, path, is_raw, raw_headers, has_raw_email, is_inline, created_at, updated_at, message_uuid, workspace_uuid
 `
 
 type CreateFileParams struct {
@@ -59,6 +61,7 @@ type CreateFileParams struct {
 	RawHeaders  pgtype.Text `json:"raw_headers"`
 	HasRawEmail pgtype.Bool `json:"has_raw_email"`
 	IsInline    pgtype.Bool `json:"is_inline"`
+	MessageUuid pgtype.UUID `json:"message_uuid"`
 }
 
 func (q *Queries) CreateFile(ctx context.Context, arg CreateFileParams) (File, error) {
@@ -75,6 +78,7 @@ func (q *Queries) CreateFile(ctx context.Context, arg CreateFileParams) (File, e
 		arg.RawHeaders,
 		arg.HasRawEmail,
 		arg.IsInline,
+		arg.MessageUuid,
 	)
 	var i File
 	err := row.Scan(
@@ -92,6 +96,8 @@ func (q *Queries) CreateFile(ctx context.Context, arg CreateFileParams) (File, e
 		&i.IsInline,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.MessageUuid,
+		&i.WorkspaceUUID,
 	)
 	return i, err
 }
@@ -108,7 +114,7 @@ func (q *Queries) DeleteFile(ctx context.Context, argUuid pgtype.UUID) error {
 
 const getFile = `-- name: GetFile :one
 SELECT
-    file.uuid, file.storage_type, file.storage_uuid, file.name, file.mime_type, file.size, file.data, file.path, file.is_raw, file.raw_headers, file.has_raw_email, file.is_inline, file.created_at, file.updated_at
+    file.uuid, file.storage_type, file.storage_uuid, file.name, file.mime_type, file.size, file.data, file.path, file.is_raw, file.raw_headers, file.has_raw_email, file.is_inline, file.created_at, file.updated_at, file.message_uuid, file.workspace_uuid
 FROM "file"
 WHERE uuid = $1::uuid
 `
@@ -135,13 +141,15 @@ func (q *Queries) GetFile(ctx context.Context, argUuid pgtype.UUID) (GetFileRow,
 		&i.File.IsInline,
 		&i.File.CreatedAt,
 		&i.File.UpdatedAt,
+		&i.File.MessageUuid,
+		&i.File.WorkspaceUUID,
 	)
 	return i, err
 }
 
 const getFiles = `-- name: GetFiles :many
 WITH filtered_files AS (
-    SELECT f.uuid, f.storage_type, f.storage_uuid, f.name, f.mime_type, f.size, f.data, f.path, f.is_raw, f.raw_headers, f.has_raw_email, f.is_inline, f.created_at, f.updated_at
+    SELECT f.uuid, f.storage_type, f.storage_uuid, f.name, f.mime_type, f.size, f.data, f.path, f.is_raw, f.raw_headers, f.has_raw_email, f.is_inline, f.created_at, f.updated_at, f.message_uuid, f.workspace_uuid
     FROM "file" f
     WHERE
       -- Filter by storage_type if not empty
@@ -169,7 +177,7 @@ WITH filtered_files AS (
         )
 )
 SELECT
-    f.uuid, f.storage_type, f.storage_uuid, f.name, f.mime_type, f.size, f.data, f.path, f.is_raw, f.raw_headers, f.has_raw_email, f.is_inline, f.created_at, f.updated_at,
+    f.uuid, f.storage_type, f.storage_uuid, f.name, f.mime_type, f.size, f.data, f.path, f.is_raw, f.raw_headers, f.has_raw_email, f.is_inline, f.created_at, f.updated_at, f.message_uuid, f.workspace_uuid,
     -- total_count of all matching rows (ignoring limit/offset)
     (SELECT COUNT(*) FROM filtered_files) AS total_count
 FROM filtered_files f
@@ -205,21 +213,23 @@ type GetFilesParams struct {
 }
 
 type GetFilesRow struct {
-	UUID        uuid.UUID          `json:"uuid"`
-	StorageType string             `json:"storage_type"`
-	StorageUuid *uuid.UUID         `json:"storage_uuid"`
-	Name        string             `json:"name"`
-	MimeType    pgtype.Text        `json:"mime_type"`
-	Size        pgtype.Int8        `json:"size"`
-	Data        []byte             `json:"data"`
-	Path        pgtype.Text        `json:"path"`
-	IsRaw       pgtype.Bool        `json:"is_raw"`
-	RawHeaders  pgtype.Text        `json:"raw_headers"`
-	HasRawEmail pgtype.Bool        `json:"has_raw_email"`
-	IsInline    pgtype.Bool        `json:"is_inline"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
-	TotalCount  int64              `json:"total_count"`
+	UUID          uuid.UUID          `json:"uuid"`
+	StorageType   string             `json:"storage_type"`
+	StorageUuid   *uuid.UUID         `json:"storage_uuid"`
+	Name          string             `json:"name"`
+	MimeType      pgtype.Text        `json:"mime_type"`
+	Size          pgtype.Int8        `json:"size"`
+	Data          []byte             `json:"data"`
+	Path          pgtype.Text        `json:"path"`
+	IsRaw         pgtype.Bool        `json:"is_raw"`
+	RawHeaders    pgtype.Text        `json:"raw_headers"`
+	HasRawEmail   pgtype.Bool        `json:"has_raw_email"`
+	IsInline      pgtype.Bool        `json:"is_inline"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	MessageUuid   *uuid.UUID         `json:"message_uuid"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
+	TotalCount    int64              `json:"total_count"`
 }
 
 func (q *Queries) GetFiles(ctx context.Context, arg GetFilesParams) ([]GetFilesRow, error) {
@@ -258,6 +268,8 @@ func (q *Queries) GetFiles(ctx context.Context, arg GetFilesParams) ([]GetFilesR
 			&i.IsInline,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.MessageUuid,
+			&i.WorkspaceUUID,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -270,9 +282,74 @@ func (q *Queries) GetFiles(ctx context.Context, arg GetFilesParams) ([]GetFilesR
 	return items, nil
 }
 
+const getFilesToMigrate = `-- name: GetFilesToMigrate :many
+SELECT
+    file.uuid, file.storage_type, file.storage_uuid, file.name, file.mime_type, file.size, file.data, file.path, file.is_raw, file.raw_headers, file.has_raw_email, file.is_inline, file.created_at, file.updated_at, file.message_uuid, file.workspace_uuid
+FROM "file"
+WHERE
+    storage_uuid = $1::uuid
+  AND ($2::uuid IS NULL OR uuid > $2::uuid)
+  AND ($3::timestamptz IS NULL OR created_at < $3::timestamptz)
+ORDER BY uuid ASC
+LIMIT $4::int
+`
+
+type GetFilesToMigrateParams struct {
+	StorageUuid   pgtype.UUID        `json:"storage_uuid"`
+	AfterUuid     pgtype.UUID        `json:"after_uuid"`
+	CreatedBefore pgtype.Timestamptz `json:"created_before"`
+	Limit         int32              `json:"limit"`
+}
+
+type GetFilesToMigrateRow struct {
+	File File `json:"file"`
+}
+
+func (q *Queries) GetFilesToMigrate(ctx context.Context, arg GetFilesToMigrateParams) ([]GetFilesToMigrateRow, error) {
+	rows, err := q.db.Query(ctx, getFilesToMigrate,
+		arg.StorageUuid,
+		arg.AfterUuid,
+		arg.CreatedBefore,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetFilesToMigrateRow
+	for rows.Next() {
+		var i GetFilesToMigrateRow
+		if err := rows.Scan(
+			&i.File.UUID,
+			&i.File.StorageType,
+			&i.File.StorageUuid,
+			&i.File.Name,
+			&i.File.MimeType,
+			&i.File.Size,
+			&i.File.Data,
+			&i.File.Path,
+			&i.File.IsRaw,
+			&i.File.RawHeaders,
+			&i.File.HasRawEmail,
+			&i.File.IsInline,
+			&i.File.CreatedAt,
+			&i.File.UpdatedAt,
+			&i.File.MessageUuid,
+			&i.File.WorkspaceUUID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listFiles = `-- name: ListFiles :many
 SELECT
-    file.uuid, file.storage_type, file.storage_uuid, file.name, file.mime_type, file.size, file.data, file.path, file.is_raw, file.raw_headers, file.has_raw_email, file.is_inline, file.created_at, file.updated_at
+    file.uuid, file.storage_type, file.storage_uuid, file.name, file.mime_type, file.size, file.data, file.path, file.is_raw, file.raw_headers, file.has_raw_email, file.is_inline, file.created_at, file.updated_at, file.message_uuid, file.workspace_uuid
 FROM "file"
 ORDER BY created_at DESC
 LIMIT NULLIF($2::int, 0)
@@ -312,6 +389,8 @@ func (q *Queries) ListFiles(ctx context.Context, arg ListFilesParams) ([]ListFil
 			&i.File.IsInline,
 			&i.File.CreatedAt,
 			&i.File.UpdatedAt,
+			&i.File.MessageUuid,
+			&i.File.WorkspaceUUID,
 		); err != nil {
 			return nil, err
 		}
@@ -373,3 +452,36 @@ func (q *Queries) UpdateFile(ctx context.Context, arg UpdateFileParams) error {
 	)
 	return err
 }
+
+const updateFileLocation = `-- name: UpdateFileLocation :exec
+UPDATE "file"
+SET
+    storage_type = $1,
+    storage_uuid = $2::uuid,
+    data         = $3,
+    path         = $4,
+    size         = COALESCE($5, size),
+    updated_at   = NOW()
+WHERE uuid = $6::uuid
+`
+
+type UpdateFileLocationParams struct {
+	StorageType string      `json:"storage_type"`
+	StorageUuid pgtype.UUID `json:"storage_uuid"`
+	Data        []byte      `json:"data"`
+	Path        pgtype.Text `json:"path"`
+	Size        pgtype.Int8 `json:"size"`
+	UUID        pgtype.UUID `json:"uuid"`
+}
+
+func (q *Queries) UpdateFileLocation(ctx context.Context, arg UpdateFileLocationParams) error {
+	_, err := q.db.Exec(ctx, updateFileLocation,
+		arg.StorageType,
+		arg.StorageUuid,
+		arg.Data,
+		arg.Path,
+		arg.Size,
+		arg.UUID,
+	)
+	return err
+}
@@ -33,6 +33,8 @@ INSERT INTO message (
     forward_meta,
     meta,
     external_message_id,
+    pipeline_uuid,
+    datasource_uuid,
     created_at,
     updated_at
 ) VALUES (
@@ -55,9 +57,11 @@ INSERT INTO message (
              $17,
              $18,
     $19,
+    $20::uuid,
+    $21::uuid,
              NOW(),
              NOW()
-         ) RETURNING uuid, format, type, chat_uuid, thread_uuid, external_message_id, sender, recipients, subject, body, body_parsed, reactions, attachments, forward_from, reply_to_message_uuid, forward_from_chat_uuid, forward_from_message_uuid, forward_meta, meta, created_at, updated_at
+         ) RETURNING uuid, format, type, chat_uuid, thread_uuid, external_message_id, sender, recipients, subject, body, body_parsed, reactions, attachments, forward_from, reply_to_message_uuid, forward_from_chat_uuid, forward_from_message_uuid, forward_meta, meta, created_at, updated_at, pipeline_uuid, datasource_uuid, workspace_uuid, body_file_uuid
 `
 
 type CreateMessageParams struct {
@@ -80,6 +84,8 @@ type CreateMessageParams struct {
 	ForwardMeta            []byte      `json:"forward_meta"`
 	Meta                   []byte      `json:"meta"`
 	ExternalMessageID      pgtype.Text `json:"external_message_id"`
+	PipelineUuid           pgtype.UUID `json:"pipeline_uuid"`
+	DatasourceUUID         pgtype.UUID `json:"datasource_uuid"`
 }
 
 func (q *Queries) CreateMessage(ctx context.Context, arg CreateMessageParams) (Message, error) {
@@ -103,6 +109,8 @@ func (q *Queries) CreateMessage(ctx context.Context, arg CreateMessageParams) (M
 		arg.ForwardMeta,
 		arg.Meta,
 		arg.ExternalMessageID,
+		arg.PipelineUuid,
+		arg.DatasourceUUID,
 	)
 	var i Message
 	err := row.Scan(
@@ -127,6 +135,10 @@ func (q *Queries) CreateMessage(ctx context.Context, arg CreateMessageParams) (M
 		&i.Meta,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PipelineUuid,
+		&i.DatasourceUUID,
+		&i.WorkspaceUUID,
+		&i.BodyFileUuid,
 	)
 	return i, err
 }
@@ -143,7 +155,7 @@ func (q *Queries) DeleteMessage(ctx context.Context, argUuid pgtype.UUID) error
 
 const getMessage = `-- name: GetMessage :one
 SELECT
-    message.uuid, message.format, message.type, message.chat_uuid, message.thread_uuid, message.external_message_id, message.sender, message.recipients, message.subject, message.body, message.body_parsed, message.reactions, message.attachments, message.forward_from, message.reply_to_message_uuid, message.forward_from_chat_uuid, message.forward_from_message_uuid, message.forward_meta, message.meta, message.created_at, message.updated_at
+    message.uuid, message.format, message.type, message.chat_uuid, message.thread_uuid, message.external_message_id, message.sender, message.recipients, message.subject, message.body, message.body_parsed, message.reactions, message.attachments, message.forward_from, message.reply_to_message_uuid, message.forward_from_chat_uuid, message.forward_from_message_uuid, message.forward_meta, message.meta, message.created_at, message.updated_at, message.pipeline_uuid, message.datasource_uuid, message.workspace_uuid, message.body_file_uuid
 FROM message
 WHERE uuid = $1::uuid
 `
@@ -177,21 +189,158 @@ func (q *Queries) GetMessage(ctx context.Context, argUuid pgtype.UUID) (GetMessa
 		&i.Message.Meta,
 		&i.Message.CreatedAt,
 		&i.Message.UpdatedAt,
+		&i.Message.PipelineUuid,
+		&i.Message.DatasourceUUID,
+		&i.Message.WorkspaceUUID,
+		&i.Message.BodyFileUuid,
 	)
 	return i, err
 }
 
+const getMessageBodiesToMigrate = `-- name: GetMessageBodiesToMigrate :many
+SELECT
+    m.uuid, m.format, m.body, m.created_at, m.workspace_uuid
+FROM message m
+JOIN pipeline p ON p.uuid = m.pipeline_uuid
+WHERE
+    p.storage_uuid = $1::uuid
+  AND m.body_file_uuid IS NULL
+  AND m.body <> ''
+  AND ($2::uuid IS NULL OR m.uuid > $2::uuid)
+  AND ($3::timestamptz IS NULL OR m.created_at < $3::timestamptz)
+ORDER BY m.uuid ASC
+LIMIT $4::int
+`
+
+type GetMessageBodiesToMigrateParams struct {
+	StorageUuid   pgtype.UUID        `json:"storage_uuid"`
+	AfterUuid     pgtype.UUID        `json:"after_uuid"`
+	CreatedBefore pgtype.Timestamptz `json:"created_before"`
+	Limit         int32              `json:"limit"`
+}
+
+type GetMessageBodiesToMigrateRow struct {
+	UUID          uuid.UUID          `json:"uuid"`
+	Format        string             `json:"format"`
+	Body          string             `json:"body"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
+}
+
+// Bodies still held in the message table of the messages written to a storage.
+func (q *Queries) GetMessageBodiesToMigrate(ctx context.Context, arg GetMessageBodiesToMigrateParams) ([]GetMessageBodiesToMigrateRow, error) {
+	rows, err := q.db.Query(ctx, getMessageBodiesToMigrate,
+		arg.StorageUuid,
+		arg.AfterUuid,
+		arg.CreatedBefore,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMessageBodiesToMigrateRow
+	for rows.Next() {
+		var i GetMessageBodiesToMigrateRow
+		if err := rows.Scan(
+			&i.UUID,
+			&i.Format,
+			&i.Body,
+			&i.CreatedAt,
+			&i.WorkspaceUUID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMessageBodyFiles = `-- name: GetMessageBodyFiles :many
+SELECT
+    m.uuid AS message_uuid, f.uuid, f.storage_type, f.storage_uuid, f.name, f.mime_type, f.size, f.data, f.path, f.is_raw, f.raw_headers, f.has_raw_email, f.is_inline, f.created_at, f.updated_at, f.message_uuid, f.workspace_uuid
+FROM message m
+JOIN "file" f ON f.uuid = m.body_file_uuid
+WHERE $1::uuid IS NULL OR m.uuid > $1::uuid
+ORDER BY m.uuid ASC
+LIMIT $2::int
+`
+
+type GetMessageBodyFilesParams struct {
+	AfterUuid pgtype.UUID `json:"after_uuid"`
+	Limit     int32       `json:"limit"`
+}
+
+type GetMessageBodyFilesRow struct {
+	MessageUuid uuid.UUID `json:"message_uuid"`
+	File        File      `json:"file"`
+}
+
+// Files holding message bodies, for erasures to search what SQL can not.
+func (q *Queries) GetMessageBodyFiles(ctx context.Context, arg GetMessageBodyFilesParams) ([]GetMessageBodyFilesRow, error) {
+	rows, err := q.db.Query(ctx, getMessageBodyFiles, arg.AfterUuid, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMessageBodyFilesRow
+	for rows.Next() {
+		var i GetMessageBodyFilesRow
+		if err := rows.Scan(
+			&i.MessageUuid,
+			&i.File.UUID,
+			&i.File.StorageType,
+			&i.File.StorageUuid,
+			&i.File.Name,
+			&i.File.MimeType,
+			&i.File.Size,
+			&i.File.Data,
+			&i.File.Path,
+			&i.File.IsRaw,
+			&i.File.RawHeaders,
+			&i.File.HasRawEmail,
+			&i.File.IsInline,
+			&i.File.CreatedAt,
+			&i.File.UpdatedAt,
+			&i.File.MessageUuid,
+			&i.File.WorkspaceUUID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getMessages = `-- name: GetMessages :many
 WITH filtered_messages AS (
-    SELECT m.uuid, m.format, m.type, m.chat_uuid, m.thread_uuid, m.external_message_id, m.sender, m.recipients, m.subject, m.body, m.body_parsed, m.reactions, m.attachments, m.forward_from, m.reply_to_message_uuid, m.forward_from_chat_uuid, m.forward_from_message_uuid, m.forward_meta, m.meta, m.created_at, m.updated_at
+    SELECT m.uuid, m.format, m.type, m.chat_uuid, m.thread_uuid, m.external_message_id, m.sender, m.recipients, m.subject, m.body, m.body_parsed, m.reactions, m.attachments, m.forward_from, m.reply_to_message_uuid, m.forward_from_chat_uuid, m.forward_from_message_uuid, m.forward_meta, m.meta, m.created_at, m.updated_at, m.pipeline_uuid, m.datasource_uuid, m.workspace_uuid, m.body_file_uuid
     FROM message m
     WHERE
         (NULLIF($5, '') IS NULL OR m.type = $5) AND
         (NULLIF($6, '') IS NULL OR m.format = $6) AND
-        (NULLIF($7, '') IS NULL OR m.sender = $7)
+        ($7::uuid IS NULL OR m.chat_uuid = $7::uuid) AND
+        ($8::uuid IS NULL OR m.thread_uuid = $8::uuid) AND
+        (NULLIF($9, '') IS NULL OR m.sender = $9) AND
+        ($10::uuid IS NULL OR m.pipeline_uuid = $10::uuid) AND
+        -- free text, matched against the subject, the body and the sender
+        (NULLIF($11::text, '') IS NULL OR
+            m.subject ILIKE '%' || $11::text || '%' OR
+            m.body ILIKE '%' || $11::text || '%' OR
+            m.sender ILIKE '%' || $11::text || '%') AND
+        ($12::timestamptz IS NULL OR m.created_at >= $12::timestamptz) AND
+        ($13::timestamptz IS NULL OR m.created_at < $13::timestamptz) AND
+        -- the messages found by a semantic search
+        ($14::uuid[] IS NULL OR m.uuid = ANY($14::uuid[]))
 )
 SELECT
-    uuid, format, type, chat_uuid, thread_uuid, external_message_id, sender, recipients, subject, body, body_parsed, reactions, attachments, forward_from, reply_to_message_uuid, forward_from_chat_uuid, forward_from_message_uuid, forward_meta, meta, created_at, updated_at,
+    uuid, format, type, chat_uuid, thread_uuid, external_message_id, sender, recipients, subject, body, body_parsed, reactions, attachments, forward_from, reply_to_message_uuid, forward_from_chat_uuid, forward_from_message_uuid, forward_meta, meta, created_at, updated_at, pipeline_uuid, datasource_uuid, workspace_uuid, body_file_uuid,
     (SELECT count(*) FROM filtered_messages) as total_count
 FROM filtered_messages
 ORDER BY
@@ -205,13 +354,20 @@ OFFSET $3::int
 `
 
 type GetMessagesParams struct {
-	OrderBy        interface{} `json:"order_by"`
-	OrderDirection interface{} `json:"order_direction"`
-	Offset         int32       `json:"offset"`
-	Limit          int32       `json:"limit"`
-	Type           interface{} `json:"type"`
-	Format         interface{} `json:"format"`
-	Sender         interface{} `json:"sender"`
+	OrderBy        interface{}        `json:"order_by"`
+	OrderDirection interface{}        `json:"order_direction"`
+	Offset         int32              `json:"offset"`
+	Limit          int32              `json:"limit"`
+	Type           interface{}        `json:"type"`
+	Format         interface{}        `json:"format"`
+	ChatUuid       pgtype.UUID        `json:"chat_uuid"`
+	ThreadUuid     pgtype.UUID        `json:"thread_uuid"`
+	Sender         interface{}        `json:"sender"`
+	PipelineUuid   pgtype.UUID        `json:"pipeline_uuid"`
+	Search         string             `json:"search"`
+	CreatedAfter   pgtype.Timestamptz `json:"created_after"`
+	CreatedBefore  pgtype.Timestamptz `json:"created_before"`
+	Uuids          []pgtype.UUID      `json:"uuids"`
 }
 
 type GetMessagesRow struct {
@@ -236,11 +392,13 @@ type GetMessagesRow struct {
 	Meta                   []byte             `json:"meta"`
 	CreatedAt              pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt              pgtype.Timestamptz `json:"updated_at"`
+	PipelineUuid           *uuid.UUID         `json:"pipeline_uuid"`
+	DatasourceUUID         *uuid.UUID         `json:"datasource_uuid"`
+	WorkspaceUUID          *uuid.UUID         `json:"workspace_uuid"`
+	BodyFileUuid           *uuid.UUID         `json:"body_file_uuid"`
 	TotalCount             int64              `json:"total_count"`
 }
 
-// (NULLIF(sqlc.arg('chat_uuid'), ”) IS NULL OR sp.chat_uuid = sqlc.arg('chat_uuid')::uuid) AND
-// (NULLIF(sqlc.arg('thread_uuid'), ”) IS NULL OR sp.thread_uuid = sqlc.arg('thread_uuid')::uuid) AND
 func (q *Queries) GetMessages(ctx context.Context, arg GetMessagesParams) ([]GetMessagesRow, error) {
 	rows, err := q.db.Query(ctx, getMessages,
 		arg.OrderBy,
@@ -249,7 +407,14 @@ func (q *Queries) GetMessages(ctx context.Context, arg GetMessagesParams) ([]Get
 		arg.Limit,
 		arg.Type,
 		arg.Format,
+		arg.ChatUuid,
+		arg.ThreadUuid,
 		arg.Sender,
+		arg.PipelineUuid,
+		arg.Search,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.Uuids,
 	)
 	if err != nil {
 		return nil, err
@@ -280,6 +445,10 @@ func (q *Queries) GetMessages(ctx context.Context, arg GetMessagesParams) ([]Get
 			&i.Meta,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.PipelineUuid,
+			&i.DatasourceUUID,
+			&i.WorkspaceUUID,
+			&i.BodyFileUuid,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -292,9 +461,31 @@ func (q *Queries) GetMessages(ctx context.Context, arg GetMessagesParams) ([]Get
 	return items, nil
 }
 
+const inlineMessageBody = `-- name: InlineMessageBody :execrows
+UPDATE message SET
+    body = $1,
+    body_file_uuid = NULL,
+    updated_at = NOW()
+WHERE body_file_uuid = $2::uuid
+`
+
+type InlineMessageBodyParams struct {
+	Body         string      `json:"body"`
+	BodyFileUuid pgtype.UUID `json:"body_file_uuid"`
+}
+
+// Moves a body back from its file to the message table.
+func (q *Queries) InlineMessageBody(ctx context.Context, arg InlineMessageBodyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, inlineMessageBody, arg.Body, arg.BodyFileUuid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const listMessages = `-- name: ListMessages :many
 SELECT
-    message.uuid, message.format, message.type, message.chat_uuid, message.thread_uuid, message.external_message_id, message.sender, message.recipients, message.subject, message.body, message.body_parsed, message.reactions, message.attachments, message.forward_from, message.reply_to_message_uuid, message.forward_from_chat_uuid, message.forward_from_message_uuid, message.forward_meta, message.meta, message.created_at, message.updated_at
+    message.uuid, message.format, message.type, message.chat_uuid, message.thread_uuid, message.external_message_id, message.sender, message.recipients, message.subject, message.body, message.body_parsed, message.reactions, message.attachments, message.forward_from, message.reply_to_message_uuid, message.forward_from_chat_uuid, message.forward_from_message_uuid, message.forward_meta, message.meta, message.created_at, message.updated_at, message.pipeline_uuid, message.datasource_uuid, message.workspace_uuid, message.body_file_uuid
 FROM message
 ORDER BY created_at DESC
 LIMIT NULLIF($2::int, 0)
@@ -341,6 +532,10 @@ func (q *Queries) ListMessages(ctx context.Context, arg ListMessagesParams) ([]L
 			&i.Message.Meta,
 			&i.Message.CreatedAt,
 			&i.Message.UpdatedAt,
+			&i.Message.PipelineUuid,
+			&i.Message.DatasourceUUID,
+			&i.Message.WorkspaceUUID,
+			&i.Message.BodyFileUuid,
 		); err != nil {
 			return nil, err
 		}
@@ -352,6 +547,27 @@ func (q *Queries) ListMessages(ctx context.Context, arg ListMessagesParams) ([]L
 	return items, nil
 }
 
+const setMessageBodyFile = `-- name: SetMessageBodyFile :exec
+UPDATE message SET
+    body_file_uuid = $1::uuid,
+    body = CASE WHEN $2::boolean THEN '' ELSE body END,
+    updated_at = NOW()
+WHERE uuid = $3::uuid
+`
+
+type SetMessageBodyFileParams struct {
+	BodyFileUuid pgtype.UUID `json:"body_file_uuid"`
+	ClearBody    bool        `json:"clear_body"`
+	UUID         pgtype.UUID `json:"uuid"`
+}
+
+// Points the message to the copy of its body in a storage, clear_body drops
+// the copy kept in the message table.
+func (q *Queries) SetMessageBodyFile(ctx context.Context, arg SetMessageBodyFileParams) error {
+	_, err := q.db.Exec(ctx, setMessageBodyFile, arg.BodyFileUuid, arg.ClearBody, arg.UUID)
+	return err
+}
+
 const updateMessage = `-- name: UpdateMessage :exec
 UPDATE message
 SET
@@ -363,6 +579,8 @@ SET
     recipients                = $6,
     subject                   = $7,
     body                      = $8,
+    -- a new body replaces the one moved to a storage
+    body_file_uuid            = CASE WHEN $8 = '' THEN body_file_uuid END,
     body_parsed               = $9,
     reactions                 = $10,
     attachments               = $11,
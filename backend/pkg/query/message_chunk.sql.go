@@ -0,0 +1,209 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: message_chunk.sql
+
+package query
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createMessageChunk = `-- name: CreateMessageChunk :execrows
+INSERT INTO message_chunk (uuid, workspace_uuid, message_uuid, file_uuid, seq, content, terms, length, model, embedding)
+SELECT $1, m.workspace_uuid, m.uuid, $2, $3, $4, $5::text[], $6, $7,
+       $8::text::vector
+FROM message m
+WHERE m.uuid = $9
+`
+
+type CreateMessageChunkParams struct {
+	UUID        uuid.UUID  `json:"uuid"`
+	FileUuid    *uuid.UUID `json:"file_uuid"`
+	Seq         int32      `json:"seq"`
+	Content     string     `json:"content"`
+	Terms       []string   `json:"terms"`
+	Length      int32      `json:"length"`
+	Model       string     `json:"model"`
+	Embedding   string     `json:"embedding"`
+	MessageUuid uuid.UUID  `json:"message_uuid"`
+}
+
+// The chunk lands in the workspace of its message, nothing is inserted when the
+// message is not stored.
+func (q *Queries) CreateMessageChunk(ctx context.Context, arg CreateMessageChunkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createMessageChunk,
+		arg.UUID,
+		arg.FileUuid,
+		arg.Seq,
+		arg.Content,
+		arg.Terms,
+		arg.Length,
+		arg.Model,
+		arg.Embedding,
+		arg.MessageUuid,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteMessageChunks = `-- name: DeleteMessageChunks :exec
+DELETE FROM message_chunk WHERE message_uuid = $1
+`
+
+func (q *Queries) DeleteMessageChunks(ctx context.Context, messageUuid *uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteMessageChunks, messageUuid)
+	return err
+}
+
+const getMessageChunkStats = `-- name: GetMessageChunkStats :one
+SELECT count(*)::bigint AS chunks, COALESCE(avg(length), 0)::float8 AS avg_length
+FROM message_chunk
+WHERE model = $1
+`
+
+type GetMessageChunkStatsRow struct {
+	Chunks    int64   `json:"chunks"`
+	AvgLength float64 `json:"avg_length"`
+}
+
+// Corpus statistics of BM25: the number of chunks and their average length.
+func (q *Queries) GetMessageChunkStats(ctx context.Context, model string) (GetMessageChunkStatsRow, error) {
+	row := q.db.QueryRow(ctx, getMessageChunkStats, model)
+	var i GetMessageChunkStatsRow
+	err := row.Scan(&i.Chunks, &i.AvgLength)
+	return i, err
+}
+
+const getMessageChunkTermCounts = `-- name: GetMessageChunkTermCounts :many
+SELECT t.term::text AS term,
+       (SELECT count(*) FROM message_chunk c WHERE c.model = $1 AND c.terms @> ARRAY[t.term]::text[])::bigint AS chunks
+FROM unnest($2::text[]) AS t(term)
+`
+
+type GetMessageChunkTermCountsParams struct {
+	Model string   `json:"model"`
+	Terms []string `json:"terms"`
+}
+
+type GetMessageChunkTermCountsRow struct {
+	Term   string `json:"term"`
+	Chunks int64  `json:"chunks"`
+}
+
+// Document frequencies of BM25: the number of chunks holding each term.
+func (q *Queries) GetMessageChunkTermCounts(ctx context.Context, arg GetMessageChunkTermCountsParams) ([]GetMessageChunkTermCountsRow, error) {
+	rows, err := q.db.Query(ctx, getMessageChunkTermCounts, arg.Model, arg.Terms)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMessageChunkTermCountsRow
+	for rows.Next() {
+		var i GetMessageChunkTermCountsRow
+		if err := rows.Scan(&i.Term, &i.Chunks); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchMessageChunks = `-- name: SearchMessageChunks :many
+WITH filtered AS (
+    SELECT c.uuid, c.message_uuid, c.content, c.length, c.embedding, c.terms
+    FROM message_chunk c
+    JOIN message m ON m.uuid = c.message_uuid
+    WHERE c.model = $2 AND
+        vector_dims(c.embedding) = $3::int AND
+        (NULLIF($4, '') IS NULL OR m.type = $4) AND
+        ($5::uuid IS NULL OR m.chat_uuid = $5::uuid) AND
+        ($6::uuid IS NULL OR m.thread_uuid = $6::uuid) AND
+        ($7::uuid IS NULL OR m.pipeline_uuid = $7::uuid) AND
+        ($8::timestamptz IS NULL OR m.created_at >= $8::timestamptz) AND
+        ($9::timestamptz IS NULL OR m.created_at < $9::timestamptz)
+), nearest AS (
+    SELECT f.uuid FROM filtered f
+    ORDER BY f.embedding <=> $1::text::vector
+    LIMIT $10::int
+), matching AS (
+    SELECT f.uuid FROM filtered f
+    WHERE f.terms && $11::text[]
+    ORDER BY cardinality(ARRAY(SELECT unnest(f.terms) INTERSECT SELECT unnest($11::text[]))) DESC
+    LIMIT $10::int
+)
+SELECT f.uuid, f.message_uuid, f.content, f.length,
+       (1 - (f.embedding <=> $1::text::vector))::float8 AS similarity
+FROM filtered f
+WHERE f.uuid IN (SELECT uuid FROM nearest UNION SELECT uuid FROM matching)
+`
+
+type SearchMessageChunksParams struct {
+	Embedding     string             `json:"embedding"`
+	Model         string             `json:"model"`
+	Dims          int32              `json:"dims"`
+	Type          interface{}        `json:"type"`
+	ChatUuid      pgtype.UUID        `json:"chat_uuid"`
+	ThreadUuid    pgtype.UUID        `json:"thread_uuid"`
+	PipelineUuid  pgtype.UUID        `json:"pipeline_uuid"`
+	CreatedAfter  pgtype.Timestamptz `json:"created_after"`
+	CreatedBefore pgtype.Timestamptz `json:"created_before"`
+	Candidates    int32              `json:"candidates"`
+	Terms         []string           `json:"terms"`
+}
+
+type SearchMessageChunksRow struct {
+	UUID        uuid.UUID  `json:"uuid"`
+	MessageUuid *uuid.UUID `json:"message_uuid"`
+	Content     string     `json:"content"`
+	Length      int32      `json:"length"`
+	Similarity  float64    `json:"similarity"`
+}
+
+// Candidates of a semantic search: the chunks nearest to the query vector and
+// those sharing terms with the query, each with its cosine similarity.
+func (q *Queries) SearchMessageChunks(ctx context.Context, arg SearchMessageChunksParams) ([]SearchMessageChunksRow, error) {
+	rows, err := q.db.Query(ctx, searchMessageChunks,
+		arg.Embedding,
+		arg.Model,
+		arg.Dims,
+		arg.Type,
+		arg.ChatUuid,
+		arg.ThreadUuid,
+		arg.PipelineUuid,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.Candidates,
+		arg.Terms,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchMessageChunksRow
+	for rows.Next() {
+		var i SearchMessageChunksRow
+		if err := rows.Scan(
+			&i.UUID,
+			&i.MessageUuid,
+			&i.Content,
+			&i.Length,
+			&i.Similarity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -9,6 +9,58 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type APIKey struct {
+	UUID          uuid.UUID          `json:"uuid"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
+	UserUUID      *uuid.UUID         `json:"user_uuid"`
+	Name          string             `json:"name"`
+	Prefix        string             `json:"prefix"`
+	Hash          string             `json:"hash"`
+	Role          string             `json:"role"`
+	Scopes        []string           `json:"scopes"`
+	AllowedIPs    []string           `json:"allowed_ips"`
+	ExpiresAt     pgtype.Timestamptz `json:"expires_at"`
+	LastUsedAt    pgtype.Timestamptz `json:"last_used_at"`
+	LastUsedIP    pgtype.Text        `json:"last_used_ip"`
+	RevokedAt     pgtype.Timestamptz `json:"revoked_at"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
+type AccessPolicy struct {
+	ID          string             `json:"id"`
+	Description string             `json:"description"`
+	Subjects    []byte             `json:"subjects"`
+	Resources   []byte             `json:"resources"`
+	Actions     []byte             `json:"actions"`
+	Effect      string             `json:"effect"`
+	Conditions  []byte             `json:"conditions"`
+	Meta        []byte             `json:"meta"`
+	IsBuiltin   bool               `json:"is_builtin"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type AuditEvent struct {
+	UUID          uuid.UUID          `json:"uuid"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
+	OccurredAt    pgtype.Timestamptz `json:"occurred_at"`
+	Actor         string             `json:"actor"`
+	Source        string             `json:"source"`
+	APIKeyUUID    *uuid.UUID         `json:"api_key_uuid"`
+	OperationID   string             `json:"operation_id"`
+	ResourceKind  string             `json:"resource_kind"`
+	Action        string             `json:"action"`
+	ResourceID    string             `json:"resource_id"`
+	Params        []byte             `json:"params"`
+	Diff          []byte             `json:"diff"`
+	Outcome       string             `json:"outcome"`
+	Status        int32              `json:"status"`
+	Error         string             `json:"error"`
+	IP            string             `json:"ip"`
+	UserAgent     string             `json:"user_agent"`
+	DurationMs    int32              `json:"duration_ms"`
+}
+
 type Contact struct {
 	UUID                    uuid.UUID          `json:"uuid"`
 	UserUUID                *uuid.UUID         `json:"user_uuid"`
@@ -135,35 +187,72 @@ type Contact struct {
 	EntryDate               pgtype.Timestamptz `json:"entry_date"`
 	EditDate                pgtype.Timestamptz `json:"edit_date"`
 	LastKpiEntryDate        pgtype.Timestamptz `json:"last_kpi_entry_date"`
+	WorkspaceUUID           *uuid.UUID         `json:"workspace_uuid"`
 }
 
 type Datasource struct {
-	UUID      uuid.UUID          `json:"uuid"`
-	UserUUID  *uuid.UUID         `json:"user_uuid"`
-	Name      string             `json:"name"`
-	Type      string             `json:"type"`
-	IsEnabled bool               `json:"is_enabled"`
-	Provider  string             `json:"provider"`
-	Settings  []byte             `json:"settings"`
-	CreatedAt pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	UUID          uuid.UUID          `json:"uuid"`
+	UserUUID      *uuid.UUID         `json:"user_uuid"`
+	Name          string             `json:"name"`
+	Type          string             `json:"type"`
+	IsEnabled     bool               `json:"is_enabled"`
+	Provider      string             `json:"provider"`
+	Settings      []byte             `json:"settings"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
+	NeedsReauth   bool               `json:"needs_reauth"`
+	ReauthReason  string             `json:"reauth_reason"`
+}
+
+type DatasourceCursor struct {
+	DatasourceUUID *uuid.UUID         `json:"datasource_uuid"`
+	Name           string             `json:"name"`
+	State          string             `json:"state"`
+	WorkspaceUUID  *uuid.UUID         `json:"workspace_uuid"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+}
+
+type ErasureRequest struct {
+	UUID          uuid.UUID          `json:"uuid"`
+	Subject       string             `json:"subject"`
+	Mode          string             `json:"mode"`
+	Status        string             `json:"status"`
+	Report        []byte             `json:"report"`
+	Error         pgtype.Text        `json:"error"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	FinishedAt    pgtype.Timestamptz `json:"finished_at"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
+}
+
+type Event struct {
+	UUID          uuid.UUID          `json:"uuid"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
+	Type          string             `json:"type"`
+	SubjectKind   string             `json:"subject_kind"`
+	SubjectUuid   *uuid.UUID         `json:"subject_uuid"`
+	Data          []byte             `json:"data"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	Txid          int64              `json:"txid"`
 }
 
 type File struct {
-	UUID        uuid.UUID          `json:"uuid"`
-	StorageType string             `json:"storage_type"`
-	StorageUuid *uuid.UUID         `json:"storage_uuid"`
-	Name        string             `json:"name"`
-	MimeType    pgtype.Text        `json:"mime_type"`
-	Size        pgtype.Int8        `json:"size"`
-	Data        []byte             `json:"data"`
-	Path        pgtype.Text        `json:"path"`
-	IsRaw       pgtype.Bool        `json:"is_raw"`
-	RawHeaders  pgtype.Text        `json:"raw_headers"`
-	HasRawEmail pgtype.Bool        `json:"has_raw_email"`
-	IsInline    pgtype.Bool        `json:"is_inline"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	UUID          uuid.UUID          `json:"uuid"`
+	StorageType   string             `json:"storage_type"`
+	StorageUuid   *uuid.UUID         `json:"storage_uuid"`
+	Name          string             `json:"name"`
+	MimeType      pgtype.Text        `json:"mime_type"`
+	Size          pgtype.Int8        `json:"size"`
+	Data          []byte             `json:"data"`
+	Path          pgtype.Text        `json:"path"`
+	IsRaw         pgtype.Bool        `json:"is_raw"`
+	RawHeaders    pgtype.Text        `json:"raw_headers"`
+	HasRawEmail   pgtype.Bool        `json:"has_raw_email"`
+	IsInline      pgtype.Bool        `json:"is_inline"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	MessageUuid   *uuid.UUID         `json:"message_uuid"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
 }
 
 type Message struct {
@@ -188,43 +277,77 @@ type Message struct {
 	Meta                   []byte             `json:"meta"`
 	CreatedAt              pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt              pgtype.Timestamptz `json:"updated_at"`
+	PipelineUuid           *uuid.UUID         `json:"pipeline_uuid"`
+	DatasourceUUID         *uuid.UUID         `json:"datasource_uuid"`
+	WorkspaceUUID          *uuid.UUID         `json:"workspace_uuid"`
+	BodyFileUuid           *uuid.UUID         `json:"body_file_uuid"`
+}
+
+type MessageChunk struct {
+	UUID          uuid.UUID          `json:"uuid"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
+	MessageUuid   *uuid.UUID         `json:"message_uuid"`
+	FileUuid      *uuid.UUID         `json:"file_uuid"`
+	Seq           int32              `json:"seq"`
+	Content       string             `json:"content"`
+	Terms         []string           `json:"terms"`
+	Length        int32              `json:"length"`
+	Model         string             `json:"model"`
+	Embedding     string             `json:"embedding"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
 }
 
 type Oauth2Client struct {
-	UUID      uuid.UUID          `json:"uuid"`
-	Name      string             `json:"name"`
-	Provider  string             `json:"provider"`
-	ClientID  string             `json:"client_id"`
-	Secret    string             `json:"secret"`
-	CreatedAt pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	UUID          uuid.UUID          `json:"uuid"`
+	Name          string             `json:"name"`
+	Provider      string             `json:"provider"`
+	ClientID      string             `json:"client_id"`
+	Secret        string             `json:"secret"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	IssuerURL     string             `json:"issuer_url"`
+	Scopes        []string           `json:"scopes"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
 }
 
 type Oauth2State struct {
-	UUID       uuid.UUID          `json:"uuid"`
-	ClientUuid *uuid.UUID         `json:"client_uuid"`
-	State      []byte             `json:"state"`
-	CreatedAt  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
-	ExpiredAt  pgtype.Timestamptz `json:"expired_at"`
+	UUID          uuid.UUID          `json:"uuid"`
+	ClientUuid    *uuid.UUID         `json:"client_uuid"`
+	State         []byte             `json:"state"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	ExpiredAt     pgtype.Timestamptz `json:"expired_at"`
+	CodeVerifier  string             `json:"code_verifier"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
 }
 
 type Oauth2Subject struct {
-	UUID      uuid.UUID          `json:"uuid"`
-	UserUUID  *uuid.UUID         `json:"user_uuid"`
-	TokenUuid *uuid.UUID         `json:"token_uuid"`
-	CreatedAt pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	UUID            uuid.UUID          `json:"uuid"`
+	UserUUID        *uuid.UUID         `json:"user_uuid"`
+	TokenUuid       *uuid.UUID         `json:"token_uuid"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	ExternalSubject string             `json:"external_subject"`
+	ExternalEmail   string             `json:"external_email"`
 }
 
 type Oauth2Token struct {
-	UUID       uuid.UUID          `json:"uuid"`
-	ClientUuid *uuid.UUID         `json:"client_uuid"`
-	UserUUID   *uuid.UUID         `json:"user_uuid"`
-	Token      []byte             `json:"token"`
-	CreatedAt  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
-	Name       pgtype.Text        `json:"name"`
+	UUID            uuid.UUID          `json:"uuid"`
+	ClientUuid      *uuid.UUID         `json:"client_uuid"`
+	UserUUID        *uuid.UUID         `json:"user_uuid"`
+	Token           []byte             `json:"token"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	Name            pgtype.Text        `json:"name"`
+	Status          string             `json:"status"`
+	ExpiresAt       pgtype.Timestamptz `json:"expires_at"`
+	LastRefreshedAt pgtype.Timestamptz `json:"last_refreshed_at"`
+	LastFailedAt    pgtype.Timestamptz `json:"last_failed_at"`
+	FailureCount    int32              `json:"failure_count"`
+	LastError       string             `json:"last_error"`
+	Scopes          []string           `json:"scopes"`
+	MissingScopes   []string           `json:"missing_scopes"`
+	WorkspaceUUID   *uuid.UUID         `json:"workspace_uuid"`
 }
 
 type Pipeline struct {
@@ -237,45 +360,88 @@ type Pipeline struct {
 	Flow           []byte             `json:"flow"`
 	CreatedAt      pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	WorkspaceUUID  *uuid.UUID         `json:"workspace_uuid"`
+}
+
+type RetentionPolicy struct {
+	UUID            uuid.UUID          `json:"uuid"`
+	Name            string             `json:"name"`
+	DatasourceUUID  *uuid.UUID         `json:"datasource_uuid"`
+	PipelineUuid    *uuid.UUID         `json:"pipeline_uuid"`
+	KeepDays        int32              `json:"keep_days"`
+	KeepLast        int32              `json:"keep_last"`
+	AttachmentsDays int32              `json:"attachments_days"`
+	IsEnabled       bool               `json:"is_enabled"`
+	LastRunAt       pgtype.Timestamptz `json:"last_run_at"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	WorkspaceUUID   *uuid.UUID         `json:"workspace_uuid"`
 }
 
 type Scheduler struct {
-	UUID           uuid.UUID          `json:"uuid"`
-	PipelineUuid   *uuid.UUID         `json:"pipeline_uuid"`
-	ScheduleType   string             `json:"schedule_type"`
-	CronExpression pgtype.Text        `json:"cron_expression"`
-	RunAt          pgtype.Timestamptz `json:"run_at"`
-	Timezone       string             `json:"timezone"`
-	NextRun        pgtype.Timestamptz `json:"next_run"`
-	LastRun        pgtype.Timestamptz `json:"last_run"`
-	IsEnabled      bool               `json:"is_enabled"`
-	IsPaused       bool               `json:"is_paused"`
-	CreatedAt      pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	UUID            uuid.UUID          `json:"uuid"`
+	PipelineUuid    *uuid.UUID         `json:"pipeline_uuid"`
+	ScheduleType    string             `json:"schedule_type"`
+	CronExpression  pgtype.Text        `json:"cron_expression"`
+	RunAt           pgtype.Timestamptz `json:"run_at"`
+	Timezone        string             `json:"timezone"`
+	NextRun         pgtype.Timestamptz `json:"next_run"`
+	LastRun         pgtype.Timestamptz `json:"last_run"`
+	IsEnabled       bool               `json:"is_enabled"`
+	IsPaused        bool               `json:"is_paused"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	WorkspaceUUID   *uuid.UUID         `json:"workspace_uuid"`
+	PausedReason    string             `json:"paused_reason"`
+	IntervalSeconds pgtype.Int4        `json:"interval_seconds"`
+	JitterSeconds   int32              `json:"jitter_seconds"`
+	OverlapPolicy   string             `json:"overlap_policy"`
 }
 
 type Storage struct {
-	UUID      uuid.UUID          `json:"uuid"`
-	Name      string             `json:"name"`
-	Type      string             `json:"type"`
-	IsEnabled bool               `json:"is_enabled"`
-	Settings  []byte             `json:"settings"`
-	CreatedAt pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	UUID          uuid.UUID          `json:"uuid"`
+	Name          string             `json:"name"`
+	Type          string             `json:"type"`
+	IsEnabled     bool               `json:"is_enabled"`
+	Settings      []byte             `json:"settings"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
+}
+
+type StorageMigration struct {
+	UUID                  uuid.UUID          `json:"uuid"`
+	SourceStorageUuid     *uuid.UUID         `json:"source_storage_uuid"`
+	TargetStorageUuid     *uuid.UUID         `json:"target_storage_uuid"`
+	Status                string             `json:"status"`
+	OlderThanDays         int32              `json:"older_than_days"`
+	DeleteSource          bool               `json:"delete_source"`
+	BatchSize             int32              `json:"batch_size"`
+	CheckpointUuid        *uuid.UUID         `json:"checkpoint_uuid"`
+	FilesMigrated         int64              `json:"files_migrated"`
+	BytesMigrated         int64              `json:"bytes_migrated"`
+	Error                 pgtype.Text        `json:"error"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
+	FinishedAt            pgtype.Timestamptz `json:"finished_at"`
+	WorkspaceUUID         *uuid.UUID         `json:"workspace_uuid"`
+	MessageCheckpointUuid *uuid.UUID         `json:"message_checkpoint_uuid"`
+	MessagesMigrated      int64              `json:"messages_migrated"`
 }
 
 type SyncPolicy struct {
-	UUID         uuid.UUID          `json:"uuid"`
-	PipelineUuid *uuid.UUID         `json:"pipeline_uuid"`
-	Name         string             `json:"name"`
-	Type         string             `json:"type"`
-	Blocklist    []string           `json:"blocklist"`
-	ExcludeList  []string           `json:"exclude_list"`
-	SyncAll      bool               `json:"sync_all"`
-	IsEnabled    bool               `json:"is_enabled"`
-	Settings     []byte             `json:"settings"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	UUID          uuid.UUID          `json:"uuid"`
+	PipelineUuid  *uuid.UUID         `json:"pipeline_uuid"`
+	Name          string             `json:"name"`
+	Type          string             `json:"type"`
+	Blocklist     []string           `json:"blocklist"`
+	ExcludeList   []string           `json:"exclude_list"`
+	SyncAll       bool               `json:"sync_all"`
+	IsEnabled     bool               `json:"is_enabled"`
+	Settings      []byte             `json:"settings"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
 }
 
 type TgAccount struct {
@@ -366,6 +532,37 @@ type User struct {
 	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
 }
 
+type UserSession struct {
+	UUID       uuid.UUID          `json:"uuid"`
+	UserUUID   *uuid.UUID         `json:"user_uuid"`
+	TokenHash  string             `json:"token_hash"`
+	Source     string             `json:"source"`
+	UserAgent  string             `json:"user_agent"`
+	IP         string             `json:"ip"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	LastSeenAt pgtype.Timestamptz `json:"last_seen_at"`
+	ExpiresAt  pgtype.Timestamptz `json:"expires_at"`
+	RevokedAt  pgtype.Timestamptz `json:"revoked_at"`
+}
+
+type Webhook struct {
+	UUID          uuid.UUID          `json:"uuid"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
+	Name          string             `json:"name"`
+	Url           string             `json:"url"`
+	Secret        string             `json:"secret"`
+	EventTypes    []string           `json:"event_types"`
+	IsEnabled     bool               `json:"is_enabled"`
+	LastEventUuid *uuid.UUID         `json:"last_event_uuid"`
+	LastStatus    int32              `json:"last_status"`
+	LastError     string             `json:"last_error"`
+	FailureCount  int32              `json:"failure_count"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	LastEventTxid pgtype.Int8        `json:"last_event_txid"`
+	LockedUntil   pgtype.Timestamptz `json:"locked_until"`
+}
+
 type WorkerJob struct {
 	UUID          uuid.UUID          `json:"uuid"`
 	SchedulerUuid *uuid.UUID         `json:"scheduler_uuid"`
@@ -375,4 +572,21 @@ type WorkerJob struct {
 	Data          []byte             `json:"data"`
 	StartedAt     pgtype.Timestamptz `json:"started_at"`
 	FinishedAt    pgtype.Timestamptz `json:"finished_at"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
+	PipelineUuid  *uuid.UUID         `json:"pipeline_uuid"`
+}
+
+type Workspace struct {
+	UUID      uuid.UUID          `json:"uuid"`
+	Name      string             `json:"name"`
+	Slug      string             `json:"slug"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type WorkspaceMember struct {
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
+	UserUUID      *uuid.UUID         `json:"user_uuid"`
+	Role          string             `json:"role"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
 }
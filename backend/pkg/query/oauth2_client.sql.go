@@ -19,6 +19,8 @@ INSERT INTO oauth2_client (
     provider,
     client_id,
     secret,
+    issuer_url,
+    scopes,
     created_at,
     updated_at
 ) VALUES (
@@ -27,17 +29,21 @@ INSERT INTO oauth2_client (
              $3,
              $4,
              $5,
+             $6,
+             $7::text[],
              NOW(),
              NOW()
-         ) RETURNING uuid, name, provider, client_id, secret, created_at, updated_at
+         ) RETURNING uuid, name, provider, client_id, secret, created_at, updated_at, issuer_url, scopes, workspace_uuid
 `
 
 type CreateOauth2ClientParams struct {
-	UUID     pgtype.UUID `json:"uuid"`
-	Name     string      `json:"name"`
-	Provider string      `json:"provider"`
-	ClientID string      `json:"client_id"`
-	Secret   string      `json:"secret"`
+	UUID      pgtype.UUID `json:"uuid"`
+	Name      string      `json:"name"`
+	Provider  string      `json:"provider"`
+	ClientID  string      `json:"client_id"`
+	Secret    string      `json:"secret"`
+	IssuerURL string      `json:"issuer_url"`
+	Scopes    []string    `json:"scopes"`
 }
 
 func (q *Queries) CreateOauth2Client(ctx context.Context, arg CreateOauth2ClientParams) (Oauth2Client, error) {
@@ -47,6 +53,8 @@ func (q *Queries) CreateOauth2Client(ctx context.Context, arg CreateOauth2Client
 		arg.Provider,
 		arg.ClientID,
 		arg.Secret,
+		arg.IssuerURL,
+		arg.Scopes,
 	)
 	var i Oauth2Client
 	err := row.Scan(
@@ -57,6 +65,9 @@ func (q *Queries) CreateOauth2Client(ctx context.Context, arg CreateOauth2Client
 		&i.Secret,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IssuerURL,
+		&i.Scopes,
+		&i.WorkspaceUUID,
 	)
 	return i, err
 }
@@ -73,7 +84,7 @@ func (q *Queries) DeleteOauth2Client(ctx context.Context, argUuid pgtype.UUID) e
 
 const getOauth2Client = `-- name: GetOauth2Client :one
 SELECT
-    oauth2_client.uuid, oauth2_client.name, oauth2_client.provider, oauth2_client.client_id, oauth2_client.secret, oauth2_client.created_at, oauth2_client.updated_at
+    oauth2_client.uuid, oauth2_client.name, oauth2_client.provider, oauth2_client.client_id, oauth2_client.secret, oauth2_client.created_at, oauth2_client.updated_at, oauth2_client.issuer_url, oauth2_client.scopes, oauth2_client.workspace_uuid
 FROM oauth2_client
 WHERE uuid = $1::uuid
 `
@@ -93,20 +104,23 @@ func (q *Queries) GetOauth2Client(ctx context.Context, argUuid pgtype.UUID) (Get
 		&i.Oauth2Client.Secret,
 		&i.Oauth2Client.CreatedAt,
 		&i.Oauth2Client.UpdatedAt,
+		&i.Oauth2Client.IssuerURL,
+		&i.Oauth2Client.Scopes,
+		&i.Oauth2Client.WorkspaceUUID,
 	)
 	return i, err
 }
 
 const getOauth2Clients = `-- name: GetOauth2Clients :many
 WITH filtered_oauth2_clients AS (
-    SELECT oc.uuid, oc.name, oc.provider, oc.client_id, oc.secret, oc.created_at, oc.updated_at
+    SELECT oc.uuid, oc.name, oc.provider, oc.client_id, oc.secret, oc.created_at, oc.updated_at, oc.issuer_url, oc.scopes, oc.workspace_uuid
     FROM oauth2_client oc
     WHERE
         (NULLIF($5, '') IS NULL OR oc.name = $5)
       AND (NULLIF($6, '') IS NULL OR oc.provider = $6)
 )
 SELECT
-    uuid, name, provider, client_id, secret, created_at, updated_at,
+    uuid, name, provider, client_id, secret, created_at, updated_at, issuer_url, scopes, workspace_uuid,
     (SELECT count(*) FROM filtered_oauth2_clients) as total_count
 FROM filtered_oauth2_clients
 ORDER BY
@@ -129,14 +143,17 @@ type GetOauth2ClientsParams struct {
 }
 
 type GetOauth2ClientsRow struct {
-	UUID       uuid.UUID          `json:"uuid"`
-	Name       string             `json:"name"`
-	Provider   string             `json:"provider"`
-	ClientID   string             `json:"client_id"`
-	Secret     string             `json:"secret"`
-	CreatedAt  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
-	TotalCount int64              `json:"total_count"`
+	UUID          uuid.UUID          `json:"uuid"`
+	Name          string             `json:"name"`
+	Provider      string             `json:"provider"`
+	ClientID      string             `json:"client_id"`
+	Secret        string             `json:"secret"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	IssuerURL     string             `json:"issuer_url"`
+	Scopes        []string           `json:"scopes"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
+	TotalCount    int64              `json:"total_count"`
 }
 
 func (q *Queries) GetOauth2Clients(ctx context.Context, arg GetOauth2ClientsParams) ([]GetOauth2ClientsRow, error) {
@@ -163,6 +180,9 @@ func (q *Queries) GetOauth2Clients(ctx context.Context, arg GetOauth2ClientsPara
 			&i.Secret,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.IssuerURL,
+			&i.Scopes,
+			&i.WorkspaceUUID,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -177,7 +197,7 @@ func (q *Queries) GetOauth2Clients(ctx context.Context, arg GetOauth2ClientsPara
 
 const listOauth2Clients = `-- name: ListOauth2Clients :many
 SELECT
-    oauth2_client.uuid, oauth2_client.name, oauth2_client.provider, oauth2_client.client_id, oauth2_client.secret, oauth2_client.created_at, oauth2_client.updated_at
+    oauth2_client.uuid, oauth2_client.name, oauth2_client.provider, oauth2_client.client_id, oauth2_client.secret, oauth2_client.created_at, oauth2_client.updated_at, oauth2_client.issuer_url, oauth2_client.scopes, oauth2_client.workspace_uuid
 FROM oauth2_client
 ORDER BY created_at DESC
 LIMIT NULLIF($2::int, 0)
@@ -210,6 +230,9 @@ func (q *Queries) ListOauth2Clients(ctx context.Context, arg ListOauth2ClientsPa
 			&i.Oauth2Client.Secret,
 			&i.Oauth2Client.CreatedAt,
 			&i.Oauth2Client.UpdatedAt,
+			&i.Oauth2Client.IssuerURL,
+			&i.Oauth2Client.Scopes,
+			&i.Oauth2Client.WorkspaceUUID,
 		); err != nil {
 			return nil, err
 		}
@@ -227,16 +250,20 @@ UPDATE oauth2_client SET
                          provider = $2,
                          client_id = $3,
                          secret = $4,
+                         issuer_url = $5,
+                         scopes = $6::text[],
                          updated_at = NOW()
-WHERE uuid = $5::uuid
+WHERE uuid = $7::uuid
 `
 
 type UpdateOauth2ClientParams struct {
-	Name     string      `json:"name"`
-	Provider string      `json:"provider"`
-	ClientID string      `json:"client_id"`
-	Secret   string      `json:"secret"`
-	UUID     pgtype.UUID `json:"uuid"`
+	Name      string      `json:"name"`
+	Provider  string      `json:"provider"`
+	ClientID  string      `json:"client_id"`
+	Secret    string      `json:"secret"`
+	IssuerURL string      `json:"issuer_url"`
+	Scopes    []string    `json:"scopes"`
+	UUID      pgtype.UUID `json:"uuid"`
 }
 
 func (q *Queries) UpdateOauth2Client(ctx context.Context, arg UpdateOauth2ClientParams) error {
@@ -245,6 +272,8 @@ func (q *Queries) UpdateOauth2Client(ctx context.Context, arg UpdateOauth2Client
 		arg.Provider,
 		arg.ClientID,
 		arg.Secret,
+		arg.IssuerURL,
+		arg.Scopes,
 		arg.UUID,
 	)
 	return err
@@ -17,6 +17,7 @@ INSERT INTO oauth2_state (
     uuid,
     client_uuid,
     state,
+    code_verifier,
     created_at,
     updated_at,
     expired_at
@@ -24,17 +25,19 @@ INSERT INTO oauth2_state (
              $1::uuid,
              $2::uuid,
              $3,
+             $4,
              NOW(),
              NOW(),
-             $4
-         ) RETURNING uuid, client_uuid, state, created_at, updated_at, expired_at
+             $5
+         ) RETURNING uuid, client_uuid, state, created_at, updated_at, expired_at, code_verifier, workspace_uuid
 `
 
 type CreateOauth2StateParams struct {
-	UUID       pgtype.UUID        `json:"uuid"`
-	ClientUuid pgtype.UUID        `json:"client_uuid"`
-	State      []byte             `json:"state"`
-	ExpiredAt  pgtype.Timestamptz `json:"expired_at"`
+	UUID         pgtype.UUID        `json:"uuid"`
+	ClientUuid   pgtype.UUID        `json:"client_uuid"`
+	State        []byte             `json:"state"`
+	CodeVerifier string             `json:"code_verifier"`
+	ExpiredAt    pgtype.Timestamptz `json:"expired_at"`
 }
 
 func (q *Queries) CreateOauth2State(ctx context.Context, arg CreateOauth2StateParams) (Oauth2State, error) {
@@ -42,6 +45,7 @@ func (q *Queries) CreateOauth2State(ctx context.Context, arg CreateOauth2StatePa
 		arg.UUID,
 		arg.ClientUuid,
 		arg.State,
+		arg.CodeVerifier,
 		arg.ExpiredAt,
 	)
 	var i Oauth2State
@@ -52,6 +56,8 @@ func (q *Queries) CreateOauth2State(ctx context.Context, arg CreateOauth2StatePa
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExpiredAt,
+		&i.CodeVerifier,
+		&i.WorkspaceUUID,
 	)
 	return i, err
 }
@@ -68,7 +74,7 @@ func (q *Queries) DeleteOauth2State(ctx context.Context, argUuid pgtype.UUID) er
 
 const getOauth2State = `-- name: GetOauth2State :one
 SELECT
-    oauth2_state.uuid, oauth2_state.client_uuid, oauth2_state.state, oauth2_state.created_at, oauth2_state.updated_at, oauth2_state.expired_at
+    oauth2_state.uuid, oauth2_state.client_uuid, oauth2_state.state, oauth2_state.created_at, oauth2_state.updated_at, oauth2_state.expired_at, oauth2_state.code_verifier, oauth2_state.workspace_uuid
 FROM oauth2_state
 WHERE uuid = $1::uuid
 `
@@ -87,19 +93,21 @@ func (q *Queries) GetOauth2State(ctx context.Context, argUuid pgtype.UUID) (GetO
 		&i.Oauth2State.CreatedAt,
 		&i.Oauth2State.UpdatedAt,
 		&i.Oauth2State.ExpiredAt,
+		&i.Oauth2State.CodeVerifier,
+		&i.Oauth2State.WorkspaceUUID,
 	)
 	return i, err
 }
 
 const getOauth2States = `-- name: GetOauth2States :many
 WITH filtered_oauth2_states AS (
-    SELECT os.uuid, os.client_uuid, os.state, os.created_at, os.updated_at, os.expired_at
+    SELECT os.uuid, os.client_uuid, os.state, os.created_at, os.updated_at, os.expired_at, os.code_verifier, os.workspace_uuid
     FROM oauth2_state os
     WHERE
       (NULLIF($5, '') IS NULL OR os.client_uuid = $5::uuid)
 )
 SELECT
-    uuid, client_uuid, state, created_at, updated_at, expired_at,
+    uuid, client_uuid, state, created_at, updated_at, expired_at, code_verifier, workspace_uuid,
     (SELECT count(*) FROM filtered_oauth2_states) as total_count
 FROM filtered_oauth2_states
 ORDER BY
@@ -121,13 +129,15 @@ type GetOauth2StatesParams struct {
 }
 
 type GetOauth2StatesRow struct {
-	UUID       uuid.UUID          `json:"uuid"`
-	ClientUuid *uuid.UUID         `json:"client_uuid"`
-	State      []byte             `json:"state"`
-	CreatedAt  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
-	ExpiredAt  pgtype.Timestamptz `json:"expired_at"`
-	TotalCount int64              `json:"total_count"`
+	UUID          uuid.UUID          `json:"uuid"`
+	ClientUuid    *uuid.UUID         `json:"client_uuid"`
+	State         []byte             `json:"state"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	ExpiredAt     pgtype.Timestamptz `json:"expired_at"`
+	CodeVerifier  string             `json:"code_verifier"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
+	TotalCount    int64              `json:"total_count"`
 }
 
 func (q *Queries) GetOauth2States(ctx context.Context, arg GetOauth2StatesParams) ([]GetOauth2StatesRow, error) {
@@ -152,6 +162,8 @@ func (q *Queries) GetOauth2States(ctx context.Context, arg GetOauth2StatesParams
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.ExpiredAt,
+			&i.CodeVerifier,
+			&i.WorkspaceUUID,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -166,7 +178,7 @@ func (q *Queries) GetOauth2States(ctx context.Context, arg GetOauth2StatesParams
 
 const listOauth2States = `-- name: ListOauth2States :many
 SELECT
-    oauth2_state.uuid, oauth2_state.client_uuid, oauth2_state.state, oauth2_state.created_at, oauth2_state.updated_at, oauth2_state.expired_at
+    oauth2_state.uuid, oauth2_state.client_uuid, oauth2_state.state, oauth2_state.created_at, oauth2_state.updated_at, oauth2_state.expired_at, oauth2_state.code_verifier, oauth2_state.workspace_uuid
 FROM oauth2_state
 ORDER BY created_at DESC
 LIMIT NULLIF($2::int, 0)
@@ -198,6 +210,8 @@ func (q *Queries) ListOauth2States(ctx context.Context, arg ListOauth2StatesPara
 			&i.Oauth2State.CreatedAt,
 			&i.Oauth2State.UpdatedAt,
 			&i.Oauth2State.ExpiredAt,
+			&i.Oauth2State.CodeVerifier,
+			&i.Oauth2State.WorkspaceUUID,
 		); err != nil {
 			return nil, err
 		}
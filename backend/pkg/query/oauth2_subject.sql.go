@@ -17,25 +17,37 @@ INSERT INTO oauth2_subject (
     uuid,
     user_uuid,
     token_uuid,
+    external_subject,
+    external_email,
     created_at,
     updated_at
 ) VALUES (
              $1::uuid,
              $2::uuid,
              $3::uuid,
+             $4,
+             $5,
              NOW(),
              NOW()
-         ) RETURNING uuid, user_uuid, token_uuid, created_at, updated_at
+         ) RETURNING uuid, user_uuid, token_uuid, created_at, updated_at, external_subject, external_email
 `
 
 type CreateOauth2SubjectParams struct {
-	UUID      pgtype.UUID `json:"uuid"`
-	UserUUID  pgtype.UUID `json:"user_uuid"`
-	TokenUuid pgtype.UUID `json:"token_uuid"`
+	UUID            pgtype.UUID `json:"uuid"`
+	UserUUID        pgtype.UUID `json:"user_uuid"`
+	TokenUuid       pgtype.UUID `json:"token_uuid"`
+	ExternalSubject string      `json:"external_subject"`
+	ExternalEmail   string      `json:"external_email"`
 }
 
 func (q *Queries) CreateOauth2Subject(ctx context.Context, arg CreateOauth2SubjectParams) (Oauth2Subject, error) {
-	row := q.db.QueryRow(ctx, createOauth2Subject, arg.UUID, arg.UserUUID, arg.TokenUuid)
+	row := q.db.QueryRow(ctx, createOauth2Subject,
+		arg.UUID,
+		arg.UserUUID,
+		arg.TokenUuid,
+		arg.ExternalSubject,
+		arg.ExternalEmail,
+	)
 	var i Oauth2Subject
 	err := row.Scan(
 		&i.UUID,
@@ -43,6 +55,8 @@ func (q *Queries) CreateOauth2Subject(ctx context.Context, arg CreateOauth2Subje
 		&i.TokenUuid,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ExternalSubject,
+		&i.ExternalEmail,
 	)
 	return i, err
 }
@@ -59,7 +73,7 @@ func (q *Queries) DeleteOauth2Subject(ctx context.Context, argUuid pgtype.UUID)
 
 const getOauth2Subject = `-- name: GetOauth2Subject :one
 SELECT
-    oauth2_subject.uuid, oauth2_subject.user_uuid, oauth2_subject.token_uuid, oauth2_subject.created_at, oauth2_subject.updated_at
+    oauth2_subject.uuid, oauth2_subject.user_uuid, oauth2_subject.token_uuid, oauth2_subject.created_at, oauth2_subject.updated_at, oauth2_subject.external_subject, oauth2_subject.external_email
 FROM oauth2_subject
 WHERE uuid = $1::uuid
 `
@@ -77,20 +91,22 @@ func (q *Queries) GetOauth2Subject(ctx context.Context, argUuid pgtype.UUID) (Ge
 		&i.Oauth2Subject.TokenUuid,
 		&i.Oauth2Subject.CreatedAt,
 		&i.Oauth2Subject.UpdatedAt,
+		&i.Oauth2Subject.ExternalSubject,
+		&i.Oauth2Subject.ExternalEmail,
 	)
 	return i, err
 }
 
 const getOauth2Subjects = `-- name: GetOauth2Subjects :many
 WITH filtered_oauth2_subjects AS (
-    SELECT os.uuid, os.user_uuid, os.token_uuid, os.created_at, os.updated_at
+    SELECT os.uuid, os.user_uuid, os.token_uuid, os.created_at, os.updated_at, os.external_subject, os.external_email
     FROM oauth2_subject os
     WHERE
         (NULLIF($5, '') IS NULL OR os.user_uuid = $5::uuid)
       AND (NULLIF($6, '') IS NULL OR os.token_uuid = $6::uuid)
 )
 SELECT
-    uuid, user_uuid, token_uuid, created_at, updated_at,
+    uuid, user_uuid, token_uuid, created_at, updated_at, external_subject, external_email,
     (SELECT count(*) FROM filtered_oauth2_subjects) as total_count
 FROM filtered_oauth2_subjects
 ORDER BY
@@ -113,12 +129,14 @@ type GetOauth2SubjectsParams struct {
 }
 
 type GetOauth2SubjectsRow struct {
-	UUID       uuid.UUID          `json:"uuid"`
-	UserUUID   *uuid.UUID         `json:"user_uuid"`
-	TokenUuid  *uuid.UUID         `json:"token_uuid"`
-	CreatedAt  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
-	TotalCount int64              `json:"total_count"`
+	UUID            uuid.UUID          `json:"uuid"`
+	UserUUID        *uuid.UUID         `json:"user_uuid"`
+	TokenUuid       *uuid.UUID         `json:"token_uuid"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	ExternalSubject string             `json:"external_subject"`
+	ExternalEmail   string             `json:"external_email"`
+	TotalCount      int64              `json:"total_count"`
 }
 
 func (q *Queries) GetOauth2Subjects(ctx context.Context, arg GetOauth2SubjectsParams) ([]GetOauth2SubjectsRow, error) {
@@ -143,6 +161,8 @@ func (q *Queries) GetOauth2Subjects(ctx context.Context, arg GetOauth2SubjectsPa
 			&i.TokenUuid,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ExternalSubject,
+			&i.ExternalEmail,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -157,7 +177,7 @@ func (q *Queries) GetOauth2Subjects(ctx context.Context, arg GetOauth2SubjectsPa
 
 const listOauth2Subjects = `-- name: ListOauth2Subjects :many
 SELECT
-    oauth2_subject.uuid, oauth2_subject.user_uuid, oauth2_subject.token_uuid, oauth2_subject.created_at, oauth2_subject.updated_at
+    oauth2_subject.uuid, oauth2_subject.user_uuid, oauth2_subject.token_uuid, oauth2_subject.created_at, oauth2_subject.updated_at, oauth2_subject.external_subject, oauth2_subject.external_email
 FROM oauth2_subject
 ORDER BY created_at DESC
 LIMIT NULLIF($2::int, 0)
@@ -188,6 +208,8 @@ func (q *Queries) ListOauth2Subjects(ctx context.Context, arg ListOauth2Subjects
 			&i.Oauth2Subject.TokenUuid,
 			&i.Oauth2Subject.CreatedAt,
 			&i.Oauth2Subject.UpdatedAt,
+			&i.Oauth2Subject.ExternalSubject,
+			&i.Oauth2Subject.ExternalEmail,
 		); err != nil {
 			return nil, err
 		}
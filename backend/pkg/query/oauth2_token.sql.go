@@ -1,6 +1,6 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.27.0
+//   sqlc v1.28.0
 // source: oauth2_token.sql
 
 package query
@@ -18,6 +18,11 @@ INSERT INTO oauth2_token (
     client_uuid,
     user_uuid,
     token,
+    expires_at,
+    scopes,
+    missing_scopes,
+    status,
+    last_refreshed_at,
     created_at,
     updated_at
 ) VALUES (
@@ -25,16 +30,25 @@ INSERT INTO oauth2_token (
     $2::uuid,
     $3::uuid,
     $4,
+    $5,
+    $6,
+    $7::text[],
+    $8,
+    NOW(),
     NOW(),
     NOW()
-) RETURNING uuid, client_uuid, user_uuid, token, created_at, updated_at, name
+) RETURNING uuid, client_uuid, user_uuid, token, created_at, updated_at, name, status, expires_at, last_refreshed_at, last_failed_at, failure_count, last_error, scopes, missing_scopes, workspace_uuid
 `
 
 type CreateOauth2TokenParams struct {
-	UUID       pgtype.UUID `json:"uuid"`
-	ClientUuid pgtype.UUID `json:"client_uuid"`
-	UserUUID   pgtype.UUID `json:"user_uuid"`
-	Token      []byte      `json:"token"`
+	UUID          pgtype.UUID        `json:"uuid"`
+	ClientUuid    pgtype.UUID        `json:"client_uuid"`
+	UserUUID      pgtype.UUID        `json:"user_uuid"`
+	Token         []byte             `json:"token"`
+	ExpiresAt     pgtype.Timestamptz `json:"expires_at"`
+	Scopes        []string           `json:"scopes"`
+	MissingScopes []string           `json:"missing_scopes"`
+	Status        string             `json:"status"`
 }
 
 func (q *Queries) CreateOauth2Token(ctx context.Context, arg CreateOauth2TokenParams) (Oauth2Token, error) {
@@ -43,6 +57,10 @@ func (q *Queries) CreateOauth2Token(ctx context.Context, arg CreateOauth2TokenPa
 		arg.ClientUuid,
 		arg.UserUUID,
 		arg.Token,
+		arg.ExpiresAt,
+		arg.Scopes,
+		arg.MissingScopes,
+		arg.Status,
 	)
 	var i Oauth2Token
 	err := row.Scan(
@@ -53,6 +71,15 @@ func (q *Queries) CreateOauth2Token(ctx context.Context, arg CreateOauth2TokenPa
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Name,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.LastRefreshedAt,
+		&i.LastFailedAt,
+		&i.FailureCount,
+		&i.LastError,
+		&i.Scopes,
+		&i.MissingScopes,
+		&i.WorkspaceUUID,
 	)
 	return i, err
 }
@@ -79,22 +106,31 @@ func (q *Queries) DeleteOauth2TokenByClientUUID(ctx context.Context, clientUuid
 
 const getOauth2ClientTokens = `-- name: GetOauth2ClientTokens :many
 SELECT
-    ot.uuid, ot.client_uuid, ot.user_uuid, ot.token, ot.created_at, ot.updated_at, ot.name,
+    ot.uuid, ot.client_uuid, ot.user_uuid, ot.token, ot.created_at, ot.updated_at, ot.name, ot.status, ot.expires_at, ot.last_refreshed_at, ot.last_failed_at, ot.failure_count, ot.last_error, ot.scopes, ot.missing_scopes, ot.workspace_uuid,
     c.name
 FROM oauth2_token AS ot
-         LEFT JOIN datasource AS c ON c.oauth2_token_uuid = ot.uuid
+         LEFT JOIN datasource AS c ON c.settings->>'oauth2_token_uuid' = ot.uuid::text
 WHERE ot.client_uuid = $1::uuid
 `
 
 type GetOauth2ClientTokensRow struct {
-	UUID       uuid.UUID          `json:"uuid"`
-	ClientUuid *uuid.UUID         `json:"client_uuid"`
-	UserUUID   *uuid.UUID         `json:"user_uuid"`
-	Token      []byte             `json:"token"`
-	CreatedAt  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
-	Name       pgtype.Text        `json:"name"`
-	Name_2     pgtype.Text        `json:"name_2"`
+	UUID            uuid.UUID          `json:"uuid"`
+	ClientUuid      *uuid.UUID         `json:"client_uuid"`
+	UserUUID        *uuid.UUID         `json:"user_uuid"`
+	Token           []byte             `json:"token"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	Name            pgtype.Text        `json:"name"`
+	Status          string             `json:"status"`
+	ExpiresAt       pgtype.Timestamptz `json:"expires_at"`
+	LastRefreshedAt pgtype.Timestamptz `json:"last_refreshed_at"`
+	LastFailedAt    pgtype.Timestamptz `json:"last_failed_at"`
+	FailureCount    int32              `json:"failure_count"`
+	LastError       string             `json:"last_error"`
+	Scopes          []string           `json:"scopes"`
+	MissingScopes   []string           `json:"missing_scopes"`
+	WorkspaceUUID   *uuid.UUID         `json:"workspace_uuid"`
+	Name_2          pgtype.Text        `json:"name_2"`
 }
 
 func (q *Queries) GetOauth2ClientTokens(ctx context.Context, clientUuid pgtype.UUID) ([]GetOauth2ClientTokensRow, error) {
@@ -114,6 +150,15 @@ func (q *Queries) GetOauth2ClientTokens(ctx context.Context, clientUuid pgtype.U
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Name,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.LastRefreshedAt,
+			&i.LastFailedAt,
+			&i.FailureCount,
+			&i.LastError,
+			&i.Scopes,
+			&i.MissingScopes,
+			&i.WorkspaceUUID,
 			&i.Name_2,
 		); err != nil {
 			return nil, err
@@ -128,7 +173,7 @@ func (q *Queries) GetOauth2ClientTokens(ctx context.Context, clientUuid pgtype.U
 
 const getOauth2TokenByUUID = `-- name: GetOauth2TokenByUUID :one
 SELECT
-    oauth2_token.uuid, oauth2_token.client_uuid, oauth2_token.user_uuid, oauth2_token.token, oauth2_token.created_at, oauth2_token.updated_at, oauth2_token.name
+    oauth2_token.uuid, oauth2_token.client_uuid, oauth2_token.user_uuid, oauth2_token.token, oauth2_token.created_at, oauth2_token.updated_at, oauth2_token.name, oauth2_token.status, oauth2_token.expires_at, oauth2_token.last_refreshed_at, oauth2_token.last_failed_at, oauth2_token.failure_count, oauth2_token.last_error, oauth2_token.scopes, oauth2_token.missing_scopes, oauth2_token.workspace_uuid
 FROM oauth2_token
 WHERE uuid = $1::uuid
 `
@@ -148,19 +193,61 @@ func (q *Queries) GetOauth2TokenByUUID(ctx context.Context, argUuid pgtype.UUID)
 		&i.Oauth2Token.CreatedAt,
 		&i.Oauth2Token.UpdatedAt,
 		&i.Oauth2Token.Name,
+		&i.Oauth2Token.Status,
+		&i.Oauth2Token.ExpiresAt,
+		&i.Oauth2Token.LastRefreshedAt,
+		&i.Oauth2Token.LastFailedAt,
+		&i.Oauth2Token.FailureCount,
+		&i.Oauth2Token.LastError,
+		&i.Oauth2Token.Scopes,
+		&i.Oauth2Token.MissingScopes,
+		&i.Oauth2Token.WorkspaceUUID,
+	)
+	return i, err
+}
+
+const getOauth2TokenForUpdate = `-- name: GetOauth2TokenForUpdate :one
+SELECT uuid, client_uuid, user_uuid, token, created_at, updated_at, name, status, expires_at, last_refreshed_at, last_failed_at, failure_count, last_error, scopes, missing_scopes, workspace_uuid
+FROM oauth2_token
+WHERE uuid = $1::uuid
+FOR UPDATE
+`
+
+// Locks the token until the end of the transaction, refreshes of other
+// processes wait for it and then see the refreshed token.
+func (q *Queries) GetOauth2TokenForUpdate(ctx context.Context, argUuid pgtype.UUID) (Oauth2Token, error) {
+	row := q.db.QueryRow(ctx, getOauth2TokenForUpdate, argUuid)
+	var i Oauth2Token
+	err := row.Scan(
+		&i.UUID,
+		&i.ClientUuid,
+		&i.UserUUID,
+		&i.Token,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Name,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.LastRefreshedAt,
+		&i.LastFailedAt,
+		&i.FailureCount,
+		&i.LastError,
+		&i.Scopes,
+		&i.MissingScopes,
+		&i.WorkspaceUUID,
 	)
 	return i, err
 }
 
 const getOauth2Tokens = `-- name: GetOauth2Tokens :many
 WITH filtered_oauth2_tokens AS (
-    SELECT ot.uuid, ot.client_uuid, ot.user_uuid, ot.token, ot.created_at, ot.updated_at, ot.name
+    SELECT ot.uuid, ot.client_uuid, ot.user_uuid, ot.token, ot.created_at, ot.updated_at, ot.name, ot.status, ot.expires_at, ot.last_refreshed_at, ot.last_failed_at, ot.failure_count, ot.last_error, ot.scopes, ot.missing_scopes, ot.workspace_uuid
     FROM oauth2_token ot
     WHERE
         (NULLIF($5, '') IS NULL OR ot.client_uuid = $5::uuid)
 )
 SELECT
-    uuid, client_uuid, user_uuid, token, created_at, updated_at, name,
+    uuid, client_uuid, user_uuid, token, created_at, updated_at, name, status, expires_at, last_refreshed_at, last_failed_at, failure_count, last_error, scopes, missing_scopes, workspace_uuid,
     (SELECT count(*) FROM filtered_oauth2_tokens) as total_count
 FROM filtered_oauth2_tokens
 ORDER BY
@@ -182,14 +269,23 @@ type GetOauth2TokensParams struct {
 }
 
 type GetOauth2TokensRow struct {
-	UUID       uuid.UUID          `json:"uuid"`
-	ClientUuid *uuid.UUID         `json:"client_uuid"`
-	UserUUID   *uuid.UUID         `json:"user_uuid"`
-	Token      []byte             `json:"token"`
-	CreatedAt  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
-	Name       pgtype.Text        `json:"name"`
-	TotalCount int64              `json:"total_count"`
+	UUID            uuid.UUID          `json:"uuid"`
+	ClientUuid      *uuid.UUID         `json:"client_uuid"`
+	UserUUID        *uuid.UUID         `json:"user_uuid"`
+	Token           []byte             `json:"token"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	Name            pgtype.Text        `json:"name"`
+	Status          string             `json:"status"`
+	ExpiresAt       pgtype.Timestamptz `json:"expires_at"`
+	LastRefreshedAt pgtype.Timestamptz `json:"last_refreshed_at"`
+	LastFailedAt    pgtype.Timestamptz `json:"last_failed_at"`
+	FailureCount    int32              `json:"failure_count"`
+	LastError       string             `json:"last_error"`
+	Scopes          []string           `json:"scopes"`
+	MissingScopes   []string           `json:"missing_scopes"`
+	WorkspaceUUID   *uuid.UUID         `json:"workspace_uuid"`
+	TotalCount      int64              `json:"total_count"`
 }
 
 func (q *Queries) GetOauth2Tokens(ctx context.Context, arg GetOauth2TokensParams) ([]GetOauth2TokensRow, error) {
@@ -215,6 +311,15 @@ func (q *Queries) GetOauth2Tokens(ctx context.Context, arg GetOauth2TokensParams
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Name,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.LastRefreshedAt,
+			&i.LastFailedAt,
+			&i.FailureCount,
+			&i.LastError,
+			&i.Scopes,
+			&i.MissingScopes,
+			&i.WorkspaceUUID,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -229,7 +334,7 @@ func (q *Queries) GetOauth2Tokens(ctx context.Context, arg GetOauth2TokensParams
 
 const getOauth2TokensByClientUUID = `-- name: GetOauth2TokensByClientUUID :many
 SELECT
-    oauth2_token.uuid, oauth2_token.client_uuid, oauth2_token.user_uuid, oauth2_token.token, oauth2_token.created_at, oauth2_token.updated_at, oauth2_token.name
+    oauth2_token.uuid, oauth2_token.client_uuid, oauth2_token.user_uuid, oauth2_token.token, oauth2_token.created_at, oauth2_token.updated_at, oauth2_token.name, oauth2_token.status, oauth2_token.expires_at, oauth2_token.last_refreshed_at, oauth2_token.last_failed_at, oauth2_token.failure_count, oauth2_token.last_error, oauth2_token.scopes, oauth2_token.missing_scopes, oauth2_token.workspace_uuid
 FROM oauth2_token
 WHERE client_uuid = $1::uuid
 `
@@ -255,6 +360,15 @@ func (q *Queries) GetOauth2TokensByClientUUID(ctx context.Context, clientUuid pg
 			&i.Oauth2Token.CreatedAt,
 			&i.Oauth2Token.UpdatedAt,
 			&i.Oauth2Token.Name,
+			&i.Oauth2Token.Status,
+			&i.Oauth2Token.ExpiresAt,
+			&i.Oauth2Token.LastRefreshedAt,
+			&i.Oauth2Token.LastFailedAt,
+			&i.Oauth2Token.FailureCount,
+			&i.Oauth2Token.LastError,
+			&i.Oauth2Token.Scopes,
+			&i.Oauth2Token.MissingScopes,
+			&i.Oauth2Token.WorkspaceUUID,
 		); err != nil {
 			return nil, err
 		}
@@ -268,20 +382,31 @@ func (q *Queries) GetOauth2TokensByClientUUID(ctx context.Context, clientUuid pg
 
 const getTokensToRefresh = `-- name: GetTokensToRefresh :many
 SELECT
-    oauth2_token.uuid, oauth2_token.client_uuid, oauth2_token.user_uuid, oauth2_token.token, oauth2_token.created_at, oauth2_token.updated_at, oauth2_token.name
+    oauth2_token.uuid, oauth2_token.client_uuid, oauth2_token.user_uuid, oauth2_token.token, oauth2_token.created_at, oauth2_token.updated_at, oauth2_token.name, oauth2_token.status, oauth2_token.expires_at, oauth2_token.last_refreshed_at, oauth2_token.last_failed_at, oauth2_token.failure_count, oauth2_token.last_error, oauth2_token.scopes, oauth2_token.missing_scopes, oauth2_token.workspace_uuid
 FROM oauth2_token
 WHERE
-   (NULLIF($1, '') IS NULL OR os.client_uuid = $1::uuid) AND
-    expires_at < NOW() AND
-    (updated_at IS NULL OR updated_at < NOW() - INTERVAL '1 hour')
+    status <> 'needs_reauth' AND
+    (expires_at IS NULL OR expires_at < $1::timestamptz) AND
+    (failure_count = 0 OR last_failed_at IS NULL OR
+     last_failed_at < NOW() - LEAST(INTERVAL '5 minutes' * power(2, failure_count - 1), INTERVAL '6 hours')) AND
+    EXISTS (
+        SELECT 1
+        FROM datasource d
+        JOIN pipeline p ON p.datasource_uuid = d.uuid
+        WHERE d.settings->>'oauth2_client_uuid' = oauth2_token.client_uuid::text AND
+              d.is_enabled AND p.is_enabled
+    )
 `
 
 type GetTokensToRefreshRow struct {
 	Oauth2Token Oauth2Token `json:"oauth2_token"`
 }
 
-func (q *Queries) GetTokensToRefresh(ctx context.Context, clientUuid interface{}) ([]GetTokensToRefreshRow, error) {
-	rows, err := q.db.Query(ctx, getTokensToRefresh, clientUuid)
+// Tokens expiring before expires_before that an enabled datasource with an enabled
+// pipeline uses. Tokens needing a new login are skipped, failing ones are retried
+// with an exponential backoff capped at 6 hours.
+func (q *Queries) GetTokensToRefresh(ctx context.Context, expiresBefore pgtype.Timestamptz) ([]GetTokensToRefreshRow, error) {
+	rows, err := q.db.Query(ctx, getTokensToRefresh, expiresBefore)
 	if err != nil {
 		return nil, err
 	}
@@ -297,6 +422,15 @@ func (q *Queries) GetTokensToRefresh(ctx context.Context, clientUuid interface{}
 			&i.Oauth2Token.CreatedAt,
 			&i.Oauth2Token.UpdatedAt,
 			&i.Oauth2Token.Name,
+			&i.Oauth2Token.Status,
+			&i.Oauth2Token.ExpiresAt,
+			&i.Oauth2Token.LastRefreshedAt,
+			&i.Oauth2Token.LastFailedAt,
+			&i.Oauth2Token.FailureCount,
+			&i.Oauth2Token.LastError,
+			&i.Oauth2Token.Scopes,
+			&i.Oauth2Token.MissingScopes,
+			&i.Oauth2Token.WorkspaceUUID,
 		); err != nil {
 			return nil, err
 		}
@@ -308,6 +442,82 @@ func (q *Queries) GetTokensToRefresh(ctx context.Context, clientUuid interface{}
 	return items, nil
 }
 
+const setOauth2TokenFailed = `-- name: SetOauth2TokenFailed :one
+UPDATE oauth2_token SET
+    status = $1,
+    failure_count = failure_count + 1,
+    last_failed_at = NOW(),
+    last_error = $2,
+    updated_at = NOW()
+WHERE uuid = $3::uuid
+RETURNING uuid, client_uuid, user_uuid, token, created_at, updated_at, name, status, expires_at, last_refreshed_at, last_failed_at, failure_count, last_error, scopes, missing_scopes, workspace_uuid
+`
+
+type SetOauth2TokenFailedParams struct {
+	Status    string      `json:"status"`
+	LastError string      `json:"last_error"`
+	UUID      pgtype.UUID `json:"uuid"`
+}
+
+func (q *Queries) SetOauth2TokenFailed(ctx context.Context, arg SetOauth2TokenFailedParams) (Oauth2Token, error) {
+	row := q.db.QueryRow(ctx, setOauth2TokenFailed, arg.Status, arg.LastError, arg.UUID)
+	var i Oauth2Token
+	err := row.Scan(
+		&i.UUID,
+		&i.ClientUuid,
+		&i.UserUUID,
+		&i.Token,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Name,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.LastRefreshedAt,
+		&i.LastFailedAt,
+		&i.FailureCount,
+		&i.LastError,
+		&i.Scopes,
+		&i.MissingScopes,
+		&i.WorkspaceUUID,
+	)
+	return i, err
+}
+
+const setOauth2TokenRefreshed = `-- name: SetOauth2TokenRefreshed :exec
+UPDATE oauth2_token SET
+    token = $1,
+    expires_at = $2,
+    scopes = $3,
+    missing_scopes = $4::text[],
+    status = $5,
+    last_refreshed_at = NOW(),
+    failure_count = 0,
+    last_error = '',
+    updated_at = NOW()
+WHERE uuid = $6::uuid
+`
+
+type SetOauth2TokenRefreshedParams struct {
+	Token         []byte             `json:"token"`
+	ExpiresAt     pgtype.Timestamptz `json:"expires_at"`
+	Scopes        []string           `json:"scopes"`
+	MissingScopes []string           `json:"missing_scopes"`
+	Status        string             `json:"status"`
+	UUID          pgtype.UUID        `json:"uuid"`
+}
+
+func (q *Queries) SetOauth2TokenRefreshed(ctx context.Context, arg SetOauth2TokenRefreshedParams) error {
+	_, err := q.db.Exec(ctx, setOauth2TokenRefreshed,
+		arg.Token,
+		arg.ExpiresAt,
+		arg.Scopes,
+		arg.MissingScopes,
+		arg.Status,
+		arg.UUID,
+	)
+	return err
+}
+
 const updateOauth2Token = `-- name: UpdateOauth2Token :exec
 UPDATE oauth2_token SET
     token = $1,
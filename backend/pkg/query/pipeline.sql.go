@@ -33,7 +33,7 @@ INSERT INTO pipeline (
               $7,
              NOW(),
   NOW()
-) RETURNING uuid, datasource_uuid, storage_uuid, name, type, is_enabled, flow, created_at, updated_at
+) RETURNING uuid, datasource_uuid, storage_uuid, name, type, is_enabled, flow, created_at, updated_at, workspace_uuid
 `
 
 type CreatePipelineParams struct {
@@ -67,6 +67,7 @@ func (q *Queries) CreatePipeline(ctx context.Context, arg CreatePipelineParams)
 		&i.Flow,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.WorkspaceUUID,
 	)
 	return i, err
 }
@@ -82,7 +83,7 @@ func (q *Queries) DeletePipeline(ctx context.Context, argUuid pgtype.UUID) error
 
 const getPipeline = `-- name: GetPipeline :one
 SELECT
-    pipeline.uuid, pipeline.datasource_uuid, pipeline.storage_uuid, pipeline.name, pipeline.type, pipeline.is_enabled, pipeline.flow, pipeline.created_at, pipeline.updated_at
+    pipeline.uuid, pipeline.datasource_uuid, pipeline.storage_uuid, pipeline.name, pipeline.type, pipeline.is_enabled, pipeline.flow, pipeline.created_at, pipeline.updated_at, pipeline.workspace_uuid
 FROM pipeline
 WHERE uuid = $1::uuid
 `
@@ -104,13 +105,14 @@ func (q *Queries) GetPipeline(ctx context.Context, argUuid pgtype.UUID) (GetPipe
 		&i.Pipeline.Flow,
 		&i.Pipeline.CreatedAt,
 		&i.Pipeline.UpdatedAt,
+		&i.Pipeline.WorkspaceUUID,
 	)
 	return i, err
 }
 
 const getPipelines = `-- name: GetPipelines :many
 WITH filtered_pipelines AS (
-    SELECT p.uuid, p.datasource_uuid, p.storage_uuid, p.name, p.type, p.is_enabled, p.flow, p.created_at, p.updated_at
+    SELECT p.uuid, p.datasource_uuid, p.storage_uuid, p.name, p.type, p.is_enabled, p.flow, p.created_at, p.updated_at, p.workspace_uuid
     FROM pipeline p
     WHERE
         (NULLIF($5, '') IS NULL OR p.uuid = $5::uuid) AND
@@ -121,7 +123,7 @@ WITH filtered_pipelines AS (
         (NULLIF($10, '') IS NULL OR p.name ILIKE '%' || $10 || '%')
 )
 SELECT
-    uuid, datasource_uuid, storage_uuid, name, type, is_enabled, flow, created_at, updated_at,
+    uuid, datasource_uuid, storage_uuid, name, type, is_enabled, flow, created_at, updated_at, workspace_uuid,
     (SELECT count(*) FROM filtered_pipelines) AS total_count
 FROM filtered_pipelines
 ORDER BY
@@ -159,6 +161,7 @@ type GetPipelinesRow struct {
 	Flow           []byte             `json:"flow"`
 	CreatedAt      pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	WorkspaceUUID  *uuid.UUID         `json:"workspace_uuid"`
 	TotalCount     int64              `json:"total_count"`
 }
 
@@ -192,6 +195,7 @@ func (q *Queries) GetPipelines(ctx context.Context, arg GetPipelinesParams) ([]G
 			&i.Flow,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.WorkspaceUUID,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -206,7 +210,7 @@ func (q *Queries) GetPipelines(ctx context.Context, arg GetPipelinesParams) ([]G
 
 const listPipelines = `-- name: ListPipelines :many
 SELECT
-    pipeline.uuid, pipeline.datasource_uuid, pipeline.storage_uuid, pipeline.name, pipeline.type, pipeline.is_enabled, pipeline.flow, pipeline.created_at, pipeline.updated_at
+    pipeline.uuid, pipeline.datasource_uuid, pipeline.storage_uuid, pipeline.name, pipeline.type, pipeline.is_enabled, pipeline.flow, pipeline.created_at, pipeline.updated_at, pipeline.workspace_uuid
 FROM pipeline
 ORDER BY created_at DESC
 LIMIT NULLIF($2::int, 0)
@@ -241,6 +245,7 @@ func (q *Queries) ListPipelines(ctx context.Context, arg ListPipelinesParams) ([
 			&i.Pipeline.Flow,
 			&i.Pipeline.CreatedAt,
 			&i.Pipeline.UpdatedAt,
+			&i.Pipeline.WorkspaceUUID,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,448 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: retention_policy.sql
+
+package query
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createRetentionPolicy = `-- name: CreateRetentionPolicy :one
+INSERT INTO retention_policy (
+    uuid,
+    name,
+    datasource_uuid,
+    pipeline_uuid,
+    keep_days,
+    keep_last,
+    attachments_days,
+    is_enabled,
+    created_at,
+    updated_at
+) VALUES (
+    $1::uuid,
+    $2,
+    $3::uuid,
+    $4::uuid,
+    $5::int,
+    $6::int,
+    $7::int,
+    $8::boolean,
+    NOW(),
+    NOW()
+) RETURNING uuid, name, datasource_uuid, pipeline_uuid, keep_days, keep_last, attachments_days, is_enabled, last_run_at, created_at, updated_at, workspace_uuid
+`
+
+type CreateRetentionPolicyParams struct {
+	UUID            pgtype.UUID `json:"uuid"`
+	Name            string      `json:"name"`
+	DatasourceUUID  pgtype.UUID `json:"datasource_uuid"`
+	PipelineUuid    pgtype.UUID `json:"pipeline_uuid"`
+	KeepDays        int32       `json:"keep_days"`
+	KeepLast        int32       `json:"keep_last"`
+	AttachmentsDays int32       `json:"attachments_days"`
+	IsEnabled       bool        `json:"is_enabled"`
+}
+
+func (q *Queries) CreateRetentionPolicy(ctx context.Context, arg CreateRetentionPolicyParams) (RetentionPolicy, error) {
+	row := q.db.QueryRow(ctx, createRetentionPolicy,
+		arg.UUID,
+		arg.Name,
+		arg.DatasourceUUID,
+		arg.PipelineUuid,
+		arg.KeepDays,
+		arg.KeepLast,
+		arg.AttachmentsDays,
+		arg.IsEnabled,
+	)
+	var i RetentionPolicy
+	err := row.Scan(
+		&i.UUID,
+		&i.Name,
+		&i.DatasourceUUID,
+		&i.PipelineUuid,
+		&i.KeepDays,
+		&i.KeepLast,
+		&i.AttachmentsDays,
+		&i.IsEnabled,
+		&i.LastRunAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.WorkspaceUUID,
+	)
+	return i, err
+}
+
+const deleteFilesByUUIDs = `-- name: DeleteFilesByUUIDs :execrows
+DELETE FROM "file" WHERE uuid = ANY($1::uuid[])
+`
+
+func (q *Queries) DeleteFilesByUUIDs(ctx context.Context, uuids []pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteFilesByUUIDs, uuids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteMessagesByUUIDs = `-- name: DeleteMessagesByUUIDs :execrows
+DELETE FROM message WHERE uuid = ANY($1::uuid[])
+`
+
+func (q *Queries) DeleteMessagesByUUIDs(ctx context.Context, uuids []pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteMessagesByUUIDs, uuids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteRetentionPolicy = `-- name: DeleteRetentionPolicy :exec
+DELETE FROM retention_policy WHERE uuid = $1::uuid
+`
+
+func (q *Queries) DeleteRetentionPolicy(ctx context.Context, argUuid pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteRetentionPolicy, argUuid)
+	return err
+}
+
+const getExpiredAttachments = `-- name: GetExpiredAttachments :many
+SELECT
+    f.uuid, f.storage_type, f.storage_uuid, f.name, f.mime_type, f.size, f.data, f.path, f.is_raw, f.raw_headers, f.has_raw_email, f.is_inline, f.created_at, f.updated_at, f.message_uuid, f.workspace_uuid
+FROM "file" f
+JOIN message m ON m.uuid = f.message_uuid
+WHERE
+    ($1::uuid IS NULL OR m.pipeline_uuid = $1::uuid) AND
+    ($2::uuid IS NULL OR m.datasource_uuid = $2::uuid) AND
+    f.created_at < $3::timestamptz AND
+    -- a body moved to a storage is not an attachment, it goes with its message
+    f.uuid IS DISTINCT FROM m.body_file_uuid
+ORDER BY f.created_at ASC
+LIMIT $4::int
+`
+
+type GetExpiredAttachmentsParams struct {
+	PipelineUuid   pgtype.UUID        `json:"pipeline_uuid"`
+	DatasourceUUID pgtype.UUID        `json:"datasource_uuid"`
+	CreatedBefore  pgtype.Timestamptz `json:"created_before"`
+	Limit          int32              `json:"limit"`
+}
+
+type GetExpiredAttachmentsRow struct {
+	File File `json:"file"`
+}
+
+func (q *Queries) GetExpiredAttachments(ctx context.Context, arg GetExpiredAttachmentsParams) ([]GetExpiredAttachmentsRow, error) {
+	rows, err := q.db.Query(ctx, getExpiredAttachments,
+		arg.PipelineUuid,
+		arg.DatasourceUUID,
+		arg.CreatedBefore,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetExpiredAttachmentsRow
+	for rows.Next() {
+		var i GetExpiredAttachmentsRow
+		if err := rows.Scan(
+			&i.File.UUID,
+			&i.File.StorageType,
+			&i.File.StorageUuid,
+			&i.File.Name,
+			&i.File.MimeType,
+			&i.File.Size,
+			&i.File.Data,
+			&i.File.Path,
+			&i.File.IsRaw,
+			&i.File.RawHeaders,
+			&i.File.HasRawEmail,
+			&i.File.IsInline,
+			&i.File.CreatedAt,
+			&i.File.UpdatedAt,
+			&i.File.MessageUuid,
+			&i.File.WorkspaceUUID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getExpiredMessageUUIDs = `-- name: GetExpiredMessageUUIDs :many
+SELECT uuid
+FROM message
+WHERE
+    ($1::uuid IS NULL OR pipeline_uuid = $1::uuid) AND
+    ($2::uuid IS NULL OR datasource_uuid = $2::uuid) AND
+    created_at < $3::timestamptz
+ORDER BY created_at ASC
+LIMIT $4::int
+`
+
+type GetExpiredMessageUUIDsParams struct {
+	PipelineUuid   pgtype.UUID        `json:"pipeline_uuid"`
+	DatasourceUUID pgtype.UUID        `json:"datasource_uuid"`
+	CreatedBefore  pgtype.Timestamptz `json:"created_before"`
+	Limit          int32              `json:"limit"`
+}
+
+func (q *Queries) GetExpiredMessageUUIDs(ctx context.Context, arg GetExpiredMessageUUIDsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getExpiredMessageUUIDs,
+		arg.PipelineUuid,
+		arg.DatasourceUUID,
+		arg.CreatedBefore,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var uuid uuid.UUID
+		if err := rows.Scan(&uuid); err != nil {
+			return nil, err
+		}
+		items = append(items, uuid)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFilesByMessageUUIDs = `-- name: GetFilesByMessageUUIDs :many
+SELECT
+    file.uuid, file.storage_type, file.storage_uuid, file.name, file.mime_type, file.size, file.data, file.path, file.is_raw, file.raw_headers, file.has_raw_email, file.is_inline, file.created_at, file.updated_at, file.message_uuid, file.workspace_uuid
+FROM "file"
+WHERE message_uuid = ANY($1::uuid[])
+`
+
+type GetFilesByMessageUUIDsRow struct {
+	File File `json:"file"`
+}
+
+func (q *Queries) GetFilesByMessageUUIDs(ctx context.Context, messageUuids []pgtype.UUID) ([]GetFilesByMessageUUIDsRow, error) {
+	rows, err := q.db.Query(ctx, getFilesByMessageUUIDs, messageUuids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetFilesByMessageUUIDsRow
+	for rows.Next() {
+		var i GetFilesByMessageUUIDsRow
+		if err := rows.Scan(
+			&i.File.UUID,
+			&i.File.StorageType,
+			&i.File.StorageUuid,
+			&i.File.Name,
+			&i.File.MimeType,
+			&i.File.Size,
+			&i.File.Data,
+			&i.File.Path,
+			&i.File.IsRaw,
+			&i.File.RawHeaders,
+			&i.File.HasRawEmail,
+			&i.File.IsInline,
+			&i.File.CreatedAt,
+			&i.File.UpdatedAt,
+			&i.File.MessageUuid,
+			&i.File.WorkspaceUUID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMessageUUIDsBeyondKeepLast = `-- name: GetMessageUUIDsBeyondKeepLast :many
+SELECT uuid
+FROM message
+WHERE
+    ($1::uuid IS NULL OR pipeline_uuid = $1::uuid) AND
+    ($2::uuid IS NULL OR datasource_uuid = $2::uuid)
+ORDER BY created_at DESC
+LIMIT $4::int
+    OFFSET $3::int
+`
+
+type GetMessageUUIDsBeyondKeepLastParams struct {
+	PipelineUuid   pgtype.UUID `json:"pipeline_uuid"`
+	DatasourceUUID pgtype.UUID `json:"datasource_uuid"`
+	KeepLast       int32       `json:"keep_last"`
+	Limit          int32       `json:"limit"`
+}
+
+func (q *Queries) GetMessageUUIDsBeyondKeepLast(ctx context.Context, arg GetMessageUUIDsBeyondKeepLastParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getMessageUUIDsBeyondKeepLast,
+		arg.PipelineUuid,
+		arg.DatasourceUUID,
+		arg.KeepLast,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var uuid uuid.UUID
+		if err := rows.Scan(&uuid); err != nil {
+			return nil, err
+		}
+		items = append(items, uuid)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRetentionPolicies = `-- name: GetRetentionPolicies :many
+SELECT
+    retention_policy.uuid, retention_policy.name, retention_policy.datasource_uuid, retention_policy.pipeline_uuid, retention_policy.keep_days, retention_policy.keep_last, retention_policy.attachments_days, retention_policy.is_enabled, retention_policy.last_run_at, retention_policy.created_at, retention_policy.updated_at, retention_policy.workspace_uuid
+FROM retention_policy
+WHERE
+    (NULLIF($1::int, -1) IS NULL OR is_enabled = ($1::int)::boolean)
+ORDER BY created_at DESC
+LIMIT NULLIF($3::int, 0)
+    OFFSET $2::int
+`
+
+type GetRetentionPoliciesParams struct {
+	IsEnabled int32 `json:"is_enabled"`
+	Offset    int32 `json:"offset"`
+	Limit     int32 `json:"limit"`
+}
+
+type GetRetentionPoliciesRow struct {
+	RetentionPolicy RetentionPolicy `json:"retention_policy"`
+}
+
+func (q *Queries) GetRetentionPolicies(ctx context.Context, arg GetRetentionPoliciesParams) ([]GetRetentionPoliciesRow, error) {
+	rows, err := q.db.Query(ctx, getRetentionPolicies, arg.IsEnabled, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRetentionPoliciesRow
+	for rows.Next() {
+		var i GetRetentionPoliciesRow
+		if err := rows.Scan(
+			&i.RetentionPolicy.UUID,
+			&i.RetentionPolicy.Name,
+			&i.RetentionPolicy.DatasourceUUID,
+			&i.RetentionPolicy.PipelineUuid,
+			&i.RetentionPolicy.KeepDays,
+			&i.RetentionPolicy.KeepLast,
+			&i.RetentionPolicy.AttachmentsDays,
+			&i.RetentionPolicy.IsEnabled,
+			&i.RetentionPolicy.LastRunAt,
+			&i.RetentionPolicy.CreatedAt,
+			&i.RetentionPolicy.UpdatedAt,
+			&i.RetentionPolicy.WorkspaceUUID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRetentionPolicy = `-- name: GetRetentionPolicy :one
+SELECT
+    retention_policy.uuid, retention_policy.name, retention_policy.datasource_uuid, retention_policy.pipeline_uuid, retention_policy.keep_days, retention_policy.keep_last, retention_policy.attachments_days, retention_policy.is_enabled, retention_policy.last_run_at, retention_policy.created_at, retention_policy.updated_at, retention_policy.workspace_uuid
+FROM retention_policy
+WHERE uuid = $1::uuid
+`
+
+type GetRetentionPolicyRow struct {
+	RetentionPolicy RetentionPolicy `json:"retention_policy"`
+}
+
+func (q *Queries) GetRetentionPolicy(ctx context.Context, argUuid pgtype.UUID) (GetRetentionPolicyRow, error) {
+	row := q.db.QueryRow(ctx, getRetentionPolicy, argUuid)
+	var i GetRetentionPolicyRow
+	err := row.Scan(
+		&i.RetentionPolicy.UUID,
+		&i.RetentionPolicy.Name,
+		&i.RetentionPolicy.DatasourceUUID,
+		&i.RetentionPolicy.PipelineUuid,
+		&i.RetentionPolicy.KeepDays,
+		&i.RetentionPolicy.KeepLast,
+		&i.RetentionPolicy.AttachmentsDays,
+		&i.RetentionPolicy.IsEnabled,
+		&i.RetentionPolicy.LastRunAt,
+		&i.RetentionPolicy.CreatedAt,
+		&i.RetentionPolicy.UpdatedAt,
+		&i.RetentionPolicy.WorkspaceUUID,
+	)
+	return i, err
+}
+
+const updateRetentionPolicy = `-- name: UpdateRetentionPolicy :exec
+UPDATE retention_policy SET
+    name = $1,
+    datasource_uuid = $2::uuid,
+    pipeline_uuid = $3::uuid,
+    keep_days = $4::int,
+    keep_last = $5::int,
+    attachments_days = $6::int,
+    is_enabled = $7::boolean,
+    updated_at = NOW()
+WHERE uuid = $8::uuid
+`
+
+type UpdateRetentionPolicyParams struct {
+	Name            string      `json:"name"`
+	DatasourceUUID  pgtype.UUID `json:"datasource_uuid"`
+	PipelineUuid    pgtype.UUID `json:"pipeline_uuid"`
+	KeepDays        int32       `json:"keep_days"`
+	KeepLast        int32       `json:"keep_last"`
+	AttachmentsDays int32       `json:"attachments_days"`
+	IsEnabled       bool        `json:"is_enabled"`
+	UUID            pgtype.UUID `json:"uuid"`
+}
+
+func (q *Queries) UpdateRetentionPolicy(ctx context.Context, arg UpdateRetentionPolicyParams) error {
+	_, err := q.db.Exec(ctx, updateRetentionPolicy,
+		arg.Name,
+		arg.DatasourceUUID,
+		arg.PipelineUuid,
+		arg.KeepDays,
+		arg.KeepLast,
+		arg.AttachmentsDays,
+		arg.IsEnabled,
+		arg.UUID,
+	)
+	return err
+}
+
+const updateRetentionPolicyLastRun = `-- name: UpdateRetentionPolicyLastRun :exec
+UPDATE retention_policy SET
+    last_run_at = NOW()
+WHERE uuid = $1::uuid
+`
+
+func (q *Queries) UpdateRetentionPolicyLastRun(ctx context.Context, argUuid pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, updateRetentionPolicyLastRun, argUuid)
+	return err
+}
@@ -24,6 +24,9 @@ INSERT INTO scheduler (
     last_run,
     is_enabled,
     is_paused,
+    interval_seconds,
+    jitter_seconds,
+    overlap_policy,
     created_at,
     updated_at
 ) VALUES (
@@ -37,22 +40,28 @@ INSERT INTO scheduler (
              $8,
              $9::boolean,
              $10::boolean,
+             $11,
+             $12,
+             $13,
              NOW(),
              NOW()
-         ) RETURNING uuid, pipeline_uuid, schedule_type, cron_expression, run_at, timezone, next_run, last_run, is_enabled, is_paused, created_at, updated_at
+         ) RETURNING uuid, pipeline_uuid, schedule_type, cron_expression, run_at, timezone, next_run, last_run, is_enabled, is_paused, created_at, updated_at, workspace_uuid, paused_reason, interval_seconds, jitter_seconds, overlap_policy
 `
 
 type CreateSchedulerParams struct {
-	UUID           pgtype.UUID        `json:"uuid"`
-	PipelineUuid   pgtype.UUID        `json:"pipeline_uuid"`
-	ScheduleType   string             `json:"schedule_type"`
-	CronExpression pgtype.Text        `json:"cron_expression"`
-	RunAt          pgtype.Timestamptz `json:"run_at"`
-	Timezone       string             `json:"timezone"`
-	NextRun        pgtype.Timestamptz `json:"next_run"`
-	LastRun        pgtype.Timestamptz `json:"last_run"`
-	IsEnabled      bool               `json:"is_enabled"`
-	IsPaused       bool               `json:"is_paused"`
+	UUID            pgtype.UUID        `json:"uuid"`
+	PipelineUuid    pgtype.UUID        `json:"pipeline_uuid"`
+	ScheduleType    string             `json:"schedule_type"`
+	CronExpression  pgtype.Text        `json:"cron_expression"`
+	RunAt           pgtype.Timestamptz `json:"run_at"`
+	Timezone        string             `json:"timezone"`
+	NextRun         pgtype.Timestamptz `json:"next_run"`
+	LastRun         pgtype.Timestamptz `json:"last_run"`
+	IsEnabled       bool               `json:"is_enabled"`
+	IsPaused        bool               `json:"is_paused"`
+	IntervalSeconds pgtype.Int4        `json:"interval_seconds"`
+	JitterSeconds   int32              `json:"jitter_seconds"`
+	OverlapPolicy   string             `json:"overlap_policy"`
 }
 
 func (q *Queries) CreateScheduler(ctx context.Context, arg CreateSchedulerParams) (Scheduler, error) {
@@ -67,6 +76,9 @@ func (q *Queries) CreateScheduler(ctx context.Context, arg CreateSchedulerParams
 		arg.LastRun,
 		arg.IsEnabled,
 		arg.IsPaused,
+		arg.IntervalSeconds,
+		arg.JitterSeconds,
+		arg.OverlapPolicy,
 	)
 	var i Scheduler
 	err := row.Scan(
@@ -82,6 +94,11 @@ func (q *Queries) CreateScheduler(ctx context.Context, arg CreateSchedulerParams
 		&i.IsPaused,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.WorkspaceUUID,
+		&i.PausedReason,
+		&i.IntervalSeconds,
+		&i.JitterSeconds,
+		&i.OverlapPolicy,
 	)
 	return i, err
 }
@@ -95,9 +112,80 @@ func (q *Queries) DeleteScheduler(ctx context.Context, argUuid pgtype.UUID) erro
 	return err
 }
 
+const getDueSchedulers = `-- name: GetDueSchedulers :many
+SELECT
+    s.uuid, s.pipeline_uuid, s.schedule_type, s.cron_expression, s.run_at, s.timezone, s.next_run, s.last_run, s.is_enabled, s.is_paused, s.created_at, s.updated_at, s.workspace_uuid, s.paused_reason, s.interval_seconds, s.jitter_seconds, s.overlap_policy,
+    d.uuid AS datasource_uuid,
+    d.type AS datasource_type
+FROM scheduler s
+         JOIN pipeline p ON p.uuid = s.pipeline_uuid
+         JOIN datasource d ON d.uuid = p.datasource_uuid
+WHERE s.is_enabled AND
+      NOT s.is_paused AND
+      p.is_enabled AND
+      (s.next_run IS NULL OR s.next_run <= $1::timestamptz)
+ORDER BY s.next_run NULLS FIRST
+LIMIT $2::int
+FOR UPDATE OF s SKIP LOCKED
+`
+
+type GetDueSchedulersParams struct {
+	Now   pgtype.Timestamptz `json:"now"`
+	Limit int32              `json:"limit"`
+}
+
+type GetDueSchedulersRow struct {
+	Scheduler      Scheduler `json:"scheduler"`
+	DatasourceUUID uuid.UUID `json:"datasource_uuid"`
+	DatasourceType string    `json:"datasource_type"`
+}
+
+// Enabled schedulers of enabled pipelines to run by now, and those without a
+// computed next run yet. The datasource type picks the fetch job. The rows stay
+// locked until the transaction ends, concurrent runs skip them.
+func (q *Queries) GetDueSchedulers(ctx context.Context, arg GetDueSchedulersParams) ([]GetDueSchedulersRow, error) {
+	rows, err := q.db.Query(ctx, getDueSchedulers, arg.Now, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDueSchedulersRow
+	for rows.Next() {
+		var i GetDueSchedulersRow
+		if err := rows.Scan(
+			&i.Scheduler.UUID,
+			&i.Scheduler.PipelineUuid,
+			&i.Scheduler.ScheduleType,
+			&i.Scheduler.CronExpression,
+			&i.Scheduler.RunAt,
+			&i.Scheduler.Timezone,
+			&i.Scheduler.NextRun,
+			&i.Scheduler.LastRun,
+			&i.Scheduler.IsEnabled,
+			&i.Scheduler.IsPaused,
+			&i.Scheduler.CreatedAt,
+			&i.Scheduler.UpdatedAt,
+			&i.Scheduler.WorkspaceUUID,
+			&i.Scheduler.PausedReason,
+			&i.Scheduler.IntervalSeconds,
+			&i.Scheduler.JitterSeconds,
+			&i.Scheduler.OverlapPolicy,
+			&i.DatasourceUUID,
+			&i.DatasourceType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getScheduler = `-- name: GetScheduler :one
 SELECT
-    scheduler.uuid, scheduler.pipeline_uuid, scheduler.schedule_type, scheduler.cron_expression, scheduler.run_at, scheduler.timezone, scheduler.next_run, scheduler.last_run, scheduler.is_enabled, scheduler.is_paused, scheduler.created_at, scheduler.updated_at
+    scheduler.uuid, scheduler.pipeline_uuid, scheduler.schedule_type, scheduler.cron_expression, scheduler.run_at, scheduler.timezone, scheduler.next_run, scheduler.last_run, scheduler.is_enabled, scheduler.is_paused, scheduler.created_at, scheduler.updated_at, scheduler.workspace_uuid, scheduler.paused_reason, scheduler.interval_seconds, scheduler.jitter_seconds, scheduler.overlap_policy
 FROM scheduler
 WHERE uuid = $1::uuid
 `
@@ -122,13 +210,18 @@ func (q *Queries) GetScheduler(ctx context.Context, argUuid pgtype.UUID) (GetSch
 		&i.Scheduler.IsPaused,
 		&i.Scheduler.CreatedAt,
 		&i.Scheduler.UpdatedAt,
+		&i.Scheduler.WorkspaceUUID,
+		&i.Scheduler.PausedReason,
+		&i.Scheduler.IntervalSeconds,
+		&i.Scheduler.JitterSeconds,
+		&i.Scheduler.OverlapPolicy,
 	)
 	return i, err
 }
 
 const getSchedulers = `-- name: GetSchedulers :many
 WITH filtered_schedulers AS (
-    SELECT s.uuid, s.pipeline_uuid, s.schedule_type, s.cron_expression, s.run_at, s.timezone, s.next_run, s.last_run, s.is_enabled, s.is_paused, s.created_at, s.updated_at
+    SELECT s.uuid, s.pipeline_uuid, s.schedule_type, s.cron_expression, s.run_at, s.timezone, s.next_run, s.last_run, s.is_enabled, s.is_paused, s.created_at, s.updated_at, s.workspace_uuid, s.paused_reason, s.interval_seconds, s.jitter_seconds, s.overlap_policy
     FROM scheduler s
     WHERE
         (NULLIF($5, '') IS NULL OR s.pipeline_uuid = $5::uuid) AND
@@ -136,7 +229,7 @@ WITH filtered_schedulers AS (
         (NULLIF($7::int, -1) IS NULL OR s.is_paused = $7::boolean)
 )
 SELECT
-    uuid, pipeline_uuid, schedule_type, cron_expression, run_at, timezone, next_run, last_run, is_enabled, is_paused, created_at, updated_at,
+    uuid, pipeline_uuid, schedule_type, cron_expression, run_at, timezone, next_run, last_run, is_enabled, is_paused, created_at, updated_at, workspace_uuid, paused_reason, interval_seconds, jitter_seconds, overlap_policy,
     (SELECT count(*) FROM filtered_schedulers) as total_count
 FROM filtered_schedulers
 ORDER BY
@@ -160,19 +253,24 @@ type GetSchedulersParams struct {
 }
 
 type GetSchedulersRow struct {
-	UUID           uuid.UUID          `json:"uuid"`
-	PipelineUuid   *uuid.UUID         `json:"pipeline_uuid"`
-	ScheduleType   string             `json:"schedule_type"`
-	CronExpression pgtype.Text        `json:"cron_expression"`
-	RunAt          pgtype.Timestamptz `json:"run_at"`
-	Timezone       string             `json:"timezone"`
-	NextRun        pgtype.Timestamptz `json:"next_run"`
-	LastRun        pgtype.Timestamptz `json:"last_run"`
-	IsEnabled      bool               `json:"is_enabled"`
-	IsPaused       bool               `json:"is_paused"`
-	CreatedAt      pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
-	TotalCount     int64              `json:"total_count"`
+	UUID            uuid.UUID          `json:"uuid"`
+	PipelineUuid    *uuid.UUID         `json:"pipeline_uuid"`
+	ScheduleType    string             `json:"schedule_type"`
+	CronExpression  pgtype.Text        `json:"cron_expression"`
+	RunAt           pgtype.Timestamptz `json:"run_at"`
+	Timezone        string             `json:"timezone"`
+	NextRun         pgtype.Timestamptz `json:"next_run"`
+	LastRun         pgtype.Timestamptz `json:"last_run"`
+	IsEnabled       bool               `json:"is_enabled"`
+	IsPaused        bool               `json:"is_paused"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	WorkspaceUUID   *uuid.UUID         `json:"workspace_uuid"`
+	PausedReason    string             `json:"paused_reason"`
+	IntervalSeconds pgtype.Int4        `json:"interval_seconds"`
+	JitterSeconds   int32              `json:"jitter_seconds"`
+	OverlapPolicy   string             `json:"overlap_policy"`
+	TotalCount      int64              `json:"total_count"`
 }
 
 func (q *Queries) GetSchedulers(ctx context.Context, arg GetSchedulersParams) ([]GetSchedulersRow, error) {
@@ -205,6 +303,11 @@ func (q *Queries) GetSchedulers(ctx context.Context, arg GetSchedulersParams) ([
 			&i.IsPaused,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.WorkspaceUUID,
+			&i.PausedReason,
+			&i.IntervalSeconds,
+			&i.JitterSeconds,
+			&i.OverlapPolicy,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -219,7 +322,7 @@ func (q *Queries) GetSchedulers(ctx context.Context, arg GetSchedulersParams) ([
 
 const listSchedulers = `-- name: ListSchedulers :many
 SELECT
-    scheduler.uuid, scheduler.pipeline_uuid, scheduler.schedule_type, scheduler.cron_expression, scheduler.run_at, scheduler.timezone, scheduler.next_run, scheduler.last_run, scheduler.is_enabled, scheduler.is_paused, scheduler.created_at, scheduler.updated_at
+    scheduler.uuid, scheduler.pipeline_uuid, scheduler.schedule_type, scheduler.cron_expression, scheduler.run_at, scheduler.timezone, scheduler.next_run, scheduler.last_run, scheduler.is_enabled, scheduler.is_paused, scheduler.created_at, scheduler.updated_at, scheduler.workspace_uuid, scheduler.paused_reason, scheduler.interval_seconds, scheduler.jitter_seconds, scheduler.overlap_policy
 FROM scheduler
 ORDER BY created_at DESC
 LIMIT NULLIF($2::int, 0)
@@ -257,6 +360,11 @@ func (q *Queries) ListSchedulers(ctx context.Context, arg ListSchedulersParams)
 			&i.Scheduler.IsPaused,
 			&i.Scheduler.CreatedAt,
 			&i.Scheduler.UpdatedAt,
+			&i.Scheduler.WorkspaceUUID,
+			&i.Scheduler.PausedReason,
+			&i.Scheduler.IntervalSeconds,
+			&i.Scheduler.JitterSeconds,
+			&i.Scheduler.OverlapPolicy,
 		); err != nil {
 			return nil, err
 		}
@@ -268,32 +376,114 @@ func (q *Queries) ListSchedulers(ctx context.Context, arg ListSchedulersParams)
 	return items, nil
 }
 
+const pauseDatasourceSchedulers = `-- name: PauseDatasourceSchedulers :exec
+UPDATE scheduler s SET
+    is_paused = TRUE,
+    paused_reason = $1,
+    updated_at = NOW()
+FROM pipeline p
+WHERE p.uuid = s.pipeline_uuid AND
+      p.datasource_uuid = $2::uuid AND
+      NOT s.is_paused
+`
+
+type PauseDatasourceSchedulersParams struct {
+	Reason         string      `json:"reason"`
+	DatasourceUUID pgtype.UUID `json:"datasource_uuid"`
+}
+
+func (q *Queries) PauseDatasourceSchedulers(ctx context.Context, arg PauseDatasourceSchedulersParams) error {
+	_, err := q.db.Exec(ctx, pauseDatasourceSchedulers, arg.Reason, arg.DatasourceUUID)
+	return err
+}
+
+const resumeDatasourceSchedulers = `-- name: ResumeDatasourceSchedulers :exec
+UPDATE scheduler s SET
+    is_paused = FALSE,
+    paused_reason = '',
+    updated_at = NOW()
+FROM pipeline p
+WHERE p.uuid = s.pipeline_uuid AND
+      p.datasource_uuid = $1::uuid AND
+      s.is_paused AND
+      s.paused_reason = $2
+`
+
+type ResumeDatasourceSchedulersParams struct {
+	DatasourceUUID pgtype.UUID `json:"datasource_uuid"`
+	Reason         string      `json:"reason"`
+}
+
+func (q *Queries) ResumeDatasourceSchedulers(ctx context.Context, arg ResumeDatasourceSchedulersParams) error {
+	_, err := q.db.Exec(ctx, resumeDatasourceSchedulers, arg.DatasourceUUID, arg.Reason)
+	return err
+}
+
+const setSchedulerRun = `-- name: SetSchedulerRun :exec
+UPDATE scheduler SET
+    last_run = COALESCE($1, last_run),
+    next_run = $2,
+    is_enabled = $3::boolean,
+    updated_at = NOW()
+WHERE uuid = $4::uuid
+`
+
+type SetSchedulerRunParams struct {
+	LastRun   pgtype.Timestamptz `json:"last_run"`
+	NextRun   pgtype.Timestamptz `json:"next_run"`
+	IsEnabled bool               `json:"is_enabled"`
+	UUID      pgtype.UUID        `json:"uuid"`
+}
+
+// Stores the run times computed by the scheduler service, one_time schedulers
+// are disabled after their run.
+func (q *Queries) SetSchedulerRun(ctx context.Context, arg SetSchedulerRunParams) error {
+	_, err := q.db.Exec(ctx, setSchedulerRun,
+		arg.LastRun,
+		arg.NextRun,
+		arg.IsEnabled,
+		arg.UUID,
+	)
+	return err
+}
+
 const updateScheduler = `-- name: UpdateScheduler :exec
 UPDATE scheduler SET
-                     cron_expression = $1,
-                     run_at = $2,
-                     timezone = $3,
-                     next_run = $4,
-                     last_run = $5,
-                     is_enabled = $6::boolean,
-                     is_paused = $7::boolean,
+                     schedule_type = $1,
+                     cron_expression = $2,
+                     run_at = $3,
+                     timezone = $4,
+                     next_run = $5,
+                     last_run = $6,
+                     is_enabled = $7::boolean,
+                     is_paused = $8::boolean,
+                     interval_seconds = $9,
+                     jitter_seconds = $10,
+                     overlap_policy = $11,
+                     -- resuming a scheduler drops the reason it was paused for
+                     paused_reason = CASE WHEN $8::boolean THEN paused_reason ELSE '' END,
                      updated_at = NOW()
-WHERE uuid = $8::uuid
+WHERE uuid = $12::uuid
 `
 
 type UpdateSchedulerParams struct {
-	CronExpression pgtype.Text        `json:"cron_expression"`
-	RunAt          pgtype.Timestamptz `json:"run_at"`
-	Timezone       string             `json:"timezone"`
-	NextRun        pgtype.Timestamptz `json:"next_run"`
-	LastRun        pgtype.Timestamptz `json:"last_run"`
-	IsEnabled      bool               `json:"is_enabled"`
-	IsPaused       bool               `json:"is_paused"`
-	UUID           pgtype.UUID        `json:"uuid"`
+	ScheduleType    string             `json:"schedule_type"`
+	CronExpression  pgtype.Text        `json:"cron_expression"`
+	RunAt           pgtype.Timestamptz `json:"run_at"`
+	Timezone        string             `json:"timezone"`
+	NextRun         pgtype.Timestamptz `json:"next_run"`
+	LastRun         pgtype.Timestamptz `json:"last_run"`
+	IsEnabled       bool               `json:"is_enabled"`
+	IsPaused        bool               `json:"is_paused"`
+	IntervalSeconds pgtype.Int4        `json:"interval_seconds"`
+	JitterSeconds   int32              `json:"jitter_seconds"`
+	OverlapPolicy   string             `json:"overlap_policy"`
+	UUID            pgtype.UUID        `json:"uuid"`
 }
 
 func (q *Queries) UpdateScheduler(ctx context.Context, arg UpdateSchedulerParams) error {
 	_, err := q.db.Exec(ctx, updateScheduler,
+		arg.ScheduleType,
 		arg.CronExpression,
 		arg.RunAt,
 		arg.Timezone,
@@ -301,6 +491,9 @@ func (q *Queries) UpdateScheduler(ctx context.Context, arg UpdateSchedulerParams
 		arg.LastRun,
 		arg.IsEnabled,
 		arg.IsPaused,
+		arg.IntervalSeconds,
+		arg.JitterSeconds,
+		arg.OverlapPolicy,
 		arg.UUID,
 	)
 	return err
@@ -0,0 +1,254 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: secrets.sql
+
+package query
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getDatasourceSecrets = `-- name: GetDatasourceSecrets :many
+SELECT uuid, type, settings
+FROM datasource
+WHERE type = ANY($1::text[])
+ORDER BY uuid
+`
+
+type GetDatasourceSecretsRow struct {
+	UUID     uuid.UUID `json:"uuid"`
+	Type     string    `json:"type"`
+	Settings []byte    `json:"settings"`
+}
+
+func (q *Queries) GetDatasourceSecrets(ctx context.Context, types []string) ([]GetDatasourceSecretsRow, error) {
+	rows, err := q.db.Query(ctx, getDatasourceSecrets, types)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDatasourceSecretsRow
+	for rows.Next() {
+		var i GetDatasourceSecretsRow
+		if err := rows.Scan(&i.UUID, &i.Type, &i.Settings); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOauth2ClientSecrets = `-- name: GetOauth2ClientSecrets :many
+SELECT uuid, secret
+FROM oauth2_client
+ORDER BY uuid
+`
+
+type GetOauth2ClientSecretsRow struct {
+	UUID   uuid.UUID `json:"uuid"`
+	Secret string    `json:"secret"`
+}
+
+func (q *Queries) GetOauth2ClientSecrets(ctx context.Context) ([]GetOauth2ClientSecretsRow, error) {
+	rows, err := q.db.Query(ctx, getOauth2ClientSecrets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetOauth2ClientSecretsRow
+	for rows.Next() {
+		var i GetOauth2ClientSecretsRow
+		if err := rows.Scan(&i.UUID, &i.Secret); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOauth2TokenSecrets = `-- name: GetOauth2TokenSecrets :many
+SELECT uuid, token
+FROM oauth2_token
+WHERE token IS NOT NULL
+ORDER BY uuid
+`
+
+type GetOauth2TokenSecretsRow struct {
+	UUID  uuid.UUID `json:"uuid"`
+	Token []byte    `json:"token"`
+}
+
+func (q *Queries) GetOauth2TokenSecrets(ctx context.Context) ([]GetOauth2TokenSecretsRow, error) {
+	rows, err := q.db.Query(ctx, getOauth2TokenSecrets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetOauth2TokenSecretsRow
+	for rows.Next() {
+		var i GetOauth2TokenSecretsRow
+		if err := rows.Scan(&i.UUID, &i.Token); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStorageSecrets = `-- name: GetStorageSecrets :many
+SELECT uuid, type, settings
+FROM storage
+WHERE type = ANY($1::text[])
+ORDER BY uuid
+`
+
+type GetStorageSecretsRow struct {
+	UUID     uuid.UUID `json:"uuid"`
+	Type     string    `json:"type"`
+	Settings []byte    `json:"settings"`
+}
+
+func (q *Queries) GetStorageSecrets(ctx context.Context, types []string) ([]GetStorageSecretsRow, error) {
+	rows, err := q.db.Query(ctx, getStorageSecrets, types)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetStorageSecretsRow
+	for rows.Next() {
+		var i GetStorageSecretsRow
+		if err := rows.Scan(&i.UUID, &i.Type, &i.Settings); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWebhookSecrets = `-- name: GetWebhookSecrets :many
+SELECT uuid, secret
+FROM webhook
+WHERE secret <> ''
+ORDER BY uuid
+`
+
+type GetWebhookSecretsRow struct {
+	UUID   uuid.UUID `json:"uuid"`
+	Secret string    `json:"secret"`
+}
+
+func (q *Queries) GetWebhookSecrets(ctx context.Context) ([]GetWebhookSecretsRow, error) {
+	rows, err := q.db.Query(ctx, getWebhookSecrets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetWebhookSecretsRow
+	for rows.Next() {
+		var i GetWebhookSecretsRow
+		if err := rows.Scan(&i.UUID, &i.Secret); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setDatasourceSettings = `-- name: SetDatasourceSettings :exec
+UPDATE datasource SET
+    settings = $1
+WHERE uuid = $2::uuid
+`
+
+type SetDatasourceSettingsParams struct {
+	Settings []byte      `json:"settings"`
+	UUID     pgtype.UUID `json:"uuid"`
+}
+
+func (q *Queries) SetDatasourceSettings(ctx context.Context, arg SetDatasourceSettingsParams) error {
+	_, err := q.db.Exec(ctx, setDatasourceSettings, arg.Settings, arg.UUID)
+	return err
+}
+
+const setOauth2ClientSecret = `-- name: SetOauth2ClientSecret :exec
+UPDATE oauth2_client SET
+    secret = $1
+WHERE uuid = $2::uuid
+`
+
+type SetOauth2ClientSecretParams struct {
+	Secret string      `json:"secret"`
+	UUID   pgtype.UUID `json:"uuid"`
+}
+
+func (q *Queries) SetOauth2ClientSecret(ctx context.Context, arg SetOauth2ClientSecretParams) error {
+	_, err := q.db.Exec(ctx, setOauth2ClientSecret, arg.Secret, arg.UUID)
+	return err
+}
+
+const setOauth2TokenSecret = `-- name: SetOauth2TokenSecret :exec
+UPDATE oauth2_token SET
+    token = $1
+WHERE uuid = $2::uuid
+`
+
+type SetOauth2TokenSecretParams struct {
+	Token []byte      `json:"token"`
+	UUID  pgtype.UUID `json:"uuid"`
+}
+
+func (q *Queries) SetOauth2TokenSecret(ctx context.Context, arg SetOauth2TokenSecretParams) error {
+	_, err := q.db.Exec(ctx, setOauth2TokenSecret, arg.Token, arg.UUID)
+	return err
+}
+
+const setStorageSettings = `-- name: SetStorageSettings :exec
+UPDATE storage SET
+    settings = $1
+WHERE uuid = $2::uuid
+`
+
+type SetStorageSettingsParams struct {
+	Settings []byte      `json:"settings"`
+	UUID     pgtype.UUID `json:"uuid"`
+}
+
+func (q *Queries) SetStorageSettings(ctx context.Context, arg SetStorageSettingsParams) error {
+	_, err := q.db.Exec(ctx, setStorageSettings, arg.Settings, arg.UUID)
+	return err
+}
+
+const setWebhookSecret = `-- name: SetWebhookSecret :exec
+UPDATE webhook SET
+    secret = $1
+WHERE uuid = $2::uuid
+`
+
+type SetWebhookSecretParams struct {
+	Secret string      `json:"secret"`
+	UUID   pgtype.UUID `json:"uuid"`
+}
+
+func (q *Queries) SetWebhookSecret(ctx context.Context, arg SetWebhookSecretParams) error {
+	_, err := q.db.Exec(ctx, setWebhookSecret, arg.Secret, arg.UUID)
+	return err
+}
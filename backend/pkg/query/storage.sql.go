@@ -29,7 +29,7 @@ INSERT INTO storage (
   $5,
   NOW(),
   NOW()
-) RETURNING uuid, name, type, is_enabled, settings, created_at, updated_at
+) RETURNING uuid, name, type, is_enabled, settings, created_at, updated_at, workspace_uuid
 `
 
 type CreateStorageParams struct {
@@ -57,6 +57,7 @@ func (q *Queries) CreateStorage(ctx context.Context, arg CreateStorageParams) (S
 		&i.Settings,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.WorkspaceUUID,
 	)
 	return i, err
 }
@@ -72,7 +73,7 @@ func (q *Queries) DeleteStorage(ctx context.Context, argUuid pgtype.UUID) error
 
 const getStorage = `-- name: GetStorage :one
 SELECT
-    storage.uuid, storage.name, storage.type, storage.is_enabled, storage.settings, storage.created_at, storage.updated_at
+    storage.uuid, storage.name, storage.type, storage.is_enabled, storage.settings, storage.created_at, storage.updated_at, storage.workspace_uuid
 FROM storage
 WHERE uuid = $1::uuid
 `
@@ -92,13 +93,14 @@ func (q *Queries) GetStorage(ctx context.Context, argUuid pgtype.UUID) (GetStora
 		&i.Storage.Settings,
 		&i.Storage.CreatedAt,
 		&i.Storage.UpdatedAt,
+		&i.Storage.WorkspaceUUID,
 	)
 	return i, err
 }
 
 const getStorages = `-- name: GetStorages :many
 WITH filtered_storages AS (
-    SELECT d.uuid, d.name, d.type, d.is_enabled, d.settings, d.created_at, d.updated_at
+    SELECT d.uuid, d.name, d.type, d.is_enabled, d.settings, d.created_at, d.updated_at, d.workspace_uuid
     FROM storage d
     WHERE
         (NULLIF($5, '') IS NULL OR d."type" = $5)
@@ -107,7 +109,7 @@ WITH filtered_storages AS (
       AND (NULLIF($8, '') IS NULL OR d.name ILIKE $8)
 )
 SELECT
-    uuid, name, type, is_enabled, settings, created_at, updated_at,
+    uuid, name, type, is_enabled, settings, created_at, updated_at, workspace_uuid,
     (SELECT count(*) FROM filtered_storages) AS total_count
 FROM filtered_storages
 ORDER BY
@@ -130,14 +132,15 @@ type GetStoragesParams struct {
 }
 
 type GetStoragesRow struct {
-	UUID       uuid.UUID          `json:"uuid"`
-	Name       string             `json:"name"`
-	Type       string             `json:"type"`
-	IsEnabled  bool               `json:"is_enabled"`
-	Settings   []byte             `json:"settings"`
-	CreatedAt  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
-	TotalCount int64              `json:"total_count"`
+	UUID          uuid.UUID          `json:"uuid"`
+	Name          string             `json:"name"`
+	Type          string             `json:"type"`
+	IsEnabled     bool               `json:"is_enabled"`
+	Settings      []byte             `json:"settings"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
+	TotalCount    int64              `json:"total_count"`
 }
 
 func (q *Queries) GetStorages(ctx context.Context, arg GetStoragesParams) ([]GetStoragesRow, error) {
@@ -166,6 +169,7 @@ func (q *Queries) GetStorages(ctx context.Context, arg GetStoragesParams) ([]Get
 			&i.Settings,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.WorkspaceUUID,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -180,7 +184,7 @@ func (q *Queries) GetStorages(ctx context.Context, arg GetStoragesParams) ([]Get
 
 const listStorages = `-- name: ListStorages :many
 SELECT
-    storage.uuid, storage.name, storage.type, storage.is_enabled, storage.settings, storage.created_at, storage.updated_at
+    storage.uuid, storage.name, storage.type, storage.is_enabled, storage.settings, storage.created_at, storage.updated_at, storage.workspace_uuid
 FROM storage
 ORDER BY created_at DESC
 LIMIT NULLIF($2::int, 0)
@@ -213,6 +217,7 @@ func (q *Queries) ListStorages(ctx context.Context, arg ListStoragesParams) ([]L
 			&i.Storage.Settings,
 			&i.Storage.CreatedAt,
 			&i.Storage.UpdatedAt,
+			&i.Storage.WorkspaceUUID,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,303 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: storage_migration.sql
+
+package query
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimStorageMigration = `-- name: ClaimStorageMigration :one
+UPDATE storage_migration SET
+    status = 'running',
+    error = NULL,
+    updated_at = NOW()
+WHERE uuid = $1::uuid
+  AND status <> 'done'
+  AND (status <> 'running' OR updated_at < NOW() - make_interval(secs => $2::int))
+RETURNING uuid, source_storage_uuid, target_storage_uuid, status, older_than_days, delete_source, batch_size, checkpoint_uuid, files_migrated, bytes_migrated, error, created_at, updated_at, finished_at, workspace_uuid, message_checkpoint_uuid, messages_migrated
+`
+
+type ClaimStorageMigrationParams struct {
+	UUID         pgtype.UUID `json:"uuid"`
+	LeaseSeconds int32       `json:"lease_seconds"`
+}
+
+// Marks the migration running unless another run holds it: a running migration
+// is only taken over once its heartbeat is older than the lease.
+func (q *Queries) ClaimStorageMigration(ctx context.Context, arg ClaimStorageMigrationParams) (StorageMigration, error) {
+	row := q.db.QueryRow(ctx, claimStorageMigration, arg.UUID, arg.LeaseSeconds)
+	var i StorageMigration
+	err := row.Scan(
+		&i.UUID,
+		&i.SourceStorageUuid,
+		&i.TargetStorageUuid,
+		&i.Status,
+		&i.OlderThanDays,
+		&i.DeleteSource,
+		&i.BatchSize,
+		&i.CheckpointUuid,
+		&i.FilesMigrated,
+		&i.BytesMigrated,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FinishedAt,
+		&i.WorkspaceUUID,
+		&i.MessageCheckpointUuid,
+		&i.MessagesMigrated,
+	)
+	return i, err
+}
+
+const createStorageMigration = `-- name: CreateStorageMigration :one
+INSERT INTO storage_migration (
+    uuid,
+    source_storage_uuid,
+    target_storage_uuid,
+    status,
+    older_than_days,
+    delete_source,
+    batch_size,
+    created_at,
+    updated_at
+) VALUES (
+    $1::uuid,
+    $2::uuid,
+    $3::uuid,
+    $4,
+    $5::int,
+    $6::boolean,
+    $7::int,
+    NOW(),
+    NOW()
+) RETURNING uuid, source_storage_uuid, target_storage_uuid, status, older_than_days, delete_source, batch_size, checkpoint_uuid, files_migrated, bytes_migrated, error, created_at, updated_at, finished_at, workspace_uuid, message_checkpoint_uuid, messages_migrated
+`
+
+type CreateStorageMigrationParams struct {
+	UUID              pgtype.UUID `json:"uuid"`
+	SourceStorageUuid pgtype.UUID `json:"source_storage_uuid"`
+	TargetStorageUuid pgtype.UUID `json:"target_storage_uuid"`
+	Status            string      `json:"status"`
+	OlderThanDays     int32       `json:"older_than_days"`
+	DeleteSource      bool        `json:"delete_source"`
+	BatchSize         int32       `json:"batch_size"`
+}
+
+func (q *Queries) CreateStorageMigration(ctx context.Context, arg CreateStorageMigrationParams) (StorageMigration, error) {
+	row := q.db.QueryRow(ctx, createStorageMigration,
+		arg.UUID,
+		arg.SourceStorageUuid,
+		arg.TargetStorageUuid,
+		arg.Status,
+		arg.OlderThanDays,
+		arg.DeleteSource,
+		arg.BatchSize,
+	)
+	var i StorageMigration
+	err := row.Scan(
+		&i.UUID,
+		&i.SourceStorageUuid,
+		&i.TargetStorageUuid,
+		&i.Status,
+		&i.OlderThanDays,
+		&i.DeleteSource,
+		&i.BatchSize,
+		&i.CheckpointUuid,
+		&i.FilesMigrated,
+		&i.BytesMigrated,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FinishedAt,
+		&i.WorkspaceUUID,
+		&i.MessageCheckpointUuid,
+		&i.MessagesMigrated,
+	)
+	return i, err
+}
+
+const getStorageMigration = `-- name: GetStorageMigration :one
+SELECT
+    storage_migration.uuid, storage_migration.source_storage_uuid, storage_migration.target_storage_uuid, storage_migration.status, storage_migration.older_than_days, storage_migration.delete_source, storage_migration.batch_size, storage_migration.checkpoint_uuid, storage_migration.files_migrated, storage_migration.bytes_migrated, storage_migration.error, storage_migration.created_at, storage_migration.updated_at, storage_migration.finished_at, storage_migration.workspace_uuid, storage_migration.message_checkpoint_uuid, storage_migration.messages_migrated
+FROM storage_migration
+WHERE uuid = $1::uuid
+`
+
+type GetStorageMigrationRow struct {
+	StorageMigration StorageMigration `json:"storage_migration"`
+}
+
+func (q *Queries) GetStorageMigration(ctx context.Context, uuid pgtype.UUID) (GetStorageMigrationRow, error) {
+	row := q.db.QueryRow(ctx, getStorageMigration, uuid)
+	var i GetStorageMigrationRow
+	err := row.Scan(
+		&i.StorageMigration.UUID,
+		&i.StorageMigration.SourceStorageUuid,
+		&i.StorageMigration.TargetStorageUuid,
+		&i.StorageMigration.Status,
+		&i.StorageMigration.OlderThanDays,
+		&i.StorageMigration.DeleteSource,
+		&i.StorageMigration.BatchSize,
+		&i.StorageMigration.CheckpointUuid,
+		&i.StorageMigration.FilesMigrated,
+		&i.StorageMigration.BytesMigrated,
+		&i.StorageMigration.Error,
+		&i.StorageMigration.CreatedAt,
+		&i.StorageMigration.UpdatedAt,
+		&i.StorageMigration.FinishedAt,
+		&i.StorageMigration.WorkspaceUUID,
+		&i.StorageMigration.MessageCheckpointUuid,
+		&i.StorageMigration.MessagesMigrated,
+	)
+	return i, err
+}
+
+const getStorageMigrations = `-- name: GetStorageMigrations :many
+SELECT
+    storage_migration.uuid, storage_migration.source_storage_uuid, storage_migration.target_storage_uuid, storage_migration.status, storage_migration.older_than_days, storage_migration.delete_source, storage_migration.batch_size, storage_migration.checkpoint_uuid, storage_migration.files_migrated, storage_migration.bytes_migrated, storage_migration.error, storage_migration.created_at, storage_migration.updated_at, storage_migration.finished_at, storage_migration.workspace_uuid, storage_migration.message_checkpoint_uuid, storage_migration.messages_migrated
+FROM storage_migration
+WHERE
+    ($1::uuid IS NULL OR source_storage_uuid = $1::uuid) AND
+    (NULLIF($2, '') IS NULL OR status = $2)
+ORDER BY created_at DESC
+LIMIT NULLIF($4::int, 0)
+    OFFSET $3::int
+`
+
+type GetStorageMigrationsParams struct {
+	SourceStorageUuid pgtype.UUID `json:"source_storage_uuid"`
+	Status            interface{} `json:"status"`
+	Offset            int32       `json:"offset"`
+	Limit             int32       `json:"limit"`
+}
+
+type GetStorageMigrationsRow struct {
+	StorageMigration StorageMigration `json:"storage_migration"`
+}
+
+func (q *Queries) GetStorageMigrations(ctx context.Context, arg GetStorageMigrationsParams) ([]GetStorageMigrationsRow, error) {
+	rows, err := q.db.Query(ctx, getStorageMigrations,
+		arg.SourceStorageUuid,
+		arg.Status,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetStorageMigrationsRow
+	for rows.Next() {
+		var i GetStorageMigrationsRow
+		if err := rows.Scan(
+			&i.StorageMigration.UUID,
+			&i.StorageMigration.SourceStorageUuid,
+			&i.StorageMigration.TargetStorageUuid,
+			&i.StorageMigration.Status,
+			&i.StorageMigration.OlderThanDays,
+			&i.StorageMigration.DeleteSource,
+			&i.StorageMigration.BatchSize,
+			&i.StorageMigration.CheckpointUuid,
+			&i.StorageMigration.FilesMigrated,
+			&i.StorageMigration.BytesMigrated,
+			&i.StorageMigration.Error,
+			&i.StorageMigration.CreatedAt,
+			&i.StorageMigration.UpdatedAt,
+			&i.StorageMigration.FinishedAt,
+			&i.StorageMigration.WorkspaceUUID,
+			&i.StorageMigration.MessageCheckpointUuid,
+			&i.StorageMigration.MessagesMigrated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const touchStorageMigration = `-- name: TouchStorageMigration :execrows
+UPDATE storage_migration SET updated_at = NOW()
+WHERE uuid = $1::uuid AND status = 'running'
+`
+
+func (q *Queries) TouchStorageMigration(ctx context.Context, uuid pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, touchStorageMigration, uuid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateStorageMigrationCheckpoint = `-- name: UpdateStorageMigrationCheckpoint :exec
+UPDATE storage_migration SET
+    checkpoint_uuid = $1::uuid,
+    files_migrated = files_migrated + 1,
+    bytes_migrated = bytes_migrated + $2::bigint,
+    updated_at = NOW()
+WHERE uuid = $3::uuid
+`
+
+type UpdateStorageMigrationCheckpointParams struct {
+	CheckpointUuid pgtype.UUID `json:"checkpoint_uuid"`
+	Bytes          int64       `json:"bytes"`
+	UUID           pgtype.UUID `json:"uuid"`
+}
+
+func (q *Queries) UpdateStorageMigrationCheckpoint(ctx context.Context, arg UpdateStorageMigrationCheckpointParams) error {
+	_, err := q.db.Exec(ctx, updateStorageMigrationCheckpoint, arg.CheckpointUuid, arg.Bytes, arg.UUID)
+	return err
+}
+
+const updateStorageMigrationMessageCheckpoint = `-- name: UpdateStorageMigrationMessageCheckpoint :exec
+UPDATE storage_migration SET
+    message_checkpoint_uuid = $1::uuid,
+    messages_migrated = messages_migrated + 1,
+    bytes_migrated = bytes_migrated + $2::bigint,
+    updated_at = NOW()
+WHERE uuid = $3::uuid
+`
+
+type UpdateStorageMigrationMessageCheckpointParams struct {
+	CheckpointUuid pgtype.UUID `json:"checkpoint_uuid"`
+	Bytes          int64       `json:"bytes"`
+	UUID           pgtype.UUID `json:"uuid"`
+}
+
+func (q *Queries) UpdateStorageMigrationMessageCheckpoint(ctx context.Context, arg UpdateStorageMigrationMessageCheckpointParams) error {
+	_, err := q.db.Exec(ctx, updateStorageMigrationMessageCheckpoint, arg.CheckpointUuid, arg.Bytes, arg.UUID)
+	return err
+}
+
+const updateStorageMigrationStatus = `-- name: UpdateStorageMigrationStatus :exec
+UPDATE storage_migration SET
+    status = $1,
+    error = $2,
+    finished_at = $3,
+    updated_at = NOW()
+WHERE uuid = $4::uuid
+`
+
+type UpdateStorageMigrationStatusParams struct {
+	Status     string             `json:"status"`
+	Error      pgtype.Text        `json:"error"`
+	FinishedAt pgtype.Timestamptz `json:"finished_at"`
+	UUID       pgtype.UUID        `json:"uuid"`
+}
+
+func (q *Queries) UpdateStorageMigrationStatus(ctx context.Context, arg UpdateStorageMigrationStatusParams) error {
+	_, err := q.db.Exec(ctx, updateStorageMigrationStatus,
+		arg.Status,
+		arg.Error,
+		arg.FinishedAt,
+		arg.UUID,
+	)
+	return err
+}
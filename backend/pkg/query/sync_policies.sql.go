@@ -35,7 +35,7 @@ INSERT INTO sync_policy (
     $8,
              NOW(),
              NOW()
-         ) RETURNING uuid, pipeline_uuid, name, type, blocklist, exclude_list, sync_all, is_enabled, settings, created_at, updated_at
+         ) RETURNING uuid, pipeline_uuid, name, type, blocklist, exclude_list, sync_all, is_enabled, settings, created_at, updated_at, workspace_uuid
 `
 
 type CreateSyncPolicyParams struct {
@@ -73,6 +73,7 @@ func (q *Queries) CreateSyncPolicy(ctx context.Context, arg CreateSyncPolicyPara
 		&i.Settings,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.WorkspaceUUID,
 	)
 	return i, err
 }
@@ -88,7 +89,7 @@ func (q *Queries) DeleteSyncPolicy(ctx context.Context, argUuid pgtype.UUID) err
 
 const getPolicy = `-- name: GetPolicy :one
 SELECT
-    sync_policy.uuid, sync_policy.pipeline_uuid, sync_policy.name, sync_policy.type, sync_policy.blocklist, sync_policy.exclude_list, sync_policy.sync_all, sync_policy.is_enabled, sync_policy.settings, sync_policy.created_at, sync_policy.updated_at
+    sync_policy.uuid, sync_policy.pipeline_uuid, sync_policy.name, sync_policy.type, sync_policy.blocklist, sync_policy.exclude_list, sync_policy.sync_all, sync_policy.is_enabled, sync_policy.settings, sync_policy.created_at, sync_policy.updated_at, sync_policy.workspace_uuid
 FROM sync_policy
 WHERE uuid = $1::uuid
 `
@@ -112,13 +113,14 @@ func (q *Queries) GetPolicy(ctx context.Context, argUuid pgtype.UUID) (GetPolicy
 		&i.SyncPolicy.Settings,
 		&i.SyncPolicy.CreatedAt,
 		&i.SyncPolicy.UpdatedAt,
+		&i.SyncPolicy.WorkspaceUUID,
 	)
 	return i, err
 }
 
 const getSyncPolicies = `-- name: GetSyncPolicies :many
 WITH filtered_sync_policies AS (
-    SELECT sp.uuid, sp.pipeline_uuid, sp.name, sp.type, sp.blocklist, sp.exclude_list, sp.sync_all, sp.is_enabled, sp.settings, sp.created_at, sp.updated_at
+    SELECT sp.uuid, sp.pipeline_uuid, sp.name, sp.type, sp.blocklist, sp.exclude_list, sp.sync_all, sp.is_enabled, sp.settings, sp.created_at, sp.updated_at, sp.workspace_uuid
     FROM sync_policy sp
     WHERE
         (NULLIF($5, '') IS NULL OR sp."type" = $5) AND
@@ -126,7 +128,7 @@ WITH filtered_sync_policies AS (
         (NULLIF($7::int, -1) IS NULL OR sp.sync_all = ($7::int)::boolean)
 )
 SELECT
-    uuid, pipeline_uuid, name, type, blocklist, exclude_list, sync_all, is_enabled, settings, created_at, updated_at,
+    uuid, pipeline_uuid, name, type, blocklist, exclude_list, sync_all, is_enabled, settings, created_at, updated_at, workspace_uuid,
     (SELECT count(*) FROM filtered_sync_policies) as total_count
 FROM filtered_sync_policies
 ORDER BY
@@ -150,18 +152,19 @@ type GetSyncPoliciesParams struct {
 }
 
 type GetSyncPoliciesRow struct {
-	UUID         uuid.UUID          `json:"uuid"`
-	PipelineUuid *uuid.UUID         `json:"pipeline_uuid"`
-	Name         string             `json:"name"`
-	Type         string             `json:"type"`
-	Blocklist    []string           `json:"blocklist"`
-	ExcludeList  []string           `json:"exclude_list"`
-	SyncAll      bool               `json:"sync_all"`
-	IsEnabled    bool               `json:"is_enabled"`
-	Settings     []byte             `json:"settings"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
-	TotalCount   int64              `json:"total_count"`
+	UUID          uuid.UUID          `json:"uuid"`
+	PipelineUuid  *uuid.UUID         `json:"pipeline_uuid"`
+	Name          string             `json:"name"`
+	Type          string             `json:"type"`
+	Blocklist     []string           `json:"blocklist"`
+	ExcludeList   []string           `json:"exclude_list"`
+	SyncAll       bool               `json:"sync_all"`
+	IsEnabled     bool               `json:"is_enabled"`
+	Settings      []byte             `json:"settings"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
+	TotalCount    int64              `json:"total_count"`
 }
 
 func (q *Queries) GetSyncPolicies(ctx context.Context, arg GetSyncPoliciesParams) ([]GetSyncPoliciesRow, error) {
@@ -193,6 +196,7 @@ func (q *Queries) GetSyncPolicies(ctx context.Context, arg GetSyncPoliciesParams
 			&i.Settings,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.WorkspaceUUID,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -207,7 +211,7 @@ func (q *Queries) GetSyncPolicies(ctx context.Context, arg GetSyncPoliciesParams
 
 const listSyncPolicies = `-- name: ListSyncPolicies :many
 SELECT
-    sync_policy.uuid, sync_policy.pipeline_uuid, sync_policy.name, sync_policy.type, sync_policy.blocklist, sync_policy.exclude_list, sync_policy.sync_all, sync_policy.is_enabled, sync_policy.settings, sync_policy.created_at, sync_policy.updated_at
+    sync_policy.uuid, sync_policy.pipeline_uuid, sync_policy.name, sync_policy.type, sync_policy.blocklist, sync_policy.exclude_list, sync_policy.sync_all, sync_policy.is_enabled, sync_policy.settings, sync_policy.created_at, sync_policy.updated_at, sync_policy.workspace_uuid
 FROM sync_policy
 ORDER BY created_at DESC
 LIMIT NULLIF($2::int, 0)
@@ -244,6 +248,7 @@ func (q *Queries) ListSyncPolicies(ctx context.Context, arg ListSyncPoliciesPara
 			&i.SyncPolicy.Settings,
 			&i.SyncPolicy.CreatedAt,
 			&i.SyncPolicy.UpdatedAt,
+			&i.SyncPolicy.WorkspaceUUID,
 		); err != nil {
 			return nil, err
 		}
@@ -102,6 +102,39 @@ func (q *Queries) TgGetSessionList(ctx context.Context, accountID int64) ([]TgSe
 	return items, nil
 }
 
+const tgListSessions = `-- name: TgListSessions :many
+SELECT id, phone, account_id, session, contacts_hash, description, created_at, updated_at FROM tg_sessions ORDER BY id
+`
+
+func (q *Queries) TgListSessions(ctx context.Context) ([]TgSession, error) {
+	rows, err := q.db.Query(ctx, tgListSessions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TgSession
+	for rows.Next() {
+		var i TgSession
+		if err := rows.Scan(
+			&i.ID,
+			&i.Phone,
+			&i.AccountID,
+			&i.Session,
+			&i.ContactsHash,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const tgUpdateSession = `-- name: TgUpdateSession :exec
 UPDATE tg_sessions
 SET session = COALESCE(session, $1),
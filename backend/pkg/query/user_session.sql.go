@@ -0,0 +1,229 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: user_session.sql
+
+package query
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createUserSession = `-- name: CreateUserSession :one
+INSERT INTO user_session (
+    uuid,
+    user_uuid,
+    token_hash,
+    source,
+    user_agent,
+    ip,
+    created_at,
+    last_seen_at,
+    expires_at
+) VALUES (
+    $1::uuid,
+    $2::uuid,
+    $3,
+    $4,
+    $5,
+    $6,
+    NOW(),
+    NOW(),
+    $7
+) RETURNING uuid, user_uuid, token_hash, source, user_agent, ip, created_at, last_seen_at, expires_at, revoked_at
+`
+
+type CreateUserSessionParams struct {
+	UUID      pgtype.UUID        `json:"uuid"`
+	UserUUID  pgtype.UUID        `json:"user_uuid"`
+	TokenHash string             `json:"token_hash"`
+	Source    string             `json:"source"`
+	UserAgent string             `json:"user_agent"`
+	IP        string             `json:"ip"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+}
+
+func (q *Queries) CreateUserSession(ctx context.Context, arg CreateUserSessionParams) (UserSession, error) {
+	row := q.db.QueryRow(ctx, createUserSession,
+		arg.UUID,
+		arg.UserUUID,
+		arg.TokenHash,
+		arg.Source,
+		arg.UserAgent,
+		arg.IP,
+		arg.ExpiresAt,
+	)
+	var i UserSession
+	err := row.Scan(
+		&i.UUID,
+		&i.UserUUID,
+		&i.TokenHash,
+		&i.Source,
+		&i.UserAgent,
+		&i.IP,
+		&i.CreatedAt,
+		&i.LastSeenAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const deleteEndedUserSessions = `-- name: DeleteEndedUserSessions :execrows
+DELETE FROM user_session
+WHERE expires_at < NOW() - INTERVAL '1 day'
+    OR revoked_at < NOW() - INTERVAL '1 day'
+    OR last_seen_at < NOW() - make_interval(secs => $1::int) - INTERVAL '1 day'
+`
+
+func (q *Queries) DeleteEndedUserSessions(ctx context.Context, idleSeconds int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteEndedUserSessions, idleSeconds)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getActiveUserSession = `-- name: GetActiveUserSession :one
+SELECT uuid, user_uuid, token_hash, source, user_agent, ip, created_at, last_seen_at, expires_at, revoked_at FROM user_session
+WHERE token_hash = $1
+    AND revoked_at IS NULL
+    AND expires_at > NOW()
+    AND last_seen_at > NOW() - make_interval(secs => $2::int)
+`
+
+type GetActiveUserSessionParams struct {
+	TokenHash   string `json:"token_hash"`
+	IdleSeconds int32  `json:"idle_seconds"`
+}
+
+func (q *Queries) GetActiveUserSession(ctx context.Context, arg GetActiveUserSessionParams) (UserSession, error) {
+	row := q.db.QueryRow(ctx, getActiveUserSession, arg.TokenHash, arg.IdleSeconds)
+	var i UserSession
+	err := row.Scan(
+		&i.UUID,
+		&i.UserUUID,
+		&i.TokenHash,
+		&i.Source,
+		&i.UserAgent,
+		&i.IP,
+		&i.CreatedAt,
+		&i.LastSeenAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getActiveUserSessions = `-- name: GetActiveUserSessions :many
+SELECT uuid, user_uuid, token_hash, source, user_agent, ip, created_at, last_seen_at, expires_at, revoked_at FROM user_session
+WHERE user_uuid = $1::uuid
+    AND revoked_at IS NULL
+    AND expires_at > NOW()
+    AND last_seen_at > NOW() - make_interval(secs => $2::int)
+ORDER BY last_seen_at DESC
+`
+
+type GetActiveUserSessionsParams struct {
+	UserUUID    pgtype.UUID `json:"user_uuid"`
+	IdleSeconds int32       `json:"idle_seconds"`
+}
+
+func (q *Queries) GetActiveUserSessions(ctx context.Context, arg GetActiveUserSessionsParams) ([]UserSession, error) {
+	rows, err := q.db.Query(ctx, getActiveUserSessions, arg.UserUUID, arg.IdleSeconds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserSession
+	for rows.Next() {
+		var i UserSession
+		if err := rows.Scan(
+			&i.UUID,
+			&i.UserUUID,
+			&i.TokenHash,
+			&i.Source,
+			&i.UserAgent,
+			&i.IP,
+			&i.CreatedAt,
+			&i.LastSeenAt,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeUserSession = `-- name: RevokeUserSession :execrows
+UPDATE user_session SET revoked_at = NOW()
+WHERE uuid = $1::uuid AND user_uuid = $2::uuid AND revoked_at IS NULL
+`
+
+type RevokeUserSessionParams struct {
+	UUID     pgtype.UUID `json:"uuid"`
+	UserUUID pgtype.UUID `json:"user_uuid"`
+}
+
+func (q *Queries) RevokeUserSession(ctx context.Context, arg RevokeUserSessionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeUserSession, arg.UUID, arg.UserUUID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const revokeUserSessionByToken = `-- name: RevokeUserSessionByToken :exec
+UPDATE user_session SET revoked_at = NOW()
+WHERE token_hash = $1 AND revoked_at IS NULL
+`
+
+func (q *Queries) RevokeUserSessionByToken(ctx context.Context, tokenHash string) error {
+	_, err := q.db.Exec(ctx, revokeUserSessionByToken, tokenHash)
+	return err
+}
+
+const revokeUserSessions = `-- name: RevokeUserSessions :execrows
+UPDATE user_session SET revoked_at = NOW()
+WHERE user_uuid = $1::uuid
+    AND revoked_at IS NULL
+    AND ($2::uuid IS NULL OR uuid <> $2::uuid)
+`
+
+type RevokeUserSessionsParams struct {
+	UserUUID pgtype.UUID `json:"user_uuid"`
+	KeepUUID pgtype.UUID `json:"keep_uuid"`
+}
+
+// Logs a user out everywhere, optionally keeping one session.
+func (q *Queries) RevokeUserSessions(ctx context.Context, arg RevokeUserSessionsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeUserSessions, arg.UserUUID, arg.KeepUUID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const touchUserSession = `-- name: TouchUserSession :exec
+UPDATE user_session SET
+    last_seen_at = NOW(),
+    ip = $1
+WHERE uuid = $2::uuid
+`
+
+type TouchUserSessionParams struct {
+	IP   string      `json:"ip"`
+	UUID pgtype.UUID `json:"uuid"`
+}
+
+func (q *Queries) TouchUserSession(ctx context.Context, arg TouchUserSessionParams) error {
+	_, err := q.db.Exec(ctx, touchUserSession, arg.IP, arg.UUID)
+	return err
+}
@@ -0,0 +1,329 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: webhook.sql
+
+package query
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimDueWebhooks = `-- name: ClaimDueWebhooks :many
+UPDATE webhook SET locked_until = NOW() + make_interval(secs => $1::int)
+WHERE uuid IN (
+    SELECT w.uuid
+    FROM webhook w
+    WHERE w.is_enabled AND
+          (w.locked_until IS NULL OR w.locked_until < NOW()) AND
+          EXISTS (
+              SELECT 1 FROM event e
+              WHERE e.workspace_uuid = w.workspace_uuid AND
+                    (w.last_event_txid IS NULL OR
+                        (e.txid, e.uuid) > (w.last_event_txid, COALESCE(w.last_event_uuid, '00000000-0000-0000-0000-000000000000'))) AND
+                    e.txid < pg_snapshot_xmin(pg_current_snapshot())::text::bigint
+          )
+    ORDER BY w.uuid
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING uuid, workspace_uuid, name, url, secret, event_types, is_enabled, last_event_uuid, last_status, last_error, failure_count, created_at, updated_at, last_event_txid, locked_until
+`
+
+// Leases the enabled webhooks with events to deliver to one dispatcher until
+// locked_until, the events are posted outside of any transaction.
+func (q *Queries) ClaimDueWebhooks(ctx context.Context, leaseSeconds int32) ([]Webhook, error) {
+	rows, err := q.db.Query(ctx, claimDueWebhooks, leaseSeconds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Webhook
+	for rows.Next() {
+		var i Webhook
+		if err := rows.Scan(
+			&i.UUID,
+			&i.WorkspaceUUID,
+			&i.Name,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.IsEnabled,
+			&i.LastEventUuid,
+			&i.LastStatus,
+			&i.LastError,
+			&i.FailureCount,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.LastEventTxid,
+			&i.LockedUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createWebhook = `-- name: CreateWebhook :one
+INSERT INTO webhook (
+    uuid,
+    name,
+    url,
+    secret,
+    event_types,
+    is_enabled,
+    last_event_uuid,
+    last_event_txid,
+    created_at,
+    updated_at
+) VALUES (
+    $1::uuid,
+    $2,
+    $3,
+    $4,
+    $5::text[],
+    $6::boolean,
+    -- only events after the creation are delivered
+    (SELECT e.uuid FROM event e WHERE e.workspace_uuid = COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001')
+     ORDER BY e.txid DESC, e.uuid DESC LIMIT 1),
+    (SELECT max(e.txid) FROM event e WHERE e.workspace_uuid = COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001')),
+    NOW(),
+    NOW()
+) RETURNING uuid, workspace_uuid, name, url, secret, event_types, is_enabled, last_event_uuid, last_status, last_error, failure_count, created_at, updated_at, last_event_txid, locked_until
+`
+
+type CreateWebhookParams struct {
+	UUID       pgtype.UUID `json:"uuid"`
+	Name       string      `json:"name"`
+	Url        string      `json:"url"`
+	Secret     string      `json:"secret"`
+	EventTypes []string    `json:"event_types"`
+	IsEnabled  bool        `json:"is_enabled"`
+}
+
+func (q *Queries) CreateWebhook(ctx context.Context, arg CreateWebhookParams) (Webhook, error) {
+	row := q.db.QueryRow(ctx, createWebhook,
+		arg.UUID,
+		arg.Name,
+		arg.Url,
+		arg.Secret,
+		arg.EventTypes,
+		arg.IsEnabled,
+	)
+	var i Webhook
+	err := row.Scan(
+		&i.UUID,
+		&i.WorkspaceUUID,
+		&i.Name,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.IsEnabled,
+		&i.LastEventUuid,
+		&i.LastStatus,
+		&i.LastError,
+		&i.FailureCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastEventTxid,
+		&i.LockedUntil,
+	)
+	return i, err
+}
+
+const deleteWebhook = `-- name: DeleteWebhook :exec
+DELETE FROM webhook
+WHERE uuid = $1::uuid
+`
+
+func (q *Queries) DeleteWebhook(ctx context.Context, uuid pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteWebhook, uuid)
+	return err
+}
+
+const getWebhook = `-- name: GetWebhook :one
+SELECT uuid, workspace_uuid, name, url, secret, event_types, is_enabled, last_event_uuid, last_status, last_error, failure_count, created_at, updated_at, last_event_txid, locked_until
+FROM webhook
+WHERE uuid = $1::uuid
+`
+
+func (q *Queries) GetWebhook(ctx context.Context, uuid pgtype.UUID) (Webhook, error) {
+	row := q.db.QueryRow(ctx, getWebhook, uuid)
+	var i Webhook
+	err := row.Scan(
+		&i.UUID,
+		&i.WorkspaceUUID,
+		&i.Name,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.IsEnabled,
+		&i.LastEventUuid,
+		&i.LastStatus,
+		&i.LastError,
+		&i.FailureCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastEventTxid,
+		&i.LockedUntil,
+	)
+	return i, err
+}
+
+const listWebhooks = `-- name: ListWebhooks :many
+SELECT uuid, workspace_uuid, name, url, secret, event_types, is_enabled, last_event_uuid, last_status, last_error, failure_count, created_at, updated_at, last_event_txid, locked_until
+FROM webhook
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListWebhooks(ctx context.Context) ([]Webhook, error) {
+	rows, err := q.db.Query(ctx, listWebhooks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Webhook
+	for rows.Next() {
+		var i Webhook
+		if err := rows.Scan(
+			&i.UUID,
+			&i.WorkspaceUUID,
+			&i.Name,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.IsEnabled,
+			&i.LastEventUuid,
+			&i.LastStatus,
+			&i.LastError,
+			&i.FailureCount,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.LastEventTxid,
+			&i.LockedUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const releaseWebhook = `-- name: ReleaseWebhook :exec
+UPDATE webhook SET locked_until = NULL
+WHERE uuid = $1::uuid
+`
+
+func (q *Queries) ReleaseWebhook(ctx context.Context, uuid pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, releaseWebhook, uuid)
+	return err
+}
+
+const setWebhookDelivered = `-- name: SetWebhookDelivered :exec
+UPDATE webhook SET
+    last_event_txid = $1::bigint,
+    last_event_uuid = $2::uuid,
+    last_status = $3,
+    last_error = '',
+    failure_count = 0,
+    locked_until = NOW() + make_interval(secs => $4::int)
+WHERE uuid = $5::uuid
+`
+
+type SetWebhookDeliveredParams struct {
+	LastEventTxid int64       `json:"last_event_txid"`
+	LastEventUuid pgtype.UUID `json:"last_event_uuid"`
+	LastStatus    int32       `json:"last_status"`
+	LeaseSeconds  int32       `json:"lease_seconds"`
+	UUID          pgtype.UUID `json:"uuid"`
+}
+
+// Moves the cursor past a delivered event and extends the lease.
+func (q *Queries) SetWebhookDelivered(ctx context.Context, arg SetWebhookDeliveredParams) error {
+	_, err := q.db.Exec(ctx, setWebhookDelivered,
+		arg.LastEventTxid,
+		arg.LastEventUuid,
+		arg.LastStatus,
+		arg.LeaseSeconds,
+		arg.UUID,
+	)
+	return err
+}
+
+const setWebhookFailed = `-- name: SetWebhookFailed :exec
+UPDATE webhook SET
+    last_status = $1,
+    last_error = $2,
+    failure_count = failure_count + 1
+WHERE uuid = $3::uuid
+`
+
+type SetWebhookFailedParams struct {
+	LastStatus int32       `json:"last_status"`
+	LastError  string      `json:"last_error"`
+	UUID       pgtype.UUID `json:"uuid"`
+}
+
+func (q *Queries) SetWebhookFailed(ctx context.Context, arg SetWebhookFailedParams) error {
+	_, err := q.db.Exec(ctx, setWebhookFailed, arg.LastStatus, arg.LastError, arg.UUID)
+	return err
+}
+
+const updateWebhook = `-- name: UpdateWebhook :one
+UPDATE webhook SET
+    name = $1,
+    url = $2,
+    secret = $3,
+    event_types = $4::text[],
+    is_enabled = $5::boolean,
+    updated_at = NOW()
+WHERE uuid = $6::uuid
+RETURNING uuid, workspace_uuid, name, url, secret, event_types, is_enabled, last_event_uuid, last_status, last_error, failure_count, created_at, updated_at, last_event_txid, locked_until
+`
+
+type UpdateWebhookParams struct {
+	Name       string      `json:"name"`
+	Url        string      `json:"url"`
+	Secret     string      `json:"secret"`
+	EventTypes []string    `json:"event_types"`
+	IsEnabled  bool        `json:"is_enabled"`
+	UUID       pgtype.UUID `json:"uuid"`
+}
+
+func (q *Queries) UpdateWebhook(ctx context.Context, arg UpdateWebhookParams) (Webhook, error) {
+	row := q.db.QueryRow(ctx, updateWebhook,
+		arg.Name,
+		arg.Url,
+		arg.Secret,
+		arg.EventTypes,
+		arg.IsEnabled,
+		arg.UUID,
+	)
+	var i Webhook
+	err := row.Scan(
+		&i.UUID,
+		&i.WorkspaceUUID,
+		&i.Name,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.IsEnabled,
+		&i.LastEventUuid,
+		&i.LastStatus,
+		&i.LastError,
+		&i.FailureCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastEventTxid,
+		&i.LockedUntil,
+	)
+	return i, err
+}
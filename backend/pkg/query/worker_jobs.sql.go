@@ -12,10 +12,24 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const cancelWorkerJob = `-- name: CancelWorkerJob :exec
+UPDATE worker_jobs SET
+    status = 'cancelled',
+    finished_at = NOW()
+WHERE uuid = $1::uuid AND
+      finished_at IS NULL
+`
+
+func (q *Queries) CancelWorkerJob(ctx context.Context, argUuid pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, cancelWorkerJob, argUuid)
+	return err
+}
+
 const createWorkerJob = `-- name: CreateWorkerJob :one
 INSERT INTO worker_jobs (
     uuid,
     scheduler_uuid,
+    pipeline_uuid,
     job_uuid,
     subject,
     status,
@@ -26,18 +40,24 @@ INSERT INTO worker_jobs (
              $1::uuid,
              $2::uuid,
              $3::uuid,
-             $4,
+             $4::uuid,
              $5,
              $6,
+             $7,
              NOW(),
-             $7
+             $8
          )
-RETURNING uuid, scheduler_uuid, job_uuid, subject, status, data, started_at, finished_at
+ON CONFLICT (uuid) DO UPDATE SET
+    status = EXCLUDED.status,
+    started_at = EXCLUDED.started_at,
+    finished_at = EXCLUDED.finished_at
+RETURNING uuid, scheduler_uuid, job_uuid, subject, status, data, started_at, finished_at, workspace_uuid, pipeline_uuid
 `
 
 type CreateWorkerJobParams struct {
 	UUID          pgtype.UUID        `json:"uuid"`
 	SchedulerUuid pgtype.UUID        `json:"scheduler_uuid"`
+	PipelineUuid  pgtype.UUID        `json:"pipeline_uuid"`
 	JobUuid       pgtype.UUID        `json:"job_uuid"`
 	Subject       string             `json:"subject"`
 	Status        string             `json:"status"`
@@ -45,10 +65,12 @@ type CreateWorkerJobParams struct {
 	FinishedAt    pgtype.Timestamptz `json:"finished_at"`
 }
 
+// jobs recorded as queued by the scheduler start running here
 func (q *Queries) CreateWorkerJob(ctx context.Context, arg CreateWorkerJobParams) (WorkerJob, error) {
 	row := q.db.QueryRow(ctx, createWorkerJob,
 		arg.UUID,
 		arg.SchedulerUuid,
+		arg.PipelineUuid,
 		arg.JobUuid,
 		arg.Subject,
 		arg.Status,
@@ -65,6 +87,8 @@ func (q *Queries) CreateWorkerJob(ctx context.Context, arg CreateWorkerJobParams
 		&i.Data,
 		&i.StartedAt,
 		&i.FinishedAt,
+		&i.WorkspaceUUID,
+		&i.PipelineUuid,
 	)
 	return i, err
 }
@@ -79,9 +103,57 @@ func (q *Queries) DeleteWorkerJob(ctx context.Context, argUuid pgtype.UUID) erro
 	return err
 }
 
+const getActiveSchedulerJobs = `-- name: GetActiveSchedulerJobs :many
+SELECT uuid, scheduler_uuid, job_uuid, subject, status, data, started_at, finished_at, workspace_uuid, pipeline_uuid
+FROM worker_jobs
+WHERE scheduler_uuid = $1::uuid AND
+      finished_at IS NULL AND
+      status IN ('queued', 'running') AND
+      started_at > $2::timestamptz
+ORDER BY started_at
+`
+
+type GetActiveSchedulerJobsParams struct {
+	SchedulerUuid pgtype.UUID        `json:"scheduler_uuid"`
+	StartedAfter  pgtype.Timestamptz `json:"started_after"`
+}
+
+// Queued or running jobs of a scheduler. Jobs older than started_after are
+// ignored, their worker is assumed dead.
+func (q *Queries) GetActiveSchedulerJobs(ctx context.Context, arg GetActiveSchedulerJobsParams) ([]WorkerJob, error) {
+	rows, err := q.db.Query(ctx, getActiveSchedulerJobs, arg.SchedulerUuid, arg.StartedAfter)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorkerJob
+	for rows.Next() {
+		var i WorkerJob
+		if err := rows.Scan(
+			&i.UUID,
+			&i.SchedulerUuid,
+			&i.JobUuid,
+			&i.Subject,
+			&i.Status,
+			&i.Data,
+			&i.StartedAt,
+			&i.FinishedAt,
+			&i.WorkspaceUUID,
+			&i.PipelineUuid,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getWorkerJob = `-- name: GetWorkerJob :one
 SELECT
-    worker_jobs.uuid, worker_jobs.scheduler_uuid, worker_jobs.job_uuid, worker_jobs.subject, worker_jobs.status, worker_jobs.data, worker_jobs.started_at, worker_jobs.finished_at
+    worker_jobs.uuid, worker_jobs.scheduler_uuid, worker_jobs.job_uuid, worker_jobs.subject, worker_jobs.status, worker_jobs.data, worker_jobs.started_at, worker_jobs.finished_at, worker_jobs.workspace_uuid, worker_jobs.pipeline_uuid
 FROM worker_jobs
 WHERE uuid = $1::uuid
 `
@@ -102,22 +174,25 @@ func (q *Queries) GetWorkerJob(ctx context.Context, argUuid pgtype.UUID) (GetWor
 		&i.WorkerJob.Data,
 		&i.WorkerJob.StartedAt,
 		&i.WorkerJob.FinishedAt,
+		&i.WorkerJob.WorkspaceUUID,
+		&i.WorkerJob.PipelineUuid,
 	)
 	return i, err
 }
 
 const getWorkerJobs = `-- name: GetWorkerJobs :many
 WITH filtered_worker_jobs AS (
-    SELECT w.uuid, w.scheduler_uuid, w.job_uuid, w.subject, w.status, w.data, w.started_at, w.finished_at
+    SELECT w.uuid, w.scheduler_uuid, w.job_uuid, w.subject, w.status, w.data, w.started_at, w.finished_at, w.workspace_uuid, w.pipeline_uuid
     FROM worker_jobs w
     WHERE
         ($5::uuid IS NULL OR w.scheduler_uuid = $5::uuid) AND
-        ($6::uuid IS NULL OR w.scheduler_uuid = $6::uuid) AND
-        (NULLIF($7, '') IS NULL OR w.subject = $7) AND
-        (NULLIF($8, '') IS NULL OR w.status = $8)
+        ($6::uuid IS NULL OR w.pipeline_uuid = $6::uuid) AND
+        ($7::uuid IS NULL OR w.job_uuid = $7::uuid) AND
+        (NULLIF($8, '') IS NULL OR w.subject = $8) AND
+        (NULLIF($9, '') IS NULL OR w.status = $9)
 )
 SELECT
-    uuid, scheduler_uuid, job_uuid, subject, status, data, started_at, finished_at,
+    uuid, scheduler_uuid, job_uuid, subject, status, data, started_at, finished_at, workspace_uuid, pipeline_uuid,
     (SELECT COUNT(*) FROM filtered_worker_jobs) AS total_count
 FROM filtered_worker_jobs
 ORDER BY
@@ -138,6 +213,7 @@ type GetWorkerJobsParams struct {
 	Offset         int32       `json:"offset"`
 	Limit          int32       `json:"limit"`
 	SchedulerUuid  pgtype.UUID `json:"scheduler_uuid"`
+	PipelineUuid   pgtype.UUID `json:"pipeline_uuid"`
 	JobUuid        pgtype.UUID `json:"job_uuid"`
 	Subject        interface{} `json:"subject"`
 	Status         interface{} `json:"status"`
@@ -152,6 +228,8 @@ type GetWorkerJobsRow struct {
 	Data          []byte             `json:"data"`
 	StartedAt     pgtype.Timestamptz `json:"started_at"`
 	FinishedAt    pgtype.Timestamptz `json:"finished_at"`
+	WorkspaceUUID *uuid.UUID         `json:"workspace_uuid"`
+	PipelineUuid  *uuid.UUID         `json:"pipeline_uuid"`
 	TotalCount    int64              `json:"total_count"`
 }
 
@@ -162,6 +240,7 @@ func (q *Queries) GetWorkerJobs(ctx context.Context, arg GetWorkerJobsParams) ([
 		arg.Offset,
 		arg.Limit,
 		arg.SchedulerUuid,
+		arg.PipelineUuid,
 		arg.JobUuid,
 		arg.Subject,
 		arg.Status,
@@ -182,6 +261,8 @@ func (q *Queries) GetWorkerJobs(ctx context.Context, arg GetWorkerJobsParams) ([
 			&i.Data,
 			&i.StartedAt,
 			&i.FinishedAt,
+			&i.WorkspaceUUID,
+			&i.PipelineUuid,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -196,7 +277,7 @@ func (q *Queries) GetWorkerJobs(ctx context.Context, arg GetWorkerJobsParams) ([
 
 const listWorkerJobs = `-- name: ListWorkerJobs :many
 SELECT
-    worker_jobs.uuid, worker_jobs.scheduler_uuid, worker_jobs.job_uuid, worker_jobs.subject, worker_jobs.status, worker_jobs.data, worker_jobs.started_at, worker_jobs.finished_at
+    worker_jobs.uuid, worker_jobs.scheduler_uuid, worker_jobs.job_uuid, worker_jobs.subject, worker_jobs.status, worker_jobs.data, worker_jobs.started_at, worker_jobs.finished_at, worker_jobs.workspace_uuid, worker_jobs.pipeline_uuid
 FROM worker_jobs
 ORDER BY started_at DESC
 LIMIT NULLIF($2::int, 0)
@@ -230,6 +311,8 @@ func (q *Queries) ListWorkerJobs(ctx context.Context, arg ListWorkerJobsParams)
 			&i.WorkerJob.Data,
 			&i.WorkerJob.StartedAt,
 			&i.WorkerJob.FinishedAt,
+			&i.WorkerJob.WorkspaceUUID,
+			&i.WorkerJob.PipelineUuid,
 		); err != nil {
 			return nil, err
 		}
@@ -241,15 +324,53 @@ func (q *Queries) ListWorkerJobs(ctx context.Context, arg ListWorkerJobsParams)
 	return items, nil
 }
 
+const mergeWorkerJobData = `-- name: MergeWorkerJobData :exec
+UPDATE worker_jobs SET
+    data = COALESCE(data, '{}'::jsonb) || $1::jsonb
+WHERE uuid = $2::uuid
+`
+
+type MergeWorkerJobDataParams struct {
+	Data []byte      `json:"data"`
+	UUID pgtype.UUID `json:"uuid"`
+}
+
+func (q *Queries) MergeWorkerJobData(ctx context.Context, arg MergeWorkerJobDataParams) error {
+	_, err := q.db.Exec(ctx, mergeWorkerJobData, arg.Data, arg.UUID)
+	return err
+}
+
+const requeueWorkerJob = `-- name: RequeueWorkerJob :exec
+UPDATE worker_jobs SET
+    status = 'queued',
+    finished_at = NULL,
+    data = COALESCE(data, '{}'::jsonb) || $1::jsonb
+WHERE uuid = $2::uuid
+`
+
+type RequeueWorkerJobParams struct {
+	Data []byte      `json:"data"`
+	UUID pgtype.UUID `json:"uuid"`
+}
+
+// Jobs interrupted by a shutdown are queued again, their message is
+// redelivered to another worker.
+func (q *Queries) RequeueWorkerJob(ctx context.Context, arg RequeueWorkerJobParams) error {
+	_, err := q.db.Exec(ctx, requeueWorkerJob, arg.Data, arg.UUID)
+	return err
+}
+
 const updateWorkerJob = `-- name: UpdateWorkerJob :exec
 UPDATE worker_jobs
 SET
-    scheduler_uuid = $1::uuid,
+    -- jobs without a scheduler in their arguments keep the recorded one
+    scheduler_uuid = COALESCE($1::uuid, scheduler_uuid),
     job_uuid = $2::uuid,
 
     subject     = $3,
     status      = $4,
-    data        = $5,
+    -- keeps what was recorded while the job ran, e.g. its result
+    data        = COALESCE(data, '{}'::jsonb) || $5::jsonb,
     finished_at = $6
 WHERE uuid = $7::uuid
 `
@@ -0,0 +1,292 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: workspace.sql
+
+package query
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countWorkspaceOwners = `-- name: CountWorkspaceOwners :one
+SELECT count(*)::int
+FROM workspace_member
+WHERE workspace_uuid = $1::uuid AND role = 'owner'
+`
+
+func (q *Queries) CountWorkspaceOwners(ctx context.Context, workspaceUuid pgtype.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, countWorkspaceOwners, workspaceUuid)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const createWorkspace = `-- name: CreateWorkspace :one
+INSERT INTO workspace (
+    uuid,
+    name,
+    slug,
+    created_at,
+    updated_at
+) VALUES (
+    $1::uuid,
+    $2,
+    $3,
+    NOW(),
+    NOW()
+) RETURNING uuid, name, slug, created_at, updated_at
+`
+
+type CreateWorkspaceParams struct {
+	UUID pgtype.UUID `json:"uuid"`
+	Name string      `json:"name"`
+	Slug string      `json:"slug"`
+}
+
+func (q *Queries) CreateWorkspace(ctx context.Context, arg CreateWorkspaceParams) (Workspace, error) {
+	row := q.db.QueryRow(ctx, createWorkspace, arg.UUID, arg.Name, arg.Slug)
+	var i Workspace
+	err := row.Scan(
+		&i.UUID,
+		&i.Name,
+		&i.Slug,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteWorkspace = `-- name: DeleteWorkspace :exec
+DELETE FROM workspace WHERE uuid = $1::uuid
+`
+
+func (q *Queries) DeleteWorkspace(ctx context.Context, argUuid pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteWorkspace, argUuid)
+	return err
+}
+
+const deleteWorkspaceMember = `-- name: DeleteWorkspaceMember :execrows
+DELETE FROM workspace_member
+WHERE workspace_uuid = $1::uuid AND user_uuid = $2::uuid
+`
+
+type DeleteWorkspaceMemberParams struct {
+	WorkspaceUUID pgtype.UUID `json:"workspace_uuid"`
+	UserUUID      pgtype.UUID `json:"user_uuid"`
+}
+
+func (q *Queries) DeleteWorkspaceMember(ctx context.Context, arg DeleteWorkspaceMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWorkspaceMember, arg.WorkspaceUUID, arg.UserUUID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getWorkspace = `-- name: GetWorkspace :one
+SELECT
+    workspace.uuid, workspace.name, workspace.slug, workspace.created_at, workspace.updated_at
+FROM workspace
+WHERE uuid = $1::uuid
+`
+
+type GetWorkspaceRow struct {
+	Workspace Workspace `json:"workspace"`
+}
+
+func (q *Queries) GetWorkspace(ctx context.Context, argUuid pgtype.UUID) (GetWorkspaceRow, error) {
+	row := q.db.QueryRow(ctx, getWorkspace, argUuid)
+	var i GetWorkspaceRow
+	err := row.Scan(
+		&i.Workspace.UUID,
+		&i.Workspace.Name,
+		&i.Workspace.Slug,
+		&i.Workspace.CreatedAt,
+		&i.Workspace.UpdatedAt,
+	)
+	return i, err
+}
+
+const getWorkspaceMembers = `-- name: GetWorkspaceMembers :many
+SELECT
+    workspace_member.workspace_uuid, workspace_member.user_uuid, workspace_member.role, workspace_member.created_at,
+    u.email
+FROM workspace_member
+    JOIN "user" u ON u.uuid = workspace_member.user_uuid
+WHERE workspace_member.workspace_uuid = $1::uuid
+ORDER BY workspace_member.created_at ASC
+`
+
+type GetWorkspaceMembersRow struct {
+	WorkspaceMember WorkspaceMember `json:"workspace_member"`
+	Email           string          `json:"email"`
+}
+
+func (q *Queries) GetWorkspaceMembers(ctx context.Context, workspaceUuid pgtype.UUID) ([]GetWorkspaceMembersRow, error) {
+	rows, err := q.db.Query(ctx, getWorkspaceMembers, workspaceUuid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetWorkspaceMembersRow
+	for rows.Next() {
+		var i GetWorkspaceMembersRow
+		if err := rows.Scan(
+			&i.WorkspaceMember.WorkspaceUUID,
+			&i.WorkspaceMember.UserUUID,
+			&i.WorkspaceMember.Role,
+			&i.WorkspaceMember.CreatedAt,
+			&i.Email,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWorkspaces = `-- name: GetWorkspaces :many
+SELECT
+    workspace.uuid, workspace.name, workspace.slug, workspace.created_at, workspace.updated_at,
+    COALESCE(m.role, '')::text AS role
+FROM workspace
+    LEFT JOIN workspace_member m ON m.workspace_uuid = workspace.uuid AND m.user_uuid = $1::uuid
+WHERE
+    ($1::uuid IS NULL OR m.user_uuid IS NOT NULL)
+ORDER BY workspace.created_at ASC
+LIMIT NULLIF($3::int, 0)
+    OFFSET $2::int
+`
+
+type GetWorkspacesParams struct {
+	UserUUID pgtype.UUID `json:"user_uuid"`
+	Offset   int32       `json:"offset"`
+	Limit    int32       `json:"limit"`
+}
+
+type GetWorkspacesRow struct {
+	Workspace Workspace `json:"workspace"`
+	Role      string    `json:"role"`
+}
+
+func (q *Queries) GetWorkspaces(ctx context.Context, arg GetWorkspacesParams) ([]GetWorkspacesRow, error) {
+	rows, err := q.db.Query(ctx, getWorkspaces, arg.UserUUID, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetWorkspacesRow
+	for rows.Next() {
+		var i GetWorkspacesRow
+		if err := rows.Scan(
+			&i.Workspace.UUID,
+			&i.Workspace.Name,
+			&i.Workspace.Slug,
+			&i.Workspace.CreatedAt,
+			&i.Workspace.UpdatedAt,
+			&i.Role,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resolveUserWorkspace = `-- name: ResolveUserWorkspace :one
+SELECT
+    w.uuid,
+    COALESCE(m.role, 'admin')::text AS role
+FROM workspace w
+    LEFT JOIN workspace_member m ON m.workspace_uuid = w.uuid AND m.user_uuid = $1::uuid
+WHERE
+    ($2::uuid IS NULL OR w.uuid = $2::uuid)
+    AND (m.user_uuid IS NOT NULL OR EXISTS (
+        SELECT 1 FROM "user" u WHERE u.uuid = $1::uuid AND u.is_admin
+    ))
+ORDER BY m.created_at ASC NULLS LAST, w.created_at ASC
+LIMIT 1
+`
+
+type ResolveUserWorkspaceParams struct {
+	UserUUID      pgtype.UUID `json:"user_uuid"`
+	WorkspaceUUID pgtype.UUID `json:"workspace_uuid"`
+}
+
+type ResolveUserWorkspaceRow struct {
+	UUID uuid.UUID `json:"uuid"`
+	Role string    `json:"role"`
+}
+
+// Picks the requested workspace, or the oldest membership when none is
+// requested. Administrators reach every workspace, with the admin role where
+// they are not members.
+func (q *Queries) ResolveUserWorkspace(ctx context.Context, arg ResolveUserWorkspaceParams) (ResolveUserWorkspaceRow, error) {
+	row := q.db.QueryRow(ctx, resolveUserWorkspace, arg.UserUUID, arg.WorkspaceUUID)
+	var i ResolveUserWorkspaceRow
+	err := row.Scan(&i.UUID, &i.Role)
+	return i, err
+}
+
+const updateWorkspace = `-- name: UpdateWorkspace :exec
+UPDATE workspace SET
+    name = $1,
+    slug = $2,
+    updated_at = NOW()
+WHERE uuid = $3::uuid
+`
+
+type UpdateWorkspaceParams struct {
+	Name string      `json:"name"`
+	Slug string      `json:"slug"`
+	UUID pgtype.UUID `json:"uuid"`
+}
+
+func (q *Queries) UpdateWorkspace(ctx context.Context, arg UpdateWorkspaceParams) error {
+	_, err := q.db.Exec(ctx, updateWorkspace, arg.Name, arg.Slug, arg.UUID)
+	return err
+}
+
+const upsertWorkspaceMember = `-- name: UpsertWorkspaceMember :one
+INSERT INTO workspace_member (
+    workspace_uuid,
+    user_uuid,
+    role,
+    created_at
+) VALUES (
+    $1::uuid,
+    $2::uuid,
+    $3,
+    NOW()
+)
+ON CONFLICT (workspace_uuid, user_uuid) DO UPDATE SET role = EXCLUDED.role
+RETURNING workspace_uuid, user_uuid, role, created_at
+`
+
+type UpsertWorkspaceMemberParams struct {
+	WorkspaceUUID pgtype.UUID `json:"workspace_uuid"`
+	UserUUID      pgtype.UUID `json:"user_uuid"`
+	Role          string      `json:"role"`
+}
+
+func (q *Queries) UpsertWorkspaceMember(ctx context.Context, arg UpsertWorkspaceMemberParams) (WorkspaceMember, error) {
+	row := q.db.QueryRow(ctx, upsertWorkspaceMember, arg.WorkspaceUUID, arg.UserUUID, arg.Role)
+	var i WorkspaceMember
+	err := row.Scan(
+		&i.WorkspaceUUID,
+		&i.UserUUID,
+		&i.Role,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,179 @@
+// Package sdk is the Go client of the ShadowAPI REST API. It wraps the client
+// generated from the OpenAPI spec, pkg/api, with the authentication, retries
+// with backoff, iterators over the list and query endpoints, typed errors and
+// helpers for the files and the event stream.
+//
+//	c, err := sdk.New("https://shadowapi.example.com", sdk.WithToken(os.Getenv("SA_API_TOKEN")))
+//	for job, err := range c.Jobs(ctx, api.WorkerJobsListParams{}) {
+//		...
+//	}
+//
+// Every operation of the spec is available on the embedded *api.Client, their
+// errors turn into *Error with AsError.
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ogen-go/ogen/ogenerrors"
+
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+)
+
+// PathPrefix is where the server mounts the API
+const PathPrefix = "/api/v1"
+
+// workspaceHeader selects the workspace of a request, see internal/workspace
+const workspaceHeader = "X-Workspace-ID"
+
+// Client calls the API.
+type Client struct {
+	*api.Client
+
+	// blob downloads and uploads the file contents from the URLs the API
+	// hands out, without the credentials of the API
+	blob     *http.Client
+	pageSize int32
+}
+
+type options struct {
+	token      string
+	workspace  string
+	httpClient *http.Client
+	retry      RetryPolicy
+	userAgent  string
+	pageSize   int32
+}
+
+// Option configures a Client.
+type Option func(*options)
+
+// WithToken authenticates with the bearer token of the server or an API key,
+// both are sent as a bearer token.
+func WithToken(token string) Option {
+	return func(o *options) { o.token = token }
+}
+
+// WithAPIKey authenticates with an API key, it is the same as WithToken.
+func WithAPIKey(key string) Option {
+	return WithToken(key)
+}
+
+// WithWorkspace selects the workspace by UUID, the one of the API key or the
+// default one otherwise.
+func WithWorkspace(workspaceUUID string) Option {
+	return func(o *options) { o.workspace = workspaceUUID }
+}
+
+// WithHTTPClient sends the requests with the client, its transport gets the
+// retries wrapped around it.
+func WithHTTPClient(c *http.Client) Option {
+	return func(o *options) { o.httpClient = c }
+}
+
+// WithRetry replaces DefaultRetry, NoRetry disables the retries.
+func WithRetry(p RetryPolicy) Option {
+	return func(o *options) { o.retry = p }
+}
+
+// WithUserAgent sets the User-Agent of the requests.
+func WithUserAgent(ua string) Option {
+	return func(o *options) { o.userAgent = ua }
+}
+
+// WithPageSize sets how many items the iterators fetch per request.
+func WithPageSize(n int32) Option {
+	return func(o *options) { o.pageSize = n }
+}
+
+// defaultPageSize is how many items the iterators fetch per request
+const defaultPageSize = 100
+
+// New returns a client of the API at baseURL. The URL of the server is enough,
+// PathPrefix is added when the URL has no path.
+func New(baseURL string, opts ...Option) (*Client, error) {
+	o := options{retry: DefaultRetry, pageSize: defaultPageSize}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.pageSize <= 0 {
+		return nil, fmt.Errorf("page size must be positive, got %d", o.pageSize)
+	}
+
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base URL: %w", err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid base URL %q, want e.g. https://shadowapi.example.com", baseURL)
+	}
+	if strings.Trim(u.Path, "/") == "" {
+		u.Path = PathPrefix
+	}
+
+	base := http.DefaultTransport
+	httpClient := &http.Client{}
+	if o.httpClient != nil {
+		httpClient = o.httpClient
+		if httpClient.Transport != nil {
+			base = httpClient.Transport
+		}
+	}
+	retrying := &retryTransport{policy: o.retry, next: base}
+
+	// copies, the client given is left as is
+	apiHTTP := *httpClient
+	apiHTTP.Transport = headerTransport{workspace: o.workspace, userAgent: o.userAgent, next: retrying}
+	blobHTTP := *httpClient
+	blobHTTP.Transport = headerTransport{userAgent: o.userAgent, next: retrying}
+
+	c, err := api.NewClient(u.String(), bearer(o.token), api.WithClient(&apiHTTP))
+	if err != nil {
+		return nil, err
+	}
+	return &Client{Client: c, blob: &blobHTTP, pageSize: o.pageSize}, nil
+}
+
+// bearer authenticates every operation with the token. The cookie schemes of
+// the browser sessions are skipped.
+type bearer string
+
+func (b bearer) BearerAuth(context.Context, api.OperationName) (api.BearerAuth, error) {
+	if b == "" {
+		return api.BearerAuth{}, ogenerrors.ErrSkipClientSecurity
+	}
+	return api.BearerAuth{Token: string(b)}, nil
+}
+
+func (b bearer) PlainCookieAuth(context.Context, api.OperationName) (api.PlainCookieAuth, error) {
+	return api.PlainCookieAuth{}, ogenerrors.ErrSkipClientSecurity
+}
+
+func (b bearer) ZitadelCookieAuth(context.Context, api.OperationName) (api.ZitadelCookieAuth, error) {
+	return api.ZitadelCookieAuth{}, ogenerrors.ErrSkipClientSecurity
+}
+
+// headerTransport sets the headers shared by every request.
+type headerTransport struct {
+	workspace string
+	userAgent string
+	next      http.RoundTripper
+}
+
+func (t headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.workspace == "" && t.userAgent == "" {
+		return t.next.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	if t.workspace != "" {
+		req.Header.Set(workspaceHeader, t.workspace)
+	}
+	if t.userAgent != "" {
+		req.Header.Set("User-Agent", t.userAgent)
+	}
+	return t.next.RoundTrip(req)
+}
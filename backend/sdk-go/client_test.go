@@ -0,0 +1,134 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+)
+
+func newTestClient(t *testing.T, h http.HandlerFunc, opts ...Option) *Client {
+	t.Helper()
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+	opts = append([]Option{
+		WithToken("secret"),
+		WithRetry(RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}),
+	}, opts...)
+	c, err := New(srv.URL, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func TestPaginate(t *testing.T) {
+	names := []string{"a", "b", "c", "d", "e"}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != PathPrefix+"/datasource" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer secret" {
+			t.Errorf("Authorization = %q", got)
+		}
+		if got := r.Header.Get(workspaceHeader); got != "ws" {
+			t.Errorf("%s = %q", workspaceHeader, got)
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		page := []map[string]any{}
+		for _, n := range names[min(offset, len(names)):min(offset+limit, len(names))] {
+			page = append(page, map[string]any{"name": n, "type": "email", "provider": "gmail", "user_uuid": "u"})
+		}
+		writeJSON(w, http.StatusOK, page)
+	}, WithPageSize(2), WithWorkspace("ws"))
+
+	var got []string
+	for ds, err := range c.Datasources(context.Background()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, ds.Name)
+	}
+	if len(got) != len(names) {
+		t.Fatalf("got %v, want %v", got, names)
+	}
+	for i := range names {
+		if got[i] != names[i] {
+			t.Fatalf("got %v, want %v", got, names)
+		}
+	}
+}
+
+func TestRetry(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			writeJSON(w, http.StatusServiceUnavailable, map[string]any{"detail": "busy"})
+			return
+		}
+		writeJSON(w, http.StatusOK, []any{})
+	})
+	if _, err := c.DatasourceList(context.Background(), api.DatasourceListParams{}); err != nil {
+		t.Fatal(err)
+	}
+	if n := calls.Load(); n != 3 {
+		t.Errorf("calls = %d, want 3", n)
+	}
+
+	// a create is not sent twice after a bad gateway
+	calls.Store(0)
+	c = newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	if _, err := c.PipelineCreate(context.Background(), &api.Pipeline{}); err == nil {
+		t.Fatal("want an error")
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("calls = %d, want 1", n)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusNotFound, map[string]any{
+			"detail": "pipeline not found",
+			"errors": []map[string]any{{"location": "path.uuid", "message": "unknown"}},
+		})
+	})
+	_, err := c.PipelineGet(context.Background(), api.PipelineGetParams{UUID: uuid.New()})
+	if !errors.Is(Wrap(err), ErrNotFound) {
+		t.Fatalf("err = %v, want ErrNotFound", err)
+	}
+	e, ok := AsError(err)
+	if !ok {
+		t.Fatalf("AsError(%v) failed", err)
+	}
+	if e.Detail != "pipeline not found" || len(e.Fields) != 1 || e.Fields[0].Location != "path.uuid" {
+		t.Errorf("error = %+v", e)
+	}
+
+	// the iterators yield the typed error
+	for _, err := range c.Pipelines(context.Background(), api.PipelineListParams{}) {
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("iterator err = %v, want ErrNotFound", err)
+		}
+	}
+}
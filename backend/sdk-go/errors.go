@@ -0,0 +1,99 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+)
+
+// Errors an *Error matches with errors.Is, by its status code
+var (
+	ErrBadRequest   = errors.New("bad request")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrServer       = errors.New("server error")
+)
+
+// Error is an error answered by the API.
+type Error struct {
+	StatusCode int
+	// Detail is the message of the API
+	Detail string
+	// Fields lists the invalid fields of a request, if any
+	Fields []FieldError
+}
+
+// FieldError is an invalid field of a request.
+type FieldError struct {
+	Location string
+	Message  string
+}
+
+func (e *Error) Error() string {
+	msg := e.Detail
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+	for _, f := range e.Fields {
+		msg += fmt.Sprintf("; %s: %s", f.Location, f.Message)
+	}
+	return fmt.Sprintf("%s (%d)", msg, e.StatusCode)
+}
+
+// Unwrap returns the Err* of the status code, if any.
+func (e *Error) Unwrap() error {
+	switch {
+	case e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity:
+		return ErrBadRequest
+	case e.StatusCode == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case e.StatusCode == http.StatusForbidden:
+		return ErrForbidden
+	case e.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case e.StatusCode == http.StatusConflict:
+		return ErrConflict
+	case e.StatusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case e.StatusCode >= 500:
+		return ErrServer
+	}
+	return nil
+}
+
+// AsError returns the *Error of an error returned by the client, including
+// the operations of the embedded *api.Client.
+func AsError(err error) (*Error, bool) {
+	var e *Error
+	if errors.As(err, &e) {
+		return e, true
+	}
+	var se *api.ErrorStatusCode
+	if errors.As(err, &se) {
+		return fromAPI(se), true
+	}
+	return nil, false
+}
+
+// Wrap turns the error responses of the generated client into *Error, so
+// that errors.Is(sdk.Wrap(err), sdk.ErrNotFound) works on any operation.
+// Other errors are returned as is.
+func Wrap(err error) error {
+	if e, ok := AsError(err); ok {
+		return e
+	}
+	return err
+}
+
+func fromAPI(se *api.ErrorStatusCode) *Error {
+	e := &Error{StatusCode: se.StatusCode, Detail: se.Response.Detail.Or("")}
+	for _, f := range se.Response.Errors {
+		e.Fields = append(e.Fields, FieldError{Location: f.Location.Or(""), Message: f.Message.Or("")})
+	}
+	return e
+}
@@ -0,0 +1,95 @@
+package sdk
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"time"
+
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+)
+
+// defaultEventInterval is how often Events polls for new events
+const defaultEventInterval = 5 * time.Second
+
+// EventFilter selects the events of Events.
+type EventFilter struct {
+	// Type and SubjectUUID narrow the events, e.g. datasource.needs_reauth
+	Type        string
+	SubjectUUID string
+	// After is the UUID of the last event seen, the stream resumes after it.
+	// Every stored event is streamed first when empty.
+	After string
+	// Interval is how often the API is polled (default 5s)
+	Interval time.Duration
+}
+
+// Events streams the events of the workspace, oldest first, polling the API
+// for new ones until ctx is done or the iteration is stopped. Errors are
+// yielded and the polling goes on, break out of the loop to stop on them.
+func (c *Client) Events(ctx context.Context, f EventFilter) iter.Seq2[api.Event, error] {
+	interval := f.Interval
+	if interval <= 0 {
+		interval = defaultEventInterval
+	}
+	return func(yield func(api.Event, error) bool) {
+		after := f.After
+		for {
+			events, err := c.eventsAfter(ctx, f, after)
+			if err != nil && ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				if !yield(api.Event{}, err) {
+					return
+				}
+			}
+			for _, e := range events {
+				if !yield(e, nil) {
+					return
+				}
+				after = e.UUID
+			}
+
+			timer := time.NewTimer(interval)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+// eventsAfter returns the events after the one given, oldest first. The API
+// lists the newest first, the pages are read to the end before yielding.
+func (c *Client) eventsAfter(ctx context.Context, f EventFilter, after string) ([]api.Event, error) {
+	params := api.EventListParams{}
+	if f.Type != "" {
+		params.Type = api.NewOptString(f.Type)
+	}
+	if f.SubjectUUID != "" {
+		params.SubjectUUID = api.NewOptString(f.SubjectUUID)
+	}
+	if after != "" {
+		params.After = api.NewOptString(after)
+	}
+	var events []api.Event
+	// events published while paging shift the pages, some come twice
+	seen := make(map[string]bool)
+	for e, err := range Paginate(ctx, c.pageSize, func(ctx context.Context, offset, limit int32) ([]api.Event, error) {
+		params.Offset, params.Limit = api.NewOptInt32(offset), api.NewOptInt32(limit)
+		return c.EventList(ctx, params)
+	}) {
+		if err != nil {
+			return nil, err
+		}
+		if !seen[e.UUID] {
+			seen[e.UUID] = true
+			events = append(events, e)
+		}
+	}
+	slices.Reverse(events)
+	return events, nil
+}
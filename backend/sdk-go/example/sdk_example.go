@@ -2,63 +2,55 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log"
-	"net/url"
+	"os"
 	"time"
 
 	"github.com/shadowapi/shadowapi/backend/pkg/api"
+	sdk "github.com/shadowapi/shadowapi/backend/sdk-go"
 )
 
-// MySecuritySource implements the SecuritySource interface.
-type MySecuritySource struct {
-	token string
-}
-
-// BearerAuth returns a BearerAuth instance with the token.
-func (s MySecuritySource) BearerAuth(ctx context.Context, operationName api.OperationName) (api.BearerAuth, error) {
-	return api.BearerAuth{Token: s.token}, nil
-}
-
-// ZitadelCookieAuth returns a zero value; update if needed.
-func (s MySecuritySource) ZitadelCookieAuth(ctx context.Context, operationName api.OperationName) (api.ZitadelCookieAuth, error) {
-	return api.ZitadelCookieAuth{}, nil
-}
-
-// PlainCookieAuth returns a zero value; update if needed.
-func (s MySecuritySource) PlainCookieAuth(ctx context.Context, operationName api.OperationName) (api.PlainCookieAuth, error) {
-	return api.PlainCookieAuth{}, nil
-}
-
 func main() {
-	// Parse the server URL.
-	// use the same prefix "/api/v1" as in backend/internal/server/server.go
-	baseURL, err := url.Parse("https://example.com/api/v1")
-	if err != nil {
-		log.Fatal("Invalid URL:", err)
-	}
-
-	// Provide a security source using BearerAuth with your API key.
-	sec := MySecuritySource{token: "XXXX"}
-
-	// Create a new API client.
-	// If authentication is needed, implement the SecuritySource interface and pass it here instead of nil.
-	client, err := api.NewClient(baseURL.String(), sec)
+	// the bearer token of the server or an API key
+	client, err := sdk.New(os.Getenv("SA_REMOTE_URL"), sdk.WithToken(os.Getenv("SA_API_TOKEN")))
 	if err != nil {
 		log.Fatal("Error creating client:", err)
 	}
 
-	// Set up a context with timeout.
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 	defer cancel()
 
-	// Example: Call the DatasourceEmailList endpoint.
-	listParams := api.DatasourceEmailListParams{
-		Offset: api.NewOptInt32(0),
-		Limit:  api.NewOptInt32(10),
+	// every operation of the spec is available on the client
+	profile, err := client.GetProfile(ctx)
+	if errors.Is(sdk.Wrap(err), sdk.ErrUnauthorized) {
+		log.Fatal("Invalid token")
+	} else if err != nil {
+		log.Fatal("Error getting the profile:", err)
 	}
-	emails, err := client.DatasourceEmailList(ctx, listParams)
-	if err != nil {
-		log.Fatal("Error listing datasource emails:", err)
+	log.Printf("Signed in as %s", profile.Email)
+
+	// the iterators fetch the pages as they go
+	for ds, err := range client.Datasources(ctx) {
+		if err != nil {
+			log.Fatal("Error listing datasources:", err)
+		}
+		log.Printf("Datasource %s (%s)", ds.Name, ds.Type)
+	}
+
+	for job, err := range client.Jobs(ctx, api.WorkerJobsListParams{Status: api.NewOptString("failed")}) {
+		if err != nil {
+			log.Fatal("Error listing jobs:", err)
+		}
+		log.Printf("Failed job %s: %s", job.UUID.Or(""), job.Subject)
+	}
+
+	// new events are polled for until the context is done
+	for e, err := range client.Events(ctx, sdk.EventFilter{Type: "datasource.needs_reauth"}) {
+		if err != nil {
+			log.Print("Error polling events:", err)
+			continue
+		}
+		log.Printf("Datasource %s needs to be authorized again", e.SubjectUUID.Or(""))
 	}
-	log.Printf("Datasource Emails: %+v", emails)
 }
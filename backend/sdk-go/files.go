@@ -0,0 +1,100 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+)
+
+// Upload is a file to upload.
+type Upload struct {
+	StorageType api.UploadPresignedUrlRequestStorageType
+	Name        string
+	MimeType    string
+	// Body is the content, Size its length in bytes when known
+	Body io.Reader
+	Size int64
+}
+
+// UploadFile uploads the content to the URL the API hands out for it and
+// returns the file.
+func (c *Client) UploadFile(ctx context.Context, u Upload) (*api.FileObject, error) {
+	req := &api.UploadPresignedUrlRequest{
+		Name:        api.NewOptString(u.Name),
+		StorageType: api.NewOptUploadPresignedUrlRequestStorageType(u.StorageType),
+	}
+	if u.MimeType != "" {
+		req.MimeType = api.NewOptString(u.MimeType)
+	}
+	res, err := c.GeneratePresignedUploadUrl(ctx, req)
+	if err != nil {
+		return nil, Wrap(err)
+	}
+	uploadURL, ok := res.UploadURL.Get()
+	if !ok {
+		return nil, errors.New("no upload URL in the response")
+	}
+
+	put, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadURL, u.Body)
+	if err != nil {
+		return nil, err
+	}
+	if u.Size > 0 {
+		put.ContentLength = u.Size
+	}
+	if u.MimeType != "" {
+		put.Header.Set("Content-Type", u.MimeType)
+	}
+	resp, err := c.blob.Do(put)
+	if err != nil {
+		return nil, fmt.Errorf("upload: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return nil, blobError(resp)
+	}
+
+	file, ok := res.File.Get()
+	if !ok {
+		return nil, errors.New("no file in the response")
+	}
+	return &file, nil
+}
+
+// DownloadFile writes the content of the file to w, from the link the API
+// hands out for it, and returns the number of bytes written.
+func (c *Client) DownloadFile(ctx context.Context, fileUUID string, w io.Writer) (int64, error) {
+	res, err := c.GenerateDownloadLink(ctx, &api.GenerateDownloadLinkRequest{FileUUID: api.NewOptString(fileUUID)})
+	if err != nil {
+		return 0, Wrap(err)
+	}
+	link, ok := res.URL.Get()
+	if !ok {
+		return 0, errors.New("no download URL in the response")
+	}
+
+	get, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := c.blob.Do(get)
+	if err != nil {
+		return 0, fmt.Errorf("download: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return 0, blobError(resp)
+	}
+	return io.Copy(w, resp.Body)
+}
+
+// blobError is the *Error of a failed upload or download, with the start of
+// the body as detail.
+func blobError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return &Error{StatusCode: resp.StatusCode, Detail: string(body)}
+}
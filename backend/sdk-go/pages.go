@@ -0,0 +1,127 @@
+package sdk
+
+import (
+	"context"
+	"iter"
+
+	"github.com/shadowapi/shadowapi/backend/pkg/api"
+)
+
+// Paginate iterates over the items of an endpoint paged by offset and limit,
+// fetching the pages as the iteration goes. A page shorter than the limit is
+// the last one. The iteration ends with the first error, yielded as *Error
+// when the API answered it.
+func Paginate[T any](ctx context.Context, pageSize int32, page func(ctx context.Context, offset, limit int32) ([]T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for offset := int32(0); ; offset += pageSize {
+			items, err := page(ctx, offset, pageSize)
+			if err != nil {
+				var zero T
+				yield(zero, Wrap(err))
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if int32(len(items)) < pageSize {
+				return
+			}
+		}
+	}
+}
+
+// Datasources iterates over the datasources of the workspace.
+func (c *Client) Datasources(ctx context.Context) iter.Seq2[api.Datasource, error] {
+	return Paginate(ctx, c.pageSize, func(ctx context.Context, offset, limit int32) ([]api.Datasource, error) {
+		return c.DatasourceList(ctx, api.DatasourceListParams{Offset: api.NewOptInt32(offset), Limit: api.NewOptInt32(limit)})
+	})
+}
+
+// Storages iterates over the storages matching the params, their offset and
+// limit are set by the iteration.
+func (c *Client) Storages(ctx context.Context, params api.StorageListParams) iter.Seq2[api.Storage, error] {
+	return Paginate(ctx, c.pageSize, func(ctx context.Context, offset, limit int32) ([]api.Storage, error) {
+		params.Offset, params.Limit = api.NewOptInt32(offset), api.NewOptInt32(limit)
+		return c.StorageList(ctx, params)
+	})
+}
+
+// Pipelines iterates over the pipelines matching the params.
+func (c *Client) Pipelines(ctx context.Context, params api.PipelineListParams) iter.Seq2[api.Pipeline, error] {
+	return Paginate(ctx, c.pageSize, func(ctx context.Context, offset, limit int32) ([]api.Pipeline, error) {
+		params.Offset, params.Limit = api.NewOptInt32(offset), api.NewOptInt32(limit)
+		res, err := c.PipelineList(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return res.Pipelines, nil
+	})
+}
+
+// SyncPolicies iterates over the sync policies.
+func (c *Client) SyncPolicies(ctx context.Context) iter.Seq2[api.SyncPolicy, error] {
+	return Paginate(ctx, c.pageSize, func(ctx context.Context, offset, limit int32) ([]api.SyncPolicy, error) {
+		res, err := c.SyncpolicyList(ctx, api.SyncpolicyListParams{Offset: api.NewOptInt32(offset), Limit: api.NewOptInt32(limit)})
+		if err != nil {
+			return nil, err
+		}
+		return res.Policies, nil
+	})
+}
+
+// Jobs iterates over the worker jobs matching the params, newest first.
+func (c *Client) Jobs(ctx context.Context, params api.WorkerJobsListParams) iter.Seq2[api.WorkerJobs, error] {
+	return Paginate(ctx, c.pageSize, func(ctx context.Context, offset, limit int32) ([]api.WorkerJobs, error) {
+		params.Offset, params.Limit = api.NewOptInt32(offset), api.NewOptInt32(limit)
+		res, err := c.WorkerJobsList(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return res.Jobs, nil
+	})
+}
+
+// Files iterates over the files.
+func (c *Client) Files(ctx context.Context) iter.Seq2[api.FileObject, error] {
+	return Paginate(ctx, c.pageSize, func(ctx context.Context, offset, limit int32) ([]api.FileObject, error) {
+		return c.FileList(ctx, api.FileListParams{Offset: api.NewOptInt32(offset), Limit: api.NewOptInt32(limit)})
+	})
+}
+
+// AuditEvents iterates over the audit events matching the params.
+func (c *Client) AuditEvents(ctx context.Context, params api.AuditListParams) iter.Seq2[api.AuditEvent, error] {
+	return Paginate(ctx, c.pageSize, func(ctx context.Context, offset, limit int32) ([]api.AuditEvent, error) {
+		params.Offset, params.Limit = api.NewOptInt32(offset), api.NewOptInt32(limit)
+		return c.AuditList(ctx, params)
+	})
+}
+
+// Workspaces iterates over the workspaces of the caller.
+func (c *Client) Workspaces(ctx context.Context) iter.Seq2[api.Workspace, error] {
+	return Paginate(ctx, c.pageSize, func(ctx context.Context, offset, limit int32) ([]api.Workspace, error) {
+		return c.WorkspaceList(ctx, api.WorkspaceListParams{Offset: api.NewOptInt32(offset), Limit: api.NewOptInt32(limit)})
+	})
+}
+
+// APIKeys iterates over the API keys matching the params.
+func (c *Client) APIKeys(ctx context.Context, params api.ApikeyListParams) iter.Seq2[api.APIKey, error] {
+	return Paginate(ctx, c.pageSize, func(ctx context.Context, offset, limit int32) ([]api.APIKey, error) {
+		params.Offset, params.Limit = api.NewOptInt32(offset), api.NewOptInt32(limit)
+		return c.ApikeyList(ctx, params)
+	})
+}
+
+// Messages iterates over the messages matching the query, its offset and
+// limit are set by the iteration.
+func (c *Client) Messages(ctx context.Context, q api.MessageQuery) iter.Seq2[api.Message, error] {
+	return Paginate(ctx, c.pageSize, func(ctx context.Context, offset, limit int32) ([]api.Message, error) {
+		q.Offset, q.Limit = api.NewOptInt(int(offset)), api.NewOptInt(int(limit))
+		res, err := c.MessageQuery(ctx, &q)
+		if err != nil {
+			return nil, err
+		}
+		return res.Messages, nil
+	})
+}
@@ -0,0 +1,121 @@
+package sdk
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy tells how failed requests are retried. Requests the server
+// refused, answered with 429 or 503, are retried whatever their method, the
+// other failures (502, 504 and connection errors) only for the idempotent
+// methods, so that a create is not sent twice.
+type RetryPolicy struct {
+	// MaxAttempts counts the first attempt, 1 disables the retries
+	MaxAttempts int
+	// MinBackoff is the wait before the first retry, it doubles with each
+	// retry up to MaxBackoff. A Retry-After of the server takes precedence.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+var (
+	// DefaultRetry is the policy of a Client
+	DefaultRetry = RetryPolicy{MaxAttempts: 4, MinBackoff: 200 * time.Millisecond, MaxBackoff: 5 * time.Second}
+	// NoRetry sends every request once
+	NoRetry = RetryPolicy{MaxAttempts: 1}
+)
+
+// backoff returns the wait before the retry following attempt, with jitter.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.MinBackoff << (attempt - 1)
+	if d <= 0 || d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	// up to a quarter less, so that the clients do not retry in step
+	return d - rand.N(d/4+1)
+}
+
+type retryTransport struct {
+	policy RetryPolicy
+	next   http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if attempt >= t.policy.MaxAttempts || !t.retryable(req, resp, err) {
+			return resp, err
+		}
+		// the body is sent again from the start
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		wait := t.policy.backoff(attempt)
+		if resp != nil {
+			if after, ok := retryAfter(resp); ok {
+				wait = min(after, t.policy.MaxBackoff)
+			}
+			// the connection is reused once the body is read
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+			resp.Body.Close()
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *retryTransport) retryable(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if err != nil {
+		return idempotent(req.Method)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent(req.Method)
+	}
+	return false
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryAfter reads the Retry-After header given in seconds or as a date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
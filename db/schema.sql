@@ -20,7 +20,7 @@ CREATE TABLE oauth2_client (
   name VARCHAR NOT NULL, -- Friendly name for admin UI
   provider VARCHAR NOT NULL, -- e.g. "github", "google", "zitadel"
   client_id VARCHAR NOT NULL UNIQUE, -- OAuth2 client ID
-  secret VARCHAR NOT NULL, -- OAuth2 client secret (store encrypted in production)
+  secret VARCHAR NOT NULL, -- OAuth2 client secret, envelope encrypted (enc:v1:...) when a secrets master key is configured
 
   created_at TIMESTAMP WITH TIME ZONE  DEFAULT NOW(), -- When the client was registered
   updated_at TIMESTAMP WITH TIME ZONE  -- Last time client config was updated
@@ -345,3 +345,489 @@ CREATE TABLE IF NOT EXISTS worker_jobs (
                                            started_at         TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
                                            finished_at         TIMESTAMP WITH TIME ZONE
 );
+
+-- 2026-10-19
+-- Storage migration runs, moves file blobs from one storage to another.
+-- checkpoint_uuid is the last file moved, so an interrupted run resumes after it.
+CREATE TABLE IF NOT EXISTS storage_migration (
+                                                 uuid                UUID PRIMARY KEY,
+                                                 source_storage_uuid UUID NOT NULL REFERENCES storage(uuid) ON DELETE CASCADE,
+                                                 target_storage_uuid UUID NOT NULL REFERENCES storage(uuid) ON DELETE CASCADE,
+                                                 status              VARCHAR NOT NULL,            -- "pending", "running", "paused", "done", "failed"
+                                                 older_than_days     INT NOT NULL DEFAULT 0,      -- tiering rule, 0 = move everything
+                                                 delete_source       BOOLEAN NOT NULL DEFAULT FALSE,
+                                                 batch_size          INT NOT NULL DEFAULT 100,
+                                                 checkpoint_uuid     UUID,
+                                                 files_migrated      BIGINT NOT NULL DEFAULT 0,
+                                                 bytes_migrated      BIGINT NOT NULL DEFAULT 0,
+                                                 error               TEXT,
+                                                 created_at          TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+                                                 updated_at          TIMESTAMP WITH TIME ZONE,
+                                                 finished_at         TIMESTAMP WITH TIME ZONE
+);
+
+-- 2026-10-19
+-- Retention: track where a message came from and which message a file belongs to,
+-- so rules can be applied per datasource/pipeline and attachments follow their message.
+ALTER TABLE message
+    ADD COLUMN IF NOT EXISTS pipeline_uuid UUID,
+    ADD COLUMN IF NOT EXISTS datasource_uuid UUID;
+ALTER TABLE "file"
+    ADD COLUMN IF NOT EXISTS message_uuid UUID;
+CREATE INDEX IF NOT EXISTS idx_message_pipeline_created ON message(pipeline_uuid, created_at);
+CREATE INDEX IF NOT EXISTS idx_message_datasource_created ON message(datasource_uuid, created_at);
+CREATE INDEX IF NOT EXISTS idx_file_message ON "file"(message_uuid);
+
+-- Retention policies, enforced by the retention worker job.
+-- A policy without datasource_uuid and pipeline_uuid applies to all messages.
+-- 0 disables a rule.
+CREATE TABLE IF NOT EXISTS retention_policy (
+                                                uuid             UUID PRIMARY KEY,
+                                                name             VARCHAR NOT NULL,
+                                                datasource_uuid  UUID REFERENCES datasource(uuid) ON DELETE CASCADE,
+                                                pipeline_uuid    UUID REFERENCES pipeline(uuid) ON DELETE CASCADE,
+                                                keep_days        INT NOT NULL DEFAULT 0,  -- delete messages older than N days
+                                                keep_last        INT NOT NULL DEFAULT 0,  -- keep only the newest N messages
+                                                attachments_days INT NOT NULL DEFAULT 0,  -- drop attachments older than N days
+                                                is_enabled       BOOLEAN NOT NULL DEFAULT TRUE,
+                                                last_run_at      TIMESTAMP WITH TIME ZONE,
+                                                created_at       TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+                                                updated_at       TIMESTAMP WITH TIME ZONE
+);
+
+-- Subject erasure requests (GDPR), report lists everything that was deleted or anonymised.
+CREATE TABLE IF NOT EXISTS erasure_request (
+                                               uuid        UUID PRIMARY KEY,
+                                               subject     VARCHAR NOT NULL,  -- keyed digest of the email address or phone number
+                                               mode        VARCHAR NOT NULL,  -- "delete" or "anonymize"
+                                               status      VARCHAR NOT NULL,  -- "done" or "failed"
+                                               report      JSONB NOT NULL DEFAULT '{}'::jsonb,
+                                               error       TEXT,
+                                               created_at  TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+                                               finished_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Workspaces: users belong to workspaces and every tenant resource belongs to one.
+-- Isolation is enforced by row level security: the application switches scoped
+-- connections to the shadowapi_tenant role and sets app.workspace_uuid, the
+-- policies below hide rows of other workspaces from that role. workspace_uuid
+-- defaults to the scoped workspace, unscoped system writes land in the default workspace.
+CREATE TABLE IF NOT EXISTS workspace (
+                                         uuid       UUID PRIMARY KEY,
+                                         name       VARCHAR NOT NULL,
+                                         slug       VARCHAR NOT NULL UNIQUE,
+                                         created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+                                         updated_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE TABLE IF NOT EXISTS workspace_member (
+                                                workspace_uuid UUID NOT NULL REFERENCES workspace(uuid) ON DELETE CASCADE,
+                                                user_uuid      UUID NOT NULL REFERENCES "user"(uuid) ON DELETE CASCADE,
+                                                role           VARCHAR NOT NULL DEFAULT 'member', -- see access_policy for the roles
+                                                created_at     TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+                                                PRIMARY KEY (workspace_uuid, user_uuid)
+);
+CREATE INDEX IF NOT EXISTS idx_workspace_member_user ON workspace_member(user_uuid);
+
+INSERT INTO workspace (uuid, name, slug)
+VALUES ('00000000-0000-0000-0000-000000000001', 'Default', 'default')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO workspace_member (workspace_uuid, user_uuid, role)
+SELECT '00000000-0000-0000-0000-000000000001', u.uuid, CASE WHEN u.is_admin THEN 'owner' ELSE 'member' END
+FROM "user" u
+ON CONFLICT DO NOTHING;
+
+CREATE OR REPLACE FUNCTION current_workspace_uuid() RETURNS UUID
+    LANGUAGE sql STABLE AS
+$$ SELECT NULLIF(current_setting('app.workspace_uuid', true), '')::uuid $$;
+
+ALTER TABLE datasource        ADD COLUMN IF NOT EXISTS workspace_uuid UUID NOT NULL DEFAULT COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001') REFERENCES workspace(uuid) ON DELETE CASCADE;
+ALTER TABLE pipeline          ADD COLUMN IF NOT EXISTS workspace_uuid UUID NOT NULL DEFAULT COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001') REFERENCES workspace(uuid) ON DELETE CASCADE;
+ALTER TABLE storage           ADD COLUMN IF NOT EXISTS workspace_uuid UUID NOT NULL DEFAULT COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001') REFERENCES workspace(uuid) ON DELETE CASCADE;
+ALTER TABLE sync_policy       ADD COLUMN IF NOT EXISTS workspace_uuid UUID NOT NULL DEFAULT COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001') REFERENCES workspace(uuid) ON DELETE CASCADE;
+ALTER TABLE "file"            ADD COLUMN IF NOT EXISTS workspace_uuid UUID NOT NULL DEFAULT COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001') REFERENCES workspace(uuid) ON DELETE CASCADE;
+ALTER TABLE message           ADD COLUMN IF NOT EXISTS workspace_uuid UUID NOT NULL DEFAULT COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001') REFERENCES workspace(uuid) ON DELETE CASCADE;
+ALTER TABLE contact           ADD COLUMN IF NOT EXISTS workspace_uuid UUID NOT NULL DEFAULT COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001') REFERENCES workspace(uuid) ON DELETE CASCADE;
+ALTER TABLE scheduler         ADD COLUMN IF NOT EXISTS workspace_uuid UUID NOT NULL DEFAULT COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001') REFERENCES workspace(uuid) ON DELETE CASCADE;
+ALTER TABLE worker_jobs       ADD COLUMN IF NOT EXISTS workspace_uuid UUID NOT NULL DEFAULT COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001') REFERENCES workspace(uuid) ON DELETE CASCADE;
+ALTER TABLE storage_migration ADD COLUMN IF NOT EXISTS workspace_uuid UUID NOT NULL DEFAULT COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001') REFERENCES workspace(uuid) ON DELETE CASCADE;
+ALTER TABLE retention_policy  ADD COLUMN IF NOT EXISTS workspace_uuid UUID NOT NULL DEFAULT COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001') REFERENCES workspace(uuid) ON DELETE CASCADE;
+ALTER TABLE erasure_request   ADD COLUMN IF NOT EXISTS workspace_uuid UUID NOT NULL DEFAULT COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001') REFERENCES workspace(uuid) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_datasource_workspace ON datasource(workspace_uuid);
+CREATE INDEX IF NOT EXISTS idx_pipeline_workspace ON pipeline(workspace_uuid);
+CREATE INDEX IF NOT EXISTS idx_storage_workspace ON storage(workspace_uuid);
+CREATE INDEX IF NOT EXISTS idx_file_workspace ON "file"(workspace_uuid);
+CREATE INDEX IF NOT EXISTS idx_message_workspace_created ON message(workspace_uuid, created_at);
+CREATE INDEX IF NOT EXISTS idx_contact_workspace ON contact(workspace_uuid);
+CREATE INDEX IF NOT EXISTS idx_worker_jobs_workspace ON worker_jobs(workspace_uuid, started_at);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = 'shadowapi_tenant') THEN
+        CREATE ROLE shadowapi_tenant NOLOGIN;
+    END IF;
+END
+$$;
+GRANT shadowapi_tenant TO CURRENT_USER;
+GRANT USAGE ON SCHEMA public TO shadowapi_tenant;
+GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA public TO shadowapi_tenant;
+GRANT USAGE, SELECT ON ALL SEQUENCES IN SCHEMA public TO shadowapi_tenant;
+ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT SELECT, INSERT, UPDATE, DELETE ON TABLES TO shadowapi_tenant;
+ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT USAGE, SELECT ON SEQUENCES TO shadowapi_tenant;
+
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY['datasource', 'pipeline', 'storage', 'sync_policy', 'file', 'message', 'contact',
+                             'scheduler', 'worker_jobs', 'storage_migration', 'retention_policy', 'erasure_request']
+    LOOP
+        EXECUTE format('ALTER TABLE %I ENABLE ROW LEVEL SECURITY', t);
+        EXECUTE format('DROP POLICY IF EXISTS workspace_isolation ON %I', t);
+        EXECUTE format('CREATE POLICY workspace_isolation ON %I TO shadowapi_tenant
+                            USING (workspace_uuid = current_workspace_uuid())
+                            WITH CHECK (workspace_uuid = current_workspace_uuid())', t);
+    END LOOP;
+END
+$$;
+
+-- Access policies: ory/ladon policies evaluated for every API operation. Subjects are
+-- "role:<workspace role>" or "user:<uuid>", resources "shadowapi:<kind>[:<uuid>]".
+-- Built-in policies implement the default roles and are seeded by the server on start.
+CREATE TABLE IF NOT EXISTS access_policy (
+                                             id          VARCHAR PRIMARY KEY,
+                                             description TEXT NOT NULL DEFAULT '',
+                                             subjects    JSONB NOT NULL DEFAULT '[]',
+                                             resources   JSONB NOT NULL DEFAULT '[]',
+                                             actions     JSONB NOT NULL DEFAULT '[]',
+                                             effect      VARCHAR NOT NULL, -- "allow" or "deny"
+                                             conditions  JSONB NOT NULL DEFAULT '{}',
+                                             meta        JSONB,
+                                             is_builtin  BOOLEAN NOT NULL DEFAULT FALSE,
+                                             created_at  TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+                                             updated_at  TIMESTAMP WITH TIME ZONE
+);
+
+-- workspace roles are now owner, admin, operator, readonly and api_client
+UPDATE workspace_member SET role = 'operator' WHERE role = 'member';
+ALTER TABLE workspace_member ALTER COLUMN role SET DEFAULT 'operator';
+
+-- API keys: personal keys act as their user, service account keys (user_uuid NULL)
+-- with their own role. Only the SHA-256 of the key is stored, prefix identifies it in
+-- listings. Keys are confined to their workspace and narrowed by scopes.
+CREATE TABLE IF NOT EXISTS api_key (
+                                       uuid           UUID PRIMARY KEY,
+                                       workspace_uuid UUID NOT NULL DEFAULT COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001') REFERENCES workspace(uuid) ON DELETE CASCADE,
+                                       user_uuid      UUID REFERENCES "user"(uuid) ON DELETE CASCADE,
+                                       name           VARCHAR NOT NULL,
+                                       prefix         VARCHAR NOT NULL,
+                                       hash           VARCHAR NOT NULL UNIQUE,
+                                       role           VARCHAR NOT NULL DEFAULT 'api_client', -- role of service account keys
+                                       scopes         TEXT[] NOT NULL DEFAULT '{}',          -- e.g. messages:read, pipelines:write
+                                       allowed_ips    TEXT[] NOT NULL DEFAULT '{}',          -- IPs or CIDRs, empty allows all
+                                       expires_at     TIMESTAMP WITH TIME ZONE,
+                                       last_used_at   TIMESTAMP WITH TIME ZONE,
+                                       last_used_ip   VARCHAR,
+                                       revoked_at     TIMESTAMP WITH TIME ZONE,
+                                       created_at     TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_api_key_workspace ON api_key(workspace_uuid);
+
+ALTER TABLE api_key ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS workspace_isolation ON api_key;
+CREATE POLICY workspace_isolation ON api_key TO shadowapi_tenant
+    USING (workspace_uuid = current_workspace_uuid())
+    WITH CHECK (workspace_uuid = current_workspace_uuid());
+
+-- Browser login sessions. Only the SHA-256 of the cookie token is stored. A session
+-- ends when revoked, after expires_at (absolute timeout) or when last_seen_at is older
+-- than the idle timeout; every use moves last_seen_at forward.
+CREATE TABLE IF NOT EXISTS user_session (
+                                            uuid         UUID PRIMARY KEY,
+                                            user_uuid    UUID NOT NULL REFERENCES "user"(uuid) ON DELETE CASCADE,
+                                            token_hash   VARCHAR NOT NULL UNIQUE,
+                                            source       VARCHAR NOT NULL, -- "password" or "zitadel"
+                                            user_agent   TEXT NOT NULL DEFAULT '',
+                                            ip           VARCHAR NOT NULL DEFAULT '',
+                                            created_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+                                            last_seen_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+                                            expires_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+                                            revoked_at   TIMESTAMP WITH TIME ZONE
+);
+CREATE INDEX IF NOT EXISTS idx_user_session_user ON user_session(user_uuid);
+
+-- Audit log: one row per mutating API operation and per read of sensitive data.
+-- Rows are append-only, the trigger below rejects updates and deletes for every role.
+-- workspace_uuid is NULL for requests that failed before a workspace was resolved.
+CREATE TABLE IF NOT EXISTS audit_event (
+                                           uuid           UUID PRIMARY KEY,
+                                           workspace_uuid UUID DEFAULT current_workspace_uuid() REFERENCES workspace(uuid) ON DELETE SET NULL,
+                                           occurred_at    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+                                           actor          VARCHAR NOT NULL DEFAULT '',   -- user uuid, "0" for the bearer token, apikey:<uuid>
+                                           source         VARCHAR NOT NULL DEFAULT '',   -- session, bearer, api_key, empty when unauthenticated
+                                           api_key_uuid   UUID,
+                                           operation_id   VARCHAR NOT NULL,
+                                           resource_kind  VARCHAR NOT NULL DEFAULT '',
+                                           action         VARCHAR NOT NULL DEFAULT '',
+                                           resource_id    VARCHAR NOT NULL DEFAULT '',   -- uuid of the object, or the id of an access policy
+                                           params         JSONB NOT NULL DEFAULT '{}',  -- path and query parameters
+                                           diff           JSONB,                         -- redacted request body
+                                           outcome        VARCHAR NOT NULL,              -- success, denied, unauthenticated, error
+                                           status         INT NOT NULL DEFAULT 0,
+                                           error          TEXT NOT NULL DEFAULT '',
+                                           ip             VARCHAR NOT NULL DEFAULT '',
+                                           user_agent     TEXT NOT NULL DEFAULT '',
+                                           duration_ms    INT NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS idx_audit_event_workspace ON audit_event(workspace_uuid, occurred_at);
+CREATE INDEX IF NOT EXISTS idx_audit_event_resource ON audit_event(resource_id);
+
+CREATE OR REPLACE FUNCTION audit_event_append_only() RETURNS TRIGGER
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    RAISE EXCEPTION 'audit_event is append-only';
+END
+$$;
+DROP TRIGGER IF EXISTS audit_event_append_only ON audit_event;
+CREATE TRIGGER audit_event_append_only BEFORE UPDATE OR DELETE ON audit_event
+    FOR EACH ROW EXECUTE FUNCTION audit_event_append_only();
+
+ALTER TABLE audit_event ENABLE ROW LEVEL SECURITY;
+REVOKE UPDATE, DELETE ON audit_event FROM shadowapi_tenant;
+DROP POLICY IF EXISTS workspace_isolation ON audit_event;
+CREATE POLICY workspace_isolation ON audit_event TO shadowapi_tenant
+    USING (workspace_uuid = current_workspace_uuid())
+    WITH CHECK (workspace_uuid = current_workspace_uuid());
+
+-- OAuth2 providers come from a registry in the backend. issuer_url is used by providers
+-- resolving their endpoints with OpenID discovery (zitadel, oidc), scopes replaces the
+-- default scopes of the provider when not empty.
+ALTER TABLE oauth2_client ADD COLUMN IF NOT EXISTS issuer_url VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE oauth2_client ADD COLUMN IF NOT EXISTS scopes TEXT[] NOT NULL DEFAULT '{}';
+-- PKCE code verifier of the login flow
+ALTER TABLE oauth2_state ADD COLUMN IF NOT EXISTS code_verifier VARCHAR NOT NULL DEFAULT '';
+-- account at the provider that granted the token, from the provider's user info
+ALTER TABLE oauth2_subject ADD COLUMN IF NOT EXISTS external_subject VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE oauth2_subject ADD COLUMN IF NOT EXISTS external_email VARCHAR NOT NULL DEFAULT '';
+
+-- Sync cursors of datasources, e.g. the Microsoft Graph delta link of each mail folder.
+-- Removing a cursor makes the next fetch start over.
+CREATE TABLE IF NOT EXISTS datasource_cursor (
+                                                 datasource_uuid UUID NOT NULL REFERENCES datasource(uuid) ON DELETE CASCADE,
+                                                 name            VARCHAR NOT NULL,
+                                                 state           TEXT NOT NULL,
+                                                 workspace_uuid  UUID NOT NULL DEFAULT COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001') REFERENCES workspace(uuid) ON DELETE CASCADE,
+                                                 updated_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+                                                 PRIMARY KEY (datasource_uuid, name)
+);
+
+ALTER TABLE datasource_cursor ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS workspace_isolation ON datasource_cursor;
+CREATE POLICY workspace_isolation ON datasource_cursor TO shadowapi_tenant
+    USING (workspace_uuid = current_workspace_uuid())
+    WITH CHECK (workspace_uuid = current_workspace_uuid());
+
+-- OAuth2 token health, kept up to date by every refresh. status is ok, failing (the last
+-- refreshes failed and are retried with backoff) or needs_reauth (the grant is gone or
+-- lacks scopes, a user has to log in again). scopes is NULL when the provider did not
+-- report the granted scopes.
+ALTER TABLE oauth2_token ADD COLUMN IF NOT EXISTS status VARCHAR NOT NULL DEFAULT 'ok';
+ALTER TABLE oauth2_token ADD COLUMN IF NOT EXISTS expires_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE oauth2_token ADD COLUMN IF NOT EXISTS last_refreshed_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE oauth2_token ADD COLUMN IF NOT EXISTS last_failed_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE oauth2_token ADD COLUMN IF NOT EXISTS failure_count INT NOT NULL DEFAULT 0;
+ALTER TABLE oauth2_token ADD COLUMN IF NOT EXISTS last_error TEXT NOT NULL DEFAULT '';
+ALTER TABLE oauth2_token ADD COLUMN IF NOT EXISTS scopes TEXT[];
+ALTER TABLE oauth2_token ADD COLUMN IF NOT EXISTS missing_scopes TEXT[] NOT NULL DEFAULT '{}';
+CREATE INDEX IF NOT EXISTS idx_oauth2_token_expires_at ON oauth2_token(expires_at);
+
+-- Datasources whose token needs a new login. Their schedulers are paused with
+-- paused_reason 'needs_reauth' and resumed by the next successful login.
+ALTER TABLE datasource ADD COLUMN IF NOT EXISTS needs_reauth BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE datasource ADD COLUMN IF NOT EXISTS reauth_reason TEXT NOT NULL DEFAULT '';
+ALTER TABLE scheduler ADD COLUMN IF NOT EXISTS paused_reason VARCHAR NOT NULL DEFAULT '';
+
+-- Events for integrations, e.g. datasource.needs_reauth. Rows are written in the same
+-- transaction as the change and delivered to the webhooks of the workspace afterwards.
+CREATE TABLE IF NOT EXISTS event (
+                                     uuid           UUID PRIMARY KEY,              -- v7
+                                     workspace_uuid UUID NOT NULL DEFAULT COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001') REFERENCES workspace(uuid) ON DELETE CASCADE,
+                                     type           VARCHAR NOT NULL,
+                                     subject_kind   VARCHAR NOT NULL DEFAULT '',   -- datasource, oauth2_token, ...
+                                     subject_uuid   UUID,
+                                     data           JSONB NOT NULL DEFAULT '{}',
+                                     created_at     TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_event_workspace ON event(workspace_uuid, uuid);
+
+-- Webhooks receive the events of their workspace as signed HTTP POSTs. An empty
+-- event_types list subscribes to every event. last_event_uuid is the last delivered
+-- event, a failed delivery is retried on the next run before any later event.
+CREATE TABLE IF NOT EXISTS webhook (
+                                       uuid            UUID PRIMARY KEY,
+                                       workspace_uuid  UUID NOT NULL DEFAULT COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001') REFERENCES workspace(uuid) ON DELETE CASCADE,
+                                       name            VARCHAR NOT NULL,
+                                       url             VARCHAR NOT NULL,
+                                       secret          VARCHAR NOT NULL DEFAULT '',  -- encrypted HMAC key
+                                       event_types     TEXT[] NOT NULL DEFAULT '{}',
+                                       is_enabled      BOOLEAN NOT NULL DEFAULT TRUE,
+                                       last_event_uuid UUID,
+                                       last_status     INT NOT NULL DEFAULT 0,
+                                       last_error      TEXT NOT NULL DEFAULT '',
+                                       failure_count   INT NOT NULL DEFAULT 0,
+                                       created_at      TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+                                       updated_at      TIMESTAMP WITH TIME ZONE
+);
+
+ALTER TABLE event ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS workspace_isolation ON event;
+CREATE POLICY workspace_isolation ON event TO shadowapi_tenant
+    USING (workspace_uuid = current_workspace_uuid())
+    WITH CHECK (workspace_uuid = current_workspace_uuid());
+
+ALTER TABLE webhook ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS workspace_isolation ON webhook;
+CREATE POLICY workspace_isolation ON webhook TO shadowapi_tenant
+    USING (workspace_uuid = current_workspace_uuid())
+    WITH CHECK (workspace_uuid = current_workspace_uuid());
+
+-- Schedules: cron expressions are evaluated in the IANA timezone of the scheduler,
+-- interval schedules run every interval_seconds and one_time schedules run once at
+-- run_at and are disabled afterwards. jitter_seconds adds up to that many seconds to
+-- each computed run. next_run is NULL until the first run is computed.
+ALTER TABLE scheduler ADD COLUMN IF NOT EXISTS interval_seconds INT;
+ALTER TABLE scheduler ADD COLUMN IF NOT EXISTS jitter_seconds INT NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS idx_scheduler_next_run ON scheduler(next_run) WHERE is_enabled AND NOT is_paused;
+
+-- What a due scheduler does while its previous job is queued or running: skip the run,
+-- queue another job, or cancel the previous job. Jobs are recorded in worker_jobs as
+-- queued when published.
+ALTER TABLE scheduler ADD COLUMN IF NOT EXISTS overlap_policy VARCHAR NOT NULL DEFAULT 'skip';
+CREATE INDEX IF NOT EXISTS idx_worker_jobs_active ON worker_jobs(scheduler_uuid) WHERE finished_at IS NULL;
+
+-- Semantic search: pipelines with an embedder node cut the message bodies and the text of
+-- their attachments into chunks embedded by the configured provider. embedding holds the
+-- cosine half of the hybrid ranking, terms (distinct) and length (in terms) the BM25 half.
+-- Vectors of every model share the column, a search only compares those of its model.
+CREATE EXTENSION IF NOT EXISTS vector;
+CREATE TABLE IF NOT EXISTS message_chunk (
+                                             uuid           UUID PRIMARY KEY,
+                                             workspace_uuid UUID NOT NULL REFERENCES workspace(uuid) ON DELETE CASCADE,
+                                             message_uuid   UUID NOT NULL REFERENCES message(uuid) ON DELETE CASCADE,
+                                             file_uuid      UUID,                -- attachment the text was extracted from, NULL for the body
+                                             seq            INT NOT NULL,
+                                             content        TEXT NOT NULL,
+                                             terms          TEXT[] NOT NULL,
+                                             length         INT NOT NULL,
+                                             model          VARCHAR NOT NULL,
+                                             embedding      vector NOT NULL,
+                                             created_at     TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_message_chunk_message ON message_chunk(message_uuid);
+CREATE INDEX IF NOT EXISTS idx_message_chunk_workspace_model ON message_chunk(workspace_uuid, model);
+CREATE INDEX IF NOT EXISTS idx_message_chunk_terms ON message_chunk USING GIN (terms);
+
+ALTER TABLE message_chunk ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS workspace_isolation ON message_chunk;
+CREATE POLICY workspace_isolation ON message_chunk TO shadowapi_tenant
+    USING (workspace_uuid = current_workspace_uuid())
+    WITH CHECK (workspace_uuid = current_workspace_uuid());
+
+-- Files written by the worker storages before they recorded their storage: take it from
+-- the pipeline of their message, else from the only storage of that type in the workspace.
+UPDATE "file" f SET storage_uuid = s.uuid
+FROM message m
+JOIN pipeline p ON p.uuid = m.pipeline_uuid
+JOIN storage s ON s.uuid = p.storage_uuid
+WHERE f.storage_uuid IS NULL AND f.message_uuid = m.uuid AND s.type = f.storage_type;
+UPDATE "file" f SET storage_uuid = s.uuid
+FROM storage s
+WHERE f.storage_uuid IS NULL AND s.type = f.storage_type AND s.workspace_uuid = f.workspace_uuid
+  AND (SELECT count(*) FROM storage o WHERE o.type = s.type AND o.workspace_uuid = s.workspace_uuid) = 1;
+
+-- Erasure requests keep a keyed digest of their subject, never the subject itself:
+-- redact the ones recorded in plaintext.
+UPDATE erasure_request SET subject = '********' WHERE subject NOT LIKE 'hmac:v1:%';
+UPDATE erasure_request SET report = report - 'subject' WHERE report ? 'subject';
+
+-- Storage migrations also move message bodies: body_file_uuid points to the file holding
+-- the body in a storage, body is empty once the copy in the table was dropped (the free
+-- text message filter then only sees the subject and sender, erasures read the file). A
+-- running migration heartbeats updated_at, a run may take it over once the heartbeat is stale.
+ALTER TABLE message ADD COLUMN IF NOT EXISTS body_file_uuid UUID;
+ALTER TABLE storage_migration
+    ADD COLUMN IF NOT EXISTS message_checkpoint_uuid UUID,
+    ADD COLUMN IF NOT EXISTS messages_migrated BIGINT NOT NULL DEFAULT 0;
+
+-- Webhook deliveries follow the transaction ids of the events, not their uuid: an event
+-- is only delivered once every transaction that started before its own has ended, so a
+-- later committed event with a smaller uuid is never skipped. The cursor of a webhook is
+-- (last_event_txid, last_event_uuid). A dispatcher leases a webhook until locked_until
+-- while it posts, without holding its row locked.
+ALTER TABLE event ADD COLUMN IF NOT EXISTS txid BIGINT NOT NULL DEFAULT pg_current_xact_id()::text::bigint;
+CREATE INDEX IF NOT EXISTS idx_event_workspace_txid ON event(workspace_uuid, txid, uuid);
+ALTER TABLE webhook
+    ADD COLUMN IF NOT EXISTS last_event_txid BIGINT,
+    ADD COLUMN IF NOT EXISTS locked_until TIMESTAMP WITH TIME ZONE;
+UPDATE webhook w SET last_event_txid = COALESCE(
+    (SELECT max(e.txid) FROM event e WHERE e.workspace_uuid = w.workspace_uuid AND e.uuid <= w.last_event_uuid), 0)
+WHERE w.last_event_txid IS NULL AND w.last_event_uuid IS NOT NULL;
+
+-- Jobs started on demand have no scheduler: scheduler_uuid is NULL for them, manual
+-- pipeline runs record their pipeline in pipeline_uuid, scheduled ones record both.
+-- Manual runs used to be recorded with the pipeline as their scheduler.
+ALTER TABLE worker_jobs ALTER COLUMN scheduler_uuid DROP NOT NULL;
+ALTER TABLE worker_jobs ADD COLUMN IF NOT EXISTS pipeline_uuid UUID;
+CREATE INDEX IF NOT EXISTS idx_worker_jobs_pipeline ON worker_jobs(pipeline_uuid, started_at) WHERE pipeline_uuid IS NOT NULL;
+UPDATE worker_jobs j SET pipeline_uuid = j.scheduler_uuid, scheduler_uuid = NULL
+WHERE j.scheduler_uuid IN (SELECT p.uuid FROM pipeline p);
+UPDATE worker_jobs j SET pipeline_uuid = s.pipeline_uuid
+FROM scheduler s
+WHERE j.pipeline_uuid IS NULL AND s.uuid = j.scheduler_uuid;
+-- Storage migrations used to be recorded with the migration as their scheduler.
+UPDATE worker_jobs j SET scheduler_uuid = NULL
+WHERE j.scheduler_uuid IN (SELECT m.uuid FROM storage_migration m);
+
+-- OAuth2 clients, tokens and login states belong to a workspace like the datasources using
+-- them, with the same row level security. Tokens move to the workspace of their datasource,
+-- clients to the workspace of their datasources when those share one; a client used from
+-- several workspaces stays in the default one and is registered again in the others.
+-- client_id is unique per workspace, so that workspaces may use the same application.
+ALTER TABLE oauth2_client ADD COLUMN IF NOT EXISTS workspace_uuid UUID NOT NULL DEFAULT COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001') REFERENCES workspace(uuid) ON DELETE CASCADE;
+ALTER TABLE oauth2_token  ADD COLUMN IF NOT EXISTS workspace_uuid UUID NOT NULL DEFAULT COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001') REFERENCES workspace(uuid) ON DELETE CASCADE;
+ALTER TABLE oauth2_state  ADD COLUMN IF NOT EXISTS workspace_uuid UUID NOT NULL DEFAULT COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001') REFERENCES workspace(uuid) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_oauth2_client_workspace ON oauth2_client(workspace_uuid);
+CREATE INDEX IF NOT EXISTS idx_oauth2_token_workspace ON oauth2_token(workspace_uuid);
+ALTER TABLE oauth2_client DROP CONSTRAINT IF EXISTS oauth2_client_client_id_key;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_oauth2_client_workspace_client_id ON oauth2_client(workspace_uuid, client_id);
+
+UPDATE oauth2_token t SET workspace_uuid = d.workspace_uuid
+FROM datasource d
+WHERE t.workspace_uuid = '00000000-0000-0000-0000-000000000001' AND d.settings->>'oauth2_token_uuid' = t.uuid::text
+  AND d.workspace_uuid <> t.workspace_uuid;
+UPDATE oauth2_client c SET workspace_uuid = u.workspace_uuid
+FROM (SELECT d.settings->>'oauth2_client_uuid' AS client_uuid, min(d.workspace_uuid::text)::uuid AS workspace_uuid
+      FROM datasource d
+      WHERE d.settings->>'oauth2_client_uuid' IS NOT NULL
+      GROUP BY 1
+      HAVING count(DISTINCT d.workspace_uuid) = 1) u
+WHERE c.workspace_uuid = '00000000-0000-0000-0000-000000000001' AND u.client_uuid = c.uuid::text
+  AND u.workspace_uuid <> c.workspace_uuid;
+
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY['oauth2_client', 'oauth2_token', 'oauth2_state']
+    LOOP
+        EXECUTE format('ALTER TABLE %I ENABLE ROW LEVEL SECURITY', t);
+        EXECUTE format('DROP POLICY IF EXISTS workspace_isolation ON %I', t);
+        EXECUTE format('CREATE POLICY workspace_isolation ON %I TO shadowapi_tenant
+                            USING (workspace_uuid = current_workspace_uuid())
+                            WITH CHECK (workspace_uuid = current_workspace_uuid())', t);
+    END LOOP;
+END
+$$;
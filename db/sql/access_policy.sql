@@ -0,0 +1,77 @@
+-- name: CreateAccessPolicy :one
+INSERT INTO access_policy (
+    id,
+    description,
+    subjects,
+    resources,
+    actions,
+    effect,
+    conditions,
+    meta,
+    is_builtin,
+    created_at,
+    updated_at
+) VALUES (
+    sqlc.arg('id'),
+    sqlc.arg('description'),
+    sqlc.arg('subjects'),
+    sqlc.arg('resources'),
+    sqlc.arg('actions'),
+    sqlc.arg('effect'),
+    sqlc.arg('conditions'),
+    sqlc.narg('meta'),
+    sqlc.arg('is_builtin'),
+    NOW(),
+    NOW()
+) RETURNING *;
+
+-- name: SeedAccessPolicy :exec
+-- Inserts a built-in policy unless it exists, so edits made through the API
+-- survive restarts.
+INSERT INTO access_policy (
+    id,
+    description,
+    subjects,
+    resources,
+    actions,
+    effect,
+    conditions,
+    is_builtin,
+    created_at,
+    updated_at
+) VALUES (
+    sqlc.arg('id'),
+    sqlc.arg('description'),
+    sqlc.arg('subjects'),
+    sqlc.arg('resources'),
+    sqlc.arg('actions'),
+    sqlc.arg('effect'),
+    sqlc.arg('conditions'),
+    TRUE,
+    NOW(),
+    NOW()
+) ON CONFLICT (id) DO NOTHING;
+
+-- name: GetAccessPolicy :one
+SELECT * FROM access_policy WHERE id = sqlc.arg('id');
+
+-- name: GetAccessPolicies :many
+SELECT * FROM access_policy
+ORDER BY is_builtin DESC, id ASC
+LIMIT NULLIF(sqlc.arg('limit')::int, 0)
+    OFFSET sqlc.arg('offset')::int;
+
+-- name: UpdateAccessPolicy :execrows
+UPDATE access_policy SET
+    description = sqlc.arg('description'),
+    subjects = sqlc.arg('subjects'),
+    resources = sqlc.arg('resources'),
+    actions = sqlc.arg('actions'),
+    effect = sqlc.arg('effect'),
+    conditions = sqlc.arg('conditions'),
+    meta = sqlc.narg('meta'),
+    updated_at = NOW()
+WHERE id = sqlc.arg('id');
+
+-- name: DeleteAccessPolicy :execrows
+DELETE FROM access_policy WHERE id = sqlc.arg('id');
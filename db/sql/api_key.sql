@@ -0,0 +1,51 @@
+-- name: CreateAPIKey :one
+INSERT INTO api_key (
+    uuid,
+    user_uuid,
+    name,
+    prefix,
+    hash,
+    role,
+    scopes,
+    allowed_ips,
+    expires_at,
+    created_at
+) VALUES (
+    sqlc.arg('uuid')::uuid,
+    sqlc.narg('user_uuid')::uuid,
+    sqlc.arg('name'),
+    sqlc.arg('prefix'),
+    sqlc.arg('hash'),
+    sqlc.arg('role'),
+    sqlc.arg('scopes')::text[],
+    sqlc.arg('allowed_ips')::text[],
+    sqlc.narg('expires_at'),
+    NOW()
+) RETURNING *;
+
+-- name: GetAPIKeyByHash :one
+SELECT * FROM api_key WHERE hash = sqlc.arg('hash');
+
+-- name: GetAPIKey :one
+SELECT * FROM api_key WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: GetAPIKeys :many
+SELECT * FROM api_key
+WHERE
+    (sqlc.narg('user_uuid')::uuid IS NULL OR user_uuid = sqlc.narg('user_uuid')::uuid)
+    AND (sqlc.arg('include_revoked')::bool OR revoked_at IS NULL)
+ORDER BY created_at DESC
+LIMIT NULLIF(sqlc.arg('limit')::int, 0)
+    OFFSET sqlc.arg('offset')::int;
+
+-- name: TouchAPIKey :exec
+-- Records the last use at most once a minute per key.
+UPDATE api_key SET
+    last_used_at = NOW(),
+    last_used_ip = sqlc.arg('last_used_ip')
+WHERE uuid = sqlc.arg('uuid')::uuid
+    AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute' OR last_used_ip IS DISTINCT FROM sqlc.arg('last_used_ip'));
+
+-- name: RevokeAPIKey :execrows
+UPDATE api_key SET revoked_at = NOW()
+WHERE uuid = sqlc.arg('uuid')::uuid AND revoked_at IS NULL;
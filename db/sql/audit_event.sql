@@ -0,0 +1,57 @@
+-- name: CreateAuditEvent :exec
+INSERT INTO audit_event (
+    uuid,
+    workspace_uuid,
+    occurred_at,
+    actor,
+    source,
+    api_key_uuid,
+    operation_id,
+    resource_kind,
+    action,
+    resource_id,
+    params,
+    diff,
+    outcome,
+    status,
+    error,
+    ip,
+    user_agent,
+    duration_ms
+) VALUES (
+    sqlc.arg('uuid')::uuid,
+    sqlc.narg('workspace_uuid')::uuid,
+    sqlc.arg('occurred_at'),
+    sqlc.arg('actor'),
+    sqlc.arg('source'),
+    sqlc.narg('api_key_uuid')::uuid,
+    sqlc.arg('operation_id'),
+    sqlc.arg('resource_kind'),
+    sqlc.arg('action'),
+    sqlc.arg('resource_id'),
+    sqlc.arg('params'),
+    sqlc.narg('diff'),
+    sqlc.arg('outcome'),
+    sqlc.arg('status'),
+    sqlc.arg('error'),
+    sqlc.arg('ip'),
+    sqlc.arg('user_agent'),
+    sqlc.arg('duration_ms')
+);
+
+-- name: GetAuditEvents :many
+SELECT * FROM audit_event
+WHERE
+    (sqlc.narg('actor')::varchar IS NULL OR actor = sqlc.narg('actor')::varchar)
+    AND (sqlc.narg('source')::varchar IS NULL OR source = sqlc.narg('source')::varchar)
+    AND (sqlc.narg('operation_id')::varchar IS NULL OR operation_id = sqlc.narg('operation_id')::varchar)
+    AND (sqlc.narg('resource_kind')::varchar IS NULL OR resource_kind = sqlc.narg('resource_kind')::varchar)
+    AND (sqlc.narg('resource_id')::varchar IS NULL OR resource_id = sqlc.narg('resource_id')::varchar)
+    AND (sqlc.narg('outcome')::varchar IS NULL OR outcome = sqlc.narg('outcome')::varchar)
+    AND (sqlc.narg('since')::timestamptz IS NULL OR occurred_at >= sqlc.narg('since')::timestamptz)
+    AND (sqlc.narg('until')::timestamptz IS NULL OR occurred_at < sqlc.narg('until')::timestamptz)
+    -- keyset pagination for exports, event uuids are time ordered
+    AND (sqlc.narg('before_uuid')::uuid IS NULL OR uuid < sqlc.narg('before_uuid')::uuid)
+ORDER BY uuid DESC
+LIMIT NULLIF(sqlc.arg('limit')::int, 0)
+    OFFSET sqlc.arg('offset')::int;
@@ -76,3 +76,25 @@ WHERE uuid = sqlc.arg('uuid')::uuid;
 
 -- name: DeleteDatasource :exec
 DELETE FROM datasource WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: FlagDatasourcesNeedsReauth :many
+-- Flags the datasources using the OAuth2 client, returning the ones not flagged before.
+UPDATE datasource SET
+    needs_reauth = TRUE,
+    reauth_reason = sqlc.arg('reason'),
+    updated_at = NOW()
+WHERE settings->>'oauth2_client_uuid' = sqlc.arg('client_uuid')::text AND NOT needs_reauth
+RETURNING *;
+
+-- name: ClearDatasourcesNeedsReauth :many
+UPDATE datasource SET
+    needs_reauth = FALSE,
+    reauth_reason = '',
+    updated_at = NOW()
+WHERE settings->>'oauth2_client_uuid' = sqlc.arg('client_uuid')::text AND needs_reauth
+RETURNING *;
+
+-- name: CountDatasourcesWithClient :one
+SELECT count(*)
+FROM datasource
+WHERE settings->>'oauth2_client_uuid' = sqlc.arg('client_uuid')::text;
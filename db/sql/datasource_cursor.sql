@@ -0,0 +1,13 @@
+-- name: GetDatasourceCursors :many
+SELECT * FROM datasource_cursor
+WHERE datasource_uuid = sqlc.arg('datasource_uuid')::uuid;
+
+-- name: SetDatasourceCursor :exec
+INSERT INTO datasource_cursor (datasource_uuid, name, state, updated_at)
+VALUES (sqlc.arg('datasource_uuid')::uuid, sqlc.arg('name'), sqlc.arg('state'), NOW())
+ON CONFLICT (datasource_uuid, name) DO UPDATE
+SET state = EXCLUDED.state, updated_at = NOW();
+
+-- name: DeleteDatasourceCursor :exec
+DELETE FROM datasource_cursor
+WHERE datasource_uuid = sqlc.arg('datasource_uuid')::uuid AND name = sqlc.arg('name');
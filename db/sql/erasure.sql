@@ -0,0 +1,96 @@
+-- name: FindMessageUUIDsBySubject :many
+SELECT uuid
+FROM message
+WHERE
+    sender ~* sqlc.arg('pattern')::text OR
+    array_to_string(recipients, ',') ~* sqlc.arg('pattern')::text OR
+    subject ~* sqlc.arg('pattern')::text OR
+    body ~* sqlc.arg('pattern')::text OR
+    COALESCE(body_parsed::text, '') ~* sqlc.arg('pattern')::text OR
+    COALESCE(meta::text, '') ~* sqlc.arg('pattern')::text;
+
+-- name: AnonymizeMessages :execrows
+UPDATE message SET
+    sender = regexp_replace(sender, sqlc.arg('pattern')::text, sqlc.arg('replacement')::text, 'gi'),
+    recipients = ARRAY(
+        SELECT regexp_replace(r, sqlc.arg('pattern')::text, sqlc.arg('replacement')::text, 'gi')
+        FROM unnest(recipients) AS r
+    ),
+    subject = regexp_replace(subject, sqlc.arg('pattern')::text, sqlc.arg('replacement')::text, 'gi'),
+    body = regexp_replace(body, sqlc.arg('pattern')::text, sqlc.arg('replacement')::text, 'gi'),
+    body_parsed = NULL,
+    meta = NULL,
+    forward_meta = NULL,
+    attachments = NULL,
+    updated_at = NOW()
+WHERE uuid = ANY(sqlc.arg('uuids')::uuid[]);
+
+-- name: FindContactUUIDsBySubject :many
+SELECT uuid
+FROM contact
+WHERE concat_ws(' ',
+    email1, email2, email3, email4, email5, email_search, emails::text,
+    phone1, phone2, phone3, phone4, phone5, phone_search, phones::text,
+    whatsapp, telegram, messengers::text
+) ~* sqlc.arg('pattern')::text;
+
+-- name: AnonymizeContacts :execrows
+UPDATE contact SET
+    email1 = CASE WHEN email1 ~* sqlc.arg('pattern')::text THEN NULL ELSE email1 END,
+    email2 = CASE WHEN email2 ~* sqlc.arg('pattern')::text THEN NULL ELSE email2 END,
+    email3 = CASE WHEN email3 ~* sqlc.arg('pattern')::text THEN NULL ELSE email3 END,
+    email4 = CASE WHEN email4 ~* sqlc.arg('pattern')::text THEN NULL ELSE email4 END,
+    email5 = CASE WHEN email5 ~* sqlc.arg('pattern')::text THEN NULL ELSE email5 END,
+    phone1 = CASE WHEN phone1 ~* sqlc.arg('pattern')::text THEN NULL ELSE phone1 END,
+    phone2 = CASE WHEN phone2 ~* sqlc.arg('pattern')::text THEN NULL ELSE phone2 END,
+    phone3 = CASE WHEN phone3 ~* sqlc.arg('pattern')::text THEN NULL ELSE phone3 END,
+    phone4 = CASE WHEN phone4 ~* sqlc.arg('pattern')::text THEN NULL ELSE phone4 END,
+    phone5 = CASE WHEN phone5 ~* sqlc.arg('pattern')::text THEN NULL ELSE phone5 END,
+    whatsapp = CASE WHEN whatsapp ~* sqlc.arg('pattern')::text THEN NULL ELSE whatsapp END,
+    telegram = CASE WHEN telegram ~* sqlc.arg('pattern')::text THEN NULL ELSE telegram END,
+    email_search = regexp_replace(email_search, sqlc.arg('pattern')::text, '', 'gi'),
+    phone_search = regexp_replace(phone_search, sqlc.arg('pattern')::text, '', 'gi'),
+    messengers_search = regexp_replace(messengers_search, sqlc.arg('pattern')::text, '', 'gi'),
+    emails = CASE WHEN emails::text ~* sqlc.arg('pattern')::text THEN NULL ELSE emails END,
+    phones = CASE WHEN phones::text ~* sqlc.arg('pattern')::text THEN NULL ELSE phones END,
+    messengers = CASE WHEN messengers::text ~* sqlc.arg('pattern')::text THEN NULL ELSE messengers END,
+    edit_date = NOW()
+WHERE uuid = ANY(sqlc.arg('uuids')::text[]);
+
+-- name: DeleteContactsByUUIDs :execrows
+DELETE FROM contact WHERE uuid = ANY(sqlc.arg('uuids')::text[]);
+
+-- name: CreateErasureRequest :one
+INSERT INTO erasure_request (
+    uuid,
+    subject,
+    mode,
+    status,
+    report,
+    error,
+    created_at,
+    finished_at
+) VALUES (
+    sqlc.arg('uuid')::uuid,
+    sqlc.arg('subject'),
+    sqlc.arg('mode'),
+    sqlc.arg('status'),
+    sqlc.arg('report'),
+    sqlc.arg('error'),
+    sqlc.arg('created_at'),
+    NOW()
+) RETURNING *;
+
+-- name: GetErasureRequest :one
+SELECT
+    sqlc.embed(erasure_request)
+FROM erasure_request
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: GetErasureRequests :many
+SELECT
+    sqlc.embed(erasure_request)
+FROM erasure_request
+ORDER BY created_at DESC
+LIMIT NULLIF(sqlc.arg('limit')::int, 0)
+    OFFSET sqlc.arg('offset')::int;
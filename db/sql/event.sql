@@ -0,0 +1,48 @@
+-- name: CreateEvent :exec
+INSERT INTO event (
+    uuid,
+    workspace_uuid,
+    type,
+    subject_kind,
+    subject_uuid,
+    data,
+    created_at
+) VALUES (
+    sqlc.arg('uuid')::uuid,
+    COALESCE(sqlc.narg('workspace_uuid')::uuid, current_workspace_uuid(), '00000000-0000-0000-0000-000000000001'),
+    sqlc.arg('type'),
+    sqlc.arg('subject_kind'),
+    sqlc.narg('subject_uuid')::uuid,
+    sqlc.arg('data'),
+    NOW()
+);
+
+-- name: ListEvents :many
+SELECT *
+FROM event
+WHERE
+    (NULLIF(sqlc.arg('type')::text, '') IS NULL OR type = sqlc.arg('type')::text) AND
+    (NULLIF(sqlc.arg('subject_uuid')::text, '') IS NULL OR subject_uuid::text = sqlc.arg('subject_uuid')::text) AND
+    (sqlc.narg('after_uuid')::uuid IS NULL OR uuid > sqlc.narg('after_uuid')::uuid)
+ORDER BY uuid DESC
+LIMIT NULLIF(sqlc.arg('limit')::int, 0)
+OFFSET sqlc.arg('offset')::int;
+
+-- name: GetWebhookEvents :many
+-- Events of the workspace after the cursor of a webhook, in transaction order. Events
+-- of transactions still running, or started after the oldest running one, wait: they
+-- may be followed by events of earlier transactions.
+SELECT *
+FROM event
+WHERE
+    workspace_uuid = sqlc.arg('workspace_uuid')::uuid AND
+    (sqlc.narg('after_txid')::bigint IS NULL OR
+        (txid, uuid) > (sqlc.narg('after_txid')::bigint, COALESCE(sqlc.narg('after_uuid')::uuid, '00000000-0000-0000-0000-000000000000'))) AND
+    txid < pg_snapshot_xmin(pg_current_snapshot())::text::bigint AND
+    created_at >= sqlc.arg('created_after')::timestamptz
+ORDER BY txid, uuid
+LIMIT sqlc.arg('limit')::int;
+
+-- name: DeleteEventsBefore :exec
+DELETE FROM event
+WHERE created_at < sqlc.arg('before')::timestamptz;
@@ -12,6 +12,7 @@ INSERT INTO "file" (
     raw_headers,
     has_raw_email,
     is_inline,
+    message_uuid,
     created_at,
     updated_at
 ) VALUES (
@@ -27,6 +28,7 @@ INSERT INTO "file" (
     sqlc.arg('raw_headers'),
     sqlc.arg('has_raw_email'),
     sqlc.arg('is_inline'),
+    sqlc.narg('message_uuid')::uuid,
           NOW(),
              NOW()
          ) RETURNING *;
@@ -117,3 +119,25 @@ WHERE uuid = sqlc.arg('uuid')::uuid;
 -- name: DeleteFile :exec
 DELETE FROM "file"
 WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: GetFilesToMigrate :many
+SELECT
+    sqlc.embed(file)
+FROM "file"
+WHERE
+    storage_uuid = sqlc.arg('storage_uuid')::uuid
+  AND (sqlc.narg('after_uuid')::uuid IS NULL OR uuid > sqlc.narg('after_uuid')::uuid)
+  AND (sqlc.narg('created_before')::timestamptz IS NULL OR created_at < sqlc.narg('created_before')::timestamptz)
+ORDER BY uuid ASC
+LIMIT sqlc.arg('limit')::int;
+
+-- name: UpdateFileLocation :exec
+UPDATE "file"
+SET
+    storage_type = sqlc.arg('storage_type'),
+    storage_uuid = sqlc.arg('storage_uuid')::uuid,
+    data         = sqlc.arg('data'),
+    path         = sqlc.arg('path'),
+    size         = COALESCE(sqlc.narg('size'), size),
+    updated_at   = NOW()
+WHERE uuid = sqlc.arg('uuid')::uuid;
@@ -19,6 +19,8 @@ INSERT INTO message (
     forward_meta,
     meta,
     external_message_id,
+    pipeline_uuid,
+    datasource_uuid,
     created_at,
     updated_at
 ) VALUES (
@@ -41,6 +43,8 @@ INSERT INTO message (
              sqlc.arg('forward_meta'),
              sqlc.arg('meta'),
     sqlc.arg('external_message_id'),
+    sqlc.narg('pipeline_uuid')::uuid,
+    sqlc.narg('datasource_uuid')::uuid,
              NOW(),
              NOW()
          ) RETURNING *;
@@ -66,9 +70,19 @@ WITH filtered_messages AS (
     WHERE
         (NULLIF(sqlc.arg('type'), '') IS NULL OR m.type = sqlc.arg('type')) AND
         (NULLIF(sqlc.arg('format'), '') IS NULL OR m.format = sqlc.arg('format')) AND
---         (NULLIF(sqlc.arg('chat_uuid'), '') IS NULL OR sp.chat_uuid = sqlc.arg('chat_uuid')::uuid) AND
---         (NULLIF(sqlc.arg('thread_uuid'), '') IS NULL OR sp.thread_uuid = sqlc.arg('thread_uuid')::uuid) AND
-        (NULLIF(sqlc.arg('sender'), '') IS NULL OR m.sender = sqlc.arg('sender'))
+        (sqlc.narg('chat_uuid')::uuid IS NULL OR m.chat_uuid = sqlc.narg('chat_uuid')::uuid) AND
+        (sqlc.narg('thread_uuid')::uuid IS NULL OR m.thread_uuid = sqlc.narg('thread_uuid')::uuid) AND
+        (NULLIF(sqlc.arg('sender'), '') IS NULL OR m.sender = sqlc.arg('sender')) AND
+        (sqlc.narg('pipeline_uuid')::uuid IS NULL OR m.pipeline_uuid = sqlc.narg('pipeline_uuid')::uuid) AND
+        -- free text, matched against the subject, the body and the sender
+        (NULLIF(sqlc.arg('search')::text, '') IS NULL OR
+            m.subject ILIKE '%' || sqlc.arg('search')::text || '%' OR
+            m.body ILIKE '%' || sqlc.arg('search')::text || '%' OR
+            m.sender ILIKE '%' || sqlc.arg('search')::text || '%') AND
+        (sqlc.narg('created_after')::timestamptz IS NULL OR m.created_at >= sqlc.narg('created_after')::timestamptz) AND
+        (sqlc.narg('created_before')::timestamptz IS NULL OR m.created_at < sqlc.narg('created_before')::timestamptz) AND
+        -- the messages found by a semantic search
+        (sqlc.narg('uuids')::uuid[] IS NULL OR m.uuid = ANY(sqlc.narg('uuids')::uuid[]))
 )
 SELECT
     *,
@@ -94,6 +108,8 @@ SET
     recipients                = sqlc.arg('recipients'),
     subject                   = sqlc.arg('subject'),
     body                      = sqlc.arg('body'),
+    -- a new body replaces the one moved to a storage
+    body_file_uuid            = CASE WHEN sqlc.arg('body') = '' THEN body_file_uuid END,
     body_parsed               = sqlc.arg('body_parsed'),
     reactions                 = sqlc.arg('reactions'),
     attachments               = sqlc.arg('attachments'),
@@ -111,3 +127,45 @@ WHERE uuid = sqlc.arg('uuid')::uuid;
 DELETE FROM message
 WHERE uuid = sqlc.arg('uuid')::uuid;
 
+
+-- name: GetMessageBodiesToMigrate :many
+-- Bodies still held in the message table of the messages written to a storage.
+SELECT
+    m.uuid, m.format, m.body, m.created_at, m.workspace_uuid
+FROM message m
+JOIN pipeline p ON p.uuid = m.pipeline_uuid
+WHERE
+    p.storage_uuid = sqlc.arg('storage_uuid')::uuid
+  AND m.body_file_uuid IS NULL
+  AND m.body <> ''
+  AND (sqlc.narg('after_uuid')::uuid IS NULL OR m.uuid > sqlc.narg('after_uuid')::uuid)
+  AND (sqlc.narg('created_before')::timestamptz IS NULL OR m.created_at < sqlc.narg('created_before')::timestamptz)
+ORDER BY m.uuid ASC
+LIMIT sqlc.arg('limit')::int;
+
+-- name: SetMessageBodyFile :exec
+-- Points the message to the copy of its body in a storage, clear_body drops
+-- the copy kept in the message table.
+UPDATE message SET
+    body_file_uuid = sqlc.arg('body_file_uuid')::uuid,
+    body = CASE WHEN sqlc.arg('clear_body')::boolean THEN '' ELSE body END,
+    updated_at = NOW()
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: InlineMessageBody :execrows
+-- Moves a body back from its file to the message table.
+UPDATE message SET
+    body = sqlc.arg('body'),
+    body_file_uuid = NULL,
+    updated_at = NOW()
+WHERE body_file_uuid = sqlc.arg('body_file_uuid')::uuid;
+
+-- name: GetMessageBodyFiles :many
+-- Files holding message bodies, for erasures to search what SQL can not.
+SELECT
+    m.uuid AS message_uuid, sqlc.embed(f)
+FROM message m
+JOIN "file" f ON f.uuid = m.body_file_uuid
+WHERE sqlc.narg('after_uuid')::uuid IS NULL OR m.uuid > sqlc.narg('after_uuid')::uuid
+ORDER BY m.uuid ASC
+LIMIT sqlc.arg('limit')::int;
@@ -0,0 +1,53 @@
+-- name: DeleteMessageChunks :exec
+DELETE FROM message_chunk WHERE message_uuid = @message_uuid;
+
+-- name: CreateMessageChunk :execrows
+-- The chunk lands in the workspace of its message, nothing is inserted when the
+-- message is not stored.
+INSERT INTO message_chunk (uuid, workspace_uuid, message_uuid, file_uuid, seq, content, terms, length, model, embedding)
+SELECT @uuid, m.workspace_uuid, m.uuid, sqlc.narg('file_uuid'), @seq, @content, @terms::text[], @length, @model,
+       sqlc.arg('embedding')::text::vector
+FROM message m
+WHERE m.uuid = @message_uuid;
+
+-- name: SearchMessageChunks :many
+-- Candidates of a semantic search: the chunks nearest to the query vector and
+-- those sharing terms with the query, each with its cosine similarity.
+WITH filtered AS (
+    SELECT c.uuid, c.message_uuid, c.content, c.length, c.embedding, c.terms
+    FROM message_chunk c
+    JOIN message m ON m.uuid = c.message_uuid
+    WHERE c.model = @model AND
+        vector_dims(c.embedding) = @dims::int AND
+        (NULLIF(sqlc.arg('type'), '') IS NULL OR m.type = sqlc.arg('type')) AND
+        (sqlc.narg('chat_uuid')::uuid IS NULL OR m.chat_uuid = sqlc.narg('chat_uuid')::uuid) AND
+        (sqlc.narg('thread_uuid')::uuid IS NULL OR m.thread_uuid = sqlc.narg('thread_uuid')::uuid) AND
+        (sqlc.narg('pipeline_uuid')::uuid IS NULL OR m.pipeline_uuid = sqlc.narg('pipeline_uuid')::uuid) AND
+        (sqlc.narg('created_after')::timestamptz IS NULL OR m.created_at >= sqlc.narg('created_after')::timestamptz) AND
+        (sqlc.narg('created_before')::timestamptz IS NULL OR m.created_at < sqlc.narg('created_before')::timestamptz)
+), nearest AS (
+    SELECT f.uuid FROM filtered f
+    ORDER BY f.embedding <=> sqlc.arg('embedding')::text::vector
+    LIMIT sqlc.arg('candidates')::int
+), matching AS (
+    SELECT f.uuid FROM filtered f
+    WHERE f.terms && @terms::text[]
+    ORDER BY cardinality(ARRAY(SELECT unnest(f.terms) INTERSECT SELECT unnest(@terms::text[]))) DESC
+    LIMIT sqlc.arg('candidates')::int
+)
+SELECT f.uuid, f.message_uuid, f.content, f.length,
+       (1 - (f.embedding <=> sqlc.arg('embedding')::text::vector))::float8 AS similarity
+FROM filtered f
+WHERE f.uuid IN (SELECT uuid FROM nearest UNION SELECT uuid FROM matching);
+
+-- name: GetMessageChunkStats :one
+-- Corpus statistics of BM25: the number of chunks and their average length.
+SELECT count(*)::bigint AS chunks, COALESCE(avg(length), 0)::float8 AS avg_length
+FROM message_chunk
+WHERE model = @model;
+
+-- name: GetMessageChunkTermCounts :many
+-- Document frequencies of BM25: the number of chunks holding each term.
+SELECT t.term::text AS term,
+       (SELECT count(*) FROM message_chunk c WHERE c.model = @model AND c.terms @> ARRAY[t.term]::text[])::bigint AS chunks
+FROM unnest(@terms::text[]) AS t(term);
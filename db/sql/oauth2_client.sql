@@ -5,6 +5,8 @@ INSERT INTO oauth2_client (
     provider,
     client_id,
     secret,
+    issuer_url,
+    scopes,
     created_at,
     updated_at
 ) VALUES (
@@ -13,6 +15,8 @@ INSERT INTO oauth2_client (
              sqlc.arg('provider'),
              sqlc.arg('client_id'),
              sqlc.arg('secret'),
+             sqlc.arg('issuer_url'),
+             sqlc.arg('scopes')::text[],
              NOW(),
              NOW()
          ) RETURNING *;
@@ -58,6 +62,8 @@ UPDATE oauth2_client SET
                          provider = sqlc.arg('provider'),
                          client_id = sqlc.arg('client_id'),
                          secret = sqlc.arg('secret'),
+                         issuer_url = sqlc.arg('issuer_url'),
+                         scopes = sqlc.arg('scopes')::text[],
                          updated_at = NOW()
 WHERE uuid = sqlc.arg('uuid')::uuid;
 
@@ -3,6 +3,7 @@ INSERT INTO oauth2_state (
     uuid,
     client_uuid,
     state,
+    code_verifier,
     created_at,
     updated_at,
     expired_at
@@ -10,6 +11,7 @@ INSERT INTO oauth2_state (
              sqlc.arg('uuid')::uuid,
              sqlc.arg('client_uuid')::uuid,
              sqlc.arg('state'),
+             sqlc.arg('code_verifier'),
              NOW(),
              NOW(),
              sqlc.arg('expired_at')
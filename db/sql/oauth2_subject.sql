@@ -3,12 +3,16 @@ INSERT INTO oauth2_subject (
     uuid,
     user_uuid,
     token_uuid,
+    external_subject,
+    external_email,
     created_at,
     updated_at
 ) VALUES (
              sqlc.arg('uuid')::uuid,
              sqlc.arg('user_uuid')::uuid,
              sqlc.arg('token_uuid')::uuid,
+             sqlc.arg('external_subject'),
+             sqlc.arg('external_email'),
              NOW(),
              NOW()
          ) RETURNING *;
@@ -4,6 +4,11 @@ INSERT INTO oauth2_token (
     client_uuid,
     user_uuid,
     token,
+    expires_at,
+    scopes,
+    missing_scopes,
+    status,
+    last_refreshed_at,
     created_at,
     updated_at
 ) VALUES (
@@ -11,6 +16,11 @@ INSERT INTO oauth2_token (
     sqlc.arg('client_uuid')::uuid,
     sqlc.arg('user_uuid')::uuid,
     sqlc.arg('token'),
+    sqlc.arg('expires_at'),
+    sqlc.arg('scopes'),
+    sqlc.arg('missing_scopes')::text[],
+    sqlc.arg('status'),
+    NOW(),
     NOW(),
     NOW()
 ) RETURNING *;
@@ -22,13 +32,24 @@ FROM oauth2_token
 WHERE client_uuid = sqlc.arg('client_uuid')::uuid;
 
 -- name: GetTokensToRefresh :many
+-- Tokens expiring before expires_before that an enabled datasource with an enabled
+-- pipeline uses. Tokens needing a new login are skipped, failing ones are retried
+-- with an exponential backoff capped at 6 hours.
 SELECT
     sqlc.embed(oauth2_token)
 FROM oauth2_token
 WHERE
-   (NULLIF(sqlc.arg('client_uuid'), '') IS NULL OR os.client_uuid = sqlc.arg('client_uuid')::uuid) AND
-    expires_at < NOW() AND
-    (updated_at IS NULL OR updated_at < NOW() - INTERVAL '1 hour');
+    status <> 'needs_reauth' AND
+    (expires_at IS NULL OR expires_at < sqlc.arg('expires_before')::timestamptz) AND
+    (failure_count = 0 OR last_failed_at IS NULL OR
+     last_failed_at < NOW() - LEAST(INTERVAL '5 minutes' * power(2, failure_count - 1), INTERVAL '6 hours')) AND
+    EXISTS (
+        SELECT 1
+        FROM datasource d
+        JOIN pipeline p ON p.datasource_uuid = d.uuid
+        WHERE d.settings->>'oauth2_client_uuid' = oauth2_token.client_uuid::text AND
+              d.is_enabled AND p.is_enabled
+    );
 
 -- name: GetOauth2TokenByUUID :one
 SELECT
@@ -36,12 +57,20 @@ SELECT
 FROM oauth2_token
 WHERE uuid = sqlc.arg('uuid')::uuid;
 
+-- name: GetOauth2TokenForUpdate :one
+-- Locks the token until the end of the transaction, refreshes of other
+-- processes wait for it and then see the refreshed token.
+SELECT *
+FROM oauth2_token
+WHERE uuid = sqlc.arg('uuid')::uuid
+FOR UPDATE;
+
 -- name: GetOauth2ClientTokens :many
 SELECT
     ot.*,
     c.name
 FROM oauth2_token AS ot
-         LEFT JOIN datasource AS c ON c.oauth2_token_uuid = ot.uuid
+         LEFT JOIN datasource AS c ON c.settings->>'oauth2_token_uuid' = ot.uuid::text
 WHERE ot.client_uuid = sqlc.arg('client_uuid')::uuid;
 
 -- name: GetOauth2Tokens :many
@@ -70,6 +99,29 @@ UPDATE oauth2_token SET
     updated_at = NOW()
 WHERE uuid = sqlc.arg('uuid')::uuid;
 
+-- name: SetOauth2TokenRefreshed :exec
+UPDATE oauth2_token SET
+    token = sqlc.arg('token'),
+    expires_at = sqlc.arg('expires_at'),
+    scopes = sqlc.arg('scopes'),
+    missing_scopes = sqlc.arg('missing_scopes')::text[],
+    status = sqlc.arg('status'),
+    last_refreshed_at = NOW(),
+    failure_count = 0,
+    last_error = '',
+    updated_at = NOW()
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: SetOauth2TokenFailed :one
+UPDATE oauth2_token SET
+    status = sqlc.arg('status'),
+    failure_count = failure_count + 1,
+    last_failed_at = NOW(),
+    last_error = sqlc.arg('last_error'),
+    updated_at = NOW()
+WHERE uuid = sqlc.arg('uuid')::uuid
+RETURNING *;
+
 -- name: DeleteOauth2Token :exec
 DELETE FROM oauth2_token
 WHERE uuid = sqlc.arg('uuid')::uuid;
@@ -0,0 +1,106 @@
+-- name: CreateRetentionPolicy :one
+INSERT INTO retention_policy (
+    uuid,
+    name,
+    datasource_uuid,
+    pipeline_uuid,
+    keep_days,
+    keep_last,
+    attachments_days,
+    is_enabled,
+    created_at,
+    updated_at
+) VALUES (
+    sqlc.arg('uuid')::uuid,
+    sqlc.arg('name'),
+    sqlc.narg('datasource_uuid')::uuid,
+    sqlc.narg('pipeline_uuid')::uuid,
+    sqlc.arg('keep_days')::int,
+    sqlc.arg('keep_last')::int,
+    sqlc.arg('attachments_days')::int,
+    sqlc.arg('is_enabled')::boolean,
+    NOW(),
+    NOW()
+) RETURNING *;
+
+-- name: GetRetentionPolicy :one
+SELECT
+    sqlc.embed(retention_policy)
+FROM retention_policy
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: GetRetentionPolicies :many
+SELECT
+    sqlc.embed(retention_policy)
+FROM retention_policy
+WHERE
+    (NULLIF(sqlc.arg('is_enabled')::int, -1) IS NULL OR is_enabled = (sqlc.arg('is_enabled')::int)::boolean)
+ORDER BY created_at DESC
+LIMIT NULLIF(sqlc.arg('limit')::int, 0)
+    OFFSET sqlc.arg('offset')::int;
+
+-- name: UpdateRetentionPolicy :exec
+UPDATE retention_policy SET
+    name = sqlc.arg('name'),
+    datasource_uuid = sqlc.narg('datasource_uuid')::uuid,
+    pipeline_uuid = sqlc.narg('pipeline_uuid')::uuid,
+    keep_days = sqlc.arg('keep_days')::int,
+    keep_last = sqlc.arg('keep_last')::int,
+    attachments_days = sqlc.arg('attachments_days')::int,
+    is_enabled = sqlc.arg('is_enabled')::boolean,
+    updated_at = NOW()
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: UpdateRetentionPolicyLastRun :exec
+UPDATE retention_policy SET
+    last_run_at = NOW()
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: DeleteRetentionPolicy :exec
+DELETE FROM retention_policy WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: GetExpiredMessageUUIDs :many
+SELECT uuid
+FROM message
+WHERE
+    (sqlc.narg('pipeline_uuid')::uuid IS NULL OR pipeline_uuid = sqlc.narg('pipeline_uuid')::uuid) AND
+    (sqlc.narg('datasource_uuid')::uuid IS NULL OR datasource_uuid = sqlc.narg('datasource_uuid')::uuid) AND
+    created_at < sqlc.arg('created_before')::timestamptz
+ORDER BY created_at ASC
+LIMIT sqlc.arg('limit')::int;
+
+-- name: GetMessageUUIDsBeyondKeepLast :many
+SELECT uuid
+FROM message
+WHERE
+    (sqlc.narg('pipeline_uuid')::uuid IS NULL OR pipeline_uuid = sqlc.narg('pipeline_uuid')::uuid) AND
+    (sqlc.narg('datasource_uuid')::uuid IS NULL OR datasource_uuid = sqlc.narg('datasource_uuid')::uuid)
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit')::int
+    OFFSET sqlc.arg('keep_last')::int;
+
+-- name: GetExpiredAttachments :many
+SELECT
+    sqlc.embed(f)
+FROM "file" f
+JOIN message m ON m.uuid = f.message_uuid
+WHERE
+    (sqlc.narg('pipeline_uuid')::uuid IS NULL OR m.pipeline_uuid = sqlc.narg('pipeline_uuid')::uuid) AND
+    (sqlc.narg('datasource_uuid')::uuid IS NULL OR m.datasource_uuid = sqlc.narg('datasource_uuid')::uuid) AND
+    f.created_at < sqlc.arg('created_before')::timestamptz AND
+    -- a body moved to a storage is not an attachment, it goes with its message
+    f.uuid IS DISTINCT FROM m.body_file_uuid
+ORDER BY f.created_at ASC
+LIMIT sqlc.arg('limit')::int;
+
+-- name: GetFilesByMessageUUIDs :many
+SELECT
+    sqlc.embed(file)
+FROM "file"
+WHERE message_uuid = ANY(sqlc.arg('message_uuids')::uuid[]);
+
+-- name: DeleteFilesByUUIDs :execrows
+DELETE FROM "file" WHERE uuid = ANY(sqlc.arg('uuids')::uuid[]);
+
+-- name: DeleteMessagesByUUIDs :execrows
+DELETE FROM message WHERE uuid = ANY(sqlc.arg('uuids')::uuid[]);
@@ -10,6 +10,9 @@ INSERT INTO scheduler (
     last_run,
     is_enabled,
     is_paused,
+    interval_seconds,
+    jitter_seconds,
+    overlap_policy,
     created_at,
     updated_at
 ) VALUES (
@@ -23,6 +26,9 @@ INSERT INTO scheduler (
              sqlc.arg('last_run'),
              sqlc.arg('is_enabled')::boolean,
              sqlc.arg('is_paused')::boolean,
+             sqlc.narg('interval_seconds'),
+             sqlc.arg('jitter_seconds'),
+             sqlc.arg('overlap_policy'),
              NOW(),
              NOW()
          ) RETURNING *;
@@ -65,6 +71,7 @@ LIMIT NULLIF(sqlc.arg('limit')::int, 0)
 
 -- name: UpdateScheduler :exec
 UPDATE scheduler SET
+                     schedule_type = sqlc.arg('schedule_type'),
                      cron_expression = sqlc.arg('cron_expression'),
                      run_at = sqlc.arg('run_at'),
                      timezone = sqlc.arg('timezone'),
@@ -72,8 +79,63 @@ UPDATE scheduler SET
                      last_run = sqlc.arg('last_run'),
                      is_enabled = sqlc.arg('is_enabled')::boolean,
                      is_paused = sqlc.arg('is_paused')::boolean,
+                     interval_seconds = sqlc.narg('interval_seconds'),
+                     jitter_seconds = sqlc.arg('jitter_seconds'),
+                     overlap_policy = sqlc.arg('overlap_policy'),
+                     -- resuming a scheduler drops the reason it was paused for
+                     paused_reason = CASE WHEN sqlc.arg('is_paused')::boolean THEN paused_reason ELSE '' END,
                      updated_at = NOW()
 WHERE uuid = sqlc.arg('uuid')::uuid;
 
+-- name: GetDueSchedulers :many
+-- Enabled schedulers of enabled pipelines to run by now, and those without a
+-- computed next run yet. The datasource type picks the fetch job. The rows stay
+-- locked until the transaction ends, concurrent runs skip them.
+SELECT
+    sqlc.embed(s),
+    d.uuid AS datasource_uuid,
+    d.type AS datasource_type
+FROM scheduler s
+         JOIN pipeline p ON p.uuid = s.pipeline_uuid
+         JOIN datasource d ON d.uuid = p.datasource_uuid
+WHERE s.is_enabled AND
+      NOT s.is_paused AND
+      p.is_enabled AND
+      (s.next_run IS NULL OR s.next_run <= sqlc.arg('now')::timestamptz)
+ORDER BY s.next_run NULLS FIRST
+LIMIT sqlc.arg('limit')::int
+FOR UPDATE OF s SKIP LOCKED;
+
+-- name: SetSchedulerRun :exec
+-- Stores the run times computed by the scheduler service, one_time schedulers
+-- are disabled after their run.
+UPDATE scheduler SET
+    last_run = COALESCE(sqlc.narg('last_run'), last_run),
+    next_run = sqlc.narg('next_run'),
+    is_enabled = sqlc.arg('is_enabled')::boolean,
+    updated_at = NOW()
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: PauseDatasourceSchedulers :exec
+UPDATE scheduler s SET
+    is_paused = TRUE,
+    paused_reason = sqlc.arg('reason'),
+    updated_at = NOW()
+FROM pipeline p
+WHERE p.uuid = s.pipeline_uuid AND
+      p.datasource_uuid = sqlc.arg('datasource_uuid')::uuid AND
+      NOT s.is_paused;
+
+-- name: ResumeDatasourceSchedulers :exec
+UPDATE scheduler s SET
+    is_paused = FALSE,
+    paused_reason = '',
+    updated_at = NOW()
+FROM pipeline p
+WHERE p.uuid = s.pipeline_uuid AND
+      p.datasource_uuid = sqlc.arg('datasource_uuid')::uuid AND
+      s.is_paused AND
+      s.paused_reason = sqlc.arg('reason');
+
 -- name: DeleteScheduler :exec
 DELETE FROM scheduler WHERE uuid = sqlc.arg('uuid')::uuid;
@@ -0,0 +1,53 @@
+-- name: GetOauth2ClientSecrets :many
+SELECT uuid, secret
+FROM oauth2_client
+ORDER BY uuid;
+
+-- name: SetOauth2ClientSecret :exec
+UPDATE oauth2_client SET
+    secret = sqlc.arg('secret')
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: GetOauth2TokenSecrets :many
+SELECT uuid, token
+FROM oauth2_token
+WHERE token IS NOT NULL
+ORDER BY uuid;
+
+-- name: SetOauth2TokenSecret :exec
+UPDATE oauth2_token SET
+    token = sqlc.arg('token')
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: GetStorageSecrets :many
+SELECT uuid, type, settings
+FROM storage
+WHERE type = ANY(sqlc.arg('types')::text[])
+ORDER BY uuid;
+
+-- name: SetStorageSettings :exec
+UPDATE storage SET
+    settings = sqlc.arg('settings')
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: GetDatasourceSecrets :many
+SELECT uuid, type, settings
+FROM datasource
+WHERE type = ANY(sqlc.arg('types')::text[])
+ORDER BY uuid;
+
+-- name: SetDatasourceSettings :exec
+UPDATE datasource SET
+    settings = sqlc.arg('settings')
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: GetWebhookSecrets :many
+SELECT uuid, secret
+FROM webhook
+WHERE secret <> ''
+ORDER BY uuid;
+
+-- name: SetWebhookSecret :exec
+UPDATE webhook SET
+    secret = sqlc.arg('secret')
+WHERE uuid = sqlc.arg('uuid')::uuid;
@@ -0,0 +1,79 @@
+-- name: CreateStorageMigration :one
+INSERT INTO storage_migration (
+    uuid,
+    source_storage_uuid,
+    target_storage_uuid,
+    status,
+    older_than_days,
+    delete_source,
+    batch_size,
+    created_at,
+    updated_at
+) VALUES (
+    sqlc.arg('uuid')::uuid,
+    sqlc.arg('source_storage_uuid')::uuid,
+    sqlc.arg('target_storage_uuid')::uuid,
+    sqlc.arg('status'),
+    sqlc.arg('older_than_days')::int,
+    sqlc.arg('delete_source')::boolean,
+    sqlc.arg('batch_size')::int,
+    NOW(),
+    NOW()
+) RETURNING *;
+
+-- name: GetStorageMigration :one
+SELECT
+    sqlc.embed(storage_migration)
+FROM storage_migration
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: GetStorageMigrations :many
+SELECT
+    sqlc.embed(storage_migration)
+FROM storage_migration
+WHERE
+    (sqlc.narg('source_storage_uuid')::uuid IS NULL OR source_storage_uuid = sqlc.narg('source_storage_uuid')::uuid) AND
+    (NULLIF(sqlc.arg('status'), '') IS NULL OR status = sqlc.arg('status'))
+ORDER BY created_at DESC
+LIMIT NULLIF(sqlc.arg('limit')::int, 0)
+    OFFSET sqlc.arg('offset')::int;
+
+-- name: UpdateStorageMigrationStatus :exec
+UPDATE storage_migration SET
+    status = sqlc.arg('status'),
+    error = sqlc.arg('error'),
+    finished_at = sqlc.arg('finished_at'),
+    updated_at = NOW()
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: UpdateStorageMigrationCheckpoint :exec
+UPDATE storage_migration SET
+    checkpoint_uuid = sqlc.arg('checkpoint_uuid')::uuid,
+    files_migrated = files_migrated + 1,
+    bytes_migrated = bytes_migrated + sqlc.arg('bytes')::bigint,
+    updated_at = NOW()
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: UpdateStorageMigrationMessageCheckpoint :exec
+UPDATE storage_migration SET
+    message_checkpoint_uuid = sqlc.arg('checkpoint_uuid')::uuid,
+    messages_migrated = messages_migrated + 1,
+    bytes_migrated = bytes_migrated + sqlc.arg('bytes')::bigint,
+    updated_at = NOW()
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: ClaimStorageMigration :one
+-- Marks the migration running unless another run holds it: a running migration
+-- is only taken over once its heartbeat is older than the lease.
+UPDATE storage_migration SET
+    status = 'running',
+    error = NULL,
+    updated_at = NOW()
+WHERE uuid = sqlc.arg('uuid')::uuid
+  AND status <> 'done'
+  AND (status <> 'running' OR updated_at < NOW() - make_interval(secs => sqlc.arg('lease_seconds')::int))
+RETURNING *;
+
+-- name: TouchStorageMigration :execrows
+UPDATE storage_migration SET updated_at = NOW()
+WHERE uuid = sqlc.arg('uuid')::uuid AND status = 'running';
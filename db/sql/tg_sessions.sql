@@ -4,6 +4,9 @@ SELECT * FROM tg_sessions WHERE id = sqlc.arg('id');
 -- name: TgGetSessionList :many
 SELECT * FROM tg_sessions WHERE account_id = sqlc.arg('account_id');
 
+-- name: TgListSessions :many
+SELECT * FROM tg_sessions ORDER BY id;
+
 -- name: TgGetSessionByPhone :one
 SELECT * FROM tg_sessions WHERE phone = sqlc.arg('phone');
 
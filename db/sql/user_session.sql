@@ -0,0 +1,64 @@
+-- name: CreateUserSession :one
+INSERT INTO user_session (
+    uuid,
+    user_uuid,
+    token_hash,
+    source,
+    user_agent,
+    ip,
+    created_at,
+    last_seen_at,
+    expires_at
+) VALUES (
+    sqlc.arg('uuid')::uuid,
+    sqlc.arg('user_uuid')::uuid,
+    sqlc.arg('token_hash'),
+    sqlc.arg('source'),
+    sqlc.arg('user_agent'),
+    sqlc.arg('ip'),
+    NOW(),
+    NOW(),
+    sqlc.arg('expires_at')
+) RETURNING *;
+
+-- name: GetActiveUserSession :one
+SELECT * FROM user_session
+WHERE token_hash = sqlc.arg('token_hash')
+    AND revoked_at IS NULL
+    AND expires_at > NOW()
+    AND last_seen_at > NOW() - make_interval(secs => sqlc.arg('idle_seconds')::int);
+
+-- name: GetActiveUserSessions :many
+SELECT * FROM user_session
+WHERE user_uuid = sqlc.arg('user_uuid')::uuid
+    AND revoked_at IS NULL
+    AND expires_at > NOW()
+    AND last_seen_at > NOW() - make_interval(secs => sqlc.arg('idle_seconds')::int)
+ORDER BY last_seen_at DESC;
+
+-- name: TouchUserSession :exec
+UPDATE user_session SET
+    last_seen_at = NOW(),
+    ip = sqlc.arg('ip')
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: RevokeUserSessionByToken :exec
+UPDATE user_session SET revoked_at = NOW()
+WHERE token_hash = sqlc.arg('token_hash') AND revoked_at IS NULL;
+
+-- name: RevokeUserSession :execrows
+UPDATE user_session SET revoked_at = NOW()
+WHERE uuid = sqlc.arg('uuid')::uuid AND user_uuid = sqlc.arg('user_uuid')::uuid AND revoked_at IS NULL;
+
+-- name: RevokeUserSessions :execrows
+-- Logs a user out everywhere, optionally keeping one session.
+UPDATE user_session SET revoked_at = NOW()
+WHERE user_uuid = sqlc.arg('user_uuid')::uuid
+    AND revoked_at IS NULL
+    AND (sqlc.narg('keep_uuid')::uuid IS NULL OR uuid <> sqlc.narg('keep_uuid')::uuid);
+
+-- name: DeleteEndedUserSessions :execrows
+DELETE FROM user_session
+WHERE expires_at < NOW() - INTERVAL '1 day'
+    OR revoked_at < NOW() - INTERVAL '1 day'
+    OR last_seen_at < NOW() - make_interval(secs => sqlc.arg('idle_seconds')::int) - INTERVAL '1 day';
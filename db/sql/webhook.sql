@@ -0,0 +1,94 @@
+-- name: CreateWebhook :one
+INSERT INTO webhook (
+    uuid,
+    name,
+    url,
+    secret,
+    event_types,
+    is_enabled,
+    last_event_uuid,
+    last_event_txid,
+    created_at,
+    updated_at
+) VALUES (
+    sqlc.arg('uuid')::uuid,
+    sqlc.arg('name'),
+    sqlc.arg('url'),
+    sqlc.arg('secret'),
+    sqlc.arg('event_types')::text[],
+    sqlc.arg('is_enabled')::boolean,
+    -- only events after the creation are delivered
+    (SELECT e.uuid FROM event e WHERE e.workspace_uuid = COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001')
+     ORDER BY e.txid DESC, e.uuid DESC LIMIT 1),
+    (SELECT max(e.txid) FROM event e WHERE e.workspace_uuid = COALESCE(current_workspace_uuid(), '00000000-0000-0000-0000-000000000001')),
+    NOW(),
+    NOW()
+) RETURNING *;
+
+-- name: GetWebhook :one
+SELECT *
+FROM webhook
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: ListWebhooks :many
+SELECT *
+FROM webhook
+ORDER BY created_at DESC;
+
+-- name: UpdateWebhook :one
+UPDATE webhook SET
+    name = sqlc.arg('name'),
+    url = sqlc.arg('url'),
+    secret = sqlc.arg('secret'),
+    event_types = sqlc.arg('event_types')::text[],
+    is_enabled = sqlc.arg('is_enabled')::boolean,
+    updated_at = NOW()
+WHERE uuid = sqlc.arg('uuid')::uuid
+RETURNING *;
+
+-- name: DeleteWebhook :exec
+DELETE FROM webhook
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: ClaimDueWebhooks :many
+-- Leases the enabled webhooks with events to deliver to one dispatcher until
+-- locked_until, the events are posted outside of any transaction.
+UPDATE webhook SET locked_until = NOW() + make_interval(secs => sqlc.arg('lease_seconds')::int)
+WHERE uuid IN (
+    SELECT w.uuid
+    FROM webhook w
+    WHERE w.is_enabled AND
+          (w.locked_until IS NULL OR w.locked_until < NOW()) AND
+          EXISTS (
+              SELECT 1 FROM event e
+              WHERE e.workspace_uuid = w.workspace_uuid AND
+                    (w.last_event_txid IS NULL OR
+                        (e.txid, e.uuid) > (w.last_event_txid, COALESCE(w.last_event_uuid, '00000000-0000-0000-0000-000000000000'))) AND
+                    e.txid < pg_snapshot_xmin(pg_current_snapshot())::text::bigint
+          )
+    ORDER BY w.uuid
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: SetWebhookDelivered :exec
+-- Moves the cursor past a delivered event and extends the lease.
+UPDATE webhook SET
+    last_event_txid = sqlc.arg('last_event_txid')::bigint,
+    last_event_uuid = sqlc.arg('last_event_uuid')::uuid,
+    last_status = sqlc.arg('last_status'),
+    last_error = '',
+    failure_count = 0,
+    locked_until = NOW() + make_interval(secs => sqlc.arg('lease_seconds')::int)
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: SetWebhookFailed :exec
+UPDATE webhook SET
+    last_status = sqlc.arg('last_status'),
+    last_error = sqlc.arg('last_error'),
+    failure_count = failure_count + 1
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: ReleaseWebhook :exec
+UPDATE webhook SET locked_until = NULL
+WHERE uuid = sqlc.arg('uuid')::uuid;
@@ -2,6 +2,7 @@
 INSERT INTO worker_jobs (
     uuid,
     scheduler_uuid,
+    pipeline_uuid,
     job_uuid,
     subject,
     status,
@@ -11,6 +12,7 @@ INSERT INTO worker_jobs (
 ) VALUES (
              sqlc.arg('uuid')::uuid,
              sqlc.arg('scheduler_uuid')::uuid,
+             sqlc.narg('pipeline_uuid')::uuid,
              sqlc.arg('job_uuid')::uuid,
              sqlc.arg('subject'),
              sqlc.arg('status'),
@@ -18,6 +20,11 @@ INSERT INTO worker_jobs (
              NOW(),
              sqlc.arg('finished_at')
          )
+-- jobs recorded as queued by the scheduler start running here
+ON CONFLICT (uuid) DO UPDATE SET
+    status = EXCLUDED.status,
+    started_at = EXCLUDED.started_at,
+    finished_at = EXCLUDED.finished_at
 RETURNING *;
 
 -- name: GetWorkerJob :one
@@ -40,7 +47,8 @@ WITH filtered_worker_jobs AS (
     FROM worker_jobs w
     WHERE
         (sqlc.arg('scheduler_uuid')::uuid IS NULL OR w.scheduler_uuid = sqlc.arg('scheduler_uuid')::uuid) AND
-        (sqlc.arg('job_uuid')::uuid IS NULL OR w.scheduler_uuid = sqlc.arg('job_uuid')::uuid) AND
+        (sqlc.narg('pipeline_uuid')::uuid IS NULL OR w.pipeline_uuid = sqlc.narg('pipeline_uuid')::uuid) AND
+        (sqlc.arg('job_uuid')::uuid IS NULL OR w.job_uuid = sqlc.arg('job_uuid')::uuid) AND
         (NULLIF(sqlc.arg('subject'), '') IS NULL OR w.subject = sqlc.arg('subject')) AND
         (NULLIF(sqlc.arg('status'), '') IS NULL OR w.status = sqlc.arg('status'))
 )
@@ -62,15 +70,49 @@ LIMIT NULLIF(sqlc.arg('limit')::int, 0)
 -- name: UpdateWorkerJob :exec
 UPDATE worker_jobs
 SET
-    scheduler_uuid = sqlc.arg('scheduler_uuid')::uuid,
+    -- jobs without a scheduler in their arguments keep the recorded one
+    scheduler_uuid = COALESCE(sqlc.arg('scheduler_uuid')::uuid, scheduler_uuid),
     job_uuid = sqlc.arg('job_uuid')::uuid,
 
     subject     = sqlc.arg('subject'),
     status      = sqlc.arg('status'),
-    data        = sqlc.arg('data'),
+    -- keeps what was recorded while the job ran, e.g. its result
+    data        = COALESCE(data, '{}'::jsonb) || sqlc.arg('data')::jsonb,
     finished_at = sqlc.arg('finished_at')
 WHERE uuid = sqlc.arg('uuid')::uuid;
 
+-- name: MergeWorkerJobData :exec
+UPDATE worker_jobs SET
+    data = COALESCE(data, '{}'::jsonb) || sqlc.arg('data')::jsonb
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: GetActiveSchedulerJobs :many
+-- Queued or running jobs of a scheduler. Jobs older than started_after are
+-- ignored, their worker is assumed dead.
+SELECT *
+FROM worker_jobs
+WHERE scheduler_uuid = sqlc.arg('scheduler_uuid')::uuid AND
+      finished_at IS NULL AND
+      status IN ('queued', 'running') AND
+      started_at > sqlc.arg('started_after')::timestamptz
+ORDER BY started_at;
+
+-- name: CancelWorkerJob :exec
+UPDATE worker_jobs SET
+    status = 'cancelled',
+    finished_at = NOW()
+WHERE uuid = sqlc.arg('uuid')::uuid AND
+      finished_at IS NULL;
+
+-- name: RequeueWorkerJob :exec
+-- Jobs interrupted by a shutdown are queued again, their message is
+-- redelivered to another worker.
+UPDATE worker_jobs SET
+    status = 'queued',
+    finished_at = NULL,
+    data = COALESCE(data, '{}'::jsonb) || sqlc.arg('data')::jsonb
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
 -- name: DeleteWorkerJob :exec
 DELETE FROM worker_jobs
 WHERE uuid = sqlc.arg('uuid')::uuid;
@@ -0,0 +1,92 @@
+-- name: CreateWorkspace :one
+INSERT INTO workspace (
+    uuid,
+    name,
+    slug,
+    created_at,
+    updated_at
+) VALUES (
+    sqlc.arg('uuid')::uuid,
+    sqlc.arg('name'),
+    sqlc.arg('slug'),
+    NOW(),
+    NOW()
+) RETURNING *;
+
+-- name: GetWorkspace :one
+SELECT
+    sqlc.embed(workspace)
+FROM workspace
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: GetWorkspaces :many
+SELECT
+    sqlc.embed(workspace),
+    COALESCE(m.role, '')::text AS role
+FROM workspace
+    LEFT JOIN workspace_member m ON m.workspace_uuid = workspace.uuid AND m.user_uuid = sqlc.narg('user_uuid')::uuid
+WHERE
+    (sqlc.narg('user_uuid')::uuid IS NULL OR m.user_uuid IS NOT NULL)
+ORDER BY workspace.created_at ASC
+LIMIT NULLIF(sqlc.arg('limit')::int, 0)
+    OFFSET sqlc.arg('offset')::int;
+
+-- name: UpdateWorkspace :exec
+UPDATE workspace SET
+    name = sqlc.arg('name'),
+    slug = sqlc.arg('slug'),
+    updated_at = NOW()
+WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: DeleteWorkspace :exec
+DELETE FROM workspace WHERE uuid = sqlc.arg('uuid')::uuid;
+
+-- name: UpsertWorkspaceMember :one
+INSERT INTO workspace_member (
+    workspace_uuid,
+    user_uuid,
+    role,
+    created_at
+) VALUES (
+    sqlc.arg('workspace_uuid')::uuid,
+    sqlc.arg('user_uuid')::uuid,
+    sqlc.arg('role'),
+    NOW()
+)
+ON CONFLICT (workspace_uuid, user_uuid) DO UPDATE SET role = EXCLUDED.role
+RETURNING *;
+
+-- name: GetWorkspaceMembers :many
+SELECT
+    sqlc.embed(workspace_member),
+    u.email
+FROM workspace_member
+    JOIN "user" u ON u.uuid = workspace_member.user_uuid
+WHERE workspace_member.workspace_uuid = sqlc.arg('workspace_uuid')::uuid
+ORDER BY workspace_member.created_at ASC;
+
+-- name: DeleteWorkspaceMember :execrows
+DELETE FROM workspace_member
+WHERE workspace_uuid = sqlc.arg('workspace_uuid')::uuid AND user_uuid = sqlc.arg('user_uuid')::uuid;
+
+-- name: CountWorkspaceOwners :one
+SELECT count(*)::int
+FROM workspace_member
+WHERE workspace_uuid = sqlc.arg('workspace_uuid')::uuid AND role = 'owner';
+
+-- name: ResolveUserWorkspace :one
+-- Picks the requested workspace, or the oldest membership when none is
+-- requested. Administrators reach every workspace, with the admin role where
+-- they are not members.
+SELECT
+    w.uuid,
+    COALESCE(m.role, 'admin')::text AS role
+FROM workspace w
+    LEFT JOIN workspace_member m ON m.workspace_uuid = w.uuid AND m.user_uuid = sqlc.arg('user_uuid')::uuid
+WHERE
+    (sqlc.narg('workspace_uuid')::uuid IS NULL OR w.uuid = sqlc.narg('workspace_uuid')::uuid)
+    AND (m.user_uuid IS NOT NULL OR EXISTS (
+        SELECT 1 FROM "user" u WHERE u.uuid = sqlc.arg('user_uuid')::uuid AND u.is_admin
+    ))
+ORDER BY m.created_at ASC NULLS LAST, w.created_at ASC
+LIMIT 1;
@@ -14,11 +14,19 @@ type DataSourceBase = {
   user_uuid?: string
 }
 
-export type DataSourceKind = 'email' | 'email_oauth' | 'telegram' | 'whatsapp' | 'linkedin'
+// email_graph is not in the generated types yet
+type EmailGraphSettings = {
+  email: string
+  oauth2_client_uuid: string
+  folders?: string[]
+}
+
+export type DataSourceKind = 'email' | 'email_oauth' | 'email_graph' | 'telegram' | 'whatsapp' | 'linkedin'
 
 export type DataSourceFormData =
   | (DataSourceBase & { type: 'email' } & components['schemas']['datasource_email'])
   | (DataSourceBase & { type: 'email_oauth' } & components['schemas']['datasource_email_oauth'])
+  | (DataSourceBase & { type: 'email_graph' } & EmailGraphSettings)
   | (DataSourceBase & { type: 'telegram' } & components['schemas']['datasource_telegram'])
   | (DataSourceBase & { type: 'whatsapp' } & components['schemas']['datasource_whatsapp'])
   | (DataSourceBase & { type: 'linkedin' } & components['schemas']['datasource_linkedin'])
@@ -26,6 +34,7 @@ export type DataSourceFormData =
 const createEndpoints: Record<DataSourceKind, string> = {
   email: '/datasource/email',
   email_oauth: '/datasource/email_oauth',
+  email_graph: '/datasource/email_graph',
   telegram: '/datasource/telegram',
   whatsapp: '/datasource/whatsapp',
   linkedin: '/datasource/linkedin',
@@ -34,6 +43,7 @@ const createEndpoints: Record<DataSourceKind, string> = {
 const updateEndpoints: Record<DataSourceKind, string> = {
   email: '/datasource/email',
   email_oauth: '/datasource/email_oauth',
+  email_graph: '/datasource/email_graph',
   telegram: '/datasource/telegram',
   whatsapp: '/datasource/whatsapp',
   linkedin: '/datasource/linkedin',
@@ -42,6 +52,7 @@ const updateEndpoints: Record<DataSourceKind, string> = {
 const deleteEndpoints: Record<DataSourceKind, string> = {
   email: '/datasource/email',
   email_oauth: '/datasource/email_oauth',
+  email_graph: '/datasource/email_graph',
   telegram: '/datasource/telegram',
   whatsapp: '/datasource/whatsapp',
   linkedin: '/datasource/linkedin',
@@ -139,6 +150,7 @@ export function DataSourceForm({ datasourceUUID }: { datasourceUUID: string }):
           <Select disabled={datasourceUUID !== 'add'}>
             <Select.Option value="email">Email IMAP</Select.Option>
             <Select.Option value="email_oauth">Email OAuth</Select.Option>
+            <Select.Option value="email_graph">Microsoft 365 / Outlook</Select.Option>
             <Select.Option value="telegram">Telegram</Select.Option>
             <Select.Option value="whatsapp">WhatsApp</Select.Option>
             <Select.Option value="linkedin">LinkedIn</Select.Option>
@@ -192,6 +204,32 @@ export function DataSourceForm({ datasourceUUID }: { datasourceUUID: string }):
           </>
         )}
 
+        {currentType === 'email_graph' && (
+          <>
+            <Form.Item name="email" label="Email" rules={[{ required: true, message: 'Email is required' }]}>
+              <Input />
+            </Form.Item>
+            <Form.Item
+              name="oauth2_client_uuid"
+              label="OAuth2 Client"
+              rules={[{ required: true, message: 'OAuth2 client is required' }]}
+            >
+              <Select>
+                {oauth2ClientsData?.clients
+                  ?.filter((c) => c.provider.toLowerCase() === 'microsoft')
+                  .map((c) => (
+                    <Select.Option key={c.uuid} value={c.uuid}>
+                      {c.name} ({c.client_id})
+                    </Select.Option>
+                  ))}
+              </Select>
+            </Form.Item>
+            <Form.Item name="folders" label="Folders" tooltip="Defaults to inbox">
+              <Select mode="tags" tokenSeparators={[',']} placeholder="inbox, sentitems" />
+            </Form.Item>
+          </>
+        )}
+
         {currentType === 'telegram' && (
           <>
             <Form.Item name="phone_number" label="Phone Number">
@@ -7,6 +7,12 @@ import apiClient from '@/api/client'
 import { useApiGet } from '@/api/hooks'
 import type { components } from '@/api/v1'
 
+interface OAuth2Provider {
+  name: string
+  title: string
+  discovery: boolean
+}
+
 export function OAuth2CredentialForm({ clientID }: { clientID: string }): ReactElement {
   const navigate = useNavigate()
   const [searchParams] = useSearchParams()
@@ -17,6 +23,10 @@ export function OAuth2CredentialForm({ clientID }: { clientID: string }): ReactE
     clientID !== 'add' ? `/oauth2/client/${clientID}` : null
   )
 
+  const { data: providers } = useApiGet<OAuth2Provider[]>('/oauth2/provider')
+  const providerName = Form.useWatch('provider', form)
+  const needsIssuer = providers?.some((p) => p.name === providerName?.toLowerCase() && p.discovery) ?? false
+
   useEffect(() => {
     if (data) {
       form.setFieldsValue(data)
@@ -31,6 +41,7 @@ export function OAuth2CredentialForm({ clientID }: { clientID: string }): ReactE
           client_id: values.client_id,
           name: values.name,
           secret: values.secret,
+          issuer_url: values.issuer_url,
         })
       } else {
         await apiClient.put(`/oauth2/client/${clientID}`, {
@@ -38,6 +49,7 @@ export function OAuth2CredentialForm({ clientID }: { clientID: string }): ReactE
           name: values.name,
           secret: values.secret,
           client_id: values.client_id,
+          issuer_url: values.issuer_url,
         })
       }
       message.success(clientID === 'add' ? 'OAuth2 credential created' : 'OAuth2 credential updated')
@@ -79,10 +91,20 @@ export function OAuth2CredentialForm({ clientID }: { clientID: string }): ReactE
 
         <Form.Item name="provider" label="Provider" rules={[{ required: true, message: 'Provider is required' }]}>
           <Select>
-            <Select.Option value="GMAIL">Gmail</Select.Option>
+            {(providers ?? []).map((p) => (
+              <Select.Option key={p.name} value={p.name}>
+                {p.title}
+              </Select.Option>
+            ))}
           </Select>
         </Form.Item>
 
+        {needsIssuer && (
+          <Form.Item name="issuer_url" label="Issuer URL" rules={[{ required: true, message: 'Issuer URL is required' }]}>
+            <Input placeholder="https://auth.example.com" />
+          </Form.Item>
+        )}
+
         <Form.Item name="client_id" label="Client ID" rules={[{ required: true, message: 'Client ID is required' }]}>
           <Input />
         </Form.Item>
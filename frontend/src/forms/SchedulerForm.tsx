@@ -1,6 +1,6 @@
 import { ReactElement, useCallback, useEffect, useState } from 'react'
 import { useNavigate } from 'react-router-dom'
-import { Button, Form, Input, Select, Space, Switch, Typography, message } from 'antd'
+import { Button, Form, Input, InputNumber, Select, Space, Switch, Typography, message } from 'antd'
 import { useSWRConfig } from 'swr'
 
 import apiClient from '@/api/client'
@@ -39,6 +39,9 @@ type SchedulerFormData = {
   schedule_type: string
   cron_expression?: string | null
   run_at?: string | null
+  interval_seconds?: number | null
+  jitter_seconds?: number
+  overlap_policy?: string
   timezone?: string
   is_enabled: boolean
   is_paused: boolean
@@ -237,6 +240,7 @@ export function SchedulerForm({ schedulerUUID }: { schedulerUUID: string }): Rea
           timezone: 'Europe/London',
           is_enabled: true,
           is_paused: false,
+          overlap_policy: 'skip',
         }}
       >
         <Typography.Title level={4}>{isAdd ? 'Add Scheduler' : 'Edit Scheduler'}</Typography.Title>
@@ -262,6 +266,7 @@ export function SchedulerForm({ schedulerUUID }: { schedulerUUID: string }): Rea
         >
           <Select>
             <Select.Option value="cron">Cron</Select.Option>
+            <Select.Option value="interval">Interval</Select.Option>
             <Select.Option value="one_time">One Time</Select.Option>
           </Select>
         </Form.Item>
@@ -313,6 +318,16 @@ export function SchedulerForm({ schedulerUUID }: { schedulerUUID: string }): Rea
           </>
         )}
 
+        {scheduleType === 'interval' && (
+          <Form.Item
+            name="interval_seconds"
+            label="Interval (seconds)"
+            rules={[{ required: true, type: 'number', min: 60, message: 'Interval of at least 60 seconds is required' }]}
+          >
+            <InputNumber min={60} step={60} style={{ width: '100%' }} />
+          </Form.Item>
+        )}
+
         {scheduleType === 'one_time' && (
           <Form.Item name="run_at" label="Run At" rules={[{ required: true, message: 'Run At is required' }]}>
             <Input type="datetime-local" />
@@ -323,6 +338,22 @@ export function SchedulerForm({ schedulerUUID }: { schedulerUUID: string }): Rea
           <Select showSearch optionFilterProp="label" options={timezones} />
         </Form.Item>
 
+        <Form.Item name="jitter_seconds" label="Jitter (seconds)" tooltip="Delays each run by a random number of seconds up to this value">
+          <InputNumber min={0} style={{ width: '100%' }} />
+        </Form.Item>
+
+        <Form.Item
+          name="overlap_policy"
+          label="While Previous Run Is Active"
+          tooltip="What a due run does while the previous job of this scheduler is still queued or running"
+        >
+          <Select>
+            <Select.Option value="skip">Skip this run</Select.Option>
+            <Select.Option value="queue">Queue this run</Select.Option>
+            <Select.Option value="cancel">Cancel the previous run</Select.Option>
+          </Select>
+        </Form.Item>
+
         <Form.Item name="is_enabled" label="Enabled" valuePropName="checked">
           <Switch />
         </Form.Item>
@@ -1,5 +1,5 @@
 import { useNavigate } from 'react-router-dom'
-import { Button, Table, Tag, Space, Typography, message } from 'antd'
+import { Button, Table, Tag, Space, Tooltip, Typography, message } from 'antd'
 import { PlusOutlined, EditOutlined, LoginOutlined, DeleteOutlined, MailOutlined } from '@ant-design/icons'
 import type { ColumnsType } from 'antd/es/table'
 import { mutate } from 'swr'
@@ -13,6 +13,8 @@ interface DatasourceRow {
   name: string
   type: string
   state: 'Enabled' | 'Disabled'
+  needsReauth: boolean
+  reauthReason?: string
 }
 
 export function DataSources() {
@@ -26,6 +28,8 @@ export function DataSources() {
       name: ds.name,
       type: ds.type,
       state: ds.is_enabled ? 'Enabled' : 'Disabled',
+      needsReauth: !!ds.needs_reauth,
+      reauthReason: ds.reauth_reason,
     })) ?? []
 
   const handleRevokeTokens = async (datasourceUUID: string) => {
@@ -43,7 +47,7 @@ export function DataSources() {
   }
 
   const handleOauthLogin = async (row: DatasourceRow) => {
-    if (row.type !== 'email_oauth') return
+    if (row.type !== 'email_oauth' && row.type !== 'email_graph') return
     try {
       const resp = await apiClient.post('/oauth2/login', {
         query: { datasource_uuid: [row.key] },
@@ -59,6 +63,7 @@ export function DataSources() {
   const typeBadge = (type: string) => {
     if (type === 'email') return <Tag icon={<MailOutlined />}>Email IMAP</Tag>
     if (type === 'email_oauth') return <Tag icon={<MailOutlined />}>Email OAuth</Tag>
+    if (type === 'email_graph') return <Tag icon={<MailOutlined />}>Microsoft 365</Tag>
     return <Tag>{type}</Tag>
   }
 
@@ -76,14 +81,23 @@ export function DataSources() {
       dataIndex: 'state',
       key: 'state',
       width: 160,
-      render: (state: string) => <Tag color={state === 'Enabled' ? 'success' : 'error'}>{state}</Tag>,
+      render: (state: string, record) => (
+        <Space size={4}>
+          <Tag color={state === 'Enabled' ? 'success' : 'error'}>{state}</Tag>
+          {record.needsReauth && (
+            <Tooltip title={record.reauthReason}>
+              <Tag color="warning">Needs re-auth</Tag>
+            </Tooltip>
+          )}
+        </Space>
+      ),
     },
     {
       title: 'Re/Auth',
       key: 'login',
       width: 120,
       render: (_, record) =>
-        record.type === 'email_oauth' ? (
+        record.type === 'email_oauth' || record.type === 'email_graph' ? (
           <Button type="text" icon={<LoginOutlined />} onClick={() => handleOauthLogin(record)} />
         ) : (
           '-'
@@ -94,7 +108,7 @@ export function DataSources() {
       key: 'revoke',
       width: 120,
       render: (_, record) =>
-        record.type === 'email_oauth' ? (
+        record.type === 'email_oauth' || record.type === 'email_graph' ? (
           <Button type="text" danger icon={<DeleteOutlined />} onClick={() => handleRevokeTokens(record.key)} />
         ) : (
           '-'
@@ -1,9 +1,11 @@
+import { useState } from 'react'
 import { useNavigate } from 'react-router-dom'
-import { Button, Table, Space, Typography } from 'antd'
-import { PlusOutlined, EditOutlined } from '@ant-design/icons'
+import { Button, Modal, Table, Space, Tooltip, Typography } from 'antd'
+import { PlusOutlined, EditOutlined, PlayCircleOutlined } from '@ant-design/icons'
 import type { ColumnsType } from 'antd/es/table'
 
 import { useApiGet } from '@/api/hooks'
+import { PipelineRunForm } from '@/forms/PipelineRunForm'
 import { FullLayout } from '@/layouts/FullLayout'
 
 interface PipelineRow {
@@ -16,6 +18,7 @@ interface PipelineRow {
 
 export function Pipelines() {
   const navigate = useNavigate()
+  const [runUUID, setRunUUID] = useState<string | null>(null)
 
   const { data, error, isLoading } = useApiGet<{ pipelines: PipelineRow[] }>('/pipeline')
 
@@ -48,9 +51,14 @@ export function Pipelines() {
     {
       title: 'Actions',
       key: 'actions',
-      width: 80,
+      width: 100,
       render: (_, record) => (
-        <Button type="text" icon={<EditOutlined />} onClick={() => navigate('/pipelines/' + record.uuid)} />
+        <Space size={0}>
+          <Tooltip title="Run now">
+            <Button type="text" icon={<PlayCircleOutlined />} onClick={() => setRunUUID(record.uuid)} />
+          </Tooltip>
+          <Button type="text" icon={<EditOutlined />} onClick={() => navigate('/pipelines/' + record.uuid)} />
+        </Space>
       ),
     },
   ]
@@ -81,6 +89,9 @@ export function Pipelines() {
             style={{ maxWidth: 1000 }}
           />
         </Space>
+        <Modal open={runUUID !== null} onCancel={() => setRunUUID(null)} footer={null} width={520} destroyOnClose>
+          {runUUID && <PipelineRunForm pipelineUUID={runUUID} onDone={() => setRunUUID(null)} />}
+        </Modal>
       </div>
     </FullLayout>
   )
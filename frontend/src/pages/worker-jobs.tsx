@@ -13,7 +13,8 @@ interface WorkerJob {
   uuid: string
   subject: string
   status: string
-  scheduler_uuid: string
+  scheduler_uuid?: string
+  pipeline_uuid?: string
   job_uuid: string
   started_at: string | null
   finished_at: string | null
@@ -53,19 +54,30 @@ export function WorkerJobs() {
     {
       title: 'Scheduler',
       key: 'scheduler_uuid',
-      render: (_, record) => (
-        <Space>
-          <Button type="link" size="small" onClick={() => navigate('/schedulers/' + record.scheduler_uuid)}>
-            {shortenUuid(record.scheduler_uuid)}
-          </Button>
-          <Button
-            type="text"
-            size="small"
-            icon={<CopyOutlined />}
-            onClick={() => copyToClipboard(record.scheduler_uuid, 'Scheduler')}
-          />
-        </Space>
-      ),
+      render: (_, record) => {
+        // pipeline runs triggered by hand have no scheduler
+        if (!record.scheduler_uuid) {
+          return record.pipeline_uuid ? (
+            <Button type="link" size="small" onClick={() => navigate('/pipelines/' + record.pipeline_uuid)}>
+              manual run of {shortenUuid(record.pipeline_uuid)}
+            </Button>
+          ) : null
+        }
+        const schedulerUUID = record.scheduler_uuid
+        return (
+          <Space>
+            <Button type="link" size="small" onClick={() => navigate('/schedulers/' + schedulerUUID)}>
+              {shortenUuid(schedulerUUID)}
+            </Button>
+            <Button
+              type="text"
+              size="small"
+              icon={<CopyOutlined />}
+              onClick={() => copyToClipboard(schedulerUUID, 'Scheduler')}
+            />
+          </Space>
+        )
+      },
     },
     {
       title: 'Job',